@@ -2,7 +2,6 @@
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"os"
@@ -18,6 +17,7 @@
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	loggingcfg "github.com/dinnerdonebetter/backend/internal/observability/logging/config"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/workers"
 
 	_ "github.com/GoogleCloudPlatform/functions-framework-go/funcframework"
 	"github.com/GoogleCloudPlatform/functions-framework-go/functions"
@@ -103,92 +103,17 @@ func SendEmail(ctx context.Context, e event.Event) error {
 		return fmt.Errorf("event.DataAs: %w", err)
 	}
 
-	var emailDeliveryRequest email.DeliveryRequest
-	if err = json.Unmarshal(msg.Message.Data, &emailDeliveryRequest); err != nil {
-		logger = logger.WithValue("raw_data", msg.Message.Data)
-		return observability.PrepareAndLogError(err, logger, span, "unmarshalling delivery request message")
-	}
-
-	logger = logger.WithValue("template", emailDeliveryRequest.Template)
-
-	user, err := dataManager.GetUser(ctx, emailDeliveryRequest.UserID)
-	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "getting user")
-	}
-
-	var (
-		mail                   *email.OutboundEmailMessage
-		shouldSkipIfUnverified = true
-		emailType              string
+	outboundEmailerWorker := workers.ProvideOutboundEmailerWorker(
+		logger,
+		dataManager,
+		emailer,
+		analyticsEventReporter,
+		envCfg,
+		tracerProvider,
 	)
 
-	switch emailDeliveryRequest.Template {
-	case email.TemplateTypeInvite:
-		if emailDeliveryRequest.Invitation == nil {
-			return observability.PrepareAndLogError(err, logger, span, "missing household invitation")
-		}
-
-		mail, err = email.BuildInviteMemberEmail(emailDeliveryRequest.Invitation, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building email message")
-		}
-		emailType = "invite"
-	case email.TemplateTypeUsernameReminder:
-		mail, err = email.BuildUsernameReminderEmail(user, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building username reminder email")
-		}
-		emailType = "username reminder"
-	case email.TemplateTypePasswordResetTokenCreated:
-		if emailDeliveryRequest.PasswordResetToken == nil {
-			return observability.PrepareAndLogError(err, logger, span, "missing password reset token")
-		}
-
-		mail, err = email.BuildGeneratedPasswordResetTokenEmail(user, emailDeliveryRequest.PasswordResetToken, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building password reset token created email")
-		}
-		emailType = "password reset token"
-	case email.TemplateTypePasswordReset:
-		mail, err = email.BuildPasswordChangedEmail(user, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building password reset token email")
-		}
-		emailType = "password reset token"
-	case email.TemplateTypePasswordResetTokenRedeemed:
-		mail, err = email.BuildPasswordResetTokenRedeemedEmail(user, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building password reset token redemption email")
-		}
-		emailType = "password reset token redemption"
-	case email.TemplateTypeMealPlanCreated:
-		mail, err = email.BuildMealPlanCreatedEmail(user, emailDeliveryRequest.MealPlan, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building meal plan created email")
-		}
-		emailType = "meal plan created"
-	case email.TemplateTypeVerifyEmailAddress:
-		shouldSkipIfUnverified = false
-		mail, err = email.BuildVerifyEmailAddressEmail(user, emailDeliveryRequest.EmailVerificationToken, envCfg)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "building address verification email")
-		}
-		emailType = "email address verification"
-	}
-
-	if shouldSkipIfUnverified && user.EmailAddressVerifiedAt == nil {
-		logger.Info("user email address not verified, skipping email delivery")
-		return nil
-	}
-
-	logger.Info("sending email")
-
-	if err = emailer.SendEmail(ctx, mail); err != nil {
-		observability.AcknowledgeError(err, logger, span, "sending %s email", emailType)
-	}
-
-	if err = analyticsEventReporter.EventOccurred(ctx, email.SentEventType, emailDeliveryRequest.UserID, emailDeliveryRequest.TemplateParams); err != nil {
-		observability.AcknowledgeError(err, logger, span, "notifying customer data platform")
+	if err = outboundEmailerWorker.HandleMessage(ctx, msg.Message.Data); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "handling email delivery request")
 	}
 
 	return nil
@@ -2,7 +2,6 @@
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"os"
@@ -16,7 +15,7 @@
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	loggingcfg "github.com/dinnerdonebetter/backend/internal/observability/logging/config"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
-	"github.com/dinnerdonebetter/backend/internal/search/indexing"
+	"github.com/dinnerdonebetter/backend/internal/workers"
 
 	_ "github.com/GoogleCloudPlatform/functions-framework-go/funcframework"
 	"github.com/GoogleCloudPlatform/functions-framework-go/functions"
@@ -84,15 +83,15 @@ func IndexDataForSearch(ctx context.Context, e event.Event) error {
 		return fmt.Errorf("event.DataAs: %w", err)
 	}
 
-	var searchIndexRequest *indexing.IndexRequest
-	if err = json.Unmarshal(msg.Message.Data, &searchIndexRequest); err != nil {
-		logger = logger.WithValue("raw_data", msg.Message.Data)
-		return observability.PrepareAndLogError(err, logger, span, "unmarshalling data change message")
-	}
+	searchIndexerWorker := workers.ProvideSearchIndexerWorker(
+		logger,
+		dataManager,
+		&cfg.Search,
+		tracerProvider,
+	)
 
-	// we don't want to retry indexing perpetually in the event of a fundamental error, so we just log it and move on
-	if err = indexing.HandleIndexRequest(ctx, logger, tracerProvider, &cfg.Search, dataManager, searchIndexRequest); err != nil {
-		observability.AcknowledgeError(err, logger, span, "handling index request")
+	if err = searchIndexerWorker.HandleMessage(ctx, msg.Message.Data); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "handling search index request")
 	}
 
 	return nil
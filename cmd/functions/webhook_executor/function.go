@@ -1,16 +1,9 @@
 package webhookexecutor
 
 import (
-	"bytes"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
-	"encoding/xml"
 	"fmt"
 	"log/slog"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -18,17 +11,17 @@
 	"github.com/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/backend/internal/database/postgres"
 	"github.com/dinnerdonebetter/backend/internal/email"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	loggingcfg "github.com/dinnerdonebetter/backend/internal/observability/logging/config"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
-	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/internal/workers"
 
 	_ "github.com/GoogleCloudPlatform/functions-framework-go/funcframework"
 	"github.com/GoogleCloudPlatform/functions-framework-go/functions"
 	_ "github.com/KimMachineGun/automemlimit"
 	"github.com/cloudevents/sdk-go/v2/event"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel"
 	_ "go.uber.org/automaxprocs"
 )
@@ -91,66 +84,15 @@ func ExecuteWebhook(ctx context.Context, e event.Event) error {
 		return fmt.Errorf("event.DataAs: %w", err)
 	}
 
-	var webhookExecutionRequest *types.WebhookExecutionRequest
-	if err = json.Unmarshal(msg.Message.Data, &webhookExecutionRequest); err != nil {
-		logger = logger.WithValue("raw_data", msg.Message.Data)
-		return observability.PrepareAndLogError(err, logger, span, "unmarshalling data change message")
-	}
-
-	household, err := dataManager.GetHousehold(ctx, webhookExecutionRequest.HouseholdID)
-	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "getting household")
-	}
-	_ = household
-
-	webhook, err := dataManager.GetWebhook(ctx, webhookExecutionRequest.WebhookID, webhookExecutionRequest.HouseholdID)
-	if err != nil {
-		observability.AcknowledgeError(err, logger, span, "getting webhook")
-		return nil
-	}
-
-	var payloadBody []byte
-	switch webhook.ContentType {
-	case "application/json":
-		payloadBody, err = json.Marshal(webhookExecutionRequest.Payload)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "marshalling webhook payload")
-		}
-	case "application/xml":
-		payloadBody, err = xml.Marshal(webhookExecutionRequest.Payload)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "marshalling webhook payload")
-		}
-	}
-
-	req, err := http.NewRequest(webhook.Method, webhook.URL, bytes.NewReader(payloadBody))
-	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "creating webhook request")
-	}
-
-	req.Header.Set("Content-Type", webhook.ContentType)
-
-	decryptedKey, err := hex.DecodeString(household.WebhookEncryptionKey)
-	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "decoding webhook encryption key")
-	}
-
-	digest := hmac.New(sha256.New, decryptedKey)
-	digest.Write(payloadBody)
-	req.Header.Set("X-Dinner-Done-Better-Signature", hex.EncodeToString(digest.Sum(nil)))
+	webhookExecutorWorker := workers.ProvideWebhookExecutorWorker(
+		logger,
+		dataManager,
+		webhookdelivery.NewDeliverer(logger, tracerProvider, &cfg.Services.Webhooks.Delivery, dataManager),
+		tracerProvider,
+	)
 
-	res, err := otelhttp.DefaultClient.Do(req)
-	if err != nil {
-		observability.AcknowledgeError(err, logger, span, "executing webhook request")
-		return nil
-	}
-
-	logger = logger.WithResponse(res)
-	tracing.AttachResponseToSpan(span, res)
-
-	if res.StatusCode < 200 || res.StatusCode > 299 {
-		observability.AcknowledgeError(err, logger, span, "invalid response type")
-		return nil
+	if err = webhookExecutorWorker.HandleMessage(ctx, msg.Message.Data); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "handling webhook execution request")
 	}
 
 	return nil
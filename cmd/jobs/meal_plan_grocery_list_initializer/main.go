@@ -13,6 +13,7 @@
 	"github.com/dinnerdonebetter/backend/internal/database/postgres"
 	"github.com/dinnerdonebetter/backend/internal/features/grocerylistpreparation"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
 	msgconfig "github.com/dinnerdonebetter/backend/internal/messagequeue/config"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -77,6 +78,8 @@ func doTheThing() error {
 
 	defer dataChangesPublisher.Stop()
 
+	unitConversionGraphBuilder := unitconversion.NewGraphBuilder(logger, tracerProvider, dataManager)
+
 	mealPlanGroceryListInitializationWorker := workers.ProvideMealPlanGroceryListInitializer(
 		logger,
 		dataManager,
@@ -84,7 +87,8 @@ func doTheThing() error {
 		dataChangesPublisher,
 		analyticsEventReporter,
 		tracerProvider,
-		grocerylistpreparation.NewGroceryListCreator(logger, tracerProvider),
+		grocerylistpreparation.NewGroceryListCreator(logger, tracerProvider, unitConversionGraphBuilder),
+		unitConversionGraphBuilder,
 	)
 
 	if err = mealPlanGroceryListInitializationWorker.InitializeGroceryListsForFinalizedMealPlans(ctx, nil); err != nil {
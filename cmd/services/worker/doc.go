@@ -0,0 +1,5 @@
+/*
+Command worker consumes the data changes, outbound emails, search indexing, and webhook execution request
+topics from a self-hosted message queue, and handles them the same way the cloud functions do.
+*/
+package main
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	analyticsconfig "github.com/dinnerdonebetter/backend/internal/analytics/config"
+	"github.com/dinnerdonebetter/backend/internal/config"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres"
+	"github.com/dinnerdonebetter/backend/internal/email"
+	emailconfig "github.com/dinnerdonebetter/backend/internal/email/config"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
+	msgconfig "github.com/dinnerdonebetter/backend/internal/messagequeue/config"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/deadletter"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/outbox"
+	"github.com/dinnerdonebetter/backend/internal/workers"
+	"github.com/dinnerdonebetter/backend/internal/workers/runner"
+
+	_ "github.com/KimMachineGun/automemlimit"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	_ "go.uber.org/automaxprocs"
+)
+
+const (
+	databaseConnectionTimeout = 15 * time.Second
+)
+
+func main() {
+	if strings.TrimSpace(strings.ToLower(os.Getenv("CEASE_OPERATION"))) == "true" {
+		slog.Info("CEASE_OPERATION is set to true, exiting")
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(
+		context.Background(),
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGQUIT,
+		syscall.SIGTERM,
+	)
+	defer stop()
+
+	if err := run(ctx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context) error {
+	cfg, err := config.GetWorkerConfigFromEnvironment(ctx)
+	if err != nil {
+		return fmt.Errorf("getting config: %w", err)
+	}
+
+	logger := cfg.Observability.Logging.ProvideLogger()
+
+	tracerProvider, err := cfg.Observability.Tracing.ProvideTracerProvider(ctx, logger)
+	if err != nil {
+		logger.Error(err, "initializing tracer")
+	}
+	otel.SetTracerProvider(tracerProvider)
+
+	envCfg := email.GetConfigForEnvironment(os.Getenv("DINNER_DONE_BETTER_SERVICE_ENVIRONMENT"))
+	if envCfg == nil {
+		return email.ErrMissingEnvCfg
+	}
+
+	dbConnectionContext, cancel := context.WithTimeout(ctx, databaseConnectionTimeout)
+	dataManager, err := postgres.ProvideDatabaseClient(dbConnectionContext, logger, tracerProvider, &cfg.Database)
+	cancel()
+	if err != nil {
+		return fmt.Errorf("establishing database connection: %w", err)
+	}
+	defer dataManager.Close()
+
+	analyticsEventReporter, err := analyticsconfig.ProvideEventReporter(&cfg.Analytics, logger, tracerProvider)
+	if err != nil {
+		return fmt.Errorf("setting up customer data collector: %w", err)
+	}
+	defer analyticsEventReporter.Close()
+
+	emailer, err := emailconfig.ProvideEmailer(&cfg.Email, logger, tracerProvider, otelhttp.DefaultClient)
+	if err != nil {
+		return fmt.Errorf("configuring outbound emailer: %w", err)
+	}
+
+	publisherProvider, err := msgconfig.ProvidePublisherProvider(ctx, logger, tracerProvider, &cfg.Events)
+	if err != nil {
+		return fmt.Errorf("configuring publisher provider: %w", err)
+	}
+	defer publisherProvider.Close()
+
+	outboundEmailsPublisher, err := publisherProvider.ProvidePublisher(cfg.Worker.Topics.OutboundEmails)
+	if err != nil {
+		return fmt.Errorf("configuring outbound emails publisher: %w", err)
+	}
+	defer outboundEmailsPublisher.Stop()
+
+	searchDataIndexPublisher, err := publisherProvider.ProvidePublisher(cfg.Worker.Topics.SearchIndexing)
+	if err != nil {
+		return fmt.Errorf("configuring search indexing publisher: %w", err)
+	}
+	defer searchDataIndexPublisher.Stop()
+
+	webhookExecutionRequestPublisher, err := publisherProvider.ProvidePublisher(cfg.Worker.Topics.WebhookExecutionRequests)
+	if err != nil {
+		return fmt.Errorf("configuring webhook execution requests publisher: %w", err)
+	}
+	defer webhookExecutionRequestPublisher.Stop()
+
+	consumerProvider, err := msgconfig.ProvideConsumerProvider(ctx, logger, tracerProvider, &cfg.Events)
+	if err != nil {
+		return fmt.Errorf("configuring consumer provider: %w", err)
+	}
+
+	dataChangesWorker := workers.ProvideDataChangesWorker(
+		logger,
+		dataManager,
+		analyticsEventReporter,
+		outboundEmailsPublisher,
+		searchDataIndexPublisher,
+		webhookExecutionRequestPublisher,
+		tracerProvider,
+	)
+
+	outboundEmailerWorker := workers.ProvideOutboundEmailerWorker(
+		logger,
+		dataManager,
+		emailer,
+		analyticsEventReporter,
+		envCfg,
+		tracerProvider,
+	)
+
+	searchIndexerWorker := workers.ProvideSearchIndexerWorker(
+		logger,
+		dataManager,
+		&cfg.Search,
+		tracerProvider,
+	)
+
+	webhookExecutorWorker := workers.ProvideWebhookExecutorWorker(
+		logger,
+		dataManager,
+		webhookdelivery.NewDeliverer(logger, tracerProvider, &cfg.Services.Webhooks.Delivery, dataManager),
+		tracerProvider,
+	)
+
+	r := runner.NewRunner(logger, tracerProvider, &cfg.Worker.Runner, consumerProvider)
+	deadLetters := deadletter.NewWrapper(logger, tracerProvider, &cfg.Worker.DeadLetters, publisherProvider, dataManager)
+
+	handlers := map[string]runner.Handler{
+		cfg.Worker.Topics.DataChanges:              dataChangesWorker.HandleMessage,
+		cfg.Worker.Topics.OutboundEmails:           outboundEmailerWorker.HandleMessage,
+		cfg.Worker.Topics.SearchIndexing:           searchIndexerWorker.HandleMessage,
+		cfg.Worker.Topics.WebhookExecutionRequests: webhookExecutorWorker.HandleMessage,
+	}
+
+	for topic, handler := range handlers {
+		// handlers are wrapped before they're registered, because the runner doesn't wait on them to report failures to its consumers.
+		if handler, err = deadLetters.WrapHandler(topic, handler); err != nil {
+			return fmt.Errorf("configuring dead letters for %q: %w", topic, err)
+		}
+
+		if err = r.Register(topic, handler); err != nil {
+			return fmt.Errorf("registering handler for %q: %w", topic, err)
+		}
+	}
+
+	// the relay publishes straight to the queue, rather than through the outbox it's draining.
+	relay, err := outbox.NewRelay(logger, tracerProvider, otel.GetMeterProvider(), &cfg.Outbox, dataManager, publisherProvider)
+	if err != nil {
+		return fmt.Errorf("configuring outbox relay: %w", err)
+	}
+
+	relayCtx, cancelRelay := context.WithCancel(ctx)
+	relayDone := make(chan struct{})
+	go func() {
+		defer close(relayDone)
+		if relayErr := relay.Run(relayCtx); relayErr != nil {
+			logger.Error(relayErr, "running outbox relay")
+		}
+	}()
+
+	// retries are requested on the same topic as first deliveries, so whichever worker consumes it makes them.
+	retryScheduler := webhookdelivery.NewRetryScheduler(logger, tracerProvider, &cfg.Services.Webhooks.Delivery, dataManager, webhookExecutionRequestPublisher)
+
+	retrySchedulerDone := make(chan struct{})
+	go func() {
+		defer close(retrySchedulerDone)
+		if schedulerErr := retryScheduler.Run(relayCtx); schedulerErr != nil {
+			logger.Error(schedulerErr, "running webhook delivery retry scheduler")
+		}
+	}()
+
+	logger.Info("worker service started")
+
+	err = r.Run(ctx)
+	cancelRelay()
+	<-relayDone
+	<-retrySchedulerDone
+
+	if err != nil {
+		return fmt.Errorf("running workers: %w", err)
+	}
+
+	logger.Info("worker service stopped")
+
+	return nil
+}
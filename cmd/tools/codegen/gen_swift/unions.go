@@ -58,6 +58,8 @@ type unionDefinition struct {
 			Values: []string{
 				"schulze",
 				"instant-runoff",
+				"plurality",
+				"borda",
 			},
 		},
 		{
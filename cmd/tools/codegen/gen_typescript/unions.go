@@ -57,6 +57,8 @@ type unionDefinition struct {
 			Values: []string{
 				"schulze",
 				"instant-runoff",
+				"plurality",
+				"borda",
 			},
 		},
 		{
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	cookingSessionProgressEntriesTableName = "cooking_session_progress_entries"
+
+	belongsToCookingSessionColumn = "belongs_to_cooking_session"
+)
+
+var cookingSessionProgressEntriesColumns = []string{
+	idColumn,
+	"progress_type",
+	"subject",
+	"value",
+	"recorded_by_user",
+	createdAtColumn,
+	belongsToCookingSessionColumn,
+}
+
+func buildCookingSessionProgressEntriesQueries() []*Query {
+	insertColumns := filterForInsert(cookingSessionProgressEntriesColumns)
+	fullSelectColumns := applyToEach(cookingSessionProgressEntriesColumns, func(_ int, s string) string {
+		return fullColumnName(cookingSessionProgressEntriesTableName, s)
+	})
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateCookingSessionProgressEntry",
+				Type: ExecType,
+			},
+			// two household members finishing the same step at once shouldn't be an error for either of them.
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+) ON CONFLICT DO NOTHING;`,
+				cookingSessionProgressEntriesTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetCookingSessionProgressEntries",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s, %s.%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				cookingSessionProgressEntriesTableName,
+				cookingSessionProgressEntriesTableName, belongsToCookingSessionColumn, belongsToCookingSessionColumn,
+				cookingSessionProgressEntriesTableName, createdAtColumn,
+				cookingSessionProgressEntriesTableName, idColumn,
+			)),
+		},
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	cookingSessionsTableName = "cooking_sessions"
+
+	completedAtColumn = "completed_at"
+)
+
+var cookingSessionsColumns = []string{
+	idColumn,
+	recipeIDColumn,
+	belongsToHouseholdColumn,
+	"created_by_user",
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	completedAtColumn,
+	archivedAtColumn,
+}
+
+func buildCookingSessionsQueries() []*Query {
+	insertColumns := filterForInsert(cookingSessionsColumns, completedAtColumn)
+	fullSelectColumns := applyToEach(cookingSessionsColumns, func(_ int, s string) string {
+		return fullColumnName(cookingSessionsTableName, s)
+	})
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchiveCookingSession",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+				cookingSessionsTableName,
+				archivedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				idColumn, idColumn,
+				belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateCookingSession",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				cookingSessionsTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetCookingSession",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				cookingSessionsTableName,
+				cookingSessionsTableName, archivedAtColumn,
+				cookingSessionsTableName, idColumn, idColumn,
+				cookingSessionsTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetCookingSessionForUpdate",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s)
+FOR UPDATE;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				cookingSessionsTableName,
+				cookingSessionsTableName, archivedAtColumn,
+				cookingSessionsTableName, idColumn, idColumn,
+				cookingSessionsTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetCookingSessionsForHousehold",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE
+	%s.%s IS NULL
+	%s
+ORDER BY %s.%s DESC
+%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				buildFilterCountSelect(cookingSessionsTableName, true, true, "cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)"),
+				buildTotalCountSelect(cookingSessionsTableName, true, "cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)"),
+				cookingSessionsTableName,
+				cookingSessionsTableName, archivedAtColumn,
+				buildFilterConditions(
+					cookingSessionsTableName,
+					true,
+					"cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)",
+				),
+				cookingSessionsTableName, createdAtColumn,
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkCookingSessionAsCompleted",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+				cookingSessionsTableName,
+				completedAtColumn, currentTimeExpression,
+				lastUpdatedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				completedAtColumn,
+				idColumn, idColumn,
+				belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkCookingSessionAsUpdated",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				cookingSessionsTableName,
+				lastUpdatedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+	}
+}
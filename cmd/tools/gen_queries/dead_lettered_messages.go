@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	deadLetteredMessagesTableName = "dead_lettered_messages"
+
+	replayedAtColumn = "replayed_at"
+)
+
+var (
+	deadLetteredMessagesColumns = []string{
+		idColumn,
+		"topic",
+		"dead_letter_topic",
+		"payload",
+		"last_error",
+		"attempts",
+		createdAtColumn,
+		replayedAtColumn,
+	}
+)
+
+func buildDeadLetteredMessagesQueries() []*Query {
+	insertColumns := filterForInsert(deadLetteredMessagesColumns, replayedAtColumn)
+	fullSelectColumns := applyToEach(deadLetteredMessagesColumns, func(_ int, s string) string {
+		return fullColumnName(deadLetteredMessagesTableName, s)
+	})
+	outstandingCondition := fmt.Sprintf("%s.%s IS NULL", deadLetteredMessagesTableName, replayedAtColumn)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateDeadLetteredMessage",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				deadLetteredMessagesTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetDeadLetteredMessage",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				deadLetteredMessagesTableName,
+				deadLetteredMessagesTableName, idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetOutstandingDeadLetteredMessages",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s
+ORDER BY %s.%s DESC
+%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				buildFilterCountSelect(
+					deadLetteredMessagesTableName,
+					false,
+					false,
+					outstandingCondition,
+				),
+				buildTotalCountSelect(
+					deadLetteredMessagesTableName,
+					false,
+					outstandingCondition,
+				),
+				deadLetteredMessagesTableName,
+				strings.TrimPrefix(buildFilterConditions(
+					deadLetteredMessagesTableName,
+					false,
+					outstandingCondition,
+				), "AND "),
+				deadLetteredMessagesTableName, createdAtColumn,
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkDeadLetteredMessageAsReplayed",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				deadLetteredMessagesTableName,
+				replayedAtColumn, currentTimeExpression,
+				replayedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "UnmarkDeadLetteredMessageAsReplayed",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = NULL
+WHERE %s = sqlc.arg(%s);`,
+				deadLetteredMessagesTableName,
+				replayedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+	}
+}
@@ -115,6 +115,7 @@ func Test_mergeColumns(T *testing.T) {
 			"webhooks.created_at",
 			"webhooks.last_updated_at",
 			"webhooks.archived_at",
+			"webhooks.disabled_at",
 			"webhooks.belongs_to_household",
 		}
 
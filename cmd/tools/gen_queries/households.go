@@ -12,15 +12,23 @@
 
 	/* #nosec G101 */
 	webhookHMACSecretColumn = "webhook_hmac_secret"
+	/* #nosec G101 */
+	previousWebhookHMACSecretColumn          = "previous_webhook_hmac_secret"
+	previousWebhookHMACSecretExpiresAtColumn = "previous_webhook_hmac_secret_expires_at"
+
+	billingStatusColumn                     = "billing_status"
+	paymentProcessorCustomerIDColumn        = "payment_processor_customer_id"
+	subscriptionPlanIDColumn                = "subscription_plan_id"
+	lastPaymentProviderSyncOccurredAtColumn = "last_payment_provider_sync_occurred_at"
 )
 
 var householdsColumns = []string{
 	idColumn,
 	nameColumn,
-	"billing_status",
+	billingStatusColumn,
 	"contact_phone",
-	"payment_processor_customer_id",
-	"subscription_plan_id",
+	paymentProcessorCustomerIDColumn,
+	subscriptionPlanIDColumn,
 	belongsToUserColumn,
 	"time_zone",
 	"address_line_1",
@@ -31,15 +39,20 @@
 	"country",
 	"latitude",
 	"longitude",
-	"last_payment_provider_sync_occurred_at",
+	lastPaymentProviderSyncOccurredAtColumn,
 	webhookHMACSecretColumn,
+	previousWebhookHMACSecretColumn,
+	previousWebhookHMACSecretExpiresAtColumn,
 	createdAtColumn,
 	lastUpdatedAtColumn,
 	archivedAtColumn,
 }
 
 func buildHouseholdsQueries() []*Query {
-	insertColumns := filterForInsert(householdsColumns)
+	insertColumns := filterForInsert(householdsColumns,
+		previousWebhookHMACSecretColumn,
+		previousWebhookHMACSecretExpiresAtColumn,
+	)
 
 	return []*Query{
 		{
@@ -198,6 +211,40 @@ func buildHouseholdsQueries() []*Query {
 				offsetLimitAddendum,
 			)),
 		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "RotateHouseholdWebhookEncryptionKey",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s,
+	%s = sqlc.arg(%s),
+	%s = sqlc.arg(%s),
+	%s = %s
+WHERE %s IS NULL
+	AND (%s IS NULL OR %s <= %s)
+	AND %s = sqlc.arg(%s)
+	AND %s IN (
+		SELECT %s.%s
+		FROM %s
+		WHERE %s.%s IS NULL
+			AND %s.%s = sqlc.arg(%s)
+	);`,
+				householdsTableName,
+				previousWebhookHMACSecretColumn, webhookHMACSecretColumn,
+				previousWebhookHMACSecretExpiresAtColumn, previousWebhookHMACSecretExpiresAtColumn,
+				webhookHMACSecretColumn, webhookHMACSecretColumn,
+				lastUpdatedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				previousWebhookHMACSecretExpiresAtColumn, previousWebhookHMACSecretExpiresAtColumn, currentTimeExpression,
+				idColumn, idColumn,
+				idColumn,
+				householdUserMembershipsTableName, belongsToHouseholdColumn,
+				householdUserMembershipsTableName,
+				householdUserMembershipsTableName, archivedAtColumn,
+				householdUserMembershipsTableName, belongsToUserColumn, belongsToUserColumn,
+			)),
+		},
 		{
 			Annotation: QueryAnnotation{
 				Name: "UpdateHousehold",
@@ -221,6 +268,8 @@ func buildHouseholdsQueries() []*Query {
 							"time_zone",
 							"last_payment_provider_sync_occurred_at",
 							"webhook_hmac_secret",
+							previousWebhookHMACSecretColumn,
+							previousWebhookHMACSecretExpiresAtColumn,
 						),
 						func(_ int, s string) string {
 							return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
@@ -237,6 +286,31 @@ func(_ int, s string) string {
 				idColumn,
 			)),
 		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "UpdateHouseholdBillingStatus",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(%s),
+	%s = sqlc.arg(%s),
+	%s = sqlc.narg(%s),
+	%s = sqlc.arg(occurred_at),
+	%s = %s
+WHERE %s IS NULL
+	AND (%s IS NULL OR %s <= sqlc.arg(occurred_at))
+	AND %s = sqlc.arg(%s);`,
+				householdsTableName,
+				billingStatusColumn, billingStatusColumn,
+				paymentProcessorCustomerIDColumn, paymentProcessorCustomerIDColumn,
+				subscriptionPlanIDColumn, subscriptionPlanIDColumn,
+				lastPaymentProviderSyncOccurredAtColumn,
+				lastUpdatedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				lastPaymentProviderSyncOccurredAtColumn, lastPaymentProviderSyncOccurredAtColumn,
+				idColumn, idColumn,
+			)),
+		},
 		{
 			Annotation: QueryAnnotation{
 				Name: "UpdateHouseholdWebhookEncryptionKey",
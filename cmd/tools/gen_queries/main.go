@@ -29,6 +29,7 @@ func main() {
 		"households.sql":                                   buildHouseholdsQueries(),
 		"household_user_memberships.sql":                   buildHouseholdUserMembershipsQueries(),
 		"webhook_trigger_events.sql":                       buildWebhookTriggerEventsQueries(),
+		"webhook_deliveries.sql":                           buildWebhookDeliveriesQueries(),
 		"password_reset_tokens.sql":                        buildPasswordResetTokensQueries(),
 		"oauth2_client_tokens.sql":                         buildOAuth2ClientTokensQueries(),
 		"oauth2_clients.sql":                               buildOAuth2ClientsQueries(),
@@ -71,6 +72,16 @@ func main() {
 		"user_ingredient_preferences.sql":                  buildUserIngredientPreferencesQueries(),
 		"meal_plan_grocery_list_items.sql":                 buildMealPlanGroceryListItemsQueries(),
 		"audit_logs.sql":                                   buildAuditLogEntryQueries(),
+		"cooking_sessions.sql":                             buildCookingSessionsQueries(),
+		"cooking_session_progress_entries.sql":             buildCookingSessionProgressEntriesQueries(),
+		"dead_lettered_messages.sql":                       buildDeadLetteredMessagesQueries(),
+		"outbox_messages.sql":                              buildOutboxMessagesQueries(),
+		"pantry_items.sql":                                 buildPantryItemsQueries(),
+		"valid_ingredient_nutrition.sql":                   buildValidIngredientNutritionQueries(),
+		"valid_ingredient_substitutions.sql":               buildValidIngredientSubstitutionsQueries(),
+		"user_passkeys.sql":                                buildUserPasskeysQueries(),
+		"passkey_ceremonies.sql":                           buildPasskeyCeremoniesQueries(),
+		"user_recovery_codes.sql":                          buildUserRecoveryCodesQueries(),
 	}
 
 	checkOnly := *checkOnlyFlag
@@ -10,6 +10,8 @@
 const (
 	oauth2ClientsTableName = "oauth2_clients"
 	clientIDColumn         = "client_id"
+	domainColumn           = "domain"
+	scopesColumn           = "scopes"
 )
 
 var oauth2ClientsColumns = []string{
@@ -20,6 +22,9 @@
 	"client_secret",
 	createdAtColumn,
 	archivedAtColumn,
+	domainColumn,
+	belongsToHouseholdColumn,
+	scopesColumn,
 }
 
 func buildOAuth2ClientsQueries() []*Query {
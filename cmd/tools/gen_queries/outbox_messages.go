@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	outboxMessagesTableName = "outbox_messages"
+
+	claimedUntilColumn = "claimed_until"
+	publishedAtColumn  = "published_at"
+	sequenceColumn     = "sequence"
+
+	transactionIDColumn = "transaction_id"
+
+	// currentTransactionHorizonExpression is the oldest transaction that was still in progress when the query's
+	// snapshot was taken. Every transaction before it has either committed or rolled back.
+	currentTransactionHorizonExpression = "pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT"
+)
+
+var (
+	outboxMessagesColumns = []string{
+		idColumn,
+		"topic",
+		"idempotency_key",
+		"payload",
+		"attempts",
+		"last_error",
+		createdAtColumn,
+		claimedUntilColumn,
+		publishedAtColumn,
+	}
+)
+
+func buildOutboxMessagesQueries() []*Query {
+	insertColumns := append(
+		filterForInsert(outboxMessagesColumns, "attempts", "last_error", claimedUntilColumn, publishedAtColumn),
+		eventTypeColumn,
+		belongsToHouseholdColumn,
+	)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateOutboxMessage",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				outboxMessagesTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "ClaimPendingOutboxMessages",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(%s),
+	attempts = attempts + 1
+WHERE %s IN (
+	SELECT %s
+	FROM %s
+	WHERE %s IS NULL
+		AND (%s IS NULL OR %s < %s)
+	ORDER BY %s
+	LIMIT sqlc.arg(batch_size)
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING
+	%s;`,
+				outboxMessagesTableName,
+				claimedUntilColumn, claimedUntilColumn,
+				idColumn,
+				idColumn,
+				outboxMessagesTableName,
+				publishedAtColumn,
+				claimedUntilColumn, claimedUntilColumn, currentTimeExpression,
+				createdAtColumn,
+				strings.Join(outboxMessagesColumns, ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkOutboxMessageAsPublished",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s,
+	%s = NULL
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				outboxMessagesTableName,
+				publishedAtColumn, currentTimeExpression,
+				claimedUntilColumn,
+				publishedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkOutboxMessageAsFailed",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	last_error = sqlc.arg(last_error)
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				outboxMessagesTableName,
+				publishedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetOutboxLag",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	COUNT(%s.%s) AS pending_messages,
+	COALESCE(EXTRACT(EPOCH FROM (%s - MIN(%s.%s))), 0)::BIGINT AS oldest_pending_age_seconds
+FROM %s
+WHERE %s.%s IS NULL;`,
+				outboxMessagesTableName, idColumn,
+				currentTimeExpression, outboxMessagesTableName, createdAtColumn,
+				outboxMessagesTableName,
+				outboxMessagesTableName, publishedAtColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "DeletePublishedOutboxMessages",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`DELETE FROM %s
+WHERE %s IS NOT NULL
+	AND %s < sqlc.arg(published_before);`,
+				outboxMessagesTableName,
+				publishedAtColumn,
+				publishedAtColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetHouseholdEvents",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.%s,
+	%s.%s,
+	%s.%s,
+	%s.payload,
+	%s.%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND (%s.%s, %s.%s) > (sqlc.arg(after_transaction_id)::BIGINT, sqlc.arg(after_sequence)::BIGINT)
+	AND %s.%s < %s
+ORDER BY %s.%s, %s.%s
+LIMIT sqlc.arg(result_limit);`,
+				outboxMessagesTableName, transactionIDColumn,
+				outboxMessagesTableName, sequenceColumn,
+				outboxMessagesTableName, eventTypeColumn,
+				outboxMessagesTableName,
+				outboxMessagesTableName, createdAtColumn,
+				outboxMessagesTableName,
+				outboxMessagesTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+				outboxMessagesTableName, transactionIDColumn, outboxMessagesTableName, sequenceColumn,
+				outboxMessagesTableName, transactionIDColumn, currentTransactionHorizonExpression,
+				outboxMessagesTableName, transactionIDColumn, outboxMessagesTableName, sequenceColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetHouseholdEventHorizon",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT %s AS horizon;`,
+				currentTransactionHorizonExpression,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CheckHouseholdEventCursorRetention",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT EXISTS (
+	SELECT %s.%s
+	FROM %s
+	WHERE %s.%s = sqlc.arg(%s)
+		AND %s.%s = sqlc.arg(%s)::BIGINT
+		AND %s.%s = sqlc.arg(%s)::BIGINT
+) OR (
+	sqlc.arg(%s)::BIGINT = 0
+	AND sqlc.arg(%s)::BIGINT >= COALESCE((SELECT MIN(%s.%s) FROM %s), %s)
+) AS retained;`,
+				outboxMessagesTableName, idColumn,
+				outboxMessagesTableName,
+				outboxMessagesTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+				outboxMessagesTableName, transactionIDColumn, transactionIDColumn,
+				outboxMessagesTableName, sequenceColumn, sequenceColumn,
+				sequenceColumn,
+				transactionIDColumn, outboxMessagesTableName, transactionIDColumn, outboxMessagesTableName, currentTransactionHorizonExpression,
+			)),
+		},
+	}
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	pantryItemsTableName = "pantry_items"
+
+	pantryItemsExpiresAtColumn             = "expires_at"
+	pantryItemsSourceGroceryListItemColumn = "source_grocery_list_item"
+)
+
+var pantryItemsColumns = []string{
+	idColumn,
+	validIngredientColumn,
+	validMeasurementUnitColumn,
+	"quantity",
+	"storage_location",
+	notesColumn,
+	pantryItemsExpiresAtColumn,
+	belongsToHouseholdColumn,
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	archivedAtColumn,
+}
+
+func buildPantryItemsQueries() []*Query {
+	insertColumns := filterForInsert(pantryItemsColumns)
+	groceryListItemInsertColumns := append(filterForInsert(pantryItemsColumns), pantryItemsSourceGroceryListItemColumn)
+
+	fullSelectColumns := mergeColumns(
+		applyToEach(filterFromSlice(pantryItemsColumns, validIngredientColumn, validMeasurementUnitColumn), func(i int, s string) string {
+			return fmt.Sprintf("%s.%s", pantryItemsTableName, s)
+		}),
+		append(
+			applyToEach(validIngredientsColumns, func(i int, s string) string {
+				return fmt.Sprintf("%s.%s as valid_ingredient_%s", validIngredientsTableName, s, s)
+			}),
+			applyToEach(validMeasurementUnitsColumns, func(i int, s string) string {
+				return fmt.Sprintf("%s.%s as valid_measurement_unit_%s", validMeasurementUnitsTableName, s, s)
+			})...,
+		),
+		1,
+	)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchivePantryItem",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+				pantryItemsTableName,
+				archivedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				idColumn, idColumn,
+				belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreatePantryItem",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				pantryItemsTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchivePantryItemForGroceryListItem",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s)
+RETURNING %s;`,
+				pantryItemsTableName,
+				archivedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				pantryItemsSourceGroceryListItemColumn, pantryItemsSourceGroceryListItemColumn,
+				belongsToHouseholdColumn, belongsToHouseholdColumn,
+				idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreatePantryItemForGroceryListItem",
+				Type: ExecRowsType,
+			},
+			// a grocery list item only ever stocks the pantry once, no matter how many times it's marked acquired.
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+) ON CONFLICT (%s) WHERE %s IS NULL DO NOTHING;`,
+				pantryItemsTableName,
+				strings.Join(groceryListItemInsertColumns, ",\n\t"),
+				strings.Join(applyToEach(groceryListItemInsertColumns, func(i int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+				pantryItemsSourceGroceryListItemColumn, archivedAtColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CheckPantryItemExistence",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT EXISTS (
+	SELECT %s.id
+	FROM %s
+	WHERE %s.%s IS NULL
+		AND %s.%s = sqlc.arg(%s)
+		AND %s.%s = sqlc.arg(%s)
+);`,
+				pantryItemsTableName,
+				pantryItemsTableName,
+				pantryItemsTableName,
+				archivedAtColumn,
+				pantryItemsTableName, idColumn, idColumn,
+				pantryItemsTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetPantryItems",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+	JOIN %s ON %s.%s = %s.%s
+WHERE
+	%s.%s IS NULL
+	%s
+ORDER BY
+	%s.%s
+%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				buildFilterCountSelect(pantryItemsTableName, true, true, "pantry_items.belongs_to_household = sqlc.arg(household_id)"),
+				buildTotalCountSelect(pantryItemsTableName, true, "pantry_items.belongs_to_household = sqlc.arg(household_id)"),
+				pantryItemsTableName,
+				validIngredientsTableName, pantryItemsTableName, validIngredientColumn, validIngredientsTableName, idColumn,
+				validMeasurementUnitsTableName, pantryItemsTableName, validMeasurementUnitColumn, validMeasurementUnitsTableName, idColumn,
+				pantryItemsTableName, archivedAtColumn,
+				buildFilterConditions(
+					pantryItemsTableName,
+					true,
+					"pantry_items.belongs_to_household = sqlc.arg(household_id)",
+				),
+				pantryItemsTableName, idColumn,
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetPantryItem",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				pantryItemsTableName,
+				validIngredientsTableName, pantryItemsTableName, validIngredientColumn, validIngredientsTableName, idColumn,
+				validMeasurementUnitsTableName, pantryItemsTableName, validMeasurementUnitColumn, validMeasurementUnitsTableName, idColumn,
+				pantryItemsTableName, archivedAtColumn,
+				pantryItemsTableName, idColumn, idColumn,
+				pantryItemsTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetUnexpiredPantryItemsForHousehold",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND (%s.%s IS NULL OR %s.%s > %s)
+ORDER BY
+	%s.%s ASC NULLS LAST,
+	%s.%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				pantryItemsTableName,
+				validIngredientsTableName, pantryItemsTableName, validIngredientColumn, validIngredientsTableName, idColumn,
+				validMeasurementUnitsTableName, pantryItemsTableName, validMeasurementUnitColumn, validMeasurementUnitsTableName, idColumn,
+				pantryItemsTableName, archivedAtColumn,
+				pantryItemsTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+				pantryItemsTableName, pantryItemsExpiresAtColumn, pantryItemsTableName, pantryItemsExpiresAtColumn, currentTimeExpression,
+				pantryItemsTableName, pantryItemsExpiresAtColumn,
+				pantryItemsTableName, createdAtColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "UpdatePantryItem",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+				pantryItemsTableName,
+				strings.Join(applyToEach(filterForUpdate(pantryItemsColumns, belongsToHouseholdColumn), func(i int, s string) string {
+					return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
+				}), ",\n\t"),
+				lastUpdatedAtColumn,
+				currentTimeExpression,
+				archivedAtColumn,
+				idColumn, idColumn,
+				belongsToHouseholdColumn, belongsToHouseholdColumn,
+			)),
+		},
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	passkeyCeremoniesTableName = "passkey_ceremonies"
+
+	passkeyCeremonySessionDataColumn = "session_data"
+	passkeyCeremonyExpiresAtColumn   = "expires_at"
+)
+
+var passkeyCeremoniesColumns = []string{
+	idColumn,
+	passkeyCeremonySessionDataColumn,
+	passkeyCeremonyExpiresAtColumn,
+	createdAtColumn,
+}
+
+func buildPasskeyCeremoniesQueries() []*Query {
+	insertColumns := filterForInsert(passkeyCeremoniesColumns)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ConsumePasskeyCeremony",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`DELETE FROM %s
+WHERE %s > %s
+	AND %s = sqlc.arg(%s)
+RETURNING %s;`,
+				passkeyCeremoniesTableName,
+				passkeyCeremonyExpiresAtColumn, currentTimeExpression,
+				idColumn, idColumn,
+				passkeyCeremonySessionDataColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreatePasskeyCeremony",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	sqlc.arg(%s),
+	sqlc.arg(%s),
+	sqlc.arg(%s)
+);`,
+				passkeyCeremoniesTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				idColumn,
+				passkeyCeremonySessionDataColumn,
+				passkeyCeremonyExpiresAtColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "DeleteExpiredPasskeyCeremonies",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`DELETE FROM %s
+WHERE %s <= %s;`,
+				passkeyCeremoniesTableName,
+				passkeyCeremonyExpiresAtColumn, currentTimeExpression,
+			)),
+		},
+	}
+}
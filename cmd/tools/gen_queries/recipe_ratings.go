@@ -132,6 +132,30 @@ func buildRecipeRatingsQueries() []*Query {
 				recipeRatingsTableName, idColumn, idColumn,
 			)),
 		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetRecipeRatingsForHousehold",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.by_user
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s;`,
+				strings.Join(applyToEach(recipeRatingsColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s.%s", recipeRatingsTableName, s)
+				}), ",\n\t"),
+				recipeRatingsTableName,
+				householdUserMembershipsTableName, householdUserMembershipsTableName, belongsToUserColumn, recipeRatingsTableName,
+				recipeRatingsTableName, archivedAtColumn,
+				householdUserMembershipsTableName, archivedAtColumn,
+				householdUserMembershipsTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
+				recipeRatingsTableName, idColumn,
+			)),
+		},
 		{
 			Annotation: QueryAnnotation{
 				Name: "UpdateRecipeRating",
@@ -37,6 +37,76 @@
 	createdByUserColumn,
 }
 
+// uncookableRecipesCTE names the recipes a household is missing an instrument for. A recipe needs the instruments used by its
+// required steps and by those of the recipes it uses as ingredients, each in the largest quantity any of those steps calls for.
+// An instrument the household doesn't own enough of can be stood in for by one it does, so long as that one is valid for
+// every preparation the missing instrument is used for. Households don't record the vessels they own, so vessels aren't considered.
+const uncookableRecipesCTE = `WITH RECURSIVE recipe_trees(root_recipe_id, recipe_id) AS (
+	SELECT recipes.id, recipes.id
+	FROM recipes
+	WHERE recipes.archived_at IS NULL
+	UNION
+	SELECT recipe_trees.root_recipe_id, recipe_step_ingredients.recipe_step_product_recipe_id
+	FROM recipe_trees
+		JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipe_trees.recipe_id AND recipe_steps.archived_at IS NULL
+		JOIN recipe_step_ingredients ON recipe_step_ingredients.belongs_to_recipe_step = recipe_steps.id AND recipe_step_ingredients.archived_at IS NULL
+	WHERE recipe_step_ingredients.recipe_step_product_recipe_id IS NOT NULL
+),
+required_instruments AS (
+	SELECT
+		recipe_trees.root_recipe_id AS recipe_id,
+		recipe_step_instruments.instrument_id,
+		recipe_steps.preparation_id,
+		recipe_step_instruments.minimum_quantity
+	FROM recipe_trees
+		JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipe_trees.recipe_id AND recipe_steps.archived_at IS NULL AND NOT recipe_steps.optional
+		JOIN recipe_step_instruments ON recipe_step_instruments.belongs_to_recipe_step = recipe_steps.id AND recipe_step_instruments.archived_at IS NULL AND NOT recipe_step_instruments.optional
+	WHERE recipe_step_instruments.instrument_id IS NOT NULL
+),
+instrument_requirements AS (
+	SELECT
+		required_instruments.recipe_id,
+		required_instruments.instrument_id,
+		GREATEST(MAX(required_instruments.minimum_quantity), 1) AS quantity
+	FROM required_instruments
+	GROUP BY required_instruments.recipe_id, required_instruments.instrument_id
+),
+owned_instruments AS (
+	SELECT household_instrument_ownerships.valid_instrument_id, household_instrument_ownerships.quantity
+	FROM household_instrument_ownerships
+	WHERE household_instrument_ownerships.archived_at IS NULL
+		AND household_instrument_ownerships.belongs_to_household = sqlc.arg(household_id)
+),
+uncookable_recipes AS (
+	SELECT DISTINCT instrument_requirements.recipe_id
+	FROM instrument_requirements
+	WHERE NOT EXISTS (
+		SELECT 1
+		FROM owned_instruments
+		WHERE owned_instruments.valid_instrument_id = instrument_requirements.instrument_id
+			AND owned_instruments.quantity >= instrument_requirements.quantity
+	)
+	AND NOT EXISTS (
+		SELECT 1
+		FROM owned_instruments AS substitutes
+		WHERE substitutes.valid_instrument_id != instrument_requirements.instrument_id
+			AND substitutes.quantity >= instrument_requirements.quantity
+			AND NOT EXISTS (
+				SELECT 1
+				FROM required_instruments
+				WHERE required_instruments.recipe_id = instrument_requirements.recipe_id
+					AND required_instruments.instrument_id = instrument_requirements.instrument_id
+					AND NOT EXISTS (
+						SELECT 1
+						FROM valid_preparation_instruments
+						WHERE valid_preparation_instruments.archived_at IS NULL
+							AND valid_preparation_instruments.valid_preparation_id = required_instruments.preparation_id
+							AND valid_preparation_instruments.valid_instrument_id = substitutes.valid_instrument_id
+					)
+			)
+	)
+)`
+
 func buildRecipesQueries() []*Query {
 	insertColumns := filterForInsert(recipesColumns, lastValidatedAtColumn)
 
@@ -55,6 +125,8 @@ func buildRecipesQueries() []*Query {
 		)...,
 	)
 
+	cookableCondition := fmt.Sprintf("%s.%s NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)", recipesTableName, idColumn)
+
 	return []*Query{
 		{
 			Annotation: QueryAnnotation{
@@ -202,6 +274,60 @@ func buildRecipesQueries() []*Query {
 				offsetLimitAddendum,
 			)),
 		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetCookableRecipes",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`%s
+SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+	WHERE %s.%s IS NULL
+	%s
+%s;`,
+				uncookableRecipesCTE,
+				strings.Join(applyToEach(recipesColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s.%s", recipesTableName, s)
+				}), ",\n\t"),
+				buildFilterCountSelect(recipesTableName, true, true, cookableCondition),
+				buildTotalCountSelect(recipesTableName, true, cookableCondition),
+				recipesTableName,
+				recipesTableName, archivedAtColumn,
+				buildFilterConditions(recipesTableName, true, cookableCondition),
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CookableRecipeSearch",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`%s
+SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s %s
+	%s
+%s;`,
+				uncookableRecipesCTE,
+				strings.Join(applyToEach(recipesColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s.%s", recipesTableName, s)
+				}), ",\n\t"),
+				buildFilterCountSelect(recipesTableName, true, true, cookableCondition),
+				buildTotalCountSelect(recipesTableName, true, cookableCondition),
+				recipesTableName,
+				recipesTableName, archivedAtColumn,
+				recipesTableName, nameColumn, buildILIKEForArgument("query"),
+				buildFilterConditions(recipesTableName, true, cookableCondition),
+				offsetLimitAddendum,
+			)),
+		},
 		{
 			Annotation: QueryAnnotation{
 				Name: "GetRecipesNeedingIndexing",
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	userPasskeysTableName = "user_passkeys"
+
+	passkeyCredentialIDColumn = "credential_id"
+	passkeySignCountColumn    = "sign_count"
+	passkeyBackupStateColumn  = "backup_state"
+	passkeyLastUsedAtColumn   = "last_used_at"
+)
+
+var userPasskeysColumns = []string{
+	idColumn,
+	nameColumn,
+	passkeyCredentialIDColumn,
+	"public_key",
+	"attestation_type",
+	"aaguid",
+	passkeySignCountColumn,
+	"transports",
+	"backup_eligible",
+	passkeyBackupStateColumn,
+	belongsToUserColumn,
+	passkeyLastUsedAtColumn,
+	createdAtColumn,
+	archivedAtColumn,
+}
+
+func buildUserPasskeysQueries() []*Query {
+	insertColumns := filterForInsert(userPasskeysColumns, passkeyLastUsedAtColumn)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchiveUserPasskey",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+				userPasskeysTableName,
+				archivedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				idColumn, idColumn,
+				belongsToUserColumn, belongsToUserColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateUserPasskey",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				userPasskeysTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetUserPasskeysForUser",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s;`,
+				strings.Join(applyToEach(userPasskeysColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s.%s", userPasskeysTableName, s)
+				}), ",\n\t"),
+				userPasskeysTableName,
+				userPasskeysTableName, archivedAtColumn,
+				userPasskeysTableName, belongsToUserColumn, belongsToUserColumn,
+				userPasskeysTableName, createdAtColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkUserPasskeyAsUsed",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(%s),
+	%s = sqlc.arg(%s),
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				userPasskeysTableName,
+				passkeySignCountColumn, passkeySignCountColumn,
+				passkeyBackupStateColumn, passkeyBackupStateColumn,
+				passkeyLastUsedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	userRecoveryCodesTableName = "user_recovery_codes"
+
+	recoveryCodeHashedCodeColumn = "hashed_code"
+)
+
+var userRecoveryCodesColumns = []string{
+	idColumn,
+	recoveryCodeHashedCodeColumn,
+	belongsToUserColumn,
+	redeemedAtColumn,
+	createdAtColumn,
+	archivedAtColumn,
+}
+
+func buildUserRecoveryCodesQueries() []*Query {
+	insertColumns := filterForInsert(userRecoveryCodesColumns, redeemedAtColumn)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchiveUserRecoveryCodesForUser",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				userRecoveryCodesTableName,
+				archivedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				belongsToUserColumn, belongsToUserColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateUserRecoveryCode",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	sqlc.arg(%s),
+	sqlc.arg(%s),
+	sqlc.arg(%s)
+);`,
+				userRecoveryCodesTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				idColumn,
+				recoveryCodeHashedCodeColumn,
+				belongsToUserColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "RedeemUserRecoveryCode",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+				userRecoveryCodesTableName,
+				redeemedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				redeemedAtColumn,
+				belongsToUserColumn, belongsToUserColumn,
+				recoveryCodeHashedCodeColumn, recoveryCodeHashedCodeColumn,
+			)),
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	validIngredientNutritionTableName = "valid_ingredient_nutrition"
+
+	belongsToValidIngredientColumn = "belongs_to_valid_ingredient"
+	referenceMeasurementUnitColumn = "reference_measurement_unit"
+)
+
+var validIngredientNutritionColumns = []string{
+	idColumn,
+	belongsToValidIngredientColumn,
+	"reference_quantity",
+	referenceMeasurementUnitColumn,
+	"calories",
+	"protein",
+	"total_fat",
+	"saturated_fat",
+	"carbohydrates",
+	"sugar",
+	"fiber",
+	"sodium",
+	"source",
+	"source_id",
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	archivedAtColumn,
+}
+
+func buildValidIngredientNutritionQueries() []*Query {
+	insertColumns := filterForInsert(validIngredientNutritionColumns)
+
+	fullSelectColumns := mergeColumns(
+		applyToEach(filterFromSlice(validIngredientNutritionColumns, referenceMeasurementUnitColumn), func(i int, s string) string {
+			return fmt.Sprintf("%s.%s", validIngredientNutritionTableName, s)
+		}),
+		applyToEach(validMeasurementUnitsColumns, func(i int, s string) string {
+			return fmt.Sprintf("%s.%s as valid_measurement_unit_%s", validMeasurementUnitsTableName, s, s)
+		}),
+		3,
+	)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchiveValidIngredientNutrition",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				validIngredientNutritionTableName,
+				archivedAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				belongsToValidIngredientColumn, belongsToValidIngredientColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetValidIngredientNutrition",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				validIngredientNutritionTableName,
+				validMeasurementUnitsTableName, validIngredientNutritionTableName, referenceMeasurementUnitColumn, validMeasurementUnitsTableName, idColumn,
+				validIngredientNutritionTableName, archivedAtColumn,
+				validMeasurementUnitsTableName, archivedAtColumn,
+				validIngredientNutritionTableName, belongsToValidIngredientColumn, belongsToValidIngredientColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetValidIngredientNutritionForIngredients",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = ANY(sqlc.arg(ids)::text[]);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				validIngredientNutritionTableName,
+				validMeasurementUnitsTableName, validIngredientNutritionTableName, referenceMeasurementUnitColumn, validMeasurementUnitsTableName, idColumn,
+				validIngredientNutritionTableName, archivedAtColumn,
+				validMeasurementUnitsTableName, archivedAtColumn,
+				validIngredientNutritionTableName, belongsToValidIngredientColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "UpsertValidIngredientNutrition",
+				Type: ExecType,
+			},
+			// an ingredient only ever has one live set of nutrition facts, so re-importing replaces them.
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+) ON CONFLICT (%s) WHERE %s IS NULL DO UPDATE SET
+	%s,
+	%s = %s;`,
+				validIngredientNutritionTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+				belongsToValidIngredientColumn, archivedAtColumn,
+				strings.Join(applyToEach(filterForUpdate(validIngredientNutritionColumns, belongsToValidIngredientColumn), func(i int, s string) string {
+					return fmt.Sprintf("%s = EXCLUDED.%s", s, s)
+				}), ",\n\t"),
+				lastUpdatedAtColumn, currentTimeExpression,
+			)),
+		},
+	}
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	validIngredientSubstitutionsTableName = "valid_ingredient_substitutions"
+
+	originalIngredientColumn   = "original_ingredient"
+	substituteIngredientColumn = "substitute_ingredient"
+)
+
+var validIngredientSubstitutionsColumns = []string{
+	idColumn,
+	originalIngredientColumn,
+	substituteIngredientColumn,
+	validPreparationIDColumn,
+	"ratio",
+	"reasons",
+	notesColumn,
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	archivedAtColumn,
+}
+
+func buildValidIngredientSubstitutionsQueries() []*Query {
+	insertColumns := filterForInsert(validIngredientSubstitutionsColumns)
+
+	fullSelectColumns := mergeColumns(
+		applyToEach(filterFromSlice(validIngredientSubstitutionsColumns, originalIngredientColumn, substituteIngredientColumn, validPreparationIDColumn), func(i int, s string) string {
+			return fmt.Sprintf("%s.%s as valid_ingredient_substitution_%s", validIngredientSubstitutionsTableName, s, s)
+		}),
+		append(
+			append(
+				applyToEach(validIngredientsColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s_original.%s as original_ingredient_%s", validIngredientsTableName, s, s)
+				}),
+				applyToEach(validIngredientsColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s_substitute.%s as substitute_ingredient_%s", validIngredientsTableName, s, s)
+				})...,
+			),
+			applyToEach(validPreparationsColumns, func(i int, s string) string {
+				return fmt.Sprintf("%s.%s as valid_preparation_%s", validPreparationsTableName, s, s)
+			})...,
+		),
+		1,
+	)
+
+	// the preparation is optional, so it's the only LEFT JOIN.
+	joins := fmt.Sprintf(`JOIN %s AS %s_original ON %s.%s = %s_original.%s
+	JOIN %s AS %s_substitute ON %s.%s = %s_substitute.%s
+	LEFT JOIN %s ON %s.%s = %s.%s`,
+		validIngredientsTableName, validIngredientsTableName, validIngredientSubstitutionsTableName, originalIngredientColumn, validIngredientsTableName, idColumn,
+		validIngredientsTableName, validIngredientsTableName, validIngredientSubstitutionsTableName, substituteIngredientColumn, validIngredientsTableName, idColumn,
+		validPreparationsTableName, validIngredientSubstitutionsTableName, validPreparationIDColumn, validPreparationsTableName, idColumn,
+	)
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "ArchiveValidIngredientSubstitution",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s WHERE %s IS NULL AND %s = sqlc.arg(%s);`,
+				validIngredientSubstitutionsTableName,
+				archivedAtColumn,
+				currentTimeExpression,
+				archivedAtColumn,
+				idColumn,
+				idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateValidIngredientSubstitution",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				validIngredientSubstitutionsTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "CheckValidIngredientSubstitutionExistence",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT EXISTS (
+	SELECT %s.%s
+	FROM %s
+	WHERE %s.%s IS NULL
+		AND %s.%s = sqlc.arg(%s)
+);`,
+				validIngredientSubstitutionsTableName, idColumn,
+				validIngredientSubstitutionsTableName,
+				validIngredientSubstitutionsTableName, archivedAtColumn,
+				validIngredientSubstitutionsTableName, idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetValidIngredientSubstitution",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s IS NULL
+	AND %s_original.%s IS NULL
+	AND %s_substitute.%s IS NULL
+	AND %s.%s = sqlc.arg(%s);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				validIngredientSubstitutionsTableName,
+				joins,
+				validIngredientSubstitutionsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientSubstitutionsTableName, idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetValidIngredientSubstitutions",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s IS NULL
+	AND %s_original.%s IS NULL
+	AND %s_substitute.%s IS NULL
+	%s
+%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				buildFilterCountSelect(validIngredientSubstitutionsTableName, true, true),
+				buildTotalCountSelect(validIngredientSubstitutionsTableName, true),
+				validIngredientSubstitutionsTableName,
+				joins,
+				validIngredientSubstitutionsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				buildFilterConditions(validIngredientSubstitutionsTableName, true),
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetValidIngredientSubstitutionsForIngredient",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s IS NULL
+	AND %s_original.%s IS NULL
+	AND %s_substitute.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	%s
+%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				buildFilterCountSelect(validIngredientSubstitutionsTableName, true, true),
+				buildTotalCountSelect(validIngredientSubstitutionsTableName, true),
+				validIngredientSubstitutionsTableName,
+				joins,
+				validIngredientSubstitutionsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientSubstitutionsTableName, originalIngredientColumn, originalIngredientColumn,
+				buildFilterConditions(validIngredientSubstitutionsTableName, true),
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetValidIngredientSubstitutionsForIngredients",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s IS NULL
+	AND %s_original.%s IS NULL
+	AND %s_substitute.%s IS NULL
+	AND %s.%s = ANY(sqlc.arg(ids)::text[]);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				validIngredientSubstitutionsTableName,
+				joins,
+				validIngredientSubstitutionsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientsTableName, archivedAtColumn,
+				validIngredientSubstitutionsTableName, originalIngredientColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "UpdateValidIngredientSubstitution",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				validIngredientSubstitutionsTableName,
+				strings.Join(applyToEach(filterForUpdate(validIngredientSubstitutionsColumns), func(i int, s string) string {
+					return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
+				}), ",\n\t"),
+				lastUpdatedAtColumn,
+				currentTimeExpression,
+				archivedAtColumn,
+				idColumn,
+				idColumn,
+			)),
+		},
+	}
+}
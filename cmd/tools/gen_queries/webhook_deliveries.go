@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	webhookDeliveriesTableName = "webhook_deliveries"
+
+	nextAttemptAtColumn = "next_attempt_at"
+	deliveryIDColumn    = "delivery_id"
+)
+
+var (
+	webhookDeliveriesColumns = []string{
+		idColumn,
+		deliveryIDColumn,
+		triggerEventColumn,
+		"payload",
+		"attempt",
+		"response_status_code",
+		"response_body",
+		"error",
+		"latency_in_milliseconds",
+		"succeeded",
+		nextAttemptAtColumn,
+		createdAtColumn,
+		belongsToWebhookColumn,
+	}
+)
+
+func buildWebhookDeliveriesQueries() []*Query {
+	insertColumns := filterForInsert(webhookDeliveriesColumns)
+	fullSelectColumns := applyToEach(webhookDeliveriesColumns, func(_ int, s string) string {
+		return fullColumnName(webhookDeliveriesTableName, s)
+	})
+
+	return []*Query{
+		{
+			Annotation: QueryAnnotation{
+				Name: "CreateWebhookDelivery",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+				webhookDeliveriesTableName,
+				strings.Join(insertColumns, ",\n\t"),
+				strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+					if s == "response_status_code" || s == nextAttemptAtColumn {
+						return fmt.Sprintf("sqlc.narg(%s)", s)
+					}
+					return fmt.Sprintf("sqlc.arg(%s)", s)
+				}), ",\n\t"),
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetWebhookDelivery",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				webhookDeliveriesTableName,
+				webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn,
+				webhookDeliveriesTableName, idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "GetWebhookDeliveriesForWebhook",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s
+ORDER BY %s.%s DESC
+%s;`,
+				strings.Join(fullSelectColumns, ",\n\t"),
+				buildFilterCountSelect(
+					webhookDeliveriesTableName,
+					false,
+					false,
+					fmt.Sprintf("%s.%s = sqlc.arg(%s)", webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn),
+				),
+				buildTotalCountSelect(
+					webhookDeliveriesTableName,
+					false,
+					fmt.Sprintf("%s.%s = sqlc.arg(%s)", webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn),
+				),
+				webhookDeliveriesTableName,
+				strings.TrimPrefix(buildFilterConditions(
+					webhookDeliveriesTableName,
+					false,
+					fmt.Sprintf("%s.%s = sqlc.arg(%s)", webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn),
+				), "AND "),
+				webhookDeliveriesTableName, createdAtColumn,
+				offsetLimitAddendum,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "ClaimDueWebhookDeliveryRetries",
+				Type: ManyType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(claimed_until)
+FROM %s
+WHERE %s.%s IN (
+	SELECT %s.%s
+	FROM %s
+		JOIN %s ON %s.%s = %s.%s
+	WHERE %s.%s <= %s
+		AND %s.%s IS NULL
+		AND %s.%s IS NULL
+	ORDER BY %s.%s
+	LIMIT sqlc.arg(batch_size)
+	FOR UPDATE OF %s SKIP LOCKED
+)
+	AND %s.%s = %s.%s
+RETURNING
+	%s.%s,
+	%s.%s,
+	%s.%s,
+	%s.%s;`,
+				webhookDeliveriesTableName,
+				nextAttemptAtColumn,
+				webhooksTableName,
+				webhookDeliveriesTableName, idColumn,
+				webhookDeliveriesTableName, idColumn,
+				webhookDeliveriesTableName,
+				webhooksTableName, webhooksTableName, idColumn, webhookDeliveriesTableName, belongsToWebhookColumn,
+				webhookDeliveriesTableName, nextAttemptAtColumn, currentTimeExpression,
+				webhooksTableName, archivedAtColumn,
+				webhooksTableName, disabledAtColumn,
+				webhookDeliveriesTableName, nextAttemptAtColumn,
+				webhookDeliveriesTableName,
+				webhooksTableName, idColumn, webhookDeliveriesTableName, belongsToWebhookColumn,
+				webhookDeliveriesTableName, idColumn,
+				webhookDeliveriesTableName, triggerEventColumn,
+				webhookDeliveriesTableName, belongsToWebhookColumn,
+				webhooksTableName, belongsToHouseholdColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "MarkWebhookDeliveryAsRetried",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = NULL
+WHERE %s = sqlc.arg(%s);`,
+				webhookDeliveriesTableName,
+				nextAttemptAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+	}
+}
@@ -8,7 +8,9 @@
 )
 
 const (
-	webhooksTableName = "webhooks"
+	webhooksTableName         = "webhooks"
+	disabledAtColumn          = "disabled_at"
+	consecutiveFailuresColumn = "consecutive_failures"
 )
 
 var (
@@ -21,12 +23,13 @@
 		createdAtColumn,
 		lastUpdatedAtColumn,
 		archivedAtColumn,
+		disabledAtColumn,
 		belongsToHouseholdColumn,
 	}
 )
 
 func buildWebhooksQueries() []*Query {
-	insertColumns := filterForInsert(webhooksColumns)
+	insertColumns := filterForInsert(webhooksColumns, disabledAtColumn)
 	fullSelectColumns := mergeColumns(
 		applyToEach(webhooksColumns, func(_ int, s string) string {
 			return fullColumnName(webhooksTableName, s)
@@ -137,6 +140,7 @@ func buildWebhooksQueries() []*Query {
 WHERE %s.%s IS NULL
 	AND %s.%s = sqlc.arg(%s)
 	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL
 	AND %s.%s IS NULL;`,
 				strings.Join(applyToEach(webhooksColumns, func(_ int, s string) string {
 					return fullColumnName(webhooksTableName, s)
@@ -147,6 +151,7 @@ func buildWebhooksQueries() []*Query {
 				webhookTriggerEventsTableName, triggerEventColumn, triggerEventColumn,
 				webhooksTableName, belongsToHouseholdColumn, belongsToHouseholdColumn,
 				webhooksTableName, archivedAtColumn,
+				webhooksTableName, disabledAtColumn,
 			)),
 		},
 		{
@@ -176,5 +181,56 @@ func buildWebhooksQueries() []*Query {
 				webhooksTableName, idColumn, idColumn,
 			)),
 		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "RecordWebhookDeliveryFailure",
+				Type: OneType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s + 1
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+RETURNING %s;`,
+				webhooksTableName,
+				consecutiveFailuresColumn, consecutiveFailuresColumn,
+				archivedAtColumn,
+				idColumn, idColumn,
+				consecutiveFailuresColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "RecordWebhookDeliverySuccess",
+				Type: ExecType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = 0,
+	%s = NULL
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				webhooksTableName,
+				consecutiveFailuresColumn,
+				disabledAtColumn,
+				archivedAtColumn,
+				idColumn, idColumn,
+			)),
+		},
+		{
+			Annotation: QueryAnnotation{
+				Name: "DisableWebhook",
+				Type: ExecRowsType,
+			},
+			Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+				webhooksTableName,
+				disabledAtColumn, currentTimeExpression,
+				archivedAtColumn,
+				disabledAtColumn,
+				idColumn, idColumn,
+			)),
+		},
 	}
 }
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	dbconfig "github.com/dinnerdonebetter/backend/internal/database/config"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	loggingcfg "github.com/dinnerdonebetter/backend/internal/observability/logging/config"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// This tool loads nutrition facts from a FoodData Central style CSV file (see nutrition.ParseFoodDataCentralCSV)
+// into the database, so that they can be populated without any network access. Rows are matched to valid
+// ingredients by their valid_ingredient_id column where present, and otherwise by comparing the first part
+// of the food's description (i.e. "Egg" for "Egg, whole, raw, fresh") to valid ingredient names.
+
+func main() {
+	filePtr := flag.String("file", "", "path to the FoodData Central CSV file to import")
+	referenceUnitPtr := flag.String("reference-unit-id", "", "the ID of the gram valid measurement unit")
+	dryRunPtr := flag.Bool("dry-run", false, "report what would be imported without writing anything")
+
+	flag.Parse()
+
+	if *filePtr == "" {
+		log.Fatal("file is required")
+	}
+
+	if *referenceUnitPtr == "" {
+		log.Fatal("reference-unit-id is required")
+	}
+
+	ctx := context.Background()
+
+	logger := (&loggingcfg.Config{Level: logging.DebugLevel, Provider: loggingcfg.ProviderSlog}).ProvideLogger()
+
+	tracerProvider := tracing.NewNoopTracerProvider()
+
+	f, err := os.Open(*filePtr)
+	if err != nil {
+		log.Fatal(fmt.Errorf("opening file: %w", err))
+	}
+
+	records, err := nutrition.ParseFoodDataCentralCSV(f)
+	if closeErr := f.Close(); closeErr != nil {
+		log.Println(fmt.Errorf("closing file: %w", closeErr))
+	}
+
+	if err != nil {
+		log.Fatal(fmt.Errorf("parsing file: %w", err))
+	}
+
+	dbConfig := &dbconfig.Config{
+		ConnectionDetails: os.Getenv("DATABASE_URL"),
+	}
+
+	dataManager, err := postgres.ProvideDatabaseClient(ctx, logger, tracerProvider, dbConfig)
+	if dataManager != nil {
+		defer dataManager.Close()
+	}
+
+	if err != nil {
+		log.Println(fmt.Errorf("initializing database client: %w", err))
+		return
+	}
+
+	var imported, skipped uint
+	for _, record := range records {
+		validIngredientID := record.ValidIngredientID
+		if validIngredientID == "" {
+			validIngredientID, err = findValidIngredientID(ctx, dataManager, record.Description)
+			if err != nil {
+				log.Println(fmt.Errorf("searching for valid ingredient for %q: %w", record.Description, err))
+				skipped++
+				continue
+			}
+		}
+
+		if validIngredientID == "" {
+			log.Printf("no valid ingredient matches %q (fdc_id %s), skipping", record.Description, record.FDCID)
+			skipped++
+			continue
+		}
+
+		input := &types.ValidIngredientNutritionDatabaseCreationInput{
+			ID:                         identifiers.New(),
+			ValidIngredientID:          validIngredientID,
+			ReferenceMeasurementUnitID: *referenceUnitPtr,
+			Source:                     nutrition.FoodDataCentralSource,
+			SourceID:                   record.FDCID,
+			Nutrients:                  record.Nutrients,
+			ReferenceQuantity:          nutrition.FoodDataCentralReferenceQuantity,
+		}
+
+		if err = input.ValidateWithContext(ctx); err != nil {
+			log.Println(fmt.Errorf("validating nutrition for %q: %w", record.Description, err))
+			skipped++
+			continue
+		}
+
+		if *dryRunPtr {
+			log.Printf("would import %q (fdc_id %s) for valid ingredient %s", record.Description, record.FDCID, validIngredientID)
+			imported++
+			continue
+		}
+
+		if _, err = dataManager.UpsertValidIngredientNutrition(ctx, input); err != nil {
+			log.Println(fmt.Errorf("importing nutrition for %q: %w", record.Description, err))
+			skipped++
+			continue
+		}
+
+		imported++
+	}
+
+	log.Printf("imported %d of %d records, skipped %d", imported, len(records), skipped)
+}
+
+// findValidIngredientID returns the ID of the valid ingredient whose name matches a FoodData Central description, if any.
+func findValidIngredientID(ctx context.Context, dataManager types.ValidIngredientDataManager, description string) (string, error) {
+	name := strings.TrimSpace(strings.Split(description, ",")[0])
+	if name == "" {
+		return "", nil
+	}
+
+	results, err := dataManager.SearchForValidIngredients(ctx, name, nil)
+	if err != nil {
+		return "", err
+	}
+
+	for _, ingredient := range results.Data {
+		if strings.EqualFold(ingredient.Name, name) || strings.EqualFold(ingredient.PluralName, name) {
+			return ingredient.ID, nil
+		}
+	}
+
+	return "", nil
+}
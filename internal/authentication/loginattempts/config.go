@@ -0,0 +1,75 @@
+package loginattempts
+
+import (
+	"context"
+	"time"
+
+	cacheconfig "github.com/dinnerdonebetter/backend/internal/cache/config"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	defaultWindow                  = 15 * time.Minute
+	defaultBaseLockout             = time.Minute
+	defaultMaxLockout              = time.Hour
+	defaultMaxFailuresPerUser      = 5
+	defaultMaxFailuresPerIPAddress = 50
+
+	// maxRecordLifetime is how long our caches hold on to records, which bounds how long we can remember anything.
+	maxRecordLifetime = time.Hour
+)
+
+type (
+	// Config configures login attempt tracking. Tracking is disabled when no cache provider is set.
+	Config struct {
+		_ struct{} `json:"-"`
+
+		Cache                   cacheconfig.Config `json:"cache"                             toml:"cache,omitempty"`
+		Window                  time.Duration      `json:"window,omitempty"                  toml:"window,omitempty"`
+		BaseLockout             time.Duration      `json:"baseLockout,omitempty"             toml:"base_lockout,omitempty"`
+		MaxLockout              time.Duration      `json:"maxLockout,omitempty"              toml:"max_lockout,omitempty"`
+		MaxFailuresPerUser      uint16             `json:"maxFailuresPerUser,omitempty"      toml:"max_failures_per_user,omitempty"`
+		MaxFailuresPerIPAddress uint16             `json:"maxFailuresPerIPAddress,omitempty" toml:"max_failures_per_ip_address,omitempty"`
+	}
+)
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, &cfg,
+		validation.Field(&cfg.Window, validation.Max(maxRecordLifetime)),
+		validation.Field(&cfg.MaxLockout, validation.Max(maxRecordLifetime)),
+		validation.Field(&cfg.Cache, validation.When(cfg.Cache.Provider != "", validation.By(func(any) error {
+			return cfg.Cache.ValidateWithContext(ctx)
+		}))),
+	)
+}
+
+// withDefaults returns a copy of the config with unset values filled in.
+func (cfg *Config) withDefaults() *Config {
+	x := *cfg
+
+	if x.Window == 0 {
+		x.Window = defaultWindow
+	}
+
+	if x.BaseLockout == 0 {
+		x.BaseLockout = defaultBaseLockout
+	}
+
+	if x.MaxLockout == 0 {
+		x.MaxLockout = defaultMaxLockout
+	}
+
+	if x.MaxFailuresPerUser == 0 {
+		x.MaxFailuresPerUser = defaultMaxFailuresPerUser
+	}
+
+	if x.MaxFailuresPerIPAddress == 0 {
+		x.MaxFailuresPerIPAddress = defaultMaxFailuresPerIPAddress
+	}
+
+	return &x
+}
@@ -0,0 +1,56 @@
+package loginattempts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	cacheconfig "github.com/dinnerdonebetter/backend/internal/cache/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Cache:      cacheconfig.Config{Provider: cacheconfig.ProviderMemory},
+			Window:     time.Minute,
+			MaxLockout: time.Minute,
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{}
+
+		assert.NoError(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with invalid cache provider", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Cache: cacheconfig.Config{Provider: t.Name()},
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with overlong window", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Cache:  cacheconfig.Config{Provider: cacheconfig.ProviderMemory},
+			Window: 2 * maxRecordLifetime,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+}
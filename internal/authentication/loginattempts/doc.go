@@ -0,0 +1,5 @@
+/*
+Package loginattempts tracks failed login attempts per user and per IP address, and locks out either one when it makes
+too many of them in a short window.
+*/
+package loginattempts
@@ -0,0 +1,36 @@
+package loginattempts
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// RetryAfterHeader is the header that tells a locked out client when to try again.
+	RetryAfterHeader = "Retry-After"
+)
+
+// ClientIPAddress returns the IP address a request came from. The router only rewrites RemoteAddr from
+// forwarding headers sent by trusted proxies, so clients cannot pick their own address here.
+func ClientIPAddress(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return host
+}
+
+// SetRetryAfterHeader tells a locked out client how many seconds to wait before trying again.
+func SetRetryAfterHeader(res http.ResponseWriter, lockedUntil time.Time) {
+	res.Header().Set(RetryAfterHeader, RetryAfter(lockedUntil))
+}
+
+// RetryAfter returns the Retry-After header value for a lockout, in seconds.
+func RetryAfter(lockedUntil time.Time) string {
+	seconds := int(math.Ceil(time.Until(lockedUntil).Seconds()))
+	return strconv.Itoa(max(seconds, 1))
+}
@@ -0,0 +1,70 @@
+package loginattempts
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIPAddress(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		req.RemoteAddr = "10.0.0.1:12345"
+
+		assert.Equal(t, "10.0.0.1", ClientIPAddress(req))
+	})
+
+	T.Run("without port", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		req.RemoteAddr = "10.0.0.1"
+
+		assert.Equal(t, "10.0.0.1", ClientIPAddress(req))
+	})
+}
+
+func TestSetRetryAfterHeader(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		res := httptest.NewRecorder()
+		SetRetryAfterHeader(res, time.Now().Add(90*time.Second))
+
+		assert.Equal(t, "90", res.Header().Get("Retry-After"))
+	})
+
+	T.Run("with lockout already over", func(t *testing.T) {
+		t.Parallel()
+
+		res := httptest.NewRecorder()
+		SetRetryAfterHeader(res, time.Now().Add(-time.Second))
+
+		assert.Equal(t, "1", res.Header().Get("Retry-After"))
+	})
+}
+
+func TestRetryAfter(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "90", RetryAfter(time.Now().Add(90*time.Second)))
+	})
+
+	T.Run("with lockout already over", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "1", RetryAfter(time.Now().Add(-time.Second)))
+	})
+}
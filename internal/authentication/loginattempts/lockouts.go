@@ -0,0 +1,80 @@
+package loginattempts
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// CheckLockout returns when the user or IP address's lockout ends, or the zero time if neither is locked out. Lockout
+// state that can't be read is logged and ignored, so that a cache outage doesn't lock everyone out.
+func CheckLockout(ctx context.Context, logger logging.Logger, span tracing.Span, tracker Tracker, userID, ipAddress string) time.Time {
+	lockedUntil, err := tracker.LockedOutUntil(ctx, userID, ipAddress)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "checking login lockout")
+		return time.Time{}
+	}
+
+	return lockedUntil
+}
+
+// RespondIfLockedOut responds with a 429 and returns true if the user or IP address is locked out.
+func RespondIfLockedOut(
+	ctx context.Context,
+	logger logging.Logger,
+	span tracing.Span,
+	tracker Tracker,
+	encoder encoding.ServerEncoderDecoder,
+	res http.ResponseWriter,
+	userID,
+	ipAddress string,
+	responseDetails types.ResponseDetails,
+) bool {
+	lockedUntil := CheckLockout(ctx, logger, span, tracker, userID, ipAddress)
+	if lockedUntil.IsZero() {
+		return false
+	}
+
+	SetRetryAfterHeader(res, lockedUntil)
+	errRes := types.NewAPIErrorResponse("too many failed login attempts", types.ErrTooManyLoginAttempts, responseDetails)
+	encoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusTooManyRequests)
+
+	return true
+}
+
+// RecordFailure counts a failed login attempt, and reports any lockouts it triggers. Errors are logged rather than
+// returned, since they shouldn't change how the attempt itself is answered.
+func RecordFailure(
+	ctx context.Context,
+	logger logging.Logger,
+	span tracing.Span,
+	tracker Tracker,
+	auditLogEntryDataManager types.AuditLogEntryDataManager,
+	publisher messagequeue.Publisher,
+	userID,
+	ipAddress string,
+) {
+	lockouts, err := tracker.RecordFailure(ctx, userID, ipAddress)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "recording failed login attempt")
+		return
+	}
+
+	if err = ReportLockouts(ctx, auditLogEntryDataManager, publisher, lockouts); err != nil {
+		observability.AcknowledgeError(err, logger, span, "reporting login lockouts")
+	}
+}
+
+// RecordSuccess forgets a user's failed login attempts. Errors are logged rather than returned, for the same reason.
+func RecordSuccess(ctx context.Context, logger logging.Logger, span tracing.Span, tracker Tracker, userID string) {
+	if err := tracker.RecordSuccess(ctx, userID); err != nil {
+		observability.AcknowledgeError(err, logger, span, "clearing failed login attempts")
+	}
+}
@@ -0,0 +1,129 @@
+package loginattempts
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func buildTestSpan(t *testing.T) (context.Context, tracing.Span) {
+	t.Helper()
+
+	return tracing.NewTracer(tracing.NewNoopTracerProvider().Tracer(t.Name())).StartSpan(context.Background())
+}
+
+func TestRespondIfLockedOut(T *testing.T) {
+	T.Parallel()
+
+	encoder := encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, span := buildTestSpan(t)
+		tr, _ := buildTestTracker(t)
+		res := httptest.NewRecorder()
+
+		assert.False(t, RespondIfLockedOut(ctx, logging.NewNoopLogger(), span, tr, encoder, res, fakes.BuildFakeID(), "127.0.0.1", types.ResponseDetails{}))
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Empty(t, res.Header().Get(RetryAfterHeader))
+	})
+
+	T.Run("with locked out user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, span := buildTestSpan(t)
+		tr, _ := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+		recordFailuresForTest(t, tr, int(tr.config.MaxFailuresPerUser), exampleUserID, "")
+		res := httptest.NewRecorder()
+
+		assert.True(t, RespondIfLockedOut(ctx, logging.NewNoopLogger(), span, tr, encoder, res, exampleUserID, "127.0.0.1", types.ResponseDetails{}))
+		assert.Equal(t, http.StatusTooManyRequests, res.Code)
+		assert.NotEmpty(t, res.Header().Get(RetryAfterHeader))
+	})
+}
+
+func TestRecordFailure(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, span := buildTestSpan(t)
+		tr, _ := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		publisher := &mockpublishers.Publisher{}
+
+		for i := 1; i < int(tr.config.MaxFailuresPerUser); i++ {
+			RecordFailure(ctx, logging.NewNoopLogger(), span, tr, auditLogEntryDataManager, publisher, exampleUserID, "127.0.0.1")
+		}
+		assert.True(t, CheckLockout(ctx, logging.NewNoopLogger(), span, tr, exampleUserID, "").IsZero())
+
+		mock.AssertExpectationsForObjects(t, auditLogEntryDataManager, publisher)
+	})
+
+	T.Run("triggering lockout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, span := buildTestSpan(t)
+		tr, _ := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+		recordFailuresForTest(t, tr, int(tr.config.MaxFailuresPerUser)-1, exampleUserID, "")
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.AuditLogEntryDatabaseCreationInput) bool {
+				return input.ResourceType == ResourceTypeLoginLockouts && input.RelevantID == exampleUserID
+			}),
+		).Return(&types.AuditLogEntry{}, nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(dcm *types.DataChangeMessage) bool {
+				return dcm.EventType == types.LoginLockoutTriggeredEventType
+			}),
+		).Return(nil)
+
+		RecordFailure(ctx, logging.NewNoopLogger(), span, tr, auditLogEntryDataManager, publisher, exampleUserID, "")
+		assert.False(t, CheckLockout(ctx, logging.NewNoopLogger(), span, tr, exampleUserID, "").IsZero())
+
+		mock.AssertExpectationsForObjects(t, auditLogEntryDataManager, publisher)
+	})
+}
+
+func TestRecordSuccess(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, span := buildTestSpan(t)
+		tr, _ := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+		recordFailuresForTest(t, tr, int(tr.config.MaxFailuresPerUser), exampleUserID, "")
+
+		RecordSuccess(ctx, logging.NewNoopLogger(), span, tr, exampleUserID)
+
+		assert.True(t, CheckLockout(ctx, logging.NewNoopLogger(), span, tr, exampleUserID, "").IsZero())
+	})
+}
@@ -0,0 +1,41 @@
+package mockloginattempts
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ loginattempts.Tracker = (*Tracker)(nil)
+
+// Tracker is a mock loginattempts.Tracker.
+type Tracker struct {
+	mock.Mock
+}
+
+// LockedOutUntil satisfies our interface.
+func (m *Tracker) LockedOutUntil(ctx context.Context, userID, ipAddress string) (time.Time, error) {
+	args := m.Called(ctx, userID, ipAddress)
+
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+// RecordFailure satisfies our interface.
+func (m *Tracker) RecordFailure(ctx context.Context, userID, ipAddress string) ([]*loginattempts.Lockout, error) {
+	args := m.Called(ctx, userID, ipAddress)
+
+	return args.Get(0).([]*loginattempts.Lockout), args.Error(1)
+}
+
+// RecordSuccess satisfies our interface.
+func (m *Tracker) RecordSuccess(ctx context.Context, userID string) error {
+	return m.Called(ctx, userID).Error(0)
+}
+
+// ClearLockouts satisfies our interface.
+func (m *Tracker) ClearLockouts(ctx context.Context, userID, ipAddress string) error {
+	return m.Called(ctx, userID, ipAddress).Error(0)
+}
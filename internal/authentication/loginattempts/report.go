@@ -0,0 +1,59 @@
+package loginattempts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	// ResourceTypeLoginLockouts is the audit log resource type for login lockouts.
+	ResourceTypeLoginLockouts = "login_lockouts"
+)
+
+// ReportLockouts records lockouts in the audit log and announces them on the data changes topic.
+func ReportLockouts(ctx context.Context, auditLogEntryDataManager types.AuditLogEntryDataManager, publisher messagequeue.Publisher, lockouts []*Lockout) error {
+	for _, lockout := range lockouts {
+		input := &types.AuditLogEntryDatabaseCreationInput{
+			ID:           identifiers.New(),
+			ResourceType: ResourceTypeLoginLockouts,
+			RelevantID:   lockout.Subject,
+			EventType:    types.AuditLogEventTypeOther,
+			Changes: map[string]types.ChangeLog{
+				"locked_until": {
+					NewValue: lockout.LockedUntil.Format(time.RFC3339),
+				},
+			},
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.LoginLockoutTriggeredEventType,
+			Context: map[string]any{
+				"subject_type":  lockout.SubjectType,
+				"locked_until":  lockout.LockedUntil,
+				"lockout_count": lockout.Count,
+			},
+		}
+
+		if lockout.SubjectType == SubjectTypeUser {
+			input.BelongsToUser = lockout.Subject
+			dcm.UserID = lockout.Subject
+		} else {
+			dcm.Context["ip_address"] = lockout.Subject
+		}
+
+		if _, err := auditLogEntryDataManager.CreateAuditLogEntry(ctx, input); err != nil {
+			return fmt.Errorf("recording lockout in audit log: %w", err)
+		}
+
+		if err := publisher.Publish(ctx, dcm); err != nil {
+			return fmt.Errorf("publishing lockout event: %w", err)
+		}
+	}
+
+	return nil
+}
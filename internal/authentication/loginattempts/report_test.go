@@ -0,0 +1,110 @@
+package loginattempts
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReportLockouts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUserID := fakes.BuildFakeID()
+		lockouts := []*Lockout{
+			{SubjectType: SubjectTypeUser, Subject: exampleUserID, LockedUntil: time.Now(), Count: 1},
+			{SubjectType: SubjectTypeIPAddress, Subject: "127.0.0.1", LockedUntil: time.Now(), Count: 2},
+		}
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.AuditLogEntryDatabaseCreationInput) bool {
+				return input.ResourceType == ResourceTypeLoginLockouts && input.RelevantID == exampleUserID && input.BelongsToUser == exampleUserID
+			}),
+		).Return(&types.AuditLogEntry{}, nil)
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.AuditLogEntryDatabaseCreationInput) bool {
+				return input.ResourceType == ResourceTypeLoginLockouts && input.RelevantID == "127.0.0.1" && input.BelongsToUser == ""
+			}),
+		).Return(&types.AuditLogEntry{}, nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(dcm *types.DataChangeMessage) bool {
+				return dcm.EventType == types.LoginLockoutTriggeredEventType
+			}),
+		).Return(nil).Twice()
+
+		assert.NoError(t, ReportLockouts(ctx, auditLogEntryDataManager, publisher, lockouts))
+
+		mock.AssertExpectationsForObjects(t, auditLogEntryDataManager, publisher)
+	})
+
+	T.Run("with error writing audit log entry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		lockouts := []*Lockout{
+			{SubjectType: SubjectTypeUser, Subject: fakes.BuildFakeID(), LockedUntil: time.Now(), Count: 1},
+		}
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.IsType(&types.AuditLogEntryDatabaseCreationInput{}),
+		).Return((*types.AuditLogEntry)(nil), errors.New("blah"))
+
+		publisher := &mockpublishers.Publisher{}
+
+		assert.Error(t, ReportLockouts(ctx, auditLogEntryDataManager, publisher, lockouts))
+
+		mock.AssertExpectationsForObjects(t, auditLogEntryDataManager, publisher)
+	})
+
+	T.Run("with error publishing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		lockouts := []*Lockout{
+			{SubjectType: SubjectTypeUser, Subject: fakes.BuildFakeID(), LockedUntil: time.Now(), Count: 1},
+		}
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.IsType(&types.AuditLogEntryDatabaseCreationInput{}),
+		).Return(&types.AuditLogEntry{}, nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.IsType(&types.DataChangeMessage{}),
+		).Return(errors.New("blah"))
+
+		assert.Error(t, ReportLockouts(ctx, auditLogEntryDataManager, publisher, lockouts))
+
+		mock.AssertExpectationsForObjects(t, auditLogEntryDataManager, publisher)
+	})
+}
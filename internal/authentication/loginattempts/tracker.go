@@ -0,0 +1,240 @@
+package loginattempts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/cache"
+	cacheconfig "github.com/dinnerdonebetter/backend/internal/cache/config"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	serviceName = "login_attempt_tracker"
+
+	// SubjectTypeUser indicates a lockout applies to a user.
+	SubjectTypeUser SubjectType = "user"
+	// SubjectTypeIPAddress indicates a lockout applies to an IP address.
+	SubjectTypeIPAddress SubjectType = "ip_address"
+)
+
+type (
+	// SubjectType describes what a lockout applies to.
+	SubjectType string
+
+	// Lockout describes a lockout triggered by a failed login attempt.
+	Lockout struct {
+		LockedUntil time.Time
+		SubjectType SubjectType
+		Subject     string
+		Count       uint
+	}
+
+	// Tracker counts failed login attempts and locks out users and IP addresses that make too many of them.
+	Tracker interface {
+		// LockedOutUntil returns when the latest lockout for the user or IP address ends, or the zero time if neither is locked out.
+		LockedOutUntil(ctx context.Context, userID, ipAddress string) (time.Time, error)
+		// RecordFailure records a failed attempt and returns any lockouts it triggered.
+		RecordFailure(ctx context.Context, userID, ipAddress string) ([]*Lockout, error)
+		// RecordSuccess forgets a user's failed attempts and lockout history.
+		RecordSuccess(ctx context.Context, userID string) error
+		// ClearLockouts forgets failed attempts and lockout history for a user and/or IP address.
+		ClearLockouts(ctx context.Context, userID, ipAddress string) error
+	}
+
+	subject struct {
+		kind        SubjectType
+		id          string
+		maxFailures int
+	}
+
+	tracker struct {
+		logger logging.Logger
+		tracer tracing.Tracer
+		cache  cache.Cache[types.LoginAttemptRecord]
+		config *Config
+		now    func() time.Time
+	}
+)
+
+// ProvideTracker builds a Tracker from our config.
+func ProvideTracker(logger logging.Logger, tracerProvider tracing.TracerProvider, cfg *Config) (Tracker, error) {
+	if cfg == nil || cfg.Cache.Provider == "" {
+		return NewNoopTracker(), nil
+	}
+
+	c, err := cacheconfig.ProvideCache[types.LoginAttemptRecord](&cfg.Cache)
+	if err != nil {
+		return nil, fmt.Errorf("setting up login attempt cache: %w", err)
+	}
+
+	return newTracker(logger, tracerProvider, cfg, c), nil
+}
+
+func newTracker(logger logging.Logger, tracerProvider tracing.TracerProvider, cfg *Config, c cache.Cache[types.LoginAttemptRecord]) *tracker {
+	return &tracker{
+		logger: logging.EnsureLogger(logger).WithName(serviceName),
+		tracer: tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
+		cache:  c,
+		config: cfg.withDefaults(),
+		now:    time.Now,
+	}
+}
+
+func (t *tracker) subjectsFor(userID, ipAddress string) []subject {
+	subjects := []subject{}
+
+	if userID != "" {
+		subjects = append(subjects, subject{kind: SubjectTypeUser, id: userID, maxFailures: int(t.config.MaxFailuresPerUser)})
+	}
+
+	if ipAddress != "" {
+		subjects = append(subjects, subject{kind: SubjectTypeIPAddress, id: ipAddress, maxFailures: int(t.config.MaxFailuresPerIPAddress)})
+	}
+
+	return subjects
+}
+
+func (s subject) cacheKey() string {
+	return fmt.Sprintf("login_attempts:%s:%s", s.kind, s.id)
+}
+
+// fetchRecord fetches a copy of the record for a subject, or an empty record if there isn't one.
+func (t *tracker) fetchRecord(ctx context.Context, s subject) (*types.LoginAttemptRecord, error) {
+	record, err := t.cache.Get(ctx, s.cacheKey())
+	if err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			return &types.LoginAttemptRecord{}, nil
+		}
+
+		return nil, err
+	}
+
+	return &types.LoginAttemptRecord{
+		LockedUntil: record.LockedUntil,
+		Failures:    append([]time.Time{}, record.Failures...),
+		Lockouts:    record.Lockouts,
+	}, nil
+}
+
+// lockoutDuration doubles the base lockout for every lockout after the first, up to the maximum.
+func (t *tracker) lockoutDuration(lockouts uint) time.Duration {
+	duration := t.config.BaseLockout
+	for i := uint(1); i < lockouts && duration < t.config.MaxLockout; i++ {
+		duration *= 2
+	}
+
+	return min(duration, t.config.MaxLockout)
+}
+
+// LockedOutUntil implements our interface.
+func (t *tracker) LockedOutUntil(ctx context.Context, userID, ipAddress string) (time.Time, error) {
+	ctx, span := t.tracer.StartSpan(ctx)
+	defer span.End()
+
+	now := t.now()
+
+	var lockedUntil time.Time
+	for _, s := range t.subjectsFor(userID, ipAddress) {
+		record, err := t.fetchRecord(ctx, s)
+		if err != nil {
+			return time.Time{}, observability.PrepareError(err, span, "fetching login attempt record")
+		}
+
+		if record.LockedUntil.After(now) && record.LockedUntil.After(lockedUntil) {
+			lockedUntil = record.LockedUntil
+		}
+	}
+
+	return lockedUntil, nil
+}
+
+// RecordFailure implements our interface. Each record is updated atomically in the cache, so concurrent failures
+// are all counted, even across processes sharing it.
+func (t *tracker) RecordFailure(ctx context.Context, userID, ipAddress string) ([]*Lockout, error) {
+	ctx, span := t.tracer.StartSpan(ctx)
+	defer span.End()
+
+	now := t.now()
+	windowStart := now.Add(-t.config.Window)
+
+	lockouts := []*Lockout{}
+	for _, s := range t.subjectsFor(userID, ipAddress) {
+		var lockout *Lockout
+
+		err := t.cache.Update(ctx, s.cacheKey(), func(current *types.LoginAttemptRecord) (*types.LoginAttemptRecord, error) {
+			// the update may be retried, so it mustn't leave anything behind from a previous attempt.
+			lockout = nil
+
+			record := &types.LoginAttemptRecord{}
+			if current != nil {
+				record.LockedUntil = current.LockedUntil
+				record.Lockouts = current.Lockouts
+				for _, failure := range current.Failures {
+					if failure.After(windowStart) {
+						record.Failures = append(record.Failures, failure)
+					}
+				}
+			}
+			record.Failures = append(record.Failures, now)
+
+			if len(record.Failures) >= s.maxFailures {
+				// lockouts only escalate while they keep happening.
+				if !record.LockedUntil.IsZero() && now.Sub(record.LockedUntil) > t.config.MaxLockout {
+					record.Lockouts = 0
+				}
+
+				record.Lockouts++
+				record.LockedUntil = now.Add(t.lockoutDuration(record.Lockouts))
+				record.Failures = nil
+
+				lockout = &Lockout{
+					LockedUntil: record.LockedUntil,
+					SubjectType: s.kind,
+					Subject:     s.id,
+					Count:       record.Lockouts,
+				}
+			}
+
+			return record, nil
+		})
+		if err != nil {
+			return nil, observability.PrepareError(err, span, "updating login attempt record")
+		}
+
+		if lockout != nil {
+			lockouts = append(lockouts, lockout)
+			t.logger.WithValue("subject_type", s.kind).WithValue("locked_until", lockout.LockedUntil).Info("login lockout triggered")
+		}
+	}
+
+	return lockouts, nil
+}
+
+// RecordSuccess implements our interface.
+func (t *tracker) RecordSuccess(ctx context.Context, userID string) error {
+	return t.clear(ctx, t.subjectsFor(userID, ""))
+}
+
+// ClearLockouts implements our interface.
+func (t *tracker) ClearLockouts(ctx context.Context, userID, ipAddress string) error {
+	return t.clear(ctx, t.subjectsFor(userID, ipAddress))
+}
+
+func (t *tracker) clear(ctx context.Context, subjects []subject) error {
+	ctx, span := t.tracer.StartSpan(ctx)
+	defer span.End()
+
+	for _, s := range subjects {
+		if err := t.cache.Delete(ctx, s.cacheKey()); err != nil {
+			return observability.PrepareError(err, span, "clearing login attempt record")
+		}
+	}
+
+	return nil
+}
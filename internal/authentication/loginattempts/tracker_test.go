@@ -0,0 +1,252 @@
+package loginattempts
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/cache/memory"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	return c.now
+}
+
+func buildTestTracker(t *testing.T) (*tracker, *testClock) {
+	t.Helper()
+
+	clock := &testClock{now: time.Now()}
+	cfg := &Config{
+		Window:                  time.Minute,
+		BaseLockout:             time.Minute,
+		MaxLockout:              5 * time.Minute,
+		MaxFailuresPerUser:      3,
+		MaxFailuresPerIPAddress: 5,
+	}
+
+	tr := newTracker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg, memory.NewInMemoryCache[types.LoginAttemptRecord](0))
+	tr.now = clock.Now
+
+	return tr, clock
+}
+
+func recordFailuresForTest(t *testing.T, tr *tracker, count int, userID, ipAddress string) []*Lockout {
+	t.Helper()
+
+	ctx := context.Background()
+	lockouts := []*Lockout{}
+	for i := 0; i < count; i++ {
+		triggered, err := tr.RecordFailure(ctx, userID, ipAddress)
+		require.NoError(t, err)
+		lockouts = append(lockouts, triggered...)
+	}
+
+	return lockouts
+}
+
+func TestProvideTracker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{}
+		cfg.Cache.Provider = "memory"
+
+		actual, err := ProvideTracker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg)
+		assert.NoError(t, err)
+		assert.IsType(t, &tracker{}, actual)
+	})
+
+	T.Run("without cache provider", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ProvideTracker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{})
+		assert.NoError(t, err)
+		assert.IsType(t, &noopTracker{}, actual)
+	})
+
+	T.Run("with invalid cache provider", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{}
+		cfg.Cache.Provider = t.Name()
+
+		actual, err := ProvideTracker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestTracker_RecordFailure(T *testing.T) {
+	T.Parallel()
+
+	T.Run("locks out user after too many failures", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tr, clock := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+
+		assert.Empty(t, recordFailuresForTest(t, tr, 2, exampleUserID, ""))
+
+		lockouts := recordFailuresForTest(t, tr, 1, exampleUserID, "")
+		require.Len(t, lockouts, 1)
+		assert.Equal(t, SubjectTypeUser, lockouts[0].SubjectType)
+		assert.Equal(t, exampleUserID, lockouts[0].Subject)
+		assert.Equal(t, clock.now.Add(time.Minute), lockouts[0].LockedUntil)
+
+		lockedUntil, err := tr.LockedOutUntil(ctx, exampleUserID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, lockouts[0].LockedUntil, lockedUntil)
+
+		clock.now = clock.now.Add(2 * time.Minute)
+
+		lockedUntil, err = tr.LockedOutUntil(ctx, exampleUserID, "")
+		assert.NoError(t, err)
+		assert.True(t, lockedUntil.IsZero())
+	})
+
+	T.Run("counts concurrent failures", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tr, _ := buildTestTracker(t)
+		exampleIPAddress := "127.0.0.1"
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			lockouts []*Lockout
+		)
+		for range tr.config.MaxFailuresPerIPAddress {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				triggered, err := tr.RecordFailure(ctx, "", exampleIPAddress)
+				assert.NoError(t, err)
+
+				mu.Lock()
+				lockouts = append(lockouts, triggered...)
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Len(t, lockouts, 1)
+	})
+
+	T.Run("forgets failures outside the window", func(t *testing.T) {
+		t.Parallel()
+
+		tr, clock := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+
+		assert.Empty(t, recordFailuresForTest(t, tr, 2, exampleUserID, ""))
+		clock.now = clock.now.Add(2 * time.Minute)
+		assert.Empty(t, recordFailuresForTest(t, tr, 2, exampleUserID, ""))
+	})
+
+	T.Run("doubles repeated lockouts up to the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		tr, clock := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+
+		expected := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute}
+		for _, duration := range expected {
+			lockouts := recordFailuresForTest(t, tr, 3, exampleUserID, "")
+			require.Len(t, lockouts, 1)
+			assert.Equal(t, clock.now.Add(duration), lockouts[0].LockedUntil)
+
+			clock.now = lockouts[0].LockedUntil
+		}
+	})
+
+	T.Run("resets escalation after a quiet period", func(t *testing.T) {
+		t.Parallel()
+
+		tr, clock := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+
+		lockouts := recordFailuresForTest(t, tr, 3, exampleUserID, "")
+		require.Len(t, lockouts, 1)
+
+		clock.now = lockouts[0].LockedUntil.Add(10 * time.Minute)
+
+		lockouts = recordFailuresForTest(t, tr, 3, exampleUserID, "")
+		require.Len(t, lockouts, 1)
+		assert.Equal(t, uint(1), lockouts[0].Count)
+		assert.Equal(t, clock.now.Add(time.Minute), lockouts[0].LockedUntil)
+	})
+
+	T.Run("locks out IP address across users", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tr, _ := buildTestTracker(t)
+		exampleIPAddress := "127.0.0.1"
+
+		lockouts := []*Lockout{}
+		for i := 0; i < 5; i++ {
+			lockouts = append(lockouts, recordFailuresForTest(t, tr, 1, fakes.BuildFakeID(), exampleIPAddress)...)
+		}
+
+		require.Len(t, lockouts, 1)
+		assert.Equal(t, SubjectTypeIPAddress, lockouts[0].SubjectType)
+		assert.Equal(t, exampleIPAddress, lockouts[0].Subject)
+
+		lockedUntil, err := tr.LockedOutUntil(ctx, fakes.BuildFakeID(), exampleIPAddress)
+		assert.NoError(t, err)
+		assert.False(t, lockedUntil.IsZero())
+	})
+}
+
+func TestTracker_RecordSuccess(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+
+		assert.Empty(t, recordFailuresForTest(t, tr, 2, exampleUserID, ""))
+		assert.NoError(t, tr.RecordSuccess(context.Background(), exampleUserID))
+		assert.Empty(t, recordFailuresForTest(t, tr, 2, exampleUserID, ""))
+	})
+}
+
+func TestTracker_ClearLockouts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		tr, _ := buildTestTracker(t)
+		exampleUserID := fakes.BuildFakeID()
+		exampleIPAddress := "127.0.0.1"
+
+		require.Len(t, recordFailuresForTest(t, tr, 5, exampleUserID, exampleIPAddress), 2)
+
+		assert.NoError(t, tr.ClearLockouts(ctx, exampleUserID, exampleIPAddress))
+
+		lockedUntil, err := tr.LockedOutUntil(ctx, exampleUserID, exampleIPAddress)
+		assert.NoError(t, err)
+		assert.True(t, lockedUntil.IsZero())
+	})
+}
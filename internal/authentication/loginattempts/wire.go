@@ -0,0 +1,12 @@
+package loginattempts
+
+import (
+	"github.com/google/wire"
+)
+
+var (
+	// Providers are what we offer to dependency injection.
+	Providers = wire.NewSet(
+		ProvideTracker,
+	)
+)
@@ -0,0 +1,60 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+const (
+	// RecoveryCodeCount is how many recovery codes we generate for a user at a time.
+	RecoveryCodeCount = 10
+
+	recoveryCodeGroupLength = 4
+	recoveryCodeGroups      = 4
+)
+
+var recoveryCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateRecoveryCodes generates a set of single-use recovery codes, formatted like `ABCD-EFGH-IJKL-MNOP`.
+func GenerateRecoveryCodes(count int) ([]string, error) {
+	codeLength := recoveryCodeGroupLength * recoveryCodeGroups
+
+	codes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		b := make([]byte, recoveryCodeEncoding.DecodedLen(codeLength)+1)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+
+		raw := recoveryCodeEncoding.EncodeToString(b)[:codeLength]
+
+		groups := make([]string, 0, recoveryCodeGroups)
+		for j := 0; j < codeLength; j += recoveryCodeGroupLength {
+			groups = append(groups, raw[j:j+recoveryCodeGroupLength])
+		}
+
+		codes = append(codes, strings.Join(groups, "-"))
+	}
+
+	return codes, nil
+}
+
+// NormalizeRecoveryCode strips formatting from a user-provided recovery code.
+func NormalizeRecoveryCode(code string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, code)
+}
+
+// HashRecoveryCode hashes a recovery code for storage. Recovery codes are random enough that a fast hash is sufficient.
+func HashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(NormalizeRecoveryCode(code)))
+	return hex.EncodeToString(sum[:])
+}
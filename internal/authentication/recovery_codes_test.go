@@ -0,0 +1,44 @@
+package authentication_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/authentication"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateRecoveryCodes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		codes, err := authentication.GenerateRecoveryCodes(authentication.RecoveryCodeCount)
+		require.NoError(t, err)
+		assert.Len(t, codes, authentication.RecoveryCodeCount)
+
+		seen := map[string]bool{}
+		for _, code := range codes {
+			assert.Regexp(t, regexp.MustCompile(`^[A-Z2-7]{4}(-[A-Z2-7]{4}){3}$`), code)
+			assert.False(t, seen[code])
+			seen[code] = true
+		}
+	})
+}
+
+func TestHashRecoveryCode(T *testing.T) {
+	T.Parallel()
+
+	T.Run("ignores formatting", func(t *testing.T) {
+		t.Parallel()
+
+		expected := authentication.HashRecoveryCode("ABCD-EFGH-IJKL-MNOP")
+
+		assert.Equal(t, expected, authentication.HashRecoveryCode("abcd efgh ijkl mnop"))
+		assert.Equal(t, expected, authentication.HashRecoveryCode(" abcdefghijklmnop\n"))
+		assert.NotEqual(t, expected, authentication.HashRecoveryCode("ABCD-EFGH-IJKL-MNOQ"))
+	})
+}
@@ -0,0 +1,410 @@
+package authorization
+
+import (
+	"encoding/gob"
+	"slices"
+	"strings"
+
+	"github.com/mikespook/gorbac/v2"
+)
+
+type (
+	// OAuth2Scope describes a subset of permissions an OAuth2 token may exercise on a user's behalf.
+	OAuth2Scope string
+
+	// OAuth2ScopePermissionChecker checks permissions for one or more OAuth2 scopes.
+	OAuth2ScopePermissionChecker interface {
+		HasPermission(Permission) bool
+		IsServiceAdmin() bool
+	}
+
+	oauth2ScopeCollection struct {
+		Scopes []string
+	}
+
+	oauth2ScopeDefinition struct {
+		description string
+		permissions []gorbac.Permission
+	}
+)
+
+const (
+	// HouseholdMemberOAuth2Scope grants everything a household member can do.
+	HouseholdMemberOAuth2Scope OAuth2Scope = householdMemberRoleName
+	// HouseholdAdminOAuth2Scope grants everything a household admin can do.
+	HouseholdAdminOAuth2Scope OAuth2Scope = householdAdminRoleName
+	// ServiceAdminOAuth2Scope grants everything a service admin can do.
+	ServiceAdminOAuth2Scope OAuth2Scope = serviceAdminRoleName
+
+	// ManageHouseholdOAuth2Scope grants household administration.
+	ManageHouseholdOAuth2Scope OAuth2Scope = "household:manage"
+	// ReadWebhooksOAuth2Scope grants read access to webhooks.
+	ReadWebhooksOAuth2Scope OAuth2Scope = "webhooks:read"
+	// WriteWebhooksOAuth2Scope grants write access to webhooks.
+	WriteWebhooksOAuth2Scope OAuth2Scope = "webhooks:write"
+	// ReadCatalogOAuth2Scope grants read access to valid ingredients, instruments, preparations, and the like.
+	ReadCatalogOAuth2Scope OAuth2Scope = "catalog:read"
+	// WriteCatalogOAuth2Scope grants write access to valid ingredients, instruments, preparations, and the like.
+	WriteCatalogOAuth2Scope OAuth2Scope = "catalog:write"
+	// ReadRecipesOAuth2Scope grants read access to recipes and meals.
+	ReadRecipesOAuth2Scope OAuth2Scope = "recipes:read"
+	// WriteRecipesOAuth2Scope grants write access to recipes and meals.
+	WriteRecipesOAuth2Scope OAuth2Scope = "recipes:write"
+	// ReadMealPlansOAuth2Scope grants read access to meal plans.
+	ReadMealPlansOAuth2Scope OAuth2Scope = "meal_plans:read"
+	// WriteMealPlansOAuth2Scope grants write access to meal plans.
+	WriteMealPlansOAuth2Scope OAuth2Scope = "meal_plans:write"
+	// ReadPantryOAuth2Scope grants read access to pantry items, owned instruments, and ingredient preferences.
+	ReadPantryOAuth2Scope OAuth2Scope = "pantry:read"
+	// WritePantryOAuth2Scope grants write access to pantry items, owned instruments, and ingredient preferences.
+	WritePantryOAuth2Scope OAuth2Scope = "pantry:write"
+	// ReadNotificationsOAuth2Scope grants read access to user notifications.
+	ReadNotificationsOAuth2Scope OAuth2Scope = "notifications:read"
+	// WriteNotificationsOAuth2Scope grants write access to user notifications.
+	WriteNotificationsOAuth2Scope OAuth2Scope = "notifications:write"
+)
+
+var (
+	// role scopes are backed by the roles themselves, so they only need descriptions.
+	roleOAuth2ScopeDescriptions = map[OAuth2Scope]string{
+		HouseholdMemberOAuth2Scope: "Do anything you can do as a household member",
+		HouseholdAdminOAuth2Scope:  "Do anything you can do as a household admin",
+		ServiceAdminOAuth2Scope:    "Do anything you can do as a service admin",
+	}
+
+	oauth2ScopeDefinitions = map[OAuth2Scope]oauth2ScopeDefinition{
+		ManageHouseholdOAuth2Scope: {
+			description: "Manage your household and its members",
+			permissions: []gorbac.Permission{
+				UpdateHouseholdPermission,
+				ArchiveHouseholdPermission,
+				TransferHouseholdPermission,
+				InviteUserToHouseholdPermission,
+				ModifyMemberPermissionsForHouseholdPermission,
+				RemoveMemberHouseholdPermission,
+			},
+		},
+		ReadWebhooksOAuth2Scope: {
+			description: "View your household's webhooks",
+			permissions: []gorbac.Permission{
+				ReadWebhooksPermission,
+			},
+		},
+		WriteWebhooksOAuth2Scope: {
+			description: "Create, update, and remove your household's webhooks",
+			permissions: []gorbac.Permission{
+				CreateWebhooksPermission,
+				UpdateWebhooksPermission,
+				ArchiveWebhooksPermission,
+				CreateWebhookTriggerEventsPermission,
+				ArchiveWebhookTriggerEventsPermission,
+			},
+		},
+		ReadCatalogOAuth2Scope: {
+			description: "View ingredients, instruments, vessels, preparations, and measurement units",
+			permissions: []gorbac.Permission{
+				ReadValidInstrumentsPermission,
+				SearchValidInstrumentsPermission,
+				ReadValidVesselsPermission,
+				SearchValidVesselsPermission,
+				ReadValidIngredientsPermission,
+				SearchValidIngredientsPermission,
+				ReadValidIngredientGroupsPermission,
+				SearchValidIngredientGroupsPermission,
+				ReadValidPreparationsPermission,
+				SearchValidPreparationsPermission,
+				ReadValidMeasurementUnitsPermission,
+				SearchValidMeasurementUnitsPermission,
+				ReadValidMeasurementUnitConversionsPermission,
+				ReadValidIngredientPreparationsPermission,
+				SearchValidIngredientPreparationsPermission,
+				ReadValidIngredientSubstitutionsPermission,
+				SearchValidIngredientSubstitutionsPermission,
+				ReadValidIngredientStateIngredientsPermission,
+				SearchValidIngredientStateIngredientsPermission,
+				ReadValidPreparationInstrumentsPermission,
+				SearchValidPreparationInstrumentsPermission,
+				ReadValidPreparationVesselsPermission,
+				SearchValidPreparationVesselsPermission,
+				ReadValidIngredientMeasurementUnitsPermission,
+				SearchValidIngredientMeasurementUnitsPermission,
+				ReadValidIngredientStatesPermission,
+			},
+		},
+		WriteCatalogOAuth2Scope: {
+			description: "Create, update, and remove ingredients, instruments, vessels, preparations, and measurement units",
+			permissions: []gorbac.Permission{
+				CreateValidInstrumentsPermission,
+				UpdateValidInstrumentsPermission,
+				ArchiveValidInstrumentsPermission,
+				CreateValidVesselsPermission,
+				UpdateValidVesselsPermission,
+				ArchiveValidVesselsPermission,
+				CreateValidIngredientsPermission,
+				UpdateValidIngredientsPermission,
+				ArchiveValidIngredientsPermission,
+				CreateValidIngredientGroupsPermission,
+				UpdateValidIngredientGroupsPermission,
+				ArchiveValidIngredientGroupsPermission,
+				CreateValidPreparationsPermission,
+				UpdateValidPreparationsPermission,
+				ArchiveValidPreparationsPermission,
+				CreateValidMeasurementUnitsPermission,
+				UpdateValidMeasurementUnitsPermission,
+				ArchiveValidMeasurementUnitsPermission,
+				CreateValidMeasurementUnitConversionsPermission,
+				UpdateValidMeasurementUnitConversionsPermission,
+				ArchiveValidMeasurementUnitConversionsPermission,
+				CreateValidIngredientPreparationsPermission,
+				UpdateValidIngredientPreparationsPermission,
+				ArchiveValidIngredientPreparationsPermission,
+				CreateValidIngredientSubstitutionsPermission,
+				UpdateValidIngredientSubstitutionsPermission,
+				ArchiveValidIngredientSubstitutionsPermission,
+				CreateValidIngredientStateIngredientsPermission,
+				UpdateValidIngredientStateIngredientsPermission,
+				ArchiveValidIngredientStateIngredientsPermission,
+				CreateValidPreparationInstrumentsPermission,
+				UpdateValidPreparationInstrumentsPermission,
+				ArchiveValidPreparationInstrumentsPermission,
+				CreateValidPreparationVesselsPermission,
+				UpdateValidPreparationVesselsPermission,
+				ArchiveValidPreparationVesselsPermission,
+				CreateValidIngredientMeasurementUnitsPermission,
+				UpdateValidIngredientMeasurementUnitsPermission,
+				ArchiveValidIngredientMeasurementUnitsPermission,
+				CreateValidIngredientStatesPermission,
+				UpdateValidIngredientStatesPermission,
+				ArchiveValidIngredientStatesPermission,
+			},
+		},
+		ReadRecipesOAuth2Scope: {
+			description: "View recipes, meals, and recipe ratings",
+			permissions: []gorbac.Permission{
+				ReadMealsPermission,
+				ReadRecipesPermission,
+				SearchRecipesPermission,
+				ReadRecipeStepsPermission,
+				SearchRecipeStepsPermission,
+				ReadRecipePrepTasksPermission,
+				ReadRecipeStepInstrumentsPermission,
+				SearchRecipeStepInstrumentsPermission,
+				ReadRecipeStepVesselsPermission,
+				SearchRecipeStepVesselsPermission,
+				ReadRecipeStepIngredientsPermission,
+				SearchRecipeStepIngredientsPermission,
+				ReadRecipeStepCompletionConditionsPermission,
+				SearchRecipeStepCompletionConditionsPermission,
+				ReadRecipeStepProductsPermission,
+				SearchRecipeStepProductsPermission,
+				ReadRecipeRatingsPermission,
+			},
+		},
+		WriteRecipesOAuth2Scope: {
+			description: "Create, update, and remove recipes, meals, and recipe ratings",
+			permissions: []gorbac.Permission{
+				CreateMealsPermission,
+				UpdateMealsPermission,
+				ArchiveMealsPermission,
+				CreateRecipesPermission,
+				UpdateRecipesPermission,
+				ArchiveRecipesPermission,
+				CreateRecipeStepsPermission,
+				UpdateRecipeStepsPermission,
+				ArchiveRecipeStepsPermission,
+				CreateRecipePrepTasksPermission,
+				UpdateRecipePrepTasksPermission,
+				ArchiveRecipePrepTasksPermission,
+				CreateRecipeStepInstrumentsPermission,
+				UpdateRecipeStepInstrumentsPermission,
+				ArchiveRecipeStepInstrumentsPermission,
+				CreateRecipeStepVesselsPermission,
+				UpdateRecipeStepVesselsPermission,
+				ArchiveRecipeStepVesselsPermission,
+				CreateRecipeStepIngredientsPermission,
+				UpdateRecipeStepIngredientsPermission,
+				ArchiveRecipeStepIngredientsPermission,
+				CreateRecipeStepCompletionConditionsPermission,
+				UpdateRecipeStepCompletionConditionsPermission,
+				ArchiveRecipeStepCompletionConditionsPermission,
+				CreateRecipeStepProductsPermission,
+				UpdateRecipeStepProductsPermission,
+				ArchiveRecipeStepProductsPermission,
+				CreateRecipeRatingsPermission,
+				UpdateRecipeRatingsPermission,
+				ArchiveRecipeRatingsPermission,
+			},
+		},
+		ReadMealPlansOAuth2Scope: {
+			description: "View meal plans, their options, votes, tasks, and grocery lists, and cooking sessions",
+			permissions: []gorbac.Permission{
+				ReadMealPlansPermission,
+				SearchMealPlansPermission,
+				ReadMealPlanEventsPermission,
+				ReadMealPlanOptionsPermission,
+				SearchMealPlanOptionsPermission,
+				ReadMealPlanOptionVotesPermission,
+				SearchMealPlanOptionVotesPermission,
+				ReadMealPlanGroceryListItemsPermission,
+				ReadMealPlanTasksPermission,
+				ReadCookingSessionsPermission,
+			},
+		},
+		WriteMealPlansOAuth2Scope: {
+			description: "Create, update, and vote on meal plans, manage their tasks and grocery lists, and run cooking sessions",
+			permissions: []gorbac.Permission{
+				CreateMealPlansPermission,
+				UpdateMealPlansPermission,
+				ArchiveMealPlansPermission,
+				CreateMealPlanEventsPermission,
+				UpdateMealPlanEventsPermission,
+				ArchiveMealPlanEventsPermission,
+				CreateMealPlanOptionsPermission,
+				UpdateMealPlanOptionsPermission,
+				ArchiveMealPlanOptionsPermission,
+				CreateMealPlanOptionVotesPermission,
+				UpdateMealPlanOptionVotesPermission,
+				ArchiveMealPlanOptionVotesPermission,
+				CreateMealPlanGroceryListItemsPermission,
+				UpdateMealPlanGroceryListItemsPermission,
+				ArchiveMealPlanGroceryListItemsPermission,
+				CreateMealPlanTasksPermission,
+				UpdateMealPlanTasksPermission,
+				CreateCookingSessionsPermission,
+				UpdateCookingSessionsPermission,
+				ArchiveCookingSessionsPermission,
+			},
+		},
+		ReadPantryOAuth2Scope: {
+			description: "View your pantry, owned instruments, and ingredient preferences",
+			permissions: []gorbac.Permission{
+				ReadPantryItemsPermission,
+				ReadHouseholdInstrumentOwnershipsPermission,
+				ReadUserIngredientPreferencesPermission,
+			},
+		},
+		WritePantryOAuth2Scope: {
+			description: "Update your pantry, owned instruments, and ingredient preferences",
+			permissions: []gorbac.Permission{
+				CreatePantryItemsPermission,
+				UpdatePantryItemsPermission,
+				ArchivePantryItemsPermission,
+				CreateHouseholdInstrumentOwnershipsPermission,
+				UpdateHouseholdInstrumentOwnershipsPermission,
+				ArchiveHouseholdInstrumentOwnershipsPermission,
+				CreateUserIngredientPreferencesPermission,
+				UpdateUserIngredientPreferencesPermission,
+				ArchiveUserIngredientPreferencesPermission,
+			},
+		},
+		ReadNotificationsOAuth2Scope: {
+			description: "View your notifications",
+			permissions: []gorbac.Permission{
+				ReadUserNotificationsPermission,
+			},
+		},
+		WriteNotificationsOAuth2Scope: {
+			description: "Mark your notifications as read or dismissed",
+			permissions: []gorbac.Permission{
+				UpdateUserNotificationsPermission,
+			},
+		},
+	}
+
+	oauth2ScopeRoles = buildOAuth2ScopeRoles()
+)
+
+func init() {
+	gob.Register(oauth2ScopeCollection{})
+}
+
+func buildOAuth2ScopeRoles() []gorbac.Role {
+	roles := []gorbac.Role{}
+	for scope, definition := range oauth2ScopeDefinitions {
+		r := gorbac.NewStdRole(string(scope))
+		for _, perm := range definition.permissions {
+			must(r.Assign(perm))
+		}
+		roles = append(roles, r)
+	}
+
+	return roles
+}
+
+// NewOAuth2ScopePermissionChecker returns a new checker for a set of OAuth2 scopes.
+func NewOAuth2ScopePermissionChecker(scopes ...string) OAuth2ScopePermissionChecker {
+	return &oauth2ScopeCollection{
+		Scopes: scopes,
+	}
+}
+
+// HasPermission returns whether any of the scopes grants a permission.
+func (r oauth2ScopeCollection) HasPermission(p Permission) bool {
+	return hasPermission(p, r.Scopes...)
+}
+
+// IsServiceAdmin returns whether the scopes grant the full authority of a service admin.
+func (r oauth2ScopeCollection) IsServiceAdmin() bool {
+	return slices.Contains(r.Scopes, string(ServiceAdminOAuth2Scope))
+}
+
+// ParseOAuth2Scopes splits a space-delimited scope string, as used on the wire, into its component scopes.
+func ParseOAuth2Scopes(raw string) []string {
+	return strings.Fields(raw)
+}
+
+// IsValidOAuth2Scope returns whether a scope is one we know about.
+func IsValidOAuth2Scope(scope string) bool {
+	if _, ok := roleOAuth2ScopeDescriptions[OAuth2Scope(scope)]; ok {
+		return true
+	}
+
+	_, ok := oauth2ScopeDefinitions[OAuth2Scope(scope)]
+	return ok
+}
+
+// AllOAuth2Scopes returns every scope we know about, in a stable order.
+func AllOAuth2Scopes() []string {
+	scopes := []string{}
+	for scope := range roleOAuth2ScopeDescriptions {
+		scopes = append(scopes, string(scope))
+	}
+	for scope := range oauth2ScopeDefinitions {
+		scopes = append(scopes, string(scope))
+	}
+	slices.Sort(scopes)
+
+	return scopes
+}
+
+// OAuth2ScopeDescription returns a human-readable description of a scope, suitable for a consent screen.
+func OAuth2ScopeDescription(scope string) string {
+	if description, ok := roleOAuth2ScopeDescriptions[OAuth2Scope(scope)]; ok {
+		return description
+	}
+
+	return oauth2ScopeDefinitions[OAuth2Scope(scope)].description
+}
+
+// OAuth2ScopePermissions returns every permission a scope grants, in a stable order.
+func OAuth2ScopePermissions(scope string) []Permission {
+	var granted []gorbac.Permission
+	switch OAuth2Scope(scope) {
+	case HouseholdMemberOAuth2Scope:
+		granted = householdMemberPermissions
+	case HouseholdAdminOAuth2Scope:
+		granted = slices.Concat(householdMemberPermissions, householdAdminPermissions)
+	case ServiceAdminOAuth2Scope:
+		granted = slices.Concat(householdMemberPermissions, householdAdminPermissions, serviceAdminPermissions)
+	default:
+		granted = oauth2ScopeDefinitions[OAuth2Scope(scope)].permissions
+	}
+
+	permissions := []Permission{}
+	for _, perm := range granted {
+		permissions = append(permissions, Permission(perm.ID()))
+	}
+	slices.Sort(permissions)
+
+	return slices.Compact(permissions)
+}
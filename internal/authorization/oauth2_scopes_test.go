@@ -0,0 +1,73 @@
+package authorization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuth2Scopes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("fine-grained scope", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewOAuth2ScopePermissionChecker(string(ReadRecipesOAuth2Scope))
+
+		assert.True(t, r.HasPermission(ReadRecipesPermission))
+		assert.False(t, r.HasPermission(CreateRecipesPermission))
+		assert.False(t, r.HasPermission(ReadWebhooksPermission))
+		assert.False(t, r.IsServiceAdmin())
+	})
+
+	T.Run("multiple scopes", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewOAuth2ScopePermissionChecker(ParseOAuth2Scopes("recipes:read webhooks:write")...)
+
+		assert.True(t, r.HasPermission(ReadRecipesPermission))
+		assert.True(t, r.HasPermission(CreateWebhooksPermission))
+		assert.False(t, r.HasPermission(CreateRecipesPermission))
+	})
+
+	T.Run("role scope", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewOAuth2ScopePermissionChecker(string(HouseholdAdminOAuth2Scope))
+
+		assert.True(t, r.HasPermission(UpdateHouseholdPermission))
+		assert.False(t, r.HasPermission(UpdateUserStatusPermission))
+		assert.False(t, r.IsServiceAdmin())
+	})
+
+	T.Run("service admin scope", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewOAuth2ScopePermissionChecker(string(ServiceAdminOAuth2Scope))
+
+		assert.True(t, r.HasPermission(UpdateUserStatusPermission))
+		assert.True(t, r.IsServiceAdmin())
+	})
+
+	T.Run("without scopes", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewOAuth2ScopePermissionChecker()
+
+		assert.False(t, r.HasPermission(ReadRecipesPermission))
+		assert.False(t, r.IsServiceAdmin())
+	})
+
+	T.Run("validity", func(t *testing.T) {
+		t.Parallel()
+
+		for _, scope := range AllOAuth2Scopes() {
+			assert.True(t, IsValidOAuth2Scope(scope), scope)
+			assert.NotEmpty(t, OAuth2ScopeDescription(scope), scope)
+			assert.NotEmpty(t, OAuth2ScopePermissions(scope), scope)
+		}
+
+		assert.False(t, IsValidOAuth2Scope("recipes:delete"))
+		assert.Empty(t, OAuth2ScopePermissions("recipes:delete"))
+	})
+}
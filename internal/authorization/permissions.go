@@ -16,10 +16,16 @@
 	CycleCookieSecretPermission Permission = "update.cookie_secret"
 	// UpdateUserStatusPermission is a service admin permission.
 	UpdateUserStatusPermission Permission = "update.user_status"
+	// ClearLoginLockoutsPermission is a service admin permission.
+	ClearLoginLockoutsPermission Permission = "clear.login_lockouts"
 	// ReadUserPermission is a service admin permission.
 	ReadUserPermission Permission = "read.user"
 	// SearchUserPermission is a service admin permission.
 	SearchUserPermission Permission = "search.user"
+	// ReadDeadLetteredMessagesPermission is a service admin permission.
+	ReadDeadLetteredMessagesPermission Permission = "read.dead_lettered_messages"
+	// ReplayDeadLetteredMessagesPermission is a service admin permission.
+	ReplayDeadLetteredMessagesPermission Permission = "replay.dead_lettered_messages"
 
 	// UpdateHouseholdPermission is a household admin permission.
 	UpdateHouseholdPermission Permission = "update.household"
@@ -145,6 +151,17 @@
 	// ArchiveValidIngredientPreparationsPermission is a household user permission.
 	ArchiveValidIngredientPreparationsPermission Permission = "archive.valid_ingredient_preparations"
 
+	// CreateValidIngredientSubstitutionsPermission is an admin user permission.
+	CreateValidIngredientSubstitutionsPermission Permission = "create.valid_ingredient_substitutions"
+	// ReadValidIngredientSubstitutionsPermission is a household user permission.
+	ReadValidIngredientSubstitutionsPermission Permission = "read.valid_ingredient_substitutions"
+	// SearchValidIngredientSubstitutionsPermission is a household user permission.
+	SearchValidIngredientSubstitutionsPermission Permission = "search.valid_ingredient_substitutions"
+	// UpdateValidIngredientSubstitutionsPermission is an admin user permission.
+	UpdateValidIngredientSubstitutionsPermission Permission = "update.valid_ingredient_substitutions"
+	// ArchiveValidIngredientSubstitutionsPermission is an admin user permission.
+	ArchiveValidIngredientSubstitutionsPermission Permission = "archive.valid_ingredient_substitutions"
+
 	// CreateValidIngredientStateIngredientsPermission is a household user permission.
 	CreateValidIngredientStateIngredientsPermission Permission = "create.valid_ingredient_state_ingredients"
 	// ReadValidIngredientStateIngredientsPermission is a household user permission.
@@ -400,6 +417,24 @@
 	ReadUserNotificationsPermission Permission = "read.user_notifications"
 	// UpdateUserNotificationsPermission is a household user permission.
 	UpdateUserNotificationsPermission Permission = "update.user_notifications"
+
+	// CreateCookingSessionsPermission is a household user permission.
+	CreateCookingSessionsPermission Permission = "create.cooking_sessions"
+	// ReadCookingSessionsPermission is a household user permission.
+	ReadCookingSessionsPermission Permission = "read.cooking_sessions"
+	// UpdateCookingSessionsPermission is a household user permission.
+	UpdateCookingSessionsPermission Permission = "update.cooking_sessions"
+	// ArchiveCookingSessionsPermission is a household user permission.
+	ArchiveCookingSessionsPermission Permission = "archive.cooking_sessions"
+
+	// CreatePantryItemsPermission is a household user permission.
+	CreatePantryItemsPermission Permission = "create.pantry_items"
+	// ReadPantryItemsPermission is a household user permission.
+	ReadPantryItemsPermission Permission = "read.pantry_items"
+	// UpdatePantryItemsPermission is a household user permission.
+	UpdatePantryItemsPermission Permission = "update.pantry_items"
+	// ArchivePantryItemsPermission is a household user permission.
+	ArchivePantryItemsPermission Permission = "archive.pantry_items"
 )
 
 // ID implements the gorbac Permission interface.
@@ -417,8 +452,11 @@ func (p Permission) Match(perm gorbac.Permission) bool {
 	serviceAdminPermissions = []gorbac.Permission{
 		CycleCookieSecretPermission,
 		UpdateUserStatusPermission,
+		ClearLoginLockoutsPermission,
 		ReadUserPermission,
 		SearchUserPermission,
+		ReadDeadLetteredMessagesPermission,
+		ReplayDeadLetteredMessagesPermission,
 		CreateOAuth2ClientsPermission,
 		ArchiveOAuth2ClientsPermission,
 		ArchiveServiceSettingsPermission,
@@ -447,6 +485,9 @@ func (p Permission) Match(perm gorbac.Permission) bool {
 		CreateValidIngredientPreparationsPermission,
 		UpdateValidIngredientPreparationsPermission,
 		ArchiveValidIngredientPreparationsPermission,
+		CreateValidIngredientSubstitutionsPermission,
+		UpdateValidIngredientSubstitutionsPermission,
+		ArchiveValidIngredientSubstitutionsPermission,
 		CreateValidIngredientStateIngredientsPermission,
 		UpdateValidIngredientStateIngredientsPermission,
 		ArchiveValidIngredientStateIngredientsPermission,
@@ -560,6 +601,8 @@ func (p Permission) Match(perm gorbac.Permission) bool {
 		ReadValidMeasurementUnitConversionsPermission,
 		ReadValidIngredientPreparationsPermission,
 		SearchValidIngredientPreparationsPermission,
+		ReadValidIngredientSubstitutionsPermission,
+		SearchValidIngredientSubstitutionsPermission,
 		ReadValidIngredientStateIngredientsPermission,
 		SearchValidIngredientStateIngredientsPermission,
 		ReadValidPreparationInstrumentsPermission,
@@ -599,6 +642,14 @@ func (p Permission) Match(perm gorbac.Permission) bool {
 		ArchiveRecipeRatingsPermission,
 		ReadUserNotificationsPermission,
 		UpdateUserNotificationsPermission,
+		CreateCookingSessionsPermission,
+		ReadCookingSessionsPermission,
+		UpdateCookingSessionsPermission,
+		ArchiveCookingSessionsPermission,
+		CreatePantryItemsPermission,
+		ReadPantryItemsPermission,
+		UpdatePantryItemsPermission,
+		ArchivePantryItemsPermission,
 	}
 )
 
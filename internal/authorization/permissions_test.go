@@ -16,8 +16,11 @@ func TestPermissions(T *testing.T) {
 
 		assert.False(t, permissionChecker.HasPermission(CycleCookieSecretPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateUserStatusPermission))
+		assert.False(t, permissionChecker.HasPermission(ClearLoginLockoutsPermission))
 		assert.False(t, permissionChecker.HasPermission(ReadUserPermission))
 		assert.False(t, permissionChecker.HasPermission(SearchUserPermission))
+		assert.False(t, permissionChecker.HasPermission(ReadDeadLetteredMessagesPermission))
+		assert.False(t, permissionChecker.HasPermission(ReplayDeadLetteredMessagesPermission))
 		assert.True(t, permissionChecker.HasPermission(UpdateHouseholdPermission))
 		assert.True(t, permissionChecker.HasPermission(ArchiveHouseholdPermission))
 		assert.True(t, permissionChecker.HasPermission(InviteUserToHouseholdPermission))
@@ -61,6 +64,11 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(SearchValidIngredientPreparationsPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientPreparationsPermission))
 		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientPreparationsPermission))
+		assert.False(t, permissionChecker.HasPermission(CreateValidIngredientSubstitutionsPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadValidIngredientSubstitutionsPermission))
+		assert.True(t, permissionChecker.HasPermission(SearchValidIngredientSubstitutionsPermission))
+		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientSubstitutionsPermission))
+		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientSubstitutionsPermission))
 		assert.False(t, permissionChecker.HasPermission(CreateValidPreparationInstrumentsPermission))
 		assert.True(t, permissionChecker.HasPermission(ReadValidPreparationInstrumentsPermission))
 		assert.True(t, permissionChecker.HasPermission(SearchValidPreparationInstrumentsPermission))
@@ -144,8 +152,11 @@ func TestPermissions(T *testing.T) {
 
 		assert.False(t, permissionChecker.HasPermission(CycleCookieSecretPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateUserStatusPermission))
+		assert.False(t, permissionChecker.HasPermission(ClearLoginLockoutsPermission))
 		assert.False(t, permissionChecker.HasPermission(ReadUserPermission))
 		assert.False(t, permissionChecker.HasPermission(SearchUserPermission))
+		assert.False(t, permissionChecker.HasPermission(ReadDeadLetteredMessagesPermission))
+		assert.False(t, permissionChecker.HasPermission(ReplayDeadLetteredMessagesPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateHouseholdPermission))
 		assert.False(t, permissionChecker.HasPermission(ArchiveHouseholdPermission))
 		assert.False(t, permissionChecker.HasPermission(InviteUserToHouseholdPermission))
@@ -189,6 +200,11 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(SearchValidIngredientPreparationsPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientPreparationsPermission))
 		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientPreparationsPermission))
+		assert.False(t, permissionChecker.HasPermission(CreateValidIngredientSubstitutionsPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadValidIngredientSubstitutionsPermission))
+		assert.True(t, permissionChecker.HasPermission(SearchValidIngredientSubstitutionsPermission))
+		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientSubstitutionsPermission))
+		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientSubstitutionsPermission))
 		assert.False(t, permissionChecker.HasPermission(CreateValidPreparationInstrumentsPermission))
 		assert.True(t, permissionChecker.HasPermission(ReadValidPreparationInstrumentsPermission))
 		assert.True(t, permissionChecker.HasPermission(SearchValidPreparationInstrumentsPermission))
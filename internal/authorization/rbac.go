@@ -30,6 +30,10 @@ func initializeRBAC() *gorbac.RBAC {
 	must(rbac.Add(householdAdmin))
 	must(rbac.Add(householdMember))
 
+	for _, scope := range oauth2ScopeRoles {
+		must(rbac.Add(scope))
+	}
+
 	must(rbac.SetParent(householdAdminRoleName, householdMemberRoleName))
 	must(rbac.SetParent(serviceAdminRoleName, householdAdminRoleName))
 
@@ -39,6 +39,7 @@ func init() {
 		IsServiceAdmin() bool
 		CanCycleCookieSecrets() bool
 		CanUpdateUserAccountStatuses() bool
+		CanClearLoginLockouts() bool
 	}
 
 	serviceRoleCollection struct {
@@ -95,3 +96,8 @@ func (r serviceRoleCollection) CanCycleCookieSecrets() bool {
 func (r serviceRoleCollection) CanUpdateUserAccountStatuses() bool {
 	return hasPermission(UpdateUserStatusPermission, r.Roles...)
 }
+
+// CanClearLoginLockouts returns whether a user can clear login lockouts or not.
+func (r serviceRoleCollection) CanClearLoginLockouts() bool {
+	return hasPermission(ClearLoginLockoutsPermission, r.Roles...)
+}
@@ -13,13 +13,16 @@
 
 type (
 	Cacheable interface {
-		types.SessionContextData
+		types.SessionContextData | types.LoginAttemptRecord
 	}
 
 	// Cache is our wrapper interface for a cache.
 	Cache[T Cacheable] interface {
 		Get(ctx context.Context, key string) (*T, error)
 		Set(ctx context.Context, key string, value *T) error
+		// Update atomically replaces the value at a key with what update returns when given the current value, or nil
+		// if there isn't one. update may be called more than once if the value changes underneath it.
+		Update(ctx context.Context, key string, update func(*T) (*T, error)) error
 		Delete(ctx context.Context, key string) error
 	}
 )
@@ -18,6 +18,9 @@
 	ProviderMemory = "memory"
 	// ProviderRedis is the redis provider.
 	ProviderRedis = "redis"
+
+	// expiration is how long cached values live for, whichever provider holds them.
+	expiration = time.Hour
 )
 
 type (
@@ -44,9 +47,9 @@ func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 func ProvideCache[T cache.Cacheable](cfg *Config) (cache.Cache[T], error) {
 	switch strings.TrimSpace(strings.ToLower(cfg.Provider)) {
 	case ProviderMemory:
-		return memory.NewInMemoryCache[T](), nil
+		return memory.NewInMemoryCache[T](expiration), nil
 	case ProviderRedis:
-		return redis.NewRedisCache[T](cfg.Redis, time.Hour), nil
+		return redis.NewRedisCache[T](cfg.Redis, expiration), nil
 	default:
 		return nil, fmt.Errorf("invalid cache provider: %q", cfg.Provider)
 	}
@@ -3,28 +3,46 @@
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/cache"
 )
 
-type inMemoryCacheImpl[T cache.Cacheable] struct {
-	cache    map[string]*T
-	cacheHat sync.RWMutex
-}
+type (
+	entry[T cache.Cacheable] struct {
+		expiresAt time.Time
+		value     *T
+	}
+
+	inMemoryCacheImpl[T cache.Cacheable] struct {
+		cache      map[string]*entry[T]
+		now        func() time.Time
+		lastPruned time.Time
+		expiration time.Duration
+		cacheHat   sync.RWMutex
+	}
+)
 
-// NewInMemoryCache builds an in-memory cache.
-func NewInMemoryCache[T cache.Cacheable]() cache.Cache[T] {
+// NewInMemoryCache builds an in-memory cache whose entries expire after the given duration, or never if it's zero.
+func NewInMemoryCache[T cache.Cacheable](expiration time.Duration) cache.Cache[T] {
 	return &inMemoryCacheImpl[T]{
-		cache: make(map[string]*T),
+		cache:      make(map[string]*entry[T]),
+		now:        time.Now,
+		lastPruned: time.Now(),
+		expiration: expiration,
 	}
 }
 
+func (e *entry[T]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
 func (i *inMemoryCacheImpl[T]) Get(_ context.Context, key string) (*T, error) {
 	i.cacheHat.RLock()
 	defer i.cacheHat.RUnlock()
 
-	if val, ok := i.cache[key]; ok {
-		return val, nil
+	if e, ok := i.cache[key]; ok && !e.expired(i.now()) {
+		return e.value, nil
 	}
 
 	return nil, cache.ErrNotFound
@@ -34,7 +52,26 @@ func (i *inMemoryCacheImpl[T]) Set(_ context.Context, key string, value *T) erro
 	i.cacheHat.Lock()
 	defer i.cacheHat.Unlock()
 
-	i.cache[key] = value
+	i.set(key, value)
+
+	return nil
+}
+
+func (i *inMemoryCacheImpl[T]) Update(_ context.Context, key string, update func(*T) (*T, error)) error {
+	i.cacheHat.Lock()
+	defer i.cacheHat.Unlock()
+
+	var current *T
+	if e, ok := i.cache[key]; ok && !e.expired(i.now()) {
+		current = e.value
+	}
+
+	updated, err := update(current)
+	if err != nil {
+		return err
+	}
+
+	i.set(key, updated)
 
 	return nil
 }
@@ -47,3 +84,25 @@ func (i *inMemoryCacheImpl[T]) Delete(_ context.Context, key string) error {
 
 	return nil
 }
+
+// set stores a value, and drops expired entries at most once per expiration period so keys that are never read
+// again don't pile up. The caller must hold the write lock.
+func (i *inMemoryCacheImpl[T]) set(key string, value *T) {
+	now := i.now()
+
+	e := &entry[T]{value: value}
+	if i.expiration > 0 {
+		e.expiresAt = now.Add(i.expiration)
+
+		if now.Sub(i.lastPruned) >= i.expiration {
+			for k, v := range i.cache {
+				if v.expired(now) {
+					delete(i.cache, k)
+				}
+			}
+			i.lastPruned = now
+		}
+	}
+
+	i.cache[key] = e
+}
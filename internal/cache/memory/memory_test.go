@@ -2,12 +2,17 @@
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/cache"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -20,7 +25,7 @@ func Test_newInMemoryCache(T *testing.T) {
 	T.Run("standard", func(t *testing.T) {
 		t.Parallel()
 
-		actual := NewInMemoryCache[types.SessionContextData]()
+		actual := NewInMemoryCache[types.SessionContextData](0)
 		assert.NotNil(t, actual)
 	})
 }
@@ -32,7 +37,7 @@ func Test_inMemoryCacheImpl_Get(T *testing.T) {
 		t.Parallel()
 
 		ctx := context.Background()
-		c := NewInMemoryCache[types.SessionContextData]()
+		c := NewInMemoryCache[types.SessionContextData](0)
 
 		expected := fakes.BuildFakeSessionContextData()
 		assert.NoError(t, c.Set(ctx, exampleKey, expected))
@@ -50,7 +55,7 @@ func Test_inMemoryCacheImpl_Set(T *testing.T) {
 		t.Parallel()
 
 		ctx := context.Background()
-		c := NewInMemoryCache[types.SessionContextData]()
+		c := NewInMemoryCache[types.SessionContextData](0)
 
 		assert.Len(t, c.(*inMemoryCacheImpl[types.SessionContextData]).cache, 0)
 		assert.NoError(t, c.Set(ctx, exampleKey, fakes.BuildFakeSessionContextData()))
@@ -65,7 +70,7 @@ func Test_inMemoryCacheImpl_Delete(T *testing.T) {
 		t.Parallel()
 
 		ctx := context.Background()
-		c := NewInMemoryCache[types.SessionContextData]()
+		c := NewInMemoryCache[types.SessionContextData](0)
 
 		assert.Len(t, c.(*inMemoryCacheImpl[types.SessionContextData]).cache, 0)
 		assert.NoError(t, c.Set(ctx, exampleKey, fakes.BuildFakeSessionContextData()))
@@ -74,3 +79,75 @@ func Test_inMemoryCacheImpl_Delete(T *testing.T) {
 		assert.Len(t, c.(*inMemoryCacheImpl[types.SessionContextData]).cache, 0)
 	})
 }
+
+func Test_inMemoryCacheImpl_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c := NewInMemoryCache[types.LoginAttemptRecord](0)
+
+		increment := func(current *types.LoginAttemptRecord) (*types.LoginAttemptRecord, error) {
+			if current == nil {
+				return &types.LoginAttemptRecord{Lockouts: 1}, nil
+			}
+
+			return &types.LoginAttemptRecord{Lockouts: current.Lockouts + 1}, nil
+		}
+
+		var wg sync.WaitGroup
+		for range 100 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, c.Update(ctx, exampleKey, increment))
+			}()
+		}
+		wg.Wait()
+
+		actual, err := c.Get(ctx, exampleKey)
+		require.NoError(t, err)
+		assert.Equal(t, uint(100), actual.Lockouts)
+	})
+
+	T.Run("with error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c := NewInMemoryCache[types.LoginAttemptRecord](0)
+
+		assert.Error(t, c.Update(ctx, exampleKey, func(*types.LoginAttemptRecord) (*types.LoginAttemptRecord, error) {
+			return nil, errors.New("blah")
+		}))
+
+		_, err := c.Get(ctx, exampleKey)
+		assert.ErrorIs(t, err, cache.ErrNotFound)
+	})
+}
+
+func Test_inMemoryCacheImpl_expiration(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c := NewInMemoryCache[types.SessionContextData](time.Hour).(*inMemoryCacheImpl[types.SessionContextData])
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		assert.NoError(t, c.Set(ctx, exampleKey, fakes.BuildFakeSessionContextData()))
+		_, err := c.Get(ctx, exampleKey)
+		assert.NoError(t, err)
+
+		now = now.Add(time.Hour)
+		_, err = c.Get(ctx, exampleKey)
+		assert.ErrorIs(t, err, cache.ErrNotFound)
+
+		// expired entries are dropped the next time anything is written.
+		assert.NoError(t, c.Set(ctx, "other", fakes.BuildFakeSessionContextData()))
+		assert.Len(t, c.cache, 1)
+	})
+}
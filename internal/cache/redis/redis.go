@@ -4,6 +4,7 @@
 	"bytes"
 	"context"
 	"encoding/gob"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -17,8 +18,18 @@ type redisClient interface {
 	Get(ctx context.Context, key string) *redis.StringCmd
 	Set(ctx context.Context, key string, value any, expiration time.Duration) *redis.StatusCmd
 	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Watch(ctx context.Context, fn func(*redis.Tx) error, keys ...string) error
 }
 
+const (
+	// maxUpdateAttempts bounds how many times Update retries when the value changes underneath it.
+	maxUpdateAttempts = 10
+)
+
+var (
+	errUpdateContention = errors.New("value changed too many times during update")
+)
+
 type redisCacheImpl[T cache.Cacheable] struct {
 	client     redisClient
 	expiration time.Duration
@@ -32,9 +43,12 @@ func NewRedisCache[T cache.Cacheable](cfg *Config, expiration time.Duration) cac
 	}
 }
 
-func (i *redisCacheImpl[T]) Get(ctx context.Context, key string) (*T, error) {
-	res, err := i.client.Get(ctx, key).Result()
+func decode[T cache.Cacheable](res string, err error) (*T, error) {
 	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, cache.ErrNotFound
+		}
+
 		return nil, err
 	}
 
@@ -52,19 +66,67 @@ func (i *redisCacheImpl[T]) Get(ctx context.Context, key string) (*T, error) {
 	return x, nil
 }
 
-func (i *redisCacheImpl[T]) Set(ctx context.Context, key string, value *T) error {
+func encode[T cache.Cacheable](value *T) (string, error) {
 	var b bytes.Buffer
 	if err := gob.NewEncoder(&b).Encode(value); err != nil {
-		return fmt.Errorf("encoding for cache: %w", err)
+		return "", fmt.Errorf("encoding for cache: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+func (i *redisCacheImpl[T]) Get(ctx context.Context, key string) (*T, error) {
+	return decode[T](i.client.Get(ctx, key).Result())
+}
+
+func (i *redisCacheImpl[T]) Set(ctx context.Context, key string, value *T) error {
+	encoded, err := encode(value)
+	if err != nil {
+		return err
 	}
 
-	if setErr := i.client.Set(ctx, key, b.String(), i.expiration).Err(); setErr != nil {
+	if setErr := i.client.Set(ctx, key, encoded, i.expiration).Err(); setErr != nil {
 		return setErr
 	}
 
 	return nil
 }
 
+// Update watches the key so that the write only goes through if nobody else wrote it first, and retries otherwise.
+func (i *redisCacheImpl[T]) Update(ctx context.Context, key string, update func(*T) (*T, error)) error {
+	txf := func(tx *redis.Tx) error {
+		current, err := decode[T](tx.Get(ctx, key).Result())
+		if err != nil && !errors.Is(err, cache.ErrNotFound) {
+			return err
+		}
+
+		updated, err := update(current)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := encode(updated)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, encoded, i.expiration)
+			return nil
+		})
+
+		return err
+	}
+
+	for range maxUpdateAttempts {
+		if err := i.client.Watch(ctx, txf, key); !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+
+	return errUpdateContention
+}
+
 func (i *redisCacheImpl[T]) Delete(ctx context.Context, key string) error {
 	return i.client.Del(ctx, key).Err()
 }
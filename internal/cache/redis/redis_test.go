@@ -103,3 +103,34 @@ func Test_redisCacheImpl_Delete(T *testing.T) {
 		assert.NoError(t, c.Delete(ctx, exampleKey))
 	})
 }
+
+func Test_redisCacheImpl_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		cfg, containerShutdown := buildContainerBackedRedisConfig(t, ctx)
+		defer func() {
+			assert.NoError(t, containerShutdown(ctx))
+		}()
+		c := NewRedisCache[types.LoginAttemptRecord](cfg, 0)
+
+		increment := func(current *types.LoginAttemptRecord) (*types.LoginAttemptRecord, error) {
+			if current == nil {
+				return &types.LoginAttemptRecord{Lockouts: 1}, nil
+			}
+
+			return &types.LoginAttemptRecord{Lockouts: current.Lockouts + 1}, nil
+		}
+
+		assert.NoError(t, c.Update(ctx, exampleKey, increment))
+		assert.NoError(t, c.Update(ctx, exampleKey, increment))
+
+		actual, err := c.Get(ctx, exampleKey)
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), actual.Lockouts)
+	})
+}
@@ -43,6 +43,10 @@ func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 
 // ProvideCapitalismImplementation provides a capitalism.PaymentManager implementation based on the config.
 func ProvideCapitalismImplementation(logger logging.Logger, tracerProvider tracing.TracerProvider, cfg *Config) (capitalism.PaymentManager, error) {
+	if !cfg.Enabled {
+		return &capitalism.NoopPaymentManager{}, nil
+	}
+
 	switch strings.TrimSpace(strings.ToLower(cfg.Provider)) {
 	case StripeProvider:
 		return stripe.ProvideStripePaymentManager(logger, tracerProvider, cfg.Stripe), nil
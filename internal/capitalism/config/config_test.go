@@ -4,7 +4,10 @@
 	"context"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/capitalism"
 	"github.com/dinnerdonebetter/backend/internal/capitalism/stripe"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -19,7 +22,12 @@ func TestConfig_ValidateWithContext(T *testing.T) {
 		cfg := &Config{
 			Enabled:  true,
 			Provider: StripeProvider,
-			Stripe:   &stripe.Config{APIKey: t.Name()},
+			Stripe: &stripe.Config{
+				APIKey:             t.Name(),
+				CheckoutSuccessURL: "https://dinnerdonebetter.dev/billing/success",
+				CheckoutCancelURL:  "https://dinnerdonebetter.dev/billing/canceled",
+				PortalReturnURL:    "https://dinnerdonebetter.dev/household",
+			},
 		}
 
 		assert.NoError(t, cfg.ValidateWithContext(ctx))
@@ -48,3 +56,42 @@ func TestConfig_ValidateWithContext(T *testing.T) {
 		assert.Error(t, cfg.ValidateWithContext(ctx))
 	})
 }
+
+func TestProvideCapitalismImplementation(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Enabled:  true,
+			Provider: StripeProvider,
+			Stripe:   &stripe.Config{APIKey: t.Name()},
+		}
+
+		pm, err := ProvideCapitalismImplementation(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg)
+		assert.NoError(t, err)
+		assert.NotNil(t, pm)
+	})
+
+	T.Run("when not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		pm, err := ProvideCapitalismImplementation(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{})
+		assert.NoError(t, err)
+		assert.IsType(t, &capitalism.NoopPaymentManager{}, pm)
+	})
+
+	T.Run("with unknown provider", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Enabled:  true,
+			Provider: t.Name(),
+		}
+
+		pm, err := ProvideCapitalismImplementation(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg)
+		assert.Error(t, err)
+		assert.Nil(t, pm)
+	})
+}
@@ -1,9 +1,11 @@
 package capitalismmock
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/dinnerdonebetter/backend/internal/capitalism"
+	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	"github.com/stretchr/testify/mock"
 )
@@ -20,7 +22,20 @@ func NewMockPaymentManager() *MockPaymentManager {
 	return &MockPaymentManager{}
 }
 
+// CreateCheckoutSession satisfies our interface contract.
+func (m *MockPaymentManager) CreateCheckoutSession(ctx context.Context, household *types.Household, subscriptionPlanID string) (*types.PaymentSession, error) {
+	returnValues := m.Called(ctx, household, subscriptionPlanID)
+	return returnValues.Get(0).(*types.PaymentSession), returnValues.Error(1)
+}
+
+// CreateCustomerPortalSession satisfies our interface contract.
+func (m *MockPaymentManager) CreateCustomerPortalSession(ctx context.Context, household *types.Household) (*types.PaymentSession, error) {
+	returnValues := m.Called(ctx, household)
+	return returnValues.Get(0).(*types.PaymentSession), returnValues.Error(1)
+}
+
 // HandleEventWebhook satisfies our interface contract.
-func (m *MockPaymentManager) HandleEventWebhook(req *http.Request) error {
-	return m.Called(req).Error(0)
+func (m *MockPaymentManager) HandleEventWebhook(req *http.Request) (*types.HouseholdBillingStatusUpdateInput, error) {
+	returnValues := m.Called(req)
+	return returnValues.Get(0).(*types.HouseholdBillingStatusUpdateInput), returnValues.Error(1)
 }
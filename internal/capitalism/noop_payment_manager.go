@@ -1,7 +1,10 @@
 package capitalism
 
 import (
+	"context"
 	"net/http"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
 )
 
 var _ PaymentManager = (*NoopPaymentManager)(nil)
@@ -9,7 +12,17 @@
 // NoopPaymentManager is a no-op payment manager.
 type NoopPaymentManager struct{}
 
+// CreateCheckoutSession satisfies our interface.
+func (n *NoopPaymentManager) CreateCheckoutSession(_ context.Context, _ *types.Household, _ string) (*types.PaymentSession, error) {
+	return nil, ErrPaymentsDisabled
+}
+
+// CreateCustomerPortalSession satisfies our interface.
+func (n *NoopPaymentManager) CreateCustomerPortalSession(_ context.Context, _ *types.Household) (*types.PaymentSession, error) {
+	return nil, ErrPaymentsDisabled
+}
+
 // HandleEventWebhook satisfies our interface.
-func (n *NoopPaymentManager) HandleEventWebhook(_ *http.Request) error {
-	return nil
+func (n *NoopPaymentManager) HandleEventWebhook(_ *http.Request) (*types.HouseholdBillingStatusUpdateInput, error) {
+	return nil, nil
 }
@@ -1,7 +1,18 @@
 package capitalism
 
 import (
+	"context"
+	"errors"
 	"net/http"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	// ErrPaymentsDisabled indicates no payment processor is configured.
+	ErrPaymentsDisabled = errors.New("payments are disabled")
+	// ErrNoPaymentProcessorCustomer indicates a household has never checked out, and so has nothing to manage.
+	ErrNoPaymentProcessorCustomer = errors.New("household has no payment processor customer")
 )
 
 type (
@@ -14,6 +25,10 @@
 
 	// PaymentManager handles payments via 3rd-party providers.
 	PaymentManager interface {
-		HandleEventWebhook(req *http.Request) error
+		CreateCheckoutSession(ctx context.Context, household *types.Household, subscriptionPlanID string) (*types.PaymentSession, error)
+		CreateCustomerPortalSession(ctx context.Context, household *types.Household) (*types.PaymentSession, error)
+		// HandleEventWebhook verifies and interprets an incoming event, returning the billing status change it
+		// describes, or nil if the event doesn't concern a household's subscription.
+		HandleEventWebhook(req *http.Request) (*types.HouseholdBillingStatusUpdateInput, error)
 	}
 )
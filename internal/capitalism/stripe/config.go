@@ -4,13 +4,17 @@
 	"context"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
 type (
 	// Config configures our Stripe interface.
 	Config struct {
-		APIKey        string `json:"apiKey"        toml:"api_key"`
-		WebhookSecret string `json:"webhookSecret" toml:"webhook_secret"`
+		APIKey             string `json:"apiKey"             toml:"api_key"`
+		WebhookSecret      string `json:"webhookSecret"      toml:"webhook_secret"`
+		CheckoutSuccessURL string `json:"checkoutSuccessURL" toml:"checkout_success_url"`
+		CheckoutCancelURL  string `json:"checkoutCancelURL"  toml:"checkout_cancel_url"`
+		PortalReturnURL    string `json:"portalReturnURL"    toml:"portal_return_url"`
 	}
 )
 
@@ -20,5 +24,8 @@
 func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 	return validation.ValidateStructWithContext(ctx, cfg,
 		validation.Field(&cfg.APIKey, validation.Required),
+		validation.Field(&cfg.CheckoutSuccessURL, validation.Required, is.URL),
+		validation.Field(&cfg.CheckoutCancelURL, validation.Required, is.URL),
+		validation.Field(&cfg.PortalReturnURL, validation.Required, is.URL),
 	)
 }
@@ -15,7 +15,10 @@ func TestStripeConfig_ValidateWithContext(T *testing.T) {
 
 		ctx := context.Background()
 		cfg := &Config{
-			APIKey: "blah",
+			APIKey:             "blah",
+			CheckoutSuccessURL: "https://dinnerdonebetter.dev/billing/success",
+			CheckoutCancelURL:  "https://dinnerdonebetter.dev/billing/canceled",
+			PortalReturnURL:    "https://dinnerdonebetter.dev/household",
 		}
 
 		assert.NoError(t, cfg.ValidateWithContext(ctx))
@@ -31,4 +34,18 @@ func TestStripeConfig_ValidateWithContext(T *testing.T) {
 
 		assert.Error(t, cfg.ValidateWithContext(ctx))
 	})
+
+	T.Run("with invalid redirect URL", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := &Config{
+			APIKey:             "blah",
+			CheckoutSuccessURL: "not a URL",
+			CheckoutCancelURL:  "https://dinnerdonebetter.dev/billing/canceled",
+			PortalReturnURL:    "https://dinnerdonebetter.dev/household",
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
 }
@@ -1,14 +1,19 @@
 package stripe
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/capitalism"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	"github.com/stripe/stripe-go/v75"
 	"github.com/stripe/stripe-go/v75/client"
@@ -18,6 +23,10 @@
 const (
 	stripeSignatureHeaderKey = "Stripe-Signature"
 	implementationName       = "stripe_payment_manager"
+
+	// householdIDMetadataKey is the subscription metadata key we stash household IDs under,
+	// so that subscription events can be traced back to the household that checked out.
+	householdIDMetadataKey = "household_id"
 )
 
 var _ capitalism.PaymentManager = (*stripePaymentManager)(nil)
@@ -29,11 +38,14 @@
 	APIKey string
 
 	stripePaymentManager struct {
-		logger         logging.Logger
-		tracer         tracing.Tracer
-		client         *client.API
-		encoderDecoder encoding.ServerEncoderDecoder
-		webhookSecret  string
+		logger             logging.Logger
+		tracer             tracing.Tracer
+		client             *client.API
+		encoderDecoder     encoding.ServerEncoderDecoder
+		webhookSecret      string
+		checkoutSuccessURL string
+		checkoutCancelURL  string
+		portalReturnURL    string
 	}
 )
 
@@ -44,15 +56,86 @@ func ProvideStripePaymentManager(logger logging.Logger, tracerProvider tracing.T
 	}
 
 	return &stripePaymentManager{
-		client:         client.New(cfg.APIKey, nil),
-		webhookSecret:  cfg.WebhookSecret,
-		encoderDecoder: encoding.ProvideServerEncoderDecoder(logger, tracerProvider, encoding.ContentTypeJSON),
-		logger:         logging.EnsureLogger(logger),
-		tracer:         tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(implementationName)),
+		client:             client.New(cfg.APIKey, nil),
+		webhookSecret:      cfg.WebhookSecret,
+		checkoutSuccessURL: cfg.CheckoutSuccessURL,
+		checkoutCancelURL:  cfg.CheckoutCancelURL,
+		portalReturnURL:    cfg.PortalReturnURL,
+		encoderDecoder:     encoding.ProvideServerEncoderDecoder(logger, tracerProvider, encoding.ContentTypeJSON),
+		logger:             logging.EnsureLogger(logger),
+		tracer:             tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(implementationName)),
+	}
+}
+
+// CreateCheckoutSession creates a hosted checkout page that subscribes a household to a plan.
+func (s *stripePaymentManager) CreateCheckoutSession(ctx context.Context, household *types.Household, subscriptionPlanID string) (*types.PaymentSession, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(keys.HouseholdIDKey, household.ID)
+
+	params := &stripe.CheckoutSessionParams{
+		Mode:              stripe.String(string(stripe.CheckoutSessionModeSubscription)),
+		ClientReferenceID: stripe.String(household.ID),
+		SuccessURL:        stripe.String(s.checkoutSuccessURL),
+		CancelURL:         stripe.String(s.checkoutCancelURL),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				Price:    stripe.String(subscriptionPlanID),
+				Quantity: stripe.Int64(1),
+			},
+		},
+		SubscriptionData: &stripe.CheckoutSessionSubscriptionDataParams{
+			Metadata: map[string]string{householdIDMetadataKey: household.ID},
+		},
+	}
+	params.Context = ctx
+
+	// returning customers keep their saved payment methods.
+	if household.PaymentProcessorCustomerID != "" {
+		params.Customer = stripe.String(household.PaymentProcessorCustomerID)
+	}
+
+	session, err := s.client.CheckoutSessions.New(params)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating checkout session")
+	}
+
+	return &types.PaymentSession{
+		ID:  session.ID,
+		URL: session.URL,
+	}, nil
+}
+
+// CreateCustomerPortalSession creates a hosted page where a household can manage its existing subscription.
+func (s *stripePaymentManager) CreateCustomerPortalSession(ctx context.Context, household *types.Household) (*types.PaymentSession, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if household.PaymentProcessorCustomerID == "" {
+		return nil, capitalism.ErrNoPaymentProcessorCustomer
+	}
+
+	logger := s.logger.WithSpan(span).WithValue(keys.HouseholdIDKey, household.ID)
+
+	params := &stripe.BillingPortalSessionParams{
+		Customer:  stripe.String(household.PaymentProcessorCustomerID),
+		ReturnURL: stripe.String(s.portalReturnURL),
 	}
+	params.Context = ctx
+
+	session, err := s.client.BillingPortalSessions.New(params)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating customer portal session")
+	}
+
+	return &types.PaymentSession{
+		ID:  session.ID,
+		URL: session.URL,
+	}, nil
 }
 
-func (s *stripePaymentManager) HandleEventWebhook(req *http.Request) error {
+func (s *stripePaymentManager) HandleEventWebhook(req *http.Request) (*types.HouseholdBillingStatusUpdateInput, error) {
 	_, span := s.tracer.StartSpan(req.Context())
 	defer span.End()
 
@@ -60,24 +143,119 @@ func (s *stripePaymentManager) HandleEventWebhook(req *http.Request) error {
 
 	payload, err := io.ReadAll(req.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	signatureHeader := req.Header.Get(stripeSignatureHeaderKey)
 	event, err := webhook.ConstructEvent(payload, signatureHeader, s.webhookSecret)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	logger = logger.WithValue("event_type", event.Type)
+
+	var update *types.HouseholdBillingStatusUpdateInput
 	switch event.Type {
+	case stripe.EventTypeCustomerSubscriptionCreated,
+		stripe.EventTypeCustomerSubscriptionUpdated,
+		stripe.EventTypeCustomerSubscriptionDeleted:
+		var subscription stripe.Subscription
+		if marshallErr := json.Unmarshal(event.Data.Raw, &subscription); marshallErr != nil {
+			return nil, marshallErr
+		}
+
+		update = buildUpdateForSubscription(&subscription, event.Type == stripe.EventTypeCustomerSubscriptionDeleted)
+	case stripe.EventTypeInvoicePaymentFailed:
+		var invoice stripe.Invoice
+		if marshallErr := json.Unmarshal(event.Data.Raw, &invoice); marshallErr != nil {
+			return nil, marshallErr
+		}
+
+		update = buildUpdateForFailedInvoice(&invoice)
 	case stripe.EventTypePaymentIntentSucceeded:
 		var paymentIntent stripe.PaymentIntent
 		if marshallErr := json.Unmarshal(event.Data.Raw, &paymentIntent); marshallErr != nil {
-			return marshallErr
+			return nil, marshallErr
 		}
 	default:
-		logger.WithValue("event_type", event.Type).Info("Unhandled event type")
+		logger.Info("Unhandled event type")
 	}
 
-	return nil
+	// subscriptions that weren't created through our checkout sessions can't be tied to a household,
+	// and erroring would only prompt Stripe to redeliver them.
+	if update != nil && update.HouseholdID == "" {
+		logger.Info("subscription event without household ID")
+		return nil, nil
+	}
+
+	// Stripe doesn't guarantee delivery order, so updates carry when the event happened rather than when it arrived.
+	if update != nil {
+		update.OccurredAt = time.Unix(event.Created, 0).UTC()
+	}
+
+	return update, nil
+}
+
+func buildUpdateForSubscription(subscription *stripe.Subscription, deleted bool) *types.HouseholdBillingStatusUpdateInput {
+	update := &types.HouseholdBillingStatusUpdateInput{
+		HouseholdID:   subscription.Metadata[householdIDMetadataKey],
+		BillingStatus: billingStatusForSubscriptionStatus(subscription.Status),
+	}
+
+	if subscription.Customer != nil {
+		update.PaymentProcessorCustomerID = subscription.Customer.ID
+	}
+
+	if deleted {
+		update.BillingStatus = types.UnpaidHouseholdBillingStatus
+		return update
+	}
+
+	if subscription.Items != nil {
+		for _, item := range subscription.Items.Data {
+			if item.Price != nil {
+				update.SubscriptionPlanID = &item.Price.ID
+				break
+			}
+		}
+	}
+
+	return update
+}
+
+func buildUpdateForFailedInvoice(invoice *stripe.Invoice) *types.HouseholdBillingStatusUpdateInput {
+	update := &types.HouseholdBillingStatusUpdateInput{
+		BillingStatus: types.PastDueHouseholdBillingStatus,
+	}
+
+	if invoice.SubscriptionDetails != nil {
+		update.HouseholdID = invoice.SubscriptionDetails.Metadata[householdIDMetadataKey]
+	}
+
+	if invoice.Customer != nil {
+		update.PaymentProcessorCustomerID = invoice.Customer.ID
+	}
+
+	if invoice.Lines != nil {
+		for _, line := range invoice.Lines.Data {
+			if line.Price != nil {
+				update.SubscriptionPlanID = &line.Price.ID
+				break
+			}
+		}
+	}
+
+	return update
+}
+
+// billingStatusForSubscriptionStatus collapses Stripe's subscription statuses into our household billing statuses.
+func billingStatusForSubscriptionStatus(status stripe.SubscriptionStatus) string {
+	switch status {
+	case stripe.SubscriptionStatusActive, stripe.SubscriptionStatusTrialing:
+		return types.PaidHouseholdBillingStatus
+	case stripe.SubscriptionStatusPastDue:
+		return types.PastDueHouseholdBillingStatus
+	default:
+		return types.UnpaidHouseholdBillingStatus
+	}
 }
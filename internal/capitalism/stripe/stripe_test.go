@@ -4,20 +4,88 @@
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/capitalism"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/internal/pkg/random"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v75"
+	"github.com/stripe/stripe-go/v75/client"
 	"github.com/stripe/stripe-go/v75/webhook"
 )
 
+// exampleEventCreatedAt is when the events built by buildSignedWebhookRequest claim to have happened.
+var exampleEventCreatedAt = time.Date(2024, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+func buildTestPaymentManager(t *testing.T) (*stripePaymentManager, *mockBackend) {
+	t.Helper()
+
+	pm := ProvideStripePaymentManager(nil, nil, &Config{
+		APIKey:             t.Name(),
+		CheckoutSuccessURL: "https://dinnerdonebetter.dev/billing/success",
+		CheckoutCancelURL:  "https://dinnerdonebetter.dev/billing/canceled",
+		PortalReturnURL:    "https://dinnerdonebetter.dev/household",
+	}).(*stripePaymentManager)
+
+	mb := &mockBackend{}
+	pm.client = client.New(t.Name(), &stripe.Backends{API: mb, Connect: mb, Uploads: mb})
+
+	return pm, mb
+}
+
+// buildSignedWebhookRequest builds a webhook request for an event wrapping the provided object, signed the way Stripe would.
+func buildSignedWebhookRequest(t *testing.T, pm *stripePaymentManager, eventType stripe.EventType, object any) *http.Request {
+	t.Helper()
+
+	ctx := context.Background()
+
+	rawMessage, err := json.Marshal(object)
+	require.NoError(t, err)
+	require.NotNil(t, rawMessage)
+
+	exampleInput := &stripe.Event{
+		APIVersion: "2023-08-16",
+		Created:    exampleEventCreatedAt.Unix(),
+		Data: &stripe.EventData{
+			Raw: json.RawMessage(rawMessage),
+		},
+		Type: eventType,
+	}
+	jsonBytes := pm.encoderDecoder.MustEncode(ctx, exampleInput)
+
+	secret, err := random.GenerateHexEncodedString(ctx, 32)
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+	pm.webhookSecret = secret
+
+	signedPayload := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload:   jsonBytes,
+		Secret:    secret,
+		Timestamp: time.Now(),
+	})
+
+	event, err := webhook.ConstructEvent(signedPayload.Payload, signedPayload.Header, signedPayload.Secret)
+	require.NoError(t, err)
+	eventPayload := pm.encoderDecoder.MustEncode(ctx, event)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(eventPayload))
+	require.NoError(t, err)
+	require.NotNil(t, req)
+	req.Header.Set(stripeSignatureHeaderKey, signedPayload.Header)
+
+	return req
+}
+
 func TestNewStripePaymentManager(T *testing.T) {
 	T.Parallel()
 
@@ -29,78 +97,317 @@ func TestNewStripePaymentManager(T *testing.T) {
 
 		assert.NotNil(t, pm)
 	})
+
+	T.Run("with nil config", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, nil)
+
+		assert.IsType(t, &capitalism.NoopPaymentManager{}, pm)
+	})
 }
 
-func Test_stripePaymentManager_HandleSubscriptionEventWebhook(T *testing.T) {
+func Test_stripePaymentManager_CreateCheckoutSession(T *testing.T) {
 	T.Parallel()
 
 	T.Run("standard", func(t *testing.T) {
 		t.Parallel()
 
 		ctx := context.Background()
-		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
-		pm.webhookSecret = "example_webhook_secret"
-
-		paymentIntent := &stripe.PaymentIntent{
-			APIResource:      stripe.APIResource{},
-			Amount:           0,
-			AmountCapturable: 0,
-			AmountDetails:    nil,
-			AmountReceived:   0,
-			Customer:         nil,
-			ID:               "",
-			Invoice:          nil,
-			Metadata:         nil,
-			PaymentMethod:    nil,
-			ReceiptEmail:     "",
-			Status:           "",
+		pm, mb := buildTestPaymentManager(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleHousehold.PaymentProcessorCustomerID = ""
+
+		expected := &types.PaymentSession{
+			ID:  fakes.BuildFakeID(),
+			URL: "https://checkout.stripe.com/c/pay/whatever",
 		}
 
-		rawMessage, err := json.Marshal(paymentIntent)
-		require.NoError(t, err)
-		require.NotNil(t, rawMessage)
-
-		exampleInput := &stripe.Event{
-			APIResource: stripe.APIResource{},
-			Account:     "",
-			APIVersion:  "2023-08-16",
-			Created:     0,
-			Data: &stripe.EventData{
-				Object:             nil,
-				PreviousAttributes: nil,
-				Raw:                json.RawMessage(rawMessage),
+		mb.AnticipateCall(t, &stripe.CheckoutSession{ID: expected.ID, URL: expected.URL})
+		mb.On(
+			"Call",
+			http.MethodPost,
+			"/v1/checkout/sessions",
+			t.Name(),
+			mock.MatchedBy(func(params *stripe.CheckoutSessionParams) bool {
+				return *params.ClientReferenceID == exampleHousehold.ID &&
+					*params.LineItems[0].Price == "price_premium" &&
+					params.SubscriptionData.Metadata[householdIDMetadataKey] == exampleHousehold.ID &&
+					params.Customer == nil
+			}),
+			mock.AnythingOfType("*stripe.CheckoutSession"),
+		).Return(nil)
+
+		actual, err := pm.CreateCheckoutSession(ctx, exampleHousehold, "price_premium")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, mb)
+	})
+
+	T.Run("with returning customer", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		pm, mb := buildTestPaymentManager(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleHousehold.PaymentProcessorCustomerID = "cus_whatever"
+
+		mb.AnticipateCall(t, &stripe.CheckoutSession{ID: fakes.BuildFakeID()})
+		mb.On(
+			"Call",
+			http.MethodPost,
+			"/v1/checkout/sessions",
+			t.Name(),
+			mock.MatchedBy(func(params *stripe.CheckoutSessionParams) bool {
+				return params.Customer != nil && *params.Customer == exampleHousehold.PaymentProcessorCustomerID
+			}),
+			mock.AnythingOfType("*stripe.CheckoutSession"),
+		).Return(nil)
+
+		actual, err := pm.CreateCheckoutSession(ctx, exampleHousehold, "price_premium")
+		assert.NoError(t, err)
+		assert.NotNil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mb)
+	})
+
+	T.Run("with error creating session", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		pm, mb := buildTestPaymentManager(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+
+		mb.AnticipateCall(t, &stripe.CheckoutSession{})
+		mb.On(
+			"Call",
+			http.MethodPost,
+			"/v1/checkout/sessions",
+			t.Name(),
+			mock.AnythingOfType("*stripe.CheckoutSessionParams"),
+			mock.AnythingOfType("*stripe.CheckoutSession"),
+		).Return(errors.New("blah"))
+
+		actual, err := pm.CreateCheckoutSession(ctx, exampleHousehold, "price_premium")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mb)
+	})
+}
+
+func Test_stripePaymentManager_CreateCustomerPortalSession(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		pm, mb := buildTestPaymentManager(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleHousehold.PaymentProcessorCustomerID = "cus_whatever"
+
+		expected := &types.PaymentSession{
+			ID:  fakes.BuildFakeID(),
+			URL: "https://billing.stripe.com/p/session/whatever",
+		}
+
+		mb.AnticipateCall(t, &stripe.BillingPortalSession{ID: expected.ID, URL: expected.URL})
+		mb.On(
+			"Call",
+			http.MethodPost,
+			"/v1/billing_portal/sessions",
+			t.Name(),
+			mock.MatchedBy(func(params *stripe.BillingPortalSessionParams) bool {
+				return *params.Customer == exampleHousehold.PaymentProcessorCustomerID
+			}),
+			mock.AnythingOfType("*stripe.BillingPortalSession"),
+		).Return(nil)
+
+		actual, err := pm.CreateCustomerPortalSession(ctx, exampleHousehold)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, mb)
+	})
+
+	T.Run("without customer", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		pm, mb := buildTestPaymentManager(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleHousehold.PaymentProcessorCustomerID = ""
+
+		actual, err := pm.CreateCustomerPortalSession(ctx, exampleHousehold)
+		assert.ErrorIs(t, err, capitalism.ErrNoPaymentProcessorCustomer)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mb)
+	})
+
+	T.Run("with error creating session", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		pm, mb := buildTestPaymentManager(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleHousehold.PaymentProcessorCustomerID = "cus_whatever"
+
+		mb.AnticipateCall(t, &stripe.BillingPortalSession{})
+		mb.On(
+			"Call",
+			http.MethodPost,
+			"/v1/billing_portal/sessions",
+			t.Name(),
+			mock.AnythingOfType("*stripe.BillingPortalSessionParams"),
+			mock.AnythingOfType("*stripe.BillingPortalSession"),
+		).Return(errors.New("blah"))
+
+		actual, err := pm.CreateCustomerPortalSession(ctx, exampleHousehold)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mb)
+	})
+}
+
+func Test_stripePaymentManager_HandleSubscriptionEventWebhook(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypePaymentIntentSucceeded, &stripe.PaymentIntent{})
+
+		actual, err := pm.HandleEventWebhook(req)
+		assert.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with subscription created", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypeCustomerSubscriptionCreated, &stripe.Subscription{
+			Customer: &stripe.Customer{ID: "cus_whatever"},
+			Items: &stripe.SubscriptionItemList{
+				Data: []*stripe.SubscriptionItem{
+					{Price: &stripe.Price{ID: "price_premium"}},
+				},
 			},
-			ID:              "",
-			Livemode:        false,
-			Object:          "",
-			PendingWebhooks: 0,
-			Request:         nil,
-			Type:            stripe.EventTypePaymentIntentSucceeded,
+			Metadata: map[string]string{householdIDMetadataKey: exampleHouseholdID},
+			Status:   stripe.SubscriptionStatusActive,
+		})
+
+		planID := "price_premium"
+		expected := &types.HouseholdBillingStatusUpdateInput{
+			OccurredAt:                 exampleEventCreatedAt,
+			SubscriptionPlanID:         &planID,
+			HouseholdID:                exampleHouseholdID,
+			BillingStatus:              types.PaidHouseholdBillingStatus,
+			PaymentProcessorCustomerID: "cus_whatever",
 		}
-		jsonBytes := pm.encoderDecoder.MustEncode(ctx, exampleInput)
 
-		secret, err := random.GenerateHexEncodedString(ctx, 32)
+		actual, err := pm.HandleEventWebhook(req)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	T.Run("with subscription updated to past due", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypeCustomerSubscriptionUpdated, &stripe.Subscription{
+			Customer: &stripe.Customer{ID: "cus_whatever"},
+			Metadata: map[string]string{householdIDMetadataKey: fakes.BuildFakeID()},
+			Status:   stripe.SubscriptionStatusPastDue,
+		})
+
+		actual, err := pm.HandleEventWebhook(req)
 		require.NoError(t, err)
-		require.NotEmpty(t, secret)
-		pm.webhookSecret = secret
-
-		now := time.Now()
-		signedPayload := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
-			Payload:   jsonBytes,
-			Secret:    secret,
-			Timestamp: now,
+		assert.Equal(t, types.PastDueHouseholdBillingStatus, actual.BillingStatus)
+	})
+
+	T.Run("with subscription deleted", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypeCustomerSubscriptionDeleted, &stripe.Subscription{
+			Customer: &stripe.Customer{ID: "cus_whatever"},
+			Items: &stripe.SubscriptionItemList{
+				Data: []*stripe.SubscriptionItem{
+					{Price: &stripe.Price{ID: "price_premium"}},
+				},
+			},
+			Metadata: map[string]string{householdIDMetadataKey: fakes.BuildFakeID()},
+			Status:   stripe.SubscriptionStatusCanceled,
 		})
 
-		event, err := webhook.ConstructEvent(signedPayload.Payload, signedPayload.Header, signedPayload.Secret)
+		actual, err := pm.HandleEventWebhook(req)
 		require.NoError(t, err)
-		eventPayload := pm.encoderDecoder.MustEncode(ctx, event)
+		assert.Equal(t, types.UnpaidHouseholdBillingStatus, actual.BillingStatus)
+		assert.Nil(t, actual.SubscriptionPlanID)
+	})
+
+	T.Run("with failed invoice payment", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypeInvoicePaymentFailed, &stripe.Invoice{
+			Customer: &stripe.Customer{ID: "cus_whatever"},
+			Lines: &stripe.InvoiceLineItemList{
+				Data: []*stripe.InvoiceLineItem{
+					{Price: &stripe.Price{ID: "price_premium"}},
+				},
+			},
+			SubscriptionDetails: &stripe.InvoiceSubscriptionDetails{
+				Metadata: map[string]string{householdIDMetadataKey: exampleHouseholdID},
+			},
+		})
 
-		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(eventPayload))
+		actual, err := pm.HandleEventWebhook(req)
 		require.NoError(t, err)
-		require.NotNil(t, req)
-		req.Header.Set(stripeSignatureHeaderKey, signedPayload.Header)
+		assert.Equal(t, exampleHouseholdID, actual.HouseholdID)
+		assert.Equal(t, types.PastDueHouseholdBillingStatus, actual.BillingStatus)
+		require.NotNil(t, actual.SubscriptionPlanID)
+		assert.Equal(t, "price_premium", *actual.SubscriptionPlanID)
+	})
 
-		err = pm.HandleEventWebhook(req)
+	T.Run("with subscription not tied to a household", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypeCustomerSubscriptionCreated, &stripe.Subscription{
+			Customer: &stripe.Customer{ID: "cus_whatever"},
+			Status:   stripe.SubscriptionStatusActive,
+		})
+
+		actual, err := pm.HandleEventWebhook(req)
 		assert.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		pm := ProvideStripePaymentManager(nil, nil, &Config{}).(*stripePaymentManager)
+
+		req := buildSignedWebhookRequest(t, pm, stripe.EventTypePaymentIntentSucceeded, &stripe.PaymentIntent{})
+		pm.webhookSecret = "not_the_right_secret"
+
+		actual, err := pm.HandleEventWebhook(req)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
 	})
 }
@@ -8,11 +8,13 @@
 	"runtime/debug"
 
 	analyticsconfig "github.com/dinnerdonebetter/backend/internal/analytics/config"
+	capitalismcfg "github.com/dinnerdonebetter/backend/internal/capitalism/config"
 	dbconfig "github.com/dinnerdonebetter/backend/internal/database/config"
 	emailconfig "github.com/dinnerdonebetter/backend/internal/email/config"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	featureflagsconfig "github.com/dinnerdonebetter/backend/internal/featureflags/config"
 	msgconfig "github.com/dinnerdonebetter/backend/internal/messagequeue/config"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/outbox"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/routing"
 	searchcfg "github.com/dinnerdonebetter/backend/internal/search/config"
@@ -44,14 +46,17 @@
 		Email         emailconfig.Config        `json:"email"         toml:"email,omitempty"`
 		Analytics     analyticsconfig.Config    `json:"analytics"     toml:"analytics,omitempty"`
 		Search        searchcfg.Config          `json:"search"        toml:"search,omitempty"`
+		Capitalism    capitalismcfg.Config      `json:"capitalism"    toml:"capitalism,omitempty"`
 		FeatureFlags  featureflagsconfig.Config `json:"featureFlags"  toml:"events,omitempty"`
 		Encoding      encoding.Config           `json:"encoding"      toml:"encoding,omitempty"`
 		Meta          MetaSettings              `json:"meta"          toml:"meta,omitempty"`
 		Routing       routing.Config            `json:"routing"       toml:"routing,omitempty"`
 		Events        msgconfig.Config          `json:"events"        toml:"events,omitempty"`
+		Outbox        outbox.Config             `json:"outbox"        toml:"outbox,omitempty"`
 		Server        http.Config               `json:"server"        toml:"server,omitempty"`
 		Database      dbconfig.Config           `json:"database"      toml:"database,omitempty"`
 		Services      ServicesConfig            `json:"services"      toml:"services,omitempty"`
+		Worker        WorkerConfig              `json:"worker"        toml:"worker,omitempty"`
 	}
 )
 
@@ -84,6 +89,8 @@ func (cfg *InstanceConfig) ValidateWithContext(ctx context.Context, validateServ
 		"Email":         cfg.Email.ValidateWithContext,
 		"FeatureFlags":  cfg.FeatureFlags.ValidateWithContext,
 		"Search":        cfg.Search.ValidateWithContext,
+		"Outbox":        cfg.Outbox.ValidateWithContext,
+		"Capitalism":    cfg.Capitalism.ValidateWithContext,
 	}
 
 	for name, validator := range validators {
@@ -161,6 +161,10 @@ func GetAPIServerConfigFromGoogleCloudRunEnvironment(ctx context.Context, client
 	cfg.Services.ValidPreparationVessels.DataChangesTopicName = dataChangesTopicName
 	cfg.Services.Workers.DataChangesTopicName = dataChangesTopicName
 	cfg.Services.UserNotifications.DataChangesTopicName = dataChangesTopicName
+	cfg.Services.CookingSessions.DataChangesTopicName = dataChangesTopicName
+	cfg.Services.PantryItems.DataChangesTopicName = dataChangesTopicName
+	cfg.Services.ValidIngredientSubstitutions.DataChangesTopicName = dataChangesTopicName
+	cfg.Services.Capitalism.DataChangesTopicName = dataChangesTopicName
 
 	if err = cfg.ValidateWithContext(ctx, true); err != nil {
 		return nil, err
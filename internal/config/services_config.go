@@ -6,6 +6,8 @@
 
 	auditlogentriesservice "github.com/dinnerdonebetter/backend/internal/services/auditlogentries"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	capitalismservice "github.com/dinnerdonebetter/backend/internal/services/capitalism"
+	cookingsessionsservice "github.com/dinnerdonebetter/backend/internal/services/cookingsessions"
 	householdinstrumentownershipsservice "github.com/dinnerdonebetter/backend/internal/services/householdinstrumentownerships"
 	householdinvitationsservice "github.com/dinnerdonebetter/backend/internal/services/householdinvitations"
 	householdsservice "github.com/dinnerdonebetter/backend/internal/services/households"
@@ -17,6 +19,7 @@
 	"github.com/dinnerdonebetter/backend/internal/services/mealplantasks"
 	mealsservice "github.com/dinnerdonebetter/backend/internal/services/meals"
 	oauth2clientsservice "github.com/dinnerdonebetter/backend/internal/services/oauth2clients"
+	pantryitemsservice "github.com/dinnerdonebetter/backend/internal/services/pantryitems"
 	"github.com/dinnerdonebetter/backend/internal/services/recipepreptasks"
 	reciperatingsservice "github.com/dinnerdonebetter/backend/internal/services/reciperatings"
 	recipesservice "github.com/dinnerdonebetter/backend/internal/services/recipes"
@@ -37,6 +40,7 @@
 	validingredientsservice "github.com/dinnerdonebetter/backend/internal/services/validingredients"
 	"github.com/dinnerdonebetter/backend/internal/services/validingredientstateingredients"
 	"github.com/dinnerdonebetter/backend/internal/services/validingredientstates"
+	validingredientsubstitutionsservice "github.com/dinnerdonebetter/backend/internal/services/validingredientsubstitutions"
 	validinstrumentsservice "github.com/dinnerdonebetter/backend/internal/services/validinstruments"
 	"github.com/dinnerdonebetter/backend/internal/services/validmeasurementunitconversions"
 	validmeasurementunitsservice "github.com/dinnerdonebetter/backend/internal/services/validmeasurementunits"
@@ -96,6 +100,10 @@
 		HouseholdInvitations            householdinvitationsservice.Config            `json:"householdInvitations"            toml:"household_invitations,omitempty"`
 		Recipes                         recipesservice.Config                         `json:"recipes"                         toml:"recipes,omitempty"`
 		Auth                            authservice.Config                            `json:"auth"                            toml:"auth,omitempty"`
+		CookingSessions                 cookingsessionsservice.Config                 `json:"cookingSessions"                 toml:"cooking_sessions,omitempty"`
+		PantryItems                     pantryitemsservice.Config                     `json:"pantryItems"                     toml:"pantry_items,omitempty"`
+		ValidIngredientSubstitutions    validingredientsubstitutionsservice.Config    `json:"validIngredientSubstitutions"    toml:"valid_ingredient_substitutions,omitempty"`
+		Capitalism                      capitalismservice.Config                      `json:"capitalism"                      toml:"capitalism,omitempty"`
 	}
 )
 
@@ -138,6 +146,10 @@ func (cfg *ServicesConfig) ValidateWithContext(ctx context.Context) error {
 		"Workers":                         cfg.Workers.ValidateWithContext,
 		"UserNotifications":               cfg.UserNotifications.ValidateWithContext,
 		"AuditLogEntries":                 cfg.AuditLogEntries.ValidateWithContext,
+		"CookingSessions":                 cfg.CookingSessions.ValidateWithContext,
+		"PantryItems":                     cfg.PantryItems.ValidateWithContext,
+		"ValidIngredientSubstitutions":    cfg.ValidIngredientSubstitutions.ValidateWithContext,
+		"Capitalism":                      cfg.Capitalism.ValidateWithContext,
 	}
 
 	for name, validator := range validatorsToRun {
@@ -18,7 +18,9 @@
 			"Database",
 			"Meta",
 			"Events",
+			"Outbox",
 			"Search",
+			"Capitalism",
 			"Server",
 			"Services",
 		),
@@ -65,6 +67,10 @@
 			"HouseholdInstrumentOwnerships",
 			"ValidVessels",
 			"ValidPreparationVessels",
+			"CookingSessions",
+			"PantryItems",
+			"ValidIngredientSubstitutions",
+			"Capitalism",
 		),
 	)
 )
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/deadletter"
+	"github.com/dinnerdonebetter/backend/internal/workers/runner"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	workerConfigFilepathEnvVarKey              = "CONFIGURATION_FILEPATH"
+	workerConcurrencyEnvVarKey                 = "WORKER_CONCURRENCY"
+	workerShutdownTimeoutEnvVarKey             = "WORKER_SHUTDOWN_TIMEOUT"
+	dataChangesTopicNameEnvVarKey              = "DATA_CHANGES_TOPIC_NAME"
+	outboundEmailsTopicNameEnvVarKey           = "OUTBOUND_EMAILS_TOPIC_NAME"
+	searchIndexingTopicNameEnvVarKey           = "SEARCH_INDEXING_TOPIC_NAME"
+	webhookExecutionRequestsTopicNameEnvVarKey = "WEBHOOK_EXECUTION_REQUESTS_TOPIC_NAME"
+)
+
+type (
+	// WorkerTopicsConfig names the topics the worker service consumes from and publishes to.
+	WorkerTopicsConfig struct {
+		_ struct{} `json:"-"`
+
+		DataChanges              string `json:"dataChanges,omitempty"              toml:"data_changes,omitempty"`
+		OutboundEmails           string `json:"outboundEmails,omitempty"           toml:"outbound_emails,omitempty"`
+		SearchIndexing           string `json:"searchIndexing,omitempty"           toml:"search_indexing,omitempty"`
+		WebhookExecutionRequests string `json:"webhookExecutionRequests,omitempty" toml:"webhook_execution_requests,omitempty"`
+	}
+
+	// WorkerConfig configures the worker service.
+	WorkerConfig struct {
+		_ struct{} `json:"-"`
+
+		Topics      WorkerTopicsConfig `json:"topics"      toml:"topics,omitempty"`
+		DeadLetters deadletter.Config  `json:"deadLetters" toml:"dead_letters,omitempty"`
+		Runner      runner.Config      `json:"runner"      toml:"runner,omitempty"`
+	}
+)
+
+var _ validation.ValidatableWithContext = (*WorkerTopicsConfig)(nil)
+
+// ValidateWithContext validates a WorkerTopicsConfig struct.
+func (cfg *WorkerTopicsConfig) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.DataChanges, validation.Required),
+		validation.Field(&cfg.OutboundEmails, validation.Required),
+		validation.Field(&cfg.SearchIndexing, validation.Required),
+		validation.Field(&cfg.WebhookExecutionRequests, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*WorkerConfig)(nil)
+
+// ValidateWithContext validates a WorkerConfig struct.
+func (cfg *WorkerConfig) ValidateWithContext(ctx context.Context) error {
+	var result *multierror.Error
+
+	if err := cfg.Topics.ValidateWithContext(ctx); err != nil {
+		result = multierror.Append(fmt.Errorf("error validating Topics config: %w", err), result)
+	}
+
+	if err := cfg.Runner.ValidateWithContext(ctx); err != nil {
+		result = multierror.Append(fmt.Errorf("error validating Runner config: %w", err), result)
+	}
+
+	if err := cfg.DeadLetters.ValidateWithContext(ctx); err != nil {
+		result = multierror.Append(fmt.Errorf("error validating DeadLetters config: %w", err), result)
+	}
+
+	return result.ErrorOrNil()
+}
+
+// applyEnvironment overrides the worker config with whatever's set in the environment.
+func (cfg *WorkerConfig) applyEnvironment(lookup func(string) (string, bool)) error {
+	for key, field := range map[string]*string{
+		dataChangesTopicNameEnvVarKey:              &cfg.Topics.DataChanges,
+		outboundEmailsTopicNameEnvVarKey:           &cfg.Topics.OutboundEmails,
+		searchIndexingTopicNameEnvVarKey:           &cfg.Topics.SearchIndexing,
+		webhookExecutionRequestsTopicNameEnvVarKey: &cfg.Topics.WebhookExecutionRequests,
+	} {
+		if value, ok := lookup(key); ok {
+			*field = value
+		}
+	}
+
+	if rawConcurrency, ok := lookup(workerConcurrencyEnvVarKey); ok {
+		concurrency, err := strconv.ParseUint(rawConcurrency, 10, 16)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", workerConcurrencyEnvVarKey, err)
+		}
+		cfg.Runner.Concurrency = uint16(concurrency)
+	}
+
+	if rawShutdownTimeout, ok := lookup(workerShutdownTimeoutEnvVarKey); ok {
+		shutdownTimeout, err := time.ParseDuration(rawShutdownTimeout)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", workerShutdownTimeoutEnvVarKey, err)
+		}
+		cfg.Runner.ShutdownTimeout = shutdownTimeout
+	}
+
+	return nil
+}
+
+// GetWorkerConfigFromEnvironment reads an InstanceConfig from the file named by CONFIGURATION_FILEPATH,
+// then overrides its worker settings with any that are set in the environment.
+func GetWorkerConfigFromEnvironment(ctx context.Context) (*InstanceConfig, error) {
+	return getWorkerConfigFromEnvironment(ctx, os.LookupEnv)
+}
+
+func getWorkerConfigFromEnvironment(ctx context.Context, lookup func(string) (string, bool)) (*InstanceConfig, error) {
+	configFilepath, ok := lookup(workerConfigFilepathEnvVarKey)
+	if !ok || configFilepath == "" {
+		return nil, fmt.Errorf("%s is not set", workerConfigFilepathEnvVarKey)
+	}
+
+	configBytes, err := os.ReadFile(configFilepath)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	var cfg *InstanceConfig
+	if err = json.NewDecoder(bytes.NewReader(configBytes)).Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("decoding config file contents: %w", err)
+	}
+
+	if cfg == nil {
+		return nil, errors.New("config is nil")
+	}
+
+	if err = cfg.Worker.applyEnvironment(lookup); err != nil {
+		return nil, err
+	}
+
+	if err = cfg.ValidateWithContext(ctx, false); err != nil {
+		return nil, fmt.Errorf("validating config: %w", err)
+	}
+
+	if err = cfg.Worker.ValidateWithContext(ctx); err != nil {
+		return nil, fmt.Errorf("validating worker config: %w", err)
+	}
+
+	return cfg, nil
+}
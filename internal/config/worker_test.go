@@ -0,0 +1,168 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildValidWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		Topics: WorkerTopicsConfig{
+			DataChanges:              "data_changes",
+			OutboundEmails:           "outbound_emails",
+			SearchIndexing:           "search_index_requests",
+			WebhookExecutionRequests: "webhook_execution_requests",
+		},
+	}
+}
+
+func buildEnvironmentLookup(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func TestWorkerConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := buildValidWorkerConfig()
+
+		assert.NoError(t, cfg.ValidateWithContext(ctx))
+	})
+
+	T.Run("with missing topic", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := buildValidWorkerConfig()
+		cfg.Topics.SearchIndexing = ""
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
+
+	T.Run("with negative shutdown timeout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := buildValidWorkerConfig()
+		cfg.Runner.ShutdownTimeout = -time.Second
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
+}
+
+func TestGetWorkerConfigFromEnvironment(T *testing.T) {
+	T.Parallel()
+
+	T.Run("without config filepath", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		actual, err := getWorkerConfigFromEnvironment(ctx, buildEnvironmentLookup(nil))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with nonexistent config file", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		env := map[string]string{
+			workerConfigFilepathEnvVarKey: filepath.Join(t.TempDir(), "nonexistent.json"),
+		}
+
+		actual, err := getWorkerConfigFromEnvironment(ctx, buildEnvironmentLookup(env))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid config file contents", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		filePath := filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.WriteFile(filePath, []byte("blah"), 0o600))
+
+		env := map[string]string{
+			workerConfigFilepathEnvVarKey: filePath,
+		}
+
+		actual, err := getWorkerConfigFromEnvironment(ctx, buildEnvironmentLookup(env))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestWorkerConfig_applyEnvironment(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := &WorkerConfig{}
+
+		env := map[string]string{
+			dataChangesTopicNameEnvVarKey:              "data_changes",
+			outboundEmailsTopicNameEnvVarKey:           "outbound_emails",
+			searchIndexingTopicNameEnvVarKey:           "search_index_requests",
+			webhookExecutionRequestsTopicNameEnvVarKey: "webhook_execution_requests",
+			workerConcurrencyEnvVarKey:                 "4",
+			workerShutdownTimeoutEnvVarKey:             "5s",
+		}
+
+		require.NoError(t, cfg.applyEnvironment(buildEnvironmentLookup(env)))
+		assert.NoError(t, cfg.ValidateWithContext(ctx))
+
+		assert.Equal(t, "data_changes", cfg.Topics.DataChanges)
+		assert.Equal(t, "outbound_emails", cfg.Topics.OutboundEmails)
+		assert.Equal(t, "search_index_requests", cfg.Topics.SearchIndexing)
+		assert.Equal(t, "webhook_execution_requests", cfg.Topics.WebhookExecutionRequests)
+		assert.Equal(t, uint16(4), cfg.Runner.Concurrency)
+		assert.Equal(t, 5*time.Second, cfg.Runner.ShutdownTimeout)
+	})
+
+	T.Run("leaves unset values alone", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := buildValidWorkerConfig()
+		expected := buildValidWorkerConfig()
+
+		require.NoError(t, cfg.applyEnvironment(buildEnvironmentLookup(nil)))
+		assert.Equal(t, expected, cfg)
+	})
+
+	T.Run("with invalid concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &WorkerConfig{}
+		env := map[string]string{
+			workerConcurrencyEnvVarKey: "a lot",
+		}
+
+		assert.Error(t, cfg.applyEnvironment(buildEnvironmentLookup(env)))
+	})
+
+	T.Run("with invalid shutdown timeout", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &WorkerConfig{}
+		env := map[string]string{
+			workerShutdownTimeoutEnvVarKey: "a while",
+		}
+
+		assert.Error(t, cfg.applyEnvironment(buildEnvironmentLookup(env)))
+	})
+}
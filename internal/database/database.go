@@ -53,6 +53,11 @@
 		SQLTransactionManager
 	}
 
+	// TransactionRunner runs functions whose queries should be committed or rolled back together.
+	TransactionRunner interface {
+		RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
 	// DataManager describes anything that stores data for our services.
 	DataManager interface {
 		DB() *sql.DB
@@ -60,6 +65,7 @@
 		Migrate(ctx context.Context, waitPeriod time.Duration, maxAttempts uint64) error
 		IsReady(ctx context.Context, waitPeriod time.Duration, maxAttempts uint64) (ready bool)
 		ProvideSessionStore() scs.Store
+		TransactionRunner
 
 		types.MealPlanTaskDataManager
 		types.AdminUserDataManager
@@ -69,6 +75,7 @@
 		types.UserDataManager
 		types.PasswordResetTokenDataManager
 		types.WebhookDataManager
+		types.WebhookDeliveryDataManager
 		types.ValidInstrumentDataManager
 		types.ValidIngredientDataManager
 		types.ValidPreparationDataManager
@@ -106,5 +113,15 @@
 		types.ValidPreparationVesselDataManager
 		types.UserNotificationDataManager
 		types.AuditLogEntryDataManager
+		types.CookingSessionDataManager
+		types.DeadLetteredMessageDataManager
+		types.OutboxMessageDataManager
+		types.HouseholdEventDataManager
+		types.PantryItemDataManager
+		types.ValidIngredientNutritionDataManager
+		types.ValidIngredientSubstitutionDataManager
+		types.UserPasskeyDataManager
+		types.PasskeyCeremonyDataManager
+		types.UserRecoveryCodeDataManager
 	}
 )
@@ -37,6 +37,7 @@ func NewMockDatabase() *MockDatabase {
 		AdminUserDataManagerMock:                      &mocktypes.AdminUserDataManagerMock{},
 		PasswordResetTokenDataManagerMock:             &mocktypes.PasswordResetTokenDataManagerMock{},
 		WebhookDataManagerMock:                        &mocktypes.WebhookDataManagerMock{},
+		WebhookDeliveryDataManagerMock:                &mocktypes.WebhookDeliveryDataManagerMock{},
 		ValidMeasurementUnitDataManagerMock:           &mocktypes.ValidMeasurementUnitDataManagerMock{},
 		ValidPreparationInstrumentDataManagerMock:     &mocktypes.ValidPreparationInstrumentDataManagerMock{},
 		ValidIngredientMeasurementUnitDataManagerMock: &mocktypes.ValidIngredientMeasurementUnitDataManagerMock{},
@@ -56,10 +57,21 @@ func NewMockDatabase() *MockDatabase {
 		HouseholdInstrumentOwnershipDataManagerMock:   &mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
 		RecipeRatingDataManagerMock:                   &mocktypes.RecipeRatingDataManagerMock{},
 		OAuth2ClientDataManagerMock:                   &mocktypes.OAuth2ClientDataManagerMock{},
+		OAuth2ClientTokenDataManagerMock:              &mocktypes.OAuth2ClientTokenDataManagerMock{},
 		ValidVesselDataManagerMock:                    &mocktypes.ValidVesselDataManagerMock{},
 		ValidPreparationVesselDataManagerMock:         &mocktypes.ValidPreparationVesselDataManagerMock{},
 		UserNotificationDataManagerMock:               &mocktypes.UserNotificationDataManagerMock{},
 		AuditLogEntryDataManagerMock:                  &mocktypes.AuditLogEntryDataManagerMock{},
+		CookingSessionDataManagerMock:                 &mocktypes.CookingSessionDataManagerMock{},
+		DeadLetteredMessageDataManagerMock:            &mocktypes.DeadLetteredMessageDataManagerMock{},
+		OutboxMessageDataManagerMock:                  &mocktypes.OutboxMessageDataManagerMock{},
+		HouseholdEventDataManagerMock:                 &mocktypes.HouseholdEventDataManagerMock{},
+		PantryItemDataManagerMock:                     &mocktypes.PantryItemDataManagerMock{},
+		ValidIngredientNutritionDataManagerMock:       &mocktypes.ValidIngredientNutritionDataManagerMock{},
+		ValidIngredientSubstitutionDataManagerMock:    &mocktypes.ValidIngredientSubstitutionDataManagerMock{},
+		UserPasskeyDataManagerMock:                    &mocktypes.UserPasskeyDataManagerMock{},
+		PasskeyCeremonyDataManagerMock:                &mocktypes.PasskeyCeremonyDataManagerMock{},
+		UserRecoveryCodeDataManagerMock:               &mocktypes.UserRecoveryCodeDataManagerMock{},
 	}
 }
 
@@ -86,6 +98,7 @@ type MockDatabase struct {
 	*mocktypes.UserDataManagerMock
 	*mocktypes.PasswordResetTokenDataManagerMock
 	*mocktypes.WebhookDataManagerMock
+	*mocktypes.WebhookDeliveryDataManagerMock
 	*mocktypes.HouseholdDataManagerMock
 	*mocktypes.HouseholdInvitationDataManagerMock
 	*mocktypes.ValidMeasurementUnitDataManagerMock
@@ -111,6 +124,16 @@ type MockDatabase struct {
 	*mocktypes.ValidPreparationVesselDataManagerMock
 	*mocktypes.UserNotificationDataManagerMock
 	*mocktypes.AuditLogEntryDataManagerMock
+	*mocktypes.CookingSessionDataManagerMock
+	*mocktypes.DeadLetteredMessageDataManagerMock
+	*mocktypes.OutboxMessageDataManagerMock
+	*mocktypes.HouseholdEventDataManagerMock
+	*mocktypes.PantryItemDataManagerMock
+	*mocktypes.ValidIngredientNutritionDataManagerMock
+	*mocktypes.ValidIngredientSubstitutionDataManagerMock
+	*mocktypes.UserPasskeyDataManagerMock
+	*mocktypes.PasskeyCeremonyDataManagerMock
+	*mocktypes.UserRecoveryCodeDataManagerMock
 
 	mock.Mock
 }
@@ -140,6 +163,18 @@ func (m *MockDatabase) IsReady(ctx context.Context, waitPeriod time.Duration, ma
 	return m.Called(ctx, waitPeriod, maxAttempts).Bool(0)
 }
 
+// RunInTransaction satisfies the DataManager interface. The provided function is always called, and the
+// mocked error is only returned if it succeeds, standing in for a failure to commit.
+func (m *MockDatabase) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx, fn)
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return args.Error(0)
+}
+
 // BeginTx satisfies the DataManager interface.
 func (m *MockDatabase) BeginTx(ctx context.Context, options *sql.TxOptions) (*sql.Tx, error) {
 	args := m.Called(ctx, options)
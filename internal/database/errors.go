@@ -6,4 +6,6 @@
 
 var (
 	ErrAlreadyFinalized = errors.New("meal plan already finalized")
+	// ErrWebhookEncryptionKeyRotationInProgress indicates a household's previous webhook encryption key hasn't expired yet.
+	ErrWebhookEncryptionKeyRotationInProgress = errors.New("previous webhook encryption key has not expired yet")
 )
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"context"
+)
+
+type noopTransactionRunner struct{}
+
+// RunInTransaction calls the provided function without starting a transaction.
+func (n *noopTransactionRunner) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// NewNoopTransactionRunner is a TransactionRunner that doesn't start transactions.
+func NewNoopTransactionRunner() TransactionRunner {
+	return &noopTransactionRunner{}
+}
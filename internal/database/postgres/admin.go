@@ -25,7 +25,7 @@ func (q *Querier) UpdateUserAccountStatus(ctx context.Context, userID string, in
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	rowsChanged, err := q.generatedQuerier.SetUserAccountStatus(ctx, q.db, &generated.SetUserAccountStatusParams{
+	rowsChanged, err := q.generatedQuerier.SetUserAccountStatus(ctx, q.dbFor(ctx), &generated.SetUserAccountStatusParams{
 		UserAccountStatus:            input.NewStatus,
 		UserAccountStatusExplanation: input.Reason,
 		ID:                           input.TargetUserID,
@@ -31,7 +31,7 @@ func (q *Querier) GetAuditLogEntry(ctx context.Context, auditLogEntryID string)
 	logger = logger.WithValue(keys.AuditLogEntryIDKey, auditLogEntryID)
 	tracing.AttachToSpan(span, keys.AuditLogEntryIDKey, auditLogEntryID)
 
-	result, err := q.generatedQuerier.GetAuditLogEntry(ctx, q.db, auditLogEntryID)
+	result, err := q.generatedQuerier.GetAuditLogEntry(ctx, q.dbFor(ctx), auditLogEntryID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching audit log entry")
 	}
@@ -75,7 +75,7 @@ func (q *Querier) GetAuditLogEntriesForUser(ctx context.Context, userID string,
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetAuditLogEntriesForUser(ctx, q.db, &generated.GetAuditLogEntriesForUserParams{
+	results, err := q.generatedQuerier.GetAuditLogEntriesForUser(ctx, q.dbFor(ctx), &generated.GetAuditLogEntriesForUserParams{
 		BelongsToUser: database.NullStringFromString(userID),
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -137,7 +137,7 @@ func (q *Querier) GetAuditLogEntriesForUserAndResourceType(ctx context.Context,
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetAuditLogEntriesForUserAndResourceType(ctx, q.db, &generated.GetAuditLogEntriesForUserAndResourceTypeParams{
+	results, err := q.generatedQuerier.GetAuditLogEntriesForUserAndResourceType(ctx, q.dbFor(ctx), &generated.GetAuditLogEntriesForUserAndResourceTypeParams{
 		BelongsToUser: database.NullStringFromString(userID),
 		Resources:     resourceTypes,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -194,7 +194,7 @@ func (q *Querier) GetAuditLogEntriesForHousehold(ctx context.Context, householdI
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetAuditLogEntriesForHousehold(ctx, q.db, &generated.GetAuditLogEntriesForHouseholdParams{
+	results, err := q.generatedQuerier.GetAuditLogEntriesForHousehold(ctx, q.dbFor(ctx), &generated.GetAuditLogEntriesForHouseholdParams{
 		BelongsToHousehold: database.NullStringFromString(householdID),
 		CreatedBefore:      database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:       database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -256,7 +256,7 @@ func (q *Querier) GetAuditLogEntriesForHouseholdAndResourceType(ctx context.Cont
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetAuditLogEntriesForHouseholdAndResourceType(ctx, q.db, &generated.GetAuditLogEntriesForHouseholdAndResourceTypeParams{
+	results, err := q.generatedQuerier.GetAuditLogEntriesForHouseholdAndResourceType(ctx, q.dbFor(ctx), &generated.GetAuditLogEntriesForHouseholdAndResourceTypeParams{
 		BelongsToHousehold: database.NullStringFromString(householdID),
 		Resources:          resourceTypes,
 		CreatedBefore:      database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -291,6 +291,11 @@ func (q *Querier) GetAuditLogEntriesForHouseholdAndResourceType(ctx context.Cont
 	return x, nil
 }
 
+// CreateAuditLogEntry creates an audit log entry for events that don't happen within another write.
+func (q *Querier) CreateAuditLogEntry(ctx context.Context, input *types.AuditLogEntryDatabaseCreationInput) (*types.AuditLogEntry, error) {
+	return q.createAuditLogEntry(ctx, q.dbFor(ctx), input)
+}
+
 // createAuditLogEntry creates an audit log entry in a database.
 func (q *Querier) createAuditLogEntry(ctx context.Context, querier database.SQLQueryExecutor, input *types.AuditLogEntryDatabaseCreationInput) (*types.AuditLogEntry, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -138,4 +138,15 @@ func TestQuerier_CreateAuditLogEntry(T *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, actual)
 	})
+
+	T.Run("with invalid input outside a transaction", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateAuditLogEntry(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
 }
@@ -0,0 +1,320 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.CookingSessionDataManager = (*Querier)(nil)
+)
+
+// GetCookingSession fetches a cooking session and everything that's happened in it from the database.
+func (q *Querier) GetCookingSession(ctx context.Context, cookingSessionID, householdID string) (*types.CookingSession, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	result, err := q.generatedQuerier.GetCookingSession(ctx, q.dbFor(ctx), &generated.GetCookingSessionParams{
+		ID:                 cookingSessionID,
+		BelongsToHousehold: householdID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching cooking session")
+	}
+
+	return q.cookingSessionWithProgress(ctx, logger, span, result)
+}
+
+// GetCookingSessionForUpdate fetches a cooking session and everything that's happened in it from the database, locking
+// the session's row until the transaction carried by the context ends so concurrent progress can't be recorded.
+func (q *Querier) GetCookingSessionForUpdate(ctx context.Context, cookingSessionID, householdID string) (*types.CookingSession, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	result, err := q.generatedQuerier.GetCookingSessionForUpdate(ctx, q.dbFor(ctx), &generated.GetCookingSessionForUpdateParams{
+		ID:                 cookingSessionID,
+		BelongsToHousehold: householdID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "locking cooking session")
+	}
+
+	return q.cookingSessionWithProgress(ctx, logger, span, result)
+}
+
+// cookingSessionWithProgress converts a cooking session row and fetches the progress recorded in it.
+func (q *Querier) cookingSessionWithProgress(ctx context.Context, logger logging.Logger, span tracing.Span, result *generated.CookingSessions) (*types.CookingSession, error) {
+	cookingSession := &types.CookingSession{
+		CreatedAt:          result.CreatedAt,
+		ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+		LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+		CompletedAt:        database.TimePointerFromNullTime(result.CompletedAt),
+		ID:                 result.ID,
+		RecipeID:           result.RecipeID,
+		BelongsToHousehold: result.BelongsToHousehold,
+		CreatedByUser:      result.CreatedByUser,
+		Progress:           []*types.CookingSessionProgressEntry{},
+	}
+
+	entries, err := q.generatedQuerier.GetCookingSessionProgressEntries(ctx, q.dbFor(ctx), result.ID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching cooking session progress")
+	}
+
+	for _, entry := range entries {
+		cookingSession.Progress = append(cookingSession.Progress, &types.CookingSessionProgressEntry{
+			CreatedAt:               entry.CreatedAt,
+			ID:                      entry.ID,
+			BelongsToCookingSession: entry.BelongsToCookingSession,
+			ProgressType:            string(entry.ProgressType),
+			Subject:                 entry.Subject,
+			Value:                   entry.Value,
+			RecordedByUser:          entry.RecordedByUser,
+		})
+	}
+
+	return cookingSession, nil
+}
+
+// GetCookingSessions fetches a list of a household's cooking sessions from the database that meet a particular filter.
+// The sessions' progress is left out; fetch a single session to get it.
+func (q *Querier) GetCookingSessions(ctx context.Context, householdID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.CookingSession], error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+
+	tracing.AttachQueryFilterToSpan(span, filter)
+	x := &types.QueryFilteredResult[types.CookingSession]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.GetCookingSessionsForHousehold(ctx, q.dbFor(ctx), &generated.GetCookingSessionsForHouseholdParams{
+		CreatedAfter:       database.NullTimeFromTimePointer(filter.CreatedAfter),
+		CreatedBefore:      database.NullTimeFromTimePointer(filter.CreatedBefore),
+		UpdatedBefore:      database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:       database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		BelongsToHousehold: householdID,
+		QueryOffset:        database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:         database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching cooking sessions from database")
+	}
+
+	for _, result := range results {
+		x.Data = append(x.Data, &types.CookingSession{
+			CreatedAt:          result.CreatedAt,
+			ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+			LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+			CompletedAt:        database.TimePointerFromNullTime(result.CompletedAt),
+			ID:                 result.ID,
+			RecipeID:           result.RecipeID,
+			BelongsToHousehold: result.BelongsToHousehold,
+			CreatedByUser:      result.CreatedByUser,
+		})
+
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
+// CreateCookingSession creates a cooking session in the database.
+func (q *Querier) CreateCookingSession(ctx context.Context, input *types.CookingSessionDatabaseCreationInput) (*types.CookingSession, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, input.ID)
+	logger := q.logger.WithValue(keys.CookingSessionIDKey, input.ID)
+
+	if err := q.generatedQuerier.CreateCookingSession(ctx, q.dbFor(ctx), &generated.CreateCookingSessionParams{
+		ID:                 input.ID,
+		RecipeID:           input.RecipeID,
+		BelongsToHousehold: input.BelongsToHousehold,
+		CreatedByUser:      input.CreatedByUser,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing cooking session creation query")
+	}
+
+	x := &types.CookingSession{
+		CreatedAt:          q.currentTime(),
+		ID:                 input.ID,
+		RecipeID:           input.RecipeID,
+		BelongsToHousehold: input.BelongsToHousehold,
+		CreatedByUser:      input.CreatedByUser,
+		Progress:           []*types.CookingSessionProgressEntry{},
+	}
+
+	logger.Info("cooking session created")
+
+	return x, nil
+}
+
+// CreateCookingSessionProgressEntry records progress for a cooking session in the database.
+func (q *Querier) CreateCookingSessionProgressEntry(ctx context.Context, input *types.CookingSessionProgressDatabaseCreationInput) (*types.CookingSessionProgressEntry, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	logger := q.logger.WithValues(map[string]any{
+		keys.CookingSessionIDKey:              input.BelongsToCookingSession,
+		keys.CookingSessionProgressEntryIDKey: input.ID,
+	})
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, input.BelongsToCookingSession)
+	tracing.AttachToSpan(span, keys.CookingSessionProgressEntryIDKey, input.ID)
+
+	tx, err := q.beginTx(ctx)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.generatedQuerier.CreateCookingSessionProgressEntry(ctx, tx, &generated.CreateCookingSessionProgressEntryParams{
+		ID:                      input.ID,
+		ProgressType:            generated.CookingSessionProgressType(input.ProgressType),
+		Subject:                 input.Subject,
+		Value:                   input.Value,
+		RecordedByUser:          input.RecordedByUser,
+		BelongsToCookingSession: input.BelongsToCookingSession,
+	}); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing cooking session progress creation query")
+	}
+
+	if err = q.generatedQuerier.MarkCookingSessionAsUpdated(ctx, tx, input.BelongsToCookingSession); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "marking cooking session as updated")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "committing database transaction")
+	}
+
+	x := &types.CookingSessionProgressEntry{
+		CreatedAt:               q.currentTime(),
+		ID:                      input.ID,
+		BelongsToCookingSession: input.BelongsToCookingSession,
+		ProgressType:            input.ProgressType,
+		Subject:                 input.Subject,
+		Value:                   input.Value,
+		RecordedByUser:          input.RecordedByUser,
+	}
+
+	logger.Info("cooking session progress recorded")
+
+	return x, nil
+}
+
+// MarkCookingSessionAsCompleted marks a cooking session as completed.
+func (q *Querier) MarkCookingSessionAsCompleted(ctx context.Context, cookingSessionID, householdID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if cookingSessionID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	if householdID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if _, err := q.generatedQuerier.MarkCookingSessionAsCompleted(ctx, q.dbFor(ctx), &generated.MarkCookingSessionAsCompletedParams{
+		ID:                 cookingSessionID,
+		BelongsToHousehold: householdID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking cooking session as completed")
+	}
+
+	logger.Info("cooking session completed")
+
+	return nil
+}
+
+// ArchiveCookingSession archives a cooking session from the database by its ID.
+func (q *Querier) ArchiveCookingSession(ctx context.Context, cookingSessionID, householdID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if cookingSessionID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	if householdID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if _, err := q.generatedQuerier.ArchiveCookingSession(ctx, q.dbFor(ctx), &generated.ArchiveCookingSessionParams{
+		ID:                 cookingSessionID,
+		BelongsToHousehold: householdID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving cooking session")
+	}
+
+	logger.Info("cooking session archived")
+
+	return nil
+}
@@ -0,0 +1,306 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createCookingSessionForTest(t *testing.T, ctx context.Context, exampleCookingSession *types.CookingSession, dbc *Querier) *types.CookingSession {
+	t.Helper()
+
+	// create
+	if exampleCookingSession == nil {
+		exampleCookingSession = fakes.BuildFakeCookingSession()
+	}
+	exampleCookingSession.Progress = []*types.CookingSessionProgressEntry{}
+
+	dbInput := &types.CookingSessionDatabaseCreationInput{
+		ID:                 exampleCookingSession.ID,
+		RecipeID:           exampleCookingSession.RecipeID,
+		BelongsToHousehold: exampleCookingSession.BelongsToHousehold,
+		CreatedByUser:      exampleCookingSession.CreatedByUser,
+	}
+
+	created, err := dbc.CreateCookingSession(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+
+	exampleCookingSession.CreatedAt = created.CreatedAt
+	exampleCookingSession.LastUpdatedAt = nil
+	exampleCookingSession.CompletedAt = nil
+	exampleCookingSession.ArchivedAt = nil
+	assert.Equal(t, exampleCookingSession, created)
+
+	cookingSession, err := dbc.GetCookingSession(ctx, created.ID, created.BelongsToHousehold)
+	require.NoError(t, err)
+	exampleCookingSession.CreatedAt = cookingSession.CreatedAt
+	assert.Equal(t, exampleCookingSession, cookingSession)
+
+	return created
+}
+
+func TestQuerier_Integration_CookingSessions(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := createUserForTest(t, ctx, nil, dbc)
+	householdID, err := dbc.GetDefaultHouseholdIDForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, householdID)
+
+	recipe := createRecipeForTest(t, ctx, nil, dbc, false)
+
+	exampleCookingSession := fakes.BuildFakeCookingSession()
+	exampleCookingSession.RecipeID = recipe.ID
+	exampleCookingSession.BelongsToHousehold = householdID
+	exampleCookingSession.CreatedByUser = user.ID
+	createdCookingSessions := []*types.CookingSession{}
+
+	// create
+	createdCookingSessions = append(createdCookingSessions, createCookingSessionForTest(t, ctx, exampleCookingSession, dbc))
+
+	// record progress
+	step := recipe.Steps[0]
+	for _, input := range []*types.CookingSessionProgressDatabaseCreationInput{
+		{
+			ID:                      fakes.BuildFakeID(),
+			BelongsToCookingSession: createdCookingSessions[0].ID,
+			ProgressType:            types.CookingSessionProgressTypeObservationRecorded,
+			Subject:                 "internal_temperature",
+			Value:                   "74",
+			RecordedByUser:          user.ID,
+		},
+		{
+			ID:                      fakes.BuildFakeID(),
+			BelongsToCookingSession: createdCookingSessions[0].ID,
+			ProgressType:            types.CookingSessionProgressTypeStepCompleted,
+			Subject:                 step.ID,
+			RecordedByUser:          user.ID,
+		},
+		{
+			// a second member finishing the same step is a no-op.
+			ID:                      fakes.BuildFakeID(),
+			BelongsToCookingSession: createdCookingSessions[0].ID,
+			ProgressType:            types.CookingSessionProgressTypeStepCompleted,
+			Subject:                 step.ID,
+			RecordedByUser:          user.ID,
+		},
+	} {
+		var entry *types.CookingSessionProgressEntry
+		entry, err = dbc.CreateCookingSessionProgressEntry(ctx, input)
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+	}
+
+	cookingSession, err := dbc.GetCookingSession(ctx, createdCookingSessions[0].ID, householdID)
+	require.NoError(t, err)
+	assert.Len(t, cookingSession.Progress, 2)
+	assert.NotNil(t, cookingSession.LastUpdatedAt)
+
+	// lock
+	require.NoError(t, dbc.RunInTransaction(ctx, func(ctx context.Context) error {
+		locked, lockErr := dbc.GetCookingSessionForUpdate(ctx, createdCookingSessions[0].ID, householdID)
+		require.NoError(t, lockErr)
+		assert.Equal(t, cookingSession, locked)
+
+		return nil
+	}))
+
+	// complete
+	assert.NoError(t, dbc.MarkCookingSessionAsCompleted(ctx, createdCookingSessions[0].ID, householdID))
+	cookingSession, err = dbc.GetCookingSession(ctx, createdCookingSessions[0].ID, householdID)
+	require.NoError(t, err)
+	assert.NotNil(t, cookingSession.CompletedAt)
+
+	// create more
+	for i := 0; i < exampleQuantity; i++ {
+		input := fakes.BuildFakeCookingSession()
+		input.RecipeID = recipe.ID
+		input.BelongsToHousehold = householdID
+		input.CreatedByUser = user.ID
+		createdCookingSessions = append(createdCookingSessions, createCookingSessionForTest(t, ctx, input, dbc))
+	}
+
+	// fetch as list
+	cookingSessions, err := dbc.GetCookingSessions(ctx, householdID, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, cookingSessions.Data)
+	assert.Equal(t, len(createdCookingSessions), len(cookingSessions.Data))
+
+	// delete
+	for _, cookingSession := range createdCookingSessions {
+		assert.NoError(t, dbc.ArchiveCookingSession(ctx, cookingSession.ID, householdID))
+
+		var y *types.CookingSession
+		y, err = dbc.GetCookingSession(ctx, cookingSession.ID, householdID)
+		assert.Nil(t, y)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestQuerier_GetCookingSession(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetCookingSession(ctx, "", exampleHouseholdID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleCookingSessionID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetCookingSession(ctx, exampleCookingSessionID, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetCookingSessionForUpdate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetCookingSessionForUpdate(ctx, "", exampleHouseholdID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleCookingSessionID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetCookingSessionForUpdate(ctx, exampleCookingSessionID, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetCookingSessions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetCookingSessions(ctx, "", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateCookingSession(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateCookingSession(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateCookingSessionProgressEntry(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateCookingSessionProgressEntry(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_MarkCookingSessionAsCompleted(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.MarkCookingSessionAsCompleted(ctx, "", exampleHouseholdID))
+	})
+}
+
+func TestQuerier_ArchiveCookingSession(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchiveCookingSession(ctx, "", exampleHouseholdID))
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleCookingSessionID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchiveCookingSession(ctx, exampleCookingSessionID, ""))
+	})
+}
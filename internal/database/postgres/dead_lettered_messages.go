@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.DeadLetteredMessageDataManager = (*Querier)(nil)
+)
+
+// GetDeadLetteredMessage fetches a dead-lettered message from the database.
+func (q *Querier) GetDeadLetteredMessage(ctx context.Context, deadLetteredMessageID string) (*types.DeadLetteredMessage, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if deadLetteredMessageID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	result, err := q.generatedQuerier.GetDeadLetteredMessage(ctx, q.dbFor(ctx), deadLetteredMessageID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching dead-lettered message")
+	}
+
+	deadLetteredMessage := &types.DeadLetteredMessage{
+		CreatedAt:       result.CreatedAt,
+		ReplayedAt:      database.TimePointerFromNullTime(result.ReplayedAt),
+		ID:              result.ID,
+		Topic:           result.Topic,
+		DeadLetterTopic: result.DeadLetterTopic,
+		Payload:         result.Payload,
+		LastError:       result.LastError,
+		Attempts:        uint16(result.Attempts),
+	}
+
+	return deadLetteredMessage, nil
+}
+
+// GetDeadLetteredMessages fetches a list of dead-lettered messages that have not been replayed from the database.
+func (q *Querier) GetDeadLetteredMessages(ctx context.Context, filter *types.QueryFilter) (*types.QueryFilteredResult[types.DeadLetteredMessage], error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+
+	tracing.AttachQueryFilterToSpan(span, filter)
+	x := &types.QueryFilteredResult[types.DeadLetteredMessage]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.GetOutstandingDeadLetteredMessages(ctx, q.dbFor(ctx), &generated.GetOutstandingDeadLetteredMessagesParams{
+		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
+		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
+		QueryOffset:   database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:    database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching dead-lettered messages from database")
+	}
+
+	for _, result := range results {
+		x.Data = append(x.Data, &types.DeadLetteredMessage{
+			CreatedAt:       result.CreatedAt,
+			ReplayedAt:      database.TimePointerFromNullTime(result.ReplayedAt),
+			ID:              result.ID,
+			Topic:           result.Topic,
+			DeadLetterTopic: result.DeadLetterTopic,
+			Payload:         result.Payload,
+			LastError:       result.LastError,
+			Attempts:        uint16(result.Attempts),
+		})
+
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
+// CreateDeadLetteredMessage records a dead-lettered message in the database.
+func (q *Querier) CreateDeadLetteredMessage(ctx context.Context, input *types.DeadLetteredMessageDatabaseCreationInput) (*types.DeadLetteredMessage, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, input.ID)
+	logger := q.logger.WithValues(map[string]any{
+		keys.DeadLetteredMessageIDKey: input.ID,
+		keys.QueueTopicKey:            input.Topic,
+	})
+
+	if err := q.generatedQuerier.CreateDeadLetteredMessage(ctx, q.dbFor(ctx), &generated.CreateDeadLetteredMessageParams{
+		ID:              input.ID,
+		Topic:           input.Topic,
+		DeadLetterTopic: input.DeadLetterTopic,
+		Payload:         input.Payload,
+		LastError:       input.LastError,
+		Attempts:        int32(input.Attempts),
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing dead-lettered message creation query")
+	}
+
+	x := &types.DeadLetteredMessage{
+		CreatedAt:       q.currentTime(),
+		ID:              input.ID,
+		Topic:           input.Topic,
+		DeadLetterTopic: input.DeadLetterTopic,
+		Payload:         input.Payload,
+		LastError:       input.LastError,
+		Attempts:        input.Attempts,
+	}
+
+	logger.Info("dead-lettered message recorded")
+
+	return x, nil
+}
+
+// MarkDeadLetteredMessageAsReplayed marks a dead-lettered message as replayed. It returns sql.ErrNoRows
+// if the message doesn't exist or has already been replayed.
+func (q *Querier) MarkDeadLetteredMessageAsReplayed(ctx context.Context, deadLetteredMessageID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if deadLetteredMessageID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	rowsAffected, err := q.generatedQuerier.MarkDeadLetteredMessageAsReplayed(ctx, q.dbFor(ctx), deadLetteredMessageID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking dead-lettered message as replayed")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Info("dead-lettered message marked as replayed")
+
+	return nil
+}
+
+// UnmarkDeadLetteredMessageAsReplayed clears a dead-lettered message's replay claim, so that it can be replayed again.
+func (q *Querier) UnmarkDeadLetteredMessageAsReplayed(ctx context.Context, deadLetteredMessageID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if deadLetteredMessageID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	if err := q.generatedQuerier.UnmarkDeadLetteredMessageAsReplayed(ctx, q.dbFor(ctx), deadLetteredMessageID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "unmarking dead-lettered message as replayed")
+	}
+
+	logger.Info("dead-lettered message unmarked as replayed")
+
+	return nil
+}
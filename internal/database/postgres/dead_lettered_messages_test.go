@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDeadLetteredMessageForTest(t *testing.T, ctx context.Context, exampleDeadLetteredMessage *types.DeadLetteredMessage, dbc *Querier) *types.DeadLetteredMessage {
+	t.Helper()
+
+	// create
+	if exampleDeadLetteredMessage == nil {
+		exampleDeadLetteredMessage = fakes.BuildFakeDeadLetteredMessage()
+	}
+
+	dbInput := &types.DeadLetteredMessageDatabaseCreationInput{
+		ID:              exampleDeadLetteredMessage.ID,
+		Topic:           exampleDeadLetteredMessage.Topic,
+		DeadLetterTopic: exampleDeadLetteredMessage.DeadLetterTopic,
+		Payload:         exampleDeadLetteredMessage.Payload,
+		LastError:       exampleDeadLetteredMessage.LastError,
+		Attempts:        exampleDeadLetteredMessage.Attempts,
+	}
+
+	created, err := dbc.CreateDeadLetteredMessage(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+
+	exampleDeadLetteredMessage.CreatedAt = created.CreatedAt
+	exampleDeadLetteredMessage.ReplayedAt = nil
+	assert.Equal(t, exampleDeadLetteredMessage, created)
+
+	deadLetteredMessage, err := dbc.GetDeadLetteredMessage(ctx, created.ID)
+	require.NoError(t, err)
+	exampleDeadLetteredMessage.CreatedAt = deadLetteredMessage.CreatedAt
+	assert.Equal(t, exampleDeadLetteredMessage, deadLetteredMessage)
+
+	return created
+}
+
+func TestQuerier_Integration_DeadLetteredMessages(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	createdDeadLetteredMessages := []*types.DeadLetteredMessage{}
+
+	// create
+	for i := 0; i < exampleQuantity; i++ {
+		createdDeadLetteredMessages = append(createdDeadLetteredMessages, createDeadLetteredMessageForTest(t, ctx, nil, dbc))
+	}
+
+	// fetch as list
+	deadLetteredMessages, err := dbc.GetDeadLetteredMessages(ctx, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, deadLetteredMessages.Data)
+	assert.Equal(t, len(createdDeadLetteredMessages), len(deadLetteredMessages.Data))
+
+	// replay
+	for _, deadLetteredMessage := range createdDeadLetteredMessages {
+		assert.NoError(t, dbc.MarkDeadLetteredMessageAsReplayed(ctx, deadLetteredMessage.ID))
+
+		var y *types.DeadLetteredMessage
+		y, err = dbc.GetDeadLetteredMessage(ctx, deadLetteredMessage.ID)
+		assert.NoError(t, err)
+		assert.NotNil(t, y.ReplayedAt)
+
+		assert.ErrorIs(t, dbc.MarkDeadLetteredMessageAsReplayed(ctx, deadLetteredMessage.ID), sql.ErrNoRows)
+
+		// a released claim can be taken again.
+		assert.NoError(t, dbc.UnmarkDeadLetteredMessageAsReplayed(ctx, deadLetteredMessage.ID))
+		assert.NoError(t, dbc.MarkDeadLetteredMessageAsReplayed(ctx, deadLetteredMessage.ID))
+	}
+
+	deadLetteredMessages, err = dbc.GetDeadLetteredMessages(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, deadLetteredMessages.Data)
+}
+
+func TestQuerier_GetDeadLetteredMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid dead-lettered message ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetDeadLetteredMessage(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateDeadLetteredMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateDeadLetteredMessage(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_MarkDeadLetteredMessageAsReplayed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid dead-lettered message ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.MarkDeadLetteredMessageAsReplayed(ctx, ""))
+	})
+}
+
+func TestQuerier_UnmarkDeadLetteredMessageAsReplayed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid dead-lettered message ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.UnmarkDeadLetteredMessageAsReplayed(ctx, ""))
+	})
+}
@@ -121,6 +121,8 @@ func (q *Queries) CreateHouseholdInvitation(ctx context.Context, db DBTX, arg *C
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -175,64 +177,66 @@ type GetHouseholdInvitationByEmailAndTokenParams struct {
 }
 
 type GetHouseholdInvitationByEmailAndTokenRow struct {
-	ExpiresAt                                  time.Time
-	HouseholdCreatedAt                         time.Time
-	UserCreatedAt                              time.Time
-	CreatedAt                                  time.Time
-	UserLastIndexedAt                          sql.NullTime
-	UserEmailAddressVerifiedAt                 sql.NullTime
-	UserLastUpdatedAt                          sql.NullTime
-	LastUpdatedAt                              sql.NullTime
-	ArchivedAt                                 sql.NullTime
-	UserLastAcceptedPrivacyPolicy              sql.NullTime
-	UserLastAcceptedTermsOfService             sql.NullTime
-	UserArchivedAt                             sql.NullTime
-	UserBirthday                               sql.NullTime
-	UserTwoFactorSecretVerifiedAt              sql.NullTime
-	UserPasswordLastChangedAt                  sql.NullTime
-	HouseholdArchivedAt                        sql.NullTime
-	HouseholdLastUpdatedAt                     sql.NullTime
-	HouseholdLastPaymentProviderSyncOccurredAt sql.NullTime
-	HouseholdCountry                           string
-	HouseholdPaymentProcessorCustomerID        string
-	HouseholdID                                string
-	HouseholdName                              string
-	FromUser                                   string
-	HouseholdBillingStatus                     string
-	UserID                                     string
-	UserUsername                               string
-	StatusNote                                 string
-	UserEmailAddress                           string
-	UserHashedPassword                         string
-	ID                                         string
-	Status                                     InvitationState
-	UserTwoFactorSecret                        string
-	HouseholdZipCode                           string
-	UserServiceRole                            string
-	UserUserAccountStatus                      string
-	UserUserAccountStatusExplanation           string
-	HouseholdState                             string
-	HouseholdContactPhone                      string
-	HouseholdCity                              string
-	UserFirstName                              string
-	UserLastName                               string
-	HouseholdAddressLine2                      string
-	HouseholdAddressLine1                      string
-	HouseholdTimeZone                          TimeZone
-	HouseholdBelongsToUser                     string
-	DestinationHousehold                       string
-	HouseholdWebhookHmacSecret                 string
-	ToName                                     string
-	Note                                       string
-	ToEmail                                    string
-	Token                                      string
-	HouseholdSubscriptionPlanID                sql.NullString
-	UserEmailAddressVerificationToken          sql.NullString
-	UserAvatarSrc                              sql.NullString
-	ToUser                                     sql.NullString
-	HouseholdLatitude                          sql.NullString
-	HouseholdLongitude                         sql.NullString
-	UserRequiresPasswordChange                 bool
+	ExpiresAt                                   time.Time
+	HouseholdCreatedAt                          time.Time
+	UserCreatedAt                               time.Time
+	CreatedAt                                   time.Time
+	UserLastIndexedAt                           sql.NullTime
+	UserEmailAddressVerifiedAt                  sql.NullTime
+	UserLastUpdatedAt                           sql.NullTime
+	LastUpdatedAt                               sql.NullTime
+	ArchivedAt                                  sql.NullTime
+	UserLastAcceptedPrivacyPolicy               sql.NullTime
+	UserLastAcceptedTermsOfService              sql.NullTime
+	UserArchivedAt                              sql.NullTime
+	UserBirthday                                sql.NullTime
+	UserTwoFactorSecretVerifiedAt               sql.NullTime
+	UserPasswordLastChangedAt                   sql.NullTime
+	HouseholdArchivedAt                         sql.NullTime
+	HouseholdLastUpdatedAt                      sql.NullTime
+	HouseholdLastPaymentProviderSyncOccurredAt  sql.NullTime
+	HouseholdPreviousWebhookHmacSecretExpiresAt sql.NullTime
+	HouseholdCountry                            string
+	HouseholdPaymentProcessorCustomerID         string
+	HouseholdID                                 string
+	HouseholdName                               string
+	FromUser                                    string
+	HouseholdBillingStatus                      string
+	UserID                                      string
+	UserUsername                                string
+	StatusNote                                  string
+	UserEmailAddress                            string
+	UserHashedPassword                          string
+	ID                                          string
+	Status                                      InvitationState
+	UserTwoFactorSecret                         string
+	HouseholdZipCode                            string
+	UserServiceRole                             string
+	UserUserAccountStatus                       string
+	UserUserAccountStatusExplanation            string
+	HouseholdState                              string
+	HouseholdContactPhone                       string
+	HouseholdCity                               string
+	UserFirstName                               string
+	UserLastName                                string
+	HouseholdAddressLine2                       string
+	HouseholdAddressLine1                       string
+	HouseholdTimeZone                           TimeZone
+	HouseholdBelongsToUser                      string
+	DestinationHousehold                        string
+	HouseholdWebhookHmacSecret                  string
+	HouseholdPreviousWebhookHmacSecret          string
+	ToName                                      string
+	Note                                        string
+	ToEmail                                     string
+	Token                                       string
+	HouseholdSubscriptionPlanID                 sql.NullString
+	UserEmailAddressVerificationToken           sql.NullString
+	UserAvatarSrc                               sql.NullString
+	ToUser                                      sql.NullString
+	HouseholdLatitude                           sql.NullString
+	HouseholdLongitude                          sql.NullString
+	UserRequiresPasswordChange                  bool
 }
 
 func (q *Queries) GetHouseholdInvitationByEmailAndToken(ctx context.Context, db DBTX, arg *GetHouseholdInvitationByEmailAndTokenParams) (*GetHouseholdInvitationByEmailAndTokenRow, error) {
@@ -258,6 +262,8 @@ func (q *Queries) GetHouseholdInvitationByEmailAndToken(ctx context.Context, db
 		&i.HouseholdLongitude,
 		&i.HouseholdLastPaymentProviderSyncOccurredAt,
 		&i.HouseholdWebhookHmacSecret,
+		&i.HouseholdPreviousWebhookHmacSecret,
+		&i.HouseholdPreviousWebhookHmacSecretExpiresAt,
 		&i.HouseholdCreatedAt,
 		&i.HouseholdLastUpdatedAt,
 		&i.HouseholdArchivedAt,
@@ -323,6 +329,8 @@ func (q *Queries) GetHouseholdInvitationByEmailAndToken(ctx context.Context, db
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -377,64 +385,66 @@ type GetHouseholdInvitationByHouseholdAndIDParams struct {
 }
 
 type GetHouseholdInvitationByHouseholdAndIDRow struct {
-	ExpiresAt                                  time.Time
-	HouseholdCreatedAt                         time.Time
-	UserCreatedAt                              time.Time
-	CreatedAt                                  time.Time
-	UserLastIndexedAt                          sql.NullTime
-	UserEmailAddressVerifiedAt                 sql.NullTime
-	UserLastUpdatedAt                          sql.NullTime
-	LastUpdatedAt                              sql.NullTime
-	ArchivedAt                                 sql.NullTime
-	UserLastAcceptedPrivacyPolicy              sql.NullTime
-	UserLastAcceptedTermsOfService             sql.NullTime
-	UserArchivedAt                             sql.NullTime
-	UserBirthday                               sql.NullTime
-	UserTwoFactorSecretVerifiedAt              sql.NullTime
-	UserPasswordLastChangedAt                  sql.NullTime
-	HouseholdArchivedAt                        sql.NullTime
-	HouseholdLastUpdatedAt                     sql.NullTime
-	HouseholdLastPaymentProviderSyncOccurredAt sql.NullTime
-	HouseholdCountry                           string
-	HouseholdPaymentProcessorCustomerID        string
-	HouseholdID                                string
-	HouseholdName                              string
-	FromUser                                   string
-	HouseholdBillingStatus                     string
-	UserID                                     string
-	UserUsername                               string
-	StatusNote                                 string
-	UserEmailAddress                           string
-	UserHashedPassword                         string
-	ID                                         string
-	Status                                     InvitationState
-	UserTwoFactorSecret                        string
-	HouseholdZipCode                           string
-	UserServiceRole                            string
-	UserUserAccountStatus                      string
-	UserUserAccountStatusExplanation           string
-	HouseholdState                             string
-	HouseholdContactPhone                      string
-	HouseholdCity                              string
-	UserFirstName                              string
-	UserLastName                               string
-	HouseholdAddressLine2                      string
-	HouseholdAddressLine1                      string
-	HouseholdTimeZone                          TimeZone
-	HouseholdBelongsToUser                     string
-	DestinationHousehold                       string
-	HouseholdWebhookHmacSecret                 string
-	ToName                                     string
-	Note                                       string
-	ToEmail                                    string
-	Token                                      string
-	HouseholdSubscriptionPlanID                sql.NullString
-	UserEmailAddressVerificationToken          sql.NullString
-	UserAvatarSrc                              sql.NullString
-	ToUser                                     sql.NullString
-	HouseholdLatitude                          sql.NullString
-	HouseholdLongitude                         sql.NullString
-	UserRequiresPasswordChange                 bool
+	ExpiresAt                                   time.Time
+	HouseholdCreatedAt                          time.Time
+	UserCreatedAt                               time.Time
+	CreatedAt                                   time.Time
+	UserLastIndexedAt                           sql.NullTime
+	UserEmailAddressVerifiedAt                  sql.NullTime
+	UserLastUpdatedAt                           sql.NullTime
+	LastUpdatedAt                               sql.NullTime
+	ArchivedAt                                  sql.NullTime
+	UserLastAcceptedPrivacyPolicy               sql.NullTime
+	UserLastAcceptedTermsOfService              sql.NullTime
+	UserArchivedAt                              sql.NullTime
+	UserBirthday                                sql.NullTime
+	UserTwoFactorSecretVerifiedAt               sql.NullTime
+	UserPasswordLastChangedAt                   sql.NullTime
+	HouseholdArchivedAt                         sql.NullTime
+	HouseholdLastUpdatedAt                      sql.NullTime
+	HouseholdLastPaymentProviderSyncOccurredAt  sql.NullTime
+	HouseholdPreviousWebhookHmacSecretExpiresAt sql.NullTime
+	HouseholdCountry                            string
+	HouseholdPaymentProcessorCustomerID         string
+	HouseholdID                                 string
+	HouseholdName                               string
+	FromUser                                    string
+	HouseholdBillingStatus                      string
+	UserID                                      string
+	UserUsername                                string
+	StatusNote                                  string
+	UserEmailAddress                            string
+	UserHashedPassword                          string
+	ID                                          string
+	Status                                      InvitationState
+	UserTwoFactorSecret                         string
+	HouseholdZipCode                            string
+	UserServiceRole                             string
+	UserUserAccountStatus                       string
+	UserUserAccountStatusExplanation            string
+	HouseholdState                              string
+	HouseholdContactPhone                       string
+	HouseholdCity                               string
+	UserFirstName                               string
+	UserLastName                                string
+	HouseholdAddressLine2                       string
+	HouseholdAddressLine1                       string
+	HouseholdTimeZone                           TimeZone
+	HouseholdBelongsToUser                      string
+	DestinationHousehold                        string
+	HouseholdWebhookHmacSecret                  string
+	HouseholdPreviousWebhookHmacSecret          string
+	ToName                                      string
+	Note                                        string
+	ToEmail                                     string
+	Token                                       string
+	HouseholdSubscriptionPlanID                 sql.NullString
+	UserEmailAddressVerificationToken           sql.NullString
+	UserAvatarSrc                               sql.NullString
+	ToUser                                      sql.NullString
+	HouseholdLatitude                           sql.NullString
+	HouseholdLongitude                          sql.NullString
+	UserRequiresPasswordChange                  bool
 }
 
 func (q *Queries) GetHouseholdInvitationByHouseholdAndID(ctx context.Context, db DBTX, arg *GetHouseholdInvitationByHouseholdAndIDParams) (*GetHouseholdInvitationByHouseholdAndIDRow, error) {
@@ -460,6 +470,8 @@ func (q *Queries) GetHouseholdInvitationByHouseholdAndID(ctx context.Context, db
 		&i.HouseholdLongitude,
 		&i.HouseholdLastPaymentProviderSyncOccurredAt,
 		&i.HouseholdWebhookHmacSecret,
+		&i.HouseholdPreviousWebhookHmacSecret,
+		&i.HouseholdPreviousWebhookHmacSecretExpiresAt,
 		&i.HouseholdCreatedAt,
 		&i.HouseholdLastUpdatedAt,
 		&i.HouseholdArchivedAt,
@@ -525,6 +537,8 @@ func (q *Queries) GetHouseholdInvitationByHouseholdAndID(ctx context.Context, db
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -579,64 +593,66 @@ type GetHouseholdInvitationByTokenAndIDParams struct {
 }
 
 type GetHouseholdInvitationByTokenAndIDRow struct {
-	ExpiresAt                                  time.Time
-	HouseholdCreatedAt                         time.Time
-	UserCreatedAt                              time.Time
-	CreatedAt                                  time.Time
-	UserLastIndexedAt                          sql.NullTime
-	UserEmailAddressVerifiedAt                 sql.NullTime
-	UserLastUpdatedAt                          sql.NullTime
-	LastUpdatedAt                              sql.NullTime
-	ArchivedAt                                 sql.NullTime
-	UserLastAcceptedPrivacyPolicy              sql.NullTime
-	UserLastAcceptedTermsOfService             sql.NullTime
-	UserArchivedAt                             sql.NullTime
-	UserBirthday                               sql.NullTime
-	UserTwoFactorSecretVerifiedAt              sql.NullTime
-	UserPasswordLastChangedAt                  sql.NullTime
-	HouseholdArchivedAt                        sql.NullTime
-	HouseholdLastUpdatedAt                     sql.NullTime
-	HouseholdLastPaymentProviderSyncOccurredAt sql.NullTime
-	HouseholdCountry                           string
-	HouseholdPaymentProcessorCustomerID        string
-	HouseholdID                                string
-	HouseholdName                              string
-	FromUser                                   string
-	HouseholdBillingStatus                     string
-	UserID                                     string
-	UserUsername                               string
-	StatusNote                                 string
-	UserEmailAddress                           string
-	UserHashedPassword                         string
-	ID                                         string
-	Status                                     InvitationState
-	UserTwoFactorSecret                        string
-	HouseholdZipCode                           string
-	UserServiceRole                            string
-	UserUserAccountStatus                      string
-	UserUserAccountStatusExplanation           string
-	HouseholdState                             string
-	HouseholdContactPhone                      string
-	HouseholdCity                              string
-	UserFirstName                              string
-	UserLastName                               string
-	HouseholdAddressLine2                      string
-	HouseholdAddressLine1                      string
-	HouseholdTimeZone                          TimeZone
-	HouseholdBelongsToUser                     string
-	DestinationHousehold                       string
-	HouseholdWebhookHmacSecret                 string
-	ToName                                     string
-	Note                                       string
-	ToEmail                                    string
-	Token                                      string
-	HouseholdSubscriptionPlanID                sql.NullString
-	UserEmailAddressVerificationToken          sql.NullString
-	UserAvatarSrc                              sql.NullString
-	ToUser                                     sql.NullString
-	HouseholdLatitude                          sql.NullString
-	HouseholdLongitude                         sql.NullString
-	UserRequiresPasswordChange                 bool
+	ExpiresAt                                   time.Time
+	HouseholdCreatedAt                          time.Time
+	UserCreatedAt                               time.Time
+	CreatedAt                                   time.Time
+	UserLastIndexedAt                           sql.NullTime
+	UserEmailAddressVerifiedAt                  sql.NullTime
+	UserLastUpdatedAt                           sql.NullTime
+	LastUpdatedAt                               sql.NullTime
+	ArchivedAt                                  sql.NullTime
+	UserLastAcceptedPrivacyPolicy               sql.NullTime
+	UserLastAcceptedTermsOfService              sql.NullTime
+	UserArchivedAt                              sql.NullTime
+	UserBirthday                                sql.NullTime
+	UserTwoFactorSecretVerifiedAt               sql.NullTime
+	UserPasswordLastChangedAt                   sql.NullTime
+	HouseholdArchivedAt                         sql.NullTime
+	HouseholdLastUpdatedAt                      sql.NullTime
+	HouseholdLastPaymentProviderSyncOccurredAt  sql.NullTime
+	HouseholdPreviousWebhookHmacSecretExpiresAt sql.NullTime
+	HouseholdCountry                            string
+	HouseholdPaymentProcessorCustomerID         string
+	HouseholdID                                 string
+	HouseholdName                               string
+	FromUser                                    string
+	HouseholdBillingStatus                      string
+	UserID                                      string
+	UserUsername                                string
+	StatusNote                                  string
+	UserEmailAddress                            string
+	UserHashedPassword                          string
+	ID                                          string
+	Status                                      InvitationState
+	UserTwoFactorSecret                         string
+	HouseholdZipCode                            string
+	UserServiceRole                             string
+	UserUserAccountStatus                       string
+	UserUserAccountStatusExplanation            string
+	HouseholdState                              string
+	HouseholdContactPhone                       string
+	HouseholdCity                               string
+	UserFirstName                               string
+	UserLastName                                string
+	HouseholdAddressLine2                       string
+	HouseholdAddressLine1                       string
+	HouseholdTimeZone                           TimeZone
+	HouseholdBelongsToUser                      string
+	DestinationHousehold                        string
+	HouseholdWebhookHmacSecret                  string
+	HouseholdPreviousWebhookHmacSecret          string
+	ToName                                      string
+	Note                                        string
+	ToEmail                                     string
+	Token                                       string
+	HouseholdSubscriptionPlanID                 sql.NullString
+	UserEmailAddressVerificationToken           sql.NullString
+	UserAvatarSrc                               sql.NullString
+	ToUser                                      sql.NullString
+	HouseholdLatitude                           sql.NullString
+	HouseholdLongitude                          sql.NullString
+	UserRequiresPasswordChange                  bool
 }
 
 func (q *Queries) GetHouseholdInvitationByTokenAndID(ctx context.Context, db DBTX, arg *GetHouseholdInvitationByTokenAndIDParams) (*GetHouseholdInvitationByTokenAndIDRow, error) {
@@ -662,6 +678,8 @@ func (q *Queries) GetHouseholdInvitationByTokenAndID(ctx context.Context, db DBT
 		&i.HouseholdLongitude,
 		&i.HouseholdLastPaymentProviderSyncOccurredAt,
 		&i.HouseholdWebhookHmacSecret,
+		&i.HouseholdPreviousWebhookHmacSecret,
+		&i.HouseholdPreviousWebhookHmacSecretExpiresAt,
 		&i.HouseholdCreatedAt,
 		&i.HouseholdLastUpdatedAt,
 		&i.HouseholdArchivedAt,
@@ -727,6 +745,8 @@ func (q *Queries) GetHouseholdInvitationByTokenAndID(ctx context.Context, db DBT
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -818,66 +838,68 @@ type GetPendingInvitesForUserParams struct {
 }
 
 type GetPendingInvitesForUserRow struct {
-	ExpiresAt                                  time.Time
-	HouseholdCreatedAt                         time.Time
-	UserCreatedAt                              time.Time
-	CreatedAt                                  time.Time
-	UserLastIndexedAt                          sql.NullTime
-	UserEmailAddressVerifiedAt                 sql.NullTime
-	UserLastUpdatedAt                          sql.NullTime
-	LastUpdatedAt                              sql.NullTime
-	ArchivedAt                                 sql.NullTime
-	UserLastAcceptedPrivacyPolicy              sql.NullTime
-	UserLastAcceptedTermsOfService             sql.NullTime
-	UserArchivedAt                             sql.NullTime
-	UserBirthday                               sql.NullTime
-	UserTwoFactorSecretVerifiedAt              sql.NullTime
-	UserPasswordLastChangedAt                  sql.NullTime
-	HouseholdArchivedAt                        sql.NullTime
-	HouseholdLastUpdatedAt                     sql.NullTime
-	HouseholdLastPaymentProviderSyncOccurredAt sql.NullTime
-	UserTwoFactorSecret                        string
-	UserFirstName                              string
-	HouseholdID                                string
-	HouseholdName                              string
-	FromUser                                   string
-	HouseholdBillingStatus                     string
-	UserID                                     string
-	UserUsername                               string
-	StatusNote                                 string
-	UserEmailAddress                           string
-	UserHashedPassword                         string
-	HouseholdCountry                           string
-	Status                                     InvitationState
-	ID                                         string
-	HouseholdZipCode                           string
-	UserServiceRole                            string
-	UserUserAccountStatus                      string
-	UserUserAccountStatusExplanation           string
-	HouseholdState                             string
-	HouseholdContactPhone                      string
-	HouseholdCity                              string
-	HouseholdWebhookHmacSecret                 string
-	UserLastName                               string
-	HouseholdAddressLine2                      string
-	HouseholdAddressLine1                      string
-	HouseholdTimeZone                          TimeZone
-	HouseholdBelongsToUser                     string
-	DestinationHousehold                       string
-	HouseholdPaymentProcessorCustomerID        string
-	ToName                                     string
-	Note                                       string
-	ToEmail                                    string
-	Token                                      string
-	HouseholdSubscriptionPlanID                sql.NullString
-	UserEmailAddressVerificationToken          sql.NullString
-	UserAvatarSrc                              sql.NullString
-	ToUser                                     sql.NullString
-	HouseholdLatitude                          sql.NullString
-	HouseholdLongitude                         sql.NullString
-	FilteredCount                              int64
-	TotalCount                                 int64
-	UserRequiresPasswordChange                 bool
+	ExpiresAt                                   time.Time
+	HouseholdCreatedAt                          time.Time
+	UserCreatedAt                               time.Time
+	CreatedAt                                   time.Time
+	UserLastIndexedAt                           sql.NullTime
+	UserEmailAddressVerifiedAt                  sql.NullTime
+	UserLastUpdatedAt                           sql.NullTime
+	LastUpdatedAt                               sql.NullTime
+	ArchivedAt                                  sql.NullTime
+	UserLastAcceptedPrivacyPolicy               sql.NullTime
+	UserLastAcceptedTermsOfService              sql.NullTime
+	UserArchivedAt                              sql.NullTime
+	UserBirthday                                sql.NullTime
+	UserTwoFactorSecretVerifiedAt               sql.NullTime
+	UserPasswordLastChangedAt                   sql.NullTime
+	HouseholdArchivedAt                         sql.NullTime
+	HouseholdLastUpdatedAt                      sql.NullTime
+	HouseholdLastPaymentProviderSyncOccurredAt  sql.NullTime
+	HouseholdPreviousWebhookHmacSecretExpiresAt sql.NullTime
+	UserTwoFactorSecret                         string
+	UserFirstName                               string
+	HouseholdID                                 string
+	HouseholdName                               string
+	FromUser                                    string
+	HouseholdBillingStatus                      string
+	UserID                                      string
+	UserUsername                                string
+	StatusNote                                  string
+	UserEmailAddress                            string
+	UserHashedPassword                          string
+	HouseholdCountry                            string
+	Status                                      InvitationState
+	ID                                          string
+	HouseholdZipCode                            string
+	UserServiceRole                             string
+	UserUserAccountStatus                       string
+	UserUserAccountStatusExplanation            string
+	HouseholdState                              string
+	HouseholdContactPhone                       string
+	HouseholdCity                               string
+	HouseholdWebhookHmacSecret                  string
+	HouseholdPreviousWebhookHmacSecret          string
+	UserLastName                                string
+	HouseholdAddressLine2                       string
+	HouseholdAddressLine1                       string
+	HouseholdTimeZone                           TimeZone
+	HouseholdBelongsToUser                      string
+	DestinationHousehold                        string
+	HouseholdPaymentProcessorCustomerID         string
+	ToName                                      string
+	Note                                        string
+	ToEmail                                     string
+	Token                                       string
+	HouseholdSubscriptionPlanID                 sql.NullString
+	UserEmailAddressVerificationToken           sql.NullString
+	UserAvatarSrc                               sql.NullString
+	ToUser                                      sql.NullString
+	HouseholdLatitude                           sql.NullString
+	HouseholdLongitude                          sql.NullString
+	FilteredCount                               int64
+	TotalCount                                  int64
+	UserRequiresPasswordChange                  bool
 }
 
 func (q *Queries) GetPendingInvitesForUser(ctx context.Context, db DBTX, arg *GetPendingInvitesForUserParams) ([]*GetPendingInvitesForUserRow, error) {
@@ -918,6 +940,8 @@ func (q *Queries) GetPendingInvitesForUser(ctx context.Context, db DBTX, arg *Ge
 			&i.HouseholdLongitude,
 			&i.HouseholdLastPaymentProviderSyncOccurredAt,
 			&i.HouseholdWebhookHmacSecret,
+			&i.HouseholdPreviousWebhookHmacSecret,
+			&i.HouseholdPreviousWebhookHmacSecretExpiresAt,
 			&i.HouseholdCreatedAt,
 			&i.HouseholdLastUpdatedAt,
 			&i.HouseholdArchivedAt,
@@ -995,6 +1019,8 @@ func (q *Queries) GetPendingInvitesForUser(ctx context.Context, db DBTX, arg *Ge
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -1086,66 +1112,68 @@ type GetPendingInvitesFromUserParams struct {
 }
 
 type GetPendingInvitesFromUserRow struct {
-	ExpiresAt                                  time.Time
-	HouseholdCreatedAt                         time.Time
-	UserCreatedAt                              time.Time
-	CreatedAt                                  time.Time
-	UserLastIndexedAt                          sql.NullTime
-	UserEmailAddressVerifiedAt                 sql.NullTime
-	UserLastUpdatedAt                          sql.NullTime
-	LastUpdatedAt                              sql.NullTime
-	ArchivedAt                                 sql.NullTime
-	UserLastAcceptedPrivacyPolicy              sql.NullTime
-	UserLastAcceptedTermsOfService             sql.NullTime
-	UserArchivedAt                             sql.NullTime
-	UserBirthday                               sql.NullTime
-	UserTwoFactorSecretVerifiedAt              sql.NullTime
-	UserPasswordLastChangedAt                  sql.NullTime
-	HouseholdArchivedAt                        sql.NullTime
-	HouseholdLastUpdatedAt                     sql.NullTime
-	HouseholdLastPaymentProviderSyncOccurredAt sql.NullTime
-	UserTwoFactorSecret                        string
-	UserFirstName                              string
-	HouseholdID                                string
-	HouseholdName                              string
-	FromUser                                   string
-	HouseholdBillingStatus                     string
-	UserID                                     string
-	UserUsername                               string
-	StatusNote                                 string
-	UserEmailAddress                           string
-	UserHashedPassword                         string
-	HouseholdCountry                           string
-	Status                                     InvitationState
-	ID                                         string
-	HouseholdZipCode                           string
-	UserServiceRole                            string
-	UserUserAccountStatus                      string
-	UserUserAccountStatusExplanation           string
-	HouseholdState                             string
-	HouseholdContactPhone                      string
-	HouseholdCity                              string
-	HouseholdWebhookHmacSecret                 string
-	UserLastName                               string
-	HouseholdAddressLine2                      string
-	HouseholdAddressLine1                      string
-	HouseholdTimeZone                          TimeZone
-	HouseholdBelongsToUser                     string
-	DestinationHousehold                       string
-	HouseholdPaymentProcessorCustomerID        string
-	ToName                                     string
-	Note                                       string
-	ToEmail                                    string
-	Token                                      string
-	HouseholdSubscriptionPlanID                sql.NullString
-	UserEmailAddressVerificationToken          sql.NullString
-	UserAvatarSrc                              sql.NullString
-	ToUser                                     sql.NullString
-	HouseholdLatitude                          sql.NullString
-	HouseholdLongitude                         sql.NullString
-	FilteredCount                              int64
-	TotalCount                                 int64
-	UserRequiresPasswordChange                 bool
+	ExpiresAt                                   time.Time
+	HouseholdCreatedAt                          time.Time
+	UserCreatedAt                               time.Time
+	CreatedAt                                   time.Time
+	UserLastIndexedAt                           sql.NullTime
+	UserEmailAddressVerifiedAt                  sql.NullTime
+	UserLastUpdatedAt                           sql.NullTime
+	LastUpdatedAt                               sql.NullTime
+	ArchivedAt                                  sql.NullTime
+	UserLastAcceptedPrivacyPolicy               sql.NullTime
+	UserLastAcceptedTermsOfService              sql.NullTime
+	UserArchivedAt                              sql.NullTime
+	UserBirthday                                sql.NullTime
+	UserTwoFactorSecretVerifiedAt               sql.NullTime
+	UserPasswordLastChangedAt                   sql.NullTime
+	HouseholdArchivedAt                         sql.NullTime
+	HouseholdLastUpdatedAt                      sql.NullTime
+	HouseholdLastPaymentProviderSyncOccurredAt  sql.NullTime
+	HouseholdPreviousWebhookHmacSecretExpiresAt sql.NullTime
+	UserTwoFactorSecret                         string
+	UserFirstName                               string
+	HouseholdID                                 string
+	HouseholdName                               string
+	FromUser                                    string
+	HouseholdBillingStatus                      string
+	UserID                                      string
+	UserUsername                                string
+	StatusNote                                  string
+	UserEmailAddress                            string
+	UserHashedPassword                          string
+	HouseholdCountry                            string
+	Status                                      InvitationState
+	ID                                          string
+	HouseholdZipCode                            string
+	UserServiceRole                             string
+	UserUserAccountStatus                       string
+	UserUserAccountStatusExplanation            string
+	HouseholdState                              string
+	HouseholdContactPhone                       string
+	HouseholdCity                               string
+	HouseholdWebhookHmacSecret                  string
+	HouseholdPreviousWebhookHmacSecret          string
+	UserLastName                                string
+	HouseholdAddressLine2                       string
+	HouseholdAddressLine1                       string
+	HouseholdTimeZone                           TimeZone
+	HouseholdBelongsToUser                      string
+	DestinationHousehold                        string
+	HouseholdPaymentProcessorCustomerID         string
+	ToName                                      string
+	Note                                        string
+	ToEmail                                     string
+	Token                                       string
+	HouseholdSubscriptionPlanID                 sql.NullString
+	UserEmailAddressVerificationToken           sql.NullString
+	UserAvatarSrc                               sql.NullString
+	ToUser                                      sql.NullString
+	HouseholdLatitude                           sql.NullString
+	HouseholdLongitude                          sql.NullString
+	FilteredCount                               int64
+	TotalCount                                  int64
+	UserRequiresPasswordChange                  bool
 }
 
 func (q *Queries) GetPendingInvitesFromUser(ctx context.Context, db DBTX, arg *GetPendingInvitesFromUserParams) ([]*GetPendingInvitesFromUserRow, error) {
@@ -1186,6 +1214,8 @@ func (q *Queries) GetPendingInvitesFromUser(ctx context.Context, db DBTX, arg *G
 			&i.HouseholdLongitude,
 			&i.HouseholdLastPaymentProviderSyncOccurredAt,
 			&i.HouseholdWebhookHmacSecret,
+			&i.HouseholdPreviousWebhookHmacSecret,
+			&i.HouseholdPreviousWebhookHmacSecretExpiresAt,
 			&i.HouseholdCreatedAt,
 			&i.HouseholdLastUpdatedAt,
 			&i.HouseholdArchivedAt,
@@ -159,6 +159,8 @@ func (q *Queries) CreateHousehold(ctx context.Context, db DBTX, arg *CreateHouse
 	households.longitude,
 	households.last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret,
+	households.previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at,
 	households.created_at,
 	households.last_updated_at,
 	households.archived_at,
@@ -202,58 +204,60 @@ func (q *Queries) CreateHousehold(ctx context.Context, db DBTX, arg *CreateHouse
 `
 
 type GetHouseholdByIDWithMembershipsRow struct {
-	MembershipCreatedAt               time.Time
-	CreatedAt                         time.Time
-	UserCreatedAt                     time.Time
-	UserLastAcceptedPrivacyPolicy     sql.NullTime
-	UserEmailAddressVerifiedAt        sql.NullTime
-	UserLastUpdatedAt                 sql.NullTime
-	MembershipLastUpdatedAt           sql.NullTime
-	UserLastIndexedAt                 sql.NullTime
-	MembershipArchivedAt              sql.NullTime
-	UserLastAcceptedTermsOfService    sql.NullTime
-	UserArchivedAt                    sql.NullTime
-	UserBirthday                      sql.NullTime
-	UserTwoFactorSecretVerifiedAt     sql.NullTime
-	UserPasswordLastChangedAt         sql.NullTime
-	ArchivedAt                        sql.NullTime
-	LastUpdatedAt                     sql.NullTime
-	LastPaymentProviderSyncOccurredAt sql.NullTime
-	Country                           string
-	UserServiceRole                   string
-	Name                              string
-	BillingStatus                     string
-	UserID                            string
-	UserUsername                      string
-	ContactPhone                      string
-	UserEmailAddress                  string
-	UserHashedPassword                string
-	ID                                string
-	MembershipHouseholdRole           string
-	UserTwoFactorSecret               string
-	ZipCode                           string
-	MembershipBelongsToHousehold      string
-	UserUserAccountStatus             string
-	UserUserAccountStatusExplanation  string
-	State                             string
-	WebhookHmacSecret                 string
-	City                              string
-	UserFirstName                     string
-	UserLastName                      string
-	AddressLine2                      string
-	AddressLine1                      string
-	TimeZone                          TimeZone
-	BelongsToUser                     string
-	MembershipBelongsToUser           string
-	PaymentProcessorCustomerID        string
-	MembershipID                      string
-	UserEmailAddressVerificationToken sql.NullString
-	SubscriptionPlanID                sql.NullString
-	UserAvatarSrc                     sql.NullString
-	Latitude                          sql.NullString
-	Longitude                         sql.NullString
-	MembershipDefaultHousehold        bool
-	UserRequiresPasswordChange        bool
+	MembershipCreatedAt                time.Time
+	CreatedAt                          time.Time
+	UserCreatedAt                      time.Time
+	UserLastAcceptedPrivacyPolicy      sql.NullTime
+	UserEmailAddressVerifiedAt         sql.NullTime
+	UserLastUpdatedAt                  sql.NullTime
+	MembershipLastUpdatedAt            sql.NullTime
+	UserLastIndexedAt                  sql.NullTime
+	MembershipArchivedAt               sql.NullTime
+	UserLastAcceptedTermsOfService     sql.NullTime
+	UserArchivedAt                     sql.NullTime
+	UserBirthday                       sql.NullTime
+	UserTwoFactorSecretVerifiedAt      sql.NullTime
+	UserPasswordLastChangedAt          sql.NullTime
+	ArchivedAt                         sql.NullTime
+	LastUpdatedAt                      sql.NullTime
+	LastPaymentProviderSyncOccurredAt  sql.NullTime
+	PreviousWebhookHmacSecretExpiresAt sql.NullTime
+	Country                            string
+	UserServiceRole                    string
+	Name                               string
+	BillingStatus                      string
+	UserID                             string
+	UserUsername                       string
+	ContactPhone                       string
+	UserEmailAddress                   string
+	UserHashedPassword                 string
+	ID                                 string
+	MembershipHouseholdRole            string
+	UserTwoFactorSecret                string
+	ZipCode                            string
+	MembershipBelongsToHousehold       string
+	UserUserAccountStatus              string
+	UserUserAccountStatusExplanation   string
+	State                              string
+	WebhookHmacSecret                  string
+	PreviousWebhookHmacSecret          string
+	City                               string
+	UserFirstName                      string
+	UserLastName                       string
+	AddressLine2                       string
+	AddressLine1                       string
+	TimeZone                           TimeZone
+	BelongsToUser                      string
+	MembershipBelongsToUser            string
+	PaymentProcessorCustomerID         string
+	MembershipID                       string
+	UserEmailAddressVerificationToken  sql.NullString
+	SubscriptionPlanID                 sql.NullString
+	UserAvatarSrc                      sql.NullString
+	Latitude                           sql.NullString
+	Longitude                          sql.NullString
+	MembershipDefaultHousehold         bool
+	UserRequiresPasswordChange         bool
 }
 
 func (q *Queries) GetHouseholdByIDWithMemberships(ctx context.Context, db DBTX, id string) ([]*GetHouseholdByIDWithMembershipsRow, error) {
@@ -284,6 +288,8 @@ func (q *Queries) GetHouseholdByIDWithMemberships(ctx context.Context, db DBTX,
 			&i.Longitude,
 			&i.LastPaymentProviderSyncOccurredAt,
 			&i.WebhookHmacSecret,
+			&i.PreviousWebhookHmacSecret,
+			&i.PreviousWebhookHmacSecretExpiresAt,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
@@ -353,6 +359,8 @@ func (q *Queries) GetHouseholdByIDWithMemberships(ctx context.Context, db DBTX,
 	households.longitude,
 	households.last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret,
+	households.previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at,
 	households.created_at,
 	households.last_updated_at,
 	households.archived_at,
@@ -409,29 +417,31 @@ type GetHouseholdsForUserParams struct {
 }
 
 type GetHouseholdsForUserRow struct {
-	CreatedAt                         time.Time
-	LastPaymentProviderSyncOccurredAt sql.NullTime
-	LastUpdatedAt                     sql.NullTime
-	ArchivedAt                        sql.NullTime
-	AddressLine1                      string
-	State                             string
-	BelongsToUser                     string
-	TimeZone                          TimeZone
-	PaymentProcessorCustomerID        string
-	AddressLine2                      string
-	City                              string
-	ID                                string
-	ZipCode                           string
-	Country                           string
-	ContactPhone                      string
-	BillingStatus                     string
-	Name                              string
-	WebhookHmacSecret                 string
-	SubscriptionPlanID                sql.NullString
-	Longitude                         sql.NullString
-	Latitude                          sql.NullString
-	FilteredCount                     int64
-	TotalCount                        int64
+	CreatedAt                          time.Time
+	LastPaymentProviderSyncOccurredAt  sql.NullTime
+	PreviousWebhookHmacSecretExpiresAt sql.NullTime
+	LastUpdatedAt                      sql.NullTime
+	ArchivedAt                         sql.NullTime
+	AddressLine1                       string
+	State                              string
+	BelongsToUser                      string
+	TimeZone                           TimeZone
+	PaymentProcessorCustomerID         string
+	AddressLine2                       string
+	City                               string
+	ID                                 string
+	ZipCode                            string
+	Country                            string
+	ContactPhone                       string
+	BillingStatus                      string
+	Name                               string
+	WebhookHmacSecret                  string
+	PreviousWebhookHmacSecret          string
+	SubscriptionPlanID                 sql.NullString
+	Longitude                          sql.NullString
+	Latitude                           sql.NullString
+	FilteredCount                      int64
+	TotalCount                         int64
 }
 
 func (q *Queries) GetHouseholdsForUser(ctx context.Context, db DBTX, arg *GetHouseholdsForUserParams) ([]*GetHouseholdsForUserRow, error) {
@@ -470,6 +480,8 @@ func (q *Queries) GetHouseholdsForUser(ctx context.Context, db DBTX, arg *GetHou
 			&i.Longitude,
 			&i.LastPaymentProviderSyncOccurredAt,
 			&i.WebhookHmacSecret,
+			&i.PreviousWebhookHmacSecret,
+			&i.PreviousWebhookHmacSecretExpiresAt,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
@@ -489,6 +501,44 @@ func (q *Queries) GetHouseholdsForUser(ctx context.Context, db DBTX, arg *GetHou
 	return items, nil
 }
 
+const rotateHouseholdWebhookEncryptionKey = `-- name: RotateHouseholdWebhookEncryptionKey :execrows
+
+UPDATE households SET
+	previous_webhook_hmac_secret = webhook_hmac_secret,
+	previous_webhook_hmac_secret_expires_at = $1,
+	webhook_hmac_secret = $2,
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND (previous_webhook_hmac_secret_expires_at IS NULL OR previous_webhook_hmac_secret_expires_at <= NOW())
+	AND id = $3
+	AND id IN (
+		SELECT household_user_memberships.belongs_to_household
+		FROM household_user_memberships
+		WHERE household_user_memberships.archived_at IS NULL
+			AND household_user_memberships.belongs_to_user = $4
+	)
+`
+
+type RotateHouseholdWebhookEncryptionKeyParams struct {
+	PreviousWebhookHmacSecretExpiresAt sql.NullTime
+	WebhookHmacSecret                  string
+	ID                                 string
+	BelongsToUser                      string
+}
+
+func (q *Queries) RotateHouseholdWebhookEncryptionKey(ctx context.Context, db DBTX, arg *RotateHouseholdWebhookEncryptionKeyParams) (int64, error) {
+	result, err := db.ExecContext(ctx, rotateHouseholdWebhookEncryptionKey,
+		arg.PreviousWebhookHmacSecretExpiresAt,
+		arg.WebhookHmacSecret,
+		arg.ID,
+		arg.BelongsToUser,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateHousehold = `-- name: UpdateHousehold :execrows
 
 UPDATE households SET
@@ -544,6 +594,41 @@ func (q *Queries) UpdateHousehold(ctx context.Context, db DBTX, arg *UpdateHouse
 	return result.RowsAffected()
 }
 
+const updateHouseholdBillingStatus = `-- name: UpdateHouseholdBillingStatus :execrows
+
+UPDATE households SET
+	billing_status = $1,
+	payment_processor_customer_id = $2,
+	subscription_plan_id = $3,
+	last_payment_provider_sync_occurred_at = $4,
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND (last_payment_provider_sync_occurred_at IS NULL OR last_payment_provider_sync_occurred_at <= $4)
+	AND id = $5
+`
+
+type UpdateHouseholdBillingStatusParams struct {
+	OccurredAt                 time.Time
+	BillingStatus              string
+	PaymentProcessorCustomerID string
+	SubscriptionPlanID         sql.NullString
+	ID                         string
+}
+
+func (q *Queries) UpdateHouseholdBillingStatus(ctx context.Context, db DBTX, arg *UpdateHouseholdBillingStatusParams) (int64, error) {
+	result, err := db.ExecContext(ctx, updateHouseholdBillingStatus,
+		arg.BillingStatus,
+		arg.PaymentProcessorCustomerID,
+		arg.SubscriptionPlanID,
+		arg.OccurredAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateHouseholdWebhookEncryptionKey = `-- name: UpdateHouseholdWebhookEncryptionKey :execrows
 
 UPDATE households SET
@@ -154,6 +154,70 @@ func AllComponentTypeValues() []ComponentType {
 	}
 }
 
+type CookingSessionProgressType string
+
+const (
+	CookingSessionProgressTypeStepCompleted       CookingSessionProgressType = "step_completed"
+	CookingSessionProgressTypeStepSkipped         CookingSessionProgressType = "step_skipped"
+	CookingSessionProgressTypeConditionSatisfied  CookingSessionProgressType = "condition_satisfied"
+	CookingSessionProgressTypeObservationRecorded CookingSessionProgressType = "observation_recorded"
+)
+
+func (e *CookingSessionProgressType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CookingSessionProgressType(s)
+	case string:
+		*e = CookingSessionProgressType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CookingSessionProgressType: %T", src)
+	}
+	return nil
+}
+
+type NullCookingSessionProgressType struct {
+	CookingSessionProgressType CookingSessionProgressType
+	Valid                      bool // Valid is true if CookingSessionProgressType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCookingSessionProgressType) Scan(value interface{}) error {
+	if value == nil {
+		ns.CookingSessionProgressType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CookingSessionProgressType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCookingSessionProgressType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CookingSessionProgressType), nil
+}
+
+func (e CookingSessionProgressType) Valid() bool {
+	switch e {
+	case CookingSessionProgressTypeStepCompleted,
+		CookingSessionProgressTypeStepSkipped,
+		CookingSessionProgressTypeConditionSatisfied,
+		CookingSessionProgressTypeObservationRecorded:
+		return true
+	}
+	return false
+}
+
+func AllCookingSessionProgressTypeValues() []CookingSessionProgressType {
+	return []CookingSessionProgressType{
+		CookingSessionProgressTypeStepCompleted,
+		CookingSessionProgressTypeStepSkipped,
+		CookingSessionProgressTypeConditionSatisfied,
+		CookingSessionProgressTypeObservationRecorded,
+	}
+}
+
 type GroceryListItemStatus string
 
 const (
@@ -492,67 +556,67 @@ func AllMealPlanStatusValues() []MealPlanStatus {
 	}
 }
 
-type Oauth2ClientTokenScopes string
+type PantryStorageLocation string
 
 const (
-	Oauth2ClientTokenScopesUnknown         Oauth2ClientTokenScopes = "unknown"
-	Oauth2ClientTokenScopesHouseholdMember Oauth2ClientTokenScopes = "household_member"
-	Oauth2ClientTokenScopesHouseholdAdmin  Oauth2ClientTokenScopes = "household_admin"
-	Oauth2ClientTokenScopesServiceAdmin    Oauth2ClientTokenScopes = "service_admin"
+	PantryStorageLocationPantry       PantryStorageLocation = "pantry"
+	PantryStorageLocationRefrigerator PantryStorageLocation = "refrigerator"
+	PantryStorageLocationFreezer      PantryStorageLocation = "freezer"
+	PantryStorageLocationOther        PantryStorageLocation = "other"
 )
 
-func (e *Oauth2ClientTokenScopes) Scan(src interface{}) error {
+func (e *PantryStorageLocation) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = Oauth2ClientTokenScopes(s)
+		*e = PantryStorageLocation(s)
 	case string:
-		*e = Oauth2ClientTokenScopes(s)
+		*e = PantryStorageLocation(s)
 	default:
-		return fmt.Errorf("unsupported scan type for Oauth2ClientTokenScopes: %T", src)
+		return fmt.Errorf("unsupported scan type for PantryStorageLocation: %T", src)
 	}
 	return nil
 }
 
-type NullOauth2ClientTokenScopes struct {
-	Oauth2ClientTokenScopes Oauth2ClientTokenScopes
-	Valid                   bool // Valid is true if Oauth2ClientTokenScopes is not NULL
+type NullPantryStorageLocation struct {
+	PantryStorageLocation PantryStorageLocation
+	Valid                 bool // Valid is true if PantryStorageLocation is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOauth2ClientTokenScopes) Scan(value interface{}) error {
+func (ns *NullPantryStorageLocation) Scan(value interface{}) error {
 	if value == nil {
-		ns.Oauth2ClientTokenScopes, ns.Valid = "", false
+		ns.PantryStorageLocation, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.Oauth2ClientTokenScopes.Scan(value)
+	return ns.PantryStorageLocation.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOauth2ClientTokenScopes) Value() (driver.Value, error) {
+func (ns NullPantryStorageLocation) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.Oauth2ClientTokenScopes), nil
+	return string(ns.PantryStorageLocation), nil
 }
 
-func (e Oauth2ClientTokenScopes) Valid() bool {
+func (e PantryStorageLocation) Valid() bool {
 	switch e {
-	case Oauth2ClientTokenScopesUnknown,
-		Oauth2ClientTokenScopesHouseholdMember,
-		Oauth2ClientTokenScopesHouseholdAdmin,
-		Oauth2ClientTokenScopesServiceAdmin:
+	case PantryStorageLocationPantry,
+		PantryStorageLocationRefrigerator,
+		PantryStorageLocationFreezer,
+		PantryStorageLocationOther:
 		return true
 	}
 	return false
 }
 
-func AllOauth2ClientTokenScopesValues() []Oauth2ClientTokenScopes {
-	return []Oauth2ClientTokenScopes{
-		Oauth2ClientTokenScopesUnknown,
-		Oauth2ClientTokenScopesHouseholdMember,
-		Oauth2ClientTokenScopesHouseholdAdmin,
-		Oauth2ClientTokenScopesServiceAdmin,
+func AllPantryStorageLocationValues() []PantryStorageLocation {
+	return []PantryStorageLocation{
+		PantryStorageLocationPantry,
+		PantryStorageLocationRefrigerator,
+		PantryStorageLocationFreezer,
+		PantryStorageLocationOther,
 	}
 }
 
@@ -942,6 +1006,8 @@ func AllUserNotificationStatusValues() []UserNotificationStatus {
 const (
 	ValidElectionMethodSchulze       ValidElectionMethod = "schulze"
 	ValidElectionMethodInstantRunoff ValidElectionMethod = "instant-runoff"
+	ValidElectionMethodPlurality     ValidElectionMethod = "plurality"
+	ValidElectionMethodBorda         ValidElectionMethod = "borda"
 )
 
 func (e *ValidElectionMethod) Scan(src interface{}) error {
@@ -982,7 +1048,9 @@ func (ns NullValidElectionMethod) Value() (driver.Value, error) {
 func (e ValidElectionMethod) Valid() bool {
 	switch e {
 	case ValidElectionMethodSchulze,
-		ValidElectionMethodInstantRunoff:
+		ValidElectionMethodInstantRunoff,
+		ValidElectionMethodPlurality,
+		ValidElectionMethodBorda:
 		return true
 	}
 	return false
@@ -992,6 +1060,8 @@ func AllValidElectionMethodValues() []ValidElectionMethod {
 	return []ValidElectionMethod{
 		ValidElectionMethodSchulze,
 		ValidElectionMethodInstantRunoff,
+		ValidElectionMethodPlurality,
+		ValidElectionMethodBorda,
 	}
 }
 
@@ -1132,6 +1202,38 @@ func AllWebhookEventValues() []WebhookEvent {
 	}
 }
 
+type CookingSessionProgressEntries struct {
+	CreatedAt               time.Time
+	ID                      string
+	ProgressType            CookingSessionProgressType
+	Subject                 string
+	Value                   string
+	RecordedByUser          string
+	BelongsToCookingSession string
+}
+
+type CookingSessions struct {
+	CreatedAt          time.Time
+	LastUpdatedAt      sql.NullTime
+	CompletedAt        sql.NullTime
+	ArchivedAt         sql.NullTime
+	ID                 string
+	RecipeID           string
+	BelongsToHousehold string
+	CreatedByUser      string
+}
+
+type DeadLetteredMessages struct {
+	CreatedAt       time.Time
+	ReplayedAt      sql.NullTime
+	ID              string
+	Topic           string
+	DeadLetterTopic string
+	Payload         string
+	LastError       string
+	Attempts        int32
+}
+
 type HouseholdUserMemberships struct {
 	CreatedAt          time.Time
 	LastUpdatedAt      sql.NullTime
@@ -1176,7 +1278,7 @@ type Oauth2ClientTokens struct {
 	AccessCreatedAt     time.Time
 	CodeChallenge       string
 	CodeChallengeMethod string
-	Scope               Oauth2ClientTokenScopes
+	Scope               string
 	ClientID            string
 	Access              string
 	Code                string
@@ -1187,13 +1289,38 @@ type Oauth2ClientTokens struct {
 }
 
 type Oauth2Clients struct {
-	ID           string
-	Name         string
-	Description  string
-	ClientID     string
-	ClientSecret string
-	CreatedAt    time.Time
-	ArchivedAt   sql.NullTime
+	ID                 string
+	Name               string
+	Description        string
+	ClientID           string
+	ClientSecret       string
+	CreatedAt          time.Time
+	ArchivedAt         sql.NullTime
+	Domain             string
+	BelongsToHousehold sql.NullString
+	Scopes             []string
+}
+
+type OutboxMessages struct {
+	CreatedAt          time.Time
+	ClaimedUntil       sql.NullTime
+	PublishedAt        sql.NullTime
+	BelongsToHousehold sql.NullString
+	ID                 string
+	Topic              string
+	IdempotencyKey     string
+	Payload            string
+	LastError          string
+	EventType          string
+	Sequence           int64
+	Attempts           int32
+}
+
+type PasskeyCeremonies struct {
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	ID          string
+	SessionData []byte
 }
 
 type RecipeRatings struct {
@@ -1233,6 +1360,32 @@ type UserNotifications struct {
 	LastUpdatedAt sql.NullTime
 }
 
+type UserPasskeys struct {
+	CreatedAt       time.Time
+	LastUsedAt      sql.NullTime
+	ArchivedAt      sql.NullTime
+	ID              string
+	Name            string
+	CredentialID    string
+	AttestationType string
+	BelongsToUser   string
+	PublicKey       []byte
+	Aaguid          []byte
+	Transports      []string
+	SignCount       int64
+	BackupEligible  bool
+	BackupState     bool
+}
+
+type UserRecoveryCodes struct {
+	CreatedAt     time.Time
+	RedeemedAt    sql.NullTime
+	ArchivedAt    sql.NullTime
+	ID            string
+	HashedCode    string
+	BelongsToUser string
+}
+
 type ValidVessels struct {
 	CreatedAt                      time.Time
 	ArchivedAt                     sql.NullTime
@@ -1255,14 +1408,32 @@ type ValidVessels struct {
 	UsableForStorage               bool
 }
 
+type WebhookDeliveries struct {
+	CreatedAt             time.Time
+	NextAttemptAt         sql.NullTime
+	ResponseStatusCode    sql.NullInt32
+	ID                    string
+	DeliveryID            string
+	TriggerEvent          string
+	Payload               string
+	ResponseBody          string
+	Error                 string
+	BelongsToWebhook      string
+	LatencyInMilliseconds int64
+	Attempt               int32
+	Succeeded             bool
+}
+
 type Webhooks struct {
-	ID                 string
-	Name               string
-	ContentType        string
-	URL                string
-	Method             string
-	CreatedAt          time.Time
-	LastUpdatedAt      sql.NullTime
-	ArchivedAt         sql.NullTime
-	BelongsToHousehold string
+	ID                  string
+	Name                string
+	ContentType         string
+	URL                 string
+	Method              string
+	CreatedAt           time.Time
+	LastUpdatedAt       sql.NullTime
+	ArchivedAt          sql.NullTime
+	BelongsToHousehold  string
+	ConsecutiveFailures int32
+	DisabledAt          sql.NullTime
 }
@@ -114,7 +114,7 @@ type CreateOAuth2ClientTokenParams struct {
 	AccessCreatedAt     time.Time
 	CodeChallenge       string
 	CodeChallengeMethod string
-	Scope               Oauth2ClientTokenScopes
+	Scope               string
 	ClientID            string
 	Access              string
 	Code                string
@@ -9,6 +9,8 @@
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const archiveOAuth2Client = `-- name: ArchiveOAuth2Client :execrows
@@ -34,22 +36,31 @@ func (q *Queries) ArchiveOAuth2Client(ctx context.Context, db DBTX, id string) (
 	name,
 	description,
 	client_id,
-	client_secret
+	client_secret,
+	domain,
+	belongs_to_household,
+	scopes
 ) VALUES (
 	$1,
 	$2,
 	$3,
 	$4,
-	$5
+	$5,
+	$6,
+	$7,
+	$8
 )
 `
 
 type CreateOAuth2ClientParams struct {
-	ID           string
-	Name         string
-	Description  string
-	ClientID     string
-	ClientSecret string
+	ID                 string
+	Name               string
+	Description        string
+	ClientID           string
+	ClientSecret       string
+	Domain             string
+	BelongsToHousehold sql.NullString
+	Scopes             []string
 }
 
 func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOAuth2ClientParams) error {
@@ -59,6 +70,9 @@ func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOA
 		arg.Description,
 		arg.ClientID,
 		arg.ClientSecret,
+		arg.Domain,
+		arg.BelongsToHousehold,
+		pq.Array(arg.Scopes),
 	)
 	return err
 }
@@ -72,7 +86,10 @@ func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOA
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
 	oauth2_clients.created_at,
-	oauth2_clients.archived_at
+	oauth2_clients.archived_at,
+	oauth2_clients.domain,
+	oauth2_clients.belongs_to_household,
+	oauth2_clients.scopes
 FROM oauth2_clients
 WHERE oauth2_clients.archived_at IS NULL
 	AND oauth2_clients.client_id = $1
@@ -89,6 +106,9 @@ func (q *Queries) GetOAuth2ClientByClientID(ctx context.Context, db DBTX, client
 		&i.ClientSecret,
 		&i.CreatedAt,
 		&i.ArchivedAt,
+		&i.Domain,
+		&i.BelongsToHousehold,
+		pq.Array(&i.Scopes),
 	)
 	return &i, err
 }
@@ -102,7 +122,10 @@ func (q *Queries) GetOAuth2ClientByClientID(ctx context.Context, db DBTX, client
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
 	oauth2_clients.created_at,
-	oauth2_clients.archived_at
+	oauth2_clients.archived_at,
+	oauth2_clients.domain,
+	oauth2_clients.belongs_to_household,
+	oauth2_clients.scopes
 FROM oauth2_clients
 WHERE oauth2_clients.archived_at IS NULL
 	AND oauth2_clients.id = $1
@@ -119,6 +142,9 @@ func (q *Queries) GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id s
 		&i.ClientSecret,
 		&i.CreatedAt,
 		&i.ArchivedAt,
+		&i.Domain,
+		&i.BelongsToHousehold,
+		pq.Array(&i.Scopes),
 	)
 	return &i, err
 }
@@ -133,6 +159,9 @@ func (q *Queries) GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id s
 	oauth2_clients.client_secret,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at,
+	oauth2_clients.domain,
+	oauth2_clients.belongs_to_household,
+	oauth2_clients.scopes,
 	(
 		SELECT COUNT(oauth2_clients.id)
 		FROM oauth2_clients
@@ -161,15 +190,18 @@ type GetOAuth2ClientsParams struct {
 }
 
 type GetOAuth2ClientsRow struct {
-	ID            string
-	Name          string
-	Description   string
-	ClientID      string
-	ClientSecret  string
-	CreatedAt     time.Time
-	ArchivedAt    sql.NullTime
-	FilteredCount int64
-	TotalCount    int64
+	ID                 string
+	Name               string
+	Description        string
+	ClientID           string
+	ClientSecret       string
+	CreatedAt          time.Time
+	ArchivedAt         sql.NullTime
+	Domain             string
+	BelongsToHousehold sql.NullString
+	Scopes             []string
+	FilteredCount      int64
+	TotalCount         int64
 }
 
 func (q *Queries) GetOAuth2Clients(ctx context.Context, db DBTX, arg *GetOAuth2ClientsParams) ([]*GetOAuth2ClientsRow, error) {
@@ -194,6 +226,9 @@ func (q *Queries) GetOAuth2Clients(ctx context.Context, db DBTX, arg *GetOAuth2C
 			&i.ClientSecret,
 			&i.CreatedAt,
 			&i.ArchivedAt,
+			&i.Domain,
+			&i.BelongsToHousehold,
+			pq.Array(&i.Scopes),
 			&i.FilteredCount,
 			&i.TotalCount,
 		); err != nil {
@@ -14,6 +14,7 @@ type Querier interface {
 	AcceptTermsOfServiceForUser(ctx context.Context, db DBTX, id string) error
 	AddToHouseholdDuringCreation(ctx context.Context, db DBTX, arg *AddToHouseholdDuringCreationParams) error
 	AddUserToHousehold(ctx context.Context, db DBTX, arg *AddUserToHouseholdParams) error
+	ArchiveCookingSession(ctx context.Context, db DBTX, arg *ArchiveCookingSessionParams) (int64, error)
 	ArchiveHousehold(ctx context.Context, db DBTX, arg *ArchiveHouseholdParams) (int64, error)
 	ArchiveHouseholdInstrumentOwnership(ctx context.Context, db DBTX, arg *ArchiveHouseholdInstrumentOwnershipParams) (int64, error)
 	ArchiveMeal(ctx context.Context, db DBTX, arg *ArchiveMealParams) (int64, error)
@@ -26,6 +27,8 @@ type Querier interface {
 	ArchiveOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, access string) (int64, error)
 	ArchiveOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (int64, error)
 	ArchiveOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (int64, error)
+	ArchivePantryItem(ctx context.Context, db DBTX, arg *ArchivePantryItemParams) (int64, error)
+	ArchivePantryItemForGroceryListItem(ctx context.Context, db DBTX, arg *ArchivePantryItemForGroceryListItemParams) (string, error)
 	ArchiveRecipe(ctx context.Context, db DBTX, arg *ArchiveRecipeParams) (int64, error)
 	ArchiveRecipeMedia(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveRecipePrepTask(ctx context.Context, db DBTX, id string) (int64, error)
@@ -41,13 +44,17 @@ type Querier interface {
 	ArchiveUser(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveUserIngredientPreference(ctx context.Context, db DBTX, arg *ArchiveUserIngredientPreferenceParams) (int64, error)
 	ArchiveUserMemberships(ctx context.Context, db DBTX, id string) (int64, error)
+	ArchiveUserPasskey(ctx context.Context, db DBTX, arg *ArchiveUserPasskeyParams) (int64, error)
+	ArchiveUserRecoveryCodesForUser(ctx context.Context, db DBTX, belongsToUser string) error
 	ArchiveValidIngredient(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientGroup(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientGroupMember(ctx context.Context, db DBTX, arg *ArchiveValidIngredientGroupMemberParams) (int64, error)
 	ArchiveValidIngredientMeasurementUnit(ctx context.Context, db DBTX, id string) (int64, error)
+	ArchiveValidIngredientNutrition(ctx context.Context, db DBTX, belongsToValidIngredient string) (int64, error)
 	ArchiveValidIngredientPreparation(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientState(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientStateIngredient(ctx context.Context, db DBTX, id string) (int64, error)
+	ArchiveValidIngredientSubstitution(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidInstrument(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidMeasurementUnit(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidMeasurementUnitConversion(ctx context.Context, db DBTX, id string) (int64, error)
@@ -59,6 +66,7 @@ type Querier interface {
 	ArchiveWebhookTriggerEvent(ctx context.Context, db DBTX, arg *ArchiveWebhookTriggerEventParams) (int64, error)
 	AttachHouseholdInvitationsToUserID(ctx context.Context, db DBTX, arg *AttachHouseholdInvitationsToUserIDParams) error
 	ChangeMealPlanTaskStatus(ctx context.Context, db DBTX, arg *ChangeMealPlanTaskStatusParams) error
+	CheckHouseholdEventCursorRetention(ctx context.Context, db DBTX, arg *CheckHouseholdEventCursorRetentionParams) (bool, error)
 	CheckHouseholdInstrumentOwnershipExistence(ctx context.Context, db DBTX, arg *CheckHouseholdInstrumentOwnershipExistenceParams) (bool, error)
 	CheckHouseholdInvitationExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckMealExistence(ctx context.Context, db DBTX, id string) (bool, error)
@@ -69,6 +77,7 @@ type Querier interface {
 	CheckMealPlanOptionVoteExistence(ctx context.Context, db DBTX, arg *CheckMealPlanOptionVoteExistenceParams) (bool, error)
 	CheckMealPlanTaskExistence(ctx context.Context, db DBTX, arg *CheckMealPlanTaskExistenceParams) (bool, error)
 	CheckOAuth2ClientTokenExistence(ctx context.Context, db DBTX, id string) (bool, error)
+	CheckPantryItemExistence(ctx context.Context, db DBTX, arg *CheckPantryItemExistenceParams) (bool, error)
 	CheckRecipeExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckRecipeMediaExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckRecipePrepTaskExistence(ctx context.Context, db DBTX, arg *CheckRecipePrepTaskExistenceParams) (bool, error)
@@ -89,6 +98,7 @@ type Querier interface {
 	CheckValidIngredientPreparationExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientStateExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientStateIngredientExistence(ctx context.Context, db DBTX, id string) (bool, error)
+	CheckValidIngredientSubstitutionExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidInstrumentExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidMeasurementUnitConversionExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidMeasurementUnitExistence(ctx context.Context, db DBTX, id string) (bool, error)
@@ -98,7 +108,14 @@ type Querier interface {
 	CheckValidVesselExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidityOfValidIngredientStateIngredientPair(ctx context.Context, db DBTX, arg *CheckValidityOfValidIngredientStateIngredientPairParams) (bool, error)
 	CheckWebhookExistence(ctx context.Context, db DBTX, arg *CheckWebhookExistenceParams) (bool, error)
+	ClaimDueWebhookDeliveryRetries(ctx context.Context, db DBTX, arg *ClaimDueWebhookDeliveryRetriesParams) ([]*ClaimDueWebhookDeliveryRetriesRow, error)
+	ClaimPendingOutboxMessages(ctx context.Context, db DBTX, arg *ClaimPendingOutboxMessagesParams) ([]*OutboxMessages, error)
+	ConsumePasskeyCeremony(ctx context.Context, db DBTX, id string) ([]byte, error)
+	CookableRecipeSearch(ctx context.Context, db DBTX, arg *CookableRecipeSearchParams) ([]*CookableRecipeSearchRow, error)
 	CreateAuditLogEntry(ctx context.Context, db DBTX, arg *CreateAuditLogEntryParams) error
+	CreateCookingSession(ctx context.Context, db DBTX, arg *CreateCookingSessionParams) error
+	CreateCookingSessionProgressEntry(ctx context.Context, db DBTX, arg *CreateCookingSessionProgressEntryParams) error
+	CreateDeadLetteredMessage(ctx context.Context, db DBTX, arg *CreateDeadLetteredMessageParams) error
 	CreateHousehold(ctx context.Context, db DBTX, arg *CreateHouseholdParams) error
 	CreateHouseholdInstrumentOwnership(ctx context.Context, db DBTX, arg *CreateHouseholdInstrumentOwnershipParams) error
 	CreateHouseholdInvitation(ctx context.Context, db DBTX, arg *CreateHouseholdInvitationParams) error
@@ -113,6 +130,10 @@ type Querier interface {
 	CreateMealPlanTask(ctx context.Context, db DBTX, arg *CreateMealPlanTaskParams) error
 	CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOAuth2ClientParams) error
 	CreateOAuth2ClientToken(ctx context.Context, db DBTX, arg *CreateOAuth2ClientTokenParams) error
+	CreateOutboxMessage(ctx context.Context, db DBTX, arg *CreateOutboxMessageParams) error
+	CreatePantryItem(ctx context.Context, db DBTX, arg *CreatePantryItemParams) error
+	CreatePantryItemForGroceryListItem(ctx context.Context, db DBTX, arg *CreatePantryItemForGroceryListItemParams) (int64, error)
+	CreatePasskeyCeremony(ctx context.Context, db DBTX, arg *CreatePasskeyCeremonyParams) error
 	CreatePasswordResetToken(ctx context.Context, db DBTX, arg *CreatePasswordResetTokenParams) error
 	CreateRecipe(ctx context.Context, db DBTX, arg *CreateRecipeParams) error
 	CreateRecipeMedia(ctx context.Context, db DBTX, arg *CreateRecipeMediaParams) error
@@ -131,6 +152,8 @@ type Querier interface {
 	CreateUser(ctx context.Context, db DBTX, arg *CreateUserParams) error
 	CreateUserIngredientPreference(ctx context.Context, db DBTX, arg *CreateUserIngredientPreferenceParams) error
 	CreateUserNotification(ctx context.Context, db DBTX, arg *CreateUserNotificationParams) error
+	CreateUserPasskey(ctx context.Context, db DBTX, arg *CreateUserPasskeyParams) error
+	CreateUserRecoveryCode(ctx context.Context, db DBTX, arg *CreateUserRecoveryCodeParams) error
 	CreateValidIngredient(ctx context.Context, db DBTX, arg *CreateValidIngredientParams) error
 	CreateValidIngredientGroup(ctx context.Context, db DBTX, arg *CreateValidIngredientGroupParams) error
 	CreateValidIngredientGroupMember(ctx context.Context, db DBTX, arg *CreateValidIngredientGroupMemberParams) error
@@ -138,6 +161,7 @@ type Querier interface {
 	CreateValidIngredientPreparation(ctx context.Context, db DBTX, arg *CreateValidIngredientPreparationParams) error
 	CreateValidIngredientState(ctx context.Context, db DBTX, arg *CreateValidIngredientStateParams) error
 	CreateValidIngredientStateIngredient(ctx context.Context, db DBTX, arg *CreateValidIngredientStateIngredientParams) error
+	CreateValidIngredientSubstitution(ctx context.Context, db DBTX, arg *CreateValidIngredientSubstitutionParams) error
 	CreateValidInstrument(ctx context.Context, db DBTX, arg *CreateValidInstrumentParams) error
 	CreateValidMeasurementUnit(ctx context.Context, db DBTX, arg *CreateValidMeasurementUnitParams) error
 	CreateValidMeasurementUnitConversion(ctx context.Context, db DBTX, arg *CreateValidMeasurementUnitConversionParams) error
@@ -146,7 +170,11 @@ type Querier interface {
 	CreateValidPreparationVessel(ctx context.Context, db DBTX, arg *CreateValidPreparationVesselParams) error
 	CreateValidVessel(ctx context.Context, db DBTX, arg *CreateValidVesselParams) error
 	CreateWebhook(ctx context.Context, db DBTX, arg *CreateWebhookParams) error
+	CreateWebhookDelivery(ctx context.Context, db DBTX, arg *CreateWebhookDeliveryParams) error
 	CreateWebhookTriggerEvent(ctx context.Context, db DBTX, arg *CreateWebhookTriggerEventParams) error
+	DeleteExpiredPasskeyCeremonies(ctx context.Context, db DBTX) error
+	DeletePublishedOutboxMessages(ctx context.Context, db DBTX, publishedBefore sql.NullTime) (int64, error)
+	DisableWebhook(ctx context.Context, db DBTX, id string) (int64, error)
 	FinalizeMealPlan(ctx context.Context, db DBTX, arg *FinalizeMealPlanParams) error
 	FinalizeMealPlanOption(ctx context.Context, db DBTX, arg *FinalizeMealPlanOptionParams) error
 	GetAdminUserByUsername(ctx context.Context, db DBTX, username string) (*GetAdminUserByUsernameRow, error)
@@ -162,12 +190,20 @@ type Querier interface {
 	GetAuditLogEntriesForUser(ctx context.Context, db DBTX, arg *GetAuditLogEntriesForUserParams) ([]*GetAuditLogEntriesForUserRow, error)
 	GetAuditLogEntriesForUserAndResourceType(ctx context.Context, db DBTX, arg *GetAuditLogEntriesForUserAndResourceTypeParams) ([]*GetAuditLogEntriesForUserAndResourceTypeRow, error)
 	GetAuditLogEntry(ctx context.Context, db DBTX, id string) (*GetAuditLogEntryRow, error)
+	GetCookableRecipes(ctx context.Context, db DBTX, arg *GetCookableRecipesParams) ([]*GetCookableRecipesRow, error)
+	GetCookingSession(ctx context.Context, db DBTX, arg *GetCookingSessionParams) (*CookingSessions, error)
+	GetCookingSessionForUpdate(ctx context.Context, db DBTX, arg *GetCookingSessionForUpdateParams) (*CookingSessions, error)
+	GetCookingSessionProgressEntries(ctx context.Context, db DBTX, belongsToCookingSession string) ([]*CookingSessionProgressEntries, error)
+	GetCookingSessionsForHousehold(ctx context.Context, db DBTX, arg *GetCookingSessionsForHouseholdParams) ([]*GetCookingSessionsForHouseholdRow, error)
+	GetDeadLetteredMessage(ctx context.Context, db DBTX, id string) (*DeadLetteredMessages, error)
 	GetDefaultHouseholdIDForUser(ctx context.Context, db DBTX, belongsToUser string) (string, error)
 	GetEmailVerificationTokenByUserID(ctx context.Context, db DBTX, id string) (sql.NullString, error)
 	GetExpiredAndUnresolvedMealPlans(ctx context.Context, db DBTX) ([]*GetExpiredAndUnresolvedMealPlansRow, error)
 	GetFinalizedMealPlansForPlanning(ctx context.Context, db DBTX) ([]*GetFinalizedMealPlansForPlanningRow, error)
 	GetFinalizedMealPlansWithoutGroceryListInit(ctx context.Context, db DBTX) ([]*GetFinalizedMealPlansWithoutGroceryListInitRow, error)
 	GetHouseholdByIDWithMemberships(ctx context.Context, db DBTX, id string) ([]*GetHouseholdByIDWithMembershipsRow, error)
+	GetHouseholdEventHorizon(ctx context.Context, db DBTX) (int64, error)
+	GetHouseholdEvents(ctx context.Context, db DBTX, arg *GetHouseholdEventsParams) ([]*GetHouseholdEventsRow, error)
 	GetHouseholdInstrumentOwnership(ctx context.Context, db DBTX, arg *GetHouseholdInstrumentOwnershipParams) (*GetHouseholdInstrumentOwnershipRow, error)
 	GetHouseholdInstrumentOwnerships(ctx context.Context, db DBTX, arg *GetHouseholdInstrumentOwnershipsParams) ([]*GetHouseholdInstrumentOwnershipsRow, error)
 	GetHouseholdInvitationByEmailAndToken(ctx context.Context, db DBTX, arg *GetHouseholdInvitationByEmailAndTokenParams) (*GetHouseholdInvitationByEmailAndTokenRow, error)
@@ -198,6 +234,10 @@ type Querier interface {
 	GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (*Oauth2ClientTokens, error)
 	GetOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (*Oauth2ClientTokens, error)
 	GetOAuth2Clients(ctx context.Context, db DBTX, arg *GetOAuth2ClientsParams) ([]*GetOAuth2ClientsRow, error)
+	GetOutboxLag(ctx context.Context, db DBTX) (*GetOutboxLagRow, error)
+	GetOutstandingDeadLetteredMessages(ctx context.Context, db DBTX, arg *GetOutstandingDeadLetteredMessagesParams) ([]*GetOutstandingDeadLetteredMessagesRow, error)
+	GetPantryItem(ctx context.Context, db DBTX, arg *GetPantryItemParams) (*GetPantryItemRow, error)
+	GetPantryItems(ctx context.Context, db DBTX, arg *GetPantryItemsParams) ([]*GetPantryItemsRow, error)
 	GetPasswordResetToken(ctx context.Context, db DBTX, token string) (*GetPasswordResetTokenRow, error)
 	GetPendingInvitesForUser(ctx context.Context, db DBTX, arg *GetPendingInvitesForUserParams) ([]*GetPendingInvitesForUserRow, error)
 	GetPendingInvitesFromUser(ctx context.Context, db DBTX, arg *GetPendingInvitesFromUserParams) ([]*GetPendingInvitesFromUserRow, error)
@@ -215,6 +255,7 @@ type Querier interface {
 	GetRecipePrepTask(ctx context.Context, db DBTX, recipePrepTaskID string) ([]*GetRecipePrepTaskRow, error)
 	GetRecipeRating(ctx context.Context, db DBTX, id string) (*RecipeRatings, error)
 	GetRecipeRatings(ctx context.Context, db DBTX, arg *GetRecipeRatingsParams) ([]*GetRecipeRatingsRow, error)
+	GetRecipeRatingsForHousehold(ctx context.Context, db DBTX, belongsToHousehold string) ([]*RecipeRatings, error)
 	GetRecipeStep(ctx context.Context, db DBTX, arg *GetRecipeStepParams) (*GetRecipeStepRow, error)
 	GetRecipeStepByRecipeID(ctx context.Context, db DBTX, id string) (*GetRecipeStepByRecipeIDRow, error)
 	GetRecipeStepCompletionConditionWithIngredients(ctx context.Context, db DBTX, arg *GetRecipeStepCompletionConditionWithIngredientsParams) ([]*GetRecipeStepCompletionConditionWithIngredientsRow, error)
@@ -240,6 +281,7 @@ type Querier interface {
 	GetServiceSettingConfigurationsForHousehold(ctx context.Context, db DBTX, belongsToHousehold string) ([]*GetServiceSettingConfigurationsForHouseholdRow, error)
 	GetServiceSettingConfigurationsForUser(ctx context.Context, db DBTX, belongsToUser string) ([]*GetServiceSettingConfigurationsForUserRow, error)
 	GetServiceSettings(ctx context.Context, db DBTX, arg *GetServiceSettingsParams) ([]*GetServiceSettingsRow, error)
+	GetUnexpiredPantryItemsForHousehold(ctx context.Context, db DBTX, belongsToHousehold string) ([]*GetUnexpiredPantryItemsForHouseholdRow, error)
 	GetUserByEmail(ctx context.Context, db DBTX, emailAddress string) (*GetUserByEmailRow, error)
 	GetUserByEmailAddressVerificationToken(ctx context.Context, db DBTX, emailAddressVerificationToken sql.NullString) (*GetUserByEmailAddressVerificationTokenRow, error)
 	GetUserByID(ctx context.Context, db DBTX, id string) (*GetUserByIDRow, error)
@@ -249,6 +291,7 @@ type Querier interface {
 	GetUserIngredientPreferencesForUser(ctx context.Context, db DBTX, arg *GetUserIngredientPreferencesForUserParams) ([]*GetUserIngredientPreferencesForUserRow, error)
 	GetUserNotification(ctx context.Context, db DBTX, arg *GetUserNotificationParams) (*UserNotifications, error)
 	GetUserNotificationsForUser(ctx context.Context, db DBTX, arg *GetUserNotificationsForUserParams) ([]*GetUserNotificationsForUserRow, error)
+	GetUserPasskeysForUser(ctx context.Context, db DBTX, belongsToUser string) ([]*UserPasskeys, error)
 	GetUserWithUnverifiedTwoFactor(ctx context.Context, db DBTX, id string) (*GetUserWithUnverifiedTwoFactorRow, error)
 	GetUserWithVerifiedTwoFactor(ctx context.Context, db DBTX, id string) (*GetUserWithVerifiedTwoFactorRow, error)
 	GetUsers(ctx context.Context, db DBTX, arg *GetUsersParams) ([]*GetUsersRow, error)
@@ -261,6 +304,8 @@ type Querier interface {
 	GetValidIngredientMeasurementUnits(ctx context.Context, db DBTX, arg *GetValidIngredientMeasurementUnitsParams) ([]*GetValidIngredientMeasurementUnitsRow, error)
 	GetValidIngredientMeasurementUnitsForIngredient(ctx context.Context, db DBTX, arg *GetValidIngredientMeasurementUnitsForIngredientParams) ([]*GetValidIngredientMeasurementUnitsForIngredientRow, error)
 	GetValidIngredientMeasurementUnitsForMeasurementUnit(ctx context.Context, db DBTX, arg *GetValidIngredientMeasurementUnitsForMeasurementUnitParams) ([]*GetValidIngredientMeasurementUnitsForMeasurementUnitRow, error)
+	GetValidIngredientNutrition(ctx context.Context, db DBTX, belongsToValidIngredient string) (*GetValidIngredientNutritionRow, error)
+	GetValidIngredientNutritionForIngredients(ctx context.Context, db DBTX, ids []string) ([]*GetValidIngredientNutritionForIngredientsRow, error)
 	GetValidIngredientPreparation(ctx context.Context, db DBTX, id string) (*GetValidIngredientPreparationRow, error)
 	GetValidIngredientPreparations(ctx context.Context, db DBTX, arg *GetValidIngredientPreparationsParams) ([]*GetValidIngredientPreparationsRow, error)
 	GetValidIngredientPreparationsForIngredient(ctx context.Context, db DBTX, arg *GetValidIngredientPreparationsForIngredientParams) ([]*GetValidIngredientPreparationsForIngredientRow, error)
@@ -274,6 +319,10 @@ type Querier interface {
 	GetValidIngredientStates(ctx context.Context, db DBTX, arg *GetValidIngredientStatesParams) ([]*GetValidIngredientStatesRow, error)
 	GetValidIngredientStatesNeedingIndexing(ctx context.Context, db DBTX) ([]string, error)
 	GetValidIngredientStatesWithIDs(ctx context.Context, db DBTX, ids []string) ([]*GetValidIngredientStatesWithIDsRow, error)
+	GetValidIngredientSubstitution(ctx context.Context, db DBTX, id string) (*GetValidIngredientSubstitutionRow, error)
+	GetValidIngredientSubstitutions(ctx context.Context, db DBTX, arg *GetValidIngredientSubstitutionsParams) ([]*GetValidIngredientSubstitutionsRow, error)
+	GetValidIngredientSubstitutionsForIngredient(ctx context.Context, db DBTX, arg *GetValidIngredientSubstitutionsForIngredientParams) ([]*GetValidIngredientSubstitutionsForIngredientRow, error)
+	GetValidIngredientSubstitutionsForIngredients(ctx context.Context, db DBTX, ids []string) ([]*GetValidIngredientSubstitutionsForIngredientsRow, error)
 	GetValidIngredients(ctx context.Context, db DBTX, arg *GetValidIngredientsParams) ([]*GetValidIngredientsRow, error)
 	GetValidIngredientsNeedingIndexing(ctx context.Context, db DBTX) ([]string, error)
 	GetValidIngredientsWithIDs(ctx context.Context, db DBTX, ids []string) ([]*GetValidIngredientsWithIDsRow, error)
@@ -303,23 +352,36 @@ type Querier interface {
 	GetValidVessels(ctx context.Context, db DBTX, arg *GetValidVesselsParams) ([]*GetValidVesselsRow, error)
 	GetValidVesselsWithIDs(ctx context.Context, db DBTX, ids []string) ([]*GetValidVesselsWithIDsRow, error)
 	GetWebhook(ctx context.Context, db DBTX, arg *GetWebhookParams) ([]*GetWebhookRow, error)
+	GetWebhookDeliveriesForWebhook(ctx context.Context, db DBTX, arg *GetWebhookDeliveriesForWebhookParams) ([]*GetWebhookDeliveriesForWebhookRow, error)
+	GetWebhookDelivery(ctx context.Context, db DBTX, arg *GetWebhookDeliveryParams) (*WebhookDeliveries, error)
 	GetWebhooksForHousehold(ctx context.Context, db DBTX, arg *GetWebhooksForHouseholdParams) ([]*GetWebhooksForHouseholdRow, error)
-	GetWebhooksForHouseholdAndEvent(ctx context.Context, db DBTX, arg *GetWebhooksForHouseholdAndEventParams) ([]*Webhooks, error)
+	GetWebhooksForHouseholdAndEvent(ctx context.Context, db DBTX, arg *GetWebhooksForHouseholdAndEventParams) ([]*GetWebhooksForHouseholdAndEventRow, error)
 	ListAllMealPlanTasksByMealPlan(ctx context.Context, db DBTX, mealPlanID string) ([]*ListAllMealPlanTasksByMealPlanRow, error)
 	ListAllRecipePrepTasksByRecipe(ctx context.Context, db DBTX, recipeID string) ([]*ListAllRecipePrepTasksByRecipeRow, error)
 	ListIncompleteMealPlanTasksByMealPlanOption(ctx context.Context, db DBTX, belongsToMealPlanOption string) ([]*ListIncompleteMealPlanTasksByMealPlanOptionRow, error)
+	MarkCookingSessionAsCompleted(ctx context.Context, db DBTX, arg *MarkCookingSessionAsCompletedParams) (int64, error)
+	MarkCookingSessionAsUpdated(ctx context.Context, db DBTX, id string) error
+	MarkDeadLetteredMessageAsReplayed(ctx context.Context, db DBTX, id string) (int64, error)
 	MarkEmailAddressAsUnverified(ctx context.Context, db DBTX, id string) error
 	MarkEmailAddressAsVerified(ctx context.Context, db DBTX, arg *MarkEmailAddressAsVerifiedParams) error
 	MarkHouseholdUserMembershipAsUserDefault(ctx context.Context, db DBTX, arg *MarkHouseholdUserMembershipAsUserDefaultParams) error
 	MarkMealPlanAsGroceryListInitialized(ctx context.Context, db DBTX, id string) error
 	MarkMealPlanAsPrepTasksCreated(ctx context.Context, db DBTX, id string) error
+	MarkOutboxMessageAsFailed(ctx context.Context, db DBTX, arg *MarkOutboxMessageAsFailedParams) (int64, error)
+	MarkOutboxMessageAsPublished(ctx context.Context, db DBTX, id string) (int64, error)
 	MarkTwoFactorSecretAsUnverified(ctx context.Context, db DBTX, arg *MarkTwoFactorSecretAsUnverifiedParams) error
 	MarkTwoFactorSecretAsVerified(ctx context.Context, db DBTX, id string) error
+	MarkUserPasskeyAsUsed(ctx context.Context, db DBTX, arg *MarkUserPasskeyAsUsedParams) (int64, error)
+	MarkWebhookDeliveryAsRetried(ctx context.Context, db DBTX, id string) error
 	MealPlanEventIsEligibleForVoting(ctx context.Context, db DBTX, arg *MealPlanEventIsEligibleForVotingParams) (bool, error)
 	ModifyHouseholdUserPermissions(ctx context.Context, db DBTX, arg *ModifyHouseholdUserPermissionsParams) error
 	RecipeSearch(ctx context.Context, db DBTX, arg *RecipeSearchParams) ([]*RecipeSearchRow, error)
+	RecordWebhookDeliveryFailure(ctx context.Context, db DBTX, id string) (int32, error)
+	RecordWebhookDeliverySuccess(ctx context.Context, db DBTX, id string) error
 	RedeemPasswordResetToken(ctx context.Context, db DBTX, id string) error
+	RedeemUserRecoveryCode(ctx context.Context, db DBTX, arg *RedeemUserRecoveryCodeParams) (int64, error)
 	RemoveUserFromHousehold(ctx context.Context, db DBTX, arg *RemoveUserFromHouseholdParams) error
+	RotateHouseholdWebhookEncryptionKey(ctx context.Context, db DBTX, arg *RotateHouseholdWebhookEncryptionKeyParams) (int64, error)
 	SearchForMeals(ctx context.Context, db DBTX, arg *SearchForMealsParams) ([]*SearchForMealsRow, error)
 	SearchForServiceSettings(ctx context.Context, db DBTX, nameQuery string) ([]*ServiceSettings, error)
 	SearchForValidIngredientGroups(ctx context.Context, db DBTX, arg *SearchForValidIngredientGroupsParams) ([]*SearchForValidIngredientGroupsRow, error)
@@ -337,7 +399,9 @@ type Querier interface {
 	SetUserAccountStatus(ctx context.Context, db DBTX, arg *SetUserAccountStatusParams) (int64, error)
 	TransferHouseholdMembership(ctx context.Context, db DBTX, arg *TransferHouseholdMembershipParams) error
 	TransferHouseholdOwnership(ctx context.Context, db DBTX, arg *TransferHouseholdOwnershipParams) error
+	UnmarkDeadLetteredMessageAsReplayed(ctx context.Context, db DBTX, id string) error
 	UpdateHousehold(ctx context.Context, db DBTX, arg *UpdateHouseholdParams) (int64, error)
+	UpdateHouseholdBillingStatus(ctx context.Context, db DBTX, arg *UpdateHouseholdBillingStatusParams) (int64, error)
 	UpdateHouseholdInstrumentOwnership(ctx context.Context, db DBTX, arg *UpdateHouseholdInstrumentOwnershipParams) (int64, error)
 	UpdateHouseholdWebhookEncryptionKey(ctx context.Context, db DBTX, arg *UpdateHouseholdWebhookEncryptionKeyParams) (int64, error)
 	UpdateMealLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
@@ -346,6 +410,7 @@ type Querier interface {
 	UpdateMealPlanGroceryListItem(ctx context.Context, db DBTX, arg *UpdateMealPlanGroceryListItemParams) (int64, error)
 	UpdateMealPlanOption(ctx context.Context, db DBTX, arg *UpdateMealPlanOptionParams) (int64, error)
 	UpdateMealPlanOptionVote(ctx context.Context, db DBTX, arg *UpdateMealPlanOptionVoteParams) (int64, error)
+	UpdatePantryItem(ctx context.Context, db DBTX, arg *UpdatePantryItemParams) (int64, error)
 	UpdateRecipe(ctx context.Context, db DBTX, arg *UpdateRecipeParams) (int64, error)
 	UpdateRecipeLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
 	UpdateRecipeMedia(ctx context.Context, db DBTX, arg *UpdateRecipeMediaParams) (int64, error)
@@ -375,6 +440,7 @@ type Querier interface {
 	UpdateValidIngredientState(ctx context.Context, db DBTX, arg *UpdateValidIngredientStateParams) (int64, error)
 	UpdateValidIngredientStateIngredient(ctx context.Context, db DBTX, arg *UpdateValidIngredientStateIngredientParams) (int64, error)
 	UpdateValidIngredientStateLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
+	UpdateValidIngredientSubstitution(ctx context.Context, db DBTX, arg *UpdateValidIngredientSubstitutionParams) (int64, error)
 	UpdateValidInstrument(ctx context.Context, db DBTX, arg *UpdateValidInstrumentParams) (int64, error)
 	UpdateValidInstrumentLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
 	UpdateValidMeasurementUnit(ctx context.Context, db DBTX, arg *UpdateValidMeasurementUnitParams) (int64, error)
@@ -386,6 +452,7 @@ type Querier interface {
 	UpdateValidPreparationVessel(ctx context.Context, db DBTX, arg *UpdateValidPreparationVesselParams) (int64, error)
 	UpdateValidVessel(ctx context.Context, db DBTX, arg *UpdateValidVesselParams) (int64, error)
 	UpdateValidVesselLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
+	UpsertValidIngredientNutrition(ctx context.Context, db DBTX, arg *UpsertValidIngredientNutritionParams) error
 	UserIsHouseholdMember(ctx context.Context, db DBTX, arg *UserIsHouseholdMemberParams) (bool, error)
 	ValidIngredientMeasurementUnitPairIsValid(ctx context.Context, db DBTX, arg *ValidIngredientMeasurementUnitPairIsValidParams) (bool, error)
 	ValidIngredientPreparationPairIsValid(ctx context.Context, db DBTX, arg *ValidIngredientPreparationPairIsValidParams) (bool, error)
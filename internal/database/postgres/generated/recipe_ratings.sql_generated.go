@@ -258,6 +258,65 @@ func (q *Queries) GetRecipeRatings(ctx context.Context, db DBTX, arg *GetRecipeR
 	return items, nil
 }
 
+const getRecipeRatingsForHousehold = `-- name: GetRecipeRatingsForHousehold :many
+
+SELECT
+	recipe_ratings.id,
+	recipe_ratings.recipe_id,
+	recipe_ratings.taste,
+	recipe_ratings.difficulty,
+	recipe_ratings.cleanup,
+	recipe_ratings.instructions,
+	recipe_ratings.overall,
+	recipe_ratings.notes,
+	recipe_ratings.by_user,
+	recipe_ratings.created_at,
+	recipe_ratings.last_updated_at,
+	recipe_ratings.archived_at
+FROM recipe_ratings
+	JOIN household_user_memberships ON household_user_memberships.belongs_to_user = recipe_ratings.by_user
+WHERE recipe_ratings.archived_at IS NULL
+	AND household_user_memberships.archived_at IS NULL
+	AND household_user_memberships.belongs_to_household = $1
+ORDER BY recipe_ratings.id
+`
+
+func (q *Queries) GetRecipeRatingsForHousehold(ctx context.Context, db DBTX, belongsToHousehold string) ([]*RecipeRatings, error) {
+	rows, err := db.QueryContext(ctx, getRecipeRatingsForHousehold, belongsToHousehold)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*RecipeRatings{}
+	for rows.Next() {
+		var i RecipeRatings
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipeID,
+			&i.Taste,
+			&i.Difficulty,
+			&i.Cleanup,
+			&i.Instructions,
+			&i.Overall,
+			&i.Notes,
+			&i.ByUser,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateRecipeRating = `-- name: UpdateRecipeRating :execrows
 
 UPDATE recipe_ratings SET
@@ -96,6 +96,23 @@ func (q *Queries) CreateWebhook(ctx context.Context, db DBTX, arg *CreateWebhook
 	return err
 }
 
+const disableWebhook = `-- name: DisableWebhook :execrows
+
+UPDATE webhooks SET
+	disabled_at = NOW()
+WHERE archived_at IS NULL
+	AND disabled_at IS NULL
+	AND id = $1
+`
+
+func (q *Queries) DisableWebhook(ctx context.Context, db DBTX, id string) (int64, error) {
+	result, err := db.ExecContext(ctx, disableWebhook, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getWebhook = `-- name: GetWebhook :many
 
 SELECT
@@ -112,6 +129,7 @@ func (q *Queries) CreateWebhook(ctx context.Context, db DBTX, arg *CreateWebhook
 	webhooks.created_at as webhook_created_at,
 	webhooks.last_updated_at as webhook_last_updated_at,
 	webhooks.archived_at as webhook_archived_at,
+	webhooks.disabled_at as webhook_disabled_at,
 	webhooks.belongs_to_household as webhook_belongs_to_household
 FROM webhooks
 	JOIN webhook_trigger_events ON webhooks.id = webhook_trigger_events.belongs_to_webhook
@@ -140,6 +158,7 @@ type GetWebhookRow struct {
 	WebhookCreatedAt                    time.Time
 	WebhookLastUpdatedAt                sql.NullTime
 	WebhookArchivedAt                   sql.NullTime
+	WebhookDisabledAt                   sql.NullTime
 	WebhookBelongsToHousehold           string
 }
 
@@ -166,6 +185,7 @@ func (q *Queries) GetWebhook(ctx context.Context, db DBTX, arg *GetWebhookParams
 			&i.WebhookCreatedAt,
 			&i.WebhookLastUpdatedAt,
 			&i.WebhookArchivedAt,
+			&i.WebhookDisabledAt,
 			&i.WebhookBelongsToHousehold,
 		); err != nil {
 			return nil, err
@@ -197,6 +217,7 @@ func (q *Queries) GetWebhook(ctx context.Context, db DBTX, arg *GetWebhookParams
 	webhooks.created_at,
 	webhooks.last_updated_at,
 	webhooks.archived_at,
+	webhooks.disabled_at,
 	webhooks.belongs_to_household,
 	(
 		SELECT COUNT(webhooks.id)
@@ -264,6 +285,7 @@ type GetWebhooksForHouseholdRow struct {
 	CreatedAt_2        time.Time
 	LastUpdatedAt      sql.NullTime
 	ArchivedAt_2       sql.NullTime
+	DisabledAt         sql.NullTime
 	BelongsToHousehold string
 	FilteredCount      int64
 	TotalCount         int64
@@ -300,6 +322,7 @@ func (q *Queries) GetWebhooksForHousehold(ctx context.Context, db DBTX, arg *Get
 			&i.CreatedAt_2,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt_2,
+			&i.DisabledAt,
 			&i.BelongsToHousehold,
 			&i.FilteredCount,
 			&i.TotalCount,
@@ -328,6 +351,7 @@ func (q *Queries) GetWebhooksForHousehold(ctx context.Context, db DBTX, arg *Get
 	webhooks.created_at,
 	webhooks.last_updated_at,
 	webhooks.archived_at,
+	webhooks.disabled_at,
 	webhooks.belongs_to_household
 FROM webhooks
 	JOIN webhook_trigger_events ON webhooks.id = webhook_trigger_events.belongs_to_webhook
@@ -335,6 +359,7 @@ func (q *Queries) GetWebhooksForHousehold(ctx context.Context, db DBTX, arg *Get
 	AND webhook_trigger_events.trigger_event = $1
 	AND webhooks.belongs_to_household = $2
 	AND webhooks.archived_at IS NULL
+	AND webhooks.disabled_at IS NULL
 `
 
 type GetWebhooksForHouseholdAndEventParams struct {
@@ -342,15 +367,28 @@ type GetWebhooksForHouseholdAndEventParams struct {
 	BelongsToHousehold string
 }
 
-func (q *Queries) GetWebhooksForHouseholdAndEvent(ctx context.Context, db DBTX, arg *GetWebhooksForHouseholdAndEventParams) ([]*Webhooks, error) {
+type GetWebhooksForHouseholdAndEventRow struct {
+	CreatedAt          time.Time
+	LastUpdatedAt      sql.NullTime
+	ArchivedAt         sql.NullTime
+	DisabledAt         sql.NullTime
+	ID                 string
+	Name               string
+	ContentType        string
+	URL                string
+	Method             string
+	BelongsToHousehold string
+}
+
+func (q *Queries) GetWebhooksForHouseholdAndEvent(ctx context.Context, db DBTX, arg *GetWebhooksForHouseholdAndEventParams) ([]*GetWebhooksForHouseholdAndEventRow, error) {
 	rows, err := db.QueryContext(ctx, getWebhooksForHouseholdAndEvent, arg.TriggerEvent, arg.BelongsToHousehold)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []*Webhooks{}
+	items := []*GetWebhooksForHouseholdAndEventRow{}
 	for rows.Next() {
-		var i Webhooks
+		var i GetWebhooksForHouseholdAndEventRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
@@ -360,6 +398,7 @@ func (q *Queries) GetWebhooksForHouseholdAndEvent(ctx context.Context, db DBTX,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
+			&i.DisabledAt,
 			&i.BelongsToHousehold,
 		); err != nil {
 			return nil, err
@@ -374,3 +413,33 @@ func (q *Queries) GetWebhooksForHouseholdAndEvent(ctx context.Context, db DBTX,
 	}
 	return items, nil
 }
+
+const recordWebhookDeliveryFailure = `-- name: RecordWebhookDeliveryFailure :one
+
+UPDATE webhooks SET
+	consecutive_failures = consecutive_failures + 1
+WHERE archived_at IS NULL
+	AND id = $1
+RETURNING consecutive_failures
+`
+
+func (q *Queries) RecordWebhookDeliveryFailure(ctx context.Context, db DBTX, id string) (int32, error) {
+	row := db.QueryRowContext(ctx, recordWebhookDeliveryFailure, id)
+	var consecutive_failures int32
+	err := row.Scan(&consecutive_failures)
+	return consecutive_failures, err
+}
+
+const recordWebhookDeliverySuccess = `-- name: RecordWebhookDeliverySuccess :exec
+
+UPDATE webhooks SET
+	consecutive_failures = 0,
+	disabled_at = NULL
+WHERE archived_at IS NULL
+	AND id = $1
+`
+
+func (q *Queries) RecordWebhookDeliverySuccess(ctx context.Context, db DBTX, id string) error {
+	_, err := db.ExecContext(ctx, recordWebhookDeliverySuccess, id)
+	return err
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.HouseholdEventDataManager = (*Querier)(nil)
+)
+
+// GetHouseholdEvents fetches up to limit events recorded for a household after a given cursor, in the order their
+// transactions were started. Only events recorded by transactions older than every transaction still in progress are
+// returned, so that an event can never appear behind a cursor that has already been handed out.
+func (q *Querier) GetHouseholdEvents(ctx context.Context, householdID string, after types.HouseholdEventCursor, limit uint16) ([]*types.HouseholdEvent, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID).WithValue("after", after.String())
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	results, err := q.generatedQuerier.GetHouseholdEvents(ctx, q.dbFor(ctx), &generated.GetHouseholdEventsParams{
+		BelongsToHousehold: database.NullStringFromString(householdID),
+		AfterTransactionID: int64(after.TransactionID),
+		AfterSequence:      int64(after.Sequence),
+		ResultLimit:        int32(limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching household events")
+	}
+
+	x := []*types.HouseholdEvent{}
+	for _, result := range results {
+		event := &types.HouseholdEvent{
+			CreatedAt: result.CreatedAt,
+			EventType: types.ServiceEventType(result.EventType),
+			ID: types.HouseholdEventCursor{
+				TransactionID: uint64(result.TransactionID),
+				Sequence:      uint64(result.Sequence),
+			},
+		}
+
+		if err = json.Unmarshal([]byte(result.Payload), &event.Data); err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "parsing household event payload")
+		}
+
+		x = append(x, event)
+	}
+
+	return x, nil
+}
+
+// GetHouseholdEventHorizon fetches a cursor that every event GetHouseholdEvents has yet to return comes after.
+func (q *Querier) GetHouseholdEventHorizon(ctx context.Context) (types.HouseholdEventCursor, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	horizon, err := q.generatedQuerier.GetHouseholdEventHorizon(ctx, q.dbFor(ctx))
+	if err != nil {
+		return types.HouseholdEventCursor{}, observability.PrepareAndLogError(err, q.logger, span, "fetching household event horizon")
+	}
+
+	return types.HouseholdEventCursor{TransactionID: uint64(horizon)}, nil
+}
+
+// HouseholdEventCursorIsRetained reports whether a household's events after a given cursor are still retained, which is
+// to say that the event it refers to, or for horizon cursors, everything since, hasn't been pruned.
+func (q *Querier) HouseholdEventCursorIsRetained(ctx context.Context, householdID string, cursor types.HouseholdEventCursor) (bool, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if householdID == "" {
+		return false, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID).WithValue("cursor", cursor.String())
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	retained, err := q.generatedQuerier.CheckHouseholdEventCursorRetention(ctx, q.dbFor(ctx), &generated.CheckHouseholdEventCursorRetentionParams{
+		BelongsToHousehold: database.NullStringFromString(householdID),
+		TransactionID:      int64(cursor.TransactionID),
+		Sequence:           int64(cursor.Sequence),
+	})
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "checking household event cursor retention")
+	}
+
+	return retained, nil
+}
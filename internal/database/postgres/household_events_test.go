@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_HouseholdEvents(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	householdID := fakes.BuildFakeID()
+
+	start, err := dbc.GetHouseholdEventHorizon(ctx)
+	require.NoError(t, err)
+	assert.NotZero(t, start.TransactionID)
+
+	retained, err := dbc.HouseholdEventCursorIsRetained(ctx, householdID, start)
+	require.NoError(t, err)
+	assert.True(t, retained)
+
+	// messages that don't belong to the household aren't household events
+	_, err = dbc.CreateOutboxMessage(ctx, fakes.BuildFakeOutboxMessageDatabaseCreationInput())
+	require.NoError(t, err)
+
+	createHouseholdEvent := func(ctx context.Context) error {
+		payload, marshalErr := json.Marshal(&types.DataChangeMessage{
+			EventType:   types.MealPlanGroceryListItemUpdatedCustomerEventType,
+			HouseholdID: householdID,
+		})
+		if marshalErr != nil {
+			return marshalErr
+		}
+
+		input := fakes.BuildFakeOutboxMessageDatabaseCreationInput()
+		input.BelongsToHousehold = &householdID
+		input.EventType = string(types.MealPlanGroceryListItemUpdatedCustomerEventType)
+		input.Payload = string(payload)
+
+		_, createErr := dbc.CreateOutboxMessage(ctx, input)
+		return createErr
+	}
+
+	for i := 0; i < exampleQuantity; i++ {
+		require.NoError(t, createHouseholdEvent(ctx))
+	}
+
+	events, err := dbc.GetHouseholdEvents(ctx, householdID, start, exampleQuantity*2)
+	require.NoError(t, err)
+	require.Len(t, events, exampleQuantity)
+	for i, event := range events {
+		assert.Equal(t, types.MealPlanGroceryListItemUpdatedCustomerEventType, event.EventType)
+		assert.Equal(t, householdID, event.Data.HouseholdID)
+		if i > 0 {
+			assert.Greater(t, event.ID.TransactionID, events[i-1].ID.TransactionID)
+		}
+
+		retained, err = dbc.HouseholdEventCursorIsRetained(ctx, householdID, event.ID)
+		require.NoError(t, err)
+		assert.True(t, retained)
+	}
+
+	// resuming from an event only returns what came after it
+	resumed, err := dbc.GetHouseholdEvents(ctx, householdID, events[0].ID, exampleQuantity*2)
+	require.NoError(t, err)
+	assert.Equal(t, events[1:], resumed)
+
+	// events are withheld while a transaction that started before them is still in progress, and then returned in
+	// the order their transactions started, so that nothing can slip in behind a cursor that was already handed out.
+	last := events[len(events)-1].ID
+	started, finish, finished := make(chan struct{}), make(chan struct{}), make(chan error)
+	go func() {
+		finished <- dbc.RunInTransaction(ctx, func(ctx context.Context) error {
+			if txErr := createHouseholdEvent(ctx); txErr != nil {
+				return txErr
+			}
+			close(started)
+			<-finish
+			return nil
+		})
+	}()
+
+	<-started
+	require.NoError(t, createHouseholdEvent(ctx))
+
+	pending, err := dbc.GetHouseholdEvents(ctx, householdID, last, exampleQuantity*2)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	close(finish)
+	require.NoError(t, <-finished)
+
+	committed, err := dbc.GetHouseholdEvents(ctx, householdID, last, exampleQuantity*2)
+	require.NoError(t, err)
+	require.Len(t, committed, 2)
+	assert.Less(t, committed[0].ID.TransactionID, committed[1].ID.TransactionID)
+
+	// once events are pruned, cursors from before them are no longer resumable.
+	claimed, err := dbc.ClaimPendingOutboxMessages(ctx, exampleQuantity*2, time.Minute)
+	require.NoError(t, err)
+	for _, message := range claimed {
+		require.NoError(t, dbc.MarkOutboxMessageAsPublished(ctx, message.ID))
+	}
+
+	_, err = dbc.PrunePublishedOutboxMessages(ctx, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	for _, cursor := range []types.HouseholdEventCursor{start, events[0].ID} {
+		retained, err = dbc.HouseholdEventCursorIsRetained(ctx, householdID, cursor)
+		require.NoError(t, err)
+		assert.False(t, retained)
+	}
+}
+
+func TestQuerier_GetHouseholdEvents(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetHouseholdEvents(ctx, "", types.HouseholdEventCursor{}, 10)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_HouseholdEventCursorIsRetained(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.HouseholdEventCursorIsRetained(ctx, "", types.HouseholdEventCursor{})
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+}
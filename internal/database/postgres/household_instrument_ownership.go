@@ -34,7 +34,7 @@ func (q *Querier) HouseholdInstrumentOwnershipExists(ctx context.Context, househ
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	result, err := q.generatedQuerier.CheckHouseholdInstrumentOwnershipExistence(ctx, q.db, &generated.CheckHouseholdInstrumentOwnershipExistenceParams{
+	result, err := q.generatedQuerier.CheckHouseholdInstrumentOwnershipExistence(ctx, q.dbFor(ctx), &generated.CheckHouseholdInstrumentOwnershipExistenceParams{
 		ID:                 householdInstrumentOwnershipID,
 		BelongsToHousehold: householdID,
 	})
@@ -64,7 +64,7 @@ func (q *Querier) GetHouseholdInstrumentOwnership(ctx context.Context, household
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	result, err := q.generatedQuerier.GetHouseholdInstrumentOwnership(ctx, q.db, &generated.GetHouseholdInstrumentOwnershipParams{
+	result, err := q.generatedQuerier.GetHouseholdInstrumentOwnership(ctx, q.dbFor(ctx), &generated.GetHouseholdInstrumentOwnershipParams{
 		ID:                 householdInstrumentOwnershipID,
 		BelongsToHousehold: householdID,
 	})
@@ -122,7 +122,7 @@ func (q *Querier) GetHouseholdInstrumentOwnerships(ctx context.Context, househol
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	results, err := q.generatedQuerier.GetHouseholdInstrumentOwnerships(ctx, q.db, &generated.GetHouseholdInstrumentOwnershipsParams{
+	results, err := q.generatedQuerier.GetHouseholdInstrumentOwnerships(ctx, q.dbFor(ctx), &generated.GetHouseholdInstrumentOwnershipsParams{
 		HouseholdID:   householdID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -180,7 +180,7 @@ func (q *Querier) CreateHouseholdInstrumentOwnership(ctx context.Context, input
 	logger := q.logger.WithValue(keys.HouseholdInstrumentOwnershipIDKey, input.ID)
 
 	// create the household instrument ownership.
-	if err := q.generatedQuerier.CreateHouseholdInstrumentOwnership(ctx, q.db, &generated.CreateHouseholdInstrumentOwnershipParams{
+	if err := q.generatedQuerier.CreateHouseholdInstrumentOwnership(ctx, q.dbFor(ctx), &generated.CreateHouseholdInstrumentOwnershipParams{
 		ID:                 input.ID,
 		Notes:              input.Notes,
 		ValidInstrumentID:  input.ValidInstrumentID,
@@ -215,7 +215,7 @@ func (q *Querier) UpdateHouseholdInstrumentOwnership(ctx context.Context, update
 	logger := q.logger.WithValue(keys.HouseholdInstrumentOwnershipIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.HouseholdInstrumentOwnershipIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateHouseholdInstrumentOwnership(ctx, q.db, &generated.UpdateHouseholdInstrumentOwnershipParams{
+	if _, err := q.generatedQuerier.UpdateHouseholdInstrumentOwnership(ctx, q.dbFor(ctx), &generated.UpdateHouseholdInstrumentOwnershipParams{
 		Notes:              updated.Notes,
 		ValidInstrumentID:  updated.Instrument.ID,
 		ID:                 updated.ID,
@@ -249,7 +249,7 @@ func (q *Querier) ArchiveHouseholdInstrumentOwnership(ctx context.Context, house
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	if _, err := q.generatedQuerier.ArchiveHouseholdInstrumentOwnership(ctx, q.db, &generated.ArchiveHouseholdInstrumentOwnershipParams{
+	if _, err := q.generatedQuerier.ArchiveHouseholdInstrumentOwnership(ctx, q.dbFor(ctx), &generated.ArchiveHouseholdInstrumentOwnershipParams{
 		ID:                 householdInstrumentOwnershipID,
 		BelongsToHousehold: householdID,
 	}); err != nil {
@@ -33,7 +33,7 @@ func (q *Querier) HouseholdInvitationExists(ctx context.Context, householdInvita
 	logger = logger.WithValue(keys.HouseholdInvitationIDKey, householdInvitationID)
 	tracing.AttachToSpan(span, keys.HouseholdInvitationIDKey, householdInvitationID)
 
-	result, err := q.generatedQuerier.CheckHouseholdInvitationExistence(ctx, q.db, householdInvitationID)
+	result, err := q.generatedQuerier.CheckHouseholdInvitationExistence(ctx, q.dbFor(ctx), householdInvitationID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing household invitation existence check")
 	}
@@ -60,7 +60,7 @@ func (q *Querier) GetHouseholdInvitationByHouseholdAndID(ctx context.Context, ho
 	logger = logger.WithValue(keys.HouseholdInvitationIDKey, householdInvitationID)
 	tracing.AttachToSpan(span, keys.HouseholdInvitationIDKey, householdInvitationID)
 
-	result, err := q.generatedQuerier.GetHouseholdInvitationByHouseholdAndID(ctx, q.db, &generated.GetHouseholdInvitationByHouseholdAndIDParams{
+	result, err := q.generatedQuerier.GetHouseholdInvitationByHouseholdAndID(ctx, q.dbFor(ctx), &generated.GetHouseholdInvitationByHouseholdAndIDParams{
 		DestinationHousehold: householdID,
 		ID:                   householdInvitationID,
 	})
@@ -149,7 +149,7 @@ func (q *Querier) GetHouseholdInvitationByTokenAndID(ctx context.Context, token,
 
 	logger.Debug("fetching household invitation")
 
-	result, err := q.generatedQuerier.GetHouseholdInvitationByTokenAndID(ctx, q.db, &generated.GetHouseholdInvitationByTokenAndIDParams{
+	result, err := q.generatedQuerier.GetHouseholdInvitationByTokenAndID(ctx, q.dbFor(ctx), &generated.GetHouseholdInvitationByTokenAndIDParams{
 		Token: token,
 		ID:    invitationID,
 	})
@@ -238,7 +238,7 @@ func (q *Querier) GetHouseholdInvitationByEmailAndToken(ctx context.Context, ema
 	logger = logger.WithValue(keys.HouseholdInvitationTokenKey, token)
 	tracing.AttachToSpan(span, keys.HouseholdInvitationTokenKey, token)
 
-	result, err := q.generatedQuerier.GetHouseholdInvitationByEmailAndToken(ctx, q.db, &generated.GetHouseholdInvitationByEmailAndTokenParams{
+	result, err := q.generatedQuerier.GetHouseholdInvitationByEmailAndToken(ctx, q.dbFor(ctx), &generated.GetHouseholdInvitationByEmailAndTokenParams{
 		ToEmail: emailAddress,
 		Token:   token,
 	})
@@ -320,7 +320,7 @@ func (q *Querier) CreateHouseholdInvitation(ctx context.Context, input *types.Ho
 	logger := q.logger.WithValue(keys.HouseholdInvitationIDKey, input.ID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, input.DestinationHouseholdID)
 
-	if err := q.generatedQuerier.CreateHouseholdInvitation(ctx, q.db, &generated.CreateHouseholdInvitationParams{
+	if err := q.generatedQuerier.CreateHouseholdInvitation(ctx, q.dbFor(ctx), &generated.CreateHouseholdInvitationParams{
 		ExpiresAt:            input.ExpiresAt,
 		ID:                   input.ID,
 		FromUser:             input.FromUser,
@@ -372,7 +372,7 @@ func (q *Querier) GetPendingHouseholdInvitationsFromUser(ctx context.Context, us
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetPendingInvitesFromUser(ctx, q.db, &generated.GetPendingInvitesFromUserParams{
+	results, err := q.generatedQuerier.GetPendingInvitesFromUser(ctx, q.dbFor(ctx), &generated.GetPendingInvitesFromUserParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -468,7 +468,7 @@ func (q *Querier) GetPendingHouseholdInvitationsForUser(ctx context.Context, use
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetPendingInvitesForUser(ctx, q.db, &generated.GetPendingInvitesForUserParams{
+	results, err := q.generatedQuerier.GetPendingInvitesForUser(ctx, q.dbFor(ctx), &generated.GetPendingInvitesForUserParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -576,7 +576,7 @@ func (q *Querier) setInvitationStatus(ctx context.Context, querier database.SQLQ
 
 // CancelHouseholdInvitation cancels a household invitation by its ID with a note.
 func (q *Querier) CancelHouseholdInvitation(ctx context.Context, householdInvitationID, note string) error {
-	return q.setInvitationStatus(ctx, q.db, householdInvitationID, note, string(types.CancelledHouseholdInvitationStatus))
+	return q.setInvitationStatus(ctx, q.dbFor(ctx), householdInvitationID, note, string(types.CancelledHouseholdInvitationStatus))
 }
 
 // AcceptHouseholdInvitation accepts a household invitation by its ID with a note.
@@ -592,7 +592,7 @@ func (q *Querier) AcceptHouseholdInvitation(ctx context.Context, householdInvita
 	logger = logger.WithValue(keys.HouseholdInvitationIDKey, householdInvitationID)
 	tracing.AttachToSpan(span, keys.HouseholdInvitationIDKey, householdInvitationID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -632,7 +632,7 @@ func (q *Querier) AcceptHouseholdInvitation(ctx context.Context, householdInvita
 
 // RejectHouseholdInvitation rejects a household invitation by its ID with a note.
 func (q *Querier) RejectHouseholdInvitation(ctx context.Context, householdInvitationID, note string) error {
-	return q.setInvitationStatus(ctx, q.db, householdInvitationID, note, string(types.RejectedHouseholdInvitationStatus))
+	return q.setInvitationStatus(ctx, q.dbFor(ctx), householdInvitationID, note, string(types.RejectedHouseholdInvitationStatus))
 }
 
 func (q *Querier) attachInvitationsToUser(ctx context.Context, querier database.SQLQueryExecutor, userEmail, userID string) error {
@@ -35,7 +35,7 @@ func (q *Querier) BuildSessionContextDataForUser(ctx context.Context, userID str
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	results, err := q.generatedQuerier.GetHouseholdUserMembershipsForUser(ctx, q.db, userID)
+	results, err := q.generatedQuerier.GetHouseholdUserMembershipsForUser(ctx, q.dbFor(ctx), userID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching user's memberships from database")
 	}
@@ -85,7 +85,7 @@ func (q *Querier) GetDefaultHouseholdIDForUser(ctx context.Context, userID strin
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger = logger.WithValue(keys.UserIDKey, userID)
 
-	id, err := q.generatedQuerier.GetDefaultHouseholdIDForUser(ctx, q.db, userID)
+	id, err := q.generatedQuerier.GetDefaultHouseholdIDForUser(ctx, q.dbFor(ctx), userID)
 	if err != nil {
 		return "", observability.PrepareAndLogError(err, logger, span, "fetching default household ID for user")
 	}
@@ -110,7 +110,7 @@ func (q *Querier) markHouseholdAsUserDefault(ctx context.Context, querier databa
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -150,7 +150,7 @@ func (q *Querier) markHouseholdAsUserDefault(ctx context.Context, querier databa
 
 // MarkHouseholdAsUserDefault does a thing.
 func (q *Querier) MarkHouseholdAsUserDefault(ctx context.Context, userID, householdID string) error {
-	return q.markHouseholdAsUserDefault(ctx, q.db, userID, householdID)
+	return q.markHouseholdAsUserDefault(ctx, q.dbFor(ctx), userID, householdID)
 }
 
 // UserIsMemberOfHousehold does a thing.
@@ -164,7 +164,7 @@ func (q *Querier) UserIsMemberOfHousehold(ctx context.Context, userID, household
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	result, err := q.generatedQuerier.UserIsHouseholdMember(ctx, q.db, &generated.UserIsHouseholdMemberParams{
+	result, err := q.generatedQuerier.UserIsHouseholdMember(ctx, q.dbFor(ctx), &generated.UserIsHouseholdMemberParams{
 		BelongsToHousehold: householdID,
 		BelongsToUser:      userID,
 	})
@@ -197,7 +197,7 @@ func (q *Querier) ModifyUserPermissions(ctx context.Context, householdID, userID
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -274,7 +274,7 @@ func (q *Querier) TransferHouseholdOwnership(ctx context.Context, householdID st
 	tracing.AttachToSpan(span, keys.UserIDKey, input.NewOwner)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -466,7 +466,7 @@ func (q *Querier) RemoveUserFromHousehold(ctx context.Context, userID, household
 		keys.HouseholdIDKey: householdID,
 	})
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareError(err, span, "beginning transaction")
 	}
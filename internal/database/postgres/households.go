@@ -3,7 +3,10 @@
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/backend/internal/database"
@@ -33,7 +36,7 @@ func (q *Querier) GetHousehold(ctx context.Context, householdID string) (*types.
 	}
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	results, err := q.generatedQuerier.GetHouseholdByIDWithMemberships(ctx, q.db, householdID)
+	results, err := q.generatedQuerier.GetHouseholdByIDWithMemberships(ctx, q.dbFor(ctx), householdID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing households list retrieval query")
 	}
@@ -42,26 +45,28 @@ func (q *Querier) GetHousehold(ctx context.Context, householdID string) (*types.
 	for _, result := range results {
 		if household == nil {
 			household = &types.Household{
-				CreatedAt:                  result.CreatedAt,
-				SubscriptionPlanID:         database.StringPointerFromNullString(result.SubscriptionPlanID),
-				LastUpdatedAt:              database.TimePointerFromNullTime(result.LastUpdatedAt),
-				ArchivedAt:                 database.TimePointerFromNullTime(result.ArchivedAt),
-				ContactPhone:               result.ContactPhone,
-				BillingStatus:              result.BillingStatus,
-				AddressLine1:               result.AddressLine1,
-				AddressLine2:               result.AddressLine2,
-				City:                       result.City,
-				State:                      result.State,
-				ZipCode:                    result.ZipCode,
-				Country:                    result.Country,
-				Latitude:                   database.Float64PointerFromNullString(result.Latitude),
-				Longitude:                  database.Float64PointerFromNullString(result.Longitude),
-				PaymentProcessorCustomerID: result.PaymentProcessorCustomerID,
-				BelongsToUser:              result.BelongsToUser,
-				ID:                         result.ID,
-				Name:                       result.Name,
-				WebhookEncryptionKey:       result.WebhookHmacSecret,
-				Members:                    nil,
+				CreatedAt:                             result.CreatedAt,
+				SubscriptionPlanID:                    database.StringPointerFromNullString(result.SubscriptionPlanID),
+				LastUpdatedAt:                         database.TimePointerFromNullTime(result.LastUpdatedAt),
+				ArchivedAt:                            database.TimePointerFromNullTime(result.ArchivedAt),
+				ContactPhone:                          result.ContactPhone,
+				BillingStatus:                         result.BillingStatus,
+				AddressLine1:                          result.AddressLine1,
+				AddressLine2:                          result.AddressLine2,
+				City:                                  result.City,
+				State:                                 result.State,
+				ZipCode:                               result.ZipCode,
+				Country:                               result.Country,
+				Latitude:                              database.Float64PointerFromNullString(result.Latitude),
+				Longitude:                             database.Float64PointerFromNullString(result.Longitude),
+				PaymentProcessorCustomerID:            result.PaymentProcessorCustomerID,
+				BelongsToUser:                         result.BelongsToUser,
+				ID:                                    result.ID,
+				Name:                                  result.Name,
+				WebhookEncryptionKey:                  result.WebhookHmacSecret,
+				PreviousWebhookEncryptionKey:          result.PreviousWebhookHmacSecret,
+				PreviousWebhookEncryptionKeyExpiresAt: database.TimePointerFromNullTime(result.PreviousWebhookHmacSecretExpiresAt),
+				Members:                               nil,
 			}
 		}
 
@@ -171,7 +176,7 @@ func (q *Querier) getHouseholdsForUser(ctx context.Context, querier database.SQL
 
 // GetHouseholds fetches a list of households from the database that meet a particular filter.
 func (q *Querier) GetHouseholds(ctx context.Context, userID string, filter *types.QueryFilter) (x *types.QueryFilteredResult[types.Household], err error) {
-	return q.getHouseholdsForUser(ctx, q.db, userID, filter)
+	return q.getHouseholdsForUser(ctx, q.dbFor(ctx), userID, filter)
 }
 
 // CreateHousehold creates a household in the database.
@@ -186,7 +191,7 @@ func (q *Querier) CreateHousehold(ctx context.Context, input *types.HouseholdDat
 	logger := q.logger.WithValue(keys.UserIDKey, input.BelongsToUser)
 
 	// begin household creation transaction
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -285,7 +290,7 @@ func (q *Querier) UpdateHousehold(ctx context.Context, updated *types.Household)
 	logger := q.logger.WithValue(keys.HouseholdIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, updated.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -296,7 +301,7 @@ func (q *Querier) UpdateHousehold(ctx context.Context, updated *types.Household)
 		return observability.PrepareError(err, span, "fetching household")
 	}
 
-	if _, err = q.generatedQuerier.UpdateHousehold(ctx, q.db, &generated.UpdateHouseholdParams{
+	if _, err = q.generatedQuerier.UpdateHousehold(ctx, q.dbFor(ctx), &generated.UpdateHouseholdParams{
 		Name:          updated.Name,
 		ContactPhone:  updated.ContactPhone,
 		AddressLine1:  updated.AddressLine1,
@@ -426,12 +431,12 @@ func (q *Querier) ArchiveHousehold(ctx context.Context, householdID, userID stri
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
-	if _, err = q.generatedQuerier.ArchiveHousehold(ctx, q.db, &generated.ArchiveHouseholdParams{
+	if _, err = q.generatedQuerier.ArchiveHousehold(ctx, q.dbFor(ctx), &generated.ArchiveHouseholdParams{
 		BelongsToUser: userID,
 		ID:            householdID,
 	}); err != nil {
@@ -459,3 +464,158 @@ func (q *Querier) ArchiveHousehold(ctx context.Context, householdID, userID stri
 
 	return nil
 }
+
+// RotateHouseholdWebhookEncryptionKey replaces a household's webhook encryption key, keeping the
+// outgoing key around until previousKeyExpiresAt so that receivers have time to switch over. Only
+// a member of the household may rotate its key; for anyone else, it returns sql.ErrNoRows. Since only
+// one previous key is kept, a key can't be rotated again until the previous one has expired.
+func (q *Querier) RotateHouseholdWebhookEncryptionKey(ctx context.Context, householdID, userID, newKey string, previousKeyExpiresAt time.Time) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	logger := q.logger.WithValue(keys.HouseholdIDKey, householdID)
+
+	if userID == "" {
+		return ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.UserIDKey, userID)
+	logger = logger.WithValue(keys.UserIDKey, userID)
+
+	if newKey == "" {
+		return ErrEmptyInputProvided
+	}
+
+	tx, err := q.beginTx(ctx)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	changed, err := q.generatedQuerier.RotateHouseholdWebhookEncryptionKey(ctx, tx, &generated.RotateHouseholdWebhookEncryptionKeyParams{
+		PreviousWebhookHmacSecretExpiresAt: database.NullTimeFromTime(previousKeyExpiresAt),
+		WebhookHmacSecret:                  newKey,
+		ID:                                 householdID,
+		BelongsToUser:                      userID,
+	})
+	if err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "rotating household webhook encryption key")
+	}
+
+	if changed == 0 {
+		q.rollbackTransaction(ctx, tx)
+		return q.explainUnrotatedWebhookEncryptionKey(ctx, householdID, userID)
+	}
+
+	if _, err = q.createAuditLogEntry(ctx, tx, &types.AuditLogEntryDatabaseCreationInput{
+		BelongsToHousehold: &householdID,
+		ID:                 identifiers.New(),
+		ResourceType:       resourceTypeHouseholds,
+		RelevantID:         householdID,
+		EventType:          types.AuditLogEventTypeUpdated,
+		BelongsToUser:      userID,
+	}); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("household webhook encryption key rotated")
+
+	return nil
+}
+
+// explainUnrotatedWebhookEncryptionKey determines why a webhook encryption key rotation changed nothing.
+func (q *Querier) explainUnrotatedWebhookEncryptionKey(ctx context.Context, householdID, userID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	household, err := q.GetHousehold(ctx, householdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.ErrNoRows
+	} else if err != nil {
+		return observability.PrepareError(err, span, "fetching household")
+	}
+
+	isMember := slices.ContainsFunc(household.Members, func(membership *types.HouseholdUserMembershipWithUser) bool {
+		return membership.BelongsToUser != nil && membership.BelongsToUser.ID == userID
+	})
+	if !isMember {
+		return sql.ErrNoRows
+	}
+
+	if household.PreviousWebhookEncryptionKeyExpiresAt != nil && household.PreviousWebhookEncryptionKeyExpiresAt.After(q.currentTime()) {
+		return database.ErrWebhookEncryptionKeyRotationInProgress
+	}
+
+	return sql.ErrNoRows
+}
+
+// UpdateHouseholdBillingStatus records a household's subscription state as reported by the payment processor.
+// Updates that occurred before the last one applied are ignored, and reported as sql.ErrNoRows.
+func (q *Querier) UpdateHouseholdBillingStatus(ctx context.Context, input *types.HouseholdBillingStatusUpdateInput) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return ErrNilInputProvided
+	}
+
+	if input.HouseholdID == "" {
+		return ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, input.HouseholdID)
+	logger := q.logger.WithValue(keys.HouseholdIDKey, input.HouseholdID).WithValue("billing_status", input.BillingStatus)
+
+	tx, err := q.beginTx(ctx)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	changed, err := q.generatedQuerier.UpdateHouseholdBillingStatus(ctx, tx, &generated.UpdateHouseholdBillingStatusParams{
+		BillingStatus:              input.BillingStatus,
+		PaymentProcessorCustomerID: input.PaymentProcessorCustomerID,
+		SubscriptionPlanID:         database.NullStringFromStringPointer(input.SubscriptionPlanID),
+		OccurredAt:                 input.OccurredAt,
+		ID:                         input.HouseholdID,
+	})
+	if err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "updating household billing status")
+	}
+
+	if changed == 0 {
+		q.rollbackTransaction(ctx, tx)
+		return sql.ErrNoRows
+	}
+
+	if _, err = q.createAuditLogEntry(ctx, tx, &types.AuditLogEntryDatabaseCreationInput{
+		BelongsToHousehold: &input.HouseholdID,
+		ID:                 identifiers.New(),
+		ResourceType:       resourceTypeHouseholds,
+		RelevantID:         input.HouseholdID,
+		EventType:          types.AuditLogEventTypeUpdated,
+		Changes: map[string]types.ChangeLog{
+			"billing_status": {
+				NewValue: input.BillingStatus,
+			},
+		},
+	}); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("household billing status updated")
+
+	return nil
+}
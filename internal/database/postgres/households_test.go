@@ -3,14 +3,19 @@
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/converters"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,6 +86,51 @@ func TestQuerier_Integration_Households(t *testing.T) {
 	updatedHousehold.BelongsToUser = createdHouseholds[0].BelongsToUser
 	assert.NoError(t, dbc.UpdateHousehold(ctx, updatedHousehold))
 
+	// rotate webhook encryption key
+	originalHousehold, err := dbc.GetHousehold(ctx, createdHouseholds[0].ID)
+	require.NoError(t, err)
+	previousKeyExpiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	newKey := fakes.BuildFakeID()
+	assert.ErrorIs(t, dbc.RotateHouseholdWebhookEncryptionKey(ctx, createdHouseholds[0].ID, fakes.BuildFakeID(), newKey, previousKeyExpiresAt), sql.ErrNoRows)
+	require.NoError(t, dbc.RotateHouseholdWebhookEncryptionKey(ctx, createdHouseholds[0].ID, createdHouseholds[0].BelongsToUser, newKey, previousKeyExpiresAt))
+
+	rotatedHousehold, err := dbc.GetHousehold(ctx, createdHouseholds[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, newKey, rotatedHousehold.WebhookEncryptionKey)
+	assert.Equal(t, originalHousehold.WebhookEncryptionKey, rotatedHousehold.PreviousWebhookEncryptionKey)
+	require.NotNil(t, rotatedHousehold.PreviousWebhookEncryptionKeyExpiresAt)
+	assert.True(t, previousKeyExpiresAt.Equal(*rotatedHousehold.PreviousWebhookEncryptionKeyExpiresAt))
+
+	// rotating again before the previous key expires would cut its grace period short, so it's refused.
+	assert.ErrorIs(t, dbc.RotateHouseholdWebhookEncryptionKey(ctx, createdHouseholds[0].ID, createdHouseholds[0].BelongsToUser, fakes.BuildFakeID(), previousKeyExpiresAt), database.ErrWebhookEncryptionKeyRotationInProgress)
+
+	unrotatedHousehold, err := dbc.GetHousehold(ctx, createdHouseholds[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, newKey, unrotatedHousehold.WebhookEncryptionKey)
+	assert.Equal(t, originalHousehold.WebhookEncryptionKey, unrotatedHousehold.PreviousWebhookEncryptionKey)
+
+	// update billing status, ignoring updates that occurred before the last one applied
+	planID := "price_premium"
+	occurredAt := time.Now().Truncate(time.Second)
+	require.NoError(t, dbc.UpdateHouseholdBillingStatus(ctx, &types.HouseholdBillingStatusUpdateInput{
+		OccurredAt:                 occurredAt,
+		SubscriptionPlanID:         &planID,
+		HouseholdID:                createdHouseholds[0].ID,
+		BillingStatus:              types.PaidHouseholdBillingStatus,
+		PaymentProcessorCustomerID: "cus_whatever",
+	}))
+	assert.ErrorIs(t, dbc.UpdateHouseholdBillingStatus(ctx, &types.HouseholdBillingStatusUpdateInput{
+		OccurredAt:                 occurredAt.Add(-time.Minute),
+		HouseholdID:                createdHouseholds[0].ID,
+		BillingStatus:              types.UnpaidHouseholdBillingStatus,
+		PaymentProcessorCustomerID: "cus_whatever",
+	}), sql.ErrNoRows)
+
+	billedHousehold, err := dbc.GetHousehold(ctx, createdHouseholds[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, types.PaidHouseholdBillingStatus, billedHousehold.BillingStatus)
+	assert.Equal(t, &planID, billedHousehold.SubscriptionPlanID)
+
 	// create more
 	for i := 0; i < exampleQuantity; i++ {
 		input := fakes.BuildFakeHousehold()
@@ -219,3 +269,85 @@ func TestQuerier_ArchiveHousehold(T *testing.T) {
 		assert.Error(t, c.ArchiveHousehold(ctx, exampleHouseholdID, ""))
 	})
 }
+
+func TestQuerier_RotateHouseholdWebhookEncryptionKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.RotateHouseholdWebhookEncryptionKey(ctx, "", fakes.BuildFakeID(), fakes.BuildFakeID(), time.Now()))
+	})
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.RotateHouseholdWebhookEncryptionKey(ctx, fakes.BuildFakeID(), "", fakes.BuildFakeID(), time.Now()))
+	})
+
+	T.Run("with empty key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.RotateHouseholdWebhookEncryptionKey(ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), "", time.Now()))
+	})
+}
+
+func TestQuerier_UpdateHouseholdBillingStatus(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.UpdateHouseholdBillingStatus(ctx, nil))
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.UpdateHouseholdBillingStatus(ctx, &types.HouseholdBillingStatusUpdateInput{BillingStatus: types.PaidHouseholdBillingStatus}))
+	})
+
+	T.Run("rolls back with the surrounding transaction", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectExec("SAVEPOINT savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectExec("UPDATE households SET").WillReturnResult(sqlmock.NewResult(0, 1))
+		db.ExpectExec("INSERT INTO audit_log_entries").WillReturnResult(sqlmock.NewResult(0, 1))
+		db.ExpectExec("RELEASE SAVEPOINT savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectRollback()
+
+		publishErr := errors.New("blah")
+		assert.ErrorIs(t, c.RunInTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, c.UpdateHouseholdBillingStatus(ctx, &types.HouseholdBillingStatusUpdateInput{
+				OccurredAt:                 time.Now(),
+				HouseholdID:                fakes.BuildFakeID(),
+				BillingStatus:              types.PaidHouseholdBillingStatus,
+				PaymentProcessorCustomerID: "cus_whatever",
+			}))
+
+			// the data change message couldn't be published, so the status change mustn't be committed either.
+			return publishErr
+		}), publishErr)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+}
@@ -34,7 +34,7 @@ func (q *Querier) MealPlanEventExists(ctx context.Context, mealPlanID, mealPlanE
 	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
 	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
 
-	result, err := q.generatedQuerier.CheckMealPlanEventExistence(ctx, q.db, &generated.CheckMealPlanEventExistenceParams{
+	result, err := q.generatedQuerier.CheckMealPlanEventExistence(ctx, q.dbFor(ctx), &generated.CheckMealPlanEventExistenceParams{
 		ID:         mealPlanEventID,
 		MealPlanID: mealPlanID,
 	})
@@ -64,7 +64,7 @@ func (q *Querier) GetMealPlanEvent(ctx context.Context, mealPlanID, mealPlanEven
 	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
 	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
 
-	result, err := q.generatedQuerier.GetMealPlanEvent(ctx, q.db, &generated.GetMealPlanEventParams{
+	result, err := q.generatedQuerier.GetMealPlanEvent(ctx, q.dbFor(ctx), &generated.GetMealPlanEventParams{
 		ID:                mealPlanEventID,
 		BelongsToMealPlan: mealPlanID,
 	})
@@ -108,7 +108,7 @@ func (q *Querier) getMealPlanEventsForMealPlan(ctx context.Context, mealPlanID s
 
 	x = []*types.MealPlanEvent{}
 
-	results, err := q.generatedQuerier.GetAllMealPlanEventsForMealPlan(ctx, q.db, mealPlanID)
+	results, err := q.generatedQuerier.GetAllMealPlanEventsForMealPlan(ctx, q.dbFor(ctx), mealPlanID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing meal plan events list retrieval query")
 	}
@@ -162,7 +162,7 @@ func (q *Querier) GetMealPlanEvents(ctx context.Context, mealPlanID string, filt
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetMealPlanEvents(ctx, q.db, &generated.GetMealPlanEventsParams{
+	results, err := q.generatedQuerier.GetMealPlanEvents(ctx, q.dbFor(ctx), &generated.GetMealPlanEventsParams{
 		MealPlanID:    mealPlanID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -213,7 +213,7 @@ func (q *Querier) MealPlanEventIsEligibleForVoting(ctx context.Context, mealPlan
 	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
 	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
 
-	result, err := q.generatedQuerier.MealPlanEventIsEligibleForVoting(ctx, q.db, &generated.MealPlanEventIsEligibleForVotingParams{
+	result, err := q.generatedQuerier.MealPlanEventIsEligibleForVoting(ctx, q.dbFor(ctx), &generated.MealPlanEventIsEligibleForVotingParams{
 		MealPlanID:      mealPlanID,
 		MealPlanEventID: mealPlanEventID,
 	})
@@ -283,7 +283,7 @@ func (q *Querier) CreateMealPlanEvent(ctx context.Context, input *types.MealPlan
 		return nil, ErrNilInputProvided
 	}
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "beginning transaction")
 	}
@@ -311,7 +311,7 @@ func (q *Querier) UpdateMealPlanEvent(ctx context.Context, updated *types.MealPl
 	logger := q.logger.WithValue(keys.MealPlanEventIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateMealPlanEvent(ctx, q.db, &generated.UpdateMealPlanEventParams{
+	if _, err := q.generatedQuerier.UpdateMealPlanEvent(ctx, q.dbFor(ctx), &generated.UpdateMealPlanEventParams{
 		Notes:             updated.Notes,
 		StartsAt:          updated.StartsAt,
 		EndsAt:            updated.EndsAt,
@@ -346,7 +346,7 @@ func (q *Querier) ArchiveMealPlanEvent(ctx context.Context, mealPlanID, mealPlan
 	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
 	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
 
-	if _, err := q.generatedQuerier.ArchiveMealPlanEvent(ctx, q.db, &generated.ArchiveMealPlanEventParams{
+	if _, err := q.generatedQuerier.ArchiveMealPlanEvent(ctx, q.dbFor(ctx), &generated.ArchiveMealPlanEventParams{
 		ID:                mealPlanEventID,
 		BelongsToMealPlan: mealPlanID,
 	}); err != nil {
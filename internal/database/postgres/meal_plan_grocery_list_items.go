@@ -34,7 +34,7 @@ func (q *Querier) MealPlanGroceryListItemExists(ctx context.Context, mealPlanID,
 	logger = logger.WithValue(keys.MealPlanGroceryListItemIDKey, mealPlanGroceryListItemID)
 	tracing.AttachToSpan(span, keys.MealPlanGroceryListItemIDKey, mealPlanGroceryListItemID)
 
-	result, err := q.generatedQuerier.CheckMealPlanGroceryListItemExistence(ctx, q.db, &generated.CheckMealPlanGroceryListItemExistenceParams{
+	result, err := q.generatedQuerier.CheckMealPlanGroceryListItemExistence(ctx, q.dbFor(ctx), &generated.CheckMealPlanGroceryListItemExistenceParams{
 		MealPlanID:                mealPlanID,
 		MealPlanGroceryListItemID: mealPlanGroceryListItemID,
 	})
@@ -99,7 +99,7 @@ func (q *Querier) GetMealPlanGroceryListItem(ctx context.Context, mealPlanID, me
 	logger = logger.WithValue(keys.MealPlanGroceryListItemIDKey, mealPlanGroceryListItemID)
 	tracing.AttachToSpan(span, keys.MealPlanGroceryListItemIDKey, mealPlanGroceryListItemID)
 
-	result, err := q.generatedQuerier.GetMealPlanGroceryListItem(ctx, q.db, &generated.GetMealPlanGroceryListItemParams{
+	result, err := q.generatedQuerier.GetMealPlanGroceryListItem(ctx, q.dbFor(ctx), &generated.GetMealPlanGroceryListItemParams{
 		MealPlanID:                mealPlanID,
 		MealPlanGroceryListItemID: mealPlanGroceryListItemID,
 	})
@@ -208,7 +208,7 @@ func (q *Querier) GetMealPlanGroceryListItemsForMealPlan(ctx context.Context, me
 	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
 	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
 
-	results, err := q.generatedQuerier.GetMealPlanGroceryListItemsForMealPlan(ctx, q.db, mealPlanID)
+	results, err := q.generatedQuerier.GetMealPlanGroceryListItemsForMealPlan(ctx, q.dbFor(ctx), mealPlanID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing meal plan grocery list items list retrieval query")
 	}
@@ -369,7 +369,7 @@ func (q *Querier) createMealPlanGroceryListItem(ctx context.Context, querier dat
 
 // CreateMealPlanGroceryListItem creates a meal plan grocery list in the database.
 func (q *Querier) CreateMealPlanGroceryListItem(ctx context.Context, input *types.MealPlanGroceryListItemDatabaseCreationInput) (*types.MealPlanGroceryListItem, error) {
-	return q.createMealPlanGroceryListItem(ctx, q.db, input)
+	return q.createMealPlanGroceryListItem(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateMealPlanGroceryListItem updates a particular meal plan grocery list.
@@ -388,7 +388,7 @@ func (q *Querier) UpdateMealPlanGroceryListItem(ctx context.Context, updated *ty
 		purchasedMeasurementUnitID = &updated.PurchasedMeasurementUnit.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateMealPlanGroceryListItem(ctx, q.db, &generated.UpdateMealPlanGroceryListItemParams{
+	if _, err := q.generatedQuerier.UpdateMealPlanGroceryListItem(ctx, q.dbFor(ctx), &generated.UpdateMealPlanGroceryListItemParams{
 		BelongsToMealPlan:        updated.BelongsToMealPlan,
 		ValidIngredient:          updated.Ingredient.ID,
 		ValidMeasurementUnit:     updated.MeasurementUnit.ID,
@@ -423,7 +423,7 @@ func (q *Querier) ArchiveMealPlanGroceryListItem(ctx context.Context, mealPlanGr
 	logger = logger.WithValue(keys.MealPlanGroceryListItemIDKey, mealPlanGroceryListItemID)
 	tracing.AttachToSpan(span, keys.MealPlanGroceryListItemIDKey, mealPlanGroceryListItemID)
 
-	if _, err := q.generatedQuerier.ArchiveMealPlanGroceryListItem(ctx, q.db, mealPlanGroceryListItemID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveMealPlanGroceryListItem(ctx, q.dbFor(ctx), mealPlanGroceryListItemID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving meal plan grocery list")
 	}
 
@@ -97,6 +97,34 @@ func TestQuerier_Integration_MealPlanGroceryListItems(t *testing.T) {
 	// update
 	assert.NoError(t, dbc.UpdateMealPlanGroceryListItem(ctx, createdMealPlanGroceryListItems[0]))
 
+	// stock the pantry, only once
+	pantryInput := &types.PantryItemDatabaseCreationInput{
+		ID:                      fakes.BuildFakeID(),
+		ValidIngredientID:       ingredient.ID,
+		ValidMeasurementUnitID:  measurmentUnit.ID,
+		StorageLocation:         types.PantryItemStorageLocationPantry,
+		BelongsToHousehold:      householdID,
+		SourceGroceryListItemID: createdMealPlanGroceryListItems[0].ID,
+		Quantity:                createdMealPlanGroceryListItems[0].MinimumQuantityNeeded,
+	}
+	pantryItem, err := dbc.CreatePantryItemForGroceryListItem(ctx, pantryInput)
+	require.NoError(t, err)
+	require.NotNil(t, pantryItem)
+
+	pantryInput.ID = fakes.BuildFakeID()
+	duplicatePantryItem, err := dbc.CreatePantryItemForGroceryListItem(ctx, pantryInput)
+	assert.NoError(t, err)
+	assert.Nil(t, duplicatePantryItem)
+
+	// take it back out of the pantry
+	archivedPantryItemID, err := dbc.ArchivePantryItemForGroceryListItem(ctx, createdMealPlanGroceryListItems[0].ID, householdID)
+	assert.NoError(t, err)
+	assert.Equal(t, pantryItem.ID, archivedPantryItemID)
+
+	archivedPantryItemID, err = dbc.ArchivePantryItemForGroceryListItem(ctx, createdMealPlanGroceryListItems[0].ID, householdID)
+	assert.NoError(t, err)
+	assert.Empty(t, archivedPantryItemID)
+
 	// fetch as list
 	mealPlanGroceryListItems, err := dbc.GetMealPlanGroceryListItemsForMealPlan(ctx, mealPlan.ID)
 	assert.NoError(t, err)
@@ -6,13 +6,13 @@
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/features/mealplanelections"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	"math/rand/v2"
-	"resenje.org/schulze"
 )
 
 var (
@@ -44,7 +44,7 @@ func (q *Querier) MealPlanOptionExists(ctx context.Context, mealPlanID, mealPlan
 	logger = logger.WithValue(keys.MealPlanOptionIDKey, mealPlanOptionID)
 	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
 
-	result, err := q.generatedQuerier.CheckMealPlanOptionExistence(ctx, q.db, &generated.CheckMealPlanOptionExistenceParams{
+	result, err := q.generatedQuerier.CheckMealPlanOptionExistence(ctx, q.dbFor(ctx), &generated.CheckMealPlanOptionExistenceParams{
 		MealPlanEventID:  database.NullStringFromString(mealPlanEventID),
 		MealPlanOptionID: mealPlanOptionID,
 		MealPlanID:       mealPlanID,
@@ -82,7 +82,7 @@ func (q *Querier) GetMealPlanOption(ctx context.Context, mealPlanID, mealPlanEve
 	logger = logger.WithValue(keys.MealPlanOptionIDKey, mealPlanOptionID)
 	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
 
-	result, err := q.generatedQuerier.GetMealPlanOption(ctx, q.db, &generated.GetMealPlanOptionParams{
+	result, err := q.generatedQuerier.GetMealPlanOption(ctx, q.dbFor(ctx), &generated.GetMealPlanOptionParams{
 		MealPlanID:       mealPlanID,
 		MealPlanEventID:  database.NullStringFromString(mealPlanEventID),
 		MealPlanOptionID: mealPlanOptionID,
@@ -133,7 +133,7 @@ func (q *Querier) getMealPlanOptionByID(ctx context.Context, mealPlanOptionID st
 	logger = logger.WithValue(keys.MealPlanOptionIDKey, mealPlanOptionID)
 	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
 
-	result, err := q.generatedQuerier.GetMealPlanOptionByID(ctx, q.db, mealPlanOptionID)
+	result, err := q.generatedQuerier.GetMealPlanOptionByID(ctx, q.dbFor(ctx), mealPlanOptionID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing meal plan option query")
 	}
@@ -188,7 +188,7 @@ func (q *Querier) getMealPlanOptionsForMealPlanEvent(ctx context.Context, mealPl
 	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
 	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanEventID)
 
-	results, err := q.generatedQuerier.GetAllMealPlanOptionsForMealPlanEvent(ctx, q.db, &generated.GetAllMealPlanOptionsForMealPlanEventParams{
+	results, err := q.generatedQuerier.GetAllMealPlanOptionsForMealPlanEvent(ctx, q.dbFor(ctx), &generated.GetAllMealPlanOptionsForMealPlanEventParams{
 		MealPlanID:      mealPlanID,
 		MealPlanEventID: database.NullStringFromString(mealPlanEventID),
 	})
@@ -265,7 +265,7 @@ func (q *Querier) GetMealPlanOptions(ctx context.Context, mealPlanID, mealPlanEv
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetMealPlanOptions(ctx, q.db, &generated.GetMealPlanOptionsParams{
+	results, err := q.generatedQuerier.GetMealPlanOptions(ctx, q.dbFor(ctx), &generated.GetMealPlanOptionsParams{
 		MealPlanEventID: database.NullStringFromString(mealPlanEventID),
 		MealPlanID:      mealPlanID,
 		CreatedBefore:   database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -348,7 +348,7 @@ func (q *Querier) createMealPlanOption(ctx context.Context, db database.SQLQuery
 
 // CreateMealPlanOption creates a meal plan option in the database.
 func (q *Querier) CreateMealPlanOption(ctx context.Context, input *types.MealPlanOptionDatabaseCreationInput) (*types.MealPlanOption, error) {
-	return q.createMealPlanOption(ctx, q.db, input, false)
+	return q.createMealPlanOption(ctx, q.dbFor(ctx), input, false)
 }
 
 // UpdateMealPlanOption updates a particular meal plan option.
@@ -362,7 +362,7 @@ func (q *Querier) UpdateMealPlanOption(ctx context.Context, updated *types.MealP
 	logger := q.logger.WithValue(keys.MealPlanOptionIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateMealPlanOption(ctx, q.db, &generated.UpdateMealPlanOptionParams{
+	if _, err := q.generatedQuerier.UpdateMealPlanOption(ctx, q.dbFor(ctx), &generated.UpdateMealPlanOptionParams{
 		MealID:             updated.Meal.ID,
 		Notes:              updated.Notes,
 		MealScale:          database.StringFromFloat32(updated.MealScale),
@@ -404,7 +404,7 @@ func (q *Querier) ArchiveMealPlanOption(ctx context.Context, mealPlanID, mealPla
 	logger = logger.WithValue(keys.MealPlanOptionIDKey, mealPlanOptionID)
 	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
 
-	if _, err := q.generatedQuerier.ArchiveMealPlanOption(ctx, q.db, &generated.ArchiveMealPlanOptionParams{
+	if _, err := q.generatedQuerier.ArchiveMealPlanOption(ctx, q.dbFor(ctx), &generated.ArchiveMealPlanOptionParams{
 		ID:                     mealPlanOptionID,
 		BelongsToMealPlanEvent: sql.NullString{String: mealPlanEventID, Valid: true},
 	}); err != nil {
@@ -416,71 +416,30 @@ func (q *Querier) ArchiveMealPlanOption(ctx context.Context, mealPlanID, mealPla
 	return nil
 }
 
-func (q *Querier) determineWinner(winners []schulze.Result[string]) string {
-	var (
-		highestScore int
-		scoreWinners []string
-	)
-
-	for _, winner := range winners {
-		if winner.Wins == highestScore {
-			scoreWinners = append(scoreWinners, winner.Choice)
-		} else if winner.Wins > highestScore {
-			highestScore = winner.Wins
-			scoreWinners = []string{winner.Choice}
-		}
-	}
-
+// determineWinner breaks a tie between several winning options at random.
+func (q *Querier) determineWinner(winners []string) string {
 	/* #nosec: G404 */
-	return scoreWinners[rand.N(len(scoreWinners))]
+	return winners[rand.N(len(winners))]
 }
 
-func (q *Querier) decideOptionWinner(ctx context.Context, options []*types.MealPlanOption) (_ string, _, _ bool) {
+// decideOptionWinner tallies the votes for a set of options using the provided election method.
+func (q *Querier) decideOptionWinner(ctx context.Context, electionMethod string, options []*types.MealPlanOption) (_ string, _, _ bool, _ error) {
 	_, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	candidateMap := map[string]struct{}{}
-	votesByUser := map[string]schulze.Ballot[string]{}
-
-	logger := q.logger.WithValue("options.count", len(options))
-
-	for _, option := range options {
-		for _, v := range option.Votes {
-			if votesByUser[v.ByUser] == nil {
-				votesByUser[v.ByUser] = schulze.Ballot[string]{}
-			}
-
-			if !v.Abstain {
-				votesByUser[v.ByUser][v.BelongsToMealPlanOption] = int(v.Rank)
-			}
-
-			candidateMap[v.BelongsToMealPlanOption] = struct{}{}
-		}
-	}
-
-	candidates := []string{}
-	for c := range candidateMap {
-		candidates = append(candidates, c)
-	}
-
-	e := schulze.NewVoting(candidates)
-	for _, vote := range votesByUser {
-		if _, err := e.Vote(vote); err != nil {
-			// this actually can never happen because we use uints for ranks, lol
-			observability.AcknowledgeError(err, logger, span, "an invalid vote was received")
-		}
-	}
-
-	winners, _, tie := e.Compute()
-	if tie {
-		return q.determineWinner(winners), true, true
+	results, err := mealplanelections.TallyMealPlanEvent(electionMethod, &types.MealPlanEvent{Options: options})
+	if err != nil {
+		return "", false, false, observability.PrepareError(err, span, "tallying votes")
 	}
 
-	if len(winners) > 0 {
-		return winners[0].Choice, false, true
+	switch len(results.Winners) {
+	case 0:
+		return "", false, false, nil
+	case 1:
+		return results.Winners[0], false, true, nil
+	default:
+		return q.determineWinner(results.Winners), true, true, nil
 	}
-
-	return "", false, false
 }
 
 // FinalizeMealPlanOption archives a meal plan option vote from the database by its ID.
@@ -556,9 +515,13 @@ func (q *Querier) FinalizeMealPlanOption(ctx context.Context, mealPlanID, mealPl
 		}
 	}
 
-	winner, tiebroken, chosen := q.decideOptionWinner(ctx, mealPlanEvent.Options)
+	winner, tiebroken, chosen, err := q.decideOptionWinner(ctx, mealPlan.ElectionMethod, mealPlanEvent.Options)
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "deciding meal plan option winner")
+	}
+
 	if chosen {
-		if err = q.generatedQuerier.FinalizeMealPlanOption(ctx, q.db, &generated.FinalizeMealPlanOptionParams{
+		if err = q.generatedQuerier.FinalizeMealPlanOption(ctx, q.dbFor(ctx), &generated.FinalizeMealPlanOptionParams{
 			MealPlanEventID: database.NullStringFromString(mealPlanEventID),
 			ID:              winner,
 			Tiebroken:       tiebroken,
@@ -10,7 +10,6 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"resenje.org/schulze"
 )
 
 func buildMealPlanOptionForIntegrationTest(meal *types.Meal) *types.MealPlanOption {
@@ -284,22 +283,8 @@ func Test_determineWinner(T *testing.T) {
 		c, _ := buildTestClient(t)
 
 		expected := "blah blah blah"
-		exampleWinners := []schulze.Result[string]{
-			{
-				Choice: t.Name(),
-				Wins:   1,
-			},
-			{
-				Choice: "",
-				Wins:   2,
-			},
-			{
-				Choice: expected,
-				Wins:   3,
-			},
-		}
 
-		actual := c.determineWinner(exampleWinners)
+		actual := c.determineWinner([]string{expected})
 
 		assert.Equal(t, expected, actual)
 	})
@@ -311,22 +296,8 @@ func Test_determineWinner(T *testing.T) {
 
 		expectedA := "blah blah blah"
 		expectedB := "beeble beeble"
-		exampleWinners := []schulze.Result[string]{
-			{
-				Choice: expectedA,
-				Wins:   3,
-			},
-			{
-				Choice: "",
-				Wins:   1,
-			},
-			{
-				Choice: expectedB,
-				Wins:   3,
-			},
-		}
 
-		actual := c.determineWinner(exampleWinners)
+		actual := c.determineWinner([]string{expectedA, expectedB})
 
 		assert.True(t, expectedA == actual || expectedB == actual)
 	})
@@ -429,7 +400,8 @@ func Test_decideOptionWinner(T *testing.T) {
 			},
 		}
 
-		actual, tiebroken, chosen := c.decideOptionWinner(ctx, exampleOptions)
+		actual, tiebroken, chosen, err := c.decideOptionWinner(ctx, types.MealPlanElectionMethodSchulze, exampleOptions)
+		assert.NoError(t, err)
 		assert.Equal(t, expected, actual)
 		assert.False(t, tiebroken)
 		assert.True(t, chosen)
@@ -464,7 +436,8 @@ func Test_decideOptionWinner(T *testing.T) {
 			},
 		}
 
-		actual, tiebroken, chosen := c.decideOptionWinner(ctx, exampleOptions)
+		actual, tiebroken, chosen, err := c.decideOptionWinner(ctx, types.MealPlanElectionMethodSchulze, exampleOptions)
+		assert.NoError(t, err)
 		assert.NotEmpty(t, actual)
 		assert.True(t, tiebroken)
 		assert.True(t, chosen)
@@ -483,7 +456,34 @@ func Test_decideOptionWinner(T *testing.T) {
 			},
 		}
 
-		actual, tiebroken, chosen := c.decideOptionWinner(ctx, exampleOptions)
+		actual, tiebroken, chosen, err := c.decideOptionWinner(ctx, types.MealPlanElectionMethodSchulze, exampleOptions)
+		assert.NoError(t, err)
+		assert.Empty(t, actual)
+		assert.False(t, tiebroken)
+		assert.False(t, chosen)
+	})
+
+	T.Run("with unknown election method", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		exampleOptions := []*types.MealPlanOption{
+			{
+				ID: optionA,
+				Votes: []*types.MealPlanOptionVote{
+					{
+						BelongsToMealPlanOption: optionA,
+						Rank:                    0,
+						ByUser:                  userID1,
+					},
+				},
+			},
+		}
+
+		actual, tiebroken, chosen, err := c.decideOptionWinner(ctx, "fake", exampleOptions)
+		assert.Error(t, err)
 		assert.Empty(t, actual)
 		assert.False(t, tiebroken)
 		assert.False(t, chosen)
@@ -35,7 +35,7 @@ func (q *Querier) MealPlanTaskExists(ctx context.Context, mealPlanID, mealPlanTa
 	logger = logger.WithValue(keys.MealPlanTaskIDKey, mealPlanTaskID)
 	tracing.AttachToSpan(span, keys.MealPlanTaskIDKey, mealPlanTaskID)
 
-	result, err := q.generatedQuerier.CheckMealPlanTaskExistence(ctx, q.db, &generated.CheckMealPlanTaskExistenceParams{
+	result, err := q.generatedQuerier.CheckMealPlanTaskExistence(ctx, q.dbFor(ctx), &generated.CheckMealPlanTaskExistenceParams{
 		MealPlanID:     mealPlanID,
 		MealPlanTaskID: mealPlanTaskID,
 	})
@@ -61,7 +61,7 @@ func (q *Querier) GetMealPlanTask(ctx context.Context, mealPlanTaskID string) (*
 	logger = logger.WithValue(keys.MealPlanTaskIDKey, mealPlanTaskID)
 	tracing.AttachToSpan(span, keys.MealPlanTaskIDKey, mealPlanTaskID)
 
-	result, err := q.generatedQuerier.GetMealPlanTask(ctx, q.db, mealPlanTaskID)
+	result, err := q.generatedQuerier.GetMealPlanTask(ctx, q.dbFor(ctx), mealPlanTaskID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing meal plan task existence check")
 	}
@@ -157,7 +157,7 @@ func (q *Querier) CreateMealPlanTask(ctx context.Context, input *types.MealPlanT
 	}
 	logger = logger.WithValue(keys.MealPlanTaskIDKey, input.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -190,7 +190,7 @@ func (q *Querier) GetMealPlanTasksForMealPlan(ctx context.Context, mealPlanID st
 	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
 	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
 
-	results, err := q.generatedQuerier.ListAllMealPlanTasksByMealPlan(ctx, q.db, mealPlanID)
+	results, err := q.generatedQuerier.ListAllMealPlanTasksByMealPlan(ctx, q.dbFor(ctx), mealPlanID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing meal plan tasks list retrieval query")
 	}
@@ -241,7 +241,7 @@ func (q *Querier) CreateMealPlanTasksForMealPlanOption(ctx context.Context, inpu
 
 	logger := q.logger.Clone()
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -279,7 +279,7 @@ func (q *Querier) MarkMealPlanAsHavingTasksCreated(ctx context.Context, mealPlan
 	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
 	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
 
-	if err := q.generatedQuerier.MarkMealPlanAsPrepTasksCreated(ctx, q.db, mealPlanID); err != nil {
+	if err := q.generatedQuerier.MarkMealPlanAsPrepTasksCreated(ctx, q.dbFor(ctx), mealPlanID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking meal plan as having tasks created")
 	}
 
@@ -301,7 +301,7 @@ func (q *Querier) MarkMealPlanAsHavingGroceryListInitialized(ctx context.Context
 	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
 	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
 
-	if err := q.generatedQuerier.MarkMealPlanAsGroceryListInitialized(ctx, q.db, mealPlanID); err != nil {
+	if err := q.generatedQuerier.MarkMealPlanAsGroceryListInitialized(ctx, q.dbFor(ctx), mealPlanID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking meal plan as having tasks created")
 	}
 
@@ -334,7 +334,7 @@ func (q *Querier) ChangeMealPlanTaskStatus(ctx context.Context, input *types.Mea
 		newStatus = *input.Status
 	}
 
-	if err := q.generatedQuerier.ChangeMealPlanTaskStatus(ctx, q.db, &generated.ChangeMealPlanTaskStatusParams{
+	if err := q.generatedQuerier.ChangeMealPlanTaskStatus(ctx, q.dbFor(ctx), &generated.ChangeMealPlanTaskStatusParams{
 		ID:                input.ID,
 		Status:            generated.PrepStepStatus(newStatus),
 		StatusExplanation: input.StatusExplanation,
@@ -35,7 +35,7 @@ func (q *Querier) MealPlanExists(ctx context.Context, mealPlanID, householdID st
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	result, err := q.generatedQuerier.CheckMealPlanExistence(ctx, q.db, &generated.CheckMealPlanExistenceParams{
+	result, err := q.generatedQuerier.CheckMealPlanExistence(ctx, q.dbFor(ctx), &generated.CheckMealPlanExistenceParams{
 		MealPlanID:         mealPlanID,
 		BelongsToHousehold: householdID,
 	})
@@ -65,7 +65,7 @@ func (q *Querier) getMealPlan(ctx context.Context, mealPlanID, householdID strin
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	result, err := q.generatedQuerier.GetMealPlan(ctx, q.db, &generated.GetMealPlanParams{
+	result, err := q.generatedQuerier.GetMealPlan(ctx, q.dbFor(ctx), &generated.GetMealPlanParams{
 		ID:                 mealPlanID,
 		BelongsToHousehold: householdID,
 	})
@@ -122,7 +122,7 @@ func (q *Querier) GetMealPlans(ctx context.Context, householdID string, filter *
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetMealPlans(ctx, q.db, &generated.GetMealPlansParams{
+	results, err := q.generatedQuerier.GetMealPlans(ctx, q.dbFor(ctx), &generated.GetMealPlansParams{
 		HouseholdID:   householdID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -185,13 +185,13 @@ func (q *Querier) CreateMealPlan(ctx context.Context, input *types.MealPlanDatab
 		}
 	}
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
 	// create the meal plan.
-	if err = q.generatedQuerier.CreateMealPlan(ctx, q.db, &generated.CreateMealPlanParams{
+	if err = q.generatedQuerier.CreateMealPlan(ctx, q.dbFor(ctx), &generated.CreateMealPlanParams{
 		ID:                 input.ID,
 		Notes:              input.Notes,
 		Status:             generated.MealPlanStatus(status),
@@ -247,7 +247,7 @@ func (q *Querier) UpdateMealPlan(ctx context.Context, updated *types.MealPlan) e
 	tracing.AttachToSpan(span, keys.MealPlanIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, updated.BelongsToHousehold)
 
-	if _, err := q.generatedQuerier.UpdateMealPlan(ctx, q.db, &generated.UpdateMealPlanParams{
+	if _, err := q.generatedQuerier.UpdateMealPlan(ctx, q.dbFor(ctx), &generated.UpdateMealPlanParams{
 		Notes:              updated.Notes,
 		Status:             generated.MealPlanStatus(updated.Status),
 		VotingDeadline:     updated.VotingDeadline,
@@ -281,7 +281,7 @@ func (q *Querier) ArchiveMealPlan(ctx context.Context, mealPlanID, householdID s
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	if _, err := q.generatedQuerier.ArchiveMealPlan(ctx, q.db, &generated.ArchiveMealPlanParams{
+	if _, err := q.generatedQuerier.ArchiveMealPlan(ctx, q.dbFor(ctx), &generated.ArchiveMealPlanParams{
 		BelongsToHousehold: householdID,
 		ID:                 mealPlanID,
 	}); err != nil {
@@ -331,7 +331,7 @@ func (q *Querier) AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID, hou
 	}
 
 	usersWhoHaveNotVoted := []string{}
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -381,11 +381,15 @@ func (q *Querier) AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID, hou
 		}
 
 		// the ballot is ready to be tallied for this event
-		winner, tiebroken, chosen := q.decideOptionWinner(ctx, event.Options)
+		winner, tiebroken, chosen, decisionErr := q.decideOptionWinner(ctx, mealPlan.ElectionMethod, event.Options)
+		if decisionErr != nil {
+			return false, observability.PrepareAndLogError(decisionErr, logger, span, "deciding meal plan option winner")
+		}
+
 		if chosen {
 			logger = logger.WithValue("winner", winner).WithValue("tiebroken", tiebroken)
 
-			if err = q.generatedQuerier.FinalizeMealPlanOption(ctx, q.db, &generated.FinalizeMealPlanOptionParams{
+			if err = q.generatedQuerier.FinalizeMealPlanOption(ctx, q.dbFor(ctx), &generated.FinalizeMealPlanOptionParams{
 				MealPlanEventID: database.NullStringFromString(event.ID),
 				ID:              winner,
 				Tiebroken:       tiebroken,
@@ -402,7 +406,7 @@ func (q *Querier) AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID, hou
 	if allVotesAreSubmitted || (!allVotesAreSubmitted && votingDeadlineHasPassed) {
 		logger.Info("finalizing meal plan")
 
-		if err = q.generatedQuerier.FinalizeMealPlan(ctx, q.db, &generated.FinalizeMealPlanParams{
+		if err = q.generatedQuerier.FinalizeMealPlan(ctx, q.dbFor(ctx), &generated.FinalizeMealPlanParams{
 			Status: generated.MealPlanStatus(types.MealPlanStatusFinalized),
 			ID:     mealPlanID,
 		}); err != nil {
@@ -430,7 +434,7 @@ func (q *Querier) GetUnfinalizedMealPlansWithExpiredVotingPeriods(ctx context.Co
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetExpiredAndUnresolvedMealPlans(ctx, q.db)
+	results, err := q.generatedQuerier.GetExpiredAndUnresolvedMealPlans(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing unfinalized meal plans with expired voting periods retrieval query")
 	}
@@ -462,7 +466,7 @@ func (q *Querier) GetFinalizedMealPlanIDsForTheNextWeek(ctx context.Context) ([]
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetFinalizedMealPlansForPlanning(ctx, q.db)
+	results, err := q.generatedQuerier.GetFinalizedMealPlansForPlanning(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing finalized meal plan IDs for the week retrieval query")
 	}
@@ -504,7 +508,7 @@ func (q *Querier) GetFinalizedMealPlansWithUninitializedGroceryLists(ctx context
 	_, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetFinalizedMealPlansWithoutGroceryListInit(ctx, q.db)
+	results, err := q.generatedQuerier.GetFinalizedMealPlansWithoutGroceryListInit(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing finalized meal plans without grocery list initialization query")
 	}
@@ -30,7 +30,7 @@ func (q *Querier) MealExists(ctx context.Context, mealID string) (exists bool, e
 	logger = logger.WithValue(keys.MealIDKey, mealID)
 	tracing.AttachToSpan(span, keys.MealIDKey, mealID)
 
-	result, err := q.generatedQuerier.CheckMealExistence(ctx, q.db, mealID)
+	result, err := q.generatedQuerier.CheckMealExistence(ctx, q.dbFor(ctx), mealID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing meal existence check")
 	}
@@ -51,7 +51,7 @@ func (q *Querier) GetMeal(ctx context.Context, mealID string) (*types.Meal, erro
 	logger = logger.WithValue(keys.MealIDKey, mealID)
 	tracing.AttachToSpan(span, keys.MealIDKey, mealID)
 
-	results, err := q.generatedQuerier.GetMeal(ctx, q.db, mealID)
+	results, err := q.generatedQuerier.GetMeal(ctx, q.dbFor(ctx), mealID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing meal retrieval query")
 	}
@@ -117,7 +117,7 @@ func (q *Querier) GetMeals(ctx context.Context, filter *types.QueryFilter) (x *t
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetMeals(ctx, q.db, &generated.GetMealsParams{
+	results, err := q.generatedQuerier.GetMeals(ctx, q.dbFor(ctx), &generated.GetMealsParams{
 		CreatedAfter:  sql.NullTime{},
 		CreatedBefore: sql.NullTime{},
 		UpdatedAfter:  sql.NullTime{},
@@ -176,7 +176,7 @@ func (q *Querier) GetMealIDsThatNeedSearchIndexing(ctx context.Context) ([]strin
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetMealsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetMealsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing meals list retrieval query")
 	}
@@ -201,7 +201,7 @@ func (q *Querier) SearchForMeals(ctx context.Context, mealNameQuery string, filt
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.SearchForMeals(ctx, q.db, &generated.SearchForMealsParams{
+	results, err := q.generatedQuerier.SearchForMeals(ctx, q.dbFor(ctx), &generated.SearchForMealsParams{
 		Query:         mealNameQuery,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -313,7 +313,7 @@ func (q *Querier) CreateMeal(ctx context.Context, input *types.MealDatabaseCreat
 		return nil, ErrNilInputProvided
 	}
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "beginning transaction")
 	}
@@ -374,7 +374,7 @@ func (q *Querier) MarkMealAsIndexed(ctx context.Context, mealID string) error {
 	logger = logger.WithValue(keys.MealIDKey, mealID)
 	tracing.AttachToSpan(span, keys.MealIDKey, mealID)
 
-	if _, err := q.generatedQuerier.UpdateMealLastIndexedAt(ctx, q.db, mealID); err != nil {
+	if _, err := q.generatedQuerier.UpdateMealLastIndexedAt(ctx, q.dbFor(ctx), mealID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking meal as indexed")
 	}
 
@@ -402,7 +402,7 @@ func (q *Querier) ArchiveMeal(ctx context.Context, mealID, userID string) error
 	logger = logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	if _, err := q.generatedQuerier.ArchiveMeal(ctx, q.db, &generated.ArchiveMealParams{
+	if _, err := q.generatedQuerier.ArchiveMeal(ctx, q.dbFor(ctx), &generated.ArchiveMealParams{
 		CreatedByUser: userID,
 		ID:            mealID,
 	}); err != nil {
@@ -69,5 +69,75 @@ func (q *Querier) Migrate(ctx context.Context, waitPeriod time.Duration, maxAtte
 			Description: "audit log table",
 			Script:      fetchMigration("00004_audit_log"),
 		},
+		{
+			Version:     5,
+			Description: "plurality and borda election methods",
+			Script:      fetchMigration("00005_election_methods"),
+		},
+		{
+			Version:     6,
+			Description: "webhook deliveries",
+			Script:      fetchMigration("00006_webhook_deliveries"),
+		},
+		{
+			Version:     7,
+			Description: "webhook key rotation",
+			Script:      fetchMigration("00007_webhook_key_rotation"),
+		},
+		{
+			Version:     8,
+			Description: "cooking sessions",
+			Script:      fetchMigration("00008_cooking_sessions"),
+		},
+		{
+			Version:     9,
+			Description: "dead-lettered messages",
+			Script:      fetchMigration("00009_dead_lettered_messages"),
+		},
+		{
+			Version:     10,
+			Description: "outbox messages",
+			Script:      fetchMigration("00010_outbox_messages"),
+		},
+		{
+			Version:     11,
+			Description: "household event stream",
+			Script:      fetchMigration("00011_household_event_stream"),
+		},
+		{
+			Version:     12,
+			Description: "pantry items",
+			Script:      fetchMigration("00012_pantry_items"),
+		},
+		{
+			Version:     13,
+			Description: "valid ingredient nutrition",
+			Script:      fetchMigration("00013_valid_ingredient_nutrition"),
+		},
+		{
+			Version:     14,
+			Description: "valid ingredient substitutions",
+			Script:      fetchMigration("00014_valid_ingredient_substitutions"),
+		},
+		{
+			Version:     15,
+			Description: "oauth2 client scoping",
+			Script:      fetchMigration("00015_oauth2_client_scoping"),
+		},
+		{
+			Version:     16,
+			Description: "oauth2 scopes",
+			Script:      fetchMigration("00016_oauth2_scopes"),
+		},
+		{
+			Version:     17,
+			Description: "passkeys and recovery codes",
+			Script:      fetchMigration("00017_passkeys_and_recovery_codes"),
+		},
+		{
+			Version:     18,
+			Description: "passkey ceremonies",
+			Script:      fetchMigration("00018_passkey_ceremonies"),
+		},
 	}
 )
@@ -0,0 +1,2 @@
+ALTER TYPE valid_election_method ADD VALUE IF NOT EXISTS 'plurality';
+ALTER TYPE valid_election_method ADD VALUE IF NOT EXISTS 'borda';
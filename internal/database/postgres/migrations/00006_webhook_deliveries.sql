@@ -0,0 +1,22 @@
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS consecutive_failures INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS disabled_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id TEXT NOT NULL PRIMARY KEY,
+    delivery_id TEXT NOT NULL,
+    trigger_event TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    attempt INTEGER NOT NULL DEFAULT 1,
+    response_status_code INTEGER,
+    response_body TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    latency_in_milliseconds BIGINT NOT NULL DEFAULT 0,
+    succeeded BOOLEAN NOT NULL DEFAULT 'false',
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    belongs_to_webhook TEXT NOT NULL REFERENCES webhooks("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_belongs_to_webhook_index ON webhook_deliveries USING btree (belongs_to_webhook);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_delivery_id_index ON webhook_deliveries USING btree (delivery_id);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_next_attempt_at_index ON webhook_deliveries USING btree (next_attempt_at) WHERE next_attempt_at IS NOT NULL;
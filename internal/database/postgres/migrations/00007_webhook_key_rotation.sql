@@ -0,0 +1,2 @@
+ALTER TABLE households ADD COLUMN IF NOT EXISTS previous_webhook_hmac_secret TEXT NOT NULL DEFAULT '';
+ALTER TABLE households ADD COLUMN IF NOT EXISTS previous_webhook_hmac_secret_expires_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,34 @@
+CREATE TYPE cooking_session_progress_type AS ENUM (
+    'step_completed',
+    'step_skipped',
+    'condition_satisfied',
+    'observation_recorded'
+);
+
+CREATE TABLE IF NOT EXISTS cooking_sessions (
+    id TEXT NOT NULL PRIMARY KEY,
+    recipe_id TEXT NOT NULL REFERENCES recipes("id") ON DELETE CASCADE,
+    belongs_to_household TEXT NOT NULL REFERENCES households("id") ON DELETE CASCADE,
+    created_by_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS cooking_sessions_belongs_to_household_index ON cooking_sessions USING btree (belongs_to_household);
+
+CREATE TABLE IF NOT EXISTS cooking_session_progress_entries (
+    id TEXT NOT NULL PRIMARY KEY,
+    progress_type cooking_session_progress_type NOT NULL,
+    subject TEXT NOT NULL,
+    value TEXT NOT NULL DEFAULT '',
+    recorded_by_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    belongs_to_cooking_session TEXT NOT NULL REFERENCES cooking_sessions("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS cooking_session_progress_entries_belongs_to_cooking_session_index ON cooking_session_progress_entries USING btree (belongs_to_cooking_session);
+
+-- observations can be re-recorded, but a step or completion condition is only ever finished once.
+CREATE UNIQUE INDEX IF NOT EXISTS cooking_session_progress_entries_unique_progress_index ON cooking_session_progress_entries USING btree (belongs_to_cooking_session, progress_type, subject) WHERE progress_type != 'observation_recorded';
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS dead_lettered_messages (
+    id TEXT NOT NULL PRIMARY KEY,
+    topic TEXT NOT NULL,
+    dead_letter_topic TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    attempts INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    replayed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS dead_lettered_messages_topic_index ON dead_lettered_messages USING btree (topic);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    id TEXT NOT NULL PRIMARY KEY,
+    topic TEXT NOT NULL,
+    idempotency_key TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    claimed_until TIMESTAMP WITH TIME ZONE,
+    published_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE(topic, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS outbox_messages_pending_index ON outbox_messages USING btree (created_at) WHERE published_at IS NULL;
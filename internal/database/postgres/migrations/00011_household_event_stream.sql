@@ -0,0 +1,7 @@
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS transaction_id BIGINT NOT NULL DEFAULT pg_current_xact_id()::TEXT::BIGINT;
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS sequence BIGSERIAL NOT NULL;
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS event_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE outbox_messages ADD COLUMN IF NOT EXISTS belongs_to_household TEXT;
+
+CREATE INDEX IF NOT EXISTS outbox_messages_household_position_index ON outbox_messages USING btree (belongs_to_household, transaction_id, sequence) WHERE belongs_to_household IS NOT NULL;
+CREATE INDEX IF NOT EXISTS outbox_messages_transaction_id_index ON outbox_messages USING btree (transaction_id);
@@ -0,0 +1,24 @@
+CREATE TYPE pantry_storage_location AS ENUM (
+    'pantry',
+    'refrigerator',
+    'freezer',
+    'other'
+);
+
+CREATE TABLE IF NOT EXISTS pantry_items (
+    id TEXT NOT NULL PRIMARY KEY,
+    valid_ingredient TEXT NOT NULL REFERENCES valid_ingredients("id") ON DELETE CASCADE,
+    valid_measurement_unit TEXT NOT NULL REFERENCES valid_measurement_units("id") ON DELETE CASCADE,
+    quantity NUMERIC(14,2) NOT NULL,
+    storage_location pantry_storage_location NOT NULL DEFAULT 'pantry',
+    notes TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP WITH TIME ZONE,
+    belongs_to_household TEXT NOT NULL REFERENCES households("id") ON DELETE CASCADE,
+    source_grocery_list_item TEXT REFERENCES meal_plan_grocery_list_items("id") ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS pantry_items_belongs_to_household_index ON pantry_items USING btree (belongs_to_household);
+CREATE UNIQUE INDEX IF NOT EXISTS pantry_items_source_grocery_list_item_unique ON pantry_items USING btree (source_grocery_list_item) WHERE archived_at IS NULL;
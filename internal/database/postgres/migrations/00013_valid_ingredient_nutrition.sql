@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS valid_ingredient_nutrition (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_valid_ingredient TEXT NOT NULL REFERENCES valid_ingredients("id") ON DELETE CASCADE,
+    reference_quantity NUMERIC(14,2) NOT NULL DEFAULT 100,
+    reference_measurement_unit TEXT NOT NULL REFERENCES valid_measurement_units("id") ON DELETE CASCADE,
+    calories NUMERIC(14,2) NOT NULL DEFAULT 0,
+    protein NUMERIC(14,2) NOT NULL DEFAULT 0,
+    total_fat NUMERIC(14,2) NOT NULL DEFAULT 0,
+    saturated_fat NUMERIC(14,2) NOT NULL DEFAULT 0,
+    carbohydrates NUMERIC(14,2) NOT NULL DEFAULT 0,
+    sugar NUMERIC(14,2) NOT NULL DEFAULT 0,
+    fiber NUMERIC(14,2) NOT NULL DEFAULT 0,
+    sodium NUMERIC(14,2) NOT NULL DEFAULT 0,
+    source TEXT NOT NULL DEFAULT '',
+    source_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS valid_ingredient_nutrition_belongs_to_valid_ingredient_unique_index ON valid_ingredient_nutrition USING btree (belongs_to_valid_ingredient) WHERE archived_at IS NULL;
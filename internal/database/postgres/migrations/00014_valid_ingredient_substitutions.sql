@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS valid_ingredient_substitutions (
+    id TEXT NOT NULL PRIMARY KEY,
+    original_ingredient TEXT NOT NULL REFERENCES valid_ingredients("id") ON DELETE CASCADE,
+    substitute_ingredient TEXT NOT NULL REFERENCES valid_ingredients("id") ON DELETE CASCADE,
+    valid_preparation_id TEXT REFERENCES valid_preparations("id") ON DELETE CASCADE,
+    ratio NUMERIC(14,2) NOT NULL DEFAULT 1,
+    reasons TEXT[] NOT NULL DEFAULT '{}',
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    CHECK (original_ingredient != substitute_ingredient),
+    CHECK (ratio > 0)
+);
+
+CREATE INDEX IF NOT EXISTS valid_ingredient_substitutions_original_ingredient_index ON valid_ingredient_substitutions USING btree (original_ingredient);
+CREATE UNIQUE INDEX IF NOT EXISTS valid_ingredient_substitutions_unique_index ON valid_ingredient_substitutions USING btree (original_ingredient, substitute_ingredient, COALESCE(valid_preparation_id, '')) WHERE archived_at IS NULL;
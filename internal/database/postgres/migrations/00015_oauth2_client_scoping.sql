@@ -0,0 +1,2 @@
+ALTER TABLE oauth2_clients ADD COLUMN IF NOT EXISTS domain TEXT NOT NULL DEFAULT '';
+ALTER TABLE oauth2_clients ADD COLUMN IF NOT EXISTS belongs_to_household TEXT REFERENCES households("id") ON DELETE CASCADE;
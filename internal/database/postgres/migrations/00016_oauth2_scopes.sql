@@ -0,0 +1,14 @@
+ALTER TABLE oauth2_clients ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}';
+
+-- clients created before scopes existed keep the authority of the household members they act for. they don't get
+-- service_admin, since that would let them act with a service admin's authority and use routes no narrower scope covers.
+UPDATE oauth2_clients SET scopes = '{household_admin}' WHERE scopes = '{}';
+
+ALTER TABLE oauth2_client_tokens ALTER COLUMN scope DROP DEFAULT;
+ALTER TABLE oauth2_client_tokens ALTER COLUMN scope TYPE TEXT USING scope::TEXT;
+ALTER TABLE oauth2_client_tokens ALTER COLUMN scope SET DEFAULT '';
+
+-- likewise for tokens, which were never restricted.
+UPDATE oauth2_client_tokens SET scope = 'household_admin' WHERE scope = 'unknown';
+
+DROP TYPE IF EXISTS oauth2_client_token_scopes;
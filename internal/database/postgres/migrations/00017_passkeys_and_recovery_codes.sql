@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS user_passkeys (
+    id TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    credential_id TEXT NOT NULL,
+    public_key BYTEA NOT NULL,
+    attestation_type TEXT NOT NULL DEFAULT '',
+    aaguid BYTEA NOT NULL DEFAULT '',
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    backup_eligible BOOLEAN NOT NULL DEFAULT 'false',
+    backup_state BOOLEAN NOT NULL DEFAULT 'false',
+    belongs_to_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS user_passkeys_belongs_to_user_index ON user_passkeys USING btree (belongs_to_user);
+CREATE UNIQUE INDEX IF NOT EXISTS user_passkeys_credential_id_unique_index ON user_passkeys USING btree (credential_id) WHERE archived_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id TEXT NOT NULL PRIMARY KEY,
+    hashed_code TEXT NOT NULL,
+    belongs_to_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    redeemed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS user_recovery_codes_belongs_to_user_index ON user_recovery_codes USING btree (belongs_to_user);
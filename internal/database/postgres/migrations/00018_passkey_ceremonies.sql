@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS passkey_ceremonies (
+    id TEXT NOT NULL PRIMARY KEY,
+    session_data BYTEA NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS passkey_ceremonies_expires_at_index ON passkey_ceremonies USING btree (expires_at);
@@ -30,7 +30,7 @@ func (q *Querier) GetOAuth2ClientTokenByCode(ctx context.Context, code string) (
 		return nil, observability.PrepareError(err, span, "decrypting oauth2 token code")
 	}
 
-	result, err := q.generatedQuerier.GetOAuth2ClientTokenByCode(ctx, q.db, encryptedCode)
+	result, err := q.generatedQuerier.GetOAuth2ClientTokenByCode(ctx, q.dbFor(ctx), encryptedCode)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting oauth2 client token by code")
 	}
@@ -40,7 +40,7 @@ func (q *Querier) GetOAuth2ClientTokenByCode(ctx context.Context, code string) (
 		AccessCreatedAt:     result.AccessCreatedAt,
 		CodeCreatedAt:       result.CodeCreatedAt,
 		RedirectURI:         result.RedirectUri,
-		Scope:               string(result.Scope),
+		Scope:               result.Scope,
 		Code:                result.Code,
 		CodeChallenge:       result.CodeChallenge,
 		CodeChallengeMethod: result.CodeChallengeMethod,
@@ -97,7 +97,7 @@ func (q *Querier) GetOAuth2ClientTokenByAccess(ctx context.Context, access strin
 		return nil, observability.PrepareError(err, span, "decrypting oauth2 token access")
 	}
 
-	result, err := q.generatedQuerier.GetOAuth2ClientTokenByAccess(ctx, q.db, encryptedAccess)
+	result, err := q.generatedQuerier.GetOAuth2ClientTokenByAccess(ctx, q.dbFor(ctx), encryptedAccess)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting oauth2 client token by access")
 	}
@@ -107,7 +107,7 @@ func (q *Querier) GetOAuth2ClientTokenByAccess(ctx context.Context, access strin
 		AccessCreatedAt:     result.AccessCreatedAt,
 		CodeCreatedAt:       result.CodeCreatedAt,
 		RedirectURI:         result.RedirectUri,
-		Scope:               string(result.Scope),
+		Scope:               result.Scope,
 		Code:                result.Code,
 		CodeChallenge:       result.CodeChallenge,
 		CodeChallengeMethod: result.CodeChallengeMethod,
@@ -164,7 +164,7 @@ func (q *Querier) GetOAuth2ClientTokenByRefresh(ctx context.Context, refresh str
 		return nil, observability.PrepareError(err, span, "decrypting oauth2 token access")
 	}
 
-	result, err := q.generatedQuerier.GetOAuth2ClientTokenByRefresh(ctx, q.db, encryptedRefresh)
+	result, err := q.generatedQuerier.GetOAuth2ClientTokenByRefresh(ctx, q.dbFor(ctx), encryptedRefresh)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting oauth2 client token by refresh")
 	}
@@ -174,7 +174,7 @@ func (q *Querier) GetOAuth2ClientTokenByRefresh(ctx context.Context, refresh str
 		AccessCreatedAt:     result.AccessCreatedAt,
 		CodeCreatedAt:       result.CodeCreatedAt,
 		RedirectURI:         result.RedirectUri,
-		Scope:               string(result.Scope),
+		Scope:               result.Scope,
 		Code:                result.Code,
 		CodeChallenge:       result.CodeChallenge,
 		CodeChallengeMethod: result.CodeChallengeMethod,
@@ -241,7 +241,7 @@ func (q *Querier) CreateOAuth2ClientToken(ctx context.Context, input *types.OAut
 	}
 
 	// create the oauth2 client token.
-	if err = q.generatedQuerier.CreateOAuth2ClientToken(ctx, q.db, &generated.CreateOAuth2ClientTokenParams{
+	if err = q.generatedQuerier.CreateOAuth2ClientToken(ctx, q.dbFor(ctx), &generated.CreateOAuth2ClientTokenParams{
 		AccessExpiresAt:     now.Add(input.AccessExpiresIn),
 		CodeExpiresAt:       now.Add(input.CodeExpiresIn),
 		RefreshExpiresAt:    now.Add(input.RefreshExpiresIn),
@@ -250,7 +250,7 @@ func (q *Querier) CreateOAuth2ClientToken(ctx context.Context, input *types.OAut
 		AccessCreatedAt:     now,
 		CodeChallenge:       input.CodeChallenge,
 		CodeChallengeMethod: input.CodeChallengeMethod,
-		Scope:               generated.Oauth2ClientTokenScopes(input.Scope),
+		Scope:               input.Scope,
 		ClientID:            input.ClientID,
 		Access:              encryptedAccess,
 		Code:                encryptedCode,
@@ -304,7 +304,7 @@ func (q *Querier) ArchiveOAuth2ClientTokenByAccess(ctx context.Context, access s
 		return observability.PrepareError(err, span, "decrypting oauth2 token access")
 	}
 
-	if _, err = q.generatedQuerier.ArchiveOAuth2ClientTokenByAccess(ctx, q.db, encryptedAccess); err != nil {
+	if _, err = q.generatedQuerier.ArchiveOAuth2ClientTokenByAccess(ctx, q.dbFor(ctx), encryptedAccess); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving oauth2 client token by refresh")
 	}
 
@@ -331,7 +331,7 @@ func (q *Querier) ArchiveOAuth2ClientTokenByCode(ctx context.Context, code strin
 		return observability.PrepareError(err, span, "decrypting oauth2 token access")
 	}
 
-	if _, err = q.generatedQuerier.ArchiveOAuth2ClientTokenByCode(ctx, q.db, encryptedCode); err != nil {
+	if _, err = q.generatedQuerier.ArchiveOAuth2ClientTokenByCode(ctx, q.dbFor(ctx), encryptedCode); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving oauth2 client token by refresh")
 	}
 
@@ -358,7 +358,7 @@ func (q *Querier) ArchiveOAuth2ClientTokenByRefresh(ctx context.Context, refresh
 		return observability.PrepareError(err, span, "decrypting oauth2 token access")
 	}
 
-	if _, err = q.generatedQuerier.ArchiveOAuth2ClientTokenByRefresh(ctx, q.db, encryptedRefresh); err != nil {
+	if _, err = q.generatedQuerier.ArchiveOAuth2ClientTokenByRefresh(ctx, q.dbFor(ctx), encryptedRefresh); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving oauth2 client token by refresh")
 	}
 
@@ -30,19 +30,22 @@ func (q *Querier) GetOAuth2ClientByClientID(ctx context.Context, clientID string
 	logger = logger.WithValue(keys.OAuth2ClientClientIDKey, clientID)
 	tracing.AttachToSpan(span, keys.OAuth2ClientClientIDKey, clientID)
 
-	result, err := q.generatedQuerier.GetOAuth2ClientByClientID(ctx, q.db, clientID)
+	result, err := q.generatedQuerier.GetOAuth2ClientByClientID(ctx, q.dbFor(ctx), clientID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching oauth2 client")
 	}
 
 	client := &types.OAuth2Client{
-		CreatedAt:    result.CreatedAt,
-		ArchivedAt:   database.TimePointerFromNullTime(result.ArchivedAt),
-		Name:         result.Name,
-		Description:  result.Description,
-		ClientID:     result.ClientID,
-		ID:           result.ID,
-		ClientSecret: result.ClientSecret,
+		CreatedAt:          result.CreatedAt,
+		ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+		Name:               result.Name,
+		Description:        result.Description,
+		ClientID:           result.ClientID,
+		ID:                 result.ID,
+		ClientSecret:       result.ClientSecret,
+		Domain:             result.Domain,
+		BelongsToHousehold: database.StringPointerFromNullString(result.BelongsToHousehold),
+		Scopes:             result.Scopes,
 	}
 
 	return client, nil
@@ -61,19 +64,22 @@ func (q *Querier) GetOAuth2ClientByDatabaseID(ctx context.Context, clientID stri
 	logger = logger.WithValue(keys.OAuth2ClientClientIDKey, clientID)
 	tracing.AttachToSpan(span, keys.OAuth2ClientClientIDKey, clientID)
 
-	result, err := q.generatedQuerier.GetOAuth2ClientByDatabaseID(ctx, q.db, clientID)
+	result, err := q.generatedQuerier.GetOAuth2ClientByDatabaseID(ctx, q.dbFor(ctx), clientID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching oauth2 client")
 	}
 
 	client := &types.OAuth2Client{
-		CreatedAt:    result.CreatedAt,
-		ArchivedAt:   database.TimePointerFromNullTime(result.ArchivedAt),
-		Name:         result.Name,
-		Description:  result.Description,
-		ClientID:     result.ClientID,
-		ID:           result.ID,
-		ClientSecret: result.ClientSecret,
+		CreatedAt:          result.CreatedAt,
+		ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+		Name:               result.Name,
+		Description:        result.Description,
+		ClientID:           result.ClientID,
+		ID:                 result.ID,
+		ClientSecret:       result.ClientSecret,
+		Domain:             result.Domain,
+		BelongsToHousehold: database.StringPointerFromNullString(result.BelongsToHousehold),
+		Scopes:             result.Scopes,
 	}
 
 	return client, nil
@@ -96,7 +102,7 @@ func (q *Querier) GetOAuth2Clients(ctx context.Context, filter *types.QueryFilte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetOAuth2Clients(ctx, q.db, &generated.GetOAuth2ClientsParams{
+	results, err := q.generatedQuerier.GetOAuth2Clients(ctx, q.dbFor(ctx), &generated.GetOAuth2ClientsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		QueryOffset:   database.NullInt32FromUint16(filter.QueryOffset()),
@@ -108,13 +114,16 @@ func (q *Querier) GetOAuth2Clients(ctx context.Context, filter *types.QueryFilte
 
 	for _, result := range results {
 		x.Data = append(x.Data, &types.OAuth2Client{
-			CreatedAt:    result.CreatedAt,
-			ArchivedAt:   database.TimePointerFromNullTime(result.ArchivedAt),
-			Name:         result.Name,
-			Description:  result.Description,
-			ClientID:     result.ClientID,
-			ID:           result.ID,
-			ClientSecret: result.ClientSecret,
+			CreatedAt:          result.CreatedAt,
+			ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+			Name:               result.Name,
+			Description:        result.Description,
+			ClientID:           result.ClientID,
+			ID:                 result.ID,
+			ClientSecret:       result.ClientSecret,
+			Domain:             result.Domain,
+			BelongsToHousehold: database.StringPointerFromNullString(result.BelongsToHousehold),
+			Scopes:             result.Scopes,
 		})
 		x.FilteredCount = uint64(result.FilteredCount)
 		x.TotalCount = uint64(result.TotalCount)
@@ -136,11 +145,15 @@ func (q *Querier) CreateOAuth2Client(ctx context.Context, input *types.OAuth2Cli
 		keys.OAuth2ClientClientIDKey: input.ClientID,
 	})
 
-	if writeErr := q.generatedQuerier.CreateOAuth2Client(ctx, q.db, &generated.CreateOAuth2ClientParams{
-		ID:           input.ID,
-		Name:         input.Name,
-		ClientID:     input.ClientID,
-		ClientSecret: input.ClientSecret,
+	if writeErr := q.generatedQuerier.CreateOAuth2Client(ctx, q.dbFor(ctx), &generated.CreateOAuth2ClientParams{
+		ID:                 input.ID,
+		Name:               input.Name,
+		Description:        input.Description,
+		ClientID:           input.ClientID,
+		ClientSecret:       input.ClientSecret,
+		Domain:             input.Domain,
+		BelongsToHousehold: database.NullStringFromStringPointer(input.BelongsToHousehold),
+		Scopes:             input.Scopes,
 	}); writeErr != nil {
 		return nil, observability.PrepareError(writeErr, span, "creating OAuth2 client")
 	}
@@ -148,11 +161,15 @@ func (q *Querier) CreateOAuth2Client(ctx context.Context, input *types.OAuth2Cli
 	tracing.AttachToSpan(span, keys.OAuth2ClientClientIDKey, input.ID)
 
 	client := &types.OAuth2Client{
-		ID:           input.ID,
-		Name:         input.Name,
-		ClientID:     input.ClientID,
-		ClientSecret: input.ClientSecret,
-		CreatedAt:    q.currentTime(),
+		ID:                 input.ID,
+		Name:               input.Name,
+		Description:        input.Description,
+		ClientID:           input.ClientID,
+		ClientSecret:       input.ClientSecret,
+		Domain:             input.Domain,
+		BelongsToHousehold: input.BelongsToHousehold,
+		Scopes:             input.Scopes,
+		CreatedAt:          q.currentTime(),
 	}
 
 	logger.Info("OAuth2 client created")
@@ -171,7 +188,7 @@ func (q *Querier) ArchiveOAuth2Client(ctx context.Context, clientID string) erro
 	tracing.AttachToSpan(span, keys.OAuth2ClientClientIDKey, clientID)
 	logger := q.logger.WithValue(keys.OAuth2ClientIDKey, clientID)
 
-	if _, err := q.generatedQuerier.ArchiveOAuth2Client(ctx, q.db, clientID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveOAuth2Client(ctx, q.dbFor(ctx), clientID); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return err
 		}
@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.OutboxMessageDataManager = (*Querier)(nil)
+)
+
+// CreateOutboxMessage records an outbox message in the database. When called with a context that carries a
+// transaction, the message is only recorded if that transaction commits.
+func (q *Querier) CreateOutboxMessage(ctx context.Context, input *types.OutboxMessageDatabaseCreationInput) (*types.OutboxMessage, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.OutboxMessageIDKey, input.ID)
+	logger := q.logger.WithValues(map[string]any{
+		keys.OutboxMessageIDKey: input.ID,
+		keys.QueueTopicKey:      input.Topic,
+	})
+
+	if err := q.generatedQuerier.CreateOutboxMessage(ctx, q.dbFor(ctx), &generated.CreateOutboxMessageParams{
+		ID:                 input.ID,
+		Topic:              input.Topic,
+		IdempotencyKey:     input.IdempotencyKey,
+		Payload:            input.Payload,
+		EventType:          input.EventType,
+		BelongsToHousehold: database.NullStringFromStringPointer(input.BelongsToHousehold),
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing outbox message creation query")
+	}
+
+	x := &types.OutboxMessage{
+		CreatedAt:          q.currentTime(),
+		BelongsToHousehold: input.BelongsToHousehold,
+		ID:                 input.ID,
+		Topic:              input.Topic,
+		IdempotencyKey:     input.IdempotencyKey,
+		Payload:            input.Payload,
+		EventType:          input.EventType,
+	}
+
+	logger.Debug("outbox message recorded")
+
+	return x, nil
+}
+
+// ClaimPendingOutboxMessages claims up to batchSize unpublished outbox messages for claimDuration, oldest first.
+// Messages whose claim lapses without them being published become claimable again.
+func (q *Querier) ClaimPendingOutboxMessages(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*types.OutboxMessage, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.WithValue("batch_size", batchSize)
+
+	results, err := q.generatedQuerier.ClaimPendingOutboxMessages(ctx, q.dbFor(ctx), &generated.ClaimPendingOutboxMessagesParams{
+		ClaimedUntil: database.NullTimeFromTime(q.currentTime().Add(claimDuration)),
+		BatchSize:    int32(batchSize),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "claiming pending outbox messages")
+	}
+
+	x := []*types.OutboxMessage{}
+	for _, result := range results {
+		x = append(x, &types.OutboxMessage{
+			CreatedAt:      result.CreatedAt,
+			ClaimedUntil:   database.TimePointerFromNullTime(result.ClaimedUntil),
+			PublishedAt:    database.TimePointerFromNullTime(result.PublishedAt),
+			ID:             result.ID,
+			Topic:          result.Topic,
+			IdempotencyKey: result.IdempotencyKey,
+			Payload:        result.Payload,
+			LastError:      result.LastError,
+			Attempts:       uint16(result.Attempts),
+		})
+	}
+
+	// RETURNING doesn't preserve the subquery's ordering.
+	slices.SortStableFunc(x, func(a, b *types.OutboxMessage) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return x, nil
+}
+
+// MarkOutboxMessageAsPublished marks an outbox message as published. It returns sql.ErrNoRows
+// if the message doesn't exist or has already been published.
+func (q *Querier) MarkOutboxMessageAsPublished(ctx context.Context, outboxMessageID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if outboxMessageID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.OutboxMessageIDKey, outboxMessageID)
+	tracing.AttachToSpan(span, keys.OutboxMessageIDKey, outboxMessageID)
+
+	rowsAffected, err := q.generatedQuerier.MarkOutboxMessageAsPublished(ctx, q.dbFor(ctx), outboxMessageID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking outbox message as published")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// MarkOutboxMessageAsFailed records why an outbox message couldn't be published. The message stays claimed
+// until its claim lapses, which acts as a backoff. It returns sql.ErrNoRows if the message doesn't exist or
+// has already been published.
+func (q *Querier) MarkOutboxMessageAsFailed(ctx context.Context, outboxMessageID, reason string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if outboxMessageID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.OutboxMessageIDKey, outboxMessageID)
+	tracing.AttachToSpan(span, keys.OutboxMessageIDKey, outboxMessageID)
+
+	rowsAffected, err := q.generatedQuerier.MarkOutboxMessageAsFailed(ctx, q.dbFor(ctx), &generated.MarkOutboxMessageAsFailedParams{
+		LastError: reason,
+		ID:        outboxMessageID,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking outbox message as failed")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetOutboxLag fetches how many outbox messages are waiting to be published, and how long the oldest has waited.
+func (q *Querier) GetOutboxLag(ctx context.Context) (*types.OutboxLag, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	result, err := q.generatedQuerier.GetOutboxLag(ctx, q.dbFor(ctx))
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, q.logger, span, "fetching outbox lag")
+	}
+
+	x := &types.OutboxLag{
+		PendingMessages:  uint64(result.PendingMessages),
+		OldestPendingAge: time.Duration(result.OldestPendingAgeSeconds) * time.Second,
+	}
+
+	return x, nil
+}
+
+// PrunePublishedOutboxMessages deletes outbox messages that were published before the provided time.
+func (q *Querier) PrunePublishedOutboxMessages(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.WithValue("published_before", publishedBefore)
+
+	rowsAffected, err := q.generatedQuerier.DeletePublishedOutboxMessages(ctx, q.dbFor(ctx), database.NullTimeFromTime(publishedBefore))
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, logger, span, "pruning published outbox messages")
+	}
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_OutboxMessages(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	// messages written in a transaction that rolls back are never recorded
+	rolledBack := fakes.BuildFakeOutboxMessageDatabaseCreationInput()
+	expectedErr := errors.New("blah")
+	assert.ErrorIs(t, dbc.RunInTransaction(ctx, func(ctx context.Context) error {
+		_, createErr := dbc.CreateOutboxMessage(ctx, rolledBack)
+		require.NoError(t, createErr)
+		return expectedErr
+	}), expectedErr)
+
+	created := []*types.OutboxMessage{}
+	for i := 0; i < exampleQuantity; i++ {
+		input := fakes.BuildFakeOutboxMessageDatabaseCreationInput()
+		require.NoError(t, dbc.RunInTransaction(ctx, func(ctx context.Context) error {
+			outboxMessage, createErr := dbc.CreateOutboxMessage(ctx, input)
+			created = append(created, outboxMessage)
+			return createErr
+		}))
+	}
+
+	// idempotency keys are unique per topic
+	duplicate := fakes.BuildFakeOutboxMessageDatabaseCreationInput()
+	duplicate.Topic = created[0].Topic
+	duplicate.IdempotencyKey = created[0].IdempotencyKey
+	_, err = dbc.CreateOutboxMessage(ctx, duplicate)
+	assert.Error(t, err)
+
+	lag, err := dbc.GetOutboxLag(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(len(created)), lag.PendingMessages)
+
+	claimed, err := dbc.ClaimPendingOutboxMessages(ctx, uint16(len(created)*2), time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, len(created))
+	for _, outboxMessage := range claimed {
+		assert.NotEqual(t, rolledBack.ID, outboxMessage.ID)
+		assert.Equal(t, uint16(1), outboxMessage.Attempts)
+		assert.NotNil(t, outboxMessage.ClaimedUntil)
+	}
+
+	// claimed messages can't be claimed again until their claim lapses
+	claimedAgain, err := dbc.ClaimPendingOutboxMessages(ctx, uint16(len(created)*2), time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimedAgain)
+
+	assert.NoError(t, dbc.MarkOutboxMessageAsFailed(ctx, claimed[0].ID, "blah"))
+	for _, outboxMessage := range claimed {
+		assert.NoError(t, dbc.MarkOutboxMessageAsPublished(ctx, outboxMessage.ID))
+		assert.ErrorIs(t, dbc.MarkOutboxMessageAsPublished(ctx, outboxMessage.ID), sql.ErrNoRows)
+	}
+
+	lag, err = dbc.GetOutboxLag(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, lag.PendingMessages)
+	assert.Zero(t, lag.OldestPendingAge)
+
+	pruned, err := dbc.PrunePublishedOutboxMessages(ctx, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(created)), pruned)
+}
+
+func TestQuerier_CreateOutboxMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateOutboxMessage(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_MarkOutboxMessageAsPublished(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid outbox message ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.MarkOutboxMessageAsPublished(ctx, ""))
+	})
+}
+
+func TestQuerier_MarkOutboxMessageAsFailed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid outbox message ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.MarkOutboxMessageAsFailed(ctx, "", "blah"))
+	})
+}
@@ -0,0 +1,540 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.PantryItemDataManager = (*Querier)(nil)
+)
+
+// PantryItemExists fetches whether a pantry item exists from the database.
+func (q *Querier) PantryItemExists(ctx context.Context, pantryItemID, householdID string) (exists bool, err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if pantryItemID == "" {
+		return false, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	if householdID == "" {
+		return false, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	result, err := q.generatedQuerier.CheckPantryItemExistence(ctx, q.dbFor(ctx), &generated.CheckPantryItemExistenceParams{
+		ID:                 pantryItemID,
+		BelongsToHousehold: householdID,
+	})
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "performing pantry item existence check")
+	}
+
+	return result, nil
+}
+
+// GetPantryItem fetches a pantry item from the database.
+func (q *Querier) GetPantryItem(ctx context.Context, pantryItemID, householdID string) (*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if pantryItemID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	result, err := q.generatedQuerier.GetPantryItem(ctx, q.dbFor(ctx), &generated.GetPantryItemParams{
+		ID:                 pantryItemID,
+		BelongsToHousehold: householdID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching pantry item")
+	}
+
+	pantryItem := &types.PantryItem{
+		CreatedAt:          result.CreatedAt,
+		ExpiresAt:          database.TimePointerFromNullTime(result.ExpiresAt),
+		ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+		LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+		ID:                 result.ID,
+		StorageLocation:    string(result.StorageLocation),
+		Notes:              result.Notes,
+		BelongsToHousehold: result.BelongsToHousehold,
+		Quantity:           database.Float32FromString(result.Quantity),
+		MeasurementUnit: types.ValidMeasurementUnit{
+			CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+			Name:          result.ValidMeasurementUnitName,
+			IconPath:      result.ValidMeasurementUnitIconPath,
+			ID:            result.ValidMeasurementUnitID,
+			Description:   result.ValidMeasurementUnitDescription,
+			PluralName:    result.ValidMeasurementUnitPluralName,
+			Slug:          result.ValidMeasurementUnitSlug,
+			Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+			Universal:     result.ValidMeasurementUnitUniversal,
+			Metric:        result.ValidMeasurementUnitMetric,
+			Imperial:      result.ValidMeasurementUnitImperial,
+		},
+		Ingredient: types.ValidIngredient{
+			CreatedAt:                               result.ValidIngredientCreatedAt,
+			LastUpdatedAt:                           database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+			ArchivedAt:                              database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+			MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+			MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+			IconPath:                                result.ValidIngredientIconPath,
+			Warning:                                 result.ValidIngredientWarning,
+			PluralName:                              result.ValidIngredientPluralName,
+			StorageInstructions:                     result.ValidIngredientStorageInstructions,
+			Name:                                    result.ValidIngredientName,
+			ID:                                      result.ValidIngredientID,
+			Description:                             result.ValidIngredientDescription,
+			Slug:                                    result.ValidIngredientSlug,
+			ShoppingSuggestions:                     result.ValidIngredientShoppingSuggestions,
+			ContainsShellfish:                       result.ValidIngredientContainsShellfish,
+			IsMeasuredVolumetrically:                result.ValidIngredientVolumetric,
+			IsLiquid:                                database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+			ContainsPeanut:                          result.ValidIngredientContainsPeanut,
+			ContainsTreeNut:                         result.ValidIngredientContainsTreeNut,
+			ContainsEgg:                             result.ValidIngredientContainsEgg,
+			ContainsWheat:                           result.ValidIngredientContainsWheat,
+			ContainsSoy:                             result.ValidIngredientContainsSoy,
+			AnimalDerived:                           result.ValidIngredientAnimalDerived,
+			RestrictToPreparations:                  result.ValidIngredientRestrictToPreparations,
+			ContainsSesame:                          result.ValidIngredientContainsSesame,
+			ContainsFish:                            result.ValidIngredientContainsFish,
+			ContainsGluten:                          result.ValidIngredientContainsGluten,
+			ContainsDairy:                           result.ValidIngredientContainsDairy,
+			ContainsAlcohol:                         result.ValidIngredientContainsAlcohol,
+			AnimalFlesh:                             result.ValidIngredientAnimalFlesh,
+			IsStarch:                                result.ValidIngredientIsStarch,
+			IsProtein:                               result.ValidIngredientIsProtein,
+			IsGrain:                                 result.ValidIngredientIsGrain,
+			IsFruit:                                 result.ValidIngredientIsFruit,
+			IsSalt:                                  result.ValidIngredientIsSalt,
+			IsFat:                                   result.ValidIngredientIsFat,
+			IsAcid:                                  result.ValidIngredientIsAcid,
+			IsHeat:                                  result.ValidIngredientIsHeat,
+		},
+	}
+
+	return pantryItem, nil
+}
+
+// GetPantryItems fetches a list of pantry items from the database that meet a particular filter.
+func (q *Querier) GetPantryItems(ctx context.Context, householdID string, filter *types.QueryFilter) (x *types.QueryFilteredResult[types.PantryItem], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	x = &types.QueryFilteredResult[types.PantryItem]{
+		Pagination: filter.ToPagination(),
+	}
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	results, err := q.generatedQuerier.GetPantryItems(ctx, q.dbFor(ctx), &generated.GetPantryItemsParams{
+		HouseholdID:   householdID,
+		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:  database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		QueryOffset:   database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:    database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching pantry items")
+	}
+
+	for _, result := range results {
+		pantryItem := &types.PantryItem{
+			CreatedAt:          result.CreatedAt,
+			ExpiresAt:          database.TimePointerFromNullTime(result.ExpiresAt),
+			ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+			LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ID:                 result.ID,
+			StorageLocation:    string(result.StorageLocation),
+			Notes:              result.Notes,
+			BelongsToHousehold: result.BelongsToHousehold,
+			Quantity:           database.Float32FromString(result.Quantity),
+			MeasurementUnit: types.ValidMeasurementUnit{
+				CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+				LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+				ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+				Name:          result.ValidMeasurementUnitName,
+				IconPath:      result.ValidMeasurementUnitIconPath,
+				ID:            result.ValidMeasurementUnitID,
+				Description:   result.ValidMeasurementUnitDescription,
+				PluralName:    result.ValidMeasurementUnitPluralName,
+				Slug:          result.ValidMeasurementUnitSlug,
+				Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+				Universal:     result.ValidMeasurementUnitUniversal,
+				Metric:        result.ValidMeasurementUnitMetric,
+				Imperial:      result.ValidMeasurementUnitImperial,
+			},
+			Ingredient: types.ValidIngredient{
+				CreatedAt:                               result.ValidIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.ValidIngredientIconPath,
+				Warning:                                 result.ValidIngredientWarning,
+				PluralName:                              result.ValidIngredientPluralName,
+				StorageInstructions:                     result.ValidIngredientStorageInstructions,
+				Name:                                    result.ValidIngredientName,
+				ID:                                      result.ValidIngredientID,
+				Description:                             result.ValidIngredientDescription,
+				Slug:                                    result.ValidIngredientSlug,
+				ShoppingSuggestions:                     result.ValidIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.ValidIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.ValidIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+				ContainsPeanut:                          result.ValidIngredientContainsPeanut,
+				ContainsTreeNut:                         result.ValidIngredientContainsTreeNut,
+				ContainsEgg:                             result.ValidIngredientContainsEgg,
+				ContainsWheat:                           result.ValidIngredientContainsWheat,
+				ContainsSoy:                             result.ValidIngredientContainsSoy,
+				AnimalDerived:                           result.ValidIngredientAnimalDerived,
+				RestrictToPreparations:                  result.ValidIngredientRestrictToPreparations,
+				ContainsSesame:                          result.ValidIngredientContainsSesame,
+				ContainsFish:                            result.ValidIngredientContainsFish,
+				ContainsGluten:                          result.ValidIngredientContainsGluten,
+				ContainsDairy:                           result.ValidIngredientContainsDairy,
+				ContainsAlcohol:                         result.ValidIngredientContainsAlcohol,
+				AnimalFlesh:                             result.ValidIngredientAnimalFlesh,
+				IsStarch:                                result.ValidIngredientIsStarch,
+				IsProtein:                               result.ValidIngredientIsProtein,
+				IsGrain:                                 result.ValidIngredientIsGrain,
+				IsFruit:                                 result.ValidIngredientIsFruit,
+				IsSalt:                                  result.ValidIngredientIsSalt,
+				IsFat:                                   result.ValidIngredientIsFat,
+				IsAcid:                                  result.ValidIngredientIsAcid,
+				IsHeat:                                  result.ValidIngredientIsHeat,
+			},
+		}
+
+		x.Data = append(x.Data, pantryItem)
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
+// GetUnexpiredPantryItemsForHousehold fetches every pantry item a household has that hasn't expired, soonest to expire first.
+func (q *Querier) GetUnexpiredPantryItemsForHousehold(ctx context.Context, householdID string) ([]*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	results, err := q.generatedQuerier.GetUnexpiredPantryItemsForHousehold(ctx, q.dbFor(ctx), householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching unexpired pantry items")
+	}
+
+	pantryItems := []*types.PantryItem{}
+	for _, result := range results {
+		pantryItems = append(pantryItems, &types.PantryItem{
+			CreatedAt:          result.CreatedAt,
+			ExpiresAt:          database.TimePointerFromNullTime(result.ExpiresAt),
+			ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
+			LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ID:                 result.ID,
+			StorageLocation:    string(result.StorageLocation),
+			Notes:              result.Notes,
+			BelongsToHousehold: result.BelongsToHousehold,
+			Quantity:           database.Float32FromString(result.Quantity),
+			MeasurementUnit: types.ValidMeasurementUnit{
+				CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+				LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+				ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+				Name:          result.ValidMeasurementUnitName,
+				IconPath:      result.ValidMeasurementUnitIconPath,
+				ID:            result.ValidMeasurementUnitID,
+				Description:   result.ValidMeasurementUnitDescription,
+				PluralName:    result.ValidMeasurementUnitPluralName,
+				Slug:          result.ValidMeasurementUnitSlug,
+				Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+				Universal:     result.ValidMeasurementUnitUniversal,
+				Metric:        result.ValidMeasurementUnitMetric,
+				Imperial:      result.ValidMeasurementUnitImperial,
+			},
+			Ingredient: types.ValidIngredient{
+				CreatedAt:                               result.ValidIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.ValidIngredientIconPath,
+				Warning:                                 result.ValidIngredientWarning,
+				PluralName:                              result.ValidIngredientPluralName,
+				StorageInstructions:                     result.ValidIngredientStorageInstructions,
+				Name:                                    result.ValidIngredientName,
+				ID:                                      result.ValidIngredientID,
+				Description:                             result.ValidIngredientDescription,
+				Slug:                                    result.ValidIngredientSlug,
+				ShoppingSuggestions:                     result.ValidIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.ValidIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.ValidIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+				ContainsPeanut:                          result.ValidIngredientContainsPeanut,
+				ContainsTreeNut:                         result.ValidIngredientContainsTreeNut,
+				ContainsEgg:                             result.ValidIngredientContainsEgg,
+				ContainsWheat:                           result.ValidIngredientContainsWheat,
+				ContainsSoy:                             result.ValidIngredientContainsSoy,
+				AnimalDerived:                           result.ValidIngredientAnimalDerived,
+				RestrictToPreparations:                  result.ValidIngredientRestrictToPreparations,
+				ContainsSesame:                          result.ValidIngredientContainsSesame,
+				ContainsFish:                            result.ValidIngredientContainsFish,
+				ContainsGluten:                          result.ValidIngredientContainsGluten,
+				ContainsDairy:                           result.ValidIngredientContainsDairy,
+				ContainsAlcohol:                         result.ValidIngredientContainsAlcohol,
+				AnimalFlesh:                             result.ValidIngredientAnimalFlesh,
+				IsStarch:                                result.ValidIngredientIsStarch,
+				IsProtein:                               result.ValidIngredientIsProtein,
+				IsGrain:                                 result.ValidIngredientIsGrain,
+				IsFruit:                                 result.ValidIngredientIsFruit,
+				IsSalt:                                  result.ValidIngredientIsSalt,
+				IsFat:                                   result.ValidIngredientIsFat,
+				IsAcid:                                  result.ValidIngredientIsAcid,
+				IsHeat:                                  result.ValidIngredientIsHeat,
+			},
+		})
+	}
+
+	return pantryItems, nil
+}
+
+// CreatePantryItem creates a pantry item in the database.
+func (q *Querier) CreatePantryItem(ctx context.Context, input *types.PantryItemDatabaseCreationInput) (*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, input.ID)
+	logger := q.logger.WithValue(keys.PantryItemIDKey, input.ID)
+
+	// create the pantry item.
+	if err := q.generatedQuerier.CreatePantryItem(ctx, q.dbFor(ctx), &generated.CreatePantryItemParams{
+		ID:                   input.ID,
+		ValidIngredient:      input.ValidIngredientID,
+		ValidMeasurementUnit: input.ValidMeasurementUnitID,
+		Quantity:             database.StringFromFloat32(input.Quantity),
+		StorageLocation:      generated.PantryStorageLocation(input.StorageLocation),
+		Notes:                input.Notes,
+		ExpiresAt:            database.NullTimeFromTimePointer(input.ExpiresAt),
+		BelongsToHousehold:   input.BelongsToHousehold,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing pantry item creation query")
+	}
+
+	x := &types.PantryItem{
+		ID:                 input.ID,
+		ExpiresAt:          input.ExpiresAt,
+		StorageLocation:    input.StorageLocation,
+		Notes:              input.Notes,
+		BelongsToHousehold: input.BelongsToHousehold,
+		Ingredient:         types.ValidIngredient{ID: input.ValidIngredientID},
+		MeasurementUnit:    types.ValidMeasurementUnit{ID: input.ValidMeasurementUnitID},
+		Quantity:           input.Quantity,
+		CreatedAt:          q.currentTime(),
+	}
+
+	logger.Info("pantry item created")
+
+	return x, nil
+}
+
+// CreatePantryItemForGroceryListItem creates the pantry item stocked by an acquired grocery list item.
+// It returns a nil pantry item if that grocery list item has already stocked the pantry.
+func (q *Querier) CreatePantryItemForGroceryListItem(ctx context.Context, input *types.PantryItemDatabaseCreationInput) (*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if input.SourceGroceryListItemID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, input.ID)
+	tracing.AttachToSpan(span, keys.MealPlanGroceryListItemIDKey, input.SourceGroceryListItemID)
+	logger := q.logger.WithValue(keys.PantryItemIDKey, input.ID).WithValue(keys.MealPlanGroceryListItemIDKey, input.SourceGroceryListItemID)
+
+	created, err := q.generatedQuerier.CreatePantryItemForGroceryListItem(ctx, q.dbFor(ctx), &generated.CreatePantryItemForGroceryListItemParams{
+		ID:                    input.ID,
+		ValidIngredient:       input.ValidIngredientID,
+		ValidMeasurementUnit:  input.ValidMeasurementUnitID,
+		Quantity:              database.StringFromFloat32(input.Quantity),
+		StorageLocation:       generated.PantryStorageLocation(input.StorageLocation),
+		Notes:                 input.Notes,
+		ExpiresAt:             database.NullTimeFromTimePointer(input.ExpiresAt),
+		BelongsToHousehold:    input.BelongsToHousehold,
+		SourceGroceryListItem: database.NullStringFromString(input.SourceGroceryListItemID),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing pantry item creation query")
+	}
+
+	if created == 0 {
+		logger.Info("grocery list item already stocked the pantry")
+		return nil, nil
+	}
+
+	x := &types.PantryItem{
+		ID:                 input.ID,
+		ExpiresAt:          input.ExpiresAt,
+		StorageLocation:    input.StorageLocation,
+		Notes:              input.Notes,
+		BelongsToHousehold: input.BelongsToHousehold,
+		Ingredient:         types.ValidIngredient{ID: input.ValidIngredientID},
+		MeasurementUnit:    types.ValidMeasurementUnit{ID: input.ValidMeasurementUnitID},
+		Quantity:           input.Quantity,
+		CreatedAt:          q.currentTime(),
+	}
+
+	logger.Info("pantry item created")
+
+	return x, nil
+}
+
+// UpdatePantryItem updates a particular pantry item.
+func (q *Querier) UpdatePantryItem(ctx context.Context, updated *types.PantryItem) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(keys.PantryItemIDKey, updated.ID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, updated.ID)
+
+	if _, err := q.generatedQuerier.UpdatePantryItem(ctx, q.dbFor(ctx), &generated.UpdatePantryItemParams{
+		ValidIngredient:      updated.Ingredient.ID,
+		ValidMeasurementUnit: updated.MeasurementUnit.ID,
+		Quantity:             database.StringFromFloat32(updated.Quantity),
+		StorageLocation:      generated.PantryStorageLocation(updated.StorageLocation),
+		Notes:                updated.Notes,
+		ExpiresAt:            database.NullTimeFromTimePointer(updated.ExpiresAt),
+		ID:                   updated.ID,
+		BelongsToHousehold:   updated.BelongsToHousehold,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating pantry item")
+	}
+
+	logger.Info("pantry item updated")
+
+	return nil
+}
+
+// ArchivePantryItem archives a pantry item from the database by its ID.
+func (q *Querier) ArchivePantryItem(ctx context.Context, pantryItemID, householdID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if pantryItemID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	if householdID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if _, err := q.generatedQuerier.ArchivePantryItem(ctx, q.dbFor(ctx), &generated.ArchivePantryItemParams{
+		ID:                 pantryItemID,
+		BelongsToHousehold: householdID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving pantry item")
+	}
+
+	logger.Info("pantry item archived")
+
+	return nil
+}
+
+// ArchivePantryItemForGroceryListItem archives the pantry item stocked by a grocery list item, returning its ID.
+// It returns an empty ID if that grocery list item has no pantry item on hand.
+func (q *Querier) ArchivePantryItemForGroceryListItem(ctx context.Context, groceryListItemID, householdID string) (string, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if groceryListItemID == "" {
+		return "", ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.MealPlanGroceryListItemIDKey, groceryListItemID)
+	tracing.AttachToSpan(span, keys.MealPlanGroceryListItemIDKey, groceryListItemID)
+
+	if householdID == "" {
+		return "", ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	pantryItemID, err := q.generatedQuerier.ArchivePantryItemForGroceryListItem(ctx, q.dbFor(ctx), &generated.ArchivePantryItemForGroceryListItemParams{
+		SourceGroceryListItem: database.NullStringFromString(groceryListItemID),
+		BelongsToHousehold:    householdID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", observability.PrepareAndLogError(err, logger, span, "archiving pantry item for grocery list item")
+	}
+
+	logger.WithValue(keys.PantryItemIDKey, pantryItemID).Info("pantry item archived")
+
+	return pantryItemID, nil
+}
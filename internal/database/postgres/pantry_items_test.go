@@ -0,0 +1,289 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPantryItemForTest(t *testing.T, ctx context.Context, examplePantryItem *types.PantryItem, dbc *Querier) *types.PantryItem {
+	t.Helper()
+
+	// create
+	if examplePantryItem == nil {
+		examplePantryItem = fakes.BuildFakePantryItem()
+	}
+	dbInput := converters.ConvertPantryItemToPantryItemDatabaseCreationInput(examplePantryItem)
+
+	created, err := dbc.CreatePantryItem(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+
+	examplePantryItem.CreatedAt = created.CreatedAt
+	assert.Equal(t, examplePantryItem.Ingredient.ID, created.Ingredient.ID)
+	assert.Equal(t, examplePantryItem.MeasurementUnit.ID, created.MeasurementUnit.ID)
+	examplePantryItem.Ingredient = created.Ingredient
+	examplePantryItem.MeasurementUnit = created.MeasurementUnit
+	assert.Equal(t, examplePantryItem, created)
+
+	pantryItem, err := dbc.GetPantryItem(ctx, created.ID, created.BelongsToHousehold)
+	require.NoError(t, err)
+	examplePantryItem.CreatedAt = pantryItem.CreatedAt
+	assert.Equal(t, examplePantryItem.Ingredient.ID, pantryItem.Ingredient.ID)
+	assert.Equal(t, examplePantryItem.MeasurementUnit.ID, pantryItem.MeasurementUnit.ID)
+	examplePantryItem.Ingredient = pantryItem.Ingredient
+	examplePantryItem.MeasurementUnit = pantryItem.MeasurementUnit
+	if examplePantryItem.ExpiresAt != nil {
+		require.NotNil(t, pantryItem.ExpiresAt)
+		assert.WithinDuration(t, *examplePantryItem.ExpiresAt, *pantryItem.ExpiresAt, time.Second)
+		examplePantryItem.ExpiresAt = pantryItem.ExpiresAt
+	}
+
+	assert.Equal(t, pantryItem, examplePantryItem)
+
+	return created
+}
+
+func TestQuerier_Integration_PantryItems(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := createUserForTest(t, ctx, nil, dbc)
+	householdID, err := dbc.GetDefaultHouseholdIDForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, householdID)
+
+	ingredient := createValidIngredientForTest(t, ctx, nil, dbc)
+	measurementUnit := createValidMeasurementUnitForTest(t, ctx, nil, dbc)
+
+	examplePantryItem := fakes.BuildFakePantryItem()
+	examplePantryItem.BelongsToHousehold = householdID
+	examplePantryItem.Ingredient = *ingredient
+	examplePantryItem.MeasurementUnit = *measurementUnit
+	examplePantryItem.ExpiresAt = nil
+	createdPantryItems := []*types.PantryItem{}
+
+	// create
+	createdPantryItems = append(createdPantryItems, createPantryItemForTest(t, ctx, examplePantryItem, dbc))
+
+	// update
+	createdPantryItems[0].StorageLocation = types.PantryItemStorageLocationFreezer
+	assert.NoError(t, dbc.UpdatePantryItem(ctx, createdPantryItems[0]))
+
+	// create more
+	for i := 0; i < exampleQuantity; i++ {
+		input := fakes.BuildFakePantryItem()
+		input.BelongsToHousehold = householdID
+		input.Ingredient = *ingredient
+		input.MeasurementUnit = *measurementUnit
+		input.ExpiresAt = pointer.To(time.Now().Add(24 * time.Hour).Truncate(time.Second))
+		createdPantryItems = append(createdPantryItems, createPantryItemForTest(t, ctx, input, dbc))
+	}
+
+	expired := fakes.BuildFakePantryItem()
+	expired.BelongsToHousehold = householdID
+	expired.Ingredient = *ingredient
+	expired.MeasurementUnit = *measurementUnit
+	expired.ExpiresAt = pointer.To(time.Now().Add(-24 * time.Hour).Truncate(time.Second))
+	createdPantryItems = append(createdPantryItems, createPantryItemForTest(t, ctx, expired, dbc))
+
+	// fetch as list
+	pantryItems, err := dbc.GetPantryItems(ctx, householdID, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pantryItems.Data)
+	assert.Equal(t, len(createdPantryItems), len(pantryItems.Data))
+
+	// fetch what's still usable
+	unexpired, err := dbc.GetUnexpiredPantryItemsForHousehold(ctx, householdID)
+	assert.NoError(t, err)
+	assert.Equal(t, len(createdPantryItems)-1, len(unexpired))
+	for _, item := range unexpired {
+		assert.NotEqual(t, expired.ID, item.ID)
+	}
+
+	// delete
+	for _, pantryItem := range createdPantryItems {
+		assert.NoError(t, dbc.ArchivePantryItem(ctx, pantryItem.ID, householdID))
+
+		var exists bool
+		exists, err = dbc.PantryItemExists(ctx, pantryItem.ID, householdID)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		var y *types.PantryItem
+		y, err = dbc.GetPantryItem(ctx, pantryItem.ID, householdID)
+		assert.Nil(t, y)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestQuerier_PantryItemExists(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.PantryItemExists(ctx, "", exampleHouseholdID)
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+}
+
+func TestQuerier_GetPantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetPantryItem(ctx, "", exampleHouseholdID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetUnexpiredPantryItemsForHousehold(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetUnexpiredPantryItemsForHousehold(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreatePantryItem(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreatePantryItemForGroceryListItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreatePantryItemForGroceryListItem(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("without source grocery list item", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreatePantryItemForGroceryListItem(ctx, &types.PantryItemDatabaseCreationInput{ID: fakes.BuildFakeID()})
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpdatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.UpdatePantryItem(ctx, nil))
+	})
+}
+
+func TestQuerier_ArchivePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchivePantryItem(ctx, "", exampleHouseholdID))
+	})
+}
+
+func TestQuerier_ArchivePantryItemForGroceryListItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid grocery list item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.ArchivePantryItemForGroceryListItem(ctx, "", exampleHouseholdID)
+		assert.Error(t, err)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleGroceryListItemID := fakes.BuildFakeID()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.ArchivePantryItemForGroceryListItem(ctx, exampleGroceryListItemID, "")
+		assert.Error(t, err)
+		assert.Empty(t, actual)
+	})
+}
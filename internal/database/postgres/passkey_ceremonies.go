@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.PasskeyCeremonyDataManager = (*Querier)(nil)
+)
+
+// CreatePasskeyCeremony stores the state of an in-progress WebAuthn ceremony, and drops any ceremonies that expired unfinished.
+func (q *Querier) CreatePasskeyCeremony(ctx context.Context, input *types.PasskeyCeremonyDatabaseCreationInput) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if input == nil {
+		return ErrNilInputProvided
+	}
+
+	if input.ID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PasskeyCeremonyIDKey, input.ID)
+	tracing.AttachToSpan(span, keys.PasskeyCeremonyIDKey, input.ID)
+
+	if err := q.generatedQuerier.DeleteExpiredPasskeyCeremonies(ctx, q.dbFor(ctx)); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "deleting expired passkey ceremonies")
+	}
+
+	if err := q.generatedQuerier.CreatePasskeyCeremony(ctx, q.dbFor(ctx), &generated.CreatePasskeyCeremonyParams{
+		ExpiresAt:   input.ExpiresAt,
+		ID:          input.ID,
+		SessionData: input.SessionData,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "creating passkey ceremony")
+	}
+
+	logger.Info("passkey ceremony created")
+
+	return nil
+}
+
+// ConsumePasskeyCeremony deletes an unexpired WebAuthn ceremony and returns its state, so that each ceremony can only be
+// finished once. It returns sql.ErrNoRows if there's no such ceremony.
+func (q *Querier) ConsumePasskeyCeremony(ctx context.Context, ceremonyID string) ([]byte, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if ceremonyID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PasskeyCeremonyIDKey, ceremonyID)
+	tracing.AttachToSpan(span, keys.PasskeyCeremonyIDKey, ceremonyID)
+
+	sessionData, err := q.generatedQuerier.ConsumePasskeyCeremony(ctx, q.dbFor(ctx), ceremonyID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "consuming passkey ceremony")
+	}
+
+	logger.Info("passkey ceremony consumed")
+
+	return sessionData, nil
+}
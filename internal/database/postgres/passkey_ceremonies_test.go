@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_PasskeyCeremonies(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	input := &types.PasskeyCeremonyDatabaseCreationInput{
+		ExpiresAt:   time.Now().Add(time.Minute),
+		ID:          fakes.BuildFakeID(),
+		SessionData: []byte(`{"challenge":"blah"}`),
+	}
+	require.NoError(t, dbc.CreatePasskeyCeremony(ctx, input))
+
+	// ceremonies can only be consumed once.
+	sessionData, err := dbc.ConsumePasskeyCeremony(ctx, input.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, input.SessionData, sessionData)
+
+	sessionData, err = dbc.ConsumePasskeyCeremony(ctx, input.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, sessionData)
+
+	// expired ceremonies can't be consumed at all.
+	expired := &types.PasskeyCeremonyDatabaseCreationInput{
+		ExpiresAt:   time.Now().Add(-time.Minute),
+		ID:          fakes.BuildFakeID(),
+		SessionData: []byte(`{"challenge":"blah"}`),
+	}
+	require.NoError(t, dbc.CreatePasskeyCeremony(ctx, expired))
+
+	sessionData, err = dbc.ConsumePasskeyCeremony(ctx, expired.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, sessionData)
+}
+
+func TestQuerier_CreatePasskeyCeremony(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.CreatePasskeyCeremony(ctx, nil))
+	})
+
+	T.Run("with invalid ceremony ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.CreatePasskeyCeremony(ctx, &types.PasskeyCeremonyDatabaseCreationInput{}))
+	})
+}
+
+func TestQuerier_ConsumePasskeyCeremony(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid ceremony ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.ConsumePasskeyCeremony(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -25,7 +25,7 @@ func (q *Querier) GetPasswordResetTokenByToken(ctx context.Context, token string
 	}
 	tracing.AttachToSpan(span, keys.PasswordResetTokenIDKey, token)
 
-	result, err := q.generatedQuerier.GetPasswordResetToken(ctx, q.db, token)
+	result, err := q.generatedQuerier.GetPasswordResetToken(ctx, q.dbFor(ctx), token)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, q.logger, span, "getting password reset token")
 	}
@@ -55,7 +55,7 @@ func (q *Querier) CreatePasswordResetToken(ctx context.Context, input *types.Pas
 	tracing.AttachToSpan(span, keys.PasswordResetTokenIDKey, input.ID)
 
 	// create the password reset token.
-	if err := q.generatedQuerier.CreatePasswordResetToken(ctx, q.db, &generated.CreatePasswordResetTokenParams{
+	if err := q.generatedQuerier.CreatePasswordResetToken(ctx, q.dbFor(ctx), &generated.CreatePasswordResetTokenParams{
 		ID:            input.ID,
 		Token:         input.Token,
 		BelongsToUser: input.BelongsToUser,
@@ -89,7 +89,7 @@ func (q *Querier) RedeemPasswordResetToken(ctx context.Context, passwordResetTok
 	logger = logger.WithValue(keys.PasswordResetTokenIDKey, passwordResetTokenID)
 	tracing.AttachToSpan(span, keys.PasswordResetTokenIDKey, passwordResetTokenID)
 
-	if err := q.generatedQuerier.RedeemPasswordResetToken(ctx, q.db, passwordResetTokenID); err != nil {
+	if err := q.generatedQuerier.RedeemPasswordResetToken(ctx, q.dbFor(ctx), passwordResetTokenID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving password reset token")
 	}
 
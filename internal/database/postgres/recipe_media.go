@@ -28,7 +28,7 @@ func (q *Querier) RecipeMediaExists(ctx context.Context, recipeMediaID string) (
 	logger = logger.WithValue(keys.RecipeMediaIDKey, recipeMediaID)
 	tracing.AttachToSpan(span, keys.RecipeMediaIDKey, recipeMediaID)
 
-	result, err := q.generatedQuerier.CheckRecipeMediaExistence(ctx, q.db, recipeMediaID)
+	result, err := q.generatedQuerier.CheckRecipeMediaExistence(ctx, q.dbFor(ctx), recipeMediaID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing recipe media existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetRecipeMedia(ctx context.Context, recipeMediaID string) (*ty
 	logger = logger.WithValue(keys.RecipeMediaIDKey, recipeMediaID)
 	tracing.AttachToSpan(span, keys.RecipeMediaIDKey, recipeMediaID)
 
-	result, err := q.generatedQuerier.GetRecipeMedia(ctx, q.db, recipeMediaID)
+	result, err := q.generatedQuerier.GetRecipeMedia(ctx, q.dbFor(ctx), recipeMediaID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting recipe media")
 	}
@@ -83,7 +83,7 @@ func (q *Querier) getRecipeMediaForRecipe(ctx context.Context, recipeID string)
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeMediaForRecipe(ctx, q.db, database.NullStringFromString(recipeID))
+	results, err := q.generatedQuerier.GetRecipeMediaForRecipe(ctx, q.dbFor(ctx), database.NullStringFromString(recipeID))
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe media list retrieval query")
 	}
@@ -126,7 +126,7 @@ func (q *Querier) getRecipeMediaForRecipeStep(ctx context.Context, recipeID, rec
 	logger = logger.WithValue(keys.RecipeStepIDKey, recipeStepID)
 	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
 
-	results, err := q.generatedQuerier.GetRecipeMediaForRecipeStep(ctx, q.db, &generated.GetRecipeMediaForRecipeStepParams{
+	results, err := q.generatedQuerier.GetRecipeMediaForRecipeStep(ctx, q.dbFor(ctx), &generated.GetRecipeMediaForRecipeStepParams{
 		RecipeID:     database.NullStringFromString(recipeID),
 		RecipeStepID: database.NullStringFromString(recipeStepID),
 	})
@@ -165,7 +165,7 @@ func (q *Querier) CreateRecipeMedia(ctx context.Context, input *types.RecipeMedi
 	logger := q.logger.WithValue(keys.RecipeMediaIDKey, input.ID)
 
 	// create the recipe media.
-	if err := q.generatedQuerier.CreateRecipeMedia(ctx, q.db, &generated.CreateRecipeMediaParams{
+	if err := q.generatedQuerier.CreateRecipeMedia(ctx, q.dbFor(ctx), &generated.CreateRecipeMediaParams{
 		ID:                  input.ID,
 		MimeType:            input.MimeType,
 		InternalPath:        input.InternalPath,
@@ -205,7 +205,7 @@ func (q *Querier) UpdateRecipeMedia(ctx context.Context, updated *types.RecipeMe
 	logger := q.logger.WithValue(keys.RecipeMediaIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.RecipeMediaIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeMedia(ctx, q.db, &generated.UpdateRecipeMediaParams{
+	if _, err := q.generatedQuerier.UpdateRecipeMedia(ctx, q.dbFor(ctx), &generated.UpdateRecipeMediaParams{
 		BelongsToRecipe:     database.NullStringFromStringPointer(updated.BelongsToRecipe),
 		BelongsToRecipeStep: database.NullStringFromStringPointer(updated.BelongsToRecipeStep),
 		MimeType:            updated.MimeType,
@@ -234,7 +234,7 @@ func (q *Querier) ArchiveRecipeMedia(ctx context.Context, recipeMediaID string)
 	logger = logger.WithValue(keys.RecipeMediaIDKey, recipeMediaID)
 	tracing.AttachToSpan(span, keys.RecipeMediaIDKey, recipeMediaID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeMedia(ctx, q.db, recipeMediaID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveRecipeMedia(ctx, q.dbFor(ctx), recipeMediaID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving recipe media")
 	}
 
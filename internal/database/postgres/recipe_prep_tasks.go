@@ -35,7 +35,7 @@ func (q *Querier) RecipePrepTaskExists(ctx context.Context, recipeID, recipePrep
 	logger = logger.WithValue(keys.RecipePrepTaskIDKey, recipePrepTaskID)
 	tracing.AttachToSpan(span, keys.RecipePrepTaskIDKey, recipePrepTaskID)
 
-	result, err := q.generatedQuerier.CheckRecipePrepTaskExistence(ctx, q.db, &generated.CheckRecipePrepTaskExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipePrepTaskExistence(ctx, q.dbFor(ctx), &generated.CheckRecipePrepTaskExistenceParams{
 		RecipeID:         recipeID,
 		RecipePrepTaskID: recipePrepTaskID,
 	})
@@ -67,7 +67,7 @@ func (q *Querier) GetRecipePrepTask(ctx context.Context, recipeID, recipePrepTas
 	logger = logger.WithValue(keys.RecipePrepTaskIDKey, recipePrepTaskID)
 	tracing.AttachToSpan(span, keys.RecipePrepTaskIDKey, recipePrepTaskID)
 
-	results, err := q.generatedQuerier.GetRecipePrepTask(ctx, q.db, recipePrepTaskID)
+	results, err := q.generatedQuerier.GetRecipePrepTask(ctx, q.dbFor(ctx), recipePrepTaskID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting recipe prep task")
 	}
@@ -189,7 +189,7 @@ func (q *Querier) CreateRecipePrepTask(ctx context.Context, input *types.RecipeP
 	}
 	logger = logger.WithValue(keys.RecipePrepTaskIDKey, input.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -257,7 +257,7 @@ func (q *Querier) getRecipePrepTasksForRecipe(ctx context.Context, recipeID stri
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.ListAllRecipePrepTasksByRecipe(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.ListAllRecipePrepTasksByRecipe(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe prep tasks list retrieval query")
 	}
@@ -324,7 +324,7 @@ func (q *Querier) UpdateRecipePrepTask(ctx context.Context, updated *types.Recip
 	}
 	logger = logger.WithValue(keys.RecipePrepTaskIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipePrepTask(ctx, q.db, &generated.UpdateRecipePrepTaskParams{
+	if _, err := q.generatedQuerier.UpdateRecipePrepTask(ctx, q.dbFor(ctx), &generated.UpdateRecipePrepTaskParams{
 		Name:                                   updated.Name,
 		Description:                            updated.Description,
 		Notes:                                  updated.Notes,
@@ -365,7 +365,7 @@ func (q *Querier) ArchiveRecipePrepTask(ctx context.Context, recipeID, recipePre
 	logger = logger.WithValue(keys.RecipePrepTaskIDKey, recipePrepTaskID)
 	tracing.AttachToSpan(span, keys.RecipePrepTaskIDKey, recipePrepTaskID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipePrepTask(ctx, q.db, recipePrepTaskID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveRecipePrepTask(ctx, q.dbFor(ctx), recipePrepTaskID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe prep task")
 	}
 
@@ -28,7 +28,7 @@ func (q *Querier) RecipeRatingExists(ctx context.Context, recipeRatingID string)
 	logger = logger.WithValue(keys.RecipeRatingIDKey, recipeRatingID)
 	tracing.AttachToSpan(span, keys.RecipeRatingIDKey, recipeRatingID)
 
-	result, err := q.generatedQuerier.CheckRecipeRatingExistence(ctx, q.db, recipeRatingID)
+	result, err := q.generatedQuerier.CheckRecipeRatingExistence(ctx, q.dbFor(ctx), recipeRatingID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing recipe rating existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetRecipeRating(ctx context.Context, recipeRatingID string) (*
 	logger = logger.WithValue(keys.RecipeRatingIDKey, recipeRatingID)
 	tracing.AttachToSpan(span, keys.RecipeRatingIDKey, recipeRatingID)
 
-	result, err := q.generatedQuerier.GetRecipeRating(ctx, q.db, recipeRatingID)
+	result, err := q.generatedQuerier.GetRecipeRating(ctx, q.dbFor(ctx), recipeRatingID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing recipe rating existence check")
 	}
@@ -89,7 +89,7 @@ func (q *Querier) GetRecipeRatings(ctx context.Context, filter *types.QueryFilte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeRatings(ctx, q.db, &generated.GetRecipeRatingsParams{
+	results, err := q.generatedQuerier.GetRecipeRatings(ctx, q.dbFor(ctx), &generated.GetRecipeRatingsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -123,6 +123,45 @@ func (q *Querier) GetRecipeRatings(ctx context.Context, filter *types.QueryFilte
 	return x, nil
 }
 
+// GetRecipeRatingsForHousehold fetches every recipe rating made by a member of a given household.
+func (q *Querier) GetRecipeRatingsForHousehold(ctx context.Context, householdID string) ([]*types.RecipeRating, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	results, err := q.generatedQuerier.GetRecipeRatingsForHousehold(ctx, q.dbFor(ctx), householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing household recipe ratings retrieval query")
+	}
+
+	recipeRatings := []*types.RecipeRating{}
+	for _, result := range results {
+		recipeRatings = append(recipeRatings, &types.RecipeRating{
+			CreatedAt:     result.CreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ArchivedAt),
+			Notes:         result.Notes,
+			ID:            result.ID,
+			RecipeID:      result.RecipeID,
+			ByUser:        result.ByUser,
+			Taste:         database.Float32FromNullString(result.Taste),
+			Instructions:  database.Float32FromNullString(result.Instructions),
+			Overall:       database.Float32FromNullString(result.Overall),
+			Cleanup:       database.Float32FromNullString(result.Cleanup),
+			Difficulty:    database.Float32FromNullString(result.Difficulty),
+		})
+	}
+
+	return recipeRatings, nil
+}
+
 // CreateRecipeRating creates a recipe rating in the database.
 func (q *Querier) CreateRecipeRating(ctx context.Context, input *types.RecipeRatingDatabaseCreationInput) (*types.RecipeRating, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -135,7 +174,7 @@ func (q *Querier) CreateRecipeRating(ctx context.Context, input *types.RecipeRat
 	logger := q.logger.WithValue(keys.RecipeRatingIDKey, input.ID)
 
 	// create the recipe rating.
-	if err := q.generatedQuerier.CreateRecipeRating(ctx, q.db, &generated.CreateRecipeRatingParams{
+	if err := q.generatedQuerier.CreateRecipeRating(ctx, q.dbFor(ctx), &generated.CreateRecipeRatingParams{
 		ID:           input.ID,
 		RecipeID:     input.RecipeID,
 		Notes:        input.Notes,
@@ -179,7 +218,7 @@ func (q *Querier) UpdateRecipeRating(ctx context.Context, updated *types.RecipeR
 	logger := q.logger.WithValue(keys.RecipeRatingIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.RecipeRatingIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeRating(ctx, q.db, &generated.UpdateRecipeRatingParams{
+	if _, err := q.generatedQuerier.UpdateRecipeRating(ctx, q.dbFor(ctx), &generated.UpdateRecipeRatingParams{
 		RecipeID:     updated.RecipeID,
 		Taste:        database.NullStringFromFloat32(updated.Taste),
 		Difficulty:   database.NullStringFromFloat32(updated.Difficulty),
@@ -210,7 +249,7 @@ func (q *Querier) ArchiveRecipeRating(ctx context.Context, recipeRatingID string
 	logger = logger.WithValue(keys.RecipeRatingIDKey, recipeRatingID)
 	tracing.AttachToSpan(span, keys.RecipeRatingIDKey, recipeRatingID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeRating(ctx, q.db, recipeRatingID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveRecipeRating(ctx, q.dbFor(ctx), recipeRatingID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving recipe rating")
 	}
 
@@ -77,6 +77,11 @@ func TestQuerier_Integration_RecipeRatings(t *testing.T) {
 	assert.NotEmpty(t, recipeRatings.Data)
 	assert.Equal(t, len(createdRecipeRatings), len(recipeRatings.Data))
 
+	// fetch for household
+	householdRecipeRatings, err := dbc.GetRecipeRatingsForHousehold(ctx, householdID)
+	assert.NoError(t, err)
+	assert.Equal(t, len(createdRecipeRatings), len(householdRecipeRatings))
+
 	// delete
 	for _, recipeRating := range createdRecipeRatings {
 		assert.NoError(t, dbc.ArchiveRecipeRating(ctx, recipeRating.ID))
@@ -124,6 +129,21 @@ func TestQuerier_GetRecipeRating(T *testing.T) {
 	})
 }
 
+func TestQuerier_GetRecipeRatingsForHousehold(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetRecipeRatingsForHousehold(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
 func TestQuerier_CreateRecipeRating(T *testing.T) {
 	T.Parallel()
 
@@ -42,7 +42,7 @@ func (q *Querier) RecipeStepCompletionConditionExists(ctx context.Context, recip
 	logger = logger.WithValue(keys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 	tracing.AttachToSpan(span, keys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 
-	result, err := q.generatedQuerier.CheckRecipeStepCompletionConditionExistence(ctx, q.db, &generated.CheckRecipeStepCompletionConditionExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipeStepCompletionConditionExistence(ctx, q.dbFor(ctx), &generated.CheckRecipeStepCompletionConditionExistenceParams{
 		RecipeStepID:                    recipeStepID,
 		RecipeStepCompletionConditionID: recipeStepCompletionConditionID,
 		RecipeID:                        recipeID,
@@ -79,7 +79,7 @@ func (q *Querier) GetRecipeStepCompletionCondition(ctx context.Context, recipeID
 	logger = logger.WithValue(keys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 	tracing.AttachToSpan(span, keys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 
-	results, err := q.generatedQuerier.GetRecipeStepCompletionConditionWithIngredients(ctx, q.db, &generated.GetRecipeStepCompletionConditionWithIngredientsParams{
+	results, err := q.generatedQuerier.GetRecipeStepCompletionConditionWithIngredients(ctx, q.dbFor(ctx), &generated.GetRecipeStepCompletionConditionWithIngredientsParams{
 		RecipeID:                        recipeID,
 		RecipeStepID:                    recipeStepID,
 		RecipeStepCompletionConditionID: recipeStepCompletionConditionID,
@@ -145,7 +145,7 @@ func (q *Querier) getRecipeStepCompletionConditionsForRecipe(ctx context.Context
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetAllRecipeStepCompletionConditionsForRecipe(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.GetAllRecipeStepCompletionConditionsForRecipe(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "querying for recipe step completion condition")
 	}
@@ -225,7 +225,7 @@ func (q *Querier) GetRecipeStepCompletionConditions(ctx context.Context, recipeI
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeStepCompletionConditions(ctx, q.db, &generated.GetRecipeStepCompletionConditionsParams{
+	results, err := q.generatedQuerier.GetRecipeStepCompletionConditions(ctx, q.dbFor(ctx), &generated.GetRecipeStepCompletionConditionsParams{
 		RecipeStepID:  recipeStepID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -362,7 +362,7 @@ func (q *Querier) createRecipeStepCompletionConditionIngredient(ctx context.Cont
 
 // CreateRecipeStepCompletionCondition creates a recipe step completion condition in the database.
 func (q *Querier) CreateRecipeStepCompletionCondition(ctx context.Context, input *types.RecipeStepCompletionConditionDatabaseCreationInput) (*types.RecipeStepCompletionCondition, error) {
-	return q.createRecipeStepCompletionCondition(ctx, q.db, input)
+	return q.createRecipeStepCompletionCondition(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateRecipeStepCompletionCondition updates a particular recipe step completion condition.
@@ -376,7 +376,7 @@ func (q *Querier) UpdateRecipeStepCompletionCondition(ctx context.Context, updat
 	logger := q.logger.WithValue(keys.RecipeStepCompletionConditionIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.RecipeStepCompletionConditionIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepCompletionCondition(ctx, q.db, &generated.UpdateRecipeStepCompletionConditionParams{
+	if _, err := q.generatedQuerier.UpdateRecipeStepCompletionCondition(ctx, q.dbFor(ctx), &generated.UpdateRecipeStepCompletionConditionParams{
 		Optional:            updated.Optional,
 		Notes:               updated.Notes,
 		BelongsToRecipeStep: updated.BelongsToRecipeStep,
@@ -410,7 +410,7 @@ func (q *Querier) ArchiveRecipeStepCompletionCondition(ctx context.Context, reci
 	logger = logger.WithValue(keys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 	tracing.AttachToSpan(span, keys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeStepCompletionCondition(ctx, q.db, &generated.ArchiveRecipeStepCompletionConditionParams{
+	if _, err := q.generatedQuerier.ArchiveRecipeStepCompletionCondition(ctx, q.dbFor(ctx), &generated.ArchiveRecipeStepCompletionConditionParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepCompletionConditionID,
 	}); err != nil {
@@ -40,7 +40,7 @@ func (q *Querier) RecipeStepIngredientExists(ctx context.Context, recipeID, reci
 	logger = logger.WithValue(keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 
-	result, err := q.generatedQuerier.CheckRecipeStepIngredientExistence(ctx, q.db, &generated.CheckRecipeStepIngredientExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipeStepIngredientExistence(ctx, q.dbFor(ctx), &generated.CheckRecipeStepIngredientExistenceParams{
 		RecipeStepID:           recipeStepID,
 		RecipeStepIngredientID: recipeStepIngredientID,
 		RecipeID:               recipeID,
@@ -77,7 +77,7 @@ func (q *Querier) GetRecipeStepIngredient(ctx context.Context, recipeID, recipeS
 	logger = logger.WithValue(keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 
-	result, err := q.generatedQuerier.GetRecipeStepIngredient(ctx, q.db, &generated.GetRecipeStepIngredientParams{
+	result, err := q.generatedQuerier.GetRecipeStepIngredient(ctx, q.dbFor(ctx), &generated.GetRecipeStepIngredientParams{
 		RecipeStepID:           recipeStepID,
 		RecipeStepIngredientID: recipeStepIngredientID,
 		RecipeID:               recipeID,
@@ -180,7 +180,7 @@ func (q *Querier) getRecipeStepIngredientsForRecipe(ctx context.Context, recipeI
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetAllRecipeStepIngredientsForRecipe(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.GetAllRecipeStepIngredientsForRecipe(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe step ingredients list retrieval query")
 	}
@@ -300,7 +300,7 @@ func (q *Querier) GetRecipeStepIngredients(ctx context.Context, recipeID, recipe
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeStepIngredients(ctx, q.db, &generated.GetRecipeStepIngredientsParams{
+	results, err := q.generatedQuerier.GetRecipeStepIngredients(ctx, q.dbFor(ctx), &generated.GetRecipeStepIngredientsParams{
 		RecipeID:      recipeID,
 		RecipeStepID:  recipeStepID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -462,7 +462,7 @@ func (q *Querier) createRecipeStepIngredient(ctx context.Context, db database.SQ
 
 // CreateRecipeStepIngredient creates a recipe step ingredient in the database.
 func (q *Querier) CreateRecipeStepIngredient(ctx context.Context, input *types.RecipeStepIngredientDatabaseCreationInput) (*types.RecipeStepIngredient, error) {
-	return q.createRecipeStepIngredient(ctx, q.db, input)
+	return q.createRecipeStepIngredient(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateRecipeStepIngredient updates a particular recipe step ingredient.
@@ -481,7 +481,7 @@ func (q *Querier) UpdateRecipeStepIngredient(ctx context.Context, updated *types
 		ingredientID = &updated.Ingredient.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepIngredient(ctx, q.db, &generated.UpdateRecipeStepIngredientParams{
+	if _, err := q.generatedQuerier.UpdateRecipeStepIngredient(ctx, q.dbFor(ctx), &generated.UpdateRecipeStepIngredientParams{
 		IngredientID:              database.NullStringFromStringPointer(ingredientID),
 		Name:                      updated.Name,
 		Optional:                  updated.Optional,
@@ -526,7 +526,7 @@ func (q *Querier) ArchiveRecipeStepIngredient(ctx context.Context, recipeStepID,
 	logger = logger.WithValue(keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeStepIngredient(ctx, q.db, &generated.ArchiveRecipeStepIngredientParams{
+	if _, err := q.generatedQuerier.ArchiveRecipeStepIngredient(ctx, q.dbFor(ctx), &generated.ArchiveRecipeStepIngredientParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepIngredientID,
 	}); err != nil {
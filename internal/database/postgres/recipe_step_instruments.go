@@ -40,7 +40,7 @@ func (q *Querier) RecipeStepInstrumentExists(ctx context.Context, recipeID, reci
 	logger = logger.WithValue(keys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 	tracing.AttachToSpan(span, keys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 
-	result, err := q.generatedQuerier.CheckRecipeStepInstrumentExistence(ctx, q.db, &generated.CheckRecipeStepInstrumentExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipeStepInstrumentExistence(ctx, q.dbFor(ctx), &generated.CheckRecipeStepInstrumentExistenceParams{
 		RecipeStepID:           recipeStepID,
 		RecipeStepInstrumentID: recipeStepInstrumentID,
 		RecipeID:               recipeID,
@@ -77,7 +77,7 @@ func (q *Querier) GetRecipeStepInstrument(ctx context.Context, recipeID, recipeS
 	logger = logger.WithValue(keys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 	tracing.AttachToSpan(span, keys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 
-	result, err := q.generatedQuerier.GetRecipeStepInstrument(ctx, q.db, &generated.GetRecipeStepInstrumentParams{
+	result, err := q.generatedQuerier.GetRecipeStepInstrument(ctx, q.dbFor(ctx), &generated.GetRecipeStepInstrumentParams{
 		RecipeStepID:           recipeStepID,
 		RecipeStepInstrumentID: recipeStepInstrumentID,
 		RecipeID:               recipeID,
@@ -152,7 +152,7 @@ func (q *Querier) GetRecipeStepInstruments(ctx context.Context, recipeID, recipe
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeStepInstruments(ctx, q.db, &generated.GetRecipeStepInstrumentsParams{
+	results, err := q.generatedQuerier.GetRecipeStepInstruments(ctx, q.dbFor(ctx), &generated.GetRecipeStepInstrumentsParams{
 		RecipeStepID:  recipeStepID,
 		RecipeID:      recipeID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -222,7 +222,7 @@ func (q *Querier) getRecipeStepInstrumentsForRecipe(ctx context.Context, recipeI
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeStepInstrumentsForRecipe(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.GetRecipeStepInstrumentsForRecipe(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing recipe step instruments list retrieval")
 	}
@@ -323,7 +323,7 @@ func (q *Querier) createRecipeStepInstrument(ctx context.Context, querier databa
 
 // CreateRecipeStepInstrument creates a recipe step instrument in the database.
 func (q *Querier) CreateRecipeStepInstrument(ctx context.Context, input *types.RecipeStepInstrumentDatabaseCreationInput) (*types.RecipeStepInstrument, error) {
-	return q.createRecipeStepInstrument(ctx, q.db, input)
+	return q.createRecipeStepInstrument(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateRecipeStepInstrument updates a particular recipe step instrument.
@@ -342,7 +342,7 @@ func (q *Querier) UpdateRecipeStepInstrument(ctx context.Context, updated *types
 		instrumentID = &updated.Instrument.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepInstrument(ctx, q.db, &generated.UpdateRecipeStepInstrumentParams{
+	if _, err := q.generatedQuerier.UpdateRecipeStepInstrument(ctx, q.dbFor(ctx), &generated.UpdateRecipeStepInstrumentParams{
 		InstrumentID:        database.NullStringFromStringPointer(instrumentID),
 		RecipeStepProductID: database.NullStringFromStringPointer(updated.RecipeStepProductID),
 		Name:                updated.Name,
@@ -382,7 +382,7 @@ func (q *Querier) ArchiveRecipeStepInstrument(ctx context.Context, recipeStepID,
 	logger = logger.WithValue(keys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 	tracing.AttachToSpan(span, keys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeStepInstrument(ctx, q.db, &generated.ArchiveRecipeStepInstrumentParams{
+	if _, err := q.generatedQuerier.ArchiveRecipeStepInstrument(ctx, q.dbFor(ctx), &generated.ArchiveRecipeStepInstrumentParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepInstrumentID,
 	}); err != nil {
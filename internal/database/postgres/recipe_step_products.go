@@ -40,7 +40,7 @@ func (q *Querier) RecipeStepProductExists(ctx context.Context, recipeID, recipeS
 	logger = logger.WithValue(keys.RecipeStepProductIDKey, recipeStepProductID)
 	tracing.AttachToSpan(span, keys.RecipeStepProductIDKey, recipeStepProductID)
 
-	result, err := q.generatedQuerier.CheckRecipeStepProductExistence(ctx, q.db, &generated.CheckRecipeStepProductExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipeStepProductExistence(ctx, q.dbFor(ctx), &generated.CheckRecipeStepProductExistenceParams{
 		RecipeStepID:        recipeStepID,
 		RecipeStepProductID: recipeStepProductID,
 		RecipeID:            recipeID,
@@ -77,7 +77,7 @@ func (q *Querier) GetRecipeStepProduct(ctx context.Context, recipeID, recipeStep
 	logger = logger.WithValue(keys.RecipeStepProductIDKey, recipeStepProductID)
 	tracing.AttachToSpan(span, keys.RecipeStepProductIDKey, recipeStepProductID)
 
-	result, err := q.generatedQuerier.GetRecipeStepProduct(ctx, q.db, &generated.GetRecipeStepProductParams{
+	result, err := q.generatedQuerier.GetRecipeStepProduct(ctx, q.dbFor(ctx), &generated.GetRecipeStepProductParams{
 		RecipeStepID:        recipeStepID,
 		RecipeStepProductID: recipeStepProductID,
 		RecipeID:            recipeID,
@@ -143,7 +143,7 @@ func (q *Querier) getRecipeStepProductsForRecipe(ctx context.Context, recipeID s
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeStepProductsForRecipe(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.GetRecipeStepProductsForRecipe(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting recipe step products for recipe")
 	}
@@ -226,7 +226,7 @@ func (q *Querier) GetRecipeStepProducts(ctx context.Context, recipeID, recipeSte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeStepProducts(ctx, q.db, &generated.GetRecipeStepProductsParams{
+	results, err := q.generatedQuerier.GetRecipeStepProducts(ctx, q.dbFor(ctx), &generated.GetRecipeStepProductsParams{
 		RecipeStepID:  recipeStepID,
 		RecipeID:      recipeID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -353,7 +353,7 @@ func (q *Querier) createRecipeStepProduct(ctx context.Context, db database.SQLQu
 
 // CreateRecipeStepProduct creates a recipe step product in the database.
 func (q *Querier) CreateRecipeStepProduct(ctx context.Context, input *types.RecipeStepProductDatabaseCreationInput) (*types.RecipeStepProduct, error) {
-	return q.createRecipeStepProduct(ctx, q.db, input)
+	return q.createRecipeStepProduct(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateRecipeStepProduct updates a particular recipe step product.
@@ -372,7 +372,7 @@ func (q *Querier) UpdateRecipeStepProduct(ctx context.Context, updated *types.Re
 		measurementUnitID = &updated.MeasurementUnit.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepProduct(ctx, q.db, &generated.UpdateRecipeStepProductParams{
+	if _, err := q.generatedQuerier.UpdateRecipeStepProduct(ctx, q.dbFor(ctx), &generated.UpdateRecipeStepProductParams{
 		Name:                               updated.Name,
 		Type:                               generated.RecipeStepProductType(updated.Type),
 		MeasurementUnit:                    database.NullStringFromStringPointer(measurementUnitID),
@@ -418,7 +418,7 @@ func (q *Querier) ArchiveRecipeStepProduct(ctx context.Context, recipeStepID, re
 	logger = logger.WithValue(keys.RecipeStepProductIDKey, recipeStepProductID)
 	tracing.AttachToSpan(span, keys.RecipeStepProductIDKey, recipeStepProductID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeStepProduct(ctx, q.db, &generated.ArchiveRecipeStepProductParams{
+	if _, err := q.generatedQuerier.ArchiveRecipeStepProduct(ctx, q.dbFor(ctx), &generated.ArchiveRecipeStepProductParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepProductID,
 	}); err != nil {
@@ -40,7 +40,7 @@ func (q *Querier) RecipeStepVesselExists(ctx context.Context, recipeID, recipeSt
 	logger = logger.WithValue(keys.RecipeStepVesselIDKey, recipeStepVesselID)
 	tracing.AttachToSpan(span, keys.RecipeStepVesselIDKey, recipeStepVesselID)
 
-	result, err := q.generatedQuerier.CheckRecipeStepVesselExistence(ctx, q.db, &generated.CheckRecipeStepVesselExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipeStepVesselExistence(ctx, q.dbFor(ctx), &generated.CheckRecipeStepVesselExistenceParams{
 		RecipeStepID:       recipeStepID,
 		RecipeStepVesselID: recipeStepVesselID,
 		RecipeID:           recipeID,
@@ -77,7 +77,7 @@ func (q *Querier) GetRecipeStepVessel(ctx context.Context, recipeID, recipeStepI
 	logger = logger.WithValue(keys.RecipeStepVesselIDKey, recipeStepVesselID)
 	tracing.AttachToSpan(span, keys.RecipeStepVesselIDKey, recipeStepVesselID)
 
-	result, err := q.generatedQuerier.GetRecipeStepVessel(ctx, q.db, &generated.GetRecipeStepVesselParams{
+	result, err := q.generatedQuerier.GetRecipeStepVessel(ctx, q.dbFor(ctx), &generated.GetRecipeStepVesselParams{
 		RecipeStepID:       recipeStepID,
 		RecipeStepVesselID: recipeStepVesselID,
 		RecipeID:           recipeID,
@@ -175,7 +175,7 @@ func (q *Querier) GetRecipeStepVessels(ctx context.Context, recipeID, recipeStep
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeStepVessels(ctx, q.db, &generated.GetRecipeStepVesselsParams{
+	results, err := q.generatedQuerier.GetRecipeStepVessels(ctx, q.dbFor(ctx), &generated.GetRecipeStepVesselsParams{
 		RecipeID:      recipeID,
 		RecipeStepID:  recipeStepID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
@@ -268,7 +268,7 @@ func (q *Querier) getRecipeStepVesselsForRecipe(ctx context.Context, recipeID st
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeStepVesselsForRecipe(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.GetRecipeStepVesselsForRecipe(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting recipe step vessels for a recipe")
 	}
@@ -390,7 +390,7 @@ func (q *Querier) createRecipeStepVessel(ctx context.Context, querier database.S
 
 // CreateRecipeStepVessel creates a recipe step vessel in the database.
 func (q *Querier) CreateRecipeStepVessel(ctx context.Context, input *types.RecipeStepVesselDatabaseCreationInput) (*types.RecipeStepVessel, error) {
-	return q.createRecipeStepVessel(ctx, q.db, input)
+	return q.createRecipeStepVessel(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateRecipeStepVessel updates a particular recipe step vessel.
@@ -409,7 +409,7 @@ func (q *Querier) UpdateRecipeStepVessel(ctx context.Context, updated *types.Rec
 		vesselID = &updated.Vessel.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepVessel(ctx, q.db, &generated.UpdateRecipeStepVesselParams{
+	if _, err := q.generatedQuerier.UpdateRecipeStepVessel(ctx, q.dbFor(ctx), &generated.UpdateRecipeStepVesselParams{
 		Name:                 updated.Name,
 		Notes:                updated.Notes,
 		BelongsToRecipeStep:  updated.BelongsToRecipeStep,
@@ -448,7 +448,7 @@ func (q *Querier) ArchiveRecipeStepVessel(ctx context.Context, recipeStepID, rec
 	logger = logger.WithValue(keys.RecipeStepVesselIDKey, recipeStepVesselID)
 	tracing.AttachToSpan(span, keys.RecipeStepVesselIDKey, recipeStepVesselID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeStepVessel(ctx, q.db, &generated.ArchiveRecipeStepVesselParams{
+	if _, err := q.generatedQuerier.ArchiveRecipeStepVessel(ctx, q.dbFor(ctx), &generated.ArchiveRecipeStepVesselParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepVesselID,
 	}); err != nil {
@@ -34,7 +34,7 @@ func (q *Querier) RecipeStepExists(ctx context.Context, recipeID, recipeStepID s
 	logger = logger.WithValue(keys.RecipeStepIDKey, recipeStepID)
 	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
 
-	result, err := q.generatedQuerier.CheckRecipeStepExistence(ctx, q.db, &generated.CheckRecipeStepExistenceParams{
+	result, err := q.generatedQuerier.CheckRecipeStepExistence(ctx, q.dbFor(ctx), &generated.CheckRecipeStepExistenceParams{
 		RecipeID:     recipeID,
 		RecipeStepID: recipeStepID,
 	})
@@ -64,7 +64,7 @@ func (q *Querier) GetRecipeStep(ctx context.Context, recipeID, recipeStepID stri
 	logger = logger.WithValue(keys.RecipeStepIDKey, recipeStepID)
 	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
 
-	result, err := q.generatedQuerier.GetRecipeStep(ctx, q.db, &generated.GetRecipeStepParams{
+	result, err := q.generatedQuerier.GetRecipeStep(ctx, q.dbFor(ctx), &generated.GetRecipeStepParams{
 		RecipeID:     recipeID,
 		RecipeStepID: recipeStepID,
 	})
@@ -215,7 +215,7 @@ func (q *Querier) GetRecipeSteps(ctx context.Context, recipeID string, filter *t
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipeSteps(ctx, q.db, &generated.GetRecipeStepsParams{
+	results, err := q.generatedQuerier.GetRecipeSteps(ctx, q.dbFor(ctx), &generated.GetRecipeStepsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -387,7 +387,7 @@ func (q *Querier) createRecipeStep(ctx context.Context, db database.SQLQueryExec
 
 // CreateRecipeStep creates a recipe step in the database.
 func (q *Querier) CreateRecipeStep(ctx context.Context, input *types.RecipeStepDatabaseCreationInput) (*types.RecipeStep, error) {
-	return q.createRecipeStep(ctx, q.db, input)
+	return q.createRecipeStep(ctx, q.dbFor(ctx), input)
 }
 
 // UpdateRecipeStep updates a particular recipe step.
@@ -401,7 +401,7 @@ func (q *Querier) UpdateRecipeStep(ctx context.Context, updated *types.RecipeSte
 	logger := q.logger.WithValue(keys.RecipeStepIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.RecipeStepIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeStep(ctx, q.db, &generated.UpdateRecipeStepParams{
+	if _, err := q.generatedQuerier.UpdateRecipeStep(ctx, q.dbFor(ctx), &generated.UpdateRecipeStepParams{
 		ConditionExpression:           updated.ConditionExpression,
 		PreparationID:                 updated.Preparation.ID,
 		ID:                            updated.ID,
@@ -443,7 +443,7 @@ func (q *Querier) ArchiveRecipeStep(ctx context.Context, recipeID, recipeStepID
 	logger = logger.WithValue(keys.RecipeStepIDKey, recipeStepID)
 	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipeStep(ctx, q.db, &generated.ArchiveRecipeStepParams{
+	if _, err := q.generatedQuerier.ArchiveRecipeStep(ctx, q.dbFor(ctx), &generated.ArchiveRecipeStepParams{
 		BelongsToRecipe: recipeID,
 		ID:              recipeStepID,
 	}); err != nil {
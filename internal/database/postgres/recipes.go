@@ -28,7 +28,7 @@ func (q *Querier) RecipeExists(ctx context.Context, recipeID string) (exists boo
 	}
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	result, err := q.generatedQuerier.CheckRecipeExistence(ctx, q.db, recipeID)
+	result, err := q.generatedQuerier.CheckRecipeExistence(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return false, observability.PrepareError(err, span, "performing recipe existence check")
 	}
@@ -47,7 +47,7 @@ func (q *Querier) getRecipe(ctx context.Context, recipeID string) (*types.Recipe
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
 	var x *types.Recipe
-	results, err := q.generatedQuerier.GetRecipeByID(ctx, q.db, recipeID)
+	results, err := q.generatedQuerier.GetRecipeByID(ctx, q.dbFor(ctx), recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe")
 	}
@@ -240,7 +240,7 @@ func (q *Querier) GetRecipes(ctx context.Context, filter *types.QueryFilter) (x
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetRecipes(ctx, q.db, &generated.GetRecipesParams{
+	results, err := q.generatedQuerier.GetRecipes(ctx, q.dbFor(ctx), &generated.GetRecipesParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -279,6 +279,67 @@ func (q *Querier) GetRecipes(ctx context.Context, filter *types.QueryFilter) (x
 	return x, nil
 }
 
+// GetCookableRecipes fetches a list of recipes from the database that meet a particular filter and that a household has the instruments for.
+func (q *Querier) GetCookableRecipes(ctx context.Context, householdID string, filter *types.QueryFilter) (x *types.QueryFilteredResult[types.Recipe], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	x = &types.QueryFilteredResult[types.Recipe]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.GetCookableRecipes(ctx, q.dbFor(ctx), &generated.GetCookableRecipesParams{
+		HouseholdID:   householdID,
+		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:  database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		QueryOffset:   database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:    database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing cookable recipes list retrieval query")
+	}
+
+	for _, result := range results {
+		x.Data = append(x.Data, &types.Recipe{
+			CreatedAt:                result.CreatedAt,
+			InspiredByRecipeID:       database.StringPointerFromNullString(result.InspiredByRecipeID),
+			LastUpdatedAt:            database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ArchivedAt:               database.TimePointerFromNullTime(result.ArchivedAt),
+			MaximumEstimatedPortions: database.Float32PointerFromNullString(result.MaxEstimatedPortions),
+			PluralPortionName:        result.PluralPortionName,
+			Description:              result.Description,
+			Name:                     result.Name,
+			PortionName:              result.PortionName,
+			ID:                       result.ID,
+			CreatedByUser:            result.CreatedByUser,
+			Source:                   result.Source,
+			Slug:                     result.Slug,
+			YieldsComponentType:      string(result.YieldsComponentType),
+			MinimumEstimatedPortions: database.Float32FromString(result.MinEstimatedPortions),
+			SealOfApproval:           result.SealOfApproval,
+			EligibleForMeals:         result.EligibleForMeals,
+		})
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
 // GetRecipesWithIDs fetches a list of recipes from the database that meet a particular filter.
 func (q *Querier) GetRecipesWithIDs(ctx context.Context, ids []string) ([]*types.Recipe, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -304,7 +365,7 @@ func (q *Querier) GetRecipeIDsThatNeedSearchIndexing(ctx context.Context) ([]str
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetRecipesNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetRecipesNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing recipes list retrieval query")
 	}
@@ -329,7 +390,7 @@ func (q *Querier) SearchForRecipes(ctx context.Context, recipeNameQuery string,
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.RecipeSearch(ctx, q.db, &generated.RecipeSearchParams{
+	results, err := q.generatedQuerier.RecipeSearch(ctx, q.dbFor(ctx), &generated.RecipeSearchParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -369,6 +430,68 @@ func (q *Querier) SearchForRecipes(ctx context.Context, recipeNameQuery string,
 	return x, nil
 }
 
+// SearchForCookableRecipes fetches a list of recipes from the database that match a query and that a household has the instruments for.
+func (q *Querier) SearchForCookableRecipes(ctx context.Context, recipeNameQuery, householdID string, filter *types.QueryFilter) (x *types.QueryFilteredResult[types.Recipe], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	x = &types.QueryFilteredResult[types.Recipe]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.CookableRecipeSearch(ctx, q.dbFor(ctx), &generated.CookableRecipeSearchParams{
+		HouseholdID:   householdID,
+		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:  database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		QueryOffset:   database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:    database.NullInt32FromUint8Pointer(filter.Limit),
+		Query:         recipeNameQuery,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing cookable recipes search query")
+	}
+
+	for _, result := range results {
+		x.Data = append(x.Data, &types.Recipe{
+			CreatedAt:                result.CreatedAt,
+			InspiredByRecipeID:       database.StringPointerFromNullString(result.InspiredByRecipeID),
+			LastUpdatedAt:            database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ArchivedAt:               database.TimePointerFromNullTime(result.ArchivedAt),
+			MaximumEstimatedPortions: database.Float32PointerFromNullString(result.MaxEstimatedPortions),
+			PluralPortionName:        result.PluralPortionName,
+			Description:              result.Description,
+			Name:                     result.Name,
+			PortionName:              result.PortionName,
+			ID:                       result.ID,
+			CreatedByUser:            result.CreatedByUser,
+			Source:                   result.Source,
+			Slug:                     result.Slug,
+			YieldsComponentType:      string(result.YieldsComponentType),
+			MinimumEstimatedPortions: database.Float32FromString(result.MinEstimatedPortions),
+			SealOfApproval:           result.SealOfApproval,
+			EligibleForMeals:         result.EligibleForMeals,
+		})
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
 // CreateRecipe creates a recipe in the database.
 func (q *Querier) CreateRecipe(ctx context.Context, input *types.RecipeDatabaseCreationInput) (*types.Recipe, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -380,7 +503,7 @@ func (q *Querier) CreateRecipe(ctx context.Context, input *types.RecipeDatabaseC
 	logger := q.logger.WithValue(keys.RecipeIDKey, input.ID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, input.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -548,7 +671,7 @@ func (q *Querier) UpdateRecipe(ctx context.Context, updated *types.Recipe) error
 	tracing.AttachToSpan(span, keys.RecipeIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.UserIDKey, updated.CreatedByUser)
 
-	if _, err := q.generatedQuerier.UpdateRecipe(ctx, q.db, &generated.UpdateRecipeParams{
+	if _, err := q.generatedQuerier.UpdateRecipe(ctx, q.dbFor(ctx), &generated.UpdateRecipeParams{
 		Name:                 updated.Name,
 		Slug:                 updated.Slug,
 		Source:               updated.Source,
@@ -585,7 +708,7 @@ func (q *Querier) MarkRecipeAsIndexed(ctx context.Context, recipeID string) erro
 	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeLastIndexedAt(ctx, q.db, recipeID); err != nil {
+	if _, err := q.generatedQuerier.UpdateRecipeLastIndexedAt(ctx, q.dbFor(ctx), recipeID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking recipe as indexed")
 	}
 
@@ -611,7 +734,7 @@ func (q *Querier) ArchiveRecipe(ctx context.Context, recipeID, userID string) er
 	logger = logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	if _, err := q.generatedQuerier.ArchiveRecipe(ctx, q.db, &generated.ArchiveRecipeParams{
+	if _, err := q.generatedQuerier.ArchiveRecipe(ctx, q.dbFor(ctx), &generated.ArchiveRecipeParams{
 		CreatedByUser: userID,
 		ID:            recipeID,
 	}); err != nil {
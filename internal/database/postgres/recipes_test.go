@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
@@ -205,6 +206,49 @@ func TestQuerier_Integration_Recipes(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, searchResults)
 
+	// cookable recipes
+	householdID, err := dbc.GetDefaultHouseholdIDForUser(ctx, user.ID)
+	require.NoError(t, err)
+
+	exampleRecipe = buildRecipeForTestCreation(t, ctx, user.ID, dbc)
+	for _, step := range exampleRecipe.Steps {
+		step.Optional = false
+		for _, instrument := range step.Instruments {
+			instrument.Optional = false
+			instrument.MinimumQuantity = 1
+		}
+	}
+	cookableRecipe := createRecipeForTest(t, ctx, exampleRecipe, dbc, false)
+	createdRecipes = append(createdRecipes, cookableRecipe)
+
+	containsRecipe := func(results *types.QueryFilteredResult[types.Recipe], recipeID string) bool {
+		return slices.ContainsFunc(results.Data, func(r *types.Recipe) bool { return r.ID == recipeID })
+	}
+
+	cookable, err := dbc.GetCookableRecipes(ctx, householdID, nil)
+	require.NoError(t, err)
+	assert.False(t, containsRecipe(cookable, cookableRecipe.ID))
+	assert.Equal(t, uint64(len(cookable.Data)), cookable.FilteredCount)
+
+	for _, step := range cookableRecipe.Steps {
+		for _, instrument := range step.Instruments {
+			ownership := fakes.BuildFakeHouseholdInstrumentOwnership()
+			ownership.BelongsToHousehold = householdID
+			ownership.Instrument = *instrument.Instrument
+			ownership.Quantity = 1
+			createHouseholdInstrumentOwnershipForTest(t, ctx, ownership, dbc)
+		}
+	}
+
+	cookable, err = dbc.GetCookableRecipes(ctx, householdID, nil)
+	require.NoError(t, err)
+	assert.True(t, containsRecipe(cookable, cookableRecipe.ID))
+	assert.Equal(t, uint64(len(cookable.Data)), cookable.FilteredCount)
+
+	cookable, err = dbc.SearchForCookableRecipes(ctx, cookableRecipe.Name, householdID, nil)
+	require.NoError(t, err)
+	assert.True(t, containsRecipe(cookable, cookableRecipe.ID))
+
 	byIDs, err := dbc.GetRecipesWithIDs(ctx, []string{createdRecipes[0].ID})
 	assert.NoError(t, err)
 	assert.NotEmpty(t, byIDs)
@@ -222,6 +266,38 @@ func TestQuerier_Integration_Recipes(t *testing.T) {
 	}
 }
 
+func TestQuerier_GetCookableRecipes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetCookableRecipes(ctx, "", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_SearchForCookableRecipes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c, _ := buildTestClient(t)
+
+		actual, err := c.SearchForCookableRecipes(ctx, t.Name(), "", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
 func TestQuerier_RecipeExists(T *testing.T) {
 	T.Parallel()
 
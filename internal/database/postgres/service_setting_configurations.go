@@ -34,7 +34,7 @@ func (q *Querier) ServiceSettingConfigurationExists(ctx context.Context, service
 	logger = logger.WithValue(keys.ServiceSettingConfigurationIDKey, serviceSettingConfigurationID)
 	tracing.AttachToSpan(span, keys.ServiceSettingConfigurationIDKey, serviceSettingConfigurationID)
 
-	result, err := q.generatedQuerier.CheckServiceSettingConfigurationExistence(ctx, q.db, serviceSettingConfigurationID)
+	result, err := q.generatedQuerier.CheckServiceSettingConfigurationExistence(ctx, q.dbFor(ctx), serviceSettingConfigurationID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing service setting configuration existence check")
 	}
@@ -55,7 +55,7 @@ func (q *Querier) GetServiceSettingConfiguration(ctx context.Context, serviceSet
 	tracing.AttachToSpan(span, keys.ServiceSettingConfigurationIDKey, serviceSettingConfigurationID)
 	logger = logger.WithValue(keys.ServiceSettingConfigurationIDKey, serviceSettingConfigurationID)
 
-	result, err := q.generatedQuerier.GetServiceSettingConfigurationByID(ctx, q.db, serviceSettingConfigurationID)
+	result, err := q.generatedQuerier.GetServiceSettingConfigurationByID(ctx, q.dbFor(ctx), serviceSettingConfigurationID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching service setting configuration")
 	}
@@ -112,7 +112,7 @@ func (q *Querier) GetServiceSettingConfigurationForUserByName(ctx context.Contex
 	logger = logger.WithValue(keys.ServiceSettingNameKey, settingName)
 	tracing.AttachToSpan(span, keys.ServiceSettingNameKey, settingName)
 
-	result, err := q.generatedQuerier.GetServiceSettingConfigurationForUserBySettingName(ctx, q.db, &generated.GetServiceSettingConfigurationForUserBySettingNameParams{
+	result, err := q.generatedQuerier.GetServiceSettingConfigurationForUserBySettingName(ctx, q.dbFor(ctx), &generated.GetServiceSettingConfigurationForUserBySettingNameParams{
 		Name:          settingName,
 		BelongsToUser: userID,
 	})
@@ -172,7 +172,7 @@ func (q *Querier) GetServiceSettingConfigurationForHouseholdByName(ctx context.C
 	logger = logger.WithValue(keys.ServiceSettingNameKey, settingName)
 	tracing.AttachToSpan(span, keys.ServiceSettingNameKey, settingName)
 
-	result, err := q.generatedQuerier.GetServiceSettingConfigurationForHouseholdBySettingName(ctx, q.db, &generated.GetServiceSettingConfigurationForHouseholdBySettingNameParams{
+	result, err := q.generatedQuerier.GetServiceSettingConfigurationForHouseholdBySettingName(ctx, q.dbFor(ctx), &generated.GetServiceSettingConfigurationForHouseholdBySettingNameParams{
 		Name:               settingName,
 		BelongsToHousehold: householdID,
 	})
@@ -237,7 +237,7 @@ func (q *Querier) GetServiceSettingConfigurationsForUser(ctx context.Context, us
 	}
 
 	// TODO: properly apply query filter to this
-	results, err := q.generatedQuerier.GetServiceSettingConfigurationsForUser(ctx, q.db, userID)
+	results, err := q.generatedQuerier.GetServiceSettingConfigurationsForUser(ctx, q.dbFor(ctx), userID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing service setting configurations list retrieval query")
 	}
@@ -303,7 +303,7 @@ func (q *Querier) GetServiceSettingConfigurationsForHousehold(ctx context.Contex
 	}
 
 	// TODO: properly apply query filter to this
-	results, err := q.generatedQuerier.GetServiceSettingConfigurationsForHousehold(ctx, q.db, householdID)
+	results, err := q.generatedQuerier.GetServiceSettingConfigurationsForHousehold(ctx, q.dbFor(ctx), householdID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing service setting configurations list retrieval query")
 	}
@@ -357,13 +357,13 @@ func (q *Querier) CreateServiceSettingConfiguration(ctx context.Context, input *
 	logger := q.logger.WithValue(keys.ServiceSettingConfigurationIDKey, input.ID)
 
 	// begin household creation transaction
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
 	// create the service setting configuration.
-	if err = q.generatedQuerier.CreateServiceSettingConfiguration(ctx, q.db, &generated.CreateServiceSettingConfigurationParams{
+	if err = q.generatedQuerier.CreateServiceSettingConfiguration(ctx, q.dbFor(ctx), &generated.CreateServiceSettingConfigurationParams{
 		ID:                 input.ID,
 		Value:              input.Value,
 		Notes:              input.Notes,
@@ -418,12 +418,12 @@ func (q *Querier) UpdateServiceSettingConfiguration(ctx context.Context, updated
 	tracing.AttachToSpan(span, keys.ServiceSettingConfigurationIDKey, updated.ID)
 
 	// begin household creation transaction
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
-	if _, err = q.generatedQuerier.UpdateServiceSettingConfiguration(ctx, q.db, &generated.UpdateServiceSettingConfigurationParams{
+	if _, err = q.generatedQuerier.UpdateServiceSettingConfiguration(ctx, q.dbFor(ctx), &generated.UpdateServiceSettingConfigurationParams{
 		Value:              updated.Value,
 		Notes:              updated.Notes,
 		ServiceSettingID:   updated.ServiceSetting.ID,
@@ -470,12 +470,12 @@ func (q *Querier) ArchiveServiceSettingConfiguration(ctx context.Context, servic
 	tracing.AttachToSpan(span, keys.ServiceSettingConfigurationIDKey, serviceSettingConfigurationID)
 
 	// begin household creation transaction
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
-	if _, err = q.generatedQuerier.ArchiveServiceSettingConfiguration(ctx, q.db, serviceSettingConfigurationID); err != nil {
+	if _, err = q.generatedQuerier.ArchiveServiceSettingConfiguration(ctx, q.dbFor(ctx), serviceSettingConfigurationID); err != nil {
 		q.rollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "archiving service setting configuration")
 	}
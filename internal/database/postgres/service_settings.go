@@ -35,7 +35,7 @@ func (q *Querier) ServiceSettingExists(ctx context.Context, serviceSettingID str
 	logger = logger.WithValue(keys.ServiceSettingIDKey, serviceSettingID)
 	tracing.AttachToSpan(span, keys.ServiceSettingIDKey, serviceSettingID)
 
-	result, err := q.generatedQuerier.CheckServiceSettingExistence(ctx, q.db, serviceSettingID)
+	result, err := q.generatedQuerier.CheckServiceSettingExistence(ctx, q.dbFor(ctx), serviceSettingID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing service setting existence check")
 	}
@@ -56,7 +56,7 @@ func (q *Querier) GetServiceSetting(ctx context.Context, serviceSettingID string
 	logger = logger.WithValue(keys.ServiceSettingIDKey, serviceSettingID)
 	tracing.AttachToSpan(span, keys.ServiceSettingIDKey, serviceSettingID)
 
-	result, err := q.generatedQuerier.GetServiceSetting(ctx, q.db, serviceSettingID)
+	result, err := q.generatedQuerier.GetServiceSetting(ctx, q.dbFor(ctx), serviceSettingID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing service setting fetch")
 	}
@@ -97,7 +97,7 @@ func (q *Querier) SearchForServiceSettings(ctx context.Context, query string) ([
 	logger = logger.WithValue(keys.SearchQueryKey, query)
 	tracing.AttachToSpan(span, keys.ServiceSettingIDKey, query)
 
-	results, err := q.generatedQuerier.SearchForServiceSettings(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForServiceSettings(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing service settings list retrieval query")
 	}
@@ -148,7 +148,7 @@ func (q *Querier) GetServiceSettings(ctx context.Context, filter *types.QueryFil
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetServiceSettings(ctx, q.db, &generated.GetServiceSettingsParams{
+	results, err := q.generatedQuerier.GetServiceSettings(ctx, q.dbFor(ctx), &generated.GetServiceSettingsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -199,7 +199,7 @@ func (q *Querier) CreateServiceSetting(ctx context.Context, input *types.Service
 	tracing.AttachToSpan(span, keys.ServiceSettingIDKey, input.ID)
 	logger := q.logger.WithValue(keys.ServiceSettingIDKey, input.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -261,12 +261,12 @@ func (q *Querier) ArchiveServiceSetting(ctx context.Context, serviceSettingID st
 	logger = logger.WithValue(keys.ServiceSettingIDKey, serviceSettingID)
 	tracing.AttachToSpan(span, keys.ServiceSettingIDKey, serviceSettingID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
-	if _, err = q.generatedQuerier.ArchiveServiceSetting(ctx, q.db, serviceSettingID); err != nil {
+	if _, err = q.generatedQuerier.ArchiveServiceSetting(ctx, q.dbFor(ctx), serviceSettingID); err != nil {
 		q.rollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating service setting")
 	}
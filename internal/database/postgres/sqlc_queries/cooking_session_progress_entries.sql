@@ -0,0 +1,31 @@
+-- name: CreateCookingSessionProgressEntry :exec
+
+INSERT INTO cooking_session_progress_entries (
+	id,
+	progress_type,
+	subject,
+	value,
+	recorded_by_user,
+	belongs_to_cooking_session
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(progress_type),
+	sqlc.arg(subject),
+	sqlc.arg(value),
+	sqlc.arg(recorded_by_user),
+	sqlc.arg(belongs_to_cooking_session)
+) ON CONFLICT DO NOTHING;
+
+-- name: GetCookingSessionProgressEntries :many
+
+SELECT
+	cooking_session_progress_entries.id,
+	cooking_session_progress_entries.progress_type,
+	cooking_session_progress_entries.subject,
+	cooking_session_progress_entries.value,
+	cooking_session_progress_entries.recorded_by_user,
+	cooking_session_progress_entries.created_at,
+	cooking_session_progress_entries.belongs_to_cooking_session
+FROM cooking_session_progress_entries
+WHERE cooking_session_progress_entries.belongs_to_cooking_session = sqlc.arg(belongs_to_cooking_session)
+ORDER BY cooking_session_progress_entries.created_at, cooking_session_progress_entries.id;
@@ -0,0 +1,122 @@
+-- name: ArchiveCookingSession :execrows
+
+UPDATE cooking_sessions SET
+	archived_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND belongs_to_household = sqlc.arg(belongs_to_household);
+
+-- name: CreateCookingSession :exec
+
+INSERT INTO cooking_sessions (
+	id,
+	recipe_id,
+	belongs_to_household,
+	created_by_user
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(recipe_id),
+	sqlc.arg(belongs_to_household),
+	sqlc.arg(created_by_user)
+);
+
+-- name: GetCookingSession :one
+
+SELECT
+	cooking_sessions.id,
+	cooking_sessions.recipe_id,
+	cooking_sessions.belongs_to_household,
+	cooking_sessions.created_by_user,
+	cooking_sessions.created_at,
+	cooking_sessions.last_updated_at,
+	cooking_sessions.completed_at,
+	cooking_sessions.archived_at
+FROM cooking_sessions
+WHERE cooking_sessions.archived_at IS NULL
+	AND cooking_sessions.id = sqlc.arg(id)
+	AND cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household);
+
+-- name: GetCookingSessionForUpdate :one
+
+SELECT
+	cooking_sessions.id,
+	cooking_sessions.recipe_id,
+	cooking_sessions.belongs_to_household,
+	cooking_sessions.created_by_user,
+	cooking_sessions.created_at,
+	cooking_sessions.last_updated_at,
+	cooking_sessions.completed_at,
+	cooking_sessions.archived_at
+FROM cooking_sessions
+WHERE cooking_sessions.archived_at IS NULL
+	AND cooking_sessions.id = sqlc.arg(id)
+	AND cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)
+FOR UPDATE;
+
+-- name: GetCookingSessionsForHousehold :many
+
+SELECT
+	cooking_sessions.id,
+	cooking_sessions.recipe_id,
+	cooking_sessions.belongs_to_household,
+	cooking_sessions.created_by_user,
+	cooking_sessions.created_at,
+	cooking_sessions.last_updated_at,
+	cooking_sessions.completed_at,
+	cooking_sessions.archived_at,
+	(
+		SELECT COUNT(cooking_sessions.id)
+		FROM cooking_sessions
+		WHERE cooking_sessions.archived_at IS NULL
+			AND cooking_sessions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND cooking_sessions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				cooking_sessions.last_updated_at IS NULL
+				OR cooking_sessions.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				cooking_sessions.last_updated_at IS NULL
+				OR cooking_sessions.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+			AND cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)
+	) AS filtered_count,
+	(
+		SELECT COUNT(cooking_sessions.id)
+		FROM cooking_sessions
+		WHERE cooking_sessions.archived_at IS NULL
+			AND cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)
+	) AS total_count
+FROM cooking_sessions
+WHERE
+	cooking_sessions.archived_at IS NULL
+	AND cooking_sessions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND cooking_sessions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		cooking_sessions.last_updated_at IS NULL
+		OR cooking_sessions.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		cooking_sessions.last_updated_at IS NULL
+		OR cooking_sessions.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+	AND cooking_sessions.belongs_to_household = sqlc.arg(belongs_to_household)
+ORDER BY cooking_sessions.created_at DESC
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: MarkCookingSessionAsCompleted :execrows
+
+UPDATE cooking_sessions SET
+	completed_at = NOW(),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND completed_at IS NULL
+	AND id = sqlc.arg(id)
+	AND belongs_to_household = sqlc.arg(belongs_to_household);
+
+-- name: MarkCookingSessionAsUpdated :exec
+
+UPDATE cooking_sessions SET
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
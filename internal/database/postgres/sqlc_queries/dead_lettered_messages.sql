@@ -0,0 +1,76 @@
+-- name: CreateDeadLetteredMessage :exec
+
+INSERT INTO dead_lettered_messages (
+	id,
+	topic,
+	dead_letter_topic,
+	payload,
+	last_error,
+	attempts
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(topic),
+	sqlc.arg(dead_letter_topic),
+	sqlc.arg(payload),
+	sqlc.arg(last_error),
+	sqlc.arg(attempts)
+);
+
+-- name: GetDeadLetteredMessage :one
+
+SELECT
+	dead_lettered_messages.id,
+	dead_lettered_messages.topic,
+	dead_lettered_messages.dead_letter_topic,
+	dead_lettered_messages.payload,
+	dead_lettered_messages.last_error,
+	dead_lettered_messages.attempts,
+	dead_lettered_messages.created_at,
+	dead_lettered_messages.replayed_at
+FROM dead_lettered_messages
+WHERE dead_lettered_messages.id = sqlc.arg(id);
+
+-- name: GetOutstandingDeadLetteredMessages :many
+
+SELECT
+	dead_lettered_messages.id,
+	dead_lettered_messages.topic,
+	dead_lettered_messages.dead_letter_topic,
+	dead_lettered_messages.payload,
+	dead_lettered_messages.last_error,
+	dead_lettered_messages.attempts,
+	dead_lettered_messages.created_at,
+	dead_lettered_messages.replayed_at,
+	(
+		SELECT COUNT(dead_lettered_messages.id)
+		FROM dead_lettered_messages
+		WHERE dead_lettered_messages.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND dead_lettered_messages.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND dead_lettered_messages.replayed_at IS NULL
+	) AS filtered_count,
+	(
+		SELECT COUNT(dead_lettered_messages.id)
+		FROM dead_lettered_messages
+		WHERE
+			dead_lettered_messages.replayed_at IS NULL
+	) AS total_count
+FROM dead_lettered_messages
+WHERE dead_lettered_messages.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND dead_lettered_messages.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND dead_lettered_messages.replayed_at IS NULL
+ORDER BY dead_lettered_messages.created_at DESC
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: MarkDeadLetteredMessageAsReplayed :execrows
+
+UPDATE dead_lettered_messages SET
+	replayed_at = NOW()
+WHERE replayed_at IS NULL
+	AND id = sqlc.arg(id);
+
+-- name: UnmarkDeadLetteredMessageAsReplayed :exec
+
+UPDATE dead_lettered_messages SET
+	replayed_at = NULL
+WHERE id = sqlc.arg(id);
@@ -61,6 +61,8 @@ SELECT
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -130,6 +132,8 @@ SELECT
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -199,6 +203,8 @@ SELECT
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -268,6 +274,8 @@ SELECT
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
@@ -368,6 +376,8 @@ SELECT
 	households.longitude as household_longitude,
 	households.last_payment_provider_sync_occurred_at as household_last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret as household_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret as household_previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at as household_previous_webhook_hmac_secret_expires_at,
 	households.created_at as household_created_at,
 	households.last_updated_at as household_last_updated_at,
 	households.archived_at as household_archived_at,
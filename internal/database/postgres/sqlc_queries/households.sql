@@ -76,6 +76,8 @@ SELECT
 	households.longitude,
 	households.last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret,
+	households.previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at,
 	households.created_at,
 	households.last_updated_at,
 	households.archived_at,
@@ -138,6 +140,8 @@ SELECT
 	households.longitude,
 	households.last_payment_provider_sync_occurred_at,
 	households.webhook_hmac_secret,
+	households.previous_webhook_hmac_secret,
+	households.previous_webhook_hmac_secret_expires_at,
 	households.created_at,
 	households.last_updated_at,
 	households.archived_at,
@@ -182,6 +186,23 @@ WHERE households.archived_at IS NULL
 LIMIT sqlc.narg(query_limit)
 OFFSET sqlc.narg(query_offset);
 
+-- name: RotateHouseholdWebhookEncryptionKey :execrows
+
+UPDATE households SET
+	previous_webhook_hmac_secret = webhook_hmac_secret,
+	previous_webhook_hmac_secret_expires_at = sqlc.arg(previous_webhook_hmac_secret_expires_at),
+	webhook_hmac_secret = sqlc.arg(webhook_hmac_secret),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND (previous_webhook_hmac_secret_expires_at IS NULL OR previous_webhook_hmac_secret_expires_at <= NOW())
+	AND id = sqlc.arg(id)
+	AND id IN (
+		SELECT household_user_memberships.belongs_to_household
+		FROM household_user_memberships
+		WHERE household_user_memberships.archived_at IS NULL
+			AND household_user_memberships.belongs_to_user = sqlc.arg(belongs_to_user)
+	);
+
 -- name: UpdateHousehold :execrows
 
 UPDATE households SET
@@ -200,6 +221,18 @@ WHERE archived_at IS NULL
 	AND belongs_to_user = sqlc.arg(belongs_to_user)
 	AND id = sqlc.arg(id);
 
+-- name: UpdateHouseholdBillingStatus :execrows
+
+UPDATE households SET
+	billing_status = sqlc.arg(billing_status),
+	payment_processor_customer_id = sqlc.arg(payment_processor_customer_id),
+	subscription_plan_id = sqlc.narg(subscription_plan_id),
+	last_payment_provider_sync_occurred_at = sqlc.arg(occurred_at),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND (last_payment_provider_sync_occurred_at IS NULL OR last_payment_provider_sync_occurred_at <= sqlc.arg(occurred_at))
+	AND id = sqlc.arg(id);
+
 -- name: UpdateHouseholdWebhookEncryptionKey :execrows
 
 UPDATE households SET
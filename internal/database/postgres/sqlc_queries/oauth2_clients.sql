@@ -12,13 +12,19 @@ INSERT INTO oauth2_clients (
 	name,
 	description,
 	client_id,
-	client_secret
+	client_secret,
+	domain,
+	belongs_to_household,
+	scopes
 ) VALUES (
 	sqlc.arg(id),
 	sqlc.arg(name),
 	sqlc.arg(description),
 	sqlc.arg(client_id),
-	sqlc.arg(client_secret)
+	sqlc.arg(client_secret),
+	sqlc.arg(domain),
+	sqlc.arg(belongs_to_household),
+	sqlc.arg(scopes)
 );
 
 -- name: GetOAuth2ClientByClientID :one
@@ -30,7 +36,10 @@ SELECT
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
 	oauth2_clients.created_at,
-	oauth2_clients.archived_at
+	oauth2_clients.archived_at,
+	oauth2_clients.domain,
+	oauth2_clients.belongs_to_household,
+	oauth2_clients.scopes
 FROM oauth2_clients
 WHERE oauth2_clients.archived_at IS NULL
 	AND oauth2_clients.client_id = sqlc.arg(client_id);
@@ -44,7 +53,10 @@ SELECT
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
 	oauth2_clients.created_at,
-	oauth2_clients.archived_at
+	oauth2_clients.archived_at,
+	oauth2_clients.domain,
+	oauth2_clients.belongs_to_household,
+	oauth2_clients.scopes
 FROM oauth2_clients
 WHERE oauth2_clients.archived_at IS NULL
 	AND oauth2_clients.id = sqlc.arg(id);
@@ -59,6 +71,9 @@ SELECT
 	oauth2_clients.client_secret,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at,
+	oauth2_clients.domain,
+	oauth2_clients.belongs_to_household,
+	oauth2_clients.scopes,
 	(
 		SELECT COUNT(oauth2_clients.id)
 		FROM oauth2_clients
@@ -0,0 +1,103 @@
+-- name: CreateOutboxMessage :exec
+
+INSERT INTO outbox_messages (
+	id,
+	topic,
+	idempotency_key,
+	payload,
+	event_type,
+	belongs_to_household
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(topic),
+	sqlc.arg(idempotency_key),
+	sqlc.arg(payload),
+	sqlc.arg(event_type),
+	sqlc.arg(belongs_to_household)
+);
+
+-- name: ClaimPendingOutboxMessages :many
+
+UPDATE outbox_messages SET
+	claimed_until = sqlc.arg(claimed_until),
+	attempts = attempts + 1
+WHERE id IN (
+	SELECT id
+	FROM outbox_messages
+	WHERE published_at IS NULL
+		AND (claimed_until IS NULL OR claimed_until < NOW())
+	ORDER BY created_at
+	LIMIT sqlc.arg(batch_size)
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING
+	id,
+	topic,
+	idempotency_key,
+	payload,
+	attempts,
+	last_error,
+	created_at,
+	claimed_until,
+	published_at;
+
+-- name: MarkOutboxMessageAsPublished :execrows
+
+UPDATE outbox_messages SET
+	published_at = NOW(),
+	claimed_until = NULL
+WHERE published_at IS NULL
+	AND id = sqlc.arg(id);
+
+-- name: MarkOutboxMessageAsFailed :execrows
+
+UPDATE outbox_messages SET
+	last_error = sqlc.arg(last_error)
+WHERE published_at IS NULL
+	AND id = sqlc.arg(id);
+
+-- name: GetOutboxLag :one
+
+SELECT
+	COUNT(outbox_messages.id) AS pending_messages,
+	COALESCE(EXTRACT(EPOCH FROM (NOW() - MIN(outbox_messages.created_at))), 0)::BIGINT AS oldest_pending_age_seconds
+FROM outbox_messages
+WHERE outbox_messages.published_at IS NULL;
+
+-- name: DeletePublishedOutboxMessages :execrows
+
+DELETE FROM outbox_messages
+WHERE published_at IS NOT NULL
+	AND published_at < sqlc.arg(published_before);
+
+-- name: GetHouseholdEvents :many
+
+SELECT
+	outbox_messages.transaction_id,
+	outbox_messages.sequence,
+	outbox_messages.event_type,
+	outbox_messages.payload,
+	outbox_messages.created_at
+FROM outbox_messages
+WHERE outbox_messages.belongs_to_household = sqlc.arg(belongs_to_household)
+	AND (outbox_messages.transaction_id, outbox_messages.sequence) > (sqlc.arg(after_transaction_id)::BIGINT, sqlc.arg(after_sequence)::BIGINT)
+	AND outbox_messages.transaction_id < pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT
+ORDER BY outbox_messages.transaction_id, outbox_messages.sequence
+LIMIT sqlc.arg(result_limit);
+
+-- name: GetHouseholdEventHorizon :one
+
+SELECT pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT AS horizon;
+
+-- name: CheckHouseholdEventCursorRetention :one
+
+SELECT EXISTS (
+	SELECT outbox_messages.id
+	FROM outbox_messages
+	WHERE outbox_messages.belongs_to_household = sqlc.arg(belongs_to_household)
+		AND outbox_messages.transaction_id = sqlc.arg(transaction_id)::BIGINT
+		AND outbox_messages.sequence = sqlc.arg(sequence)::BIGINT
+) OR (
+	sqlc.arg(sequence)::BIGINT = 0
+	AND sqlc.arg(transaction_id)::BIGINT >= COALESCE((SELECT MIN(outbox_messages.transaction_id) FROM outbox_messages), pg_snapshot_xmin(pg_current_snapshot())::TEXT::BIGINT)
+) AS retained;
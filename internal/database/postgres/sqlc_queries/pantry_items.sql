@@ -0,0 +1,341 @@
+-- name: ArchivePantryItem :execrows
+
+UPDATE pantry_items SET
+	archived_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND belongs_to_household = sqlc.arg(belongs_to_household);
+
+-- name: CreatePantryItem :exec
+
+INSERT INTO pantry_items (
+	id,
+	valid_ingredient,
+	valid_measurement_unit,
+	quantity,
+	storage_location,
+	notes,
+	expires_at,
+	belongs_to_household
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(valid_ingredient),
+	sqlc.arg(valid_measurement_unit),
+	sqlc.arg(quantity),
+	sqlc.arg(storage_location),
+	sqlc.arg(notes),
+	sqlc.arg(expires_at),
+	sqlc.arg(belongs_to_household)
+);
+
+-- name: ArchivePantryItemForGroceryListItem :one
+
+UPDATE pantry_items SET
+	archived_at = NOW()
+WHERE archived_at IS NULL
+	AND source_grocery_list_item = sqlc.arg(source_grocery_list_item)
+	AND belongs_to_household = sqlc.arg(belongs_to_household)
+RETURNING id;
+
+-- name: CreatePantryItemForGroceryListItem :execrows
+
+INSERT INTO pantry_items (
+	id,
+	valid_ingredient,
+	valid_measurement_unit,
+	quantity,
+	storage_location,
+	notes,
+	expires_at,
+	belongs_to_household,
+	source_grocery_list_item
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(valid_ingredient),
+	sqlc.arg(valid_measurement_unit),
+	sqlc.arg(quantity),
+	sqlc.arg(storage_location),
+	sqlc.arg(notes),
+	sqlc.arg(expires_at),
+	sqlc.arg(belongs_to_household),
+	sqlc.arg(source_grocery_list_item)
+) ON CONFLICT (source_grocery_list_item) WHERE archived_at IS NULL DO NOTHING;
+
+-- name: CheckPantryItemExistence :one
+
+SELECT EXISTS (
+	SELECT pantry_items.id
+	FROM pantry_items
+	WHERE pantry_items.archived_at IS NULL
+		AND pantry_items.id = sqlc.arg(id)
+		AND pantry_items.belongs_to_household = sqlc.arg(belongs_to_household)
+);
+
+-- name: GetPantryItems :many
+
+SELECT
+	pantry_items.id,
+	valid_ingredients.id as valid_ingredient_id,
+	valid_ingredients.name as valid_ingredient_name,
+	valid_ingredients.description as valid_ingredient_description,
+	valid_ingredients.warning as valid_ingredient_warning,
+	valid_ingredients.contains_egg as valid_ingredient_contains_egg,
+	valid_ingredients.contains_dairy as valid_ingredient_contains_dairy,
+	valid_ingredients.contains_peanut as valid_ingredient_contains_peanut,
+	valid_ingredients.contains_tree_nut as valid_ingredient_contains_tree_nut,
+	valid_ingredients.contains_soy as valid_ingredient_contains_soy,
+	valid_ingredients.contains_wheat as valid_ingredient_contains_wheat,
+	valid_ingredients.contains_shellfish as valid_ingredient_contains_shellfish,
+	valid_ingredients.contains_sesame as valid_ingredient_contains_sesame,
+	valid_ingredients.contains_fish as valid_ingredient_contains_fish,
+	valid_ingredients.contains_gluten as valid_ingredient_contains_gluten,
+	valid_ingredients.animal_flesh as valid_ingredient_animal_flesh,
+	valid_ingredients.volumetric as valid_ingredient_volumetric,
+	valid_ingredients.is_liquid as valid_ingredient_is_liquid,
+	valid_ingredients.icon_path as valid_ingredient_icon_path,
+	valid_ingredients.animal_derived as valid_ingredient_animal_derived,
+	valid_ingredients.plural_name as valid_ingredient_plural_name,
+	valid_ingredients.restrict_to_preparations as valid_ingredient_restrict_to_preparations,
+	valid_ingredients.minimum_ideal_storage_temperature_in_celsius as valid_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients.maximum_ideal_storage_temperature_in_celsius as valid_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients.storage_instructions as valid_ingredient_storage_instructions,
+	valid_ingredients.slug as valid_ingredient_slug,
+	valid_ingredients.contains_alcohol as valid_ingredient_contains_alcohol,
+	valid_ingredients.shopping_suggestions as valid_ingredient_shopping_suggestions,
+	valid_ingredients.is_starch as valid_ingredient_is_starch,
+	valid_ingredients.is_protein as valid_ingredient_is_protein,
+	valid_ingredients.is_grain as valid_ingredient_is_grain,
+	valid_ingredients.is_fruit as valid_ingredient_is_fruit,
+	valid_ingredients.is_salt as valid_ingredient_is_salt,
+	valid_ingredients.is_fat as valid_ingredient_is_fat,
+	valid_ingredients.is_acid as valid_ingredient_is_acid,
+	valid_ingredients.is_heat as valid_ingredient_is_heat,
+	valid_ingredients.last_indexed_at as valid_ingredient_last_indexed_at,
+	valid_ingredients.created_at as valid_ingredient_created_at,
+	valid_ingredients.last_updated_at as valid_ingredient_last_updated_at,
+	valid_ingredients.archived_at as valid_ingredient_archived_at,
+	valid_measurement_units.id as valid_measurement_unit_id,
+	valid_measurement_units.name as valid_measurement_unit_name,
+	valid_measurement_units.description as valid_measurement_unit_description,
+	valid_measurement_units.volumetric as valid_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as valid_measurement_unit_icon_path,
+	valid_measurement_units.universal as valid_measurement_unit_universal,
+	valid_measurement_units.metric as valid_measurement_unit_metric,
+	valid_measurement_units.imperial as valid_measurement_unit_imperial,
+	valid_measurement_units.slug as valid_measurement_unit_slug,
+	valid_measurement_units.plural_name as valid_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as valid_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as valid_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as valid_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as valid_measurement_unit_archived_at,
+	pantry_items.quantity,
+	pantry_items.storage_location,
+	pantry_items.notes,
+	pantry_items.expires_at,
+	pantry_items.belongs_to_household,
+	pantry_items.created_at,
+	pantry_items.last_updated_at,
+	pantry_items.archived_at,
+	(
+		SELECT COUNT(pantry_items.id)
+		FROM pantry_items
+		WHERE pantry_items.archived_at IS NULL
+			AND pantry_items.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND pantry_items.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				pantry_items.last_updated_at IS NULL
+				OR pantry_items.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				pantry_items.last_updated_at IS NULL
+				OR pantry_items.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+			AND pantry_items.belongs_to_household = sqlc.arg(household_id)
+	) AS filtered_count,
+	(
+		SELECT COUNT(pantry_items.id)
+		FROM pantry_items
+		WHERE pantry_items.archived_at IS NULL
+			AND pantry_items.belongs_to_household = sqlc.arg(household_id)
+	) AS total_count
+FROM pantry_items
+	JOIN valid_ingredients ON pantry_items.valid_ingredient = valid_ingredients.id
+	JOIN valid_measurement_units ON pantry_items.valid_measurement_unit = valid_measurement_units.id
+WHERE
+	pantry_items.archived_at IS NULL
+	AND pantry_items.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND pantry_items.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		pantry_items.last_updated_at IS NULL
+		OR pantry_items.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		pantry_items.last_updated_at IS NULL
+		OR pantry_items.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+	AND pantry_items.belongs_to_household = sqlc.arg(household_id)
+ORDER BY
+	pantry_items.id
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: GetPantryItem :one
+
+SELECT
+	pantry_items.id,
+	valid_ingredients.id as valid_ingredient_id,
+	valid_ingredients.name as valid_ingredient_name,
+	valid_ingredients.description as valid_ingredient_description,
+	valid_ingredients.warning as valid_ingredient_warning,
+	valid_ingredients.contains_egg as valid_ingredient_contains_egg,
+	valid_ingredients.contains_dairy as valid_ingredient_contains_dairy,
+	valid_ingredients.contains_peanut as valid_ingredient_contains_peanut,
+	valid_ingredients.contains_tree_nut as valid_ingredient_contains_tree_nut,
+	valid_ingredients.contains_soy as valid_ingredient_contains_soy,
+	valid_ingredients.contains_wheat as valid_ingredient_contains_wheat,
+	valid_ingredients.contains_shellfish as valid_ingredient_contains_shellfish,
+	valid_ingredients.contains_sesame as valid_ingredient_contains_sesame,
+	valid_ingredients.contains_fish as valid_ingredient_contains_fish,
+	valid_ingredients.contains_gluten as valid_ingredient_contains_gluten,
+	valid_ingredients.animal_flesh as valid_ingredient_animal_flesh,
+	valid_ingredients.volumetric as valid_ingredient_volumetric,
+	valid_ingredients.is_liquid as valid_ingredient_is_liquid,
+	valid_ingredients.icon_path as valid_ingredient_icon_path,
+	valid_ingredients.animal_derived as valid_ingredient_animal_derived,
+	valid_ingredients.plural_name as valid_ingredient_plural_name,
+	valid_ingredients.restrict_to_preparations as valid_ingredient_restrict_to_preparations,
+	valid_ingredients.minimum_ideal_storage_temperature_in_celsius as valid_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients.maximum_ideal_storage_temperature_in_celsius as valid_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients.storage_instructions as valid_ingredient_storage_instructions,
+	valid_ingredients.slug as valid_ingredient_slug,
+	valid_ingredients.contains_alcohol as valid_ingredient_contains_alcohol,
+	valid_ingredients.shopping_suggestions as valid_ingredient_shopping_suggestions,
+	valid_ingredients.is_starch as valid_ingredient_is_starch,
+	valid_ingredients.is_protein as valid_ingredient_is_protein,
+	valid_ingredients.is_grain as valid_ingredient_is_grain,
+	valid_ingredients.is_fruit as valid_ingredient_is_fruit,
+	valid_ingredients.is_salt as valid_ingredient_is_salt,
+	valid_ingredients.is_fat as valid_ingredient_is_fat,
+	valid_ingredients.is_acid as valid_ingredient_is_acid,
+	valid_ingredients.is_heat as valid_ingredient_is_heat,
+	valid_ingredients.last_indexed_at as valid_ingredient_last_indexed_at,
+	valid_ingredients.created_at as valid_ingredient_created_at,
+	valid_ingredients.last_updated_at as valid_ingredient_last_updated_at,
+	valid_ingredients.archived_at as valid_ingredient_archived_at,
+	valid_measurement_units.id as valid_measurement_unit_id,
+	valid_measurement_units.name as valid_measurement_unit_name,
+	valid_measurement_units.description as valid_measurement_unit_description,
+	valid_measurement_units.volumetric as valid_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as valid_measurement_unit_icon_path,
+	valid_measurement_units.universal as valid_measurement_unit_universal,
+	valid_measurement_units.metric as valid_measurement_unit_metric,
+	valid_measurement_units.imperial as valid_measurement_unit_imperial,
+	valid_measurement_units.slug as valid_measurement_unit_slug,
+	valid_measurement_units.plural_name as valid_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as valid_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as valid_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as valid_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as valid_measurement_unit_archived_at,
+	pantry_items.quantity,
+	pantry_items.storage_location,
+	pantry_items.notes,
+	pantry_items.expires_at,
+	pantry_items.belongs_to_household,
+	pantry_items.created_at,
+	pantry_items.last_updated_at,
+	pantry_items.archived_at
+FROM pantry_items
+	JOIN valid_ingredients ON pantry_items.valid_ingredient = valid_ingredients.id
+	JOIN valid_measurement_units ON pantry_items.valid_measurement_unit = valid_measurement_units.id
+WHERE pantry_items.archived_at IS NULL
+	AND pantry_items.id = sqlc.arg(id)
+	AND pantry_items.belongs_to_household = sqlc.arg(belongs_to_household);
+
+-- name: GetUnexpiredPantryItemsForHousehold :many
+
+SELECT
+	pantry_items.id,
+	valid_ingredients.id as valid_ingredient_id,
+	valid_ingredients.name as valid_ingredient_name,
+	valid_ingredients.description as valid_ingredient_description,
+	valid_ingredients.warning as valid_ingredient_warning,
+	valid_ingredients.contains_egg as valid_ingredient_contains_egg,
+	valid_ingredients.contains_dairy as valid_ingredient_contains_dairy,
+	valid_ingredients.contains_peanut as valid_ingredient_contains_peanut,
+	valid_ingredients.contains_tree_nut as valid_ingredient_contains_tree_nut,
+	valid_ingredients.contains_soy as valid_ingredient_contains_soy,
+	valid_ingredients.contains_wheat as valid_ingredient_contains_wheat,
+	valid_ingredients.contains_shellfish as valid_ingredient_contains_shellfish,
+	valid_ingredients.contains_sesame as valid_ingredient_contains_sesame,
+	valid_ingredients.contains_fish as valid_ingredient_contains_fish,
+	valid_ingredients.contains_gluten as valid_ingredient_contains_gluten,
+	valid_ingredients.animal_flesh as valid_ingredient_animal_flesh,
+	valid_ingredients.volumetric as valid_ingredient_volumetric,
+	valid_ingredients.is_liquid as valid_ingredient_is_liquid,
+	valid_ingredients.icon_path as valid_ingredient_icon_path,
+	valid_ingredients.animal_derived as valid_ingredient_animal_derived,
+	valid_ingredients.plural_name as valid_ingredient_plural_name,
+	valid_ingredients.restrict_to_preparations as valid_ingredient_restrict_to_preparations,
+	valid_ingredients.minimum_ideal_storage_temperature_in_celsius as valid_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients.maximum_ideal_storage_temperature_in_celsius as valid_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients.storage_instructions as valid_ingredient_storage_instructions,
+	valid_ingredients.slug as valid_ingredient_slug,
+	valid_ingredients.contains_alcohol as valid_ingredient_contains_alcohol,
+	valid_ingredients.shopping_suggestions as valid_ingredient_shopping_suggestions,
+	valid_ingredients.is_starch as valid_ingredient_is_starch,
+	valid_ingredients.is_protein as valid_ingredient_is_protein,
+	valid_ingredients.is_grain as valid_ingredient_is_grain,
+	valid_ingredients.is_fruit as valid_ingredient_is_fruit,
+	valid_ingredients.is_salt as valid_ingredient_is_salt,
+	valid_ingredients.is_fat as valid_ingredient_is_fat,
+	valid_ingredients.is_acid as valid_ingredient_is_acid,
+	valid_ingredients.is_heat as valid_ingredient_is_heat,
+	valid_ingredients.last_indexed_at as valid_ingredient_last_indexed_at,
+	valid_ingredients.created_at as valid_ingredient_created_at,
+	valid_ingredients.last_updated_at as valid_ingredient_last_updated_at,
+	valid_ingredients.archived_at as valid_ingredient_archived_at,
+	valid_measurement_units.id as valid_measurement_unit_id,
+	valid_measurement_units.name as valid_measurement_unit_name,
+	valid_measurement_units.description as valid_measurement_unit_description,
+	valid_measurement_units.volumetric as valid_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as valid_measurement_unit_icon_path,
+	valid_measurement_units.universal as valid_measurement_unit_universal,
+	valid_measurement_units.metric as valid_measurement_unit_metric,
+	valid_measurement_units.imperial as valid_measurement_unit_imperial,
+	valid_measurement_units.slug as valid_measurement_unit_slug,
+	valid_measurement_units.plural_name as valid_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as valid_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as valid_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as valid_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as valid_measurement_unit_archived_at,
+	pantry_items.quantity,
+	pantry_items.storage_location,
+	pantry_items.notes,
+	pantry_items.expires_at,
+	pantry_items.belongs_to_household,
+	pantry_items.created_at,
+	pantry_items.last_updated_at,
+	pantry_items.archived_at
+FROM pantry_items
+	JOIN valid_ingredients ON pantry_items.valid_ingredient = valid_ingredients.id
+	JOIN valid_measurement_units ON pantry_items.valid_measurement_unit = valid_measurement_units.id
+WHERE pantry_items.archived_at IS NULL
+	AND pantry_items.belongs_to_household = sqlc.arg(belongs_to_household)
+	AND (pantry_items.expires_at IS NULL OR pantry_items.expires_at > NOW())
+ORDER BY
+	pantry_items.expires_at ASC NULLS LAST,
+	pantry_items.created_at;
+
+-- name: UpdatePantryItem :execrows
+
+UPDATE pantry_items SET
+	valid_ingredient = sqlc.arg(valid_ingredient),
+	valid_measurement_unit = sqlc.arg(valid_measurement_unit),
+	quantity = sqlc.arg(quantity),
+	storage_location = sqlc.arg(storage_location),
+	notes = sqlc.arg(notes),
+	expires_at = sqlc.arg(expires_at),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND belongs_to_household = sqlc.arg(belongs_to_household);
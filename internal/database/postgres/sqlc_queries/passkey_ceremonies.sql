@@ -0,0 +1,23 @@
+-- name: ConsumePasskeyCeremony :one
+
+DELETE FROM passkey_ceremonies
+WHERE expires_at > NOW()
+	AND id = sqlc.arg(id)
+RETURNING session_data;
+
+-- name: CreatePasskeyCeremony :exec
+
+INSERT INTO passkey_ceremonies (
+	id,
+	session_data,
+	expires_at
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(session_data),
+	sqlc.arg(expires_at)
+);
+
+-- name: DeleteExpiredPasskeyCeremonies :exec
+
+DELETE FROM passkey_ceremonies
+WHERE expires_at <= NOW();
@@ -107,6 +107,28 @@ FROM recipe_ratings
 WHERE recipe_ratings.archived_at IS NULL
 	AND recipe_ratings.id = sqlc.arg(id);
 
+-- name: GetRecipeRatingsForHousehold :many
+
+SELECT
+	recipe_ratings.id,
+	recipe_ratings.recipe_id,
+	recipe_ratings.taste,
+	recipe_ratings.difficulty,
+	recipe_ratings.cleanup,
+	recipe_ratings.instructions,
+	recipe_ratings.overall,
+	recipe_ratings.notes,
+	recipe_ratings.by_user,
+	recipe_ratings.created_at,
+	recipe_ratings.last_updated_at,
+	recipe_ratings.archived_at
+FROM recipe_ratings
+	JOIN household_user_memberships ON household_user_memberships.belongs_to_user = recipe_ratings.by_user
+WHERE recipe_ratings.archived_at IS NULL
+	AND household_user_memberships.archived_at IS NULL
+	AND household_user_memberships.belongs_to_household = sqlc.arg(belongs_to_household)
+ORDER BY recipe_ratings.id;
+
 -- name: UpdateRecipeRating :execrows
 
 UPDATE recipe_ratings SET
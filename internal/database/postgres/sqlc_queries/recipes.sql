@@ -295,6 +295,257 @@ WHERE recipes.archived_at IS NULL
 LIMIT sqlc.narg(query_limit)
 OFFSET sqlc.narg(query_offset);
 
+-- name: GetCookableRecipes :many
+
+WITH RECURSIVE recipe_trees(root_recipe_id, recipe_id) AS (
+	SELECT recipes.id, recipes.id
+	FROM recipes
+	WHERE recipes.archived_at IS NULL
+	UNION
+	SELECT recipe_trees.root_recipe_id, recipe_step_ingredients.recipe_step_product_recipe_id
+	FROM recipe_trees
+		JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipe_trees.recipe_id AND recipe_steps.archived_at IS NULL
+		JOIN recipe_step_ingredients ON recipe_step_ingredients.belongs_to_recipe_step = recipe_steps.id AND recipe_step_ingredients.archived_at IS NULL
+	WHERE recipe_step_ingredients.recipe_step_product_recipe_id IS NOT NULL
+),
+required_instruments AS (
+	SELECT
+		recipe_trees.root_recipe_id AS recipe_id,
+		recipe_step_instruments.instrument_id,
+		recipe_steps.preparation_id,
+		recipe_step_instruments.minimum_quantity
+	FROM recipe_trees
+		JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipe_trees.recipe_id AND recipe_steps.archived_at IS NULL AND NOT recipe_steps.optional
+		JOIN recipe_step_instruments ON recipe_step_instruments.belongs_to_recipe_step = recipe_steps.id AND recipe_step_instruments.archived_at IS NULL AND NOT recipe_step_instruments.optional
+	WHERE recipe_step_instruments.instrument_id IS NOT NULL
+),
+instrument_requirements AS (
+	SELECT
+		required_instruments.recipe_id,
+		required_instruments.instrument_id,
+		GREATEST(MAX(required_instruments.minimum_quantity), 1) AS quantity
+	FROM required_instruments
+	GROUP BY required_instruments.recipe_id, required_instruments.instrument_id
+),
+owned_instruments AS (
+	SELECT household_instrument_ownerships.valid_instrument_id, household_instrument_ownerships.quantity
+	FROM household_instrument_ownerships
+	WHERE household_instrument_ownerships.archived_at IS NULL
+		AND household_instrument_ownerships.belongs_to_household = sqlc.arg(household_id)
+),
+uncookable_recipes AS (
+	SELECT DISTINCT instrument_requirements.recipe_id
+	FROM instrument_requirements
+	WHERE NOT EXISTS (
+		SELECT 1
+		FROM owned_instruments
+		WHERE owned_instruments.valid_instrument_id = instrument_requirements.instrument_id
+			AND owned_instruments.quantity >= instrument_requirements.quantity
+	)
+	AND NOT EXISTS (
+		SELECT 1
+		FROM owned_instruments AS substitutes
+		WHERE substitutes.valid_instrument_id != instrument_requirements.instrument_id
+			AND substitutes.quantity >= instrument_requirements.quantity
+			AND NOT EXISTS (
+				SELECT 1
+				FROM required_instruments
+				WHERE required_instruments.recipe_id = instrument_requirements.recipe_id
+					AND required_instruments.instrument_id = instrument_requirements.instrument_id
+					AND NOT EXISTS (
+						SELECT 1
+						FROM valid_preparation_instruments
+						WHERE valid_preparation_instruments.archived_at IS NULL
+							AND valid_preparation_instruments.valid_preparation_id = required_instruments.preparation_id
+							AND valid_preparation_instruments.valid_instrument_id = substitutes.valid_instrument_id
+					)
+			)
+	)
+)
+SELECT
+	recipes.id,
+	recipes.name,
+	recipes.slug,
+	recipes.source,
+	recipes.description,
+	recipes.inspired_by_recipe_id,
+	recipes.min_estimated_portions,
+	recipes.max_estimated_portions,
+	recipes.portion_name,
+	recipes.plural_portion_name,
+	recipes.seal_of_approval,
+	recipes.eligible_for_meals,
+	recipes.yields_component_type,
+	recipes.last_indexed_at,
+	recipes.last_validated_at,
+	recipes.created_at,
+	recipes.last_updated_at,
+	recipes.archived_at,
+	recipes.created_by_user,
+	(
+		SELECT COUNT(recipes.id)
+		FROM recipes
+		WHERE recipes.archived_at IS NULL
+			AND recipes.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND recipes.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				recipes.last_updated_at IS NULL
+				OR recipes.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				recipes.last_updated_at IS NULL
+				OR recipes.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+			AND recipes.id NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)
+	) AS filtered_count,
+	(
+		SELECT COUNT(recipes.id)
+		FROM recipes
+		WHERE recipes.archived_at IS NULL
+			AND recipes.id NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)
+	) AS total_count
+FROM recipes
+	WHERE recipes.archived_at IS NULL
+	AND recipes.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND recipes.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		recipes.last_updated_at IS NULL
+		OR recipes.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		recipes.last_updated_at IS NULL
+		OR recipes.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+	AND recipes.id NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: CookableRecipeSearch :many
+
+WITH RECURSIVE recipe_trees(root_recipe_id, recipe_id) AS (
+	SELECT recipes.id, recipes.id
+	FROM recipes
+	WHERE recipes.archived_at IS NULL
+	UNION
+	SELECT recipe_trees.root_recipe_id, recipe_step_ingredients.recipe_step_product_recipe_id
+	FROM recipe_trees
+		JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipe_trees.recipe_id AND recipe_steps.archived_at IS NULL
+		JOIN recipe_step_ingredients ON recipe_step_ingredients.belongs_to_recipe_step = recipe_steps.id AND recipe_step_ingredients.archived_at IS NULL
+	WHERE recipe_step_ingredients.recipe_step_product_recipe_id IS NOT NULL
+),
+required_instruments AS (
+	SELECT
+		recipe_trees.root_recipe_id AS recipe_id,
+		recipe_step_instruments.instrument_id,
+		recipe_steps.preparation_id,
+		recipe_step_instruments.minimum_quantity
+	FROM recipe_trees
+		JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipe_trees.recipe_id AND recipe_steps.archived_at IS NULL AND NOT recipe_steps.optional
+		JOIN recipe_step_instruments ON recipe_step_instruments.belongs_to_recipe_step = recipe_steps.id AND recipe_step_instruments.archived_at IS NULL AND NOT recipe_step_instruments.optional
+	WHERE recipe_step_instruments.instrument_id IS NOT NULL
+),
+instrument_requirements AS (
+	SELECT
+		required_instruments.recipe_id,
+		required_instruments.instrument_id,
+		GREATEST(MAX(required_instruments.minimum_quantity), 1) AS quantity
+	FROM required_instruments
+	GROUP BY required_instruments.recipe_id, required_instruments.instrument_id
+),
+owned_instruments AS (
+	SELECT household_instrument_ownerships.valid_instrument_id, household_instrument_ownerships.quantity
+	FROM household_instrument_ownerships
+	WHERE household_instrument_ownerships.archived_at IS NULL
+		AND household_instrument_ownerships.belongs_to_household = sqlc.arg(household_id)
+),
+uncookable_recipes AS (
+	SELECT DISTINCT instrument_requirements.recipe_id
+	FROM instrument_requirements
+	WHERE NOT EXISTS (
+		SELECT 1
+		FROM owned_instruments
+		WHERE owned_instruments.valid_instrument_id = instrument_requirements.instrument_id
+			AND owned_instruments.quantity >= instrument_requirements.quantity
+	)
+	AND NOT EXISTS (
+		SELECT 1
+		FROM owned_instruments AS substitutes
+		WHERE substitutes.valid_instrument_id != instrument_requirements.instrument_id
+			AND substitutes.quantity >= instrument_requirements.quantity
+			AND NOT EXISTS (
+				SELECT 1
+				FROM required_instruments
+				WHERE required_instruments.recipe_id = instrument_requirements.recipe_id
+					AND required_instruments.instrument_id = instrument_requirements.instrument_id
+					AND NOT EXISTS (
+						SELECT 1
+						FROM valid_preparation_instruments
+						WHERE valid_preparation_instruments.archived_at IS NULL
+							AND valid_preparation_instruments.valid_preparation_id = required_instruments.preparation_id
+							AND valid_preparation_instruments.valid_instrument_id = substitutes.valid_instrument_id
+					)
+			)
+	)
+)
+SELECT
+	recipes.id,
+	recipes.name,
+	recipes.slug,
+	recipes.source,
+	recipes.description,
+	recipes.inspired_by_recipe_id,
+	recipes.min_estimated_portions,
+	recipes.max_estimated_portions,
+	recipes.portion_name,
+	recipes.plural_portion_name,
+	recipes.seal_of_approval,
+	recipes.eligible_for_meals,
+	recipes.yields_component_type,
+	recipes.last_indexed_at,
+	recipes.last_validated_at,
+	recipes.created_at,
+	recipes.last_updated_at,
+	recipes.archived_at,
+	recipes.created_by_user,
+	(
+		SELECT COUNT(recipes.id)
+		FROM recipes
+		WHERE recipes.archived_at IS NULL
+			AND recipes.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND recipes.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				recipes.last_updated_at IS NULL
+				OR recipes.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				recipes.last_updated_at IS NULL
+				OR recipes.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+			AND recipes.id NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)
+	) AS filtered_count,
+	(
+		SELECT COUNT(recipes.id)
+		FROM recipes
+		WHERE recipes.archived_at IS NULL
+			AND recipes.id NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)
+	) AS total_count
+FROM recipes
+WHERE recipes.archived_at IS NULL
+	AND recipes.name ILIKE '%' || sqlc.arg(query)::text || '%'
+	AND recipes.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND recipes.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		recipes.last_updated_at IS NULL
+		OR recipes.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		recipes.last_updated_at IS NULL
+		OR recipes.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+	AND recipes.id NOT IN (SELECT uncookable_recipes.recipe_id FROM uncookable_recipes)
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
 -- name: GetRecipesNeedingIndexing :many
 
 SELECT recipes.id
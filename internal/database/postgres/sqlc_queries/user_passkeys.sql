@@ -0,0 +1,66 @@
+-- name: ArchiveUserPasskey :execrows
+
+UPDATE user_passkeys SET
+	archived_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND belongs_to_user = sqlc.arg(belongs_to_user);
+
+-- name: CreateUserPasskey :exec
+
+INSERT INTO user_passkeys (
+	id,
+	name,
+	credential_id,
+	public_key,
+	attestation_type,
+	aaguid,
+	sign_count,
+	transports,
+	backup_eligible,
+	backup_state,
+	belongs_to_user
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(name),
+	sqlc.arg(credential_id),
+	sqlc.arg(public_key),
+	sqlc.arg(attestation_type),
+	sqlc.arg(aaguid),
+	sqlc.arg(sign_count),
+	sqlc.arg(transports),
+	sqlc.arg(backup_eligible),
+	sqlc.arg(backup_state),
+	sqlc.arg(belongs_to_user)
+);
+
+-- name: GetUserPasskeysForUser :many
+
+SELECT
+	user_passkeys.id,
+	user_passkeys.name,
+	user_passkeys.credential_id,
+	user_passkeys.public_key,
+	user_passkeys.attestation_type,
+	user_passkeys.aaguid,
+	user_passkeys.sign_count,
+	user_passkeys.transports,
+	user_passkeys.backup_eligible,
+	user_passkeys.backup_state,
+	user_passkeys.belongs_to_user,
+	user_passkeys.last_used_at,
+	user_passkeys.created_at,
+	user_passkeys.archived_at
+FROM user_passkeys
+WHERE user_passkeys.archived_at IS NULL
+	AND user_passkeys.belongs_to_user = sqlc.arg(belongs_to_user)
+ORDER BY user_passkeys.created_at;
+
+-- name: MarkUserPasskeyAsUsed :execrows
+
+UPDATE user_passkeys SET
+	sign_count = sqlc.arg(sign_count),
+	backup_state = sqlc.arg(backup_state),
+	last_used_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
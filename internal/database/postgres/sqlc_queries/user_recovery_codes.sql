@@ -0,0 +1,27 @@
+-- name: ArchiveUserRecoveryCodesForUser :exec
+
+UPDATE user_recovery_codes SET
+	archived_at = NOW()
+WHERE archived_at IS NULL
+	AND belongs_to_user = sqlc.arg(belongs_to_user);
+
+-- name: CreateUserRecoveryCode :exec
+
+INSERT INTO user_recovery_codes (
+	id,
+	hashed_code,
+	belongs_to_user
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(hashed_code),
+	sqlc.arg(belongs_to_user)
+);
+
+-- name: RedeemUserRecoveryCode :execrows
+
+UPDATE user_recovery_codes SET
+	redeemed_at = NOW()
+WHERE archived_at IS NULL
+	AND redeemed_at IS NULL
+	AND belongs_to_user = sqlc.arg(belongs_to_user)
+	AND hashed_code = sqlc.arg(hashed_code);
@@ -0,0 +1,131 @@
+-- name: ArchiveValidIngredientNutrition :execrows
+
+UPDATE valid_ingredient_nutrition SET
+	archived_at = NOW()
+WHERE archived_at IS NULL
+	AND belongs_to_valid_ingredient = sqlc.arg(belongs_to_valid_ingredient);
+
+-- name: GetValidIngredientNutrition :one
+
+SELECT
+	valid_ingredient_nutrition.id,
+	valid_ingredient_nutrition.belongs_to_valid_ingredient,
+	valid_ingredient_nutrition.reference_quantity,
+	valid_measurement_units.id as valid_measurement_unit_id,
+	valid_measurement_units.name as valid_measurement_unit_name,
+	valid_measurement_units.description as valid_measurement_unit_description,
+	valid_measurement_units.volumetric as valid_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as valid_measurement_unit_icon_path,
+	valid_measurement_units.universal as valid_measurement_unit_universal,
+	valid_measurement_units.metric as valid_measurement_unit_metric,
+	valid_measurement_units.imperial as valid_measurement_unit_imperial,
+	valid_measurement_units.slug as valid_measurement_unit_slug,
+	valid_measurement_units.plural_name as valid_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as valid_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as valid_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as valid_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as valid_measurement_unit_archived_at,
+	valid_ingredient_nutrition.calories,
+	valid_ingredient_nutrition.protein,
+	valid_ingredient_nutrition.total_fat,
+	valid_ingredient_nutrition.saturated_fat,
+	valid_ingredient_nutrition.carbohydrates,
+	valid_ingredient_nutrition.sugar,
+	valid_ingredient_nutrition.fiber,
+	valid_ingredient_nutrition.sodium,
+	valid_ingredient_nutrition.source,
+	valid_ingredient_nutrition.source_id,
+	valid_ingredient_nutrition.created_at,
+	valid_ingredient_nutrition.last_updated_at,
+	valid_ingredient_nutrition.archived_at
+FROM valid_ingredient_nutrition
+	JOIN valid_measurement_units ON valid_ingredient_nutrition.reference_measurement_unit = valid_measurement_units.id
+WHERE valid_ingredient_nutrition.archived_at IS NULL
+	AND valid_measurement_units.archived_at IS NULL
+	AND valid_ingredient_nutrition.belongs_to_valid_ingredient = sqlc.arg(belongs_to_valid_ingredient);
+
+-- name: GetValidIngredientNutritionForIngredients :many
+
+SELECT
+	valid_ingredient_nutrition.id,
+	valid_ingredient_nutrition.belongs_to_valid_ingredient,
+	valid_ingredient_nutrition.reference_quantity,
+	valid_measurement_units.id as valid_measurement_unit_id,
+	valid_measurement_units.name as valid_measurement_unit_name,
+	valid_measurement_units.description as valid_measurement_unit_description,
+	valid_measurement_units.volumetric as valid_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as valid_measurement_unit_icon_path,
+	valid_measurement_units.universal as valid_measurement_unit_universal,
+	valid_measurement_units.metric as valid_measurement_unit_metric,
+	valid_measurement_units.imperial as valid_measurement_unit_imperial,
+	valid_measurement_units.slug as valid_measurement_unit_slug,
+	valid_measurement_units.plural_name as valid_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as valid_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as valid_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as valid_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as valid_measurement_unit_archived_at,
+	valid_ingredient_nutrition.calories,
+	valid_ingredient_nutrition.protein,
+	valid_ingredient_nutrition.total_fat,
+	valid_ingredient_nutrition.saturated_fat,
+	valid_ingredient_nutrition.carbohydrates,
+	valid_ingredient_nutrition.sugar,
+	valid_ingredient_nutrition.fiber,
+	valid_ingredient_nutrition.sodium,
+	valid_ingredient_nutrition.source,
+	valid_ingredient_nutrition.source_id,
+	valid_ingredient_nutrition.created_at,
+	valid_ingredient_nutrition.last_updated_at,
+	valid_ingredient_nutrition.archived_at
+FROM valid_ingredient_nutrition
+	JOIN valid_measurement_units ON valid_ingredient_nutrition.reference_measurement_unit = valid_measurement_units.id
+WHERE valid_ingredient_nutrition.archived_at IS NULL
+	AND valid_measurement_units.archived_at IS NULL
+	AND valid_ingredient_nutrition.belongs_to_valid_ingredient = ANY(sqlc.arg(ids)::text[]);
+
+-- name: UpsertValidIngredientNutrition :exec
+
+INSERT INTO valid_ingredient_nutrition (
+	id,
+	belongs_to_valid_ingredient,
+	reference_quantity,
+	reference_measurement_unit,
+	calories,
+	protein,
+	total_fat,
+	saturated_fat,
+	carbohydrates,
+	sugar,
+	fiber,
+	sodium,
+	source,
+	source_id
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(belongs_to_valid_ingredient),
+	sqlc.arg(reference_quantity),
+	sqlc.arg(reference_measurement_unit),
+	sqlc.arg(calories),
+	sqlc.arg(protein),
+	sqlc.arg(total_fat),
+	sqlc.arg(saturated_fat),
+	sqlc.arg(carbohydrates),
+	sqlc.arg(sugar),
+	sqlc.arg(fiber),
+	sqlc.arg(sodium),
+	sqlc.arg(source),
+	sqlc.arg(source_id)
+) ON CONFLICT (belongs_to_valid_ingredient) WHERE archived_at IS NULL DO UPDATE SET
+	reference_quantity = EXCLUDED.reference_quantity,
+	reference_measurement_unit = EXCLUDED.reference_measurement_unit,
+	calories = EXCLUDED.calories,
+	protein = EXCLUDED.protein,
+	total_fat = EXCLUDED.total_fat,
+	saturated_fat = EXCLUDED.saturated_fat,
+	carbohydrates = EXCLUDED.carbohydrates,
+	sugar = EXCLUDED.sugar,
+	fiber = EXCLUDED.fiber,
+	sodium = EXCLUDED.sodium,
+	source = EXCLUDED.source,
+	source_id = EXCLUDED.source_id,
+	last_updated_at = NOW();
@@ -0,0 +1,592 @@
+-- name: ArchiveValidIngredientSubstitution :execrows
+
+UPDATE valid_ingredient_substitutions SET archived_at = NOW() WHERE archived_at IS NULL AND id = sqlc.arg(id);
+
+-- name: CreateValidIngredientSubstitution :exec
+
+INSERT INTO valid_ingredient_substitutions (
+	id,
+	original_ingredient,
+	substitute_ingredient,
+	valid_preparation_id,
+	ratio,
+	reasons,
+	notes
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(original_ingredient),
+	sqlc.arg(substitute_ingredient),
+	sqlc.arg(valid_preparation_id),
+	sqlc.arg(ratio),
+	sqlc.arg(reasons),
+	sqlc.arg(notes)
+);
+
+-- name: CheckValidIngredientSubstitutionExistence :one
+
+SELECT EXISTS (
+	SELECT valid_ingredient_substitutions.id
+	FROM valid_ingredient_substitutions
+	WHERE valid_ingredient_substitutions.archived_at IS NULL
+		AND valid_ingredient_substitutions.id = sqlc.arg(id)
+);
+
+-- name: GetValidIngredientSubstitution :one
+
+SELECT
+	valid_ingredient_substitutions.id as valid_ingredient_substitution_id,
+	valid_ingredients_original.id as original_ingredient_id,
+	valid_ingredients_original.name as original_ingredient_name,
+	valid_ingredients_original.description as original_ingredient_description,
+	valid_ingredients_original.warning as original_ingredient_warning,
+	valid_ingredients_original.contains_egg as original_ingredient_contains_egg,
+	valid_ingredients_original.contains_dairy as original_ingredient_contains_dairy,
+	valid_ingredients_original.contains_peanut as original_ingredient_contains_peanut,
+	valid_ingredients_original.contains_tree_nut as original_ingredient_contains_tree_nut,
+	valid_ingredients_original.contains_soy as original_ingredient_contains_soy,
+	valid_ingredients_original.contains_wheat as original_ingredient_contains_wheat,
+	valid_ingredients_original.contains_shellfish as original_ingredient_contains_shellfish,
+	valid_ingredients_original.contains_sesame as original_ingredient_contains_sesame,
+	valid_ingredients_original.contains_fish as original_ingredient_contains_fish,
+	valid_ingredients_original.contains_gluten as original_ingredient_contains_gluten,
+	valid_ingredients_original.animal_flesh as original_ingredient_animal_flesh,
+	valid_ingredients_original.volumetric as original_ingredient_volumetric,
+	valid_ingredients_original.is_liquid as original_ingredient_is_liquid,
+	valid_ingredients_original.icon_path as original_ingredient_icon_path,
+	valid_ingredients_original.animal_derived as original_ingredient_animal_derived,
+	valid_ingredients_original.plural_name as original_ingredient_plural_name,
+	valid_ingredients_original.restrict_to_preparations as original_ingredient_restrict_to_preparations,
+	valid_ingredients_original.minimum_ideal_storage_temperature_in_celsius as original_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.maximum_ideal_storage_temperature_in_celsius as original_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.storage_instructions as original_ingredient_storage_instructions,
+	valid_ingredients_original.slug as original_ingredient_slug,
+	valid_ingredients_original.contains_alcohol as original_ingredient_contains_alcohol,
+	valid_ingredients_original.shopping_suggestions as original_ingredient_shopping_suggestions,
+	valid_ingredients_original.is_starch as original_ingredient_is_starch,
+	valid_ingredients_original.is_protein as original_ingredient_is_protein,
+	valid_ingredients_original.is_grain as original_ingredient_is_grain,
+	valid_ingredients_original.is_fruit as original_ingredient_is_fruit,
+	valid_ingredients_original.is_salt as original_ingredient_is_salt,
+	valid_ingredients_original.is_fat as original_ingredient_is_fat,
+	valid_ingredients_original.is_acid as original_ingredient_is_acid,
+	valid_ingredients_original.is_heat as original_ingredient_is_heat,
+	valid_ingredients_original.last_indexed_at as original_ingredient_last_indexed_at,
+	valid_ingredients_original.created_at as original_ingredient_created_at,
+	valid_ingredients_original.last_updated_at as original_ingredient_last_updated_at,
+	valid_ingredients_original.archived_at as original_ingredient_archived_at,
+	valid_ingredients_substitute.id as substitute_ingredient_id,
+	valid_ingredients_substitute.name as substitute_ingredient_name,
+	valid_ingredients_substitute.description as substitute_ingredient_description,
+	valid_ingredients_substitute.warning as substitute_ingredient_warning,
+	valid_ingredients_substitute.contains_egg as substitute_ingredient_contains_egg,
+	valid_ingredients_substitute.contains_dairy as substitute_ingredient_contains_dairy,
+	valid_ingredients_substitute.contains_peanut as substitute_ingredient_contains_peanut,
+	valid_ingredients_substitute.contains_tree_nut as substitute_ingredient_contains_tree_nut,
+	valid_ingredients_substitute.contains_soy as substitute_ingredient_contains_soy,
+	valid_ingredients_substitute.contains_wheat as substitute_ingredient_contains_wheat,
+	valid_ingredients_substitute.contains_shellfish as substitute_ingredient_contains_shellfish,
+	valid_ingredients_substitute.contains_sesame as substitute_ingredient_contains_sesame,
+	valid_ingredients_substitute.contains_fish as substitute_ingredient_contains_fish,
+	valid_ingredients_substitute.contains_gluten as substitute_ingredient_contains_gluten,
+	valid_ingredients_substitute.animal_flesh as substitute_ingredient_animal_flesh,
+	valid_ingredients_substitute.volumetric as substitute_ingredient_volumetric,
+	valid_ingredients_substitute.is_liquid as substitute_ingredient_is_liquid,
+	valid_ingredients_substitute.icon_path as substitute_ingredient_icon_path,
+	valid_ingredients_substitute.animal_derived as substitute_ingredient_animal_derived,
+	valid_ingredients_substitute.plural_name as substitute_ingredient_plural_name,
+	valid_ingredients_substitute.restrict_to_preparations as substitute_ingredient_restrict_to_preparations,
+	valid_ingredients_substitute.minimum_ideal_storage_temperature_in_celsius as substitute_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.maximum_ideal_storage_temperature_in_celsius as substitute_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.storage_instructions as substitute_ingredient_storage_instructions,
+	valid_ingredients_substitute.slug as substitute_ingredient_slug,
+	valid_ingredients_substitute.contains_alcohol as substitute_ingredient_contains_alcohol,
+	valid_ingredients_substitute.shopping_suggestions as substitute_ingredient_shopping_suggestions,
+	valid_ingredients_substitute.is_starch as substitute_ingredient_is_starch,
+	valid_ingredients_substitute.is_protein as substitute_ingredient_is_protein,
+	valid_ingredients_substitute.is_grain as substitute_ingredient_is_grain,
+	valid_ingredients_substitute.is_fruit as substitute_ingredient_is_fruit,
+	valid_ingredients_substitute.is_salt as substitute_ingredient_is_salt,
+	valid_ingredients_substitute.is_fat as substitute_ingredient_is_fat,
+	valid_ingredients_substitute.is_acid as substitute_ingredient_is_acid,
+	valid_ingredients_substitute.is_heat as substitute_ingredient_is_heat,
+	valid_ingredients_substitute.last_indexed_at as substitute_ingredient_last_indexed_at,
+	valid_ingredients_substitute.created_at as substitute_ingredient_created_at,
+	valid_ingredients_substitute.last_updated_at as substitute_ingredient_last_updated_at,
+	valid_ingredients_substitute.archived_at as substitute_ingredient_archived_at,
+	valid_preparations.id as valid_preparation_id,
+	valid_preparations.name as valid_preparation_name,
+	valid_preparations.description as valid_preparation_description,
+	valid_preparations.icon_path as valid_preparation_icon_path,
+	valid_preparations.yields_nothing as valid_preparation_yields_nothing,
+	valid_preparations.restrict_to_ingredients as valid_preparation_restrict_to_ingredients,
+	valid_preparations.past_tense as valid_preparation_past_tense,
+	valid_preparations.slug as valid_preparation_slug,
+	valid_preparations.minimum_ingredient_count as valid_preparation_minimum_ingredient_count,
+	valid_preparations.maximum_ingredient_count as valid_preparation_maximum_ingredient_count,
+	valid_preparations.minimum_instrument_count as valid_preparation_minimum_instrument_count,
+	valid_preparations.maximum_instrument_count as valid_preparation_maximum_instrument_count,
+	valid_preparations.temperature_required as valid_preparation_temperature_required,
+	valid_preparations.time_estimate_required as valid_preparation_time_estimate_required,
+	valid_preparations.condition_expression_required as valid_preparation_condition_expression_required,
+	valid_preparations.consumes_vessel as valid_preparation_consumes_vessel,
+	valid_preparations.only_for_vessels as valid_preparation_only_for_vessels,
+	valid_preparations.minimum_vessel_count as valid_preparation_minimum_vessel_count,
+	valid_preparations.maximum_vessel_count as valid_preparation_maximum_vessel_count,
+	valid_preparations.last_indexed_at as valid_preparation_last_indexed_at,
+	valid_preparations.created_at as valid_preparation_created_at,
+	valid_preparations.last_updated_at as valid_preparation_last_updated_at,
+	valid_preparations.archived_at as valid_preparation_archived_at,
+	valid_ingredient_substitutions.ratio as valid_ingredient_substitution_ratio,
+	valid_ingredient_substitutions.reasons as valid_ingredient_substitution_reasons,
+	valid_ingredient_substitutions.notes as valid_ingredient_substitution_notes,
+	valid_ingredient_substitutions.created_at as valid_ingredient_substitution_created_at,
+	valid_ingredient_substitutions.last_updated_at as valid_ingredient_substitution_last_updated_at,
+	valid_ingredient_substitutions.archived_at as valid_ingredient_substitution_archived_at
+FROM valid_ingredient_substitutions
+	JOIN valid_ingredients AS valid_ingredients_original ON valid_ingredient_substitutions.original_ingredient = valid_ingredients_original.id
+	JOIN valid_ingredients AS valid_ingredients_substitute ON valid_ingredient_substitutions.substitute_ingredient = valid_ingredients_substitute.id
+	LEFT JOIN valid_preparations ON valid_ingredient_substitutions.valid_preparation_id = valid_preparations.id
+WHERE
+	valid_ingredient_substitutions.archived_at IS NULL
+	AND valid_ingredients_original.archived_at IS NULL
+	AND valid_ingredients_substitute.archived_at IS NULL
+	AND valid_ingredient_substitutions.id = sqlc.arg(id);
+
+-- name: GetValidIngredientSubstitutions :many
+
+SELECT
+	valid_ingredient_substitutions.id as valid_ingredient_substitution_id,
+	valid_ingredients_original.id as original_ingredient_id,
+	valid_ingredients_original.name as original_ingredient_name,
+	valid_ingredients_original.description as original_ingredient_description,
+	valid_ingredients_original.warning as original_ingredient_warning,
+	valid_ingredients_original.contains_egg as original_ingredient_contains_egg,
+	valid_ingredients_original.contains_dairy as original_ingredient_contains_dairy,
+	valid_ingredients_original.contains_peanut as original_ingredient_contains_peanut,
+	valid_ingredients_original.contains_tree_nut as original_ingredient_contains_tree_nut,
+	valid_ingredients_original.contains_soy as original_ingredient_contains_soy,
+	valid_ingredients_original.contains_wheat as original_ingredient_contains_wheat,
+	valid_ingredients_original.contains_shellfish as original_ingredient_contains_shellfish,
+	valid_ingredients_original.contains_sesame as original_ingredient_contains_sesame,
+	valid_ingredients_original.contains_fish as original_ingredient_contains_fish,
+	valid_ingredients_original.contains_gluten as original_ingredient_contains_gluten,
+	valid_ingredients_original.animal_flesh as original_ingredient_animal_flesh,
+	valid_ingredients_original.volumetric as original_ingredient_volumetric,
+	valid_ingredients_original.is_liquid as original_ingredient_is_liquid,
+	valid_ingredients_original.icon_path as original_ingredient_icon_path,
+	valid_ingredients_original.animal_derived as original_ingredient_animal_derived,
+	valid_ingredients_original.plural_name as original_ingredient_plural_name,
+	valid_ingredients_original.restrict_to_preparations as original_ingredient_restrict_to_preparations,
+	valid_ingredients_original.minimum_ideal_storage_temperature_in_celsius as original_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.maximum_ideal_storage_temperature_in_celsius as original_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.storage_instructions as original_ingredient_storage_instructions,
+	valid_ingredients_original.slug as original_ingredient_slug,
+	valid_ingredients_original.contains_alcohol as original_ingredient_contains_alcohol,
+	valid_ingredients_original.shopping_suggestions as original_ingredient_shopping_suggestions,
+	valid_ingredients_original.is_starch as original_ingredient_is_starch,
+	valid_ingredients_original.is_protein as original_ingredient_is_protein,
+	valid_ingredients_original.is_grain as original_ingredient_is_grain,
+	valid_ingredients_original.is_fruit as original_ingredient_is_fruit,
+	valid_ingredients_original.is_salt as original_ingredient_is_salt,
+	valid_ingredients_original.is_fat as original_ingredient_is_fat,
+	valid_ingredients_original.is_acid as original_ingredient_is_acid,
+	valid_ingredients_original.is_heat as original_ingredient_is_heat,
+	valid_ingredients_original.last_indexed_at as original_ingredient_last_indexed_at,
+	valid_ingredients_original.created_at as original_ingredient_created_at,
+	valid_ingredients_original.last_updated_at as original_ingredient_last_updated_at,
+	valid_ingredients_original.archived_at as original_ingredient_archived_at,
+	valid_ingredients_substitute.id as substitute_ingredient_id,
+	valid_ingredients_substitute.name as substitute_ingredient_name,
+	valid_ingredients_substitute.description as substitute_ingredient_description,
+	valid_ingredients_substitute.warning as substitute_ingredient_warning,
+	valid_ingredients_substitute.contains_egg as substitute_ingredient_contains_egg,
+	valid_ingredients_substitute.contains_dairy as substitute_ingredient_contains_dairy,
+	valid_ingredients_substitute.contains_peanut as substitute_ingredient_contains_peanut,
+	valid_ingredients_substitute.contains_tree_nut as substitute_ingredient_contains_tree_nut,
+	valid_ingredients_substitute.contains_soy as substitute_ingredient_contains_soy,
+	valid_ingredients_substitute.contains_wheat as substitute_ingredient_contains_wheat,
+	valid_ingredients_substitute.contains_shellfish as substitute_ingredient_contains_shellfish,
+	valid_ingredients_substitute.contains_sesame as substitute_ingredient_contains_sesame,
+	valid_ingredients_substitute.contains_fish as substitute_ingredient_contains_fish,
+	valid_ingredients_substitute.contains_gluten as substitute_ingredient_contains_gluten,
+	valid_ingredients_substitute.animal_flesh as substitute_ingredient_animal_flesh,
+	valid_ingredients_substitute.volumetric as substitute_ingredient_volumetric,
+	valid_ingredients_substitute.is_liquid as substitute_ingredient_is_liquid,
+	valid_ingredients_substitute.icon_path as substitute_ingredient_icon_path,
+	valid_ingredients_substitute.animal_derived as substitute_ingredient_animal_derived,
+	valid_ingredients_substitute.plural_name as substitute_ingredient_plural_name,
+	valid_ingredients_substitute.restrict_to_preparations as substitute_ingredient_restrict_to_preparations,
+	valid_ingredients_substitute.minimum_ideal_storage_temperature_in_celsius as substitute_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.maximum_ideal_storage_temperature_in_celsius as substitute_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.storage_instructions as substitute_ingredient_storage_instructions,
+	valid_ingredients_substitute.slug as substitute_ingredient_slug,
+	valid_ingredients_substitute.contains_alcohol as substitute_ingredient_contains_alcohol,
+	valid_ingredients_substitute.shopping_suggestions as substitute_ingredient_shopping_suggestions,
+	valid_ingredients_substitute.is_starch as substitute_ingredient_is_starch,
+	valid_ingredients_substitute.is_protein as substitute_ingredient_is_protein,
+	valid_ingredients_substitute.is_grain as substitute_ingredient_is_grain,
+	valid_ingredients_substitute.is_fruit as substitute_ingredient_is_fruit,
+	valid_ingredients_substitute.is_salt as substitute_ingredient_is_salt,
+	valid_ingredients_substitute.is_fat as substitute_ingredient_is_fat,
+	valid_ingredients_substitute.is_acid as substitute_ingredient_is_acid,
+	valid_ingredients_substitute.is_heat as substitute_ingredient_is_heat,
+	valid_ingredients_substitute.last_indexed_at as substitute_ingredient_last_indexed_at,
+	valid_ingredients_substitute.created_at as substitute_ingredient_created_at,
+	valid_ingredients_substitute.last_updated_at as substitute_ingredient_last_updated_at,
+	valid_ingredients_substitute.archived_at as substitute_ingredient_archived_at,
+	valid_preparations.id as valid_preparation_id,
+	valid_preparations.name as valid_preparation_name,
+	valid_preparations.description as valid_preparation_description,
+	valid_preparations.icon_path as valid_preparation_icon_path,
+	valid_preparations.yields_nothing as valid_preparation_yields_nothing,
+	valid_preparations.restrict_to_ingredients as valid_preparation_restrict_to_ingredients,
+	valid_preparations.past_tense as valid_preparation_past_tense,
+	valid_preparations.slug as valid_preparation_slug,
+	valid_preparations.minimum_ingredient_count as valid_preparation_minimum_ingredient_count,
+	valid_preparations.maximum_ingredient_count as valid_preparation_maximum_ingredient_count,
+	valid_preparations.minimum_instrument_count as valid_preparation_minimum_instrument_count,
+	valid_preparations.maximum_instrument_count as valid_preparation_maximum_instrument_count,
+	valid_preparations.temperature_required as valid_preparation_temperature_required,
+	valid_preparations.time_estimate_required as valid_preparation_time_estimate_required,
+	valid_preparations.condition_expression_required as valid_preparation_condition_expression_required,
+	valid_preparations.consumes_vessel as valid_preparation_consumes_vessel,
+	valid_preparations.only_for_vessels as valid_preparation_only_for_vessels,
+	valid_preparations.minimum_vessel_count as valid_preparation_minimum_vessel_count,
+	valid_preparations.maximum_vessel_count as valid_preparation_maximum_vessel_count,
+	valid_preparations.last_indexed_at as valid_preparation_last_indexed_at,
+	valid_preparations.created_at as valid_preparation_created_at,
+	valid_preparations.last_updated_at as valid_preparation_last_updated_at,
+	valid_preparations.archived_at as valid_preparation_archived_at,
+	valid_ingredient_substitutions.ratio as valid_ingredient_substitution_ratio,
+	valid_ingredient_substitutions.reasons as valid_ingredient_substitution_reasons,
+	valid_ingredient_substitutions.notes as valid_ingredient_substitution_notes,
+	valid_ingredient_substitutions.created_at as valid_ingredient_substitution_created_at,
+	valid_ingredient_substitutions.last_updated_at as valid_ingredient_substitution_last_updated_at,
+	valid_ingredient_substitutions.archived_at as valid_ingredient_substitution_archived_at,
+	(
+		SELECT COUNT(valid_ingredient_substitutions.id)
+		FROM valid_ingredient_substitutions
+		WHERE valid_ingredient_substitutions.archived_at IS NULL
+			AND valid_ingredient_substitutions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND valid_ingredient_substitutions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				valid_ingredient_substitutions.last_updated_at IS NULL
+				OR valid_ingredient_substitutions.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				valid_ingredient_substitutions.last_updated_at IS NULL
+				OR valid_ingredient_substitutions.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+	) AS filtered_count,
+	(
+		SELECT COUNT(valid_ingredient_substitutions.id)
+		FROM valid_ingredient_substitutions
+		WHERE valid_ingredient_substitutions.archived_at IS NULL
+	) AS total_count
+FROM valid_ingredient_substitutions
+	JOIN valid_ingredients AS valid_ingredients_original ON valid_ingredient_substitutions.original_ingredient = valid_ingredients_original.id
+	JOIN valid_ingredients AS valid_ingredients_substitute ON valid_ingredient_substitutions.substitute_ingredient = valid_ingredients_substitute.id
+	LEFT JOIN valid_preparations ON valid_ingredient_substitutions.valid_preparation_id = valid_preparations.id
+WHERE
+	valid_ingredient_substitutions.archived_at IS NULL
+	AND valid_ingredients_original.archived_at IS NULL
+	AND valid_ingredients_substitute.archived_at IS NULL
+	AND valid_ingredient_substitutions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND valid_ingredient_substitutions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		valid_ingredient_substitutions.last_updated_at IS NULL
+		OR valid_ingredient_substitutions.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		valid_ingredient_substitutions.last_updated_at IS NULL
+		OR valid_ingredient_substitutions.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: GetValidIngredientSubstitutionsForIngredient :many
+
+SELECT
+	valid_ingredient_substitutions.id as valid_ingredient_substitution_id,
+	valid_ingredients_original.id as original_ingredient_id,
+	valid_ingredients_original.name as original_ingredient_name,
+	valid_ingredients_original.description as original_ingredient_description,
+	valid_ingredients_original.warning as original_ingredient_warning,
+	valid_ingredients_original.contains_egg as original_ingredient_contains_egg,
+	valid_ingredients_original.contains_dairy as original_ingredient_contains_dairy,
+	valid_ingredients_original.contains_peanut as original_ingredient_contains_peanut,
+	valid_ingredients_original.contains_tree_nut as original_ingredient_contains_tree_nut,
+	valid_ingredients_original.contains_soy as original_ingredient_contains_soy,
+	valid_ingredients_original.contains_wheat as original_ingredient_contains_wheat,
+	valid_ingredients_original.contains_shellfish as original_ingredient_contains_shellfish,
+	valid_ingredients_original.contains_sesame as original_ingredient_contains_sesame,
+	valid_ingredients_original.contains_fish as original_ingredient_contains_fish,
+	valid_ingredients_original.contains_gluten as original_ingredient_contains_gluten,
+	valid_ingredients_original.animal_flesh as original_ingredient_animal_flesh,
+	valid_ingredients_original.volumetric as original_ingredient_volumetric,
+	valid_ingredients_original.is_liquid as original_ingredient_is_liquid,
+	valid_ingredients_original.icon_path as original_ingredient_icon_path,
+	valid_ingredients_original.animal_derived as original_ingredient_animal_derived,
+	valid_ingredients_original.plural_name as original_ingredient_plural_name,
+	valid_ingredients_original.restrict_to_preparations as original_ingredient_restrict_to_preparations,
+	valid_ingredients_original.minimum_ideal_storage_temperature_in_celsius as original_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.maximum_ideal_storage_temperature_in_celsius as original_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.storage_instructions as original_ingredient_storage_instructions,
+	valid_ingredients_original.slug as original_ingredient_slug,
+	valid_ingredients_original.contains_alcohol as original_ingredient_contains_alcohol,
+	valid_ingredients_original.shopping_suggestions as original_ingredient_shopping_suggestions,
+	valid_ingredients_original.is_starch as original_ingredient_is_starch,
+	valid_ingredients_original.is_protein as original_ingredient_is_protein,
+	valid_ingredients_original.is_grain as original_ingredient_is_grain,
+	valid_ingredients_original.is_fruit as original_ingredient_is_fruit,
+	valid_ingredients_original.is_salt as original_ingredient_is_salt,
+	valid_ingredients_original.is_fat as original_ingredient_is_fat,
+	valid_ingredients_original.is_acid as original_ingredient_is_acid,
+	valid_ingredients_original.is_heat as original_ingredient_is_heat,
+	valid_ingredients_original.last_indexed_at as original_ingredient_last_indexed_at,
+	valid_ingredients_original.created_at as original_ingredient_created_at,
+	valid_ingredients_original.last_updated_at as original_ingredient_last_updated_at,
+	valid_ingredients_original.archived_at as original_ingredient_archived_at,
+	valid_ingredients_substitute.id as substitute_ingredient_id,
+	valid_ingredients_substitute.name as substitute_ingredient_name,
+	valid_ingredients_substitute.description as substitute_ingredient_description,
+	valid_ingredients_substitute.warning as substitute_ingredient_warning,
+	valid_ingredients_substitute.contains_egg as substitute_ingredient_contains_egg,
+	valid_ingredients_substitute.contains_dairy as substitute_ingredient_contains_dairy,
+	valid_ingredients_substitute.contains_peanut as substitute_ingredient_contains_peanut,
+	valid_ingredients_substitute.contains_tree_nut as substitute_ingredient_contains_tree_nut,
+	valid_ingredients_substitute.contains_soy as substitute_ingredient_contains_soy,
+	valid_ingredients_substitute.contains_wheat as substitute_ingredient_contains_wheat,
+	valid_ingredients_substitute.contains_shellfish as substitute_ingredient_contains_shellfish,
+	valid_ingredients_substitute.contains_sesame as substitute_ingredient_contains_sesame,
+	valid_ingredients_substitute.contains_fish as substitute_ingredient_contains_fish,
+	valid_ingredients_substitute.contains_gluten as substitute_ingredient_contains_gluten,
+	valid_ingredients_substitute.animal_flesh as substitute_ingredient_animal_flesh,
+	valid_ingredients_substitute.volumetric as substitute_ingredient_volumetric,
+	valid_ingredients_substitute.is_liquid as substitute_ingredient_is_liquid,
+	valid_ingredients_substitute.icon_path as substitute_ingredient_icon_path,
+	valid_ingredients_substitute.animal_derived as substitute_ingredient_animal_derived,
+	valid_ingredients_substitute.plural_name as substitute_ingredient_plural_name,
+	valid_ingredients_substitute.restrict_to_preparations as substitute_ingredient_restrict_to_preparations,
+	valid_ingredients_substitute.minimum_ideal_storage_temperature_in_celsius as substitute_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.maximum_ideal_storage_temperature_in_celsius as substitute_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.storage_instructions as substitute_ingredient_storage_instructions,
+	valid_ingredients_substitute.slug as substitute_ingredient_slug,
+	valid_ingredients_substitute.contains_alcohol as substitute_ingredient_contains_alcohol,
+	valid_ingredients_substitute.shopping_suggestions as substitute_ingredient_shopping_suggestions,
+	valid_ingredients_substitute.is_starch as substitute_ingredient_is_starch,
+	valid_ingredients_substitute.is_protein as substitute_ingredient_is_protein,
+	valid_ingredients_substitute.is_grain as substitute_ingredient_is_grain,
+	valid_ingredients_substitute.is_fruit as substitute_ingredient_is_fruit,
+	valid_ingredients_substitute.is_salt as substitute_ingredient_is_salt,
+	valid_ingredients_substitute.is_fat as substitute_ingredient_is_fat,
+	valid_ingredients_substitute.is_acid as substitute_ingredient_is_acid,
+	valid_ingredients_substitute.is_heat as substitute_ingredient_is_heat,
+	valid_ingredients_substitute.last_indexed_at as substitute_ingredient_last_indexed_at,
+	valid_ingredients_substitute.created_at as substitute_ingredient_created_at,
+	valid_ingredients_substitute.last_updated_at as substitute_ingredient_last_updated_at,
+	valid_ingredients_substitute.archived_at as substitute_ingredient_archived_at,
+	valid_preparations.id as valid_preparation_id,
+	valid_preparations.name as valid_preparation_name,
+	valid_preparations.description as valid_preparation_description,
+	valid_preparations.icon_path as valid_preparation_icon_path,
+	valid_preparations.yields_nothing as valid_preparation_yields_nothing,
+	valid_preparations.restrict_to_ingredients as valid_preparation_restrict_to_ingredients,
+	valid_preparations.past_tense as valid_preparation_past_tense,
+	valid_preparations.slug as valid_preparation_slug,
+	valid_preparations.minimum_ingredient_count as valid_preparation_minimum_ingredient_count,
+	valid_preparations.maximum_ingredient_count as valid_preparation_maximum_ingredient_count,
+	valid_preparations.minimum_instrument_count as valid_preparation_minimum_instrument_count,
+	valid_preparations.maximum_instrument_count as valid_preparation_maximum_instrument_count,
+	valid_preparations.temperature_required as valid_preparation_temperature_required,
+	valid_preparations.time_estimate_required as valid_preparation_time_estimate_required,
+	valid_preparations.condition_expression_required as valid_preparation_condition_expression_required,
+	valid_preparations.consumes_vessel as valid_preparation_consumes_vessel,
+	valid_preparations.only_for_vessels as valid_preparation_only_for_vessels,
+	valid_preparations.minimum_vessel_count as valid_preparation_minimum_vessel_count,
+	valid_preparations.maximum_vessel_count as valid_preparation_maximum_vessel_count,
+	valid_preparations.last_indexed_at as valid_preparation_last_indexed_at,
+	valid_preparations.created_at as valid_preparation_created_at,
+	valid_preparations.last_updated_at as valid_preparation_last_updated_at,
+	valid_preparations.archived_at as valid_preparation_archived_at,
+	valid_ingredient_substitutions.ratio as valid_ingredient_substitution_ratio,
+	valid_ingredient_substitutions.reasons as valid_ingredient_substitution_reasons,
+	valid_ingredient_substitutions.notes as valid_ingredient_substitution_notes,
+	valid_ingredient_substitutions.created_at as valid_ingredient_substitution_created_at,
+	valid_ingredient_substitutions.last_updated_at as valid_ingredient_substitution_last_updated_at,
+	valid_ingredient_substitutions.archived_at as valid_ingredient_substitution_archived_at,
+	(
+		SELECT COUNT(valid_ingredient_substitutions.id)
+		FROM valid_ingredient_substitutions
+		WHERE valid_ingredient_substitutions.archived_at IS NULL
+			AND valid_ingredient_substitutions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND valid_ingredient_substitutions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				valid_ingredient_substitutions.last_updated_at IS NULL
+				OR valid_ingredient_substitutions.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				valid_ingredient_substitutions.last_updated_at IS NULL
+				OR valid_ingredient_substitutions.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+	) AS filtered_count,
+	(
+		SELECT COUNT(valid_ingredient_substitutions.id)
+		FROM valid_ingredient_substitutions
+		WHERE valid_ingredient_substitutions.archived_at IS NULL
+	) AS total_count
+FROM valid_ingredient_substitutions
+	JOIN valid_ingredients AS valid_ingredients_original ON valid_ingredient_substitutions.original_ingredient = valid_ingredients_original.id
+	JOIN valid_ingredients AS valid_ingredients_substitute ON valid_ingredient_substitutions.substitute_ingredient = valid_ingredients_substitute.id
+	LEFT JOIN valid_preparations ON valid_ingredient_substitutions.valid_preparation_id = valid_preparations.id
+WHERE
+	valid_ingredient_substitutions.archived_at IS NULL
+	AND valid_ingredients_original.archived_at IS NULL
+	AND valid_ingredients_substitute.archived_at IS NULL
+	AND valid_ingredient_substitutions.original_ingredient = sqlc.arg(original_ingredient)
+	AND valid_ingredient_substitutions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND valid_ingredient_substitutions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		valid_ingredient_substitutions.last_updated_at IS NULL
+		OR valid_ingredient_substitutions.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		valid_ingredient_substitutions.last_updated_at IS NULL
+		OR valid_ingredient_substitutions.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: GetValidIngredientSubstitutionsForIngredients :many
+
+SELECT
+	valid_ingredient_substitutions.id as valid_ingredient_substitution_id,
+	valid_ingredients_original.id as original_ingredient_id,
+	valid_ingredients_original.name as original_ingredient_name,
+	valid_ingredients_original.description as original_ingredient_description,
+	valid_ingredients_original.warning as original_ingredient_warning,
+	valid_ingredients_original.contains_egg as original_ingredient_contains_egg,
+	valid_ingredients_original.contains_dairy as original_ingredient_contains_dairy,
+	valid_ingredients_original.contains_peanut as original_ingredient_contains_peanut,
+	valid_ingredients_original.contains_tree_nut as original_ingredient_contains_tree_nut,
+	valid_ingredients_original.contains_soy as original_ingredient_contains_soy,
+	valid_ingredients_original.contains_wheat as original_ingredient_contains_wheat,
+	valid_ingredients_original.contains_shellfish as original_ingredient_contains_shellfish,
+	valid_ingredients_original.contains_sesame as original_ingredient_contains_sesame,
+	valid_ingredients_original.contains_fish as original_ingredient_contains_fish,
+	valid_ingredients_original.contains_gluten as original_ingredient_contains_gluten,
+	valid_ingredients_original.animal_flesh as original_ingredient_animal_flesh,
+	valid_ingredients_original.volumetric as original_ingredient_volumetric,
+	valid_ingredients_original.is_liquid as original_ingredient_is_liquid,
+	valid_ingredients_original.icon_path as original_ingredient_icon_path,
+	valid_ingredients_original.animal_derived as original_ingredient_animal_derived,
+	valid_ingredients_original.plural_name as original_ingredient_plural_name,
+	valid_ingredients_original.restrict_to_preparations as original_ingredient_restrict_to_preparations,
+	valid_ingredients_original.minimum_ideal_storage_temperature_in_celsius as original_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.maximum_ideal_storage_temperature_in_celsius as original_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_original.storage_instructions as original_ingredient_storage_instructions,
+	valid_ingredients_original.slug as original_ingredient_slug,
+	valid_ingredients_original.contains_alcohol as original_ingredient_contains_alcohol,
+	valid_ingredients_original.shopping_suggestions as original_ingredient_shopping_suggestions,
+	valid_ingredients_original.is_starch as original_ingredient_is_starch,
+	valid_ingredients_original.is_protein as original_ingredient_is_protein,
+	valid_ingredients_original.is_grain as original_ingredient_is_grain,
+	valid_ingredients_original.is_fruit as original_ingredient_is_fruit,
+	valid_ingredients_original.is_salt as original_ingredient_is_salt,
+	valid_ingredients_original.is_fat as original_ingredient_is_fat,
+	valid_ingredients_original.is_acid as original_ingredient_is_acid,
+	valid_ingredients_original.is_heat as original_ingredient_is_heat,
+	valid_ingredients_original.last_indexed_at as original_ingredient_last_indexed_at,
+	valid_ingredients_original.created_at as original_ingredient_created_at,
+	valid_ingredients_original.last_updated_at as original_ingredient_last_updated_at,
+	valid_ingredients_original.archived_at as original_ingredient_archived_at,
+	valid_ingredients_substitute.id as substitute_ingredient_id,
+	valid_ingredients_substitute.name as substitute_ingredient_name,
+	valid_ingredients_substitute.description as substitute_ingredient_description,
+	valid_ingredients_substitute.warning as substitute_ingredient_warning,
+	valid_ingredients_substitute.contains_egg as substitute_ingredient_contains_egg,
+	valid_ingredients_substitute.contains_dairy as substitute_ingredient_contains_dairy,
+	valid_ingredients_substitute.contains_peanut as substitute_ingredient_contains_peanut,
+	valid_ingredients_substitute.contains_tree_nut as substitute_ingredient_contains_tree_nut,
+	valid_ingredients_substitute.contains_soy as substitute_ingredient_contains_soy,
+	valid_ingredients_substitute.contains_wheat as substitute_ingredient_contains_wheat,
+	valid_ingredients_substitute.contains_shellfish as substitute_ingredient_contains_shellfish,
+	valid_ingredients_substitute.contains_sesame as substitute_ingredient_contains_sesame,
+	valid_ingredients_substitute.contains_fish as substitute_ingredient_contains_fish,
+	valid_ingredients_substitute.contains_gluten as substitute_ingredient_contains_gluten,
+	valid_ingredients_substitute.animal_flesh as substitute_ingredient_animal_flesh,
+	valid_ingredients_substitute.volumetric as substitute_ingredient_volumetric,
+	valid_ingredients_substitute.is_liquid as substitute_ingredient_is_liquid,
+	valid_ingredients_substitute.icon_path as substitute_ingredient_icon_path,
+	valid_ingredients_substitute.animal_derived as substitute_ingredient_animal_derived,
+	valid_ingredients_substitute.plural_name as substitute_ingredient_plural_name,
+	valid_ingredients_substitute.restrict_to_preparations as substitute_ingredient_restrict_to_preparations,
+	valid_ingredients_substitute.minimum_ideal_storage_temperature_in_celsius as substitute_ingredient_minimum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.maximum_ideal_storage_temperature_in_celsius as substitute_ingredient_maximum_ideal_storage_temperature_in_celsius,
+	valid_ingredients_substitute.storage_instructions as substitute_ingredient_storage_instructions,
+	valid_ingredients_substitute.slug as substitute_ingredient_slug,
+	valid_ingredients_substitute.contains_alcohol as substitute_ingredient_contains_alcohol,
+	valid_ingredients_substitute.shopping_suggestions as substitute_ingredient_shopping_suggestions,
+	valid_ingredients_substitute.is_starch as substitute_ingredient_is_starch,
+	valid_ingredients_substitute.is_protein as substitute_ingredient_is_protein,
+	valid_ingredients_substitute.is_grain as substitute_ingredient_is_grain,
+	valid_ingredients_substitute.is_fruit as substitute_ingredient_is_fruit,
+	valid_ingredients_substitute.is_salt as substitute_ingredient_is_salt,
+	valid_ingredients_substitute.is_fat as substitute_ingredient_is_fat,
+	valid_ingredients_substitute.is_acid as substitute_ingredient_is_acid,
+	valid_ingredients_substitute.is_heat as substitute_ingredient_is_heat,
+	valid_ingredients_substitute.last_indexed_at as substitute_ingredient_last_indexed_at,
+	valid_ingredients_substitute.created_at as substitute_ingredient_created_at,
+	valid_ingredients_substitute.last_updated_at as substitute_ingredient_last_updated_at,
+	valid_ingredients_substitute.archived_at as substitute_ingredient_archived_at,
+	valid_preparations.id as valid_preparation_id,
+	valid_preparations.name as valid_preparation_name,
+	valid_preparations.description as valid_preparation_description,
+	valid_preparations.icon_path as valid_preparation_icon_path,
+	valid_preparations.yields_nothing as valid_preparation_yields_nothing,
+	valid_preparations.restrict_to_ingredients as valid_preparation_restrict_to_ingredients,
+	valid_preparations.past_tense as valid_preparation_past_tense,
+	valid_preparations.slug as valid_preparation_slug,
+	valid_preparations.minimum_ingredient_count as valid_preparation_minimum_ingredient_count,
+	valid_preparations.maximum_ingredient_count as valid_preparation_maximum_ingredient_count,
+	valid_preparations.minimum_instrument_count as valid_preparation_minimum_instrument_count,
+	valid_preparations.maximum_instrument_count as valid_preparation_maximum_instrument_count,
+	valid_preparations.temperature_required as valid_preparation_temperature_required,
+	valid_preparations.time_estimate_required as valid_preparation_time_estimate_required,
+	valid_preparations.condition_expression_required as valid_preparation_condition_expression_required,
+	valid_preparations.consumes_vessel as valid_preparation_consumes_vessel,
+	valid_preparations.only_for_vessels as valid_preparation_only_for_vessels,
+	valid_preparations.minimum_vessel_count as valid_preparation_minimum_vessel_count,
+	valid_preparations.maximum_vessel_count as valid_preparation_maximum_vessel_count,
+	valid_preparations.last_indexed_at as valid_preparation_last_indexed_at,
+	valid_preparations.created_at as valid_preparation_created_at,
+	valid_preparations.last_updated_at as valid_preparation_last_updated_at,
+	valid_preparations.archived_at as valid_preparation_archived_at,
+	valid_ingredient_substitutions.ratio as valid_ingredient_substitution_ratio,
+	valid_ingredient_substitutions.reasons as valid_ingredient_substitution_reasons,
+	valid_ingredient_substitutions.notes as valid_ingredient_substitution_notes,
+	valid_ingredient_substitutions.created_at as valid_ingredient_substitution_created_at,
+	valid_ingredient_substitutions.last_updated_at as valid_ingredient_substitution_last_updated_at,
+	valid_ingredient_substitutions.archived_at as valid_ingredient_substitution_archived_at
+FROM valid_ingredient_substitutions
+	JOIN valid_ingredients AS valid_ingredients_original ON valid_ingredient_substitutions.original_ingredient = valid_ingredients_original.id
+	JOIN valid_ingredients AS valid_ingredients_substitute ON valid_ingredient_substitutions.substitute_ingredient = valid_ingredients_substitute.id
+	LEFT JOIN valid_preparations ON valid_ingredient_substitutions.valid_preparation_id = valid_preparations.id
+WHERE
+	valid_ingredient_substitutions.archived_at IS NULL
+	AND valid_ingredients_original.archived_at IS NULL
+	AND valid_ingredients_substitute.archived_at IS NULL
+	AND valid_ingredient_substitutions.original_ingredient = ANY(sqlc.arg(ids)::text[]);
+
+-- name: UpdateValidIngredientSubstitution :execrows
+
+UPDATE valid_ingredient_substitutions SET
+	original_ingredient = sqlc.arg(original_ingredient),
+	substitute_ingredient = sqlc.arg(substitute_ingredient),
+	valid_preparation_id = sqlc.arg(valid_preparation_id),
+	ratio = sqlc.arg(ratio),
+	reasons = sqlc.arg(reasons),
+	notes = sqlc.arg(notes),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
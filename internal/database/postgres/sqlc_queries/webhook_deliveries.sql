@@ -0,0 +1,115 @@
+-- name: CreateWebhookDelivery :exec
+
+INSERT INTO webhook_deliveries (
+	id,
+	delivery_id,
+	trigger_event,
+	payload,
+	attempt,
+	response_status_code,
+	response_body,
+	error,
+	latency_in_milliseconds,
+	succeeded,
+	next_attempt_at,
+	belongs_to_webhook
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(delivery_id),
+	sqlc.arg(trigger_event),
+	sqlc.arg(payload),
+	sqlc.arg(attempt),
+	sqlc.narg(response_status_code),
+	sqlc.arg(response_body),
+	sqlc.arg(error),
+	sqlc.arg(latency_in_milliseconds),
+	sqlc.arg(succeeded),
+	sqlc.narg(next_attempt_at),
+	sqlc.arg(belongs_to_webhook)
+);
+
+-- name: GetWebhookDelivery :one
+
+SELECT
+	webhook_deliveries.id,
+	webhook_deliveries.delivery_id,
+	webhook_deliveries.trigger_event,
+	webhook_deliveries.payload,
+	webhook_deliveries.attempt,
+	webhook_deliveries.response_status_code,
+	webhook_deliveries.response_body,
+	webhook_deliveries.error,
+	webhook_deliveries.latency_in_milliseconds,
+	webhook_deliveries.succeeded,
+	webhook_deliveries.next_attempt_at,
+	webhook_deliveries.created_at,
+	webhook_deliveries.belongs_to_webhook
+FROM webhook_deliveries
+WHERE webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+	AND webhook_deliveries.id = sqlc.arg(id);
+
+-- name: GetWebhookDeliveriesForWebhook :many
+
+SELECT
+	webhook_deliveries.id,
+	webhook_deliveries.delivery_id,
+	webhook_deliveries.trigger_event,
+	webhook_deliveries.payload,
+	webhook_deliveries.attempt,
+	webhook_deliveries.response_status_code,
+	webhook_deliveries.response_body,
+	webhook_deliveries.error,
+	webhook_deliveries.latency_in_milliseconds,
+	webhook_deliveries.succeeded,
+	webhook_deliveries.next_attempt_at,
+	webhook_deliveries.created_at,
+	webhook_deliveries.belongs_to_webhook,
+	(
+		SELECT COUNT(webhook_deliveries.id)
+		FROM webhook_deliveries
+		WHERE webhook_deliveries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND webhook_deliveries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+	) AS filtered_count,
+	(
+		SELECT COUNT(webhook_deliveries.id)
+		FROM webhook_deliveries
+		WHERE
+			webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+	) AS total_count
+FROM webhook_deliveries
+WHERE webhook_deliveries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND webhook_deliveries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+ORDER BY webhook_deliveries.created_at DESC
+LIMIT sqlc.narg(query_limit)
+OFFSET sqlc.narg(query_offset);
+
+-- name: ClaimDueWebhookDeliveryRetries :many
+
+UPDATE webhook_deliveries SET
+	next_attempt_at = sqlc.arg(claimed_until)
+FROM webhooks
+WHERE webhook_deliveries.id IN (
+	SELECT webhook_deliveries.id
+	FROM webhook_deliveries
+		JOIN webhooks ON webhooks.id = webhook_deliveries.belongs_to_webhook
+	WHERE webhook_deliveries.next_attempt_at <= NOW()
+		AND webhooks.archived_at IS NULL
+		AND webhooks.disabled_at IS NULL
+	ORDER BY webhook_deliveries.next_attempt_at
+	LIMIT sqlc.arg(batch_size)
+	FOR UPDATE OF webhook_deliveries SKIP LOCKED
+)
+	AND webhooks.id = webhook_deliveries.belongs_to_webhook
+RETURNING
+	webhook_deliveries.id,
+	webhook_deliveries.trigger_event,
+	webhook_deliveries.belongs_to_webhook,
+	webhooks.belongs_to_household;
+
+-- name: MarkWebhookDeliveryAsRetried :exec
+
+UPDATE webhook_deliveries SET
+	next_attempt_at = NULL
+WHERE id = sqlc.arg(id);
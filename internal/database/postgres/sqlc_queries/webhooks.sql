@@ -50,6 +50,7 @@ SELECT
 	webhooks.created_at,
 	webhooks.last_updated_at,
 	webhooks.archived_at,
+	webhooks.disabled_at,
 	webhooks.belongs_to_household,
 	(
 		SELECT COUNT(webhooks.id)
@@ -103,13 +104,15 @@ SELECT
 	webhooks.created_at,
 	webhooks.last_updated_at,
 	webhooks.archived_at,
+	webhooks.disabled_at,
 	webhooks.belongs_to_household
 FROM webhooks
 	JOIN webhook_trigger_events ON webhooks.id = webhook_trigger_events.belongs_to_webhook
 WHERE webhook_trigger_events.archived_at IS NULL
 	AND webhook_trigger_events.trigger_event = sqlc.arg(trigger_event)
 	AND webhooks.belongs_to_household = sqlc.arg(belongs_to_household)
-	AND webhooks.archived_at IS NULL;
+	AND webhooks.archived_at IS NULL
+	AND webhooks.disabled_at IS NULL;
 
 -- name: GetWebhook :many
 
@@ -127,6 +130,7 @@ SELECT
 	webhooks.created_at as webhook_created_at,
 	webhooks.last_updated_at as webhook_last_updated_at,
 	webhooks.archived_at as webhook_archived_at,
+	webhooks.disabled_at as webhook_disabled_at,
 	webhooks.belongs_to_household as webhook_belongs_to_household
 FROM webhooks
 	JOIN webhook_trigger_events ON webhooks.id = webhook_trigger_events.belongs_to_webhook
@@ -134,3 +138,27 @@ WHERE webhook_trigger_events.archived_at IS NULL
 	AND webhooks.archived_at IS NULL
 	AND webhooks.belongs_to_household = sqlc.arg(belongs_to_household)
 	AND webhooks.id = sqlc.arg(id);
+
+-- name: RecordWebhookDeliveryFailure :one
+
+UPDATE webhooks SET
+	consecutive_failures = consecutive_failures + 1
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+RETURNING consecutive_failures;
+
+-- name: RecordWebhookDeliverySuccess :exec
+
+UPDATE webhooks SET
+	consecutive_failures = 0,
+	disabled_at = NULL
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
+
+-- name: DisableWebhook :execrows
+
+UPDATE webhooks SET
+	disabled_at = NOW()
+WHERE archived_at IS NULL
+	AND disabled_at IS NULL
+	AND id = sqlc.arg(id);
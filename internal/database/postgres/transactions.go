@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+)
+
+type (
+	// transaction is a unit of work that is either committed or rolled back as a whole.
+	transaction interface {
+		database.SQLQueryExecutorAndTransactionManager
+		Commit() error
+	}
+
+	transactionContextKey struct{}
+
+	// ambientTransaction is the transaction that every query made with a given context runs in.
+	ambientTransaction struct {
+		tx         *sql.Tx
+		savepoints atomic.Uint64
+	}
+
+	// savepoint is a transaction nested inside an ambient one.
+	savepoint struct {
+		*sql.Tx
+		ctx  context.Context
+		name string
+		done bool
+	}
+)
+
+// Commit releases the savepoint, folding its changes into the ambient transaction.
+func (s *savepoint) Commit() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+	s.done = true
+
+	_, err := s.ExecContext(s.ctx, fmt.Sprintf("RELEASE SAVEPOINT %s", s.name))
+	return err
+}
+
+// Rollback discards everything done since the savepoint was created, leaving the ambient transaction usable.
+func (s *savepoint) Rollback() error {
+	if s.done {
+		return sql.ErrTxDone
+	}
+	s.done = true
+
+	_, err := s.ExecContext(s.ctx, fmt.Sprintf("ROLLBACK TO SAVEPOINT %s", s.name))
+	return err
+}
+
+// ambientTransactionFrom fetches the ambient transaction from a context, if there is one.
+func ambientTransactionFrom(ctx context.Context) (*ambientTransaction, bool) {
+	at, ok := ctx.Value(transactionContextKey{}).(*ambientTransaction)
+	return at, ok && at != nil
+}
+
+// dbFor returns what queries made with the provided context should be executed against.
+func (q *Querier) dbFor(ctx context.Context) database.SQLQueryExecutor {
+	if at, ok := ambientTransactionFrom(ctx); ok {
+		return at.tx
+	}
+
+	return q.db
+}
+
+// beginTx starts a transaction, or a savepoint if the context already carries one.
+func (q *Querier) beginTx(ctx context.Context) (transaction, error) {
+	at, ok := ambientTransactionFrom(ctx)
+	if !ok {
+		tx, err := q.db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return tx, nil
+	}
+
+	name := fmt.Sprintf("savepoint_%d", at.savepoints.Add(1))
+	if _, err := at.tx.ExecContext(ctx, fmt.Sprintf("SAVEPOINT %s", name)); err != nil {
+		return nil, err
+	}
+
+	return &savepoint{Tx: at.tx, ctx: ctx, name: name}, nil
+}
+
+// RunInTransaction calls the provided function with a context whose queries all run in a single transaction,
+// which is committed if the function succeeds and rolled back otherwise. Calls made with a context that already
+// carries a transaction run in a savepoint within it.
+func (q *Querier) RunInTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	tx, err := q.beginTx(ctx)
+	if err != nil {
+		return observability.PrepareAndLogError(err, q.logger, span, "beginning transaction")
+	}
+
+	txCtx := ctx
+	if sqlTx, ok := tx.(*sql.Tx); ok {
+		txCtx = context.WithValue(ctx, transactionContextKey{}, &ambientTransaction{tx: sqlTx})
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			q.rollbackTransaction(ctx, tx)
+			panic(recovered)
+		}
+	}()
+
+	if err = fn(txCtx); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, q.logger, span, "committing transaction")
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_RunInTransaction(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectExec("UPDATE things").WillReturnResult(sqlmock.NewResult(0, 1))
+		db.ExpectCommit()
+
+		assert.NoError(t, c.RunInTransaction(ctx, func(ctx context.Context) error {
+			assert.NotEqual(t, c.db, c.dbFor(ctx))
+
+			_, err := c.dbFor(ctx).ExecContext(ctx, "UPDATE things")
+			return err
+		}))
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("rolls back when the function fails", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectRollback()
+
+		expectedErr := errors.New("blah")
+		assert.ErrorIs(t, c.RunInTransaction(ctx, func(context.Context) error {
+			return expectedErr
+		}), expectedErr)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("rolls back when the function panics", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectRollback()
+
+		assert.Panics(t, func() {
+			_ = c.RunInTransaction(ctx, func(context.Context) error {
+				panic("blah")
+			})
+		})
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("with error beginning transaction", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin().WillReturnError(errors.New("blah"))
+
+		called := false
+		assert.Error(t, c.RunInTransaction(ctx, func(context.Context) error {
+			called = true
+			return nil
+		}))
+		assert.False(t, called)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("with error committing transaction", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectCommit().WillReturnError(errors.New("blah"))
+
+		assert.Error(t, c.RunInTransaction(ctx, func(context.Context) error {
+			return nil
+		}))
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("nests with savepoints", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectExec("SAVEPOINT savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectExec("ROLLBACK TO SAVEPOINT savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectExec("SAVEPOINT savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectExec("RELEASE SAVEPOINT savepoint_2").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectCommit()
+
+		assert.NoError(t, c.RunInTransaction(ctx, func(ctx context.Context) error {
+			assert.Error(t, c.RunInTransaction(ctx, func(context.Context) error {
+				return errors.New("blah")
+			}))
+
+			return c.RunInTransaction(ctx, func(context.Context) error {
+				return nil
+			})
+		}))
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+}
+
+func TestQuerier_dbFor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("without transaction", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Equal(t, c.db, c.dbFor(ctx))
+	})
+}
+
+func TestSavepoint_Commit(T *testing.T) {
+	T.Parallel()
+
+	T.Run("cannot be finished twice", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, db := buildTestClient(t)
+
+		db.ExpectBegin()
+		db.ExpectExec("SAVEPOINT savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectExec("RELEASE SAVEPOINT savepoint_1").WillReturnResult(sqlmock.NewResult(0, 0))
+		db.ExpectRollback()
+
+		tx, err := c.db.BeginTx(ctx, nil)
+		require.NoError(t, err)
+
+		ctx = context.WithValue(ctx, transactionContextKey{}, &ambientTransaction{tx: tx})
+		sp, err := c.beginTx(ctx)
+		require.NoError(t, err)
+
+		assert.NoError(t, sp.Commit())
+		assert.Error(t, sp.Commit())
+		assert.Error(t, sp.Rollback())
+
+		assert.NoError(t, tx.Rollback())
+		mock.AssertExpectationsForObjects(t, db)
+	})
+}
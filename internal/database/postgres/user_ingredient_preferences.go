@@ -35,7 +35,7 @@ func (q *Querier) UserIngredientPreferenceExists(ctx context.Context, userIngred
 	logger = logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	exists, err = q.generatedQuerier.CheckUserIngredientPreferenceExistence(ctx, q.db, &generated.CheckUserIngredientPreferenceExistenceParams{
+	exists, err = q.generatedQuerier.CheckUserIngredientPreferenceExistence(ctx, q.dbFor(ctx), &generated.CheckUserIngredientPreferenceExistenceParams{
 		ID:            userIngredientPreferenceID,
 		BelongsToUser: userID,
 	})
@@ -65,7 +65,7 @@ func (q *Querier) GetUserIngredientPreference(ctx context.Context, userIngredien
 	logger = logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	result, err := q.generatedQuerier.GetUserIngredientPreference(ctx, q.db, &generated.GetUserIngredientPreferenceParams{
+	result, err := q.generatedQuerier.GetUserIngredientPreference(ctx, q.dbFor(ctx), &generated.GetUserIngredientPreferenceParams{
 		ID:            userIngredientPreferenceID,
 		BelongsToUser: userID,
 	})
@@ -150,7 +150,7 @@ func (q *Querier) GetUserIngredientPreferences(ctx context.Context, userID strin
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetUserIngredientPreferencesForUser(ctx, q.db, &generated.GetUserIngredientPreferencesForUserParams{
+	results, err := q.generatedQuerier.GetUserIngredientPreferencesForUser(ctx, q.dbFor(ctx), &generated.GetUserIngredientPreferencesForUserParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -247,7 +247,7 @@ func (q *Querier) CreateUserIngredientPreference(ctx context.Context, input *typ
 		validIngredientIDs = append(validIngredientIDs, input.ValidIngredientID)
 	}
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -311,7 +311,7 @@ func (q *Querier) UpdateUserIngredientPreference(ctx context.Context, updated *t
 	logger := q.logger.WithValue(keys.UserIngredientPreferenceIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.UserIngredientPreferenceIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateUserIngredientPreference(ctx, q.db, &generated.UpdateUserIngredientPreferenceParams{
+	if _, err := q.generatedQuerier.UpdateUserIngredientPreference(ctx, q.dbFor(ctx), &generated.UpdateUserIngredientPreferenceParams{
 		Ingredient:    updated.Ingredient.ID,
 		Notes:         updated.Notes,
 		ID:            updated.ID,
@@ -346,7 +346,7 @@ func (q *Querier) ArchiveUserIngredientPreference(ctx context.Context, userIngre
 	logger = logger.WithValue(keys.UserIngredientPreferenceIDKey, userIngredientPreferenceID)
 	tracing.AttachToSpan(span, keys.UserIngredientPreferenceIDKey, userIngredientPreferenceID)
 
-	if _, err := q.generatedQuerier.ArchiveUserIngredientPreference(ctx, q.db, &generated.ArchiveUserIngredientPreferenceParams{
+	if _, err := q.generatedQuerier.ArchiveUserIngredientPreference(ctx, q.dbFor(ctx), &generated.ArchiveUserIngredientPreferenceParams{
 		ID:            userIngredientPreferenceID,
 		BelongsToUser: userID,
 	}); err != nil {
@@ -39,7 +39,7 @@ func (q *Querier) UserNotificationExists(ctx context.Context, userID, userNotifi
 	logger = logger.WithValue(keys.UserNotificationIDKey, userNotificationID)
 	tracing.AttachToSpan(span, keys.UserNotificationIDKey, userNotificationID)
 
-	result, err := q.generatedQuerier.CheckUserNotificationExistence(ctx, q.db, &generated.CheckUserNotificationExistenceParams{
+	result, err := q.generatedQuerier.CheckUserNotificationExistence(ctx, q.dbFor(ctx), &generated.CheckUserNotificationExistenceParams{
 		ID:            userNotificationID,
 		BelongsToUser: userID,
 	})
@@ -69,7 +69,7 @@ func (q *Querier) GetUserNotification(ctx context.Context, userID, userNotificat
 	logger = logger.WithValue(keys.UserNotificationIDKey, userNotificationID)
 	tracing.AttachToSpan(span, keys.UserNotificationIDKey, userNotificationID)
 
-	result, err := q.generatedQuerier.GetUserNotification(ctx, q.db, &generated.GetUserNotificationParams{
+	result, err := q.generatedQuerier.GetUserNotification(ctx, q.dbFor(ctx), &generated.GetUserNotificationParams{
 		BelongsToUser: userID,
 		ID:            userNotificationID,
 	})
@@ -112,7 +112,7 @@ func (q *Querier) GetUserNotifications(ctx context.Context, userID string, filte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetUserNotificationsForUser(ctx, q.db, &generated.GetUserNotificationsForUserParams{
+	results, err := q.generatedQuerier.GetUserNotificationsForUser(ctx, q.dbFor(ctx), &generated.GetUserNotificationsForUserParams{
 		UserID:        userID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -151,7 +151,7 @@ func (q *Querier) CreateUserNotification(ctx context.Context, input *types.UserN
 	tracing.AttachToSpan(span, keys.UserNotificationIDKey, input.ID)
 	logger := q.logger.WithValue(keys.UserNotificationIDKey, input.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -205,7 +205,7 @@ func (q *Querier) UpdateUserNotification(ctx context.Context, updated *types.Use
 	logger := q.logger.WithValue(keys.UserNotificationIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.UserNotificationIDKey, updated.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
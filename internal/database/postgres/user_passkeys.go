@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.UserPasskeyDataManager = (*Querier)(nil)
+)
+
+// GetUserPasskeysForUser fetches a user's passkeys from the database.
+func (q *Querier) GetUserPasskeysForUser(ctx context.Context, userID string) ([]*types.UserPasskey, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.UserIDKey, userID)
+	tracing.AttachToSpan(span, keys.UserIDKey, userID)
+
+	results, err := q.generatedQuerier.GetUserPasskeysForUser(ctx, q.dbFor(ctx), userID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "getting passkeys for user")
+	}
+
+	passkeys := []*types.UserPasskey{}
+	for _, result := range results {
+		passkeys = append(passkeys, &types.UserPasskey{
+			CreatedAt:       result.CreatedAt,
+			LastUsedAt:      database.TimePointerFromNullTime(result.LastUsedAt),
+			ArchivedAt:      database.TimePointerFromNullTime(result.ArchivedAt),
+			ID:              result.ID,
+			Name:            result.Name,
+			CredentialID:    result.CredentialID,
+			AttestationType: result.AttestationType,
+			BelongsToUser:   result.BelongsToUser,
+			PublicKey:       result.PublicKey,
+			AAGUID:          result.Aaguid,
+			Transports:      result.Transports,
+			SignCount:       uint32(result.SignCount),
+			BackupEligible:  result.BackupEligible,
+			BackupState:     result.BackupState,
+		})
+	}
+
+	return passkeys, nil
+}
+
+// CreateUserPasskey creates a passkey in the database.
+func (q *Querier) CreateUserPasskey(ctx context.Context, input *types.UserPasskeyDatabaseCreationInput) (*types.UserPasskey, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(keys.UserPasskeyIDKey, input.ID).WithValue(keys.UserIDKey, input.BelongsToUser)
+	tracing.AttachToSpan(span, keys.UserPasskeyIDKey, input.ID)
+
+	transports := input.Transports
+	if transports == nil {
+		transports = []string{}
+	}
+
+	if err := q.generatedQuerier.CreateUserPasskey(ctx, q.dbFor(ctx), &generated.CreateUserPasskeyParams{
+		ID:              input.ID,
+		Name:            input.Name,
+		CredentialID:    input.CredentialID,
+		AttestationType: input.AttestationType,
+		BelongsToUser:   input.BelongsToUser,
+		PublicKey:       input.PublicKey,
+		Aaguid:          input.AAGUID,
+		Transports:      transports,
+		SignCount:       int64(input.SignCount),
+		BackupEligible:  input.BackupEligible,
+		BackupState:     input.BackupState,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing passkey creation query")
+	}
+
+	x := &types.UserPasskey{
+		CreatedAt:       q.currentTime(),
+		ID:              input.ID,
+		Name:            input.Name,
+		CredentialID:    input.CredentialID,
+		AttestationType: input.AttestationType,
+		BelongsToUser:   input.BelongsToUser,
+		PublicKey:       input.PublicKey,
+		AAGUID:          input.AAGUID,
+		Transports:      transports,
+		SignCount:       input.SignCount,
+		BackupEligible:  input.BackupEligible,
+		BackupState:     input.BackupState,
+	}
+
+	logger.Info("passkey created")
+
+	return x, nil
+}
+
+// MarkUserPasskeyAsUsed records a successful login with a passkey. It returns sql.ErrNoRows if the passkey doesn't exist.
+func (q *Querier) MarkUserPasskeyAsUsed(ctx context.Context, passkeyID string, signCount uint32, backupState bool) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if passkeyID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.UserPasskeyIDKey, passkeyID)
+	tracing.AttachToSpan(span, keys.UserPasskeyIDKey, passkeyID)
+
+	rowsAffected, err := q.generatedQuerier.MarkUserPasskeyAsUsed(ctx, q.dbFor(ctx), &generated.MarkUserPasskeyAsUsedParams{
+		ID:          passkeyID,
+		SignCount:   int64(signCount),
+		BackupState: backupState,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking passkey as used")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Info("passkey marked as used")
+
+	return nil
+}
+
+// ArchiveUserPasskey archives a user's passkey. It returns sql.ErrNoRows if the passkey doesn't exist.
+func (q *Querier) ArchiveUserPasskey(ctx context.Context, userID, passkeyID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.UserIDKey, userID)
+	tracing.AttachToSpan(span, keys.UserIDKey, userID)
+
+	if passkeyID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.UserPasskeyIDKey, passkeyID)
+	tracing.AttachToSpan(span, keys.UserPasskeyIDKey, passkeyID)
+
+	rowsAffected, err := q.generatedQuerier.ArchiveUserPasskey(ctx, q.dbFor(ctx), &generated.ArchiveUserPasskeyParams{
+		ID:            passkeyID,
+		BelongsToUser: userID,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving passkey")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	logger.Info("passkey archived")
+
+	return nil
+}
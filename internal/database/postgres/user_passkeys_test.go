@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUserPasskeyForTest(t *testing.T, ctx context.Context, exampleUserPasskey *types.UserPasskey, dbc *Querier) *types.UserPasskey {
+	t.Helper()
+
+	// create
+	if exampleUserPasskey == nil {
+		exampleUserPasskey = fakes.BuildFakeUserPasskey()
+	}
+	dbInput := converters.ConvertUserPasskeyToUserPasskeyDatabaseCreationInput(exampleUserPasskey)
+
+	created, err := dbc.CreateUserPasskey(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+
+	exampleUserPasskey.CreatedAt = created.CreatedAt
+	assert.Equal(t, exampleUserPasskey, created)
+
+	return created
+}
+
+func TestQuerier_Integration_UserPasskeys(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := createUserForTest(t, ctx, nil, dbc)
+
+	createdUserPasskeys := []*types.UserPasskey{}
+
+	// create
+	for i := 0; i < exampleQuantity; i++ {
+		input := fakes.BuildFakeUserPasskey()
+		input.BelongsToUser = user.ID
+		createdUserPasskeys = append(createdUserPasskeys, createUserPasskeyForTest(t, ctx, input, dbc))
+	}
+
+	// fetch as list
+	userPasskeys, err := dbc.GetUserPasskeysForUser(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Len(t, userPasskeys, len(createdUserPasskeys))
+
+	// use
+	assert.NoError(t, dbc.MarkUserPasskeyAsUsed(ctx, createdUserPasskeys[0].ID, createdUserPasskeys[0].SignCount+1, true))
+
+	// delete
+	for _, userPasskey := range createdUserPasskeys {
+		assert.NoError(t, dbc.ArchiveUserPasskey(ctx, user.ID, userPasskey.ID))
+	}
+
+	assert.ErrorIs(t, dbc.ArchiveUserPasskey(ctx, user.ID, createdUserPasskeys[0].ID), sql.ErrNoRows)
+
+	userPasskeys, err = dbc.GetUserPasskeysForUser(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, userPasskeys)
+}
+
+func TestQuerier_GetUserPasskeysForUser(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetUserPasskeysForUser(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateUserPasskey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateUserPasskey(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_MarkUserPasskeyAsUsed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid passkey ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.MarkUserPasskeyAsUsed(ctx, "", 1, false))
+	})
+}
+
+func TestQuerier_ArchiveUserPasskey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchiveUserPasskey(ctx, "", fakes.BuildFakeID()))
+	})
+
+	T.Run("with invalid passkey ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchiveUserPasskey(ctx, fakes.BuildFakeID(), ""))
+	})
+}
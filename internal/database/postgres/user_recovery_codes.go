@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.UserRecoveryCodeDataManager = (*Querier)(nil)
+)
+
+// CreateUserRecoveryCodes replaces a user's recovery codes with a new set.
+func (q *Querier) CreateUserRecoveryCodes(ctx context.Context, userID string, hashedCodes []string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.UserIDKey, userID)
+	tracing.AttachToSpan(span, keys.UserIDKey, userID)
+
+	if len(hashedCodes) == 0 {
+		return ErrEmptyInputProvided
+	}
+
+	if err := q.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err := q.generatedQuerier.ArchiveUserRecoveryCodesForUser(ctx, q.dbFor(ctx), userID); err != nil {
+			return observability.PrepareError(err, span, "archiving existing recovery codes")
+		}
+
+		for _, hashedCode := range hashedCodes {
+			if err := q.generatedQuerier.CreateUserRecoveryCode(ctx, q.dbFor(ctx), &generated.CreateUserRecoveryCodeParams{
+				ID:            identifiers.New(),
+				HashedCode:    hashedCode,
+				BelongsToUser: userID,
+			}); err != nil {
+				return observability.PrepareError(err, span, "creating recovery code")
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "replacing recovery codes")
+	}
+
+	logger.Info("recovery codes created")
+
+	return nil
+}
+
+// RedeemUserRecoveryCode marks a user's recovery code as redeemed, and returns whether there was an unused one to redeem.
+func (q *Querier) RedeemUserRecoveryCode(ctx context.Context, userID, hashedCode string) (bool, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return false, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.UserIDKey, userID)
+	tracing.AttachToSpan(span, keys.UserIDKey, userID)
+
+	if hashedCode == "" {
+		return false, ErrEmptyInputProvided
+	}
+
+	rowsAffected, err := q.generatedQuerier.RedeemUserRecoveryCode(ctx, q.dbFor(ctx), &generated.RedeemUserRecoveryCodeParams{
+		BelongsToUser: userID,
+		HashedCode:    hashedCode,
+	})
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "redeeming recovery code")
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	logger.Info("recovery code redeemed")
+
+	return true, nil
+}
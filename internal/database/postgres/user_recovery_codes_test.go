@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_UserRecoveryCodes(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := createUserForTest(t, ctx, nil, dbc)
+
+	originalCodes := []string{fakes.BuildFakeID(), fakes.BuildFakeID()}
+	require.NoError(t, dbc.CreateUserRecoveryCodes(ctx, user.ID, originalCodes))
+
+	// codes can only be redeemed once.
+	redeemed, err := dbc.RedeemUserRecoveryCode(ctx, user.ID, originalCodes[0])
+	assert.NoError(t, err)
+	assert.True(t, redeemed)
+
+	redeemed, err = dbc.RedeemUserRecoveryCode(ctx, user.ID, originalCodes[0])
+	assert.NoError(t, err)
+	assert.False(t, redeemed)
+
+	// generating new codes invalidates the old ones.
+	replacementCodes := []string{fakes.BuildFakeID()}
+	require.NoError(t, dbc.CreateUserRecoveryCodes(ctx, user.ID, replacementCodes))
+
+	redeemed, err = dbc.RedeemUserRecoveryCode(ctx, user.ID, originalCodes[1])
+	assert.NoError(t, err)
+	assert.False(t, redeemed)
+
+	redeemed, err = dbc.RedeemUserRecoveryCode(ctx, user.ID, replacementCodes[0])
+	assert.NoError(t, err)
+	assert.True(t, redeemed)
+}
+
+func TestQuerier_CreateUserRecoveryCodes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.CreateUserRecoveryCodes(ctx, "", []string{t.Name()}))
+	})
+
+	T.Run("without codes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.CreateUserRecoveryCodes(ctx, fakes.BuildFakeID(), nil))
+	})
+}
+
+func TestQuerier_RedeemUserRecoveryCode(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.RedeemUserRecoveryCode(ctx, "", t.Name())
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+
+	T.Run("without code", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.RedeemUserRecoveryCode(ctx, fakes.BuildFakeID(), "")
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+}
@@ -39,7 +39,7 @@ func (q *Querier) GetUser(ctx context.Context, userID string) (*types.User, erro
 
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	result, err := q.generatedQuerier.GetUserByID(ctx, q.db, userID)
+	result, err := q.generatedQuerier.GetUserByID(ctx, q.dbFor(ctx), userID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting user with verified two factor")
 	}
@@ -81,7 +81,7 @@ func (q *Querier) GetUserWithUnverifiedTwoFactorSecret(ctx context.Context, user
 	}
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	result, err := q.generatedQuerier.GetUserWithUnverifiedTwoFactor(ctx, q.db, userID)
+	result, err := q.generatedQuerier.GetUserWithUnverifiedTwoFactor(ctx, q.dbFor(ctx), userID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting user with verified two factor")
 	}
@@ -123,7 +123,7 @@ func (q *Querier) GetUserByUsername(ctx context.Context, username string) (*type
 	}
 	tracing.AttachToSpan(span, keys.UsernameKey, username)
 
-	result, err := q.generatedQuerier.GetUserByUsername(ctx, q.db, username)
+	result, err := q.generatedQuerier.GetUserByUsername(ctx, q.dbFor(ctx), username)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting user by username")
 	}
@@ -165,7 +165,7 @@ func (q *Querier) GetAdminUserByUsername(ctx context.Context, username string) (
 	}
 	tracing.AttachToSpan(span, keys.UsernameKey, username)
 
-	result, err := q.generatedQuerier.GetAdminUserByUsername(ctx, q.db, username)
+	result, err := q.generatedQuerier.GetAdminUserByUsername(ctx, q.dbFor(ctx), username)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, err
@@ -210,7 +210,7 @@ func (q *Querier) GetUserByEmail(ctx context.Context, email string) (*types.User
 	}
 	tracing.AttachToSpan(span, keys.UserEmailAddressKey, email)
 
-	result, err := q.generatedQuerier.GetUserByEmail(ctx, q.db, email)
+	result, err := q.generatedQuerier.GetUserByEmail(ctx, q.dbFor(ctx), email)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting user by email")
 	}
@@ -252,7 +252,7 @@ func (q *Querier) SearchForUsersByUsername(ctx context.Context, usernameQuery st
 	}
 	tracing.AttachToSpan(span, keys.SearchQueryKey, usernameQuery)
 
-	results, err := q.generatedQuerier.SearchUsersByUsername(ctx, q.db, usernameQuery)
+	results, err := q.generatedQuerier.SearchUsersByUsername(ctx, q.dbFor(ctx), usernameQuery)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "querying database for users")
 	}
@@ -308,7 +308,7 @@ func (q *Querier) GetUsers(ctx context.Context, filter *types.QueryFilter) (x *t
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetUsers(ctx, q.db, &generated.GetUsersParams{
+	results, err := q.generatedQuerier.GetUsers(ctx, q.dbFor(ctx), &generated.GetUsersParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -358,7 +358,7 @@ func (q *Querier) GetUserIDsThatNeedSearchIndexing(ctx context.Context) ([]strin
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetUserIDsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetUserIDsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing users list retrieval query")
 	}
@@ -379,7 +379,7 @@ func (q *Querier) MarkUserAsIndexed(ctx context.Context, userID string) error {
 	logger = logger.WithValue(keys.UserIDKey, userID)
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	if _, err := q.generatedQuerier.UpdateUserLastIndexedAt(ctx, q.db, userID); err != nil {
+	if _, err := q.generatedQuerier.UpdateUserLastIndexedAt(ctx, q.dbFor(ctx), userID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking user as indexed")
 	}
 
@@ -406,7 +406,7 @@ func (q *Querier) CreateUser(ctx context.Context, input *types.UserDatabaseCreat
 	})
 
 	// begin user creation transaction
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "beginning transaction")
 	}
@@ -623,7 +623,7 @@ func (q *Querier) UpdateUserUsername(ctx context.Context, userID, newUsername st
 	logger = logger.WithValue(keys.UsernameKey, newUsername)
 	tracing.AttachToSpan(span, keys.UsernameKey, newUsername)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -683,7 +683,7 @@ func (q *Querier) UpdateUserEmailAddress(ctx context.Context, userID, newEmailAd
 	}
 	tracing.AttachToSpan(span, keys.UserEmailAddressKey, newEmailAddress)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -742,7 +742,7 @@ func (q *Querier) UpdateUserDetails(ctx context.Context, userID string, input *t
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -811,7 +811,7 @@ func (q *Querier) UpdateUserAvatar(ctx context.Context, userID, newAvatarSrc str
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -862,7 +862,7 @@ func (q *Querier) UpdateUserPassword(ctx context.Context, userID, newHash string
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -913,7 +913,7 @@ func (q *Querier) UpdateUserTwoFactorSecret(ctx context.Context, userID, newSecr
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -960,7 +960,7 @@ func (q *Querier) MarkUserTwoFactorSecretAsVerified(ctx context.Context, userID
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -1010,12 +1010,12 @@ func (q *Querier) MarkUserTwoFactorSecretAsUnverified(ctx context.Context, userI
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
 
-	if err = q.generatedQuerier.MarkTwoFactorSecretAsUnverified(ctx, q.db, &generated.MarkTwoFactorSecretAsUnverifiedParams{
+	if err = q.generatedQuerier.MarkTwoFactorSecretAsUnverified(ctx, q.dbFor(ctx), &generated.MarkTwoFactorSecretAsUnverifiedParams{
 		TwoFactorSecret: newSecret,
 		ID:              userID,
 	}); err != nil {
@@ -1071,7 +1071,7 @@ func (q *Querier) ArchiveUser(ctx context.Context, userID string) error {
 	logger := q.logger.WithValue(keys.UserIDKey, userID)
 
 	// begin archive user transaction
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -1120,7 +1120,7 @@ func (q *Querier) GetEmailAddressVerificationTokenForUser(ctx context.Context, u
 	}
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
-	result, err := q.generatedQuerier.GetEmailVerificationTokenByUserID(ctx, q.db, userID)
+	result, err := q.generatedQuerier.GetEmailVerificationTokenByUserID(ctx, q.dbFor(ctx), userID)
 	if err != nil {
 		return "", observability.PrepareError(err, span, "getting user by email address verification token")
 	}
@@ -1136,7 +1136,7 @@ func (q *Querier) GetUserByEmailAddressVerificationToken(ctx context.Context, to
 		return nil, ErrEmptyInputProvided
 	}
 
-	result, err := q.generatedQuerier.GetUserByEmailAddressVerificationToken(ctx, q.db, database.NullStringFromString(token))
+	result, err := q.generatedQuerier.GetUserByEmailAddressVerificationToken(ctx, q.dbFor(ctx), database.NullStringFromString(token))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting user by email address verification token")
 	}
@@ -1183,7 +1183,7 @@ func (q *Querier) MarkUserEmailAddressAsVerified(ctx context.Context, userID, to
 		return ErrEmptyInputProvided
 	}
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -1244,7 +1244,7 @@ func (q *Querier) MarkUserEmailAddressAsUnverified(ctx context.Context, userID s
 	}
 	logger = logger.WithValue(keys.UserIDKey, userID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return err
 	}
@@ -28,7 +28,7 @@ func (q *Querier) ValidIngredientGroupExists(ctx context.Context, validIngredien
 	logger = logger.WithValue(keys.ValidIngredientGroupIDKey, validIngredientGroupID)
 	tracing.AttachToSpan(span, keys.ValidIngredientGroupIDKey, validIngredientGroupID)
 
-	result, err := q.generatedQuerier.CheckValidIngredientGroupExistence(ctx, q.db, validIngredientGroupID)
+	result, err := q.generatedQuerier.CheckValidIngredientGroupExistence(ctx, q.dbFor(ctx), validIngredientGroupID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient group existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidIngredientGroup(ctx context.Context, validIngredientGr
 	logger = logger.WithValue(keys.ValidIngredientGroupIDKey, validIngredientGroupID)
 	tracing.AttachToSpan(span, keys.ValidIngredientGroupIDKey, validIngredientGroupID)
 
-	result, err := q.generatedQuerier.GetValidIngredientGroup(ctx, q.db, validIngredientGroupID)
+	result, err := q.generatedQuerier.GetValidIngredientGroup(ctx, q.dbFor(ctx), validIngredientGroupID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredients group from database")
 	}
@@ -65,7 +65,7 @@ func (q *Querier) GetValidIngredientGroup(ctx context.Context, validIngredientGr
 		Members:       nil,
 	}
 
-	membersResults, err := q.generatedQuerier.GetValidIngredientGroupMembers(ctx, q.db, validIngredientGroupID)
+	membersResults, err := q.generatedQuerier.GetValidIngredientGroupMembers(ctx, q.dbFor(ctx), validIngredientGroupID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredients group members from database")
 	}
@@ -141,7 +141,7 @@ func (q *Querier) SearchForValidIngredientGroups(ctx context.Context, query stri
 	tracing.AttachQueryFilterToSpan(span, filter)
 	filter.AttachToLogger(logger)
 
-	results, err := q.generatedQuerier.SearchForValidIngredientGroups(ctx, q.db, &generated.SearchForValidIngredientGroupsParams{
+	results, err := q.generatedQuerier.SearchForValidIngredientGroups(ctx, q.dbFor(ctx), &generated.SearchForValidIngredientGroupsParams{
 		Name:          query,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -168,7 +168,7 @@ func (q *Querier) SearchForValidIngredientGroups(ctx context.Context, query stri
 		}
 
 		var membersResults []*generated.GetValidIngredientGroupMembersRow
-		membersResults, err = q.generatedQuerier.GetValidIngredientGroupMembers(ctx, q.db, result.ID)
+		membersResults, err = q.generatedQuerier.GetValidIngredientGroupMembers(ctx, q.dbFor(ctx), result.ID)
 		if err != nil {
 			return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredients group members from database")
 		}
@@ -245,7 +245,7 @@ func (q *Querier) GetValidIngredientGroups(ctx context.Context, filter *types.Qu
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientGroups(ctx, q.db, &generated.GetValidIngredientGroupsParams{
+	results, err := q.generatedQuerier.GetValidIngredientGroups(ctx, q.dbFor(ctx), &generated.GetValidIngredientGroupsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -270,7 +270,7 @@ func (q *Querier) GetValidIngredientGroups(ctx context.Context, filter *types.Qu
 		}
 
 		var membersResults []*generated.GetValidIngredientGroupMembersRow
-		membersResults, err = q.generatedQuerier.GetValidIngredientGroupMembers(ctx, q.db, result.ID)
+		membersResults, err = q.generatedQuerier.GetValidIngredientGroupMembers(ctx, q.dbFor(ctx), result.ID)
 		if err != nil {
 			return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredients group members from database")
 		}
@@ -343,7 +343,7 @@ func (q *Querier) CreateValidIngredientGroup(ctx context.Context, input *types.V
 	tracing.AttachToSpan(span, keys.ValidIngredientGroupIDKey, input.ID)
 	logger := q.logger.WithValue(keys.ValidIngredientGroupIDKey, input.ID)
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "starting transaction")
 	}
@@ -434,7 +434,7 @@ func (q *Querier) UpdateValidIngredientGroup(ctx context.Context, updated *types
 	logger := q.logger.WithValue(keys.ValidIngredientGroupIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidIngredientGroupIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientGroup(ctx, q.db, &generated.UpdateValidIngredientGroupParams{
+	if _, err := q.generatedQuerier.UpdateValidIngredientGroup(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientGroupParams{
 		Name:        updated.Name,
 		Description: updated.Description,
 		Slug:        updated.Slug,
@@ -461,7 +461,7 @@ func (q *Querier) ArchiveValidIngredientGroup(ctx context.Context, validIngredie
 	logger = logger.WithValue(keys.ValidIngredientGroupIDKey, validIngredientGroupID)
 	tracing.AttachToSpan(span, keys.ValidIngredientGroupIDKey, validIngredientGroupID)
 
-	if _, err := q.generatedQuerier.ArchiveValidIngredientGroup(ctx, q.db, validIngredientGroupID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidIngredientGroup(ctx, q.dbFor(ctx), validIngredientGroupID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient group")
 	}
 
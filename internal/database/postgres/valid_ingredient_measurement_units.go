@@ -28,7 +28,7 @@ func (q *Querier) ValidIngredientMeasurementUnitExists(ctx context.Context, vali
 	logger = logger.WithValue(keys.ValidIngredientMeasurementUnitIDKey, validIngredientMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidIngredientMeasurementUnitIDKey, validIngredientMeasurementUnitID)
 
-	result, err := q.generatedQuerier.CheckValidIngredientMeasurementUnitExistence(ctx, q.db, validIngredientMeasurementUnitID)
+	result, err := q.generatedQuerier.CheckValidIngredientMeasurementUnitExistence(ctx, q.dbFor(ctx), validIngredientMeasurementUnitID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient measurement unit existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidIngredientMeasurementUnit(ctx context.Context, validIn
 	logger = logger.WithValue(keys.ValidIngredientMeasurementUnitIDKey, validIngredientMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidIngredientMeasurementUnitIDKey, validIngredientMeasurementUnitID)
 
-	result, err := q.generatedQuerier.GetValidIngredientMeasurementUnit(ctx, q.db, validIngredientMeasurementUnitID)
+	result, err := q.generatedQuerier.GetValidIngredientMeasurementUnit(ctx, q.dbFor(ctx), validIngredientMeasurementUnitID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "scanning validIngredientMeasurementUnit")
 	}
@@ -146,7 +146,7 @@ func (q *Querier) GetValidIngredientMeasurementUnitsForIngredient(ctx context.Co
 		Data:       []*types.ValidIngredientMeasurementUnit{},
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientMeasurementUnitsForIngredient(ctx, q.db, &generated.GetValidIngredientMeasurementUnitsForIngredientParams{
+	results, err := q.generatedQuerier.GetValidIngredientMeasurementUnitsForIngredient(ctx, q.dbFor(ctx), &generated.GetValidIngredientMeasurementUnitsForIngredientParams{
 		ValidIngredientID: ingredientID,
 		CreatedBefore:     database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:      database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -256,7 +256,7 @@ func (q *Querier) GetValidIngredientMeasurementUnitsForMeasurementUnit(ctx conte
 		Data:       []*types.ValidIngredientMeasurementUnit{},
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientMeasurementUnitsForMeasurementUnit(ctx, q.db, &generated.GetValidIngredientMeasurementUnitsForMeasurementUnitParams{
+	results, err := q.generatedQuerier.GetValidIngredientMeasurementUnitsForMeasurementUnit(ctx, q.dbFor(ctx), &generated.GetValidIngredientMeasurementUnitsForMeasurementUnitParams{
 		ValidMeasurementUnitID: validMeasurementUnitID,
 		CreatedBefore:          database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:           database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -360,7 +360,7 @@ func (q *Querier) GetValidIngredientMeasurementUnits(ctx context.Context, filter
 		Data:       []*types.ValidIngredientMeasurementUnit{},
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientMeasurementUnits(ctx, q.db, &generated.GetValidIngredientMeasurementUnitsParams{
+	results, err := q.generatedQuerier.GetValidIngredientMeasurementUnits(ctx, q.dbFor(ctx), &generated.GetValidIngredientMeasurementUnitsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -457,7 +457,7 @@ func (q *Querier) CreateValidIngredientMeasurementUnit(ctx context.Context, inpu
 	logger := q.logger.WithValue(keys.ValidIngredientMeasurementUnitIDKey, input.ID)
 
 	// create the valid ingredient measurement unit.
-	if err := q.generatedQuerier.CreateValidIngredientMeasurementUnit(ctx, q.db, &generated.CreateValidIngredientMeasurementUnitParams{
+	if err := q.generatedQuerier.CreateValidIngredientMeasurementUnit(ctx, q.dbFor(ctx), &generated.CreateValidIngredientMeasurementUnitParams{
 		ID:                       input.ID,
 		Notes:                    input.Notes,
 		ValidMeasurementUnitID:   input.ValidMeasurementUnitID,
@@ -494,7 +494,7 @@ func (q *Querier) UpdateValidIngredientMeasurementUnit(ctx context.Context, upda
 	logger := q.logger.WithValue(keys.ValidIngredientMeasurementUnitIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidIngredientMeasurementUnitIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientMeasurementUnit(ctx, q.db, &generated.UpdateValidIngredientMeasurementUnitParams{
+	if _, err := q.generatedQuerier.UpdateValidIngredientMeasurementUnit(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientMeasurementUnitParams{
 		Notes:                    updated.Notes,
 		ValidMeasurementUnitID:   updated.MeasurementUnit.ID,
 		ValidIngredientID:        updated.Ingredient.ID,
@@ -523,7 +523,7 @@ func (q *Querier) ArchiveValidIngredientMeasurementUnit(ctx context.Context, val
 	logger = logger.WithValue(keys.ValidIngredientMeasurementUnitIDKey, validIngredientMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidIngredientMeasurementUnitIDKey, validIngredientMeasurementUnitID)
 
-	if _, err := q.generatedQuerier.ArchiveValidIngredientMeasurementUnit(ctx, q.db, validIngredientMeasurementUnitID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidIngredientMeasurementUnit(ctx, q.dbFor(ctx), validIngredientMeasurementUnitID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient measurement unit")
 	}
 
@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.ValidIngredientNutritionDataManager = (*Querier)(nil)
+)
+
+// GetValidIngredientNutrition fetches the nutrition facts for a valid ingredient from the database.
+func (q *Querier) GetValidIngredientNutrition(ctx context.Context, validIngredientID string) (*types.ValidIngredientNutrition, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	result, err := q.generatedQuerier.GetValidIngredientNutrition(ctx, q.dbFor(ctx), validIngredientID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition")
+	}
+
+	validIngredientNutrition := &types.ValidIngredientNutrition{
+		CreatedAt:                result.CreatedAt,
+		LastUpdatedAt:            database.TimePointerFromNullTime(result.LastUpdatedAt),
+		ArchivedAt:               database.TimePointerFromNullTime(result.ArchivedAt),
+		ID:                       result.ID,
+		BelongsToValidIngredient: result.BelongsToValidIngredient,
+		Source:                   result.Source,
+		SourceID:                 result.SourceID,
+		ReferenceQuantity:        database.Float32FromString(result.ReferenceQuantity),
+		ReferenceMeasurementUnit: types.ValidMeasurementUnit{
+			CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+			Name:          result.ValidMeasurementUnitName,
+			IconPath:      result.ValidMeasurementUnitIconPath,
+			ID:            result.ValidMeasurementUnitID,
+			Description:   result.ValidMeasurementUnitDescription,
+			PluralName:    result.ValidMeasurementUnitPluralName,
+			Slug:          result.ValidMeasurementUnitSlug,
+			Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+			Universal:     result.ValidMeasurementUnitUniversal,
+			Metric:        result.ValidMeasurementUnitMetric,
+			Imperial:      result.ValidMeasurementUnitImperial,
+		},
+		Nutrients: types.NutrientAmounts{
+			Calories:      database.Float32FromString(result.Calories),
+			Protein:       database.Float32FromString(result.Protein),
+			TotalFat:      database.Float32FromString(result.TotalFat),
+			SaturatedFat:  database.Float32FromString(result.SaturatedFat),
+			Carbohydrates: database.Float32FromString(result.Carbohydrates),
+			Sugar:         database.Float32FromString(result.Sugar),
+			Fiber:         database.Float32FromString(result.Fiber),
+			Sodium:        database.Float32FromString(result.Sodium),
+		},
+	}
+
+	return validIngredientNutrition, nil
+}
+
+// GetValidIngredientNutritionForIngredients fetches the nutrition facts for a set of valid ingredients from the database.
+// Ingredients without nutrition facts are simply absent from the results.
+func (q *Querier) GetValidIngredientNutritionForIngredients(ctx context.Context, validIngredientIDs []string) ([]*types.ValidIngredientNutrition, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientIDs == nil {
+		return nil, ErrNilInputProvided
+	}
+	logger = logger.WithValue("valid_ingredient_ids", validIngredientIDs)
+
+	results, err := q.generatedQuerier.GetValidIngredientNutritionForIngredients(ctx, q.dbFor(ctx), validIngredientIDs)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition for ingredients")
+	}
+
+	x := []*types.ValidIngredientNutrition{}
+	for _, result := range results {
+		x = append(x, &types.ValidIngredientNutrition{
+			CreatedAt:                result.CreatedAt,
+			LastUpdatedAt:            database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ArchivedAt:               database.TimePointerFromNullTime(result.ArchivedAt),
+			ID:                       result.ID,
+			BelongsToValidIngredient: result.BelongsToValidIngredient,
+			Source:                   result.Source,
+			SourceID:                 result.SourceID,
+			ReferenceQuantity:        database.Float32FromString(result.ReferenceQuantity),
+			ReferenceMeasurementUnit: types.ValidMeasurementUnit{
+				CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+				LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+				ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+				Name:          result.ValidMeasurementUnitName,
+				IconPath:      result.ValidMeasurementUnitIconPath,
+				ID:            result.ValidMeasurementUnitID,
+				Description:   result.ValidMeasurementUnitDescription,
+				PluralName:    result.ValidMeasurementUnitPluralName,
+				Slug:          result.ValidMeasurementUnitSlug,
+				Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+				Universal:     result.ValidMeasurementUnitUniversal,
+				Metric:        result.ValidMeasurementUnitMetric,
+				Imperial:      result.ValidMeasurementUnitImperial,
+			},
+			Nutrients: types.NutrientAmounts{
+				Calories:      database.Float32FromString(result.Calories),
+				Protein:       database.Float32FromString(result.Protein),
+				TotalFat:      database.Float32FromString(result.TotalFat),
+				SaturatedFat:  database.Float32FromString(result.SaturatedFat),
+				Carbohydrates: database.Float32FromString(result.Carbohydrates),
+				Sugar:         database.Float32FromString(result.Sugar),
+				Fiber:         database.Float32FromString(result.Fiber),
+				Sodium:        database.Float32FromString(result.Sodium),
+			},
+		})
+	}
+
+	return x, nil
+}
+
+// UpsertValidIngredientNutrition sets the nutrition facts for a valid ingredient in the database, replacing any that already exist.
+func (q *Querier) UpsertValidIngredientNutrition(ctx context.Context, input *types.ValidIngredientNutritionDatabaseCreationInput) (*types.ValidIngredientNutrition, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, input.ValidIngredientID)
+	logger := q.logger.WithValue(keys.ValidIngredientIDKey, input.ValidIngredientID)
+
+	if err := q.generatedQuerier.UpsertValidIngredientNutrition(ctx, q.dbFor(ctx), &generated.UpsertValidIngredientNutritionParams{
+		ID:                       input.ID,
+		BelongsToValidIngredient: input.ValidIngredientID,
+		ReferenceQuantity:        database.StringFromFloat32(input.ReferenceQuantity),
+		ReferenceMeasurementUnit: input.ReferenceMeasurementUnitID,
+		Calories:                 database.StringFromFloat32(input.Nutrients.Calories),
+		Protein:                  database.StringFromFloat32(input.Nutrients.Protein),
+		TotalFat:                 database.StringFromFloat32(input.Nutrients.TotalFat),
+		SaturatedFat:             database.StringFromFloat32(input.Nutrients.SaturatedFat),
+		Carbohydrates:            database.StringFromFloat32(input.Nutrients.Carbohydrates),
+		Sugar:                    database.StringFromFloat32(input.Nutrients.Sugar),
+		Fiber:                    database.StringFromFloat32(input.Nutrients.Fiber),
+		Sodium:                   database.StringFromFloat32(input.Nutrients.Sodium),
+		Source:                   input.Source,
+		SourceID:                 input.SourceID,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient nutrition upsert query")
+	}
+
+	// the upsert may have updated an existing row rather than creating the one we asked for, so read back what's stored.
+	x, err := q.GetValidIngredientNutrition(ctx, input.ValidIngredientID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching upserted valid ingredient nutrition")
+	}
+
+	logger.Info("valid ingredient nutrition upserted")
+
+	return x, nil
+}
+
+// ArchiveValidIngredientNutrition archives the nutrition facts for a valid ingredient.
+func (q *Querier) ArchiveValidIngredientNutrition(ctx context.Context, validIngredientID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	if _, err := q.generatedQuerier.ArchiveValidIngredientNutrition(ctx, q.dbFor(ctx), validIngredientID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient nutrition")
+	}
+
+	logger.Info("valid ingredient nutrition archived")
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_ValidIngredientNutrition(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	ingredient := createValidIngredientForTest(t, ctx, nil, dbc)
+	otherIngredient := createValidIngredientForTest(t, ctx, nil, dbc)
+	measurementUnit := createValidMeasurementUnitForTest(t, ctx, nil, dbc)
+
+	exampleNutrition := fakes.BuildFakeValidIngredientNutrition()
+	exampleNutrition.BelongsToValidIngredient = ingredient.ID
+	exampleNutrition.ReferenceMeasurementUnit = *measurementUnit
+
+	// create
+	created, err := dbc.UpsertValidIngredientNutrition(ctx, converters.ConvertValidIngredientNutritionToValidIngredientNutritionDatabaseCreationInput(exampleNutrition))
+	require.NoError(t, err)
+	require.NotNil(t, created)
+	assert.Equal(t, exampleNutrition.ID, created.ID)
+	assert.Equal(t, exampleNutrition.Nutrients, created.Nutrients)
+	assert.Equal(t, measurementUnit.ID, created.ReferenceMeasurementUnit.ID)
+
+	// upserting again replaces the existing facts rather than adding a second set
+	replacement := fakes.BuildFakeValidIngredientNutrition()
+	replacement.BelongsToValidIngredient = ingredient.ID
+	replacement.ReferenceMeasurementUnit = *measurementUnit
+	updated, err := dbc.UpsertValidIngredientNutrition(ctx, converters.ConvertValidIngredientNutritionToValidIngredientNutritionDatabaseCreationInput(replacement))
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, replacement.Nutrients, updated.Nutrients)
+	assert.NotNil(t, updated.LastUpdatedAt)
+
+	// fetch for several ingredients at once
+	results, err := dbc.GetValidIngredientNutritionForIngredients(ctx, []string{ingredient.ID, otherIngredient.ID})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, ingredient.ID, results[0].BelongsToValidIngredient)
+
+	// delete
+	assert.NoError(t, dbc.ArchiveValidIngredientNutrition(ctx, ingredient.ID))
+
+	var y *types.ValidIngredientNutrition
+	y, err = dbc.GetValidIngredientNutrition(ctx, ingredient.ID)
+	assert.Nil(t, y)
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestQuerier_GetValidIngredientNutrition(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetValidIngredientNutrition(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetValidIngredientNutritionForIngredients(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetValidIngredientNutritionForIngredients(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpsertValidIngredientNutrition(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.UpsertValidIngredientNutrition(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_ArchiveValidIngredientNutrition(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchiveValidIngredientNutrition(ctx, ""))
+	})
+}
@@ -28,7 +28,7 @@ func (q *Querier) ValidIngredientPreparationExists(ctx context.Context, validIng
 	logger = logger.WithValue(keys.ValidIngredientPreparationIDKey, validIngredientPreparationID)
 	tracing.AttachToSpan(span, keys.ValidIngredientPreparationIDKey, validIngredientPreparationID)
 
-	result, err := q.generatedQuerier.CheckValidIngredientPreparationExistence(ctx, q.db, validIngredientPreparationID)
+	result, err := q.generatedQuerier.CheckValidIngredientPreparationExistence(ctx, q.dbFor(ctx), validIngredientPreparationID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient preparation existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidIngredientPreparation(ctx context.Context, validIngred
 	logger = logger.WithValue(keys.ValidIngredientPreparationIDKey, validIngredientPreparationID)
 	tracing.AttachToSpan(span, keys.ValidIngredientPreparationIDKey, validIngredientPreparationID)
 
-	result, err := q.generatedQuerier.GetValidIngredientPreparation(ctx, q.db, validIngredientPreparationID)
+	result, err := q.generatedQuerier.GetValidIngredientPreparation(ctx, q.dbFor(ctx), validIngredientPreparationID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient preparation retrieval")
 	}
@@ -146,7 +146,7 @@ func (q *Querier) GetValidIngredientPreparations(ctx context.Context, filter *ty
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientPreparations(ctx, q.db, &generated.GetValidIngredientPreparationsParams{
+	results, err := q.generatedQuerier.GetValidIngredientPreparations(ctx, q.dbFor(ctx), &generated.GetValidIngredientPreparationsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -260,7 +260,7 @@ func (q *Querier) GetValidIngredientPreparationsForPreparation(ctx context.Conte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientPreparationsForPreparation(ctx, q.db, &generated.GetValidIngredientPreparationsForPreparationParams{
+	results, err := q.generatedQuerier.GetValidIngredientPreparationsForPreparation(ctx, q.dbFor(ctx), &generated.GetValidIngredientPreparationsForPreparationParams{
 		ID:            preparationID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -375,7 +375,7 @@ func (q *Querier) GetValidIngredientPreparationsForIngredient(ctx context.Contex
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientPreparationsForIngredient(ctx, q.db, &generated.GetValidIngredientPreparationsForIngredientParams{
+	results, err := q.generatedQuerier.GetValidIngredientPreparationsForIngredient(ctx, q.dbFor(ctx), &generated.GetValidIngredientPreparationsForIngredientParams{
 		ID:            ingredientID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -479,7 +479,7 @@ func (q *Querier) CreateValidIngredientPreparation(ctx context.Context, input *t
 	logger := q.logger.WithValue(keys.ValidIngredientPreparationIDKey, input.ID)
 
 	// create the valid ingredient preparation.
-	if err := q.generatedQuerier.CreateValidIngredientPreparation(ctx, q.db, &generated.CreateValidIngredientPreparationParams{
+	if err := q.generatedQuerier.CreateValidIngredientPreparation(ctx, q.dbFor(ctx), &generated.CreateValidIngredientPreparationParams{
 		ID:                 input.ID,
 		Notes:              input.Notes,
 		ValidPreparationID: input.ValidPreparationID,
@@ -512,7 +512,7 @@ func (q *Querier) UpdateValidIngredientPreparation(ctx context.Context, updated
 	logger := q.logger.WithValue(keys.ValidIngredientPreparationIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidIngredientPreparationIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientPreparation(ctx, q.db, &generated.UpdateValidIngredientPreparationParams{
+	if _, err := q.generatedQuerier.UpdateValidIngredientPreparation(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientPreparationParams{
 		Notes:              updated.Notes,
 		ValidPreparationID: updated.Preparation.ID,
 		ValidIngredientID:  updated.Ingredient.ID,
@@ -539,7 +539,7 @@ func (q *Querier) ArchiveValidIngredientPreparation(ctx context.Context, validIn
 	logger = logger.WithValue(keys.ValidIngredientPreparationIDKey, validIngredientPreparationID)
 	tracing.AttachToSpan(span, keys.ValidIngredientPreparationIDKey, validIngredientPreparationID)
 
-	if _, err := q.generatedQuerier.ArchiveValidIngredientPreparation(ctx, q.db, validIngredientPreparationID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidIngredientPreparation(ctx, q.dbFor(ctx), validIngredientPreparationID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient preparation")
 	}
 
@@ -28,7 +28,7 @@ func (q *Querier) ValidIngredientStateIngredientExists(ctx context.Context, vali
 	logger = logger.WithValue(keys.ValidIngredientStateIngredientIDKey, validIngredientStateIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIngredientIDKey, validIngredientStateIngredientID)
 
-	result, err := q.generatedQuerier.CheckValidIngredientStateIngredientExistence(ctx, q.db, validIngredientStateIngredientID)
+	result, err := q.generatedQuerier.CheckValidIngredientStateIngredientExistence(ctx, q.dbFor(ctx), validIngredientStateIngredientID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient state ingredient existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidIngredientStateIngredient(ctx context.Context, validIn
 	logger = logger.WithValue(keys.ValidIngredientStateIngredientIDKey, validIngredientStateIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIngredientIDKey, validIngredientStateIngredientID)
 
-	result, err := q.generatedQuerier.GetValidIngredientStateIngredient(ctx, q.db, validIngredientStateIngredientID)
+	result, err := q.generatedQuerier.GetValidIngredientStateIngredient(ctx, q.dbFor(ctx), validIngredientStateIngredientID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting valid ingredient state ingredient")
 	}
@@ -134,7 +134,7 @@ func (q *Querier) GetValidIngredientStateIngredients(ctx context.Context, filter
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientStateIngredients(ctx, q.db, &generated.GetValidIngredientStateIngredientsParams{
+	results, err := q.generatedQuerier.GetValidIngredientStateIngredients(ctx, q.dbFor(ctx), &generated.GetValidIngredientStateIngredientsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -238,7 +238,7 @@ func (q *Querier) GetValidIngredientStateIngredientsForIngredientState(ctx conte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientStateIngredientsForIngredientState(ctx, q.db, &generated.GetValidIngredientStateIngredientsForIngredientStateParams{
+	results, err := q.generatedQuerier.GetValidIngredientStateIngredientsForIngredientState(ctx, q.dbFor(ctx), &generated.GetValidIngredientStateIngredientsForIngredientStateParams{
 		CreatedBefore:        database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:         database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore:        database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -343,7 +343,7 @@ func (q *Querier) GetValidIngredientStateIngredientsForIngredient(ctx context.Co
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientStateIngredientsForIngredient(ctx, q.db, &generated.GetValidIngredientStateIngredientsForIngredientParams{
+	results, err := q.generatedQuerier.GetValidIngredientStateIngredientsForIngredient(ctx, q.dbFor(ctx), &generated.GetValidIngredientStateIngredientsForIngredientParams{
 		CreatedBefore:   database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:    database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore:   database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -437,7 +437,7 @@ func (q *Querier) CreateValidIngredientStateIngredient(ctx context.Context, inpu
 	logger := q.logger.WithValue(keys.ValidIngredientStateIngredientIDKey, input.ID)
 
 	// create the valid ingredient state ingredient.
-	if err := q.generatedQuerier.CreateValidIngredientStateIngredient(ctx, q.db, &generated.CreateValidIngredientStateIngredientParams{
+	if err := q.generatedQuerier.CreateValidIngredientStateIngredient(ctx, q.dbFor(ctx), &generated.CreateValidIngredientStateIngredientParams{
 		ID:                   input.ID,
 		Notes:                input.Notes,
 		ValidIngredientState: input.ValidIngredientStateID,
@@ -471,7 +471,7 @@ func (q *Querier) UpdateValidIngredientStateIngredient(ctx context.Context, upda
 	logger := q.logger.WithValue(keys.ValidIngredientStateIngredientIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIngredientIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientStateIngredient(ctx, q.db, &generated.UpdateValidIngredientStateIngredientParams{
+	if _, err := q.generatedQuerier.UpdateValidIngredientStateIngredient(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientStateIngredientParams{
 		Notes:                updated.Notes,
 		ValidIngredientState: updated.IngredientState.ID,
 		ValidIngredient:      updated.Ingredient.ID,
@@ -498,7 +498,7 @@ func (q *Querier) ArchiveValidIngredientStateIngredient(ctx context.Context, val
 	logger = logger.WithValue(keys.ValidIngredientStateIngredientIDKey, validIngredientStateIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIngredientIDKey, validIngredientStateIngredientID)
 
-	if _, err := q.generatedQuerier.ArchiveValidIngredientStateIngredient(ctx, q.db, validIngredientStateIngredientID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidIngredientStateIngredient(ctx, q.dbFor(ctx), validIngredientStateIngredientID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient state ingredient")
 	}
 
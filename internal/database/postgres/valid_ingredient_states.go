@@ -28,7 +28,7 @@ func (q *Querier) ValidIngredientStateExists(ctx context.Context, validIngredien
 	logger = logger.WithValue(keys.ValidIngredientStateIDKey, validIngredientStateID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIDKey, validIngredientStateID)
 
-	result, err := q.generatedQuerier.CheckValidIngredientStateExistence(ctx, q.db, validIngredientStateID)
+	result, err := q.generatedQuerier.CheckValidIngredientStateExistence(ctx, q.dbFor(ctx), validIngredientStateID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient state existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidIngredientState(ctx context.Context, validIngredientSt
 	logger = logger.WithValue(keys.ValidIngredientStateIDKey, validIngredientStateID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIDKey, validIngredientStateID)
 
-	result, err := q.generatedQuerier.GetValidIngredientState(ctx, q.db, validIngredientStateID)
+	result, err := q.generatedQuerier.GetValidIngredientState(ctx, q.dbFor(ctx), validIngredientStateID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredient state retrieval query")
 	}
@@ -83,7 +83,7 @@ func (q *Querier) SearchForValidIngredientStates(ctx context.Context, query stri
 	logger = logger.WithValue(keys.SearchQueryKey, query)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIDKey, query)
 
-	results, err := q.generatedQuerier.SearchForValidIngredientStates(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForValidIngredientStates(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredient states list retrieval query")
 	}
@@ -124,7 +124,7 @@ func (q *Querier) GetValidIngredientStates(ctx context.Context, filter *types.Qu
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientStates(ctx, q.db, &generated.GetValidIngredientStatesParams{
+	results, err := q.generatedQuerier.GetValidIngredientStates(ctx, q.dbFor(ctx), &generated.GetValidIngredientStatesParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -163,7 +163,7 @@ func (q *Querier) GetValidIngredientStatesWithIDs(ctx context.Context, ids []str
 
 	logger := q.logger.Clone()
 
-	results, err := q.generatedQuerier.GetValidIngredientStatesWithIDs(ctx, q.db, ids)
+	results, err := q.generatedQuerier.GetValidIngredientStatesWithIDs(ctx, q.dbFor(ctx), ids)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredient states id list retrieval query")
 	}
@@ -192,7 +192,7 @@ func (q *Querier) GetValidIngredientStateIDsThatNeedSearchIndexing(ctx context.C
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetValidIngredientStatesNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetValidIngredientStatesNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing valid ingredient states list retrieval query")
 	}
@@ -212,7 +212,7 @@ func (q *Querier) CreateValidIngredientState(ctx context.Context, input *types.V
 	logger := q.logger.WithValue(keys.ValidIngredientStateIDKey, input.ID)
 
 	// create the valid ingredient state.
-	if err := q.generatedQuerier.CreateValidIngredientState(ctx, q.db, &generated.CreateValidIngredientStateParams{
+	if err := q.generatedQuerier.CreateValidIngredientState(ctx, q.dbFor(ctx), &generated.CreateValidIngredientStateParams{
 		ID:            input.ID,
 		Name:          input.Name,
 		Description:   input.Description,
@@ -252,7 +252,7 @@ func (q *Querier) UpdateValidIngredientState(ctx context.Context, updated *types
 	logger := q.logger.WithValue(keys.ValidIngredientStateIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientState(ctx, q.db, &generated.UpdateValidIngredientStateParams{
+	if _, err := q.generatedQuerier.UpdateValidIngredientState(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientStateParams{
 		Name:          updated.Name,
 		Description:   updated.Description,
 		IconPath:      updated.IconPath,
@@ -282,7 +282,7 @@ func (q *Querier) MarkValidIngredientStateAsIndexed(ctx context.Context, validIn
 	logger = logger.WithValue(keys.ValidIngredientStateIDKey, validIngredientStateID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIDKey, validIngredientStateID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientStateLastIndexedAt(ctx, q.db, validIngredientStateID); err != nil {
+	if _, err := q.generatedQuerier.UpdateValidIngredientStateLastIndexedAt(ctx, q.dbFor(ctx), validIngredientStateID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking valid ingredient state as indexed")
 	}
 
@@ -304,7 +304,7 @@ func (q *Querier) ArchiveValidIngredientState(ctx context.Context, validIngredie
 	logger = logger.WithValue(keys.ValidIngredientStateIDKey, validIngredientStateID)
 	tracing.AttachToSpan(span, keys.ValidIngredientStateIDKey, validIngredientStateID)
 
-	if _, err := q.generatedQuerier.ArchiveValidIngredientState(ctx, q.db, validIngredientStateID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidIngredientState(ctx, q.dbFor(ctx), validIngredientStateID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient state")
 	}
 
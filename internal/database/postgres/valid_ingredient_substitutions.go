@@ -0,0 +1,741 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.ValidIngredientSubstitutionDataManager = (*Querier)(nil)
+)
+
+// ValidIngredientSubstitutionExists fetches whether a valid ingredient substitution exists from the database.
+func (q *Querier) ValidIngredientSubstitutionExists(ctx context.Context, validIngredientSubstitutionID string) (exists bool, err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientSubstitutionID == "" {
+		return false, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	result, err := q.generatedQuerier.CheckValidIngredientSubstitutionExistence(ctx, q.dbFor(ctx), validIngredientSubstitutionID)
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient substitution existence check")
+	}
+
+	return result, nil
+}
+
+// GetValidIngredientSubstitution fetches a valid ingredient substitution from the database.
+func (q *Querier) GetValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) (*types.ValidIngredientSubstitution, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientSubstitutionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	result, err := q.generatedQuerier.GetValidIngredientSubstitution(ctx, q.dbFor(ctx), validIngredientSubstitutionID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient substitution retrieval")
+	}
+
+	x := &types.ValidIngredientSubstitution{
+		CreatedAt:     result.ValidIngredientSubstitutionCreatedAt,
+		LastUpdatedAt: database.TimePointerFromNullTime(result.ValidIngredientSubstitutionLastUpdatedAt),
+		ArchivedAt:    database.TimePointerFromNullTime(result.ValidIngredientSubstitutionArchivedAt),
+		ID:            result.ValidIngredientSubstitutionID,
+		Notes:         result.ValidIngredientSubstitutionNotes,
+		Reasons:       result.ValidIngredientSubstitutionReasons,
+		Ratio:         database.Float32FromString(result.ValidIngredientSubstitutionRatio),
+		Original: types.ValidIngredient{
+			CreatedAt:                               result.OriginalIngredientCreatedAt,
+			LastUpdatedAt:                           database.TimePointerFromNullTime(result.OriginalIngredientLastUpdatedAt),
+			ArchivedAt:                              database.TimePointerFromNullTime(result.OriginalIngredientArchivedAt),
+			MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMaximumIdealStorageTemperatureInCelsius),
+			MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMinimumIdealStorageTemperatureInCelsius),
+			IconPath:                                result.OriginalIngredientIconPath,
+			Warning:                                 result.OriginalIngredientWarning,
+			PluralName:                              result.OriginalIngredientPluralName,
+			StorageInstructions:                     result.OriginalIngredientStorageInstructions,
+			Name:                                    result.OriginalIngredientName,
+			ID:                                      result.OriginalIngredientID,
+			Description:                             result.OriginalIngredientDescription,
+			Slug:                                    result.OriginalIngredientSlug,
+			ShoppingSuggestions:                     result.OriginalIngredientShoppingSuggestions,
+			ContainsShellfish:                       result.OriginalIngredientContainsShellfish,
+			IsMeasuredVolumetrically:                result.OriginalIngredientVolumetric,
+			IsLiquid:                                database.BoolFromNullBool(result.OriginalIngredientIsLiquid),
+			ContainsPeanut:                          result.OriginalIngredientContainsPeanut,
+			ContainsTreeNut:                         result.OriginalIngredientContainsTreeNut,
+			ContainsEgg:                             result.OriginalIngredientContainsEgg,
+			ContainsWheat:                           result.OriginalIngredientContainsWheat,
+			ContainsSoy:                             result.OriginalIngredientContainsSoy,
+			AnimalDerived:                           result.OriginalIngredientAnimalDerived,
+			RestrictToPreparations:                  result.OriginalIngredientRestrictToPreparations,
+			ContainsSesame:                          result.OriginalIngredientContainsSesame,
+			ContainsFish:                            result.OriginalIngredientContainsFish,
+			ContainsGluten:                          result.OriginalIngredientContainsGluten,
+			ContainsDairy:                           result.OriginalIngredientContainsDairy,
+			ContainsAlcohol:                         result.OriginalIngredientContainsAlcohol,
+			AnimalFlesh:                             result.OriginalIngredientAnimalFlesh,
+			IsStarch:                                result.OriginalIngredientIsStarch,
+			IsProtein:                               result.OriginalIngredientIsProtein,
+			IsGrain:                                 result.OriginalIngredientIsGrain,
+			IsFruit:                                 result.OriginalIngredientIsFruit,
+			IsSalt:                                  result.OriginalIngredientIsSalt,
+			IsFat:                                   result.OriginalIngredientIsFat,
+			IsAcid:                                  result.OriginalIngredientIsAcid,
+			IsHeat:                                  result.OriginalIngredientIsHeat,
+		},
+		Substitute: types.ValidIngredient{
+			CreatedAt:                               result.SubstituteIngredientCreatedAt,
+			LastUpdatedAt:                           database.TimePointerFromNullTime(result.SubstituteIngredientLastUpdatedAt),
+			ArchivedAt:                              database.TimePointerFromNullTime(result.SubstituteIngredientArchivedAt),
+			MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMaximumIdealStorageTemperatureInCelsius),
+			MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMinimumIdealStorageTemperatureInCelsius),
+			IconPath:                                result.SubstituteIngredientIconPath,
+			Warning:                                 result.SubstituteIngredientWarning,
+			PluralName:                              result.SubstituteIngredientPluralName,
+			StorageInstructions:                     result.SubstituteIngredientStorageInstructions,
+			Name:                                    result.SubstituteIngredientName,
+			ID:                                      result.SubstituteIngredientID,
+			Description:                             result.SubstituteIngredientDescription,
+			Slug:                                    result.SubstituteIngredientSlug,
+			ShoppingSuggestions:                     result.SubstituteIngredientShoppingSuggestions,
+			ContainsShellfish:                       result.SubstituteIngredientContainsShellfish,
+			IsMeasuredVolumetrically:                result.SubstituteIngredientVolumetric,
+			IsLiquid:                                database.BoolFromNullBool(result.SubstituteIngredientIsLiquid),
+			ContainsPeanut:                          result.SubstituteIngredientContainsPeanut,
+			ContainsTreeNut:                         result.SubstituteIngredientContainsTreeNut,
+			ContainsEgg:                             result.SubstituteIngredientContainsEgg,
+			ContainsWheat:                           result.SubstituteIngredientContainsWheat,
+			ContainsSoy:                             result.SubstituteIngredientContainsSoy,
+			AnimalDerived:                           result.SubstituteIngredientAnimalDerived,
+			RestrictToPreparations:                  result.SubstituteIngredientRestrictToPreparations,
+			ContainsSesame:                          result.SubstituteIngredientContainsSesame,
+			ContainsFish:                            result.SubstituteIngredientContainsFish,
+			ContainsGluten:                          result.SubstituteIngredientContainsGluten,
+			ContainsDairy:                           result.SubstituteIngredientContainsDairy,
+			ContainsAlcohol:                         result.SubstituteIngredientContainsAlcohol,
+			AnimalFlesh:                             result.SubstituteIngredientAnimalFlesh,
+			IsStarch:                                result.SubstituteIngredientIsStarch,
+			IsProtein:                               result.SubstituteIngredientIsProtein,
+			IsGrain:                                 result.SubstituteIngredientIsGrain,
+			IsFruit:                                 result.SubstituteIngredientIsFruit,
+			IsSalt:                                  result.SubstituteIngredientIsSalt,
+			IsFat:                                   result.SubstituteIngredientIsFat,
+			IsAcid:                                  result.SubstituteIngredientIsAcid,
+			IsHeat:                                  result.SubstituteIngredientIsHeat,
+		},
+	}
+
+	if result.ValidPreparationID.Valid {
+		x.Preparation = &types.ValidPreparation{
+			CreatedAt:                   result.ValidPreparationCreatedAt.Time,
+			MaximumInstrumentCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumInstrumentCount),
+			ArchivedAt:                  database.TimePointerFromNullTime(result.ValidPreparationArchivedAt),
+			MaximumIngredientCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumIngredientCount),
+			LastUpdatedAt:               database.TimePointerFromNullTime(result.ValidPreparationLastUpdatedAt),
+			MaximumVesselCount:          database.Int32PointerFromNullInt32(result.ValidPreparationMaximumVesselCount),
+			IconPath:                    result.ValidPreparationIconPath.String,
+			PastTense:                   result.ValidPreparationPastTense.String,
+			ID:                          result.ValidPreparationID.String,
+			Name:                        result.ValidPreparationName.String,
+			Description:                 result.ValidPreparationDescription.String,
+			Slug:                        result.ValidPreparationSlug.String,
+			MinimumIngredientCount:      result.ValidPreparationMinimumIngredientCount.Int32,
+			MinimumInstrumentCount:      result.ValidPreparationMinimumInstrumentCount.Int32,
+			MinimumVesselCount:          result.ValidPreparationMinimumVesselCount.Int32,
+			RestrictToIngredients:       result.ValidPreparationRestrictToIngredients.Bool,
+			TemperatureRequired:         result.ValidPreparationTemperatureRequired.Bool,
+			TimeEstimateRequired:        result.ValidPreparationTimeEstimateRequired.Bool,
+			ConditionExpressionRequired: result.ValidPreparationConditionExpressionRequired.Bool,
+			ConsumesVessel:              result.ValidPreparationConsumesVessel.Bool,
+			OnlyForVessels:              result.ValidPreparationOnlyForVessels.Bool,
+			YieldsNothing:               result.ValidPreparationYieldsNothing.Bool,
+		}
+	}
+
+	return x, nil
+}
+
+// GetValidIngredientSubstitutions fetches a list of valid ingredient substitutions from the database that meet a particular filter.
+func (q *Querier) GetValidIngredientSubstitutions(ctx context.Context, filter *types.QueryFilter) (x *types.QueryFilteredResult[types.ValidIngredientSubstitution], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	x = &types.QueryFilteredResult[types.ValidIngredientSubstitution]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.GetValidIngredientSubstitutions(ctx, q.dbFor(ctx), &generated.GetValidIngredientSubstitutionsParams{
+		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:  database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		QueryOffset:   database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:    database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredient substitutions list retrieval query")
+	}
+
+	for _, result := range results {
+		validIngredientSubstitution := &types.ValidIngredientSubstitution{
+			CreatedAt:     result.ValidIngredientSubstitutionCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.ValidIngredientSubstitutionLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ValidIngredientSubstitutionArchivedAt),
+			ID:            result.ValidIngredientSubstitutionID,
+			Notes:         result.ValidIngredientSubstitutionNotes,
+			Reasons:       result.ValidIngredientSubstitutionReasons,
+			Ratio:         database.Float32FromString(result.ValidIngredientSubstitutionRatio),
+			Original: types.ValidIngredient{
+				CreatedAt:                               result.OriginalIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.OriginalIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.OriginalIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.OriginalIngredientIconPath,
+				Warning:                                 result.OriginalIngredientWarning,
+				PluralName:                              result.OriginalIngredientPluralName,
+				StorageInstructions:                     result.OriginalIngredientStorageInstructions,
+				Name:                                    result.OriginalIngredientName,
+				ID:                                      result.OriginalIngredientID,
+				Description:                             result.OriginalIngredientDescription,
+				Slug:                                    result.OriginalIngredientSlug,
+				ShoppingSuggestions:                     result.OriginalIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.OriginalIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.OriginalIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.OriginalIngredientIsLiquid),
+				ContainsPeanut:                          result.OriginalIngredientContainsPeanut,
+				ContainsTreeNut:                         result.OriginalIngredientContainsTreeNut,
+				ContainsEgg:                             result.OriginalIngredientContainsEgg,
+				ContainsWheat:                           result.OriginalIngredientContainsWheat,
+				ContainsSoy:                             result.OriginalIngredientContainsSoy,
+				AnimalDerived:                           result.OriginalIngredientAnimalDerived,
+				RestrictToPreparations:                  result.OriginalIngredientRestrictToPreparations,
+				ContainsSesame:                          result.OriginalIngredientContainsSesame,
+				ContainsFish:                            result.OriginalIngredientContainsFish,
+				ContainsGluten:                          result.OriginalIngredientContainsGluten,
+				ContainsDairy:                           result.OriginalIngredientContainsDairy,
+				ContainsAlcohol:                         result.OriginalIngredientContainsAlcohol,
+				AnimalFlesh:                             result.OriginalIngredientAnimalFlesh,
+				IsStarch:                                result.OriginalIngredientIsStarch,
+				IsProtein:                               result.OriginalIngredientIsProtein,
+				IsGrain:                                 result.OriginalIngredientIsGrain,
+				IsFruit:                                 result.OriginalIngredientIsFruit,
+				IsSalt:                                  result.OriginalIngredientIsSalt,
+				IsFat:                                   result.OriginalIngredientIsFat,
+				IsAcid:                                  result.OriginalIngredientIsAcid,
+				IsHeat:                                  result.OriginalIngredientIsHeat,
+			},
+			Substitute: types.ValidIngredient{
+				CreatedAt:                               result.SubstituteIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.SubstituteIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.SubstituteIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.SubstituteIngredientIconPath,
+				Warning:                                 result.SubstituteIngredientWarning,
+				PluralName:                              result.SubstituteIngredientPluralName,
+				StorageInstructions:                     result.SubstituteIngredientStorageInstructions,
+				Name:                                    result.SubstituteIngredientName,
+				ID:                                      result.SubstituteIngredientID,
+				Description:                             result.SubstituteIngredientDescription,
+				Slug:                                    result.SubstituteIngredientSlug,
+				ShoppingSuggestions:                     result.SubstituteIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.SubstituteIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.SubstituteIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.SubstituteIngredientIsLiquid),
+				ContainsPeanut:                          result.SubstituteIngredientContainsPeanut,
+				ContainsTreeNut:                         result.SubstituteIngredientContainsTreeNut,
+				ContainsEgg:                             result.SubstituteIngredientContainsEgg,
+				ContainsWheat:                           result.SubstituteIngredientContainsWheat,
+				ContainsSoy:                             result.SubstituteIngredientContainsSoy,
+				AnimalDerived:                           result.SubstituteIngredientAnimalDerived,
+				RestrictToPreparations:                  result.SubstituteIngredientRestrictToPreparations,
+				ContainsSesame:                          result.SubstituteIngredientContainsSesame,
+				ContainsFish:                            result.SubstituteIngredientContainsFish,
+				ContainsGluten:                          result.SubstituteIngredientContainsGluten,
+				ContainsDairy:                           result.SubstituteIngredientContainsDairy,
+				ContainsAlcohol:                         result.SubstituteIngredientContainsAlcohol,
+				AnimalFlesh:                             result.SubstituteIngredientAnimalFlesh,
+				IsStarch:                                result.SubstituteIngredientIsStarch,
+				IsProtein:                               result.SubstituteIngredientIsProtein,
+				IsGrain:                                 result.SubstituteIngredientIsGrain,
+				IsFruit:                                 result.SubstituteIngredientIsFruit,
+				IsSalt:                                  result.SubstituteIngredientIsSalt,
+				IsFat:                                   result.SubstituteIngredientIsFat,
+				IsAcid:                                  result.SubstituteIngredientIsAcid,
+				IsHeat:                                  result.SubstituteIngredientIsHeat,
+			},
+		}
+
+		if result.ValidPreparationID.Valid {
+			validIngredientSubstitution.Preparation = &types.ValidPreparation{
+				CreatedAt:                   result.ValidPreparationCreatedAt.Time,
+				MaximumInstrumentCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumInstrumentCount),
+				ArchivedAt:                  database.TimePointerFromNullTime(result.ValidPreparationArchivedAt),
+				MaximumIngredientCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumIngredientCount),
+				LastUpdatedAt:               database.TimePointerFromNullTime(result.ValidPreparationLastUpdatedAt),
+				MaximumVesselCount:          database.Int32PointerFromNullInt32(result.ValidPreparationMaximumVesselCount),
+				IconPath:                    result.ValidPreparationIconPath.String,
+				PastTense:                   result.ValidPreparationPastTense.String,
+				ID:                          result.ValidPreparationID.String,
+				Name:                        result.ValidPreparationName.String,
+				Description:                 result.ValidPreparationDescription.String,
+				Slug:                        result.ValidPreparationSlug.String,
+				MinimumIngredientCount:      result.ValidPreparationMinimumIngredientCount.Int32,
+				MinimumInstrumentCount:      result.ValidPreparationMinimumInstrumentCount.Int32,
+				MinimumVesselCount:          result.ValidPreparationMinimumVesselCount.Int32,
+				RestrictToIngredients:       result.ValidPreparationRestrictToIngredients.Bool,
+				TemperatureRequired:         result.ValidPreparationTemperatureRequired.Bool,
+				TimeEstimateRequired:        result.ValidPreparationTimeEstimateRequired.Bool,
+				ConditionExpressionRequired: result.ValidPreparationConditionExpressionRequired.Bool,
+				ConsumesVessel:              result.ValidPreparationConsumesVessel.Bool,
+				OnlyForVessels:              result.ValidPreparationOnlyForVessels.Bool,
+				YieldsNothing:               result.ValidPreparationYieldsNothing.Bool,
+			}
+		}
+
+		x.Data = append(x.Data, validIngredientSubstitution)
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
+// GetValidIngredientSubstitutionsForIngredient fetches a list of the substitutions for a valid ingredient from the database that meet a particular filter.
+func (q *Querier) GetValidIngredientSubstitutionsForIngredient(ctx context.Context, ingredientID string, filter *types.QueryFilter) (x *types.QueryFilteredResult[types.ValidIngredientSubstitution], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if ingredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, ingredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, ingredientID)
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	x = &types.QueryFilteredResult[types.ValidIngredientSubstitution]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.GetValidIngredientSubstitutionsForIngredient(ctx, q.dbFor(ctx), &generated.GetValidIngredientSubstitutionsForIngredientParams{
+		OriginalIngredient: ingredientID,
+		CreatedBefore:      database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:       database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore:      database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:       database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		QueryOffset:        database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:         database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredient substitutions list retrieval query")
+	}
+
+	for _, result := range results {
+		validIngredientSubstitution := &types.ValidIngredientSubstitution{
+			CreatedAt:     result.ValidIngredientSubstitutionCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.ValidIngredientSubstitutionLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ValidIngredientSubstitutionArchivedAt),
+			ID:            result.ValidIngredientSubstitutionID,
+			Notes:         result.ValidIngredientSubstitutionNotes,
+			Reasons:       result.ValidIngredientSubstitutionReasons,
+			Ratio:         database.Float32FromString(result.ValidIngredientSubstitutionRatio),
+			Original: types.ValidIngredient{
+				CreatedAt:                               result.OriginalIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.OriginalIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.OriginalIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.OriginalIngredientIconPath,
+				Warning:                                 result.OriginalIngredientWarning,
+				PluralName:                              result.OriginalIngredientPluralName,
+				StorageInstructions:                     result.OriginalIngredientStorageInstructions,
+				Name:                                    result.OriginalIngredientName,
+				ID:                                      result.OriginalIngredientID,
+				Description:                             result.OriginalIngredientDescription,
+				Slug:                                    result.OriginalIngredientSlug,
+				ShoppingSuggestions:                     result.OriginalIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.OriginalIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.OriginalIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.OriginalIngredientIsLiquid),
+				ContainsPeanut:                          result.OriginalIngredientContainsPeanut,
+				ContainsTreeNut:                         result.OriginalIngredientContainsTreeNut,
+				ContainsEgg:                             result.OriginalIngredientContainsEgg,
+				ContainsWheat:                           result.OriginalIngredientContainsWheat,
+				ContainsSoy:                             result.OriginalIngredientContainsSoy,
+				AnimalDerived:                           result.OriginalIngredientAnimalDerived,
+				RestrictToPreparations:                  result.OriginalIngredientRestrictToPreparations,
+				ContainsSesame:                          result.OriginalIngredientContainsSesame,
+				ContainsFish:                            result.OriginalIngredientContainsFish,
+				ContainsGluten:                          result.OriginalIngredientContainsGluten,
+				ContainsDairy:                           result.OriginalIngredientContainsDairy,
+				ContainsAlcohol:                         result.OriginalIngredientContainsAlcohol,
+				AnimalFlesh:                             result.OriginalIngredientAnimalFlesh,
+				IsStarch:                                result.OriginalIngredientIsStarch,
+				IsProtein:                               result.OriginalIngredientIsProtein,
+				IsGrain:                                 result.OriginalIngredientIsGrain,
+				IsFruit:                                 result.OriginalIngredientIsFruit,
+				IsSalt:                                  result.OriginalIngredientIsSalt,
+				IsFat:                                   result.OriginalIngredientIsFat,
+				IsAcid:                                  result.OriginalIngredientIsAcid,
+				IsHeat:                                  result.OriginalIngredientIsHeat,
+			},
+			Substitute: types.ValidIngredient{
+				CreatedAt:                               result.SubstituteIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.SubstituteIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.SubstituteIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.SubstituteIngredientIconPath,
+				Warning:                                 result.SubstituteIngredientWarning,
+				PluralName:                              result.SubstituteIngredientPluralName,
+				StorageInstructions:                     result.SubstituteIngredientStorageInstructions,
+				Name:                                    result.SubstituteIngredientName,
+				ID:                                      result.SubstituteIngredientID,
+				Description:                             result.SubstituteIngredientDescription,
+				Slug:                                    result.SubstituteIngredientSlug,
+				ShoppingSuggestions:                     result.SubstituteIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.SubstituteIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.SubstituteIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.SubstituteIngredientIsLiquid),
+				ContainsPeanut:                          result.SubstituteIngredientContainsPeanut,
+				ContainsTreeNut:                         result.SubstituteIngredientContainsTreeNut,
+				ContainsEgg:                             result.SubstituteIngredientContainsEgg,
+				ContainsWheat:                           result.SubstituteIngredientContainsWheat,
+				ContainsSoy:                             result.SubstituteIngredientContainsSoy,
+				AnimalDerived:                           result.SubstituteIngredientAnimalDerived,
+				RestrictToPreparations:                  result.SubstituteIngredientRestrictToPreparations,
+				ContainsSesame:                          result.SubstituteIngredientContainsSesame,
+				ContainsFish:                            result.SubstituteIngredientContainsFish,
+				ContainsGluten:                          result.SubstituteIngredientContainsGluten,
+				ContainsDairy:                           result.SubstituteIngredientContainsDairy,
+				ContainsAlcohol:                         result.SubstituteIngredientContainsAlcohol,
+				AnimalFlesh:                             result.SubstituteIngredientAnimalFlesh,
+				IsStarch:                                result.SubstituteIngredientIsStarch,
+				IsProtein:                               result.SubstituteIngredientIsProtein,
+				IsGrain:                                 result.SubstituteIngredientIsGrain,
+				IsFruit:                                 result.SubstituteIngredientIsFruit,
+				IsSalt:                                  result.SubstituteIngredientIsSalt,
+				IsFat:                                   result.SubstituteIngredientIsFat,
+				IsAcid:                                  result.SubstituteIngredientIsAcid,
+				IsHeat:                                  result.SubstituteIngredientIsHeat,
+			},
+		}
+
+		if result.ValidPreparationID.Valid {
+			validIngredientSubstitution.Preparation = &types.ValidPreparation{
+				CreatedAt:                   result.ValidPreparationCreatedAt.Time,
+				MaximumInstrumentCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumInstrumentCount),
+				ArchivedAt:                  database.TimePointerFromNullTime(result.ValidPreparationArchivedAt),
+				MaximumIngredientCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumIngredientCount),
+				LastUpdatedAt:               database.TimePointerFromNullTime(result.ValidPreparationLastUpdatedAt),
+				MaximumVesselCount:          database.Int32PointerFromNullInt32(result.ValidPreparationMaximumVesselCount),
+				IconPath:                    result.ValidPreparationIconPath.String,
+				PastTense:                   result.ValidPreparationPastTense.String,
+				ID:                          result.ValidPreparationID.String,
+				Name:                        result.ValidPreparationName.String,
+				Description:                 result.ValidPreparationDescription.String,
+				Slug:                        result.ValidPreparationSlug.String,
+				MinimumIngredientCount:      result.ValidPreparationMinimumIngredientCount.Int32,
+				MinimumInstrumentCount:      result.ValidPreparationMinimumInstrumentCount.Int32,
+				MinimumVesselCount:          result.ValidPreparationMinimumVesselCount.Int32,
+				RestrictToIngredients:       result.ValidPreparationRestrictToIngredients.Bool,
+				TemperatureRequired:         result.ValidPreparationTemperatureRequired.Bool,
+				TimeEstimateRequired:        result.ValidPreparationTimeEstimateRequired.Bool,
+				ConditionExpressionRequired: result.ValidPreparationConditionExpressionRequired.Bool,
+				ConsumesVessel:              result.ValidPreparationConsumesVessel.Bool,
+				OnlyForVessels:              result.ValidPreparationOnlyForVessels.Bool,
+				YieldsNothing:               result.ValidPreparationYieldsNothing.Bool,
+			}
+		}
+
+		x.Data = append(x.Data, validIngredientSubstitution)
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
+// GetValidIngredientSubstitutionsForIngredients fetches every substitution for any of a set of valid ingredients from the database.
+func (q *Querier) GetValidIngredientSubstitutionsForIngredients(ctx context.Context, ingredientIDs []string) ([]*types.ValidIngredientSubstitution, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if len(ingredientIDs) == 0 {
+		return []*types.ValidIngredientSubstitution{}, nil
+	}
+
+	results, err := q.generatedQuerier.GetValidIngredientSubstitutionsForIngredients(ctx, q.dbFor(ctx), ingredientIDs)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredient substitutions retrieval query")
+	}
+
+	x := []*types.ValidIngredientSubstitution{}
+	for _, result := range results {
+		validIngredientSubstitution := &types.ValidIngredientSubstitution{
+			CreatedAt:     result.ValidIngredientSubstitutionCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.ValidIngredientSubstitutionLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ValidIngredientSubstitutionArchivedAt),
+			ID:            result.ValidIngredientSubstitutionID,
+			Notes:         result.ValidIngredientSubstitutionNotes,
+			Reasons:       result.ValidIngredientSubstitutionReasons,
+			Ratio:         database.Float32FromString(result.ValidIngredientSubstitutionRatio),
+			Original: types.ValidIngredient{
+				CreatedAt:                               result.OriginalIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.OriginalIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.OriginalIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.OriginalIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.OriginalIngredientIconPath,
+				Warning:                                 result.OriginalIngredientWarning,
+				PluralName:                              result.OriginalIngredientPluralName,
+				StorageInstructions:                     result.OriginalIngredientStorageInstructions,
+				Name:                                    result.OriginalIngredientName,
+				ID:                                      result.OriginalIngredientID,
+				Description:                             result.OriginalIngredientDescription,
+				Slug:                                    result.OriginalIngredientSlug,
+				ShoppingSuggestions:                     result.OriginalIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.OriginalIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.OriginalIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.OriginalIngredientIsLiquid),
+				ContainsPeanut:                          result.OriginalIngredientContainsPeanut,
+				ContainsTreeNut:                         result.OriginalIngredientContainsTreeNut,
+				ContainsEgg:                             result.OriginalIngredientContainsEgg,
+				ContainsWheat:                           result.OriginalIngredientContainsWheat,
+				ContainsSoy:                             result.OriginalIngredientContainsSoy,
+				AnimalDerived:                           result.OriginalIngredientAnimalDerived,
+				RestrictToPreparations:                  result.OriginalIngredientRestrictToPreparations,
+				ContainsSesame:                          result.OriginalIngredientContainsSesame,
+				ContainsFish:                            result.OriginalIngredientContainsFish,
+				ContainsGluten:                          result.OriginalIngredientContainsGluten,
+				ContainsDairy:                           result.OriginalIngredientContainsDairy,
+				ContainsAlcohol:                         result.OriginalIngredientContainsAlcohol,
+				AnimalFlesh:                             result.OriginalIngredientAnimalFlesh,
+				IsStarch:                                result.OriginalIngredientIsStarch,
+				IsProtein:                               result.OriginalIngredientIsProtein,
+				IsGrain:                                 result.OriginalIngredientIsGrain,
+				IsFruit:                                 result.OriginalIngredientIsFruit,
+				IsSalt:                                  result.OriginalIngredientIsSalt,
+				IsFat:                                   result.OriginalIngredientIsFat,
+				IsAcid:                                  result.OriginalIngredientIsAcid,
+				IsHeat:                                  result.OriginalIngredientIsHeat,
+			},
+			Substitute: types.ValidIngredient{
+				CreatedAt:                               result.SubstituteIngredientCreatedAt,
+				LastUpdatedAt:                           database.TimePointerFromNullTime(result.SubstituteIngredientLastUpdatedAt),
+				ArchivedAt:                              database.TimePointerFromNullTime(result.SubstituteIngredientArchivedAt),
+				MaximumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMaximumIdealStorageTemperatureInCelsius),
+				MinimumIdealStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.SubstituteIngredientMinimumIdealStorageTemperatureInCelsius),
+				IconPath:                                result.SubstituteIngredientIconPath,
+				Warning:                                 result.SubstituteIngredientWarning,
+				PluralName:                              result.SubstituteIngredientPluralName,
+				StorageInstructions:                     result.SubstituteIngredientStorageInstructions,
+				Name:                                    result.SubstituteIngredientName,
+				ID:                                      result.SubstituteIngredientID,
+				Description:                             result.SubstituteIngredientDescription,
+				Slug:                                    result.SubstituteIngredientSlug,
+				ShoppingSuggestions:                     result.SubstituteIngredientShoppingSuggestions,
+				ContainsShellfish:                       result.SubstituteIngredientContainsShellfish,
+				IsMeasuredVolumetrically:                result.SubstituteIngredientVolumetric,
+				IsLiquid:                                database.BoolFromNullBool(result.SubstituteIngredientIsLiquid),
+				ContainsPeanut:                          result.SubstituteIngredientContainsPeanut,
+				ContainsTreeNut:                         result.SubstituteIngredientContainsTreeNut,
+				ContainsEgg:                             result.SubstituteIngredientContainsEgg,
+				ContainsWheat:                           result.SubstituteIngredientContainsWheat,
+				ContainsSoy:                             result.SubstituteIngredientContainsSoy,
+				AnimalDerived:                           result.SubstituteIngredientAnimalDerived,
+				RestrictToPreparations:                  result.SubstituteIngredientRestrictToPreparations,
+				ContainsSesame:                          result.SubstituteIngredientContainsSesame,
+				ContainsFish:                            result.SubstituteIngredientContainsFish,
+				ContainsGluten:                          result.SubstituteIngredientContainsGluten,
+				ContainsDairy:                           result.SubstituteIngredientContainsDairy,
+				ContainsAlcohol:                         result.SubstituteIngredientContainsAlcohol,
+				AnimalFlesh:                             result.SubstituteIngredientAnimalFlesh,
+				IsStarch:                                result.SubstituteIngredientIsStarch,
+				IsProtein:                               result.SubstituteIngredientIsProtein,
+				IsGrain:                                 result.SubstituteIngredientIsGrain,
+				IsFruit:                                 result.SubstituteIngredientIsFruit,
+				IsSalt:                                  result.SubstituteIngredientIsSalt,
+				IsFat:                                   result.SubstituteIngredientIsFat,
+				IsAcid:                                  result.SubstituteIngredientIsAcid,
+				IsHeat:                                  result.SubstituteIngredientIsHeat,
+			},
+		}
+
+		if result.ValidPreparationID.Valid {
+			validIngredientSubstitution.Preparation = &types.ValidPreparation{
+				CreatedAt:                   result.ValidPreparationCreatedAt.Time,
+				MaximumInstrumentCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumInstrumentCount),
+				ArchivedAt:                  database.TimePointerFromNullTime(result.ValidPreparationArchivedAt),
+				MaximumIngredientCount:      database.Int32PointerFromNullInt32(result.ValidPreparationMaximumIngredientCount),
+				LastUpdatedAt:               database.TimePointerFromNullTime(result.ValidPreparationLastUpdatedAt),
+				MaximumVesselCount:          database.Int32PointerFromNullInt32(result.ValidPreparationMaximumVesselCount),
+				IconPath:                    result.ValidPreparationIconPath.String,
+				PastTense:                   result.ValidPreparationPastTense.String,
+				ID:                          result.ValidPreparationID.String,
+				Name:                        result.ValidPreparationName.String,
+				Description:                 result.ValidPreparationDescription.String,
+				Slug:                        result.ValidPreparationSlug.String,
+				MinimumIngredientCount:      result.ValidPreparationMinimumIngredientCount.Int32,
+				MinimumInstrumentCount:      result.ValidPreparationMinimumInstrumentCount.Int32,
+				MinimumVesselCount:          result.ValidPreparationMinimumVesselCount.Int32,
+				RestrictToIngredients:       result.ValidPreparationRestrictToIngredients.Bool,
+				TemperatureRequired:         result.ValidPreparationTemperatureRequired.Bool,
+				TimeEstimateRequired:        result.ValidPreparationTimeEstimateRequired.Bool,
+				ConditionExpressionRequired: result.ValidPreparationConditionExpressionRequired.Bool,
+				ConsumesVessel:              result.ValidPreparationConsumesVessel.Bool,
+				OnlyForVessels:              result.ValidPreparationOnlyForVessels.Bool,
+				YieldsNothing:               result.ValidPreparationYieldsNothing.Bool,
+			}
+		}
+
+		x = append(x, validIngredientSubstitution)
+	}
+
+	return x, nil
+}
+
+// CreateValidIngredientSubstitution creates a valid ingredient substitution in the database.
+func (q *Querier) CreateValidIngredientSubstitution(ctx context.Context, input *types.ValidIngredientSubstitutionDatabaseCreationInput) (*types.ValidIngredientSubstitution, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, input.ID)
+	logger := q.logger.WithValue(keys.ValidIngredientSubstitutionIDKey, input.ID)
+
+	reasons := input.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	// create the valid ingredient substitution.
+	if err := q.generatedQuerier.CreateValidIngredientSubstitution(ctx, q.dbFor(ctx), &generated.CreateValidIngredientSubstitutionParams{
+		ID:                   input.ID,
+		OriginalIngredient:   input.OriginalIngredientID,
+		SubstituteIngredient: input.SubstituteIngredientID,
+		ValidPreparationID:   database.NullStringFromStringPointer(input.ValidPreparationID),
+		Ratio:                database.StringFromFloat32(input.Ratio),
+		Reasons:              reasons,
+		Notes:                input.Notes,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient substitution creation query")
+	}
+
+	x := &types.ValidIngredientSubstitution{
+		ID:         input.ID,
+		Notes:      input.Notes,
+		Reasons:    reasons,
+		Ratio:      input.Ratio,
+		Original:   types.ValidIngredient{ID: input.OriginalIngredientID},
+		Substitute: types.ValidIngredient{ID: input.SubstituteIngredientID},
+		CreatedAt:  q.currentTime(),
+	}
+
+	if input.ValidPreparationID != nil {
+		x.Preparation = &types.ValidPreparation{ID: *input.ValidPreparationID}
+	}
+
+	logger.Info("valid ingredient substitution created")
+
+	return x, nil
+}
+
+// UpdateValidIngredientSubstitution updates a particular valid ingredient substitution.
+func (q *Querier) UpdateValidIngredientSubstitution(ctx context.Context, updated *types.ValidIngredientSubstitution) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(keys.ValidIngredientSubstitutionIDKey, updated.ID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, updated.ID)
+
+	var preparationID *string
+	if updated.Preparation != nil {
+		preparationID = &updated.Preparation.ID
+	}
+
+	reasons := updated.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	if _, err := q.generatedQuerier.UpdateValidIngredientSubstitution(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientSubstitutionParams{
+		OriginalIngredient:   updated.Original.ID,
+		SubstituteIngredient: updated.Substitute.ID,
+		ValidPreparationID:   database.NullStringFromStringPointer(preparationID),
+		Ratio:                database.StringFromFloat32(updated.Ratio),
+		Reasons:              reasons,
+		Notes:                updated.Notes,
+		ID:                   updated.ID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating valid ingredient substitution")
+	}
+
+	logger.Info("valid ingredient substitution updated")
+
+	return nil
+}
+
+// ArchiveValidIngredientSubstitution archives a valid ingredient substitution from the database by its ID.
+func (q *Querier) ArchiveValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientSubstitutionID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	if _, err := q.generatedQuerier.ArchiveValidIngredientSubstitution(ctx, q.dbFor(ctx), validIngredientSubstitutionID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient substitution")
+	}
+
+	logger.Info("valid ingredient substitution archived")
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidIngredientSubstitutionForTest(t *testing.T, ctx context.Context, exampleValidIngredientSubstitution *types.ValidIngredientSubstitution, dbc *Querier) *types.ValidIngredientSubstitution {
+	t.Helper()
+
+	// create
+	if exampleValidIngredientSubstitution == nil {
+		exampleValidIngredientSubstitution = fakes.BuildFakeValidIngredientSubstitution()
+		exampleValidIngredientSubstitution.Original = *createValidIngredientForTest(t, ctx, nil, dbc)
+		exampleValidIngredientSubstitution.Substitute = *createValidIngredientForTest(t, ctx, nil, dbc)
+		exampleValidIngredientSubstitution.Preparation = createValidPreparationForTest(t, ctx, nil, dbc)
+	}
+
+	dbInput := converters.ConvertValidIngredientSubstitutionToValidIngredientSubstitutionDatabaseCreationInput(exampleValidIngredientSubstitution)
+
+	created, err := dbc.CreateValidIngredientSubstitution(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+	exampleValidIngredientSubstitution.CreatedAt = created.CreatedAt
+	exampleValidIngredientSubstitution.Original = types.ValidIngredient{ID: exampleValidIngredientSubstitution.Original.ID}
+	exampleValidIngredientSubstitution.Substitute = types.ValidIngredient{ID: exampleValidIngredientSubstitution.Substitute.ID}
+	if exampleValidIngredientSubstitution.Preparation != nil {
+		exampleValidIngredientSubstitution.Preparation = &types.ValidPreparation{ID: exampleValidIngredientSubstitution.Preparation.ID}
+	}
+	assert.Equal(t, exampleValidIngredientSubstitution, created)
+
+	validIngredientSubstitution, err := dbc.GetValidIngredientSubstitution(ctx, created.ID)
+	exampleValidIngredientSubstitution.CreatedAt = validIngredientSubstitution.CreatedAt
+	exampleValidIngredientSubstitution.Original = validIngredientSubstitution.Original
+	exampleValidIngredientSubstitution.Substitute = validIngredientSubstitution.Substitute
+	exampleValidIngredientSubstitution.Preparation = validIngredientSubstitution.Preparation
+
+	assert.NoError(t, err)
+	assert.Equal(t, validIngredientSubstitution, exampleValidIngredientSubstitution)
+
+	return created
+}
+
+func TestQuerier_Integration_ValidIngredientSubstitutions(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	exampleOriginal := createValidIngredientForTest(t, ctx, nil, dbc)
+	exampleValidPreparation := createValidPreparationForTest(t, ctx, nil, dbc)
+	exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+	exampleValidIngredientSubstitution.Original = *exampleOriginal
+	exampleValidIngredientSubstitution.Substitute = *createValidIngredientForTest(t, ctx, nil, dbc)
+	exampleValidIngredientSubstitution.Preparation = exampleValidPreparation
+	createdValidIngredientSubstitutions := []*types.ValidIngredientSubstitution{}
+
+	// create
+	createdValidIngredientSubstitutions = append(createdValidIngredientSubstitutions, createValidIngredientSubstitutionForTest(t, ctx, exampleValidIngredientSubstitution, dbc))
+
+	// update
+	updatedValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+	updatedValidIngredientSubstitution.ID = createdValidIngredientSubstitutions[0].ID
+	updatedValidIngredientSubstitution.Original = createdValidIngredientSubstitutions[0].Original
+	updatedValidIngredientSubstitution.Substitute = createdValidIngredientSubstitutions[0].Substitute
+	updatedValidIngredientSubstitution.Preparation = nil
+	assert.NoError(t, dbc.UpdateValidIngredientSubstitution(ctx, updatedValidIngredientSubstitution))
+
+	// create more
+	for i := 0; i < exampleQuantity; i++ {
+		input := fakes.BuildFakeValidIngredientSubstitution()
+		input.Original = *exampleOriginal
+		input.Substitute = *createValidIngredientForTest(t, ctx, nil, dbc)
+		input.Preparation = nil
+		createdValidIngredientSubstitutions = append(createdValidIngredientSubstitutions, createValidIngredientSubstitutionForTest(t, ctx, input, dbc))
+	}
+
+	// fetch as list
+	validIngredientSubstitutions, err := dbc.GetValidIngredientSubstitutions(ctx, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, validIngredientSubstitutions.Data)
+	assert.Equal(t, len(createdValidIngredientSubstitutions), len(validIngredientSubstitutions.Data))
+
+	forIngredient, err := dbc.GetValidIngredientSubstitutionsForIngredient(ctx, exampleOriginal.ID, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(createdValidIngredientSubstitutions), len(forIngredient.Data))
+
+	forIngredients, err := dbc.GetValidIngredientSubstitutionsForIngredients(ctx, []string{exampleOriginal.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, len(createdValidIngredientSubstitutions), len(forIngredients))
+
+	// delete
+	for _, validIngredientSubstitution := range createdValidIngredientSubstitutions {
+		assert.NoError(t, dbc.ArchiveValidIngredientSubstitution(ctx, validIngredientSubstitution.ID))
+
+		var exists bool
+		exists, err = dbc.ValidIngredientSubstitutionExists(ctx, validIngredientSubstitution.ID)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		var y *types.ValidIngredientSubstitution
+		y, err = dbc.GetValidIngredientSubstitution(ctx, validIngredientSubstitution.ID)
+		assert.Nil(t, y)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestQuerier_ValidIngredientSubstitutionExists(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient substitution ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c, _ := buildTestClient(t)
+
+		actual, err := c.ValidIngredientSubstitutionExists(ctx, "")
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+}
+
+func TestQuerier_GetValidIngredientSubstitution(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient substitution ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetValidIngredientSubstitution(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetValidIngredientSubstitutionsForIngredient(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetValidIngredientSubstitutionsForIngredient(ctx, "", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetValidIngredientSubstitutionsForIngredients(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with no valid ingredient IDs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetValidIngredientSubstitutionsForIngredients(ctx, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, actual)
+	})
+}
+
+func TestQuerier_CreateValidIngredientSubstitution(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateValidIngredientSubstitution(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpdateValidIngredientSubstitution(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.UpdateValidIngredientSubstitution(ctx, nil))
+	})
+}
+
+func TestQuerier_ArchiveValidIngredientSubstitution(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient substitution ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.ArchiveValidIngredientSubstitution(ctx, ""))
+	})
+}
@@ -28,7 +28,7 @@ func (q *Querier) ValidIngredientExists(ctx context.Context, validIngredientID s
 	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
 
-	result, err := q.generatedQuerier.CheckValidIngredientExistence(ctx, q.db, validIngredientID)
+	result, err := q.generatedQuerier.CheckValidIngredientExistence(ctx, q.dbFor(ctx), validIngredientID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidIngredient(ctx context.Context, validIngredientID stri
 	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
 
-	result, err := q.generatedQuerier.GetValidIngredient(ctx, q.db, validIngredientID)
+	result, err := q.generatedQuerier.GetValidIngredient(ctx, q.dbFor(ctx), validIngredientID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient")
 	}
@@ -103,7 +103,7 @@ func (q *Querier) GetRandomValidIngredient(ctx context.Context) (*types.ValidIng
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	result, err := q.generatedQuerier.GetRandomValidIngredient(ctx, q.db)
+	result, err := q.generatedQuerier.GetRandomValidIngredient(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching random valid ingredient")
 	}
@@ -173,7 +173,7 @@ func (q *Querier) SearchForValidIngredients(ctx context.Context, query string, f
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.SearchForValidIngredients(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForValidIngredients(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient")
 	}
@@ -255,7 +255,7 @@ func (q *Querier) SearchForValidIngredientsForPreparation(ctx context.Context, p
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.SearchValidIngredientsByPreparationAndIngredientName(ctx, q.db, &generated.SearchValidIngredientsByPreparationAndIngredientNameParams{
+	results, err := q.generatedQuerier.SearchValidIngredientsByPreparationAndIngredientName(ctx, q.dbFor(ctx), &generated.SearchValidIngredientsByPreparationAndIngredientNameParams{
 		ValidPreparationID: preparationID,
 		NameQuery:          query,
 	})
@@ -328,7 +328,7 @@ func (q *Querier) GetValidIngredients(ctx context.Context, filter *types.QueryFi
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredients(ctx, q.db, &generated.GetValidIngredientsParams{
+	results, err := q.generatedQuerier.GetValidIngredients(ctx, q.dbFor(ctx), &generated.GetValidIngredientsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -401,7 +401,7 @@ func (q *Querier) GetValidIngredientsWithIDs(ctx context.Context, ids []string)
 		return nil, ErrEmptyInputProvided
 	}
 
-	results, err := q.generatedQuerier.GetValidIngredientsWithIDs(ctx, q.db, ids)
+	results, err := q.generatedQuerier.GetValidIngredientsWithIDs(ctx, q.dbFor(ctx), ids)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredients id list retrieval query")
 	}
@@ -460,7 +460,7 @@ func (q *Querier) GetValidIngredientIDsThatNeedSearchIndexing(ctx context.Contex
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetValidIngredientsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetValidIngredientsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing valid ingredients list retrieval query")
 	}
@@ -480,7 +480,7 @@ func (q *Querier) CreateValidIngredient(ctx context.Context, input *types.ValidI
 	logger := q.logger.WithValue(keys.ValidIngredientIDKey, input.ID)
 
 	// create the valid ingredient.
-	if err := q.generatedQuerier.CreateValidIngredient(ctx, q.db, &generated.CreateValidIngredientParams{
+	if err := q.generatedQuerier.CreateValidIngredient(ctx, q.dbFor(ctx), &generated.CreateValidIngredientParams{
 		ID:                                      input.ID,
 		Name:                                    input.Name,
 		Description:                             input.Description,
@@ -576,7 +576,7 @@ func (q *Querier) UpdateValidIngredient(ctx context.Context, updated *types.Vali
 	logger := q.logger.WithValue(keys.ValidIngredientIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredient(ctx, q.db, &generated.UpdateValidIngredientParams{
+	if _, err := q.generatedQuerier.UpdateValidIngredient(ctx, q.dbFor(ctx), &generated.UpdateValidIngredientParams{
 		Description:                             updated.Description,
 		Warning:                                 updated.Warning,
 		ID:                                      updated.ID,
@@ -634,7 +634,7 @@ func (q *Querier) MarkValidIngredientAsIndexed(ctx context.Context, validIngredi
 	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
 
-	if _, err := q.generatedQuerier.UpdateValidIngredientLastIndexedAt(ctx, q.db, validIngredientID); err != nil {
+	if _, err := q.generatedQuerier.UpdateValidIngredientLastIndexedAt(ctx, q.dbFor(ctx), validIngredientID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking valid ingredient as indexed")
 	}
 
@@ -656,7 +656,7 @@ func (q *Querier) ArchiveValidIngredient(ctx context.Context, validIngredientID
 	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
 
-	if _, err := q.generatedQuerier.ArchiveValidIngredient(ctx, q.db, validIngredientID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidIngredient(ctx, q.dbFor(ctx), validIngredientID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient")
 	}
 
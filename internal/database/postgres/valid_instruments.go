@@ -28,7 +28,7 @@ func (q *Querier) ValidInstrumentExists(ctx context.Context, validInstrumentID s
 	logger = logger.WithValue(keys.ValidInstrumentIDKey, validInstrumentID)
 	tracing.AttachToSpan(span, keys.ValidInstrumentIDKey, validInstrumentID)
 
-	result, err := q.generatedQuerier.CheckValidInstrumentExistence(ctx, q.db, validInstrumentID)
+	result, err := q.generatedQuerier.CheckValidInstrumentExistence(ctx, q.dbFor(ctx), validInstrumentID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid instrument existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidInstrument(ctx context.Context, validInstrumentID stri
 	logger = logger.WithValue(keys.ValidInstrumentIDKey, validInstrumentID)
 	tracing.AttachToSpan(span, keys.ValidInstrumentIDKey, validInstrumentID)
 
-	result, err := q.generatedQuerier.GetValidInstrument(ctx, q.db, validInstrumentID)
+	result, err := q.generatedQuerier.GetValidInstrument(ctx, q.dbFor(ctx), validInstrumentID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting valid instrument")
 	}
@@ -77,7 +77,7 @@ func (q *Querier) GetRandomValidInstrument(ctx context.Context) (*types.ValidIns
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	result, err := q.generatedQuerier.GetRandomValidInstrument(ctx, q.db)
+	result, err := q.generatedQuerier.GetRandomValidInstrument(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "scanning validInstrument")
 	}
@@ -113,7 +113,7 @@ func (q *Querier) SearchForValidInstruments(ctx context.Context, query string) (
 	logger = logger.WithValue(keys.SearchQueryKey, query)
 	tracing.AttachToSpan(span, keys.ValidInstrumentIDKey, query)
 
-	results, err := q.generatedQuerier.SearchForValidInstruments(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForValidInstruments(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid ingredients list retrieval query")
 	}
@@ -157,7 +157,7 @@ func (q *Querier) GetValidInstruments(ctx context.Context, filter *types.QueryFi
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidInstruments(ctx, q.db, &generated.GetValidInstrumentsParams{
+	results, err := q.generatedQuerier.GetValidInstruments(ctx, q.dbFor(ctx), &generated.GetValidInstrumentsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -198,7 +198,7 @@ func (q *Querier) GetValidInstrumentsWithIDs(ctx context.Context, ids []string)
 
 	logger := q.logger.Clone()
 
-	results, err := q.generatedQuerier.GetValidInstrumentsWithIDs(ctx, q.db, ids)
+	results, err := q.generatedQuerier.GetValidInstrumentsWithIDs(ctx, q.dbFor(ctx), ids)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid instruments id list retrieval query")
 	}
@@ -229,7 +229,7 @@ func (q *Querier) GetValidInstrumentIDsThatNeedSearchIndexing(ctx context.Contex
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetValidInstrumentsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetValidInstrumentsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing valid instruments list retrieval query")
 	}
@@ -249,7 +249,7 @@ func (q *Querier) CreateValidInstrument(ctx context.Context, input *types.ValidI
 	logger := q.logger.WithValue(keys.ValidInstrumentIDKey, input.ID)
 
 	// create the valid instrument.
-	if err := q.generatedQuerier.CreateValidInstrument(ctx, q.db, &generated.CreateValidInstrumentParams{
+	if err := q.generatedQuerier.CreateValidInstrument(ctx, q.dbFor(ctx), &generated.CreateValidInstrumentParams{
 		ID:                             input.ID,
 		Name:                           input.Name,
 		PluralName:                     input.PluralName,
@@ -292,7 +292,7 @@ func (q *Querier) UpdateValidInstrument(ctx context.Context, updated *types.Vali
 	logger := q.logger.WithValue(keys.ValidInstrumentIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidInstrumentIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidInstrument(ctx, q.db, &generated.UpdateValidInstrumentParams{
+	if _, err := q.generatedQuerier.UpdateValidInstrument(ctx, q.dbFor(ctx), &generated.UpdateValidInstrumentParams{
 		Name:                           updated.Name,
 		PluralName:                     updated.PluralName,
 		Description:                    updated.Description,
@@ -324,7 +324,7 @@ func (q *Querier) MarkValidInstrumentAsIndexed(ctx context.Context, validInstrum
 	logger = logger.WithValue(keys.ValidInstrumentIDKey, validInstrumentID)
 	tracing.AttachToSpan(span, keys.ValidInstrumentIDKey, validInstrumentID)
 
-	if _, err := q.generatedQuerier.UpdateValidInstrumentLastIndexedAt(ctx, q.db, validInstrumentID); err != nil {
+	if _, err := q.generatedQuerier.UpdateValidInstrumentLastIndexedAt(ctx, q.dbFor(ctx), validInstrumentID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking valid instrument as indexed")
 	}
 
@@ -346,7 +346,7 @@ func (q *Querier) ArchiveValidInstrument(ctx context.Context, validInstrumentID
 	logger = logger.WithValue(keys.ValidInstrumentIDKey, validInstrumentID)
 	tracing.AttachToSpan(span, keys.ValidInstrumentIDKey, validInstrumentID)
 
-	if _, err := q.generatedQuerier.ArchiveValidInstrument(ctx, q.db, validInstrumentID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidInstrument(ctx, q.dbFor(ctx), validInstrumentID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid instrument")
 	}
 
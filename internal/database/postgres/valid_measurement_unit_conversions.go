@@ -28,7 +28,7 @@ func (q *Querier) ValidMeasurementUnitConversionExists(ctx context.Context, vali
 	logger = logger.WithValue(keys.ValidMeasurementUnitConversionIDKey, validMeasurementUnitConversionID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitConversionIDKey, validMeasurementUnitConversionID)
 
-	result, err := q.generatedQuerier.CheckValidMeasurementUnitConversionExistence(ctx, q.db, validMeasurementUnitConversionID)
+	result, err := q.generatedQuerier.CheckValidMeasurementUnitConversionExistence(ctx, q.dbFor(ctx), validMeasurementUnitConversionID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid measurement conversion existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidMeasurementUnitConversion(ctx context.Context, validMe
 	logger = logger.WithValue(keys.ValidMeasurementUnitConversionIDKey, validMeasurementUnitConversionID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitConversionIDKey, validMeasurementUnitConversionID)
 
-	result, err := q.generatedQuerier.GetValidMeasurementUnitConversion(ctx, q.db, validMeasurementUnitConversionID)
+	result, err := q.generatedQuerier.GetValidMeasurementUnitConversion(ctx, q.dbFor(ctx), validMeasurementUnitConversionID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "scanning valid measurement conversion")
 	}
@@ -152,7 +152,7 @@ func (q *Querier) GetValidMeasurementUnitConversionsFromUnit(ctx context.Context
 	logger = logger.WithValue(keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 
-	results, err := q.generatedQuerier.GetAllValidMeasurementUnitConversionsFromMeasurementUnit(ctx, q.db, validMeasurementUnitID)
+	results, err := q.generatedQuerier.GetAllValidMeasurementUnitConversionsFromMeasurementUnit(ctx, q.dbFor(ctx), validMeasurementUnitID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "querying for valid measurement conversions")
 	}
@@ -256,7 +256,7 @@ func (q *Querier) GetValidMeasurementUnitConversionsToUnit(ctx context.Context,
 	logger = logger.WithValue(keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 
-	results, err := q.generatedQuerier.GetAllValidMeasurementUnitConversionsToMeasurementUnit(ctx, q.db, validMeasurementUnitID)
+	results, err := q.generatedQuerier.GetAllValidMeasurementUnitConversionsToMeasurementUnit(ctx, q.dbFor(ctx), validMeasurementUnitID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "querying for valid measurement conversions")
 	}
@@ -359,7 +359,7 @@ func (q *Querier) CreateValidMeasurementUnitConversion(ctx context.Context, inpu
 	logger := q.logger.WithValue(keys.ValidMeasurementUnitConversionIDKey, input.ID)
 
 	// create the valid measurement conversion.
-	if err := q.generatedQuerier.CreateValidMeasurementUnitConversion(ctx, q.db, &generated.CreateValidMeasurementUnitConversionParams{
+	if err := q.generatedQuerier.CreateValidMeasurementUnitConversion(ctx, q.dbFor(ctx), &generated.CreateValidMeasurementUnitConversionParams{
 		ID:                input.ID,
 		FromUnit:          input.From,
 		ToUnit:            input.To,
@@ -406,7 +406,7 @@ func (q *Querier) UpdateValidMeasurementUnitConversion(ctx context.Context, upda
 		ingredientID = &updated.OnlyForIngredient.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateValidMeasurementUnitConversion(ctx, q.db, &generated.UpdateValidMeasurementUnitConversionParams{
+	if _, err := q.generatedQuerier.UpdateValidMeasurementUnitConversion(ctx, q.dbFor(ctx), &generated.UpdateValidMeasurementUnitConversionParams{
 		FromUnit:          updated.From.ID,
 		ToUnit:            updated.To.ID,
 		OnlyForIngredient: database.NullStringFromStringPointer(ingredientID),
@@ -435,7 +435,7 @@ func (q *Querier) ArchiveValidMeasurementUnitConversion(ctx context.Context, val
 	logger = logger.WithValue(keys.ValidMeasurementUnitConversionIDKey, validMeasurementUnitConversionID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitConversionIDKey, validMeasurementUnitConversionID)
 
-	if _, err := q.generatedQuerier.ArchiveValidMeasurementUnitConversion(ctx, q.db, validMeasurementUnitConversionID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidMeasurementUnitConversion(ctx, q.dbFor(ctx), validMeasurementUnitConversionID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid measurement conversion")
 	}
 
@@ -28,7 +28,7 @@ func (q *Querier) ValidMeasurementUnitExists(ctx context.Context, validMeasureme
 	logger = logger.WithValue(keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 
-	result, err := q.generatedQuerier.CheckValidMeasurementUnitExistence(ctx, q.db, validMeasurementUnitID)
+	result, err := q.generatedQuerier.CheckValidMeasurementUnitExistence(ctx, q.dbFor(ctx), validMeasurementUnitID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid measurement unit existence check")
 	}
@@ -49,7 +49,7 @@ func (q *Querier) GetValidMeasurementUnit(ctx context.Context, validMeasurementU
 	logger = logger.WithValue(keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 
-	result, err := q.generatedQuerier.GetValidMeasurementUnit(ctx, q.db, validMeasurementUnitID)
+	result, err := q.generatedQuerier.GetValidMeasurementUnit(ctx, q.dbFor(ctx), validMeasurementUnitID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "scanning valid measurement unit")
 	}
@@ -78,7 +78,7 @@ func (q *Querier) GetRandomValidMeasurementUnit(ctx context.Context) (*types.Val
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	result, err := q.generatedQuerier.GetRandomValidMeasurementUnit(ctx, q.db)
+	result, err := q.generatedQuerier.GetRandomValidMeasurementUnit(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "scanning valid measurement unit")
 	}
@@ -115,7 +115,7 @@ func (q *Querier) SearchForValidMeasurementUnits(ctx context.Context, query stri
 	logger = logger.WithValue(keys.SearchQueryKey, query)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, query)
 
-	results, err := q.generatedQuerier.SearchForValidMeasurementUnits(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForValidMeasurementUnits(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid measurement units list retrieval query")
 	}
@@ -165,7 +165,7 @@ func (q *Querier) ValidMeasurementUnitsForIngredientID(ctx context.Context, vali
 	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
 	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
 
-	results, err := q.generatedQuerier.SearchValidMeasurementUnitsByIngredientID(ctx, q.db, &generated.SearchValidMeasurementUnitsByIngredientIDParams{
+	results, err := q.generatedQuerier.SearchValidMeasurementUnitsByIngredientID(ctx, q.dbFor(ctx), &generated.SearchValidMeasurementUnitsByIngredientIDParams{
 		CreatedBefore:     database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:      database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore:     database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -220,7 +220,7 @@ func (q *Querier) GetValidMeasurementUnits(ctx context.Context, filter *types.Qu
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidMeasurementUnits(ctx, q.db, &generated.GetValidMeasurementUnitsParams{
+	results, err := q.generatedQuerier.GetValidMeasurementUnits(ctx, q.dbFor(ctx), &generated.GetValidMeasurementUnitsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -264,7 +264,7 @@ func (q *Querier) GetValidMeasurementUnitsWithIDs(ctx context.Context, ids []str
 
 	logger := q.logger.Clone()
 
-	results, err := q.generatedQuerier.GetValidMeasurementUnitsWithIDs(ctx, q.db, ids)
+	results, err := q.generatedQuerier.GetValidMeasurementUnitsWithIDs(ctx, q.dbFor(ctx), ids)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid measurement unit id list retrieval query")
 	}
@@ -296,7 +296,7 @@ func (q *Querier) GetValidMeasurementUnitIDsThatNeedSearchIndexing(ctx context.C
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetValidMeasurementUnitsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetValidMeasurementUnitsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing valid measurement units list retrieval query")
 	}
@@ -316,7 +316,7 @@ func (q *Querier) CreateValidMeasurementUnit(ctx context.Context, input *types.V
 	logger := q.logger.WithValue(keys.ValidMeasurementUnitIDKey, input.ID)
 
 	// create the valid measurement unit.
-	if err := q.generatedQuerier.CreateValidMeasurementUnit(ctx, q.db, &generated.CreateValidMeasurementUnitParams{
+	if err := q.generatedQuerier.CreateValidMeasurementUnit(ctx, q.dbFor(ctx), &generated.CreateValidMeasurementUnitParams{
 		Name:        input.Name,
 		Description: input.Description,
 		IconPath:    input.IconPath,
@@ -361,7 +361,7 @@ func (q *Querier) UpdateValidMeasurementUnit(ctx context.Context, updated *types
 	logger := q.logger.WithValue(keys.ValidMeasurementUnitIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidMeasurementUnit(ctx, q.db, &generated.UpdateValidMeasurementUnitParams{
+	if _, err := q.generatedQuerier.UpdateValidMeasurementUnit(ctx, q.dbFor(ctx), &generated.UpdateValidMeasurementUnitParams{
 		Name:        updated.Name,
 		Description: updated.Description,
 		IconPath:    updated.IconPath,
@@ -394,7 +394,7 @@ func (q *Querier) MarkValidMeasurementUnitAsIndexed(ctx context.Context, validMe
 	logger = logger.WithValue(keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 
-	if _, err := q.generatedQuerier.UpdateValidMeasurementUnitLastIndexedAt(ctx, q.db, validMeasurementUnitID); err != nil {
+	if _, err := q.generatedQuerier.UpdateValidMeasurementUnitLastIndexedAt(ctx, q.dbFor(ctx), validMeasurementUnitID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking valid measurement unit as indexed")
 	}
 
@@ -416,7 +416,7 @@ func (q *Querier) ArchiveValidMeasurementUnit(ctx context.Context, validMeasurem
 	logger = logger.WithValue(keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitIDKey, validMeasurementUnitID)
 
-	if _, err := q.generatedQuerier.ArchiveValidMeasurementUnit(ctx, q.db, validMeasurementUnitID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidMeasurementUnit(ctx, q.dbFor(ctx), validMeasurementUnitID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid measurement unit")
 	}
 
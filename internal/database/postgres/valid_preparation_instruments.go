@@ -25,7 +25,7 @@ func (q *Querier) ValidPreparationInstrumentExists(ctx context.Context, validPre
 	}
 	tracing.AttachToSpan(span, keys.ValidPreparationInstrumentIDKey, validPreparationInstrumentID)
 
-	result, err := q.generatedQuerier.CheckValidPreparationInstrumentExistence(ctx, q.db, validPreparationInstrumentID)
+	result, err := q.generatedQuerier.CheckValidPreparationInstrumentExistence(ctx, q.dbFor(ctx), validPreparationInstrumentID)
 	if err != nil {
 		return false, observability.PrepareError(err, span, "checking valid preparation instrument existence")
 	}
@@ -43,7 +43,7 @@ func (q *Querier) GetValidPreparationInstrument(ctx context.Context, validPrepar
 	}
 	tracing.AttachToSpan(span, keys.ValidPreparationInstrumentIDKey, validPreparationInstrumentID)
 
-	result, err := q.generatedQuerier.GetValidPreparationInstrument(ctx, q.db, validPreparationInstrumentID)
+	result, err := q.generatedQuerier.GetValidPreparationInstrument(ctx, q.dbFor(ctx), validPreparationInstrumentID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting valid preparation instrument")
 	}
@@ -114,7 +114,7 @@ func (q *Querier) GetValidPreparationInstruments(ctx context.Context, filter *ty
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidPreparationInstruments(ctx, q.db, &generated.GetValidPreparationInstrumentsParams{
+	results, err := q.generatedQuerier.GetValidPreparationInstruments(ctx, q.dbFor(ctx), &generated.GetValidPreparationInstrumentsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -203,7 +203,7 @@ func (q *Querier) GetValidPreparationInstrumentsForPreparation(ctx context.Conte
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidPreparationInstrumentsForPreparation(ctx, q.db, &generated.GetValidPreparationInstrumentsForPreparationParams{
+	results, err := q.generatedQuerier.GetValidPreparationInstrumentsForPreparation(ctx, q.dbFor(ctx), &generated.GetValidPreparationInstrumentsForPreparationParams{
 		ID:            preparationID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -294,7 +294,7 @@ func (q *Querier) GetValidPreparationInstrumentsForInstrument(ctx context.Contex
 	}
 	tracing.AttachQueryFilterToSpan(span, filter)
 
-	results, err := q.generatedQuerier.GetValidPreparationInstrumentsForInstrument(ctx, q.db, &generated.GetValidPreparationInstrumentsForInstrumentParams{
+	results, err := q.generatedQuerier.GetValidPreparationInstrumentsForInstrument(ctx, q.dbFor(ctx), &generated.GetValidPreparationInstrumentsForInstrumentParams{
 		ID:            instrumentID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -374,7 +374,7 @@ func (q *Querier) CreateValidPreparationInstrument(ctx context.Context, input *t
 	logger := q.logger.WithValue(keys.ValidPreparationInstrumentIDKey, input.ID)
 
 	// create the valid preparation instrument.
-	if err := q.generatedQuerier.CreateValidPreparationInstrument(ctx, q.db, &generated.CreateValidPreparationInstrumentParams{
+	if err := q.generatedQuerier.CreateValidPreparationInstrument(ctx, q.dbFor(ctx), &generated.CreateValidPreparationInstrumentParams{
 		ID:                 input.ID,
 		Notes:              input.Notes,
 		ValidPreparationID: input.ValidPreparationID,
@@ -408,7 +408,7 @@ func (q *Querier) UpdateValidPreparationInstrument(ctx context.Context, updated
 	logger := q.logger.WithValue(keys.ValidPreparationInstrumentIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidPreparationInstrumentIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidPreparationInstrument(ctx, q.db, &generated.UpdateValidPreparationInstrumentParams{
+	if _, err := q.generatedQuerier.UpdateValidPreparationInstrument(ctx, q.dbFor(ctx), &generated.UpdateValidPreparationInstrumentParams{
 		Notes:              updated.Notes,
 		ValidPreparationID: updated.Preparation.ID,
 		ValidInstrumentID:  updated.Instrument.ID,
@@ -433,7 +433,7 @@ func (q *Querier) ArchiveValidPreparationInstrument(ctx context.Context, validPr
 	logger := q.logger.WithValue(keys.ValidPreparationInstrumentIDKey, validPreparationInstrumentID)
 	tracing.AttachToSpan(span, keys.ValidPreparationInstrumentIDKey, validPreparationInstrumentID)
 
-	if _, err := q.generatedQuerier.ArchiveValidPreparationInstrument(ctx, q.db, validPreparationInstrumentID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidPreparationInstrument(ctx, q.dbFor(ctx), validPreparationInstrumentID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating valid preparation instrument")
 	}
 
@@ -26,7 +26,7 @@ func (q *Querier) ValidPreparationVesselExists(ctx context.Context, validPrepara
 	}
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validPreparationVesselID)
 
-	exists, err = q.generatedQuerier.CheckValidPreparationVesselExistence(ctx, q.db, validPreparationVesselID)
+	exists, err = q.generatedQuerier.CheckValidPreparationVesselExistence(ctx, q.dbFor(ctx), validPreparationVesselID)
 	if err != nil {
 		return false, observability.PrepareError(err, span, "performing valid preparation vessel existence check")
 	}
@@ -44,7 +44,7 @@ func (q *Querier) GetValidPreparationVessel(ctx context.Context, validPreparatio
 	}
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validPreparationVesselID)
 
-	result, err := q.generatedQuerier.GetValidPreparationVessel(ctx, q.db, validPreparationVesselID)
+	result, err := q.generatedQuerier.GetValidPreparationVessel(ctx, q.dbFor(ctx), validPreparationVesselID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "reading valid preparation vessel from database")
 	}
@@ -136,7 +136,7 @@ func (q *Querier) GetValidPreparationVessels(ctx context.Context, filter *types.
 	}
 	tracing.AttachQueryFilterToSpan(span, filter)
 
-	results, err := q.generatedQuerier.GetValidPreparationVessels(ctx, q.db, &generated.GetValidPreparationVesselsParams{
+	results, err := q.generatedQuerier.GetValidPreparationVessels(ctx, q.dbFor(ctx), &generated.GetValidPreparationVesselsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -254,7 +254,7 @@ func (q *Querier) GetValidPreparationVesselsForPreparation(ctx context.Context,
 	}
 	tracing.AttachQueryFilterToSpan(span, filter)
 
-	results, err := q.generatedQuerier.GetValidPreparationVesselsForPreparation(ctx, q.db, &generated.GetValidPreparationVesselsForPreparationParams{
+	results, err := q.generatedQuerier.GetValidPreparationVesselsForPreparation(ctx, q.dbFor(ctx), &generated.GetValidPreparationVesselsForPreparationParams{
 		ID:            preparationID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -372,7 +372,7 @@ func (q *Querier) GetValidPreparationVesselsForVessel(ctx context.Context, vesse
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidPreparationVesselsForVessel(ctx, q.db, &generated.GetValidPreparationVesselsForVesselParams{
+	results, err := q.generatedQuerier.GetValidPreparationVesselsForVessel(ctx, q.dbFor(ctx), &generated.GetValidPreparationVesselsForVesselParams{
 		ID:            vesselID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -479,7 +479,7 @@ func (q *Querier) CreateValidPreparationVessel(ctx context.Context, input *types
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, input.ID)
 
 	// create the valid preparation vessel.
-	if err := q.generatedQuerier.CreateValidPreparationVessel(ctx, q.db, &generated.CreateValidPreparationVesselParams{
+	if err := q.generatedQuerier.CreateValidPreparationVessel(ctx, q.dbFor(ctx), &generated.CreateValidPreparationVesselParams{
 		ID:                 input.ID,
 		Notes:              input.Notes,
 		ValidPreparationID: input.ValidPreparationID,
@@ -512,7 +512,7 @@ func (q *Querier) UpdateValidPreparationVessel(ctx context.Context, updated *typ
 	logger := q.logger.WithValue(keys.ValidPreparationVesselIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidPreparationVessel(ctx, q.db, &generated.UpdateValidPreparationVesselParams{
+	if _, err := q.generatedQuerier.UpdateValidPreparationVessel(ctx, q.dbFor(ctx), &generated.UpdateValidPreparationVesselParams{
 		Notes:              updated.Notes,
 		ValidPreparationID: updated.Preparation.ID,
 		ValidVesselID:      updated.Vessel.ID,
@@ -537,7 +537,7 @@ func (q *Querier) ArchiveValidPreparationVessel(ctx context.Context, validPrepar
 	logger := q.logger.WithValue(keys.ValidPreparationVesselIDKey, validPreparationVesselID)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validPreparationVesselID)
 
-	if _, err := q.generatedQuerier.ArchiveValidPreparationVessel(ctx, q.db, validPreparationVesselID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidPreparationVessel(ctx, q.dbFor(ctx), validPreparationVesselID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating valid preparation vessel")
 	}
 
@@ -29,7 +29,7 @@ func (q *Querier) ValidPreparationExists(ctx context.Context, validPreparationID
 	logger = logger.WithValue(keys.ValidPreparationIDKey, validPreparationID)
 	tracing.AttachToSpan(span, keys.ValidPreparationIDKey, validPreparationID)
 
-	exists, err := q.generatedQuerier.CheckValidPreparationExistence(ctx, q.db, validPreparationID)
+	exists, err := q.generatedQuerier.CheckValidPreparationExistence(ctx, q.dbFor(ctx), validPreparationID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "checking valid preparation existence")
 	}
@@ -50,7 +50,7 @@ func (q *Querier) GetValidPreparation(ctx context.Context, validPreparationID st
 	logger = logger.WithValue(keys.ValidPreparationIDKey, validPreparationID)
 	tracing.AttachToSpan(span, keys.ValidPreparationIDKey, validPreparationID)
 
-	result, err := q.generatedQuerier.GetValidPreparation(ctx, q.db, validPreparationID)
+	result, err := q.generatedQuerier.GetValidPreparation(ctx, q.dbFor(ctx), validPreparationID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting random valid preparation")
 	}
@@ -88,7 +88,7 @@ func (q *Querier) GetRandomValidPreparation(ctx context.Context) (*types.ValidPr
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	result, err := q.generatedQuerier.GetRandomValidPreparation(ctx, q.db)
+	result, err := q.generatedQuerier.GetRandomValidPreparation(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "getting random valid preparation")
 	}
@@ -134,7 +134,7 @@ func (q *Querier) SearchForValidPreparations(ctx context.Context, query string)
 	logger = logger.WithValue(keys.SearchQueryKey, query)
 	tracing.AttachToSpan(span, keys.SearchQueryKey, query)
 
-	results, err := q.generatedQuerier.SearchForValidPreparations(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForValidPreparations(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid preparations search")
 	}
@@ -187,7 +187,7 @@ func (q *Querier) GetValidPreparations(ctx context.Context, filter *types.QueryF
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidPreparations(ctx, q.db, &generated.GetValidPreparationsParams{
+	results, err := q.generatedQuerier.GetValidPreparations(ctx, q.dbFor(ctx), &generated.GetValidPreparationsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -241,7 +241,7 @@ func (q *Querier) GetValidPreparationsWithIDs(ctx context.Context, ids []string)
 	}
 	logger := q.logger.WithValue("ids_count", len(ids))
 
-	results, err := q.generatedQuerier.GetValidPreparationsWithIDs(ctx, q.db, ids)
+	results, err := q.generatedQuerier.GetValidPreparationsWithIDs(ctx, q.dbFor(ctx), ids)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "getting valid preparations by ID")
 	}
@@ -282,7 +282,7 @@ func (q *Querier) GetValidPreparationIDsThatNeedSearchIndexing(ctx context.Conte
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetValidPreparationsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetValidPreparationsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing valid preparations list retrieval query")
 	}
@@ -302,7 +302,7 @@ func (q *Querier) CreateValidPreparation(ctx context.Context, input *types.Valid
 	tracing.AttachToSpan(span, keys.ValidPreparationIDKey, input.ID)
 
 	// create the valid preparation.
-	if err := q.generatedQuerier.CreateValidPreparation(ctx, q.db, &generated.CreateValidPreparationParams{
+	if err := q.generatedQuerier.CreateValidPreparation(ctx, q.dbFor(ctx), &generated.CreateValidPreparationParams{
 		ID:                          input.ID,
 		Name:                        input.Name,
 		Description:                 input.Description,
@@ -365,7 +365,7 @@ func (q *Querier) UpdateValidPreparation(ctx context.Context, updated *types.Val
 	logger := q.logger.WithValue(keys.ValidPreparationIDKey, updated.ID)
 	tracing.AttachToSpan(span, keys.ValidPreparationIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateValidPreparation(ctx, q.db, &generated.UpdateValidPreparationParams{
+	if _, err := q.generatedQuerier.UpdateValidPreparation(ctx, q.dbFor(ctx), &generated.UpdateValidPreparationParams{
 		Description:                 updated.Description,
 		IconPath:                    updated.IconPath,
 		ID:                          updated.ID,
@@ -407,7 +407,7 @@ func (q *Querier) MarkValidPreparationAsIndexed(ctx context.Context, validPrepar
 	logger = logger.WithValue(keys.ValidPreparationIDKey, validPreparationID)
 	tracing.AttachToSpan(span, keys.ValidPreparationIDKey, validPreparationID)
 
-	if _, err := q.generatedQuerier.UpdateValidPreparationLastIndexedAt(ctx, q.db, validPreparationID); err != nil {
+	if _, err := q.generatedQuerier.UpdateValidPreparationLastIndexedAt(ctx, q.dbFor(ctx), validPreparationID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking valid preparation as indexed")
 	}
 
@@ -429,7 +429,7 @@ func (q *Querier) ArchiveValidPreparation(ctx context.Context, validPreparationI
 	logger = logger.WithValue(keys.ValidPreparationIDKey, validPreparationID)
 	tracing.AttachToSpan(span, keys.ValidPreparationIDKey, validPreparationID)
 
-	if _, err := q.generatedQuerier.ArchiveValidPreparation(ctx, q.db, validPreparationID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidPreparation(ctx, q.dbFor(ctx), validPreparationID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating valid preparation")
 	}
 
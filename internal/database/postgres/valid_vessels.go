@@ -30,7 +30,7 @@ func (q *Querier) ValidVesselExists(ctx context.Context, validVesselID string) (
 	logger = logger.WithValue(keys.ValidVesselIDKey, validVesselID)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validVesselID)
 
-	result, err := q.generatedQuerier.CheckValidVesselExistence(ctx, q.db, validVesselID)
+	result, err := q.generatedQuerier.CheckValidVesselExistence(ctx, q.dbFor(ctx), validVesselID)
 	if err != nil {
 		return false, observability.PrepareAndLogError(err, logger, span, "performing valid vessel existence check")
 	}
@@ -51,7 +51,7 @@ func (q *Querier) GetValidVessel(ctx context.Context, validVesselID string) (*ty
 	logger = logger.WithValue(keys.ValidVesselIDKey, validVesselID)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validVesselID)
 
-	result, err := q.generatedQuerier.GetValidVessel(ctx, q.db, validVesselID)
+	result, err := q.generatedQuerier.GetValidVessel(ctx, q.dbFor(ctx), validVesselID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid vessel existence check")
 	}
@@ -99,7 +99,7 @@ func (q *Querier) GetRandomValidVessel(ctx context.Context) (*types.ValidVessel,
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	result, err := q.generatedQuerier.GetRandomValidVessel(ctx, q.db)
+	result, err := q.generatedQuerier.GetRandomValidVessel(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "querying for random valid vessel")
 	}
@@ -155,7 +155,7 @@ func (q *Querier) SearchForValidVessels(ctx context.Context, query string) ([]*t
 	logger = logger.WithValue(keys.SearchQueryKey, query)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, query)
 
-	results, err := q.generatedQuerier.SearchForValidVessels(ctx, q.db, query)
+	results, err := q.generatedQuerier.SearchForValidVessels(ctx, q.dbFor(ctx), query)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "querying for valid vessel")
 	}
@@ -216,7 +216,7 @@ func (q *Querier) GetValidVessels(ctx context.Context, filter *types.QueryFilter
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetValidVessels(ctx, q.db, &generated.GetValidVesselsParams{
+	results, err := q.generatedQuerier.GetValidVessels(ctx, q.dbFor(ctx), &generated.GetValidVesselsParams{
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
 		UpdatedBefore: database.NullTimeFromTimePointer(filter.UpdatedBefore),
@@ -275,7 +275,7 @@ func (q *Querier) GetValidVesselsWithIDs(ctx context.Context, ids []string) ([]*
 		return nil, sql.ErrNoRows
 	}
 
-	results, err := q.generatedQuerier.GetValidVesselsWithIDs(ctx, q.db, ids)
+	results, err := q.generatedQuerier.GetValidVesselsWithIDs(ctx, q.dbFor(ctx), ids)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing valid vessels id list retrieval query")
 	}
@@ -326,7 +326,7 @@ func (q *Querier) GetValidVesselIDsThatNeedSearchIndexing(ctx context.Context) (
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
-	results, err := q.generatedQuerier.GetValidVesselIDsNeedingIndexing(ctx, q.db)
+	results, err := q.generatedQuerier.GetValidVesselIDsNeedingIndexing(ctx, q.dbFor(ctx))
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "executing valid vessels list retrieval query")
 	}
@@ -345,7 +345,7 @@ func (q *Querier) CreateValidVessel(ctx context.Context, input *types.ValidVesse
 	logger := q.logger.WithValue(keys.ValidVesselIDKey, input.ID)
 
 	// create the valid vessel.
-	if err := q.generatedQuerier.CreateValidVessel(ctx, q.db, &generated.CreateValidVesselParams{
+	if err := q.generatedQuerier.CreateValidVessel(ctx, q.dbFor(ctx), &generated.CreateValidVesselParams{
 		Slug:                           input.Slug,
 		ID:                             input.ID,
 		PluralName:                     input.PluralName,
@@ -408,7 +408,7 @@ func (q *Querier) UpdateValidVessel(ctx context.Context, updated *types.ValidVes
 		return fmt.Errorf("capacity unit: %w", ErrNilInputProvided)
 	}
 
-	if _, err := q.generatedQuerier.UpdateValidVessel(ctx, q.db, &generated.UpdateValidVesselParams{
+	if _, err := q.generatedQuerier.UpdateValidVessel(ctx, q.dbFor(ctx), &generated.UpdateValidVesselParams{
 		Name:                           updated.Name,
 		PluralName:                     updated.PluralName,
 		Description:                    updated.Description,
@@ -446,7 +446,7 @@ func (q *Querier) MarkValidVesselAsIndexed(ctx context.Context, validVesselID st
 	logger = logger.WithValue(keys.ValidVesselIDKey, validVesselID)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validVesselID)
 
-	if _, err := q.generatedQuerier.UpdateValidVesselLastIndexedAt(ctx, q.db, validVesselID); err != nil {
+	if _, err := q.generatedQuerier.UpdateValidVesselLastIndexedAt(ctx, q.dbFor(ctx), validVesselID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "marking valid vessel as indexed")
 	}
 
@@ -468,7 +468,7 @@ func (q *Querier) ArchiveValidVessel(ctx context.Context, validVesselID string)
 	logger = logger.WithValue(keys.ValidVesselIDKey, validVesselID)
 	tracing.AttachToSpan(span, keys.ValidVesselIDKey, validVesselID)
 
-	if _, err := q.generatedQuerier.ArchiveValidVessel(ctx, q.db, validVesselID); err != nil {
+	if _, err := q.generatedQuerier.ArchiveValidVessel(ctx, q.dbFor(ctx), validVesselID); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "archiving valid vessel")
 	}
 
@@ -0,0 +1,298 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/database/postgres/generated"
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	_ types.WebhookDeliveryDataManager = (*Querier)(nil)
+)
+
+// GetWebhookDelivery fetches a webhook delivery from the database.
+func (q *Querier) GetWebhookDelivery(ctx context.Context, webhookID, webhookDeliveryID string) (*types.WebhookDelivery, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	if webhookDeliveryID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookDeliveryIDKey, webhookDeliveryID)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	result, err := q.generatedQuerier.GetWebhookDelivery(ctx, q.dbFor(ctx), &generated.GetWebhookDeliveryParams{
+		BelongsToWebhook: webhookID,
+		ID:               webhookDeliveryID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching webhook delivery")
+	}
+
+	webhookDelivery := &types.WebhookDelivery{
+		CreatedAt:             result.CreatedAt,
+		NextAttemptAt:         database.TimePointerFromNullTime(result.NextAttemptAt),
+		ResponseStatusCode:    database.Uint16PointerFromNullInt32(result.ResponseStatusCode),
+		ID:                    result.ID,
+		DeliveryID:            result.DeliveryID,
+		TriggerEvent:          result.TriggerEvent,
+		Payload:               result.Payload,
+		ResponseBody:          result.ResponseBody,
+		Error:                 result.Error,
+		BelongsToWebhook:      result.BelongsToWebhook,
+		LatencyInMilliseconds: uint64(result.LatencyInMilliseconds),
+		Attempt:               uint16(result.Attempt),
+		Succeeded:             result.Succeeded,
+	}
+
+	return webhookDelivery, nil
+}
+
+// GetWebhookDeliveries fetches a list of webhook deliveries from the database that meet a particular filter.
+func (q *Querier) GetWebhookDeliveries(ctx context.Context, webhookID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.WebhookDelivery], error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	if filter == nil {
+		filter = types.DefaultQueryFilter()
+	}
+
+	tracing.AttachQueryFilterToSpan(span, filter)
+	x := &types.QueryFilteredResult[types.WebhookDelivery]{
+		Pagination: filter.ToPagination(),
+	}
+
+	results, err := q.generatedQuerier.GetWebhookDeliveriesForWebhook(ctx, q.dbFor(ctx), &generated.GetWebhookDeliveriesForWebhookParams{
+		BelongsToWebhook: webhookID,
+		CreatedBefore:    database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:     database.NullTimeFromTimePointer(filter.CreatedAfter),
+		QueryOffset:      database.NullInt32FromUint16(filter.QueryOffset()),
+		QueryLimit:       database.NullInt32FromUint8Pointer(filter.Limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching webhook deliveries from database")
+	}
+
+	for _, result := range results {
+		x.Data = append(x.Data, &types.WebhookDelivery{
+			CreatedAt:             result.CreatedAt,
+			NextAttemptAt:         database.TimePointerFromNullTime(result.NextAttemptAt),
+			ResponseStatusCode:    database.Uint16PointerFromNullInt32(result.ResponseStatusCode),
+			ID:                    result.ID,
+			DeliveryID:            result.DeliveryID,
+			TriggerEvent:          result.TriggerEvent,
+			Payload:               result.Payload,
+			ResponseBody:          result.ResponseBody,
+			Error:                 result.Error,
+			BelongsToWebhook:      result.BelongsToWebhook,
+			LatencyInMilliseconds: uint64(result.LatencyInMilliseconds),
+			Attempt:               uint16(result.Attempt),
+			Succeeded:             result.Succeeded,
+		})
+
+		x.FilteredCount = uint64(result.FilteredCount)
+		x.TotalCount = uint64(result.TotalCount)
+	}
+
+	return x, nil
+}
+
+// CreateWebhookDelivery records a webhook delivery attempt in the database.
+func (q *Querier) CreateWebhookDelivery(ctx context.Context, input *types.WebhookDeliveryDatabaseCreationInput) (*types.WebhookDelivery, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	logger := q.logger.WithValues(map[string]any{
+		keys.WebhookIDKey:         input.BelongsToWebhook,
+		keys.WebhookDeliveryIDKey: input.ID,
+	})
+	tracing.AttachToSpan(span, keys.WebhookIDKey, input.BelongsToWebhook)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, input.ID)
+
+	if err := q.generatedQuerier.CreateWebhookDelivery(ctx, q.dbFor(ctx), &generated.CreateWebhookDeliveryParams{
+		NextAttemptAt:         database.NullTimeFromTimePointer(input.NextAttemptAt),
+		ResponseStatusCode:    database.NullInt32FromUint16Pointer(input.ResponseStatusCode),
+		ID:                    input.ID,
+		DeliveryID:            input.DeliveryID,
+		TriggerEvent:          input.TriggerEvent,
+		Payload:               input.Payload,
+		ResponseBody:          input.ResponseBody,
+		Error:                 input.Error,
+		BelongsToWebhook:      input.BelongsToWebhook,
+		LatencyInMilliseconds: int64(input.LatencyInMilliseconds),
+		Attempt:               int32(input.Attempt),
+		Succeeded:             input.Succeeded,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing webhook delivery creation query")
+	}
+
+	x := &types.WebhookDelivery{
+		CreatedAt:             q.currentTime(),
+		NextAttemptAt:         input.NextAttemptAt,
+		ResponseStatusCode:    input.ResponseStatusCode,
+		ID:                    input.ID,
+		DeliveryID:            input.DeliveryID,
+		TriggerEvent:          input.TriggerEvent,
+		Payload:               input.Payload,
+		ResponseBody:          input.ResponseBody,
+		Error:                 input.Error,
+		BelongsToWebhook:      input.BelongsToWebhook,
+		LatencyInMilliseconds: input.LatencyInMilliseconds,
+		Attempt:               input.Attempt,
+		Succeeded:             input.Succeeded,
+	}
+
+	return x, nil
+}
+
+// RecordWebhookDeliverySuccess resets a webhook's consecutive failure count, re-enabling it if it was disabled.
+func (q *Querier) RecordWebhookDeliverySuccess(ctx context.Context, webhookID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(keys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	if err := q.generatedQuerier.RecordWebhookDeliverySuccess(ctx, q.dbFor(ctx), webhookID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "recording webhook delivery success")
+	}
+
+	return nil
+}
+
+// RecordWebhookDeliveryFailure increments a webhook's consecutive failure count, and returns the new count.
+func (q *Querier) RecordWebhookDeliveryFailure(ctx context.Context, webhookID string) (uint32, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" {
+		return 0, ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(keys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	consecutiveFailures, err := q.generatedQuerier.RecordWebhookDeliveryFailure(ctx, q.dbFor(ctx), webhookID)
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, logger, span, "recording webhook delivery failure")
+	}
+
+	return uint32(consecutiveFailures), nil
+}
+
+// DisableWebhook marks a webhook as disabled, which stops it from receiving further deliveries.
+func (q *Querier) DisableWebhook(ctx context.Context, webhookID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(keys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	tx, err := q.beginTx(ctx)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if _, err = q.generatedQuerier.DisableWebhook(ctx, tx, webhookID); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "disabling webhook")
+	}
+
+	if _, err = q.createAuditLogEntry(ctx, tx, &types.AuditLogEntryDatabaseCreationInput{
+		ID:           identifiers.New(),
+		ResourceType: resourceTypeWebhooks,
+		RelevantID:   webhookID,
+		EventType:    types.AuditLogEventTypeUpdated,
+	}); err != nil {
+		q.rollbackTransaction(ctx, tx)
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing database transaction")
+	}
+
+	logger.Info("webhook disabled")
+
+	return nil
+}
+
+// ClaimDueWebhookDeliveryRetries claims a batch of failed deliveries whose next attempt is due. A claimed
+// delivery's next attempt is pushed back by the claim duration, so that it's claimed again if its retry
+// isn't marked as made before then.
+func (q *Querier) ClaimDueWebhookDeliveryRetries(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*types.WebhookDeliveryRetry, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.WithValue("batch_size", batchSize)
+
+	results, err := q.generatedQuerier.ClaimDueWebhookDeliveryRetries(ctx, q.dbFor(ctx), &generated.ClaimDueWebhookDeliveryRetriesParams{
+		ClaimedUntil: database.NullTimeFromTime(q.currentTime().Add(claimDuration)),
+		BatchSize:    int32(batchSize),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "claiming due webhook delivery retries")
+	}
+
+	x := []*types.WebhookDeliveryRetry{}
+	for _, result := range results {
+		x = append(x, &types.WebhookDeliveryRetry{
+			WebhookDeliveryID: result.ID,
+			TriggerEvent:      result.TriggerEvent,
+			WebhookID:         result.BelongsToWebhook,
+			HouseholdID:       result.BelongsToHousehold,
+		})
+	}
+
+	return x, nil
+}
+
+// MarkWebhookDeliveryAsRetried clears a failed delivery's next attempt, once that attempt has been made.
+func (q *Querier) MarkWebhookDeliveryAsRetried(ctx context.Context, webhookDeliveryID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookDeliveryID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(keys.WebhookDeliveryIDKey, webhookDeliveryID)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	if err := q.generatedQuerier.MarkWebhookDeliveryAsRetried(ctx, q.dbFor(ctx), webhookDeliveryID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking webhook delivery as retried")
+	}
+
+	return nil
+}
@@ -0,0 +1,275 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_WebhookDeliveries(t *testing.T) {
+	if !runningContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := context.Background()
+	dbc, container := buildDatabaseClientForTest(t, ctx)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := createUserForTest(t, ctx, nil, dbc)
+	householdID, err := dbc.GetDefaultHouseholdIDForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, householdID)
+
+	exampleWebhook := fakes.BuildFakeWebhook()
+	exampleWebhook.BelongsToHousehold = householdID
+	webhook := createWebhookForTest(t, ctx, exampleWebhook, dbc)
+	triggerEvent := types.ServiceEventType(webhook.Events[0].TriggerEvent)
+
+	createdDeliveries := []*types.WebhookDelivery{}
+	for i := 0; i < exampleQuantity; i++ {
+		exampleDelivery := fakes.BuildFakeWebhookDelivery()
+		exampleDelivery.BelongsToWebhook = webhook.ID
+		exampleDelivery.Attempt = uint16(i + 1)
+
+		created, createErr := dbc.CreateWebhookDelivery(ctx, &types.WebhookDeliveryDatabaseCreationInput{
+			ResponseStatusCode:    exampleDelivery.ResponseStatusCode,
+			ID:                    exampleDelivery.ID,
+			DeliveryID:            exampleDelivery.DeliveryID,
+			TriggerEvent:          exampleDelivery.TriggerEvent,
+			Payload:               exampleDelivery.Payload,
+			ResponseBody:          exampleDelivery.ResponseBody,
+			Error:                 exampleDelivery.Error,
+			BelongsToWebhook:      exampleDelivery.BelongsToWebhook,
+			LatencyInMilliseconds: exampleDelivery.LatencyInMilliseconds,
+			Attempt:               exampleDelivery.Attempt,
+			Succeeded:             exampleDelivery.Succeeded,
+		})
+		require.NoError(t, createErr)
+		exampleDelivery.CreatedAt = created.CreatedAt
+		assert.Equal(t, exampleDelivery, created)
+
+		createdDeliveries = append(createdDeliveries, created)
+	}
+
+	fetched, err := dbc.GetWebhookDelivery(ctx, webhook.ID, createdDeliveries[0].ID)
+	require.NoError(t, err)
+	createdDeliveries[0].CreatedAt = fetched.CreatedAt
+	assert.Equal(t, createdDeliveries[0], fetched)
+
+	deliveries, err := dbc.GetWebhookDeliveries(ctx, webhook.ID, nil)
+	require.NoError(t, err)
+	assert.Len(t, deliveries.Data, len(createdDeliveries))
+
+	// failed deliveries are claimed for retry once their next attempt is due
+	retryableDelivery := fakes.BuildFakeWebhookDelivery()
+	nextAttemptAt := time.Now().Add(-time.Minute)
+	_, err = dbc.CreateWebhookDelivery(ctx, &types.WebhookDeliveryDatabaseCreationInput{
+		NextAttemptAt:    &nextAttemptAt,
+		ID:               retryableDelivery.ID,
+		DeliveryID:       retryableDelivery.DeliveryID,
+		TriggerEvent:     retryableDelivery.TriggerEvent,
+		Payload:          retryableDelivery.Payload,
+		Error:            retryableDelivery.Error,
+		BelongsToWebhook: webhook.ID,
+		Attempt:          1,
+	})
+	require.NoError(t, err)
+
+	retries, err := dbc.ClaimDueWebhookDeliveryRetries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	assert.Equal(t, retryableDelivery.ID, retries[0].WebhookDeliveryID)
+	assert.Equal(t, webhook.ID, retries[0].WebhookID)
+	assert.Equal(t, householdID, retries[0].HouseholdID)
+
+	retries, err = dbc.ClaimDueWebhookDeliveryRetries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+
+	require.NoError(t, dbc.MarkWebhookDeliveryAsRetried(ctx, retryableDelivery.ID))
+
+	retried, err := dbc.GetWebhookDelivery(ctx, webhook.ID, retryableDelivery.ID)
+	require.NoError(t, err)
+	assert.Nil(t, retried.NextAttemptAt)
+
+	// failures accumulate until the webhook is disabled
+	for i := uint32(1); i <= 3; i++ {
+		consecutiveFailures, failureErr := dbc.RecordWebhookDeliveryFailure(ctx, webhook.ID)
+		require.NoError(t, failureErr)
+		assert.Equal(t, i, consecutiveFailures)
+	}
+	require.NoError(t, dbc.DisableWebhook(ctx, webhook.ID))
+
+	disabled, err := dbc.GetWebhook(ctx, webhook.ID, householdID)
+	require.NoError(t, err)
+	assert.NotNil(t, disabled.DisabledAt)
+
+	// retries aren't claimed for disabled webhooks
+	pendingDelivery := fakes.BuildFakeWebhookDelivery()
+	_, err = dbc.CreateWebhookDelivery(ctx, &types.WebhookDeliveryDatabaseCreationInput{
+		NextAttemptAt:    &nextAttemptAt,
+		ID:               pendingDelivery.ID,
+		DeliveryID:       pendingDelivery.DeliveryID,
+		TriggerEvent:     pendingDelivery.TriggerEvent,
+		Payload:          pendingDelivery.Payload,
+		Error:            pendingDelivery.Error,
+		BelongsToWebhook: webhook.ID,
+		Attempt:          1,
+	})
+	require.NoError(t, err)
+
+	retries, err = dbc.ClaimDueWebhookDeliveryRetries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+
+	eligible, err := dbc.GetWebhooksForHouseholdAndEvent(ctx, householdID, triggerEvent)
+	require.NoError(t, err)
+	assert.Empty(t, eligible)
+
+	// a successful delivery re-enables the webhook and resets the count
+	require.NoError(t, dbc.RecordWebhookDeliverySuccess(ctx, webhook.ID))
+
+	enabled, err := dbc.GetWebhook(ctx, webhook.ID, householdID)
+	require.NoError(t, err)
+	assert.Nil(t, enabled.DisabledAt)
+
+	eligible, err = dbc.GetWebhooksForHouseholdAndEvent(ctx, householdID, triggerEvent)
+	require.NoError(t, err)
+	assert.NotEmpty(t, eligible)
+
+	consecutiveFailures, err := dbc.RecordWebhookDeliveryFailure(ctx, webhook.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), consecutiveFailures)
+
+	// nor for archived ones
+	require.NoError(t, dbc.ArchiveWebhook(ctx, webhook.ID, householdID))
+
+	retries, err = dbc.ClaimDueWebhookDeliveryRetries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, retries)
+}
+
+func TestQuerier_GetWebhookDelivery(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetWebhookDelivery(ctx, "", identifiers.New())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid webhook delivery ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetWebhookDelivery(ctx, identifiers.New(), "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetWebhookDeliveries(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.GetWebhookDeliveries(ctx, "", types.DefaultQueryFilter())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateWebhookDelivery(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.CreateWebhookDelivery(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_RecordWebhookDeliverySuccess(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.RecordWebhookDeliverySuccess(ctx, ""))
+	})
+}
+
+func TestQuerier_RecordWebhookDeliveryFailure(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		actual, err := c.RecordWebhookDeliveryFailure(ctx, "")
+		assert.Error(t, err)
+		assert.Zero(t, actual)
+	})
+}
+
+func TestQuerier_DisableWebhook(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.DisableWebhook(ctx, ""))
+	})
+}
+
+func TestQuerier_MarkWebhookDeliveryAsRetried(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook delivery ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, _ := buildTestClient(t)
+
+		assert.Error(t, c.MarkWebhookDeliveryAsRetried(ctx, ""))
+	})
+}
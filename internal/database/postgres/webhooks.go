@@ -41,7 +41,7 @@ func (q *Querier) WebhookExists(ctx context.Context, webhookID, householdID stri
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	result, err := q.generatedQuerier.CheckWebhookExistence(ctx, q.db, &generated.CheckWebhookExistenceParams{
+	result, err := q.generatedQuerier.CheckWebhookExistence(ctx, q.dbFor(ctx), &generated.CheckWebhookExistenceParams{
 		BelongsToHousehold: householdID,
 		ID:                 webhookID,
 	})
@@ -71,7 +71,7 @@ func (q *Querier) GetWebhook(ctx context.Context, webhookID, householdID string)
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	results, err := q.generatedQuerier.GetWebhook(ctx, q.db, &generated.GetWebhookParams{
+	results, err := q.generatedQuerier.GetWebhook(ctx, q.dbFor(ctx), &generated.GetWebhookParams{
 		BelongsToHousehold: householdID,
 		ID:                 webhookID,
 	})
@@ -90,6 +90,7 @@ func (q *Querier) GetWebhook(ctx context.Context, webhookID, householdID string)
 		webhook.CreatedAt = result.WebhookCreatedAt
 		webhook.ArchivedAt = database.TimePointerFromNullTime(result.WebhookArchivedAt)
 		webhook.LastUpdatedAt = database.TimePointerFromNullTime(result.WebhookLastUpdatedAt)
+		webhook.DisabledAt = database.TimePointerFromNullTime(result.WebhookDisabledAt)
 		webhook.Name = result.WebhookName
 		webhook.URL = result.WebhookUrl
 		webhook.Method = result.WebhookMethod
@@ -131,7 +132,7 @@ func (q *Querier) GetWebhooks(ctx context.Context, householdID string, filter *t
 		Pagination: filter.ToPagination(),
 	}
 
-	results, err := q.generatedQuerier.GetWebhooksForHousehold(ctx, q.db, &generated.GetWebhooksForHouseholdParams{
+	results, err := q.generatedQuerier.GetWebhooksForHousehold(ctx, q.dbFor(ctx), &generated.GetWebhooksForHouseholdParams{
 		HouseholdID:   householdID,
 		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
 		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
@@ -149,6 +150,7 @@ func (q *Querier) GetWebhooks(ctx context.Context, householdID string, filter *t
 			CreatedAt:          result.CreatedAt,
 			ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
 			LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+			DisabledAt:         database.TimePointerFromNullTime(result.DisabledAt),
 			Name:               result.Name,
 			URL:                result.URL,
 			Method:             result.Method,
@@ -177,7 +179,7 @@ func (q *Querier) GetWebhooksForHouseholdAndEvent(ctx context.Context, household
 	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
 
-	databaseResults, err := q.generatedQuerier.GetWebhooksForHouseholdAndEvent(ctx, q.db, &generated.GetWebhooksForHouseholdAndEventParams{
+	databaseResults, err := q.generatedQuerier.GetWebhooksForHouseholdAndEvent(ctx, q.dbFor(ctx), &generated.GetWebhooksForHouseholdAndEventParams{
 		BelongsToHousehold: householdID,
 		TriggerEvent:       generated.WebhookEvent(eventType),
 	})
@@ -191,6 +193,7 @@ func (q *Querier) GetWebhooksForHouseholdAndEvent(ctx context.Context, household
 			CreatedAt:          result.CreatedAt,
 			ArchivedAt:         database.TimePointerFromNullTime(result.ArchivedAt),
 			LastUpdatedAt:      database.TimePointerFromNullTime(result.LastUpdatedAt),
+			DisabledAt:         database.TimePointerFromNullTime(result.DisabledAt),
 			Name:               result.Name,
 			URL:                result.URL,
 			Method:             result.Method,
@@ -218,7 +221,7 @@ func (q *Querier) CreateWebhook(ctx context.Context, input *types.WebhookDatabas
 
 	logger.Debug("CreateWebhook invoked")
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -345,7 +348,7 @@ func (q *Querier) ArchiveWebhook(ctx context.Context, webhookID, householdID str
 		keys.HouseholdIDKey: householdID,
 	})
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -399,7 +402,7 @@ func (q *Querier) AddWebhookTriggerEvent(ctx context.Context, householdID string
 		keys.HouseholdIDKey:           householdID,
 	})
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -439,7 +442,7 @@ func (q *Querier) ArchiveWebhookTriggerEvent(ctx context.Context, webhookID, web
 		keys.WebhookTriggerEventIDKey: webhookTriggerEventID,
 	})
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	tx, err := q.beginTx(ctx)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
 	}
@@ -9,6 +9,7 @@
 var (
 	// DBProviders represents what we provide to dependency injectors.
 	DBProviders = wire.NewSet(
+		ProvideTransactionRunner,
 		ProvideMealPlanTaskDataManager,
 		ProvideHouseholdDataManager,
 		ProvideHouseholdInvitationDataManager,
@@ -34,6 +35,7 @@
 		ProvideAdminUserDataManager,
 		ProvidePasswordResetTokenDataManager,
 		ProvideWebhookDataManager,
+		ProvideWebhookDeliveryDataManager,
 		ProvideRecipePrepTaskDataManager,
 		ProvideMealPlanGroceryListItemDataManager,
 		ProvideValidMeasurementUnitConversionDataManager,
@@ -53,9 +55,23 @@
 		ProvideValidPreparationVesselDataManager,
 		ProvideUserNotificationDataManager,
 		ProvideAuditLogEntryDataManager,
+		ProvideCookingSessionDataManager,
+		ProvideOutboxMessageDataManager,
+		ProvideHouseholdEventDataManager,
+		ProvidePantryItemDataManager,
+		ProvideValidIngredientNutritionDataManager,
+		ProvideValidIngredientSubstitutionDataManager,
+		ProvideUserPasskeyDataManager,
+		ProvidePasskeyCeremonyDataManager,
+		ProvideUserRecoveryCodeDataManager,
 	)
 )
 
+// ProvideTransactionRunner is an arbitrary function for dependency injection's sake.
+func ProvideTransactionRunner(db DataManager) TransactionRunner {
+	return db
+}
+
 // ProvideMealPlanTaskDataManager is an arbitrary function for dependency injection's sake.
 func ProvideMealPlanTaskDataManager(db DataManager) types.MealPlanTaskDataManager {
 	return db
@@ -181,6 +197,11 @@ func ProvideWebhookDataManager(db DataManager) types.WebhookDataManager {
 	return db
 }
 
+// ProvideWebhookDeliveryDataManager is an arbitrary function for dependency injection's sake.
+func ProvideWebhookDeliveryDataManager(db DataManager) types.WebhookDeliveryDataManager {
+	return db
+}
+
 // ProvideRecipePrepTaskDataManager is an arbitrary function for dependency injection's sake.
 func ProvideRecipePrepTaskDataManager(db DataManager) types.RecipePrepTaskDataManager {
 	return db
@@ -275,3 +296,48 @@ func ProvideUserNotificationDataManager(db DataManager) types.UserNotificationDa
 func ProvideAuditLogEntryDataManager(db DataManager) types.AuditLogEntryDataManager {
 	return db
 }
+
+// ProvideCookingSessionDataManager is an arbitrary function for dependency injection's sake.
+func ProvideCookingSessionDataManager(db DataManager) types.CookingSessionDataManager {
+	return db
+}
+
+// ProvideOutboxMessageDataManager is an arbitrary function for dependency injection's sake.
+func ProvideOutboxMessageDataManager(db DataManager) types.OutboxMessageDataManager {
+	return db
+}
+
+// ProvideHouseholdEventDataManager is an arbitrary function for dependency injection's sake.
+func ProvideHouseholdEventDataManager(db DataManager) types.HouseholdEventDataManager {
+	return db
+}
+
+// ProvidePantryItemDataManager is an arbitrary function for dependency injection's sake.
+func ProvidePantryItemDataManager(db DataManager) types.PantryItemDataManager {
+	return db
+}
+
+// ProvideValidIngredientNutritionDataManager is an arbitrary function for dependency injection's sake.
+func ProvideValidIngredientNutritionDataManager(db DataManager) types.ValidIngredientNutritionDataManager {
+	return db
+}
+
+// ProvideValidIngredientSubstitutionDataManager is an arbitrary function for dependency injection's sake.
+func ProvideValidIngredientSubstitutionDataManager(db DataManager) types.ValidIngredientSubstitutionDataManager {
+	return db
+}
+
+// ProvideUserPasskeyDataManager is an arbitrary function for dependency injection's sake.
+func ProvideUserPasskeyDataManager(db DataManager) types.UserPasskeyDataManager {
+	return db
+}
+
+// ProvidePasskeyCeremonyDataManager is an arbitrary function for dependency injection's sake.
+func ProvidePasskeyCeremonyDataManager(db DataManager) types.PasskeyCeremonyDataManager {
+	return db
+}
+
+// ProvideUserRecoveryCodeDataManager is an arbitrary function for dependency injection's sake.
+func ProvideUserRecoveryCodeDataManager(db DataManager) types.UserRecoveryCodeDataManager {
+	return db
+}
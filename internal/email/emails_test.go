@@ -73,6 +73,32 @@ func TestBuildPasswordResetTokenRedeemedEmail(T *testing.T) {
 	})
 }
 
+func TestBuildPasskeyRegisteredEmail(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		user := fakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = pointer.To(time.Now())
+
+		actual, err := BuildPasskeyRegisteredEmail(user, envConfigsMap[defaultEnv])
+		assert.NoError(t, err)
+		assert.NotNil(t, actual)
+	})
+
+	T.Run("with unverified recipient", func(t *testing.T) {
+		t.Parallel()
+
+		user := fakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = nil
+
+		actual, err := BuildPasskeyRegisteredEmail(user, envConfigsMap[defaultEnv])
+		assert.ErrorIs(t, err, ErrUnverifiedEmailRecipient)
+		assert.Nil(t, actual)
+	})
+}
+
 func TestBuildUsernameReminderEmail(T *testing.T) {
 	T.Parallel()
 
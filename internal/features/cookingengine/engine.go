@@ -0,0 +1,390 @@
+package cookingengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+var (
+	// ErrInvalidRecipe indicates a recipe can't be cooked in a session, usually because of its condition expressions.
+	ErrInvalidRecipe = errors.New("recipe cannot be cooked in a session")
+	// ErrSessionFinished indicates a cooking session has no steps left to work on.
+	ErrSessionFinished = errors.New("cooking session is already finished")
+	// ErrUnknownRecipeStep indicates progress referred to a step that isn't part of the session's recipe.
+	ErrUnknownRecipeStep = errors.New("recipe step is not part of this recipe")
+	// ErrStepNotUnlocked indicates progress referred to a step that can't be worked on yet.
+	ErrStepNotUnlocked = errors.New("recipe step is not unlocked")
+	// ErrStepNotOptional indicates someone tried to skip a step the recipe requires.
+	ErrStepNotOptional = errors.New("recipe step is not optional")
+	// ErrCompletionConditionsUnsatisfied indicates someone tried to complete a step before its completion conditions were satisfied.
+	ErrCompletionConditionsUnsatisfied = errors.New("recipe step has unsatisfied completion conditions")
+	// ErrUnknownCompletionCondition indicates progress referred to a completion condition that isn't part of the session's recipe.
+	ErrUnknownCompletionCondition = errors.New("completion condition is not part of this recipe")
+	// ErrConditionAlreadySatisfied indicates a completion condition was satisfied twice.
+	ErrConditionAlreadySatisfied = errors.New("completion condition is already satisfied")
+	// ErrInvalidObservationName indicates an observation name couldn't be referred to from a condition expression.
+	ErrInvalidObservationName = errors.New("observation names must be identifiers")
+
+	observationNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// SessionEngine interprets a recipe's condition expressions and completion conditions against a cooking session's progress.
+type SessionEngine interface {
+	ValidateRecipe(ctx context.Context, recipe *types.Recipe) error
+	DetermineState(ctx context.Context, recipe *types.Recipe, session *types.CookingSession) (*types.CookingSessionState, error)
+	ValidateProgress(ctx context.Context, recipe *types.Recipe, state *types.CookingSessionState, input *types.CookingSessionProgressCreationRequestInput) error
+}
+
+var _ SessionEngine = (*sessionEngine)(nil)
+
+type sessionEngine struct {
+	logger         logging.Logger
+	tracer         tracing.Tracer
+	recipeAnalyzer recipeanalysis.RecipeAnalyzer
+}
+
+// NewSessionEngine creates a SessionEngine.
+func NewSessionEngine(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	recipeAnalyzer recipeanalysis.RecipeAnalyzer,
+) SessionEngine {
+	return &sessionEngine{
+		logger:         logging.EnsureLogger(logger).WithName("cooking_session_engine"),
+		tracer:         tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("cooking_session_engine")),
+		recipeAnalyzer: recipeAnalyzer,
+	}
+}
+
+// stepNumber is how people refer to a step, and how condition expressions do too.
+func stepNumber(step *types.RecipeStep) uint32 {
+	return step.Index + 1
+}
+
+// ValidateRecipe makes sure every condition expression in a recipe parses, and that every step whose preparation requires one has one.
+func (e *sessionEngine) ValidateRecipe(ctx context.Context, recipe *types.Recipe) error {
+	ctx, span := e.tracer.StartSpan(ctx)
+	defer span.End()
+
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipe.ID)
+
+	if _, err := e.recipeAnalyzer.MakeGraphForRecipe(ctx, recipe); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidRecipe, err)
+	}
+
+	problems := []string{}
+	for _, step := range recipe.Steps {
+		if step.ConditionExpression == "" {
+			if step.Preparation.ConditionExpressionRequired {
+				problems = append(problems, fmt.Sprintf("step %d: %s requires a condition expression", stepNumber(step), step.Preparation.Name))
+			}
+			continue
+		}
+
+		expression, err := ParseConditionExpression(step.ConditionExpression)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("step %d: %s", stepNumber(step), err))
+			continue
+		}
+
+		for _, reference := range expression.StepReferences() {
+			switch {
+			case reference == stepNumber(step):
+				problems = append(problems, fmt.Sprintf("step %d: condition expression refers to its own step", stepNumber(step)))
+			case reference > uint32(len(recipe.Steps)):
+				problems = append(problems, fmt.Sprintf("step %d: condition expression refers to nonexistent step %d", stepNumber(step), reference))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidRecipe, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// sessionProgress is a cooking session's progress entries boiled down to what currently holds.
+type sessionProgress struct {
+	completedSteps      map[string]bool
+	skippedSteps        map[string]bool
+	satisfiedConditions map[string]bool
+	observations        map[string]string
+}
+
+func summarizeProgress(session *types.CookingSession) *sessionProgress {
+	progress := &sessionProgress{
+		completedSteps:      map[string]bool{},
+		skippedSteps:        map[string]bool{},
+		satisfiedConditions: map[string]bool{},
+		observations:        map[string]string{},
+	}
+
+	for _, entry := range session.Progress {
+		switch entry.ProgressType {
+		case types.CookingSessionProgressTypeStepCompleted, types.CookingSessionProgressTypeStepSkipped:
+			// whoever got there first wins when two people finish the same step at once.
+			if progress.completedSteps[entry.Subject] || progress.skippedSteps[entry.Subject] {
+				continue
+			}
+			if entry.ProgressType == types.CookingSessionProgressTypeStepCompleted {
+				progress.completedSteps[entry.Subject] = true
+			} else {
+				progress.skippedSteps[entry.Subject] = true
+			}
+		case types.CookingSessionProgressTypeConditionSatisfied:
+			progress.satisfiedConditions[entry.Subject] = true
+		case types.CookingSessionProgressTypeObservationRecorded:
+			progress.observations[entry.Subject] = entry.Value
+		}
+	}
+
+	return progress
+}
+
+// DetermineState works out where every step of a recipe stands, given what's happened in a cooking session so far.
+func (e *sessionEngine) DetermineState(ctx context.Context, recipe *types.Recipe, session *types.CookingSession) (*types.CookingSessionState, error) {
+	ctx, span := e.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := e.logger.WithValue(keys.RecipeIDKey, recipe.ID).WithValue(keys.CookingSessionIDKey, session.ID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipe.ID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, session.ID)
+
+	recipeGraph, err := e.recipeAnalyzer.MakeGraphForRecipe(ctx, recipe)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building recipe graph")
+	}
+
+	order, err := stepsInDependencyOrder(recipe, recipeGraph)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "ordering recipe steps")
+	}
+
+	progress := summarizeProgress(session)
+	env := &environment{
+		observations:   progress.observations,
+		completedSteps: map[uint32]bool{},
+		skippedSteps:   map[uint32]bool{},
+		reachedStates:  map[string]bool{},
+	}
+
+	for _, step := range recipe.Steps {
+		env.completedSteps[stepNumber(step)] = progress.completedSteps[step.ID]
+		env.skippedSteps[stepNumber(step)] = progress.skippedSteps[step.ID]
+
+		for _, condition := range step.CompletionConditions {
+			if progress.satisfiedConditions[condition.ID] && condition.IngredientState.Slug != "" {
+				env.reachedStates[condition.IngredientState.Slug] = true
+			}
+		}
+	}
+
+	states := map[uint32]*types.CookingSessionStepState{}
+	for _, step := range order {
+		state := &types.CookingSessionStepState{
+			RecipeStepID:                    step.ID,
+			Index:                           step.Index,
+			ConditionExpression:             step.ConditionExpression,
+			Optional:                        step.Optional,
+			MissingObservations:             []string{},
+			UnsatisfiedCompletionConditions: []string{},
+		}
+		states[stepNumber(step)] = state
+
+		switch {
+		case progress.completedSteps[step.ID]:
+			state.Status = types.CookingSessionStepStatusCompleted
+		case progress.skippedSteps[step.ID]:
+			state.Status = types.CookingSessionStepStatusSkipped
+		case !predecessorsFinished(recipeGraph, states, step):
+			state.Status = types.CookingSessionStepStatusLocked
+		case step.ConditionExpression == "":
+			state.Status = types.CookingSessionStepStatusUnlocked
+		default:
+			expression, parseErr := ParseConditionExpression(step.ConditionExpression)
+			if parseErr != nil {
+				return nil, observability.PrepareAndLogError(parseErr, logger, span, "parsing condition expression for step %d", stepNumber(step))
+			}
+
+			conditionMet, missing, evaluationErr := expression.evaluate(env)
+			if evaluationErr != nil {
+				return nil, observability.PrepareAndLogError(evaluationErr, logger, span, "evaluating condition expression for step %d", stepNumber(step))
+			}
+
+			state.ConditionMet = conditionMet
+			switch {
+			case conditionMet == nil:
+				state.Status = types.CookingSessionStepStatusAwaitingObservations
+				state.MissingObservations = missing
+			case *conditionMet:
+				state.Status = types.CookingSessionStepStatusUnlocked
+			default:
+				// a step whose condition doesn't hold doesn't apply, so it's out of the way of the steps after it.
+				state.Status = types.CookingSessionStepStatusSkipped
+				env.skippedSteps[stepNumber(step)] = true
+			}
+		}
+
+		if state.Status != types.CookingSessionStepStatusCompleted && state.Status != types.CookingSessionStepStatusSkipped {
+			for _, condition := range step.CompletionConditions {
+				if !condition.Optional && !progress.satisfiedConditions[condition.ID] {
+					state.UnsatisfiedCompletionConditions = append(state.UnsatisfiedCompletionConditions, condition.ID)
+				}
+			}
+		}
+	}
+
+	x := &types.CookingSessionState{
+		Session:       session,
+		Observations:  progress.observations,
+		Steps:         []*types.CookingSessionStepState{},
+		UnlockedSteps: []string{},
+		Finished:      true,
+	}
+
+	for _, step := range recipe.Steps {
+		state := states[stepNumber(step)]
+		x.Steps = append(x.Steps, state)
+
+		switch state.Status {
+		case types.CookingSessionStepStatusUnlocked:
+			x.UnlockedSteps = append(x.UnlockedSteps, state.RecipeStepID)
+			x.Finished = false
+		case types.CookingSessionStepStatusCompleted, types.CookingSessionStepStatusSkipped:
+		default:
+			x.Finished = false
+		}
+	}
+
+	sort.SliceStable(x.Steps, func(i, j int) bool { return x.Steps[i].Index < x.Steps[j].Index })
+
+	return x, nil
+}
+
+// stepsInDependencyOrder orders a recipe's steps so that every step comes after the steps it depends on.
+func stepsInDependencyOrder(recipe *types.Recipe, recipeGraph *simple.DirectedGraph) ([]*types.RecipeStep, error) {
+	byNumber := map[int64]*types.RecipeStep{}
+	for _, step := range recipe.Steps {
+		byNumber[int64(stepNumber(step))] = step
+	}
+
+	sorted, err := topo.SortStabilized(recipeGraph, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	order := []*types.RecipeStep{}
+	for _, n := range sorted {
+		if step, ok := byNumber[n.ID()]; ok {
+			order = append(order, step)
+		}
+	}
+
+	return order, nil
+}
+
+func predecessorsFinished(recipeGraph *simple.DirectedGraph, states map[uint32]*types.CookingSessionStepState, step *types.RecipeStep) bool {
+	predecessors := recipeGraph.To(int64(stepNumber(step)))
+	for predecessors.Next() {
+		state, ok := states[uint32(predecessors.Node().ID())]
+		if !ok {
+			return false
+		}
+
+		if state.Status != types.CookingSessionStepStatusCompleted && state.Status != types.CookingSessionStepStatusSkipped {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateProgress checks that a piece of progress makes sense for a cooking session in its current state.
+func (e *sessionEngine) ValidateProgress(ctx context.Context, recipe *types.Recipe, state *types.CookingSessionState, input *types.CookingSessionProgressCreationRequestInput) error {
+	_, span := e.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if state.Finished {
+		return ErrSessionFinished
+	}
+
+	switch input.ProgressType {
+	case types.CookingSessionProgressTypeStepCompleted, types.CookingSessionProgressTypeStepSkipped:
+		stepState := findStepState(state, input.Subject)
+		if stepState == nil {
+			return ErrUnknownRecipeStep
+		}
+
+		if stepState.Status != types.CookingSessionStepStatusUnlocked {
+			return ErrStepNotUnlocked
+		}
+
+		if input.ProgressType == types.CookingSessionProgressTypeStepSkipped {
+			if !stepState.Optional {
+				return ErrStepNotOptional
+			}
+			return nil
+		}
+
+		if len(stepState.UnsatisfiedCompletionConditions) > 0 {
+			return ErrCompletionConditionsUnsatisfied
+		}
+	case types.CookingSessionProgressTypeConditionSatisfied:
+		var owner *types.RecipeStep
+		for _, step := range recipe.Steps {
+			for _, condition := range step.CompletionConditions {
+				if condition.ID == input.Subject {
+					owner = step
+				}
+			}
+		}
+
+		if owner == nil {
+			return ErrUnknownCompletionCondition
+		}
+
+		if stepState := findStepState(state, owner.ID); stepState == nil || stepState.Status != types.CookingSessionStepStatusUnlocked {
+			return ErrStepNotUnlocked
+		}
+
+		if summarizeProgress(state.Session).satisfiedConditions[input.Subject] {
+			return ErrConditionAlreadySatisfied
+		}
+	case types.CookingSessionProgressTypeObservationRecorded:
+		if !observationNamePattern.MatchString(input.Subject) {
+			return ErrInvalidObservationName
+		}
+
+		switch input.Subject {
+		case "true", "false", functionCompleted, functionSkipped, functionReached:
+			return ErrInvalidObservationName
+		}
+	}
+
+	return nil
+}
+
+func findStepState(state *types.CookingSessionState, recipeStepID string) *types.CookingSessionStepState {
+	for _, stepState := range state.Steps {
+		if stepState.RecipeStepID == recipeStepID {
+			return stepState
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,452 @@
+package cookingengine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func buildStep(index uint32, conditionExpression string, conditions ...*types.RecipeStepCompletionCondition) *types.RecipeStep {
+	step := fakes.BuildFakeRecipeStep()
+	step.Index = index
+	step.ConditionExpression = conditionExpression
+	step.Optional = false
+	step.Preparation.ConditionExpressionRequired = false
+	step.CompletionConditions = conditions
+
+	return step
+}
+
+func buildCondition(slug string, optional bool) *types.RecipeStepCompletionCondition {
+	condition := fakes.BuildFakeRecipeStepCompletionCondition()
+	condition.IngredientState.Slug = slug
+	condition.Optional = optional
+
+	return condition
+}
+
+// buildGraph builds a recipe's step graph from pairs of step indices, the first of which feeds the second.
+func buildGraph(recipe *types.Recipe, edges ...[2]uint32) *simple.DirectedGraph {
+	g := simple.NewDirectedGraph()
+	for _, step := range recipe.Steps {
+		g.AddNode(simple.Node(int64(step.Index + 1)))
+	}
+
+	for _, edge := range edges {
+		g.SetEdge(simple.Edge{F: simple.Node(int64(edge[0] + 1)), T: simple.Node(int64(edge[1] + 1))})
+	}
+
+	return g
+}
+
+func buildRecipe(steps ...*types.RecipeStep) *types.Recipe {
+	recipe := fakes.BuildFakeRecipe()
+	recipe.Steps = steps
+
+	return recipe
+}
+
+func buildSession(entries ...*types.CookingSessionProgressEntry) *types.CookingSession {
+	session := fakes.BuildFakeCookingSession()
+	session.Progress = entries
+
+	return session
+}
+
+func progressEntry(progressType, subject, value string) *types.CookingSessionProgressEntry {
+	return &types.CookingSessionProgressEntry{
+		ID:           fakes.BuildFakeID(),
+		ProgressType: progressType,
+		Subject:      subject,
+		Value:        value,
+	}
+}
+
+func buildEngine(t *testing.T, recipe *types.Recipe, recipeGraph *simple.DirectedGraph) (SessionEngine, *recipeanalysis.MockRecipeAnalyzer) {
+	t.Helper()
+
+	recipeAnalyzer := &recipeanalysis.MockRecipeAnalyzer{}
+	recipeAnalyzer.On("MakeGraphForRecipe", testutils.ContextMatcher, recipe).Return(recipeGraph, nil)
+
+	return NewSessionEngine(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), recipeAnalyzer), recipeAnalyzer
+}
+
+func statusesByStepID(state *types.CookingSessionState) map[string]string {
+	statuses := map[string]string{}
+	for _, step := range state.Steps {
+		statuses[step.RecipeStepID] = step.Status
+	}
+
+	return statuses
+}
+
+func TestSessionEngine_ValidateRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, "completed(1) && internal_temperature >= 74"),
+		)
+		recipe.Steps[1].Preparation.ConditionExpressionRequired = true
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe, [2]uint32{0, 1}))
+
+		assert.NoError(t, engine.ValidateRecipe(ctx, recipe))
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("with invalid condition expressions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, "internal_temperature >="),
+			buildStep(2, "completed(3)"),
+			buildStep(3, "completed(9)"),
+		)
+		recipe.Steps[0].Preparation.ConditionExpressionRequired = true
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe))
+
+		err := engine.ValidateRecipe(ctx, recipe)
+		assert.ErrorIs(t, err, ErrInvalidRecipe)
+		assert.ErrorContains(t, err, "step 1:")
+		assert.ErrorContains(t, err, "step 2:")
+		assert.ErrorContains(t, err, "refers to its own step")
+		assert.ErrorContains(t, err, "nonexistent step 9")
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("with error building graph", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(buildStep(0, ""))
+
+		recipeAnalyzer := &recipeanalysis.MockRecipeAnalyzer{}
+		recipeAnalyzer.On("MakeGraphForRecipe", testutils.ContextMatcher, recipe).Return((*simple.DirectedGraph)(nil), errors.New("blah"))
+		engine := NewSessionEngine(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), recipeAnalyzer)
+
+		assert.ErrorIs(t, engine.ValidateRecipe(ctx, recipe), ErrInvalidRecipe)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+}
+
+func TestSessionEngine_DetermineState(T *testing.T) {
+	T.Parallel()
+
+	T.Run("new session", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, ""),
+			buildStep(2, ""),
+		)
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe, [2]uint32{0, 2}, [2]uint32{1, 2}))
+
+		state, err := engine.DetermineState(ctx, recipe, buildSession())
+		require.NoError(t, err)
+
+		assert.False(t, state.Finished)
+		assert.Equal(t, []string{recipe.Steps[0].ID, recipe.Steps[1].ID}, state.UnlockedSteps)
+		assert.Equal(t, types.CookingSessionStepStatusLocked, statusesByStepID(state)[recipe.Steps[2].ID])
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("unlocks steps as their predecessors finish", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, ""),
+			buildStep(2, ""),
+		)
+		recipe.Steps[1].Optional = true
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe, [2]uint32{0, 2}, [2]uint32{1, 2}))
+
+		session := buildSession(
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[0].ID, ""),
+			progressEntry(types.CookingSessionProgressTypeStepSkipped, recipe.Steps[1].ID, ""),
+			// a second household member finishing the same step doesn't change the outcome.
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[1].ID, ""),
+		)
+
+		state, err := engine.DetermineState(ctx, recipe, session)
+		require.NoError(t, err)
+
+		statuses := statusesByStepID(state)
+		assert.Equal(t, types.CookingSessionStepStatusCompleted, statuses[recipe.Steps[0].ID])
+		assert.Equal(t, types.CookingSessionStepStatusSkipped, statuses[recipe.Steps[1].ID])
+		assert.Equal(t, []string{recipe.Steps[2].ID}, state.UnlockedSteps)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("with condition expressions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, "thickness > 2"),
+			buildStep(2, `reached("caramelized")`),
+			buildStep(3, "skipped(3)"),
+		)
+		onions := buildCondition("caramelized", false)
+		recipe.Steps[0].CompletionConditions = []*types.RecipeStepCompletionCondition{onions}
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe, [2]uint32{0, 1}, [2]uint32{0, 2}, [2]uint32{2, 3}))
+
+		session := buildSession(
+			progressEntry(types.CookingSessionProgressTypeConditionSatisfied, onions.ID, ""),
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[0].ID, ""),
+		)
+
+		state, err := engine.DetermineState(ctx, recipe, session)
+		require.NoError(t, err)
+
+		assert.Equal(t, types.CookingSessionStepStatusAwaitingObservations, state.Steps[1].Status)
+		assert.Equal(t, []string{"thickness"}, state.Steps[1].MissingObservations)
+		assert.Nil(t, state.Steps[1].ConditionMet)
+
+		assert.Equal(t, types.CookingSessionStepStatusUnlocked, state.Steps[2].Status)
+		require.NotNil(t, state.Steps[2].ConditionMet)
+		assert.True(t, *state.Steps[2].ConditionMet)
+
+		assert.Equal(t, types.CookingSessionStepStatusLocked, state.Steps[3].Status)
+		assert.Equal(t, []string{recipe.Steps[2].ID}, state.UnlockedSteps)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("skips steps whose conditions don't hold", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, "thickness > 2"),
+			buildStep(2, "skipped(2)"),
+		)
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe, [2]uint32{0, 1}, [2]uint32{1, 2}))
+
+		session := buildSession(
+			progressEntry(types.CookingSessionProgressTypeObservationRecorded, "thickness", "5"),
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[0].ID, ""),
+			// the latest observation wins.
+			progressEntry(types.CookingSessionProgressTypeObservationRecorded, "thickness", "1"),
+		)
+
+		state, err := engine.DetermineState(ctx, recipe, session)
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{"thickness": "1"}, state.Observations)
+		assert.Equal(t, types.CookingSessionStepStatusSkipped, state.Steps[1].Status)
+		require.NotNil(t, state.Steps[1].ConditionMet)
+		assert.False(t, *state.Steps[1].ConditionMet)
+		assert.Equal(t, []string{recipe.Steps[2].ID}, state.UnlockedSteps)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("reports unsatisfied completion conditions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		required := buildCondition("browned", false)
+		optional := buildCondition("crispy", true)
+		recipe := buildRecipe(buildStep(0, "", required, optional))
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe))
+
+		state, err := engine.DetermineState(ctx, recipe, buildSession())
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{required.ID}, state.Steps[0].UnsatisfiedCompletionConditions)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("finished session", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(
+			buildStep(0, ""),
+			buildStep(1, "false"),
+		)
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe, [2]uint32{0, 1}))
+
+		state, err := engine.DetermineState(ctx, recipe, buildSession(
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[0].ID, ""),
+		))
+		require.NoError(t, err)
+
+		assert.True(t, state.Finished)
+		assert.Empty(t, state.UnlockedSteps)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("with invalid condition expression", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(buildStep(0, "internal_temperature >="))
+		engine, recipeAnalyzer := buildEngine(t, recipe, buildGraph(recipe))
+
+		state, err := engine.DetermineState(ctx, recipe, buildSession())
+		assert.Error(t, err)
+		assert.Nil(t, state)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+
+	T.Run("with error building graph", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe(buildStep(0, ""))
+
+		recipeAnalyzer := &recipeanalysis.MockRecipeAnalyzer{}
+		recipeAnalyzer.On("MakeGraphForRecipe", testutils.ContextMatcher, recipe).Return((*simple.DirectedGraph)(nil), errors.New("blah"))
+		engine := NewSessionEngine(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), recipeAnalyzer)
+
+		state, err := engine.DetermineState(ctx, recipe, buildSession())
+		assert.Error(t, err)
+		assert.Nil(t, state)
+
+		mock.AssertExpectationsForObjects(t, recipeAnalyzer)
+	})
+}
+
+func TestSessionEngine_ValidateProgress(T *testing.T) {
+	T.Parallel()
+
+	ctx := context.Background()
+	required := buildCondition("browned", false)
+	recipe := buildRecipe(
+		buildStep(0, "", required),
+		buildStep(1, ""),
+		buildStep(2, ""),
+	)
+	recipe.Steps[1].Optional = true
+	recipeGraph := buildGraph(recipe, [2]uint32{0, 2})
+
+	determine := func(t *testing.T, session *types.CookingSession) (SessionEngine, *types.CookingSessionState) {
+		t.Helper()
+
+		engine, _ := buildEngine(t, recipe, recipeGraph)
+		state, err := engine.DetermineState(ctx, recipe, session)
+		require.NoError(t, err)
+
+		return engine, state
+	}
+
+	T.Run("valid progress", func(t *testing.T) {
+		t.Parallel()
+
+		engine, state := determine(t, buildSession())
+
+		for _, input := range []*types.CookingSessionProgressCreationRequestInput{
+			{ProgressType: types.CookingSessionProgressTypeConditionSatisfied, Subject: required.ID},
+			{ProgressType: types.CookingSessionProgressTypeStepSkipped, Subject: recipe.Steps[1].ID},
+			{ProgressType: types.CookingSessionProgressTypeObservationRecorded, Subject: "internal_temperature", Value: "74"},
+		} {
+			assert.NoError(t, engine.ValidateProgress(ctx, recipe, state, input), input.ProgressType)
+		}
+	})
+
+	T.Run("invalid progress", func(t *testing.T) {
+		t.Parallel()
+
+		engine, state := determine(t, buildSession())
+
+		expectations := []struct {
+			input    *types.CookingSessionProgressCreationRequestInput
+			expected error
+		}{
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeStepCompleted, Subject: fakes.BuildFakeID()},
+				expected: ErrUnknownRecipeStep,
+			},
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeStepCompleted, Subject: recipe.Steps[0].ID},
+				expected: ErrCompletionConditionsUnsatisfied,
+			},
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeStepCompleted, Subject: recipe.Steps[2].ID},
+				expected: ErrStepNotUnlocked,
+			},
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeStepSkipped, Subject: recipe.Steps[0].ID},
+				expected: ErrStepNotOptional,
+			},
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeConditionSatisfied, Subject: fakes.BuildFakeID()},
+				expected: ErrUnknownCompletionCondition,
+			},
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeObservationRecorded, Subject: "internal temperature", Value: "74"},
+				expected: ErrInvalidObservationName,
+			},
+			{
+				input:    &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeObservationRecorded, Subject: "completed", Value: "74"},
+				expected: ErrInvalidObservationName,
+			},
+		}
+
+		for _, expectation := range expectations {
+			assert.ErrorIs(t, engine.ValidateProgress(ctx, recipe, state, expectation.input), expectation.expected)
+		}
+	})
+
+	T.Run("with already satisfied condition", func(t *testing.T) {
+		t.Parallel()
+
+		engine, state := determine(t, buildSession(
+			progressEntry(types.CookingSessionProgressTypeConditionSatisfied, required.ID, ""),
+		))
+
+		input := &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeConditionSatisfied, Subject: required.ID}
+		assert.ErrorIs(t, engine.ValidateProgress(ctx, recipe, state, input), ErrConditionAlreadySatisfied)
+
+		input = &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeStepCompleted, Subject: recipe.Steps[0].ID}
+		assert.NoError(t, engine.ValidateProgress(ctx, recipe, state, input))
+	})
+
+	T.Run("with finished session", func(t *testing.T) {
+		t.Parallel()
+
+		engine, state := determine(t, buildSession(
+			progressEntry(types.CookingSessionProgressTypeConditionSatisfied, required.ID, ""),
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[0].ID, ""),
+			progressEntry(types.CookingSessionProgressTypeStepSkipped, recipe.Steps[1].ID, ""),
+			progressEntry(types.CookingSessionProgressTypeStepCompleted, recipe.Steps[2].ID, ""),
+		))
+		require.True(t, state.Finished)
+
+		input := &types.CookingSessionProgressCreationRequestInput{ProgressType: types.CookingSessionProgressTypeObservationRecorded, Subject: "done", Value: "true"}
+		assert.ErrorIs(t, engine.ValidateProgress(ctx, recipe, state, input), ErrSessionFinished)
+	})
+}
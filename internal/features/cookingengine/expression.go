@@ -0,0 +1,639 @@
+package cookingengine
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+Condition expressions decide whether a recipe step applies to a given cooking session. The grammar is:
+
+	expression := or
+	or         := and ( "||" and )*
+	and        := not ( "&&" not )*
+	not        := "!" not | comparison
+	comparison := operand ( ( "==" | "!=" | "<" | "<=" | ">" | ">=" ) operand )?
+	operand    := NUMBER | STRING | "true" | "false" | IDENTIFIER | call | "(" expression ")"
+	call       := IDENTIFIER "(" ( NUMBER | STRING ) ")"
+
+Bare identifiers refer to observations recorded during the session, like `internal_temperature >= 74`.
+The supported calls are completed(n) and skipped(n), which refer to recipe steps by their 1-based
+number, and reached("slug"), which asks whether a satisfied completion condition has put ingredients
+into the ingredient state with that slug.
+*/
+
+const (
+	functionCompleted = "completed"
+	functionSkipped   = "skipped"
+	functionReached   = "reached"
+)
+
+// ExpressionError describes a problem with a condition expression.
+type ExpressionError struct {
+	Message  string
+	Position int
+}
+
+// Error implements the error interface.
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("invalid condition expression at position %d: %s", e.Position, e.Message)
+}
+
+func expressionErrorf(position int, format string, args ...any) *ExpressionError {
+	return &ExpressionError{Position: position, Message: fmt.Sprintf(format, args...)}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdentifier
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	text     string
+	kind     tokenKind
+	position int
+}
+
+var operators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!"}
+
+func tokenize(source string) ([]token, error) {
+	tokens := []token{}
+
+	for i := 0; i < len(source); {
+		c := rune(source[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", position: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", position: i})
+			i++
+		case c == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(source) && source[i] != '"'; i++ {
+				if source[i] == '\\' && i+1 < len(source) {
+					i++
+				}
+				sb.WriteByte(source[i])
+			}
+			if i >= len(source) {
+				return nil, expressionErrorf(start, "unterminated string")
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, text: sb.String(), position: start})
+		case unicode.IsDigit(c) || (c == '.' && i+1 < len(source) && unicode.IsDigit(rune(source[i+1]))):
+			start := i
+			for i < len(source) && (unicode.IsDigit(rune(source[i])) || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], position: start})
+		case unicode.IsLetter(c) || c == '_':
+			start := i
+			for i < len(source) && (unicode.IsLetter(rune(source[i])) || unicode.IsDigit(rune(source[i])) || source[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: source[start:i], position: start})
+		default:
+			matched := false
+			for _, op := range operators {
+				if strings.HasPrefix(source[i:], op) {
+					tokens = append(tokens, token{kind: tokenOperator, text: op, position: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, expressionErrorf(i, "unexpected character %q", c)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, position: len(source)}), nil
+}
+
+type valueKind int
+
+const (
+	// kindUnknown is the static kind of an observation, whose type isn't known until it's recorded.
+	kindUnknown valueKind = iota
+	kindBool
+	kindNumber
+	kindString
+)
+
+func (k valueKind) String() string {
+	switch k {
+	case kindBool:
+		return "boolean"
+	case kindNumber:
+		return "number"
+	case kindString:
+		return "string"
+	default:
+		return "observation"
+	}
+}
+
+type value struct {
+	str   string
+	num   float64
+	kind  valueKind
+	bool  bool
+	known bool
+}
+
+func unknownValue() value {
+	return value{}
+}
+
+func boolValue(b bool) value {
+	return value{kind: kindBool, bool: b, known: true}
+}
+
+// observationValue interprets a recorded observation as a number or boolean where it looks like one.
+func observationValue(raw string) value {
+	trimmed := strings.TrimSpace(raw)
+	if n, err := strconv.ParseFloat(trimmed, 64); err == nil {
+		return value{kind: kindNumber, num: n, known: true}
+	}
+	if b, err := strconv.ParseBool(trimmed); err == nil {
+		return boolValue(b)
+	}
+
+	return value{kind: kindString, str: raw, known: true}
+}
+
+// environment is everything a condition expression can be evaluated against.
+type environment struct {
+	observations   map[string]string
+	completedSteps map[uint32]bool
+	skippedSteps   map[uint32]bool
+	reachedStates  map[string]bool
+}
+
+// evaluation tracks which observations an expression needed but couldn't find.
+type evaluation struct {
+	env     *environment
+	missing map[string]bool
+}
+
+type node interface {
+	kind() valueKind
+	evaluate(e *evaluation) (value, error)
+	position() int
+}
+
+type literalNode struct {
+	value value
+	pos   int
+}
+
+func (n *literalNode) kind() valueKind { return n.value.kind }
+func (n *literalNode) position() int   { return n.pos }
+func (n *literalNode) evaluate(*evaluation) (value, error) {
+	return n.value, nil
+}
+
+type observationNode struct {
+	name string
+	pos  int
+}
+
+func (n *observationNode) kind() valueKind { return kindUnknown }
+func (n *observationNode) position() int   { return n.pos }
+func (n *observationNode) evaluate(e *evaluation) (value, error) {
+	raw, ok := e.env.observations[n.name]
+	if !ok {
+		e.missing[n.name] = true
+		return unknownValue(), nil
+	}
+
+	return observationValue(raw), nil
+}
+
+type callNode struct {
+	function   string
+	argument   string
+	stepNumber uint32
+	pos        int
+}
+
+func (n *callNode) kind() valueKind { return kindBool }
+func (n *callNode) position() int   { return n.pos }
+func (n *callNode) evaluate(e *evaluation) (value, error) {
+	switch n.function {
+	case functionCompleted:
+		return boolValue(e.env.completedSteps[n.stepNumber]), nil
+	case functionSkipped:
+		return boolValue(e.env.skippedSteps[n.stepNumber]), nil
+	default:
+		return boolValue(e.env.reachedStates[n.argument]), nil
+	}
+}
+
+type notNode struct {
+	operand node
+	pos     int
+}
+
+func (n *notNode) kind() valueKind { return kindBool }
+func (n *notNode) position() int   { return n.pos }
+func (n *notNode) evaluate(e *evaluation) (value, error) {
+	v, err := evaluateBool(e, n.operand)
+	if err != nil || !v.known {
+		return v, err
+	}
+
+	return boolValue(!v.bool), nil
+}
+
+type logicalNode struct {
+	left     node
+	right    node
+	operator string
+	pos      int
+}
+
+func (n *logicalNode) kind() valueKind { return kindBool }
+func (n *logicalNode) position() int   { return n.pos }
+
+// evaluate uses three-valued logic, so a missing observation only matters when it could change the answer.
+func (n *logicalNode) evaluate(e *evaluation) (value, error) {
+	left, err := evaluateBool(e, n.left)
+	if err != nil {
+		return value{}, err
+	}
+
+	right, err := evaluateBool(e, n.right)
+	if err != nil {
+		return value{}, err
+	}
+
+	// a known dominant value settles the result regardless of the other side.
+	dominant := n.operator == "||"
+	if (left.known && left.bool == dominant) || (right.known && right.bool == dominant) {
+		return boolValue(dominant), nil
+	}
+
+	if !left.known || !right.known {
+		return unknownValue(), nil
+	}
+
+	return boolValue(!dominant), nil
+}
+
+type comparisonNode struct {
+	left     node
+	right    node
+	operator string
+	pos      int
+}
+
+func (n *comparisonNode) kind() valueKind { return kindBool }
+func (n *comparisonNode) position() int   { return n.pos }
+func (n *comparisonNode) evaluate(e *evaluation) (value, error) {
+	left, err := n.left.evaluate(e)
+	if err != nil {
+		return value{}, err
+	}
+
+	right, err := n.right.evaluate(e)
+	if err != nil {
+		return value{}, err
+	}
+
+	if !left.known || !right.known {
+		return unknownValue(), nil
+	}
+
+	if left.kind != right.kind {
+		return value{}, expressionErrorf(n.pos, "cannot compare %s to %s", left.kind, right.kind)
+	}
+
+	switch left.kind {
+	case kindNumber:
+		return boolValue(compareNumbers(left.num, right.num, n.operator)), nil
+	case kindString:
+		if !isEqualityOperator(n.operator) {
+			return value{}, expressionErrorf(n.pos, "operator %s is not supported for strings", n.operator)
+		}
+		return boolValue((left.str == right.str) == (n.operator == "==")), nil
+	default:
+		if !isEqualityOperator(n.operator) {
+			return value{}, expressionErrorf(n.pos, "operator %s is not supported for booleans", n.operator)
+		}
+		return boolValue((left.bool == right.bool) == (n.operator == "==")), nil
+	}
+}
+
+func isEqualityOperator(operator string) bool {
+	return operator == "==" || operator == "!="
+}
+
+func compareNumbers(left, right float64, operator string) bool {
+	switch operator {
+	case "==":
+		return left == right
+	case "!=":
+		return left != right
+	case "<":
+		return left < right
+	case "<=":
+		return left <= right
+	case ">":
+		return left > right
+	default:
+		return left >= right
+	}
+}
+
+func evaluateBool(e *evaluation, n node) (value, error) {
+	v, err := n.evaluate(e)
+	if err != nil || !v.known {
+		return v, err
+	}
+
+	if v.kind != kindBool {
+		return value{}, expressionErrorf(n.position(), "expected a boolean, got a %s", v.kind)
+	}
+
+	return v, nil
+}
+
+// ConditionExpression is a parsed recipe step condition expression.
+type ConditionExpression struct {
+	root   node
+	source string
+}
+
+// ParseConditionExpression parses and type-checks a condition expression.
+func ParseConditionExpression(source string) (*ConditionExpression, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, expressionErrorf(next.position, "unexpected %q", next.text)
+	}
+
+	if err = expectBool(root); err != nil {
+		return nil, err
+	}
+
+	return &ConditionExpression{root: root, source: source}, nil
+}
+
+// String returns the expression as it was written.
+func (x *ConditionExpression) String() string {
+	return x.source
+}
+
+// StepReferences returns the step numbers the expression refers to, in ascending order.
+func (x *ConditionExpression) StepReferences() []uint32 {
+	seen := map[uint32]bool{}
+	walk(x.root, func(n node) {
+		if call, ok := n.(*callNode); ok && call.function != functionReached {
+			seen[call.stepNumber] = true
+		}
+	})
+
+	references := []uint32{}
+	for stepNumber := range seen {
+		references = append(references, stepNumber)
+	}
+	sort.Slice(references, func(i, j int) bool { return references[i] < references[j] })
+
+	return references
+}
+
+// evaluate evaluates the expression. The result is nil when it depends on observations that haven't been recorded, which are returned in sorted order.
+func (x *ConditionExpression) evaluate(env *environment) (result *bool, missingObservations []string, err error) {
+	e := &evaluation{env: env, missing: map[string]bool{}}
+
+	v, err := evaluateBool(e, x.root)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if v.known {
+		return &v.bool, nil, nil
+	}
+
+	for name := range e.missing {
+		missingObservations = append(missingObservations, name)
+	}
+	sort.Strings(missingObservations)
+
+	return nil, missingObservations, nil
+}
+
+func walk(n node, fn func(node)) {
+	fn(n)
+
+	switch x := n.(type) {
+	case *notNode:
+		walk(x.operand, fn)
+	case *logicalNode:
+		walk(x.left, fn)
+		walk(x.right, fn)
+	case *comparisonNode:
+		walk(x.left, fn)
+		walk(x.right, fn)
+	}
+}
+
+func expectBool(n node) error {
+	if k := n.kind(); k != kindBool && k != kindUnknown {
+		return expressionErrorf(n.position(), "expected a boolean, got a %s", k)
+	}
+
+	return nil
+}
+
+type parser struct {
+	tokens  []token
+	current int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.current]
+	if t.kind != tokenEOF {
+		p.current++
+	}
+
+	return t
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseLogical("||", p.parseAnd)
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseLogical("&&", p.parseNot)
+}
+
+func (p *parser) parseLogical(operator string, operand func() (node, error)) (node, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOperator && p.peek().text == operator {
+		op := p.next()
+
+		right, rightErr := operand()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+
+		if err = expectBool(left); err != nil {
+			return nil, err
+		}
+		if err = expectBool(right); err != nil {
+			return nil, err
+		}
+
+		left = &logicalNode{left: left, right: right, operator: operator, pos: op.position}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if t := p.peek(); t.kind == tokenOperator && t.text == "!" {
+		p.next()
+
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = expectBool(operand); err != nil {
+			return nil, err
+		}
+
+		return &notNode{operand: operand, pos: t.position}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	if t.kind != tokenOperator || t.text == "&&" || t.text == "||" || t.text == "!" {
+		return left, nil
+	}
+	p.next()
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	leftKind, rightKind := left.kind(), right.kind()
+	if leftKind != kindUnknown && rightKind != kindUnknown && leftKind != rightKind {
+		return nil, expressionErrorf(t.position, "cannot compare %s to %s", leftKind, rightKind)
+	}
+
+	if !isEqualityOperator(t.text) && (leftKind == kindBool || leftKind == kindString || rightKind == kindBool || rightKind == kindString) {
+		return nil, expressionErrorf(t.position, "operator %s only applies to numbers", t.text)
+	}
+
+	return &comparisonNode{left: left, right: right, operator: t.text, pos: t.position}, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, expressionErrorf(t.position, "invalid number %q", t.text)
+		}
+		return &literalNode{value: value{kind: kindNumber, num: n, known: true}, pos: t.position}, nil
+	case tokenString:
+		return &literalNode{value: value{kind: kindString, str: t.text, known: true}, pos: t.position}, nil
+	case tokenLeftParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, expressionErrorf(closing.position, "expected ')'")
+		}
+		return inner, nil
+	case tokenIdentifier:
+		switch t.text {
+		case "true", "false":
+			return &literalNode{value: boolValue(t.text == "true"), pos: t.position}, nil
+		}
+
+		if p.peek().kind == tokenLeftParen {
+			return p.parseCall(t)
+		}
+
+		return &observationNode{name: t.text, pos: t.position}, nil
+	case tokenEOF:
+		return nil, expressionErrorf(t.position, "unexpected end of expression")
+	default:
+		return nil, expressionErrorf(t.position, "unexpected %q", t.text)
+	}
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	p.next()
+	argument := p.next()
+
+	if closing := p.next(); closing.kind != tokenRightParen {
+		return nil, expressionErrorf(closing.position, "expected ')'")
+	}
+
+	switch name.text {
+	case functionCompleted, functionSkipped:
+		stepNumber, err := strconv.ParseUint(argument.text, 10, 32)
+		if argument.kind != tokenNumber || err != nil || stepNumber == 0 {
+			return nil, expressionErrorf(argument.position, "%s() takes a step number", name.text)
+		}
+		return &callNode{function: name.text, stepNumber: uint32(stepNumber), pos: name.position}, nil
+	case functionReached:
+		if argument.kind != tokenString || argument.text == "" {
+			return nil, expressionErrorf(argument.position, "%s() takes an ingredient state slug", name.text)
+		}
+		return &callNode{function: name.text, argument: argument.text, pos: name.position}, nil
+	default:
+		return nil, expressionErrorf(name.position, "unknown function %q", name.text)
+	}
+}
@@ -0,0 +1,147 @@
+package cookingengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestEnvironment() *environment {
+	return &environment{
+		observations: map[string]string{
+			"internal_temperature": "74",
+			"color":                "golden",
+			"rested":               "true",
+		},
+		completedSteps: map[uint32]bool{1: true},
+		skippedSteps:   map[uint32]bool{2: true},
+		reachedStates:  map[string]bool{"caramelized": true},
+	}
+}
+
+func TestParseConditionExpression(T *testing.T) {
+	T.Parallel()
+
+	T.Run("valid expressions", func(t *testing.T) {
+		t.Parallel()
+
+		for _, source := range []string{
+			"true",
+			"internal_temperature >= 74",
+			`color == "golden" && !skipped(2)`,
+			`(completed(1) || reached("caramelized")) && rested`,
+			"1.5 < 2",
+		} {
+			expression, err := ParseConditionExpression(source)
+			assert.NoError(t, err, source)
+			assert.Equal(t, source, expression.String())
+		}
+	})
+
+	T.Run("invalid expressions", func(t *testing.T) {
+		t.Parallel()
+
+		for _, source := range []string{
+			"",
+			"internal_temperature >=",
+			`"golden"`,
+			"74",
+			`color < "golden"`,
+			`true == 1`,
+			"completed(0)",
+			`completed("one")`,
+			"reached(1)",
+			"boiling(1)",
+			"(true",
+			`"unterminated`,
+			"true && 3",
+			"a # b",
+			"a < b < c",
+		} {
+			_, err := ParseConditionExpression(source)
+
+			var expressionErr *ExpressionError
+			assert.ErrorAs(t, err, &expressionErr, source)
+		}
+	})
+}
+
+func TestConditionExpression_StepReferences(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		expression, err := ParseConditionExpression(`completed(3) && (skipped(1) || completed(3)) && reached("x")`)
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint32{1, 3}, expression.StepReferences())
+	})
+}
+
+func TestConditionExpression_evaluate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("known results", func(t *testing.T) {
+		t.Parallel()
+
+		expectations := map[string]bool{
+			"internal_temperature >= 74":   true,
+			"internal_temperature > 74":    false,
+			`color == "golden"`:            true,
+			`color != "golden"`:            false,
+			"rested":                       true,
+			"!rested":                      false,
+			"completed(1) && skipped(2)":   true,
+			"completed(2) || skipped(1)":   false,
+			`reached("caramelized")`:       true,
+			`reached("raw")`:               false,
+			"false && missing_observation": false,
+			"missing_observation || internal_temperature >= 70": true,
+		}
+
+		for source, expected := range expectations {
+			expression, err := ParseConditionExpression(source)
+			require.NoError(t, err, source)
+
+			actual, missing, err := expression.evaluate(buildTestEnvironment())
+			require.NoError(t, err, source)
+			require.NotNil(t, actual, source)
+			assert.Equal(t, expected, *actual, source)
+			assert.Empty(t, missing, source)
+		}
+	})
+
+	T.Run("with missing observations", func(t *testing.T) {
+		t.Parallel()
+
+		expression, err := ParseConditionExpression("thickness > 2 || (true && !bubbling)")
+		require.NoError(t, err)
+
+		actual, missing, err := expression.evaluate(buildTestEnvironment())
+		assert.NoError(t, err)
+		assert.Nil(t, actual)
+		assert.Equal(t, []string{"bubbling", "thickness"}, missing)
+	})
+
+	T.Run("with mismatched observation type", func(t *testing.T) {
+		t.Parallel()
+
+		expression, err := ParseConditionExpression("color > 3")
+		require.NoError(t, err)
+
+		_, _, err = expression.evaluate(buildTestEnvironment())
+		assert.Error(t, err)
+	})
+
+	T.Run("with non-boolean observation", func(t *testing.T) {
+		t.Parallel()
+
+		expression, err := ParseConditionExpression("internal_temperature")
+		require.NoError(t, err)
+
+		_, _, err = expression.evaluate(buildTestEnvironment())
+		assert.Error(t, err)
+	})
+}
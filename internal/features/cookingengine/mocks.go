@@ -0,0 +1,33 @@
+package cookingengine
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ SessionEngine = (*MockSessionEngine)(nil)
+
+// MockSessionEngine is a mock SessionEngine.
+type MockSessionEngine struct {
+	mock.Mock
+}
+
+// ValidateRecipe is a mock function.
+func (m *MockSessionEngine) ValidateRecipe(ctx context.Context, recipe *types.Recipe) error {
+	return m.Called(ctx, recipe).Error(0)
+}
+
+// DetermineState is a mock function.
+func (m *MockSessionEngine) DetermineState(ctx context.Context, recipe *types.Recipe, session *types.CookingSession) (*types.CookingSessionState, error) {
+	returnValues := m.Called(ctx, recipe, session)
+
+	return returnValues.Get(0).(*types.CookingSessionState), returnValues.Error(1)
+}
+
+// ValidateProgress is a mock function.
+func (m *MockSessionEngine) ValidateProgress(ctx context.Context, recipe *types.Recipe, state *types.CookingSessionState, input *types.CookingSessionProgressCreationRequestInput) error {
+	return m.Called(ctx, recipe, state, input).Error(0)
+}
@@ -0,0 +1,93 @@
+package cooktimeline
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	// ICalendarContentType is the content type of a rendered iCalendar document.
+	ICalendarContentType = "text/calendar; charset=utf-8"
+
+	icalTimeFormat = "20060102T150405Z"
+	// icalMaxLineLength is the longest a content line may be, in octets, before it has to be folded.
+	icalMaxLineLength = 75
+)
+
+var icalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// RenderICalendar renders a cook timeline as an iCalendar document with one event per scheduled step.
+func RenderICalendar(timeline *types.CookTimeline, generatedAt time.Time) []byte {
+	var buf bytes.Buffer
+
+	writeICalendarLine(&buf, "BEGIN:VCALENDAR")
+	writeICalendarLine(&buf, "VERSION:2.0")
+	writeICalendarLine(&buf, "PRODID:-//Dinner Done Better//Cook Timeline//EN")
+	writeICalendarLine(&buf, "CALSCALE:GREGORIAN")
+	writeICalendarLine(&buf, "X-WR-CALNAME:"+icalEscaper.Replace(timeline.MealName))
+
+	for _, entry := range timeline.Entries {
+		summary := fmt.Sprintf("%s: step %d", entry.RecipeName, entry.RecipeStepIndex+1)
+		if entry.PreparationName != "" {
+			summary = fmt.Sprintf("%s (%s)", summary, entry.PreparationName)
+		}
+
+		description := entry.Instructions
+		if len(entry.Equipment) > 0 {
+			description = strings.TrimSpace(fmt.Sprintf("%s\nEquipment: %s", description, strings.Join(entry.Equipment, ", ")))
+		}
+
+		writeICalendarLine(&buf, "BEGIN:VEVENT")
+		writeICalendarLine(&buf, fmt.Sprintf("UID:%s-%s@dinnerdonebetter.dev", timeline.MealPlanEventID, entry.RecipeStepID))
+		writeICalendarLine(&buf, "DTSTAMP:"+generatedAt.UTC().Format(icalTimeFormat))
+		writeICalendarLine(&buf, "DTSTART:"+entry.StartsAt.UTC().Format(icalTimeFormat))
+		writeICalendarLine(&buf, "DTEND:"+entry.EndsAt.UTC().Format(icalTimeFormat))
+		writeICalendarLine(&buf, "SUMMARY:"+icalEscaper.Replace(summary))
+		if description != "" {
+			writeICalendarLine(&buf, "DESCRIPTION:"+icalEscaper.Replace(description))
+		}
+		if entry.OnCriticalPath {
+			writeICalendarLine(&buf, "CATEGORIES:CRITICAL PATH")
+		}
+		writeICalendarLine(&buf, "END:VEVENT")
+	}
+
+	writeICalendarLine(&buf, "END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// writeICalendarLine writes a content line, folding it onto continuation lines as RFC 5545 requires.
+func writeICalendarLine(buf *bytes.Buffer, line string) {
+	limit := icalMaxLineLength
+	for len(line) > limit {
+		// don't split a multi-byte character across lines.
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines lose an octet to their leading space.
+		limit = icalMaxLineLength - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func isRuneStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
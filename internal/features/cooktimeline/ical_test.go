@@ -0,0 +1,73 @@
+package cooktimeline
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderICalendar(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		serveAt := time.Date(2024, time.March, 1, 18, 0, 0, 0, time.UTC)
+		timeline := &types.CookTimeline{
+			MealPlanEventID: "event",
+			MealName:        "Sunday, roast",
+			Entries: []*types.CookTimelineEntry{
+				{
+					StartsAt:        serveAt.Add(-time.Hour),
+					EndsAt:          serveAt,
+					RecipeName:      "Roast chicken",
+					RecipeStepID:    "step",
+					PreparationName: "roast",
+					Instructions:    "roast until golden; rest after",
+					Equipment:       []string{"oven"},
+					OnCriticalPath:  true,
+				},
+			},
+		}
+
+		expected := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"VERSION:2.0",
+			"PRODID:-//Dinner Done Better//Cook Timeline//EN",
+			"CALSCALE:GREGORIAN",
+			`X-WR-CALNAME:Sunday\, roast`,
+			"BEGIN:VEVENT",
+			"UID:event-step@dinnerdonebetter.dev",
+			"DTSTAMP:20240301T120000Z",
+			"DTSTART:20240301T170000Z",
+			"DTEND:20240301T180000Z",
+			"SUMMARY:Roast chicken: step 1 (roast)",
+			`DESCRIPTION:roast until golden\; rest after\nEquipment: oven`,
+			"CATEGORIES:CRITICAL PATH",
+			"END:VEVENT",
+			"END:VCALENDAR",
+			"",
+		}, "\r\n")
+
+		actual := RenderICalendar(timeline, serveAt.Add(-6*time.Hour))
+
+		assert.Equal(t, expected, string(actual))
+	})
+
+	T.Run("folds long lines", func(t *testing.T) {
+		t.Parallel()
+
+		timeline := &types.CookTimeline{MealName: strings.Repeat("é", 60)}
+
+		actual := string(RenderICalendar(timeline, time.Now()))
+
+		for _, line := range strings.Split(strings.TrimSuffix(actual, "\r\n"), "\r\n") {
+			assert.LessOrEqual(t, len(line), icalMaxLineLength)
+		}
+		assert.Contains(t, strings.ReplaceAll(actual, "\r\n ", ""), "X-WR-CALNAME:"+strings.Repeat("é", 60))
+	})
+}
@@ -0,0 +1,23 @@
+package cooktimeline
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ TimelineScheduler = (*MockTimelineScheduler)(nil)
+
+// MockTimelineScheduler is a mock TimelineScheduler.
+type MockTimelineScheduler struct {
+	mock.Mock
+}
+
+// ScheduleMealPlanEvent is a mock function.
+func (m *MockTimelineScheduler) ScheduleMealPlanEvent(ctx context.Context, householdID, mealPlanID, mealPlanEventID string) (*types.CookTimeline, error) {
+	returnValues := m.Called(ctx, householdID, mealPlanID, mealPlanEventID)
+
+	return returnValues.Get(0).(*types.CookTimeline), returnValues.Error(1)
+}
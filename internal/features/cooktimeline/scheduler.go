@@ -0,0 +1,497 @@
+package cooktimeline
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+const (
+	// defaultStepDuration is how long we assume a step takes when it has no time estimate.
+	defaultStepDuration = 5 * time.Minute
+)
+
+var (
+	// ErrNoChosenOption indicates a meal plan event has no option to build a timeline for.
+	ErrNoChosenOption = errors.New("meal plan event has no chosen option")
+)
+
+// TimelineScheduler builds cook timelines for meal plan events.
+type TimelineScheduler interface {
+	ScheduleMealPlanEvent(ctx context.Context, householdID, mealPlanID, mealPlanEventID string) (*types.CookTimeline, error)
+}
+
+var _ TimelineScheduler = (*timelineScheduler)(nil)
+
+type timelineScheduler struct {
+	logger                     logging.Logger
+	tracer                     tracing.Tracer
+	recipeAnalyzer             recipeanalysis.RecipeAnalyzer
+	mealPlanEventDataManager   types.MealPlanEventDataManager
+	mealDataManager            types.MealDataManager
+	instrumentOwnershipManager types.HouseholdInstrumentOwnershipDataManager
+}
+
+// NewTimelineScheduler creates a TimelineScheduler.
+func NewTimelineScheduler(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	recipeAnalyzer recipeanalysis.RecipeAnalyzer,
+	mealPlanEventDataManager types.MealPlanEventDataManager,
+	mealDataManager types.MealDataManager,
+	instrumentOwnershipManager types.HouseholdInstrumentOwnershipDataManager,
+) TimelineScheduler {
+	return &timelineScheduler{
+		logger:                     logging.EnsureLogger(logger).WithName("cook_timeline_scheduler"),
+		tracer:                     tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("cook_timeline_scheduler")),
+		recipeAnalyzer:             recipeAnalyzer,
+		mealPlanEventDataManager:   mealPlanEventDataManager,
+		mealDataManager:            mealDataManager,
+		instrumentOwnershipManager: instrumentOwnershipManager,
+	}
+}
+
+// ScheduleMealPlanEvent schedules every step of a meal plan event's chosen meal so that the whole meal is ready when the event starts.
+func (s *timelineScheduler) ScheduleMealPlanEvent(ctx context.Context, householdID, mealPlanID, mealPlanEventID string) (*types.CookTimeline, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithValue(keys.HouseholdIDKey, householdID).
+		WithValue(keys.MealPlanIDKey, mealPlanID).
+		WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	event, err := s.mealPlanEventDataManager.GetMealPlanEvent(ctx, mealPlanID, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan event")
+	}
+
+	option := chosenOption(event)
+	if option == nil {
+		return nil, ErrNoChosenOption
+	}
+	logger = logger.WithValue(keys.MealPlanOptionIDKey, option.ID)
+
+	meal, err := s.mealDataManager.GetMeal(ctx, option.Meal.ID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal")
+	}
+
+	capacities, err := s.loadCapacities(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching household instrument ownerships")
+	}
+
+	tasks := []*task{}
+	for i, component := range meal.Components {
+		recipe := component.Recipe
+
+		recipeGraph, graphErr := s.recipeAnalyzer.MakeGraphForRecipe(ctx, &recipe)
+		if graphErr != nil {
+			return nil, observability.PrepareAndLogError(graphErr, logger, span, "building graph for recipe %s", recipe.ID)
+		}
+
+		tasks = append(tasks, buildTasks(i, &recipe, recipeGraph)...)
+	}
+
+	schedule(tasks, capacities)
+
+	timeline := &types.CookTimeline{
+		StartsAt:         event.StartsAt,
+		FinishesAt:       event.StartsAt,
+		MealPlanID:       mealPlanID,
+		MealPlanEventID:  event.ID,
+		MealPlanOptionID: option.ID,
+		MealID:           meal.ID,
+		MealName:         meal.Name,
+		Entries:          []*types.CookTimelineEntry{},
+	}
+
+	for _, t := range tasks {
+		entry := t.toEntry(event.StartsAt)
+		if entry.StartsAt.Before(timeline.StartsAt) {
+			timeline.StartsAt = entry.StartsAt
+		}
+		timeline.Entries = append(timeline.Entries, entry)
+	}
+
+	sort.SliceStable(timeline.Entries, func(i, j int) bool {
+		return timeline.Entries[i].StartsAt.Before(timeline.Entries[j].StartsAt)
+	})
+
+	return timeline, nil
+}
+
+// chosenOption returns the option a meal plan event settled on, or its only option if it has just one.
+func chosenOption(event *types.MealPlanEvent) *types.MealPlanOption {
+	for _, option := range event.Options {
+		if option.Chosen {
+			return option
+		}
+	}
+
+	if len(event.Options) == 1 {
+		return event.Options[0]
+	}
+
+	return nil
+}
+
+// loadCapacities determines how many of each instrument a household has to work with, keyed by both ID and slug.
+func (s *timelineScheduler) loadCapacities(ctx context.Context, householdID string) (map[string]int, error) {
+	filter := types.DefaultQueryFilter()
+	filter.Page = pointer.To(uint16(1))
+	filter.Limit = pointer.To(uint8(types.MaxLimit))
+
+	capacities := map[string]int{}
+	for {
+		results, err := s.instrumentOwnershipManager.GetHouseholdInstrumentOwnerships(ctx, householdID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ownership := range results.Data {
+			capacities[instrumentResourceKey(ownership.Instrument.ID)] = int(ownership.Quantity)
+			if ownership.Instrument.Slug != "" {
+				capacities[vesselSlugResourceKey(ownership.Instrument.Slug)] = int(ownership.Quantity)
+			}
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			return capacities, nil
+		}
+		*filter.Page++
+	}
+}
+
+func instrumentResourceKey(instrumentID string) string {
+	return "instrument:" + instrumentID
+}
+
+// vesselSlugResourceKey identifies a vessel by its slug, since households record the vessels they own as instruments.
+func vesselSlugResourceKey(slug string) string {
+	return "vessel:" + slug
+}
+
+// resourceNeed is an amount of one instrument or vessel a step occupies for its whole duration.
+type resourceNeed struct {
+	key      string
+	name     string
+	quantity int
+}
+
+// task is a single recipe step being scheduled.
+type task struct {
+	recipe       *types.Recipe
+	step         *types.RecipeStep
+	predecessors []*task
+	successors   []*task
+	needs        []*resourceNeed
+	duration     time.Duration
+	// head is the longest chain of work ending with this task, and tail the longest chain starting with it.
+	head time.Duration
+	tail time.Duration
+	// offset is how long before serving this task has to finish.
+	offset      time.Duration
+	recipeIndex int
+	scheduled   bool
+	critical    bool
+}
+
+func (t *task) toEntry(serveAt time.Time) *types.CookTimelineEntry {
+	equipment := []string{}
+	for _, need := range t.needs {
+		equipment = append(equipment, need.name)
+	}
+
+	instructions := t.step.ExplicitInstructions
+	if instructions == "" {
+		instructions = t.step.Notes
+	}
+
+	endsAt := serveAt.Add(-t.offset)
+
+	return &types.CookTimelineEntry{
+		StartsAt:                endsAt.Add(-t.duration),
+		EndsAt:                  endsAt,
+		RecipeID:                t.recipe.ID,
+		RecipeName:              t.recipe.Name,
+		RecipeStepID:            t.step.ID,
+		RecipeStepIndex:         t.step.Index,
+		PreparationName:         t.step.Preparation.Name,
+		Instructions:            instructions,
+		Equipment:               equipment,
+		DurationInSeconds:       uint32(t.duration / time.Second),
+		OnCriticalPath:          t.critical,
+		StartTimerAutomatically: t.step.StartTimerAutomatically,
+	}
+}
+
+// stepDuration uses a step's longest estimate, so that the meal isn't late when steps run long.
+func stepDuration(step *types.RecipeStep) time.Duration {
+	switch {
+	case step.MaximumEstimatedTimeInSeconds != nil && *step.MaximumEstimatedTimeInSeconds > 0:
+		return time.Duration(*step.MaximumEstimatedTimeInSeconds) * time.Second
+	case step.MinimumEstimatedTimeInSeconds != nil && *step.MinimumEstimatedTimeInSeconds > 0:
+		return time.Duration(*step.MinimumEstimatedTimeInSeconds) * time.Second
+	default:
+		return defaultStepDuration
+	}
+}
+
+// stepNeeds lists the instruments and vessels a step occupies. Instruments and vessels that are products of earlier steps
+// are already accounted for by the step that produced them.
+func stepNeeds(step *types.RecipeStep) []*resourceNeed {
+	needs := []*resourceNeed{}
+	for _, instrument := range step.Instruments {
+		if instrument.Instrument == nil {
+			continue
+		}
+
+		needs = append(needs, &resourceNeed{
+			key:      instrumentResourceKey(instrument.Instrument.ID),
+			name:     instrument.Instrument.Name,
+			quantity: max(int(instrument.MinimumQuantity), 1),
+		})
+	}
+
+	for _, vessel := range step.Vessels {
+		if vessel.Vessel == nil {
+			continue
+		}
+
+		needs = append(needs, &resourceNeed{
+			key:      vesselSlugResourceKey(vessel.Vessel.Slug),
+			name:     vessel.Vessel.Name,
+			quantity: max(int(vessel.MinimumQuantity), 1),
+		})
+	}
+
+	return needs
+}
+
+// buildTasks turns each step of a recipe into a task, linked according to the recipe's step graph.
+func buildTasks(recipeIndex int, recipe *types.Recipe, recipeGraph *simple.DirectedGraph) []*task {
+	tasks := []*task{}
+	byGraphID := map[int64]*task{}
+	for _, step := range recipe.Steps {
+		t := &task{
+			recipe:      recipe,
+			recipeIndex: recipeIndex,
+			step:        step,
+			duration:    stepDuration(step),
+			needs:       stepNeeds(step),
+		}
+		tasks = append(tasks, t)
+		byGraphID[int64(step.Index+1)] = t
+	}
+
+	for id, t := range byGraphID {
+		successors := recipeGraph.From(id)
+		for successors.Next() {
+			successor, ok := byGraphID[successors.Node().ID()]
+			if !ok {
+				continue
+			}
+
+			t.successors = append(t.successors, successor)
+			successor.predecessors = append(successor.predecessors, t)
+		}
+	}
+
+	// map iteration order is random, so keep links in step order for deterministic schedules.
+	for _, t := range tasks {
+		sort.Slice(t.successors, func(i, j int) bool { return t.successors[i].step.Index < t.successors[j].step.Index })
+		sort.Slice(t.predecessors, func(i, j int) bool { return t.predecessors[i].step.Index < t.predecessors[j].step.Index })
+	}
+
+	return tasks
+}
+
+// schedule places every task as late as possible before serving, longest remaining chain of work first,
+// without ever using more of an instrument or vessel at once than the household has.
+func schedule(tasks []*task, capacities map[string]int) {
+	markCriticalPath(tasks)
+
+	pools := map[string]*resourcePool{}
+	for _, t := range tasks {
+		for _, need := range t.needs {
+			if _, ok := pools[need.key]; !ok {
+				pools[need.key] = &resourcePool{capacity: max(capacities[need.key], 1)}
+			}
+		}
+	}
+
+	for range tasks {
+		next := nextReadyTask(tasks)
+		if next == nil {
+			// only possible with a cyclic graph, which the recipe analyzer rejects.
+			return
+		}
+
+		var earliest time.Duration
+		for _, successor := range next.successors {
+			earliest = max(earliest, successor.offset+successor.duration)
+		}
+
+		next.offset = findSlot(next, pools, earliest)
+		for _, need := range next.needs {
+			pools[need.key].reserve(next.offset, next.offset+next.duration, min(need.quantity, pools[need.key].capacity))
+		}
+		next.scheduled = true
+	}
+}
+
+// nextReadyTask picks the unscheduled task whose successors are all scheduled with the most work still ahead of it.
+func nextReadyTask(tasks []*task) *task {
+	var next *task
+	for _, t := range tasks {
+		if t.scheduled || !allScheduled(t.successors) {
+			continue
+		}
+
+		if next == nil ||
+			t.head > next.head ||
+			(t.head == next.head && t.recipeIndex < next.recipeIndex) ||
+			(t.head == next.head && t.recipeIndex == next.recipeIndex && t.step.Index > next.step.Index) {
+			next = t
+		}
+	}
+
+	return next
+}
+
+func allScheduled(tasks []*task) bool {
+	for _, t := range tasks {
+		if !t.scheduled {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findSlot finds the smallest offset at or after earliest where every resource a task needs is free for its whole duration.
+func findSlot(t *task, pools map[string]*resourcePool, earliest time.Duration) time.Duration {
+	candidates := []time.Duration{earliest}
+	for _, need := range t.needs {
+		for _, r := range pools[need.key].reservations {
+			if r.to > earliest {
+				candidates = append(candidates, r.to)
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	for _, candidate := range candidates {
+		fits := true
+		for _, need := range t.needs {
+			pool := pools[need.key]
+			if !pool.available(candidate, candidate+t.duration, min(need.quantity, pool.capacity)) {
+				fits = false
+				break
+			}
+		}
+
+		if fits {
+			return candidate
+		}
+	}
+
+	// the last reservation to end always leaves every resource free, so this is unreachable.
+	return candidates[len(candidates)-1]
+}
+
+// markCriticalPath flags the tasks on the longest chain of dependent steps, ignoring equipment contention.
+func markCriticalPath(tasks []*task) {
+	var computeHead, computeTail func(*task) time.Duration
+	heads, tails := map[*task]bool{}, map[*task]bool{}
+
+	computeHead = func(t *task) time.Duration {
+		if !heads[t] {
+			heads[t] = true
+			var longest time.Duration
+			for _, predecessor := range t.predecessors {
+				longest = max(longest, computeHead(predecessor))
+			}
+			t.head = longest + t.duration
+		}
+		return t.head
+	}
+
+	computeTail = func(t *task) time.Duration {
+		if !tails[t] {
+			tails[t] = true
+			var longest time.Duration
+			for _, successor := range t.successors {
+				longest = max(longest, computeTail(successor))
+			}
+			t.tail = longest + t.duration
+		}
+		return t.tail
+	}
+
+	var longestPath time.Duration
+	for _, t := range tasks {
+		longestPath = max(longestPath, computeHead(t)+computeTail(t)-t.duration)
+	}
+
+	for _, t := range tasks {
+		t.critical = t.head+t.tail-t.duration == longestPath
+	}
+}
+
+type reservation struct {
+	from     time.Duration
+	to       time.Duration
+	quantity int
+}
+
+// resourcePool tracks how much of one instrument or vessel is in use, measured backwards from serving time.
+type resourcePool struct {
+	reservations []reservation
+	capacity     int
+}
+
+func (p *resourcePool) reserve(from, to time.Duration, quantity int) {
+	p.reservations = append(p.reservations, reservation{from: from, to: to, quantity: quantity})
+}
+
+// available reports whether quantity more can be used throughout [from, to).
+func (p *resourcePool) available(from, to time.Duration, quantity int) bool {
+	// usage only increases where a reservation begins, so those are the only points worth checking.
+	points := []time.Duration{from}
+	for _, r := range p.reservations {
+		if r.from > from && r.from < to {
+			points = append(points, r.from)
+		}
+	}
+
+	for _, point := range points {
+		inUse := 0
+		for _, r := range p.reservations {
+			if r.from <= point && point < r.to {
+				inUse += r.quantity
+			}
+		}
+
+		if inUse+quantity > p.capacity {
+			return false
+		}
+	}
+
+	return true
+}
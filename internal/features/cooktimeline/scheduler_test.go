@@ -0,0 +1,342 @@
+package cooktimeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gonum.org/v1/gonum/graph/simple"
+)
+
+func buildStep(index uint32, duration time.Duration, instruments ...*types.ValidInstrument) *types.RecipeStep {
+	step := fakes.BuildFakeRecipeStep()
+	step.Index = index
+	step.MinimumEstimatedTimeInSeconds = nil
+	step.MaximumEstimatedTimeInSeconds = pointer.To(uint32(duration / time.Second))
+	step.Vessels = []*types.RecipeStepVessel{}
+	step.Instruments = []*types.RecipeStepInstrument{}
+	for _, instrument := range instruments {
+		stepInstrument := fakes.BuildFakeRecipeStepInstrument()
+		stepInstrument.Instrument = instrument
+		stepInstrument.MinimumQuantity = 1
+		step.Instruments = append(step.Instruments, stepInstrument)
+	}
+
+	return step
+}
+
+// buildGraph builds a recipe's step graph from pairs of step indices, the first of which feeds the second.
+func buildGraph(recipe *types.Recipe, edges ...[2]uint32) *simple.DirectedGraph {
+	g := simple.NewDirectedGraph()
+	for _, step := range recipe.Steps {
+		g.AddNode(simple.Node(int64(step.Index + 1)))
+	}
+
+	for _, edge := range edges {
+		g.SetEdge(simple.Edge{F: simple.Node(int64(edge[0] + 1)), T: simple.Node(int64(edge[1] + 1))})
+	}
+
+	return g
+}
+
+func buildRecipe(steps ...*types.RecipeStep) *types.Recipe {
+	recipe := fakes.BuildFakeRecipe()
+	recipe.Steps = steps
+
+	return recipe
+}
+
+func entriesByStepID(timeline *types.CookTimeline) map[string]*types.CookTimelineEntry {
+	entries := map[string]*types.CookTimelineEntry{}
+	for _, entry := range timeline.Entries {
+		entries[entry.RecipeStepID] = entry
+	}
+
+	return entries
+}
+
+type schedulerMocks struct {
+	recipeAnalyzer             *recipeanalysis.MockRecipeAnalyzer
+	mealPlanEventDataManager   *mocktypes.MealPlanEventDataManagerMock
+	mealDataManager            *mocktypes.MealDataManagerMock
+	instrumentOwnershipManager *mocktypes.HouseholdInstrumentOwnershipDataManagerMock
+}
+
+func (m *schedulerMocks) assertExpectations(t *testing.T) {
+	t.Helper()
+
+	mock.AssertExpectationsForObjects(t, m.recipeAnalyzer, m.mealPlanEventDataManager, m.mealDataManager, m.instrumentOwnershipManager)
+}
+
+func buildTestScheduler() (TimelineScheduler, *schedulerMocks) {
+	mocks := &schedulerMocks{
+		recipeAnalyzer:             &recipeanalysis.MockRecipeAnalyzer{},
+		mealPlanEventDataManager:   &mocktypes.MealPlanEventDataManagerMock{},
+		mealDataManager:            &mocktypes.MealDataManagerMock{},
+		instrumentOwnershipManager: &mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
+	}
+
+	s := NewTimelineScheduler(
+		logging.NewNoopLogger(),
+		tracing.NewNoopTracerProvider(),
+		mocks.recipeAnalyzer,
+		mocks.mealPlanEventDataManager,
+		mocks.mealDataManager,
+		mocks.instrumentOwnershipManager,
+	)
+
+	return s, mocks
+}
+
+// setUpEvent prepares mocks for a meal plan event whose chosen meal is made of the provided recipes.
+func setUpEvent(mocks *schedulerMocks, householdID string, ownerships []*types.HouseholdInstrumentOwnership, recipes map[*types.Recipe]*simple.DirectedGraph, order ...*types.Recipe) *types.MealPlanEvent {
+	meal := fakes.BuildFakeMeal()
+	meal.Components = []*types.MealComponent{}
+	for _, recipe := range order {
+		meal.Components = append(meal.Components, &types.MealComponent{Recipe: *recipe, RecipeScale: 1})
+		mocks.recipeAnalyzer.On(
+			"MakeGraphForRecipe",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(r *types.Recipe) bool { return r.ID == recipe.ID }),
+		).Return(recipes[recipe], nil)
+	}
+
+	event := fakes.BuildFakeMealPlanEvent()
+	for _, option := range event.Options {
+		option.Chosen = false
+	}
+	event.Options[0].Chosen = true
+	event.Options[0].Meal = *meal
+
+	mocks.mealPlanEventDataManager.On("GetMealPlanEvent", testutils.ContextMatcher, event.BelongsToMealPlan, event.ID).Return(event, nil)
+	mocks.mealDataManager.On("GetMeal", testutils.ContextMatcher, meal.ID).Return(meal, nil)
+	mocks.instrumentOwnershipManager.On(
+		"GetHouseholdInstrumentOwnerships",
+		testutils.ContextMatcher,
+		householdID,
+		mock.AnythingOfType("*types.QueryFilter"),
+	).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{Data: ownerships}, nil)
+
+	return event
+}
+
+func TestTimelineScheduler_ScheduleMealPlanEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("schedules dependent steps back to back", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+		householdID := fakes.BuildFakeID()
+
+		chop := buildStep(0, 10*time.Minute)
+		simmer := buildStep(1, 20*time.Minute)
+		garnish := buildStep(2, 5*time.Minute)
+		recipe := buildRecipe(chop, simmer, garnish)
+
+		event := setUpEvent(mocks, householdID, nil, map[*types.Recipe]*simple.DirectedGraph{
+			recipe: buildGraph(recipe, [2]uint32{0, 1}, [2]uint32{1, 2}),
+		}, recipe)
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, householdID, event.BelongsToMealPlan, event.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, event.StartsAt, actual.FinishesAt)
+		assert.Equal(t, event.StartsAt.Add(-35*time.Minute), actual.StartsAt)
+		assert.Equal(t, event.Options[0].ID, actual.MealPlanOptionID)
+		require.Len(t, actual.Entries, 3)
+
+		assert.Equal(t, chop.ID, actual.Entries[0].RecipeStepID)
+		assert.Equal(t, event.StartsAt.Add(-25*time.Minute), actual.Entries[0].EndsAt)
+		assert.Equal(t, simmer.ID, actual.Entries[1].RecipeStepID)
+		assert.Equal(t, event.StartsAt.Add(-5*time.Minute), actual.Entries[1].EndsAt)
+		assert.Equal(t, garnish.ID, actual.Entries[2].RecipeStepID)
+		assert.Equal(t, event.StartsAt, actual.Entries[2].EndsAt)
+
+		for _, entry := range actual.Entries {
+			assert.True(t, entry.OnCriticalPath)
+		}
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("only marks the longest chain as critical", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+		householdID := fakes.BuildFakeID()
+
+		quick := buildStep(0, 10*time.Minute)
+		slow := buildStep(1, 30*time.Minute)
+		combine := buildStep(2, 5*time.Minute)
+		recipe := buildRecipe(quick, slow, combine)
+
+		event := setUpEvent(mocks, householdID, nil, map[*types.Recipe]*simple.DirectedGraph{
+			recipe: buildGraph(recipe, [2]uint32{0, 2}, [2]uint32{1, 2}),
+		}, recipe)
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, householdID, event.BelongsToMealPlan, event.ID)
+		require.NoError(t, err)
+
+		entries := entriesByStepID(actual)
+		assert.False(t, entries[quick.ID].OnCriticalPath)
+		assert.True(t, entries[slow.ID].OnCriticalPath)
+		assert.True(t, entries[combine.ID].OnCriticalPath)
+
+		// the quick step is left until as late as it can go.
+		assert.Equal(t, event.StartsAt.Add(-5*time.Minute), entries[quick.ID].EndsAt)
+		assert.Equal(t, event.StartsAt.Add(-35*time.Minute), actual.StartsAt)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("staggers recipes that share a single instrument", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+		householdID := fakes.BuildFakeID()
+
+		oven := fakes.BuildFakeValidInstrument()
+		roast := buildRecipe(buildStep(0, 40*time.Minute, oven))
+		bake := buildRecipe(buildStep(0, 30*time.Minute, oven))
+
+		ownership := fakes.BuildFakeHouseholdInstrumentOwnership()
+		ownership.Instrument = *oven
+		ownership.Quantity = 1
+
+		event := setUpEvent(mocks, householdID, []*types.HouseholdInstrumentOwnership{ownership}, map[*types.Recipe]*simple.DirectedGraph{
+			roast: buildGraph(roast),
+			bake:  buildGraph(bake),
+		}, roast, bake)
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, householdID, event.BelongsToMealPlan, event.ID)
+		require.NoError(t, err)
+
+		entries := entriesByStepID(actual)
+		roastEntry, bakeEntry := entries[roast.Steps[0].ID], entries[bake.Steps[0].ID]
+
+		assert.Equal(t, event.StartsAt, roastEntry.EndsAt)
+		assert.Equal(t, roastEntry.StartsAt, bakeEntry.EndsAt)
+		assert.Equal(t, event.StartsAt.Add(-70*time.Minute), actual.StartsAt)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("runs recipes in parallel when there's enough of an instrument", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+		householdID := fakes.BuildFakeID()
+
+		burner := fakes.BuildFakeValidInstrument()
+		soup := buildRecipe(buildStep(0, 40*time.Minute, burner))
+		sauce := buildRecipe(buildStep(0, 30*time.Minute, burner))
+
+		ownership := fakes.BuildFakeHouseholdInstrumentOwnership()
+		ownership.Instrument = *burner
+		ownership.Quantity = 2
+
+		event := setUpEvent(mocks, householdID, []*types.HouseholdInstrumentOwnership{ownership}, map[*types.Recipe]*simple.DirectedGraph{
+			soup:  buildGraph(soup),
+			sauce: buildGraph(sauce),
+		}, soup, sauce)
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, householdID, event.BelongsToMealPlan, event.ID)
+		require.NoError(t, err)
+
+		entries := entriesByStepID(actual)
+		assert.Equal(t, event.StartsAt, entries[soup.Steps[0].ID].EndsAt)
+		assert.Equal(t, event.StartsAt, entries[sauce.Steps[0].ID].EndsAt)
+		assert.Equal(t, event.StartsAt.Add(-40*time.Minute), actual.StartsAt)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("without chosen option", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+
+		event := fakes.BuildFakeMealPlanEvent()
+		for _, option := range event.Options {
+			option.Chosen = false
+		}
+		mocks.mealPlanEventDataManager.On("GetMealPlanEvent", testutils.ContextMatcher, event.BelongsToMealPlan, event.ID).Return(event, nil)
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, fakes.BuildFakeID(), event.BelongsToMealPlan, event.ID)
+		assert.ErrorIs(t, err, ErrNoChosenOption)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching meal plan event", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+		mealPlanID, mealPlanEventID := fakes.BuildFakeID(), fakes.BuildFakeID()
+
+		mocks.mealPlanEventDataManager.On("GetMealPlanEvent", testutils.ContextMatcher, mealPlanID, mealPlanEventID).Return((*types.MealPlanEvent)(nil), errors.New("blah"))
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, fakes.BuildFakeID(), mealPlanID, mealPlanEventID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching meal", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		s, mocks := buildTestScheduler()
+
+		event := fakes.BuildFakeMealPlanEvent()
+		event.Options = event.Options[:1]
+		mocks.mealPlanEventDataManager.On("GetMealPlanEvent", testutils.ContextMatcher, event.BelongsToMealPlan, event.ID).Return(event, nil)
+		mocks.mealDataManager.On("GetMeal", testutils.ContextMatcher, event.Options[0].Meal.ID).Return((*types.Meal)(nil), errors.New("blah"))
+
+		actual, err := s.ScheduleMealPlanEvent(ctx, fakes.BuildFakeID(), event.BelongsToMealPlan, event.ID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+}
+
+func TestResourcePool_available(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		pool := &resourcePool{capacity: 2}
+		pool.reserve(0, 10*time.Minute, 1)
+		pool.reserve(5*time.Minute, 15*time.Minute, 1)
+
+		assert.True(t, pool.available(0, 5*time.Minute, 1))
+		assert.False(t, pool.available(0, 10*time.Minute, 1))
+		assert.True(t, pool.available(10*time.Minute, 20*time.Minute, 1))
+		assert.False(t, pool.available(10*time.Minute, 20*time.Minute, 2))
+		assert.True(t, pool.available(15*time.Minute, 20*time.Minute, 2))
+	})
+}
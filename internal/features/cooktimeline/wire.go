@@ -0,0 +1,10 @@
+package cooktimeline
+
+import (
+	"github.com/google/wire"
+)
+
+// Providers are our collection of what we provide to other services.
+var Providers = wire.NewSet(
+	NewTimelineScheduler,
+)
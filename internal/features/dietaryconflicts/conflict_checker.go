@@ -0,0 +1,239 @@
+package dietaryconflicts
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	// StrongDislikeThreshold is the rating at or below which a user's ingredient preference counts as a conflict.
+	StrongDislikeThreshold int8 = -5
+)
+
+// ConflictChecker finds the ingredients in a meal that conflict with a household's allergies and strong dislikes.
+type ConflictChecker interface {
+	CheckMealForHousehold(ctx context.Context, householdID, mealID string) ([]*types.DietaryConflict, error)
+}
+
+var _ ConflictChecker = (*conflictChecker)(nil)
+
+type conflictChecker struct {
+	logger                       logging.Logger
+	tracer                       tracing.Tracer
+	householdDataManager         types.HouseholdDataManager
+	mealDataManager              types.MealDataManager
+	ingredientPreferencesManager types.UserIngredientPreferenceDataManager
+}
+
+// NewConflictChecker creates a ConflictChecker.
+func NewConflictChecker(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	householdDataManager types.HouseholdDataManager,
+	mealDataManager types.MealDataManager,
+	ingredientPreferencesManager types.UserIngredientPreferenceDataManager,
+) ConflictChecker {
+	return &conflictChecker{
+		logger:                       logging.EnsureLogger(logger).WithName("dietary_conflict_checker"),
+		tracer:                       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("dietary_conflict_checker")),
+		householdDataManager:         householdDataManager,
+		mealDataManager:              mealDataManager,
+		ingredientPreferencesManager: ingredientPreferencesManager,
+	}
+}
+
+// CheckMealForHousehold checks a meal against the ingredient preferences of every member of a household.
+func (c *conflictChecker) CheckMealForHousehold(ctx context.Context, householdID, mealID string) ([]*types.DietaryConflict, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.WithValue(keys.HouseholdIDKey, householdID).WithValue(keys.MealIDKey, mealID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.MealIDKey, mealID)
+
+	meal, err := c.mealDataManager.GetMeal(ctx, mealID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal")
+	}
+
+	household, err := c.householdDataManager.GetHousehold(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching household")
+	}
+
+	preferences := []*types.UserIngredientPreference{}
+	for _, member := range household.Members {
+		if member.BelongsToUser == nil {
+			continue
+		}
+
+		var memberPreferences []*types.UserIngredientPreference
+		memberPreferences, err = c.getAllPreferencesForUser(ctx, member.BelongsToUser.ID)
+		if err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "fetching ingredient preferences for household member")
+		}
+
+		preferences = append(preferences, memberPreferences...)
+	}
+
+	return FindConflicts(meal, preferences), nil
+}
+
+func (c *conflictChecker) getAllPreferencesForUser(ctx context.Context, userID string) ([]*types.UserIngredientPreference, error) {
+	filter := types.DefaultQueryFilter()
+	filter.Page = pointer.To(uint16(1))
+	filter.Limit = pointer.To(uint8(types.MaxLimit))
+
+	preferences := []*types.UserIngredientPreference{}
+	for {
+		results, err := c.ingredientPreferencesManager.GetUserIngredientPreferences(ctx, userID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		preferences = append(preferences, results.Data...)
+
+		if len(results.Data) < int(*filter.Limit) {
+			return preferences, nil
+		}
+		*filter.Page++
+	}
+}
+
+// FindConflicts walks every step ingredient of a meal's recipes, including their supporting recipes,
+// and reports each one that a user is allergic to, that shares an allergen with an ingredient a user
+// is allergic to, or that a user has rated at or below StrongDislikeThreshold.
+func FindConflicts(meal *types.Meal, preferences []*types.UserIngredientPreference) []*types.DietaryConflict {
+	conflicts := []*types.DietaryConflict{}
+	if meal == nil || len(preferences) == 0 {
+		return conflicts
+	}
+
+	visited := map[string]bool{}
+	for _, component := range meal.Components {
+		conflicts = append(conflicts, findConflictsInRecipe(&component.Recipe, preferences, visited)...)
+	}
+
+	return conflicts
+}
+
+// FindRecipeConflicts is FindConflicts for a single recipe and its supporting recipes.
+func FindRecipeConflicts(recipe *types.Recipe, preferences []*types.UserIngredientPreference) []*types.DietaryConflict {
+	if len(preferences) == 0 {
+		return []*types.DietaryConflict{}
+	}
+
+	return findConflictsInRecipe(recipe, preferences, map[string]bool{})
+}
+
+func findConflictsInRecipe(recipe *types.Recipe, preferences []*types.UserIngredientPreference, visited map[string]bool) []*types.DietaryConflict {
+	conflicts := []*types.DietaryConflict{}
+	if recipe == nil || visited[recipe.ID] {
+		return conflicts
+	}
+	visited[recipe.ID] = true
+
+	for _, step := range recipe.Steps {
+		for _, stepIngredient := range step.Ingredients {
+			// ingredients that are products of other steps have already been checked where they were introduced.
+			if stepIngredient.Ingredient == nil {
+				continue
+			}
+
+			for _, preference := range preferences {
+				conflict := conflictFor(stepIngredient.Ingredient, preference)
+				if conflict == nil {
+					continue
+				}
+
+				conflict.RecipeID = recipe.ID
+				conflict.RecipeName = recipe.Name
+				conflict.RecipeStepID = step.ID
+				conflict.Optional = stepIngredient.Optional
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+
+	for _, supportingRecipe := range recipe.SupportingRecipes {
+		conflicts = append(conflicts, findConflictsInRecipe(supportingRecipe, preferences, visited)...)
+	}
+
+	return conflicts
+}
+
+// conflictFor determines whether an ingredient conflicts with a single preference, returning nil if it doesn't.
+func conflictFor(ingredient *types.ValidIngredient, preference *types.UserIngredientPreference) *types.DietaryConflict {
+	conflict := &types.DietaryConflict{
+		UserID:                     preference.BelongsToUser,
+		UserIngredientPreferenceID: preference.ID,
+		IngredientID:               ingredient.ID,
+		IngredientName:             ingredient.Name,
+		Rating:                     preference.Rating,
+	}
+
+	switch {
+	case preference.Allergy && ingredient.ID == preference.Ingredient.ID:
+		conflict.ConflictType = types.DietaryConflictTypeAllergy
+	case preference.Allergy:
+		allergen := sharedAllergen(ingredient, &preference.Ingredient)
+		if allergen == "" {
+			return nil
+		}
+		conflict.ConflictType = types.DietaryConflictTypeAllergen
+		conflict.Allergen = allergen
+	case ingredient.ID == preference.Ingredient.ID && preference.Rating <= StrongDislikeThreshold:
+		conflict.ConflictType = types.DietaryConflictTypeDislike
+	default:
+		return nil
+	}
+
+	return conflict
+}
+
+// sharedAllergen returns the first allergen both ingredients contain, or an empty string if they share none.
+func sharedAllergen(a, b *types.ValidIngredient) string {
+	for _, allergen := range allergensFor(a) {
+		for _, other := range allergensFor(b) {
+			if allergen == other {
+				return allergen
+			}
+		}
+	}
+
+	return ""
+}
+
+// allergensFor lists the allergens an ingredient is flagged as containing.
+func allergensFor(ingredient *types.ValidIngredient) []string {
+	flags := []struct {
+		name     string
+		contains bool
+	}{
+		{name: "peanut", contains: ingredient.ContainsPeanut},
+		{name: "tree_nut", contains: ingredient.ContainsTreeNut},
+		{name: "shellfish", contains: ingredient.ContainsShellfish},
+		{name: "fish", contains: ingredient.ContainsFish},
+		{name: "egg", contains: ingredient.ContainsEgg},
+		{name: "dairy", contains: ingredient.ContainsDairy},
+		{name: "wheat", contains: ingredient.ContainsWheat},
+		{name: "gluten", contains: ingredient.ContainsGluten},
+		{name: "soy", contains: ingredient.ContainsSoy},
+		{name: "sesame", contains: ingredient.ContainsSesame},
+	}
+
+	allergens := []string{}
+	for _, flag := range flags {
+		if flag.contains {
+			allergens = append(allergens, flag.name)
+		}
+	}
+
+	return allergens
+}
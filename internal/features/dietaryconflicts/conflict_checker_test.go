@@ -0,0 +1,410 @@
+package dietaryconflicts
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildIngredient(name string) *types.ValidIngredient {
+	ingredient := fakes.BuildFakeValidIngredient()
+	ingredient.Name = name
+	ingredient.ContainsPeanut = false
+	ingredient.ContainsTreeNut = false
+	ingredient.ContainsShellfish = false
+	ingredient.ContainsFish = false
+	ingredient.ContainsEgg = false
+	ingredient.ContainsDairy = false
+	ingredient.ContainsWheat = false
+	ingredient.ContainsGluten = false
+	ingredient.ContainsSoy = false
+	ingredient.ContainsSesame = false
+
+	return ingredient
+}
+
+func buildRecipe(ingredients ...*types.ValidIngredient) *types.Recipe {
+	step := fakes.BuildFakeRecipeStep()
+	step.Ingredients = []*types.RecipeStepIngredient{}
+	for _, ingredient := range ingredients {
+		stepIngredient := fakes.BuildFakeRecipeStepIngredient()
+		stepIngredient.Ingredient = ingredient
+		stepIngredient.Optional = false
+		step.Ingredients = append(step.Ingredients, stepIngredient)
+	}
+
+	recipe := fakes.BuildFakeRecipe()
+	recipe.Steps = []*types.RecipeStep{step}
+	recipe.SupportingRecipes = nil
+
+	return recipe
+}
+
+func buildMeal(recipes ...*types.Recipe) *types.Meal {
+	meal := fakes.BuildFakeMeal()
+	meal.Components = []*types.MealComponent{}
+	for _, recipe := range recipes {
+		meal.Components = append(meal.Components, &types.MealComponent{Recipe: *recipe, RecipeScale: 1})
+	}
+
+	return meal
+}
+
+func buildPreference(userID string, ingredient *types.ValidIngredient, rating int8, allergy bool) *types.UserIngredientPreference {
+	preference := fakes.BuildFakeUserIngredientPreference()
+	preference.BelongsToUser = userID
+	preference.Ingredient = *ingredient
+	preference.Rating = rating
+	preference.Allergy = allergy
+
+	return preference
+}
+
+func TestFindConflicts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with direct allergy", func(t *testing.T) {
+		t.Parallel()
+
+		shrimp := buildIngredient("shrimp")
+		recipe := buildRecipe(shrimp, buildIngredient("rice"))
+		preference := buildPreference("user", shrimp, 0, true)
+
+		actual := FindConflicts(buildMeal(recipe), []*types.UserIngredientPreference{preference})
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, types.DietaryConflictTypeAllergy, actual[0].ConflictType)
+		assert.Equal(t, "user", actual[0].UserID)
+		assert.Equal(t, preference.ID, actual[0].UserIngredientPreferenceID)
+		assert.Equal(t, shrimp.ID, actual[0].IngredientID)
+		assert.Equal(t, recipe.ID, actual[0].RecipeID)
+		assert.Equal(t, recipe.Steps[0].ID, actual[0].RecipeStepID)
+	})
+
+	T.Run("with shared allergen", func(t *testing.T) {
+		t.Parallel()
+
+		peanutButter := buildIngredient("peanut butter")
+		peanutButter.ContainsPeanut = true
+		peanuts := buildIngredient("peanuts")
+		peanuts.ContainsPeanut = true
+
+		actual := FindConflicts(
+			buildMeal(buildRecipe(peanuts)),
+			[]*types.UserIngredientPreference{buildPreference("user", peanutButter, 0, true)},
+		)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, types.DietaryConflictTypeAllergen, actual[0].ConflictType)
+		assert.Equal(t, "peanut", actual[0].Allergen)
+		assert.Equal(t, peanuts.ID, actual[0].IngredientID)
+	})
+
+	T.Run("with strong dislike", func(t *testing.T) {
+		t.Parallel()
+
+		cilantro := buildIngredient("cilantro")
+
+		actual := FindConflicts(
+			buildMeal(buildRecipe(cilantro)),
+			[]*types.UserIngredientPreference{buildPreference("user", cilantro, StrongDislikeThreshold, false)},
+		)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, types.DietaryConflictTypeDislike, actual[0].ConflictType)
+		assert.Equal(t, StrongDislikeThreshold, actual[0].Rating)
+	})
+
+	T.Run("ignores mild dislikes and unrelated allergens", func(t *testing.T) {
+		t.Parallel()
+
+		cilantro := buildIngredient("cilantro")
+		shrimp := buildIngredient("shrimp")
+		shrimp.ContainsShellfish = true
+		milk := buildIngredient("milk")
+		milk.ContainsDairy = true
+
+		actual := FindConflicts(
+			buildMeal(buildRecipe(cilantro, milk)),
+			[]*types.UserIngredientPreference{
+				buildPreference("user", cilantro, StrongDislikeThreshold+1, false),
+				buildPreference("user", shrimp, 0, true),
+			},
+		)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("checks supporting recipes", func(t *testing.T) {
+		t.Parallel()
+
+		sesame := buildIngredient("sesame seeds")
+		sesame.ContainsSesame = true
+
+		supportingRecipe := buildRecipe(sesame)
+		recipe := buildRecipe(buildIngredient("noodles"))
+		recipe.SupportingRecipes = []*types.Recipe{supportingRecipe}
+
+		actual := FindConflicts(
+			buildMeal(recipe),
+			[]*types.UserIngredientPreference{buildPreference("user", sesame, 0, true)},
+		)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, supportingRecipe.ID, actual[0].RecipeID)
+	})
+
+	T.Run("checks a recipe shared by components only once", func(t *testing.T) {
+		t.Parallel()
+
+		egg := buildIngredient("egg")
+		recipe := buildRecipe(egg)
+
+		actual := FindConflicts(
+			buildMeal(recipe, recipe),
+			[]*types.UserIngredientPreference{buildPreference("user", egg, 0, true)},
+		)
+		assert.Len(t, actual, 1)
+	})
+
+	T.Run("reports each affected user", func(t *testing.T) {
+		t.Parallel()
+
+		egg := buildIngredient("egg")
+		egg.ContainsEgg = true
+		optionalStep := buildRecipe(egg)
+		optionalStep.Steps[0].Ingredients[0].Optional = true
+
+		actual := FindConflicts(
+			buildMeal(optionalStep),
+			[]*types.UserIngredientPreference{
+				buildPreference("first", egg, 0, true),
+				buildPreference("second", egg, -10, false),
+			},
+		)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, "first", actual[0].UserID)
+		assert.Equal(t, "second", actual[1].UserID)
+		assert.True(t, actual[0].Optional)
+	})
+
+	T.Run("skips ingredients that are products of other steps", func(t *testing.T) {
+		t.Parallel()
+
+		egg := buildIngredient("egg")
+		recipe := buildRecipe(egg)
+		recipe.Steps[0].Ingredients[0].Ingredient = nil
+
+		actual := FindConflicts(
+			buildMeal(recipe),
+			[]*types.UserIngredientPreference{buildPreference("user", egg, 0, true)},
+		)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("with nil meal", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, FindConflicts(nil, []*types.UserIngredientPreference{}))
+	})
+}
+
+func TestFindRecipeConflicts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		sesame := buildIngredient("sesame seeds")
+		sesame.ContainsSesame = true
+
+		supportingRecipe := buildRecipe(sesame)
+		recipe := buildRecipe(buildIngredient("noodles"))
+		recipe.SupportingRecipes = []*types.Recipe{supportingRecipe}
+
+		actual := FindRecipeConflicts(recipe, []*types.UserIngredientPreference{buildPreference("user", sesame, 0, true)})
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, types.DietaryConflictTypeAllergy, actual[0].ConflictType)
+		assert.Equal(t, supportingRecipe.ID, actual[0].RecipeID)
+	})
+
+	T.Run("without preferences", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, FindRecipeConflicts(buildRecipe(buildIngredient("egg")), nil))
+	})
+}
+
+func TestConflictChecker_CheckMealForHousehold(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		shrimp := buildIngredient("shrimp")
+		meal := buildMeal(buildRecipe(shrimp))
+
+		household := fakes.BuildFakeHousehold()
+		members := household.Members
+		require.NotEmpty(t, members)
+
+		mealDataManager := &mocktypes.MealDataManagerMock{}
+		mealDataManager.On("GetMeal", testutils.ContextMatcher, meal.ID).Return(meal, nil)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		for i, member := range members {
+			result := &types.QueryFilteredResult[types.UserIngredientPreference]{Data: []*types.UserIngredientPreference{}}
+			if i == 0 {
+				result.Data = append(result.Data, buildPreference(member.BelongsToUser.ID, shrimp, 0, true))
+			}
+
+			preferencesManager.On(
+				"GetUserIngredientPreferences",
+				testutils.ContextMatcher,
+				member.BelongsToUser.ID,
+				mock.AnythingOfType("*types.QueryFilter"),
+			).Return(result, nil)
+		}
+
+		c := NewConflictChecker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), householdDataManager, mealDataManager, preferencesManager)
+
+		actual, err := c.CheckMealForHousehold(ctx, household.ID, meal.ID)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, members[0].BelongsToUser.ID, actual[0].UserID)
+
+		mock.AssertExpectationsForObjects(t, mealDataManager, householdDataManager, preferencesManager)
+	})
+
+	T.Run("pages through every preference", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meal := buildMeal(buildRecipe(buildIngredient("rice")))
+
+		user := fakes.BuildFakeUser()
+		household := fakes.BuildFakeHousehold()
+		household.Members = []*types.HouseholdUserMembershipWithUser{{BelongsToUser: user}}
+
+		mealDataManager := &mocktypes.MealDataManagerMock{}
+		mealDataManager.On("GetMeal", testutils.ContextMatcher, meal.ID).Return(meal, nil)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+
+		fullPage := &types.QueryFilteredResult[types.UserIngredientPreference]{}
+		for i := 0; i < types.MaxLimit; i++ {
+			fullPage.Data = append(fullPage.Data, fakes.BuildFakeUserIngredientPreference())
+		}
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			user.ID,
+			mock.MatchedBy(func(filter *types.QueryFilter) bool { return *filter.Page == 1 }),
+		).Return(fullPage, nil).Once()
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			user.ID,
+			mock.MatchedBy(func(filter *types.QueryFilter) bool { return *filter.Page == 2 }),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{}, nil).Once()
+
+		c := NewConflictChecker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), householdDataManager, mealDataManager, preferencesManager)
+
+		_, err := c.CheckMealForHousehold(ctx, household.ID, meal.ID)
+		require.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, mealDataManager, householdDataManager, preferencesManager)
+	})
+
+	T.Run("with error fetching meal", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleMealID := fakes.BuildFakeID()
+
+		mealDataManager := &mocktypes.MealDataManagerMock{}
+		mealDataManager.On("GetMeal", testutils.ContextMatcher, exampleMealID).Return((*types.Meal)(nil), errors.New("blah"))
+
+		c := NewConflictChecker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &mocktypes.HouseholdDataManagerMock{}, mealDataManager, &mocktypes.UserIngredientPreferenceDataManagerMock{})
+
+		actual, err := c.CheckMealForHousehold(ctx, fakes.BuildFakeID(), exampleMealID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mealDataManager)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meal := buildMeal()
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		mealDataManager := &mocktypes.MealDataManagerMock{}
+		mealDataManager.On("GetMeal", testutils.ContextMatcher, meal.ID).Return(meal, nil)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, exampleHouseholdID).Return((*types.Household)(nil), errors.New("blah"))
+
+		c := NewConflictChecker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), householdDataManager, mealDataManager, &mocktypes.UserIngredientPreferenceDataManagerMock{})
+
+		actual, err := c.CheckMealForHousehold(ctx, exampleHouseholdID, meal.ID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mealDataManager, householdDataManager)
+	})
+
+	T.Run("with error fetching preferences", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		meal := buildMeal()
+
+		user := fakes.BuildFakeUser()
+		household := fakes.BuildFakeHousehold()
+		household.Members = []*types.HouseholdUserMembershipWithUser{{BelongsToUser: user}}
+
+		mealDataManager := &mocktypes.MealDataManagerMock{}
+		mealDataManager.On("GetMeal", testutils.ContextMatcher, meal.ID).Return(meal, nil)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			user.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return((*types.QueryFilteredResult[types.UserIngredientPreference])(nil), errors.New("blah"))
+
+		c := NewConflictChecker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), householdDataManager, mealDataManager, preferencesManager)
+
+		actual, err := c.CheckMealForHousehold(ctx, household.ID, meal.ID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mealDataManager, householdDataManager, preferencesManager)
+	})
+}
@@ -0,0 +1,23 @@
+package dietaryconflicts
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ ConflictChecker = (*MockConflictChecker)(nil)
+
+// MockConflictChecker is a mock ConflictChecker.
+type MockConflictChecker struct {
+	mock.Mock
+}
+
+// CheckMealForHousehold is a mock function.
+func (m *MockConflictChecker) CheckMealForHousehold(ctx context.Context, householdID, mealID string) ([]*types.DietaryConflict, error) {
+	returnValues := m.Called(ctx, householdID, mealID)
+
+	return returnValues.Get(0).([]*types.DietaryConflict), returnValues.Error(1)
+}
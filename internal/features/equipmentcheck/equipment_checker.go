@@ -0,0 +1,394 @@
+package equipmentcheck
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// EquipmentChecker determines whether a household owns the instruments and vessels a recipe calls for.
+type EquipmentChecker interface {
+	CheckRecipeForHousehold(ctx context.Context, householdID, recipeID string) (*types.RecipeEquipmentReport, error)
+	FilterCookableRecipes(ctx context.Context, householdID string, recipes []*types.Recipe) ([]*types.Recipe, error)
+}
+
+var _ EquipmentChecker = (*equipmentChecker)(nil)
+
+type equipmentChecker struct {
+	logger                       logging.Logger
+	tracer                       tracing.Tracer
+	recipeDataManager            types.RecipeDataManager
+	instrumentOwnershipManager   types.HouseholdInstrumentOwnershipDataManager
+	preparationInstrumentManager types.ValidPreparationInstrumentDataManager
+}
+
+// NewEquipmentChecker creates an EquipmentChecker.
+func NewEquipmentChecker(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	recipeDataManager types.RecipeDataManager,
+	instrumentOwnershipManager types.HouseholdInstrumentOwnershipDataManager,
+	preparationInstrumentManager types.ValidPreparationInstrumentDataManager,
+) EquipmentChecker {
+	return &equipmentChecker{
+		logger:                       logging.EnsureLogger(logger).WithName("equipment_checker"),
+		tracer:                       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("equipment_checker")),
+		recipeDataManager:            recipeDataManager,
+		instrumentOwnershipManager:   instrumentOwnershipManager,
+		preparationInstrumentManager: preparationInstrumentManager,
+	}
+}
+
+// ownedEquipment indexes a household's instrument ownerships. Households don't record the vessels they own,
+// so there's nothing to check vessels against.
+type ownedEquipment struct {
+	byInstrumentID map[string]*types.HouseholdInstrumentOwnership
+}
+
+func (o *ownedEquipment) quantityOfInstrument(instrumentID string) uint32 {
+	if ownership, ok := o.byInstrumentID[instrumentID]; ok {
+		return uint32(ownership.Quantity)
+	}
+
+	return 0
+}
+
+// equipmentRequirement is a single instrument or vessel a recipe needs, aggregated across the steps that use it.
+type equipmentRequirement struct {
+	instrument     *types.ValidInstrument
+	vessel         *types.ValidVessel
+	stepIDs        []string
+	preparationIDs []string
+	quantity       uint32
+}
+
+// CheckRecipeForHousehold reports which of a recipe's instruments and vessels a household is missing.
+func (c *equipmentChecker) CheckRecipeForHousehold(ctx context.Context, householdID, recipeID string) (*types.RecipeEquipmentReport, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.WithValue(keys.HouseholdIDKey, householdID).WithValue(keys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	recipe, err := c.recipeDataManager.GetRecipe(ctx, recipeID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe")
+	}
+
+	owned, err := c.loadOwnedEquipment(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching household instrument ownerships")
+	}
+
+	report, err := c.buildReport(ctx, householdID, recipe, owned, map[string]map[string]*types.ValidInstrument{})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building equipment report")
+	}
+
+	return report, nil
+}
+
+// FilterCookableRecipes returns the subset of the provided recipes a household can cook, preserving their order.
+func (c *equipmentChecker) FilterCookableRecipes(ctx context.Context, householdID string, recipes []*types.Recipe) ([]*types.Recipe, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	cookable := []*types.Recipe{}
+	if len(recipes) == 0 {
+		return cookable, nil
+	}
+
+	// list and search results don't include steps, so fetch the full recipes.
+	ids := []string{}
+	for _, recipe := range recipes {
+		ids = append(ids, recipe.ID)
+	}
+
+	fullRecipes, err := c.recipeDataManager.GetRecipesWithIDs(ctx, ids)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipes")
+	}
+
+	owned, err := c.loadOwnedEquipment(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching household instrument ownerships")
+	}
+
+	preparationInstruments := map[string]map[string]*types.ValidInstrument{}
+	canCook := map[string]bool{}
+	for _, recipe := range fullRecipes {
+		report, reportErr := c.buildReport(ctx, householdID, recipe, owned, preparationInstruments)
+		if reportErr != nil {
+			return nil, observability.PrepareAndLogError(reportErr, logger, span, "building equipment report")
+		}
+
+		canCook[recipe.ID] = report.CanCook
+	}
+
+	for _, recipe := range recipes {
+		if canCook[recipe.ID] {
+			cookable = append(cookable, recipe)
+		}
+	}
+
+	return cookable, nil
+}
+
+func (c *equipmentChecker) loadOwnedEquipment(ctx context.Context, householdID string) (*ownedEquipment, error) {
+	filter := types.DefaultQueryFilter()
+	filter.Page = pointer.To(uint16(1))
+	filter.Limit = pointer.To(uint8(types.MaxLimit))
+
+	owned := &ownedEquipment{
+		byInstrumentID: map[string]*types.HouseholdInstrumentOwnership{},
+	}
+
+	for {
+		results, err := c.instrumentOwnershipManager.GetHouseholdInstrumentOwnerships(ctx, householdID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ownership := range results.Data {
+			owned.byInstrumentID[ownership.Instrument.ID] = ownership
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			return owned, nil
+		}
+		*filter.Page++
+	}
+}
+
+// buildReport compares a recipe's requirements to what a household owns. preparationInstruments caches
+// the instruments valid for each preparation so that checking many recipes doesn't refetch them.
+func (c *equipmentChecker) buildReport(
+	ctx context.Context,
+	householdID string,
+	recipe *types.Recipe,
+	owned *ownedEquipment,
+	preparationInstruments map[string]map[string]*types.ValidInstrument,
+) (*types.RecipeEquipmentReport, error) {
+	report := &types.RecipeEquipmentReport{
+		RecipeID:         recipe.ID,
+		HouseholdID:      householdID,
+		Missing:          []*types.MissingRecipeEquipment{},
+		UncheckedVessels: []*types.ValidVessel{},
+		CanCook:          true,
+	}
+
+	requirements := gatherRequirements(recipe)
+	for _, requirement := range requirements {
+		if requirement.vessel != nil {
+			report.UncheckedVessels = append(report.UncheckedVessels, requirement.vessel)
+		}
+	}
+
+	for _, missing := range findMissingInstruments(requirements, owned) {
+		substitutes, err := c.findSubstitutes(ctx, missing.requirement, owned, preparationInstruments)
+		if err != nil {
+			return nil, err
+		}
+		missing.report.Substitutes = substitutes
+
+		if len(missing.report.Substitutes) == 0 {
+			report.CanCook = false
+		}
+
+		report.Missing = append(report.Missing, missing.report)
+	}
+
+	return report, nil
+}
+
+// findSubstitutes lists the instruments a household owns that are valid for every preparation a missing instrument is used for.
+func (c *equipmentChecker) findSubstitutes(
+	ctx context.Context,
+	requirement *equipmentRequirement,
+	owned *ownedEquipment,
+	preparationInstruments map[string]map[string]*types.ValidInstrument,
+) ([]*types.ValidInstrument, error) {
+	var candidates map[string]*types.ValidInstrument
+	for _, preparationID := range requirement.preparationIDs {
+		valid, err := c.instrumentsForPreparation(ctx, preparationID, preparationInstruments)
+		if err != nil {
+			return nil, err
+		}
+
+		if candidates == nil {
+			candidates = map[string]*types.ValidInstrument{}
+			for id, instrument := range valid {
+				candidates[id] = instrument
+			}
+			continue
+		}
+
+		for id := range candidates {
+			if _, ok := valid[id]; !ok {
+				delete(candidates, id)
+			}
+		}
+	}
+
+	substitutes := []*types.ValidInstrument{}
+	for id, instrument := range candidates {
+		if id == requirement.instrument.ID || owned.quantityOfInstrument(id) < requirement.quantity {
+			continue
+		}
+		substitutes = append(substitutes, instrument)
+	}
+
+	sort.Slice(substitutes, func(i, j int) bool {
+		if substitutes[i].Name == substitutes[j].Name {
+			return substitutes[i].ID < substitutes[j].ID
+		}
+		return substitutes[i].Name < substitutes[j].Name
+	})
+
+	return substitutes, nil
+}
+
+func (c *equipmentChecker) instrumentsForPreparation(
+	ctx context.Context,
+	preparationID string,
+	preparationInstruments map[string]map[string]*types.ValidInstrument,
+) (map[string]*types.ValidInstrument, error) {
+	if valid, ok := preparationInstruments[preparationID]; ok {
+		return valid, nil
+	}
+
+	filter := types.DefaultQueryFilter()
+	filter.Page = pointer.To(uint16(1))
+	filter.Limit = pointer.To(uint8(types.MaxLimit))
+
+	valid := map[string]*types.ValidInstrument{}
+	for {
+		results, err := c.preparationInstrumentManager.GetValidPreparationInstrumentsForPreparation(ctx, preparationID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range results.Data {
+			instrument := result.Instrument
+			valid[instrument.ID] = &instrument
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			break
+		}
+		*filter.Page++
+	}
+
+	preparationInstruments[preparationID] = valid
+
+	return valid, nil
+}
+
+type missingEquipment struct {
+	requirement *equipmentRequirement
+	report      *types.MissingRecipeEquipment
+}
+
+// findMissingInstruments lists each instrument requirement the household doesn't own enough of, in the order they're first used.
+func findMissingInstruments(requirements []*equipmentRequirement, owned *ownedEquipment) []*missingEquipment {
+	missing := []*missingEquipment{}
+	for _, requirement := range requirements {
+		if requirement.instrument == nil {
+			continue
+		}
+
+		ownedQuantity := owned.quantityOfInstrument(requirement.instrument.ID)
+		if ownedQuantity >= requirement.quantity {
+			continue
+		}
+
+		missing = append(missing, &missingEquipment{
+			requirement: requirement,
+			report: &types.MissingRecipeEquipment{
+				EquipmentType:    types.MissingEquipmentTypeInstrument,
+				Instrument:       requirement.instrument,
+				RecipeStepIDs:    requirement.stepIDs,
+				Substitutes:      []*types.ValidInstrument{},
+				RequiredQuantity: requirement.quantity,
+				OwnedQuantity:    ownedQuantity,
+			},
+		})
+	}
+
+	return missing
+}
+
+// gatherRequirements collects the instruments and vessels used by the required steps of a recipe and its supporting recipes.
+// Steps run one after another, so a piece of equipment used by several steps needs only its largest minimum quantity.
+func gatherRequirements(recipe *types.Recipe) []*equipmentRequirement {
+	requirements := []*equipmentRequirement{}
+	byKey := map[string]*equipmentRequirement{}
+
+	add := func(key string, quantity uint32, step *types.RecipeStep, build func() *equipmentRequirement) {
+		requirement, ok := byKey[key]
+		if !ok {
+			requirement = build()
+			byKey[key] = requirement
+			requirements = append(requirements, requirement)
+		}
+
+		requirement.quantity = max(requirement.quantity, quantity, 1)
+		requirement.stepIDs = append(requirement.stepIDs, step.ID)
+		if step.Preparation.ID != "" {
+			requirement.preparationIDs = append(requirement.preparationIDs, step.Preparation.ID)
+		}
+	}
+
+	visited := map[string]bool{}
+	var walk func(*types.Recipe)
+	walk = func(r *types.Recipe) {
+		if r == nil || visited[r.ID] {
+			return
+		}
+		visited[r.ID] = true
+
+		for _, step := range r.Steps {
+			if step.Optional {
+				continue
+			}
+
+			for _, instrument := range step.Instruments {
+				// instruments that are products of other steps aren't something a household owns.
+				if instrument.Optional || instrument.Instrument == nil {
+					continue
+				}
+
+				add(types.MissingEquipmentTypeInstrument+instrument.Instrument.ID, instrument.MinimumQuantity, step, func() *equipmentRequirement {
+					return &equipmentRequirement{instrument: instrument.Instrument}
+				})
+			}
+
+			for _, vessel := range step.Vessels {
+				if vessel.Vessel == nil {
+					continue
+				}
+
+				add("vessel"+vessel.Vessel.ID, vessel.MinimumQuantity, step, func() *equipmentRequirement {
+					return &equipmentRequirement{vessel: vessel.Vessel}
+				})
+			}
+		}
+
+		for _, supportingRecipe := range r.SupportingRecipes {
+			walk(supportingRecipe)
+		}
+	}
+	walk(recipe)
+
+	return requirements
+}
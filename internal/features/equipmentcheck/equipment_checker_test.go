@@ -0,0 +1,400 @@
+package equipmentcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type checkerMocks struct {
+	recipeDataManager            *mocktypes.RecipeDataManagerMock
+	instrumentOwnershipManager   *mocktypes.HouseholdInstrumentOwnershipDataManagerMock
+	preparationInstrumentManager *mocktypes.ValidPreparationInstrumentDataManagerMock
+}
+
+func (m *checkerMocks) assertExpectations(t *testing.T) {
+	t.Helper()
+
+	mock.AssertExpectationsForObjects(t, m.recipeDataManager, m.instrumentOwnershipManager, m.preparationInstrumentManager)
+}
+
+func buildTestChecker() (*equipmentChecker, *checkerMocks) {
+	mocks := &checkerMocks{
+		recipeDataManager:            &mocktypes.RecipeDataManagerMock{},
+		instrumentOwnershipManager:   &mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
+		preparationInstrumentManager: &mocktypes.ValidPreparationInstrumentDataManagerMock{},
+	}
+
+	c := NewEquipmentChecker(
+		logging.NewNoopLogger(),
+		tracing.NewNoopTracerProvider(),
+		mocks.recipeDataManager,
+		mocks.instrumentOwnershipManager,
+		mocks.preparationInstrumentManager,
+	).(*equipmentChecker)
+
+	return c, mocks
+}
+
+func buildStep(preparation *types.ValidPreparation, instruments []*types.ValidInstrument, vessels []*types.ValidVessel) *types.RecipeStep {
+	step := fakes.BuildFakeRecipeStep()
+	step.Optional = false
+	step.Preparation = *preparation
+	step.Instruments = []*types.RecipeStepInstrument{}
+	for _, instrument := range instruments {
+		stepInstrument := fakes.BuildFakeRecipeStepInstrument()
+		stepInstrument.Instrument = instrument
+		stepInstrument.Optional = false
+		stepInstrument.MinimumQuantity = 1
+		step.Instruments = append(step.Instruments, stepInstrument)
+	}
+
+	step.Vessels = []*types.RecipeStepVessel{}
+	for _, vessel := range vessels {
+		stepVessel := fakes.BuildFakeRecipeStepVessel()
+		stepVessel.Vessel = vessel
+		stepVessel.MinimumQuantity = 1
+		step.Vessels = append(step.Vessels, stepVessel)
+	}
+
+	return step
+}
+
+func buildRecipe(steps ...*types.RecipeStep) *types.Recipe {
+	recipe := fakes.BuildFakeRecipe()
+	recipe.Steps = steps
+	recipe.SupportingRecipes = nil
+
+	return recipe
+}
+
+func buildOwnership(instrument *types.ValidInstrument, quantity uint16) *types.HouseholdInstrumentOwnership {
+	ownership := fakes.BuildFakeHouseholdInstrumentOwnership()
+	ownership.Instrument = *instrument
+	ownership.Quantity = quantity
+
+	return ownership
+}
+
+func buildOwned(ownerships ...*types.HouseholdInstrumentOwnership) *ownedEquipment {
+	owned := &ownedEquipment{
+		byInstrumentID: map[string]*types.HouseholdInstrumentOwnership{},
+	}
+	for _, ownership := range ownerships {
+		owned.byInstrumentID[ownership.Instrument.ID] = ownership
+	}
+
+	return owned
+}
+
+func TestFindMissingInstruments(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with everything owned", func(t *testing.T) {
+		t.Parallel()
+
+		whisk := fakes.BuildFakeValidInstrument()
+		recipe := buildRecipe(buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{whisk}, nil))
+
+		assert.Empty(t, findMissingInstruments(gatherRequirements(recipe), buildOwned(buildOwnership(whisk, 1))))
+	})
+
+	T.Run("with unowned instrument", func(t *testing.T) {
+		t.Parallel()
+
+		whisk := fakes.BuildFakeValidInstrument()
+		step := buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{whisk}, nil)
+		recipe := buildRecipe(step)
+
+		actual := findMissingInstruments(gatherRequirements(recipe), buildOwned())
+		require.Len(t, actual, 1)
+		assert.Equal(t, types.MissingEquipmentTypeInstrument, actual[0].report.EquipmentType)
+		assert.Equal(t, whisk, actual[0].report.Instrument)
+		assert.Equal(t, []string{step.ID}, actual[0].report.RecipeStepIDs)
+		assert.Equal(t, uint32(1), actual[0].report.RequiredQuantity)
+		assert.Equal(t, uint32(0), actual[0].report.OwnedQuantity)
+	})
+
+	T.Run("with too few of an instrument", func(t *testing.T) {
+		t.Parallel()
+
+		bowl := fakes.BuildFakeValidInstrument()
+		firstStep := buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{bowl}, nil)
+		secondStep := buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{bowl}, nil)
+		secondStep.Instruments[0].MinimumQuantity = 3
+		recipe := buildRecipe(firstStep, secondStep)
+
+		actual := findMissingInstruments(gatherRequirements(recipe), buildOwned(buildOwnership(bowl, 2)))
+		require.Len(t, actual, 1)
+		assert.Equal(t, []string{firstStep.ID, secondStep.ID}, actual[0].report.RecipeStepIDs)
+		assert.Equal(t, uint32(3), actual[0].report.RequiredQuantity)
+		assert.Equal(t, uint32(2), actual[0].report.OwnedQuantity)
+	})
+
+	T.Run("ignores optional steps and instruments", func(t *testing.T) {
+		t.Parallel()
+
+		optionalStep := buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{fakes.BuildFakeValidInstrument()}, nil)
+		optionalStep.Optional = true
+		stepWithOptionalInstrument := buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{fakes.BuildFakeValidInstrument()}, nil)
+		stepWithOptionalInstrument.Instruments[0].Optional = true
+		productStep := buildStep(fakes.BuildFakeValidPreparation(), nil, nil)
+		productStep.Instruments = []*types.RecipeStepInstrument{{Name: t.Name()}}
+		recipe := buildRecipe(optionalStep, stepWithOptionalInstrument, productStep)
+
+		assert.Empty(t, findMissingInstruments(gatherRequirements(recipe), buildOwned()))
+	})
+
+	T.Run("ignores vessels", func(t *testing.T) {
+		t.Parallel()
+
+		pot := fakes.BuildFakeValidVessel()
+		potInstrument := fakes.BuildFakeValidInstrument()
+		potInstrument.Slug = pot.Slug
+		recipe := buildRecipe(buildStep(fakes.BuildFakeValidPreparation(), nil, []*types.ValidVessel{pot}))
+
+		assert.Empty(t, findMissingInstruments(gatherRequirements(recipe), buildOwned()))
+	})
+
+	T.Run("with supporting recipes", func(t *testing.T) {
+		t.Parallel()
+
+		whisk := fakes.BuildFakeValidInstrument()
+		supporting := buildRecipe(buildStep(fakes.BuildFakeValidPreparation(), []*types.ValidInstrument{whisk}, nil))
+		recipe := buildRecipe()
+		recipe.SupportingRecipes = []*types.Recipe{supporting, supporting}
+
+		actual := findMissingInstruments(gatherRequirements(recipe), buildOwned())
+		require.Len(t, actual, 1)
+		assert.Equal(t, whisk, actual[0].report.Instrument)
+	})
+}
+
+func TestEquipmentChecker_CheckRecipeForHousehold(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+		householdID := fakes.BuildFakeID()
+
+		whisk := fakes.BuildFakeValidInstrument()
+		whisk.Name = "whisk"
+		fork := fakes.BuildFakeValidInstrument()
+		fork.Name = "fork"
+		mixer := fakes.BuildFakeValidInstrument()
+		mixer.Name = "mixer"
+		pan := fakes.BuildFakeValidVessel()
+
+		whip := fakes.BuildFakeValidPreparation()
+		recipe := buildRecipe(buildStep(whip, []*types.ValidInstrument{whisk}, []*types.ValidVessel{pan}))
+
+		mocks.recipeDataManager.On("GetRecipe", testutils.ContextMatcher, recipe.ID).Return(recipe, nil)
+		mocks.instrumentOwnershipManager.On(
+			"GetHouseholdInstrumentOwnerships",
+			testutils.ContextMatcher,
+			householdID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{
+			Data: []*types.HouseholdInstrumentOwnership{buildOwnership(fork, 1), buildOwnership(mixer, 0)},
+		}, nil)
+		mocks.preparationInstrumentManager.On(
+			"GetValidPreparationInstrumentsForPreparation",
+			testutils.ContextMatcher,
+			whip.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.ValidPreparationInstrument]{
+			Data: []*types.ValidPreparationInstrument{
+				{Instrument: *whisk, Preparation: *whip},
+				{Instrument: *fork, Preparation: *whip},
+				{Instrument: *mixer, Preparation: *whip},
+			},
+		}, nil)
+
+		actual, err := c.CheckRecipeForHousehold(ctx, householdID, recipe.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, recipe.ID, actual.RecipeID)
+		assert.Equal(t, householdID, actual.HouseholdID)
+		assert.True(t, actual.CanCook, "the fork stands in for the whisk, and the pan isn't checked")
+		require.Len(t, actual.Missing, 1)
+
+		assert.Equal(t, whisk, actual.Missing[0].Instrument)
+		assert.Equal(t, []*types.ValidInstrument{fork}, actual.Missing[0].Substitutes)
+
+		assert.Equal(t, []*types.ValidVessel{pan}, actual.UncheckedVessels)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with substitute for every missing instrument", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+		householdID := fakes.BuildFakeID()
+
+		whisk := fakes.BuildFakeValidInstrument()
+		fork := fakes.BuildFakeValidInstrument()
+		whip := fakes.BuildFakeValidPreparation()
+		recipe := buildRecipe(buildStep(whip, []*types.ValidInstrument{whisk}, nil))
+
+		mocks.recipeDataManager.On("GetRecipe", testutils.ContextMatcher, recipe.ID).Return(recipe, nil)
+		mocks.instrumentOwnershipManager.On(
+			"GetHouseholdInstrumentOwnerships",
+			testutils.ContextMatcher,
+			householdID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{
+			Data: []*types.HouseholdInstrumentOwnership{buildOwnership(fork, 1)},
+		}, nil)
+		mocks.preparationInstrumentManager.On(
+			"GetValidPreparationInstrumentsForPreparation",
+			testutils.ContextMatcher,
+			whip.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.ValidPreparationInstrument]{
+			Data: []*types.ValidPreparationInstrument{{Instrument: *fork, Preparation: *whip}},
+		}, nil)
+
+		actual, err := c.CheckRecipeForHousehold(ctx, householdID, recipe.ID)
+		require.NoError(t, err)
+
+		assert.True(t, actual.CanCook)
+		require.Len(t, actual.Missing, 1)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching recipe", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+		recipeID := fakes.BuildFakeID()
+
+		mocks.recipeDataManager.On("GetRecipe", testutils.ContextMatcher, recipeID).Return((*types.Recipe)(nil), errors.New("blah"))
+
+		actual, err := c.CheckRecipeForHousehold(ctx, fakes.BuildFakeID(), recipeID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching ownerships", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+		householdID := fakes.BuildFakeID()
+		recipe := buildRecipe()
+
+		mocks.recipeDataManager.On("GetRecipe", testutils.ContextMatcher, recipe.ID).Return(recipe, nil)
+		mocks.instrumentOwnershipManager.On(
+			"GetHouseholdInstrumentOwnerships",
+			testutils.ContextMatcher,
+			householdID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return((*types.QueryFilteredResult[types.HouseholdInstrumentOwnership])(nil), errors.New("blah"))
+
+		actual, err := c.CheckRecipeForHousehold(ctx, householdID, recipe.ID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+}
+
+func TestEquipmentChecker_FilterCookableRecipes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+		householdID := fakes.BuildFakeID()
+
+		whisk := fakes.BuildFakeValidInstrument()
+		knife := fakes.BuildFakeValidInstrument()
+		whip := fakes.BuildFakeValidPreparation()
+
+		cookable := buildRecipe(buildStep(whip, []*types.ValidInstrument{whisk}, nil))
+		uncookable := buildRecipe(buildStep(whip, []*types.ValidInstrument{knife}, nil))
+		listed := []*types.Recipe{{ID: uncookable.ID}, {ID: cookable.ID}}
+
+		mocks.recipeDataManager.On(
+			"GetRecipesWithIDs",
+			testutils.ContextMatcher,
+			[]string{uncookable.ID, cookable.ID},
+		).Return([]*types.Recipe{uncookable, cookable}, nil)
+		mocks.instrumentOwnershipManager.On(
+			"GetHouseholdInstrumentOwnerships",
+			testutils.ContextMatcher,
+			householdID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{
+			Data: []*types.HouseholdInstrumentOwnership{buildOwnership(whisk, 1)},
+		}, nil)
+		mocks.preparationInstrumentManager.On(
+			"GetValidPreparationInstrumentsForPreparation",
+			testutils.ContextMatcher,
+			whip.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.ValidPreparationInstrument]{}, nil).Once()
+
+		actual, err := c.FilterCookableRecipes(ctx, householdID, listed)
+		require.NoError(t, err)
+
+		assert.Equal(t, []*types.Recipe{listed[1]}, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with no recipes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+
+		actual, err := c.FilterCookableRecipes(ctx, fakes.BuildFakeID(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching recipes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		c, mocks := buildTestChecker()
+		recipe := fakes.BuildFakeRecipe()
+
+		mocks.recipeDataManager.On(
+			"GetRecipesWithIDs",
+			testutils.ContextMatcher,
+			[]string{recipe.ID},
+		).Return([]*types.Recipe(nil), errors.New("blah"))
+
+		actual, err := c.FilterCookableRecipes(ctx, fakes.BuildFakeID(), []*types.Recipe{recipe})
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+}
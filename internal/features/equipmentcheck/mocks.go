@@ -0,0 +1,30 @@
+package equipmentcheck
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ EquipmentChecker = (*MockEquipmentChecker)(nil)
+
+// MockEquipmentChecker is a mock EquipmentChecker.
+type MockEquipmentChecker struct {
+	mock.Mock
+}
+
+// CheckRecipeForHousehold is a mock function.
+func (m *MockEquipmentChecker) CheckRecipeForHousehold(ctx context.Context, householdID, recipeID string) (*types.RecipeEquipmentReport, error) {
+	returnValues := m.Called(ctx, householdID, recipeID)
+
+	return returnValues.Get(0).(*types.RecipeEquipmentReport), returnValues.Error(1)
+}
+
+// FilterCookableRecipes is a mock function.
+func (m *MockEquipmentChecker) FilterCookableRecipes(ctx context.Context, householdID string, recipes []*types.Recipe) ([]*types.Recipe, error) {
+	returnValues := m.Called(ctx, householdID, recipes)
+
+	return returnValues.Get(0).([]*types.Recipe), returnValues.Error(1)
+}
@@ -4,7 +4,9 @@
 	"context"
 	"fmt"
 
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -19,71 +21,71 @@ type GroceryListCreator interface {
 }
 
 type groceryListCreator struct {
-	logger logging.Logger
-	tracer tracing.Tracer
+	logger       logging.Logger
+	tracer       tracing.Tracer
+	graphBuilder unitconversion.GraphBuilder
 }
 
-func NewGroceryListCreator(logger logging.Logger, tracerProvider tracing.TracerProvider) GroceryListCreator {
+func NewGroceryListCreator(logger logging.Logger, tracerProvider tracing.TracerProvider, graphBuilder unitconversion.GraphBuilder) GroceryListCreator {
 	return &groceryListCreator{
-		logger: logging.EnsureLogger(logger).WithName("grocery_list_creator"),
-		tracer: tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("grocery_list_creator")),
+		logger:       logging.EnsureLogger(logger).WithName("grocery_list_creator"),
+		tracer:       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("grocery_list_creator")),
+		graphBuilder: graphBuilder,
 	}
 }
 
+// ingredientQuantity is a scaled quantity of an ingredient in a given measurement unit.
+type ingredientQuantity struct {
+	maximum           *decimal.Decimal
+	measurementUnitID string
+	minimum           decimal.Decimal
+}
+
+// neededIngredient tracks every quantity of an ingredient a meal plan calls for, in the order they were found.
+type neededIngredient struct {
+	ingredientID string
+	quantities   []*ingredientQuantity
+}
+
 func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, mealPlan *types.MealPlan) ([]*types.MealPlanGroceryListItemDatabaseCreationInput, error) {
-	_, span := g.tracer.StartSpan(ctx)
+	ctx, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
-	inputs := map[string]*types.MealPlanGroceryListItemDatabaseCreationInput{}
 	logger := g.logger.Clone().WithValue(keys.MealPlanIDKey, mealPlan.ID)
 
+	needed := []*neededIngredient{}
+	neededByIngredientID := map[string]*neededIngredient{}
+
 	for _, event := range mealPlan.Events {
-		logger = logger.WithValue(keys.MealPlanEventIDKey, event.ID)
 		for _, option := range event.Options {
 			if option.Chosen {
 				mealScale := decimal.NewFromFloat32(option.MealScale)
-				logger = logger.WithValue(keys.MealPlanOptionIDKey, option.ID)
 				for _, component := range option.Meal.Components {
 					recipeScale := decimal.NewFromFloat32(component.RecipeScale).Mul(mealScale)
-					logger = logger.WithValue(keys.RecipeIDKey, component.Recipe.ID)
 					for _, step := range component.Recipe.Steps {
-						logger = logger.WithValue(keys.RecipeStepIDKey, step.ID)
 						for _, ingredient := range step.Ingredients {
-							if ingredient.Ingredient != nil {
-								logger = logger.WithValue(keys.RecipeStepIngredientIDKey, ingredient.ID)
-								if _, ok := inputs[ingredient.Ingredient.ID]; !ok {
-									minQty := float32(recipeScale.Mul(decimal.NewFromFloat32(ingredient.MinimumQuantity)).Truncate(2).InexactFloat64())
-									var maxQty *float32
-									if ingredient.MaximumQuantity != nil {
-										max := float32(recipeScale.Mul(decimal.NewFromFloat32(*ingredient.MaximumQuantity)).Truncate(2).InexactFloat64())
-										maxQty = &max
-									}
-
-									inputs[ingredient.Ingredient.ID] = &types.MealPlanGroceryListItemDatabaseCreationInput{
-										Status:                 types.MealPlanGroceryListItemStatusNeeds,
-										ValidMeasurementUnitID: ingredient.MeasurementUnit.ID,
-										ValidIngredientID:      ingredient.Ingredient.ID,
-										BelongsToMealPlan:      mealPlan.ID,
-										ID:                     identifiers.New(),
-										MinimumQuantityNeeded:  minQty,
-										MaximumQuantityNeeded:  maxQty,
-									}
-								} else {
-									if inputs[ingredient.Ingredient.ID].ValidMeasurementUnitID == ingredient.MeasurementUnit.ID {
-										inputs[ingredient.Ingredient.ID].MinimumQuantityNeeded += ingredient.MinimumQuantity
-
-										if inputs[ingredient.Ingredient.ID].MaximumQuantityNeeded != nil {
-											if ingredient.MaximumQuantity != nil {
-												*inputs[ingredient.Ingredient.ID].MaximumQuantityNeeded += *ingredient.MaximumQuantity
-											}
-										} else if ingredient.MaximumQuantity != nil {
-											inputs[ingredient.Ingredient.ID].MaximumQuantityNeeded = ingredient.MaximumQuantity
-										}
-									} else {
-										logger.Error(fmt.Errorf("mismatched measurement units: %s and %s", inputs[ingredient.Ingredient.ID].ValidMeasurementUnitID, ingredient.MeasurementUnit.ID), "creating grocery list")
-									}
-								}
+							if ingredient.Ingredient == nil {
+								continue
+							}
+
+							qty := &ingredientQuantity{
+								measurementUnitID: ingredient.MeasurementUnit.ID,
+								minimum:           recipeScale.Mul(decimal.NewFromFloat32(ingredient.MinimumQuantity)),
+							}
+
+							if ingredient.MaximumQuantity != nil {
+								maxQty := recipeScale.Mul(decimal.NewFromFloat32(*ingredient.MaximumQuantity))
+								qty.maximum = &maxQty
+							}
+
+							n, ok := neededByIngredientID[ingredient.Ingredient.ID]
+							if !ok {
+								n = &neededIngredient{ingredientID: ingredient.Ingredient.ID}
+								neededByIngredientID[ingredient.Ingredient.ID] = n
+								needed = append(needed, n)
 							}
+
+							n.quantities = append(n.quantities, qty)
 						}
 					}
 				}
@@ -91,10 +93,99 @@ func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, meal
 		}
 	}
 
+	// we only need to consult the conversion table if an ingredient is called for in more than one unit.
+	unitsToConvert := []string{}
+	for _, n := range needed {
+		for _, qty := range n.quantities[1:] {
+			if qty.measurementUnitID != n.quantities[0].measurementUnitID {
+				unitsToConvert = append(unitsToConvert, n.quantities[0].measurementUnitID, qty.measurementUnitID)
+			}
+		}
+	}
+
+	conversions := unitconversion.NewGraph()
+	if len(unitsToConvert) > 0 {
+		var err error
+		if conversions, err = g.graphBuilder.BuildGraph(ctx, unitsToConvert...); err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "building measurement unit conversion graph")
+		}
+	}
+
 	dbInputs := []*types.MealPlanGroceryListItemDatabaseCreationInput{}
-	for _, i := range inputs {
-		dbInputs = append(dbInputs, i)
+	for _, n := range needed {
+		l := logger.WithValue(keys.ValidIngredientIDKey, n.ingredientID)
+
+		// quantities are expressed in the first unit we encountered, unless they can't be converted to it.
+		totals := []*ingredientQuantity{}
+		totalsByUnit := map[string]*ingredientQuantity{}
+
+		for _, qty := range n.quantities {
+			target := qty.measurementUnitID
+			factor := decimal.NewFromInt(1)
+
+			if len(totals) > 0 {
+				f, err := conversions.ConversionFactor(qty.measurementUnitID, totals[0].measurementUnitID, n.ingredientID)
+				if err != nil {
+					l.Error(fmt.Errorf("converting %s to %s: %w", qty.measurementUnitID, totals[0].measurementUnitID, err), "creating grocery list")
+				} else {
+					target = totals[0].measurementUnitID
+					factor = f
+				}
+			}
+
+			total, ok := totalsByUnit[target]
+			if !ok {
+				total = &ingredientQuantity{measurementUnitID: target}
+				totalsByUnit[target] = total
+				totals = append(totals, total)
+			}
+
+			total.addConverted(qty, factor)
+		}
+
+		for _, total := range totals {
+			dbInputs = append(dbInputs, total.toDatabaseCreationInput(mealPlan.ID, n.ingredientID))
+		}
 	}
 
 	return dbInputs, nil
 }
+
+// addConverted adds a quantity to this one after multiplying it by a conversion factor.
+// When either quantity has a maximum, the other contributes its maximum if it has one and its minimum otherwise.
+func (q *ingredientQuantity) addConverted(other *ingredientQuantity, factor decimal.Decimal) {
+	if q.maximum != nil || other.maximum != nil {
+		maxQty := q.minimum
+		if q.maximum != nil {
+			maxQty = *q.maximum
+		}
+
+		if other.maximum != nil {
+			maxQty = maxQty.Add(other.maximum.Mul(factor))
+		} else {
+			maxQty = maxQty.Add(other.minimum.Mul(factor))
+		}
+
+		q.maximum = &maxQty
+	}
+
+	q.minimum = q.minimum.Add(other.minimum.Mul(factor))
+}
+
+func (q *ingredientQuantity) toDatabaseCreationInput(mealPlanID, ingredientID string) *types.MealPlanGroceryListItemDatabaseCreationInput {
+	var maxQty *float32
+	if q.maximum != nil {
+		x := float32(q.maximum.Round(2).InexactFloat64())
+		maxQty = &x
+	}
+
+	return &types.MealPlanGroceryListItemDatabaseCreationInput{
+		Status:                 types.MealPlanGroceryListItemStatusNeeds,
+		ValidMeasurementUnitID: q.measurementUnitID,
+		ValidIngredientID:      ingredientID,
+		BelongsToMealPlan:      mealPlanID,
+		ID:                     identifiers.New(),
+		MinimumQuantityNeeded:  float32(q.minimum.Round(2).InexactFloat64()),
+		MaximumQuantityNeeded:  maxQty,
+	}
+}
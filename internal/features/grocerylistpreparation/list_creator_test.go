@@ -2,15 +2,20 @@
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
@@ -376,4 +381,253 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		assert.Equal(t, expectedMap, actualMap)
 	})
+
+	T.Run("with mismatched measurement units", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		flour := fakes.BuildFakeValidIngredient()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+		tablespoons := fakes.BuildFakeValidMeasurementUnit()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		cupsToTablespoons := fakes.BuildFakeValidMeasurementUnitConversion()
+		cupsToTablespoons.From = *cups
+		cupsToTablespoons.To = *tablespoons
+		cupsToTablespoons.Modifier = 16
+		cupsToTablespoons.OnlyForIngredient = nil
+
+		cupsToGramsOfFlour := fakes.BuildFakeValidMeasurementUnitConversion()
+		cupsToGramsOfFlour.From = *cups
+		cupsToGramsOfFlour.To = *grams
+		cupsToGramsOfFlour.Modifier = 120
+		cupsToGramsOfFlour.OnlyForIngredient = flour
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{cups.ID, grams.ID, cups.ID, tablespoons.ID}).Return(unitconversion.NewGraph(cupsToTablespoons, cupsToGramsOfFlour), nil)
+
+		listGenerator := &groceryListCreator{
+			logger:       logging.NewNoopLogger(),
+			tracer:       tracing.NewTracer(tracing.NewNoopTracerProvider().Tracer(t.Name())),
+			graphBuilder: graphBuilder,
+		}
+
+		expectedMealPlan := &types.MealPlan{
+			ID: fakes.BuildFakeID(),
+			Events: []*types.MealPlanEvent{
+				{
+					Options: []*types.MealPlanOption{
+						{
+							Chosen:    true,
+							MealScale: 1.0,
+							Meal: types.Meal{
+								Components: []*types.MealComponent{
+									{
+										RecipeScale: 1.0,
+										Recipe: types.Recipe{
+											Steps: []*types.RecipeStep{
+												{
+													Ingredients: []*types.RecipeStepIngredient{
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 2,
+															MeasurementUnit: *cups,
+														},
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 300,
+															MaximumQuantity: pointer.To(float32(360)),
+															MeasurementUnit: *grams,
+														},
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 24,
+															MeasurementUnit: *tablespoons,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		expected := []*types.MealPlanGroceryListItemDatabaseCreationInput{
+			{
+				Status:                 types.MealPlanGroceryListItemStatusNeeds,
+				ValidMeasurementUnitID: cups.ID,
+				ValidIngredientID:      flour.ID,
+				BelongsToMealPlan:      expectedMealPlan.ID,
+				MinimumQuantityNeeded:  6,
+				MaximumQuantityNeeded:  pointer.To(float32(6.5)),
+			},
+		}
+
+		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		assert.NoError(t, err)
+		require.Len(t, actual, len(expected))
+		for i := range actual {
+			expected[i].ID = actual[i].ID
+		}
+
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
+
+	T.Run("with unconvertible measurement units", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		flour := fakes.BuildFakeValidIngredient()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{cups.ID, grams.ID}).Return(unitconversion.NewGraph(), nil)
+
+		listGenerator := &groceryListCreator{
+			logger:       logging.NewNoopLogger(),
+			tracer:       tracing.NewTracer(tracing.NewNoopTracerProvider().Tracer(t.Name())),
+			graphBuilder: graphBuilder,
+		}
+
+		expectedMealPlan := &types.MealPlan{
+			ID: fakes.BuildFakeID(),
+			Events: []*types.MealPlanEvent{
+				{
+					Options: []*types.MealPlanOption{
+						{
+							Chosen:    true,
+							MealScale: 1.0,
+							Meal: types.Meal{
+								Components: []*types.MealComponent{
+									{
+										RecipeScale: 1.0,
+										Recipe: types.Recipe{
+											Steps: []*types.RecipeStep{
+												{
+													Ingredients: []*types.RecipeStepIngredient{
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 2,
+															MeasurementUnit: *cups,
+														},
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 300,
+															MeasurementUnit: *grams,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		expected := []*types.MealPlanGroceryListItemDatabaseCreationInput{
+			{
+				Status:                 types.MealPlanGroceryListItemStatusNeeds,
+				ValidMeasurementUnitID: cups.ID,
+				ValidIngredientID:      flour.ID,
+				BelongsToMealPlan:      expectedMealPlan.ID,
+				MinimumQuantityNeeded:  2,
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusNeeds,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      flour.ID,
+				BelongsToMealPlan:      expectedMealPlan.ID,
+				MinimumQuantityNeeded:  300,
+			},
+		}
+
+		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		assert.NoError(t, err)
+		require.Len(t, actual, len(expected))
+		for i := range actual {
+			expected[i].ID = actual[i].ID
+		}
+
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
+
+	T.Run("with error building conversion graph", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		flour := fakes.BuildFakeValidIngredient()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{cups.ID, grams.ID}).Return((*unitconversion.Graph)(nil), errors.New("blah"))
+
+		listGenerator := &groceryListCreator{
+			logger:       logging.NewNoopLogger(),
+			tracer:       tracing.NewTracer(tracing.NewNoopTracerProvider().Tracer(t.Name())),
+			graphBuilder: graphBuilder,
+		}
+
+		expectedMealPlan := &types.MealPlan{
+			ID: fakes.BuildFakeID(),
+			Events: []*types.MealPlanEvent{
+				{
+					Options: []*types.MealPlanOption{
+						{
+							Chosen:    true,
+							MealScale: 1.0,
+							Meal: types.Meal{
+								Components: []*types.MealComponent{
+									{
+										RecipeScale: 1.0,
+										Recipe: types.Recipe{
+											Steps: []*types.RecipeStep{
+												{
+													Ingredients: []*types.RecipeStepIngredient{
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 2,
+															MeasurementUnit: *cups,
+														},
+														{
+															Ingredient:      flour,
+															MinimumQuantity: 300,
+															MeasurementUnit: *grams,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
 }
@@ -0,0 +1,72 @@
+package mealplanelections
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// Ballot maps the meal plan options a single voter ranked to the rank they gave them. Lower ranks are preferred.
+type Ballot map[string]int
+
+// preferred returns whether the ballot prefers one candidate over another. Ranked candidates are preferred over unranked ones.
+func (b Ballot) preferred(over, under string) bool {
+	overRank, overRanked := b[over]
+	if !overRanked {
+		return false
+	}
+
+	underRank, underRanked := b[under]
+
+	return !underRanked || overRank < underRank
+}
+
+// topChoice returns the most preferred candidate on the ballot among those still standing, if any.
+// Candidates are checked in order, so the earliest of several equally ranked candidates wins out.
+func (b Ballot) topChoice(candidates []string, standing map[string]bool) (string, bool) {
+	var (
+		choice   string
+		bestRank int
+		found    bool
+	)
+
+	for _, candidate := range candidates {
+		if !standing[candidate] {
+			continue
+		}
+
+		if rank, ranked := b[candidate]; ranked && (!found || rank < bestRank) {
+			choice, bestRank, found = candidate, rank, true
+		}
+	}
+
+	return choice, found
+}
+
+// BallotsForOptions collects the votes cast for a set of meal plan options into one ballot per voter.
+// Abstentions leave the option unranked on that voter's ballot.
+func BallotsForOptions(options []*types.MealPlanOption) (candidates []string, ballots []Ballot) {
+	candidates = []string{}
+	ballotsByUser := map[string]Ballot{}
+	voters := []string{}
+
+	for _, option := range options {
+		candidates = append(candidates, option.ID)
+
+		for _, vote := range option.Votes {
+			if _, ok := ballotsByUser[vote.ByUser]; !ok {
+				ballotsByUser[vote.ByUser] = Ballot{}
+				voters = append(voters, vote.ByUser)
+			}
+
+			if !vote.Abstain {
+				ballotsByUser[vote.ByUser][option.ID] = int(vote.Rank)
+			}
+		}
+	}
+
+	ballots = []Ballot{}
+	for _, voter := range voters {
+		ballots = append(ballots, ballotsByUser[voter])
+	}
+
+	return candidates, ballots
+}
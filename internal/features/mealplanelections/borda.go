@@ -0,0 +1,43 @@
+package mealplanelections
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var _ ElectionMethod = (*bordaElectionMethod)(nil)
+
+type bordaElectionMethod struct{}
+
+// Tally runs a Borda count. With N candidates, each ballot awards a candidate N-1 points, less one point for
+// every candidate the ballot ranks ahead of it; unranked candidates get nothing. The candidates with the most points win.
+func (m *bordaElectionMethod) Tally(candidates []string, ballots []Ballot) *types.MealPlanEventResults {
+	points := map[string]int{}
+	for _, candidate := range candidates {
+		points[candidate] = 0
+	}
+
+	for _, ballot := range ballots {
+		for _, candidate := range candidates {
+			rank, ranked := ballot[candidate]
+			if !ranked {
+				continue
+			}
+
+			rankedAhead := 0
+			for _, other := range candidates {
+				if otherRank, otherRanked := ballot[other]; otherRanked && otherRank < rank {
+					rankedAhead++
+				}
+			}
+
+			points[candidate] += len(candidates) - 1 - rankedAhead
+		}
+	}
+
+	return &types.MealPlanEventResults{
+		Winners: winnersByScore(candidates, points),
+		Borda: &types.BordaElectionResults{
+			Points: points,
+		},
+	}
+}
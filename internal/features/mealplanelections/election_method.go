@@ -0,0 +1,48 @@
+package mealplanelections
+
+import (
+	"fmt"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// ElectionMethod decides the winners of a meal plan event from its voters' ballots.
+type ElectionMethod interface {
+	// Tally returns results holding the winning candidates (several, in the event of a tie) and
+	// whatever intermediate workings the method produces along the way.
+	Tally(candidates []string, ballots []Ballot) *types.MealPlanEventResults
+}
+
+var electionMethods = map[string]ElectionMethod{
+	types.MealPlanElectionMethodSchulze:       &schulzeElectionMethod{},
+	types.MealPlanElectionMethodInstantRunoff: &instantRunoffElectionMethod{},
+	types.MealPlanElectionMethodPlurality:     &pluralityElectionMethod{},
+	types.MealPlanElectionMethodBorda:         &bordaElectionMethod{},
+}
+
+// ElectionMethodFor returns the ElectionMethod a meal plan's election method refers to.
+func ElectionMethodFor(electionMethod string) (ElectionMethod, error) {
+	method, ok := electionMethods[electionMethod]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownElectionMethod, electionMethod)
+	}
+
+	return method, nil
+}
+
+// winnersByScore returns the candidates with the highest score, in candidate order.
+func winnersByScore(candidates []string, scores map[string]int) []string {
+	winners := []string{}
+	highestScore := 0
+
+	for _, candidate := range candidates {
+		switch score := scores[candidate]; {
+		case len(winners) == 0 || score > highestScore:
+			winners, highestScore = []string{candidate}, score
+		case score == highestScore:
+			winners = append(winners, candidate)
+		}
+	}
+
+	return winners
+}
@@ -0,0 +1,203 @@
+package mealplanelections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tennesseeBallots builds the classic example of Tennessee voting on the location of its capital,
+// one ballot per percent of the electorate. It's useful because each method picks a different winner.
+func tennesseeBallots() []Ballot {
+	ballots := []Ballot{}
+	ballots = append(ballots, buildBallots(42, "memphis", "nashville", "chattanooga", "knoxville")...)
+	ballots = append(ballots, buildBallots(26, "nashville", "chattanooga", "knoxville", "memphis")...)
+	ballots = append(ballots, buildBallots(15, "chattanooga", "knoxville", "nashville", "memphis")...)
+	ballots = append(ballots, buildBallots(17, "knoxville", "chattanooga", "nashville", "memphis")...)
+
+	return ballots
+}
+
+func TestElectionMethods(T *testing.T) {
+	T.Parallel()
+
+	tennesseeCandidates := []string{"memphis", "nashville", "chattanooga", "knoxville"}
+
+	// a Condorcet cycle: a beats b, b beats c, and c beats a, all by the same margin.
+	cycleCandidates := []string{"a", "b", "c"}
+	cycleBallots := []Ballot{}
+	cycleBallots = append(cycleBallots, buildBallots(1, "a", "b", "c")...)
+	cycleBallots = append(cycleBallots, buildBallots(1, "b", "c", "a")...)
+	cycleBallots = append(cycleBallots, buildBallots(1, "c", "a", "b")...)
+
+	// the Condorcet winner is nobody's first choice.
+	compromiseCandidates := []string{"left", "center", "right"}
+	compromiseBallots := []Ballot{}
+	compromiseBallots = append(compromiseBallots, buildBallots(4, "left", "center", "right")...)
+	compromiseBallots = append(compromiseBallots, buildBallots(3, "right", "center", "left")...)
+	compromiseBallots = append(compromiseBallots, buildBallots(2, "center", "right", "left")...)
+
+	testCases := map[string]struct {
+		electionMethod  string
+		candidates      []string
+		ballots         []Ballot
+		expectedWinners []string
+	}{
+		"schulze with tennessee": {
+			electionMethod:  types.MealPlanElectionMethodSchulze,
+			candidates:      tennesseeCandidates,
+			ballots:         tennesseeBallots(),
+			expectedWinners: []string{"nashville"},
+		},
+		"instant runoff with tennessee": {
+			electionMethod:  types.MealPlanElectionMethodInstantRunoff,
+			candidates:      tennesseeCandidates,
+			ballots:         tennesseeBallots(),
+			expectedWinners: []string{"knoxville"},
+		},
+		"plurality with tennessee": {
+			electionMethod:  types.MealPlanElectionMethodPlurality,
+			candidates:      tennesseeCandidates,
+			ballots:         tennesseeBallots(),
+			expectedWinners: []string{"memphis"},
+		},
+		"borda with tennessee": {
+			electionMethod:  types.MealPlanElectionMethodBorda,
+			candidates:      tennesseeCandidates,
+			ballots:         tennesseeBallots(),
+			expectedWinners: []string{"nashville"},
+		},
+		"schulze with cycle": {
+			electionMethod:  types.MealPlanElectionMethodSchulze,
+			candidates:      cycleCandidates,
+			ballots:         cycleBallots,
+			expectedWinners: []string{"a", "b", "c"},
+		},
+		"instant runoff with cycle": {
+			electionMethod:  types.MealPlanElectionMethodInstantRunoff,
+			candidates:      cycleCandidates,
+			ballots:         cycleBallots,
+			expectedWinners: []string{"a", "b", "c"},
+		},
+		"plurality with cycle": {
+			electionMethod:  types.MealPlanElectionMethodPlurality,
+			candidates:      cycleCandidates,
+			ballots:         cycleBallots,
+			expectedWinners: []string{"a", "b", "c"},
+		},
+		"borda with cycle": {
+			electionMethod:  types.MealPlanElectionMethodBorda,
+			candidates:      cycleCandidates,
+			ballots:         cycleBallots,
+			expectedWinners: []string{"a", "b", "c"},
+		},
+		"schulze with compromise": {
+			electionMethod:  types.MealPlanElectionMethodSchulze,
+			candidates:      compromiseCandidates,
+			ballots:         compromiseBallots,
+			expectedWinners: []string{"center"},
+		},
+		"instant runoff with compromise": {
+			electionMethod:  types.MealPlanElectionMethodInstantRunoff,
+			candidates:      compromiseCandidates,
+			ballots:         compromiseBallots,
+			expectedWinners: []string{"right"},
+		},
+		"plurality with compromise": {
+			electionMethod:  types.MealPlanElectionMethodPlurality,
+			candidates:      compromiseCandidates,
+			ballots:         compromiseBallots,
+			expectedWinners: []string{"left"},
+		},
+		"borda with compromise": {
+			electionMethod:  types.MealPlanElectionMethodBorda,
+			candidates:      compromiseCandidates,
+			ballots:         compromiseBallots,
+			expectedWinners: []string{"center"},
+		},
+		"schulze with unanimous ballots": {
+			electionMethod:  types.MealPlanElectionMethodSchulze,
+			candidates:      cycleCandidates,
+			ballots:         buildBallots(3, "b", "a"),
+			expectedWinners: []string{"b"},
+		},
+		"instant runoff with unanimous ballots": {
+			electionMethod:  types.MealPlanElectionMethodInstantRunoff,
+			candidates:      cycleCandidates,
+			ballots:         buildBallots(3, "b", "a"),
+			expectedWinners: []string{"b"},
+		},
+		"plurality with unanimous ballots": {
+			electionMethod:  types.MealPlanElectionMethodPlurality,
+			candidates:      cycleCandidates,
+			ballots:         buildBallots(3, "b", "a"),
+			expectedWinners: []string{"b"},
+		},
+		"borda with unanimous ballots": {
+			electionMethod:  types.MealPlanElectionMethodBorda,
+			candidates:      cycleCandidates,
+			ballots:         buildBallots(3, "b", "a"),
+			expectedWinners: []string{"b"},
+		},
+	}
+
+	for name, tc := range testCases {
+		T.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			method, err := ElectionMethodFor(tc.electionMethod)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedWinners, method.Tally(tc.candidates, tc.ballots).Winners)
+		})
+	}
+}
+
+func TestElectionMethodFor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with unknown election method", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ElectionMethodFor("fake")
+		assert.ErrorIs(t, err, ErrUnknownElectionMethod)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestBordaElectionMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		results := (&bordaElectionMethod{}).Tally([]string{"memphis", "nashville", "chattanooga", "knoxville"}, tennesseeBallots())
+
+		assert.Equal(t, map[string]int{
+			"memphis":     126,
+			"nashville":   194,
+			"chattanooga": 173,
+			"knoxville":   107,
+		}, results.Borda.Points)
+	})
+}
+
+func TestPluralityElectionMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		results := (&pluralityElectionMethod{}).Tally([]string{"memphis", "nashville", "chattanooga", "knoxville"}, tennesseeBallots())
+
+		assert.Equal(t, map[string]int{
+			"memphis":     42,
+			"nashville":   26,
+			"chattanooga": 15,
+			"knoxville":   17,
+		}, results.Plurality.FirstPreferences)
+	})
+}
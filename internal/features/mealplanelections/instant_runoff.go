@@ -0,0 +1,82 @@
+package mealplanelections
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var _ ElectionMethod = (*instantRunoffElectionMethod)(nil)
+
+type instantRunoffElectionMethod struct{}
+
+// Tally runs an instant-runoff election, recording the tallies and eliminations of every round.
+// Each round, every ballot counts towards its highest ranked candidate still standing. A candidate with a majority
+// of the ballots that haven't been exhausted wins; otherwise, every candidate with the fewest votes is eliminated.
+// If every remaining candidate is tied, they all win.
+func (m *instantRunoffElectionMethod) Tally(candidates []string, ballots []Ballot) *types.MealPlanEventResults {
+	results := &types.MealPlanEventResults{
+		Winners: []string{},
+		InstantRunoff: &types.InstantRunoffElectionResults{
+			Rounds: []*types.InstantRunoffRound{},
+		},
+	}
+
+	standing := map[string]bool{}
+	for _, candidate := range candidates {
+		standing[candidate] = true
+	}
+
+	for len(standing) > 0 {
+		round := &types.InstantRunoffRound{
+			Round:      len(results.InstantRunoff.Rounds) + 1,
+			Tallies:    map[string]int{},
+			Eliminated: []string{},
+		}
+		results.InstantRunoff.Rounds = append(results.InstantRunoff.Rounds, round)
+
+		remaining := []string{}
+		for _, candidate := range candidates {
+			if standing[candidate] {
+				remaining = append(remaining, candidate)
+				round.Tallies[candidate] = 0
+			}
+		}
+
+		for _, ballot := range ballots {
+			if choice, ok := ballot.topChoice(candidates, standing); ok {
+				round.Tallies[choice]++
+			} else {
+				round.ExhaustedBallots++
+			}
+		}
+
+		activeBallots := len(ballots) - round.ExhaustedBallots
+		fewestVotes := activeBallots
+		for _, candidate := range remaining {
+			if round.Tallies[candidate]*2 > activeBallots {
+				results.Winners = []string{candidate}
+				return results
+			}
+
+			fewestVotes = min(fewestVotes, round.Tallies[candidate])
+		}
+
+		for _, candidate := range remaining {
+			if round.Tallies[candidate] == fewestVotes {
+				round.Eliminated = append(round.Eliminated, candidate)
+			}
+		}
+
+		// if eliminating the trailing candidates would leave nobody standing, they're tied.
+		if len(round.Eliminated) == len(remaining) {
+			round.Eliminated = []string{}
+			results.Winners = remaining
+			return results
+		}
+
+		for _, candidate := range round.Eliminated {
+			delete(standing, candidate)
+		}
+	}
+
+	return results
+}
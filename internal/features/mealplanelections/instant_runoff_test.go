@@ -0,0 +1,73 @@
+package mealplanelections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstantRunoffElectionMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []Ballot{}
+		ballots = append(ballots, buildBallots(4, "a", "b", "c")...)
+		ballots = append(ballots, buildBallots(3, "b", "c", "a")...)
+		ballots = append(ballots, buildBallots(2, "c", "b", "a")...)
+
+		results := (&instantRunoffElectionMethod{}).Tally(candidates, ballots)
+
+		assert.Equal(t, []string{"b"}, results.Winners)
+		assert.Equal(t, []*types.InstantRunoffRound{
+			{
+				Round:      1,
+				Tallies:    map[string]int{"a": 4, "b": 3, "c": 2},
+				Eliminated: []string{"c"},
+			},
+			{
+				Round:      2,
+				Tallies:    map[string]int{"a": 4, "b": 5},
+				Eliminated: []string{},
+			},
+		}, results.InstantRunoff.Rounds)
+	})
+
+	T.Run("with exhausted ballots", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []Ballot{}
+		ballots = append(ballots, buildBallots(2, "a")...)
+		ballots = append(ballots, buildBallots(1, "c")...)
+		ballots = append(ballots, buildBallots(2, "b")...)
+
+		results := (&instantRunoffElectionMethod{}).Tally(candidates, ballots)
+
+		assert.Equal(t, []string{"a", "b"}, results.Winners)
+		assert.Len(t, results.InstantRunoff.Rounds, 2)
+		assert.Equal(t, []string{"c"}, results.InstantRunoff.Rounds[0].Eliminated)
+		assert.Equal(t, 1, results.InstantRunoff.Rounds[1].ExhaustedBallots)
+	})
+
+	T.Run("with simultaneous eliminations", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c", "d"}
+		ballots := []Ballot{}
+		ballots = append(ballots, buildBallots(3, "a")...)
+		ballots = append(ballots, buildBallots(1, "b", "a")...)
+		ballots = append(ballots, buildBallots(1, "c", "d")...)
+		ballots = append(ballots, buildBallots(2, "d")...)
+
+		results := (&instantRunoffElectionMethod{}).Tally(candidates, ballots)
+
+		assert.Equal(t, []string{"a"}, results.Winners)
+		assert.Equal(t, []string{"b", "c"}, results.InstantRunoff.Rounds[0].Eliminated)
+		assert.Equal(t, map[string]int{"a": 4, "d": 3}, results.InstantRunoff.Rounds[1].Tallies)
+	})
+}
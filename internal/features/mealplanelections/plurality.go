@@ -0,0 +1,32 @@
+package mealplanelections
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var _ ElectionMethod = (*pluralityElectionMethod)(nil)
+
+type pluralityElectionMethod struct{}
+
+// Tally counts the ballots that ranked each candidate first. The candidates with the most first preferences win.
+func (m *pluralityElectionMethod) Tally(candidates []string, ballots []Ballot) *types.MealPlanEventResults {
+	standing := map[string]bool{}
+	firstPreferences := map[string]int{}
+	for _, candidate := range candidates {
+		standing[candidate] = true
+		firstPreferences[candidate] = 0
+	}
+
+	for _, ballot := range ballots {
+		if choice, ok := ballot.topChoice(candidates, standing); ok {
+			firstPreferences[choice]++
+		}
+	}
+
+	return &types.MealPlanEventResults{
+		Winners: winnersByScore(candidates, firstPreferences),
+		Plurality: &types.PluralityElectionResults{
+			FirstPreferences: firstPreferences,
+		},
+	}
+}
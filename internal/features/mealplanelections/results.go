@@ -0,0 +1,50 @@
+package mealplanelections
+
+import (
+	"errors"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	// ErrNilMealPlanEvent indicates a nil meal plan event was provided.
+	ErrNilMealPlanEvent = errors.New("nil meal plan event provided")
+	// ErrUnknownElectionMethod indicates the election method provided isn't one we know how to tally.
+	ErrUnknownElectionMethod = errors.New("unknown election method")
+)
+
+// TallyMealPlanEvent tallies the votes cast for a meal plan event's options using the given election method.
+func TallyMealPlanEvent(electionMethod string, event *types.MealPlanEvent) (*types.MealPlanEventResults, error) {
+	if event == nil {
+		return nil, ErrNilMealPlanEvent
+	}
+
+	method, err := ElectionMethodFor(electionMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, ballots := BallotsForOptions(event.Options)
+
+	results := method.Tally(candidates, ballots)
+	results.MealPlanEventID = event.ID
+	results.ElectionMethod = electionMethod
+	results.Candidates = candidates
+	results.BallotCount = len(ballots)
+
+	// nobody wins an election nobody voted in.
+	if len(ballots) == 0 {
+		results.Winners = []string{}
+	}
+
+	results.Tie = len(results.Winners) > 1
+
+	for _, option := range event.Options {
+		if option.Chosen {
+			results.ChosenOption = option.ID
+			results.TieBroken = option.TieBroken
+		}
+	}
+
+	return results, nil
+}
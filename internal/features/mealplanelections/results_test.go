@@ -0,0 +1,118 @@
+package mealplanelections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildMealPlanEventForTest() *types.MealPlanEvent {
+	event := fakes.BuildFakeMealPlanEvent()
+	event.Options = []*types.MealPlanOption{
+		{ID: "a", Chosen: true},
+		{ID: "b"},
+		{ID: "c"},
+	}
+
+	for i, user := range []string{"alice", "bob", "carol"} {
+		for j, option := range event.Options {
+			option.Votes = append(option.Votes, &types.MealPlanOptionVote{
+				ByUser:                  user,
+				BelongsToMealPlanOption: option.ID,
+				Rank:                    uint8((i + j) % len(event.Options)),
+				Abstain:                 user == "carol" && option.ID == "c",
+			})
+		}
+	}
+
+	return event
+}
+
+func TestBallotsForOptions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates, ballots := BallotsForOptions(buildMealPlanEventForTest().Options)
+
+		assert.Equal(t, []string{"a", "b", "c"}, candidates)
+		assert.Equal(t, []Ballot{
+			{"a": 0, "b": 1, "c": 2},
+			{"a": 1, "b": 2, "c": 0},
+			{"a": 2, "b": 0},
+		}, ballots)
+	})
+}
+
+func TestTallyMealPlanEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with schulze", func(t *testing.T) {
+		t.Parallel()
+
+		event := buildMealPlanEventForTest()
+
+		actual, err := TallyMealPlanEvent(types.MealPlanElectionMethodSchulze, event)
+		require.NoError(t, err)
+
+		assert.Equal(t, event.ID, actual.MealPlanEventID)
+		assert.Equal(t, types.MealPlanElectionMethodSchulze, actual.ElectionMethod)
+		assert.Equal(t, 3, actual.BallotCount)
+		assert.Equal(t, "a", actual.ChosenOption)
+		assert.NotNil(t, actual.Schulze)
+		assert.Nil(t, actual.InstantRunoff)
+		assert.NotEmpty(t, actual.Winners)
+	})
+
+	T.Run("with instant runoff", func(t *testing.T) {
+		t.Parallel()
+
+		event := buildMealPlanEventForTest()
+
+		actual, err := TallyMealPlanEvent(types.MealPlanElectionMethodInstantRunoff, event)
+		require.NoError(t, err)
+
+		assert.Equal(t, types.MealPlanElectionMethodInstantRunoff, actual.ElectionMethod)
+		assert.Nil(t, actual.Schulze)
+		assert.NotNil(t, actual.InstantRunoff)
+		assert.NotEmpty(t, actual.InstantRunoff.Rounds)
+	})
+
+	T.Run("without votes", func(t *testing.T) {
+		t.Parallel()
+
+		event := fakes.BuildFakeMealPlanEvent()
+		for _, option := range event.Options {
+			option.Votes = nil
+			option.Chosen = false
+		}
+
+		actual, err := TallyMealPlanEvent(types.MealPlanElectionMethodSchulze, event)
+		require.NoError(t, err)
+
+		assert.Empty(t, actual.Winners)
+		assert.False(t, actual.Tie)
+		assert.Empty(t, actual.ChosenOption)
+	})
+
+	T.Run("with nil event", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := TallyMealPlanEvent(types.MealPlanElectionMethodSchulze, nil)
+		assert.ErrorIs(t, err, ErrNilMealPlanEvent)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with unknown election method", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := TallyMealPlanEvent("fake", buildMealPlanEventForTest())
+		assert.ErrorIs(t, err, ErrUnknownElectionMethod)
+		assert.Nil(t, actual)
+	})
+}
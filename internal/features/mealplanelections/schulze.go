@@ -0,0 +1,85 @@
+package mealplanelections
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var _ ElectionMethod = (*schulzeElectionMethod)(nil)
+
+type schulzeElectionMethod struct{}
+
+// Tally computes the pairwise preference matrix and strongest paths for a Schulze election.
+// The winners are the candidates who beat the most other candidates by strongest path.
+func (m *schulzeElectionMethod) Tally(candidates []string, ballots []Ballot) *types.MealPlanEventResults {
+	results := &types.SchulzeElectionResults{
+		PairwisePreferences: map[string]map[string]int{},
+		StrongestPaths:      map[string]map[string]int{},
+	}
+
+	for _, a := range candidates {
+		results.PairwisePreferences[a] = map[string]int{}
+		for _, b := range candidates {
+			if a == b {
+				continue
+			}
+
+			results.PairwisePreferences[a][b] = 0
+			for _, ballot := range ballots {
+				if ballot.preferred(a, b) {
+					results.PairwisePreferences[a][b]++
+				}
+			}
+		}
+	}
+
+	d := results.PairwisePreferences
+	p := results.StrongestPaths
+
+	for _, a := range candidates {
+		p[a] = map[string]int{}
+		for _, b := range candidates {
+			if a == b {
+				continue
+			}
+
+			p[a][b] = 0
+			if d[a][b] > d[b][a] {
+				p[a][b] = d[a][b]
+			}
+		}
+	}
+
+	// a variant of Floyd-Warshall that finds the widest path between every pair of candidates.
+	for _, i := range candidates {
+		for _, j := range candidates {
+			if i == j {
+				continue
+			}
+
+			for _, k := range candidates {
+				if i == k || j == k {
+					continue
+				}
+
+				p[j][k] = max(p[j][k], min(p[j][i], p[i][k]))
+			}
+		}
+	}
+
+	// a candidate beats another if its strongest path to that candidate is stronger than the reverse.
+	wins := map[string]int{}
+	for _, a := range candidates {
+		for _, b := range candidates {
+			if a != b && p[a][b] > p[b][a] {
+				wins[a]++
+			}
+		}
+	}
+
+	winners := winnersByScore(candidates, wins)
+
+	return &types.MealPlanEventResults{
+		Winners: winners,
+		Schulze: results,
+	}
+}
@@ -0,0 +1,81 @@
+package mealplanelections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// buildBallots builds count identical ballots ranking the provided candidates in order.
+func buildBallots(count int, rankedCandidates ...string) []Ballot {
+	ballots := []Ballot{}
+	for range count {
+		ballot := Ballot{}
+		for i, candidate := range rankedCandidates {
+			ballot[candidate] = i
+		}
+		ballots = append(ballots, ballot)
+	}
+
+	return ballots
+}
+
+func TestSchulzeElectionMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		// the canonical example from the Schulze method's documentation.
+		candidates := []string{"a", "b", "c", "d", "e"}
+		ballots := []Ballot{}
+		ballots = append(ballots, buildBallots(5, "a", "c", "b", "e", "d")...)
+		ballots = append(ballots, buildBallots(5, "a", "d", "e", "c", "b")...)
+		ballots = append(ballots, buildBallots(8, "b", "e", "d", "a", "c")...)
+		ballots = append(ballots, buildBallots(3, "c", "a", "b", "e", "d")...)
+		ballots = append(ballots, buildBallots(7, "c", "a", "e", "b", "d")...)
+		ballots = append(ballots, buildBallots(2, "c", "b", "a", "d", "e")...)
+		ballots = append(ballots, buildBallots(7, "d", "c", "e", "b", "a")...)
+		ballots = append(ballots, buildBallots(8, "e", "b", "a", "d", "c")...)
+
+		results := (&schulzeElectionMethod{}).Tally(candidates, ballots)
+
+		assert.Equal(t, []string{"e"}, results.Winners)
+
+		assert.Equal(t, map[string]int{"b": 20, "c": 26, "d": 30, "e": 22}, results.Schulze.PairwisePreferences["a"])
+		assert.Equal(t, map[string]int{"a": 25, "c": 16, "d": 33, "e": 18}, results.Schulze.PairwisePreferences["b"])
+
+		assert.Equal(t, map[string]int{"b": 28, "c": 28, "d": 30, "e": 24}, results.Schulze.StrongestPaths["a"])
+		assert.Equal(t, map[string]int{"a": 25, "c": 28, "d": 33, "e": 24}, results.Schulze.StrongestPaths["b"])
+		assert.Equal(t, map[string]int{"a": 25, "b": 29, "d": 29, "e": 24}, results.Schulze.StrongestPaths["c"])
+		assert.Equal(t, map[string]int{"a": 25, "b": 28, "c": 28, "e": 24}, results.Schulze.StrongestPaths["d"])
+		assert.Equal(t, map[string]int{"a": 25, "b": 28, "c": 28, "d": 31}, results.Schulze.StrongestPaths["e"])
+	})
+
+	T.Run("with unranked candidates", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []Ballot{
+			{"b": 1},
+			{"b": 1, "a": 2},
+		}
+
+		results := (&schulzeElectionMethod{}).Tally(candidates, ballots)
+
+		assert.Equal(t, []string{"b"}, results.Winners)
+		assert.Equal(t, map[string]int{"b": 0, "c": 1}, results.Schulze.PairwisePreferences["a"])
+		assert.Equal(t, map[string]int{"a": 2, "c": 2}, results.Schulze.PairwisePreferences["b"])
+	})
+
+	T.Run("with tie", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b"}
+		ballots := append(buildBallots(1, "a", "b"), buildBallots(1, "b", "a")...)
+
+		results := (&schulzeElectionMethod{}).Tally(candidates, ballots)
+
+		assert.Equal(t, []string{"a", "b"}, results.Winners)
+	})
+}
@@ -0,0 +1,241 @@
+package nutrition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrNilRecipe indicates a nil recipe was provided.
+	ErrNilRecipe = errors.New("nil recipe provided")
+	// ErrNilMeal indicates a nil meal was provided.
+	ErrNilMeal = errors.New("nil meal provided")
+)
+
+// Calculator computes the nutritional content of recipes and meals.
+type Calculator interface {
+	CalculateRecipeNutrition(ctx context.Context, recipe *types.Recipe) (*types.NutritionSummary, error)
+	CalculateMealNutrition(ctx context.Context, meal *types.Meal) (*types.NutritionSummary, error)
+}
+
+var _ Calculator = (*calculator)(nil)
+
+type calculator struct {
+	logger                                    logging.Logger
+	tracer                                    tracing.Tracer
+	graphBuilder                              unitconversion.GraphBuilder
+	nutritionDataManager                      types.ValidIngredientNutritionDataManager
+	validIngredientMeasurementUnitDataManager types.ValidIngredientMeasurementUnitDataManager
+}
+
+// NewCalculator creates a Calculator.
+func NewCalculator(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	graphBuilder unitconversion.GraphBuilder,
+	nutritionDataManager types.ValidIngredientNutritionDataManager,
+	validIngredientMeasurementUnitDataManager types.ValidIngredientMeasurementUnitDataManager,
+) Calculator {
+	return &calculator{
+		logger:               logging.EnsureLogger(logger).WithName("nutrition_calculator"),
+		tracer:               tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("nutrition_calculator")),
+		graphBuilder:         graphBuilder,
+		nutritionDataManager: nutritionDataManager,
+		validIngredientMeasurementUnitDataManager: validIngredientMeasurementUnitDataManager,
+	}
+}
+
+// CalculateRecipeNutrition totals the nutritional content of a recipe's ingredients, and divides it by the recipe's minimum estimated portions.
+func (c *calculator) CalculateRecipeNutrition(ctx context.Context, recipe *types.Recipe) (*types.NutritionSummary, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if recipe == nil {
+		return nil, ErrNilRecipe
+	}
+
+	logger := c.logger.WithValue(keys.RecipeIDKey, recipe.ID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipe.ID)
+
+	ingredients := countedIngredientsForRecipe(recipe)
+
+	ingredientIDs := []string{}
+	seen := map[string]bool{}
+	for _, ingredient := range ingredients {
+		if !seen[ingredient.Ingredient.ID] {
+			seen[ingredient.Ingredient.ID] = true
+			ingredientIDs = append(ingredientIDs, ingredient.Ingredient.ID)
+		}
+	}
+
+	facts, err := c.nutritionDataManager.GetValidIngredientNutritionForIngredients(ctx, ingredientIDs)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition")
+	}
+
+	nutritionByIngredient := map[string]*types.ValidIngredientNutrition{}
+	for _, x := range facts {
+		nutritionByIngredient[x.BelongsToValidIngredient] = x
+	}
+
+	measurementUnitIDs := []string{}
+	for _, ingredient := range ingredients {
+		measurementUnitIDs = append(measurementUnitIDs, ingredient.MeasurementUnit.ID)
+	}
+	for _, x := range facts {
+		measurementUnitIDs = append(measurementUnitIDs, x.ReferenceMeasurementUnit.ID)
+	}
+
+	g, err := c.graphBuilder.BuildGraph(ctx, measurementUnitIDs...)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building measurement unit conversion graph")
+	}
+
+	allowedUnits := map[string]map[string]bool{}
+	for _, ingredientID := range ingredientIDs {
+		// we only care whether the step's unit is among the ingredient's pairings, so one page is plenty.
+		validIngredientMeasurementUnits, getErr := c.validIngredientMeasurementUnitDataManager.GetValidIngredientMeasurementUnitsForIngredient(ctx, ingredientID, nil)
+		if getErr != nil {
+			return nil, observability.PrepareAndLogError(getErr, logger, span, "fetching valid measurement units for ingredient")
+		}
+
+		if len(validIngredientMeasurementUnits.Data) == 0 {
+			continue
+		}
+
+		allowedUnits[ingredientID] = map[string]bool{}
+		for _, x := range validIngredientMeasurementUnits.Data {
+			allowedUnits[ingredientID][x.MeasurementUnit.ID] = true
+		}
+	}
+
+	return summarizeNutrition(ingredients, recipe.MinimumEstimatedPortions, nutritionByIngredient, allowedUnits, g), nil
+}
+
+// CalculateMealNutrition totals the nutritional content of a meal's components, scaled by each component's recipe scale,
+// and divides it by the meal's minimum estimated portions.
+func (c *calculator) CalculateMealNutrition(ctx context.Context, meal *types.Meal) (*types.NutritionSummary, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if meal == nil {
+		return nil, ErrNilMeal
+	}
+
+	logger := c.logger.WithValue(keys.MealIDKey, meal.ID)
+	tracing.AttachToSpan(span, keys.MealIDKey, meal.ID)
+
+	summary := &types.NutritionSummary{
+		Complete: true,
+		Warnings: []string{},
+	}
+
+	for _, component := range meal.Components {
+		recipeSummary, err := c.CalculateRecipeNutrition(ctx, &component.Recipe)
+		if err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "calculating nutrition for meal component")
+		}
+
+		scale := component.RecipeScale
+		if scale <= 0 {
+			scale = 1
+		}
+
+		summary.Total.Add(recipeSummary.Total.Scale(scale))
+		summary.Warnings = append(summary.Warnings, recipeSummary.Warnings...)
+		summary.Complete = summary.Complete && recipeSummary.Complete
+	}
+
+	if meal.MinimumEstimatedPortions > 0 {
+		summary.PerPortion = summary.Total.Scale(1 / meal.MinimumEstimatedPortions)
+	}
+
+	return summary, nil
+}
+
+// countedIngredientsForRecipe returns the step ingredients that contribute to a recipe's nutrition. Products of
+// earlier steps are already accounted for by the ingredients that made them, and optional ingredients or
+// alternatives beyond the first option might never make it into the dish, so neither are counted.
+// Supporting recipes are counted in full.
+func countedIngredientsForRecipe(recipe *types.Recipe) []*types.RecipeStepIngredient {
+	ingredients := []*types.RecipeStepIngredient{}
+	for _, step := range recipe.Steps {
+		for _, ingredient := range step.Ingredients {
+			if ingredient.Ingredient == nil || ingredient.RecipeStepProductID != nil {
+				continue
+			}
+
+			if ingredient.Optional || ingredient.OptionIndex != 0 {
+				continue
+			}
+
+			ingredients = append(ingredients, ingredient)
+		}
+	}
+
+	for _, supportingRecipe := range recipe.SupportingRecipes {
+		ingredients = append(ingredients, countedIngredientsForRecipe(supportingRecipe)...)
+	}
+
+	return ingredients
+}
+
+// summarizeNutrition totals the nutrition of the provided ingredients. Anything that prevents an ingredient from
+// being counted is noted as a warning, and marks the summary as incomplete.
+func summarizeNutrition(
+	ingredients []*types.RecipeStepIngredient,
+	portions float32,
+	nutritionByIngredient map[string]*types.ValidIngredientNutrition,
+	allowedUnits map[string]map[string]bool,
+	g *unitconversion.Graph,
+) *types.NutritionSummary {
+	summary := &types.NutritionSummary{
+		Warnings: []string{},
+	}
+
+	for _, ingredient := range ingredients {
+		if units, ok := allowedUnits[ingredient.Ingredient.ID]; ok && !units[ingredient.MeasurementUnit.ID] {
+			summary.Warnings = append(summary.Warnings, fmt.Sprintf("%s is not usually measured in %s", ingredient.Ingredient.Name, ingredient.MeasurementUnit.PluralName))
+		}
+
+		facts, ok := nutritionByIngredient[ingredient.Ingredient.ID]
+		if !ok {
+			summary.Warnings = append(summary.Warnings, fmt.Sprintf("no nutrition data for %s", ingredient.Ingredient.Name))
+			continue
+		}
+
+		if facts.ReferenceQuantity <= 0 {
+			summary.Warnings = append(summary.Warnings, fmt.Sprintf("nutrition data for %s has no reference quantity", ingredient.Ingredient.Name))
+			continue
+		}
+
+		converted, err := g.Convert(decimal.NewFromFloat32(ingredient.MinimumQuantity), ingredient.MeasurementUnit.ID, facts.ReferenceMeasurementUnit.ID, ingredient.Ingredient.ID)
+		if err != nil {
+			summary.Warnings = append(summary.Warnings, fmt.Sprintf("cannot convert %s of %s to %s", ingredient.MeasurementUnit.PluralName, ingredient.Ingredient.Name, facts.ReferenceMeasurementUnit.PluralName))
+			continue
+		}
+
+		factor := float32(converted.Div(decimal.NewFromFloat32(facts.ReferenceQuantity)).InexactFloat64())
+		summary.Total.Add(facts.Nutrients.Scale(factor))
+	}
+
+	sort.Strings(summary.Warnings)
+	summary.Complete = len(summary.Warnings) == 0
+
+	if portions > 0 {
+		summary.PerPortion = summary.Total.Scale(1 / portions)
+	}
+
+	return summary
+}
@@ -0,0 +1,260 @@
+package nutrition
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildConversionForTest(from, to *types.ValidMeasurementUnit, modifier float32) *types.ValidMeasurementUnitConversion {
+	conversion := fakes.BuildFakeValidMeasurementUnitConversion()
+	conversion.From = *from
+	conversion.To = *to
+	conversion.Modifier = modifier
+	conversion.OnlyForIngredient = nil
+
+	return conversion
+}
+
+func buildNutritionForTest(ingredient *types.ValidIngredient, unit *types.ValidMeasurementUnit, nutrients types.NutrientAmounts) *types.ValidIngredientNutrition {
+	x := fakes.BuildFakeValidIngredientNutrition()
+	x.BelongsToValidIngredient = ingredient.ID
+	x.ReferenceMeasurementUnit = *unit
+	x.ReferenceQuantity = 100
+	x.Nutrients = nutrients
+
+	return x
+}
+
+func TestCalculator_CalculateRecipeNutrition(T *testing.T) {
+	T.Parallel()
+
+	gram := fakes.BuildFakeValidMeasurementUnit()
+	kilogram := fakes.BuildFakeValidMeasurementUnit()
+	cup := fakes.BuildFakeValidMeasurementUnit()
+	clove := fakes.BuildFakeValidMeasurementUnit()
+
+	flour := fakes.BuildFakeValidIngredient()
+	butter := fakes.BuildFakeValidIngredient()
+	garlic := fakes.BuildFakeValidIngredient()
+
+	conversions := unitconversion.NewGraph(
+		buildConversionForTest(kilogram, gram, 1000),
+	)
+	flourCup := buildConversionForTest(cup, gram, 120)
+	flourCup.OnlyForIngredient = flour
+	conversions.AddConversion(flourCup)
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := &types.Recipe{
+			ID:                       fakes.BuildFakeID(),
+			MinimumEstimatedPortions: 4,
+			Steps: []*types.RecipeStep{
+				{
+					Ingredients: []*types.RecipeStepIngredient{
+						{Ingredient: flour, MeasurementUnit: *cup, MinimumQuantity: 2},
+						{Ingredient: butter, MeasurementUnit: *kilogram, MinimumQuantity: 0.1},
+						// optional ingredients and alternatives aren't counted.
+						{Ingredient: garlic, MeasurementUnit: *clove, MinimumQuantity: 2, Optional: true},
+						{Ingredient: butter, MeasurementUnit: *gram, MinimumQuantity: 500, OptionIndex: 1},
+					},
+				},
+				{
+					Ingredients: []*types.RecipeStepIngredient{
+						// products of earlier steps aren't counted either.
+						{MeasurementUnit: *cup, MinimumQuantity: 1, RecipeStepProductID: pointer.To(fakes.BuildFakeID())},
+					},
+				},
+			},
+		}
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On("GetValidIngredientNutritionForIngredients", testutils.ContextMatcher, []string{flour.ID, butter.ID}).Return([]*types.ValidIngredientNutrition{
+			buildNutritionForTest(flour, gram, types.NutrientAmounts{Calories: 360, Carbohydrates: 75, Protein: 10}),
+			buildNutritionForTest(butter, gram, types.NutrientAmounts{Calories: 720, TotalFat: 80, Sodium: 10}),
+		}, nil)
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{cup.ID, kilogram.ID, gram.ID, gram.ID}).Return(conversions, nil)
+
+		validIngredientMeasurementUnitDataManager := &mocktypes.ValidIngredientMeasurementUnitDataManagerMock{}
+		validIngredientMeasurementUnitDataManager.On("GetValidIngredientMeasurementUnitsForIngredient", testutils.ContextMatcher, flour.ID, (*types.QueryFilter)(nil)).Return(&types.QueryFilteredResult[types.ValidIngredientMeasurementUnit]{}, nil)
+		validIngredientMeasurementUnitDataManager.On("GetValidIngredientMeasurementUnitsForIngredient", testutils.ContextMatcher, butter.ID, (*types.QueryFilter)(nil)).Return(&types.QueryFilteredResult[types.ValidIngredientMeasurementUnit]{}, nil)
+
+		c := NewCalculator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder, nutritionDataManager, validIngredientMeasurementUnitDataManager)
+
+		actual, err := c.CalculateRecipeNutrition(ctx, recipe)
+		require.NoError(t, err)
+
+		// 240 g of flour and 100 g of butter.
+		assert.True(t, actual.Complete)
+		assert.Empty(t, actual.Warnings)
+		assert.InDelta(t, 864+720, actual.Total.Calories, 0.01)
+		assert.InDelta(t, 180, actual.Total.Carbohydrates, 0.01)
+		assert.InDelta(t, 80, actual.Total.TotalFat, 0.01)
+		assert.InDelta(t, 10, actual.Total.Sodium, 0.01)
+		require.NotNil(t, actual.PerPortion)
+		assert.InDelta(t, 396, actual.PerPortion.Calories, 0.01)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager, graphBuilder, validIngredientMeasurementUnitDataManager)
+	})
+
+	T.Run("with incomplete data", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := &types.Recipe{
+			ID: fakes.BuildFakeID(),
+			Steps: []*types.RecipeStep{
+				{
+					Ingredients: []*types.RecipeStepIngredient{
+						{Ingredient: flour, MeasurementUnit: *gram, MinimumQuantity: 50},
+						{Ingredient: butter, MeasurementUnit: *cup, MinimumQuantity: 1},
+						{Ingredient: garlic, MeasurementUnit: *clove, MinimumQuantity: 2},
+					},
+				},
+			},
+		}
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On("GetValidIngredientNutritionForIngredients", testutils.ContextMatcher, []string{flour.ID, butter.ID, garlic.ID}).Return([]*types.ValidIngredientNutrition{
+			buildNutritionForTest(flour, gram, types.NutrientAmounts{Calories: 360}),
+			buildNutritionForTest(butter, gram, types.NutrientAmounts{Calories: 720}),
+		}, nil)
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{gram.ID, cup.ID, clove.ID, gram.ID, gram.ID}).Return(conversions, nil)
+
+		flourInKilograms := fakes.BuildFakeValidIngredientMeasurementUnit()
+		flourInKilograms.MeasurementUnit = *kilogram
+
+		validIngredientMeasurementUnitDataManager := &mocktypes.ValidIngredientMeasurementUnitDataManagerMock{}
+		validIngredientMeasurementUnitDataManager.On("GetValidIngredientMeasurementUnitsForIngredient", testutils.ContextMatcher, flour.ID, (*types.QueryFilter)(nil)).Return(&types.QueryFilteredResult[types.ValidIngredientMeasurementUnit]{
+			Data: []*types.ValidIngredientMeasurementUnit{flourInKilograms},
+		}, nil)
+		validIngredientMeasurementUnitDataManager.On("GetValidIngredientMeasurementUnitsForIngredient", testutils.ContextMatcher, butter.ID, (*types.QueryFilter)(nil)).Return(&types.QueryFilteredResult[types.ValidIngredientMeasurementUnit]{}, nil)
+		validIngredientMeasurementUnitDataManager.On("GetValidIngredientMeasurementUnitsForIngredient", testutils.ContextMatcher, garlic.ID, (*types.QueryFilter)(nil)).Return(&types.QueryFilteredResult[types.ValidIngredientMeasurementUnit]{}, nil)
+
+		c := NewCalculator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder, nutritionDataManager, validIngredientMeasurementUnitDataManager)
+
+		actual, err := c.CalculateRecipeNutrition(ctx, recipe)
+		require.NoError(t, err)
+
+		assert.False(t, actual.Complete)
+		// one warning for flour's unusual unit, one for butter's missing conversion, and one for garlic's missing data.
+		assert.Len(t, actual.Warnings, 3)
+		assert.InDelta(t, 180, actual.Total.Calories, 0.01)
+		assert.Nil(t, actual.PerPortion)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager, graphBuilder, validIngredientMeasurementUnitDataManager)
+	})
+
+	T.Run("with nil recipe", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewCalculator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), nil, nil, nil)
+
+		actual, err := c.CalculateRecipeNutrition(context.Background(), nil)
+		assert.ErrorIs(t, err, ErrNilRecipe)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with error fetching nutrition", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := &types.Recipe{ID: fakes.BuildFakeID()}
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On("GetValidIngredientNutritionForIngredients", testutils.ContextMatcher, []string{}).Return([]*types.ValidIngredientNutrition(nil), errors.New("blah"))
+
+		c := NewCalculator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), nil, nutritionDataManager, nil)
+
+		actual, err := c.CalculateRecipeNutrition(ctx, recipe)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager)
+	})
+}
+
+func TestCalculator_CalculateMealNutrition(T *testing.T) {
+	T.Parallel()
+
+	gram := fakes.BuildFakeValidMeasurementUnit()
+	rice := fakes.BuildFakeValidIngredient()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := types.Recipe{
+			ID:                       fakes.BuildFakeID(),
+			MinimumEstimatedPortions: 2,
+			Steps: []*types.RecipeStep{
+				{
+					Ingredients: []*types.RecipeStepIngredient{
+						{Ingredient: rice, MeasurementUnit: *gram, MinimumQuantity: 200},
+					},
+				},
+			},
+		}
+		meal := &types.Meal{
+			ID:                       fakes.BuildFakeID(),
+			MinimumEstimatedPortions: 3,
+			Components: []*types.MealComponent{
+				{Recipe: recipe, RecipeScale: 1.5},
+			},
+		}
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On("GetValidIngredientNutritionForIngredients", testutils.ContextMatcher, []string{rice.ID}).Return([]*types.ValidIngredientNutrition{
+			buildNutritionForTest(rice, gram, types.NutrientAmounts{Calories: 130}),
+		}, nil)
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{gram.ID, gram.ID}).Return(unitconversion.NewGraph(), nil)
+
+		validIngredientMeasurementUnitDataManager := &mocktypes.ValidIngredientMeasurementUnitDataManagerMock{}
+		validIngredientMeasurementUnitDataManager.On("GetValidIngredientMeasurementUnitsForIngredient", testutils.ContextMatcher, rice.ID, (*types.QueryFilter)(nil)).Return(&types.QueryFilteredResult[types.ValidIngredientMeasurementUnit]{}, nil)
+
+		c := NewCalculator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder, nutritionDataManager, validIngredientMeasurementUnitDataManager)
+
+		actual, err := c.CalculateMealNutrition(ctx, meal)
+		require.NoError(t, err)
+
+		// 200 g of rice is 260 kcal, scaled by 1.5 and split three ways.
+		assert.True(t, actual.Complete)
+		assert.InDelta(t, 390, actual.Total.Calories, 0.01)
+		require.NotNil(t, actual.PerPortion)
+		assert.InDelta(t, 130, actual.PerPortion.Calories, 0.01)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager, graphBuilder, validIngredientMeasurementUnitDataManager)
+	})
+
+	T.Run("with nil meal", func(t *testing.T) {
+		t.Parallel()
+
+		c := NewCalculator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), nil, nil, nil)
+
+		actual, err := c.CalculateMealNutrition(context.Background(), nil)
+		assert.ErrorIs(t, err, ErrNilMeal)
+		assert.Nil(t, actual)
+	})
+}
@@ -0,0 +1,117 @@
+package nutrition
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	// FoodDataCentralSource is the source recorded for nutrition facts imported from FoodData Central.
+	FoodDataCentralSource = "usda_fdc"
+	// FoodDataCentralReferenceQuantity is the quantity, in grams, that FoodData Central nutrient amounts describe.
+	FoodDataCentralReferenceQuantity = 100
+
+	fdcIDColumn             = "fdc_id"
+	descriptionColumn       = "description"
+	validIngredientIDColumn = "valid_ingredient_id"
+)
+
+var (
+	// ErrMissingRequiredColumn indicates a FoodData Central file is missing a column we can't do without.
+	ErrMissingRequiredColumn = errors.New("missing required column")
+
+	// foodDataCentralNutrientColumns maps FoodData Central nutrient names to the amount they populate.
+	// FoodData Central reports energy in kcal, sodium in mg, and everything else in g, which is what we store.
+	foodDataCentralNutrientColumns = map[string]func(*types.NutrientAmounts, float32){
+		"energy":                       func(x *types.NutrientAmounts, v float32) { x.Calories = v },
+		"protein":                      func(x *types.NutrientAmounts, v float32) { x.Protein = v },
+		"total lipid (fat)":            func(x *types.NutrientAmounts, v float32) { x.TotalFat = v },
+		"fatty acids, total saturated": func(x *types.NutrientAmounts, v float32) { x.SaturatedFat = v },
+		"carbohydrate, by difference":  func(x *types.NutrientAmounts, v float32) { x.Carbohydrates = v },
+		"sugars, total including nlea": func(x *types.NutrientAmounts, v float32) { x.Sugar = v },
+		"fiber, total dietary":         func(x *types.NutrientAmounts, v float32) { x.Fiber = v },
+		"sodium, na":                   func(x *types.NutrientAmounts, v float32) { x.Sodium = v },
+	}
+)
+
+// FoodDataCentralRecord is a single food from a FoodData Central style CSV file.
+type FoodDataCentralRecord struct {
+	FDCID             string
+	Description       string
+	ValidIngredientID string
+	Nutrients         types.NutrientAmounts
+}
+
+// ParseFoodDataCentralCSV reads a FoodData Central style CSV file, with one food per row, a fdc_id and description column,
+// and a column per nutrient named as FoodData Central names them (i.e. "Protein" or "Sodium, Na"), holding the amount
+// of that nutrient in 100 grams of the food. An optional valid_ingredient_id column pins rows to a particular ingredient.
+// Unrecognized columns are ignored, and blank nutrient values are treated as zero.
+func ParseFoodDataCentralCSV(r io.Reader) ([]*FoodDataCentralRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{fdcIDColumn, descriptionColumn} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingRequiredColumn, required)
+		}
+	}
+
+	records := []*FoodDataCentralRecord{}
+	for line := 2; ; line++ {
+		row, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("reading line %d: %w", line, readErr)
+		}
+
+		record := &FoodDataCentralRecord{
+			FDCID:       strings.TrimSpace(row[columns[fdcIDColumn]]),
+			Description: strings.TrimSpace(row[columns[descriptionColumn]]),
+		}
+
+		if i, ok := columns[validIngredientIDColumn]; ok {
+			record.ValidIngredientID = strings.TrimSpace(row[i])
+		}
+
+		for name, setter := range foodDataCentralNutrientColumns {
+			i, ok := columns[name]
+			if !ok {
+				continue
+			}
+
+			raw := strings.TrimSpace(row[i])
+			if raw == "" {
+				continue
+			}
+
+			value, parseErr := strconv.ParseFloat(raw, 32)
+			if parseErr != nil {
+				return nil, fmt.Errorf("parsing %q on line %d: %w", header[i], line, parseErr)
+			}
+
+			setter(&record.Nutrients, float32(value))
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
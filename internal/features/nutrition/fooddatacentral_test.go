@@ -0,0 +1,80 @@
+package nutrition
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFoodDataCentralCSV(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		input := `fdc_id,description,valid_ingredient_id,Energy,Protein,"Total lipid (fat)","Fatty acids, total saturated","Carbohydrate, by difference","Sugars, total including NLEA","Fiber, total dietary","Sodium, Na",Water
+171287,"Egg, whole, raw, fresh",,143,12.6,9.51,3.13,0.72,0.37,0,142,76.2
+169704,"Flour, wheat, all-purpose",abc123,364,10.3,0.98,0.155,76.3,0.27,2.7,2,
+`
+
+		actual, err := ParseFoodDataCentralCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, &FoodDataCentralRecord{
+			FDCID:       "171287",
+			Description: "Egg, whole, raw, fresh",
+			Nutrients: types.NutrientAmounts{
+				Calories:      143,
+				Protein:       12.6,
+				TotalFat:      9.51,
+				SaturatedFat:  3.13,
+				Carbohydrates: 0.72,
+				Sugar:         0.37,
+				Fiber:         0,
+				Sodium:        142,
+			},
+		}, actual[0])
+		assert.Equal(t, "abc123", actual[1].ValidIngredientID)
+		assert.Equal(t, float32(364), actual[1].Nutrients.Calories)
+	})
+
+	T.Run("with missing nutrient columns", func(t *testing.T) {
+		t.Parallel()
+
+		input := "fdc_id,description,Energy\n1,Salt,\n"
+
+		actual, err := ParseFoodDataCentralCSV(strings.NewReader(input))
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, types.NutrientAmounts{}, actual[0].Nutrients)
+	})
+
+	T.Run("with missing required column", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseFoodDataCentralCSV(strings.NewReader("description,Energy\nSalt,0\n"))
+		assert.ErrorIs(t, err, ErrMissingRequiredColumn)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid value", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseFoodDataCentralCSV(strings.NewReader("fdc_id,description,Energy\n1,Salt,lots\n"))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with empty input", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseFoodDataCentralCSV(strings.NewReader(""))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
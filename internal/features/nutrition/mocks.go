@@ -0,0 +1,30 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Calculator = (*MockCalculator)(nil)
+
+// MockCalculator is a mock Calculator.
+type MockCalculator struct {
+	mock.Mock
+}
+
+// CalculateRecipeNutrition is a mock function.
+func (m *MockCalculator) CalculateRecipeNutrition(ctx context.Context, recipe *types.Recipe) (*types.NutritionSummary, error) {
+	returnValues := m.Called(ctx, recipe)
+
+	return returnValues.Get(0).(*types.NutritionSummary), returnValues.Error(1)
+}
+
+// CalculateMealNutrition is a mock function.
+func (m *MockCalculator) CalculateMealNutrition(ctx context.Context, meal *types.Meal) (*types.NutritionSummary, error) {
+	returnValues := m.Called(ctx, meal)
+
+	return returnValues.Get(0).(*types.NutritionSummary), returnValues.Error(1)
+}
@@ -0,0 +1,335 @@
+package recipeimport
+
+import (
+	"context"
+	"errors"
+	"html"
+	"strings"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	// ErrNilInput indicates nil input was provided.
+	ErrNilInput = errors.New("nil input provided")
+)
+
+// Importer builds draft recipes from schema.org Recipe documents and free-text ingredient lines.
+type Importer interface {
+	ImportRecipe(ctx context.Context, input *types.RecipeImportRequestInput) (*types.RecipeImportDraft, error)
+}
+
+var _ Importer = (*importer)(nil)
+
+type importer struct {
+	logger                          logging.Logger
+	tracer                          tracing.Tracer
+	validIngredientDataManager      types.ValidIngredientDataManager
+	validMeasurementUnitDataManager types.ValidMeasurementUnitDataManager
+	validPreparationDataManager     types.ValidPreparationDataManager
+}
+
+// NewImporter creates an Importer.
+func NewImporter(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	validIngredientDataManager types.ValidIngredientDataManager,
+	validMeasurementUnitDataManager types.ValidMeasurementUnitDataManager,
+	validPreparationDataManager types.ValidPreparationDataManager,
+) Importer {
+	return &importer{
+		logger:                          logging.EnsureLogger(logger).WithName("recipe_importer"),
+		tracer:                          tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("recipe_importer")),
+		validIngredientDataManager:      validIngredientDataManager,
+		validMeasurementUnitDataManager: validMeasurementUnitDataManager,
+		validPreparationDataManager:     validPreparationDataManager,
+	}
+}
+
+// resolutionCache keeps an import from searching for the same text twice.
+type resolutionCache struct {
+	ingredients      map[string]*types.ValidIngredient
+	measurementUnits map[string]*types.ValidMeasurementUnit
+	preparations     map[string]*types.ValidPreparation
+}
+
+// ImportRecipe builds a draft recipe from a schema.org Recipe document and/or free-text ingredient lines.
+// Each instruction becomes a step, and each ingredient joins the first step that mentions it. Anything
+// that can't be matched to a valid ingredient, measurement unit or preparation is listed as an unresolved token.
+func (i *importer) ImportRecipe(ctx context.Context, input *types.RecipeImportRequestInput) (*types.RecipeImportDraft, error) {
+	ctx, span := i.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInput
+	}
+
+	logger := i.logger.Clone()
+
+	recipe := &types.RecipeCreationRequestInput{
+		PrepTasks: []*types.RecipePrepTaskWithinRecipeCreationRequestInput{},
+		Steps:     []*types.RecipeStepCreationRequestInput{},
+	}
+
+	instructions, ingredientLines := []string{}, []string{}
+	if input.Document != "" {
+		document, err := parseRecipeDocument([]byte(input.Document))
+		if err != nil {
+			return nil, observability.PrepareError(err, span, "parsing recipe document")
+		}
+
+		recipe.Name = html.UnescapeString(document.Name)
+		recipe.Description = html.UnescapeString(document.Description)
+		recipe.Source = document.URL
+		if portions, ok := document.yield(); ok {
+			recipe.MinimumEstimatedPortions = portions
+		}
+
+		instructions = document.instructions()
+		ingredientLines = document.ingredientLines()
+	}
+	ingredientLines = append(ingredientLines, input.IngredientLines...)
+
+	if len(instructions) == 0 {
+		instructions = []string{""}
+	}
+
+	draft := &types.RecipeImportDraft{
+		Recipe:           recipe,
+		IngredientLines:  []*types.ParsedIngredientLine{},
+		UnresolvedTokens: []*types.UnresolvedRecipeImportToken{},
+	}
+
+	cache := &resolutionCache{
+		ingredients:      map[string]*types.ValidIngredient{},
+		measurementUnits: map[string]*types.ValidMeasurementUnit{},
+		preparations:     map[string]*types.ValidPreparation{},
+	}
+
+	for index, instruction := range instructions {
+		step := &types.RecipeStepCreationRequestInput{
+			Index:                uint32(index),
+			ExplicitInstructions: instruction,
+			Instruments:          []*types.RecipeStepInstrumentCreationRequestInput{},
+			Vessels:              []*types.RecipeStepVesselCreationRequestInput{},
+			Products:             []*types.RecipeStepProductCreationRequestInput{},
+			Ingredients:          []*types.RecipeStepIngredientCreationRequestInput{},
+			CompletionConditions: []*types.RecipeStepCompletionConditionCreationRequestInput{},
+		}
+
+		if verb := leadingWord(instruction); verb != "" {
+			preparation, err := i.resolvePreparation(ctx, cache, verb)
+			if err != nil {
+				return nil, observability.PrepareAndLogError(err, logger, span, "resolving step preparation")
+			}
+
+			if preparation != nil {
+				step.PreparationID = preparation.ID
+			} else {
+				draft.UnresolvedTokens = append(draft.UnresolvedTokens, &types.UnresolvedRecipeImportToken{
+					Kind:      types.UnresolvedRecipeImportTokenKindPreparation,
+					Value:     verb,
+					Source:    instruction,
+					StepIndex: step.Index,
+				})
+			}
+		}
+
+		recipe.Steps = append(recipe.Steps, step)
+	}
+
+	for _, line := range ingredientLines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		parsed := ParseIngredientLine(line)
+		step := recipe.Steps[stepIndexForIngredient(instructions, parsed.Name)]
+
+		unresolved := func(kind, value string) {
+			draft.UnresolvedTokens = append(draft.UnresolvedTokens, &types.UnresolvedRecipeImportToken{
+				Kind:      kind,
+				Value:     value,
+				Source:    line,
+				StepIndex: step.Index,
+			})
+		}
+
+		if parsed.Name != "" {
+			ingredient, err := i.resolveIngredient(ctx, cache, parsed.Name)
+			if err != nil {
+				return nil, observability.PrepareAndLogError(err, logger, span, "resolving ingredient")
+			}
+
+			if ingredient != nil {
+				parsed.IngredientID = pointer.To(ingredient.ID)
+			} else {
+				unresolved(types.UnresolvedRecipeImportTokenKindIngredient, parsed.Name)
+			}
+		}
+
+		if parsed.MeasurementUnit != "" {
+			measurementUnit, err := i.resolveMeasurementUnit(ctx, cache, parsed.MeasurementUnit)
+			if err != nil {
+				return nil, observability.PrepareAndLogError(err, logger, span, "resolving measurement unit")
+			}
+
+			if measurementUnit != nil {
+				parsed.MeasurementUnitID = measurementUnit.ID
+			} else {
+				unresolved(types.UnresolvedRecipeImportTokenKindMeasurementUnit, parsed.MeasurementUnit)
+			}
+		}
+
+		for _, preparationName := range parsed.Preparations {
+			preparation, err := i.resolvePreparation(ctx, cache, preparationName)
+			if err != nil {
+				return nil, observability.PrepareAndLogError(err, logger, span, "resolving ingredient preparation")
+			}
+
+			if preparation != nil {
+				parsed.PreparationIDs = append(parsed.PreparationIDs, preparation.ID)
+			} else {
+				unresolved(types.UnresolvedRecipeImportTokenKindPreparation, preparationName)
+			}
+		}
+
+		draft.IngredientLines = append(draft.IngredientLines, parsed)
+		step.Ingredients = append(step.Ingredients, &types.RecipeStepIngredientCreationRequestInput{
+			IngredientID:      parsed.IngredientID,
+			MeasurementUnitID: parsed.MeasurementUnitID,
+			Name:              parsed.Name,
+			IngredientNotes:   parsed.Notes,
+			MinimumQuantity:   parsed.MinimumQuantity,
+			MaximumQuantity:   parsed.MaximumQuantity,
+			Optional:          parsed.Optional,
+			ToTaste:           parsed.ToTaste,
+		})
+	}
+
+	return draft, nil
+}
+
+// resolveIngredient searches for a valid ingredient by name, preferring an exact match over the top search result,
+// since ingredient lines tend to dress names up ("large eggs" for "egg").
+func (i *importer) resolveIngredient(ctx context.Context, cache *resolutionCache, name string) (*types.ValidIngredient, error) {
+	if ingredient, ok := cache.ingredients[name]; ok {
+		return ingredient, nil
+	}
+
+	results, err := i.validIngredientDataManager.SearchForValidIngredients(ctx, name, types.DefaultQueryFilter())
+	if err != nil {
+		return nil, err
+	} else if results == nil {
+		results = &types.QueryFilteredResult[types.ValidIngredient]{}
+	}
+
+	var resolved *types.ValidIngredient
+	for _, ingredient := range results.Data {
+		if matchesAny(name, ingredient.Name, ingredient.PluralName, ingredient.Slug) {
+			resolved = ingredient
+			break
+		}
+	}
+
+	if resolved == nil && len(results.Data) > 0 {
+		resolved = results.Data[0]
+	}
+	cache.ingredients[name] = resolved
+
+	return resolved, nil
+}
+
+// resolveMeasurementUnit searches for a valid measurement unit by name. Units are a closed vocabulary, so only exact matches count.
+func (i *importer) resolveMeasurementUnit(ctx context.Context, cache *resolutionCache, name string) (*types.ValidMeasurementUnit, error) {
+	if measurementUnit, ok := cache.measurementUnits[name]; ok {
+		return measurementUnit, nil
+	}
+
+	results, err := i.validMeasurementUnitDataManager.SearchForValidMeasurementUnits(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved *types.ValidMeasurementUnit
+	for _, measurementUnit := range results {
+		if matchesAny(name, measurementUnit.Name, measurementUnit.PluralName, measurementUnit.Slug) {
+			resolved = measurementUnit
+			break
+		}
+	}
+	cache.measurementUnits[name] = resolved
+
+	return resolved, nil
+}
+
+// resolvePreparation searches for a valid preparation by name or past tense. Preparations are a closed vocabulary,
+// so only exact matches count; this also keeps words like "in" that lead off an instruction from matching anything.
+func (i *importer) resolvePreparation(ctx context.Context, cache *resolutionCache, name string) (*types.ValidPreparation, error) {
+	if preparation, ok := cache.preparations[name]; ok {
+		return preparation, nil
+	}
+
+	results, err := i.validPreparationDataManager.SearchForValidPreparations(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var resolved *types.ValidPreparation
+	for _, preparation := range results {
+		if matchesAny(name, preparation.Name, preparation.PastTense, preparation.Slug) {
+			resolved = preparation
+			break
+		}
+	}
+	cache.preparations[name] = resolved
+
+	return resolved, nil
+}
+
+func matchesAny(query string, candidates ...string) bool {
+	for _, candidate := range candidates {
+		if candidate != "" && strings.EqualFold(query, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// leadingWord returns the lowercased first word of an instruction, which is usually the verb that names its preparation.
+func leadingWord(instruction string) string {
+	fields := strings.Fields(instruction)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(strings.Trim(fields[0], ".,;:!"))
+}
+
+// stepIndexForIngredient finds the first instruction that mentions an ingredient, by its full name or its last word
+// ("flour" for "all-purpose flour"), and falls back to the first step.
+func stepIndexForIngredient(instructions []string, name string) int {
+	if name == "" {
+		return 0
+	}
+
+	candidates := []string{name}
+	if fields := strings.Fields(name); len(fields) > 1 {
+		candidates = append(candidates, fields[len(fields)-1])
+	}
+
+	for _, candidate := range candidates {
+		for index, instruction := range instructions {
+			if strings.Contains(strings.ToLower(instruction), candidate) {
+				return index
+			}
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,171 @@
+package recipeimport
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestImporter(
+	ingredientDataManager *mocktypes.ValidIngredientDataManagerMock,
+	measurementUnitDataManager *mocktypes.ValidMeasurementUnitDataManagerMock,
+	preparationDataManager *mocktypes.ValidPreparationDataManagerMock,
+) Importer {
+	return NewImporter(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), ingredientDataManager, measurementUnitDataManager, preparationDataManager)
+}
+
+func TestImporter_ImportRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		onion := fakes.BuildFakeValidIngredient()
+		onion.Name = "onion"
+		cup := fakes.BuildFakeValidMeasurementUnit()
+		cup.Name = "cup"
+		dice := fakes.BuildFakeValidPreparation()
+		dice.Name = "dice"
+		dice.PastTense = "diced"
+		saute := fakes.BuildFakeValidPreparation()
+		saute.Name = "sauté"
+
+		ingredientDataManager := &mocktypes.ValidIngredientDataManagerMock{}
+		ingredientDataManager.On("SearchForValidIngredients", testutils.ContextMatcher, "onion", mock.AnythingOfType("*types.QueryFilter")).
+			Return(&types.QueryFilteredResult[types.ValidIngredient]{Data: []*types.ValidIngredient{onion}}, nil)
+		ingredientDataManager.On("SearchForValidIngredients", testutils.ContextMatcher, "stock", mock.AnythingOfType("*types.QueryFilter")).
+			Return(&types.QueryFilteredResult[types.ValidIngredient]{Data: []*types.ValidIngredient{}}, nil)
+
+		measurementUnitDataManager := &mocktypes.ValidMeasurementUnitDataManagerMock{}
+		measurementUnitDataManager.On("SearchForValidMeasurementUnits", testutils.ContextMatcher, "cup").
+			Return([]*types.ValidMeasurementUnit{cup}, nil)
+
+		preparationDataManager := &mocktypes.ValidPreparationDataManagerMock{}
+		preparationDataManager.On("SearchForValidPreparations", testutils.ContextMatcher, "sauté").
+			Return([]*types.ValidPreparation{saute}, nil)
+		preparationDataManager.On("SearchForValidPreparations", testutils.ContextMatcher, "simmer").
+			Return([]*types.ValidPreparation{}, nil)
+		preparationDataManager.On("SearchForValidPreparations", testutils.ContextMatcher, "diced").
+			Return([]*types.ValidPreparation{dice}, nil)
+
+		i := buildTestImporter(ingredientDataManager, measurementUnitDataManager, preparationDataManager)
+
+		actual, err := i.ImportRecipe(ctx, &types.RecipeImportRequestInput{Document: exampleRecipeDocument})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Weeknight Soup", actual.Recipe.Name)
+		assert.Equal(t, "Soup, but fast & easy.", actual.Recipe.Description)
+		assert.Equal(t, "https://example.com/soup", actual.Recipe.Source)
+		assert.Equal(t, float32(4), actual.Recipe.MinimumEstimatedPortions)
+
+		require.Len(t, actual.Recipe.Steps, 2)
+		assert.Equal(t, saute.ID, actual.Recipe.Steps[0].PreparationID)
+		assert.Empty(t, actual.Recipe.Steps[1].PreparationID)
+
+		require.Len(t, actual.Recipe.Steps[0].Ingredients, 1)
+		assert.Equal(t, &onion.ID, actual.Recipe.Steps[0].Ingredients[0].IngredientID)
+		assert.Equal(t, cup.ID, actual.Recipe.Steps[0].Ingredients[0].MeasurementUnitID)
+		assert.Equal(t, float32(2.5), actual.Recipe.Steps[0].Ingredients[0].MinimumQuantity)
+
+		require.Len(t, actual.Recipe.Steps[1].Ingredients, 1)
+		assert.Nil(t, actual.Recipe.Steps[1].Ingredients[0].IngredientID)
+
+		require.Len(t, actual.IngredientLines, 2)
+		assert.Equal(t, []string{dice.ID}, actual.IngredientLines[0].PreparationIDs)
+
+		assert.Equal(t, []*types.UnresolvedRecipeImportToken{
+			{
+				Kind:      types.UnresolvedRecipeImportTokenKindPreparation,
+				Value:     "simmer",
+				Source:    "Simmer with the stock.",
+				StepIndex: 1,
+			},
+			{
+				Kind:      types.UnresolvedRecipeImportTokenKindIngredient,
+				Value:     "stock",
+				Source:    "4 cups stock",
+				StepIndex: 1,
+			},
+		}, actual.UnresolvedTokens)
+
+		mock.AssertExpectationsForObjects(t, ingredientDataManager, measurementUnitDataManager, preparationDataManager)
+	})
+
+	T.Run("with only ingredient lines", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		ingredientDataManager := &mocktypes.ValidIngredientDataManagerMock{}
+		ingredientDataManager.On("SearchForValidIngredients", testutils.ContextMatcher, "flour", mock.AnythingOfType("*types.QueryFilter")).
+			Return(&types.QueryFilteredResult[types.ValidIngredient]{Data: []*types.ValidIngredient{}}, nil)
+
+		measurementUnitDataManager := &mocktypes.ValidMeasurementUnitDataManagerMock{}
+		measurementUnitDataManager.On("SearchForValidMeasurementUnits", testutils.ContextMatcher, "gram").
+			Return([]*types.ValidMeasurementUnit{}, nil)
+
+		i := buildTestImporter(ingredientDataManager, measurementUnitDataManager, &mocktypes.ValidPreparationDataManagerMock{})
+
+		actual, err := i.ImportRecipe(ctx, &types.RecipeImportRequestInput{IngredientLines: []string{"500g flour", "200 g flour", ""}})
+		require.NoError(t, err)
+
+		require.Len(t, actual.Recipe.Steps, 1)
+		assert.Len(t, actual.Recipe.Steps[0].Ingredients, 2)
+		assert.Len(t, actual.UnresolvedTokens, 4)
+
+		// repeated text is only searched for once.
+		ingredientDataManager.AssertNumberOfCalls(t, "SearchForValidIngredients", 1)
+		mock.AssertExpectationsForObjects(t, ingredientDataManager, measurementUnitDataManager)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		i := buildTestImporter(&mocktypes.ValidIngredientDataManagerMock{}, &mocktypes.ValidMeasurementUnitDataManagerMock{}, &mocktypes.ValidPreparationDataManagerMock{})
+
+		actual, err := i.ImportRecipe(context.Background(), nil)
+		assert.ErrorIs(t, err, ErrNilInput)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid document", func(t *testing.T) {
+		t.Parallel()
+
+		i := buildTestImporter(&mocktypes.ValidIngredientDataManagerMock{}, &mocktypes.ValidMeasurementUnitDataManagerMock{}, &mocktypes.ValidPreparationDataManagerMock{})
+
+		actual, err := i.ImportRecipe(context.Background(), &types.RecipeImportRequestInput{Document: `{"@type": "WebSite"}`})
+		assert.ErrorIs(t, err, ErrNoRecipeFound)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with error searching ingredients", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		ingredientDataManager := &mocktypes.ValidIngredientDataManagerMock{}
+		ingredientDataManager.On("SearchForValidIngredients", testutils.ContextMatcher, "flour", mock.AnythingOfType("*types.QueryFilter")).
+			Return((*types.QueryFilteredResult[types.ValidIngredient])(nil), errors.New("blah"))
+
+		i := buildTestImporter(ingredientDataManager, &mocktypes.ValidMeasurementUnitDataManagerMock{}, &mocktypes.ValidPreparationDataManagerMock{})
+
+		actual, err := i.ImportRecipe(ctx, &types.RecipeImportRequestInput{IngredientLines: []string{"flour"}})
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, ingredientDataManager)
+	})
+}
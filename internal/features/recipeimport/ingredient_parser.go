@@ -0,0 +1,284 @@
+package recipeimport
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	unicodeFractions = map[rune]string{
+		'½': "1/2",
+		'⅓': "1/3",
+		'⅔': "2/3",
+		'¼': "1/4",
+		'¾': "3/4",
+		'⅕': "1/5",
+		'⅛': "1/8",
+		'⅜': "3/8",
+		'⅝': "5/8",
+		'⅞': "7/8",
+	}
+
+	// measurementUnitAliases maps the ways recipes abbreviate or pluralize a measurement unit to the unit's name.
+	measurementUnitAliases = map[string]string{
+		"c":           "cup",
+		"cup":         "cup",
+		"cups":        "cup",
+		"tbs":         "tablespoon",
+		"tbsp":        "tablespoon",
+		"tablespoon":  "tablespoon",
+		"tablespoons": "tablespoon",
+		"tsp":         "teaspoon",
+		"teaspoon":    "teaspoon",
+		"teaspoons":   "teaspoon",
+		"oz":          "ounce",
+		"ounce":       "ounce",
+		"ounces":      "ounce",
+		"lb":          "pound",
+		"lbs":         "pound",
+		"pound":       "pound",
+		"pounds":      "pound",
+		"g":           "gram",
+		"gram":        "gram",
+		"grams":       "gram",
+		"kg":          "kilogram",
+		"kilogram":    "kilogram",
+		"kilograms":   "kilogram",
+		"ml":          "milliliter",
+		"milliliter":  "milliliter",
+		"milliliters": "milliliter",
+		"millilitre":  "milliliter",
+		"millilitres": "milliliter",
+		"l":           "liter",
+		"liter":       "liter",
+		"liters":      "liter",
+		"litre":       "liter",
+		"litres":      "liter",
+		"pt":          "pint",
+		"pint":        "pint",
+		"pints":       "pint",
+		"qt":          "quart",
+		"quart":       "quart",
+		"quarts":      "quart",
+		"gal":         "gallon",
+		"gallon":      "gallon",
+		"gallons":     "gallon",
+		"pinch":       "pinch",
+		"pinches":     "pinch",
+		"dash":        "dash",
+		"dashes":      "dash",
+		"clove":       "clove",
+		"cloves":      "clove",
+		"can":         "can",
+		"cans":        "can",
+		"slice":       "slice",
+		"slices":      "slice",
+		"stick":       "stick",
+		"sticks":      "stick",
+		"sprig":       "sprig",
+		"sprigs":      "sprig",
+		"bunch":       "bunch",
+		"bunches":     "bunch",
+		"head":        "head",
+		"heads":       "head",
+		"piece":       "piece",
+		"pieces":      "piece",
+	}
+
+	// ingredientQualifiers are past-tense words that name a kind of ingredient rather than something to do to it.
+	ingredientQualifiers = map[string]bool{
+		"canned":      true,
+		"condensed":   true,
+		"cured":       true,
+		"dried":       true,
+		"evaporated":  true,
+		"granulated":  true,
+		"pickled":     true,
+		"powdered":    true,
+		"roasted":     true,
+		"salted":      true,
+		"smoked":      true,
+		"toasted":     true,
+		"unsalted":    true,
+		"unsweetened": true,
+	}
+
+	numericRangeRegex  = regexp.MustCompile(`(\d)\s*-\s*(\d)`)
+	gluedUnitRegex     = regexp.MustCompile(`(\d)([a-z])`)
+	parentheticalRegex = regexp.MustCompile(`\(([^)]*)\)`)
+)
+
+// ParseIngredientLine breaks a free-text ingredient line like "2 1/2 cups diced onion" into its quantity, measurement unit,
+// preparations and ingredient name. Nothing is resolved against valid data here; see Importer for that.
+func ParseIngredientLine(line string) *types.ParsedIngredientLine {
+	parsed := &types.ParsedIngredientLine{
+		Line:           line,
+		Preparations:   []string{},
+		PreparationIDs: []string{},
+	}
+
+	text := normalizeIngredientLine(line)
+	notes := []string{}
+
+	for _, match := range parentheticalRegex.FindAllStringSubmatch(text, -1) {
+		if note := strings.TrimSpace(match[1]); note == "optional" {
+			parsed.Optional = true
+		} else if note != "" {
+			notes = append(notes, note)
+		}
+	}
+	text = parentheticalRegex.ReplaceAllString(text, " ")
+
+	if strings.Contains(text, "to taste") {
+		parsed.ToTaste = true
+		text = strings.ReplaceAll(text, "to taste", " ")
+	}
+
+	main, trailing, _ := strings.Cut(text, ",")
+
+	tokens := parseQuantity(strings.Fields(main), parsed)
+	if len(tokens) > 0 {
+		if unit, ok := measurementUnitAliases[strings.TrimSuffix(tokens[0], ".")]; ok {
+			parsed.MeasurementUnit = unit
+			tokens = tokens[1:]
+		}
+	}
+	if len(tokens) > 0 && tokens[0] == "of" {
+		tokens = tokens[1:]
+	}
+
+	nameTokens := []string{}
+	for i, token := range tokens {
+		switch {
+		case isPreparationWord(token):
+			parsed.Preparations = append(parsed.Preparations, token)
+			notes = append(notes, token)
+		case strings.HasSuffix(token, "ly") && i+1 < len(tokens) && isPreparationWord(tokens[i+1]):
+			// adverbs like "finely" only describe the preparation that follows them.
+			continue
+		default:
+			nameTokens = append(nameTokens, token)
+		}
+	}
+	parsed.Name = strings.Trim(strings.Join(nameTokens, " "), " .;:")
+
+	for _, clause := range strings.Split(trailing, ",") {
+		clause = strings.TrimSpace(clause)
+		if clause == "" {
+			continue
+		}
+
+		if clause == "optional" {
+			parsed.Optional = true
+			continue
+		}
+
+		for _, token := range strings.Fields(clause) {
+			if isPreparationWord(token) {
+				parsed.Preparations = append(parsed.Preparations, token)
+			}
+		}
+		notes = append(notes, clause)
+	}
+	parsed.Notes = strings.Join(notes, ", ")
+
+	return parsed
+}
+
+// normalizeIngredientLine lowercases a line, spells out unicode fractions, and spaces out numeric ranges and units
+// written flush against their quantity ("500g").
+func normalizeIngredientLine(line string) string {
+	var builder strings.Builder
+	for _, r := range strings.ToLower(line) {
+		switch {
+		case unicodeFractions[r] != "":
+			builder.WriteString(" " + unicodeFractions[r] + " ")
+		case r == '–' || r == '—':
+			builder.WriteRune('-')
+		default:
+			builder.WriteRune(r)
+		}
+	}
+
+	normalized := numericRangeRegex.ReplaceAllString(builder.String(), "$1 - $2")
+
+	return gluedUnitRegex.ReplaceAllString(normalized, "$1 $2")
+}
+
+// parseQuantity consumes a leading quantity, or range of quantities, from tokens and returns what's left.
+func parseQuantity(tokens []string, parsed *types.ParsedIngredientLine) []string {
+	minimum, consumed := parseAmount(tokens)
+	if consumed == 0 {
+		return tokens
+	}
+	parsed.MinimumQuantity = minimum
+	tokens = tokens[consumed:]
+
+	if len(tokens) > 1 && (tokens[0] == "-" || tokens[0] == "to" || tokens[0] == "or") {
+		if maximum, n := parseAmount(tokens[1:]); n > 0 {
+			parsed.MaximumQuantity = &maximum
+			tokens = tokens[n+1:]
+		}
+	}
+
+	return tokens
+}
+
+// parseAmount parses a whole number, decimal, fraction or mixed number like "2 1/2" from the start of tokens.
+func parseAmount(tokens []string) (amount float32, consumed int) {
+	if len(tokens) == 0 {
+		return 0, 0
+	}
+
+	value, ok := parseNumber(tokens[0])
+	if !ok {
+		return 0, 0
+	}
+
+	if len(tokens) > 1 && !strings.Contains(tokens[0], "/") && strings.Contains(tokens[1], "/") {
+		if fraction, isFraction := parseNumber(tokens[1]); isFraction && isFiniteQuantity(value+fraction) {
+			return float32(value + fraction), 2
+		}
+	}
+
+	return float32(value), 1
+}
+
+// parseNumber parses a decimal or fraction, rejecting anything that isn't a finite quantity, like "nan", "inf", or "1/0".
+func parseNumber(token string) (float64, bool) {
+	var value float64
+	if numerator, denominator, isFraction := strings.Cut(token, "/"); isFraction {
+		n, err := strconv.ParseFloat(numerator, 64)
+		if err != nil {
+			return 0, false
+		}
+
+		d, err := strconv.ParseFloat(denominator, 64)
+		if err != nil || d == 0 {
+			return 0, false
+		}
+
+		value = n / d
+	} else {
+		var err error
+		if value, err = strconv.ParseFloat(token, 64); err != nil {
+			return 0, false
+		}
+	}
+
+	return value, isFiniteQuantity(value)
+}
+
+// isFiniteQuantity reports whether a value can be stored as a quantity without becoming NaN or infinite.
+func isFiniteQuantity(value float64) bool {
+	return !math.IsNaN(value) && math.Abs(value) <= math.MaxFloat32
+}
+
+// isPreparationWord reports whether a word reads like something done to an ingredient, e.g. "diced" or "melted".
+func isPreparationWord(word string) bool {
+	return len(word) > 3 && strings.HasSuffix(word, "ed") && !strings.HasSuffix(word, "eed") && !ingredientQualifiers[word]
+}
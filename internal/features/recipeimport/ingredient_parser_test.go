@@ -0,0 +1,131 @@
+package recipeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIngredientLine(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("2 1/2 cups diced onion")
+
+		assert.Equal(t, "2 1/2 cups diced onion", actual.Line)
+		assert.Equal(t, float32(2.5), actual.MinimumQuantity)
+		assert.Nil(t, actual.MaximumQuantity)
+		assert.Equal(t, "cup", actual.MeasurementUnit)
+		assert.Equal(t, []string{"diced"}, actual.Preparations)
+		assert.Equal(t, "onion", actual.Name)
+	})
+
+	T.Run("with unicode fraction", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("1½ tsp. kosher salt")
+
+		assert.Equal(t, float32(1.5), actual.MinimumQuantity)
+		assert.Equal(t, "teaspoon", actual.MeasurementUnit)
+		assert.Equal(t, "kosher salt", actual.Name)
+	})
+
+	T.Run("with unit written flush against quantity", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("500g flour")
+
+		assert.Equal(t, float32(500), actual.MinimumQuantity)
+		assert.Equal(t, "gram", actual.MeasurementUnit)
+		assert.Equal(t, "flour", actual.Name)
+	})
+
+	T.Run("with range", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("2-3 lbs chicken thighs")
+
+		assert.Equal(t, float32(2), actual.MinimumQuantity)
+		require.NotNil(t, actual.MaximumQuantity)
+		assert.Equal(t, float32(3), *actual.MaximumQuantity)
+		assert.Equal(t, "pound", actual.MeasurementUnit)
+		assert.Equal(t, "chicken thighs", actual.Name)
+	})
+
+	T.Run("with worded range", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("1 to 2 tablespoons of olive oil")
+
+		assert.Equal(t, float32(1), actual.MinimumQuantity)
+		require.NotNil(t, actual.MaximumQuantity)
+		assert.Equal(t, float32(2), *actual.MaximumQuantity)
+		assert.Equal(t, "tablespoon", actual.MeasurementUnit)
+		assert.Equal(t, "olive oil", actual.Name)
+	})
+
+	T.Run("with trailing preparation", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("1 large yellow onion, finely chopped")
+
+		assert.Equal(t, float32(1), actual.MinimumQuantity)
+		assert.Empty(t, actual.MeasurementUnit)
+		assert.Equal(t, "large yellow onion", actual.Name)
+		assert.Equal(t, []string{"chopped"}, actual.Preparations)
+		assert.Equal(t, "finely chopped", actual.Notes)
+	})
+
+	T.Run("with parenthetical", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("1 (14 oz) can crushed tomatoes")
+
+		assert.Equal(t, "can", actual.MeasurementUnit)
+		assert.Equal(t, "tomatoes", actual.Name)
+		assert.Equal(t, []string{"crushed"}, actual.Preparations)
+		assert.Equal(t, "14 oz, crushed", actual.Notes)
+	})
+
+	T.Run("keeps qualifiers in the name", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("1 tsp dried oregano")
+
+		assert.Equal(t, "dried oregano", actual.Name)
+		assert.Empty(t, actual.Preparations)
+	})
+
+	T.Run("to taste and optional", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("black pepper to taste (optional)")
+
+		assert.True(t, actual.ToTaste)
+		assert.True(t, actual.Optional)
+		assert.Zero(t, actual.MinimumQuantity)
+		assert.Equal(t, "black pepper", actual.Name)
+	})
+	T.Run("with non-finite quantities", func(t *testing.T) {
+		t.Parallel()
+
+		for _, line := range []string{"nan cups flour", "inf cups flour", "-Infinity cups flour", "1/0 cups flour", "1000000000000000000000000000000000000000 cups flour"} {
+			actual := ParseIngredientLine(line)
+
+			assert.Zero(t, actual.MinimumQuantity, line)
+			assert.Nil(t, actual.MaximumQuantity, line)
+		}
+	})
+
+	T.Run("with non-finite maximum quantity", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("2 - nan cups flour")
+
+		assert.Equal(t, float32(2), actual.MinimumQuantity)
+		assert.Nil(t, actual.MaximumQuantity)
+	})
+}
@@ -0,0 +1,149 @@
+package recipeimport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidDocument indicates a document wasn't valid JSON-LD.
+	ErrInvalidDocument = errors.New("invalid JSON-LD document")
+	// ErrNoRecipeFound indicates a document held no schema.org Recipe.
+	ErrNoRecipeFound = errors.New("no schema.org Recipe found in document")
+)
+
+// schemaRecipe is the subset of a schema.org Recipe we know how to import.
+type schemaRecipe struct {
+	RecipeYield        any    `json:"recipeYield"`
+	RecipeIngredient   any    `json:"recipeIngredient"`
+	Ingredients        any    `json:"ingredients"`
+	RecipeInstructions any    `json:"recipeInstructions"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	URL                string `json:"url"`
+}
+
+// parseRecipeDocument finds the first schema.org Recipe in a JSON-LD document. Recipes may be the document itself,
+// an element of a top-level array, or an entry in an @graph.
+func parseRecipeDocument(document []byte) (*schemaRecipe, error) {
+	var raw any
+	if err := json.Unmarshal(document, &raw); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	found := findRecipeNode(raw)
+	if found == nil {
+		return nil, ErrNoRecipeFound
+	}
+
+	// round-tripping the node is less error-prone than plucking fields out of a map by hand.
+	encoded, err := json.Marshal(found)
+	if err != nil {
+		return nil, err
+	}
+
+	var recipe *schemaRecipe
+	if err = json.Unmarshal(encoded, &recipe); err != nil {
+		return nil, err
+	}
+
+	return recipe, nil
+}
+
+func findRecipeNode(node any) map[string]any {
+	switch x := node.(type) {
+	case []any:
+		for _, element := range x {
+			if found := findRecipeNode(element); found != nil {
+				return found
+			}
+		}
+	case map[string]any:
+		if isRecipeType(x["@type"]) {
+			return x
+		}
+
+		if graph, ok := x["@graph"]; ok {
+			return findRecipeNode(graph)
+		}
+	}
+
+	return nil
+}
+
+func isRecipeType(t any) bool {
+	switch x := t.(type) {
+	case string:
+		return x == "Recipe" || strings.HasSuffix(x, "/Recipe") || strings.HasSuffix(x, ":Recipe")
+	case []any:
+		for _, element := range x {
+			if isRecipeType(element) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ingredientLines returns the recipe's ingredients, falling back to the deprecated "ingredients" property.
+func (r *schemaRecipe) ingredientLines() []string {
+	if lines := flattenText(r.RecipeIngredient); len(lines) > 0 {
+		return lines
+	}
+
+	return flattenText(r.Ingredients)
+}
+
+// instructions returns the recipe's instructions as a flat list, one entry per step.
+func (r *schemaRecipe) instructions() []string {
+	return flattenText(r.RecipeInstructions)
+}
+
+// yield returns the first number in the recipe's yield, which is usually the serving count.
+func (r *schemaRecipe) yield() (float32, bool) {
+	for _, candidate := range flattenText(r.RecipeYield) {
+		for _, token := range strings.Fields(candidate) {
+			if value, ok := parseNumber(token); ok && value > 0 {
+				return float32(value), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// flattenText reduces the many shapes schema.org allows for text lists (strings, arrays, HowToSteps and
+// HowToSections) to a list of trimmed, non-empty strings.
+func flattenText(node any) []string {
+	output := []string{}
+
+	switch x := node.(type) {
+	case string:
+		for _, line := range strings.Split(html.UnescapeString(x), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				output = append(output, line)
+			}
+		}
+	case float64:
+		output = append(output, strconv.FormatFloat(x, 'f', -1, 64))
+	case []any:
+		for _, element := range x {
+			output = append(output, flattenText(element)...)
+		}
+	case map[string]any:
+		if items, ok := x["itemListElement"]; ok {
+			output = append(output, flattenText(items)...)
+		} else if text, ok := x["text"]; ok {
+			output = append(output, flattenText(text)...)
+		} else if name, ok := x["name"]; ok {
+			output = append(output, flattenText(name)...)
+		}
+	}
+
+	return output
+}
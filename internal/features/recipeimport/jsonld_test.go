@@ -0,0 +1,83 @@
+package recipeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleRecipeDocument = `{
+	"@context": "https://schema.org",
+	"@graph": [
+		{"@type": "WebSite", "name": "Example Kitchen"},
+		{
+			"@type": ["Recipe", "NewsArticle"],
+			"name": "Weeknight Soup",
+			"description": "Soup, but fast &amp; easy.",
+			"url": "https://example.com/soup",
+			"recipeYield": ["4", "4 servings"],
+			"recipeIngredient": ["2 1/2 cups diced onion", "4 cups stock"],
+			"recipeInstructions": [
+				{
+					"@type": "HowToSection",
+					"name": "Soup",
+					"itemListElement": [
+						{"@type": "HowToStep", "text": "Sauté the onion."},
+						{"@type": "HowToStep", "text": "Simmer with the stock."}
+					]
+				}
+			]
+		}
+	]
+}`
+
+func TestParseRecipeDocument(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parseRecipeDocument([]byte(exampleRecipeDocument))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Weeknight Soup", actual.Name)
+		assert.Equal(t, "https://example.com/soup", actual.URL)
+		assert.Equal(t, []string{"2 1/2 cups diced onion", "4 cups stock"}, actual.ingredientLines())
+		assert.Equal(t, []string{"Sauté the onion.", "Simmer with the stock."}, actual.instructions())
+
+		portions, ok := actual.yield()
+		assert.True(t, ok)
+		assert.Equal(t, float32(4), portions)
+	})
+
+	T.Run("with bare recipe and text instructions", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parseRecipeDocument([]byte(`{"@type": "Recipe", "ingredients": "1 egg", "recipeInstructions": "Crack the egg.\nFry it.", "recipeYield": 2}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"1 egg"}, actual.ingredientLines())
+		assert.Equal(t, []string{"Crack the egg.", "Fry it."}, actual.instructions())
+
+		portions, ok := actual.yield()
+		assert.True(t, ok)
+		assert.Equal(t, float32(2), portions)
+	})
+
+	T.Run("without recipe", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parseRecipeDocument([]byte(`[{"@type": "WebSite"}]`))
+		assert.ErrorIs(t, err, ErrNoRecipeFound)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid JSON", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parseRecipeDocument([]byte(`{`))
+		assert.ErrorIs(t, err, ErrInvalidDocument)
+		assert.Nil(t, actual)
+	})
+}
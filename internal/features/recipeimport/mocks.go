@@ -0,0 +1,23 @@
+package recipeimport
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Importer = (*MockImporter)(nil)
+
+// MockImporter is a mock Importer.
+type MockImporter struct {
+	mock.Mock
+}
+
+// ImportRecipe is a mock function.
+func (m *MockImporter) ImportRecipe(ctx context.Context, input *types.RecipeImportRequestInput) (*types.RecipeImportDraft, error) {
+	returnValues := m.Called(ctx, input)
+
+	return returnValues.Get(0).(*types.RecipeImportDraft), returnValues.Error(1)
+}
@@ -0,0 +1,23 @@
+package recipescaling
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ RecipeScaler = (*MockRecipeScaler)(nil)
+
+// MockRecipeScaler is a mock RecipeScaler.
+type MockRecipeScaler struct {
+	mock.Mock
+}
+
+// ScaleRecipe is a mock function.
+func (m *MockRecipeScaler) ScaleRecipe(ctx context.Context, recipe *types.Recipe, portions float32) (*types.Recipe, error) {
+	returnValues := m.Called(ctx, recipe, portions)
+
+	return returnValues.Get(0).(*types.Recipe), returnValues.Error(1)
+}
@@ -0,0 +1,239 @@
+package recipescaling
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrNilRecipe indicates a nil recipe was provided.
+	ErrNilRecipe = errors.New("nil recipe provided")
+	// ErrInvalidPortions indicates the requested portion count is not usable.
+	ErrInvalidPortions = errors.New("portions must be a finite number greater than zero")
+	// ErrRecipeHasNoPortionEstimate indicates the recipe has no portion estimate to scale from.
+	ErrRecipeHasNoPortionEstimate = errors.New("recipe has no minimum estimated portions")
+)
+
+// RecipeScaler scales recipes to a desired number of portions.
+type RecipeScaler interface {
+	ScaleRecipe(ctx context.Context, recipe *types.Recipe, portions float32) (*types.Recipe, error)
+}
+
+var _ RecipeScaler = (*recipeScaler)(nil)
+
+type recipeScaler struct {
+	logger       logging.Logger
+	tracer       tracing.Tracer
+	graphBuilder unitconversion.GraphBuilder
+}
+
+// NewRecipeScaler creates a RecipeScaler.
+func NewRecipeScaler(logger logging.Logger, tracerProvider tracing.TracerProvider, graphBuilder unitconversion.GraphBuilder) RecipeScaler {
+	return &recipeScaler{
+		logger:       logging.EnsureLogger(logger).WithName("recipe_scaler"),
+		tracer:       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("recipe_scaler")),
+		graphBuilder: graphBuilder,
+	}
+}
+
+// ScaleRecipe returns a copy of the provided recipe with every quantity scaled from its
+// minimum estimated portions to the requested portions. Where the conversion table allows,
+// scaled quantities are expressed in a more sensible unit (i.e. 48 teaspoons becomes 1 cup).
+func (s *recipeScaler) ScaleRecipe(ctx context.Context, recipe *types.Recipe, portions float32) (*types.Recipe, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if recipe == nil {
+		return nil, ErrNilRecipe
+	}
+
+	logger := s.logger.WithValue(keys.RecipeIDKey, recipe.ID).WithValue("portions", portions)
+
+	if portions <= 0 || math.IsNaN(float64(portions)) || math.IsInf(float64(portions), 0) {
+		return nil, ErrInvalidPortions
+	}
+
+	if recipe.MinimumEstimatedPortions <= 0 {
+		return nil, ErrRecipeHasNoPortionEstimate
+	}
+
+	ratio := decimal.NewFromFloat32(portions).Div(decimal.NewFromFloat32(recipe.MinimumEstimatedPortions))
+
+	g, err := s.graphBuilder.BuildGraph(ctx, measurementUnitIDsForRecipe(recipe)...)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building measurement unit conversion graph")
+	}
+
+	return scaleRecipe(recipe, ratio, g), nil
+}
+
+func measurementUnitIDsForRecipe(recipe *types.Recipe) []string {
+	ids := []string{}
+	for _, step := range recipe.Steps {
+		for _, ingredient := range step.Ingredients {
+			ids = append(ids, ingredient.MeasurementUnit.ID)
+		}
+
+		for _, product := range step.Products {
+			if product.MeasurementUnit != nil {
+				ids = append(ids, product.MeasurementUnit.ID)
+			}
+		}
+	}
+
+	for _, supportingRecipe := range recipe.SupportingRecipes {
+		ids = append(ids, measurementUnitIDsForRecipe(supportingRecipe)...)
+	}
+
+	return ids
+}
+
+// scaleRecipe copies a recipe, scaling every quantity in the copy by the provided ratio.
+func scaleRecipe(recipe *types.Recipe, ratio decimal.Decimal, g *unitconversion.Graph) *types.Recipe {
+	scaled := *recipe
+	scaled.MinimumEstimatedPortions = float32(decimal.NewFromFloat32(recipe.MinimumEstimatedPortions).Mul(ratio).Round(2).InexactFloat64())
+	if recipe.MaximumEstimatedPortions != nil {
+		maxPortions := float32(decimal.NewFromFloat32(*recipe.MaximumEstimatedPortions).Mul(ratio).Round(2).InexactFloat64())
+		scaled.MaximumEstimatedPortions = &maxPortions
+	}
+
+	scaled.Steps = make([]*types.RecipeStep, len(recipe.Steps))
+	for i, step := range recipe.Steps {
+		scaledStep := *step
+
+		scaledStep.Ingredients = make([]*types.RecipeStepIngredient, len(step.Ingredients))
+		for j, ingredient := range step.Ingredients {
+			scaledIngredient := *ingredient
+
+			unit, minQty, maxQty := scaleQuantity(g, ratio, ingredient.MeasurementUnit, decimal.NewFromFloat32(ingredient.MinimumQuantity), ingredient.MaximumQuantity)
+			scaledIngredient.MeasurementUnit = unit
+			scaledIngredient.MinimumQuantity = minQty
+			scaledIngredient.MaximumQuantity = maxQty
+
+			scaledStep.Ingredients[j] = &scaledIngredient
+		}
+
+		scaledStep.Products = make([]*types.RecipeStepProduct, len(step.Products))
+		for j, product := range step.Products {
+			scaledProduct := *product
+
+			if product.MeasurementUnit != nil && product.MinimumQuantity != nil {
+				unit, minQty, maxQty := scaleQuantity(g, ratio, *product.MeasurementUnit, decimal.NewFromFloat32(*product.MinimumQuantity), product.MaximumQuantity)
+				scaledProduct.MeasurementUnit = &unit
+				scaledProduct.MinimumQuantity = &minQty
+				scaledProduct.MaximumQuantity = maxQty
+			}
+
+			scaledStep.Products[j] = &scaledProduct
+		}
+
+		scaled.Steps[i] = &scaledStep
+	}
+
+	scaled.SupportingRecipes = make([]*types.Recipe, len(recipe.SupportingRecipes))
+	for i, supportingRecipe := range recipe.SupportingRecipes {
+		scaled.SupportingRecipes[i] = scaleRecipe(supportingRecipe, ratio, g)
+	}
+
+	return &scaled
+}
+
+// scaleQuantity scales a min/max quantity pair, then expresses both in the most sensible unit for the scaled minimum.
+func scaleQuantity(g *unitconversion.Graph, ratio decimal.Decimal, unit types.ValidMeasurementUnit, minimum decimal.Decimal, maximum *float32) (types.ValidMeasurementUnit, float32, *float32) {
+	scaledMin := minimum.Mul(ratio)
+	bestUnit, factor := preferredUnit(g, unit, scaledMin)
+
+	// quantities are left alone unless we actually changed something.
+	shouldRound := !ratio.Equal(decimal.NewFromInt(1)) || bestUnit.ID != unit.ID
+	finalize := func(x decimal.Decimal) float32 {
+		x = x.Mul(factor)
+		if shouldRound {
+			x = roundQuantity(x)
+		}
+
+		return float32(x.InexactFloat64())
+	}
+
+	var scaledMax *float32
+	if maximum != nil {
+		x := finalize(decimal.NewFromFloat32(*maximum).Mul(ratio))
+		scaledMax = &x
+	}
+
+	return bestUnit, finalize(scaledMin), scaledMax
+}
+
+// preferredUnit picks the largest unit compatible with the provided one in which the quantity is still at least one.
+// Candidate units must be measured the same way (volume vs. weight) and belong to the same measurement system.
+func preferredUnit(g *unitconversion.Graph, unit types.ValidMeasurementUnit, quantity decimal.Decimal) (types.ValidMeasurementUnit, decimal.Decimal) {
+	bestUnit, bestFactor := unit, decimal.NewFromInt(1)
+	if unit.Universal {
+		return bestUnit, bestFactor
+	}
+
+	one := decimal.NewFromInt(1)
+	bestQuantity := quantity
+
+	for candidateID, factor := range g.ReachableUnits(unit.ID, "") {
+		candidate, ok := g.Unit(candidateID)
+		if !ok || candidate.ID == unit.ID || !unitsAreCompatible(unit, candidate) {
+			continue
+		}
+
+		// reverse conversions are stored as repeating decimals, so 48 teaspoons might otherwise come out as 0.99999 cups.
+		converted := quantity.Mul(factor).Round(6)
+		if converted.GreaterThanOrEqual(one) && (bestQuantity.LessThan(one) || converted.LessThan(bestQuantity)) {
+			bestUnit, bestFactor, bestQuantity = candidate, factor, converted
+		}
+	}
+
+	return bestUnit, bestFactor
+}
+
+func unitsAreCompatible(a, b types.ValidMeasurementUnit) bool {
+	if b.Universal || a.Volumetric != b.Volumetric {
+		return false
+	}
+
+	return (a.Metric && b.Metric) || (a.Imperial && b.Imperial)
+}
+
+var (
+	eighth     = decimal.NewFromFloat(0.125)
+	quarter    = decimal.NewFromFloat(0.25)
+	five       = decimal.NewFromInt(5)
+	ten        = decimal.NewFromInt(10)
+	oneHundred = decimal.NewFromInt(100)
+)
+
+// roundQuantity rounds a quantity to a precision a cook can actually measure:
+// eighths below 1, quarters below 10, whole numbers below 100, and multiples of 5 above that.
+// Very small quantities (i.e. a pinch of saffron) are rounded to three decimal places instead.
+func roundQuantity(x decimal.Decimal) decimal.Decimal {
+	switch {
+	case x.LessThan(eighth):
+		return x.Round(3)
+	case x.LessThan(decimal.NewFromInt(1)):
+		return roundToNearest(x, eighth)
+	case x.LessThan(ten):
+		return roundToNearest(x, quarter)
+	case x.LessThan(oneHundred):
+		return x.Round(0)
+	default:
+		return roundToNearest(x, five)
+	}
+}
+
+func roundToNearest(x, increment decimal.Decimal) decimal.Decimal {
+	return x.Div(increment).Round(0).Mul(increment)
+}
@@ -0,0 +1,293 @@
+package recipescaling
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildUnitForTest(volumetric, metric, imperial bool) *types.ValidMeasurementUnit {
+	unit := fakes.BuildFakeValidMeasurementUnit()
+	unit.Volumetric = volumetric
+	unit.Metric = metric
+	unit.Imperial = imperial
+	unit.Universal = false
+
+	return unit
+}
+
+func buildConversionForTest(from, to *types.ValidMeasurementUnit, modifier float32) *types.ValidMeasurementUnitConversion {
+	conversion := fakes.BuildFakeValidMeasurementUnitConversion()
+	conversion.From = *from
+	conversion.To = *to
+	conversion.Modifier = modifier
+	conversion.OnlyForIngredient = nil
+
+	return conversion
+}
+
+func TestRecipeScaler_ScaleRecipe(T *testing.T) {
+	T.Parallel()
+
+	teaspoon := buildUnitForTest(true, false, true)
+	tablespoon := buildUnitForTest(true, false, true)
+	cup := buildUnitForTest(true, false, true)
+	gram := buildUnitForTest(false, true, false)
+	kilogram := buildUnitForTest(false, true, false)
+	clove := fakes.BuildFakeValidMeasurementUnit()
+	clove.Universal = true
+
+	conversions := unitconversion.NewGraph(
+		buildConversionForTest(tablespoon, teaspoon, 3),
+		buildConversionForTest(cup, tablespoon, 16),
+		buildConversionForTest(kilogram, gram, 1000),
+		// a cross-system conversion that should never be used for promotion.
+		buildConversionForTest(cup, gram, 240),
+	)
+
+	buildRecipe := func() *types.Recipe {
+		return &types.Recipe{
+			ID:                       fakes.BuildFakeID(),
+			MinimumEstimatedPortions: 2,
+			MaximumEstimatedPortions: pointer.To(float32(3)),
+			Steps: []*types.RecipeStep{
+				{
+					Ingredients: []*types.RecipeStepIngredient{
+						{
+							MeasurementUnit: *teaspoon,
+							MinimumQuantity: 6,
+							MaximumQuantity: pointer.To(float32(8)),
+						},
+						{
+							MeasurementUnit: *gram,
+							MinimumQuantity: 400,
+						},
+						{
+							MeasurementUnit: *clove,
+							MinimumQuantity: 3,
+						},
+					},
+					Products: []*types.RecipeStepProduct{
+						{
+							MeasurementUnit: cup,
+							MinimumQuantity: pointer.To(float32(0.25)),
+						},
+						{
+							MeasurementUnit: nil,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe()
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{teaspoon.ID, gram.ID, clove.ID, cup.ID}).Return(conversions, nil)
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder)
+
+		actual, err := s.ScaleRecipe(ctx, recipe, 16)
+		require.NoError(t, err)
+
+		assert.Equal(t, float32(16), actual.MinimumEstimatedPortions)
+		assert.Equal(t, float32(24), *actual.MaximumEstimatedPortions)
+
+		// 6 tsp * 8 = 48 tsp = 1 cup.
+		assert.Equal(t, cup.ID, actual.Steps[0].Ingredients[0].MeasurementUnit.ID)
+		assert.Equal(t, float32(1), actual.Steps[0].Ingredients[0].MinimumQuantity)
+		assert.Equal(t, float32(1.25), *actual.Steps[0].Ingredients[0].MaximumQuantity)
+
+		// 400 g * 8 = 3.2 kg.
+		assert.Equal(t, kilogram.ID, actual.Steps[0].Ingredients[1].MeasurementUnit.ID)
+		assert.Equal(t, float32(3.25), actual.Steps[0].Ingredients[1].MinimumQuantity)
+
+		// universal units are scaled but never converted.
+		assert.Equal(t, clove.ID, actual.Steps[0].Ingredients[2].MeasurementUnit.ID)
+		assert.Equal(t, float32(24), actual.Steps[0].Ingredients[2].MinimumQuantity)
+
+		assert.Equal(t, cup.ID, actual.Steps[0].Products[0].MeasurementUnit.ID)
+		assert.Equal(t, float32(2), *actual.Steps[0].Products[0].MinimumQuantity)
+		assert.Nil(t, actual.Steps[0].Products[1].MinimumQuantity)
+
+		// the original recipe is untouched.
+		assert.Equal(t, buildRecipe().Steps[0].Ingredients[0].MinimumQuantity, recipe.Steps[0].Ingredients[0].MinimumQuantity)
+		assert.Equal(t, teaspoon.ID, recipe.Steps[0].Ingredients[0].MeasurementUnit.ID)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
+
+	T.Run("demotes units when scaling down", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := &types.Recipe{
+			MinimumEstimatedPortions: 4,
+			Steps: []*types.RecipeStep{
+				{
+					Ingredients: []*types.RecipeStepIngredient{
+						{
+							MeasurementUnit: *cup,
+							MinimumQuantity: 0.25,
+						},
+					},
+				},
+			},
+		}
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{cup.ID}).Return(conversions, nil)
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder)
+
+		actual, err := s.ScaleRecipe(ctx, recipe, 1)
+		require.NoError(t, err)
+
+		// a quarter of a quarter cup is a tablespoon.
+		assert.Equal(t, tablespoon.ID, actual.Steps[0].Ingredients[0].MeasurementUnit.ID)
+		assert.Equal(t, float32(1), actual.Steps[0].Ingredients[0].MinimumQuantity)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
+
+	T.Run("with supporting recipes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := &types.Recipe{
+			MinimumEstimatedPortions: 1,
+			SupportingRecipes: []*types.Recipe{
+				{
+					MinimumEstimatedPortions: 1,
+					Steps: []*types.RecipeStep{
+						{
+							Ingredients: []*types.RecipeStepIngredient{
+								{
+									MeasurementUnit: *clove,
+									MinimumQuantity: 1,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{clove.ID}).Return(conversions, nil)
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder)
+
+		actual, err := s.ScaleRecipe(ctx, recipe, 3)
+		require.NoError(t, err)
+
+		assert.Equal(t, float32(3), actual.SupportingRecipes[0].Steps[0].Ingredients[0].MinimumQuantity)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
+
+	T.Run("with nil recipe", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &unitconversion.MockGraphBuilder{})
+
+		actual, err := s.ScaleRecipe(context.Background(), nil, 2)
+		assert.ErrorIs(t, err, ErrNilRecipe)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid portions", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &unitconversion.MockGraphBuilder{})
+
+		actual, err := s.ScaleRecipe(context.Background(), buildRecipe(), 0)
+		assert.ErrorIs(t, err, ErrInvalidPortions)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with non-finite portions", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &unitconversion.MockGraphBuilder{})
+
+		for _, portions := range []float32{float32(math.NaN()), float32(math.Inf(1))} {
+			actual, err := s.ScaleRecipe(context.Background(), buildRecipe(), portions)
+			assert.ErrorIs(t, err, ErrInvalidPortions)
+			assert.Nil(t, actual)
+		}
+	})
+
+	T.Run("with recipe without portion estimate", func(t *testing.T) {
+		t.Parallel()
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &unitconversion.MockGraphBuilder{})
+
+		recipe := buildRecipe()
+		recipe.MinimumEstimatedPortions = 0
+
+		actual, err := s.ScaleRecipe(context.Background(), recipe, 2)
+		assert.ErrorIs(t, err, ErrRecipeHasNoPortionEstimate)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with error building graph", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		recipe := buildRecipe()
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{teaspoon.ID, gram.ID, clove.ID, cup.ID}).Return((*unitconversion.Graph)(nil), errors.New("blah"))
+
+		s := NewRecipeScaler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), graphBuilder)
+
+		actual, err := s.ScaleRecipe(ctx, recipe, 4)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, graphBuilder)
+	})
+}
+
+func Test_roundQuantity(T *testing.T) {
+	T.Parallel()
+
+	testCases := map[string]struct {
+		input    float64
+		expected string
+	}{
+		"tiny":            {input: 0.0123, expected: "0.012"},
+		"below one":       {input: 0.3, expected: "0.25"},
+		"below ten":       {input: 1.67, expected: "1.75"},
+		"below a hundred": {input: 12.5, expected: "13"},
+		"large":           {input: 312, expected: "310"},
+	}
+
+	for name, tc := range testCases {
+		T.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, roundQuantity(decimal.NewFromFloat(tc.input)).String())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package recommendations
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Recommender = (*MockRecommender)(nil)
+
+// MockRecommender is a mock Recommender.
+type MockRecommender struct {
+	mock.Mock
+}
+
+// RecommendRecipes is a mock function.
+func (m *MockRecommender) RecommendRecipes(ctx context.Context, householdID string, count int) ([]*types.RecipeRecommendation, error) {
+	returnValues := m.Called(ctx, householdID, count)
+
+	return returnValues.Get(0).([]*types.RecipeRecommendation), returnValues.Error(1)
+}
+
+// RecommendMeals is a mock function.
+func (m *MockRecommender) RecommendMeals(ctx context.Context, householdID string, count int) ([]*types.MealRecommendation, error) {
+	returnValues := m.Called(ctx, householdID, count)
+
+	return returnValues.Get(0).([]*types.MealRecommendation), returnValues.Error(1)
+}
+
+// RecommendMealsForEvent is a mock function.
+func (m *MockRecommender) RecommendMealsForEvent(ctx context.Context, householdID, mealName string, count int) ([]*types.MealRecommendation, error) {
+	returnValues := m.Called(ctx, householdID, mealName, count)
+
+	return returnValues.Get(0).([]*types.MealRecommendation), returnValues.Error(1)
+}
@@ -0,0 +1,617 @@
+package recommendations
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/features/dietaryconflicts"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	// maximumCandidates is how many recipes or meals are considered for a single set of recommendations.
+	maximumCandidates = 1000
+	// maximumEventCandidates is how many meals are considered when suggesting options for a meal plan event, which
+	// happens while the event is being created.
+	maximumEventCandidates = 100
+	// maximumRecipeRating is the top of the scale recipe ratings are given on.
+	maximumRecipeRating = 5
+	// maximumIngredientPreferenceRating is the top of the scale ingredient preferences are given on.
+	maximumIngredientPreferenceRating = 10
+	// maximumFamiliarChoices is how many past choices it takes for a recipe to be considered completely familiar.
+	maximumFamiliarChoices = 5
+
+	// historyWindow is how far back meal plans are considered when looking for past choices.
+	historyWindow = 90 * 24 * time.Hour
+	// recentlyChosenWindow is how recently a recipe must have been chosen to be considered repetitive.
+	recentlyChosenWindow = 14 * 24 * time.Hour
+
+	ingredientPreferenceWeight = 3
+	recipeRatingWeight         = 4
+	instrumentWeight           = 2
+	familiarityWeight          = 1
+	mealNameWeight             = 2
+	recentlyChosenPenalty      = 2
+
+	// significantSignal is how strong a signal needs to be before it's worth explaining.
+	significantSignal = 0.3
+
+	reasonLikedIngredients     = "household members like its ingredients"
+	reasonDislikedIngredients  = "contains ingredients household members dislike"
+	reasonRatedHighly          = "household members rated it highly"
+	reasonRatedPoorly          = "household members rated it poorly"
+	reasonHasInstruments       = "the household owns the instruments it needs"
+	reasonMissingInstruments   = "needs instruments the household doesn't own"
+	reasonChosenBefore         = "the household has chosen it before"
+	reasonChosenRecently       = "the household chose it recently"
+	reasonChosenForMeal        = "the household has chosen it for this meal before"
+	reasonContainsAllergen     = "contains an ingredient a household member is allergic to"
+	reasonComponentNotEligible = "a component contains an ingredient a household member is allergic to"
+)
+
+// Recommender ranks recipes and meals for a household.
+type Recommender interface {
+	RecommendRecipes(ctx context.Context, householdID string, count int) ([]*types.RecipeRecommendation, error)
+	RecommendMeals(ctx context.Context, householdID string, count int) ([]*types.MealRecommendation, error)
+	RecommendMealsForEvent(ctx context.Context, householdID, mealName string, count int) ([]*types.MealRecommendation, error)
+}
+
+var _ Recommender = (*recommender)(nil)
+
+type recommender struct {
+	logger                       logging.Logger
+	tracer                       tracing.Tracer
+	householdDataManager         types.HouseholdDataManager
+	recipeDataManager            types.RecipeDataManager
+	mealDataManager              types.MealDataManager
+	mealPlanDataManager          types.MealPlanDataManager
+	recipeRatingDataManager      types.RecipeRatingDataManager
+	ingredientPreferencesManager types.UserIngredientPreferenceDataManager
+	instrumentOwnershipManager   types.HouseholdInstrumentOwnershipDataManager
+	now                          func() time.Time
+}
+
+// NewRecommender creates a Recommender.
+func NewRecommender(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	householdDataManager types.HouseholdDataManager,
+	recipeDataManager types.RecipeDataManager,
+	mealDataManager types.MealDataManager,
+	mealPlanDataManager types.MealPlanDataManager,
+	recipeRatingDataManager types.RecipeRatingDataManager,
+	ingredientPreferencesManager types.UserIngredientPreferenceDataManager,
+	instrumentOwnershipManager types.HouseholdInstrumentOwnershipDataManager,
+) Recommender {
+	return &recommender{
+		logger:                       logging.EnsureLogger(logger).WithName("recommender"),
+		tracer:                       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("recommender")),
+		householdDataManager:         householdDataManager,
+		recipeDataManager:            recipeDataManager,
+		mealDataManager:              mealDataManager,
+		mealPlanDataManager:          mealPlanDataManager,
+		recipeRatingDataManager:      recipeRatingDataManager,
+		ingredientPreferencesManager: ingredientPreferencesManager,
+		instrumentOwnershipManager:   instrumentOwnershipManager,
+		now:                          time.Now,
+	}
+}
+
+// householdSignals is everything we know about a household's tastes.
+type householdSignals struct {
+	now              time.Time
+	preferences      []*types.UserIngredientPreference
+	recipeRatings    map[string][]float32
+	ownedInstruments map[string]bool
+	timesChosen      map[string]int
+	lastChosen       map[string]time.Time
+	// mealTimesChosen counts how often each meal was chosen, by the meal name of the event it was chosen for.
+	mealTimesChosen map[string]map[string]int
+}
+
+// RecommendRecipes ranks the recipes eligible for meals for a household.
+func (r *recommender) RecommendRecipes(ctx context.Context, householdID string, count int) ([]*types.RecipeRecommendation, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	signals, err := r.loadHouseholdSignals(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "loading household signals")
+	}
+
+	recipes, err := r.loadCandidateRecipes(ctx)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "loading candidate recipes")
+	}
+
+	recommendations := []*types.RecipeRecommendation{}
+	for _, recipe := range recipes {
+		score, reasons, eligible := scoreRecipe(recipe, signals)
+		if !eligible {
+			continue
+		}
+
+		recommendations = append(recommendations, &types.RecipeRecommendation{
+			Recipe:  recipe,
+			Score:   score,
+			Reasons: reasons,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+
+	if len(recommendations) > count {
+		recommendations = recommendations[:count]
+	}
+
+	return recommendations, nil
+}
+
+// RecommendMeals ranks the meals eligible for meal plans for a household, scoring each by its component recipes.
+func (r *recommender) RecommendMeals(ctx context.Context, householdID string, count int) ([]*types.MealRecommendation, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	signals, err := r.loadHouseholdSignals(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "loading household signals")
+	}
+
+	meals, err := r.loadCandidateMeals(ctx, nil, maximumCandidates)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "loading candidate meals")
+	}
+
+	return rankMeals(meals, signals, "", count), nil
+}
+
+// RecommendMealsForEvent ranks meals for a meal plan event, favoring the ones a household has chosen for the same
+// meal before. Fewer candidates are considered than for RecommendMeals, and the previously chosen ones come first.
+func (r *recommender) RecommendMealsForEvent(ctx context.Context, householdID, mealName string, count int) ([]*types.MealRecommendation, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	signals, err := r.loadHouseholdSignals(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "loading household signals")
+	}
+
+	meals, err := r.loadCandidateMeals(ctx, mealsChosenFor(signals, mealName), maximumEventCandidates)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "loading candidate meals")
+	}
+
+	return rankMeals(meals, signals, mealName, count), nil
+}
+
+// rankMeals scores meals and returns the best of them. If a meal name is provided, meals the household has chosen
+// for it before score higher.
+func rankMeals(meals []*types.Meal, signals *householdSignals, mealName string, count int) []*types.MealRecommendation {
+	recommendations := []*types.MealRecommendation{}
+	for _, meal := range meals {
+		if !meal.EligibleForMealPlans {
+			continue
+		}
+
+		score, reasons, eligible := scoreMeal(meal, signals)
+		if !eligible {
+			continue
+		}
+
+		if timesChosen := signals.mealTimesChosen[mealName][meal.ID]; mealName != "" && timesChosen > 0 {
+			score += mealNameWeight * float64(min(timesChosen, maximumFamiliarChoices)) / maximumFamiliarChoices
+			reasons = append(reasons, reasonChosenForMeal)
+		}
+
+		recommendations = append(recommendations, &types.MealRecommendation{
+			Meal:    meal,
+			Score:   score,
+			Reasons: reasons,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		return recommendations[i].Score > recommendations[j].Score
+	})
+
+	if len(recommendations) > count {
+		recommendations = recommendations[:count]
+	}
+
+	return recommendations
+}
+
+// mealsChosenFor returns the IDs of the meals a household has chosen for a meal name, most often chosen first.
+func mealsChosenFor(signals *householdSignals, mealName string) []string {
+	timesChosen := signals.mealTimesChosen[mealName]
+
+	ids := []string{}
+	for id := range timesChosen {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if timesChosen[ids[i]] != timesChosen[ids[j]] {
+			return timesChosen[ids[i]] > timesChosen[ids[j]]
+		}
+
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
+
+func (r *recommender) loadHouseholdSignals(ctx context.Context, householdID string) (*householdSignals, error) {
+	household, err := r.householdDataManager.GetHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	signals := &householdSignals{
+		now:              r.now(),
+		preferences:      []*types.UserIngredientPreference{},
+		recipeRatings:    map[string][]float32{},
+		ownedInstruments: map[string]bool{},
+		timesChosen:      map[string]int{},
+		lastChosen:       map[string]time.Time{},
+		mealTimesChosen:  map[string]map[string]int{},
+	}
+
+	for _, member := range household.Members {
+		if member.BelongsToUser == nil {
+			continue
+		}
+
+		var preferences []*types.UserIngredientPreference
+		preferences, err = r.getAllPreferencesForUser(ctx, member.BelongsToUser.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		signals.preferences = append(signals.preferences, preferences...)
+	}
+
+	ratings, err := r.recipeRatingDataManager.GetRecipeRatingsForHousehold(ctx, householdID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rating := range ratings {
+		signals.recipeRatings[rating.RecipeID] = append(signals.recipeRatings[rating.RecipeID], rating.Overall)
+	}
+
+	if err = r.loadOwnedInstruments(ctx, householdID, signals); err != nil {
+		return nil, err
+	}
+
+	if err = r.loadMealPlanHistory(ctx, householdID, signals); err != nil {
+		return nil, err
+	}
+
+	return signals, nil
+}
+
+func (r *recommender) getAllPreferencesForUser(ctx context.Context, userID string) ([]*types.UserIngredientPreference, error) {
+	filter := buildPagingFilter()
+
+	preferences := []*types.UserIngredientPreference{}
+	for {
+		results, err := r.ingredientPreferencesManager.GetUserIngredientPreferences(ctx, userID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		preferences = append(preferences, results.Data...)
+
+		if len(results.Data) < int(*filter.Limit) {
+			return preferences, nil
+		}
+		*filter.Page++
+	}
+}
+
+func (r *recommender) loadOwnedInstruments(ctx context.Context, householdID string, signals *householdSignals) error {
+	filter := buildPagingFilter()
+
+	for {
+		results, err := r.instrumentOwnershipManager.GetHouseholdInstrumentOwnerships(ctx, householdID, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, ownership := range results.Data {
+			if ownership.Quantity > 0 {
+				signals.ownedInstruments[ownership.Instrument.ID] = true
+			}
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			return nil
+		}
+		*filter.Page++
+	}
+}
+
+func (r *recommender) loadMealPlanHistory(ctx context.Context, householdID string, signals *householdSignals) error {
+	filter := buildPagingFilter()
+	filter.CreatedAfter = pointer.To(signals.now.Add(-historyWindow))
+
+	for {
+		results, err := r.mealPlanDataManager.GetMealPlans(ctx, householdID, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, mealPlan := range results.Data {
+			for _, event := range mealPlan.Events {
+				for _, option := range event.Options {
+					if !option.Chosen {
+						continue
+					}
+
+					if signals.mealTimesChosen[event.MealName] == nil {
+						signals.mealTimesChosen[event.MealName] = map[string]int{}
+					}
+					signals.mealTimesChosen[event.MealName][option.Meal.ID]++
+
+					for _, component := range option.Meal.Components {
+						recipeID := component.Recipe.ID
+						signals.timesChosen[recipeID]++
+						if event.StartsAt.After(signals.lastChosen[recipeID]) {
+							signals.lastChosen[recipeID] = event.StartsAt
+						}
+					}
+				}
+			}
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			return nil
+		}
+		*filter.Page++
+	}
+}
+
+func (r *recommender) loadCandidateRecipes(ctx context.Context) ([]*types.Recipe, error) {
+	filter := buildPagingFilter()
+
+	ids := []string{}
+	for len(ids) < maximumCandidates {
+		results, err := r.recipeDataManager.GetRecipes(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, recipe := range results.Data {
+			if recipe.EligibleForMeals && len(ids) < maximumCandidates {
+				ids = append(ids, recipe.ID)
+			}
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			break
+		}
+		*filter.Page++
+	}
+
+	if len(ids) == 0 {
+		return []*types.Recipe{}, nil
+	}
+
+	return r.recipeDataManager.GetRecipesWithIDs(ctx, ids)
+}
+
+// loadCandidateMeals fetches up to limit meals, starting with the preferred ones and filling in with eligible meals.
+func (r *recommender) loadCandidateMeals(ctx context.Context, preferredIDs []string, limit int) ([]*types.Meal, error) {
+	filter := buildPagingFilter()
+
+	ids := []string{}
+	seen := map[string]bool{}
+	for _, id := range preferredIDs {
+		if len(ids) < limit && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for len(ids) < limit {
+		results, err := r.mealDataManager.GetMeals(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, meal := range results.Data {
+			if meal.EligibleForMealPlans && !seen[meal.ID] && len(ids) < limit {
+				seen[meal.ID] = true
+				ids = append(ids, meal.ID)
+			}
+		}
+
+		if len(results.Data) < int(*filter.Limit) {
+			break
+		}
+		*filter.Page++
+	}
+
+	if len(ids) == 0 {
+		return []*types.Meal{}, nil
+	}
+
+	return r.mealDataManager.GetMealsWithIDs(ctx, ids)
+}
+
+func buildPagingFilter() *types.QueryFilter {
+	filter := types.DefaultQueryFilter()
+	filter.Page = pointer.To(uint16(1))
+	filter.Limit = pointer.To(uint8(types.MaxLimit))
+
+	return filter
+}
+
+// scoreMeal averages the scores of a meal's component recipes. A meal is only eligible if all of its components are.
+func scoreMeal(meal *types.Meal, signals *householdSignals) (score float64, reasons []string, eligible bool) {
+	reasons = []string{}
+	if len(meal.Components) == 0 {
+		return 0, reasons, false
+	}
+
+	seenReasons := map[string]bool{}
+	for _, component := range meal.Components {
+		componentScore, componentReasons, componentEligible := scoreRecipe(&component.Recipe, signals)
+		if !componentEligible {
+			return 0, []string{reasonComponentNotEligible}, false
+		}
+
+		score += componentScore
+		for _, reason := range componentReasons {
+			if !seenReasons[reason] {
+				seenReasons[reason] = true
+				reasons = append(reasons, reason)
+			}
+		}
+	}
+
+	return score / float64(len(meal.Components)), reasons, true
+}
+
+// scoreRecipe combines every signal we have about a household into a single score for a recipe.
+// Recipes containing anything a household member is allergic to are never eligible.
+func scoreRecipe(recipe *types.Recipe, signals *householdSignals) (score float64, reasons []string, eligible bool) {
+	reasons = []string{}
+
+	for _, conflict := range dietaryconflicts.FindRecipeConflicts(recipe, signals.preferences) {
+		if conflict.ConflictType != types.DietaryConflictTypeDislike {
+			return 0, []string{reasonContainsAllergen}, false
+		}
+	}
+
+	if preferenceScore, ok := ingredientPreferenceScore(recipe, signals.preferences); ok {
+		score += ingredientPreferenceWeight * preferenceScore
+		reasons = appendReasonForSignal(reasons, preferenceScore, reasonLikedIngredients, reasonDislikedIngredients)
+	}
+
+	if ratings, ok := signals.recipeRatings[recipe.ID]; ok && len(ratings) > 0 {
+		ratingScore := recipeRatingScore(ratings)
+		score += recipeRatingWeight * ratingScore
+		reasons = appendReasonForSignal(reasons, ratingScore, reasonRatedHighly, reasonRatedPoorly)
+	}
+
+	// households that haven't told us what they own shouldn't be penalized for it.
+	if len(signals.ownedInstruments) > 0 {
+		if ownedScore, ok := instrumentScore(recipe, signals.ownedInstruments); ok {
+			score += instrumentWeight * ownedScore
+			reasons = appendReasonForSignal(reasons, ownedScore, reasonHasInstruments, reasonMissingInstruments)
+		}
+	}
+
+	if timesChosen := signals.timesChosen[recipe.ID]; timesChosen > 0 {
+		score += familiarityWeight * float64(min(timesChosen, maximumFamiliarChoices)) / maximumFamiliarChoices
+		reasons = append(reasons, reasonChosenBefore)
+
+		if signals.now.Sub(signals.lastChosen[recipe.ID]) < recentlyChosenWindow {
+			score -= recentlyChosenPenalty
+			reasons = append(reasons, reasonChosenRecently)
+		}
+	}
+
+	return score, reasons, true
+}
+
+// ingredientPreferenceScore averages the household's ratings of a recipe's ingredients, scaled to [-1, 1].
+func ingredientPreferenceScore(recipe *types.Recipe, preferences []*types.UserIngredientPreference) (float64, bool) {
+	if len(preferences) == 0 {
+		return 0, false
+	}
+
+	var (
+		total   float64
+		matched int
+	)
+	for _, step := range recipe.Steps {
+		for _, stepIngredient := range step.Ingredients {
+			if stepIngredient.Ingredient == nil {
+				continue
+			}
+
+			for _, preference := range preferences {
+				if preference.Ingredient.ID == stepIngredient.Ingredient.ID {
+					total += float64(preference.Rating) / maximumIngredientPreferenceRating
+					matched++
+				}
+			}
+		}
+	}
+
+	if matched == 0 {
+		return 0, false
+	}
+
+	return total / float64(matched), true
+}
+
+// recipeRatingScore averages the household's overall ratings of a recipe, scaled to [-1, 1].
+func recipeRatingScore(ratings []float32) float64 {
+	var total float64
+	for _, rating := range ratings {
+		total += float64(rating)
+	}
+
+	mean := total / float64(len(ratings))
+
+	return max(-1, min(1, (mean/maximumRecipeRating)*2-1))
+}
+
+// instrumentScore is the share of a recipe's required instruments the household owns, scaled to [-1, 1].
+func instrumentScore(recipe *types.Recipe, ownedInstruments map[string]bool) (float64, bool) {
+	required := map[string]bool{}
+	for _, step := range recipe.Steps {
+		for _, instrument := range step.Instruments {
+			if instrument.Optional || instrument.Instrument == nil {
+				continue
+			}
+
+			required[instrument.Instrument.ID] = true
+		}
+	}
+
+	if len(required) == 0 {
+		return 0, false
+	}
+
+	owned := 0
+	for instrumentID := range required {
+		if ownedInstruments[instrumentID] {
+			owned++
+		}
+	}
+
+	return (float64(owned)/float64(len(required)))*2 - 1, true
+}
+
+func appendReasonForSignal(reasons []string, signal float64, positiveReason, negativeReason string) []string {
+	switch {
+	case signal >= significantSignal:
+		return append(reasons, positiveReason)
+	case signal <= -significantSignal:
+		return append(reasons, negativeReason)
+	default:
+		return reasons
+	}
+}
@@ -0,0 +1,721 @@
+package recommendations
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type recommenderMocks struct {
+	householdDataManager         *mocktypes.HouseholdDataManagerMock
+	recipeDataManager            *mocktypes.RecipeDataManagerMock
+	mealDataManager              *mocktypes.MealDataManagerMock
+	mealPlanDataManager          *mocktypes.MealPlanDataManagerMock
+	recipeRatingDataManager      *mocktypes.RecipeRatingDataManagerMock
+	ingredientPreferencesManager *mocktypes.UserIngredientPreferenceDataManagerMock
+	instrumentOwnershipManager   *mocktypes.HouseholdInstrumentOwnershipDataManagerMock
+}
+
+func (m *recommenderMocks) assertExpectations(t *testing.T) {
+	t.Helper()
+
+	mock.AssertExpectationsForObjects(
+		t,
+		m.householdDataManager,
+		m.recipeDataManager,
+		m.mealDataManager,
+		m.mealPlanDataManager,
+		m.recipeRatingDataManager,
+		m.ingredientPreferencesManager,
+		m.instrumentOwnershipManager,
+	)
+}
+
+func buildTestRecommender(t *testing.T) (*recommender, *recommenderMocks) {
+	t.Helper()
+
+	mocks := &recommenderMocks{
+		householdDataManager:         &mocktypes.HouseholdDataManagerMock{},
+		recipeDataManager:            &mocktypes.RecipeDataManagerMock{},
+		mealDataManager:              &mocktypes.MealDataManagerMock{},
+		mealPlanDataManager:          &mocktypes.MealPlanDataManagerMock{},
+		recipeRatingDataManager:      &mocktypes.RecipeRatingDataManagerMock{},
+		ingredientPreferencesManager: &mocktypes.UserIngredientPreferenceDataManagerMock{},
+		instrumentOwnershipManager:   &mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
+	}
+
+	r := NewRecommender(
+		logging.NewNoopLogger(),
+		tracing.NewNoopTracerProvider(),
+		mocks.householdDataManager,
+		mocks.recipeDataManager,
+		mocks.mealDataManager,
+		mocks.mealPlanDataManager,
+		mocks.recipeRatingDataManager,
+		mocks.ingredientPreferencesManager,
+		mocks.instrumentOwnershipManager,
+	).(*recommender)
+
+	return r, mocks
+}
+
+// expectHouseholdSignals sets up the mocks for a household with a single member and no history.
+func expectHouseholdSignals(mocks *recommenderMocks, household *types.Household, preferences []*types.UserIngredientPreference, ratings []*types.RecipeRating) {
+	mocks.householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+
+	for _, member := range household.Members {
+		mocks.ingredientPreferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			member.BelongsToUser.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{Data: preferences}, nil)
+	}
+
+	mocks.recipeRatingDataManager.On("GetRecipeRatingsForHousehold", testutils.ContextMatcher, household.ID).Return(ratings, nil)
+
+	mocks.instrumentOwnershipManager.On(
+		"GetHouseholdInstrumentOwnerships",
+		testutils.ContextMatcher,
+		household.ID,
+		mock.AnythingOfType("*types.QueryFilter"),
+	).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{}, nil)
+
+	mocks.mealPlanDataManager.On(
+		"GetMealPlans",
+		testutils.ContextMatcher,
+		household.ID,
+		mock.AnythingOfType("*types.QueryFilter"),
+	).Return(&types.QueryFilteredResult[types.MealPlan]{}, nil)
+}
+
+func buildHouseholdWithOneMember() *types.Household {
+	household := fakes.BuildFakeHousehold()
+	household.Members = []*types.HouseholdUserMembershipWithUser{{BelongsToUser: fakes.BuildFakeUser()}}
+
+	return household
+}
+
+func buildIngredient() *types.ValidIngredient {
+	ingredient := fakes.BuildFakeValidIngredient()
+	ingredient.ContainsPeanut = false
+	ingredient.ContainsTreeNut = false
+	ingredient.ContainsShellfish = false
+	ingredient.ContainsFish = false
+	ingredient.ContainsEgg = false
+	ingredient.ContainsDairy = false
+	ingredient.ContainsWheat = false
+	ingredient.ContainsGluten = false
+	ingredient.ContainsSoy = false
+	ingredient.ContainsSesame = false
+
+	return ingredient
+}
+
+func buildRecipe(ingredients []*types.ValidIngredient, instruments []*types.ValidInstrument) *types.Recipe {
+	step := fakes.BuildFakeRecipeStep()
+	step.Ingredients = []*types.RecipeStepIngredient{}
+	for _, ingredient := range ingredients {
+		stepIngredient := fakes.BuildFakeRecipeStepIngredient()
+		stepIngredient.Ingredient = ingredient
+		step.Ingredients = append(step.Ingredients, stepIngredient)
+	}
+
+	step.Instruments = []*types.RecipeStepInstrument{}
+	for _, instrument := range instruments {
+		stepInstrument := fakes.BuildFakeRecipeStepInstrument()
+		stepInstrument.Instrument = instrument
+		stepInstrument.Optional = false
+		step.Instruments = append(step.Instruments, stepInstrument)
+	}
+
+	recipe := fakes.BuildFakeRecipe()
+	recipe.Steps = []*types.RecipeStep{step}
+	recipe.SupportingRecipes = nil
+	recipe.EligibleForMeals = true
+
+	return recipe
+}
+
+func buildPreference(ingredient *types.ValidIngredient, rating int8, allergy bool) *types.UserIngredientPreference {
+	preference := fakes.BuildFakeUserIngredientPreference()
+	preference.Ingredient = *ingredient
+	preference.Rating = rating
+	preference.Allergy = allergy
+
+	return preference
+}
+
+func buildEmptySignals() *householdSignals {
+	return &householdSignals{
+		now:              time.Now(),
+		preferences:      []*types.UserIngredientPreference{},
+		recipeRatings:    map[string][]float32{},
+		ownedInstruments: map[string]bool{},
+		timesChosen:      map[string]int{},
+		lastChosen:       map[string]time.Time{},
+		mealTimesChosen:  map[string]map[string]int{},
+	}
+}
+
+func TestScoreRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with no signals", func(t *testing.T) {
+		t.Parallel()
+
+		score, reasons, eligible := scoreRecipe(buildRecipe([]*types.ValidIngredient{buildIngredient()}, nil), buildEmptySignals())
+
+		assert.True(t, eligible)
+		assert.Zero(t, score)
+		assert.Empty(t, reasons)
+	})
+
+	T.Run("excludes recipes with allergens", func(t *testing.T) {
+		t.Parallel()
+
+		ingredient := buildIngredient()
+		signals := buildEmptySignals()
+		signals.preferences = []*types.UserIngredientPreference{buildPreference(ingredient, 0, true)}
+
+		_, reasons, eligible := scoreRecipe(buildRecipe([]*types.ValidIngredient{ingredient}, nil), signals)
+
+		assert.False(t, eligible)
+		assert.Equal(t, []string{reasonContainsAllergen}, reasons)
+	})
+
+	T.Run("with liked and disliked ingredients", func(t *testing.T) {
+		t.Parallel()
+
+		liked := buildIngredient()
+		disliked := buildIngredient()
+		signals := buildEmptySignals()
+		signals.preferences = []*types.UserIngredientPreference{
+			buildPreference(liked, 10, false),
+			buildPreference(disliked, -10, false),
+		}
+
+		likedScore, likedReasons, likedEligible := scoreRecipe(buildRecipe([]*types.ValidIngredient{liked}, nil), signals)
+		assert.True(t, likedEligible)
+		assert.Equal(t, float64(ingredientPreferenceWeight), likedScore)
+		assert.Equal(t, []string{reasonLikedIngredients}, likedReasons)
+
+		dislikedScore, dislikedReasons, dislikedEligible := scoreRecipe(buildRecipe([]*types.ValidIngredient{disliked}, nil), signals)
+		assert.True(t, dislikedEligible)
+		assert.Equal(t, float64(-ingredientPreferenceWeight), dislikedScore)
+		assert.Equal(t, []string{reasonDislikedIngredients}, dislikedReasons)
+	})
+
+	T.Run("with household ratings", func(t *testing.T) {
+		t.Parallel()
+
+		recipe := buildRecipe(nil, nil)
+		signals := buildEmptySignals()
+		signals.recipeRatings[recipe.ID] = []float32{5, 5}
+
+		score, reasons, _ := scoreRecipe(recipe, signals)
+
+		assert.Equal(t, float64(recipeRatingWeight), score)
+		assert.Equal(t, []string{reasonRatedHighly}, reasons)
+	})
+
+	T.Run("with missing instruments", func(t *testing.T) {
+		t.Parallel()
+
+		owned := fakes.BuildFakeValidInstrument()
+		missing := fakes.BuildFakeValidInstrument()
+		signals := buildEmptySignals()
+		signals.ownedInstruments[owned.ID] = true
+
+		score, reasons, _ := scoreRecipe(buildRecipe(nil, []*types.ValidInstrument{missing}), signals)
+		assert.Equal(t, float64(-instrumentWeight), score)
+		assert.Equal(t, []string{reasonMissingInstruments}, reasons)
+
+		score, reasons, _ = scoreRecipe(buildRecipe(nil, []*types.ValidInstrument{owned}), signals)
+		assert.Equal(t, float64(instrumentWeight), score)
+		assert.Equal(t, []string{reasonHasInstruments}, reasons)
+	})
+
+	T.Run("ignores instruments when the household hasn't recorded any", func(t *testing.T) {
+		t.Parallel()
+
+		score, reasons, _ := scoreRecipe(buildRecipe(nil, []*types.ValidInstrument{fakes.BuildFakeValidInstrument()}), buildEmptySignals())
+
+		assert.Zero(t, score)
+		assert.Empty(t, reasons)
+	})
+
+	T.Run("with past choices", func(t *testing.T) {
+		t.Parallel()
+
+		recipe := buildRecipe(nil, nil)
+		signals := buildEmptySignals()
+		signals.timesChosen[recipe.ID] = maximumFamiliarChoices * 2
+		signals.lastChosen[recipe.ID] = signals.now.Add(-recentlyChosenWindow * 2)
+
+		score, reasons, _ := scoreRecipe(recipe, signals)
+		assert.Equal(t, float64(familiarityWeight), score)
+		assert.Equal(t, []string{reasonChosenBefore}, reasons)
+
+		signals.lastChosen[recipe.ID] = signals.now.Add(-time.Hour)
+
+		score, reasons, _ = scoreRecipe(recipe, signals)
+		assert.Equal(t, float64(familiarityWeight-recentlyChosenPenalty), score)
+		assert.Equal(t, []string{reasonChosenBefore, reasonChosenRecently}, reasons)
+	})
+}
+
+func TestScoreMeal(T *testing.T) {
+	T.Parallel()
+
+	T.Run("averages component scores", func(t *testing.T) {
+		t.Parallel()
+
+		rated := buildRecipe(nil, nil)
+		unrated := buildRecipe(nil, nil)
+		signals := buildEmptySignals()
+		signals.recipeRatings[rated.ID] = []float32{5}
+
+		meal := fakes.BuildFakeMeal()
+		meal.Components = []*types.MealComponent{{Recipe: *rated}, {Recipe: *unrated}}
+
+		score, reasons, eligible := scoreMeal(meal, signals)
+
+		assert.True(t, eligible)
+		assert.Equal(t, float64(recipeRatingWeight)/2, score)
+		assert.Equal(t, []string{reasonRatedHighly}, reasons)
+	})
+
+	T.Run("with an ineligible component", func(t *testing.T) {
+		t.Parallel()
+
+		ingredient := buildIngredient()
+		signals := buildEmptySignals()
+		signals.preferences = []*types.UserIngredientPreference{buildPreference(ingredient, 0, true)}
+
+		meal := fakes.BuildFakeMeal()
+		meal.Components = []*types.MealComponent{
+			{Recipe: *buildRecipe(nil, nil)},
+			{Recipe: *buildRecipe([]*types.ValidIngredient{ingredient}, nil)},
+		}
+
+		_, _, eligible := scoreMeal(meal, signals)
+		assert.False(t, eligible)
+	})
+
+	T.Run("without components", func(t *testing.T) {
+		t.Parallel()
+
+		meal := fakes.BuildFakeMeal()
+		meal.Components = nil
+
+		_, _, eligible := scoreMeal(meal, buildEmptySignals())
+		assert.False(t, eligible)
+	})
+}
+
+func TestRecommender_RecommendRecipes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		allergen := buildIngredient()
+		favorite := buildRecipe(nil, nil)
+		ordinary := buildRecipe(nil, nil)
+		dangerous := buildRecipe([]*types.ValidIngredient{allergen}, nil)
+		ineligible := buildRecipe(nil, nil)
+		ineligible.EligibleForMeals = false
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(
+			mocks,
+			household,
+			[]*types.UserIngredientPreference{buildPreference(allergen, 0, true)},
+			[]*types.RecipeRating{{RecipeID: favorite.ID, Overall: 5}},
+		)
+
+		mocks.recipeDataManager.On(
+			"GetRecipes",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.Recipe]{Data: []*types.Recipe{ordinary, favorite, dangerous, ineligible}}, nil)
+		mocks.recipeDataManager.On(
+			"GetRecipesWithIDs",
+			testutils.ContextMatcher,
+			[]string{ordinary.ID, favorite.ID, dangerous.ID},
+		).Return([]*types.Recipe{ordinary, favorite, dangerous}, nil)
+
+		actual, err := r.RecommendRecipes(ctx, household.ID, 5)
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, favorite, actual[0].Recipe)
+		assert.Equal(t, ordinary, actual[1].Recipe)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("limits results to the requested count", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(mocks, household, nil, []*types.RecipeRating{})
+
+		recipes := []*types.Recipe{buildRecipe(nil, nil), buildRecipe(nil, nil), buildRecipe(nil, nil)}
+		mocks.recipeDataManager.On(
+			"GetRecipes",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.Recipe]{Data: recipes}, nil)
+		mocks.recipeDataManager.On(
+			"GetRecipesWithIDs",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("[]string"),
+		).Return(recipes, nil)
+
+		actual, err := r.RecommendRecipes(ctx, household.ID, 1)
+		require.NoError(t, err)
+		assert.Len(t, actual, 1)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		exampleHouseholdID := fakes.BuildFakeID()
+		mocks.householdDataManager.On("GetHousehold", testutils.ContextMatcher, exampleHouseholdID).Return((*types.Household)(nil), errors.New("blah"))
+
+		actual, err := r.RecommendRecipes(ctx, exampleHouseholdID, 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching recipe ratings", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		household := buildHouseholdWithOneMember()
+		household.Members = nil
+		mocks.householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+		mocks.recipeRatingDataManager.On("GetRecipeRatingsForHousehold", testutils.ContextMatcher, household.ID).Return([]*types.RecipeRating(nil), errors.New("blah"))
+
+		actual, err := r.RecommendRecipes(ctx, household.ID, 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching recipes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(mocks, household, nil, []*types.RecipeRating{})
+
+		mocks.recipeDataManager.On(
+			"GetRecipes",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return((*types.QueryFilteredResult[types.Recipe])(nil), errors.New("blah"))
+
+		actual, err := r.RecommendRecipes(ctx, household.ID, 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+}
+
+func TestRecommender_RecommendMeals(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		favorite := buildRecipe(nil, nil)
+		ordinary := buildRecipe(nil, nil)
+
+		favoriteMeal := fakes.BuildFakeMeal()
+		favoriteMeal.EligibleForMealPlans = true
+		favoriteMeal.Components = []*types.MealComponent{{Recipe: *favorite}}
+
+		ordinaryMeal := fakes.BuildFakeMeal()
+		ordinaryMeal.EligibleForMealPlans = true
+		ordinaryMeal.Components = []*types.MealComponent{{Recipe: *ordinary}}
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(mocks, household, nil, []*types.RecipeRating{{RecipeID: favorite.ID, Overall: 5}})
+
+		mocks.mealDataManager.On(
+			"GetMeals",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.Meal]{Data: []*types.Meal{ordinaryMeal, favoriteMeal}}, nil)
+		mocks.mealDataManager.On(
+			"GetMealsWithIDs",
+			testutils.ContextMatcher,
+			[]string{ordinaryMeal.ID, favoriteMeal.ID},
+		).Return([]*types.Meal{ordinaryMeal, favoriteMeal}, nil)
+
+		actual, err := r.RecommendMeals(ctx, household.ID, 5)
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, favoriteMeal, actual[0].Meal)
+		assert.Equal(t, ordinaryMeal, actual[1].Meal)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("considers past choices", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		recipe := buildRecipe(nil, nil)
+		meal := fakes.BuildFakeMeal()
+		meal.EligibleForMealPlans = true
+		meal.Components = []*types.MealComponent{{Recipe: *recipe}}
+
+		household := buildHouseholdWithOneMember()
+		mocks.householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+		mocks.ingredientPreferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			household.Members[0].BelongsToUser.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{}, nil)
+		mocks.recipeRatingDataManager.On("GetRecipeRatingsForHousehold", testutils.ContextMatcher, household.ID).Return([]*types.RecipeRating{}, nil)
+		mocks.instrumentOwnershipManager.On(
+			"GetHouseholdInstrumentOwnerships",
+			testutils.ContextMatcher,
+			household.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{}, nil)
+		mocks.mealPlanDataManager.On(
+			"GetMealPlans",
+			testutils.ContextMatcher,
+			household.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.MealPlan]{
+			Data: []*types.MealPlan{
+				{
+					Events: []*types.MealPlanEvent{
+						{
+							StartsAt: time.Now().Add(-time.Hour),
+							Options:  []*types.MealPlanOption{{Chosen: true, Meal: *meal}},
+						},
+					},
+				},
+			},
+		}, nil)
+
+		mocks.mealDataManager.On(
+			"GetMeals",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.Meal]{Data: []*types.Meal{meal}}, nil)
+		mocks.mealDataManager.On(
+			"GetMealsWithIDs",
+			testutils.ContextMatcher,
+			[]string{meal.ID},
+		).Return([]*types.Meal{meal}, nil)
+
+		actual, err := r.RecommendMeals(ctx, household.ID, 5)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, float64(familiarityWeight)/maximumFamiliarChoices-recentlyChosenPenalty, actual[0].Score)
+		assert.Equal(t, []string{reasonChosenBefore, reasonChosenRecently}, actual[0].Reasons)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching meals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(mocks, household, nil, []*types.RecipeRating{})
+
+		mocks.mealDataManager.On(
+			"GetMeals",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return((*types.QueryFilteredResult[types.Meal])(nil), errors.New("blah"))
+
+		actual, err := r.RecommendMeals(ctx, household.ID, 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+}
+
+func TestRecommender_RecommendMealsForEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("favors meals chosen for the same meal", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		breakfastMeal := fakes.BuildFakeMeal()
+		breakfastMeal.EligibleForMealPlans = true
+		breakfastMeal.Components = []*types.MealComponent{{Recipe: *buildRecipe(nil, nil)}}
+
+		otherMeal := fakes.BuildFakeMeal()
+		otherMeal.EligibleForMealPlans = true
+		otherMeal.Components = []*types.MealComponent{{Recipe: *buildRecipe(nil, nil)}}
+
+		household := buildHouseholdWithOneMember()
+		mocks.householdDataManager.On("GetHousehold", testutils.ContextMatcher, household.ID).Return(household, nil)
+		mocks.ingredientPreferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			household.Members[0].BelongsToUser.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{}, nil)
+		mocks.recipeRatingDataManager.On("GetRecipeRatingsForHousehold", testutils.ContextMatcher, household.ID).Return([]*types.RecipeRating{}, nil)
+		mocks.instrumentOwnershipManager.On(
+			"GetHouseholdInstrumentOwnerships",
+			testutils.ContextMatcher,
+			household.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.HouseholdInstrumentOwnership]{}, nil)
+		mocks.mealPlanDataManager.On(
+			"GetMealPlans",
+			testutils.ContextMatcher,
+			household.ID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.MealPlan]{
+			Data: []*types.MealPlan{
+				{
+					Events: []*types.MealPlanEvent{
+						{
+							MealName: types.BreakfastMealName,
+							StartsAt: time.Now().Add(-30 * 24 * time.Hour),
+							Options:  []*types.MealPlanOption{{Chosen: true, Meal: *breakfastMeal}},
+						},
+					},
+				},
+			},
+		}, nil)
+
+		mocks.mealDataManager.On(
+			"GetMeals",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.Meal]{Data: []*types.Meal{otherMeal, breakfastMeal}}, nil)
+		// meals previously chosen for the meal are considered first.
+		mocks.mealDataManager.On(
+			"GetMealsWithIDs",
+			testutils.ContextMatcher,
+			[]string{breakfastMeal.ID, otherMeal.ID},
+		).Return([]*types.Meal{breakfastMeal, otherMeal}, nil)
+
+		actual, err := r.RecommendMealsForEvent(ctx, household.ID, types.BreakfastMealName, 5)
+		require.NoError(t, err)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, breakfastMeal, actual[0].Meal)
+		assert.Equal(t, float64(familiarityWeight)/maximumFamiliarChoices+float64(mealNameWeight)/maximumFamiliarChoices, actual[0].Score)
+		assert.Equal(t, []string{reasonChosenBefore, reasonChosenForMeal}, actual[0].Reasons)
+		assert.Equal(t, otherMeal, actual[1].Meal)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("considers fewer candidates", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(mocks, household, nil, []*types.RecipeRating{})
+
+		meals := []*types.Meal{}
+		for range maximumEventCandidates + 1 {
+			meal := fakes.BuildFakeMeal()
+			meal.EligibleForMealPlans = true
+			meals = append(meals, meal)
+		}
+
+		mocks.mealDataManager.On(
+			"GetMeals",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.Meal]{Data: meals}, nil)
+		mocks.mealDataManager.On(
+			"GetMealsWithIDs",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(ids []string) bool { return len(ids) == maximumEventCandidates }),
+		).Return([]*types.Meal{}, nil)
+
+		actual, err := r.RecommendMealsForEvent(ctx, household.ID, types.DinnerMealName, 3)
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+
+	T.Run("with error fetching meals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		r, mocks := buildTestRecommender(t)
+
+		household := buildHouseholdWithOneMember()
+		expectHouseholdSignals(mocks, household, nil, []*types.RecipeRating{})
+
+		mocks.mealDataManager.On(
+			"GetMeals",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return((*types.QueryFilteredResult[types.Meal])(nil), errors.New("blah"))
+
+		actual, err := r.RecommendMealsForEvent(ctx, household.ID, types.DinnerMealName, 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mocks.assertExpectations(t)
+	})
+}
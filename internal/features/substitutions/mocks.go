@@ -0,0 +1,23 @@
+package substitutions
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Suggester = (*MockSuggester)(nil)
+
+// MockSuggester is a mock Suggester.
+type MockSuggester struct {
+	mock.Mock
+}
+
+// SuggestSubstitutionsForUser is a mock function.
+func (m *MockSuggester) SuggestSubstitutionsForUser(ctx context.Context, userID string, recipe *types.Recipe) ([]*types.RecipeIngredientSubstitutionSuggestion, error) {
+	returnValues := m.Called(ctx, userID, recipe)
+
+	return returnValues.Get(0).([]*types.RecipeIngredientSubstitutionSuggestion), returnValues.Error(1)
+}
@@ -0,0 +1,167 @@
+package substitutions
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// Suggester offers substitutes for the ingredients in a recipe that a user is allergic to.
+type Suggester interface {
+	SuggestSubstitutionsForUser(ctx context.Context, userID string, recipe *types.Recipe) ([]*types.RecipeIngredientSubstitutionSuggestion, error)
+}
+
+var _ Suggester = (*suggester)(nil)
+
+type suggester struct {
+	logger                       logging.Logger
+	tracer                       tracing.Tracer
+	ingredientPreferencesManager types.UserIngredientPreferenceDataManager
+	substitutionDataManager      types.ValidIngredientSubstitutionDataManager
+}
+
+// NewSuggester creates a Suggester.
+func NewSuggester(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	ingredientPreferencesManager types.UserIngredientPreferenceDataManager,
+	substitutionDataManager types.ValidIngredientSubstitutionDataManager,
+) Suggester {
+	return &suggester{
+		logger:                       logging.EnsureLogger(logger).WithName("substitution_suggester"),
+		tracer:                       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("substitution_suggester")),
+		ingredientPreferencesManager: ingredientPreferencesManager,
+		substitutionDataManager:      substitutionDataManager,
+	}
+}
+
+// SuggestSubstitutionsForUser finds the recipe's ingredients that a user is allergic to and the substitutes available for each.
+func (s *suggester) SuggestSubstitutionsForUser(ctx context.Context, userID string, recipe *types.Recipe) ([]*types.RecipeIngredientSubstitutionSuggestion, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithValue(keys.UserIDKey, userID)
+	tracing.AttachToSpan(span, keys.UserIDKey, userID)
+
+	if recipe == nil {
+		return []*types.RecipeIngredientSubstitutionSuggestion{}, nil
+	}
+	logger = logger.WithValue(keys.RecipeIDKey, recipe.ID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipe.ID)
+
+	preferences, err := s.getAllPreferencesForUser(ctx, userID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching ingredient preferences")
+	}
+
+	allergies := allergicIngredientIDs(preferences)
+	conflictingIngredientIDs := []string{}
+	for _, step := range recipe.Steps {
+		for _, stepIngredient := range step.Ingredients {
+			if stepIngredient.Ingredient != nil && allergies[stepIngredient.Ingredient.ID] {
+				conflictingIngredientIDs = append(conflictingIngredientIDs, stepIngredient.Ingredient.ID)
+			}
+		}
+	}
+
+	if len(conflictingIngredientIDs) == 0 {
+		return []*types.RecipeIngredientSubstitutionSuggestion{}, nil
+	}
+
+	substitutions, err := s.substitutionDataManager.GetValidIngredientSubstitutionsForIngredients(ctx, conflictingIngredientIDs)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient substitutions")
+	}
+
+	return FindSuggestions(recipe, preferences, substitutions), nil
+}
+
+func (s *suggester) getAllPreferencesForUser(ctx context.Context, userID string) ([]*types.UserIngredientPreference, error) {
+	filter := types.DefaultQueryFilter()
+	filter.Page = pointer.To(uint16(1))
+	filter.Limit = pointer.To(uint8(types.MaxLimit))
+
+	preferences := []*types.UserIngredientPreference{}
+	for {
+		results, err := s.ingredientPreferencesManager.GetUserIngredientPreferences(ctx, userID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		preferences = append(preferences, results.Data...)
+
+		if len(results.Data) < int(*filter.Limit) {
+			return preferences, nil
+		}
+		*filter.Page++
+	}
+}
+
+// FindSuggestions pairs every step ingredient of a recipe that a user is allergic to with the substitutions
+// tagged for allergies that apply to the step's preparation. Substitutes the user is also allergic to are
+// skipped, as are ingredients with nothing left to offer.
+func FindSuggestions(recipe *types.Recipe, preferences []*types.UserIngredientPreference, substitutions []*types.ValidIngredientSubstitution) []*types.RecipeIngredientSubstitutionSuggestion {
+	suggestions := []*types.RecipeIngredientSubstitutionSuggestion{}
+	if recipe == nil {
+		return suggestions
+	}
+
+	allergies := allergicIngredientIDs(preferences)
+	if len(allergies) == 0 {
+		return suggestions
+	}
+
+	substitutionsByOriginal := map[string][]*types.ValidIngredientSubstitution{}
+	for _, substitution := range substitutions {
+		if !substitution.HasReason(types.SubstitutionReasonAllergy) || allergies[substitution.Substitute.ID] {
+			continue
+		}
+		substitutionsByOriginal[substitution.Original.ID] = append(substitutionsByOriginal[substitution.Original.ID], substitution)
+	}
+
+	for _, step := range recipe.Steps {
+		for _, stepIngredient := range step.Ingredients {
+			if stepIngredient.Ingredient == nil || !allergies[stepIngredient.Ingredient.ID] {
+				continue
+			}
+
+			applicable := []*types.ValidIngredientSubstitution{}
+			for _, substitution := range substitutionsByOriginal[stepIngredient.Ingredient.ID] {
+				if substitution.AppliesToPreparation(step.Preparation.ID) {
+					applicable = append(applicable, substitution)
+				}
+			}
+
+			if len(applicable) == 0 {
+				continue
+			}
+
+			suggestions = append(suggestions, &types.RecipeIngredientSubstitutionSuggestion{
+				RecipeStepID:           step.ID,
+				RecipeStepIngredientID: stepIngredient.ID,
+				IngredientID:           stepIngredient.Ingredient.ID,
+				IngredientName:         stepIngredient.Ingredient.Name,
+				Substitutions:          applicable,
+			})
+		}
+	}
+
+	return suggestions
+}
+
+// allergicIngredientIDs collects the IDs of the ingredients a set of preferences flags as allergies.
+func allergicIngredientIDs(preferences []*types.UserIngredientPreference) map[string]bool {
+	allergies := map[string]bool{}
+	for _, preference := range preferences {
+		if preference.Allergy {
+			allergies[preference.Ingredient.ID] = true
+		}
+	}
+
+	return allergies
+}
@@ -0,0 +1,276 @@
+package substitutions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildIngredient(name string) *types.ValidIngredient {
+	ingredient := fakes.BuildFakeValidIngredient()
+	ingredient.Name = name
+
+	return ingredient
+}
+
+func buildRecipe(ingredients ...*types.ValidIngredient) *types.Recipe {
+	step := fakes.BuildFakeRecipeStep()
+	step.Ingredients = []*types.RecipeStepIngredient{}
+	for _, ingredient := range ingredients {
+		stepIngredient := fakes.BuildFakeRecipeStepIngredient()
+		stepIngredient.Ingredient = ingredient
+		step.Ingredients = append(step.Ingredients, stepIngredient)
+	}
+
+	recipe := fakes.BuildFakeRecipe()
+	recipe.Steps = []*types.RecipeStep{step}
+
+	return recipe
+}
+
+func buildAllergy(ingredient *types.ValidIngredient) *types.UserIngredientPreference {
+	preference := fakes.BuildFakeUserIngredientPreference()
+	preference.Ingredient = *ingredient
+	preference.Allergy = true
+
+	return preference
+}
+
+func buildSubstitution(original, substitute *types.ValidIngredient, reasons ...string) *types.ValidIngredientSubstitution {
+	substitution := fakes.BuildFakeValidIngredientSubstitution()
+	substitution.Original = *original
+	substitution.Substitute = *substitute
+	substitution.Preparation = nil
+	substitution.Reasons = reasons
+
+	return substitution
+}
+
+func TestFindSuggestions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		butter := buildIngredient("butter")
+		oliveOil := buildIngredient("olive oil")
+		recipe := buildRecipe(butter, buildIngredient("flour"))
+		substitution := buildSubstitution(butter, oliveOil, types.SubstitutionReasonAllergy)
+
+		actual := FindSuggestions(
+			recipe,
+			[]*types.UserIngredientPreference{buildAllergy(butter)},
+			[]*types.ValidIngredientSubstitution{substitution},
+		)
+		require.Len(t, actual, 1)
+
+		assert.Equal(t, recipe.Steps[0].ID, actual[0].RecipeStepID)
+		assert.Equal(t, recipe.Steps[0].Ingredients[0].ID, actual[0].RecipeStepIngredientID)
+		assert.Equal(t, butter.ID, actual[0].IngredientID)
+		assert.Equal(t, butter.Name, actual[0].IngredientName)
+		assert.Equal(t, []*types.ValidIngredientSubstitution{substitution}, actual[0].Substitutions)
+	})
+
+	T.Run("ignores substitutions not tagged for allergies", func(t *testing.T) {
+		t.Parallel()
+
+		butter := buildIngredient("butter")
+
+		actual := FindSuggestions(
+			buildRecipe(butter),
+			[]*types.UserIngredientPreference{buildAllergy(butter)},
+			[]*types.ValidIngredientSubstitution{
+				buildSubstitution(butter, buildIngredient("margarine"), types.SubstitutionReasonAvailability),
+			},
+		)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("ignores substitutes the user is also allergic to", func(t *testing.T) {
+		t.Parallel()
+
+		butter := buildIngredient("butter")
+		ghee := buildIngredient("ghee")
+
+		actual := FindSuggestions(
+			buildRecipe(butter),
+			[]*types.UserIngredientPreference{buildAllergy(butter), buildAllergy(ghee)},
+			[]*types.ValidIngredientSubstitution{buildSubstitution(butter, ghee, types.SubstitutionReasonAllergy)},
+		)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("ignores substitutions for other preparations", func(t *testing.T) {
+		t.Parallel()
+
+		butter := buildIngredient("butter")
+		recipe := buildRecipe(butter)
+
+		substitution := buildSubstitution(butter, buildIngredient("olive oil"), types.SubstitutionReasonAllergy)
+		substitution.Preparation = fakes.BuildFakeValidPreparation()
+
+		actual := FindSuggestions(
+			recipe,
+			[]*types.UserIngredientPreference{buildAllergy(butter)},
+			[]*types.ValidIngredientSubstitution{substitution},
+		)
+		assert.Empty(t, actual)
+
+		substitution.Preparation = &recipe.Steps[0].Preparation
+		actual = FindSuggestions(
+			recipe,
+			[]*types.UserIngredientPreference{buildAllergy(butter)},
+			[]*types.ValidIngredientSubstitution{substitution},
+		)
+		assert.Len(t, actual, 1)
+	})
+
+	T.Run("ignores preferences that aren't allergies", func(t *testing.T) {
+		t.Parallel()
+
+		butter := buildIngredient("butter")
+		preference := buildAllergy(butter)
+		preference.Allergy = false
+
+		actual := FindSuggestions(
+			buildRecipe(butter),
+			[]*types.UserIngredientPreference{preference},
+			[]*types.ValidIngredientSubstitution{buildSubstitution(butter, buildIngredient("olive oil"), types.SubstitutionReasonAllergy)},
+		)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("with nil recipe", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, FindSuggestions(nil, nil, nil))
+	})
+}
+
+func TestSuggester_SuggestSubstitutionsForUser(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		userID := fakes.BuildFakeID()
+		butter := buildIngredient("butter")
+		recipe := buildRecipe(butter, buildIngredient("flour"))
+		substitution := buildSubstitution(butter, buildIngredient("olive oil"), types.SubstitutionReasonAllergy)
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			userID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{Data: []*types.UserIngredientPreference{buildAllergy(butter)}}, nil)
+
+		substitutionDataManager := &mocktypes.ValidIngredientSubstitutionDataManagerMock{}
+		substitutionDataManager.On(
+			"GetValidIngredientSubstitutionsForIngredients",
+			testutils.ContextMatcher,
+			[]string{butter.ID},
+		).Return([]*types.ValidIngredientSubstitution{substitution}, nil)
+
+		s := NewSuggester(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), preferencesManager, substitutionDataManager)
+
+		actual, err := s.SuggestSubstitutionsForUser(ctx, userID, recipe)
+		require.NoError(t, err)
+		require.Len(t, actual, 1)
+		assert.Equal(t, butter.ID, actual[0].IngredientID)
+
+		mock.AssertExpectationsForObjects(t, preferencesManager, substitutionDataManager)
+	})
+
+	T.Run("without allergies in the recipe", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		userID := fakes.BuildFakeID()
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			userID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{Data: []*types.UserIngredientPreference{buildAllergy(buildIngredient("shrimp"))}}, nil)
+
+		substitutionDataManager := &mocktypes.ValidIngredientSubstitutionDataManagerMock{}
+
+		s := NewSuggester(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), preferencesManager, substitutionDataManager)
+
+		actual, err := s.SuggestSubstitutionsForUser(ctx, userID, buildRecipe(buildIngredient("rice")))
+		require.NoError(t, err)
+		assert.Empty(t, actual)
+
+		mock.AssertExpectationsForObjects(t, preferencesManager, substitutionDataManager)
+	})
+
+	T.Run("with error fetching preferences", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		userID := fakes.BuildFakeID()
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			userID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return((*types.QueryFilteredResult[types.UserIngredientPreference])(nil), errors.New("blah"))
+
+		s := NewSuggester(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), preferencesManager, &mocktypes.ValidIngredientSubstitutionDataManagerMock{})
+
+		actual, err := s.SuggestSubstitutionsForUser(ctx, userID, buildRecipe(buildIngredient("rice")))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, preferencesManager)
+	})
+
+	T.Run("with error fetching substitutions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		userID := fakes.BuildFakeID()
+		butter := buildIngredient("butter")
+
+		preferencesManager := &mocktypes.UserIngredientPreferenceDataManagerMock{}
+		preferencesManager.On(
+			"GetUserIngredientPreferences",
+			testutils.ContextMatcher,
+			userID,
+			mock.AnythingOfType("*types.QueryFilter"),
+		).Return(&types.QueryFilteredResult[types.UserIngredientPreference]{Data: []*types.UserIngredientPreference{buildAllergy(butter)}}, nil)
+
+		substitutionDataManager := &mocktypes.ValidIngredientSubstitutionDataManagerMock{}
+		substitutionDataManager.On(
+			"GetValidIngredientSubstitutionsForIngredients",
+			testutils.ContextMatcher,
+			[]string{butter.ID},
+		).Return([]*types.ValidIngredientSubstitution(nil), errors.New("blah"))
+
+		s := NewSuggester(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), preferencesManager, substitutionDataManager)
+
+		actual, err := s.SuggestSubstitutionsForUser(ctx, userID, buildRecipe(butter))
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, preferencesManager, substitutionDataManager)
+	})
+}
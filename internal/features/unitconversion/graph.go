@@ -0,0 +1,154 @@
+package unitconversion
+
+import (
+	"errors"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrNoConversionPath indicates there is no chain of conversions between two measurement units.
+	ErrNoConversionPath = errors.New("no conversion path between measurement units")
+)
+
+// conversionEdge is a single directed hop between two measurement units.
+type conversionEdge struct {
+	to                string
+	onlyForIngredient string
+	modifier          decimal.Decimal
+}
+
+// Graph is a graph of measurement units connected by known conversions.
+// A conversion from unit A to unit B with modifier M means that one A is M Bs,
+// and every conversion is also usable in reverse (one B is 1/M As).
+type Graph struct {
+	edges map[string][]*conversionEdge
+	units map[string]types.ValidMeasurementUnit
+}
+
+// NewGraph builds a Graph from a set of conversions.
+func NewGraph(conversions ...*types.ValidMeasurementUnitConversion) *Graph {
+	g := &Graph{
+		edges: map[string][]*conversionEdge{},
+		units: map[string]types.ValidMeasurementUnit{},
+	}
+
+	for _, conversion := range conversions {
+		g.AddConversion(conversion)
+	}
+
+	return g
+}
+
+// AddConversion adds a conversion (and its inverse) to the graph.
+func (g *Graph) AddConversion(conversion *types.ValidMeasurementUnitConversion) {
+	if conversion == nil || conversion.Modifier <= 0 || conversion.From.ID == "" || conversion.To.ID == "" {
+		return
+	}
+
+	onlyForIngredient := ""
+	if conversion.OnlyForIngredient != nil {
+		onlyForIngredient = conversion.OnlyForIngredient.ID
+	}
+
+	g.units[conversion.From.ID] = conversion.From
+	g.units[conversion.To.ID] = conversion.To
+
+	modifier := decimal.NewFromFloat32(conversion.Modifier)
+
+	g.edges[conversion.From.ID] = append(g.edges[conversion.From.ID], &conversionEdge{
+		to:                conversion.To.ID,
+		onlyForIngredient: onlyForIngredient,
+		modifier:          modifier,
+	})
+
+	g.edges[conversion.To.ID] = append(g.edges[conversion.To.ID], &conversionEdge{
+		to:                conversion.From.ID,
+		onlyForIngredient: onlyForIngredient,
+		modifier:          decimal.NewFromInt(1).Div(modifier),
+	})
+}
+
+// Unit returns the measurement unit with the given ID, if it appears in any conversion in the graph.
+func (g *Graph) Unit(unitID string) (types.ValidMeasurementUnit, bool) {
+	unit, ok := g.units[unitID]
+	return unit, ok
+}
+
+// edgesFor returns the usable edges leaving a given unit for a given ingredient.
+// Where a conversion specific to the ingredient exists for a pair of units, it overrides the general one.
+func (g *Graph) edgesFor(unitID, ingredientID string) []*conversionEdge {
+	specific := map[string]*conversionEdge{}
+	for _, edge := range g.edges[unitID] {
+		if edge.onlyForIngredient != "" && edge.onlyForIngredient == ingredientID {
+			specific[edge.to] = edge
+		}
+	}
+
+	usable := []*conversionEdge{}
+	for _, edge := range g.edges[unitID] {
+		switch {
+		case edge.onlyForIngredient == "":
+			if _, overridden := specific[edge.to]; !overridden {
+				usable = append(usable, edge)
+			}
+		case edge.onlyForIngredient == ingredientID:
+			usable = append(usable, edge)
+		}
+	}
+
+	return usable
+}
+
+// ReachableUnits returns the factor by which a quantity in the provided unit must be
+// multiplied to express it in every unit reachable from it. The provided ingredient ID
+// may be empty, in which case only conversions that apply to all ingredients are used.
+func (g *Graph) ReachableUnits(fromUnitID, ingredientID string) map[string]decimal.Decimal {
+	factors := map[string]decimal.Decimal{
+		fromUnitID: decimal.NewFromInt(1),
+	}
+
+	// breadth-first, so we always take the path with the fewest hops.
+	queue := []string{fromUnitID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range g.edgesFor(current, ingredientID) {
+			if _, seen := factors[edge.to]; seen {
+				continue
+			}
+
+			factors[edge.to] = factors[current].Mul(edge.modifier)
+			queue = append(queue, edge.to)
+		}
+	}
+
+	return factors
+}
+
+// ConversionFactor returns the factor by which a quantity in one unit must be multiplied to express it in another.
+func (g *Graph) ConversionFactor(fromUnitID, toUnitID, ingredientID string) (decimal.Decimal, error) {
+	if fromUnitID == toUnitID {
+		return decimal.NewFromInt(1), nil
+	}
+
+	factor, ok := g.ReachableUnits(fromUnitID, ingredientID)[toUnitID]
+	if !ok {
+		return decimal.Zero, ErrNoConversionPath
+	}
+
+	return factor, nil
+}
+
+// Convert converts a quantity of a given ingredient from one unit to another.
+func (g *Graph) Convert(quantity decimal.Decimal, fromUnitID, toUnitID, ingredientID string) (decimal.Decimal, error) {
+	factor, err := g.ConversionFactor(fromUnitID, toUnitID, ingredientID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return quantity.Mul(factor), nil
+}
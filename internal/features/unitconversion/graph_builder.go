@@ -0,0 +1,88 @@
+package unitconversion
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// GraphBuilder builds conversion graphs for measurement units.
+type GraphBuilder interface {
+	BuildGraph(ctx context.Context, measurementUnitIDs ...string) (*Graph, error)
+}
+
+var _ GraphBuilder = (*graphBuilder)(nil)
+
+type graphBuilder struct {
+	logger      logging.Logger
+	tracer      tracing.Tracer
+	dataManager types.ValidMeasurementUnitConversionDataManager
+}
+
+// NewGraphBuilder creates a GraphBuilder.
+func NewGraphBuilder(logger logging.Logger, tracerProvider tracing.TracerProvider, dataManager types.ValidMeasurementUnitConversionDataManager) GraphBuilder {
+	return &graphBuilder{
+		logger:      logging.EnsureLogger(logger).WithName("unit_conversion_graph_builder"),
+		tracer:      tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("unit_conversion_graph_builder")),
+		dataManager: dataManager,
+	}
+}
+
+// BuildGraph builds a Graph containing every conversion reachable from the provided measurement units.
+func (b *graphBuilder) BuildGraph(ctx context.Context, measurementUnitIDs ...string) (*Graph, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := b.logger.Clone()
+	g := NewGraph()
+
+	seenUnits := map[string]bool{}
+	seenConversions := map[string]bool{}
+	queue := []string{}
+
+	for _, id := range measurementUnitIDs {
+		if id != "" && !seenUnits[id] {
+			seenUnits[id] = true
+			queue = append(queue, id)
+		}
+	}
+
+	for len(queue) > 0 {
+		unitID := queue[0]
+		queue = queue[1:]
+		l := logger.WithValue(keys.ValidMeasurementUnitIDKey, unitID)
+
+		fromUnit, err := b.dataManager.GetValidMeasurementUnitConversionsFromUnit(ctx, unitID)
+		if err != nil {
+			return nil, observability.PrepareAndLogError(err, l, span, "fetching conversions from measurement unit")
+		}
+
+		toUnit, err := b.dataManager.GetValidMeasurementUnitConversionsToUnit(ctx, unitID)
+		if err != nil {
+			return nil, observability.PrepareAndLogError(err, l, span, "fetching conversions to measurement unit")
+		}
+
+		for _, conversion := range append(fromUnit, toUnit...) {
+			if seenConversions[conversion.ID] {
+				continue
+			}
+			seenConversions[conversion.ID] = true
+			g.AddConversion(conversion)
+
+			for _, neighbor := range []string{conversion.From.ID, conversion.To.ID} {
+				if !seenUnits[neighbor] {
+					seenUnits[neighbor] = true
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+
+	logger.WithValue("conversion_count", len(seenConversions)).Debug("built measurement unit conversion graph")
+
+	return g, nil
+}
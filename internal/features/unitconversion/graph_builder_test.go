@@ -0,0 +1,71 @@
+package unitconversion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGraphBuilder_BuildGraph(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		teaspoon := fakes.BuildFakeValidMeasurementUnit()
+		tablespoon := fakes.BuildFakeValidMeasurementUnit()
+		cup := fakes.BuildFakeValidMeasurementUnit()
+
+		tbspToTsp := buildConversionForTest(tablespoon, teaspoon, 3, nil)
+		cupToTbsp := buildConversionForTest(cup, tablespoon, 16, nil)
+
+		dataManager := &mocktypes.ValidMeasurementUnitConversionDataManagerMock{}
+		dataManager.On("GetValidMeasurementUnitConversionsFromUnit", testutils.ContextMatcher, teaspoon.ID).Return([]*types.ValidMeasurementUnitConversion{}, nil)
+		dataManager.On("GetValidMeasurementUnitConversionsToUnit", testutils.ContextMatcher, teaspoon.ID).Return([]*types.ValidMeasurementUnitConversion{tbspToTsp}, nil)
+		dataManager.On("GetValidMeasurementUnitConversionsFromUnit", testutils.ContextMatcher, tablespoon.ID).Return([]*types.ValidMeasurementUnitConversion{tbspToTsp}, nil)
+		dataManager.On("GetValidMeasurementUnitConversionsToUnit", testutils.ContextMatcher, tablespoon.ID).Return([]*types.ValidMeasurementUnitConversion{cupToTbsp}, nil)
+		dataManager.On("GetValidMeasurementUnitConversionsFromUnit", testutils.ContextMatcher, cup.ID).Return([]*types.ValidMeasurementUnitConversion{cupToTbsp}, nil)
+		dataManager.On("GetValidMeasurementUnitConversionsToUnit", testutils.ContextMatcher, cup.ID).Return([]*types.ValidMeasurementUnitConversion{}, nil)
+
+		b := NewGraphBuilder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), dataManager)
+
+		g, err := b.BuildGraph(ctx, teaspoon.ID)
+		assert.NoError(t, err)
+
+		actual, err := g.ConversionFactor(cup.ID, teaspoon.ID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "48", actual.String())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with error fetching conversions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cup := fakes.BuildFakeValidMeasurementUnit()
+
+		dataManager := &mocktypes.ValidMeasurementUnitConversionDataManagerMock{}
+		dataManager.On("GetValidMeasurementUnitConversionsFromUnit", testutils.ContextMatcher, cup.ID).Return([]*types.ValidMeasurementUnitConversion(nil), errors.New("blah"))
+
+		b := NewGraphBuilder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), dataManager)
+
+		g, err := b.BuildGraph(ctx, cup.ID)
+		assert.Error(t, err)
+		assert.Nil(t, g)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
@@ -0,0 +1,134 @@
+package unitconversion
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildConversionForTest(from, to *types.ValidMeasurementUnit, modifier float32, onlyFor *types.ValidIngredient) *types.ValidMeasurementUnitConversion {
+	conversion := fakes.BuildFakeValidMeasurementUnitConversion()
+	conversion.From = *from
+	conversion.To = *to
+	conversion.Modifier = modifier
+	conversion.OnlyForIngredient = onlyFor
+
+	return conversion
+}
+
+func TestGraph_ConversionFactor(T *testing.T) {
+	T.Parallel()
+
+	teaspoon := fakes.BuildFakeValidMeasurementUnit()
+	tablespoon := fakes.BuildFakeValidMeasurementUnit()
+	cup := fakes.BuildFakeValidMeasurementUnit()
+	gram := fakes.BuildFakeValidMeasurementUnit()
+	flour := fakes.BuildFakeValidIngredient()
+	sugar := fakes.BuildFakeValidIngredient()
+
+	T.Run("same unit", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := NewGraph().ConversionFactor(cup.ID, cup.ID, "")
+		assert.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(1).Equal(actual))
+	})
+
+	T.Run("direct", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(buildConversionForTest(tablespoon, teaspoon, 3, nil))
+
+		actual, err := g.ConversionFactor(tablespoon.ID, teaspoon.ID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", actual.String())
+	})
+
+	T.Run("inverse", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(buildConversionForTest(tablespoon, teaspoon, 4, nil))
+
+		actual, err := g.ConversionFactor(teaspoon.ID, tablespoon.ID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "0.25", actual.String())
+	})
+
+	T.Run("multiple hops", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(
+			buildConversionForTest(tablespoon, teaspoon, 3, nil),
+			buildConversionForTest(cup, tablespoon, 16, nil),
+		)
+
+		actual, err := g.ConversionFactor(cup.ID, teaspoon.ID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "48", actual.String())
+
+		converted, err := g.Convert(decimal.NewFromInt(96), teaspoon.ID, cup.ID, "")
+		assert.NoError(t, err)
+		assert.Equal(t, "2", converted.Round(4).String())
+	})
+
+	T.Run("ingredient-specific conversions only apply to their ingredient", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(buildConversionForTest(cup, gram, 120, flour))
+
+		actual, err := g.ConversionFactor(cup.ID, gram.ID, flour.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "120", actual.String())
+
+		_, err = g.ConversionFactor(cup.ID, gram.ID, sugar.ID)
+		assert.ErrorIs(t, err, ErrNoConversionPath)
+
+		_, err = g.ConversionFactor(cup.ID, gram.ID, "")
+		assert.ErrorIs(t, err, ErrNoConversionPath)
+	})
+
+	T.Run("ingredient-specific conversions override general ones", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(
+			buildConversionForTest(cup, gram, 240, nil),
+			buildConversionForTest(cup, gram, 120, flour),
+		)
+
+		actual, err := g.ConversionFactor(cup.ID, gram.ID, flour.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "120", actual.String())
+
+		actual, err = g.ConversionFactor(cup.ID, gram.ID, sugar.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "240", actual.String())
+	})
+
+	T.Run("multiple hops through an ingredient-specific conversion", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(
+			buildConversionForTest(tablespoon, teaspoon, 3, nil),
+			buildConversionForTest(cup, tablespoon, 16, nil),
+			buildConversionForTest(cup, gram, 120, flour),
+		)
+
+		actual, err := g.Convert(decimal.NewFromInt(6), teaspoon.ID, gram.ID, flour.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "15", actual.Round(4).String())
+	})
+
+	T.Run("ignores invalid modifiers", func(t *testing.T) {
+		t.Parallel()
+
+		g := NewGraph(buildConversionForTest(cup, gram, 0, nil))
+
+		_, err := g.ConversionFactor(gram.ID, cup.ID, "")
+		assert.ErrorIs(t, err, ErrNoConversionPath)
+	})
+}
@@ -0,0 +1,21 @@
+package unitconversion
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ GraphBuilder = (*MockGraphBuilder)(nil)
+
+// MockGraphBuilder is a mock GraphBuilder.
+type MockGraphBuilder struct {
+	mock.Mock
+}
+
+// BuildGraph is a mock function.
+func (m *MockGraphBuilder) BuildGraph(ctx context.Context, measurementUnitIDs ...string) (*Graph, error) {
+	returnValues := m.Called(ctx, measurementUnitIDs)
+
+	return returnValues.Get(0).(*Graph), returnValues.Error(1)
+}
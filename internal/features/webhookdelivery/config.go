@@ -0,0 +1,90 @@
+package webhookdelivery
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	defaultMaxAttempts             = 5
+	defaultInitialBackoff          = time.Second
+	defaultMaxBackoff              = time.Minute
+	defaultTimeout                 = 10 * time.Second
+	defaultFailuresBeforeDisabling = 10
+	defaultMaxResponseBodyBytes    = 1024
+	defaultRetryPollInterval       = 5 * time.Second
+	defaultRetryClaimDuration      = time.Minute
+	defaultRetryBatchSize          = 100
+)
+
+// Config configures webhook delivery. A delivery is attempted up to MaxAttempts times, waiting
+// InitialBackoff after the first failure and doubling (up to MaxBackoff) after each one thereafter.
+// Once a webhook has FailuresBeforeDisabling consecutive failed deliveries, it is disabled. Every
+// RetryPollInterval, the retry scheduler claims up to RetryBatchSize deliveries whose next attempt is due
+// for RetryClaimDuration, and requests their retries; a retry that isn't made before its claim lapses is
+// requested again. Up to MaxResponseBodyBytes of each response body is recorded, with anything unprintable removed.
+type Config struct {
+	_ struct{} `json:"-"`
+
+	InitialBackoff          time.Duration `json:"initialBackoff,omitempty"          toml:"initial_backoff,omitempty"`
+	MaxBackoff              time.Duration `json:"maxBackoff,omitempty"              toml:"max_backoff,omitempty"`
+	Timeout                 time.Duration `json:"timeout,omitempty"                 toml:"timeout,omitempty"`
+	RetryPollInterval       time.Duration `json:"retryPollInterval,omitempty"       toml:"retry_poll_interval,omitempty"`
+	RetryClaimDuration      time.Duration `json:"retryClaimDuration,omitempty"      toml:"retry_claim_duration,omitempty"`
+	MaxResponseBodyBytes    int64         `json:"maxResponseBodyBytes,omitempty"    toml:"max_response_body_bytes,omitempty"`
+	FailuresBeforeDisabling uint32        `json:"failuresBeforeDisabling,omitempty" toml:"failures_before_disabling,omitempty"`
+	MaxAttempts             uint16        `json:"maxAttempts,omitempty"             toml:"max_attempts,omitempty"`
+	RetryBatchSize          uint16        `json:"retryBatchSize,omitempty"          toml:"retry_batch_size,omitempty"`
+}
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg *Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.MaxBackoff, validation.Min(cfg.InitialBackoff)),
+		validation.Field(&cfg.MaxResponseBodyBytes, validation.Min(int64(0))),
+		validation.Field(&cfg.RetryPollInterval, validation.Min(time.Duration(0))),
+		validation.Field(&cfg.RetryClaimDuration, validation.Min(cfg.Timeout)),
+	)
+}
+
+// withDefaults returns a copy of the config with zero values replaced by sensible defaults.
+func (cfg *Config) withDefaults() *Config {
+	x := &Config{}
+	if cfg != nil {
+		*x = *cfg
+	}
+
+	if x.MaxAttempts == 0 {
+		x.MaxAttempts = defaultMaxAttempts
+	}
+	if x.InitialBackoff == 0 {
+		x.InitialBackoff = defaultInitialBackoff
+	}
+	if x.MaxBackoff == 0 {
+		x.MaxBackoff = defaultMaxBackoff
+	}
+	if x.Timeout == 0 {
+		x.Timeout = defaultTimeout
+	}
+	if x.FailuresBeforeDisabling == 0 {
+		x.FailuresBeforeDisabling = defaultFailuresBeforeDisabling
+	}
+	if x.MaxResponseBodyBytes == 0 {
+		x.MaxResponseBodyBytes = defaultMaxResponseBodyBytes
+	}
+	if x.RetryPollInterval == 0 {
+		x.RetryPollInterval = defaultRetryPollInterval
+	}
+	if x.RetryClaimDuration == 0 {
+		x.RetryClaimDuration = defaultRetryClaimDuration
+	}
+	if x.RetryBatchSize == 0 {
+		x.RetryBatchSize = defaultRetryBatchSize
+	}
+
+	return x
+}
@@ -0,0 +1,78 @@
+package webhookdelivery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Minute,
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with maximum backoff below initial backoff", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			InitialBackoff: time.Minute,
+			MaxBackoff:     time.Second,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with retry claim duration below timeout", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Timeout:            time.Minute,
+			RetryClaimDuration: time.Second,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+}
+
+func TestConfig_withDefaults(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil config", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg *Config
+		actual := cfg.withDefaults()
+
+		assert.Equal(t, uint16(defaultMaxAttempts), actual.MaxAttempts)
+		assert.Equal(t, defaultInitialBackoff, actual.InitialBackoff)
+		assert.Equal(t, defaultMaxBackoff, actual.MaxBackoff)
+		assert.Equal(t, defaultTimeout, actual.Timeout)
+		assert.Equal(t, uint32(defaultFailuresBeforeDisabling), actual.FailuresBeforeDisabling)
+		assert.Equal(t, int64(defaultMaxResponseBodyBytes), actual.MaxResponseBodyBytes)
+		assert.Equal(t, defaultRetryPollInterval, actual.RetryPollInterval)
+		assert.Equal(t, defaultRetryClaimDuration, actual.RetryClaimDuration)
+		assert.Equal(t, uint16(defaultRetryBatchSize), actual.RetryBatchSize)
+	})
+
+	T.Run("preserves provided values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{MaxAttempts: 2, FailuresBeforeDisabling: 3}
+		actual := cfg.withDefaults()
+
+		assert.Equal(t, uint16(2), actual.MaxAttempts)
+		assert.Equal(t, uint32(3), actual.FailuresBeforeDisabling)
+		assert.Equal(t, defaultMaxBackoff, actual.MaxBackoff)
+	})
+}
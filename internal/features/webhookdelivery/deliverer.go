@@ -0,0 +1,509 @@
+package webhookdelivery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	// SignatureHeader is the header containing the timestamped HMAC signatures of a delivered payload.
+	SignatureHeader = "X-Dinner-Done-Better-Signature"
+	// DeliveryIDHeader is the header containing the unique ID of a delivery, which stays the same across
+	// its retries and redeliveries so that receivers can deduplicate them.
+	DeliveryIDHeader = "X-Dinner-Done-Better-Delivery-ID"
+
+	signatureTimestampKey = "t"
+	signatureV1Key        = "v1"
+
+	// previewSignaturePlaceholder stands in for the signature in previews, which must not hand out a payload
+	// signed with the household's key.
+	previewSignaturePlaceholder = signatureTimestampKey + "=<timestamp>," + signatureV1Key + "=<signature>"
+
+	contentTypeJSON = "application/json"
+	contentTypeXML  = "application/xml"
+)
+
+var (
+	// ErrNilWebhook indicates a nil webhook was provided.
+	ErrNilWebhook = errors.New("nil webhook provided")
+	// ErrNilHousehold indicates a nil household was provided.
+	ErrNilHousehold = errors.New("nil household provided")
+	// ErrNilDelivery indicates a nil delivery was provided.
+	ErrNilDelivery = errors.New("nil delivery provided")
+	// ErrWebhookDisabled indicates a delivery was retried for a webhook that has since been disabled.
+	ErrWebhookDisabled = errors.New("webhook is disabled")
+	// ErrUnsupportedContentType indicates a webhook has a content type we can't encode payloads for.
+	ErrUnsupportedContentType = errors.New("unsupported webhook content type")
+	// ErrDeliveryFailed indicates an attempt to deliver a payload failed.
+	ErrDeliveryFailed = errors.New("webhook delivery failed")
+)
+
+// Deliverer delivers payloads to webhooks, recording every attempt.
+type Deliverer interface {
+	Deliver(ctx context.Context, household *types.Household, webhook *types.Webhook, triggerEvent string, payload any) (*types.WebhookDelivery, error)
+	Retry(ctx context.Context, household *types.Household, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error)
+	Redeliver(ctx context.Context, household *types.Household, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error)
+	Ping(ctx context.Context, household *types.Household, webhook *types.Webhook, payload any) (*types.WebhookDelivery, error)
+	Preview(ctx context.Context, household *types.Household, webhook *types.Webhook, payload any) (*types.WebhookPreview, error)
+}
+
+var _ Deliverer = (*deliverer)(nil)
+
+type deliverer struct {
+	logger      logging.Logger
+	tracer      tracing.Tracer
+	cfg         *Config
+	dataManager types.WebhookDeliveryDataManager
+	client      *http.Client
+	now         func() time.Time
+	jitter      func(d time.Duration) time.Duration
+}
+
+// NewDeliverer creates a Deliverer.
+func NewDeliverer(logger logging.Logger, tracerProvider tracing.TracerProvider, cfg *Config, dataManager types.WebhookDeliveryDataManager) Deliverer {
+	cfg = cfg.withDefaults()
+
+	return &deliverer{
+		logger:      logging.EnsureLogger(logger).WithName("webhook_deliverer"),
+		tracer:      tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer("webhook_deliverer")),
+		cfg:         cfg,
+		dataManager: dataManager,
+		client:      &http.Client{Transport: buildTransport(cfg.Timeout), Timeout: cfg.Timeout},
+		now:         time.Now,
+		jitter:      equalJitter,
+	}
+}
+
+// Deliver makes the first attempt to send a payload to a webhook. A failed attempt has its next attempt scheduled
+// with exponential backoff, which Retry makes once a RetryScheduler finds it due. A success resets the webhook's
+// consecutive failure count, and running out of attempts increments it, disabling the webhook once it reaches
+// the configured threshold.
+func (d *deliverer) Deliver(ctx context.Context, household *types.Household, webhook *types.Webhook, triggerEvent string, payload any) (*types.WebhookDelivery, error) {
+	ctx, span := d.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if household == nil {
+		return nil, ErrNilHousehold
+	}
+
+	if webhook == nil {
+		return nil, ErrNilWebhook
+	}
+
+	logger := d.logger.WithValue(keys.WebhookIDKey, webhook.ID).WithValue(keys.HouseholdIDKey, household.ID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhook.ID)
+
+	body, err := EncodePayload(webhook.ContentType, payload)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "encoding webhook payload")
+	}
+
+	delivery, err := d.attempt(ctx, household, webhook, identifiers.New(), triggerEvent, body, 1, true)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "attempting webhook delivery")
+	}
+
+	return d.recordOutcome(ctx, logger, webhook, delivery)
+}
+
+// Retry makes the next attempt at a failed delivery whose retry has come due, scheduling another if it fails
+// and attempts remain. Retries for a webhook that has since been disabled are dropped.
+func (d *deliverer) Retry(ctx context.Context, household *types.Household, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error) {
+	ctx, span := d.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if household == nil {
+		return nil, ErrNilHousehold
+	}
+
+	if webhook == nil {
+		return nil, ErrNilWebhook
+	}
+
+	if delivery == nil {
+		return nil, ErrNilDelivery
+	}
+
+	logger := d.logger.WithValue(keys.WebhookIDKey, webhook.ID).WithValue(keys.WebhookDeliveryIDKey, delivery.ID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhook.ID)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, delivery.ID)
+
+	if webhook.DisabledAt != nil {
+		d.markAsRetried(ctx, logger, delivery)
+		return nil, ErrWebhookDisabled
+	}
+
+	retry, err := d.attempt(ctx, household, webhook, delivery.DeliveryID, delivery.TriggerEvent, []byte(delivery.Payload), delivery.Attempt+1, true)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "attempting webhook delivery retry")
+	}
+
+	// if this fails, the delivery will be claimed and retried again once its claim lapses.
+	d.markAsRetried(ctx, logger, delivery)
+
+	return d.recordOutcome(ctx, logger, webhook, retry)
+}
+
+// Redeliver makes a single attempt to resend a previously recorded delivery's payload, under the same delivery ID.
+func (d *deliverer) Redeliver(ctx context.Context, household *types.Household, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error) {
+	ctx, span := d.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if household == nil {
+		return nil, ErrNilHousehold
+	}
+
+	if webhook == nil {
+		return nil, ErrNilWebhook
+	}
+
+	if delivery == nil {
+		return nil, ErrNilDelivery
+	}
+
+	logger := d.logger.WithValue(keys.WebhookIDKey, webhook.ID).WithValue(keys.WebhookDeliveryIDKey, delivery.ID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhook.ID)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, delivery.ID)
+
+	redelivery, err := d.attempt(ctx, household, webhook, delivery.DeliveryID, delivery.TriggerEvent, []byte(delivery.Payload), 1, false)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "attempting webhook redelivery")
+	}
+
+	return d.recordOutcome(ctx, logger, webhook, redelivery)
+}
+
+// Ping makes a single attempt to send a synthetic payload to a webhook. The attempt is recorded like any other,
+// but it doesn't count towards the webhook's consecutive failures, nor does it re-enable a disabled webhook.
+func (d *deliverer) Ping(ctx context.Context, household *types.Household, webhook *types.Webhook, payload any) (*types.WebhookDelivery, error) {
+	ctx, span := d.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if household == nil {
+		return nil, ErrNilHousehold
+	}
+
+	if webhook == nil {
+		return nil, ErrNilWebhook
+	}
+
+	logger := d.logger.WithValue(keys.WebhookIDKey, webhook.ID).WithValue(keys.HouseholdIDKey, household.ID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhook.ID)
+
+	body, err := EncodePayload(webhook.ContentType, payload)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "encoding webhook payload")
+	}
+
+	delivery, err := d.attempt(ctx, household, webhook, identifiers.New(), string(types.WebhookPingCustomerEventType), body, 1, false)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "attempting webhook ping")
+	}
+
+	if !delivery.Succeeded {
+		return delivery, ErrDeliveryFailed
+	}
+
+	return delivery, nil
+}
+
+// Preview renders the request that would be sent to a webhook for a given payload, without sending it.
+// The signature header is replaced with a placeholder.
+func (d *deliverer) Preview(ctx context.Context, household *types.Household, webhook *types.Webhook, payload any) (*types.WebhookPreview, error) {
+	ctx, span := d.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if household == nil {
+		return nil, ErrNilHousehold
+	}
+
+	if webhook == nil {
+		return nil, ErrNilWebhook
+	}
+
+	logger := d.logger.WithValue(keys.WebhookIDKey, webhook.ID).WithValue(keys.HouseholdIDKey, household.ID)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhook.ID)
+
+	body, err := EncodePayload(webhook.ContentType, payload)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "encoding webhook payload")
+	}
+
+	req, err := buildRequest(ctx, household, webhook, body, identifiers.New(), time.Now())
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building webhook request")
+	}
+
+	preview := &types.WebhookPreview{
+		Headers: map[string]string{},
+		Method:  req.Method,
+		URL:     req.URL.String(),
+		Body:    string(body),
+	}
+
+	for name := range req.Header {
+		preview.Headers[name] = req.Header.Get(name)
+	}
+	preview.Headers[http.CanonicalHeaderKey(SignatureHeader)] = previewSignaturePlaceholder
+
+	return preview, nil
+}
+
+// attempt makes a single attempt at a delivery and records its outcome. If the attempt fails, is retryable, and
+// attempts remain, the recorded delivery's next attempt is scheduled.
+func (d *deliverer) attempt(ctx context.Context, household *types.Household, webhook *types.Webhook, deliveryID, triggerEvent string, body []byte, attempt uint16, retryable bool) (*types.WebhookDelivery, error) {
+	ctx, span := d.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := d.logger.WithValue(keys.WebhookIDKey, webhook.ID).WithValue("attempt", attempt)
+
+	input := &types.WebhookDeliveryDatabaseCreationInput{
+		ID:               identifiers.New(),
+		DeliveryID:       deliveryID,
+		TriggerEvent:     triggerEvent,
+		Payload:          string(body),
+		BelongsToWebhook: webhook.ID,
+		Attempt:          attempt,
+	}
+
+	start := time.Now()
+
+	req, err := buildRequest(ctx, household, webhook, body, input.DeliveryID, start)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building webhook request")
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		input.LatencyInMilliseconds = uint64(time.Since(start).Milliseconds())
+		input.Error = err.Error()
+	} else {
+		responseBody, readErr := io.ReadAll(io.LimitReader(res.Body, d.cfg.MaxResponseBodyBytes))
+		input.LatencyInMilliseconds = uint64(time.Since(start).Milliseconds())
+		if readErr != nil {
+			observability.AcknowledgeError(readErr, logger, span, "reading webhook response body")
+		}
+
+		if closeErr := res.Body.Close(); closeErr != nil {
+			observability.AcknowledgeError(closeErr, logger, span, "closing webhook response body")
+		}
+
+		statusCode := uint16(res.StatusCode)
+		input.ResponseStatusCode = &statusCode
+		input.ResponseBody = sanitizeResponseBody(responseBody)
+		input.Succeeded = res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices
+		if !input.Succeeded {
+			input.Error = fmt.Sprintf("unexpected response status code: %d", res.StatusCode)
+		}
+	}
+
+	if !input.Succeeded && retryable && attempt < d.cfg.MaxAttempts {
+		nextAttemptAt := d.now().Add(d.backoff(attempt))
+		input.NextAttemptAt = &nextAttemptAt
+	}
+
+	delivery, err := d.dataManager.CreateWebhookDelivery(ctx, input)
+	if err != nil {
+		// losing the log entry shouldn't change the outcome of the delivery itself.
+		observability.AcknowledgeError(err, logger, span, "recording webhook delivery")
+		delivery = &types.WebhookDelivery{
+			CreatedAt:             start,
+			NextAttemptAt:         input.NextAttemptAt,
+			ResponseStatusCode:    input.ResponseStatusCode,
+			ID:                    input.ID,
+			DeliveryID:            input.DeliveryID,
+			TriggerEvent:          input.TriggerEvent,
+			Payload:               input.Payload,
+			ResponseBody:          input.ResponseBody,
+			Error:                 input.Error,
+			BelongsToWebhook:      input.BelongsToWebhook,
+			LatencyInMilliseconds: input.LatencyInMilliseconds,
+			Attempt:               input.Attempt,
+			Succeeded:             input.Succeeded,
+		}
+	}
+
+	return delivery, nil
+}
+
+// buildRequest builds the signed request that delivers an encoded payload to a webhook.
+func buildRequest(ctx context.Context, household *types.Household, webhook *types.Webhook, body []byte, deliveryID string, now time.Time) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, webhook.Method, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook request: %w", err)
+	}
+
+	signature, err := BuildSignatureHeader(now, body, SigningKeys(household, now)...)
+	if err != nil {
+		return nil, fmt.Errorf("signing webhook payload: %w", err)
+	}
+
+	req.Header.Set("Content-Type", webhook.ContentType)
+	req.Header.Set(SignatureHeader, signature)
+	req.Header.Set(DeliveryIDHeader, deliveryID)
+
+	return req, nil
+}
+
+// recordOutcome updates a webhook's consecutive failure count once a delivery has succeeded or run out of attempts.
+func (d *deliverer) recordOutcome(ctx context.Context, logger logging.Logger, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error) {
+	if delivery.Succeeded {
+		d.recordSuccess(ctx, logger, webhook)
+		return delivery, nil
+	}
+
+	if delivery.NextAttemptAt == nil {
+		d.recordFailure(ctx, logger, webhook)
+	}
+
+	return delivery, ErrDeliveryFailed
+}
+
+func (d *deliverer) markAsRetried(ctx context.Context, logger logging.Logger, delivery *types.WebhookDelivery) {
+	if err := d.dataManager.MarkWebhookDeliveryAsRetried(ctx, delivery.ID); err != nil {
+		observability.AcknowledgeError(err, logger, nil, "marking webhook delivery as retried")
+	}
+}
+
+func (d *deliverer) recordSuccess(ctx context.Context, logger logging.Logger, webhook *types.Webhook) {
+	if err := d.dataManager.RecordWebhookDeliverySuccess(ctx, webhook.ID); err != nil {
+		observability.AcknowledgeError(err, logger, nil, "recording webhook delivery success")
+	}
+}
+
+func (d *deliverer) recordFailure(ctx context.Context, logger logging.Logger, webhook *types.Webhook) {
+	consecutiveFailures, err := d.dataManager.RecordWebhookDeliveryFailure(ctx, webhook.ID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, nil, "recording webhook delivery failure")
+		return
+	}
+
+	if consecutiveFailures < d.cfg.FailuresBeforeDisabling {
+		return
+	}
+
+	logger = logger.WithValue("consecutive_failures", consecutiveFailures)
+	if err = d.dataManager.DisableWebhook(ctx, webhook.ID); err != nil {
+		observability.AcknowledgeError(err, logger, nil, "disabling webhook")
+		return
+	}
+
+	logger.Info("webhook disabled after consecutive delivery failures")
+}
+
+// backoff determines how long to wait before retrying a given failed attempt.
+func (d *deliverer) backoff(attempt uint16) time.Duration {
+	wait := d.cfg.InitialBackoff
+	for i := uint16(1); i < attempt && wait < d.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > d.cfg.MaxBackoff {
+		wait = d.cfg.MaxBackoff
+	}
+
+	return d.jitter(wait)
+}
+
+// equalJitter returns a random duration between half of the provided duration and the full duration,
+// so that webhooks which failed together don't all retry at the same instant.
+func equalJitter(d time.Duration) time.Duration {
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+
+	return half + rand.N(half+1)
+}
+
+// EncodePayload encodes a payload in the provided content type.
+func EncodePayload(contentType string, payload any) ([]byte, error) {
+	switch contentType {
+	case contentTypeJSON:
+		return json.Marshal(payload)
+	case contentTypeXML:
+		return xml.Marshal(payload)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedContentType, contentType)
+	}
+}
+
+// SigningKeys returns the keys a household's payloads should be signed with at a given time: its current
+// key, and, during the grace window that follows a rotation, its previous one.
+func SigningKeys(household *types.Household, now time.Time) []string {
+	keys := []string{household.WebhookEncryptionKey}
+
+	if household.PreviousWebhookEncryptionKey != "" &&
+		household.PreviousWebhookEncryptionKeyExpiresAt != nil &&
+		now.Before(*household.PreviousWebhookEncryptionKeyExpiresAt) {
+		keys = append(keys, household.PreviousWebhookEncryptionKey)
+	}
+
+	return keys
+}
+
+// BuildSignatureHeader builds a signature header value of the form `t=<unix seconds>,v1=<signature>`,
+// with one v1 signature for each provided hex-encoded key.
+func BuildSignatureHeader(timestamp time.Time, payload []byte, hexKeys ...string) (string, error) {
+	parts := []string{fmt.Sprintf("%s=%d", signatureTimestampKey, timestamp.Unix())}
+
+	for _, hexKey := range hexKeys {
+		signature, err := Sign(hexKey, timestamp, payload)
+		if err != nil {
+			return "", err
+		}
+
+		parts = append(parts, fmt.Sprintf("%s=%s", signatureV1Key, signature))
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// Sign produces the hex-encoded HMAC-SHA256 signature of a payload sent at a given time for a hex-encoded key.
+// The signed content is the timestamp's unix seconds, a period, and the payload, so that a captured payload
+// can't be replayed with a fresh timestamp.
+func Sign(hexKey string, timestamp time.Time, payload []byte) (string, error) {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return "", fmt.Errorf("decoding webhook encryption key: %w", err)
+	}
+
+	digest := hmac.New(sha256.New, key)
+	digest.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10) + "."))
+	digest.Write(payload)
+
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
+
+// sanitizeResponseBody makes a (possibly truncated) response body safe to record and display, by replacing invalid
+// UTF-8, which truncation can produce, and removing control characters other than whitespace.
+func sanitizeResponseBody(body []byte) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) && !unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, strings.ToValidUTF8(string(body), string(utf8.RuneError)))
+}
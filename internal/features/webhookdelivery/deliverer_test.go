@@ -0,0 +1,859 @@
+package webhookdelivery
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/apiclient"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// buildTestDeliverer returns a deliverer without jitter, whose clock is stopped at the returned time, and
+// which can deliver to the loopback addresses test servers listen on.
+func buildTestDeliverer(t *testing.T, cfg *Config, dataManager types.WebhookDeliveryDataManager) (*deliverer, time.Time) {
+	t.Helper()
+
+	d, ok := NewDeliverer(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg, dataManager).(*deliverer)
+	require.True(t, ok)
+
+	d.client = &http.Client{Timeout: d.cfg.Timeout}
+
+	now := time.Now()
+	d.now = func() time.Time { return now }
+	d.jitter = func(d time.Duration) time.Duration { return d }
+
+	return d, now
+}
+
+// buildTestServer returns a server that responds with the provided status codes in order, repeating the last one.
+func buildTestServer(t *testing.T, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+		i := int(calls.Add(1)) - 1
+		if i >= len(statusCodes) {
+			i = len(statusCodes) - 1
+		}
+
+		res.WriteHeader(statusCodes[i])
+		_, _ = res.Write([]byte(http.StatusText(statusCodes[i])))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func buildTestHousehold() *types.Household {
+	household := fakes.BuildFakeHousehold()
+	household.WebhookEncryptionKey = hex.EncodeToString([]byte(strings.Repeat("k", 64)))
+
+	return household
+}
+
+func buildTestWebhook(url string) *types.Webhook {
+	webhook := fakes.BuildFakeWebhook()
+	webhook.URL = url
+
+	return webhook
+}
+
+func deliveryInputMatcher(succeeded bool) any {
+	return mock.MatchedBy(func(input *types.WebhookDeliveryDatabaseCreationInput) bool {
+		return input.Succeeded == succeeded
+	})
+}
+
+func returnDeliveryFromInput(args mock.Arguments) *types.WebhookDelivery {
+	input := args.Get(1).(*types.WebhookDeliveryDatabaseCreationInput)
+	return &types.WebhookDelivery{
+		NextAttemptAt:         input.NextAttemptAt,
+		ResponseStatusCode:    input.ResponseStatusCode,
+		ID:                    input.ID,
+		DeliveryID:            input.DeliveryID,
+		TriggerEvent:          input.TriggerEvent,
+		Payload:               input.Payload,
+		ResponseBody:          input.ResponseBody,
+		Error:                 input.Error,
+		BelongsToWebhook:      input.BelongsToWebhook,
+		LatencyInMilliseconds: input.LatencyInMilliseconds,
+		Attempt:               input.Attempt,
+		Succeeded:             input.Succeeded,
+	}
+}
+
+func expectDeliveryRecorded(dataManager *mocktypes.WebhookDeliveryDataManagerMock, inputMatcher any, times int) {
+	call := dataManager.On("CreateWebhookDelivery", testutils.ContextMatcher, inputMatcher)
+	call.RunFn = func(args mock.Arguments) {
+		call.ReturnArguments = mock.Arguments{returnDeliveryFromInput(args), nil}
+	}
+	call.Times(times)
+}
+
+func TestDeliverer_Deliver(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		household := buildTestHousehold()
+		payload := map[string]string{"things": "stuff"}
+
+		var (
+			receivedBody       []byte
+			receivedSignature  string
+			receivedDeliveryID string
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedBody, _ = io.ReadAll(req.Body)
+			receivedSignature = req.Header.Get(SignatureHeader)
+			receivedDeliveryID = req.Header.Get(DeliveryIDHeader)
+			res.WriteHeader(http.StatusAccepted)
+		}))
+		t.Cleanup(server.Close)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(true), 1)
+		dataManager.On("RecordWebhookDeliverySuccess", testutils.ContextMatcher, webhook.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Deliver(ctx, household, webhook, string(types.WebhookCreatedCustomerEventType), payload)
+		require.NoError(t, err)
+		require.NotNil(t, delivery)
+
+		assert.True(t, delivery.Succeeded)
+		assert.Equal(t, uint16(1), delivery.Attempt)
+		assert.Equal(t, uint16(http.StatusAccepted), *delivery.ResponseStatusCode)
+		assert.Equal(t, `{"things":"stuff"}`, delivery.Payload)
+		assert.Equal(t, delivery.Payload, string(receivedBody))
+		assert.Nil(t, delivery.NextAttemptAt)
+
+		assert.Equal(t, delivery.DeliveryID, receivedDeliveryID)
+		assert.NoError(t, apiclient.VerifyWebhookSignature(receivedBody, receivedSignature, household.WebhookEncryptionKey, time.Minute))
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("signs with both keys during rotation grace window", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		household := buildTestHousehold()
+		previousKey := household.WebhookEncryptionKey
+		household.WebhookEncryptionKey = hex.EncodeToString([]byte(strings.Repeat("n", 64)))
+		household.PreviousWebhookEncryptionKey = previousKey
+		household.PreviousWebhookEncryptionKeyExpiresAt = pointer.To(time.Now().Add(time.Hour))
+
+		var (
+			receivedBody      []byte
+			receivedSignature string
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedBody, _ = io.ReadAll(req.Body)
+			receivedSignature = req.Header.Get(SignatureHeader)
+			res.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(true), 1)
+		dataManager.On("RecordWebhookDeliverySuccess", testutils.ContextMatcher, webhook.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		_, err := d.Deliver(ctx, household, webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, strings.Count(receivedSignature, "v1="))
+		assert.NoError(t, apiclient.VerifyWebhookSignature(receivedBody, receivedSignature, household.WebhookEncryptionKey, time.Minute))
+		assert.NoError(t, apiclient.VerifyWebhookSignature(receivedBody, receivedSignature, previousKey, time.Minute))
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("schedules retry after failure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, calls := buildTestServer(t, http.StatusInternalServerError)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+
+		d, now := buildTestDeliverer(t, &Config{InitialBackoff: time.Second, MaxBackoff: time.Minute}, dataManager)
+
+		delivery, err := d.Deliver(ctx, buildTestHousehold(), webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		require.NotNil(t, delivery)
+
+		assert.False(t, delivery.Succeeded)
+		assert.Equal(t, uint16(1), delivery.Attempt)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.Equal(t, now.Add(time.Second), *delivery.NextAttemptAt)
+		assert.Equal(t, int32(1), calls.Load())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("disables webhook after enough consecutive failures", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, calls := buildTestServer(t, http.StatusInternalServerError)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+		dataManager.On("RecordWebhookDeliveryFailure", testutils.ContextMatcher, webhook.ID).Return(uint32(5), nil)
+		dataManager.On("DisableWebhook", testutils.ContextMatcher, webhook.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, &Config{MaxAttempts: 1, FailuresBeforeDisabling: 5}, dataManager)
+
+		delivery, err := d.Deliver(ctx, buildTestHousehold(), webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		require.NotNil(t, delivery)
+
+		assert.False(t, delivery.Succeeded)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Equal(t, "unexpected response status code: 500", delivery.Error)
+		assert.Equal(t, int32(1), calls.Load())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("does not disable webhook below failure threshold", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, _ := buildTestServer(t, http.StatusNotFound)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+		dataManager.On("RecordWebhookDeliveryFailure", testutils.ContextMatcher, webhook.ID).Return(uint32(4), nil)
+
+		d, _ := buildTestDeliverer(t, &Config{MaxAttempts: 1, FailuresBeforeDisabling: 5}, dataManager)
+
+		_, err := d.Deliver(ctx, buildTestHousehold(), webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with unreachable webhook", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server := httptest.NewServer(http.NotFoundHandler())
+		webhook := buildTestWebhook(server.URL)
+		server.Close()
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, mock.MatchedBy(func(input *types.WebhookDeliveryDatabaseCreationInput) bool {
+			return !input.Succeeded && input.ResponseStatusCode == nil && input.Error != ""
+		}), 1)
+		dataManager.On("RecordWebhookDeliveryFailure", testutils.ContextMatcher, webhook.ID).Return(uint32(1), nil)
+
+		d, _ := buildTestDeliverer(t, &Config{MaxAttempts: 1}, dataManager)
+
+		_, err := d.Deliver(ctx, buildTestHousehold(), webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("truncates and sanitizes response body", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+			_, _ = res.Write([]byte("a\x1b[31mb\n" + strings.Repeat("c", 100)))
+		}))
+		t.Cleanup(server.Close)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, mock.MatchedBy(func(input *types.WebhookDeliveryDatabaseCreationInput) bool {
+			return input.ResponseBody == "a[31mb\ncc"
+		}), 1)
+		dataManager.On("RecordWebhookDeliverySuccess", testutils.ContextMatcher, webhook.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, &Config{MaxResponseBodyBytes: 10}, dataManager)
+
+		_, err := d.Deliver(ctx, buildTestHousehold(), webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("refuses non-public destinations", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, calls := buildTestServer(t, http.StatusOK)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, mock.MatchedBy(func(input *types.WebhookDeliveryDatabaseCreationInput) bool {
+			return !input.Succeeded && strings.Contains(input.Error, ErrDisallowedDestination.Error())
+		}), 1)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+		d.client = &http.Client{Transport: buildTransport(time.Second)}
+
+		_, err := d.Deliver(ctx, buildTestHousehold(), webhook, string(types.WebhookCreatedCustomerEventType), map[string]string{})
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		assert.Zero(t, calls.Load())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with nil household", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Deliver(context.Background(), nil, fakes.BuildFakeWebhook(), "", nil)
+		assert.ErrorIs(t, err, ErrNilHousehold)
+	})
+
+	T.Run("with nil webhook", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Deliver(context.Background(), buildTestHousehold(), nil, "", nil)
+		assert.ErrorIs(t, err, ErrNilWebhook)
+	})
+
+	T.Run("with unsupported content type", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := fakes.BuildFakeWebhook()
+		webhook.ContentType = "text/plain"
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Deliver(context.Background(), buildTestHousehold(), webhook, "", nil)
+		assert.ErrorIs(t, err, ErrUnsupportedContentType)
+	})
+
+	T.Run("with invalid household key", func(t *testing.T) {
+		t.Parallel()
+
+		household := buildTestHousehold()
+		household.WebhookEncryptionKey = "not hex"
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Deliver(context.Background(), household, fakes.BuildFakeWebhook(), "", map[string]string{})
+		assert.Error(t, err)
+	})
+}
+
+func TestDeliverer_Retry(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		var receivedDeliveryID string
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedDeliveryID = req.Header.Get(DeliveryIDHeader)
+			res.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		webhook := buildTestWebhook(server.URL)
+		previousDelivery := fakes.BuildFakeWebhookDelivery()
+		previousDelivery.Attempt = 2
+		previousDelivery.BelongsToWebhook = webhook.ID
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(true), 1)
+		dataManager.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, previousDelivery.ID).Return(nil)
+		dataManager.On("RecordWebhookDeliverySuccess", testutils.ContextMatcher, webhook.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Retry(ctx, buildTestHousehold(), webhook, previousDelivery)
+		require.NoError(t, err)
+
+		assert.True(t, delivery.Succeeded)
+		assert.Equal(t, uint16(3), delivery.Attempt)
+		assert.Equal(t, previousDelivery.Payload, delivery.Payload)
+		assert.Equal(t, previousDelivery.TriggerEvent, delivery.TriggerEvent)
+		assert.NotEqual(t, previousDelivery.ID, delivery.ID)
+		assert.Equal(t, previousDelivery.DeliveryID, delivery.DeliveryID)
+		assert.Equal(t, previousDelivery.DeliveryID, receivedDeliveryID)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("schedules another retry with longer backoff", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, _ := buildTestServer(t, http.StatusBadGateway)
+		webhook := buildTestWebhook(server.URL)
+		previousDelivery := fakes.BuildFakeWebhookDelivery()
+		previousDelivery.Attempt = 2
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+		dataManager.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, previousDelivery.ID).Return(nil)
+
+		d, now := buildTestDeliverer(t, &Config{InitialBackoff: time.Second, MaxBackoff: time.Minute}, dataManager)
+
+		delivery, err := d.Retry(ctx, buildTestHousehold(), webhook, previousDelivery)
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		require.NotNil(t, delivery)
+
+		assert.Equal(t, uint16(3), delivery.Attempt)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.Equal(t, now.Add(4*time.Second), *delivery.NextAttemptAt)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("records failure once attempts run out", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, _ := buildTestServer(t, http.StatusInternalServerError)
+		webhook := buildTestWebhook(server.URL)
+		previousDelivery := fakes.BuildFakeWebhookDelivery()
+		previousDelivery.Attempt = 2
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+		dataManager.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, previousDelivery.ID).Return(nil)
+		dataManager.On("RecordWebhookDeliveryFailure", testutils.ContextMatcher, webhook.ID).Return(uint32(1), nil)
+
+		d, _ := buildTestDeliverer(t, &Config{MaxAttempts: 3}, dataManager)
+
+		delivery, err := d.Retry(ctx, buildTestHousehold(), webhook, previousDelivery)
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		require.NotNil(t, delivery)
+
+		assert.Equal(t, uint16(3), delivery.Attempt)
+		assert.Nil(t, delivery.NextAttemptAt)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with disabled webhook", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, calls := buildTestServer(t, http.StatusOK)
+		webhook := buildTestWebhook(server.URL)
+		webhook.DisabledAt = pointer.To(time.Now())
+		previousDelivery := fakes.BuildFakeWebhookDelivery()
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		dataManager.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, previousDelivery.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Retry(ctx, buildTestHousehold(), webhook, previousDelivery)
+		assert.ErrorIs(t, err, ErrWebhookDisabled)
+		assert.Nil(t, delivery)
+		assert.Zero(t, calls.Load())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with nil delivery", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Retry(context.Background(), buildTestHousehold(), fakes.BuildFakeWebhook(), nil)
+		assert.ErrorIs(t, err, ErrNilDelivery)
+	})
+}
+
+func TestDeliverer_Redeliver(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		var receivedDeliveryID string
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedDeliveryID = req.Header.Get(DeliveryIDHeader)
+			res.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(server.Close)
+		webhook := buildTestWebhook(server.URL)
+		previousDelivery := fakes.BuildFakeWebhookDelivery()
+		previousDelivery.Attempt = 5
+		previousDelivery.BelongsToWebhook = webhook.ID
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(true), 1)
+		dataManager.On("RecordWebhookDeliverySuccess", testutils.ContextMatcher, webhook.ID).Return(nil)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Redeliver(ctx, buildTestHousehold(), webhook, previousDelivery)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, previousDelivery.ID, delivery.ID)
+		assert.Equal(t, previousDelivery.Payload, delivery.Payload)
+		assert.Equal(t, previousDelivery.TriggerEvent, delivery.TriggerEvent)
+		assert.Equal(t, uint16(1), delivery.Attempt)
+		assert.Equal(t, previousDelivery.DeliveryID, delivery.DeliveryID)
+		assert.Equal(t, previousDelivery.DeliveryID, receivedDeliveryID)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("does not retry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, calls := buildTestServer(t, http.StatusInternalServerError)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+		dataManager.On("RecordWebhookDeliveryFailure", testutils.ContextMatcher, webhook.ID).Return(uint32(1), nil)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Redeliver(ctx, buildTestHousehold(), webhook, fakes.BuildFakeWebhookDelivery())
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		require.NotNil(t, delivery)
+		assert.False(t, delivery.Succeeded)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Equal(t, int32(1), calls.Load())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with nil delivery", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Redeliver(context.Background(), buildTestHousehold(), fakes.BuildFakeWebhook(), nil)
+		assert.ErrorIs(t, err, ErrNilDelivery)
+	})
+}
+
+func TestDeliverer_Ping(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		household := buildTestHousehold()
+		payload := &types.DataChangeMessage{EventType: types.WebhookPingCustomerEventType, HouseholdID: household.ID}
+
+		var (
+			receivedBody      []byte
+			receivedSignature string
+		)
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedBody, _ = io.ReadAll(req.Body)
+			receivedSignature = req.Header.Get(SignatureHeader)
+			res.WriteHeader(http.StatusOK)
+			_, _ = res.Write([]byte("pong"))
+		}))
+		t.Cleanup(server.Close)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(true), 1)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Ping(ctx, household, webhook, payload)
+		require.NoError(t, err)
+		require.NotNil(t, delivery)
+
+		assert.True(t, delivery.Succeeded)
+		assert.Equal(t, string(types.WebhookPingCustomerEventType), delivery.TriggerEvent)
+		assert.Equal(t, "pong", delivery.ResponseBody)
+		assert.NoError(t, apiclient.VerifyWebhookSignature(receivedBody, receivedSignature, household.WebhookEncryptionKey, time.Minute))
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("does not retry or count failures", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		server, calls := buildTestServer(t, http.StatusInternalServerError)
+		webhook := buildTestWebhook(server.URL)
+
+		dataManager := &mocktypes.WebhookDeliveryDataManagerMock{}
+		expectDeliveryRecorded(dataManager, deliveryInputMatcher(false), 1)
+
+		d, _ := buildTestDeliverer(t, nil, dataManager)
+
+		delivery, err := d.Ping(ctx, buildTestHousehold(), webhook, map[string]string{})
+		assert.ErrorIs(t, err, ErrDeliveryFailed)
+		require.NotNil(t, delivery)
+		assert.Equal(t, uint16(http.StatusInternalServerError), *delivery.ResponseStatusCode)
+		assert.Nil(t, delivery.NextAttemptAt)
+		assert.Equal(t, int32(1), calls.Load())
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with nil household", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Ping(context.Background(), nil, fakes.BuildFakeWebhook(), nil)
+		assert.ErrorIs(t, err, ErrNilHousehold)
+	})
+
+	T.Run("with nil webhook", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Ping(context.Background(), buildTestHousehold(), nil, nil)
+		assert.ErrorIs(t, err, ErrNilWebhook)
+	})
+}
+
+func TestDeliverer_Preview(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		household := buildTestHousehold()
+		webhook := fakes.BuildFakeWebhook()
+		payload := map[string]string{"things": "stuff"}
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		preview, err := d.Preview(context.Background(), household, webhook, payload)
+		require.NoError(t, err)
+		require.NotNil(t, preview)
+
+		assert.Equal(t, webhook.Method, preview.Method)
+		assert.Equal(t, webhook.URL, preview.URL)
+		assert.Equal(t, `{"things":"stuff"}`, preview.Body)
+		assert.Equal(t, webhook.ContentType, preview.Headers["Content-Type"])
+		assert.NotEmpty(t, preview.Headers[http.CanonicalHeaderKey(DeliveryIDHeader)])
+		assert.Equal(t, previewSignaturePlaceholder, preview.Headers[http.CanonicalHeaderKey(SignatureHeader)])
+		assert.Error(t, apiclient.VerifyWebhookSignature([]byte(preview.Body), preview.Headers[http.CanonicalHeaderKey(SignatureHeader)], household.WebhookEncryptionKey, time.Minute))
+	})
+
+	T.Run("with XML webhook", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := fakes.BuildFakeWebhook()
+		webhook.ContentType = contentTypeXML
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		preview, err := d.Preview(context.Background(), buildTestHousehold(), webhook, fakes.BuildFakeWebhookTriggerEvent())
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(preview.Body, "<WebhookTriggerEvent>"))
+		assert.Equal(t, contentTypeXML, preview.Headers["Content-Type"])
+	})
+
+	T.Run("with unsupported content type", func(t *testing.T) {
+		t.Parallel()
+
+		webhook := fakes.BuildFakeWebhook()
+		webhook.ContentType = "text/plain"
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Preview(context.Background(), buildTestHousehold(), webhook, nil)
+		assert.ErrorIs(t, err, ErrUnsupportedContentType)
+	})
+
+	T.Run("with nil webhook", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, nil, &mocktypes.WebhookDeliveryDataManagerMock{})
+
+		_, err := d.Preview(context.Background(), buildTestHousehold(), nil, nil)
+		assert.ErrorIs(t, err, ErrNilWebhook)
+	})
+}
+
+func TestDeliverer_backoff(T *testing.T) {
+	T.Parallel()
+
+	T.Run("doubles up to the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		d, _ := buildTestDeliverer(t, &Config{InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}, nil)
+
+		expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+		for i, want := range expected {
+			assert.Equal(t, want, d.backoff(uint16(i+1)))
+		}
+
+		assert.Equal(t, 10*time.Second, d.backoff(1000))
+	})
+}
+
+func Test_equalJitter(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		for range 100 {
+			actual := equalJitter(time.Second)
+			assert.GreaterOrEqual(t, actual, 500*time.Millisecond)
+			assert.LessOrEqual(t, actual, time.Second)
+		}
+	})
+}
+
+func TestEncodePayload(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with XML", func(t *testing.T) {
+		t.Parallel()
+
+		type example struct {
+			Name string `xml:"name"`
+		}
+
+		actual, err := EncodePayload("application/xml", &example{Name: "things"})
+		assert.NoError(t, err)
+		assert.Equal(t, "<example><name>things</name></example>", string(actual))
+	})
+}
+
+func TestSigningKeys(T *testing.T) {
+	T.Parallel()
+
+	T.Run("without a previous key", func(t *testing.T) {
+		t.Parallel()
+
+		household := buildTestHousehold()
+
+		assert.Equal(t, []string{household.WebhookEncryptionKey}, SigningKeys(household, time.Now()))
+	})
+
+	T.Run("within the grace window", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		household := buildTestHousehold()
+		household.PreviousWebhookEncryptionKey = hex.EncodeToString([]byte("previous"))
+		household.PreviousWebhookEncryptionKeyExpiresAt = pointer.To(now.Add(time.Minute))
+
+		assert.Equal(t, []string{household.WebhookEncryptionKey, household.PreviousWebhookEncryptionKey}, SigningKeys(household, now))
+	})
+
+	T.Run("after the grace window", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		household := buildTestHousehold()
+		household.PreviousWebhookEncryptionKey = hex.EncodeToString([]byte("previous"))
+		household.PreviousWebhookEncryptionKeyExpiresAt = pointer.To(now.Add(-time.Minute))
+
+		assert.Equal(t, []string{household.WebhookEncryptionKey}, SigningKeys(household, now))
+	})
+}
+
+func TestBuildSignatureHeader(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		timestamp := time.Unix(1700000000, 0)
+		key := hex.EncodeToString([]byte("key"))
+		payload := []byte(`{"things":"stuff"}`)
+
+		signature, err := Sign(key, timestamp, payload)
+		require.NoError(t, err)
+
+		actual, err := BuildSignatureHeader(timestamp, payload, key)
+		require.NoError(t, err)
+		assert.Equal(t, "t=1700000000,v1="+signature, actual)
+	})
+
+	T.Run("with invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := BuildSignatureHeader(time.Now(), []byte("stuff"), "not hex")
+		assert.Error(t, err)
+		assert.Empty(t, actual)
+	})
+}
+
+func TestSign(T *testing.T) {
+	T.Parallel()
+
+	T.Run("signature depends on timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		key := hex.EncodeToString([]byte("key"))
+		payload := []byte("stuff")
+
+		first, err := Sign(key, time.Unix(1, 0), payload)
+		require.NoError(t, err)
+
+		second, err := Sign(key, time.Unix(2, 0), payload)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestSanitizeResponseBody(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "{\"ok\": true}\r\n", sanitizeResponseBody([]byte("{\"ok\": true}\r\n")))
+	})
+
+	T.Run("with control characters", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "a[31mb", sanitizeResponseBody([]byte("a\x1b[31m\x00b")))
+	})
+
+	T.Run("with truncated multibyte character", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "caf\uFFFD", sanitizeResponseBody([]byte("café")[:4]))
+	})
+}
@@ -0,0 +1,51 @@
+package webhookdelivery
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ Deliverer = (*MockDeliverer)(nil)
+
+// MockDeliverer is a mock Deliverer.
+type MockDeliverer struct {
+	mock.Mock
+}
+
+// Deliver is a mock function.
+func (m *MockDeliverer) Deliver(ctx context.Context, household *types.Household, webhook *types.Webhook, triggerEvent string, payload any) (*types.WebhookDelivery, error) {
+	returnValues := m.Called(ctx, household, webhook, triggerEvent, payload)
+
+	return returnValues.Get(0).(*types.WebhookDelivery), returnValues.Error(1)
+}
+
+// Retry is a mock function.
+func (m *MockDeliverer) Retry(ctx context.Context, household *types.Household, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error) {
+	returnValues := m.Called(ctx, household, webhook, delivery)
+
+	return returnValues.Get(0).(*types.WebhookDelivery), returnValues.Error(1)
+}
+
+// Redeliver is a mock function.
+func (m *MockDeliverer) Redeliver(ctx context.Context, household *types.Household, webhook *types.Webhook, delivery *types.WebhookDelivery) (*types.WebhookDelivery, error) {
+	returnValues := m.Called(ctx, household, webhook, delivery)
+
+	return returnValues.Get(0).(*types.WebhookDelivery), returnValues.Error(1)
+}
+
+// Ping is a mock function.
+func (m *MockDeliverer) Ping(ctx context.Context, household *types.Household, webhook *types.Webhook, payload any) (*types.WebhookDelivery, error) {
+	returnValues := m.Called(ctx, household, webhook, payload)
+
+	return returnValues.Get(0).(*types.WebhookDelivery), returnValues.Error(1)
+}
+
+// Preview is a mock function.
+func (m *MockDeliverer) Preview(ctx context.Context, household *types.Household, webhook *types.Webhook, payload any) (*types.WebhookPreview, error) {
+	returnValues := m.Called(ctx, household, webhook, payload)
+
+	return returnValues.Get(0).(*types.WebhookPreview), returnValues.Error(1)
+}
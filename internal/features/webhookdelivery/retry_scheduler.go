@@ -0,0 +1,103 @@
+package webhookdelivery
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	retrySchedulerName = "webhook_delivery_retry_scheduler"
+)
+
+type (
+	// RetryStore is what the retry scheduler needs from the database.
+	RetryStore interface {
+		ClaimDueWebhookDeliveryRetries(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*types.WebhookDeliveryRetry, error)
+	}
+
+	// RetryScheduler requests retries of failed webhook deliveries once they're due.
+	RetryScheduler interface {
+		Run(ctx context.Context) error
+	}
+
+	retryScheduler struct {
+		logger    logging.Logger
+		tracer    tracing.Tracer
+		cfg       *Config
+		store     RetryStore
+		publisher messagequeue.Publisher
+	}
+)
+
+// NewRetryScheduler creates a RetryScheduler, which publishes retry requests to the provided webhook
+// execution requests publisher, so that retries are made by whichever worker consumes them.
+func NewRetryScheduler(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	cfg *Config,
+	store RetryStore,
+	publisher messagequeue.Publisher,
+) RetryScheduler {
+	return &retryScheduler{
+		logger:    logging.EnsureLogger(logger).WithName(retrySchedulerName),
+		tracer:    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(retrySchedulerName)),
+		cfg:       cfg.withDefaults(),
+		store:     store,
+		publisher: publisher,
+	}
+}
+
+// Run requests due retries every RetryPollInterval until the context is cancelled.
+func (s *retryScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.cfg.RetryPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// keep going while there's a backlog, rather than waiting for the next tick.
+		for {
+			scheduled, err := s.scheduleDue(ctx)
+			if err != nil || scheduled < int(s.cfg.RetryBatchSize) || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// scheduleDue claims a batch of due retries and requests them, returning how many it claimed. A retry
+// that couldn't be requested is left claimed, so it's requested again once its claim lapses.
+func (s *retryScheduler) scheduleDue(ctx context.Context) (int, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	retries, err := s.store.ClaimDueWebhookDeliveryRetries(ctx, s.cfg.RetryBatchSize, s.cfg.RetryClaimDuration)
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, s.logger, span, "claiming due webhook delivery retries")
+	}
+
+	for _, retry := range retries {
+		if err = s.publisher.Publish(ctx, &types.WebhookExecutionRequest{
+			WebhookID:         retry.WebhookID,
+			HouseholdID:       retry.HouseholdID,
+			TriggerEvent:      retry.TriggerEvent,
+			RetryOfDeliveryID: retry.WebhookDeliveryID,
+		}); err != nil {
+			logger := s.logger.WithValue(keys.WebhookDeliveryIDKey, retry.WebhookDeliveryID)
+			observability.AcknowledgeError(err, logger, span, "requesting webhook delivery retry")
+		}
+	}
+
+	return len(retries), nil
+}
@@ -0,0 +1,126 @@
+package webhookdelivery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func buildTestRetryScheduler(store RetryStore, publisher *mockpublishers.Publisher) *retryScheduler {
+	cfg := &Config{
+		RetryPollInterval: time.Millisecond,
+		RetryBatchSize:    10,
+	}
+
+	return NewRetryScheduler(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg, store, publisher).(*retryScheduler)
+}
+
+func buildFakeWebhookDeliveryRetry() *types.WebhookDeliveryRetry {
+	return &types.WebhookDeliveryRetry{
+		WebhookDeliveryID: fakes.BuildFakeID(),
+		TriggerEvent:      string(types.WebhookCreatedCustomerEventType),
+		WebhookID:         fakes.BuildFakeID(),
+		HouseholdID:       fakes.BuildFakeID(),
+	}
+}
+
+func TestRetryScheduler_scheduleDue(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		retry := buildFakeWebhookDeliveryRetry()
+
+		store := &mocktypes.WebhookDeliveryDataManagerMock{}
+		store.On("ClaimDueWebhookDeliveryRetries", testutils.ContextMatcher, uint16(10), defaultRetryClaimDuration).Return([]*types.WebhookDeliveryRetry{retry}, nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, &types.WebhookExecutionRequest{
+			WebhookID:         retry.WebhookID,
+			HouseholdID:       retry.HouseholdID,
+			TriggerEvent:      retry.TriggerEvent,
+			RetryOfDeliveryID: retry.WebhookDeliveryID,
+		}).Return(nil)
+
+		s := buildTestRetryScheduler(store, publisher)
+
+		scheduled, err := s.scheduleDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, scheduled)
+
+		mock.AssertExpectationsForObjects(t, store, publisher)
+	})
+
+	T.Run("with error publishing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		retries := []*types.WebhookDeliveryRetry{buildFakeWebhookDeliveryRetry(), buildFakeWebhookDeliveryRetry()}
+
+		store := &mocktypes.WebhookDeliveryDataManagerMock{}
+		store.On("ClaimDueWebhookDeliveryRetries", testutils.ContextMatcher, uint16(10), defaultRetryClaimDuration).Return(retries, nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, mock.AnythingOfType("*types.WebhookExecutionRequest")).Return(errors.New("blah")).Times(len(retries))
+
+		s := buildTestRetryScheduler(store, publisher)
+
+		scheduled, err := s.scheduleDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, len(retries), scheduled)
+
+		mock.AssertExpectationsForObjects(t, store, publisher)
+	})
+
+	T.Run("with error claiming retries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		store := &mocktypes.WebhookDeliveryDataManagerMock{}
+		store.On("ClaimDueWebhookDeliveryRetries", testutils.ContextMatcher, uint16(10), defaultRetryClaimDuration).Return([]*types.WebhookDeliveryRetry(nil), errors.New("blah"))
+
+		publisher := &mockpublishers.Publisher{}
+
+		s := buildTestRetryScheduler(store, publisher)
+
+		scheduled, err := s.scheduleDue(ctx)
+		assert.Error(t, err)
+		assert.Zero(t, scheduled)
+
+		mock.AssertExpectationsForObjects(t, store, publisher)
+	})
+}
+
+func TestRetryScheduler_Run(T *testing.T) {
+	T.Parallel()
+
+	T.Run("stops when context is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		store := &mocktypes.WebhookDeliveryDataManagerMock{}
+		store.On("ClaimDueWebhookDeliveryRetries", testutils.ContextMatcher, uint16(10), defaultRetryClaimDuration).Return([]*types.WebhookDeliveryRetry{}, nil).Run(func(mock.Arguments) {
+			cancel()
+		})
+
+		s := buildTestRetryScheduler(store, &mockpublishers.Publisher{})
+
+		assert.NoError(t, s.Run(ctx))
+	})
+}
@@ -0,0 +1,62 @@
+package webhookdelivery
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+var (
+	// ErrDisallowedDestination indicates a webhook's URL resolved to an address deliveries may not be sent to.
+	ErrDisallowedDestination = errors.New("webhook destination is not a public address")
+)
+
+// buildTransport builds the transport webhook requests are sent with. It doesn't use a proxy, and it refuses
+// connections to non-public addresses once they've been resolved, so that a webhook's hostname can't be
+// pointed at internal services after its URL has been validated, nor can a redirect lead to one.
+func buildTransport(timeout time.Duration) http.RoundTripper {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   refuseDisallowedDestinations,
+	}
+
+	t := &http.Transport{
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   10,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		IdleConnTimeout:       3 * timeout,
+	}
+
+	return otelhttp.NewTransport(t, otelhttp.WithSpanNameFormatter(tracing.FormatSpan))
+}
+
+// refuseDisallowedDestinations is a net.Dialer Control function, which is called with the resolved address of every connection.
+func refuseDisallowedDestinations(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("parsing dialed address: %w", err)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("parsing dialed address: %w", err)
+	}
+
+	if !types.IsAllowedWebhookDestination(addr) {
+		return fmt.Errorf("%w: %s", ErrDisallowedDestination, addr)
+	}
+
+	return nil
+}
@@ -62,11 +62,13 @@ func cleanString(s string) string {
 	return strings.ToLower(strings.TrimSpace(s))
 }
 
-// ProvideConsumerProvider provides a PublisherProvider.
+// ProvideConsumerProvider provides a ConsumerProvider.
 func ProvideConsumerProvider(ctx context.Context, logger logging.Logger, tracerProvider tracing.TracerProvider, c *Config) (messagequeue.ConsumerProvider, error) {
 	switch cleanString(string(c.Consumers.Provider)) {
 	case ProviderRedis:
 		return redis.ProvideRedisConsumerProvider(logger, tracerProvider, c.Consumers.Redis), nil
+	case ProviderSQS:
+		return sqs.ProvideSQSConsumerProvider(logger, tracerProvider), nil
 	case ProviderPubSub:
 		client, err := ps.NewClient(ctx, os.Getenv("GOOGLE_CLOUD_PROJECT_ID"))
 		if err != nil {
@@ -39,6 +39,22 @@ func TestProvideConsumerProvider(T *testing.T) {
 		assert.NotNil(t, provider)
 	})
 
+	T.Run("with sqs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		logger := logging.NewNoopLogger()
+		cfg := &Config{
+			Consumers: MessageQueueConfig{
+				Provider: ProviderSQS,
+			},
+		}
+
+		provider, err := ProvideConsumerProvider(ctx, logger, tracing.NewNoopTracerProvider(), cfg)
+		assert.NoError(t, err)
+		assert.NotNil(t, provider)
+	})
+
 	T.Run("with invalid provider", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,83 @@
+package deadletter
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	defaultMaxAttempts    = 5
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = time.Minute
+	defaultTopicSuffix    = "_dead_letters"
+)
+
+// Config configures how messages are retried and dead-lettered. A message is handled at most MaxAttempts
+// times, waiting InitialBackoff after the first failure and doubling the wait after each one after that,
+// up to MaxBackoff. A topic's dead letters go to the topic named in Topics, or to the topic's name plus
+// TopicSuffix if it has no entry there.
+type Config struct {
+	_ struct{} `json:"-"`
+
+	Topics         map[string]string `json:"topics,omitempty"         toml:"topics,omitempty"`
+	TopicSuffix    string            `json:"topicSuffix,omitempty"    toml:"topic_suffix,omitempty"`
+	InitialBackoff time.Duration     `json:"initialBackoff,omitempty" toml:"initial_backoff,omitempty"`
+	MaxBackoff     time.Duration     `json:"maxBackoff,omitempty"     toml:"max_backoff,omitempty"`
+	MaxAttempts    uint16            `json:"maxAttempts,omitempty"    toml:"max_attempts,omitempty"`
+}
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg *Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.InitialBackoff, validation.Min(time.Duration(0))),
+		validation.Field(&cfg.MaxBackoff, validation.Min(cfg.InitialBackoff)),
+	)
+}
+
+// withDefaults returns a copy of the config with zero values replaced by sensible defaults.
+func (cfg *Config) withDefaults() *Config {
+	x := &Config{}
+	if cfg != nil {
+		*x = *cfg
+	}
+
+	if x.MaxAttempts == 0 {
+		x.MaxAttempts = defaultMaxAttempts
+	}
+	if x.InitialBackoff == 0 {
+		x.InitialBackoff = defaultInitialBackoff
+	}
+	if x.MaxBackoff == 0 {
+		x.MaxBackoff = max(defaultMaxBackoff, x.InitialBackoff)
+	}
+	if x.TopicSuffix == "" {
+		x.TopicSuffix = defaultTopicSuffix
+	}
+
+	return x
+}
+
+// DeadLetterTopicFor returns the topic a topic's dead letters are published to.
+func (cfg *Config) DeadLetterTopicFor(topic string) string {
+	x := cfg.withDefaults()
+
+	if dlq, ok := x.Topics[topic]; ok && dlq != "" {
+		return dlq
+	}
+
+	return topic + x.TopicSuffix
+}
+
+// backoffFor returns how long to wait after a message's attempt-th failed attempt.
+func (cfg *Config) backoffFor(attempt uint16) time.Duration {
+	backoff := cfg.InitialBackoff
+	for i := uint16(1); i < attempt && backoff < cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, cfg.MaxBackoff)
+}
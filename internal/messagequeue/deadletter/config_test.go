@@ -0,0 +1,121 @@
+package deadletter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			MaxAttempts:    3,
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Minute,
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with negative initial backoff", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			InitialBackoff: -time.Second,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with max backoff shorter than initial backoff", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			InitialBackoff: time.Minute,
+			MaxBackoff:     time.Second,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+}
+
+func TestConfig_withDefaults(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil config", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg *Config
+		actual := cfg.withDefaults()
+
+		assert.Equal(t, uint16(defaultMaxAttempts), actual.MaxAttempts)
+		assert.Equal(t, defaultInitialBackoff, actual.InitialBackoff)
+		assert.Equal(t, defaultMaxBackoff, actual.MaxBackoff)
+		assert.Equal(t, defaultTopicSuffix, actual.TopicSuffix)
+	})
+
+	T.Run("preserves provided values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			MaxAttempts:    2,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Second,
+			TopicSuffix:    "_dlq",
+		}
+		actual := cfg.withDefaults()
+
+		assert.Equal(t, cfg.MaxAttempts, actual.MaxAttempts)
+		assert.Equal(t, cfg.InitialBackoff, actual.InitialBackoff)
+		assert.Equal(t, cfg.MaxBackoff, actual.MaxBackoff)
+		assert.Equal(t, cfg.TopicSuffix, actual.TopicSuffix)
+	})
+}
+
+func TestConfig_DeadLetterTopicFor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{}
+
+		assert.Equal(t, "data_changes_dead_letters", cfg.DeadLetterTopicFor("data_changes"))
+	})
+
+	T.Run("with override", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Topics: map[string]string{"data_changes": "failed_data_changes"},
+		}
+
+		assert.Equal(t, "failed_data_changes", cfg.DeadLetterTopicFor("data_changes"))
+		assert.Equal(t, "outbound_emails_dead_letters", cfg.DeadLetterTopicFor("outbound_emails"))
+	})
+}
+
+func TestConfig_backoffFor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := (&Config{
+			InitialBackoff: time.Second,
+			MaxBackoff:     5 * time.Second,
+		}).withDefaults()
+
+		assert.Equal(t, time.Second, cfg.backoffFor(1))
+		assert.Equal(t, 2*time.Second, cfg.backoffFor(2))
+		assert.Equal(t, 4*time.Second, cfg.backoffFor(3))
+		assert.Equal(t, 5*time.Second, cfg.backoffFor(4))
+		assert.Equal(t, 5*time.Second, cfg.backoffFor(100))
+	})
+}
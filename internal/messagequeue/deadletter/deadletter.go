@@ -0,0 +1,211 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	// ErrNilHandler indicates a nil handler was provided to be wrapped.
+	ErrNilHandler = errors.New("nil handler provided")
+	// ErrDeadLetterLoop indicates a topic was configured to dead-letter to itself.
+	ErrDeadLetterLoop = errors.New("topic cannot be its own dead-letter topic")
+)
+
+type (
+	// Handler handles a message consumed from a topic.
+	Handler = func(ctx context.Context, message []byte) error
+
+	// Recorder stores dead-lettered messages so they can be inspected and replayed later.
+	Recorder interface {
+		CreateDeadLetteredMessage(ctx context.Context, input *types.DeadLetteredMessageDatabaseCreationInput) (*types.DeadLetteredMessage, error)
+	}
+
+	// Wrapper adds retries and dead-lettering to message handlers.
+	Wrapper interface {
+		WrapHandler(topic string, handler Handler) (Handler, error)
+	}
+
+	wrapper struct {
+		logger            logging.Logger
+		tracer            tracing.Tracer
+		cfg               *Config
+		publisherProvider messagequeue.PublisherProvider
+		recorder          Recorder
+	}
+)
+
+// NewWrapper creates a Wrapper. The recorder may be nil, in which case dead letters are only published.
+func NewWrapper(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	cfg *Config,
+	publisherProvider messagequeue.PublisherProvider,
+	recorder Recorder,
+) Wrapper {
+	n := "dead_letter_wrapper"
+
+	return &wrapper{
+		logger:            logging.EnsureLogger(logger).WithName(n),
+		tracer:            tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(n)),
+		cfg:               cfg.withDefaults(),
+		publisherProvider: publisherProvider,
+		recorder:          recorder,
+	}
+}
+
+// WrapHandler returns a handler that retries the provided one with backoff, and dead-letters the message
+// once it has failed MaxAttempts times. The returned handler only returns an error if the message couldn't
+// be dead-lettered, or if its context was cancelled while retrying, so that the message gets redelivered.
+func (w *wrapper) WrapHandler(topic string, handler Handler) (Handler, error) {
+	if topic == "" {
+		return nil, messagequeue.ErrEmptyTopicName
+	}
+
+	if handler == nil {
+		return nil, ErrNilHandler
+	}
+
+	deadLetterTopic := w.cfg.DeadLetterTopicFor(topic)
+	if deadLetterTopic == topic {
+		return nil, fmt.Errorf("%w: %s", ErrDeadLetterLoop, topic)
+	}
+
+	publisher, err := w.publisherProvider.ProvidePublisher(deadLetterTopic)
+	if err != nil {
+		return nil, fmt.Errorf("providing dead-letter publisher for %q: %w", topic, err)
+	}
+
+	logger := w.logger.WithValue(keys.QueueTopicKey, topic)
+
+	return func(ctx context.Context, message []byte) error {
+		ctx, span := w.tracer.StartSpan(ctx)
+		defer span.End()
+
+		for attempt := uint16(1); ; attempt++ {
+			handleErr := invoke(ctx, handler, message)
+			if handleErr == nil {
+				return nil
+			}
+
+			if attempt >= w.cfg.MaxAttempts {
+				return w.deadLetter(ctx, logger, publisher, topic, deadLetterTopic, message, attempt, handleErr)
+			}
+
+			backoff := w.cfg.backoffFor(attempt)
+			logger.WithValue("attempt", attempt).WithValue("backoff", backoff).Error(handleErr, "handling message, retrying")
+
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}, nil
+}
+
+// invoke calls a handler, turning a panic into an error so that it counts as a failed attempt.
+func invoke(ctx context.Context, handler Handler, message []byte) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("handler panicked: %v", recovered)
+		}
+	}()
+
+	return handler(ctx, message)
+}
+
+// deadLetter records a message that ran out of attempts and publishes it to its dead-letter topic.
+func (w *wrapper) deadLetter(
+	ctx context.Context,
+	logger logging.Logger,
+	publisher messagequeue.Publisher,
+	topic, deadLetterTopic string,
+	message []byte,
+	attempts uint16,
+	lastErr error,
+) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	input := &types.DeadLetteredMessageDatabaseCreationInput{
+		ID:              identifiers.New(),
+		Topic:           topic,
+		DeadLetterTopic: deadLetterTopic,
+		Payload:         string(message),
+		LastError:       lastErr.Error(),
+		Attempts:        attempts,
+	}
+
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, input.ID).WithValue("attempts", attempts)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, input.ID)
+
+	deadLetteredMessage := &types.DeadLetteredMessage{
+		CreatedAt:       time.Now(),
+		ID:              input.ID,
+		Topic:           input.Topic,
+		DeadLetterTopic: input.DeadLetterTopic,
+		Payload:         input.Payload,
+		LastError:       input.LastError,
+		Attempts:        input.Attempts,
+	}
+
+	if w.recorder != nil {
+		created, err := w.recorder.CreateDeadLetteredMessage(ctx, input)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "recording dead-lettered message")
+		}
+		deadLetteredMessage = created
+	}
+
+	if err := publisher.Publish(ctx, deadLetteredMessage); err != nil {
+		// a recorded message can still be replayed, so there's no point in redelivering it.
+		if w.recorder == nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing dead-lettered message")
+		}
+		observability.AcknowledgeError(err, logger, span, "publishing dead-lettered message")
+	}
+
+	logger.Error(lastErr, "message dead-lettered")
+
+	return nil
+}
+
+var _ messagequeue.ConsumerProvider = (*consumerProvider)(nil)
+
+type consumerProvider struct {
+	wrapper          Wrapper
+	consumerProvider messagequeue.ConsumerProvider
+}
+
+// ProvideConsumerProvider wraps a ConsumerProvider so that every consumer it provides retries and
+// dead-letters its messages. Only use this for handlers that report their own failures; a handler that
+// hands messages off to be processed asynchronously should be wrapped with WrapHandler instead.
+func ProvideConsumerProvider(wrapper Wrapper, cp messagequeue.ConsumerProvider) messagequeue.ConsumerProvider {
+	return &consumerProvider{
+		wrapper:          wrapper,
+		consumerProvider: cp,
+	}
+}
+
+// ProvideConsumer implements the interface.
+func (c *consumerProvider) ProvideConsumer(ctx context.Context, topic string, handlerFunc func(context.Context, []byte) error) (messagequeue.Consumer, error) {
+	wrapped, err := c.wrapper.WrapHandler(topic, handlerFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.consumerProvider.ProvideConsumer(ctx, topic, wrapped)
+}
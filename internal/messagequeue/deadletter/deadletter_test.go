@@ -0,0 +1,294 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleTopic = "things"
+
+func buildTestWrapper(t *testing.T, publisherProvider messagequeue.PublisherProvider, recorder Recorder) Wrapper {
+	t.Helper()
+
+	cfg := &Config{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+	}
+
+	return NewWrapper(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg, publisherProvider, recorder)
+}
+
+func TestWrapper_WrapHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(&mockpublishers.Publisher{}, nil)
+
+		w := buildTestWrapper(t, publisherProvider, nil)
+
+		calls := 0
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			calls++
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, handler(context.Background(), []byte(`{}`)))
+		assert.Equal(t, 1, calls)
+
+		mock.AssertExpectationsForObjects(t, publisherProvider)
+	})
+
+	T.Run("retries until the handler succeeds", func(t *testing.T) {
+		t.Parallel()
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(&mockpublishers.Publisher{}, nil)
+
+		w := buildTestWrapper(t, publisherProvider, nil)
+
+		calls := 0
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			calls++
+			if calls < 3 {
+				return errors.New("blah")
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, handler(context.Background(), []byte(`{}`)))
+		assert.Equal(t, 3, calls)
+
+		mock.AssertExpectationsForObjects(t, publisherProvider)
+	})
+
+	T.Run("dead-letters messages that run out of attempts", func(t *testing.T) {
+		t.Parallel()
+
+		message := []byte(`{"things":"stuff"}`)
+
+		recorder := &mocktypes.DeadLetteredMessageDataManagerMock{}
+		recorder.On(
+			"CreateDeadLetteredMessage",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.DeadLetteredMessageDatabaseCreationInput) bool {
+				return input.Topic == exampleTopic &&
+					input.DeadLetterTopic == exampleTopic+defaultTopicSuffix &&
+					input.Payload == string(message) &&
+					input.LastError == "blah" &&
+					input.Attempts == 3
+			}),
+		).Return(&types.DeadLetteredMessage{Topic: exampleTopic}, nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, &types.DeadLetteredMessage{Topic: exampleTopic}).Return(nil)
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(publisher, nil)
+
+		w := buildTestWrapper(t, publisherProvider, recorder)
+
+		calls := 0
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			calls++
+			return errors.New("blah")
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, handler(context.Background(), message))
+		assert.Equal(t, 3, calls)
+
+		mock.AssertExpectationsForObjects(t, recorder, publisher, publisherProvider)
+	})
+
+	T.Run("counts panics as failures", func(t *testing.T) {
+		t.Parallel()
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, mock.AnythingOfType("*types.DeadLetteredMessage")).Return(nil)
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(publisher, nil)
+
+		w := buildTestWrapper(t, publisherProvider, nil)
+
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			panic("blah")
+		})
+		require.NoError(t, err)
+
+		assert.NoError(t, handler(context.Background(), []byte(`{}`)))
+
+		mock.AssertExpectationsForObjects(t, publisher, publisherProvider)
+	})
+
+	T.Run("with error recording dead-lettered message", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := &mocktypes.DeadLetteredMessageDataManagerMock{}
+		recorder.On("CreateDeadLetteredMessage", testutils.ContextMatcher, mock.AnythingOfType("*types.DeadLetteredMessageDatabaseCreationInput")).Return((*types.DeadLetteredMessage)(nil), errors.New("blah"))
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(&mockpublishers.Publisher{}, nil)
+
+		w := buildTestWrapper(t, publisherProvider, recorder)
+
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			return errors.New("blah")
+		})
+		require.NoError(t, err)
+
+		assert.Error(t, handler(context.Background(), []byte(`{}`)))
+
+		mock.AssertExpectationsForObjects(t, recorder, publisherProvider)
+	})
+
+	T.Run("with error publishing unrecorded dead-lettered message", func(t *testing.T) {
+		t.Parallel()
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, mock.AnythingOfType("*types.DeadLetteredMessage")).Return(errors.New("blah"))
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(publisher, nil)
+
+		w := buildTestWrapper(t, publisherProvider, nil)
+
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			return errors.New("blah")
+		})
+		require.NoError(t, err)
+
+		assert.Error(t, handler(context.Background(), []byte(`{}`)))
+
+		mock.AssertExpectationsForObjects(t, publisher, publisherProvider)
+	})
+
+	T.Run("with cancelled context", func(t *testing.T) {
+		t.Parallel()
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(&mockpublishers.Publisher{}, nil)
+
+		w := NewWrapper(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{InitialBackoff: time.Hour}, publisherProvider, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error {
+			cancel()
+			return errors.New("blah")
+		})
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, handler(ctx, []byte(`{}`)), context.Canceled)
+
+		mock.AssertExpectationsForObjects(t, publisherProvider)
+	})
+
+	T.Run("with empty topic", func(t *testing.T) {
+		t.Parallel()
+
+		w := buildTestWrapper(t, &mockpublishers.ProducerProvider{}, nil)
+
+		handler, err := w.WrapHandler("", func(context.Context, []byte) error { return nil })
+		assert.ErrorIs(t, err, messagequeue.ErrEmptyTopicName)
+		assert.Nil(t, handler)
+	})
+
+	T.Run("with nil handler", func(t *testing.T) {
+		t.Parallel()
+
+		w := buildTestWrapper(t, &mockpublishers.ProducerProvider{}, nil)
+
+		handler, err := w.WrapHandler(exampleTopic, nil)
+		assert.ErrorIs(t, err, ErrNilHandler)
+		assert.Nil(t, handler)
+	})
+
+	T.Run("with topic dead-lettering to itself", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{Topics: map[string]string{exampleTopic: exampleTopic}}
+		w := NewWrapper(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg, &mockpublishers.ProducerProvider{}, nil)
+
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error { return nil })
+		assert.ErrorIs(t, err, ErrDeadLetterLoop)
+		assert.Nil(t, handler)
+	})
+
+	T.Run("with error providing publisher", func(t *testing.T) {
+		t.Parallel()
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return((*mockpublishers.Publisher)(nil), errors.New("blah"))
+
+		w := buildTestWrapper(t, publisherProvider, nil)
+
+		handler, err := w.WrapHandler(exampleTopic, func(context.Context, []byte) error { return nil })
+		assert.Error(t, err)
+		assert.Nil(t, handler)
+
+		mock.AssertExpectationsForObjects(t, publisherProvider)
+	})
+}
+
+func TestProvideConsumerProvider(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", exampleTopic+defaultTopicSuffix).Return(&mockpublishers.Publisher{}, nil)
+
+		calls := 0
+		inner := &mockpublishers.ConsumerProvider{}
+		inner.On("ProvideConsumer", testutils.ContextMatcher, exampleTopic, mock.Anything).Run(func(args mock.Arguments) {
+			handler := args.Get(2).(func(context.Context, []byte) error)
+			assert.NoError(t, handler(context.Background(), []byte(`{}`)))
+		}).Return(&mockpublishers.Consumer{}, nil)
+
+		cp := ProvideConsumerProvider(buildTestWrapper(t, publisherProvider, nil), inner)
+
+		consumer, err := cp.ProvideConsumer(context.Background(), exampleTopic, func(context.Context, []byte) error {
+			calls++
+			if calls == 1 {
+				return errors.New("blah")
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, consumer)
+		assert.Equal(t, 2, calls)
+
+		mock.AssertExpectationsForObjects(t, publisherProvider, inner)
+	})
+
+	T.Run("with error wrapping handler", func(t *testing.T) {
+		t.Parallel()
+
+		cp := ProvideConsumerProvider(buildTestWrapper(t, &mockpublishers.ProducerProvider{}, nil), &mockpublishers.ConsumerProvider{})
+
+		consumer, err := cp.ProvideConsumer(context.Background(), "", func(context.Context, []byte) error { return nil })
+		assert.Error(t, err)
+		assert.Nil(t, consumer)
+	})
+}
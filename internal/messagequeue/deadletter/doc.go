@@ -0,0 +1,5 @@
+/*
+Package deadletter wraps message queue handlers so that failed messages are retried with backoff,
+and set aside on a dead-letter topic once they run out of attempts.
+*/
+package deadletter
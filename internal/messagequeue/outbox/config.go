@@ -0,0 +1,76 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	defaultPollInterval  = time.Second
+	defaultClaimDuration = 30 * time.Second
+	defaultRetention     = 7 * 24 * time.Hour
+	defaultBatchSize     = 100
+)
+
+// Config configures the outbox. Publishes to any of the listed Topics are written to the outbox instead of
+// being sent to the queue. Every PollInterval, the relay claims up to BatchSize pending messages for
+// ClaimDuration and publishes them; a message whose claim lapses before it's published is claimed again.
+// Published messages are deleted after Retention. Household event streams are read from the outbox, so Retention
+// also bounds how far back a client can resume one; clients resuming from further back are told to reset.
+type Config struct {
+	_ struct{} `json:"-"`
+
+	Topics        []string      `json:"topics,omitempty"        toml:"topics,omitempty"`
+	PollInterval  time.Duration `json:"pollInterval,omitempty"  toml:"poll_interval,omitempty"`
+	ClaimDuration time.Duration `json:"claimDuration,omitempty" toml:"claim_duration,omitempty"`
+	Retention     time.Duration `json:"retention,omitempty"     toml:"retention,omitempty"`
+	BatchSize     uint16        `json:"batchSize,omitempty"     toml:"batch_size,omitempty"`
+}
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg *Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.Topics, validation.Each(validation.Required)),
+		validation.Field(&cfg.PollInterval, validation.Min(time.Duration(0))),
+		validation.Field(&cfg.ClaimDuration, validation.Min(time.Duration(0))),
+		validation.Field(&cfg.Retention, validation.Min(time.Duration(0))),
+	)
+}
+
+// withDefaults returns a copy of the config with zero values replaced by sensible defaults.
+func (cfg *Config) withDefaults() *Config {
+	x := &Config{}
+	if cfg != nil {
+		*x = *cfg
+	}
+
+	if x.PollInterval == 0 {
+		x.PollInterval = defaultPollInterval
+	}
+	if x.ClaimDuration == 0 {
+		x.ClaimDuration = defaultClaimDuration
+	}
+	if x.Retention == 0 {
+		x.Retention = defaultRetention
+	}
+	if x.BatchSize == 0 {
+		x.BatchSize = defaultBatchSize
+	}
+
+	return x
+}
+
+// handles returns whether publishes to a topic go through the outbox.
+func (cfg *Config) handles(topic string) bool {
+	for _, t := range cfg.Topics {
+		if t == topic {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Topics:       []string{"data_changes"},
+			PollInterval: time.Second,
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with empty topic", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Topics: []string{""},
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with negative poll interval", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			PollInterval: -time.Second,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+}
+
+func TestConfig_withDefaults(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := (*Config)(nil).withDefaults()
+
+		assert.Equal(t, defaultPollInterval, x.PollInterval)
+		assert.Equal(t, defaultClaimDuration, x.ClaimDuration)
+		assert.Equal(t, defaultRetention, x.Retention)
+		assert.Equal(t, uint16(defaultBatchSize), x.BatchSize)
+	})
+
+	T.Run("keeps provided values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{BatchSize: 7}
+		x := cfg.withDefaults()
+
+		assert.Equal(t, uint16(7), x.BatchSize)
+		assert.NotSame(t, cfg, x)
+	})
+}
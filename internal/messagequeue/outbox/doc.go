@@ -0,0 +1,6 @@
+/*
+Package outbox implements a transactional outbox for message queue publishes. Messages bound for outbox topics
+are written to the database, in whatever transaction their context carries, rather than being published right
+away; a relay then publishes them with at-least-once semantics.
+*/
+package outbox
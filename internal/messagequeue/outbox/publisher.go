@@ -0,0 +1,129 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+type (
+	// Store records outbox messages. Implementations are expected to use the transaction carried by the
+	// provided context, if there is one.
+	Store interface {
+		CreateOutboxMessage(ctx context.Context, input *types.OutboxMessageDatabaseCreationInput) (*types.OutboxMessage, error)
+	}
+
+	publisher struct {
+		logger  logging.Logger
+		tracer  tracing.Tracer
+		encoder encoding.ClientEncoder
+		store   Store
+		topic   string
+	}
+)
+
+var _ messagequeue.Publisher = (*publisher)(nil)
+
+// Stop does nothing.
+func (p *publisher) Stop() {}
+
+// Publish writes a message to the outbox. Data change messages are assigned an idempotency key if they
+// don't have one already, so consumers can recognize a message they've seen before.
+func (p *publisher) Publish(ctx context.Context, data any) error {
+	ctx, span := p.tracer.StartSpan(ctx)
+	defer span.End()
+
+	input := &types.OutboxMessageDatabaseCreationInput{
+		ID:             identifiers.New(),
+		Topic:          p.topic,
+		IdempotencyKey: identifiers.New(),
+	}
+
+	if msg, ok := data.(*types.DataChangeMessage); ok && msg != nil {
+		if msg.IdempotencyKey == "" {
+			msg.IdempotencyKey = input.IdempotencyKey
+		}
+		input.IdempotencyKey = msg.IdempotencyKey
+		input.EventType = string(msg.EventType)
+
+		// household events are streamed to the household's members from the outbox.
+		if householdID := msg.HouseholdID; householdID != "" {
+			input.BelongsToHousehold = &householdID
+		}
+	}
+
+	var b bytes.Buffer
+	if err := p.encoder.Encode(ctx, &b, data); err != nil {
+		return observability.PrepareError(err, span, "encoding outbox message")
+	}
+	input.Payload = string(bytes.TrimSpace(b.Bytes()))
+
+	logger := p.logger.WithValue(keys.OutboxMessageIDKey, input.ID)
+	tracing.AttachToSpan(span, keys.OutboxMessageIDKey, input.ID)
+
+	if _, err := p.store.CreateOutboxMessage(ctx, input); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "writing message to outbox")
+	}
+
+	return nil
+}
+
+var _ messagequeue.PublisherProvider = (*publisherProvider)(nil)
+
+type publisherProvider struct {
+	logger            logging.Logger
+	tracerProvider    tracing.TracerProvider
+	cfg               *Config
+	store             Store
+	publisherProvider messagequeue.PublisherProvider
+}
+
+// WrapPublisherProvider wraps a PublisherProvider so that publishes to the configured topics are written
+// to the outbox, and publishes to any other topic go straight to the queue.
+func WrapPublisherProvider(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	cfg *Config,
+	store Store,
+	pp messagequeue.PublisherProvider,
+) messagequeue.PublisherProvider {
+	return &publisherProvider{
+		logger:            logging.EnsureLogger(logger).WithName("outbox_publisher"),
+		tracerProvider:    tracing.EnsureTracerProvider(tracerProvider),
+		cfg:               cfg.withDefaults(),
+		store:             store,
+		publisherProvider: pp,
+	}
+}
+
+// ProvidePublisher implements the interface.
+func (p *publisherProvider) ProvidePublisher(topic string) (messagequeue.Publisher, error) {
+	if topic == "" {
+		return nil, messagequeue.ErrEmptyTopicName
+	}
+
+	if !p.cfg.handles(topic) {
+		return p.publisherProvider.ProvidePublisher(topic)
+	}
+
+	return &publisher{
+		logger:  p.logger.WithValue(keys.QueueTopicKey, topic),
+		tracer:  tracing.NewTracer(p.tracerProvider.Tracer("outbox_publisher")),
+		encoder: encoding.ProvideClientEncoder(p.logger, p.tracerProvider, encoding.ContentTypeJSON),
+		store:   p.store,
+		topic:   topic,
+	}, nil
+}
+
+// Close closes the wrapped PublisherProvider.
+func (p *publisherProvider) Close() {
+	p.publisherProvider.Close()
+}
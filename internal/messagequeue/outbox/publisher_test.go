@@ -0,0 +1,140 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleTopic = "data_changes"
+
+func TestPublisherProvider_ProvidePublisher(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with outbox topic", func(t *testing.T) {
+		t.Parallel()
+
+		underlying := &mockpublishers.ProducerProvider{}
+		pp := WrapPublisherProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{Topics: []string{exampleTopic}}, &mocktypes.OutboxMessageDataManagerMock{}, underlying)
+
+		actual, err := pp.ProvidePublisher(exampleTopic)
+		assert.NoError(t, err)
+		assert.IsType(t, &publisher{}, actual)
+
+		mock.AssertExpectationsForObjects(t, underlying)
+	})
+
+	T.Run("with other topic", func(t *testing.T) {
+		t.Parallel()
+
+		expected := &mockpublishers.Publisher{}
+		underlying := &mockpublishers.ProducerProvider{}
+		underlying.On("ProvidePublisher", "other").Return(expected, nil)
+
+		pp := WrapPublisherProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{Topics: []string{exampleTopic}}, &mocktypes.OutboxMessageDataManagerMock{}, underlying)
+
+		actual, err := pp.ProvidePublisher("other")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, underlying)
+	})
+
+	T.Run("with empty topic", func(t *testing.T) {
+		t.Parallel()
+
+		pp := WrapPublisherProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{}, &mocktypes.OutboxMessageDataManagerMock{}, &mockpublishers.ProducerProvider{})
+
+		actual, err := pp.ProvidePublisher("")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestPublisher_Publish(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		msg := &types.DataChangeMessage{
+			EventType:   types.MealCreatedCustomerEventType,
+			UserID:      "user",
+			HouseholdID: "household",
+		}
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("CreateOutboxMessage", testutils.ContextMatcher, mock.MatchedBy(func(input *types.OutboxMessageDatabaseCreationInput) bool {
+			var decoded types.DataChangeMessage
+			require.NoError(t, json.Unmarshal([]byte(input.Payload), &decoded))
+
+			return input.Topic == exampleTopic &&
+				input.ID != "" &&
+				input.IdempotencyKey != "" &&
+				decoded.IdempotencyKey == input.IdempotencyKey &&
+				decoded.EventType == msg.EventType &&
+				input.EventType == string(msg.EventType) &&
+				input.BelongsToHousehold != nil && *input.BelongsToHousehold == msg.HouseholdID
+		})).Return(&types.OutboxMessage{}, nil)
+
+		pp := WrapPublisherProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{Topics: []string{exampleTopic}}, store, &mockpublishers.ProducerProvider{})
+		p, err := pp.ProvidePublisher(exampleTopic)
+		require.NoError(t, err)
+
+		assert.NoError(t, p.Publish(ctx, msg))
+		assert.NotEmpty(t, msg.IdempotencyKey)
+
+		mock.AssertExpectationsForObjects(t, store)
+	})
+
+	T.Run("keeps existing idempotency key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		msg := &types.DataChangeMessage{IdempotencyKey: "existing"}
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("CreateOutboxMessage", testutils.ContextMatcher, mock.MatchedBy(func(input *types.OutboxMessageDatabaseCreationInput) bool {
+			return input.IdempotencyKey == msg.IdempotencyKey
+		})).Return(&types.OutboxMessage{}, nil)
+
+		pp := WrapPublisherProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{Topics: []string{exampleTopic}}, store, &mockpublishers.ProducerProvider{})
+		p, err := pp.ProvidePublisher(exampleTopic)
+		require.NoError(t, err)
+
+		assert.NoError(t, p.Publish(ctx, msg))
+		assert.Equal(t, "existing", msg.IdempotencyKey)
+
+		mock.AssertExpectationsForObjects(t, store)
+	})
+
+	T.Run("with error writing to outbox", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("CreateOutboxMessage", testutils.ContextMatcher, mock.AnythingOfType("*types.OutboxMessageDatabaseCreationInput")).Return((*types.OutboxMessage)(nil), errors.New("blah"))
+
+		pp := WrapPublisherProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), &Config{Topics: []string{exampleTopic}}, store, &mockpublishers.ProducerProvider{})
+		p, err := pp.ProvidePublisher(exampleTopic)
+		require.NoError(t, err)
+
+		assert.Error(t, p.Publish(ctx, map[string]string{"things": "stuff"}))
+
+		mock.AssertExpectationsForObjects(t, store)
+	})
+}
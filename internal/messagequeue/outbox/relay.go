@@ -0,0 +1,234 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+const (
+	relayName = "outbox_relay"
+
+	// pruneInterval is how often the relay deletes published messages older than the retention period.
+	pruneInterval = time.Hour
+)
+
+type (
+	// RelayStore is what the relay needs from the database.
+	RelayStore interface {
+		ClaimPendingOutboxMessages(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*types.OutboxMessage, error)
+		MarkOutboxMessageAsPublished(ctx context.Context, outboxMessageID string) error
+		MarkOutboxMessageAsFailed(ctx context.Context, outboxMessageID, reason string) error
+		GetOutboxLag(ctx context.Context) (*types.OutboxLag, error)
+		PrunePublishedOutboxMessages(ctx context.Context, publishedBefore time.Time) (int64, error)
+	}
+
+	// Relay publishes pending outbox messages to the queue.
+	Relay interface {
+		Run(ctx context.Context) error
+	}
+
+	relay struct {
+		logger            logging.Logger
+		tracer            tracing.Tracer
+		cfg               *Config
+		store             RelayStore
+		publisherProvider messagequeue.PublisherProvider
+		publishedCounter  metric.Int64Counter
+		failedCounter     metric.Int64Counter
+		publishLag        metric.Float64Histogram
+	}
+)
+
+// NewRelay creates a Relay. The PublisherProvider should be the one the outbox wraps, not the outbox's own.
+// The relay reports how many messages are pending and how old the oldest one is, how many messages it has
+// published or failed to publish, and how long messages waited in the outbox before being published.
+func NewRelay(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	meterProvider metric.MeterProvider,
+	cfg *Config,
+	store RelayStore,
+	publisherProvider messagequeue.PublisherProvider,
+) (Relay, error) {
+	if meterProvider == nil {
+		meterProvider = noop.NewMeterProvider()
+	}
+	meter := meterProvider.Meter(relayName)
+
+	r := &relay{
+		logger:            logging.EnsureLogger(logger).WithName(relayName),
+		tracer:            tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(relayName)),
+		cfg:               cfg.withDefaults(),
+		store:             store,
+		publisherProvider: publisherProvider,
+	}
+
+	var err error
+	if r.publishedCounter, err = meter.Int64Counter(
+		"outbox.messages.published",
+		metric.WithDescription("outbox messages published to the queue"),
+	); err != nil {
+		return nil, fmt.Errorf("creating published counter: %w", err)
+	}
+
+	if r.failedCounter, err = meter.Int64Counter(
+		"outbox.messages.failed",
+		metric.WithDescription("attempts to publish an outbox message that failed"),
+	); err != nil {
+		return nil, fmt.Errorf("creating failed counter: %w", err)
+	}
+
+	if r.publishLag, err = meter.Float64Histogram(
+		"outbox.messages.publish_lag",
+		metric.WithDescription("how long outbox messages waited before being published"),
+		metric.WithUnit("s"),
+	); err != nil {
+		return nil, fmt.Errorf("creating publish lag histogram: %w", err)
+	}
+
+	pendingGauge, err := meter.Int64ObservableGauge(
+		"outbox.messages.pending",
+		metric.WithDescription("outbox messages waiting to be published"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating pending messages gauge: %w", err)
+	}
+
+	oldestPendingGauge, err := meter.Float64ObservableGauge(
+		"outbox.messages.oldest_pending_age",
+		metric.WithDescription("how long the oldest pending outbox message has been waiting"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating oldest pending message gauge: %w", err)
+	}
+
+	if _, err = meter.RegisterCallback(func(ctx context.Context, o metric.Observer) error {
+		lag, lagErr := r.store.GetOutboxLag(ctx)
+		if lagErr != nil {
+			return lagErr
+		}
+
+		o.ObserveInt64(pendingGauge, int64(lag.PendingMessages))
+		o.ObserveFloat64(oldestPendingGauge, lag.OldestPendingAge.Seconds())
+
+		return nil
+	}, pendingGauge, oldestPendingGauge); err != nil {
+		return nil, fmt.Errorf("registering outbox lag callback: %w", err)
+	}
+
+	return r, nil
+}
+
+// Run relays pending messages every PollInterval until the context is cancelled.
+func (r *relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastPruned time.Time
+	for {
+		// keep going while there's a backlog, rather than waiting for the next tick.
+		for {
+			relayed, err := r.relayPending(ctx)
+			if err != nil || relayed < int(r.cfg.BatchSize) || ctx.Err() != nil {
+				break
+			}
+		}
+
+		if time.Since(lastPruned) >= pruneInterval {
+			if err := r.prune(ctx); err == nil {
+				lastPruned = time.Now()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayPending claims a batch of pending messages and publishes them, returning how many it claimed.
+func (r *relay) relayPending(ctx context.Context) (int, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	messages, err := r.store.ClaimPendingOutboxMessages(ctx, r.cfg.BatchSize, r.cfg.ClaimDuration)
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, r.logger, span, "claiming pending outbox messages")
+	}
+
+	for _, msg := range messages {
+		r.relay(ctx, msg)
+	}
+
+	return len(messages), nil
+}
+
+// relay publishes a single message. A message that fails to publish is left claimed, so it's retried once
+// its claim lapses. A message that was published but couldn't be marked as such will be published again,
+// which is why consumers are handed its idempotency key.
+func (r *relay) relay(ctx context.Context, msg *types.OutboxMessage) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.WithValues(map[string]any{
+		keys.OutboxMessageIDKey: msg.ID,
+		keys.QueueTopicKey:      msg.Topic,
+		"attempts":              msg.Attempts,
+	})
+	tracing.AttachToSpan(span, keys.OutboxMessageIDKey, msg.ID)
+	topicAttribute := metric.WithAttributes(attribute.String("topic", msg.Topic))
+
+	publisher, err := r.publisherProvider.ProvidePublisher(msg.Topic)
+	if err == nil {
+		err = publisher.Publish(ctx, json.RawMessage(msg.Payload))
+	}
+
+	if err != nil {
+		r.failedCounter.Add(ctx, 1, topicAttribute)
+		observability.AcknowledgeError(err, logger, span, "publishing outbox message")
+
+		if markErr := r.store.MarkOutboxMessageAsFailed(ctx, msg.ID, err.Error()); markErr != nil {
+			observability.AcknowledgeError(markErr, logger, span, "marking outbox message as failed")
+		}
+
+		return
+	}
+
+	r.publishedCounter.Add(ctx, 1, topicAttribute)
+	r.publishLag.Record(ctx, time.Since(msg.CreatedAt).Seconds(), topicAttribute)
+
+	if err = r.store.MarkOutboxMessageAsPublished(ctx, msg.ID); err != nil {
+		observability.AcknowledgeError(err, logger, span, "marking outbox message as published")
+	}
+}
+
+// prune deletes messages that were published longer ago than the retention period.
+func (r *relay) prune(ctx context.Context) error {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	pruned, err := r.store.PrunePublishedOutboxMessages(ctx, time.Now().Add(-r.cfg.Retention))
+	if err != nil {
+		return observability.PrepareAndLogError(err, r.logger, span, "pruning published outbox messages")
+	}
+
+	r.logger.WithValue("pruned", pruned).Debug("pruned published outbox messages")
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestRelay(t *testing.T, store RelayStore, publisherProvider messagequeue.PublisherProvider) *relay {
+	t.Helper()
+
+	cfg := &Config{
+		PollInterval: time.Millisecond,
+		BatchSize:    10,
+	}
+
+	r, err := NewRelay(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), nil, cfg, store, publisherProvider)
+	require.NoError(t, err)
+
+	return r.(*relay)
+}
+
+func TestRelay_relayPending(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		msg := fakes.BuildFakeOutboxMessage()
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("ClaimPendingOutboxMessages", testutils.ContextMatcher, uint16(10), defaultClaimDuration).Return([]*types.OutboxMessage{msg}, nil)
+		store.On("MarkOutboxMessageAsPublished", testutils.ContextMatcher, msg.ID).Return(nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, json.RawMessage(msg.Payload)).Return(nil)
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", msg.Topic).Return(publisher, nil)
+
+		r := buildTestRelay(t, store, publisherProvider)
+
+		relayed, err := r.relayPending(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, relayed)
+
+		mock.AssertExpectationsForObjects(t, store, publisher, publisherProvider)
+	})
+
+	T.Run("with error publishing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		msg := fakes.BuildFakeOutboxMessage()
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("ClaimPendingOutboxMessages", testutils.ContextMatcher, uint16(10), defaultClaimDuration).Return([]*types.OutboxMessage{msg}, nil)
+		store.On("MarkOutboxMessageAsFailed", testutils.ContextMatcher, msg.ID, "blah").Return(nil)
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On("Publish", testutils.ContextMatcher, json.RawMessage(msg.Payload)).Return(errors.New("blah"))
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", msg.Topic).Return(publisher, nil)
+
+		r := buildTestRelay(t, store, publisherProvider)
+
+		relayed, err := r.relayPending(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, relayed)
+
+		mock.AssertExpectationsForObjects(t, store, publisher, publisherProvider)
+	})
+
+	T.Run("with error providing publisher", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		msg := fakes.BuildFakeOutboxMessage()
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("ClaimPendingOutboxMessages", testutils.ContextMatcher, uint16(10), defaultClaimDuration).Return([]*types.OutboxMessage{msg}, nil)
+		store.On("MarkOutboxMessageAsFailed", testutils.ContextMatcher, msg.ID, "blah").Return(nil)
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", msg.Topic).Return((*mockpublishers.Publisher)(nil), errors.New("blah"))
+
+		r := buildTestRelay(t, store, publisherProvider)
+
+		relayed, err := r.relayPending(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, relayed)
+
+		mock.AssertExpectationsForObjects(t, store, publisherProvider)
+	})
+
+	T.Run("with error claiming messages", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("ClaimPendingOutboxMessages", testutils.ContextMatcher, uint16(10), defaultClaimDuration).Return([]*types.OutboxMessage(nil), errors.New("blah"))
+
+		r := buildTestRelay(t, store, &mockpublishers.ProducerProvider{})
+
+		relayed, err := r.relayPending(ctx)
+		assert.Error(t, err)
+		assert.Zero(t, relayed)
+
+		mock.AssertExpectationsForObjects(t, store)
+	})
+}
+
+func TestRelay_Run(T *testing.T) {
+	T.Parallel()
+
+	T.Run("stops when context is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		store := &mocktypes.OutboxMessageDataManagerMock{}
+		store.On("ClaimPendingOutboxMessages", testutils.ContextMatcher, uint16(10), defaultClaimDuration).Return([]*types.OutboxMessage{}, nil)
+		store.On("PrunePublishedOutboxMessages", testutils.ContextMatcher, mock.AnythingOfType("time.Time")).Return(int64(0), nil).Run(func(mock.Arguments) {
+			cancel()
+		})
+
+		r := buildTestRelay(t, store, &mockpublishers.ProducerProvider{})
+
+		assert.NoError(t, r.Run(ctx))
+
+		mock.AssertExpectationsForObjects(t, store)
+	})
+}
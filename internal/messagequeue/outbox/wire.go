@@ -0,0 +1,38 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	msgconfig "github.com/dinnerdonebetter/backend/internal/messagequeue/config"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/google/wire"
+)
+
+var (
+	// Providers are what we provide to dependency injection.
+	Providers = wire.NewSet(
+		ProvidePublisherProvider,
+	)
+)
+
+// ProvidePublisherProvider provides a PublisherProvider for the configured queue, wrapped so that
+// publishes to the outbox's topics are written to the outbox.
+func ProvidePublisherProvider(
+	ctx context.Context,
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	queueConfig *msgconfig.Config,
+	cfg *Config,
+	store types.OutboxMessageDataManager,
+) (messagequeue.PublisherProvider, error) {
+	pp, err := msgconfig.ProvidePublisherProvider(ctx, logger, tracerProvider, queueConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return WrapPublisherProvider(logger, tracerProvider, cfg, store, pp), nil
+}
@@ -12,6 +12,11 @@
 	"github.com/go-redis/redis/v8"
 )
 
+const (
+	// consumerConcurrency is how many messages a consumer handles at once.
+	consumerConcurrency = 10
+)
+
 type (
 	subscriptionProvider interface {
 		Subscribe(ctx context.Context, channels ...string) *redis.PubSub
@@ -44,7 +49,7 @@ func provideRedisConsumer(ctx context.Context, logger logging.Logger, redisClien
 	}
 }
 
-// Consume reads messages and applies the handler to their payloads.
+// Consume reads messages and applies the handler to their payloads, handling up to consumerConcurrency of them at once.
 // Writes errors to the error chan if it isn't nil.
 func (r *redisConsumer) Consume(stopChan chan bool, errs chan error) {
 	if stopChan == nil {
@@ -52,19 +57,32 @@ func (r *redisConsumer) Consume(stopChan chan bool, errs chan error) {
 	}
 	subChan := r.subscription.Channel()
 
-	for {
-		select {
-		case msg := <-subChan:
-			if err := r.handlerFunc(context.Background(), []byte(msg.Payload)); err != nil {
-				r.logger.Error(err, "handling message")
-				if errs != nil {
-					errs <- err
+	stopping := make(chan struct{})
+	var wg sync.WaitGroup
+	for range consumerConcurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case msg := <-subChan:
+					if err := r.handlerFunc(context.Background(), []byte(msg.Payload)); err != nil {
+						r.logger.Error(err, "handling message")
+						if errs != nil {
+							errs <- err
+						}
+					}
+				case <-stopping:
+					return
 				}
 			}
-		case <-stopChan:
-			return
-		}
+		}()
 	}
+
+	<-stopChan
+	close(stopping)
+	wg.Wait()
 }
 
 type consumerProvider struct {
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -91,6 +93,58 @@ func Test_redisConsumer_Consume(T *testing.T) {
 
 		stopChan <- true
 	})
+
+	T.Run("handles messages concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		// neither handler returns until both are in flight, so this only finishes if messages are handled concurrently.
+		var inFlight sync.WaitGroup
+		inFlight.Add(2)
+		handled := make(chan struct{}, 2)
+		hf := func(context.Context, []byte) error {
+			inFlight.Done()
+			inFlight.Wait()
+			handled <- struct{}{}
+			return nil
+		}
+
+		messages := make(chan *redis.Message, 2)
+		messages <- &redis.Message{Payload: "first"}
+		messages <- &redis.Message{Payload: "second"}
+
+		consumer := &redisConsumer{
+			logger:       logging.NewNoopLogger(),
+			handlerFunc:  hf,
+			subscription: &fakeChannelProvider{messages: messages},
+			topic:        t.Name(),
+		}
+
+		stopChan := make(chan bool)
+		done := make(chan struct{})
+		go func() {
+			consumer.Consume(stopChan, nil)
+			close(done)
+		}()
+
+		for range 2 {
+			select {
+			case <-handled:
+			case <-time.After(time.Second):
+				t.Fatal("messages were not handled concurrently")
+			}
+		}
+
+		stopChan <- true
+		<-done
+	})
+}
+
+type fakeChannelProvider struct {
+	messages chan *redis.Message
+}
+
+func (p *fakeChannelProvider) Channel(...redis.ChannelOption) <-chan *redis.Message {
+	return p.messages
 }
 
 func Test_consumerProvider_ProvideConsumer(T *testing.T) {
@@ -0,0 +1,170 @@
+package sqs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+const (
+	// maxMessagesPerReceive is the most messages SQS will hand back from a single receive call.
+	maxMessagesPerReceive = 10
+	// receiveWaitTimeSeconds is how long a receive call long-polls for messages.
+	receiveWaitTimeSeconds = 20
+	// receiveErrorBackoff is how long we wait before polling again after a failed receive call.
+	receiveErrorBackoff = time.Second
+)
+
+type (
+	messageReceiver interface {
+		ReceiveMessageWithContext(ctx aws.Context, input *sqs.ReceiveMessageInput, opts ...request.Option) (*sqs.ReceiveMessageOutput, error)
+		DeleteMessageWithContext(ctx aws.Context, input *sqs.DeleteMessageInput, opts ...request.Option) (*sqs.DeleteMessageOutput, error)
+	}
+
+	sqsConsumer struct {
+		logger      logging.Logger
+		receiver    messageReceiver
+		handlerFunc func(context.Context, []byte) error
+		topic       string
+	}
+)
+
+// provideSQSConsumer provides a sqs-backed Consumer.
+func provideSQSConsumer(logger logging.Logger, sqsClient messageReceiver, topic string, handlerFunc func(context.Context, []byte) error) *sqsConsumer {
+	return &sqsConsumer{
+		topic:       topic,
+		handlerFunc: handlerFunc,
+		receiver:    sqsClient,
+		logger:      logging.EnsureLogger(logger),
+	}
+}
+
+// Consume long-polls the queue and applies the handler to each message's body, handling each received batch concurrently.
+// Messages are only deleted once they've been handled successfully; the rest are redelivered
+// once their visibility timeout lapses. Writes errors to the error chan if it isn't nil.
+func (c *sqsConsumer) Consume(stopChan chan bool, errs chan error) {
+	if stopChan == nil {
+		stopChan = make(chan bool, 1)
+	}
+
+	receiveCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-stopChan:
+			cancel()
+		case <-receiveCtx.Done():
+		}
+	}()
+
+	input := &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(c.topic),
+		MaxNumberOfMessages: aws.Int64(maxMessagesPerReceive),
+		WaitTimeSeconds:     aws.Int64(receiveWaitTimeSeconds),
+	}
+
+	for {
+		output, err := c.receiver.ReceiveMessageWithContext(receiveCtx, input)
+		if receiveCtx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			c.logger.Error(err, "receiving messages")
+			if errs != nil {
+				errs <- err
+			}
+
+			select {
+			case <-time.After(receiveErrorBackoff):
+				continue
+			case <-receiveCtx.Done():
+				return
+			}
+		}
+
+		// a batch's messages are handled concurrently, and we only receive more once all of them are done with.
+		var wg sync.WaitGroup
+		for _, msg := range output.Messages {
+			wg.Add(1)
+			go func(msg *sqs.Message) {
+				defer wg.Done()
+				c.handleMessage(msg, errs)
+			}(msg)
+		}
+		wg.Wait()
+	}
+}
+
+// handleMessage applies the handler to a message's body, and deletes the message if it was handled successfully.
+func (c *sqsConsumer) handleMessage(msg *sqs.Message, errs chan error) {
+	ctx := context.Background()
+
+	if err := c.handlerFunc(ctx, []byte(aws.StringValue(msg.Body))); err != nil {
+		c.logger.Error(err, "handling message")
+		if errs != nil {
+			errs <- err
+		}
+		return
+	}
+
+	if _, err := c.receiver.DeleteMessageWithContext(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(c.topic),
+		ReceiptHandle: msg.ReceiptHandle,
+	}); err != nil {
+		c.logger.Error(err, "deleting handled message")
+		if errs != nil {
+			errs <- err
+		}
+	}
+}
+
+type consumerProvider struct {
+	logger           logging.Logger
+	consumerCache    map[string]messagequeue.Consumer
+	sqsClient        messageReceiver
+	consumerCacheHat sync.RWMutex
+}
+
+// ProvideSQSConsumerProvider returns a ConsumerProvider for a given address.
+func ProvideSQSConsumerProvider(logger logging.Logger, _ tracing.TracerProvider) messagequeue.ConsumerProvider {
+	sess := session.Must(session.NewSessionWithOptions(session.Options{
+		SharedConfigState: session.SharedConfigEnable,
+	}))
+	svc := sqs.New(sess)
+
+	return &consumerProvider{
+		logger:        logging.EnsureLogger(logger),
+		sqsClient:     svc,
+		consumerCache: map[string]messagequeue.Consumer{},
+	}
+}
+
+// ProvideConsumer returns a Consumer for a given topic.
+func (p *consumerProvider) ProvideConsumer(_ context.Context, topic string, handlerFunc func(context.Context, []byte) error) (messagequeue.Consumer, error) {
+	if topic == "" {
+		return nil, messagequeue.ErrEmptyTopicName
+	}
+	logger := logging.EnsureLogger(p.logger).WithValue("topic", topic)
+
+	p.consumerCacheHat.Lock()
+	defer p.consumerCacheHat.Unlock()
+	if cachedConsumer, ok := p.consumerCache[topic]; ok {
+		return cachedConsumer, nil
+	}
+
+	c := provideSQSConsumer(logger, p.sqsClient, topic, handlerFunc)
+	p.consumerCache[topic] = c
+
+	return c, nil
+}
@@ -0,0 +1,282 @@
+package sqs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockMessageReceiver struct {
+	mock.Mock
+}
+
+// ReceiveMessageWithContext is a mock function.
+func (m *mockMessageReceiver) ReceiveMessageWithContext(ctx aws.Context, input *sqs.ReceiveMessageInput, opts ...request.Option) (*sqs.ReceiveMessageOutput, error) {
+	retVals := m.Called(ctx, input, opts)
+
+	return retVals.Get(0).(*sqs.ReceiveMessageOutput), retVals.Error(1)
+}
+
+// DeleteMessageWithContext is a mock function.
+func (m *mockMessageReceiver) DeleteMessageWithContext(ctx aws.Context, input *sqs.DeleteMessageInput, opts ...request.Option) (*sqs.DeleteMessageOutput, error) {
+	retVals := m.Called(ctx, input, opts)
+
+	return retVals.Get(0).(*sqs.DeleteMessageOutput), retVals.Error(1)
+}
+
+func Test_sqsConsumer_Consume(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		handled := make(chan []byte, 1)
+		hf := func(_ context.Context, body []byte) error {
+			handled <- body
+			return nil
+		}
+
+		receiptHandle := aws.String(t.Name())
+
+		mmr := &mockMessageReceiver{}
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *sqs.ReceiveMessageInput) bool { return aws.StringValue(input.QueueUrl) == t.Name() }),
+			[]request.Option(nil),
+		).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{{Body: aws.String("blah"), ReceiptHandle: receiptHandle}}}, nil).Once()
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.ReceiveMessageInput"),
+			[]request.Option(nil),
+		).Return(&sqs.ReceiveMessageOutput{}, nil)
+		mmr.On(
+			"DeleteMessageWithContext",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *sqs.DeleteMessageInput) bool { return input.ReceiptHandle == receiptHandle }),
+			[]request.Option(nil),
+		).Return(&sqs.DeleteMessageOutput{}, nil)
+
+		consumer := provideSQSConsumer(logging.NewNoopLogger(), mmr, t.Name(), hf)
+
+		stopChan := make(chan bool)
+		done := make(chan struct{})
+		go func() {
+			consumer.Consume(stopChan, nil)
+			close(done)
+		}()
+
+		assert.Equal(t, []byte("blah"), <-handled)
+
+		stopChan <- true
+		<-done
+
+		mock.AssertExpectationsForObjects(t, mmr)
+	})
+
+	T.Run("handles a batch concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		// neither handler returns until both are in flight, so this only finishes if the batch is handled concurrently.
+		var inFlight sync.WaitGroup
+		inFlight.Add(2)
+		hf := func(context.Context, []byte) error {
+			inFlight.Done()
+			inFlight.Wait()
+			return nil
+		}
+
+		mmr := &mockMessageReceiver{}
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.ReceiveMessageInput"),
+			[]request.Option(nil),
+		).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{
+			{Body: aws.String("first"), ReceiptHandle: aws.String("first")},
+			{Body: aws.String("second"), ReceiptHandle: aws.String("second")},
+		}}, nil).Once()
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.ReceiveMessageInput"),
+			[]request.Option(nil),
+		).Return(&sqs.ReceiveMessageOutput{}, nil)
+
+		deleted := make(chan string, 2)
+		mmr.On(
+			"DeleteMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.DeleteMessageInput"),
+			[]request.Option(nil),
+		).Run(func(args mock.Arguments) {
+			deleted <- aws.StringValue(args.Get(1).(*sqs.DeleteMessageInput).ReceiptHandle)
+		}).Return(&sqs.DeleteMessageOutput{}, nil)
+
+		consumer := provideSQSConsumer(logging.NewNoopLogger(), mmr, t.Name(), hf)
+
+		stopChan := make(chan bool)
+		done := make(chan struct{})
+		go func() {
+			consumer.Consume(stopChan, nil)
+			close(done)
+		}()
+
+		actual := []string{}
+		for range 2 {
+			select {
+			case receiptHandle := <-deleted:
+				actual = append(actual, receiptHandle)
+			case <-time.After(time.Second):
+				t.Fatal("batch was not handled concurrently")
+			}
+		}
+		assert.ElementsMatch(t, []string{"first", "second"}, actual)
+
+		stopChan <- true
+		<-done
+	})
+
+	T.Run("with error handling message", func(t *testing.T) {
+		t.Parallel()
+
+		anticipatedError := errors.New("blah")
+		hf := func(context.Context, []byte) error {
+			return anticipatedError
+		}
+
+		mmr := &mockMessageReceiver{}
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.ReceiveMessageInput"),
+			[]request.Option(nil),
+		).Return(&sqs.ReceiveMessageOutput{Messages: []*sqs.Message{{Body: aws.String("blah"), ReceiptHandle: aws.String(t.Name())}}}, nil).Once()
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.ReceiveMessageInput"),
+			[]request.Option(nil),
+		).Return(&sqs.ReceiveMessageOutput{}, nil)
+
+		consumer := provideSQSConsumer(logging.NewNoopLogger(), mmr, t.Name(), hf)
+
+		stopChan := make(chan bool)
+		errorsChan := make(chan error)
+		done := make(chan struct{})
+		go func() {
+			consumer.Consume(stopChan, errorsChan)
+			close(done)
+		}()
+
+		err := <-errorsChan
+		assert.Equal(t, anticipatedError, err)
+
+		stopChan <- true
+		<-done
+
+		// the message must stay on the queue so that it gets redelivered.
+		mmr.AssertNotCalled(t, "DeleteMessageWithContext", testutils.ContextMatcher, mock.Anything, mock.Anything)
+	})
+
+	T.Run("with error receiving messages", func(t *testing.T) {
+		t.Parallel()
+
+		anticipatedError := errors.New("blah")
+
+		mmr := &mockMessageReceiver{}
+		mmr.On(
+			"ReceiveMessageWithContext",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*sqs.ReceiveMessageInput"),
+			[]request.Option(nil),
+		).Return((*sqs.ReceiveMessageOutput)(nil), anticipatedError)
+
+		consumer := provideSQSConsumer(logging.NewNoopLogger(), mmr, t.Name(), nil)
+
+		stopChan := make(chan bool)
+		errorsChan := make(chan error)
+		done := make(chan struct{})
+		go func() {
+			consumer.Consume(stopChan, errorsChan)
+			close(done)
+		}()
+
+		err := <-errorsChan
+		assert.Equal(t, anticipatedError, err)
+
+		stopChan <- true
+
+		select {
+		case <-done:
+		case <-time.After(receiveErrorBackoff * 5):
+			t.Fatal("consumer did not stop")
+		}
+	})
+}
+
+func TestProvideSQSConsumerProvider(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ProvideSQSConsumerProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider())
+		assert.NotNil(t, actual)
+	})
+}
+
+func Test_consumerProvider_ProvideConsumer(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		provider := ProvideSQSConsumerProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider())
+		require.NotNil(t, provider)
+
+		actual, err := provider.ProvideConsumer(context.Background(), t.Name(), nil)
+		assert.NotNil(t, actual)
+		assert.NoError(t, err)
+	})
+
+	T.Run("with cache hit", func(t *testing.T) {
+		t.Parallel()
+
+		provider := ProvideSQSConsumerProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider())
+		require.NotNil(t, provider)
+
+		first, err := provider.ProvideConsumer(context.Background(), t.Name(), nil)
+		assert.NotNil(t, first)
+		assert.NoError(t, err)
+
+		second, err := provider.ProvideConsumer(context.Background(), t.Name(), nil)
+		assert.NoError(t, err)
+		assert.Same(t, first, second)
+	})
+
+	T.Run("with empty topic", func(t *testing.T) {
+		t.Parallel()
+
+		provider := ProvideSQSConsumerProvider(logging.NewNoopLogger(), tracing.NewNoopTracerProvider())
+		require.NotNil(t, provider)
+
+		actual, err := provider.ProvideConsumer(context.Background(), "", nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
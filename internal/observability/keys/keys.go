@@ -47,6 +47,8 @@
 	WebhookIDKey = "webhook.id"
 	// WebhookTriggerEventIDKey is the standard key for referring to a webhook trigger event's ID.
 	WebhookTriggerEventIDKey = "webhook_trigger_event.id"
+	// WebhookDeliveryIDKey is the standard key for referring to a webhook delivery's ID.
+	WebhookDeliveryIDKey = "webhook_delivery.id"
 	// AuditLogEntryIDKey is the standard key for referring to an audit log entry's ID.
 	AuditLogEntryIDKey = "audit_log_entry.id"
 	// AuditLogEntryResourceTypesKey is the standard key for referring to an audit log entry's resource type.
@@ -105,6 +107,8 @@
 
 	// ValidIngredientPreparationIDKey is the standard key for referring to a valid ingredient preparation's ID.
 	ValidIngredientPreparationIDKey = "valid_ingredient_preparation.id"
+	// ValidIngredientSubstitutionIDKey is the standard key for referring to a valid ingredient substitution's ID.
+	ValidIngredientSubstitutionIDKey = "valid_ingredient_substitution.id"
 
 	// ValidPreparationInstrumentIDKey is the standard key for referring to a valid instrument preparation's ID.
 	ValidPreparationInstrumentIDKey = "valid_preparation_instrument.id"
@@ -121,6 +125,21 @@
 	// RecipeStepIDKey is the standard key for referring to a recipe step's ID.
 	RecipeStepIDKey = "recipe_step.id"
 
+	// CookingSessionIDKey is the standard key for referring to a cooking session's ID.
+	CookingSessionIDKey = "cooking_session.id"
+
+	// CookingSessionProgressEntryIDKey is the standard key for referring to a cooking session progress entry's ID.
+	CookingSessionProgressEntryIDKey = "cooking_session_progress_entry.id"
+
+	// DeadLetteredMessageIDKey is the standard key for referring to a dead-lettered message's ID.
+	DeadLetteredMessageIDKey = "dead_lettered_message.id"
+
+	// OutboxMessageIDKey is the standard key for referring to an outbox message's ID.
+	OutboxMessageIDKey = "outbox_message.id"
+
+	// QueueTopicKey is the standard key for referring to a message queue topic.
+	QueueTopicKey = "queue.topic"
+
 	// RecipePrepTaskIDKey is the standard key for referring to a recipe prep task's ID.
 	RecipePrepTaskIDKey = "recipe_prep_task.id"
 
@@ -181,6 +200,9 @@
 	// HouseholdInstrumentOwnershipIDKey is the standard key for referring to a household instrument ownership's ID.
 	HouseholdInstrumentOwnershipIDKey = "household_instrument_ownership.id"
 
+	// PantryItemIDKey is the standard key for referring to a pantry item's ID.
+	PantryItemIDKey = "pantry_item.id"
+
 	// RecipeRatingIDKey is the standard key for referring to a recipe rating's ID.
 	RecipeRatingIDKey = "recipe_rating.id"
 
@@ -208,4 +230,10 @@
 
 	// ValidPreparationVesselIDKey is the standard key for referring to a valid preparation vessel's ID.
 	ValidPreparationVesselIDKey = "valid_preparation_vessels.id"
+
+	// UserPasskeyIDKey is the standard key for referring to a user passkey's ID.
+	UserPasskeyIDKey = "user_passkey.id"
+
+	// PasskeyCeremonyIDKey is the standard key for referring to a passkey ceremony's ID.
+	PasskeyCeremonyIDKey = "passkey_ceremony.id"
 )
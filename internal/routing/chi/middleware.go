@@ -2,7 +2,9 @@
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
 	"strings"
 	"time"
 
@@ -64,3 +66,91 @@ func buildTracingMiddleware(tracer tracing.Tracer) func(next http.Handler) http.
 		})
 	}
 }
+
+// parseTrustedProxies parses the configured trusted proxy CIDRs, skipping any that are invalid.
+func parseTrustedProxies(logger logging.Logger, cidrs []string) []netip.Prefix {
+	out := []netip.Prefix{}
+
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			logger.Error(err, "parsing trusted proxy")
+			continue
+		}
+
+		out = append(out, prefix.Masked())
+	}
+
+	return out
+}
+
+// isTrustedProxy reports whether an address belongs to one of our trusted proxies.
+func isTrustedProxy(trustedProxies []netip.Prefix, address string) bool {
+	ip, err := netip.ParseAddr(strings.TrimSpace(address))
+	if err != nil {
+		return false
+	}
+
+	ip = ip.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildRealIPMiddleware builds a middleware that sets a request's RemoteAddr to the client's IP address.
+// Forwarding headers are only honored when the request comes from a trusted proxy, and then only up to the
+// rightmost X-Forwarded-For hop that isn't a trusted proxy, since anything to the left of it is client-controlled.
+func buildRealIPMiddleware(trustedProxies []netip.Prefix) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if ip := clientIPAddress(trustedProxies, req); ip != "" {
+				req.RemoteAddr = ip
+			}
+
+			next.ServeHTTP(res, req)
+		})
+	}
+}
+
+// clientIPAddress determines a request's client IP address, or returns an empty string if the peer is not a trusted proxy.
+func clientIPAddress(trustedProxies []netip.Prefix, req *http.Request) string {
+	peer, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		peer = req.RemoteAddr
+	}
+
+	if !isTrustedProxy(trustedProxies, peer) {
+		return ""
+	}
+
+	hops := []string{}
+	for _, header := range req.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if _, err = netip.ParseAddr(hop); err != nil {
+			// a proxy we trust wouldn't write garbage, so whatever is left was written by the client.
+			return ""
+		}
+
+		if !isTrustedProxy(trustedProxies, hop) {
+			return hop
+		}
+	}
+
+	if len(hops) == 0 {
+		if realIP := strings.TrimSpace(req.Header.Get("X-Real-IP")); realIP != "" {
+			if _, err = netip.ParseAddr(realIP); err == nil {
+				return realIP
+			}
+		}
+	}
+
+	return ""
+}
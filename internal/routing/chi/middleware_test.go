@@ -1,14 +1,19 @@
 package chi
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	cacheconfig "github.com/dinnerdonebetter/backend/internal/cache/config"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/routing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBuildLoggingMiddleware(T *testing.T) {
@@ -29,3 +34,103 @@ func TestBuildLoggingMiddleware(T *testing.T) {
 		middleware(hf).ServeHTTP(res, req)
 	})
 }
+
+func TestBuildRealIPMiddleware(T *testing.T) {
+	T.Parallel()
+
+	trustedProxies := parseTrustedProxies(logging.NewNoopLogger(), []string{"10.0.0.0/8"})
+
+	remoteAddrFor := func(t *testing.T, req *http.Request) string {
+		t.Helper()
+
+		var actual string
+		hf := http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
+			actual = req.RemoteAddr
+		})
+
+		buildRealIPMiddleware(trustedProxies)(hf).ServeHTTP(httptest.NewRecorder(), req)
+
+		return actual
+	}
+
+	T.Run("ignores forwarded headers from untrusted peers", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		req.RemoteAddr = "203.0.113.7:12345"
+		req.Header.Set("X-Forwarded-For", "198.51.100.1")
+		req.Header.Set("X-Real-IP", "198.51.100.2")
+
+		assert.Equal(t, "203.0.113.7:12345", remoteAddrFor(t, req))
+	})
+
+	T.Run("uses the rightmost untrusted hop from trusted peers", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		req.RemoteAddr = "10.0.0.2:12345"
+		req.Header.Add("X-Forwarded-For", "198.51.100.1, 203.0.113.7")
+		req.Header.Add("X-Forwarded-For", "10.0.0.3")
+
+		assert.Equal(t, "203.0.113.7", remoteAddrFor(t, req))
+	})
+
+	T.Run("ignores unparseable hops from trusted peers", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		req.RemoteAddr = "10.0.0.2:12345"
+		req.Header.Set("X-Forwarded-For", "203.0.113.7, nonsense")
+
+		assert.Equal(t, "10.0.0.2:12345", remoteAddrFor(t, req))
+	})
+
+	T.Run("uses X-Real-IP from trusted peers", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		req.RemoteAddr = "10.0.0.2:12345"
+		req.Header.Set("X-Real-IP", "203.0.113.7")
+
+		assert.Equal(t, "203.0.113.7", remoteAddrFor(t, req))
+	})
+
+	T.Run("spoofed headers do not reset the login attempt counter", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := &loginattempts.Config{
+			Cache:                   cacheconfig.Config{Provider: cacheconfig.ProviderMemory},
+			MaxFailuresPerIPAddress: 3,
+		}
+
+		tracker, err := loginattempts.ProvideTracker(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg)
+		require.NoError(t, err)
+
+		mux := buildChiMux(logging.NewNoopLogger(), tracing.NewTracer(tracing.NewNoopTracerProvider().Tracer(t.Name())), &routing.Config{TrustedProxies: []string{"10.0.0.0/8"}})
+
+		var lockouts []*loginattempts.Lockout
+		mux.Post("/login", func(_ http.ResponseWriter, req *http.Request) {
+			triggered, recordErr := tracker.RecordFailure(req.Context(), "", loginattempts.ClientIPAddress(req))
+			require.NoError(t, recordErr)
+			lockouts = append(lockouts, triggered...)
+		})
+
+		for _, spoofed := range []string{"198.51.100.1", "198.51.100.2", "198.51.100.3"} {
+			req := httptest.NewRequest(http.MethodPost, "/login", http.NoBody)
+			req.RemoteAddr = "203.0.113.7:12345"
+			req.Header.Set("X-Forwarded-For", spoofed)
+			req.Header.Set("X-Real-IP", spoofed)
+
+			mux.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		require.Len(t, lockouts, 1)
+		assert.Equal(t, loginattempts.SubjectTypeIPAddress, lockouts[0].SubjectType)
+		assert.Equal(t, "203.0.113.7", lockouts[0].Subject)
+
+		lockedUntil, err := tracker.LockedOutUntil(ctx, "", "203.0.113.7")
+		require.NoError(t, err)
+		assert.False(t, lockedUntil.IsZero())
+	})
+}
@@ -73,7 +73,7 @@ func buildChiMux(logger logging.Logger, tracer tracing.Tracer, cfg *routing.Conf
 	mux.Use(
 		buildTracingMiddleware(tracer),
 		chimiddleware.RequestID,
-		chimiddleware.RealIP,
+		buildRealIPMiddleware(parseTrustedProxies(logging.EnsureLogger(logger), cfg.TrustedProxies)),
 		chimiddleware.Timeout(maxTimeout),
 		buildLoggingMiddleware(logging.EnsureLogger(logger).WithName("router"), tracer, cfg.SilenceRouteLogging),
 		corsHandler.Handler,
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"net/netip"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -15,9 +16,11 @@
 type Config struct {
 	_ struct{} `json:"-"`
 
-	Provider               string `json:"provider,omitempty"            toml:"provider,omitempty"`
-	EnableCORSForLocalhost bool   `json:"enableCORSForLocalhost"        toml:"enable_cors_for_localhost"`
-	SilenceRouteLogging    bool   `json:"silenceRouteLogging,omitempty" toml:"silence_route_logging,omitempty"`
+	Provider string `json:"provider,omitempty"            toml:"provider,omitempty"`
+	// TrustedProxies are the CIDRs of the proxies we accept X-Forwarded-For and X-Real-IP headers from.
+	TrustedProxies         []string `json:"trustedProxies,omitempty"      toml:"trusted_proxies,omitempty"`
+	EnableCORSForLocalhost bool     `json:"enableCORSForLocalhost"        toml:"enable_cors_for_localhost"`
+	SilenceRouteLogging    bool     `json:"silenceRouteLogging,omitempty" toml:"silence_route_logging,omitempty"`
 }
 
 var _ validation.ValidatableWithContext = (*Config)(nil)
@@ -26,5 +29,13 @@ type Config struct {
 func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 	return validation.ValidateStructWithContext(ctx, cfg,
 		validation.Field(&cfg.Provider, validation.In(ChiProvider)),
+		validation.Field(&cfg.TrustedProxies, validation.Each(validation.By(validateCIDR))),
 	)
 }
+
+func validateCIDR(value any) error {
+	s, _ := value.(string)
+	_, err := netip.ParsePrefix(s)
+
+	return err
+}
@@ -20,4 +20,16 @@ func TestConfig_ValidateWithContext(T *testing.T) {
 
 		assert.NoError(t, cfg.ValidateWithContext(ctx))
 	})
+
+	T.Run("with invalid trusted proxy", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := &Config{
+			Provider:       ChiProvider,
+			TrustedProxies: []string{"10.0.0.1"},
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
 }
@@ -8,6 +8,8 @@
 
 	analyticscfg "github.com/dinnerdonebetter/backend/internal/analytics/config"
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	capitalismcfg "github.com/dinnerdonebetter/backend/internal/capitalism/config"
 	"github.com/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	dbconfig "github.com/dinnerdonebetter/backend/internal/database/config"
@@ -15,8 +17,19 @@
 	emailcfg "github.com/dinnerdonebetter/backend/internal/email/config"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	featureflagscfg "github.com/dinnerdonebetter/backend/internal/featureflags/config"
+	"github.com/dinnerdonebetter/backend/internal/features/cookingengine"
+	"github.com/dinnerdonebetter/backend/internal/features/cooktimeline"
+	"github.com/dinnerdonebetter/backend/internal/features/dietaryconflicts"
+	"github.com/dinnerdonebetter/backend/internal/features/equipmentcheck"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	graphing "github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
-	msgconfig "github.com/dinnerdonebetter/backend/internal/messagequeue/config"
+	"github.com/dinnerdonebetter/backend/internal/features/recipeimport"
+	"github.com/dinnerdonebetter/backend/internal/features/recipescaling"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
+	"github.com/dinnerdonebetter/backend/internal/features/substitutions"
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/outbox"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	logcfg "github.com/dinnerdonebetter/backend/internal/observability/logging/config"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -27,6 +40,8 @@
 	adminservice "github.com/dinnerdonebetter/backend/internal/services/admin"
 	auditlogentriesservice "github.com/dinnerdonebetter/backend/internal/services/auditlogentries"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	capitalismservice "github.com/dinnerdonebetter/backend/internal/services/capitalism"
+	cookingsessionsservice "github.com/dinnerdonebetter/backend/internal/services/cookingsessions"
 	householdinstrumentownershipsservice "github.com/dinnerdonebetter/backend/internal/services/householdinstrumentownerships"
 	householdinvitationssservice "github.com/dinnerdonebetter/backend/internal/services/householdinvitations"
 	householdsservice "github.com/dinnerdonebetter/backend/internal/services/households"
@@ -38,6 +53,7 @@
 	mealplantasksservice "github.com/dinnerdonebetter/backend/internal/services/mealplantasks"
 	mealsservice "github.com/dinnerdonebetter/backend/internal/services/meals"
 	oauth2clientsservice "github.com/dinnerdonebetter/backend/internal/services/oauth2clients"
+	pantryitemsservice "github.com/dinnerdonebetter/backend/internal/services/pantryitems"
 	recipepreptasksservice "github.com/dinnerdonebetter/backend/internal/services/recipepreptasks"
 	reciperatingsservice "github.com/dinnerdonebetter/backend/internal/services/reciperatings"
 	recipesservice "github.com/dinnerdonebetter/backend/internal/services/recipes"
@@ -58,6 +74,7 @@
 	validingredientsservice "github.com/dinnerdonebetter/backend/internal/services/validingredients"
 	validingredientstateingredientsservice "github.com/dinnerdonebetter/backend/internal/services/validingredientstateingredients"
 	validingredientstatesservice "github.com/dinnerdonebetter/backend/internal/services/validingredientstates"
+	validingredientsubstitutionsservice "github.com/dinnerdonebetter/backend/internal/services/validingredientsubstitutions"
 	validinstrumentsservice "github.com/dinnerdonebetter/backend/internal/services/validinstruments"
 	validmeasurementconversionsservice "github.com/dinnerdonebetter/backend/internal/services/validmeasurementunitconversions"
 	validmeasurementunitsservice "github.com/dinnerdonebetter/backend/internal/services/validmeasurementunits"
@@ -79,11 +96,12 @@ func Build(
 ) (http.Server, error) {
 	wire.Build(
 		authentication.AuthProviders,
+		loginattempts.Providers,
 		config.ServiceConfigProviders,
 		database.DBProviders,
 		dbconfig.DatabaseConfigProviders,
 		encoding.EncDecProviders,
-		msgconfig.MessageQueueProviders,
+		outbox.Providers,
 		http.ProvidersHTTP,
 		images.ProvidersImages,
 		chi.ProvidersChi,
@@ -96,6 +114,17 @@ func Build(
 		postgres.ProvidersPostgres,
 		logcfg.ProvidersLogConfig,
 		graphing.Providers,
+		unitconversion.Providers,
+		recipescaling.Providers,
+		webhookdelivery.Providers,
+		dietaryconflicts.Providers,
+		cookingengine.Providers,
+		cooktimeline.Providers,
+		equipmentcheck.Providers,
+		nutrition.Providers,
+		substitutions.Providers,
+		recipeimport.Providers,
+		recommendations.Providers,
 		authservice.Providers,
 		usersservice.Providers,
 		householdsservice.Providers,
@@ -137,9 +166,14 @@ func Build(
 		validvesselsservice.Providers,
 		validpreparationvesselsservice.Providers,
 		analyticscfg.ProvidersAnalytics,
+		capitalismcfg.Providers,
 		workersservice.Providers,
 		usernotificationsservice.Providers,
+		cookingsessionsservice.Providers,
+		pantryitemsservice.Providers,
+		validingredientsubstitutionsservice.Providers,
 		auditlogentriesservice.Providers,
+		capitalismservice.Providers,
 	)
 
 	return nil, nil
@@ -10,6 +10,8 @@
 	"context"
 	config7 "github.com/dinnerdonebetter/backend/internal/analytics/config"
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	config5 "github.com/dinnerdonebetter/backend/internal/capitalism/config"
 	"github.com/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	config4 "github.com/dinnerdonebetter/backend/internal/database/config"
@@ -17,8 +19,19 @@
 	config8 "github.com/dinnerdonebetter/backend/internal/email/config"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	config6 "github.com/dinnerdonebetter/backend/internal/featureflags/config"
+	"github.com/dinnerdonebetter/backend/internal/features/cookingengine"
+	"github.com/dinnerdonebetter/backend/internal/features/cooktimeline"
+	"github.com/dinnerdonebetter/backend/internal/features/dietaryconflicts"
+	"github.com/dinnerdonebetter/backend/internal/features/equipmentcheck"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
-	config5 "github.com/dinnerdonebetter/backend/internal/messagequeue/config"
+	"github.com/dinnerdonebetter/backend/internal/features/recipeimport"
+	"github.com/dinnerdonebetter/backend/internal/features/recipescaling"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
+	"github.com/dinnerdonebetter/backend/internal/features/substitutions"
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/outbox"
 	config2 "github.com/dinnerdonebetter/backend/internal/observability/logging/config"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	config3 "github.com/dinnerdonebetter/backend/internal/observability/tracing/config"
@@ -28,6 +41,8 @@
 	"github.com/dinnerdonebetter/backend/internal/services/admin"
 	"github.com/dinnerdonebetter/backend/internal/services/auditlogentries"
 	authentication2 "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	"github.com/dinnerdonebetter/backend/internal/services/capitalism"
+	"github.com/dinnerdonebetter/backend/internal/services/cookingsessions"
 	"github.com/dinnerdonebetter/backend/internal/services/householdinstrumentownerships"
 	"github.com/dinnerdonebetter/backend/internal/services/householdinvitations"
 	"github.com/dinnerdonebetter/backend/internal/services/households"
@@ -39,6 +54,7 @@
 	"github.com/dinnerdonebetter/backend/internal/services/mealplantasks"
 	"github.com/dinnerdonebetter/backend/internal/services/meals"
 	"github.com/dinnerdonebetter/backend/internal/services/oauth2clients"
+	"github.com/dinnerdonebetter/backend/internal/services/pantryitems"
 	"github.com/dinnerdonebetter/backend/internal/services/recipepreptasks"
 	"github.com/dinnerdonebetter/backend/internal/services/reciperatings"
 	"github.com/dinnerdonebetter/backend/internal/services/recipes"
@@ -59,6 +75,7 @@
 	"github.com/dinnerdonebetter/backend/internal/services/validingredients"
 	"github.com/dinnerdonebetter/backend/internal/services/validingredientstateingredients"
 	"github.com/dinnerdonebetter/backend/internal/services/validingredientstates"
+	"github.com/dinnerdonebetter/backend/internal/services/validingredientsubstitutions"
 	"github.com/dinnerdonebetter/backend/internal/services/validinstruments"
 	"github.com/dinnerdonebetter/backend/internal/services/validmeasurementunitconversions"
 	"github.com/dinnerdonebetter/backend/internal/services/validmeasurementunits"
@@ -104,7 +121,9 @@ func Build(ctx context.Context, cfg *config.InstanceConfig) (http.Server, error)
 		return nil, err
 	}
 	config11 := &cfg.Events
-	publisherProvider, err := config5.ProvidePublisherProvider(ctx, logger, tracerProvider, config11)
+	outboxConfig := &cfg.Outbox
+	outboxMessageDataManager := database.ProvideOutboxMessageDataManager(dataManager)
+	publisherProvider, err := outbox.ProvidePublisherProvider(ctx, logger, tracerProvider, config11, outboxConfig, outboxMessageDataManager)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +140,12 @@ func Build(ctx context.Context, cfg *config.InstanceConfig) (http.Server, error)
 		return nil, err
 	}
 	routeParamManager := chi.NewRouteParamManager()
-	authService, err := authentication2.ProvideService(ctx, logger, authenticationConfig, authenticator, dataManager, householdUserMembershipDataManager, sessionManager, serverEncoderDecoder, tracerProvider, publisherProvider, generator, featureFlagManager, eventReporter, routeParamManager)
+	loginattemptsConfig := &authenticationConfig.LoginAttempts
+	tracker, err := loginattempts.ProvideTracker(logger, tracerProvider, loginattemptsConfig)
+	if err != nil {
+		return nil, err
+	}
+	authService, err := authentication2.ProvideService(ctx, logger, authenticationConfig, authenticator, dataManager, householdUserMembershipDataManager, sessionManager, serverEncoderDecoder, tracerProvider, publisherProvider, generator, featureFlagManager, eventReporter, routeParamManager, tracker)
 	if err != nil {
 		return nil, err
 	}
@@ -131,12 +155,23 @@ func Build(ctx context.Context, cfg *config.InstanceConfig) (http.Server, error)
 	householdInvitationDataManager := database.ProvideHouseholdInvitationDataManager(dataManager)
 	mediaUploadProcessor := images.NewImageUploadProcessor(logger, tracerProvider)
 	passwordResetTokenDataManager := database.ProvidePasswordResetTokenDataManager(dataManager)
-	userDataService, err := users.ProvideUsersService(ctx, usersConfig, authenticationConfig, logger, userDataManager, householdDataManager, householdInvitationDataManager, householdUserMembershipDataManager, authenticator, serverEncoderDecoder, mediaUploadProcessor, routeParamManager, tracerProvider, publisherProvider, generator, passwordResetTokenDataManager, featureFlagManager, eventReporter)
+	userRecoveryCodeDataManager := database.ProvideUserRecoveryCodeDataManager(dataManager)
+	auditLogEntryDataManager := database.ProvideAuditLogEntryDataManager(dataManager)
+	transactionRunner := database.ProvideTransactionRunner(dataManager)
+	userDataService, err := users.ProvideUsersService(ctx, usersConfig, authenticationConfig, logger, userDataManager, householdDataManager, householdInvitationDataManager, householdUserMembershipDataManager, authenticator, transactionRunner, serverEncoderDecoder, mediaUploadProcessor, routeParamManager, tracerProvider, publisherProvider, generator, passwordResetTokenDataManager, userRecoveryCodeDataManager, featureFlagManager, eventReporter, auditLogEntryDataManager, tracker)
 	if err != nil {
 		return nil, err
 	}
+	mealDataManager := database.ProvideMealDataManager(dataManager)
+	recipeDataManager := database.ProvideRecipeDataManager(dataManager)
+	mealPlanDataManager := database.ProvideMealPlanDataManager(dataManager)
+	userIngredientPreferenceDataManager := database.ProvideUserIngredientPreferenceDataManager(dataManager)
+	recipeRatingDataManager := database.ProvideRecipeRatingDataManager(dataManager)
+	householdInstrumentOwnershipDataManager := database.ProvideHouseholdInstrumentOwnershipDataManager(dataManager)
+	recommender := recommendations.NewRecommender(logger, tracerProvider, householdDataManager, recipeDataManager, mealDataManager, mealPlanDataManager, recipeRatingDataManager, userIngredientPreferenceDataManager, householdInstrumentOwnershipDataManager)
 	householdsConfig := servicesConfig.Households
-	householdDataService, err := households.ProvideService(logger, householdsConfig, householdDataManager, householdInvitationDataManager, householdUserMembershipDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, generator)
+	householdEventDataManager := database.ProvideHouseholdEventDataManager(dataManager)
+	householdDataService, err := households.ProvideService(logger, householdsConfig, householdDataManager, householdInvitationDataManager, householdUserMembershipDataManager, householdEventDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, generator, recommender)
 	if err != nil {
 		return nil, err
 	}
@@ -146,88 +181,98 @@ func Build(ctx context.Context, cfg *config.InstanceConfig) (http.Server, error)
 	if err != nil {
 		return nil, err
 	}
-	householdInvitationDataService, err := householdinvitations.ProvideHouseholdInvitationsService(logger, householdinvitationsConfig, userDataManager, householdInvitationDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, emailer, generator)
+	householdInvitationDataService, err := householdinvitations.ProvideHouseholdInvitationsService(logger, householdinvitationsConfig, userDataManager, householdInvitationDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, emailer, generator)
 	if err != nil {
 		return nil, err
 	}
 	validinstrumentsConfig := &servicesConfig.ValidInstruments
 	config15 := &cfg.Search
 	validInstrumentDataManager := database.ProvideValidInstrumentDataManager(dataManager)
-	validInstrumentDataService, err := validinstruments.ProvideService(ctx, logger, validinstrumentsConfig, config15, validInstrumentDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validInstrumentDataService, err := validinstruments.ProvideService(ctx, logger, validinstrumentsConfig, config15, validInstrumentDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validingredientsConfig := &servicesConfig.ValidIngredients
 	validIngredientDataManager := database.ProvideValidIngredientDataManager(dataManager)
-	validIngredientDataService, err := validingredients.ProvideService(ctx, logger, validingredientsConfig, config15, validIngredientDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validIngredientNutritionDataManager := database.ProvideValidIngredientNutritionDataManager(dataManager)
+	validIngredientDataService, err := validingredients.ProvideService(ctx, logger, validingredientsConfig, config15, validIngredientDataManager, validIngredientNutritionDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validingredientgroupsConfig := &servicesConfig.ValidIngredientGroups
 	validIngredientGroupDataManager := database.ProvideValidIngredientGroupDataManager(dataManager)
-	validIngredientGroupDataService, err := validingredientgroups.ProvideService(logger, validingredientgroupsConfig, validIngredientGroupDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validIngredientGroupDataService, err := validingredientgroups.ProvideService(logger, validingredientgroupsConfig, validIngredientGroupDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validpreparationsConfig := &servicesConfig.ValidPreparations
 	validPreparationDataManager := database.ProvideValidPreparationDataManager(dataManager)
-	validPreparationDataService, err := validpreparations.ProvideService(ctx, logger, validpreparationsConfig, config15, validPreparationDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validPreparationDataService, err := validpreparations.ProvideService(ctx, logger, validpreparationsConfig, config15, validPreparationDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validingredientpreparationsConfig := &servicesConfig.ValidIngredientPreparations
 	validIngredientPreparationDataManager := database.ProvideValidIngredientPreparationDataManager(dataManager)
-	validIngredientPreparationDataService, err := validingredientpreparations.ProvideService(logger, validingredientpreparationsConfig, validIngredientPreparationDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validIngredientPreparationDataService, err := validingredientpreparations.ProvideService(logger, validingredientpreparationsConfig, validIngredientPreparationDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	mealsConfig := &servicesConfig.Meals
-	mealDataManager := database.ProvideMealDataManager(dataManager)
-	mealDataService, err := meals.ProvideService(ctx, logger, mealsConfig, config15, mealDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validMeasurementUnitConversionDataManager := database.ProvideValidMeasurementUnitConversionDataManager(dataManager)
+	graphBuilder := unitconversion.NewGraphBuilder(logger, tracerProvider, validMeasurementUnitConversionDataManager)
+	validIngredientMeasurementUnitDataManager := database.ProvideValidIngredientMeasurementUnitDataManager(dataManager)
+	calculator := nutrition.NewCalculator(logger, tracerProvider, graphBuilder, validIngredientNutritionDataManager, validIngredientMeasurementUnitDataManager)
+	mealDataService, err := meals.ProvideService(ctx, logger, mealsConfig, config15, mealDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, calculator)
 	if err != nil {
 		return nil, err
 	}
 	recipesConfig := &servicesConfig.Recipes
-	recipeDataManager := database.ProvideRecipeDataManager(dataManager)
 	recipeMediaDataManager := database.ProvideRecipeMediaDataManager(dataManager)
 	recipeAnalyzer := recipeanalysis.NewRecipeAnalyzer(logger, tracerProvider)
-	recipeDataService, err := recipes.ProvideService(ctx, logger, recipesConfig, config15, recipeDataManager, recipeMediaDataManager, recipeAnalyzer, serverEncoderDecoder, routeParamManager, publisherProvider, mediaUploadProcessor, tracerProvider)
+	recipeScaler := recipescaling.NewRecipeScaler(logger, tracerProvider, graphBuilder)
+	validPreparationInstrumentDataManager := database.ProvideValidPreparationInstrumentDataManager(dataManager)
+	equipmentChecker := equipmentcheck.NewEquipmentChecker(logger, tracerProvider, recipeDataManager, householdInstrumentOwnershipDataManager, validPreparationInstrumentDataManager)
+	validIngredientSubstitutionDataManager := database.ProvideValidIngredientSubstitutionDataManager(dataManager)
+	suggester := substitutions.NewSuggester(logger, tracerProvider, userIngredientPreferenceDataManager, validIngredientSubstitutionDataManager)
+	validMeasurementUnitDataManager := database.ProvideValidMeasurementUnitDataManager(dataManager)
+	importer := recipeimport.NewImporter(logger, tracerProvider, validIngredientDataManager, validMeasurementUnitDataManager, validPreparationDataManager)
+	recipeDataService, err := recipes.ProvideService(ctx, logger, recipesConfig, config15, recipeDataManager, recipeMediaDataManager, recipeAnalyzer, recipeScaler, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, mediaUploadProcessor, tracerProvider, equipmentChecker, calculator, suggester, importer)
 	if err != nil {
 		return nil, err
 	}
 	recipestepsConfig := &servicesConfig.RecipeSteps
 	recipeStepDataManager := database.ProvideRecipeStepDataManager(dataManager)
-	recipeStepDataService, err := recipesteps.ProvideService(ctx, logger, recipestepsConfig, recipeStepDataManager, recipeMediaDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, mediaUploadProcessor)
+	recipeStepDataService, err := recipesteps.ProvideService(ctx, logger, recipestepsConfig, recipeStepDataManager, recipeMediaDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, mediaUploadProcessor)
 	if err != nil {
 		return nil, err
 	}
 	recipestepproductsConfig := &servicesConfig.RecipeStepProducts
 	recipeStepProductDataManager := database.ProvideRecipeStepProductDataManager(dataManager)
-	recipeStepProductDataService, err := recipestepproducts.ProvideService(logger, recipestepproductsConfig, recipeStepProductDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipeStepProductDataService, err := recipestepproducts.ProvideService(logger, recipestepproductsConfig, recipeStepProductDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	recipestepinstrumentsConfig := &servicesConfig.RecipeStepInstruments
 	recipeStepInstrumentDataManager := database.ProvideRecipeStepInstrumentDataManager(dataManager)
-	recipeStepInstrumentDataService, err := recipestepinstruments.ProvideService(logger, recipestepinstrumentsConfig, recipeStepInstrumentDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipeStepInstrumentDataService, err := recipestepinstruments.ProvideService(logger, recipestepinstrumentsConfig, recipeStepInstrumentDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	recipestepingredientsConfig := &servicesConfig.RecipeStepIngredients
 	recipeStepIngredientDataManager := database.ProvideRecipeStepIngredientDataManager(dataManager)
-	recipeStepIngredientDataService, err := recipestepingredients.ProvideService(logger, recipestepingredientsConfig, recipeStepIngredientDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipeStepIngredientDataService, err := recipestepingredients.ProvideService(logger, recipestepingredientsConfig, recipeStepIngredientDataManager, recipeDataManager, validIngredientSubstitutionDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	mealplansConfig := &servicesConfig.MealPlans
-	mealPlanDataManager := database.ProvideMealPlanDataManager(dataManager)
-	mealPlanDataService, err := mealplans.ProvideService(logger, mealplansConfig, mealPlanDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	mealPlanDataService, err := mealplans.ProvideService(logger, mealplansConfig, mealPlanDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	mealplanoptionsConfig := &servicesConfig.MealPlanOptions
 	mealPlanOptionDataManager := database.ProvideMealPlanOptionDataManager(dataManager)
-	mealPlanOptionDataService, err := mealplanoptions.ProvideService(logger, mealplanoptionsConfig, mealPlanOptionDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	conflictChecker := dietaryconflicts.NewConflictChecker(logger, tracerProvider, householdDataManager, mealDataManager, userIngredientPreferenceDataManager)
+	mealPlanOptionDataService, err := mealplanoptions.ProvideService(logger, mealplanoptionsConfig, mealPlanOptionDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, conflictChecker)
 	if err != nil {
 		return nil, err
 	}
@@ -237,80 +282,81 @@ func Build(ctx context.Context, cfg *config.InstanceConfig) (http.Server, error)
 		return nil, err
 	}
 	validmeasurementunitsConfig := &servicesConfig.ValidMeasurementUnits
-	validMeasurementUnitDataManager := database.ProvideValidMeasurementUnitDataManager(dataManager)
-	validMeasurementUnitDataService, err := validmeasurementunits.ProvideService(ctx, logger, validmeasurementunitsConfig, config15, validMeasurementUnitDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validMeasurementUnitDataService, err := validmeasurementunits.ProvideService(ctx, logger, validmeasurementunitsConfig, config15, validMeasurementUnitDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validingredientstatesConfig := &servicesConfig.ValidIngredientStates
 	validIngredientStateDataManager := database.ProvideValidIngredientStateDataManager(dataManager)
-	validIngredientStateDataService, err := validingredientstates.ProvideService(ctx, logger, validingredientstatesConfig, config15, validIngredientStateDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validIngredientStateDataService, err := validingredientstates.ProvideService(ctx, logger, validingredientstatesConfig, config15, validIngredientStateDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validpreparationinstrumentsConfig := &servicesConfig.ValidPreparationInstruments
-	validPreparationInstrumentDataManager := database.ProvideValidPreparationInstrumentDataManager(dataManager)
-	validPreparationInstrumentDataService, err := validpreparationinstruments.ProvideService(logger, validpreparationinstrumentsConfig, validPreparationInstrumentDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validPreparationInstrumentDataService, err := validpreparationinstruments.ProvideService(logger, validpreparationinstrumentsConfig, validPreparationInstrumentDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validingredientmeasurementunitsConfig := &servicesConfig.ValidInstrumentMeasurementUnits
-	validIngredientMeasurementUnitDataManager := database.ProvideValidIngredientMeasurementUnitDataManager(dataManager)
-	validIngredientMeasurementUnitDataService, err := validingredientmeasurementunits.ProvideService(logger, validingredientmeasurementunitsConfig, validIngredientMeasurementUnitDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validIngredientMeasurementUnitDataService, err := validingredientmeasurementunits.ProvideService(logger, validingredientmeasurementunitsConfig, validIngredientMeasurementUnitDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	mealplaneventsConfig := &servicesConfig.MealPlanEvents
 	mealPlanEventDataManager := database.ProvideMealPlanEventDataManager(dataManager)
-	mealPlanEventDataService, err := mealplanevents.ProvideService(logger, mealplaneventsConfig, mealPlanEventDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	timelineScheduler := cooktimeline.NewTimelineScheduler(logger, tracerProvider, recipeAnalyzer, mealPlanEventDataManager, mealDataManager, householdInstrumentOwnershipDataManager)
+	mealPlanEventDataService, err := mealplanevents.ProvideService(logger, mealplaneventsConfig, mealPlanEventDataManager, mealPlanDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, recommender, timelineScheduler)
 	if err != nil {
 		return nil, err
 	}
 	mealplantasksConfig := &servicesConfig.MealPlanTasks
 	mealPlanTaskDataManager := database.ProvideMealPlanTaskDataManager(dataManager)
-	mealPlanTaskDataService, err := mealplantasks.ProvideService(logger, mealplantasksConfig, mealPlanTaskDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	mealPlanTaskDataService, err := mealplantasks.ProvideService(logger, mealplantasksConfig, mealPlanTaskDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	recipepreptasksConfig := &servicesConfig.RecipePrepTasks
 	recipePrepTaskDataManager := database.ProvideRecipePrepTaskDataManager(dataManager)
-	recipePrepTaskDataService, err := recipepreptasks.ProvideService(logger, recipepreptasksConfig, recipePrepTaskDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipePrepTaskDataService, err := recipepreptasks.ProvideService(logger, recipepreptasksConfig, recipePrepTaskDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	mealplangrocerylistitemsConfig := &servicesConfig.MealPlanGroceryListItems
 	mealPlanGroceryListItemDataManager := database.ProvideMealPlanGroceryListItemDataManager(dataManager)
-	mealPlanGroceryListItemDataService, err := mealplangrocerylistitems.ProvideService(logger, mealplangrocerylistitemsConfig, mealPlanGroceryListItemDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	pantryItemDataManager := database.ProvidePantryItemDataManager(dataManager)
+	mealPlanGroceryListItemDataService, err := mealplangrocerylistitems.ProvideService(logger, mealplangrocerylistitemsConfig, mealPlanGroceryListItemDataManager, pantryItemDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validmeasurementunitconversionsConfig := &servicesConfig.ValidMeasurementUnitConversions
-	validMeasurementUnitConversionDataManager := database.ProvideValidMeasurementUnitConversionDataManager(dataManager)
-	validMeasurementUnitConversionDataService, err := validmeasurementunitconversions.ProvideService(ctx, logger, validmeasurementunitconversionsConfig, validMeasurementUnitConversionDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validMeasurementUnitConversionDataService, err := validmeasurementunitconversions.ProvideService(ctx, logger, validmeasurementunitconversionsConfig, validMeasurementUnitConversionDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	config16 := &servicesConfig.RecipeStepCompletionConditions
 	recipeStepCompletionConditionDataManager := database.ProvideRecipeStepCompletionConditionDataManager(dataManager)
-	recipeStepCompletionConditionDataService, err := recipestepingredients2.ProvideService(logger, config16, recipeStepCompletionConditionDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipeStepCompletionConditionDataService, err := recipestepingredients2.ProvideService(logger, config16, recipeStepCompletionConditionDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validingredientstateingredientsConfig := &servicesConfig.ValidIngredientStateIngredients
 	validIngredientStateIngredientDataManager := database.ProvideValidIngredientStateIngredientDataManager(dataManager)
-	validIngredientStateIngredientDataService, err := validingredientstateingredients.ProvideService(logger, validingredientstateingredientsConfig, validIngredientStateIngredientDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validIngredientStateIngredientDataService, err := validingredientstateingredients.ProvideService(logger, validingredientstateingredientsConfig, validIngredientStateIngredientDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	recipestepvesselsConfig := &servicesConfig.RecipeStepVessels
 	recipeStepVesselDataManager := database.ProvideRecipeStepVesselDataManager(dataManager)
-	recipeStepVesselDataService, err := recipestepvessels.ProvideService(logger, recipestepvesselsConfig, recipeStepVesselDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipeStepVesselDataService, err := recipestepvessels.ProvideService(logger, recipestepvesselsConfig, recipeStepVesselDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	webhooksConfig := &servicesConfig.Webhooks
 	webhookDataManager := database.ProvideWebhookDataManager(dataManager)
-	webhookDataService, err := webhooks.ProvideWebhooksService(logger, webhooksConfig, webhookDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	webhookDeliveryDataManager := database.ProvideWebhookDeliveryDataManager(dataManager)
+	webhookdeliveryConfig := &webhooksConfig.Delivery
+	deliverer := webhookdelivery.NewDeliverer(logger, tracerProvider, webhookdeliveryConfig, webhookDeliveryDataManager)
+	webhookDataService, err := webhooks.ProvideWebhooksService(logger, webhooksConfig, webhookDataManager, webhookDeliveryDataManager, householdDataManager, deliverer, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -318,70 +364,93 @@ func Build(ctx context.Context, cfg *config.InstanceConfig) (http.Server, error)
 	adminService := admin.ProvideService(logger, authenticationConfig, authenticator, adminUserDataManager, sessionManager, serverEncoderDecoder, routeParamManager, tracerProvider)
 	servicesettingsConfig := &servicesConfig.ServiceSettings
 	serviceSettingDataManager := database.ProvideServiceSettingDataManager(dataManager)
-	serviceSettingDataService, err := servicesettings.ProvideService(logger, servicesettingsConfig, serviceSettingDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	serviceSettingDataService, err := servicesettings.ProvideService(logger, servicesettingsConfig, serviceSettingDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	servicesettingconfigurationsConfig := &servicesConfig.ServiceSettingConfigurations
 	serviceSettingConfigurationDataManager := database.ProvideServiceSettingConfigurationDataManager(dataManager)
-	serviceSettingConfigurationDataService, err := servicesettingconfigurations.ProvideService(logger, servicesettingconfigurationsConfig, serviceSettingConfigurationDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	serviceSettingConfigurationDataService, err := servicesettingconfigurations.ProvideService(logger, servicesettingconfigurationsConfig, serviceSettingConfigurationDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	useringredientpreferencesConfig := &servicesConfig.UserIngredientPreferences
-	userIngredientPreferenceDataManager := database.ProvideUserIngredientPreferenceDataManager(dataManager)
-	userIngredientPreferenceDataService, err := useringredientpreferences.ProvideService(ctx, logger, useringredientpreferencesConfig, userIngredientPreferenceDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	userIngredientPreferenceDataService, err := useringredientpreferences.ProvideService(ctx, logger, useringredientpreferencesConfig, userIngredientPreferenceDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	reciperatingsConfig := &servicesConfig.RecipeRatings
-	recipeRatingDataManager := database.ProvideRecipeRatingDataManager(dataManager)
-	recipeRatingDataService, err := reciperatings.ProvideService(logger, reciperatingsConfig, recipeRatingDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	recipeRatingDataService, err := reciperatings.ProvideService(logger, reciperatingsConfig, recipeRatingDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	householdinstrumentownershipsConfig := &servicesConfig.HouseholdInstrumentOwnerships
-	householdInstrumentOwnershipDataManager := database.ProvideHouseholdInstrumentOwnershipDataManager(dataManager)
-	householdInstrumentOwnershipDataService, err := householdinstrumentownerships.ProvideService(logger, householdinstrumentownershipsConfig, householdInstrumentOwnershipDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	householdInstrumentOwnershipDataService, err := householdinstrumentownerships.ProvideService(logger, householdinstrumentownershipsConfig, householdInstrumentOwnershipDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	oAuth2ClientDataManager := database.ProvideOAuth2ClientDataManager(dataManager)
 	oauth2clientsConfig := oauth2clients.ProvideConfig(authenticationConfig)
-	oAuth2ClientDataService, err := oauth2clients.ProvideOAuth2ClientsService(logger, oAuth2ClientDataManager, userDataManager, authenticator, serverEncoderDecoder, routeParamManager, oauth2clientsConfig, tracerProvider, generator, publisherProvider)
+	oAuth2ClientDataService, err := oauth2clients.ProvideOAuth2ClientsService(logger, oAuth2ClientDataManager, userDataManager, authenticator, transactionRunner, serverEncoderDecoder, routeParamManager, oauth2clientsConfig, tracerProvider, generator, publisherProvider)
 	if err != nil {
 		return nil, err
 	}
 	validvesselsConfig := &servicesConfig.ValidVessels
 	validVesselDataManager := database.ProvideValidVesselDataManager(dataManager)
-	validVesselDataService, err := validvessels.ProvideService(ctx, logger, validvesselsConfig, config15, validVesselDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validVesselDataService, err := validvessels.ProvideService(ctx, logger, validvesselsConfig, config15, validVesselDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	validpreparationvesselsConfig := &servicesConfig.ValidPreparationVessels
 	validPreparationVesselDataManager := database.ProvideValidPreparationVesselDataManager(dataManager)
-	validPreparationVesselDataService, err := validpreparationvessels.ProvideService(logger, validpreparationvesselsConfig, validPreparationVesselDataManager, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	validPreparationVesselDataService, err := validpreparationvessels.ProvideService(logger, validpreparationvesselsConfig, validPreparationVesselDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
 	workersConfig := &servicesConfig.Workers
-	workerService, err := workers.ProvideService(ctx, logger, workersConfig, dataManager, serverEncoderDecoder, publisherProvider, tracerProvider, eventReporter, recipeAnalyzer)
+	workerService, err := workers.ProvideService(ctx, logger, workersConfig, dataManager, serverEncoderDecoder, publisherProvider, routeParamManager, tracerProvider, eventReporter, recipeAnalyzer)
 	if err != nil {
 		return nil, err
 	}
 	usernotificationsConfig := &servicesConfig.UserNotifications
 	userNotificationDataManager := database.ProvideUserNotificationDataManager(dataManager)
-	userNotificationDataService, err := usernotifications.ProvideService(ctx, logger, usernotificationsConfig, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, userNotificationDataManager)
+	userNotificationDataService, err := usernotifications.ProvideService(ctx, logger, usernotificationsConfig, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider, userNotificationDataManager)
 	if err != nil {
 		return nil, err
 	}
 	auditlogentriesConfig := &servicesConfig.AuditLogEntries
-	auditLogEntryDataManager := database.ProvideAuditLogEntryDataManager(dataManager)
 	auditLogEntryDataService, err := auditlogentries.ProvideService(ctx, logger, auditlogentriesConfig, auditLogEntryDataManager, serverEncoderDecoder, routeParamManager, tracerProvider)
 	if err != nil {
 		return nil, err
 	}
-	server, err := http.ProvideHTTPServer(ctx, httpConfig, dataManager, logger, serverEncoderDecoder, router, tracerProvider, authService, userDataService, householdDataService, householdInvitationDataService, validInstrumentDataService, validIngredientDataService, validIngredientGroupDataService, validPreparationDataService, validIngredientPreparationDataService, mealDataService, recipeDataService, recipeStepDataService, recipeStepProductDataService, recipeStepInstrumentDataService, recipeStepIngredientDataService, mealPlanDataService, mealPlanOptionDataService, mealPlanOptionVoteDataService, validMeasurementUnitDataService, validIngredientStateDataService, validPreparationInstrumentDataService, validIngredientMeasurementUnitDataService, mealPlanEventDataService, mealPlanTaskDataService, recipePrepTaskDataService, mealPlanGroceryListItemDataService, validMeasurementUnitConversionDataService, recipeStepCompletionConditionDataService, validIngredientStateIngredientDataService, recipeStepVesselDataService, webhookDataService, adminService, serviceSettingDataService, serviceSettingConfigurationDataService, userIngredientPreferenceDataService, recipeRatingDataService, householdInstrumentOwnershipDataService, oAuth2ClientDataService, validVesselDataService, validPreparationVesselDataService, workerService, userNotificationDataService, auditLogEntryDataService)
+	cookingsessionsConfig := &servicesConfig.CookingSessions
+	cookingSessionDataManager := database.ProvideCookingSessionDataManager(dataManager)
+	sessionEngine := cookingengine.NewSessionEngine(logger, tracerProvider, recipeAnalyzer)
+	cookingSessionDataService, err := cookingsessions.ProvideService(logger, cookingsessionsConfig, cookingSessionDataManager, recipeDataManager, sessionEngine, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	pantryitemsConfig := &servicesConfig.PantryItems
+	pantryItemDataService, err := pantryitems.ProvideService(logger, pantryitemsConfig, pantryItemDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	validingredientsubstitutionsConfig := &servicesConfig.ValidIngredientSubstitutions
+	validIngredientSubstitutionDataService, err := validingredientsubstitutions.ProvideService(logger, validingredientsubstitutionsConfig, validIngredientSubstitutionDataManager, transactionRunner, serverEncoderDecoder, routeParamManager, publisherProvider, tracerProvider)
+	if err != nil {
+		return nil, err
+	}
+	capitalismConfig := &servicesConfig.Capitalism
+	config17 := &cfg.Capitalism
+	paymentManager, err := config5.ProvideCapitalismImplementation(logger, tracerProvider, config17)
+	if err != nil {
+		return nil, err
+	}
+	capitalismService, err := capitalism.ProvideService(ctx, logger, capitalismConfig, transactionRunner, serverEncoderDecoder, publisherProvider, tracerProvider, paymentManager, householdDataManager, routeParamManager)
+	if err != nil {
+		return nil, err
+	}
+	server, err := http.ProvideHTTPServer(ctx, httpConfig, dataManager, logger, serverEncoderDecoder, router, tracerProvider, authService, userDataService, householdDataService, householdInvitationDataService, validInstrumentDataService, validIngredientDataService, validIngredientGroupDataService, validPreparationDataService, validIngredientPreparationDataService, mealDataService, recipeDataService, recipeStepDataService, recipeStepProductDataService, recipeStepInstrumentDataService, recipeStepIngredientDataService, mealPlanDataService, mealPlanOptionDataService, mealPlanOptionVoteDataService, validMeasurementUnitDataService, validIngredientStateDataService, validPreparationInstrumentDataService, validIngredientMeasurementUnitDataService, mealPlanEventDataService, mealPlanTaskDataService, recipePrepTaskDataService, mealPlanGroceryListItemDataService, validMeasurementUnitConversionDataService, recipeStepCompletionConditionDataService, validIngredientStateIngredientDataService, recipeStepVesselDataService, webhookDataService, adminService, serviceSettingDataService, serviceSettingConfigurationDataService, userIngredientPreferenceDataService, recipeRatingDataService, householdInstrumentOwnershipDataService, oAuth2ClientDataService, validVesselDataService, validPreparationVesselDataService, workerService, userNotificationDataService, auditLogEntryDataService, cookingSessionDataService, pantryItemDataService, validIngredientSubstitutionDataService, capitalismService)
 	if err != nil {
 		return nil, err
 	}
@@ -10,6 +10,7 @@
 	"github.com/dinnerdonebetter/backend/internal/routing"
 	auditlogentriesservice "github.com/dinnerdonebetter/backend/internal/services/auditlogentries"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	cookingsessionsservice "github.com/dinnerdonebetter/backend/internal/services/cookingsessions"
 	householdinstrumentownershipsservice "github.com/dinnerdonebetter/backend/internal/services/householdinstrumentownerships"
 	householdinvitationsservice "github.com/dinnerdonebetter/backend/internal/services/householdinvitations"
 	householdsservice "github.com/dinnerdonebetter/backend/internal/services/households"
@@ -21,6 +22,7 @@
 	mealplantasksservice "github.com/dinnerdonebetter/backend/internal/services/mealplantasks"
 	mealsservice "github.com/dinnerdonebetter/backend/internal/services/meals"
 	oauth2clientsservice "github.com/dinnerdonebetter/backend/internal/services/oauth2clients"
+	pantryitemsservice "github.com/dinnerdonebetter/backend/internal/services/pantryitems"
 	recipepreptasksservice "github.com/dinnerdonebetter/backend/internal/services/recipepreptasks"
 	reciperatingsservice "github.com/dinnerdonebetter/backend/internal/services/reciperatings"
 	recipesservice "github.com/dinnerdonebetter/backend/internal/services/recipes"
@@ -41,6 +43,7 @@
 	validingredientsservice "github.com/dinnerdonebetter/backend/internal/services/validingredients"
 	validingredientstateingredientsservice "github.com/dinnerdonebetter/backend/internal/services/validingredientstateingredients"
 	validingredientstatesservice "github.com/dinnerdonebetter/backend/internal/services/validingredientstates"
+	validingredientsubstitutionsservice "github.com/dinnerdonebetter/backend/internal/services/validingredientsubstitutions"
 	validinstrumentsservice "github.com/dinnerdonebetter/backend/internal/services/validinstruments"
 	validmeasurementconversionsservice "github.com/dinnerdonebetter/backend/internal/services/validmeasurementunitconversions"
 	validmeasurementunitsservice "github.com/dinnerdonebetter/backend/internal/services/validmeasurementunits"
@@ -49,6 +52,7 @@
 	validpreparationvesselsservice "github.com/dinnerdonebetter/backend/internal/services/validpreparationvessels"
 	validvesselsservice "github.com/dinnerdonebetter/backend/internal/services/validvessels"
 	webhooksservice "github.com/dinnerdonebetter/backend/internal/services/webhooks"
+	workersservice "github.com/dinnerdonebetter/backend/internal/services/workers"
 )
 
 const (
@@ -82,13 +86,19 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 		userRouter.
 			WithMiddleware(s.authService.CookieRequirementMiddleware, s.authService.UserAttributionMiddleware).
 			Get("/authorize", s.authService.AuthorizeHandler)
+		userRouter.
+			WithMiddleware(s.authService.CookieRequirementMiddleware, s.authService.UserAttributionMiddleware).
+			Get("/consent", s.authService.ConsentHandler)
 		userRouter.Post("/token", s.authService.TokenHandler)
+		userRouter.Post("/revoke", s.authService.RevokeHandler)
+		userRouter.Post("/introspect", s.authService.IntrospectHandler)
 	})
 
 	router.Route("/users", func(userRouter routing.Router) {
 		userRouter.Post(root, s.usersService.CreateHandler)
 		userRouter.Post("/login", s.authService.BuildLoginHandler(false))
 		userRouter.Post("/login/admin", s.authService.BuildLoginHandler(true))
+		userRouter.Post("/passkeys/login/begin", s.authService.BeginPasskeyLoginHandler)
 		userRouter.WithMiddleware(s.authService.UserAttributionMiddleware, s.authService.CookieRequirementMiddleware).
 			Post("/logout", s.authService.EndSessionHandler)
 		userRouter.Post("/username/reminder", s.usersService.RequestUsernameReminderHandler)
@@ -104,6 +114,9 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 		authRouter.Get(path.Join(providerRouteParam, "callback"), s.authService.SSOLoginCallbackHandler)
 	})
 
+	// payment processor webhooks authenticate themselves with a signature header.
+	router.Post("/webhooks/stripe", s.capitalismService.IncomingWebhookHandler)
+
 	authenticatedRouter.WithMiddleware(s.authService.AuthorizationMiddleware).Route("/api/v1", func(v1Router routing.Router) {
 		adminRouter := v1Router.WithMiddleware(s.authService.ServiceAdminMiddleware)
 
@@ -115,6 +128,28 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 			adminRouter.
 				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateUserStatusPermission)).
 				Post("/users/status", s.adminService.UserAccountStatusChangeHandler)
+			adminRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ClearLoginLockoutsPermission)).
+				Post("/users/lockouts/clear", s.authService.ClearLoginLockoutsHandler)
+
+			adminRouter.Route("/workers", func(adminWorkersRouter routing.Router) {
+				deadLetteredMessagesRouteWithPrefix := "/dead_letters"
+				deadLetteredMessageIDRouteParam := buildURLVarChunk(workersservice.DeadLetteredMessageIDURIParamKey, "")
+				adminWorkersRouter.Route(deadLetteredMessagesRouteWithPrefix, func(deadLetteredMessagesRouter routing.Router) {
+					deadLetteredMessagesRouter.
+						WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadDeadLetteredMessagesPermission)).
+						Get(root, s.workerService.ListDeadLetteredMessagesHandler)
+
+					deadLetteredMessagesRouter.Route(deadLetteredMessageIDRouteParam, func(singleDeadLetteredMessageRouter routing.Router) {
+						singleDeadLetteredMessageRouter.
+							WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadDeadLetteredMessagesPermission)).
+							Get(root, s.workerService.ReadDeadLetteredMessageHandler)
+						singleDeadLetteredMessageRouter.
+							WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReplayDeadLetteredMessagesPermission)).
+							Post("/replay", s.workerService.ReplayDeadLetteredMessageHandler)
+					})
+				})
+			})
 		})
 
 		// Workers
@@ -135,17 +170,28 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 			usersRouter.
 				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.SearchUserPermission)).
 				Get(searchRoot, s.usersService.UsernameSearchHandler)
-			usersRouter.Post("/avatar/upload", s.usersService.AvatarUploadHandler)
-
-			usersRouter.Get("/self", s.usersService.SelfHandler)
-			usersRouter.Post("/email_address_verification", s.usersService.RequestEmailVerificationEmailHandler)
+			// the permissions check already accounts for token scopes, so any token may ask what it's allowed to do.
 			usersRouter.Post("/permissions/check", s.usersService.PermissionsHandler)
-			usersRouter.Post("/household/select", s.authService.ChangeActiveHouseholdHandler)
-			usersRouter.Put("/password/new", s.usersService.UpdatePasswordHandler)
-			usersRouter.Post("/totp_secret/new", s.usersService.NewTOTPSecretHandler)
-			usersRouter.Put("/username", s.usersService.UpdateUserUsernameHandler)
-			usersRouter.Put("/email_address", s.usersService.UpdateUserEmailAddressHandler)
-			usersRouter.Put("/details", s.usersService.UpdateUserDetailsHandler)
+
+			// no token scope covers managing the account itself.
+			accountRouter := usersRouter.WithMiddleware(s.authService.UnscopedRouteMiddleware)
+			accountRouter.Post("/avatar/upload", s.usersService.AvatarUploadHandler)
+			accountRouter.Get("/self", s.usersService.SelfHandler)
+			accountRouter.Post("/email_address_verification", s.usersService.RequestEmailVerificationEmailHandler)
+			accountRouter.Post("/household/select", s.authService.ChangeActiveHouseholdHandler)
+			accountRouter.Put("/password/new", s.usersService.UpdatePasswordHandler)
+			accountRouter.Post("/totp_secret/new", s.usersService.NewTOTPSecretHandler)
+			accountRouter.Post("/recovery_codes/new", s.usersService.NewRecoveryCodesHandler)
+			accountRouter.Put("/username", s.usersService.UpdateUserUsernameHandler)
+			accountRouter.Put("/email_address", s.usersService.UpdateUserEmailAddressHandler)
+			accountRouter.Put("/details", s.usersService.UpdateUserDetailsHandler)
+
+			accountRouter.Route("/passkeys", func(passkeysRouter routing.Router) {
+				passkeysRouter.Get(root, s.authService.ListPasskeysHandler)
+				passkeysRouter.Post("/registration/begin", s.authService.BeginPasskeyRegistrationHandler)
+				passkeysRouter.Post("/registration/finish", s.authService.FinishPasskeyRegistrationHandler)
+				passkeysRouter.Delete(buildURLVarChunk(authservice.PasskeyIDURIParamKey, ""), s.authService.ArchivePasskeyHandler)
+			})
 
 			singleUserRoute := buildURLVarChunk(usersservice.UserIDURIParamKey, "")
 			usersRouter.Route(singleUserRoute, func(singleUserRouter routing.Router) {
@@ -153,43 +199,98 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadUserPermission)).
 					Get(root, s.usersService.ReadHandler)
 
-				singleUserRouter.Delete(root, s.usersService.ArchiveHandler)
+				singleUserRouter.
+					WithMiddleware(s.authService.UnscopedRouteMiddleware).
+					Delete(root, s.usersService.ArchiveHandler)
 			})
 		})
 
 		// Households
 		v1Router.Route("/households", func(householdsRouter routing.Router) {
-			householdsRouter.Post(root, s.householdsService.CreateHandler)
-			householdsRouter.Get(root, s.householdsService.ListHandler)
-			householdsRouter.Get("/current", s.householdsService.CurrentInfoHandler)
+			// no token scope covers creating, listing, or choosing between households.
+			householdsRouter.
+				WithMiddleware(s.authService.UnscopedRouteMiddleware).
+				Post(root, s.householdsService.CreateHandler)
+			householdsRouter.
+				WithMiddleware(s.authService.UnscopedRouteMiddleware).
+				Get(root, s.householdsService.ListHandler)
+			householdsRouter.
+				WithMiddleware(s.authService.UnscopedRouteMiddleware).
+				Get("/current", s.householdsService.CurrentInfoHandler)
 
 			singleUserRoute := buildURLVarChunk(householdsservice.UserIDURIParamKey, "")
 			singleHouseholdRoute := buildURLVarChunk(householdsservice.HouseholdIDURIParamKey, "")
 			householdsRouter.Route(singleHouseholdRoute, func(singleHouseholdRouter routing.Router) {
-				singleHouseholdRouter.Get(root, s.householdsService.ReadHandler)
-				singleHouseholdRouter.Put(root, s.householdsService.UpdateHandler)
 				singleHouseholdRouter.
-					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveHouseholdPermission)).
+					WithMiddleware(s.authService.UnscopedRouteMiddleware).
+					Get(root, s.householdsService.ReadHandler)
+				singleHouseholdRouter.
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.UpdateHouseholdPermission)).
+					Put(root, s.householdsService.UpdateHandler)
+				singleHouseholdRouter.
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.ArchiveHouseholdPermission)).
 					Delete(root, s.householdsService.ArchiveHandler)
 
-				singleHouseholdRouter.Post("/default", s.householdsService.MarkAsDefaultHouseholdHandler)
 				singleHouseholdRouter.
-					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.RemoveMemberHouseholdPermission)).
+					WithMiddleware(s.authService.UnscopedRouteMiddleware).
+					Post("/default", s.householdsService.MarkAsDefaultHouseholdHandler)
+				singleHouseholdRouter.
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.RemoveMemberHouseholdPermission)).
 					Delete("/members"+singleUserRoute, s.householdsService.RemoveMemberHandler)
 				singleHouseholdRouter.
-					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.InviteUserToHouseholdPermission)).
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.InviteUserToHouseholdPermission)).
 					Post("/invite", s.householdInvitationsService.InviteMemberHandler)
 				singleHouseholdRouter.
-					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ModifyMemberPermissionsForHouseholdPermission)).
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.ModifyMemberPermissionsForHouseholdPermission)).
 					Patch("/members"+singleUserRoute+"/permissions", s.householdsService.ModifyMemberPermissionsHandler)
-				singleHouseholdRouter.Post("/transfer", s.householdsService.TransferHouseholdOwnershipHandler)
+				singleHouseholdRouter.
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.TransferHouseholdPermission)).
+					Post("/transfer", s.householdsService.TransferHouseholdOwnershipHandler)
+				singleHouseholdRouter.
+					WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.UpdateHouseholdPermission)).
+					Post("/webhook_encryption_key/rotate", s.householdsService.RotateWebhookEncryptionKeyHandler)
+				singleHouseholdRouter.
+					WithMiddleware(
+						s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.ReadRecipesPermission),
+						s.capitalismService.HouseholdSubscriptionRequirementMiddleware(householdsservice.HouseholdIDURIParamKey),
+					).
+					Get("/recommendations", s.householdsService.RecommendationsHandler)
+				// the event stream filters what it sends by the requester's permissions and token scopes.
+				singleHouseholdRouter.Get("/events/stream", s.householdsService.StreamEventsHandler)
 
 				singleHouseholdRouter.Route("/invitations", func(invitationsRouter routing.Router) {
-					invitationsRouter.Post(root, s.householdInvitationsService.InviteMemberHandler)
+					invitationsRouter.
+						WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.InviteUserToHouseholdPermission)).
+						Post(root, s.householdInvitationsService.InviteMemberHandler)
 
 					singleHouseholdInvitationRoute := buildURLVarChunk(householdinvitationsservice.HouseholdInvitationIDURIParamKey, "")
 					invitationsRouter.Route(singleHouseholdInvitationRoute, func(singleHouseholdInvitationRouter routing.Router) {
-						singleHouseholdInvitationRouter.Get(root, s.householdInvitationsService.ReadHandler)
+						singleHouseholdInvitationRouter.
+							WithMiddleware(s.authService.UnscopedRouteMiddleware).
+							Get(root, s.householdInvitationsService.ReadHandler)
+					})
+				})
+
+				// PantryItems
+				singleHouseholdRouter.Route("/pantry", func(pantryItemsRouter routing.Router) {
+					pantryItemsRouter.
+						WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.CreatePantryItemsPermission)).
+						Post(root, s.pantryItemsService.CreateHandler)
+					pantryItemsRouter.
+						WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.ReadPantryItemsPermission)).
+						Get(root, s.pantryItemsService.ListHandler)
+
+					singlePantryItemRoute := buildURLVarChunk(pantryitemsservice.PantryItemIDURIParamKey, "")
+					pantryItemsRouter.Route(singlePantryItemRoute, func(singlePantryItemRouter routing.Router) {
+						singlePantryItemRouter.
+							WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.ReadPantryItemsPermission)).
+							Get(root, s.pantryItemsService.ReadHandler)
+						singlePantryItemRouter.
+							WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.UpdatePantryItemsPermission)).
+							Put(root, s.pantryItemsService.UpdateHandler)
+						singlePantryItemRouter.
+							WithMiddleware(s.authService.HouseholdPermissionFilterMiddleware(householdsservice.HouseholdIDURIParamKey, authorization.ArchivePantryItemsPermission)).
+							Delete(root, s.pantryItemsService.ArchiveHandler)
 					})
 				})
 			})
@@ -219,7 +320,8 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 			})
 		})
 
-		v1Router.Route("/household_invitations", func(householdInvitationsRouter routing.Router) {
+		// no token scope covers joining or leaving households.
+		v1Router.WithMiddleware(s.authService.UnscopedRouteMiddleware).Route("/household_invitations", func(householdInvitationsRouter routing.Router) {
 			householdInvitationsRouter.Get("/sent", s.householdInvitationsService.OutboundInvitesHandler)
 			householdInvitationsRouter.Get("/received", s.householdInvitationsService.InboundInvitesHandler)
 
@@ -252,6 +354,16 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 			})
 		})
 
+		// Billing
+		v1Router.Route("/billing", func(billingRouter routing.Router) {
+			billingRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateHouseholdPermission)).
+				Post("/checkout", s.capitalismService.CreateCheckoutSessionHandler)
+			billingRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateHouseholdPermission)).
+				Post("/portal", s.capitalismService.CreateCustomerPortalSessionHandler)
+		})
+
 		// Webhooks
 		v1Router.Route("/webhooks", func(webhookRouter routing.Router) {
 			webhookRouter.
@@ -270,6 +382,21 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveWebhooksPermission)).
 					Delete(root, s.webhooksService.ArchiveWebhookHandler)
 
+				singleWebhookRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadWebhooksPermission)).
+					Get("/deliveries", s.webhooksService.ListWebhookDeliveriesHandler)
+
+				singleWebhookDeliveryRoute := buildURLVarChunk(webhooksservice.WebhookDeliveryIDURIParamKey, "")
+				singleWebhookRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.CreateWebhooksPermission)).
+					Post("/deliveries"+singleWebhookDeliveryRoute+"/redeliver", s.webhooksService.RedeliverWebhookDeliveryHandler)
+				singleWebhookRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.CreateWebhooksPermission)).
+					Post("/ping", s.webhooksService.PingWebhookHandler)
+				singleWebhookRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadWebhooksPermission)).
+					Post("/preview", s.webhooksService.PreviewWebhookHandler)
+
 				singleWebhookTriggerEventRoute := buildURLVarChunk(webhooksservice.WebhookTriggerEventIDURIParamKey, "")
 				singleWebhookRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.CreateWebhookTriggerEventsPermission)).
@@ -394,6 +521,18 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 				singleValidIngredientRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveValidIngredientsPermission)).
 					Delete(root, s.validIngredientsService.ArchiveHandler)
+
+				singleValidIngredientRouter.Route("/nutrition", func(nutritionRouter routing.Router) {
+					nutritionRouter.
+						WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadValidIngredientsPermission)).
+						Get(root, s.validIngredientsService.ReadNutritionHandler)
+					nutritionRouter.
+						WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateValidIngredientsPermission)).
+						Put(root, s.validIngredientsService.UpdateNutritionHandler)
+					nutritionRouter.
+						WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveValidIngredientsPermission)).
+						Delete(root, s.validIngredientsService.ArchiveNutritionHandler)
+				})
 			})
 		})
 
@@ -647,6 +786,38 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 			})
 		})
 
+		// ValidIngredientSubstitutions
+		validIngredientSubstitutionPath := "valid_ingredient_substitutions"
+		validIngredientSubstitutionsRouteWithPrefix := fmt.Sprintf("/%s", validIngredientSubstitutionPath)
+		validIngredientSubstitutionIDRouteParam := buildURLVarChunk(validingredientsubstitutionsservice.ValidIngredientSubstitutionIDURIParamKey, "")
+		v1Router.Route(validIngredientSubstitutionsRouteWithPrefix, func(validIngredientSubstitutionsRouter routing.Router) {
+			validIngredientSubstitutionsRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.CreateValidIngredientSubstitutionsPermission)).
+				Post(root, s.validIngredientSubstitutionsService.CreateHandler)
+			validIngredientSubstitutionsRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadValidIngredientSubstitutionsPermission)).
+				Get(root, s.validIngredientSubstitutionsService.ListHandler)
+
+			validIngredientSubstitutionsByIngredientIDRouteParam := fmt.Sprintf("/by_ingredient%s", buildURLVarChunk(validingredientsubstitutionsservice.ValidIngredientIDURIParamKey, ""))
+			validIngredientSubstitutionsRouter.Route(validIngredientSubstitutionsByIngredientIDRouteParam, func(byValidIngredientIDRouter routing.Router) {
+				byValidIngredientIDRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.SearchValidIngredientSubstitutionsPermission)).
+					Get(root, s.validIngredientSubstitutionsService.SearchByIngredientHandler)
+			})
+
+			validIngredientSubstitutionsRouter.Route(validIngredientSubstitutionIDRouteParam, func(singleValidIngredientSubstitutionRouter routing.Router) {
+				singleValidIngredientSubstitutionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadValidIngredientSubstitutionsPermission)).
+					Get(root, s.validIngredientSubstitutionsService.ReadHandler)
+				singleValidIngredientSubstitutionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateValidIngredientSubstitutionsPermission)).
+					Put(root, s.validIngredientSubstitutionsService.UpdateHandler)
+				singleValidIngredientSubstitutionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveValidIngredientSubstitutionsPermission)).
+					Delete(root, s.validIngredientSubstitutionsService.ArchiveHandler)
+			})
+		})
+
 		// ValidPreparationInstruments
 		validPreparationInstrumentPath := "valid_preparation_instruments"
 		validPreparationInstrumentsRouteWithPrefix := fmt.Sprintf("/%s", validPreparationInstrumentPath)
@@ -803,6 +974,12 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 			recipesRouter.
 				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadRecipesPermission)).
 				Get(searchRoot, s.recipesService.SearchHandler)
+			recipesRouter.
+				WithMiddleware(
+					s.authService.PermissionFilterMiddleware(authorization.CreateRecipesPermission),
+					s.capitalismService.ActiveSubscriptionRequirementMiddleware,
+				).
+				Post("/import", s.recipesService.ImportHandler)
 
 			recipesRouter.Route(recipeIDRouteParam, func(singleRecipeRouter routing.Router) {
 				singleRecipeRouter.
@@ -817,6 +994,12 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 				singleRecipeRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadRecipesPermission)).
 					Get("/prep_steps", s.recipesService.EstimatedPrepStepsHandler)
+				singleRecipeRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadRecipesPermission)).
+					Get("/scaled", s.recipesService.ScaledHandler)
+				singleRecipeRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadRecipesPermission)).
+					Get("/equipment", s.recipesService.EquipmentReportHandler)
 				singleRecipeRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateRecipesPermission)).
 					Put(root, s.recipesService.UpdateHandler)
@@ -1009,6 +1192,9 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 				singleRecipeStepIngredientRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateRecipeStepIngredientsPermission)).
 					Put(root, s.recipeStepIngredientsService.UpdateHandler)
+				singleRecipeStepIngredientRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateRecipeStepIngredientsPermission)).
+					Post("/substitute", s.recipeStepIngredientsService.SubstituteHandler)
 				singleRecipeStepIngredientRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveRecipeStepIngredientsPermission)).
 					Delete(root, s.recipeStepIngredientsService.ArchiveHandler)
@@ -1159,6 +1345,12 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 				singleMealPlanEventRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateMealPlanEventsPermission)).
 					Put(root, s.mealPlanEventsService.UpdateHandler)
+				singleMealPlanEventRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadMealPlanOptionVotesPermission)).
+					Get("/results", s.mealPlanEventsService.ResultsHandler)
+				singleMealPlanEventRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadMealPlanEventsPermission)).
+					Get("/timeline", s.mealPlanEventsService.TimelineHandler)
 				singleMealPlanEventRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.CreateMealPlanOptionVotesPermission)).
 					Post("/vote", s.mealPlanOptionVotesService.CreateHandler)
@@ -1227,6 +1419,9 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 				singleMealPlanOptionRouter.
 					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveMealPlanOptionsPermission)).
 					Delete(root, s.mealPlanOptionsService.ArchiveHandler)
+				singleMealPlanOptionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadMealPlanOptionsPermission)).
+					Get("/dietary_conflicts", s.mealPlanOptionsService.DietaryConflictsHandler)
 			})
 		})
 
@@ -1329,6 +1524,31 @@ func (s *server) setupRouter(ctx context.Context, router routing.Router) {
 					Patch(root, s.userNotificationsService.UpdateHandler)
 			})
 		})
+
+		// Cooking Sessions
+		cookingSessionPath := "cooking_sessions"
+		cookingSessionsRouteWithPrefix := fmt.Sprintf("/%s", cookingSessionPath)
+		cookingSessionIDRouteParam := buildURLVarChunk(cookingsessionsservice.CookingSessionIDURIParamKey, "")
+		v1Router.Route(cookingSessionsRouteWithPrefix, func(cookingSessionsRouter routing.Router) {
+			cookingSessionsRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.CreateCookingSessionsPermission)).
+				Post(root, s.cookingSessionsService.CreateHandler)
+			cookingSessionsRouter.
+				WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadCookingSessionsPermission)).
+				Get(root, s.cookingSessionsService.ListHandler)
+
+			cookingSessionsRouter.Route(cookingSessionIDRouteParam, func(singleCookingSessionRouter routing.Router) {
+				singleCookingSessionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ReadCookingSessionsPermission)).
+					Get(root, s.cookingSessionsService.ReadHandler)
+				singleCookingSessionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.UpdateCookingSessionsPermission)).
+					Post("/progress", s.cookingSessionsService.ProgressHandler)
+				singleCookingSessionRouter.
+					WithMiddleware(s.authService.PermissionFilterMiddleware(authorization.ArchiveCookingSessionsPermission)).
+					Delete(root, s.cookingSessionsService.ArchiveHandler)
+			})
+		})
 	})
 
 	s.router = router
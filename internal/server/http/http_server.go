@@ -78,6 +78,10 @@
 		userNotificationsService               types.UserNotificationDataService
 		workerService                          types.WorkerService
 		auditLogEntriesService                 types.AuditLogEntryDataService
+		cookingSessionsService                 types.CookingSessionDataService
+		pantryItemsService                     types.PantryItemDataService
+		validIngredientSubstitutionsService    types.ValidIngredientSubstitutionDataService
+		capitalismService                      types.CapitalismService
 		encoder                                encoding.ServerEncoderDecoder
 		logger                                 logging.Logger
 		router                                 routing.Router
@@ -142,6 +146,10 @@ func ProvideHTTPServer(
 	workerService types.WorkerService,
 	userNotificationsService types.UserNotificationDataService,
 	auditLogService types.AuditLogEntryDataService,
+	cookingSessionsService types.CookingSessionDataService,
+	pantryItemsService types.PantryItemDataService,
+	validIngredientSubstitutionsService types.ValidIngredientSubstitutionDataService,
+	capitalismService types.CapitalismService,
 ) (Server, error) {
 	srv := &server{
 		config: serverSettings,
@@ -159,6 +167,10 @@ func ProvideHTTPServer(
 		adminService:                           adminService,
 		auditLogEntriesService:                 auditLogService,
 		authService:                            authService,
+		cookingSessionsService:                 cookingSessionsService,
+		pantryItemsService:                     pantryItemsService,
+		validIngredientSubstitutionsService:    validIngredientSubstitutionsService,
+		capitalismService:                      capitalismService,
 		householdsService:                      householdsService,
 		householdInvitationsService:            householdInvitationsService,
 		serviceSettingsService:                 serviceSettingDataService,
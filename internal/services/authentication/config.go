@@ -4,6 +4,8 @@
 	"context"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -41,18 +43,30 @@
 		Google GoogleSSOConfig `json:"google,omitempty" toml:"google,omitempty"`
 	}
 
+	// PasskeyConfig configures the WebAuthn relying party used for passkey ceremonies.
+	PasskeyConfig struct {
+		_ struct{} `json:"-"`
+
+		RelyingPartyID          string        `json:"relyingPartyID,omitempty"          toml:"relying_party_id,omitempty"`
+		RelyingPartyDisplayName string        `json:"relyingPartyDisplayName,omitempty" toml:"relying_party_display_name,omitempty"`
+		RelyingPartyOrigins     []string      `json:"relyingPartyOrigins,omitempty"     toml:"relying_party_origins,omitempty"`
+		CeremonyTimeout         time.Duration `json:"ceremonyTimeout,omitempty"         toml:"ceremony_timeout,omitempty"`
+	}
+
 	// Config represents our passwords configuration.
 	Config struct {
 		_ struct{} `json:"-"`
 
-		SSO                   SSOConfigs   `json:"sso,omitempty"                   toml:"sso,omitempty"`
-		DataChangesTopicName  string       `json:"dataChanges,omitempty"           toml:"data_changes,omitempty"`
-		Cookies               CookieConfig `json:"cookies,omitempty"               toml:"cookies,omitempty"`
-		OAuth2                OAuth2Config `json:"oauth2,omitempty"                toml:"oauth2,omitempty"`
-		Debug                 bool         `json:"debug,omitempty"                 toml:"debug,omitempty"`
-		EnableUserSignup      bool         `json:"enableUserSignup,omitempty"      toml:"enable_user_signup,omitempty"`
-		MinimumUsernameLength uint8        `json:"minimumUsernameLength,omitempty" toml:"minimum_username_length,omitempty"`
-		MinimumPasswordLength uint8        `json:"minimumPasswordLength,omitempty" toml:"minimum_password_length,omitempty"`
+		SSO                   SSOConfigs           `json:"sso,omitempty"                   toml:"sso,omitempty"`
+		DataChangesTopicName  string               `json:"dataChanges,omitempty"           toml:"data_changes,omitempty"`
+		Passkeys              PasskeyConfig        `json:"passkeys,omitempty"              toml:"passkeys,omitempty"`
+		LoginAttempts         loginattempts.Config `json:"loginAttempts,omitempty" toml:"login_attempts,omitempty"`
+		Cookies               CookieConfig         `json:"cookies,omitempty"               toml:"cookies,omitempty"`
+		OAuth2                OAuth2Config         `json:"oauth2,omitempty"                toml:"oauth2,omitempty"`
+		Debug                 bool                 `json:"debug,omitempty"                 toml:"debug,omitempty"`
+		EnableUserSignup      bool                 `json:"enableUserSignup,omitempty"      toml:"enable_user_signup,omitempty"`
+		MinimumUsernameLength uint8                `json:"minimumUsernameLength,omitempty" toml:"minimum_username_length,omitempty"`
+		MinimumPasswordLength uint8                `json:"minimumPasswordLength,omitempty" toml:"minimum_password_length,omitempty"`
 	}
 )
 
@@ -75,6 +89,18 @@ func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 		validation.Field(&cfg.Cookies, validation.Required),
 		validation.Field(&cfg.MinimumUsernameLength, validation.Required),
 		validation.Field(&cfg.MinimumPasswordLength, validation.Required),
+		validation.Field(&cfg.Passkeys),
+		validation.Field(&cfg.LoginAttempts),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PasskeyConfig)(nil)
+
+// ValidateWithContext validates a PasskeyConfig struct. Passkeys are disabled when no relying party ID is set.
+func (cfg PasskeyConfig) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, &cfg,
+		validation.Field(&cfg.RelyingPartyDisplayName, validation.When(cfg.RelyingPartyID != "", validation.Required)),
+		validation.Field(&cfg.RelyingPartyOrigins, validation.When(cfg.RelyingPartyID != "", validation.Required)),
 	)
 }
 
@@ -5,6 +5,9 @@
 	"testing"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	cacheconfig "github.com/dinnerdonebetter/backend/internal/cache/config"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -30,6 +33,27 @@ func TestConfig_Validate(T *testing.T) {
 
 		assert.NoError(t, cfg.ValidateWithContext(ctx))
 	})
+
+	T.Run("with invalid login attempt config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		cfg := &Config{
+			Cookies: CookieConfig{
+				Name:     "name",
+				Domain:   "domain",
+				Lifetime: time.Second,
+			},
+			LoginAttempts: loginattempts.Config{
+				Cache: cacheconfig.Config{Provider: t.Name()},
+			},
+			MinimumUsernameLength: 123,
+			MinimumPasswordLength: 123,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
 }
 
 func TestCookieConfig_Validate(T *testing.T) {
@@ -2,14 +2,18 @@
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"net/http"
 	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/go-oauth2/oauth2/v4"
 )
 
 var (
@@ -25,6 +29,40 @@ func (s *service) overrideSessionContextDataValuesWithSessionData(ctx context.Co
 	}
 }
 
+// restrictSessionContextDataToOAuth2Token confines a token's session to the scopes it was granted, and to the
+// household its client belongs to, if any. Tokens from household clients never carry service-wide permissions.
+func (s *service) restrictSessionContextDataToOAuth2Token(ctx context.Context, sessionCtxData *types.SessionContextData, token oauth2.TokenInfo) error {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionCtxData.TokenScopes = authorization.NewOAuth2ScopePermissionChecker(authorization.ParseOAuth2Scopes(token.GetScope())...)
+
+	client, err := s.oauth2ClientDataManager.GetOAuth2ClientByClientID(ctx, token.GetClientID())
+	if err != nil {
+		return observability.PrepareError(err, span, "fetching oauth2 client")
+	}
+
+	if client.BelongsToHousehold == nil {
+		return nil
+	}
+
+	householdID := *client.BelongsToHousehold
+	sessionCtxData.ActiveHouseholdID = householdID
+
+	// if the user has since left the household, the empty map ensures the request is rejected.
+	householdPermissions := map[string]authorization.HouseholdRolePermissionsChecker{}
+	if checker, ok := sessionCtxData.HouseholdPermissions[householdID]; ok {
+		householdPermissions[householdID] = checker
+	}
+	sessionCtxData.HouseholdPermissions = householdPermissions
+
+	// client credentials grants are attributed to the household's owner, so their tokens mustn't inherit the
+	// owner's service role. no grant gives a household client's tokens more than a regular user would have.
+	sessionCtxData.Requester.ServicePermissions = authorization.NewServiceRolePermissionChecker(authorization.ServiceUserRole.String())
+
+	return nil
+}
+
 // getUserIDFromCookie takes a request object and fetches the cookie data if it is present.
 func (s *service) getUserIDFromCookie(ctx context.Context, req *http.Request) (context.Context, string, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
@@ -89,6 +127,45 @@ func (s *service) validateLogin(ctx context.Context, user *types.User, loginInpu
 	return loginValid, nil
 }
 
+// validateCredentialsForUpdateRequest takes a user's credentials and determines if they match what is on record.
+func (s *service) validateCredentialsForUpdateRequest(ctx context.Context, userID, password, totpToken string) (user *types.User, httpStatus int) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithValue(keys.UserIDKey, userID)
+
+	user, err := s.userDataManager.GetUser(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, http.StatusNotFound
+		}
+
+		logger.Error(err, "error encountered fetching user")
+		return nil, http.StatusInternalServerError
+	}
+
+	if user.TwoFactorSecretVerifiedAt != nil && totpToken == "" {
+		return nil, http.StatusResetContent
+	}
+
+	tfs := user.TwoFactorSecret
+	if user.TwoFactorSecretVerifiedAt == nil {
+		tfs = ""
+		totpToken = ""
+	}
+
+	valid, err := s.authenticator.CredentialsAreValid(ctx, user.HashedPassword, password, tfs, totpToken)
+	if err != nil {
+		logger.WithValue("validation_error", err).Debug("error validating credentials")
+		return nil, http.StatusBadRequest
+	} else if !valid {
+		logger.WithValue("valid", valid).Error(err, "invalid credentials")
+		return nil, http.StatusUnauthorized
+	}
+
+	return user, http.StatusOK
+}
+
 func (s *service) issueSessionManagedCookie(ctx context.Context, householdID, requesterID, cookieDomain string) (cookie *http.Cookie, err error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -8,6 +8,10 @@
 
 	"github.com/dinnerdonebetter/backend/internal/authentication"
 	mockauthn "github.com/dinnerdonebetter/backend/internal/authentication/mock"
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
 	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
 	"github.com/gorilla/securecookie"
@@ -231,3 +235,86 @@ func TestAuthenticationService_buildCookie(T *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAuthenticationService_restrictSessionContextDataToOAuth2Token(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with household scoped client", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		otherHousehold := fakes.BuildFakeHousehold()
+		helper.sessionCtxData.HouseholdPermissions[otherHousehold.ID] = authorization.NewHouseholdRolePermissionChecker(authorization.HouseholdAdminRole.String())
+		helper.sessionCtxData.ActiveHouseholdID = otherHousehold.ID
+		helper.sessionCtxData.Requester.ServicePermissions = authorization.NewServiceRolePermissionChecker(authorization.ServiceAdminRole.String())
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleClient.BelongsToHousehold = &helper.exampleHousehold.ID
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		assert.NoError(t, helper.service.restrictSessionContextDataToOAuth2Token(helper.ctx, helper.sessionCtxData, buildTestOAuth2TokenInfo(exampleClient)))
+		assert.Equal(t, helper.exampleHousehold.ID, helper.sessionCtxData.ActiveHouseholdID)
+		assert.Len(t, helper.sessionCtxData.HouseholdPermissions, 1)
+		assert.Contains(t, helper.sessionCtxData.HouseholdPermissions, helper.exampleHousehold.ID)
+		assert.False(t, helper.sessionCtxData.Requester.ServicePermissions.IsServiceAdmin())
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+
+	T.Run("with client for household user no longer belongs to", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		otherHousehold := fakes.BuildFakeHousehold()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleClient.BelongsToHousehold = &otherHousehold.ID
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		assert.NoError(t, helper.service.restrictSessionContextDataToOAuth2Token(helper.ctx, helper.sessionCtxData, buildTestOAuth2TokenInfo(exampleClient)))
+		assert.Equal(t, otherHousehold.ID, helper.sessionCtxData.ActiveHouseholdID)
+		assert.Empty(t, helper.sessionCtxData.HouseholdPermissions)
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+
+	T.Run("with unscoped client", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		assert.NoError(t, helper.service.restrictSessionContextDataToOAuth2Token(helper.ctx, helper.sessionCtxData, buildTestOAuth2TokenInfo(exampleClient)))
+		assert.Equal(t, helper.exampleHousehold.ID, helper.sessionCtxData.ActiveHouseholdID)
+		assert.Equal(t, helper.examplePermCheckers, helper.sessionCtxData.HouseholdPermissions)
+		assert.True(t, helper.sessionCtxData.TokenScopesAllow(authorization.ReadRecipesPermission))
+		assert.False(t, helper.sessionCtxData.TokenScopesAllow(authorization.CreateRecipesPermission))
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+
+	T.Run("with error fetching client", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return((*types.OAuth2Client)(nil), errors.New("blah"))
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		assert.Error(t, helper.service.restrictSessionContextDataToOAuth2Token(helper.ctx, helper.sessionCtxData, buildTestOAuth2TokenInfo(exampleClient)))
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/url"
@@ -10,11 +11,15 @@
 	"sync"
 
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
 	"github.com/gorilla/securecookie"
 	"github.com/markbates/goth"
 	"github.com/markbates/goth/gothic"
@@ -83,6 +88,7 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 		loginData.TOTPToken = strings.TrimSpace(loginData.TOTPToken)
 		loginData.Password = strings.TrimSpace(loginData.Password)
 		loginData.Username = strings.TrimSpace(loginData.Username)
+		loginData.RecoveryCode = strings.TrimSpace(loginData.RecoveryCode)
 
 		if err := loginData.ValidateWithContext(ctx, s.config.MinimumUsernameLength, s.config.MinimumPasswordLength); err != nil {
 			observability.AcknowledgeError(err, logger, span, "validating input")
@@ -91,8 +97,19 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 			return
 		}
 
+		if len(loginData.PasskeyAssertion) > 0 {
+			s.passkeyLogin(ctx, adminOnly, loginData.PasskeyAssertion, req, res, responseDetails)
+			return
+		}
+
+		ipAddress := loginattempts.ClientIPAddress(req)
 		logger = logger.WithValue(keys.UsernameKey, loginData.Username)
 
+		if loginattempts.RespondIfLockedOut(ctx, logger, span, s.loginAttemptTracker, s.encoderDecoder, res, "", ipAddress, responseDetails) {
+			logger.Info("login attempted from locked out IP address")
+			return
+		}
+
 		requestedCookieDomain := s.determineCookieDomain(ctx, req)
 		if requestedCookieDomain != "" {
 			logger = logger.WithValue("cookie_domain", requestedCookieDomain)
@@ -108,6 +125,7 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 		if err != nil || user == nil {
 			observability.AcknowledgeError(err, logger, span, "fetching user")
 			if errors.Is(err, sql.ErrNoRows) {
+				loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, "", ipAddress)
 				errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
 				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
 				return
@@ -122,6 +140,11 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 		logger = logger.WithValue(keys.UserIDKey, user.ID)
 		tracing.AttachUserToSpan(span, user)
 
+		if loginattempts.RespondIfLockedOut(ctx, logger, span, s.loginAttemptTracker, s.encoderDecoder, res, user.ID, "", responseDetails) {
+			logger.Info("login attempted for locked out user")
+			return
+		}
+
 		if user.IsBanned() {
 			errRes := types.NewAPIErrorResponse("user is banned", types.ErrUserIsBanned, responseDetails)
 			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusForbidden)
@@ -134,6 +157,7 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 		if err != nil {
 			if errors.Is(err, authentication.ErrInvalidTOTPToken) {
 				observability.AcknowledgeError(err, logger, span, "validating TOTP token")
+				loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, user.ID, ipAddress)
 				errRes := types.NewAPIErrorResponse("login was invalid", types.ErrValidatingRequestInput, responseDetails)
 				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
 				return
@@ -141,6 +165,7 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 
 			if errors.Is(err, authentication.ErrPasswordDoesNotMatch) {
 				observability.AcknowledgeError(err, logger, span, "validating password")
+				loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, user.ID, ipAddress)
 				errRes := types.NewAPIErrorResponse("login was invalid", types.ErrValidatingRequestInput, responseDetails)
 				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
 				return
@@ -152,10 +177,32 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 			return
 		} else if !loginValid {
 			logger.Debug("login was invalid")
+			loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, user.ID, ipAddress)
 			s.encoderDecoder.EncodeErrorResponse(ctx, res, "login was invalid", http.StatusUnauthorized)
 			return
 		}
 
+		if loginValid && user.TwoFactorSecretVerifiedAt != nil && loginData.TOTPToken == "" && loginData.RecoveryCode != "" {
+			redeemed, redemptionErr := s.redeemRecoveryCode(ctx, user, loginData.RecoveryCode)
+			if redemptionErr != nil {
+				observability.AcknowledgeError(redemptionErr, logger, span, "redeeming recovery code")
+				errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+				return
+			}
+
+			if !redeemed {
+				logger.Debug("invalid recovery code provided")
+				loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, user.ID, ipAddress)
+				errRes := types.NewAPIErrorResponse("login was invalid", types.ErrValidatingRequestInput, responseDetails)
+				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+				return
+			}
+
+			s.completeLogin(ctx, user, req, res, responseDetails)
+			return
+		}
+
 		if loginValid && user.TwoFactorSecretVerifiedAt != nil && loginData.TOTPToken == "" {
 			logger.Debug("user with two factor verification active attempted to log in without providing TOTP")
 			errRes := types.NewAPIErrorResponse("TOTP required", types.ErrValidatingRequestInput, responseDetails)
@@ -163,38 +210,127 @@ func (s *service) BuildLoginHandler(adminOnly bool) func(http.ResponseWriter, *h
 			return
 		}
 
-		defaultHouseholdID, err := s.householdMembershipManager.GetDefaultHouseholdIDForUser(ctx, user.ID)
-		if err != nil {
-			observability.AcknowledgeError(err, logger, span, "fetching user memberships")
-			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-			return
-		}
+		s.completeLogin(ctx, user, req, res, responseDetails)
+	}
+}
 
-		responseCode, err := s.postLogin(ctx, user, defaultHouseholdID, req, res)
-		if err != nil {
-			observability.AcknowledgeError(err, logger, span, "handling login status")
-			errRes := types.NewAPIErrorResponse(staticError, types.ErrTalkingToDatabase, responseDetails)
-			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, responseCode)
+// completeLogin issues a session for a user whose credentials have been validated.
+func (s *service) completeLogin(ctx context.Context, user *types.User, req *http.Request, res http.ResponseWriter, responseDetails types.ResponseDetails) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span).WithValue(keys.UserIDKey, user.ID)
+
+	loginattempts.RecordSuccess(ctx, logger, span, s.loginAttemptTracker, user.ID)
+
+	defaultHouseholdID, err := s.householdMembershipManager.GetDefaultHouseholdIDForUser(ctx, user.ID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching user memberships")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	responseCode, err := s.postLogin(ctx, user, defaultHouseholdID, req, res)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "handling login status")
+		errRes := types.NewAPIErrorResponse(staticError, types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, responseCode)
+		return
+	}
+
+	statusResponse := &types.UserStatusResponse{
+		UserID:                   user.ID,
+		UserIsAuthenticated:      true,
+		AccountStatus:            user.AccountStatus,
+		ActiveHousehold:          defaultHouseholdID,
+		AccountStatusExplanation: user.AccountStatusExplanation,
+	}
+
+	responseValue := &types.APIResponse[*types.UserStatusResponse]{
+		Details: responseDetails,
+		Data:    statusResponse,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, responseCode)
+	logger.Debug("user logged in")
+}
+
+// passkeyLogin logs a user in with a WebAuthn assertion in place of a username and password.
+func (s *service) passkeyLogin(ctx context.Context, adminOnly bool, assertion json.RawMessage, req *http.Request, res http.ResponseWriter, responseDetails types.ResponseDetails) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span)
+
+	ipAddress := loginattempts.ClientIPAddress(req)
+	if loginattempts.RespondIfLockedOut(ctx, logger, span, s.loginAttemptTracker, s.encoderDecoder, res, "", ipAddress, responseDetails) {
+		logger.Info("passkey login attempted from locked out IP address")
+		return
+	}
+
+	user, err := s.validatePasskeyAssertion(ctx, req, res, assertion)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "validating passkey assertion")
+		if errors.Is(err, errInvalidPasskeyAssertion) {
+			// an assertion that doesn't validate doesn't reliably tell us whose account it was for.
+			loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, "", ipAddress)
+			errRes := types.NewAPIErrorResponse("login was invalid", types.ErrValidatingRequestInput, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
 			return
 		}
 
-		statusResponse := &types.UserStatusResponse{
-			UserID:                   user.ID,
-			UserIsAuthenticated:      true,
-			AccountStatus:            user.AccountStatus,
-			ActiveHousehold:          defaultHouseholdID,
-			AccountStatusExplanation: user.AccountStatusExplanation,
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	logger = logger.WithValue(keys.UserIDKey, user.ID)
+	tracing.AttachUserToSpan(span, user)
+
+	if loginattempts.RespondIfLockedOut(ctx, logger, span, s.loginAttemptTracker, s.encoderDecoder, res, user.ID, "", responseDetails) {
+		logger.Info("passkey login attempted for locked out user")
+		return
+	}
+
+	if adminOnly && !authorization.NewServiceRolePermissionChecker(user.ServiceRole).IsServiceAdmin() {
+		logger.Debug("non-admin user attempted to log in as an admin with a passkey")
+		errRes := types.NewAPIErrorResponse("login was invalid", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+
+	if user.IsBanned() {
+		errRes := types.NewAPIErrorResponse("user is banned", types.ErrUserIsBanned, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusForbidden)
+		return
+	}
+
+	s.completeLogin(ctx, user, req, res, responseDetails)
+}
+
+// redeemRecoveryCode uses one of a user's recovery codes in place of a TOTP token, and reports whether it was valid.
+func (s *service) redeemRecoveryCode(ctx context.Context, user *types.User, recoveryCode string) (bool, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	var redeemed bool
+	if err := s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+		if redeemed, err = s.userRecoveryCodeDataManager.RedeemUserRecoveryCode(ctx, user.ID, authentication.HashRecoveryCode(recoveryCode)); err != nil || !redeemed {
+			return err
 		}
 
-		responseValue := &types.APIResponse[*types.UserStatusResponse]{
-			Details: responseDetails,
-			Data:    statusResponse,
+		dcm := &types.DataChangeMessage{
+			EventType: types.RecoveryCodeRedeemedCustomerEventType,
+			UserID:    user.ID,
 		}
 
-		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, responseCode)
-		logger.Debug("user logged in")
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		return false, observability.PrepareError(err, span, "redeeming recovery code")
 	}
+
+	return redeemed, nil
 }
 
 func (s *service) postLogin(ctx context.Context, user *types.User, defaultHouseholdID string, req *http.Request, res http.ResponseWriter) (int, error) {
@@ -686,6 +822,107 @@ func (s *service) CycleCookieSecretHandler(res http.ResponseWriter, req *http.Re
 	res.WriteHeader(http.StatusAccepted)
 }
 
+// ClearLoginLockoutsHandler forgets failed login attempts and lockouts for a user and/or IP address.
+func (s *service) ClearLoginLockoutsHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	if !sessionCtxData.Requester.ServicePermissions.CanClearLoginLockouts() || !sessionCtxData.TokenScopesAllow(authorization.ClearLoginLockoutsPermission) {
+		logger.Debug("invalid permissions")
+		s.encoderDecoder.EncodeInvalidPermissionsResponse(ctx, res)
+		return
+	}
+
+	input := new(types.LoginLockoutClearanceInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request body")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	input.TargetUserID = strings.TrimSpace(input.TargetUserID)
+	input.IPAddress = strings.TrimSpace(input.IPAddress)
+
+	if err = input.ValidateWithContext(ctx); err != nil {
+		observability.AcknowledgeError(err, logger, span, "validating input")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	logger = logger.WithValue("target_user_id", input.TargetUserID).WithValue("ip_address", input.IPAddress)
+
+	clearTimer := timing.NewMetric("cache").WithDesc("clear lockouts").Start()
+	if err = s.loginAttemptTracker.ClearLockouts(ctx, input.TargetUserID, input.IPAddress); err != nil {
+		observability.AcknowledgeError(err, logger, span, "clearing login lockouts")
+		errRes := types.NewAPIErrorResponse(staticError, types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	clearTimer.Stop()
+
+	relevantID := input.TargetUserID
+	if relevantID == "" {
+		relevantID = input.IPAddress
+	}
+
+	if _, err = s.auditLogEntryDataManager.CreateAuditLogEntry(ctx, &types.AuditLogEntryDatabaseCreationInput{
+		ID:            identifiers.New(),
+		ResourceType:  loginattempts.ResourceTypeLoginLockouts,
+		RelevantID:    relevantID,
+		EventType:     types.AuditLogEventTypeArchived,
+		BelongsToUser: sessionCtxData.Requester.UserID,
+		Changes: map[string]types.ChangeLog{
+			"target_user_id": {OldValue: input.TargetUserID},
+			"ip_address":     {OldValue: input.IPAddress},
+		},
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "recording cleared lockouts in audit log")
+	}
+
+	dcm := &types.DataChangeMessage{
+		EventType: types.LoginLockoutClearedEventType,
+		Context: map[string]any{
+			"target_user_id": input.TargetUserID,
+			"ip_address":     input.IPAddress,
+		},
+		HouseholdID: sessionCtxData.ActiveHouseholdID,
+		UserID:      sessionCtxData.Requester.UserID,
+	}
+
+	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
+		observability.AcknowledgeError(err, logger, span, "publishing data change message")
+	}
+
+	logger.Info("login lockouts cleared")
+
+	res.WriteHeader(http.StatusAccepted)
+}
+
 var _ types.OAuth2Service = (*service)(nil)
 
 // AuthorizeHandler is our oauth2 auth route.
@@ -695,8 +932,212 @@ func (s *service) AuthorizeHandler(res http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// TokenHandler is our oauth2 token route.
 func (s *service) TokenHandler(res http.ResponseWriter, req *http.Request) {
+	// the password grant has no place for a TOTP token, so we carry it alongside the request.
+	if totpToken := strings.TrimSpace(req.FormValue(totpTokenFormKey)); totpToken != "" {
+		req = req.WithContext(context.WithValue(req.Context(), totpTokenContextKey, totpToken))
+	}
+	req = req.WithContext(context.WithValue(req.Context(), ipAddressContextKey, loginattempts.ClientIPAddress(req)))
+
 	if err := s.oauth2Server.HandleTokenRequest(res, req); err != nil {
 		http.Error(res, err.Error(), http.StatusInternalServerError)
 	}
 }
+
+// RevokeHandler is our oauth2 token revocation route, per RFC 7009.
+func (s *service) RevokeHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span)
+
+	clientID, err := s.authenticateOAuth2Client(ctx, req)
+	if err != nil {
+		s.encodeOAuth2Error(res, err)
+		return
+	}
+	logger = logger.WithValue(keys.OAuth2ClientClientIDKey, clientID)
+
+	token := req.Form.Get("token")
+	if token == "" {
+		s.encodeOAuth2Error(res, oauth2errors.ErrInvalidRequest)
+		return
+	}
+
+	tokenInfo, isRefresh, err := s.loadOAuth2Token(ctx, token, req.Form.Get("token_type_hint"))
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "loading oauth2 token for revocation")
+		s.encodeOAuth2Error(res, err)
+		return
+	}
+
+	// invalid tokens do not cause an error response, since the client can't do anything about them.
+	if tokenInfo == nil {
+		res.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if tokenInfo.GetClientID() != clientID {
+		logger.Info("oauth2 client attempted to revoke another client's token")
+		s.encodeOAuth2Error(res, oauth2errors.ErrUnauthorizedClient)
+		return
+	}
+
+	if isRefresh {
+		err = s.oauth2Server.Manager.RemoveRefreshToken(ctx, token)
+	} else {
+		err = s.oauth2Server.Manager.RemoveAccessToken(ctx, token)
+	}
+
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "revoking oauth2 token")
+		s.encodeOAuth2Error(res, err)
+		return
+	}
+
+	res.WriteHeader(http.StatusOK)
+}
+
+// IntrospectHandler is our oauth2 token introspection route, per RFC 7662.
+func (s *service) IntrospectHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span)
+
+	clientID, err := s.authenticateOAuth2Client(ctx, req)
+	if err != nil {
+		s.encodeOAuth2Error(res, err)
+		return
+	}
+	logger = logger.WithValue(keys.OAuth2ClientClientIDKey, clientID)
+
+	token := req.Form.Get("token")
+	if token == "" {
+		s.encodeOAuth2Error(res, oauth2errors.ErrInvalidRequest)
+		return
+	}
+
+	tokenInfo, isRefresh, err := s.loadOAuth2Token(ctx, token, req.Form.Get("token_type_hint"))
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "loading oauth2 token for introspection")
+		s.encodeOAuth2Error(res, err)
+		return
+	}
+
+	res.Header().Set("Cache-Control", "no-store")
+
+	// tokens issued to other clients are none of the requester's business.
+	response := &types.OAuth2TokenIntrospectionResponse{}
+	if tokenInfo != nil && tokenInfo.GetClientID() == clientID {
+		response.Active = true
+		response.Scope = tokenInfo.GetScope()
+		response.ClientID = tokenInfo.GetClientID()
+		response.Subject = tokenInfo.GetUserID()
+
+		if isRefresh {
+			response.TokenType = "refresh_token"
+			response.IssuedAt = tokenInfo.GetRefreshCreateAt().Unix()
+			if expiresIn := tokenInfo.GetRefreshExpiresIn(); expiresIn != 0 {
+				response.ExpiresAt = tokenInfo.GetRefreshCreateAt().Add(expiresIn).Unix()
+			}
+		} else {
+			response.TokenType = "access_token"
+			response.IssuedAt = tokenInfo.GetAccessCreateAt().Unix()
+			if expiresIn := tokenInfo.GetAccessExpiresIn(); expiresIn != 0 {
+				response.ExpiresAt = tokenInfo.GetAccessCreateAt().Add(expiresIn).Unix()
+			}
+		}
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, response, http.StatusOK)
+}
+
+// ConsentHandler describes what an OAuth2 client is asking to do, so a user can decide whether to authorize it.
+func (s *service) ConsentHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	clientID := req.URL.Query().Get("client_id")
+	if clientID == "" {
+		errRes := types.NewAPIErrorResponse("client_id is required", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+	tracing.AttachToSpan(span, keys.OAuth2ClientClientIDKey, clientID)
+	logger = logger.WithValue(keys.OAuth2ClientClientIDKey, clientID)
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	client, err := s.oauth2ClientDataManager.GetOAuth2ClientByClientID(ctx, clientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching OAuth2 client from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	scope, ok := narrowOAuth2Scopes(client.Scopes, req.URL.Query().Get("scope"))
+	if !ok {
+		errRes := types.NewAPIErrorResponse("requested scopes exceed those granted to client", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	consentScreen := &types.OAuth2ConsentScreen{
+		BelongsToHousehold: client.BelongsToHousehold,
+		ClientID:           client.ClientID,
+		ClientName:         client.Name,
+		ClientDescription:  client.Description,
+		ClientDomain:       client.Domain,
+		Scopes:             []*types.OAuth2ConsentScope{},
+	}
+
+	for _, name := range authorization.ParseOAuth2Scopes(scope) {
+		consentScope := &types.OAuth2ConsentScope{
+			Name:        name,
+			Description: authorization.OAuth2ScopeDescription(name),
+			Permissions: []string{},
+		}
+
+		for _, perm := range authorization.OAuth2ScopePermissions(name) {
+			consentScope.Permissions = append(consentScope.Permissions, perm.ID())
+		}
+
+		consentScreen.Scopes = append(consentScreen.Scopes, consentScope)
+	}
+
+	responseValue := &types.APIResponse[*types.OAuth2ConsentScreen]{
+		Details: responseDetails,
+		Data:    consentScreen,
+	}
+
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
@@ -4,6 +4,7 @@
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -13,6 +14,8 @@
 	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	mockloginattempts "github.com/dinnerdonebetter/backend/internal/authentication/loginattempts/mock"
 	mockauthn "github.com/dinnerdonebetter/backend/internal/authentication/mock"
 	"github.com/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
@@ -648,6 +651,207 @@ func TestAuthenticationService_BuildLoginHandler_WithoutAdminRestriction(T *test
 		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
 	})
 
+	T.Run("with locked out IP address", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, helper.exampleLoginInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return(time.Now().Add(time.Minute), nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusTooManyRequests, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get("Retry-After"))
+		assert.Empty(t, helper.res.Header().Get("Set-Cookie"))
+
+		mock.AssertExpectationsForObjects(t, tracker)
+	})
+
+	T.Run("with locked out user", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, helper.exampleLoginInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On(
+			"GetUserByUsername",
+			testutils.ContextMatcher,
+			helper.exampleUser.Username,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return(time.Time{}, nil)
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			"",
+		).Return(time.Now().Add(time.Minute), nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusTooManyRequests, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get("Retry-After"))
+		assert.Empty(t, helper.res.Header().Get("Set-Cookie"))
+
+		mock.AssertExpectationsForObjects(t, userDataManager, tracker)
+	})
+
+	T.Run("with non-matching password triggering lockout", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, helper.exampleLoginInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On(
+			"GetUserByUsername",
+			testutils.ContextMatcher,
+			helper.exampleUser.Username,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			helper.exampleLoginInput.Password,
+			helper.exampleUser.TwoFactorSecret,
+			helper.exampleLoginInput.TOTPToken,
+		).Return(false, authentication.ErrPasswordDoesNotMatch)
+		helper.service.authenticator = authenticator
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+		).Return(time.Time{}, nil)
+		tracker.On(
+			"RecordFailure",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			"127.0.0.1",
+		).Return([]*loginattempts.Lockout{
+			{
+				LockedUntil: time.Now().Add(time.Minute),
+				SubjectType: loginattempts.SubjectTypeUser,
+				Subject:     helper.exampleUser.ID,
+				Count:       1,
+			},
+		}, nil)
+		helper.service.loginAttemptTracker = tracker
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.AuditLogEntryDatabaseCreationInput) bool {
+				return input.ResourceType == loginattempts.ResourceTypeLoginLockouts && input.BelongsToUser == helper.exampleUser.ID
+			}),
+		).Return(&types.AuditLogEntry{}, nil)
+		helper.service.auditLogEntryDataManager = auditLogEntryDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(dcm *types.DataChangeMessage) bool {
+				return dcm.EventType == types.LoginLockoutTriggeredEventType && dcm.UserID == helper.exampleUser.ID
+			}),
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		assert.Empty(t, helper.res.Header().Get("Set-Cookie"))
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, tracker, auditLogEntryDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with unknown username counting against IP address", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, helper.exampleLoginInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On(
+			"GetUserByUsername",
+			testutils.ContextMatcher,
+			helper.exampleUser.Username,
+		).Return((*types.User)(nil), sql.ErrNoRows)
+		helper.service.userDataManager = userDataManager
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return(time.Time{}, nil)
+		tracker.On(
+			"RecordFailure",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return([]*loginattempts.Lockout{}, nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, tracker)
+	})
+
 	T.Run("with verified two factor secret but without TOTP", func(t *testing.T) {
 		t.Parallel()
 
@@ -689,6 +893,124 @@ func TestAuthenticationService_BuildLoginHandler_WithoutAdminRestriction(T *test
 		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
 	})
 
+	T.Run("with verified two factor secret and recovery code", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		helper.exampleLoginInput.TOTPToken = ""
+		helper.exampleLoginInput.RecoveryCode = "abcd-efgh-ijkl-mnop"
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, helper.exampleLoginInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On(
+			"GetUserByUsername",
+			testutils.ContextMatcher,
+			helper.exampleUser.Username,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			helper.exampleLoginInput.Password,
+			helper.exampleUser.TwoFactorSecret,
+			helper.exampleLoginInput.TOTPToken,
+		).Return(true, nil)
+		helper.service.authenticator = authenticator
+
+		recoveryCodeDataManager := &mocktypes.UserRecoveryCodeDataManagerMock{}
+		recoveryCodeDataManager.On(
+			"RedeemUserRecoveryCode",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			authentication.HashRecoveryCode(helper.exampleLoginInput.RecoveryCode),
+		).Return(true, nil)
+		helper.service.userRecoveryCodeDataManager = recoveryCodeDataManager
+
+		membershipDB := &mocktypes.HouseholdUserMembershipDataManagerMock{}
+		membershipDB.On(
+			"GetDefaultHouseholdIDForUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleHousehold.ID, nil)
+		helper.service.householdMembershipManager = membershipDB
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get("Set-Cookie"))
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, recoveryCodeDataManager, membershipDB, dataChangesPublisher)
+	})
+
+	T.Run("with verified two factor secret and invalid recovery code", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		helper.exampleLoginInput.TOTPToken = ""
+		helper.exampleLoginInput.RecoveryCode = "abcd-efgh-ijkl-mnop"
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, helper.exampleLoginInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On(
+			"GetUserByUsername",
+			testutils.ContextMatcher,
+			helper.exampleUser.Username,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			helper.exampleLoginInput.Password,
+			helper.exampleUser.TwoFactorSecret,
+			helper.exampleLoginInput.TOTPToken,
+		).Return(true, nil)
+		helper.service.authenticator = authenticator
+
+		recoveryCodeDataManager := &mocktypes.UserRecoveryCodeDataManagerMock{}
+		recoveryCodeDataManager.On(
+			"RedeemUserRecoveryCode",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			authentication.HashRecoveryCode(helper.exampleLoginInput.RecoveryCode),
+		).Return(false, nil)
+		helper.service.userRecoveryCodeDataManager = recoveryCodeDataManager
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		assert.Empty(t, helper.res.Header().Get("Set-Cookie"))
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, recoveryCodeDataManager)
+	})
+
 	T.Run("with error fetching default household", func(t *testing.T) {
 		t.Parallel()
 
@@ -1676,6 +1998,149 @@ func TestAuthenticationService_CycleSecretHandler(T *testing.T) {
 	})
 }
 
+func TestAuthenticationService_ClearLoginLockoutsHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleUser.ServiceRole = authorization.ServiceAdminRole.String()
+		helper.setContextFetcher(t)
+
+		exampleInput := &types.LoginLockoutClearanceInput{
+			TargetUserID: fakes.BuildFakeID(),
+			IPAddress:    "127.0.0.1",
+		}
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)))
+		require.NoError(t, err)
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"ClearLockouts",
+			testutils.ContextMatcher,
+			exampleInput.TargetUserID,
+			exampleInput.IPAddress,
+		).Return(nil)
+		helper.service.loginAttemptTracker = tracker
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.AuditLogEntryDatabaseCreationInput) bool {
+				return input.ResourceType == loginattempts.ResourceTypeLoginLockouts && input.RelevantID == exampleInput.TargetUserID
+			}),
+		).Return(&types.AuditLogEntry{}, nil)
+		helper.service.auditLogEntryDataManager = auditLogEntryDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(dcm *types.DataChangeMessage) bool {
+				return dcm.EventType == types.LoginLockoutClearedEventType
+			}),
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.ClearLoginLockoutsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, tracker, auditLogEntryDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error getting session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ClearLoginLockoutsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with invalid permissions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.service.ClearLoginLockoutsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+	})
+
+	T.Run("with token scopes lacking permission", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleUser.ServiceRole = authorization.ServiceAdminRole.String()
+		helper.setContextFetcher(t)
+
+		sessionCtxData, err := helper.service.sessionContextDataFetcher(helper.req)
+		require.NoError(t, err)
+		sessionCtxData.TokenScopes = authorization.NewOAuth2ScopePermissionChecker(string(authorization.HouseholdAdminOAuth2Scope))
+
+		tracker := &mockloginattempts.Tracker{}
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.ClearLoginLockoutsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, tracker)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleUser.ServiceRole = authorization.ServiceAdminRole.String()
+		helper.setContextFetcher(t)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, &types.LoginLockoutClearanceInput{})))
+		require.NoError(t, err)
+
+		helper.service.ClearLoginLockoutsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with error clearing lockouts", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleUser.ServiceRole = authorization.ServiceAdminRole.String()
+		helper.setContextFetcher(t)
+
+		exampleInput := &types.LoginLockoutClearanceInput{
+			IPAddress: "127.0.0.1",
+		}
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)))
+		require.NoError(t, err)
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"ClearLockouts",
+			testutils.ContextMatcher,
+			"",
+			exampleInput.IPAddress,
+		).Return(errors.New("blah"))
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.ClearLoginLockoutsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, tracker)
+	})
+}
+
 //nolint:paralleltest // pending race condition fix
 func Test_service_SSOProviderHandler(T *testing.T) {
 	// T.Parallel()
@@ -1708,3 +2173,111 @@ func Test_service_SSOProviderHandler(T *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
 	})
 }
+
+func TestAuthenticationService_ConsentHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		helper.req.URL.RawQuery = fmt.Sprintf("client_id=%s&scope=%s", exampleClient.ClientID, authorization.ReadRecipesOAuth2Scope)
+
+		helper.service.ConsentHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		var actual *types.APIResponse[*types.OAuth2ConsentScreen]
+		require.NoError(t, json.NewDecoder(helper.res.Body).Decode(&actual))
+		assert.Equal(t, exampleClient.ClientID, actual.Data.ClientID)
+		require.Len(t, actual.Data.Scopes, 1)
+		assert.Equal(t, string(authorization.ReadRecipesOAuth2Scope), actual.Data.Scopes[0].Name)
+		assert.Contains(t, actual.Data.Scopes[0].Permissions, authorization.ReadRecipesPermission.ID())
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+
+	T.Run("with error fetching session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ConsentHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("without client ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.service.ConsentHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with nonexistent client", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return((*types.OAuth2Client)(nil), sql.ErrNoRows)
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		helper.req.URL.RawQuery = fmt.Sprintf("client_id=%s", exampleClient.ClientID)
+
+		helper.service.ConsentHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+
+	T.Run("with error fetching client", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return((*types.OAuth2Client)(nil), errors.New("blah"))
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		helper.req.URL.RawQuery = fmt.Sprintf("client_id=%s", exampleClient.ClientID)
+
+		helper.service.ConsentHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+
+	T.Run("with scopes not granted to client", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		oauth2ClientDataManager := &mocktypes.OAuth2ClientDataManagerMock{}
+		oauth2ClientDataManager.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		helper.service.oauth2ClientDataManager = oauth2ClientDataManager
+
+		helper.req.URL.RawQuery = fmt.Sprintf("client_id=%s&scope=%s", exampleClient.ClientID, authorization.ServiceAdminOAuth2Scope)
+
+		helper.service.ConsentHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, oauth2ClientDataManager)
+	})
+}
@@ -105,6 +105,13 @@ func (s *service) UserAttributionMiddleware(next http.Handler) http.Handler {
 				userAttributionTimer.Stop()
 
 				if sessionCtxData != nil {
+					if err = s.restrictSessionContextDataToOAuth2Token(ctx, sessionCtxData, token); err != nil {
+						observability.AcknowledgeError(err, logger, span, "restricting session to oauth2 token")
+						errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+						s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+						return
+					}
+
 					next.ServeHTTP(res, req.WithContext(context.WithValue(ctx, types.SessionContextDataKey, sessionCtxData)))
 					return
 				}
@@ -190,6 +197,13 @@ func (s *service) PermissionFilterMiddleware(permissions ...authorization.Permis
 					s.encoderDecoder.EncodeUnauthorizedResponse(ctx, res)
 					return
 				}
+
+				if !sessionContextData.TokenScopesAllow(perm) {
+					permissionCheckTimer.Stop()
+					logger.WithValue("deficient_permission", perm.ID()).Info("request filtered out by token scopes")
+					s.encoderDecoder.EncodeInvalidPermissionsResponse(ctx, res)
+					return
+				}
 			}
 
 			permissionCheckTimer.Stop()
@@ -198,6 +212,96 @@ func (s *service) PermissionFilterMiddleware(permissions ...authorization.Permis
 	}
 }
 
+// HouseholdPermissionFilterMiddleware filters users out of requests based on their permissions in the household
+// named by the given route parameter, which needn't be their active one.
+func (s *service) HouseholdPermissionFilterMiddleware(householdIDURIParamKey string, permissions ...authorization.Permission) func(next http.Handler) http.Handler {
+	householdIDFetcher := s.routeParamManager.BuildRouteParamStringIDFetcher(householdIDURIParamKey)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			ctx, span := s.tracer.StartSpan(req.Context())
+			defer span.End()
+
+			timing := servertiming.FromContext(ctx)
+			logger := s.logger.WithRequest(req).WithSpan(span)
+			logger.Debug("checking household permissions in middleware")
+
+			// check for a session context data first.
+			sessionContextData, err := s.sessionContextDataFetcher(req)
+			if err != nil {
+				observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+				s.encoderDecoder.EncodeUnauthorizedResponse(ctx, res)
+				return
+			}
+
+			permissionCheckTimer := timing.NewMetric("permissions check").WithDesc("checking user household permissions").Start()
+
+			householdID := householdIDFetcher(req)
+			tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+			logger = sessionContextData.AttachToLogger(logger).WithValue(keys.HouseholdIDKey, householdID)
+
+			servicePermissions := sessionContextData.ServiceRolePermissionChecker()
+			isServiceAdmin := servicePermissions != nil && servicePermissions.IsServiceAdmin()
+			logger = logger.WithValue("is_service_admin", isServiceAdmin)
+
+			householdPermissions, isMember := sessionContextData.HouseholdPermissions[householdID]
+			if (!isMember || householdPermissions == nil) && !isServiceAdmin {
+				permissionCheckTimer.Stop()
+				logger.Info("not authorized for household")
+				s.encoderDecoder.EncodeInvalidPermissionsResponse(ctx, res)
+				return
+			}
+
+			for _, perm := range permissions {
+				hasServicePermission := servicePermissions != nil && servicePermissions.HasPermission(perm)
+				hasHouseholdPermission := householdPermissions != nil && householdPermissions.HasPermission(perm)
+				if !isServiceAdmin && !hasServicePermission && !hasHouseholdPermission {
+					permissionCheckTimer.Stop()
+					logger.WithValue("deficient_permission", perm.ID()).Info("request filtered out")
+					s.encoderDecoder.EncodeInvalidPermissionsResponse(ctx, res)
+					return
+				}
+
+				if !sessionContextData.TokenScopesAllow(perm) {
+					permissionCheckTimer.Stop()
+					logger.WithValue("deficient_permission", perm.ID()).Info("request filtered out by token scopes")
+					s.encoderDecoder.EncodeInvalidPermissionsResponse(ctx, res)
+					return
+				}
+			}
+
+			permissionCheckTimer.Stop()
+			next.ServeHTTP(res, req)
+		})
+	}
+}
+
+// UnscopedRouteMiddleware restricts routes that no OAuth2 scope covers, like account management, to cookie
+// sessions and tokens with the service_admin scope, so a narrowly scoped token can't reach them.
+func (s *service) UnscopedRouteMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		ctx, span := s.tracer.StartSpan(req.Context())
+		defer span.End()
+
+		logger := s.logger.WithRequest(req).WithSpan(span)
+
+		sessionContextData, err := s.sessionContextDataFetcher(req)
+		if err != nil {
+			observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+			s.encoderDecoder.EncodeUnauthorizedResponse(ctx, res)
+			return
+		}
+
+		if sessionContextData.TokenScopes != nil && !sessionContextData.TokenScopes.IsServiceAdmin() {
+			sessionContextData.AttachToLogger(logger).Info("request to unscoped route filtered out by token scopes")
+			s.encoderDecoder.EncodeInvalidPermissionsResponse(ctx, res)
+			return
+		}
+
+		next.ServeHTTP(res, req)
+	})
+}
+
 // ServiceAdminMiddleware restricts requests to admin users only.
 func (s *service) ServiceAdminMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
@@ -228,6 +332,13 @@ func (s *service) ServiceAdminMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		if sessionCtxData.TokenScopes != nil && !sessionCtxData.TokenScopes.IsServiceAdmin() {
+			logger.Debug("ServiceAdminMiddleware called with token lacking service admin scope")
+			errRes := types.NewAPIErrorResponse("insufficient token scope", types.ErrUserIsNotAuthorized, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusForbidden)
+			return
+		}
+
 		next.ServeHTTP(res, req)
 	})
 }
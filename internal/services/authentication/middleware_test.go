@@ -7,7 +7,9 @@
 	"testing"
 
 	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/internal/routing/mock"
 	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
 	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
@@ -365,6 +367,183 @@ func TestAuthenticationService_PermissionFilterMiddleware(T *testing.T) {
 
 		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
 	})
+
+	T.Run("with token scopes lacking permission", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.exampleUser.ServiceRole = authorization.ServiceAdminRole.String()
+		helper.setContextFetcher(t)
+
+		sessionCtxData := &types.SessionContextData{
+			Requester: types.RequesterInfo{
+				UserID:                   helper.exampleUser.ID,
+				AccountStatus:            helper.exampleUser.AccountStatus,
+				AccountStatusExplanation: helper.exampleUser.AccountStatusExplanation,
+				ServicePermissions:       authorization.NewServiceRolePermissionChecker(helper.exampleUser.ServiceRole),
+			},
+			ActiveHouseholdID:    helper.exampleHousehold.ID,
+			HouseholdPermissions: helper.examplePermCheckers,
+			TokenScopes:          authorization.NewOAuth2ScopePermissionChecker(string(authorization.ReadRecipesOAuth2Scope)),
+		}
+
+		helper.req = helper.req.WithContext(context.WithValue(helper.req.Context(), types.SessionContextDataKey, sessionCtxData))
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		helper.service.PermissionFilterMiddleware(authorization.InviteUserToHouseholdPermission)(nil).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+	})
+}
+
+func TestAuthenticationService_HouseholdPermissionFilterMiddleware(T *testing.T) {
+	T.Parallel()
+
+	const householdIDURIParamKey = "householdID"
+
+	setHouseholdIDFetcher := func(t *testing.T, helper *authServiceHTTPRoutesTestHelper, householdID string) {
+		t.Helper()
+
+		rpm := mockrouting.NewRouteParamManager()
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			householdIDURIParamKey,
+		).Return(func(*http.Request) string { return householdID })
+		helper.service.routeParamManager = rpm
+	}
+
+	buildSessionContextData := func(helper *authServiceHTTPRoutesTestHelper, serviceRole string) *types.SessionContextData {
+		return &types.SessionContextData{
+			Requester: types.RequesterInfo{
+				UserID:                   helper.exampleUser.ID,
+				AccountStatus:            helper.exampleUser.AccountStatus,
+				AccountStatusExplanation: helper.exampleUser.AccountStatusExplanation,
+				ServicePermissions:       authorization.NewServiceRolePermissionChecker(serviceRole),
+			},
+			ActiveHouseholdID:    fakes.BuildFakeID(),
+			HouseholdPermissions: helper.examplePermCheckers,
+		}
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setHouseholdIDFetcher(t, helper, helper.exampleHousehold.ID)
+
+		sessionCtxData := buildSessionContextData(helper, authorization.ServiceUserRole.String())
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		mockHandler := &testutils.MockHTTPHandler{}
+		mockHandler.On(
+			"ServeHTTP",
+			testutils.HTTPResponseWriterMatcher,
+			testutils.HTTPRequestMatcher,
+		).Return()
+
+		helper.service.HouseholdPermissionFilterMiddleware(householdIDURIParamKey, authorization.ReadPantryItemsPermission)(mockHandler).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockHandler)
+	})
+
+	T.Run("as service admin without membership in household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setHouseholdIDFetcher(t, helper, fakes.BuildFakeID())
+
+		sessionCtxData := buildSessionContextData(helper, authorization.ServiceAdminRole.String())
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		mockHandler := &testutils.MockHTTPHandler{}
+		mockHandler.On(
+			"ServeHTTP",
+			testutils.HTTPResponseWriterMatcher,
+			testutils.HTTPRequestMatcher,
+		).Return()
+
+		helper.service.HouseholdPermissionFilterMiddleware(householdIDURIParamKey, authorization.ReadPantryItemsPermission)(mockHandler).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockHandler)
+	})
+
+	T.Run("with error fetching session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setHouseholdIDFetcher(t, helper, helper.exampleHousehold.ID)
+
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return nil, errors.New("blah")
+		}
+
+		helper.service.HouseholdPermissionFilterMiddleware(householdIDURIParamKey, authorization.ReadPantryItemsPermission)(nil).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("without membership in household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setHouseholdIDFetcher(t, helper, fakes.BuildFakeID())
+
+		sessionCtxData := buildSessionContextData(helper, authorization.ServiceUserRole.String())
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		helper.service.HouseholdPermissionFilterMiddleware(householdIDURIParamKey, authorization.ReadPantryItemsPermission)(nil).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+	})
+
+	T.Run("without permission to perform action", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setHouseholdIDFetcher(t, helper, helper.exampleHousehold.ID)
+
+		sessionCtxData := buildSessionContextData(helper, authorization.ServiceUserRole.String())
+		sessionCtxData.HouseholdPermissions = map[string]authorization.HouseholdRolePermissionsChecker{
+			helper.exampleHousehold.ID: authorization.NewHouseholdRolePermissionChecker(),
+		}
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		helper.service.HouseholdPermissionFilterMiddleware(householdIDURIParamKey, authorization.ArchivePantryItemsPermission)(nil).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+	})
+
+	T.Run("with token scopes lacking permission", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setHouseholdIDFetcher(t, helper, helper.exampleHousehold.ID)
+
+		sessionCtxData := buildSessionContextData(helper, authorization.ServiceUserRole.String())
+		sessionCtxData.TokenScopes = authorization.NewOAuth2ScopePermissionChecker(string(authorization.ReadRecipesOAuth2Scope))
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		helper.service.HouseholdPermissionFilterMiddleware(householdIDURIParamKey, authorization.ReadPantryItemsPermission)(nil).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+	})
 }
 
 func TestAuthenticationService_AdminMiddleware(T *testing.T) {
@@ -459,6 +638,154 @@ func TestAuthenticationService_AdminMiddleware(T *testing.T) {
 
 		mock.AssertExpectationsForObjects(t, mockHandler)
 	})
+
+	T.Run("with token lacking service admin scope", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.exampleUser.ServiceRole = authorization.ServiceAdminRole.String()
+		helper.setContextFetcher(t)
+
+		sessionCtxData := &types.SessionContextData{
+			Requester: types.RequesterInfo{
+				UserID:                   helper.exampleUser.ID,
+				AccountStatus:            helper.exampleUser.AccountStatus,
+				AccountStatusExplanation: helper.exampleUser.AccountStatusExplanation,
+				ServicePermissions:       authorization.NewServiceRolePermissionChecker(helper.exampleUser.ServiceRole),
+			},
+			ActiveHouseholdID:    helper.exampleHousehold.ID,
+			HouseholdPermissions: helper.examplePermCheckers,
+			TokenScopes:          authorization.NewOAuth2ScopePermissionChecker(string(authorization.HouseholdAdminOAuth2Scope)),
+		}
+
+		helper.req = helper.req.WithContext(context.WithValue(helper.req.Context(), types.SessionContextDataKey, sessionCtxData))
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		mockHandler := &testutils.MockHTTPHandler{}
+		helper.service.ServiceAdminMiddleware(mockHandler).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockHandler)
+	})
+}
+
+func TestAuthenticationService_UnscopedRouteMiddleware(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.setContextFetcher(t)
+
+		sessionCtxData := &types.SessionContextData{
+			Requester: types.RequesterInfo{
+				UserID:             helper.exampleUser.ID,
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(helper.exampleUser.ServiceRole),
+			},
+			ActiveHouseholdID:    helper.exampleHousehold.ID,
+			HouseholdPermissions: helper.examplePermCheckers,
+		}
+
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		mockHandler := &testutils.MockHTTPHandler{}
+		mockHandler.On(
+			"ServeHTTP",
+			testutils.HTTPResponseWriterMatcher,
+			testutils.HTTPRequestMatcher,
+		).Return()
+
+		helper.service.UnscopedRouteMiddleware(mockHandler).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockHandler)
+	})
+
+	T.Run("with service admin token", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.setContextFetcher(t)
+
+		sessionCtxData := &types.SessionContextData{
+			Requester: types.RequesterInfo{
+				UserID:             helper.exampleUser.ID,
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(helper.exampleUser.ServiceRole),
+			},
+			ActiveHouseholdID:    helper.exampleHousehold.ID,
+			HouseholdPermissions: helper.examplePermCheckers,
+			TokenScopes:          authorization.NewOAuth2ScopePermissionChecker(string(authorization.ServiceAdminOAuth2Scope)),
+		}
+
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		mockHandler := &testutils.MockHTTPHandler{}
+		mockHandler.On(
+			"ServeHTTP",
+			testutils.HTTPResponseWriterMatcher,
+			testutils.HTTPRequestMatcher,
+		).Return()
+
+		helper.service.UnscopedRouteMiddleware(mockHandler).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockHandler)
+	})
+
+	T.Run("with error fetching session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.setContextFetcher(t)
+
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return nil, errors.New("blah")
+		}
+
+		helper.service.UnscopedRouteMiddleware(nil).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with scoped token", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.setContextFetcher(t)
+
+		sessionCtxData := &types.SessionContextData{
+			Requester: types.RequesterInfo{
+				UserID:             helper.exampleUser.ID,
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(helper.exampleUser.ServiceRole),
+			},
+			ActiveHouseholdID:    helper.exampleHousehold.ID,
+			HouseholdPermissions: helper.examplePermCheckers,
+			TokenScopes:          authorization.NewOAuth2ScopePermissionChecker(string(authorization.HouseholdAdminOAuth2Scope)),
+		}
+
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return sessionCtxData, nil
+		}
+
+		mockHandler := &testutils.MockHTTPHandler{}
+
+		helper.service.UnscopedRouteMiddleware(mockHandler).ServeHTTP(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockHandler)
+	})
 }
 
 func TestFetchContextFromRequest(T *testing.T) {
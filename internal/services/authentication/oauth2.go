@@ -2,12 +2,26 @@
 
 import (
 	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	stderrors "errors"
 	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	"github.com/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	"github.com/go-oauth2/oauth2/v4"
 	"github.com/go-oauth2/oauth2/v4/errors"
@@ -16,25 +30,59 @@
 	"github.com/go-oauth2/oauth2/v4/server"
 )
 
+const (
+	// totpTokenFormKey is the token request form field that carries a TOTP token for the password grant.
+	totpTokenFormKey = "totp_token"
+	// totpTokenContextKey is where TokenHandler stashes the TOTP token for the password grant.
+	totpTokenContextKey types.ContextKey = "oauth2_totp_token"
+	// ipAddressContextKey is where TokenHandler stashes the client's IP address for the password grant.
+	ipAddressContextKey types.ContextKey = "oauth2_ip_address"
+)
+
+// lockedOutError is returned by the password grant when the user or IP address is locked out.
+type lockedOutError struct {
+	lockedUntil time.Time
+}
+
+func (e *lockedOutError) Error() string {
+	return "too many failed login attempts"
+}
+
 func ProvideOAuth2ServerImplementation(
 	_ context.Context,
 	logger logging.Logger,
 	tracer tracing.Tracer,
 	cfg *OAuth2Config,
+	authenticator authentication.Authenticator,
 	dataManager database.DataManager,
+	loginAttemptTracker loginattempts.Tracker,
+	dataChangesPublisher messagequeue.Publisher,
 ) *server.Server {
+	logger = logging.EnsureLogger(logger)
+
 	manager := manage.NewManager()
 
-	// we don't care at the moment
-	manager.SetValidateURIHandler(func(_, _ string) error {
-		return nil
+	manager.SetValidateURIHandler(validateRedirectURI)
+	manager.SetAuthorizeCodeTokenCfg(&manage.Config{
+		AccessTokenExp:    cfg.AccessTokenLifespan,
+		RefreshTokenExp:   cfg.RefreshTokenLifespan,
+		IsGenerateRefresh: true,
+	})
+	manager.SetPasswordTokenCfg(&manage.Config{
+		AccessTokenExp:    cfg.AccessTokenLifespan,
+		RefreshTokenExp:   cfg.RefreshTokenLifespan,
+		IsGenerateRefresh: true,
+	})
+	// machine clients can always present their credentials again, so they don't get refresh tokens.
+	manager.SetClientTokenCfg(&manage.Config{
+		AccessTokenExp: cfg.AccessTokenLifespan,
 	})
 	manager.MapAuthorizeGenerate(generates.NewAuthorizeGenerate())
 	manager.MapAccessGenerate(generates.NewAccessGenerate())
 	manager.MapClientStorage(newOAuth2ClientStore(cfg.Domain, logger, tracer, dataManager))
 	manager.MapTokenStorage(&oauth2TokenStoreImpl{
 		tracer:      tracer,
-		logger:      logging.EnsureLogger(logger),
+		logger:      logger,
 		dataManager: dataManager,
 	})
 
@@ -45,6 +93,8 @@ func ProvideOAuth2ServerImplementation(
 		},
 		AllowedGrantTypes: []oauth2.GrantType{
 			oauth2.AuthorizationCode,
+			oauth2.PasswordCredentials,
+			oauth2.ClientCredentials,
 			oauth2.Refreshing,
 		},
 		AllowedCodeChallengeMethods: []oauth2.CodeChallengeMethod{
@@ -66,16 +116,9 @@ func ProvideOAuth2ServerImplementation(
 		return sessionCtx.Requester.UserID, nil
 	}
 
-	oauth2Server.PasswordAuthorizationHandler = func(ctx context.Context, clientID, username, password string) (userID string, err error) {
-		user, err := dataManager.GetUserByUsername(ctx, username)
-		if err != nil {
-			return "", errors.New("invalid username or password")
-		}
-
-		// TODO: validate password here, duh
-
-		return user.ID, nil
-	}
+	oauth2Server.PasswordAuthorizationHandler = buildPasswordAuthorizationHandler(logger, tracer, authenticator, dataManager, loginAttemptTracker, dataChangesPublisher)
+	oauth2Server.ClientScopeHandler = buildClientScopeHandler(logger, tracer, dataManager)
+	oauth2Server.RefreshingScopeHandler = refreshingScopeHandler
 
 	// this allows GET requests to retrieve tokens
 	oauth2Server.SetAllowGetAccessRequest(true)
@@ -96,6 +139,14 @@ func ProvideOAuth2ServerImplementation(
 	})
 
 	oauth2Server.SetInternalErrorHandler(func(err error) *errors.Response {
+		var lockedOut *lockedOutError
+		if stderrors.As(err, &lockedOut) {
+			res := errors.NewResponse(errors.ErrInvalidGrant, http.StatusTooManyRequests)
+			res.Description = lockedOut.Error()
+			res.SetHeader(loginattempts.RetryAfterHeader, loginattempts.RetryAfter(lockedOut.lockedUntil))
+			return res
+		}
+
 		observability.AcknowledgeError(err, logger, nil, "internal oauth2 error")
 		return &errors.Response{
 			Error:       err,
@@ -113,3 +164,248 @@ func ProvideOAuth2ServerImplementation(
 
 	return oauth2Server
 }
+
+// buildPasswordAuthorizationHandler validates resource owner credentials the same way the login route does,
+// including its lockouts for users and IP addresses that fail too often.
+func buildPasswordAuthorizationHandler(
+	logger logging.Logger,
+	tracer tracing.Tracer,
+	authenticator authentication.Authenticator,
+	dataManager database.DataManager,
+	loginAttemptTracker loginattempts.Tracker,
+	dataChangesPublisher messagequeue.Publisher,
+) server.PasswordAuthorizationHandler {
+	return func(ctx context.Context, clientID, username, password string) (string, error) {
+		ctx, span := tracer.StartCustomSpan(ctx, "oauth2_server.PasswordAuthorizationHandler")
+		defer span.End()
+
+		logger := logger.WithValue(keys.OAuth2ClientClientIDKey, clientID).WithValue(keys.UsernameKey, username)
+
+		ipAddress, _ := ctx.Value(ipAddressContextKey).(string)
+		if lockedUntil := loginattempts.CheckLockout(ctx, logger, span, loginAttemptTracker, "", ipAddress); !lockedUntil.IsZero() {
+			logger.Info("password grant attempted from locked out IP address")
+			return "", &lockedOutError{lockedUntil: lockedUntil}
+		}
+
+		user, err := dataManager.GetUserByUsername(ctx, strings.TrimSpace(username))
+		if err != nil {
+			if stderrors.Is(err, sql.ErrNoRows) {
+				loginattempts.RecordFailure(ctx, logger, span, loginAttemptTracker, dataManager, dataChangesPublisher, "", ipAddress)
+				return "", errors.ErrInvalidGrant
+			}
+			return "", observability.PrepareAndLogError(err, logger, span, "fetching user for password grant")
+		}
+
+		if lockedUntil := loginattempts.CheckLockout(ctx, logger, span, loginAttemptTracker, user.ID, ""); !lockedUntil.IsZero() {
+			logger.Info("password grant attempted for locked out user")
+			return "", &lockedOutError{lockedUntil: lockedUntil}
+		}
+
+		if user.IsBanned() {
+			logger.Info("banned user attempted password grant")
+			return "", errors.ErrInvalidGrant
+		}
+
+		totpToken, _ := ctx.Value(totpTokenContextKey).(string)
+		if user.TwoFactorSecretVerifiedAt != nil && totpToken == "" {
+			logger.Debug("user with two factor verification active attempted password grant without providing TOTP")
+			return "", errors.ErrInvalidGrant
+		}
+
+		valid, err := authenticator.CredentialsAreValid(ctx, user.HashedPassword, strings.TrimSpace(password), user.TwoFactorSecret, totpToken)
+		if err != nil {
+			if stderrors.Is(err, authentication.ErrPasswordDoesNotMatch) || stderrors.Is(err, authentication.ErrInvalidTOTPToken) {
+				loginattempts.RecordFailure(ctx, logger, span, loginAttemptTracker, dataManager, dataChangesPublisher, user.ID, ipAddress)
+				return "", errors.ErrInvalidGrant
+			}
+			return "", observability.PrepareAndLogError(err, logger, span, "validating password grant credentials")
+		} else if !valid {
+			loginattempts.RecordFailure(ctx, logger, span, loginAttemptTracker, dataManager, dataChangesPublisher, user.ID, ipAddress)
+			return "", errors.ErrInvalidGrant
+		}
+
+		loginattempts.RecordSuccess(ctx, logger, span, loginAttemptTracker, user.ID)
+
+		return user.ID, nil
+	}
+}
+
+// buildClientScopeHandler restricts the scopes a token may carry to those its client was registered with, and
+// attributes client credentials grants to a user. Only household-scoped clients may use that grant, and their
+// tokens act on behalf of the household's owner, though only within the household and never with the owner's
+// service role (see restrictSessionContextDataToOAuth2Token).
+func buildClientScopeHandler(logger logging.Logger, tracer tracing.Tracer, dataManager database.DataManager) server.ClientScopeHandler {
+	return func(tgr *oauth2.TokenGenerateRequest) (bool, error) {
+		ctx, span := tracer.StartCustomSpan(tgr.Request.Context(), "oauth2_server.ClientScopeHandler")
+		defer span.End()
+
+		logger := logger.WithValue(keys.OAuth2ClientClientIDKey, tgr.ClientID)
+
+		client, err := dataManager.GetOAuth2ClientByClientID(ctx, tgr.ClientID)
+		if err != nil {
+			if stderrors.Is(err, sql.ErrNoRows) {
+				return false, errors.ErrInvalidClient
+			}
+			return false, observability.PrepareAndLogError(err, logger, span, "fetching oauth2 client for scope check")
+		}
+
+		scope, ok := narrowOAuth2Scopes(client.Scopes, tgr.Scope)
+		if !ok {
+			logger.WithValue("requested_scope", tgr.Scope).Info("oauth2 client requested scopes it was not granted")
+			return false, nil
+		}
+		tgr.Scope = scope
+
+		// authorization code and password grants arrive here with the user already determined.
+		if tgr.UserID != "" {
+			return true, nil
+		}
+
+		if client.BelongsToHousehold == nil {
+			return false, errors.ErrUnauthorizedClient
+		}
+
+		household, err := dataManager.GetHousehold(ctx, *client.BelongsToHousehold)
+		if err != nil {
+			if stderrors.Is(err, sql.ErrNoRows) {
+				return false, errors.ErrUnauthorizedClient
+			}
+			return false, observability.PrepareAndLogError(err, logger, span, "fetching household for client credentials grant")
+		}
+
+		tgr.UserID = household.BelongsToUser
+
+		return true, nil
+	}
+}
+
+// refreshingScopeHandler ensures a refreshed token carries no scopes beyond those of the token it replaces.
+func refreshingScopeHandler(tgr *oauth2.TokenGenerateRequest, oldScope string) (bool, error) {
+	scope, ok := narrowOAuth2Scopes(authorization.ParseOAuth2Scopes(oldScope), tgr.Scope)
+	if !ok {
+		return false, nil
+	}
+	tgr.Scope = scope
+
+	return true, nil
+}
+
+// narrowOAuth2Scopes returns the requested scopes in wire format if they're all permitted, or every permitted
+// scope if none were requested.
+func narrowOAuth2Scopes(permitted []string, requested string) (string, bool) {
+	requestedScopes := authorization.ParseOAuth2Scopes(requested)
+	if len(requestedScopes) == 0 {
+		return strings.Join(permitted, " "), true
+	}
+
+	narrowed := []string{}
+	for _, scope := range requestedScopes {
+		if !slices.Contains(permitted, scope) {
+			return "", false
+		}
+
+		if !slices.Contains(narrowed, scope) {
+			narrowed = append(narrowed, scope)
+		}
+	}
+
+	return strings.Join(narrowed, " "), true
+}
+
+// validateRedirectURI ensures a redirect URI points at the domain its client registered, or a subdomain thereof.
+func validateRedirectURI(baseURI, redirectURI string) error {
+	base, err := url.Parse(baseURI)
+	if err != nil || base.Host == "" {
+		return errors.ErrInvalidRedirectURI
+	}
+
+	redirect, err := url.Parse(redirectURI)
+	if err != nil || !redirect.IsAbs() || redirect.User != nil || redirect.Fragment != "" {
+		return errors.ErrInvalidRedirectURI
+	}
+
+	if !strings.EqualFold(redirect.Scheme, base.Scheme) {
+		return errors.ErrInvalidRedirectURI
+	}
+
+	redirectHost, baseHost := strings.ToLower(redirect.Host), strings.ToLower(base.Host)
+	if redirectHost != baseHost && !strings.HasSuffix(redirectHost, "."+baseHost) {
+		return errors.ErrInvalidRedirectURI
+	}
+
+	return nil
+}
+
+// authenticateOAuth2Client verifies the credentials an OAuth2 client presented, and returns its client ID.
+func (s *service) authenticateOAuth2Client(ctx context.Context, req *http.Request) (string, error) {
+	if err := req.ParseForm(); err != nil {
+		return "", errors.ErrInvalidRequest
+	}
+
+	clientID, clientSecret, err := s.oauth2Server.ClientInfoHandler(req)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := s.oauth2Server.Manager.GetClient(ctx, clientID)
+	if err != nil {
+		if stderrors.Is(err, sql.ErrNoRows) {
+			return "", errors.ErrInvalidClient
+		}
+		return "", err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(client.GetSecret()), []byte(clientSecret)) != 1 {
+		return "", errors.ErrInvalidClient
+	}
+
+	return clientID, nil
+}
+
+// loadOAuth2Token finds the token info for either an access or refresh token, checking the hinted type first.
+// Unknown and expired tokens yield no token info and no error.
+func (s *service) loadOAuth2Token(ctx context.Context, token, tokenTypeHint string) (tokenInfo oauth2.TokenInfo, isRefresh bool, err error) {
+	loaders := []bool{false, true}
+	if tokenTypeHint == "refresh_token" {
+		loaders = []bool{true, false}
+	}
+
+	for _, refresh := range loaders {
+		if refresh {
+			tokenInfo, err = s.oauth2Server.Manager.LoadRefreshToken(ctx, token)
+		} else {
+			tokenInfo, err = s.oauth2Server.Manager.LoadAccessToken(ctx, token)
+		}
+
+		switch {
+		case err == nil:
+			return tokenInfo, refresh, nil
+		case stderrors.Is(err, sql.ErrNoRows),
+			stderrors.Is(err, errors.ErrInvalidAccessToken),
+			stderrors.Is(err, errors.ErrInvalidRefreshToken),
+			stderrors.Is(err, errors.ErrExpiredAccessToken),
+			stderrors.Is(err, errors.ErrExpiredRefreshToken):
+			continue
+		default:
+			return nil, false, err
+		}
+	}
+
+	return nil, false, nil
+}
+
+// encodeOAuth2Error writes an error in the shape OAuth2 clients expect.
+func (s *service) encodeOAuth2Error(res http.ResponseWriter, err error) {
+	data, statusCode, header := s.oauth2Server.GetErrorData(err)
+	for key := range header {
+		res.Header().Set(key, header.Get(key))
+	}
+
+	res.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	res.Header().Set("Cache-Control", "no-store")
+	res.WriteHeader(statusCode)
+
+	if encodeErr := json.NewEncoder(res).Encode(data); encodeErr != nil {
+		observability.AcknowledgeError(encodeErr, s.logger, nil, "encoding oauth2 error response")
+	}
+}
@@ -22,6 +22,10 @@ func (i *oauth2ClientInfoImpl) GetSecret() string {
 }
 
 func (i *oauth2ClientInfoImpl) GetDomain() string {
+	if i.client.Domain != "" {
+		return i.client.Domain
+	}
+
 	return i.domain
 }
 
@@ -0,0 +1,760 @@
+package authentication
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	mockloginattempts "github.com/dinnerdonebetter/backend/internal/authentication/loginattempts/mock"
+	mockauthn "github.com/dinnerdonebetter/backend/internal/authentication/mock"
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/internal/database"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/go-oauth2/oauth2/v4"
+	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestOAuth2Server(t *testing.T, authenticator authentication.Authenticator, dataManager database.DataManager) *service {
+	t.Helper()
+
+	s := buildTestService(t)
+	s.oauth2Server = ProvideOAuth2ServerImplementation(
+		context.Background(),
+		logging.NewNoopLogger(),
+		tracing.NewTracerForTest(t.Name()),
+		&OAuth2Config{
+			Domain:               "https://dinnerdonebetter.dev",
+			AccessTokenLifespan:  time.Hour,
+			RefreshTokenLifespan: time.Hour,
+		},
+		authenticator,
+		dataManager,
+		loginattempts.NewNoopTracker(),
+		&mockpublishers.Publisher{},
+	)
+
+	return s
+}
+
+func buildTestOAuth2TokenRequest(t *testing.T, client *types.OAuth2Client, token string) *http.Request {
+	t.Helper()
+
+	form := url.Values{
+		"client_id":     {client.ClientID},
+		"client_secret": {client.ClientSecret},
+		"token":         {token},
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, "https://whatever.whocares.gov", strings.NewReader(form.Encode()))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req
+}
+
+func buildTestActiveOAuth2ClientToken(client *types.OAuth2Client) *types.OAuth2ClientToken {
+	token := fakes.BuildFakeOAuth2ClientToken()
+	token.ClientID = client.ClientID
+	token.AccessCreatedAt = time.Now()
+	token.RefreshCreatedAt = time.Now()
+
+	return token
+}
+
+func buildTestOAuth2TokenInfo(client *types.OAuth2Client) oauth2.TokenInfo {
+	token := buildTestActiveOAuth2ClientToken(client)
+	token.Scope = strings.Join(client.Scopes, " ")
+
+	return convertTokenToImpl(token)
+}
+
+func TestProvideOAuth2ServerImplementation(T *testing.T) {
+	T.Parallel()
+
+	T.Run("responds to lockouts with too many requests", func(t *testing.T) {
+		t.Parallel()
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, database.NewMockDatabase())
+
+		data, statusCode, header := s.oauth2Server.GetErrorData(&lockedOutError{lockedUntil: time.Now().Add(time.Minute)})
+		assert.Equal(t, http.StatusTooManyRequests, statusCode)
+		assert.Equal(t, oauth2errors.ErrInvalidGrant.Error(), data["error"])
+		assert.Equal(t, "60", header.Get(loginattempts.RetryAfterHeader))
+	})
+}
+
+func Test_validateRedirectURI(T *testing.T) {
+	T.Parallel()
+
+	testCases := map[string]struct {
+		baseURI     string
+		redirectURI string
+		valid       bool
+	}{
+		"same host":              {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://dinnerdonebetter.dev/callback", valid: true},
+		"subdomain":              {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://app.dinnerdonebetter.dev/callback?x=y", valid: true},
+		"mixed case host":        {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://APP.DinnerDoneBetter.dev/callback", valid: true},
+		"with port":              {baseURI: "http://localhost:9000", redirectURI: "http://localhost:9000/callback", valid: true},
+		"different host":         {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://evil.example/callback", valid: false},
+		"suffix lookalike":       {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://evildinnerdonebetter.dev/callback", valid: false},
+		"host as path":           {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://evil.example/dinnerdonebetter.dev", valid: false},
+		"scheme downgrade":       {baseURI: "https://dinnerdonebetter.dev", redirectURI: "http://dinnerdonebetter.dev/callback", valid: false},
+		"different port":         {baseURI: "http://localhost:9000", redirectURI: "http://localhost:9001/callback", valid: false},
+		"with userinfo":          {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://user@dinnerdonebetter.dev/callback", valid: false},
+		"with fragment":          {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://dinnerdonebetter.dev/callback#token", valid: false},
+		"relative redirect":      {baseURI: "https://dinnerdonebetter.dev", redirectURI: "/callback", valid: false},
+		"base without host":      {baseURI: "dinnerdonebetter.dev", redirectURI: "https://dinnerdonebetter.dev/callback", valid: false},
+		"unparseable redirect":   {baseURI: "https://dinnerdonebetter.dev", redirectURI: "https://dinnerdonebetter.dev/%zz", valid: false},
+		"empty redirect":         {baseURI: "https://dinnerdonebetter.dev", redirectURI: "", valid: false},
+		"protocol relative host": {baseURI: "https://dinnerdonebetter.dev", redirectURI: "//dinnerdonebetter.dev/callback", valid: false},
+	}
+
+	for name, tc := range testCases {
+		T.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRedirectURI(tc.baseURI, tc.redirectURI)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, oauth2errors.ErrInvalidRedirectURI)
+			}
+		})
+	}
+}
+
+func Test_buildPasswordAuthorizationHandler(T *testing.T) {
+	T.Parallel()
+
+	const totpToken = "123456"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), totpTokenContextKey, totpToken)
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On("CredentialsAreValid", testutils.ContextMatcher, exampleUser.HashedPassword, "password", exampleUser.TwoFactorSecret, totpToken).Return(true, nil)
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, loginattempts.NewNoopTracker(), &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.NoError(t, err)
+		assert.Equal(t, exampleUser.ID, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+
+	T.Run("without TOTP token for user with two factor verified", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+
+		authenticator := &mockauthn.Authenticator{}
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, loginattempts.NewNoopTracker(), &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidGrant)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+
+	T.Run("with invalid password", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), totpTokenContextKey, totpToken)
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On("CredentialsAreValid", testutils.ContextMatcher, exampleUser.HashedPassword, "password", exampleUser.TwoFactorSecret, totpToken).Return(false, authentication.ErrPasswordDoesNotMatch)
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, loginattempts.NewNoopTracker(), &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidGrant)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+
+	T.Run("with invalid TOTP token", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), totpTokenContextKey, totpToken)
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On("CredentialsAreValid", testutils.ContextMatcher, exampleUser.HashedPassword, "password", exampleUser.TwoFactorSecret, totpToken).Return(false, authentication.ErrInvalidTOTPToken)
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, loginattempts.NewNoopTracker(), &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidGrant)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+
+	T.Run("with banned user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), totpTokenContextKey, totpToken)
+		exampleUser := fakes.BuildFakeUser()
+		exampleUser.AccountStatus = string(types.BannedUserAccountStatus)
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+
+		authenticator := &mockauthn.Authenticator{}
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, loginattempts.NewNoopTracker(), &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidGrant)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+
+	T.Run("with nonexistent user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return((*types.User)(nil), sql.ErrNoRows)
+
+		authenticator := &mockauthn.Authenticator{}
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, loginattempts.NewNoopTracker(), &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidGrant)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+	T.Run("with locked out IP address", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), ipAddressContextKey, "127.0.0.1")
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		authenticator := &mockauthn.Authenticator{}
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On("LockedOutUntil", testutils.ContextMatcher, "", "127.0.0.1").Return(time.Now().Add(time.Minute), nil)
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, tracker, &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		var lockedOut *lockedOutError
+		assert.ErrorAs(t, err, &lockedOut)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, tracker)
+	})
+
+	T.Run("with locked out user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), ipAddressContextKey, "127.0.0.1")
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+
+		authenticator := &mockauthn.Authenticator{}
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On("LockedOutUntil", testutils.ContextMatcher, "", "127.0.0.1").Return(time.Time{}, nil)
+		tracker.On("LockedOutUntil", testutils.ContextMatcher, exampleUser.ID, "").Return(time.Now().Add(time.Minute), nil)
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, tracker, &mockpublishers.Publisher{})
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		var lockedOut *lockedOutError
+		assert.ErrorAs(t, err, &lockedOut)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, tracker)
+	})
+
+	T.Run("with invalid password triggering lockout", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.WithValue(context.Background(), totpTokenContextKey, totpToken), ipAddressContextKey, "127.0.0.1")
+		exampleUser := fakes.BuildFakeUser()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		userDataManager := database.NewMockDatabase()
+		userDataManager.UserDataManagerMock.On("GetUserByUsername", testutils.ContextMatcher, exampleUser.Username).Return(exampleUser, nil)
+		userDataManager.AuditLogEntryDataManagerMock.On("CreateAuditLogEntry", testutils.ContextMatcher, mock.MatchedBy(func(input *types.AuditLogEntryDatabaseCreationInput) bool {
+			return input.RelevantID == exampleUser.ID
+		})).Return(fakes.BuildFakeAuditLogEntry(), nil)
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On("CredentialsAreValid", testutils.ContextMatcher, exampleUser.HashedPassword, "password", exampleUser.TwoFactorSecret, totpToken).Return(false, authentication.ErrPasswordDoesNotMatch)
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On("LockedOutUntil", testutils.ContextMatcher, "", "127.0.0.1").Return(time.Time{}, nil)
+		tracker.On("LockedOutUntil", testutils.ContextMatcher, exampleUser.ID, "").Return(time.Time{}, nil)
+		tracker.On("RecordFailure", testutils.ContextMatcher, exampleUser.ID, "127.0.0.1").Return([]*loginattempts.Lockout{
+			{
+				LockedUntil: time.Now().Add(time.Minute),
+				SubjectType: loginattempts.SubjectTypeUser,
+				Subject:     exampleUser.ID,
+				Count:       1,
+			},
+		}, nil)
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On("Publish", testutils.ContextMatcher, mock.MatchedBy(func(message *types.DataChangeMessage) bool {
+			return message.EventType == types.LoginLockoutTriggeredEventType
+		})).Return(nil)
+
+		handler := buildPasswordAuthorizationHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), authenticator, userDataManager, tracker, dataChangesPublisher)
+
+		userID, err := handler(ctx, exampleClient.ClientID, exampleUser.Username, "password")
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidGrant)
+		assert.Empty(t, userID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, tracker, dataChangesPublisher)
+	})
+}
+
+func Test_buildClientScopeHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with household scoped client", func(t *testing.T) {
+		t.Parallel()
+
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleClient.BelongsToHousehold = &exampleHousehold.ID
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, exampleHousehold.ID).Return(exampleHousehold, nil)
+
+		handler := buildClientScopeHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), dataManager)
+
+		tgr := &oauth2.TokenGenerateRequest{
+			ClientID: exampleClient.ClientID,
+			Request:  httptest.NewRequest(http.MethodPost, "/oauth2/token", http.NoBody),
+		}
+
+		allowed, err := handler(tgr)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, exampleHousehold.BelongsToUser, tgr.UserID)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with user already attributed", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := buildClientScopeHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), dataManager)
+
+		tgr := &oauth2.TokenGenerateRequest{
+			ClientID: exampleClient.ClientID,
+			UserID:   fakes.BuildFakeID(),
+			Scope:    exampleClient.Scopes[0],
+			Request:  httptest.NewRequest(http.MethodPost, "/oauth2/token", http.NoBody),
+		}
+
+		allowed, err := handler(tgr)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, exampleClient.Scopes[0], tgr.Scope)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("without requested scopes", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := buildClientScopeHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), dataManager)
+
+		tgr := &oauth2.TokenGenerateRequest{
+			ClientID: exampleClient.ClientID,
+			UserID:   fakes.BuildFakeID(),
+			Request:  httptest.NewRequest(http.MethodPost, "/oauth2/token", http.NoBody),
+		}
+
+		allowed, err := handler(tgr)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, strings.Join(exampleClient.Scopes, " "), tgr.Scope)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with scopes not granted to client", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := buildClientScopeHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), dataManager)
+
+		tgr := &oauth2.TokenGenerateRequest{
+			ClientID: exampleClient.ClientID,
+			UserID:   fakes.BuildFakeID(),
+			Scope:    string(authorization.ServiceAdminOAuth2Scope),
+			Request:  httptest.NewRequest(http.MethodPost, "/oauth2/token", http.NoBody),
+		}
+
+		allowed, err := handler(tgr)
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with client not scoped to a household", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := buildClientScopeHandler(logging.NewNoopLogger(), tracing.NewTracerForTest(t.Name()), dataManager)
+
+		tgr := &oauth2.TokenGenerateRequest{
+			ClientID: exampleClient.ClientID,
+			Request:  httptest.NewRequest(http.MethodPost, "/oauth2/token", http.NoBody),
+		}
+
+		allowed, err := handler(tgr)
+		assert.ErrorIs(t, err, oauth2errors.ErrUnauthorizedClient)
+		assert.False(t, allowed)
+		assert.Empty(t, tgr.UserID)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
+
+func Test_refreshingScopeHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with narrower scope", func(t *testing.T) {
+		t.Parallel()
+
+		tgr := &oauth2.TokenGenerateRequest{Scope: "recipes:read"}
+
+		allowed, err := refreshingScopeHandler(tgr, "recipes:read meal_plans:read")
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, "recipes:read", tgr.Scope)
+	})
+
+	T.Run("with broader scope", func(t *testing.T) {
+		t.Parallel()
+
+		tgr := &oauth2.TokenGenerateRequest{Scope: "recipes:read recipes:write"}
+
+		allowed, err := refreshingScopeHandler(tgr, "recipes:read")
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+	})
+}
+
+func Test_narrowOAuth2Scopes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		scope, ok := narrowOAuth2Scopes([]string{"recipes:read", "meal_plans:read"}, "meal_plans:read meal_plans:read")
+		assert.True(t, ok)
+		assert.Equal(t, "meal_plans:read", scope)
+	})
+
+	T.Run("without requested scopes", func(t *testing.T) {
+		t.Parallel()
+
+		scope, ok := narrowOAuth2Scopes([]string{"recipes:read", "meal_plans:read"}, "")
+		assert.True(t, ok)
+		assert.Equal(t, "recipes:read meal_plans:read", scope)
+	})
+
+	T.Run("with unpermitted scope", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := narrowOAuth2Scopes([]string{"recipes:read"}, "recipes:read webhooks:write")
+		assert.False(t, ok)
+	})
+}
+
+func TestAuthenticationService_RevokeHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(exampleClient)
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return(exampleToken, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("ArchiveOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return(nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.RevokeHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with refresh token hint", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(exampleClient)
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByRefresh", testutils.ContextMatcher, exampleToken.Refresh).Return(exampleToken, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("ArchiveOAuth2ClientTokenByRefresh", testutils.ContextMatcher, exampleToken.Refresh).Return(nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		req := buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Refresh)
+		req.URL.RawQuery = url.Values{"token_type_hint": {"refresh_token"}}.Encode()
+
+		s.RevokeHandler(res, req)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(exampleClient)
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return((*types.OAuth2ClientToken)(nil), sql.ErrNoRows)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByRefresh", testutils.ContextMatcher, exampleToken.Access).Return((*types.OAuth2ClientToken)(nil), sql.ErrNoRows)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.RevokeHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with token belonging to another client", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(fakes.BuildFakeOAuth2Client())
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return(exampleToken, nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.RevokeHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with invalid client secret", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(exampleClient)
+
+		storedClient := *exampleClient
+		storedClient.ClientSecret = "something else entirely"
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(&storedClient, nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.RevokeHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("without token", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.RevokeHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, ""))
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
+
+func TestAuthenticationService_IntrospectHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(exampleClient)
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return(exampleToken, nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.IntrospectHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusOK, res.Code)
+		assert.Equal(t, "no-store", res.Header().Get("Cache-Control"))
+
+		var actual *types.OAuth2TokenIntrospectionResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&actual))
+		assert.True(t, actual.Active)
+		assert.Equal(t, exampleClient.ClientID, actual.ClientID)
+		assert.Equal(t, exampleToken.BelongsToUser, actual.Subject)
+		assert.Equal(t, "access_token", actual.TokenType)
+		assert.Equal(t, exampleToken.AccessCreatedAt.Add(exampleToken.AccessExpiresAt).Unix(), actual.ExpiresAt)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with token belonging to another client", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(fakes.BuildFakeOAuth2Client())
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return(exampleToken, nil)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.IntrospectHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var actual *types.OAuth2TokenIntrospectionResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&actual))
+		assert.False(t, actual.Active)
+		assert.Empty(t, actual.ClientID)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with expired token", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := fakes.BuildFakeOAuth2Client()
+		exampleToken := buildTestActiveOAuth2ClientToken(exampleClient)
+		exampleToken.AccessCreatedAt = time.Now().Add(-2 * time.Hour)
+		exampleToken.RefreshCreatedAt = time.Now().Add(-2 * time.Hour)
+
+		dataManager := database.NewMockDatabase()
+		dataManager.OAuth2ClientDataManagerMock.On("GetOAuth2ClientByClientID", testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByAccess", testutils.ContextMatcher, exampleToken.Access).Return(exampleToken, nil)
+		dataManager.OAuth2ClientTokenDataManagerMock.On("GetOAuth2ClientTokenByRefresh", testutils.ContextMatcher, exampleToken.Access).Return((*types.OAuth2ClientToken)(nil), sql.ErrNoRows)
+
+		s := buildTestOAuth2Server(t, &mockauthn.Authenticator{}, dataManager)
+		res := httptest.NewRecorder()
+
+		s.IntrospectHandler(res, buildTestOAuth2TokenRequest(t, exampleClient, exampleToken.Access))
+
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		var actual *types.OAuth2TokenIntrospectionResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&actual))
+		assert.False(t, actual.Active)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
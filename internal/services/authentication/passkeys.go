@@ -0,0 +1,725 @@
+package authentication
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	servertiming "github.com/mitchellh/go-server-timing"
+)
+
+const (
+	// PasskeyIDURIParamKey is a standard string that we'll use to refer to passkey IDs with.
+	PasskeyIDURIParamKey = "passkeyID"
+
+	passkeyRegistrationCookieName = "ddb_passkey_registration"
+	passkeyLoginCookieName        = "ddb_passkey_login"
+
+	defaultPasskeyCeremonyTimeout = 5 * time.Minute
+)
+
+var (
+	// errInvalidPasskeyAssertion indicates a passkey assertion could not be used to log in.
+	errInvalidPasskeyAssertion = errors.New("invalid passkey assertion")
+
+	credentialIDEncoding = base64.RawURLEncoding
+)
+
+// provideWebAuthn builds the WebAuthn relying party from our config, or returns nil if passkeys aren't configured.
+func provideWebAuthn(cfg *PasskeyConfig) (*webauthn.WebAuthn, error) {
+	if cfg == nil || cfg.RelyingPartyID == "" {
+		return nil, nil
+	}
+
+	timeout := cfg.CeremonyTimeout
+	if timeout == 0 {
+		timeout = defaultPasskeyCeremonyTimeout
+	}
+
+	return webauthn.New(&webauthn.Config{
+		RPID:          cfg.RelyingPartyID,
+		RPDisplayName: cfg.RelyingPartyDisplayName,
+		RPOrigins:     cfg.RelyingPartyOrigins,
+		// passkeys stand in for both the password and TOTP, so possessing the authenticator mustn't be enough on its own.
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			UserVerification: protocol.VerificationRequired,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        webauthn.TimeoutConfig{Enforce: true, Timeout: timeout},
+			Registration: webauthn.TimeoutConfig{Enforce: true, Timeout: timeout},
+		},
+	})
+}
+
+// passkeyUser adapts a user and their passkeys to what the WebAuthn library expects.
+type passkeyUser struct {
+	user     *types.User
+	passkeys []*types.UserPasskey
+}
+
+var _ webauthn.User = (*passkeyUser)(nil)
+
+func (u *passkeyUser) WebAuthnID() []byte {
+	return []byte(u.user.ID)
+}
+
+func (u *passkeyUser) WebAuthnName() string {
+	return u.user.Username
+}
+
+func (u *passkeyUser) WebAuthnDisplayName() string {
+	if name := strings.TrimSpace(fmt.Sprintf("%s %s", u.user.FirstName, u.user.LastName)); name != "" {
+		return name
+	}
+
+	return u.user.Username
+}
+
+func (u *passkeyUser) WebAuthnIcon() string {
+	return ""
+}
+
+func (u *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := []webauthn.Credential{}
+	for _, passkey := range u.passkeys {
+		credentialID, err := credentialIDEncoding.DecodeString(passkey.CredentialID)
+		if err != nil {
+			continue
+		}
+
+		transports := []protocol.AuthenticatorTransport{}
+		for _, transport := range passkey.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(transport))
+		}
+
+		credentials = append(credentials, webauthn.Credential{
+			ID:              credentialID,
+			PublicKey:       passkey.PublicKey,
+			AttestationType: passkey.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				UserPresent:    true,
+				BackupEligible: passkey.BackupEligible,
+				BackupState:    passkey.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    passkey.AAGUID,
+				SignCount: passkey.SignCount,
+			},
+		})
+	}
+
+	return credentials
+}
+
+// passkeyWithCredentialID finds the passkey matching a credential ID.
+func (u *passkeyUser) passkeyWithCredentialID(credentialID []byte) *types.UserPasskey {
+	encoded := credentialIDEncoding.EncodeToString(credentialID)
+	for _, passkey := range u.passkeys {
+		if passkey.CredentialID == encoded {
+			return passkey
+		}
+	}
+
+	return nil
+}
+
+// fetchPasskeyUser fetches a user and their passkeys.
+func (s *service) fetchPasskeyUser(ctx context.Context, userID string) (*passkeyUser, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	user, err := s.userDataManager.GetUser(ctx, userID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching user")
+	}
+
+	return s.buildPasskeyUser(ctx, user)
+}
+
+// buildPasskeyUser fetches the passkeys of an already fetched user.
+func (s *service) buildPasskeyUser(ctx context.Context, user *types.User) (*passkeyUser, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	passkeys, err := s.userPasskeyDataManager.GetUserPasskeysForUser(ctx, user.ID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching passkeys for user")
+	}
+
+	return &passkeyUser{user: user, passkeys: passkeys}, nil
+}
+
+// startPasskeyCeremony stores the state of an in-progress WebAuthn ceremony, and sets a cookie that refers to it.
+func (s *service) startPasskeyCeremony(ctx context.Context, req *http.Request, res http.ResponseWriter, name string, sessionData *webauthn.SessionData) error {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	rawSessionData, err := json.Marshal(sessionData)
+	if err != nil {
+		return observability.PrepareError(err, span, "encoding ceremony session data")
+	}
+
+	expiresAt := sessionData.Expires
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(defaultPasskeyCeremonyTimeout)
+	}
+
+	// the challenge stays on our side, so the cookie only carries a reference to it.
+	ceremonyID := identifiers.New()
+	if err = s.passkeyCeremonyDataManager.CreatePasskeyCeremony(ctx, &types.PasskeyCeremonyDatabaseCreationInput{
+		ExpiresAt:   expiresAt,
+		ID:          ceremonyID,
+		SessionData: rawSessionData,
+	}); err != nil {
+		return observability.PrepareError(err, span, "storing ceremony")
+	}
+
+	encoded, err := s.cookieManager.Encode(name, ceremonyID)
+	if err != nil {
+		return observability.PrepareError(err, span, "encoding ceremony cookie")
+	}
+
+	http.SetCookie(res, &http.Cookie{
+		Name:     name,
+		Value:    encoded,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   s.config.Cookies.SecureOnly,
+		Domain:   s.determineCookieDomain(ctx, req),
+		Expires:  expiresAt,
+		SameSite: http.SameSiteNoneMode,
+		MaxAge:   int(time.Until(expiresAt).Seconds()),
+	})
+
+	return nil
+}
+
+// consumePasskeyCeremony fetches the state of an in-progress WebAuthn ceremony and deletes it, since ceremonies are single-use.
+func (s *service) consumePasskeyCeremony(ctx context.Context, req *http.Request, res http.ResponseWriter, name string) (*webauthn.SessionData, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	cookie, err := req.Cookie(name)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching ceremony cookie")
+	}
+
+	http.SetCookie(res, &http.Cookie{
+		Name:     name,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   s.config.Cookies.SecureOnly,
+		Domain:   s.determineCookieDomain(ctx, req),
+		SameSite: http.SameSiteNoneMode,
+		MaxAge:   -1,
+	})
+
+	var ceremonyID string
+	if err = s.cookieManager.Decode(name, cookie.Value, &ceremonyID); err != nil {
+		return nil, observability.PrepareError(err, span, "decoding ceremony cookie")
+	}
+	tracing.AttachToSpan(span, keys.PasskeyCeremonyIDKey, ceremonyID)
+
+	// deleting the ceremony as we read it means a replayed cookie finds nothing.
+	rawSessionData, err := s.passkeyCeremonyDataManager.ConsumePasskeyCeremony(ctx, ceremonyID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching ceremony")
+	}
+
+	sessionData := &webauthn.SessionData{}
+	if err = json.Unmarshal(rawSessionData, sessionData); err != nil {
+		return nil, observability.PrepareError(err, span, "decoding ceremony session data")
+	}
+
+	return sessionData, nil
+}
+
+// validatePasskeyAssertion finishes a WebAuthn login ceremony and returns the user it authenticates.
+func (s *service) validatePasskeyAssertion(ctx context.Context, req *http.Request, res http.ResponseWriter, assertion json.RawMessage) (*types.User, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if s.webAuthn == nil {
+		return nil, errInvalidPasskeyAssertion
+	}
+
+	sessionData, err := s.consumePasskeyCeremony(ctx, req, res, passkeyLoginCookieName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidPasskeyAssertion, err)
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBody(bytes.NewReader(assertion))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidPasskeyAssertion, err)
+	}
+
+	var (
+		pu       *passkeyUser
+		fetchErr error
+	)
+	credential, err := s.webAuthn.ValidateDiscoverableLogin(func(_, userHandle []byte) (webauthn.User, error) {
+		pu, fetchErr = s.fetchPasskeyUser(ctx, string(userHandle))
+		return pu, fetchErr
+	}, *sessionData, parsed)
+	if fetchErr != nil && !errors.Is(fetchErr, sql.ErrNoRows) {
+		return nil, observability.PrepareError(fetchErr, span, "fetching passkey user")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidPasskeyAssertion, err)
+	}
+
+	if credential.Authenticator.CloneWarning {
+		return nil, fmt.Errorf("%w: authenticator may have been cloned", errInvalidPasskeyAssertion)
+	}
+
+	passkey := pu.passkeyWithCredentialID(credential.ID)
+	if passkey == nil {
+		return nil, fmt.Errorf("%w: unknown credential", errInvalidPasskeyAssertion)
+	}
+	tracing.AttachToSpan(span, keys.UserPasskeyIDKey, passkey.ID)
+
+	if err = s.userPasskeyDataManager.MarkUserPasskeyAsUsed(ctx, passkey.ID, credential.Authenticator.SignCount, credential.Flags.BackupState); err != nil {
+		return nil, observability.PrepareError(err, span, "marking passkey as used")
+	}
+
+	return pu.user, nil
+}
+
+// BeginPasskeyRegistrationHandler starts a WebAuthn registration ceremony for the requester, once they've re-entered their credentials.
+func (s *service) BeginPasskeyRegistrationHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	if s.webAuthn == nil {
+		errRes := types.NewAPIErrorResponse("passkeys are not enabled", types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotImplemented)
+		return
+	}
+
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	input := new(types.PasskeyRegistrationBeginRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request body")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = input.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	user, httpStatus := s.validateCredentialsForUpdateRequest(ctx, sessionCtxData.Requester.UserID, input.CurrentPassword, input.TOTPToken)
+	if httpStatus != http.StatusOK {
+		errRes := types.NewAPIErrorResponse("invalid credentials", types.ErrUserIsNotAuthorized, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, httpStatus)
+		return
+	}
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	pu, err := s.buildPasskeyUser(ctx, user)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching passkey user")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	creation, sessionData, err := s.webAuthn.BeginRegistration(
+		pu,
+		webauthn.WithExclusions(pu.credentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "beginning passkey registration")
+		errRes := types.NewAPIErrorResponse(staticError, types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	s.respondWithPasskeyCeremonyOptions(ctx, req, res, passkeyRegistrationCookieName, creation.Response, sessionData, responseDetails)
+}
+
+// credentialDescriptors returns descriptors for the user's existing passkeys, so authenticators don't register twice.
+func (u *passkeyUser) credentialDescriptors() []protocol.CredentialDescriptor {
+	descriptors := []protocol.CredentialDescriptor{}
+	for _, credential := range u.WebAuthnCredentials() {
+		descriptors = append(descriptors, credential.Descriptor())
+	}
+
+	return descriptors
+}
+
+// respondWithPasskeyCeremonyOptions stores ceremony state and sends the ceremony options to the browser.
+func (s *service) respondWithPasskeyCeremonyOptions(ctx context.Context, req *http.Request, res http.ResponseWriter, cookieName string, options any, sessionData *webauthn.SessionData, responseDetails types.ResponseDetails) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span)
+
+	publicKey, err := json.Marshal(options)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "encoding ceremony options")
+		errRes := types.NewAPIErrorResponse(staticError, types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	if err = s.startPasskeyCeremony(ctx, req, res, cookieName, sessionData); err != nil {
+		observability.AcknowledgeError(err, logger, span, "starting ceremony")
+		errRes := types.NewAPIErrorResponse(staticError, types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	responseValue := &types.APIResponse[*types.PasskeyCeremonyOptions]{
+		Details: responseDetails,
+		Data:    &types.PasskeyCeremonyOptions{PublicKey: publicKey},
+	}
+
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// FinishPasskeyRegistrationHandler verifies the requester's credentials and the authenticator's response to a registration
+// ceremony, and stores the new passkey.
+func (s *service) FinishPasskeyRegistrationHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	if s.webAuthn == nil {
+		errRes := types.NewAPIErrorResponse("passkeys are not enabled", types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotImplemented)
+		return
+	}
+
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	input := new(types.PasskeyRegistrationRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request body")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	input.Name = strings.TrimSpace(input.Name)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	sessionData, err := s.consumePasskeyCeremony(ctx, req, res, passkeyRegistrationCookieName)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching registration ceremony")
+		errRes := types.NewAPIErrorResponse("no registration in progress", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBody(bytes.NewReader(input.Credential))
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "parsing passkey credential")
+		errRes := types.NewAPIErrorResponse("invalid passkey credential", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	user, httpStatus := s.validateCredentialsForUpdateRequest(ctx, sessionCtxData.Requester.UserID, input.CurrentPassword, input.TOTPToken)
+	if httpStatus != http.StatusOK {
+		errRes := types.NewAPIErrorResponse("invalid credentials", types.ErrUserIsNotAuthorized, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, httpStatus)
+		return
+	}
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	pu, err := s.buildPasskeyUser(ctx, user)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching passkey user")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	credential, err := s.webAuthn.CreateCredential(pu, *sessionData, parsed)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "verifying passkey credential")
+		errRes := types.NewAPIErrorResponse("invalid passkey credential", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	transports := []string{}
+	for _, transport := range credential.Transport {
+		transports = append(transports, string(transport))
+	}
+
+	dbInput := &types.UserPasskeyDatabaseCreationInput{
+		ID:              identifiers.New(),
+		Name:            input.Name,
+		CredentialID:    credentialIDEncoding.EncodeToString(credential.ID),
+		AttestationType: credential.AttestationType,
+		BelongsToUser:   pu.user.ID,
+		PublicKey:       credential.PublicKey,
+		AAGUID:          credential.Authenticator.AAGUID,
+		Transports:      transports,
+		SignCount:       credential.Authenticator.SignCount,
+		BackupEligible:  credential.Flags.BackupEligible,
+		BackupState:     credential.Flags.BackupState,
+	}
+
+	createTimer := timing.NewMetric("database").WithDesc("create").Start()
+	var passkey *types.UserPasskey
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if passkey, err = s.userPasskeyDataManager.CreateUserPasskey(ctx, dbInput); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.PasskeyRegisteredCustomerEventType,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      pu.user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "creating passkey")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	createTimer.Stop()
+
+	tracing.AttachToSpan(span, keys.UserPasskeyIDKey, passkey.ID)
+	logger = logger.WithValue(keys.UserPasskeyIDKey, passkey.ID)
+
+	responseValue := &types.APIResponse[*types.UserPasskey]{
+		Details: responseDetails,
+		Data:    passkey,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
+// BeginPasskeyLoginHandler starts a WebAuthn login ceremony. The assertion is submitted to the login route.
+func (s *service) BeginPasskeyLoginHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	if s.webAuthn == nil {
+		errRes := types.NewAPIErrorResponse("passkeys are not enabled", types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotImplemented)
+		return
+	}
+
+	assertion, sessionData, err := s.webAuthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "beginning passkey login")
+		errRes := types.NewAPIErrorResponse(staticError, types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	s.respondWithPasskeyCeremonyOptions(ctx, req, res, passkeyLoginCookieName, assertion.Response, sessionData, responseDetails)
+}
+
+// ListPasskeysHandler lists the requester's passkeys.
+func (s *service) ListPasskeysHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	passkeys, err := s.userPasskeyDataManager.GetUserPasskeysForUser(ctx, sessionCtxData.Requester.UserID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching passkeys")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	responseValue := &types.APIResponse[[]*types.UserPasskey]{
+		Details: responseDetails,
+		Data:    passkeys,
+	}
+
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ArchivePasskeyHandler archives one of the requester's passkeys, once they've re-entered their credentials.
+func (s *service) ArchivePasskeyHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	passkeyID := s.passkeyIDFetcher(req)
+	tracing.AttachToSpan(span, keys.UserPasskeyIDKey, passkeyID)
+	logger = logger.WithValue(keys.UserPasskeyIDKey, passkeyID)
+
+	input := new(types.PasskeyArchiveRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request body")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = input.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if _, httpStatus := s.validateCredentialsForUpdateRequest(ctx, sessionCtxData.Requester.UserID, input.CurrentPassword, input.TOTPToken); httpStatus != http.StatusOK {
+		errRes := types.NewAPIErrorResponse("invalid credentials", types.ErrUserIsNotAuthorized, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, httpStatus)
+		return
+	}
+
+	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userPasskeyDataManager.ArchiveUserPasskey(ctx, sessionCtxData.Requester.UserID, passkeyID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.PasskeyArchivedCustomerEventType,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "archiving passkey")
+		if errors.Is(err, sql.ErrNoRows) {
+			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+			return
+		}
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	archiveTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.UserPasskey]{
+		Details: responseDetails,
+	}
+
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
@@ -0,0 +1,1215 @@
+package authentication
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	mockloginattempts "github.com/dinnerdonebetter/backend/internal/authentication/loginattempts/mock"
+	mockauthn "github.com/dinnerdonebetter/backend/internal/authentication/mock"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	examplePasskeyRelyingPartyID = "whocares.gov"
+	examplePasskeyOrigin         = "https://whocares.gov"
+)
+
+// softwareAuthenticator is a bare-bones WebAuthn authenticator for exercising our ceremonies end to end.
+type softwareAuthenticator struct {
+	privateKey   *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+	// presenceOnly has the authenticator report a touch without verifying the user, like a security key without a PIN.
+	presenceOnly bool
+}
+
+func newSoftwareAuthenticator(t *testing.T) *softwareAuthenticator {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	credentialID := make([]byte, 32)
+	_, err = rand.Read(credentialID)
+	require.NoError(t, err)
+
+	return &softwareAuthenticator{
+		privateKey:   privateKey,
+		credentialID: credentialID,
+	}
+}
+
+func (a *softwareAuthenticator) publicKey(t *testing.T) []byte {
+	t.Helper()
+
+	publicKey, err := webauthncbor.Marshal(&webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.privateKey.X.FillBytes(make([]byte, 32)),
+		YCoord: a.privateKey.Y.FillBytes(make([]byte, 32)),
+	})
+	require.NoError(t, err)
+
+	return publicKey
+}
+
+func (a *softwareAuthenticator) authenticatorData(t *testing.T, attested bool) []byte {
+	t.Helper()
+
+	// user present, user verified, backup eligible, backed up.
+	flags := byte(0x01 | 0x04 | 0x08 | 0x10)
+	if a.presenceOnly {
+		flags &^= 0x04
+	}
+	if attested {
+		flags |= 0x40
+	}
+
+	rpIDHash := sha256.Sum256([]byte(examplePasskeyRelyingPartyID))
+	authData := append([]byte{}, rpIDHash[:]...)
+	authData = append(authData, flags)
+	authData = binary.BigEndian.AppendUint32(authData, a.signCount)
+
+	if attested {
+		authData = append(authData, make([]byte, 16)...)
+		authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.credentialID)))
+		authData = append(authData, a.credentialID...)
+		authData = append(authData, a.publicKey(t)...)
+	}
+
+	return authData
+}
+
+func buildClientDataJSON(t *testing.T, ceremonyType string, options *types.PasskeyCeremonyOptions) []byte {
+	t.Helper()
+
+	var publicKey struct {
+		Challenge string `json:"challenge"`
+	}
+	require.NoError(t, json.Unmarshal(options.PublicKey, &publicKey))
+	require.NotEmpty(t, publicKey.Challenge)
+
+	clientDataJSON, err := json.Marshal(map[string]string{
+		"type":      ceremonyType,
+		"challenge": publicKey.Challenge,
+		"origin":    examplePasskeyOrigin,
+	})
+	require.NoError(t, err)
+
+	return clientDataJSON
+}
+
+func (a *softwareAuthenticator) register(t *testing.T, options *types.PasskeyCeremonyOptions) json.RawMessage {
+	t.Helper()
+
+	attestationObject, err := webauthncbor.Marshal(&struct {
+		Format    string         `cbor:"fmt"`
+		Statement map[string]any `cbor:"attStmt"`
+		AuthData  []byte         `cbor:"authData"`
+	}{
+		Format:    "none",
+		Statement: map[string]any{},
+		AuthData:  a.authenticatorData(t, true),
+	})
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(map[string]any{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(buildClientDataJSON(t, "webauthn.create", options)),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestationObject),
+			"transports":        []string{"internal"},
+		},
+	})
+	require.NoError(t, err)
+
+	return encoded
+}
+
+func (a *softwareAuthenticator) assert(t *testing.T, options *types.PasskeyCeremonyOptions, userID string) json.RawMessage {
+	t.Helper()
+
+	a.signCount++
+	authData := a.authenticatorData(t, false)
+	clientDataJSON := buildClientDataJSON(t, "webauthn.get", options)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.privateKey, digest[:])
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(map[string]any{
+		"id":    base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"signature":         base64.RawURLEncoding.EncodeToString(signature),
+			"userHandle":        base64.RawURLEncoding.EncodeToString([]byte(userID)),
+		},
+	})
+	require.NoError(t, err)
+
+	return encoded
+}
+
+// passkey returns the stored form of the authenticator's credential.
+func (a *softwareAuthenticator) passkey(t *testing.T, userID string) *types.UserPasskey {
+	t.Helper()
+
+	passkey := fakes.BuildFakeUserPasskey()
+	passkey.BelongsToUser = userID
+	passkey.CredentialID = credentialIDEncoding.EncodeToString(a.credentialID)
+	passkey.PublicKey = a.publicKey(t)
+	passkey.Transports = []string{"internal"}
+	passkey.SignCount = a.signCount
+	passkey.BackupEligible = true
+	passkey.BackupState = true
+
+	return passkey
+}
+
+func enablePasskeysForTest(t *testing.T, helper *authServiceHTTPRoutesTestHelper) {
+	t.Helper()
+
+	helper.service.config.Passkeys = PasskeyConfig{
+		RelyingPartyID:          examplePasskeyRelyingPartyID,
+		RelyingPartyDisplayName: "Dinner Done Better",
+		RelyingPartyOrigins:     []string{examplePasskeyOrigin},
+	}
+
+	webAuthn, err := provideWebAuthn(&helper.service.config.Passkeys)
+	require.NoError(t, err)
+	helper.service.webAuthn = webAuthn
+	helper.service.passkeyCeremonyDataManager = newPasskeyCeremonyStoreForTest()
+}
+
+// passkeyCeremonyStoreForTest keeps ceremonies in memory, so ceremonies can be run end to end without a database.
+type passkeyCeremonyStoreForTest struct {
+	ceremonies map[string][]byte
+	mu         sync.Mutex
+}
+
+func newPasskeyCeremonyStoreForTest() *passkeyCeremonyStoreForTest {
+	return &passkeyCeremonyStoreForTest{ceremonies: map[string][]byte{}}
+}
+
+func (s *passkeyCeremonyStoreForTest) CreatePasskeyCeremony(_ context.Context, input *types.PasskeyCeremonyDatabaseCreationInput) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ceremonies[input.ID] = input.SessionData
+
+	return nil
+}
+
+func (s *passkeyCeremonyStoreForTest) ConsumePasskeyCeremony(_ context.Context, ceremonyID string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessionData, ok := s.ceremonies[ceremonyID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	delete(s.ceremonies, ceremonyID)
+
+	return sessionData, nil
+}
+
+// expectCredentialsForTest has the service's authenticator accept the example user's credentials.
+func expectCredentialsForTest(t *testing.T, helper *authServiceHTTPRoutesTestHelper) *mockauthn.Authenticator {
+	t.Helper()
+
+	authenticator := &mockauthn.Authenticator{}
+	authenticator.On(
+		"CredentialsAreValid",
+		testutils.ContextMatcher,
+		helper.exampleUser.HashedPassword,
+		helper.exampleLoginInput.Password,
+		helper.exampleUser.TwoFactorSecret,
+		helper.exampleLoginInput.TOTPToken,
+	).Return(true, nil)
+	helper.service.authenticator = authenticator
+
+	return authenticator
+}
+
+// setRequestBodyForTest replaces the helper's request with one carrying the provided input.
+func setRequestBodyForTest(t *testing.T, helper *authServiceHTTPRoutesTestHelper, input any) {
+	t.Helper()
+
+	var err error
+	helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+	require.NoError(t, err)
+}
+
+// beginCeremonyForTest runs a begin handler with the example user's credentials and returns its options along with
+// the ceremony cookie it set.
+func beginCeremonyForTest(t *testing.T, helper *authServiceHTTPRoutesTestHelper, handler http.HandlerFunc, cookieName string) (*types.PasskeyCeremonyOptions, *http.Cookie) {
+	t.Helper()
+
+	input := &types.PasskeyRegistrationBeginRequestInput{
+		CurrentPassword: helper.exampleLoginInput.Password,
+		TOTPToken:       helper.exampleLoginInput.TOTPToken,
+	}
+
+	res := httptest.NewRecorder()
+	req, err := http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+	require.NoError(t, err)
+
+	handler(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var actual *types.APIResponse[*types.PasskeyCeremonyOptions]
+	require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, res.Body.Bytes(), &actual))
+	require.NotNil(t, actual.Data)
+
+	for _, cookie := range res.Result().Cookies() {
+		if cookie.Name == cookieName {
+			return actual.Data, cookie
+		}
+	}
+
+	t.Fatalf("no %s cookie was set", cookieName)
+	return nil, nil
+}
+
+func TestAuthenticationService_BeginPasskeyRegistrationHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		existingPasskey := newSoftwareAuthenticator(t).passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{existingPasskey}, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		authenticator := expectCredentialsForTest(t, helper)
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyRegistrationHandler, passkeyRegistrationCookieName)
+
+		// the cookie only refers to the ceremony, which is stored on our side.
+		var ceremonyID string
+		require.NoError(t, helper.service.cookieManager.Decode(passkeyRegistrationCookieName, cookie.Value, &ceremonyID))
+		assert.Contains(t, helper.service.passkeyCeremonyDataManager.(*passkeyCeremonyStoreForTest).ceremonies, ceremonyID)
+
+		var publicKey struct {
+			ExcludeCredentials []struct {
+				ID string `json:"id"`
+			} `json:"excludeCredentials"`
+		}
+		require.NoError(t, json.Unmarshal(options.PublicKey, &publicKey))
+		require.Len(t, publicKey.ExcludeCredentials, 1)
+		assert.Equal(t, existingPasskey.CredentialID, publicKey.ExcludeCredentials[0].ID)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, authenticator)
+	})
+
+	T.Run("with passkeys disabled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotImplemented, helper.res.Code)
+	})
+
+	T.Run("with error fetching session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationBeginRequestInput{})
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationBeginRequestInput{
+			CurrentPassword: helper.exampleLoginInput.Password,
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+		})
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			helper.exampleLoginInput.Password,
+			helper.exampleUser.TwoFactorSecret,
+			helper.exampleLoginInput.TOTPToken,
+		).Return(false, nil)
+		helper.service.authenticator = authenticator
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator)
+	})
+
+	T.Run("without TOTP token for user with two factor authentication", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationBeginRequestInput{
+			CurrentPassword: helper.exampleLoginInput.Password,
+		})
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusResetContent, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager)
+	})
+
+	T.Run("with error storing ceremony", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationBeginRequestInput{
+			CurrentPassword: helper.exampleLoginInput.Password,
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+		})
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{}, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		ceremonyDataManager := &mocktypes.PasskeyCeremonyDataManagerMock{}
+		ceremonyDataManager.On("CreatePasskeyCeremony", testutils.ContextMatcher, mock.IsType(&types.PasskeyCeremonyDatabaseCreationInput{})).Return(errors.New("blah"))
+		helper.service.passkeyCeremonyDataManager = ceremonyDataManager
+
+		authenticator := expectCredentialsForTest(t, helper)
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, ceremonyDataManager, authenticator)
+	})
+
+	T.Run("with error fetching user", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationBeginRequestInput{
+			CurrentPassword: helper.exampleLoginInput.Password,
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+		})
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return((*types.User)(nil), errors.New("blah"))
+		helper.service.userDataManager = userDataManager
+
+		helper.service.BeginPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager)
+	})
+}
+
+func TestAuthenticationService_FinishPasskeyRegistrationHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		expected := authenticator.passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{}, nil)
+		passkeyDataManager.On(
+			"CreateUserPasskey",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.UserPasskeyDatabaseCreationInput) bool {
+				return input.CredentialID == expected.CredentialID &&
+					input.BelongsToUser == helper.exampleUser.ID &&
+					input.Name == expected.Name &&
+					input.BackupEligible &&
+					bytes.Equal(input.PublicKey, expected.PublicKey)
+			}),
+		).Return(expected, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On("Publish", testutils.ContextMatcher, testutils.DataChangeMessageMatcher).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		credentialsAuthenticator := expectCredentialsForTest(t, helper)
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyRegistrationHandler, passkeyRegistrationCookieName)
+
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationRequestInput{
+			Name:            expected.Name,
+			CurrentPassword: helper.exampleLoginInput.Password,
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+			Credential:      authenticator.register(t, options),
+		})
+		helper.req.AddCookie(cookie)
+
+		helper.service.FinishPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.UserPasskey]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, expected.ID, actual.Data.ID)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, dataChangesPublisher, credentialsAuthenticator)
+	})
+
+	T.Run("without user verification", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		authenticator.presenceOnly = true
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{}, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		credentialsAuthenticator := expectCredentialsForTest(t, helper)
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyRegistrationHandler, passkeyRegistrationCookieName)
+
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationRequestInput{
+			Name:            t.Name(),
+			CurrentPassword: helper.exampleLoginInput.Password,
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+			Credential:      authenticator.register(t, options),
+		})
+		helper.req.AddCookie(cookie)
+
+		helper.service.FinishPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, credentialsAuthenticator)
+	})
+
+	T.Run("with invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{}, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		credentialsAuthenticator := expectCredentialsForTest(t, helper)
+		credentialsAuthenticator.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			"wrong password",
+			helper.exampleUser.TwoFactorSecret,
+			helper.exampleLoginInput.TOTPToken,
+		).Return(false, nil)
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyRegistrationHandler, passkeyRegistrationCookieName)
+
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationRequestInput{
+			Name:            t.Name(),
+			CurrentPassword: "wrong password",
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+			Credential:      authenticator.register(t, options),
+		})
+		helper.req.AddCookie(cookie)
+
+		helper.service.FinishPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, credentialsAuthenticator)
+	})
+
+	T.Run("without registration in progress", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		setRequestBodyForTest(t, helper, &types.PasskeyRegistrationRequestInput{
+			Name:            t.Name(),
+			CurrentPassword: helper.exampleLoginInput.Password,
+			TOTPToken:       helper.exampleLoginInput.TOTPToken,
+			Credential:      json.RawMessage(`{}`),
+		})
+
+		helper.service.FinishPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, &types.PasskeyRegistrationRequestInput{})))
+		require.NoError(t, err)
+
+		helper.service.FinishPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with passkeys disabled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.service.FinishPasskeyRegistrationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotImplemented, helper.res.Code)
+	})
+}
+
+func TestAuthenticationService_BuildLoginHandler_WithPasskey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		passkey := authenticator.passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{passkey}, nil)
+		passkeyDataManager.On("MarkUserPasskeyAsUsed", testutils.ContextMatcher, passkey.ID, passkey.SignCount+1, true).Return(nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		membershipDB := &mocktypes.HouseholdUserMembershipDataManagerMock{}
+		membershipDB.On("GetDefaultHouseholdIDForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleHousehold.ID, nil)
+		helper.service.householdMembershipManager = membershipDB
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On("Publish", testutils.ContextMatcher, testutils.DataChangeMessageMatcher).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyLoginHandler, passkeyLoginCookieName)
+
+		input := &types.UserLoginInput{PasskeyAssertion: authenticator.assert(t, options, helper.exampleUser.ID)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.AddCookie(cookie)
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get("Set-Cookie"))
+		var actual *types.APIResponse[*types.UserStatusResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, helper.exampleUser.ID, actual.Data.UserID)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, membershipDB, dataChangesPublisher)
+	})
+
+	T.Run("with replayed ceremony", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		passkey := authenticator.passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{passkey}, nil)
+		passkeyDataManager.On("MarkUserPasskeyAsUsed", testutils.ContextMatcher, passkey.ID, passkey.SignCount+1, true).Return(nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		membershipDB := &mocktypes.HouseholdUserMembershipDataManagerMock{}
+		membershipDB.On("GetDefaultHouseholdIDForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleHousehold.ID, nil)
+		helper.service.householdMembershipManager = membershipDB
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On("Publish", testutils.ContextMatcher, testutils.DataChangeMessageMatcher).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyLoginHandler, passkeyLoginCookieName)
+
+		input := &types.UserLoginInput{PasskeyAssertion: authenticator.assert(t, options, helper.exampleUser.ID)}
+
+		for _, expectedStatus := range []int{http.StatusAccepted, http.StatusUnauthorized} {
+			req, err := http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+			require.NoError(t, err)
+			req.AddCookie(cookie)
+
+			res := httptest.NewRecorder()
+			helper.service.BuildLoginHandler(false)(res, req)
+
+			// the ceremony is gone after its first use, even though the cookie and assertion are still valid.
+			assert.Equal(t, expectedStatus, res.Code)
+		}
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, membershipDB, dataChangesPublisher)
+	})
+
+	T.Run("with assertion from unregistered authenticator", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		registered := newSoftwareAuthenticator(t).passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{registered}, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyLoginHandler, passkeyLoginCookieName)
+
+		input := &types.UserLoginInput{PasskeyAssertion: newSoftwareAuthenticator(t).assert(t, options, helper.exampleUser.ID)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.AddCookie(cookie)
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager)
+	})
+
+	T.Run("without user verification", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		authenticator.presenceOnly = true
+		passkey := authenticator.passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{passkey}, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyLoginHandler, passkeyLoginCookieName)
+
+		var publicKey struct {
+			UserVerification string `json:"userVerification"`
+		}
+		require.NoError(t, json.Unmarshal(options.PublicKey, &publicKey))
+		assert.Equal(t, "required", publicKey.UserVerification)
+
+		input := &types.UserLoginInput{PasskeyAssertion: authenticator.assert(t, options, helper.exampleUser.ID)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.AddCookie(cookie)
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager)
+	})
+
+	T.Run("with locked out IP address", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		input := &types.UserLoginInput{PasskeyAssertion: json.RawMessage(`{}`)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return(time.Now().Add(time.Minute), nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusTooManyRequests, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get(loginattempts.RetryAfterHeader))
+
+		mock.AssertExpectationsForObjects(t, tracker)
+	})
+
+	T.Run("with locked out user", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		passkey := authenticator.passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{passkey}, nil)
+		passkeyDataManager.On("MarkUserPasskeyAsUsed", testutils.ContextMatcher, passkey.ID, passkey.SignCount+1, true).Return(nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyLoginHandler, passkeyLoginCookieName)
+
+		input := &types.UserLoginInput{PasskeyAssertion: authenticator.assert(t, options, helper.exampleUser.ID)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.AddCookie(cookie)
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			"",
+			mock.AnythingOfType("string"),
+		).Return(time.Time{}, nil)
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			"",
+		).Return(time.Now().Add(time.Minute), nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusTooManyRequests, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager, tracker)
+	})
+
+	T.Run("with invalid assertion counting against IP address", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		input := &types.UserLoginInput{PasskeyAssertion: json.RawMessage(`{}`)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return(time.Time{}, nil)
+		tracker.On(
+			"RecordFailure",
+			testutils.ContextMatcher,
+			"",
+			"127.0.0.1",
+		).Return([]*loginattempts.Lockout{}, nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, tracker)
+	})
+
+	T.Run("without login in progress", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		input := &types.UserLoginInput{PasskeyAssertion: json.RawMessage(`{}`)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+
+		helper.service.BuildLoginHandler(false)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with non-admin user on admin route", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		enablePasskeysForTest(t, helper)
+
+		authenticator := newSoftwareAuthenticator(t)
+		passkey := authenticator.passkey(t, helper.exampleUser.ID)
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey{passkey}, nil)
+		passkeyDataManager.On("MarkUserPasskeyAsUsed", testutils.ContextMatcher, passkey.ID, passkey.SignCount+1, true).Return(nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		options, cookie := beginCeremonyForTest(t, helper, helper.service.BeginPasskeyLoginHandler, passkeyLoginCookieName)
+
+		input := &types.UserLoginInput{PasskeyAssertion: authenticator.assert(t, options, helper.exampleUser.ID)}
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(helper.service.encoderDecoder.MustEncode(helper.ctx, input)))
+		require.NoError(t, err)
+		helper.req.AddCookie(cookie)
+
+		helper.service.BuildLoginHandler(true)(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, passkeyDataManager)
+	})
+}
+
+func TestAuthenticationService_BeginPasskeyLoginHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with passkeys disabled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.service.BeginPasskeyLoginHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotImplemented, helper.res.Code)
+	})
+}
+
+func TestAuthenticationService_ListPasskeysHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		expected := fakes.BuildFakeUserPasskeyList()
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(expected, nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		helper.service.ListPasskeysHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.UserPasskey]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Len(t, actual.Data, len(expected))
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, passkeyDataManager)
+	})
+
+	T.Run("with error fetching session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ListPasskeysHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with error reading from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("GetUserPasskeysForUser", testutils.ContextMatcher, helper.exampleUser.ID).Return([]*types.UserPasskey(nil), errors.New("blah"))
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		helper.service.ListPasskeysHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, passkeyDataManager)
+	})
+}
+
+func buildPasskeyArchiveInputForTest(helper *authServiceHTTPRoutesTestHelper) *types.PasskeyArchiveRequestInput {
+	return &types.PasskeyArchiveRequestInput{
+		CurrentPassword: helper.exampleLoginInput.Password,
+		TOTPToken:       helper.exampleLoginInput.TOTPToken,
+	}
+}
+
+func TestAuthenticationService_ArchivePasskeyHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		examplePasskeyID := fakes.BuildFakeID()
+		helper.service.passkeyIDFetcher = func(*http.Request) string { return examplePasskeyID }
+		setRequestBodyForTest(t, helper, buildPasskeyArchiveInputForTest(helper))
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := expectCredentialsForTest(t, helper)
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("ArchiveUserPasskey", testutils.ContextMatcher, helper.exampleUser.ID, examplePasskeyID).Return(nil)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On("Publish", testutils.ContextMatcher, testutils.DataChangeMessageMatcher).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.ArchivePasskeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, passkeyDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error fetching session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ArchivePasskeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		setRequestBodyForTest(t, helper, &types.PasskeyArchiveRequestInput{})
+
+		helper.service.ArchivePasskeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		examplePasskeyID := fakes.BuildFakeID()
+		helper.service.passkeyIDFetcher = func(*http.Request) string { return examplePasskeyID }
+		setRequestBodyForTest(t, helper, buildPasskeyArchiveInputForTest(helper))
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := &mockauthn.Authenticator{}
+		authenticator.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			helper.exampleLoginInput.Password,
+			helper.exampleUser.TwoFactorSecret,
+			helper.exampleLoginInput.TOTPToken,
+		).Return(false, nil)
+		helper.service.authenticator = authenticator
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		helper.service.ArchivePasskeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, passkeyDataManager)
+	})
+
+	T.Run("with nonexistent passkey", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		examplePasskeyID := fakes.BuildFakeID()
+		helper.service.passkeyIDFetcher = func(*http.Request) string { return examplePasskeyID }
+		setRequestBodyForTest(t, helper, buildPasskeyArchiveInputForTest(helper))
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := expectCredentialsForTest(t, helper)
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("ArchiveUserPasskey", testutils.ContextMatcher, helper.exampleUser.ID, examplePasskeyID).Return(sql.ErrNoRows)
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		helper.service.ArchivePasskeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, passkeyDataManager)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		examplePasskeyID := fakes.BuildFakeID()
+		helper.service.passkeyIDFetcher = func(*http.Request) string { return examplePasskeyID }
+		setRequestBodyForTest(t, helper, buildPasskeyArchiveInputForTest(helper))
+
+		userDataManager := &mocktypes.UserDataManagerMock{}
+		userDataManager.On("GetUser", testutils.ContextMatcher, helper.exampleUser.ID).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = userDataManager
+
+		authenticator := expectCredentialsForTest(t, helper)
+
+		passkeyDataManager := &mocktypes.UserPasskeyDataManagerMock{}
+		passkeyDataManager.On("ArchiveUserPasskey", testutils.ContextMatcher, helper.exampleUser.ID, examplePasskeyID).Return(errors.New("blah"))
+		helper.service.userPasskeyDataManager = passkeyDataManager
+
+		helper.service.ArchivePasskeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, userDataManager, authenticator, passkeyDataManager)
+	})
+}
@@ -8,6 +8,7 @@
 
 	"github.com/dinnerdonebetter/backend/internal/analytics"
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/featureflags"
@@ -20,6 +21,7 @@
 
 	"github.com/alexedwards/scs/v2"
 	"github.com/go-oauth2/oauth2/v4/server"
+	"github.com/go-webauthn/webauthn/webauthn"
 	"github.com/gorilla/securecookie"
 	"github.com/markbates/goth"
 	"github.com/markbates/goth/providers/google"
@@ -46,22 +48,32 @@
 
 	// service handles passwords service-wide.
 	service struct {
-		config                     *Config
-		logger                     logging.Logger
-		authenticator              authentication.Authenticator
-		analyticsReporter          analytics.EventReporter
-		featureFlagManager         featureflags.FeatureFlagManager
-		userDataManager            types.UserDataManager
-		householdMembershipManager types.HouseholdUserMembershipDataManager
-		encoderDecoder             encoding.ServerEncoderDecoder
-		secretGenerator            random.Generator
-		cookieManager              cookieEncoderDecoder
-		sessionManager             sessionManager
-		sessionContextDataFetcher  func(*http.Request) (*types.SessionContextData, error)
-		authProviderFetcher        func(*http.Request) string
-		tracer                     tracing.Tracer
-		dataChangesPublisher       messagequeue.Publisher
-		oauth2Server               *server.Server
+		config                      *Config
+		logger                      logging.Logger
+		authenticator               authentication.Authenticator
+		analyticsReporter           analytics.EventReporter
+		featureFlagManager          featureflags.FeatureFlagManager
+		userDataManager             types.UserDataManager
+		householdMembershipManager  types.HouseholdUserMembershipDataManager
+		oauth2ClientDataManager     types.OAuth2ClientDataManager
+		userPasskeyDataManager      types.UserPasskeyDataManager
+		userRecoveryCodeDataManager types.UserRecoveryCodeDataManager
+		passkeyCeremonyDataManager  types.PasskeyCeremonyDataManager
+		auditLogEntryDataManager    types.AuditLogEntryDataManager
+		loginAttemptTracker         loginattempts.Tracker
+		encoderDecoder              encoding.ServerEncoderDecoder
+		secretGenerator             random.Generator
+		cookieManager               cookieEncoderDecoder
+		sessionManager              sessionManager
+		sessionContextDataFetcher   func(*http.Request) (*types.SessionContextData, error)
+		authProviderFetcher         func(*http.Request) string
+		passkeyIDFetcher            func(*http.Request) string
+		routeParamManager           routing.RouteParamManager
+		tracer                      tracing.Tracer
+		dataChangesPublisher        messagequeue.Publisher
+		transactionRunner           database.TransactionRunner
+		oauth2Server                *server.Server
+		webAuthn                    *webauthn.WebAuthn
 	}
 )
 
@@ -81,6 +93,7 @@ func ProvideService(
 	featureFlagManager featureflags.FeatureFlagManager,
 	analyticsReporter analytics.EventReporter,
 	routeParamManager routing.RouteParamManager,
+	loginAttemptTracker loginattempts.Tracker,
 ) (types.AuthService, error) {
 	hashKey := []byte(cfg.Cookies.HashKey)
 	if len(hashKey) == 0 {
@@ -92,25 +105,40 @@ func ProvideService(
 		return nil, fmt.Errorf("setting up auth service data changes publisher: %w", publisherProviderErr)
 	}
 
+	webAuthn, err := provideWebAuthn(&cfg.Passkeys)
+	if err != nil {
+		return nil, fmt.Errorf("setting up passkeys: %w", err)
+	}
+
 	tracer := tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName))
 
 	svc := &service{
-		logger:                     logging.EnsureLogger(logger).WithName(serviceName),
-		encoderDecoder:             encoder,
-		config:                     cfg,
-		userDataManager:            dataManager,
-		householdMembershipManager: householdMembershipManager,
-		authenticator:              authenticator,
-		sessionManager:             sessionManager,
-		secretGenerator:            secretGenerator,
-		sessionContextDataFetcher:  FetchContextFromRequest,
-		cookieManager:              securecookie.New(hashKey, []byte(cfg.Cookies.BlockKey)),
-		tracer:                     tracer,
-		dataChangesPublisher:       dataChangesPublisher,
-		featureFlagManager:         featureFlagManager,
-		analyticsReporter:          analyticsReporter,
-		authProviderFetcher:        routeParamManager.BuildRouteParamStringIDFetcher(AuthProviderParamKey),
-		oauth2Server:               ProvideOAuth2ServerImplementation(ctx, logger, tracer, &cfg.OAuth2, dataManager),
+		logger:                      logging.EnsureLogger(logger).WithName(serviceName),
+		encoderDecoder:              encoder,
+		config:                      cfg,
+		userDataManager:             dataManager,
+		householdMembershipManager:  householdMembershipManager,
+		oauth2ClientDataManager:     dataManager,
+		userPasskeyDataManager:      dataManager,
+		userRecoveryCodeDataManager: dataManager,
+		passkeyCeremonyDataManager:  dataManager,
+		auditLogEntryDataManager:    dataManager,
+		loginAttemptTracker:         loginAttemptTracker,
+		authenticator:               authenticator,
+		sessionManager:              sessionManager,
+		secretGenerator:             secretGenerator,
+		sessionContextDataFetcher:   FetchContextFromRequest,
+		cookieManager:               securecookie.New(hashKey, []byte(cfg.Cookies.BlockKey)),
+		tracer:                      tracer,
+		dataChangesPublisher:        dataChangesPublisher,
+		transactionRunner:           dataManager,
+		featureFlagManager:          featureFlagManager,
+		analyticsReporter:           analyticsReporter,
+		authProviderFetcher:         routeParamManager.BuildRouteParamStringIDFetcher(AuthProviderParamKey),
+		passkeyIDFetcher:            routeParamManager.BuildRouteParamStringIDFetcher(PasskeyIDURIParamKey),
+		routeParamManager:           routeParamManager,
+		oauth2Server:                ProvideOAuth2ServerImplementation(ctx, logger, tracer, &cfg.OAuth2, authenticator, dataManager, loginAttemptTracker, dataChangesPublisher),
+		webAuthn:                    webAuthn,
 	}
 
 	if _, err := svc.cookieManager.Encode(cfg.Cookies.Name, "blah"); err != nil {
@@ -6,6 +6,7 @@
 	"testing"
 
 	"github.com/dinnerdonebetter/backend/internal/analytics"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
 	"github.com/dinnerdonebetter/backend/internal/authentication/mock"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
@@ -16,9 +17,11 @@
 	"github.com/dinnerdonebetter/backend/internal/pkg/random"
 	"github.com/dinnerdonebetter/backend/internal/routing/mock"
 	"github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
 	"github.com/alexedwards/scs/v2"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,13 +47,20 @@ func buildTestService(t *testing.T) *service {
 		"BuildRouteParamStringIDFetcher",
 		AuthProviderParamKey,
 	).Return(func(*http.Request) string { return "" })
+	rpm.On(
+		"BuildRouteParamStringIDFetcher",
+		PasskeyIDURIParamKey,
+	).Return(func(*http.Request) string { return "" })
+
+	dataManager := database.NewMockDatabase()
+	dataManager.On("RunInTransaction", testutils.ContextMatcher, mock.Anything).Return(nil)
 
 	s, err := ProvideService(
 		context.Background(),
 		logger,
 		cfg,
 		&mockauthn.Authenticator{},
-		database.NewMockDatabase(),
+		dataManager,
 		&mocktypes.HouseholdUserMembershipDataManagerMock{},
 		scs.New(),
 		encoderDecoder,
@@ -60,6 +70,7 @@ func buildTestService(t *testing.T) *service {
 		&featureflags.NoopFeatureFlagManager{},
 		analytics.NewNoopEventReporter(),
 		rpm,
+		loginattempts.NewNoopTracker(),
 	)
 	require.NoError(t, err)
 
@@ -89,6 +100,10 @@ func TestProvideService(T *testing.T) {
 			"BuildRouteParamStringIDFetcher",
 			AuthProviderParamKey,
 		).Return(func(*http.Request) string { return "" })
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			PasskeyIDURIParamKey,
+		).Return(func(*http.Request) string { return "" })
 
 		s, err := ProvideService(
 			context.Background(),
@@ -105,6 +120,7 @@ func TestProvideService(T *testing.T) {
 			&featureflags.NoopFeatureFlagManager{},
 			analytics.NewNoopEventReporter(),
 			rpm,
+			loginattempts.NewNoopTracker(),
 		)
 
 		assert.NotNil(t, s)
@@ -131,6 +147,10 @@ func TestProvideService(T *testing.T) {
 			"BuildRouteParamStringIDFetcher",
 			AuthProviderParamKey,
 		).Return(func(*http.Request) string { return "" })
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			PasskeyIDURIParamKey,
+		).Return(func(*http.Request) string { return "" })
 
 		s, err := ProvideService(
 			context.Background(),
@@ -152,6 +172,7 @@ func TestProvideService(T *testing.T) {
 			&featureflags.NoopFeatureFlagManager{},
 			analytics.NewNoopEventReporter(),
 			rpm,
+			loginattempts.NewNoopTracker(),
 		)
 
 		assert.Nil(t, s)
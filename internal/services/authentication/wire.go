@@ -9,5 +9,6 @@
 	ProvideService,
 	wire.FieldsOf(new(*Config),
 		"Cookies",
+		"LoginAttempts",
 	),
 )
@@ -11,6 +11,10 @@ type Config struct {
 	_ struct{} `json:"-"`
 
 	DataChangesTopicName string `json:"dataChangesTopicName,omitempty" toml:"data_changes_topic_name,omitempty"`
+	// PremiumSubscriptionPlanIDs are the payment processor price IDs households may check out with.
+	PremiumSubscriptionPlanIDs []string `json:"premiumSubscriptionPlanIDs,omitempty" toml:"premium_subscription_plan_ids,omitempty"`
+	// RequireSubscriptionForPremiumFeatures determines whether premium routes turn away households without an active subscription.
+	RequireSubscriptionForPremiumFeatures bool `json:"requireSubscriptionForPremiumFeatures,omitempty" toml:"require_subscription_for_premium_features,omitempty"`
 }
 
 var _ validation.ValidatableWithContext = (*Config)(nil)
@@ -21,5 +25,6 @@ func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 		ctx,
 		cfg,
 		validation.Field(&cfg.DataChangesTopicName, validation.Required),
+		validation.Field(&cfg.PremiumSubscriptionPlanIDs, validation.Each(validation.Required)),
 	)
 }
@@ -20,4 +20,16 @@ func TestConfig_ValidateWithContext(T *testing.T) {
 
 		assert.NoError(t, cfg.ValidateWithContext(ctx))
 	})
+
+	T.Run("with empty subscription plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		cfg := &Config{
+			DataChangesTopicName:       "blah",
+			PremiumSubscriptionPlanIDs: []string{""},
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
 }
@@ -1,4 +1,4 @@
 /*
-Package capitalism provides a series of HTTP handlers for managing valid instruments in a compatible database.
+Package capitalism provides a series of HTTP handlers for managing household subscriptions with a payment processor.
 */
 package capitalism
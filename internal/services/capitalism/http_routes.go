@@ -1,9 +1,16 @@
 package capitalism
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/capitalism"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	servertiming "github.com/mitchellh/go-server-timing"
 )
@@ -18,12 +25,199 @@ func (s *service) IncomingWebhookHandler(res http.ResponseWriter, req *http.Requ
 	tracing.AttachRequestToSpan(span, req)
 
 	webhookHandleTimer := timing.NewMetric("handler").WithDesc("handle webhook event").Start()
-	statusToWrite := http.StatusOK
-	if err := s.paymentManager.HandleEventWebhook(req); err != nil {
+	update, err := s.paymentManager.HandleEventWebhook(req)
+	if err != nil {
 		logger.Error(err, "handling subscription event webhook")
-		statusToWrite = http.StatusInternalServerError
+		res.WriteHeader(http.StatusInternalServerError)
+		return
 	}
 	webhookHandleTimer.Stop()
 
-	res.WriteHeader(statusToWrite)
+	if update == nil {
+		res.WriteHeader(http.StatusOK)
+		return
+	}
+
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, update.HouseholdID)
+	logger = logger.WithValue(keys.HouseholdIDKey, update.HouseholdID).WithValue("billing_status", update.BillingStatus)
+
+	updateTimer := timing.NewMetric("database").WithDesc("update household billing status").Start()
+	err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdDataManager.UpdateHouseholdBillingStatus(ctx, update); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdBillingStatusUpdatedCustomerEventType,
+			HouseholdID: update.HouseholdID,
+			Context: map[string]any{
+				"billingStatus":      update.BillingStatus,
+				"subscriptionPlanID": update.SubscriptionPlanID,
+			},
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// the household is gone, or a newer event was already applied, so there's nothing for a redelivery to accomplish.
+		logger.Info("billing status update for unknown household or superseded by a newer event")
+		res.WriteHeader(http.StatusOK)
+		return
+	} else if err != nil {
+		// erroring here lets the payment processor redeliver the event later.
+		observability.AcknowledgeError(err, logger, span, "updating household billing status")
+		res.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	updateTimer.Stop()
+
+	res.WriteHeader(http.StatusOK)
+}
+
+// CreateCheckoutSessionHandler starts a payment processor checkout that subscribes the active household to a plan.
+func (s *service) CreateCheckoutSessionHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// read parsed input struct from request body.
+	providedInput := new(types.CheckoutSessionCreationRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, providedInput); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = providedInput.ValidateForSubscriptionPlans(ctx, s.cfg.PremiumSubscriptionPlanIDs); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch household").Start()
+	household, err := s.householdDataManager.GetHousehold(ctx, sessionCtxData.ActiveHouseholdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching household")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	checkoutTimer := timing.NewMetric("payments").WithDesc("create checkout session").Start()
+	session, err := s.paymentManager.CreateCheckoutSession(ctx, household, providedInput.SubscriptionPlanID)
+	if errors.Is(err, capitalism.ErrPaymentsDisabled) {
+		errRes := types.NewAPIErrorResponse("payments are not enabled", types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotImplemented)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "creating checkout session")
+		errRes := types.NewAPIErrorResponse("payment processor error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	checkoutTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.PaymentSession]{
+		Details: responseDetails,
+		Data:    session,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
+// CreateCustomerPortalSessionHandler starts a payment processor session where the active household can manage its subscription.
+func (s *service) CreateCustomerPortalSessionHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch household").Start()
+	household, err := s.householdDataManager.GetHousehold(ctx, sessionCtxData.ActiveHouseholdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching household")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	portalTimer := timing.NewMetric("payments").WithDesc("create customer portal session").Start()
+	session, err := s.paymentManager.CreateCustomerPortalSession(ctx, household)
+	if errors.Is(err, capitalism.ErrNoPaymentProcessorCustomer) {
+		errRes := types.NewAPIErrorResponse("household has never subscribed", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	} else if errors.Is(err, capitalism.ErrPaymentsDisabled) {
+		errRes := types.NewAPIErrorResponse("payments are not enabled", types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotImplemented)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "creating customer portal session")
+		errRes := types.NewAPIErrorResponse("payment processor error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	portalTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.PaymentSession]{
+		Details: responseDetails,
+		Data:    session,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
 }
@@ -3,15 +3,23 @@
 import (
 	"bytes"
 	"context"
+	"database/sql"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/capitalism"
 	capitalismmock "github.com/dinnerdonebetter/backend/internal/capitalism/mock"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/internal/pkg/random"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -33,7 +41,7 @@ func TestValidInstrumentsService_StripeWebhookHandler(T *testing.T) {
 		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
 
 		mpm := &capitalismmock.MockPaymentManager{}
-		mpm.On("HandleEventWebhook", mock.AnythingOfType("*http.Request")).Return(nil)
+		mpm.On("HandleEventWebhook", mock.AnythingOfType("*http.Request")).Return((*types.HouseholdBillingStatusUpdateInput)(nil), nil)
 		helper.service.paymentManager = mpm
 
 		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(nil))
@@ -47,6 +55,374 @@ func TestValidInstrumentsService_StripeWebhookHandler(T *testing.T) {
 
 		assert.Equal(t, http.StatusOK, helper.res.Code)
 
-		mock.AssertExpectationsForObjects(t, dataChangesPublisher)
+		mock.AssertExpectationsForObjects(t, mpm, dataChangesPublisher)
+	})
+
+	T.Run("with billing status update", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		update := &types.HouseholdBillingStatusUpdateInput{
+			OccurredAt:                 time.Now(),
+			HouseholdID:                helper.exampleHousehold.ID,
+			BillingStatus:              types.PaidHouseholdBillingStatus,
+			PaymentProcessorCustomerID: "cus_whatever",
+		}
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("HandleEventWebhook", mock.AnythingOfType("*http.Request")).Return(update, nil)
+		helper.service.paymentManager = mpm
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("UpdateHouseholdBillingStatus", testutils.ContextMatcher, update).Return(nil)
+		helper.service.householdDataManager = householdDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(message *types.DataChangeMessage) bool {
+				return message.EventType == types.HouseholdBillingStatusUpdatedCustomerEventType && message.HouseholdID == helper.exampleHousehold.ID
+			}),
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.IncomingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mpm, householdDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error handling event", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("HandleEventWebhook", mock.AnythingOfType("*http.Request")).Return((*types.HouseholdBillingStatusUpdateInput)(nil), errors.New("blah"))
+		helper.service.paymentManager = mpm
+
+		helper.service.IncomingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mpm)
+	})
+
+	T.Run("with unknown household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		update := &types.HouseholdBillingStatusUpdateInput{
+			OccurredAt:    time.Now(),
+			HouseholdID:   helper.exampleHousehold.ID,
+			BillingStatus: types.UnpaidHouseholdBillingStatus,
+		}
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("HandleEventWebhook", mock.AnythingOfType("*http.Request")).Return(update, nil)
+		helper.service.paymentManager = mpm
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("UpdateHouseholdBillingStatus", testutils.ContextMatcher, update).Return(sql.ErrNoRows)
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.IncomingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mpm, householdDataManager)
+	})
+
+	T.Run("with error updating household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		update := &types.HouseholdBillingStatusUpdateInput{
+			OccurredAt:    time.Now(),
+			HouseholdID:   helper.exampleHousehold.ID,
+			BillingStatus: types.PastDueHouseholdBillingStatus,
+		}
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("HandleEventWebhook", mock.AnythingOfType("*http.Request")).Return(update, nil)
+		helper.service.paymentManager = mpm
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("UpdateHouseholdBillingStatus", testutils.ContextMatcher, update).Return(errors.New("blah"))
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.IncomingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mpm, householdDataManager)
+	})
+}
+
+func TestCapitalismService_CreateCheckoutSessionHandler(T *testing.T) {
+	T.Parallel()
+
+	buildRequest := func(t *testing.T, helper *capitalismServiceHTTPRoutesTestHelper, input *types.CheckoutSessionCreationRequestInput) {
+		t.Helper()
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, input)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		expected := &types.PaymentSession{ID: fakes.BuildFakeID(), URL: "https://checkout.stripe.com/c/pay/whatever"}
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("CreateCheckoutSession", testutils.ContextMatcher, helper.exampleHousehold, "price_premium").Return(expected, nil)
+		helper.service.paymentManager = mpm
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.PaymentSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, expected, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, householdDataManager, mpm)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.PaymentSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{})
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.PaymentSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with unknown subscription plan", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_free_lunch"})
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		helper.service.paymentManager = mpm
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.PaymentSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mpm)
+	})
+
+	T.Run("with missing household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return((*types.Household)(nil), sql.ErrNoRows)
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return((*types.Household)(nil), errors.New("blah"))
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with payments disabled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.paymentManager = &capitalism.NoopPaymentManager{}
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotImplemented, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with error creating session", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		buildRequest(t, helper, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("CreateCheckoutSession", testutils.ContextMatcher, helper.exampleHousehold, "price_premium").Return((*types.PaymentSession)(nil), errors.New("blah"))
+		helper.service.paymentManager = mpm
+
+		helper.service.CreateCheckoutSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager, mpm)
+	})
+}
+
+func TestCapitalismService_CreateCustomerPortalSessionHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		expected := &types.PaymentSession{ID: fakes.BuildFakeID(), URL: "https://billing.stripe.com/p/session/whatever"}
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("CreateCustomerPortalSession", testutils.ContextMatcher, helper.exampleHousehold).Return(expected, nil)
+		helper.service.paymentManager = mpm
+
+		helper.service.CreateCustomerPortalSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.PaymentSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, expected, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, householdDataManager, mpm)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.CreateCustomerPortalSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return((*types.Household)(nil), errors.New("blah"))
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.CreateCustomerPortalSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("for household that never subscribed", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("CreateCustomerPortalSession", testutils.ContextMatcher, helper.exampleHousehold).Return((*types.PaymentSession)(nil), capitalism.ErrNoPaymentProcessorCustomer)
+		helper.service.paymentManager = mpm
+
+		helper.service.CreateCustomerPortalSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager, mpm)
+	})
+
+	T.Run("with error creating session", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		mpm := &capitalismmock.MockPaymentManager{}
+		mpm.On("CreateCustomerPortalSession", testutils.ContextMatcher, helper.exampleHousehold).Return((*types.PaymentSession)(nil), errors.New("blah"))
+		helper.service.paymentManager = mpm
+
+		helper.service.CreateCustomerPortalSessionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager, mpm)
 	})
 }
@@ -0,0 +1,93 @@
+package capitalism
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	servertiming "github.com/mitchellh/go-server-timing"
+)
+
+// ActiveSubscriptionRequirementMiddleware turns away requests whose active household lacks an active subscription.
+// Service admins are let through, and so is everybody when subscriptions aren't being enforced.
+func (s *service) ActiveSubscriptionRequirementMiddleware(next http.Handler) http.Handler {
+	return s.subscriptionRequirementMiddleware(nil, next)
+}
+
+// HouseholdSubscriptionRequirementMiddleware turns away requests for a household, identified by the provided
+// URI param, that lacks an active subscription, regardless of which household is active for the requester.
+func (s *service) HouseholdSubscriptionRequirementMiddleware(householdIDURIParamKey string) func(next http.Handler) http.Handler {
+	householdIDFetcher := s.routeParamManager.BuildRouteParamStringIDFetcher(householdIDURIParamKey)
+
+	return func(next http.Handler) http.Handler {
+		return s.subscriptionRequirementMiddleware(householdIDFetcher, next)
+	}
+}
+
+// subscriptionRequirementMiddleware checks the household the fetcher returns, or the active household if there's no fetcher.
+func (s *service) subscriptionRequirementMiddleware(householdIDFetcher func(*http.Request) string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if !s.cfg.RequireSubscriptionForPremiumFeatures {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		ctx, span := s.tracer.StartSpan(req.Context())
+		defer span.End()
+
+		timing := servertiming.FromContext(ctx)
+		logger := s.logger.WithRequest(req).WithSpan(span)
+
+		responseDetails := types.ResponseDetails{
+			TraceID: span.SpanContext().TraceID().String(),
+		}
+
+		sessionCtxData, err := s.sessionContextDataFetcher(req)
+		if err != nil {
+			observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+			errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+			return
+		}
+
+		householdID := sessionCtxData.ActiveHouseholdID
+		if householdIDFetcher != nil {
+			householdID = householdIDFetcher(req)
+		}
+
+		logger = sessionCtxData.AttachToLogger(logger).WithValue(keys.HouseholdIDKey, householdID)
+		responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+		if sessionCtxData.Requester.ServicePermissions.IsServiceAdmin() {
+			next.ServeHTTP(res, req)
+			return
+		}
+
+		readTimer := timing.NewMetric("database").WithDesc("fetch household").Start()
+		household, err := s.householdDataManager.GetHousehold(ctx, householdID)
+		if errors.Is(err, sql.ErrNoRows) {
+			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+			return
+		} else if err != nil {
+			observability.AcknowledgeError(err, logger, span, "fetching household")
+			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+			return
+		}
+		readTimer.Stop()
+
+		if !household.HasActiveSubscription() {
+			logger.WithValue("billing_status", household.BillingStatus).Info("request filtered out for lack of subscription")
+			errRes := types.NewAPIErrorResponse("an active subscription is required", types.ErrSubscriptionRequired, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusPaymentRequired)
+			return
+		}
+
+		next.ServeHTTP(res, req)
+	})
+}
@@ -0,0 +1,247 @@
+package capitalism
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+	mockrouting "github.com/dinnerdonebetter/backend/internal/routing/mock"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func buildTestNextHandler(called *bool) http.Handler {
+	return http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+		*called = true
+		res.WriteHeader(http.StatusOK)
+	})
+}
+
+func TestCapitalismService_ActiveSubscriptionRequirementMiddleware(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.exampleHousehold.BillingStatus = types.PaidHouseholdBillingStatus
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with requirement disabled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = false
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with past due household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.exampleHousehold.BillingStatus = types.PastDueHouseholdBillingStatus
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with unpaid household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.exampleHousehold.BillingStatus = types.UnpaidHouseholdBillingStatus
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusPaymentRequired, helper.res.Code)
+
+		var actual *types.APIResponse[any]
+		assert.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, types.ErrSubscriptionRequired, actual.Error.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with service admin", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
+			return &types.SessionContextData{
+				Requester: types.RequesterInfo{
+					UserID:             helper.exampleUser.ID,
+					ServicePermissions: authorization.NewServiceRolePermissionChecker(authorization.ServiceAdminRole.String()),
+				},
+				ActiveHouseholdID: helper.exampleHousehold.ID,
+			}, nil
+		}
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with missing household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return((*types.Household)(nil), sql.ErrNoRows)
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return((*types.Household)(nil), errors.New("blah"))
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.ActiveSubscriptionRequirementMiddleware(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+}
+
+func TestCapitalismService_HouseholdSubscriptionRequirementMiddleware(T *testing.T) {
+	T.Parallel()
+
+	const householdIDURIParamKey = "householdID"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.exampleHousehold.BillingStatus = types.PaidHouseholdBillingStatus
+
+		rpm := mockrouting.NewRouteParamManager()
+		rpm.On("BuildRouteParamStringIDFetcher", householdIDURIParamKey).Return(func(*http.Request) string { return helper.exampleHousehold.ID })
+		helper.service.routeParamManager = rpm
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, helper.exampleHousehold.ID).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.HouseholdSubscriptionRequirementMiddleware(householdIDURIParamKey)(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.True(t, called)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, rpm, householdDataManager)
+	})
+
+	T.Run("checks the route household rather than the active one", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.cfg.RequireSubscriptionForPremiumFeatures = true
+		helper.exampleHousehold.BillingStatus = types.PaidHouseholdBillingStatus
+
+		unpaidHousehold := fakes.BuildFakeHousehold()
+		unpaidHousehold.BillingStatus = types.UnpaidHouseholdBillingStatus
+
+		rpm := mockrouting.NewRouteParamManager()
+		rpm.On("BuildRouteParamStringIDFetcher", householdIDURIParamKey).Return(func(*http.Request) string { return unpaidHousehold.ID })
+		helper.service.routeParamManager = rpm
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On("GetHousehold", testutils.ContextMatcher, unpaidHousehold.ID).Return(unpaidHousehold, nil)
+		helper.service.householdDataManager = householdDataManager
+
+		var called bool
+		helper.service.HouseholdSubscriptionRequirementMiddleware(householdIDURIParamKey)(buildTestNextHandler(&called)).ServeHTTP(helper.res, helper.req)
+
+		assert.False(t, called)
+		assert.Equal(t, http.StatusPaymentRequired, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, rpm, householdDataManager)
+	})
+}
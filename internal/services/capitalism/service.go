@@ -6,10 +6,12 @@
 	"net/http"
 
 	"github.com/dinnerdonebetter/backend/internal/capitalism"
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/routing"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 )
@@ -21,31 +23,37 @@
 var _ types.CapitalismService = (*service)(nil)
 
 type (
-	// service handles valid instruments.
+	// service handles household subscriptions.
 	service struct {
 		cfg                       *Config
 		logger                    logging.Logger
 		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 		encoderDecoder            encoding.ServerEncoderDecoder
 		tracer                    tracing.Tracer
 		paymentManager            capitalism.PaymentManager
+		householdDataManager      types.HouseholdDataManager
+		routeParamManager         routing.RouteParamManager
 	}
 )
 
-// ProvideService builds a new ValidInstrumentsService.
+// ProvideService builds a new CapitalismService.
 func ProvideService(
 	_ context.Context,
 	logger logging.Logger,
 	cfg *Config,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	publisherProvider messagequeue.PublisherProvider,
 	tracerProvider tracing.TracerProvider,
 	paymentManager capitalism.PaymentManager,
+	householdDataManager types.HouseholdDataManager,
+	routeParamManager routing.RouteParamManager,
 ) (types.CapitalismService, error) {
 	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
 	if err != nil {
-		return nil, fmt.Errorf("setting up valid instruments service data changes publisher: %w", err)
+		return nil, fmt.Errorf("setting up capitalism service data changes publisher: %w", err)
 	}
 
 	svc := &service{
@@ -53,8 +61,11 @@ func ProvideService(
 		logger:                    logging.EnsureLogger(logger).WithName(serviceName),
 		sessionContextDataFetcher: authservice.FetchContextFromRequest,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		encoderDecoder:            encoder,
 		paymentManager:            paymentManager,
+		householdDataManager:      householdDataManager,
+		routeParamManager:         routeParamManager,
 		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
 
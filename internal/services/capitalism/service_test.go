@@ -6,12 +6,14 @@
 	"testing"
 
 	capitalismmock "github.com/dinnerdonebetter/backend/internal/capitalism/mock"
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	mockrouting "github.com/dinnerdonebetter/backend/internal/routing/mock"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -19,17 +21,20 @@
 
 func buildTestService() *service {
 	return &service{
-		logger:         logging.NewNoopLogger(),
-		encoderDecoder: encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
-		tracer:         tracing.NewTracerForTest("test"),
-		paymentManager: capitalismmock.NewMockPaymentManager(),
+		transactionRunner:    database.NewNoopTransactionRunner(),
+		logger:               logging.NewNoopLogger(),
+		encoderDecoder:       encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
+		tracer:               tracing.NewTracerForTest("test"),
+		paymentManager:       capitalismmock.NewMockPaymentManager(),
+		householdDataManager: &mocktypes.HouseholdDataManagerMock{},
 		cfg: &Config{
-			DataChangesTopicName: "data_changes",
+			DataChangesTopicName:       "data_changes",
+			PremiumSubscriptionPlanIDs: []string{"price_premium"},
 		},
 	}
 }
 
-func TestProvideValidInstrumentsService(T *testing.T) {
+func TestProvideCapitalismService(T *testing.T) {
 	T.Parallel()
 
 	T.Run("standard", func(t *testing.T) {
@@ -53,10 +58,13 @@ func TestProvideValidInstrumentsService(T *testing.T) {
 			ctx,
 			logger,
 			cfg,
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			pp,
 			tracing.NewNoopTracerProvider(),
 			mpm,
+			&mocktypes.HouseholdDataManagerMock{},
+			mockrouting.NewRouteParamManager(),
 		)
 
 		assert.NotNil(t, s)
@@ -84,10 +92,13 @@ func TestProvideValidInstrumentsService(T *testing.T) {
 			ctx,
 			logger,
 			cfg,
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			pp,
 			tracing.NewNoopTracerProvider(),
 			mpm,
+			&mocktypes.HouseholdDataManagerMock{},
+			mockrouting.NewRouteParamManager(),
 		)
 
 		assert.Nil(t, s)
@@ -0,0 +1,4 @@
+/*
+Package cookingsessions provides a series of HTTP handlers for cooking recipes step by step, alone or together with other household members.
+*/
+package cookingsessions
@@ -0,0 +1,503 @@
+package cookingsessions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/features/cookingengine"
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	servertiming "github.com/mitchellh/go-server-timing"
+)
+
+const (
+	// CookingSessionIDURIParamKey is a standard string that we'll use to refer to cooking session IDs with.
+	CookingSessionIDURIParamKey = "cookingSessionID"
+)
+
+// CreateHandler is our cooking session creation route.
+func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// read parsed input struct from request body.
+	providedInput := new(types.CookingSessionCreationRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, providedInput); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = providedInput.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	tracing.AttachToSpan(span, keys.RecipeIDKey, providedInput.RecipeID)
+	logger = logger.WithValue(keys.RecipeIDKey, providedInput.RecipeID)
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch recipe").Start()
+	recipe, err := s.recipeDataManager.GetRecipe(ctx, providedInput.RecipeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving recipe")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	// refuse to start sessions for recipes whose conditions we can't make sense of.
+	if err = s.sessionEngine.ValidateRecipe(ctx, recipe); err != nil {
+		if errors.Is(err, cookingengine.ErrInvalidRecipe) {
+			logger.WithValue(keys.ValidationErrorKey, err).Debug("recipe cannot be cooked")
+			errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+			return
+		}
+
+		observability.AcknowledgeError(err, logger, span, "validating recipe")
+		errRes := types.NewAPIErrorResponse("internal error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	input := &types.CookingSessionDatabaseCreationInput{
+		ID:                 identifiers.New(),
+		RecipeID:           recipe.ID,
+		BelongsToHousehold: sessionCtxData.ActiveHouseholdID,
+		CreatedByUser:      sessionCtxData.Requester.UserID,
+	}
+
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, input.ID)
+
+	createTimer := timing.NewMetric("database").WithDesc("create").Start()
+	var state *types.CookingSessionState
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		cookingSession, createErr := s.cookingSessionDataManager.CreateCookingSession(ctx, input)
+		if createErr != nil {
+			return observability.PrepareError(createErr, span, "creating cooking session")
+		}
+
+		if state, err = s.sessionEngine.DetermineState(ctx, recipe, cookingSession); err != nil {
+			return observability.PrepareError(err, span, "determining cooking session state")
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.CookingSessionCreatedCustomerEventType,
+			CookingSession: cookingSession,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "creating cooking session")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	createTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.CookingSessionState]{
+		Details: responseDetails,
+		Data:    state,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
+// ReadHandler returns a GET handler that returns a cooking session and where each of its steps stands.
+func (s *service) ReadHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	householdID := sessionCtxData.ActiveHouseholdID
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+
+	// determine cooking session ID.
+	cookingSessionID := s.cookingSessionIDFetcher(req)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+
+	cookingSession, recipe, ok := s.fetchCookingSessionAndRecipe(res, req, cookingSessionID, householdID, responseDetails)
+	if !ok {
+		return
+	}
+
+	state, err := s.sessionEngine.DetermineState(ctx, recipe, cookingSession)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "determining cooking session state")
+		errRes := types.NewAPIErrorResponse("internal error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	responseValue := &types.APIResponse[*types.CookingSessionState]{
+		Details: responseDetails,
+		Data:    state,
+	}
+
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ListHandler is our list route.
+func (s *service) ListHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	filter := types.ExtractQueryFilterFromRequest(req)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	logger = filter.AttachToLogger(logger)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	tracing.AttachRequestToSpan(span, req)
+	tracing.AttachFilterDataToSpan(span, filter.Page, filter.Limit, filter.SortBy)
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	householdID := sessionCtxData.ActiveHouseholdID
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+
+	cookingSessions, err := s.cookingSessionDataManager.GetCookingSessions(ctx, householdID, filter)
+	if errors.Is(err, sql.ErrNoRows) {
+		// in the event no rows exist, return an empty list.
+		cookingSessions = &types.QueryFilteredResult[types.CookingSession]{Data: []*types.CookingSession{}}
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving cooking sessions")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	responseValue := &types.APIResponse[[]*types.CookingSession]{
+		Details:    responseDetails,
+		Data:       cookingSessions.Data,
+		Pagination: &cookingSessions.Pagination,
+	}
+
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ProgressHandler records a step being finished, a completion condition being satisfied, or an observation being
+// made in a cooking session, and responds with the session's new state.
+func (s *service) ProgressHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	householdID := sessionCtxData.ActiveHouseholdID
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+
+	// read parsed input struct from request body.
+	providedInput := new(types.CookingSessionProgressCreationRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, providedInput); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = providedInput.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	// determine cooking session ID.
+	cookingSessionID := s.cookingSessionIDFetcher(req)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+
+	cookingSession, recipe, ok := s.fetchCookingSessionAndRecipe(res, req, cookingSessionID, householdID, responseDetails)
+	if !ok {
+		return
+	}
+
+	input := &types.CookingSessionProgressDatabaseCreationInput{
+		ID:                      identifiers.New(),
+		BelongsToCookingSession: cookingSession.ID,
+		ProgressType:            providedInput.ProgressType,
+		Subject:                 providedInput.Subject,
+		Value:                   providedInput.Value,
+		RecordedByUser:          sessionCtxData.Requester.UserID,
+	}
+
+	tracing.AttachToSpan(span, keys.CookingSessionProgressEntryIDKey, input.ID)
+
+	// progress is validated against the session as it stands once its row is locked, so two members
+	// recording progress at the same time can't both be validated against the same, stale state.
+	var (
+		state         *types.CookingSessionState
+		validationErr error
+	)
+
+	createTimer := timing.NewMetric("database").WithDesc("create progress entry").Start()
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if cookingSession, err = s.cookingSessionDataManager.GetCookingSessionForUpdate(ctx, cookingSessionID, householdID); err != nil {
+			return observability.PrepareError(err, span, "locking cooking session")
+		}
+
+		if state, err = s.sessionEngine.DetermineState(ctx, recipe, cookingSession); err != nil {
+			return observability.PrepareError(err, span, "determining cooking session state")
+		}
+
+		if validationErr = s.sessionEngine.ValidateProgress(ctx, recipe, state, providedInput); validationErr != nil {
+			return validationErr
+		}
+
+		entry, createErr := s.cookingSessionDataManager.CreateCookingSessionProgressEntry(ctx, input)
+		if createErr != nil {
+			return observability.PrepareError(createErr, span, "recording cooking session progress")
+		}
+
+		cookingSession.Progress = append(cookingSession.Progress, entry)
+		if state, err = s.sessionEngine.DetermineState(ctx, recipe, cookingSession); err != nil {
+			return observability.PrepareError(err, span, "determining cooking session state")
+		}
+
+		if state.Finished && cookingSession.CompletedAt == nil {
+			if err = s.cookingSessionDataManager.MarkCookingSessionAsCompleted(ctx, cookingSession.ID, householdID); err != nil {
+				return observability.PrepareError(err, span, "marking cooking session as completed")
+			}
+
+			now := time.Now()
+			cookingSession.CompletedAt = &now
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                   types.CookingSessionProgressRecordedCustomerEventType,
+			CookingSession:              cookingSession,
+			CookingSessionProgressEntry: entry,
+			HouseholdID:                 householdID,
+			UserID:                      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); validationErr != nil {
+		logger.WithValue(keys.ValidationErrorKey, validationErr).Debug("provided progress was invalid")
+		errRes := types.NewAPIErrorResponse(validationErr.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	} else if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "recording cooking session progress")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	createTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.CookingSessionState]{
+		Details: responseDetails,
+		Data:    state,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
+// ArchiveHandler returns a handler that archives a cooking session.
+func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	householdID := sessionCtxData.ActiveHouseholdID
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+
+	// determine cooking session ID.
+	cookingSessionID := s.cookingSessionIDFetcher(req)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+
+	existenceTimer := timing.NewMetric("database").WithDesc("existence check").Start()
+	if _, err = s.cookingSessionDataManager.GetCookingSession(ctx, cookingSessionID, householdID); errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "checking cooking session existence")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	existenceTimer.Stop()
+
+	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.cookingSessionDataManager.ArchiveCookingSession(ctx, cookingSessionID, householdID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:        types.CookingSessionArchivedCustomerEventType,
+			CookingSessionID: cookingSessionID,
+			HouseholdID:      householdID,
+			UserID:           sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "archiving cooking session")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	archiveTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.CookingSession]{
+		Details: responseDetails,
+	}
+
+	// let everybody go home.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// fetchCookingSessionAndRecipe fetches a cooking session and the recipe being cooked in it, writing an error response
+// and returning false if either can't be had.
+func (s *service) fetchCookingSessionAndRecipe(res http.ResponseWriter, req *http.Request, cookingSessionID, householdID string, responseDetails types.ResponseDetails) (*types.CookingSession, *types.Recipe, bool) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(keys.CookingSessionIDKey, cookingSessionID)
+
+	cookingSession, err := s.cookingSessionDataManager.GetCookingSession(ctx, cookingSessionID, householdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return nil, nil, false
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving cooking session")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return nil, nil, false
+	}
+
+	recipe, err := s.recipeDataManager.GetRecipe(ctx, cookingSession.RecipeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return nil, nil, false
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving recipe for cooking session")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return nil, nil, false
+	}
+
+	return cookingSession, recipe, true
+}
@@ -0,0 +1,1041 @@
+package cookingsessions
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/features/cookingengine"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCookingSessionsService_CreateHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleCreationInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+		exampleCreationInput.RecipeID = helper.exampleRecipe.ID
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"ValidateRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+		).Return(nil)
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return(helper.exampleState, nil)
+		helper.service.sessionEngine = sessionEngine
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"CreateCookingSession",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.CookingSessionDatabaseCreationInput) bool {
+				return input.RecipeID == helper.exampleRecipe.ID &&
+					input.BelongsToHousehold == helper.exampleHousehold.ID &&
+					input.CreatedByUser == helper.exampleUser.ID
+			}),
+		).Return(helper.exampleCookingSession, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, helper.exampleState, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, sessionEngine, cookingSessionDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("without input attached", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(nil))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid input attached", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, &types.CookingSessionCreationRequestInput{})
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleCreationInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+		exampleCreationInput.RecipeID = helper.exampleRecipe.ID
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return((*types.Recipe)(nil), sql.ErrNoRows)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with recipe that cannot be cooked", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleCreationInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+		exampleCreationInput.RecipeID = helper.exampleRecipe.ID
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"ValidateRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+		).Return(fmt.Errorf("%w: step 1 has an invalid condition expression", cookingengine.ErrInvalidRecipe))
+		helper.service.sessionEngine = sessionEngine
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, sessionEngine)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleCreationInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+		exampleCreationInput.RecipeID = helper.exampleRecipe.ID
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"ValidateRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+		).Return(nil)
+		helper.service.sessionEngine = sessionEngine
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"CreateCookingSession",
+			testutils.ContextMatcher,
+			mock.IsType(&types.CookingSessionDatabaseCreationInput{}),
+		).Return((*types.CookingSession)(nil), errors.New("blah"))
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, sessionEngine, cookingSessionDataManager)
+	})
+}
+
+func TestCookingSessionsService_ReadHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return(helper.exampleState, nil)
+		helper.service.sessionEngine = sessionEngine
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, helper.exampleState, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such cooking session in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.CookingSession)(nil), sql.ErrNoRows)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager)
+	})
+
+	T.Run("with error fetching recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return((*types.Recipe)(nil), errors.New("blah"))
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager)
+	})
+
+	T.Run("with error determining state", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return((*types.CookingSessionState)(nil), errors.New("blah"))
+		helper.service.sessionEngine = sessionEngine
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine)
+	})
+}
+
+func TestCookingSessionsService_ListHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleCookingSessionList := fakes.BuildFakeCookingSessionList()
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSessions",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			testutils.QueryFilterMatcher,
+		).Return(exampleCookingSessionList, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.ListHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleCookingSessionList.Data, actual.Data)
+		assert.Equal(t, *actual.Pagination, exampleCookingSessionList.Pagination)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ListHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[[]*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no rows returned", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSessions",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			testutils.QueryFilterMatcher,
+		).Return((*types.QueryFilteredResult[types.CookingSession])(nil), sql.ErrNoRows)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.ListHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager)
+	})
+
+	T.Run("with error retrieving cooking sessions from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSessions",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			testutils.QueryFilterMatcher,
+		).Return((*types.QueryFilteredResult[types.CookingSession])(nil), errors.New("blah"))
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.ListHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[[]*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager)
+	})
+}
+
+func TestCookingSessionsService_ProgressHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		exampleEntry := fakes.BuildFakeCookingSessionProgressEntry(helper.exampleCookingSession.ID)
+		exampleEntry.ProgressType = exampleInput.ProgressType
+		exampleEntry.Subject = exampleInput.Subject
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"GetCookingSessionForUpdate",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"CreateCookingSessionProgressEntry",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.CookingSessionProgressDatabaseCreationInput) bool {
+				return input.BelongsToCookingSession == helper.exampleCookingSession.ID &&
+					input.Subject == exampleInput.Subject &&
+					input.RecordedByUser == helper.exampleUser.ID
+			}),
+		).Return(exampleEntry, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return(helper.exampleState, nil)
+		sessionEngine.On(
+			"ValidateProgress",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleState,
+			exampleInput,
+		).Return(nil)
+		helper.service.sessionEngine = sessionEngine
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.NoError(t, actual.Error.AsError())
+		assert.Contains(t, helper.exampleCookingSession.Progress, exampleEntry)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine, dataChangesPublisher)
+	})
+
+	T.Run("marks finished sessions as completed", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		finishedState := fakes.BuildFakeCookingSessionState()
+		finishedState.Session = helper.exampleCookingSession
+		finishedState.Finished = true
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		exampleEntry := fakes.BuildFakeCookingSessionProgressEntry(helper.exampleCookingSession.ID)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"GetCookingSessionForUpdate",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"CreateCookingSessionProgressEntry",
+			testutils.ContextMatcher,
+			mock.IsType(&types.CookingSessionProgressDatabaseCreationInput{}),
+		).Return(exampleEntry, nil)
+		cookingSessionDataManager.On(
+			"MarkCookingSessionAsCompleted",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return(helper.exampleState, nil).Once()
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return(finishedState, nil).Once()
+		sessionEngine.On(
+			"ValidateProgress",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleState,
+			exampleInput,
+		).Return(nil)
+		helper.service.sessionEngine = sessionEngine
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.NotNil(t, helper.exampleCookingSession.CompletedAt)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine, dataChangesPublisher)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid input attached", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, &types.CookingSessionProgressCreationRequestInput{ProgressType: "eaten"})
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with progress the engine rejects", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		// someone else's progress was recorded between the handler's first read and the lock.
+		lockedCookingSession := *helper.exampleCookingSession
+		lockedCookingSession.Progress = append(slices.Clone(lockedCookingSession.Progress), fakes.BuildFakeCookingSessionProgressEntry(helper.exampleCookingSession.ID))
+		cookingSessionDataManager.On(
+			"GetCookingSessionForUpdate",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(&lockedCookingSession, nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			&lockedCookingSession,
+		).Return(helper.exampleState, nil)
+		sessionEngine.On(
+			"ValidateProgress",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleState,
+			exampleInput,
+		).Return(cookingengine.ErrStepNotUnlocked)
+		helper.service.sessionEngine = sessionEngine
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine)
+	})
+
+	T.Run("with error locking cooking session", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"GetCookingSessionForUpdate",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.CookingSession)(nil), errors.New("blah"))
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		helper.service.sessionEngine = sessionEngine
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"GetCookingSessionForUpdate",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"CreateCookingSessionProgressEntry",
+			testutils.ContextMatcher,
+			mock.IsType(&types.CookingSessionProgressDatabaseCreationInput{}),
+		).Return((*types.CookingSessionProgressEntry)(nil), errors.New("blah"))
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		sessionEngine := &cookingengine.MockSessionEngine{}
+		sessionEngine.On(
+			"DetermineState",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleCookingSession,
+		).Return(helper.exampleState, nil)
+		sessionEngine.On(
+			"ValidateProgress",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			helper.exampleState,
+			exampleInput,
+		).Return(nil)
+		helper.service.sessionEngine = sessionEngine
+
+		helper.service.ProgressHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSessionState]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, recipeDataManager, sessionEngine)
+	})
+}
+
+func TestCookingSessionsService_ArchiveHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"ArchiveCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(nil)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.ArchiveHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ArchiveHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such cooking session in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.CookingSession)(nil), sql.ErrNoRows)
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.ArchiveHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		cookingSessionDataManager := &mocktypes.CookingSessionDataManagerMock{}
+		cookingSessionDataManager.On(
+			"GetCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleCookingSession, nil)
+		cookingSessionDataManager.On(
+			"ArchiveCookingSession",
+			testutils.ContextMatcher,
+			helper.exampleCookingSession.ID,
+			helper.exampleHousehold.ID,
+		).Return(errors.New("blah"))
+		helper.service.cookingSessionDataManager = cookingSessionDataManager
+
+		helper.service.ArchiveHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookingSession]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, cookingSessionDataManager)
+	})
+}
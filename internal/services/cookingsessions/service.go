@@ -0,0 +1,72 @@
+package cookingsessions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/cookingengine"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/routing"
+	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	serviceName string = "cooking_sessions_service"
+)
+
+var _ types.CookingSessionDataService = (*service)(nil)
+
+type (
+	// service handles cooking sessions.
+	service struct {
+		logger                    logging.Logger
+		cookingSessionDataManager types.CookingSessionDataManager
+		recipeDataManager         types.RecipeDataManager
+		sessionEngine             cookingengine.SessionEngine
+		cookingSessionIDFetcher   func(*http.Request) string
+		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
+		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
+		encoderDecoder            encoding.ServerEncoderDecoder
+		tracer                    tracing.Tracer
+	}
+)
+
+// ProvideService builds a new CookingSessionsService.
+func ProvideService(
+	logger logging.Logger,
+	cfg *Config,
+	cookingSessionDataManager types.CookingSessionDataManager,
+	recipeDataManager types.RecipeDataManager,
+	sessionEngine cookingengine.SessionEngine,
+	transactionRunner database.TransactionRunner,
+	encoder encoding.ServerEncoderDecoder,
+	routeParamManager routing.RouteParamManager,
+	publisherProvider messagequeue.PublisherProvider,
+	tracerProvider tracing.TracerProvider,
+) (types.CookingSessionDataService, error) {
+	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
+	if err != nil {
+		return nil, fmt.Errorf("setting up cooking sessions service data changes publisher: %w", err)
+	}
+
+	svc := &service{
+		logger:                    logging.EnsureLogger(logger).WithName(serviceName),
+		cookingSessionIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(CookingSessionIDURIParamKey),
+		sessionContextDataFetcher: authservice.FetchContextFromRequest,
+		cookingSessionDataManager: cookingSessionDataManager,
+		recipeDataManager:         recipeDataManager,
+		sessionEngine:             sessionEngine,
+		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
+		encoderDecoder:            encoder,
+		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
+	}
+
+	return svc, nil
+}
@@ -1,6 +1,7 @@
 package householdinstrumentownerships
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -71,8 +72,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.HouseholdInstrumentOwnershipIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	householdInstrumentOwnership, err := s.householdInstrumentOwnershipDataManager.CreateHouseholdInstrumentOwnership(ctx, input)
-	if err != nil {
+	var householdInstrumentOwnership *types.HouseholdInstrumentOwnership
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if householdInstrumentOwnership, err = s.householdInstrumentOwnershipDataManager.CreateHouseholdInstrumentOwnership(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                    types.HouseholdInstrumentOwnershipCreatedCustomerEventType,
+			HouseholdInstrumentOwnership: householdInstrumentOwnership,
+			UserID:                       sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating household instrument ownership")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -80,16 +93,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                    types.HouseholdInstrumentOwnershipCreatedCustomerEventType,
-		HouseholdInstrumentOwnership: householdInstrumentOwnership,
-		UserID:                       sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.HouseholdInstrumentOwnership]{
 		Details: responseDetails,
 		Data:    householdInstrumentOwnership,
@@ -272,7 +275,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	householdInstrumentOwnership.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.householdInstrumentOwnershipDataManager.UpdateHouseholdInstrumentOwnership(ctx, householdInstrumentOwnership); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdInstrumentOwnershipDataManager.UpdateHouseholdInstrumentOwnership(ctx, householdInstrumentOwnership); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                    types.HouseholdInstrumentOwnershipUpdatedCustomerEventType,
+			HouseholdInstrumentOwnership: householdInstrumentOwnership,
+			UserID:                       sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating household instrument ownership")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -280,16 +295,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                    types.HouseholdInstrumentOwnershipUpdatedCustomerEventType,
-		HouseholdInstrumentOwnership: householdInstrumentOwnership,
-		UserID:                       sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.HouseholdInstrumentOwnership]{
 		Details: responseDetails,
 		Data:    householdInstrumentOwnership,
@@ -347,7 +352,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.householdInstrumentOwnershipDataManager.ArchiveHouseholdInstrumentOwnership(ctx, householdInstrumentOwnershipID, householdID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdInstrumentOwnershipDataManager.ArchiveHouseholdInstrumentOwnership(ctx, householdInstrumentOwnershipID, householdID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.HouseholdInstrumentOwnershipArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving household instrument ownership")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -355,15 +371,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.HouseholdInstrumentOwnershipArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.HouseholdInstrumentOwnership]{
 		Details: responseDetails,
 	}
@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -27,6 +28,7 @@
 		householdInstrumentOwnershipIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher               func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher                    messagequeue.Publisher
+		transactionRunner                       database.TransactionRunner
 		encoderDecoder                          encoding.ServerEncoderDecoder
 		tracer                                  tracing.Tracer
 	}
@@ -37,6 +39,7 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	householdInstrumentOwnershipDataManager types.HouseholdInstrumentOwnershipDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -53,6 +56,7 @@ func ProvideService(
 		sessionContextDataFetcher:               authservice.FetchContextFromRequest,
 		householdInstrumentOwnershipDataManager: householdInstrumentOwnershipDataManager,
 		dataChangesPublisher:                    dataChangesPublisher,
+		transactionRunner:                       transactionRunner,
 		encoderDecoder:                          encoder,
 		tracer:                                  tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,6 +20,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:                       database.NewNoopTransactionRunner(),
 		logger:                                  logging.NewNoopLogger(),
 		householdInstrumentOwnershipDataManager: &mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
 		householdInstrumentOwnershipIDFetcher:   func(req *http.Request) string { return "" },
@@ -52,6 +54,7 @@ func TestProvideHouseholdInstrumentOwnershipsService(T *testing.T) {
 			logger,
 			cfg,
 			&mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -80,6 +83,7 @@ func TestProvideHouseholdInstrumentOwnershipsService(T *testing.T) {
 			logger,
 			cfg,
 			&mocktypes.HouseholdInstrumentOwnershipDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -1,6 +1,7 @@
 package householdinvitations
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -113,8 +114,21 @@ func (s *service) InviteMemberHandler(res http.ResponseWriter, req *http.Request
 	}
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	householdInvitation, err := s.householdInvitationDataManager.CreateHouseholdInvitation(ctx, input)
-	if err != nil {
+	var householdInvitation *types.HouseholdInvitation
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if householdInvitation, err = s.householdInvitationDataManager.CreateHouseholdInvitation(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:           types.HouseholdInvitationCreatedCustomerEventType,
+			HouseholdInvitation: householdInvitation,
+			HouseholdID:         householdID,
+			UserID:              userID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating household invitation")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -122,17 +136,6 @@ func (s *service) InviteMemberHandler(res http.ResponseWriter, req *http.Request
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:           types.HouseholdInvitationCreatedCustomerEventType,
-		HouseholdInvitation: householdInvitation,
-		HouseholdID:         householdID,
-		UserID:              userID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.HouseholdInvitation]{
 		Details: responseDetails,
 		Data:    householdInvitation,
@@ -373,24 +376,26 @@ func (s *service) AcceptInviteHandler(res http.ResponseWriter, req *http.Request
 	}
 	readTimer.Stop()
 
-	if err = s.householdInvitationDataManager.AcceptHouseholdInvitation(ctx, invitation.ID, providedInput.Token, providedInput.Note); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdInvitationDataManager.AcceptHouseholdInvitation(ctx, invitation.ID, providedInput.Token, providedInput.Note); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:             types.HouseholdInvitationAcceptedCustomerEventType,
+			HouseholdID:           invitation.DestinationHousehold.ID,
+			HouseholdInvitationID: householdInvitationID,
+			UserID:                sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "accepting invitation")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:             types.HouseholdInvitationAcceptedCustomerEventType,
-		HouseholdID:           invitation.DestinationHousehold.ID,
-		HouseholdInvitationID: householdInvitationID,
-		UserID:                sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[[]*types.HouseholdInvitation]{
 		Details: responseDetails,
 	}
@@ -462,24 +467,26 @@ func (s *service) CancelInviteHandler(res http.ResponseWriter, req *http.Request
 	}
 	readTimer.Stop()
 
-	if err = s.householdInvitationDataManager.CancelHouseholdInvitation(ctx, invitation.ID, providedInput.Note); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdInvitationDataManager.CancelHouseholdInvitation(ctx, invitation.ID, providedInput.Note); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:             types.HouseholdInvitationCanceledCustomerEventType,
+			HouseholdID:           invitation.DestinationHousehold.ID,
+			HouseholdInvitationID: householdInvitationID,
+			UserID:                sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "cancelling invitation")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:             types.HouseholdInvitationCanceledCustomerEventType,
-		HouseholdID:           invitation.DestinationHousehold.ID,
-		HouseholdInvitationID: householdInvitationID,
-		UserID:                sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[[]*types.HouseholdInvitation]{
 		Details: responseDetails,
 	}
@@ -550,24 +557,26 @@ func (s *service) RejectInviteHandler(res http.ResponseWriter, req *http.Request
 	}
 	readTimer.Stop()
 
-	if err = s.householdInvitationDataManager.RejectHouseholdInvitation(ctx, invitation.ID, providedInput.Note); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdInvitationDataManager.RejectHouseholdInvitation(ctx, invitation.ID, providedInput.Note); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:             types.HouseholdInvitationRejectedCustomerEventType,
+			HouseholdID:           invitation.DestinationHousehold.ID,
+			HouseholdInvitationID: householdInvitationID,
+			UserID:                sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "rejecting invitation")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:             types.HouseholdInvitationRejectedCustomerEventType,
-		HouseholdID:           invitation.DestinationHousehold.ID,
-		HouseholdInvitationID: householdInvitationID,
-		UserID:                sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[[]*types.HouseholdInvitation]{
 		Details: responseDetails,
 	}
@@ -299,13 +299,13 @@ func Test_service_InviteMemberHandler(T *testing.T) {
 
 		helper.service.InviteMemberHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.HouseholdInvitation]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		helper.exampleHouseholdInvitation.FromUser.TwoFactorSecret = ""
 		helper.exampleHouseholdInvitation.DestinationHousehold.WebhookEncryptionKey = ""
-		assert.Equal(t, actual.Data, helper.exampleHouseholdInvitation)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, udm, sg, dbManager, dataChangesPublisher)
 	})
@@ -932,10 +932,10 @@ func Test_service_AcceptInviteHandler(T *testing.T) {
 
 		helper.service.AcceptInviteHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.HouseholdInvitation]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dataManager, dataChangesPublisher)
 	})
@@ -1201,10 +1201,10 @@ func Test_service_CancelInviteHandler(T *testing.T) {
 
 		helper.service.CancelInviteHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.HouseholdInvitation]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dataManager, dataChangesPublisher)
 	})
@@ -1471,10 +1471,10 @@ func Test_service_RejectInviteHandler(T *testing.T) {
 
 		helper.service.RejectInviteHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.HouseholdInvitation]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dataManager, dataChangesPublisher)
 	})
@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/email"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
@@ -35,6 +36,7 @@
 		emailer                        email.Emailer
 		secretGenerator                random.Generator
 		dataChangesPublisher           messagequeue.Publisher
+		transactionRunner              database.TransactionRunner
 		householdIDFetcher             func(*http.Request) string
 		householdInvitationIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher      func(*http.Request) (*types.SessionContextData, error)
@@ -47,6 +49,7 @@ func ProvideHouseholdInvitationsService(
 	cfg *Config,
 	userDataManager types.UserDataManager,
 	householdInvitationDataManager types.HouseholdInvitationDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -65,6 +68,7 @@ func ProvideHouseholdInvitationsService(
 		householdInvitationDataManager: householdInvitationDataManager,
 		encoderDecoder:                 encoder,
 		dataChangesPublisher:           dataChangesPublisher,
+		transactionRunner:              transactionRunner,
 		emailer:                        emailer,
 		secretGenerator:                secretGenerator,
 		sessionContextDataFetcher:      authservice.FetchContextFromRequest,
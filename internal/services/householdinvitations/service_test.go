@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	mock2 "github.com/dinnerdonebetter/backend/internal/email/mock"
 	encoding "github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
@@ -22,6 +23,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:            database.NewNoopTransactionRunner(),
 		logger:                       logging.NewNoopLogger(),
 		householdInvitationIDFetcher: func(req *http.Request) string { return "" },
 		encoderDecoder:               encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
@@ -57,6 +59,7 @@ func TestProvideHouseholdInvitationsService(T *testing.T) {
 			cfg,
 			&mocktypes.UserDataManagerMock{},
 			&mocktypes.HouseholdInvitationDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -86,6 +89,7 @@ func TestProvideHouseholdInvitationsService(T *testing.T) {
 			cfg,
 			&mocktypes.UserDataManagerMock{},
 			&mocktypes.HouseholdInvitationDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -10,7 +11,9 @@
 type Config struct {
 	_ struct{} `json:"-"`
 
-	DataChangesTopicName string `json:"dataChangesTopicName,omitempty" toml:"data_changes_topic_name,omitempty"`
+	DataChangesTopicName            string        `json:"dataChangesTopicName,omitempty"            toml:"data_changes_topic_name,omitempty"`
+	WebhookEncryptionKeyGracePeriod time.Duration `json:"webhookEncryptionKeyGracePeriod,omitempty" toml:"webhook_encryption_key_grace_period,omitempty"`
+	EventStreamPollInterval         time.Duration `json:"eventStreamPollInterval,omitempty"         toml:"event_stream_poll_interval,omitempty"`
 }
 
 var _ validation.ValidatableWithContext = (*Config)(nil)
@@ -0,0 +1,256 @@
+package households
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	defaultEventStreamPollInterval = time.Second
+	eventStreamHeartbeatInterval   = 15 * time.Second
+	eventStreamBatchSize           = 100
+	// eventStreamDeadlineMargin is how long before the request deadline a stream is ended, so that it
+	// ends cleanly and the client reconnects rather than the router timing the request out.
+	eventStreamDeadlineMargin = time.Second
+	websocketWriteTimeout     = 10 * time.Second
+)
+
+var (
+	// householdEventPermissions maps the events that are streamed to household members to the permission
+	// the equivalent REST handlers require. Events that map to no permission only require membership.
+	householdEventPermissions = map[types.ServiceEventType]authorization.Permission{
+		types.HouseholdUpdatedCustomerEventType:                      "",
+		types.HouseholdMemberRemovedCustomerEventType:                "",
+		types.HouseholdMembershipPermissionsUpdatedCustomerEventType: "",
+		types.HouseholdOwnershipTransferredCustomerEventType:         "",
+		types.MealPlanCreatedCustomerEventType:                       authorization.ReadMealPlansPermission,
+		types.MealPlanUpdatedCustomerEventType:                       authorization.ReadMealPlansPermission,
+		types.MealPlanArchivedCustomerEventType:                      authorization.ReadMealPlansPermission,
+		types.MealPlanFinalizedCustomerEventType:                     authorization.ReadMealPlansPermission,
+		types.MealPlanEventCreatedCustomerEventType:                  authorization.ReadMealPlanEventsPermission,
+		types.MealPlanEventUpdatedCustomerEventType:                  authorization.ReadMealPlanEventsPermission,
+		types.MealPlanEventArchivedCustomerEventType:                 authorization.ReadMealPlanEventsPermission,
+		types.MealPlanOptionCreatedCustomerEventType:                 authorization.ReadMealPlanOptionsPermission,
+		types.MealPlanOptionUpdatedCustomerEventType:                 authorization.ReadMealPlanOptionsPermission,
+		types.MealPlanOptionArchivedCustomerEventType:                authorization.ReadMealPlanOptionsPermission,
+		types.MealPlanOptionFinalizedCreatedCustomerEventType:        authorization.ReadMealPlanOptionsPermission,
+		types.MealPlanOptionVoteCreatedCustomerEventType:             authorization.ReadMealPlanOptionVotesPermission,
+		types.MealPlanOptionVoteUpdatedCustomerEventType:             authorization.ReadMealPlanOptionVotesPermission,
+		types.MealPlanOptionVoteArchivedCustomerEventType:            authorization.ReadMealPlanOptionVotesPermission,
+		types.MealPlanGroceryListItemCreatedCustomerEventType:        authorization.ReadMealPlanGroceryListItemsPermission,
+		types.MealPlanGroceryListItemUpdatedCustomerEventType:        authorization.ReadMealPlanGroceryListItemsPermission,
+		types.MealPlanGroceryListItemArchivedCustomerEventType:       authorization.ReadMealPlanGroceryListItemsPermission,
+		types.MealPlanTaskCreatedCustomerEventType:                   authorization.ReadMealPlanTasksPermission,
+		types.MealPlanTaskStatusChangedCustomerEventType:             authorization.ReadMealPlanTasksPermission,
+		types.HouseholdInstrumentOwnershipCreatedCustomerEventType:   authorization.ReadHouseholdInstrumentOwnershipsPermission,
+		types.HouseholdInstrumentOwnershipUpdatedCustomerEventType:   authorization.ReadHouseholdInstrumentOwnershipsPermission,
+		types.HouseholdInstrumentOwnershipArchivedCustomerEventType:  authorization.ReadHouseholdInstrumentOwnershipsPermission,
+		types.CookingSessionCreatedCustomerEventType:                 authorization.ReadCookingSessionsPermission,
+		types.CookingSessionProgressRecordedCustomerEventType:        authorization.ReadCookingSessionsPermission,
+		types.CookingSessionArchivedCustomerEventType:                authorization.ReadCookingSessionsPermission,
+		types.PantryItemCreatedCustomerEventType:                     authorization.ReadPantryItemsPermission,
+		types.PantryItemUpdatedCustomerEventType:                     authorization.ReadPantryItemsPermission,
+		types.PantryItemArchivedCustomerEventType:                    authorization.ReadPantryItemsPermission,
+	}
+
+	errInvalidLastEventID = errors.New("invalid last event ID")
+)
+
+// buildHouseholdEventFilter returns a function that reports whether the requester may see a given household event.
+// Narrowly scoped OAuth2 tokens only see events their scopes grant the permission for, so never membership-only ones.
+func buildHouseholdEventFilter(sessionCtxData *types.SessionContextData, householdID string) func(*types.HouseholdEvent) bool {
+	householdPermissions := sessionCtxData.HouseholdPermissions[householdID]
+	servicePermissions := sessionCtxData.Requester.ServicePermissions
+	tokenScopes := sessionCtxData.TokenScopes
+	scopedToken := tokenScopes != nil && !tokenScopes.IsServiceAdmin()
+
+	return func(event *types.HouseholdEvent) bool {
+		perm, streamable := householdEventPermissions[event.EventType]
+		if !streamable {
+			return false
+		}
+
+		if scopedToken && (perm == "" || !tokenScopes.HasPermission(perm)) {
+			return false
+		}
+
+		if !scopedToken && servicePermissions != nil && servicePermissions.IsServiceAdmin() {
+			return true
+		}
+
+		if householdPermissions == nil {
+			return false
+		}
+
+		return perm == "" || householdPermissions.HasPermission(perm) || servicePermissions.HasPermission(perm)
+	}
+}
+
+// lastEventIDFromRequest fetches the event a client wants to resume a stream after, if any.
+func lastEventIDFromRequest(req *http.Request) (cursor types.HouseholdEventCursor, provided bool, err error) {
+	raw := req.Header.Get(types.LastEventIDHeaderKey)
+	if raw == "" {
+		raw = req.URL.Query().Get(types.LastEventIDQueryKey)
+	}
+
+	if raw == "" {
+		return types.HouseholdEventCursor{}, false, nil
+	}
+
+	cursor, err = types.ParseHouseholdEventCursor(raw)
+	if err != nil {
+		return types.HouseholdEventCursor{}, false, errInvalidLastEventID
+	}
+
+	return cursor, true, nil
+}
+
+// withStreamDeadline returns a context that ends shortly before the request's own deadline, if it has one.
+func withStreamDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(ctx, deadline.Add(-eventStreamDeadlineMargin))
+	}
+
+	return context.WithCancel(ctx)
+}
+
+type householdEventWriter interface {
+	writeEvent(*types.HouseholdEvent) error
+	writeHeartbeat() error
+}
+
+// serverSentEventWriter writes household events as server-sent events.
+type serverSentEventWriter struct {
+	res        http.ResponseWriter
+	controller *http.ResponseController
+}
+
+func newServerSentEventWriter(res http.ResponseWriter) *serverSentEventWriter {
+	controller := http.NewResponseController(res)
+
+	// streams outlive the server's write timeout; the errors are ignored because not every writer supports this.
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	res.Header().Set("Content-Type", "text/event-stream")
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	_ = controller.Flush()
+
+	return &serverSentEventWriter{
+		res:        res,
+		controller: controller,
+	}
+}
+
+func (w *serverSentEventWriter) writeEvent(event *types.HouseholdEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprintf(w.res, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.EventType, data); err != nil {
+		return err
+	}
+
+	return w.controller.Flush()
+}
+
+func (w *serverSentEventWriter) writeHeartbeat() error {
+	if _, err := fmt.Fprint(w.res, ": heartbeat\n\n"); err != nil {
+		return err
+	}
+
+	return w.controller.Flush()
+}
+
+// websocketEventWriter writes household events as websocket JSON messages.
+type websocketEventWriter struct {
+	conn *websocket.Conn
+}
+
+func (w *websocketEventWriter) writeEvent(event *types.HouseholdEvent) error {
+	if err := w.conn.SetWriteDeadline(time.Now().Add(websocketWriteTimeout)); err != nil {
+		return err
+	}
+
+	return w.conn.WriteJSON(event)
+}
+
+func (w *websocketEventWriter) writeHeartbeat() error {
+	return w.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(websocketWriteTimeout))
+}
+
+// discardIncomingMessages reads from a websocket until the client goes away, which is what lets us notice that it has.
+func discardIncomingMessages(conn *websocket.Conn, cancel context.CancelFunc) {
+	defer cancel()
+
+	for {
+		if _, _, err := conn.NextReader(); err != nil {
+			return
+		}
+	}
+}
+
+// streamHouseholdEvents writes the household's events that come after the provided cursor until ctx ends.
+func (s *service) streamHouseholdEvents(
+	ctx context.Context,
+	writer householdEventWriter,
+	householdID string,
+	after types.HouseholdEventCursor,
+	canSee func(*types.HouseholdEvent) bool,
+) error {
+	poll := time.NewTicker(s.eventStreamPollInterval)
+	defer poll.Stop()
+
+	heartbeat := time.NewTicker(eventStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for ctx.Err() == nil {
+		events, err := s.householdEventDataManager.GetHouseholdEvents(ctx, householdID, after, eventStreamBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("fetching household events: %w", err)
+		}
+
+		for _, event := range events {
+			after = event.ID
+			if !canSee(event) {
+				continue
+			}
+
+			if err = writer.writeEvent(event); err != nil {
+				return fmt.Errorf("writing household event: %w", err)
+			}
+		}
+
+		// a full batch means there may be more waiting.
+		if len(events) == eventStreamBatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if err = writer.writeHeartbeat(); err != nil {
+				return fmt.Errorf("writing heartbeat: %w", err)
+			}
+		case <-poll.C:
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package households
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildHouseholdEventFilter(T *testing.T) {
+	T.Parallel()
+
+	householdID := fakes.BuildFakeID()
+	visible := &types.HouseholdEvent{EventType: types.MealPlanOptionVoteCreatedCustomerEventType}
+	membershipOnly := &types.HouseholdEvent{EventType: types.HouseholdUpdatedCustomerEventType}
+	unstreamable := &types.HouseholdEvent{EventType: types.HouseholdWebhookEncryptionKeyRotatedCustomerEventType}
+
+	T.Run("for household member", func(t *testing.T) {
+		t.Parallel()
+
+		canSee := buildHouseholdEventFilter(&types.SessionContextData{
+			Requester: types.RequesterInfo{
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(authorization.ServiceUserRole.String()),
+			},
+			HouseholdPermissions: map[string]authorization.HouseholdRolePermissionsChecker{
+				householdID: authorization.NewHouseholdRolePermissionChecker(authorization.HouseholdMemberRole.String()),
+			},
+		}, householdID)
+
+		assert.True(t, canSee(visible))
+		assert.True(t, canSee(membershipOnly))
+		assert.False(t, canSee(unstreamable))
+	})
+
+	T.Run("for non-member", func(t *testing.T) {
+		t.Parallel()
+
+		canSee := buildHouseholdEventFilter(&types.SessionContextData{
+			Requester: types.RequesterInfo{
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(authorization.ServiceUserRole.String()),
+			},
+			HouseholdPermissions: map[string]authorization.HouseholdRolePermissionsChecker{},
+		}, householdID)
+
+		assert.False(t, canSee(visible))
+		assert.False(t, canSee(membershipOnly))
+	})
+
+	T.Run("for service admin", func(t *testing.T) {
+		t.Parallel()
+
+		canSee := buildHouseholdEventFilter(&types.SessionContextData{
+			Requester: types.RequesterInfo{
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(authorization.ServiceAdminRole.String()),
+			},
+		}, householdID)
+
+		assert.True(t, canSee(visible))
+		assert.False(t, canSee(unstreamable))
+	})
+
+	T.Run("for household member with scoped token", func(t *testing.T) {
+		t.Parallel()
+
+		canSee := buildHouseholdEventFilter(&types.SessionContextData{
+			Requester: types.RequesterInfo{
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(authorization.ServiceUserRole.String()),
+			},
+			HouseholdPermissions: map[string]authorization.HouseholdRolePermissionsChecker{
+				householdID: authorization.NewHouseholdRolePermissionChecker(authorization.HouseholdMemberRole.String()),
+			},
+			TokenScopes: authorization.NewOAuth2ScopePermissionChecker(string(authorization.ReadMealPlansOAuth2Scope)),
+		}, householdID)
+
+		assert.True(t, canSee(visible))
+		assert.False(t, canSee(membershipOnly))
+		assert.False(t, canSee(&types.HouseholdEvent{EventType: types.PantryItemCreatedCustomerEventType}))
+	})
+
+	T.Run("for service admin with scoped token", func(t *testing.T) {
+		t.Parallel()
+
+		canSee := buildHouseholdEventFilter(&types.SessionContextData{
+			Requester: types.RequesterInfo{
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(authorization.ServiceAdminRole.String()),
+			},
+			TokenScopes: authorization.NewOAuth2ScopePermissionChecker(string(authorization.ReadMealPlansOAuth2Scope)),
+		}, householdID)
+
+		assert.False(t, canSee(visible))
+	})
+}
+
+func TestLastEventIDFromRequest(T *testing.T) {
+	T.Parallel()
+
+	T.Run("from header", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := http.NewRequest(http.MethodGet, "https://whatever.whocares.gov", http.NoBody)
+		require.NoError(t, err)
+		req.Header.Set(types.LastEventIDHeaderKey, "123-4")
+
+		actual, provided, err := lastEventIDFromRequest(req)
+		assert.NoError(t, err)
+		assert.True(t, provided)
+		assert.Equal(t, types.HouseholdEventCursor{TransactionID: 123, Sequence: 4}, actual)
+	})
+
+	T.Run("from query", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := http.NewRequest(http.MethodGet, "https://whatever.whocares.gov?"+url.Values{types.LastEventIDQueryKey: []string{"123-4"}}.Encode(), http.NoBody)
+		require.NoError(t, err)
+
+		actual, provided, err := lastEventIDFromRequest(req)
+		assert.NoError(t, err)
+		assert.True(t, provided)
+		assert.Equal(t, types.HouseholdEventCursor{TransactionID: 123, Sequence: 4}, actual)
+	})
+
+	T.Run("without one", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := http.NewRequest(http.MethodGet, "https://whatever.whocares.gov", http.NoBody)
+		require.NoError(t, err)
+
+		actual, provided, err := lastEventIDFromRequest(req)
+		assert.NoError(t, err)
+		assert.False(t, provided)
+		assert.Zero(t, actual)
+	})
+
+	T.Run("with invalid value", func(t *testing.T) {
+		t.Parallel()
+
+		req, err := http.NewRequest(http.MethodGet, "https://whatever.whocares.gov", http.NoBody)
+		require.NoError(t, err)
+		req.Header.Set(types.LastEventIDHeaderKey, "123")
+
+		_, _, err = lastEventIDFromRequest(req)
+		assert.Error(t, err)
+	})
+}
@@ -1,11 +1,15 @@
 package households
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
@@ -13,6 +17,7 @@
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/converters"
 
+	"github.com/gorilla/websocket"
 	servertiming "github.com/mitchellh/go-server-timing"
 )
 
@@ -142,8 +147,22 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 
 	// create household in database.
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	household, err := s.householdDataManager.CreateHousehold(ctx, input)
-	if err != nil {
+	var household *types.Household
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if household, err = s.householdDataManager.CreateHousehold(ctx, input); err != nil {
+			return err
+		}
+
+		// notify relevant parties.
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdCreatedCustomerEventType,
+			Household:   household,
+			HouseholdID: household.ID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating household")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -154,19 +173,8 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	logger = logger.WithValue(keys.HouseholdIDKey, household.ID)
 	tracing.AttachToSpan(span, keys.HouseholdIDKey, household.ID)
 
-	// notify relevant parties.
 	logger.Debug("created household")
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdCreatedCustomerEventType,
-		Household:   household,
-		HouseholdID: household.ID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
-	}
-
 	responseValue := &types.APIResponse[*types.Household]{
 		Details: responseDetails,
 		Data:    household,
@@ -379,7 +387,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 
 	// update household in database.
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.householdDataManager.UpdateHousehold(ctx, household); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdDataManager.UpdateHousehold(ctx, household); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdUpdatedCustomerEventType,
+			Household:   household,
+			HouseholdID: household.ID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating household")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -387,16 +408,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdUpdatedCustomerEventType,
-		Household:   household,
-		HouseholdID: household.ID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
-	}
-
 	responseValue := &types.APIResponse[*types.Household]{
 		Details: responseDetails,
 		Data:    household,
@@ -441,7 +452,19 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 
 	// archive the household in the database.
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	err = s.householdDataManager.ArchiveHousehold(ctx, householdID, requester)
+	err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdDataManager.ArchiveHousehold(ctx, householdID, requester); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdArchivedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	})
 	if errors.Is(err, sql.ErrNoRows) {
 		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
@@ -454,15 +477,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdArchivedCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
-	}
-
 	responseValue := &types.APIResponse[*types.Household]{
 		Details: responseDetails,
 	}
@@ -526,22 +540,25 @@ func (s *service) ModifyMemberPermissionsHandler(res http.ResponseWriter, req *h
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
 	// create household in database.
-	if err = s.householdMembershipDataManager.ModifyUserPermissions(ctx, householdID, userID, input); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdMembershipDataManager.ModifyUserPermissions(ctx, householdID, userID, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdMembershipPermissionsUpdatedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "modifying user permissions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdMembershipPermissionsUpdatedCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
-	}
-
 	responseValue := &types.APIResponse[*types.Webhook]{
 		Details: responseDetails,
 	}
@@ -600,22 +617,25 @@ func (s *service) TransferHouseholdOwnershipHandler(res http.ResponseWriter, req
 	logger = logger.WithValue(keys.RequesterIDKey, requester)
 
 	// transfer ownership of household in database.
-	if err = s.householdMembershipDataManager.TransferHouseholdOwnership(ctx, householdID, input); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdMembershipDataManager.TransferHouseholdOwnership(ctx, householdID, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdOwnershipTransferredCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "transferring household ownership")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdOwnershipTransferredCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
-	}
-
 	responseValue := &types.APIResponse[*types.Webhook]{
 		Details: responseDetails,
 	}
@@ -664,7 +684,19 @@ func (s *service) RemoveMemberHandler(res http.ResponseWriter, req *http.Request
 	tracing.AttachToSpan(span, keys.UserIDKey, userID)
 
 	// remove user from household in database.
-	if err = s.householdMembershipDataManager.RemoveUserFromHousehold(ctx, userID, householdID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdMembershipDataManager.RemoveUserFromHousehold(ctx, userID, householdID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdMemberRemovedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "removing user from household")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -673,15 +705,6 @@ func (s *service) RemoveMemberHandler(res http.ResponseWriter, req *http.Request
 
 	logger.Info("user removed from household")
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdMemberRemovedCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
-	}
-
 	responseValue := &types.APIResponse[*types.Webhook]{
 		Details: responseDetails,
 	}
@@ -722,25 +745,292 @@ func (s *service) MarkAsDefaultHouseholdHandler(res http.ResponseWriter, req *ht
 	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
 
 	// mark household as default in database.
-	if err = s.householdMembershipDataManager.MarkHouseholdAsUserDefault(ctx, requester, householdID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdMembershipDataManager.MarkHouseholdAsUserDefault(ctx, requester, householdID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdMemberRemovedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "marking household as default")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.HouseholdMemberRemovedCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
+	responseValue := &types.APIResponse[*types.Webhook]{
+		Details: responseDetails,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusAccepted)
+}
+
+// RotateWebhookEncryptionKeyHandler replaces a household's webhook encryption key, keeping the old one valid for a grace period.
+func (s *service) RotateWebhookEncryptionKeyHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	householdID := s.householdIDFetcher(req)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+
+	newKey, err := s.secretGenerator.GenerateHexEncodedString(ctx, 128)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "generating webhook encryption key")
+		errRes := types.NewAPIErrorResponse("encryption key error", types.ErrSecretGeneration, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
 	}
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message for created household")
+
+	previousKeyExpiresAt := time.Now().Add(s.webhookKeyGracePeriod)
+
+	// rotate key in database.
+	rotateTimer := timing.NewMetric("database").WithDesc("rotate webhook encryption key").Start()
+	err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.householdDataManager.RotateHouseholdWebhookEncryptionKey(ctx, householdID, sessionCtxData.Requester.UserID, newKey, previousKeyExpiresAt); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.HouseholdWebhookEncryptionKeyRotatedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if errors.Is(err, database.ErrWebhookEncryptionKeyRotationInProgress) {
+		// rotating again now would stop the key receivers may still be using from verifying before its grace period ends.
+		errRes := types.NewAPIErrorResponse("the previous webhook encryption key has not expired yet", types.ErrNothingSpecific, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusConflict)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "rotating webhook encryption key")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
 	}
+	rotateTimer.Stop()
 
-	responseValue := &types.APIResponse[*types.Webhook]{
+	responseValue := &types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]{
 		Details: responseDetails,
+		Data: &types.HouseholdWebhookEncryptionKeyRotationResponse{
+			PreviousKeyExpiresAt: previousKeyExpiresAt,
+			WebhookEncryptionKey: newKey,
+		},
 	}
 
-	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusAccepted)
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// RecommendationsHandler ranks recipes for a household based on its members' preferences, ratings, instruments, and history.
+func (s *service) RecommendationsHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	householdID := s.householdIDFetcher(req)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+
+	// determine desired count.
+	count := types.DefaultRecommendationCount
+	if rawCount := req.URL.Query().Get(types.RecommendationCountQueryKey); rawCount != "" {
+		count, err = strconv.Atoi(rawCount)
+		if err != nil || count <= 0 || count > types.MaxRecommendationCount {
+			errRes := types.NewAPIErrorResponse("invalid count provided", types.ErrValidatingRequestInput, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+			return
+		}
+	}
+	logger = logger.WithValue("count", count)
+
+	recommendTimer := timing.NewMetric("recommendations").WithDesc("rank recipes").Start()
+	recommendations, err := s.recommender.RecommendRecipes(ctx, householdID, count)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "building recipe recommendations")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	recommendTimer.Stop()
+
+	responseValue := &types.APIResponse[[]*types.RecipeRecommendation]{
+		Details: responseDetails,
+		Data:    recommendations,
+	}
+
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// StreamEventsHandler streams a household's events to one of its members as they happen, as server-sent events,
+// or as websocket messages if the client asks to upgrade. Clients can resume a stream by providing the ID of the
+// last event they saw. Streams end shortly before the request would time out, and clients are expected to reconnect.
+// Events are only retained for as long as the outbox keeps published messages around, so a client resuming from an
+// event that has since been pruned is sent a reset event instead, and should refetch whatever state it tracks.
+func (s *service) StreamEventsHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	householdID := s.householdIDFetcher(req)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+
+	if _, isMember := sessionCtxData.HouseholdPermissions[householdID]; !isMember && !sessionCtxData.Requester.ServicePermissions.IsServiceAdmin() {
+		errRes := types.NewAPIErrorResponse("not a member of household", types.ErrUserIsNotAuthorized, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusForbidden)
+		return
+	}
+
+	// determine where to start the stream from.
+	after, resuming, err := lastEventIDFromRequest(req)
+	if err != nil {
+		errRes := types.NewAPIErrorResponse("invalid last event ID provided", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	reset := false
+	if resuming {
+		retained, retentionErr := s.householdEventDataManager.HouseholdEventCursorIsRetained(ctx, householdID, after)
+		if retentionErr != nil {
+			observability.AcknowledgeError(retentionErr, logger, span, "checking household event retention")
+			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+			return
+		}
+		reset = !retained
+	}
+
+	if !resuming || reset {
+		after, err = s.householdEventDataManager.GetHouseholdEventHorizon(ctx)
+		if err != nil {
+			observability.AcknowledgeError(err, logger, span, "fetching household event horizon")
+			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+			return
+		}
+	}
+	logger = logger.WithValue("after", after.String()).WithValue("reset", reset)
+
+	streamCtx, cancel := withStreamDeadline(ctx)
+	defer cancel()
+
+	var writer householdEventWriter
+	if websocket.IsWebSocketUpgrade(req) {
+		conn, upgradeErr := s.websocketUpgrader.Upgrade(res, req, nil)
+		if upgradeErr != nil {
+			// the upgrader has already responded to the client.
+			observability.AcknowledgeError(upgradeErr, logger, span, "upgrading event stream connection")
+			return
+		}
+		defer func() {
+			if closeErr := conn.Close(); closeErr != nil {
+				observability.AcknowledgeError(closeErr, logger, span, "closing event stream connection")
+			}
+		}()
+
+		go discardIncomingMessages(conn, cancel)
+		writer = &websocketEventWriter{conn: conn}
+	} else {
+		writer = newServerSentEventWriter(res)
+	}
+
+	logger.Debug("streaming household events")
+
+	if reset {
+		resetEvent := &types.HouseholdEvent{
+			CreatedAt: time.Now(),
+			EventType: types.HouseholdEventStreamResetEventType,
+			ID:        after,
+		}
+
+		if err = writer.writeEvent(resetEvent); err != nil {
+			observability.AcknowledgeError(err, logger, span, "writing household event stream reset")
+			return
+		}
+	}
+
+	if err = s.streamHouseholdEvents(streamCtx, writer, householdID, after, buildHouseholdEventFilter(sessionCtxData, householdID)); err != nil {
+		observability.AcknowledgeError(err, logger, span, "streaming household events")
+	}
 }
@@ -2,22 +2,30 @@
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	randommock "github.com/dinnerdonebetter/backend/internal/pkg/random/mock"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
 	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -303,12 +311,12 @@ func TestHouseholdsService_CreateHandler(T *testing.T) {
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		helper.exampleHousehold.WebhookEncryptionKey = ""
-		assert.Equal(t, actual.Data, helper.exampleHousehold)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdDataManager, dataChangesPublisher)
 	})
@@ -760,12 +768,12 @@ func TestHouseholdsService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		helper.exampleHousehold.WebhookEncryptionKey = ""
-		assert.Equal(t, actual.Data, helper.exampleHousehold)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdDataManager, dataChangesPublisher)
 	})
@@ -895,10 +903,10 @@ func TestHouseholdsService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdDataManager, dataChangesPublisher)
 	})
@@ -1085,10 +1093,10 @@ func TestHouseholdsService_ModifyMemberPermissionsHandler(T *testing.T) {
 
 		helper.service.ModifyMemberPermissionsHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdMembershipDataManager, dataChangesPublisher)
 	})
@@ -1272,10 +1280,10 @@ func TestHouseholdsService_TransferHouseholdOwnershipHandler(T *testing.T) {
 
 		helper.service.TransferHouseholdOwnershipHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdMembershipDataManager, dataChangesPublisher)
 	})
@@ -1391,10 +1399,10 @@ func TestHouseholdsService_RemoveMemberHandler(T *testing.T) {
 
 		helper.service.RemoveMemberHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdMembershipDataManager, dataChangesPublisher)
 	})
@@ -1499,11 +1507,557 @@ func TestHouseholdsService_MarkAsDefaultHouseholdHandler(T *testing.T) {
 
 		helper.service.MarkAsDefaultHouseholdHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Household]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, householdMembershipDataManager, dataChangesPublisher)
 	})
 }
+
+func TestHouseholdsService_RotateWebhookEncryptionKeyHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleKey := "deadbeef"
+		sg := &randommock.Generator{}
+		sg.On("GenerateHexEncodedString", testutils.ContextMatcher, 128).Return(exampleKey, nil)
+		helper.service.secretGenerator = sg
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On(
+			"RotateHouseholdWebhookEncryptionKey",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleUser.ID,
+			exampleKey,
+			mock.AnythingOfType("time.Time"),
+		).Return(nil)
+		helper.service.householdDataManager = householdDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.RotateWebhookEncryptionKeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.NoError(t, actual.Error.AsError())
+		assert.Equal(t, exampleKey, actual.Data.WebhookEncryptionKey)
+		assert.True(t, actual.Data.PreviousKeyExpiresAt.After(time.Now()))
+
+		mock.AssertExpectationsForObjects(t, sg, householdDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.RotateWebhookEncryptionKeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with error generating secret", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		sg := &randommock.Generator{}
+		sg.On("GenerateHexEncodedString", testutils.ContextMatcher, 128).Return("", errors.New("blah"))
+		helper.service.secretGenerator = sg
+
+		helper.service.RotateWebhookEncryptionKeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, sg)
+	})
+
+	T.Run("with no such household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On(
+			"RotateHouseholdWebhookEncryptionKey",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleUser.ID,
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("time.Time"),
+		).Return(sql.ErrNoRows)
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.RotateWebhookEncryptionKeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with previous key still valid", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On(
+			"RotateHouseholdWebhookEncryptionKey",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleUser.ID,
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("time.Time"),
+		).Return(database.ErrWebhookEncryptionKeyRotationInProgress)
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.RotateWebhookEncryptionKeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusConflict, helper.res.Code)
+		var actual *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdDataManager := &mocktypes.HouseholdDataManagerMock{}
+		householdDataManager.On(
+			"RotateHouseholdWebhookEncryptionKey",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleUser.ID,
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("time.Time"),
+		).Return(errors.New("blah"))
+		helper.service.householdDataManager = householdDataManager
+
+		helper.service.RotateWebhookEncryptionKeyHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, householdDataManager)
+	})
+}
+
+func TestHouseholdsService_RecommendationsHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleRecommendations := fakes.BuildFakeRecipeRecommendations()
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendRecipes",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			types.DefaultRecommendationCount,
+		).Return(exampleRecommendations, nil)
+		helper.service.recommender = recommender
+
+		helper.service.RecommendationsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.RecipeRecommendation]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.NoError(t, actual.Error.AsError())
+		assert.Equal(t, exampleRecommendations, actual.Data)
+
+		mock.AssertExpectationsForObjects(t, recommender)
+	})
+
+	T.Run("with count provided", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecommendationCountQueryKey: []string{"3"}}.Encode()
+
+		exampleRecommendations := fakes.BuildFakeRecipeRecommendations()
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendRecipes",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			3,
+		).Return(exampleRecommendations, nil)
+		helper.service.recommender = recommender
+
+		helper.service.RecommendationsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, recommender)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.RecommendationsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[[]*types.RecipeRecommendation]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid count", func(t *testing.T) {
+		t.Parallel()
+
+		for _, count := range []string{"zero", "0", fmt.Sprintf("%d", types.MaxRecommendationCount+1)} {
+			helper := buildTestHelper(t)
+			helper.req.URL.RawQuery = url.Values{types.RecommendationCountQueryKey: []string{count}}.Encode()
+
+			helper.service.RecommendationsHandler(helper.res, helper.req)
+
+			assert.Equal(t, http.StatusBadRequest, helper.res.Code, count)
+		}
+	})
+
+	T.Run("with no such household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendRecipes",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			types.DefaultRecommendationCount,
+		).Return([]*types.RecipeRecommendation(nil), sql.ErrNoRows)
+		helper.service.recommender = recommender
+
+		helper.service.RecommendationsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, recommender)
+	})
+
+	T.Run("with error building recommendations", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendRecipes",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			types.DefaultRecommendationCount,
+		).Return([]*types.RecipeRecommendation(nil), errors.New("blah"))
+		helper.service.recommender = recommender
+
+		helper.service.RecommendationsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[[]*types.RecipeRecommendation]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recommender)
+	})
+}
+
+func TestHouseholdsService_StreamEventsHandler(T *testing.T) {
+	T.Parallel()
+
+	lastEventID := types.HouseholdEventCursor{TransactionID: 100, Sequence: 5}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		ctx, cancel := context.WithCancel(helper.ctx)
+		defer cancel()
+		helper.req = helper.req.WithContext(ctx)
+		helper.req.Header.Set(types.LastEventIDHeaderKey, lastEventID.String())
+
+		exampleEvents := []*types.HouseholdEvent{
+			{
+				CreatedAt: fakes.BuildFakeTime(),
+				EventType: types.MealPlanTaskStatusChangedCustomerEventType,
+				ID:        types.HouseholdEventCursor{TransactionID: 100, Sequence: 6},
+			},
+			{
+				CreatedAt: fakes.BuildFakeTime(),
+				EventType: types.HouseholdWebhookEncryptionKeyRotatedCustomerEventType,
+				ID:        types.HouseholdEventCursor{TransactionID: 101, Sequence: 7},
+			},
+		}
+
+		householdEventDataManager := &mocktypes.HouseholdEventDataManagerMock{}
+		householdEventDataManager.On(
+			"HouseholdEventCursorIsRetained",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			lastEventID,
+		).Return(true, nil)
+		householdEventDataManager.On(
+			"GetHouseholdEvents",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			lastEventID,
+			uint16(eventStreamBatchSize),
+		).Run(func(mock.Arguments) { cancel() }).Return(exampleEvents, nil)
+		helper.service.householdEventDataManager = householdEventDataManager
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, "text/event-stream", helper.res.Header().Get("Content-Type"))
+		assert.Contains(t, helper.res.Body.String(), "id: 100-6\nevent: meal_plan_task_status_changed\n")
+		assert.NotContains(t, helper.res.Body.String(), "id: 101-7\n")
+
+		mock.AssertExpectationsForObjects(t, householdEventDataManager)
+	})
+
+	T.Run("without last event ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		ctx, cancel := context.WithCancel(helper.ctx)
+		defer cancel()
+		helper.req = helper.req.WithContext(ctx)
+
+		horizon := types.HouseholdEventCursor{TransactionID: 200}
+
+		householdEventDataManager := &mocktypes.HouseholdEventDataManagerMock{}
+		householdEventDataManager.On(
+			"GetHouseholdEventHorizon",
+			testutils.ContextMatcher,
+		).Return(horizon, nil)
+		householdEventDataManager.On(
+			"GetHouseholdEvents",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			horizon,
+			uint16(eventStreamBatchSize),
+		).Run(func(mock.Arguments) { cancel() }).Return([]*types.HouseholdEvent{}, nil)
+		helper.service.householdEventDataManager = householdEventDataManager
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.NotContains(t, helper.res.Body.String(), string(types.HouseholdEventStreamResetEventType))
+
+		mock.AssertExpectationsForObjects(t, householdEventDataManager)
+	})
+
+	T.Run("with last event ID that is no longer retained", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		ctx, cancel := context.WithCancel(helper.ctx)
+		defer cancel()
+		helper.req = helper.req.WithContext(ctx)
+		helper.req.Header.Set(types.LastEventIDHeaderKey, lastEventID.String())
+
+		horizon := types.HouseholdEventCursor{TransactionID: 200}
+
+		householdEventDataManager := &mocktypes.HouseholdEventDataManagerMock{}
+		householdEventDataManager.On(
+			"HouseholdEventCursorIsRetained",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			lastEventID,
+		).Return(false, nil)
+		householdEventDataManager.On(
+			"GetHouseholdEventHorizon",
+			testutils.ContextMatcher,
+		).Return(horizon, nil)
+		householdEventDataManager.On(
+			"GetHouseholdEvents",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			horizon,
+			uint16(eventStreamBatchSize),
+		).Run(func(mock.Arguments) { cancel() }).Return([]*types.HouseholdEvent{}, nil)
+		helper.service.householdEventDataManager = householdEventDataManager
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.True(t, strings.HasPrefix(helper.res.Body.String(), "id: 200-0\nevent: household_event_stream_reset\n"))
+
+		mock.AssertExpectationsForObjects(t, householdEventDataManager)
+	})
+
+	T.Run("over websocket", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleEvent := &types.HouseholdEvent{
+			CreatedAt: fakes.BuildFakeTime(),
+			EventType: types.MealPlanGroceryListItemUpdatedCustomerEventType,
+			ID:        types.HouseholdEventCursor{TransactionID: 100, Sequence: 6},
+			Data: types.DataChangeMessage{
+				EventType:   types.MealPlanGroceryListItemUpdatedCustomerEventType,
+				HouseholdID: helper.exampleHousehold.ID,
+			},
+		}
+
+		householdEventDataManager := &mocktypes.HouseholdEventDataManagerMock{}
+		householdEventDataManager.On(
+			"HouseholdEventCursorIsRetained",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			lastEventID,
+		).Return(true, nil)
+		householdEventDataManager.On(
+			"GetHouseholdEvents",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			lastEventID,
+			uint16(eventStreamBatchSize),
+		).Return([]*types.HouseholdEvent{exampleEvent}, nil).Once()
+		householdEventDataManager.On(
+			"GetHouseholdEvents",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleEvent.ID,
+			uint16(eventStreamBatchSize),
+		).Return([]*types.HouseholdEvent{}, nil).Maybe()
+		helper.service.householdEventDataManager = householdEventDataManager
+
+		ts := httptest.NewServer(http.HandlerFunc(helper.service.StreamEventsHandler))
+		defer ts.Close()
+
+		conn, res, err := websocket.DefaultDialer.Dial(
+			strings.Replace(ts.URL, "http", "ws", 1)+"?"+url.Values{types.LastEventIDQueryKey: []string{lastEventID.String()}}.Encode(),
+			nil,
+		)
+		require.NoError(t, err)
+		assert.NoError(t, res.Body.Close())
+
+		var actual *types.HouseholdEvent
+		require.NoError(t, conn.ReadJSON(&actual))
+		assert.Equal(t, exampleEvent, actual)
+		assert.NoError(t, conn.Close())
+
+		mock.AssertExpectationsForObjects(t, householdEventDataManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("for household the user isn't a member of", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.householdIDFetcher = func(*http.Request) string {
+			return fakes.BuildFakeID()
+		}
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid last event ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.Header.Set(types.LastEventIDHeaderKey, "nope")
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with error checking event retention", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.Header.Set(types.LastEventIDHeaderKey, lastEventID.String())
+
+		householdEventDataManager := &mocktypes.HouseholdEventDataManagerMock{}
+		householdEventDataManager.On(
+			"HouseholdEventCursorIsRetained",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			lastEventID,
+		).Return(false, errors.New("blah"))
+		helper.service.householdEventDataManager = householdEventDataManager
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdEventDataManager)
+	})
+
+	T.Run("with error fetching event horizon", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		householdEventDataManager := &mocktypes.HouseholdEventDataManagerMock{}
+		householdEventDataManager.On(
+			"GetHouseholdEventHorizon",
+			testutils.ContextMatcher,
+		).Return(types.HouseholdEventCursor{}, errors.New("blah"))
+		helper.service.householdEventDataManager = householdEventDataManager
+
+		helper.service.StreamEventsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, householdEventDataManager)
+	})
+}
@@ -3,8 +3,11 @@
 import (
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -12,10 +15,15 @@
 	"github.com/dinnerdonebetter/backend/internal/routing"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
 	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/gorilla/websocket"
 )
 
 const (
 	serviceName string = "households_service"
+
+	// defaultWebhookEncryptionKeyGracePeriod is how long a rotated-out webhook encryption key remains valid.
+	defaultWebhookEncryptionKeyGracePeriod = 24 * time.Hour
 )
 
 var _ types.HouseholdDataService = (*service)(nil)
@@ -27,13 +35,19 @@
 		householdDataManager           types.HouseholdDataManager
 		householdInvitationDataManager types.HouseholdInvitationDataManager
 		householdMembershipDataManager types.HouseholdUserMembershipDataManager
+		householdEventDataManager      types.HouseholdEventDataManager
 		tracer                         tracing.Tracer
 		encoderDecoder                 encoding.ServerEncoderDecoder
 		dataChangesPublisher           messagequeue.Publisher
+		transactionRunner              database.TransactionRunner
 		secretGenerator                random.Generator
+		recommender                    recommendations.Recommender
 		sessionContextDataFetcher      func(*http.Request) (*types.SessionContextData, error)
 		userIDFetcher                  func(*http.Request) string
 		householdIDFetcher             func(*http.Request) string
+		webhookKeyGracePeriod          time.Duration
+		eventStreamPollInterval        time.Duration
+		websocketUpgrader              *websocket.Upgrader
 	}
 )
 
@@ -44,17 +58,30 @@ func ProvideService(
 	householdDataManager types.HouseholdDataManager,
 	householdInvitationDataManager types.HouseholdInvitationDataManager,
 	householdMembershipDataManager types.HouseholdUserMembershipDataManager,
+	householdEventDataManager types.HouseholdEventDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
 	tracerProvider tracing.TracerProvider,
 	secretGenerator random.Generator,
+	recommender recommendations.Recommender,
 ) (types.HouseholdDataService, error) {
 	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
 	if err != nil {
 		return nil, fmt.Errorf("setting up household service data changes publisher: %w", err)
 	}
 
+	webhookKeyGracePeriod := cfg.WebhookEncryptionKeyGracePeriod
+	if webhookKeyGracePeriod == 0 {
+		webhookKeyGracePeriod = defaultWebhookEncryptionKeyGracePeriod
+	}
+
+	eventStreamPollInterval := cfg.EventStreamPollInterval
+	if eventStreamPollInterval == 0 {
+		eventStreamPollInterval = defaultEventStreamPollInterval
+	}
+
 	s := &service{
 		logger:                         logging.EnsureLogger(logger).WithName(serviceName),
 		householdIDFetcher:             routeParamManager.BuildRouteParamStringIDFetcher(HouseholdIDURIParamKey),
@@ -63,9 +90,15 @@ func ProvideService(
 		householdDataManager:           householdDataManager,
 		householdInvitationDataManager: householdInvitationDataManager,
 		householdMembershipDataManager: householdMembershipDataManager,
+		householdEventDataManager:      householdEventDataManager,
 		encoderDecoder:                 encoder,
 		dataChangesPublisher:           dataChangesPublisher,
+		transactionRunner:              transactionRunner,
 		secretGenerator:                secretGenerator,
+		recommender:                    recommender,
+		webhookKeyGracePeriod:          webhookKeyGracePeriod,
+		eventStreamPollInterval:        eventStreamPollInterval,
+		websocketUpgrader:              &websocket.Upgrader{},
 		tracer:                         tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
 
@@ -4,9 +4,12 @@
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -14,18 +17,25 @@
 	mockrouting "github.com/dinnerdonebetter/backend/internal/routing/mock"
 	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:              database.NewNoopTransactionRunner(),
 		logger:                         logging.NewNoopLogger(),
 		householdDataManager:           &mocktypes.HouseholdDataManagerMock{},
 		householdMembershipDataManager: &mocktypes.HouseholdUserMembershipDataManagerMock{},
+		householdEventDataManager:      &mocktypes.HouseholdEventDataManagerMock{},
 		householdIDFetcher:             func(req *http.Request) string { return "" },
 		encoderDecoder:                 encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
 		secretGenerator:                random.NewGenerator(nil, nil),
+		recommender:                    &recommendations.MockRecommender{},
+		webhookKeyGracePeriod:          defaultWebhookEncryptionKeyGracePeriod,
+		eventStreamPollInterval:        time.Millisecond,
+		websocketUpgrader:              &websocket.Upgrader{},
 		tracer:                         tracing.NewTracerForTest("test"),
 	}
 }
@@ -59,11 +69,14 @@ func TestProvideHouseholdsService(T *testing.T) {
 			&mocktypes.HouseholdDataManagerMock{},
 			&mocktypes.HouseholdInvitationDataManagerMock{},
 			&mocktypes.HouseholdUserMembershipDataManagerMock{},
+			&mocktypes.HouseholdEventDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
 			tracing.NewNoopTracerProvider(),
 			random.NewGenerator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider()),
+			&recommendations.MockRecommender{},
 		)
 
 		assert.NotNil(t, s)
@@ -89,11 +102,14 @@ func TestProvideHouseholdsService(T *testing.T) {
 			&mocktypes.HouseholdDataManagerMock{},
 			&mocktypes.HouseholdInvitationDataManagerMock{},
 			&mocktypes.HouseholdUserMembershipDataManagerMock{},
+			&mocktypes.HouseholdEventDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
 			tracing.NewNoopTracerProvider(),
 			random.NewGenerator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider()),
+			&recommendations.MockRecommender{},
 		)
 
 		assert.Nil(t, s)
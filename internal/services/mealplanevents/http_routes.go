@@ -1,10 +1,15 @@
 package mealplanevents
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/features/cooktimeline"
+	"github.com/dinnerdonebetter/backend/internal/features/mealplanelections"
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
@@ -72,14 +77,44 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	input.BelongsToMealPlan = mealPlanID
 	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, input.ID)
 
+	if len(input.Options) == 0 {
+		// suggestions are a nicety, so failing to produce them in time shouldn't fail event creation.
+		suggestionCtx, cancel := context.WithTimeout(ctx, s.suggestionTimeout)
+		suggestions, recommendationErr := s.recommender.RecommendMealsForEvent(suggestionCtx, sessionCtxData.ActiveHouseholdID, input.MealName, suggestedMealPlanOptionCount)
+		cancel()
+		if recommendationErr != nil {
+			observability.AcknowledgeError(recommendationErr, logger, span, "recommending meal plan options")
+		}
+
+		for _, suggestion := range suggestions {
+			input.Options = append(input.Options, &types.MealPlanOptionDatabaseCreationInput{
+				MealID:    suggestion.Meal.ID,
+				MealScale: 1,
+			})
+		}
+	}
+
 	for i := range input.Options {
 		input.Options[i].ID = identifiers.New()
 		input.Options[i].BelongsToMealPlanEvent = input.ID
 	}
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	mealPlanEvent, err := s.mealPlanEventDataManager.CreateMealPlanEvent(ctx, input)
-	if err != nil {
+	var mealPlanEvent *types.MealPlanEvent
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if mealPlanEvent, err = s.mealPlanEventDataManager.CreateMealPlanEvent(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:     types.MealPlanEventCreatedCustomerEventType,
+			MealPlanEvent: mealPlanEvent,
+			HouseholdID:   sessionCtxData.ActiveHouseholdID,
+			UserID:        sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -87,17 +122,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:     types.MealPlanEventCreatedCustomerEventType,
-		MealPlanEvent: mealPlanEvent,
-		HouseholdID:   sessionCtxData.ActiveHouseholdID,
-		UserID:        sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanEvent]{
 		Details: responseDetails,
 		Data:    mealPlanEvent,
@@ -295,7 +319,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	mealPlanEvent.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.mealPlanEventDataManager.UpdateMealPlanEvent(ctx, mealPlanEvent); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanEventDataManager.UpdateMealPlanEvent(ctx, mealPlanEvent); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:     types.MealPlanEventUpdatedCustomerEventType,
+			MealPlanEvent: mealPlanEvent,
+			HouseholdID:   sessionCtxData.ActiveHouseholdID,
+			UserID:        sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -303,17 +340,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:     types.MealPlanEventUpdatedCustomerEventType,
-		MealPlanEvent: mealPlanEvent,
-		HouseholdID:   sessionCtxData.ActiveHouseholdID,
-		UserID:        sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanEvent]{
 		Details: responseDetails,
 		Data:    mealPlanEvent,
@@ -376,7 +402,20 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.mealPlanEventDataManager.ArchiveMealPlanEvent(ctx, mealPlanID, mealPlanEventID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanEventDataManager.ArchiveMealPlanEvent(ctx, mealPlanID, mealPlanEventID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:       types.MealPlanEventArchivedCustomerEventType,
+			MealPlanEventID: mealPlanEventID,
+			HouseholdID:     sessionCtxData.ActiveHouseholdID,
+			UserID:          sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -384,21 +423,193 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:       types.MealPlanEventArchivedCustomerEventType,
-		MealPlanEventID: mealPlanEventID,
-		HouseholdID:     sessionCtxData.ActiveHouseholdID,
-		UserID:          sessionCtxData.Requester.UserID,
+	responseValue := &types.APIResponse[*types.MealPlanEvent]{
+		Details: responseDetails,
 	}
 
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
+	// let everybody go home.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ResultsHandler returns a handler that tallies the votes cast for a meal plan event's options.
+func (s *service) ResultsHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
 	}
 
-	responseValue := &types.APIResponse[*types.MealPlanEvent]{
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine meal plan ID.
+	mealPlanID := s.mealPlanIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
+
+	// determine meal plan event ID.
+	mealPlanEventID := s.mealPlanEventIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
+
+	// fetch meal plan from database, which also ensures it belongs to the active household.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	mealPlan, err := s.mealPlanDataManager.GetMealPlan(ctx, mealPlanID, sessionCtxData.ActiveHouseholdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving meal plan")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	var mealPlanEvent *types.MealPlanEvent
+	for _, event := range mealPlan.Events {
+		if event.ID == mealPlanEventID {
+			mealPlanEvent = event
+			break
+		}
+	}
+
+	if mealPlanEvent == nil {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	}
+
+	tallyTimer := timing.NewMetric("tally").WithDesc("tally votes").Start()
+	results, err := mealplanelections.TallyMealPlanEvent(mealPlan.ElectionMethod, mealPlanEvent)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "tallying meal plan event votes")
+		errRes := types.NewAPIErrorResponse("tallying votes", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	tallyTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.MealPlanEventResults]{
 		Details: responseDetails,
+		Data:    results,
 	}
 
-	// let everybody go home.
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// wantsICalendar determines whether a timeline request asked for an iCalendar document rather than JSON.
+func wantsICalendar(req *http.Request) bool {
+	if format := req.URL.Query().Get(types.CookTimelineFormatQueryKey); format != "" {
+		return strings.EqualFold(format, types.CookTimelineFormatICalendar)
+	}
+
+	return strings.Contains(req.Header.Get("Accept"), "text/calendar")
+}
+
+// TimelineHandler returns a GET handler that schedules the cooking of a meal plan event's chosen meal.
+func (s *service) TimelineHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine meal plan ID.
+	mealPlanID := s.mealPlanIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
+
+	// determine meal plan event ID.
+	mealPlanEventID := s.mealPlanEventIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
+
+	// fetch meal plan from database, which also ensures it belongs to the active household.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	mealPlan, err := s.mealPlanDataManager.GetMealPlan(ctx, mealPlanID, sessionCtxData.ActiveHouseholdID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving meal plan")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	scheduleTimer := timing.NewMetric("schedule").WithDesc("schedule cook timeline").Start()
+	timeline, err := s.timelineScheduler.ScheduleMealPlanEvent(ctx, mealPlan.BelongsToHousehold, mealPlanID, mealPlanEventID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if errors.Is(err, cooktimeline.ErrNoChosenOption) {
+		errRes := types.NewAPIErrorResponse("meal plan event has no chosen option", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "scheduling cook timeline")
+		errRes := types.NewAPIErrorResponse("scheduling cook timeline", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	scheduleTimer.Stop()
+
+	if wantsICalendar(req) {
+		res.Header().Set("Content-Type", cooktimeline.ICalendarContentType)
+		if _, err = res.Write(cooktimeline.RenderICalendar(timeline, time.Now())); err != nil {
+			observability.AcknowledgeError(err, logger, span, "writing cook timeline calendar")
+		}
+		return
+	}
+
+	responseValue := &types.APIResponse[*types.CookTimeline]{
+		Details: responseDetails,
+		Data:    timeline,
+	}
+
+	// encode our response and peace.
 	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
 }
@@ -2,13 +2,18 @@
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/cooktimeline"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -66,6 +71,170 @@ func TestMealPlanEventsService_CreateHandler(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
 
+	T.Run("without options provided", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleCreationInput := fakes.BuildFakeMealPlanEventCreationRequestInput()
+		exampleCreationInput.Options = nil
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		exampleRecommendations := []*types.MealRecommendation{
+			fakes.BuildFakeMealRecommendation(),
+			fakes.BuildFakeMealRecommendation(),
+		}
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendMealsForEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleCreationInput.MealName,
+			suggestedMealPlanOptionCount,
+		).Return(exampleRecommendations, nil)
+		helper.service.recommender = recommender
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanEventDataManagerMock.On(
+			"CreateMealPlanEvent",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanEventDatabaseCreationInput) bool {
+				if len(input.Options) != len(exampleRecommendations) {
+					return false
+				}
+
+				for i, option := range input.Options {
+					if option.MealID != exampleRecommendations[i].Meal.ID || option.BelongsToMealPlanEvent != input.ID {
+						return false
+					}
+				}
+
+				return true
+			}),
+		).Return(helper.exampleMealPlanEvent, nil)
+		helper.service.mealPlanEventDataManager = dbManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, recommender, dbManager, dataChangesPublisher)
+	})
+
+	T.Run("with error recommending options", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleCreationInput := fakes.BuildFakeMealPlanEventCreationRequestInput()
+		exampleCreationInput.Options = nil
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendMealsForEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleCreationInput.MealName,
+			suggestedMealPlanOptionCount,
+		).Return([]*types.MealRecommendation(nil), errors.New("blah"))
+		helper.service.recommender = recommender
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanEventDataManagerMock.On(
+			"CreateMealPlanEvent",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanEventDatabaseCreationInput) bool { return len(input.Options) == 0 }),
+		).Return(helper.exampleMealPlanEvent, nil)
+		helper.service.mealPlanEventDataManager = dbManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, recommender, dbManager, dataChangesPublisher)
+	})
+
+	T.Run("with recommendations taking too long", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+		helper.service.suggestionTimeout = time.Millisecond
+
+		exampleCreationInput := fakes.BuildFakeMealPlanEventCreationRequestInput()
+		exampleCreationInput.Options = nil
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recommender := &recommendations.MockRecommender{}
+		recommender.On(
+			"RecommendMealsForEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleCreationInput.MealName,
+			suggestedMealPlanOptionCount,
+		).Run(func(args mock.Arguments) {
+			// the recommender gives up once the handler stops waiting.
+			<-args.Get(0).(context.Context).Done()
+		}).Return([]*types.MealRecommendation(nil), context.DeadlineExceeded)
+		helper.service.recommender = recommender
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanEventDataManagerMock.On(
+			"CreateMealPlanEvent",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanEventDatabaseCreationInput) bool { return len(input.Options) == 0 }),
+		).Return(helper.exampleMealPlanEvent, nil)
+		helper.service.mealPlanEventDataManager = dbManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, recommender, dbManager, dataChangesPublisher)
+	})
+
 	T.Run("without input attached", func(t *testing.T) {
 		t.Parallel()
 
@@ -199,11 +368,11 @@ func TestMealPlanEventsService_CreateHandler(T *testing.T) {
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanEvent]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMealPlanEvent)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -655,11 +824,11 @@ func TestMealPlanEventsService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanEvent]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMealPlanEvent)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -835,11 +1004,366 @@ func TestMealPlanEventsService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanEvent]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
 }
+
+func TestMealPlanEventsService_ResultsHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.ElectionMethod = types.MealPlanElectionMethodSchulze
+		helper.exampleMealPlan.Events = []*types.MealPlanEvent{helper.exampleMealPlanEvent}
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		helper.service.ResultsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanEventResults]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, helper.exampleMealPlanEvent.ID, actual.Data.MealPlanEventID)
+		assert.Equal(t, types.MealPlanElectionMethodSchulze, actual.Data.ElectionMethod)
+		assert.NotNil(t, actual.Data.Schulze)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ResultsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanEventResults]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such meal plan in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.MealPlan)(nil), sql.ErrNoRows)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		helper.service.ResultsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanEventResults]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager)
+	})
+
+	T.Run("with error fetching meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.MealPlan)(nil), errors.New("blah"))
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		helper.service.ResultsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanEventResults]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager)
+	})
+
+	T.Run("with no such meal plan event in the meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.Events = []*types.MealPlanEvent{fakes.BuildFakeMealPlanEvent()}
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		helper.service.ResultsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanEventResults]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager)
+	})
+
+	T.Run("with unknown election method", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.ElectionMethod = "fake"
+		helper.exampleMealPlan.Events = []*types.MealPlanEvent{helper.exampleMealPlanEvent}
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		helper.service.ResultsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanEventResults]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager)
+	})
+}
+
+func TestMealPlanEventsService_TimelineHandler(T *testing.T) {
+	T.Parallel()
+
+	buildTimeline := func(helper *mealPlanEventsServiceHTTPRoutesTestHelper) *types.CookTimeline {
+		return &types.CookTimeline{
+			StartsAt:         helper.exampleMealPlanEvent.StartsAt.Add(-time.Hour),
+			FinishesAt:       helper.exampleMealPlanEvent.StartsAt,
+			MealPlanID:       helper.exampleMealPlan.ID,
+			MealPlanEventID:  helper.exampleMealPlanEvent.ID,
+			MealPlanOptionID: helper.exampleMealPlanEvent.Options[0].ID,
+			MealID:           helper.exampleMealPlanEvent.Options[0].Meal.ID,
+			Entries: []*types.CookTimelineEntry{
+				{
+					StartsAt:          helper.exampleMealPlanEvent.StartsAt.Add(-time.Hour),
+					EndsAt:            helper.exampleMealPlanEvent.StartsAt,
+					RecipeID:          fakes.BuildFakeID(),
+					RecipeStepID:      fakes.BuildFakeID(),
+					Equipment:         []string{},
+					DurationInSeconds: 3600,
+				},
+			},
+		}
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.BelongsToHousehold = helper.exampleHousehold.ID
+		exampleTimeline := buildTimeline(helper)
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		timelineScheduler := &cooktimeline.MockTimelineScheduler{}
+		timelineScheduler.On(
+			"ScheduleMealPlanEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+		).Return(exampleTimeline, nil)
+		helper.service.timelineScheduler = timelineScheduler
+
+		helper.service.TimelineHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.CookTimeline]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleTimeline.MealPlanOptionID, actual.Data.MealPlanOptionID)
+		require.Len(t, actual.Data.Entries, 1)
+		assert.Equal(t, exampleTimeline.Entries[0].RecipeStepID, actual.Data.Entries[0].RecipeStepID)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager, timelineScheduler)
+	})
+
+	T.Run("as iCalendar", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.BelongsToHousehold = helper.exampleHousehold.ID
+		helper.req.URL.RawQuery = url.Values{types.CookTimelineFormatQueryKey: []string{types.CookTimelineFormatICalendar}}.Encode()
+		exampleTimeline := buildTimeline(helper)
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		timelineScheduler := &cooktimeline.MockTimelineScheduler{}
+		timelineScheduler.On(
+			"ScheduleMealPlanEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+		).Return(exampleTimeline, nil)
+		helper.service.timelineScheduler = timelineScheduler
+
+		helper.service.TimelineHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, cooktimeline.ICalendarContentType, helper.res.Header().Get("Content-Type"))
+		assert.Contains(t, helper.res.Body.String(), "BEGIN:VCALENDAR")
+		assert.Contains(t, helper.res.Body.String(), exampleTimeline.Entries[0].RecipeStepID)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager, timelineScheduler)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.TimelineHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.CookTimeline]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such meal plan in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.MealPlan)(nil), sql.ErrNoRows)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		helper.service.TimelineHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager)
+	})
+
+	T.Run("without chosen option", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.BelongsToHousehold = helper.exampleHousehold.ID
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		timelineScheduler := &cooktimeline.MockTimelineScheduler{}
+		timelineScheduler.On(
+			"ScheduleMealPlanEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+		).Return((*types.CookTimeline)(nil), cooktimeline.ErrNoChosenOption)
+		helper.service.timelineScheduler = timelineScheduler
+
+		helper.service.TimelineHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager, timelineScheduler)
+	})
+
+	T.Run("with error scheduling", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlan.BelongsToHousehold = helper.exampleHousehold.ID
+
+		mealPlanDataManager := &mocktypes.MealPlanDataManagerMock{}
+		mealPlanDataManager.On(
+			"GetMealPlan",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleMealPlan, nil)
+		helper.service.mealPlanDataManager = mealPlanDataManager
+
+		timelineScheduler := &cooktimeline.MockTimelineScheduler{}
+		timelineScheduler.On(
+			"ScheduleMealPlanEvent",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+		).Return((*types.CookTimeline)(nil), errors.New("blah"))
+		helper.service.timelineScheduler = timelineScheduler
+
+		helper.service.TimelineHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.CookTimeline]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanDataManager, timelineScheduler)
+	})
+}
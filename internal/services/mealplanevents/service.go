@@ -3,8 +3,12 @@
 import (
 	"fmt"
 	"net/http"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/cooktimeline"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -16,6 +20,11 @@
 
 const (
 	serviceName string = "meal_plan_events_service"
+
+	// suggestedMealPlanOptionCount is how many recommended options are added to an event created without any.
+	suggestedMealPlanOptionCount = 3
+	// defaultSuggestionTimeout is how long event creation waits for recommended options before going without them.
+	defaultSuggestionTimeout = 2 * time.Second
 )
 
 var _ types.MealPlanEventDataService = (*service)(nil)
@@ -24,13 +33,18 @@
 	// service handles meal plans.
 	service struct {
 		logger                    logging.Logger
+		mealPlanDataManager       types.MealPlanDataManager
 		mealPlanEventDataManager  types.MealPlanEventDataManager
 		mealPlanIDFetcher         func(*http.Request) string
 		mealPlanEventIDFetcher    func(*http.Request) string
 		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 		encoderDecoder            encoding.ServerEncoderDecoder
 		tracer                    tracing.Tracer
+		recommender               recommendations.Recommender
+		timelineScheduler         cooktimeline.TimelineScheduler
+		suggestionTimeout         time.Duration
 	}
 )
 
@@ -38,11 +52,15 @@
 func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
-	mealPlanDataManager types.MealPlanEventDataManager,
+	mealPlanEventDataManager types.MealPlanEventDataManager,
+	mealPlanDataManager types.MealPlanDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
 	tracerProvider tracing.TracerProvider,
+	recommender recommendations.Recommender,
+	timelineScheduler cooktimeline.TimelineScheduler,
 ) (types.MealPlanEventDataService, error) {
 	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
 	if err != nil {
@@ -54,10 +72,15 @@ func ProvideService(
 		mealPlanIDFetcher:         routeParamManager.BuildRouteParamStringIDFetcher(mealplansservice.MealPlanIDURIParamKey),
 		mealPlanEventIDFetcher:    routeParamManager.BuildRouteParamStringIDFetcher(MealPlanEventIDURIParamKey),
 		sessionContextDataFetcher: authservice.FetchContextFromRequest,
-		mealPlanEventDataManager:  mealPlanDataManager,
+		mealPlanEventDataManager:  mealPlanEventDataManager,
+		mealPlanDataManager:       mealPlanDataManager,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		encoderDecoder:            encoder,
 		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
+		recommender:               recommender,
+		timelineScheduler:         timelineScheduler,
+		suggestionTimeout:         defaultSuggestionTimeout,
 	}
 
 	return svc, nil
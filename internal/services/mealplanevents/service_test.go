@@ -5,8 +5,11 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	"github.com/dinnerdonebetter/backend/internal/features/cooktimeline"
+	"github.com/dinnerdonebetter/backend/internal/features/recommendations"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -20,11 +23,16 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:        database.NewNoopTransactionRunner(),
 		logger:                   logging.NewNoopLogger(),
 		mealPlanEventDataManager: &mocktypes.MealPlanEventDataManagerMock{},
+		mealPlanDataManager:      &mocktypes.MealPlanDataManagerMock{},
 		mealPlanEventIDFetcher:   func(req *http.Request) string { return "" },
 		encoderDecoder:           encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
 		tracer:                   tracing.NewTracerForTest("test"),
+		recommender:              &recommendations.MockRecommender{},
+		timelineScheduler:        &cooktimeline.MockTimelineScheduler{},
+		suggestionTimeout:        defaultSuggestionTimeout,
 	}
 }
 
@@ -55,10 +63,14 @@ func TestProvideMealPlansService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanEventDataManagerMock{},
+			&mocktypes.MealPlanDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
 			tracing.NewNoopTracerProvider(),
+			&recommendations.MockRecommender{},
+			&cooktimeline.MockTimelineScheduler{},
 		)
 
 		assert.NotNil(t, s)
@@ -81,10 +93,14 @@ func TestProvideMealPlansService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanEventDataManagerMock{},
+			&mocktypes.MealPlanDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
 			tracing.NewNoopTracerProvider(),
+			&recommendations.MockRecommender{},
+			&cooktimeline.MockTimelineScheduler{},
 		)
 
 		assert.Nil(t, s)
@@ -1,6 +1,7 @@
 package mealplangrocerylistitems
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -77,8 +78,22 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	input.BelongsToMealPlan = mealPlanID
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	mealPlanGroceryListItem, err := s.mealPlanGroceryListItemDataManager.CreateMealPlanGroceryListItem(ctx, input)
-	if err != nil {
+	var mealPlanGroceryListItem *types.MealPlanGroceryListItem
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if mealPlanGroceryListItem, err = s.mealPlanGroceryListItemDataManager.CreateMealPlanGroceryListItem(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:               types.MealPlanGroceryListItemCreatedCustomerEventType,
+			MealPlanID:              mealPlanID,
+			MealPlanGroceryListItem: mealPlanGroceryListItem,
+			HouseholdID:             sessionCtxData.ActiveHouseholdID,
+			UserID:                  sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -86,18 +101,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:               types.MealPlanGroceryListItemCreatedCustomerEventType,
-		MealPlanID:              mealPlanID,
-		MealPlanGroceryListItem: mealPlanGroceryListItem,
-		HouseholdID:             sessionCtxData.ActiveHouseholdID,
-		UserID:                  sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanGroceryListItem]{
 		Details: responseDetails,
 		Data:    mealPlanGroceryListItem,
@@ -290,10 +293,29 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	previousStatus := mealPlanGroceryListItem.Status
 	mealPlanGroceryListItem.Update(providedInput)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.mealPlanGroceryListItemDataManager.UpdateMealPlanGroceryListItem(ctx, mealPlanGroceryListItem); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanGroceryListItemDataManager.UpdateMealPlanGroceryListItem(ctx, mealPlanGroceryListItem); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                 types.MealPlanGroceryListItemUpdatedCustomerEventType,
+			MealPlanGroceryListItem:   mealPlanGroceryListItem,
+			MealPlanGroceryListItemID: mealPlanGroceryListItemID,
+			HouseholdID:               sessionCtxData.ActiveHouseholdID,
+			UserID:                    sessionCtxData.Requester.UserID,
+		}
+
+		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
+			return err
+		}
+
+		return s.syncPantryWithGroceryListItemStatus(ctx, previousStatus, mealPlanGroceryListItem, sessionCtxData)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal plan grocery list item")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -301,18 +323,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                 types.MealPlanGroceryListItemUpdatedCustomerEventType,
-		MealPlanGroceryListItem:   mealPlanGroceryListItem,
-		MealPlanGroceryListItemID: mealPlanGroceryListItemID,
-		HouseholdID:               sessionCtxData.ActiveHouseholdID,
-		UserID:                    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanGroceryListItem]{
 		Details: responseDetails,
 		Data:    mealPlanGroceryListItem,
@@ -321,6 +331,77 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
 }
 
+// syncPantryWithGroceryListItemStatus puts newly acquired grocery list items into the household's pantry,
+// and takes back out whatever an item that's no longer acquired put there.
+func (s *service) syncPantryWithGroceryListItemStatus(ctx context.Context, previousStatus string, item *types.MealPlanGroceryListItem, sessionCtxData *types.SessionContextData) error {
+	wasAcquired := previousStatus == types.MealPlanGroceryListItemStatusAcquired
+	isAcquired := item.Status == types.MealPlanGroceryListItemStatusAcquired
+
+	switch {
+	case !wasAcquired && isAcquired:
+		pantryItem, err := s.pantryItemDataManager.CreatePantryItemForGroceryListItem(ctx, buildPantryItemForAcquiredGroceryListItem(item, sessionCtxData.ActiveHouseholdID))
+		if err != nil {
+			return err
+		}
+
+		// this grocery list item already stocked the pantry.
+		if pantryItem == nil {
+			return nil
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, &types.DataChangeMessage{
+			EventType:    types.PantryItemCreatedCustomerEventType,
+			PantryItem:   pantryItem,
+			PantryItemID: pantryItem.ID,
+			HouseholdID:  sessionCtxData.ActiveHouseholdID,
+			UserID:       sessionCtxData.Requester.UserID,
+		})
+	case wasAcquired && !isAcquired:
+		pantryItemID, err := s.pantryItemDataManager.ArchivePantryItemForGroceryListItem(ctx, item.ID, sessionCtxData.ActiveHouseholdID)
+		if err != nil {
+			return err
+		}
+
+		// nothing this grocery list item put in the pantry is still there.
+		if pantryItemID == "" {
+			return nil
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, &types.DataChangeMessage{
+			EventType:    types.PantryItemArchivedCustomerEventType,
+			PantryItemID: pantryItemID,
+			HouseholdID:  sessionCtxData.ActiveHouseholdID,
+			UserID:       sessionCtxData.Requester.UserID,
+		})
+	default:
+		return nil
+	}
+}
+
+// buildPantryItemForAcquiredGroceryListItem describes the pantry stock produced by purchasing a grocery list item,
+// preferring what was actually purchased over what the meal plan called for.
+func buildPantryItemForAcquiredGroceryListItem(item *types.MealPlanGroceryListItem, householdID string) *types.PantryItemDatabaseCreationInput {
+	input := &types.PantryItemDatabaseCreationInput{
+		ID:                      identifiers.New(),
+		ValidIngredientID:       item.Ingredient.ID,
+		ValidMeasurementUnitID:  item.MeasurementUnit.ID,
+		StorageLocation:         types.PantryItemStorageLocationPantry,
+		BelongsToHousehold:      householdID,
+		SourceGroceryListItemID: item.ID,
+		Quantity:                item.MinimumQuantityNeeded,
+	}
+
+	if item.QuantityPurchased != nil {
+		input.Quantity = *item.QuantityPurchased
+	}
+
+	if item.PurchasedMeasurementUnit != nil && item.PurchasedMeasurementUnit.ID != "" {
+		input.ValidMeasurementUnitID = item.PurchasedMeasurementUnit.ID
+	}
+
+	return input
+}
+
 // ArchiveHandler returns a GET handler that returns a meal plan grocery list item.
 func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	ctx, span := s.tracer.StartSpan(req.Context())
@@ -376,8 +457,20 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 
 	// fetch meal plan grocery list item from database.
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	err = s.mealPlanGroceryListItemDataManager.ArchiveMealPlanGroceryListItem(ctx, mealPlanGroceryListItemID)
-	if err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanGroceryListItemDataManager.ArchiveMealPlanGroceryListItem(ctx, mealPlanGroceryListItemID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                 types.MealPlanGroceryListItemArchivedCustomerEventType,
+			MealPlanGroceryListItemID: mealPlanGroceryListItemID,
+			HouseholdID:               sessionCtxData.ActiveHouseholdID,
+			UserID:                    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal plan grocery list item")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -385,17 +478,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                 types.MealPlanGroceryListItemArchivedCustomerEventType,
-		MealPlanGroceryListItemID: mealPlanGroceryListItemID,
-		HouseholdID:               sessionCtxData.ActiveHouseholdID,
-		UserID:                    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanGroceryListItem]{
 		Details: responseDetails,
 	}
@@ -9,6 +9,7 @@
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/converters"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
@@ -360,14 +361,345 @@ func TestMealPlanGroceryListItemsService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanGroceryListItem]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMealPlanGroceryListItem)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
+
+	T.Run("adds newly acquired items to the pantry", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := &types.MealPlanGroceryListItemUpdateRequestInput{
+			Status:            pointer.To(types.MealPlanGroceryListItemStatusAcquired),
+			QuantityPurchased: pointer.To(float32(3)),
+		}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"GetMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanGroceryListItem.ID,
+		).Return(helper.exampleMealPlanGroceryListItem, nil)
+
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"UpdateMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanGroceryListItem) bool { return true }),
+		).Return(nil)
+		helper.service.mealPlanGroceryListItemDataManager = dbManager
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+		pantryItemDataManager := &mocktypes.PantryItemDataManagerMock{}
+		pantryItemDataManager.On(
+			"CreatePantryItemForGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.PantryItemDatabaseCreationInput) bool {
+				return input.ValidIngredientID == helper.exampleMealPlanGroceryListItem.Ingredient.ID &&
+					input.BelongsToHousehold == helper.exampleHousehold.ID &&
+					input.SourceGroceryListItemID == helper.exampleMealPlanGroceryListItem.ID &&
+					input.Quantity == 3
+			}),
+		).Return(examplePantryItem, nil)
+		helper.service.pantryItemDataManager = pantryItemDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil).Twice()
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, dbManager, pantryItemDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error adding acquired item to pantry", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := &types.MealPlanGroceryListItemUpdateRequestInput{
+			Status: pointer.To(types.MealPlanGroceryListItemStatusAcquired),
+		}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"GetMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanGroceryListItem.ID,
+		).Return(helper.exampleMealPlanGroceryListItem, nil)
+
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"UpdateMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanGroceryListItem) bool { return true }),
+		).Return(nil)
+		helper.service.mealPlanGroceryListItemDataManager = dbManager
+
+		pantryItemDataManager := &mocktypes.PantryItemDataManagerMock{}
+		pantryItemDataManager.On(
+			"CreatePantryItemForGroceryListItem",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.PantryItemDatabaseCreationInput"),
+		).Return((*types.PantryItem)(nil), errors.New("blah"))
+		helper.service.pantryItemDataManager = pantryItemDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil).Once()
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, dbManager, pantryItemDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with acquired item already in pantry", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleInput := &types.MealPlanGroceryListItemUpdateRequestInput{
+			Status: pointer.To(types.MealPlanGroceryListItemStatusAcquired),
+		}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"GetMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanGroceryListItem.ID,
+		).Return(helper.exampleMealPlanGroceryListItem, nil)
+
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"UpdateMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanGroceryListItem) bool { return true }),
+		).Return(nil)
+		helper.service.mealPlanGroceryListItemDataManager = dbManager
+
+		pantryItemDataManager := &mocktypes.PantryItemDataManagerMock{}
+		pantryItemDataManager.On(
+			"CreatePantryItemForGroceryListItem",
+			testutils.ContextMatcher,
+			mock.AnythingOfType("*types.PantryItemDatabaseCreationInput"),
+		).Return((*types.PantryItem)(nil), nil)
+		helper.service.pantryItemDataManager = pantryItemDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil).Once()
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, dbManager, pantryItemDataManager, dataChangesPublisher)
+	})
+
+	T.Run("takes no longer acquired items out of the pantry", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlanGroceryListItem.Status = types.MealPlanGroceryListItemStatusAcquired
+
+		exampleInput := &types.MealPlanGroceryListItemUpdateRequestInput{
+			Status: pointer.To(types.MealPlanGroceryListItemStatusNeeds),
+		}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"GetMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanGroceryListItem.ID,
+		).Return(helper.exampleMealPlanGroceryListItem, nil)
+
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"UpdateMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanGroceryListItem) bool { return true }),
+		).Return(nil)
+		helper.service.mealPlanGroceryListItemDataManager = dbManager
+
+		pantryItemDataManager := &mocktypes.PantryItemDataManagerMock{}
+		pantryItemDataManager.On(
+			"ArchivePantryItemForGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlanGroceryListItem.ID,
+			helper.exampleHousehold.ID,
+		).Return(fakes.BuildFakeID(), nil)
+		helper.service.pantryItemDataManager = pantryItemDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil).Twice()
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, dbManager, pantryItemDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with no longer acquired item already out of the pantry", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlanGroceryListItem.Status = types.MealPlanGroceryListItemStatusAcquired
+
+		exampleInput := &types.MealPlanGroceryListItemUpdateRequestInput{
+			Status: pointer.To(types.MealPlanGroceryListItemStatusNeeds),
+		}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"GetMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanGroceryListItem.ID,
+		).Return(helper.exampleMealPlanGroceryListItem, nil)
+
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"UpdateMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanGroceryListItem) bool { return true }),
+		).Return(nil)
+		helper.service.mealPlanGroceryListItemDataManager = dbManager
+
+		pantryItemDataManager := &mocktypes.PantryItemDataManagerMock{}
+		pantryItemDataManager.On(
+			"ArchivePantryItemForGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlanGroceryListItem.ID,
+			helper.exampleHousehold.ID,
+		).Return("", nil)
+		helper.service.pantryItemDataManager = pantryItemDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil).Once()
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, dbManager, pantryItemDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error taking no longer acquired item out of pantry", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleMealPlanGroceryListItem.Status = types.MealPlanGroceryListItemStatusAcquired
+
+		exampleInput := &types.MealPlanGroceryListItemUpdateRequestInput{
+			Status: pointer.To(types.MealPlanGroceryListItemStatusNeeds),
+		}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"GetMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanGroceryListItem.ID,
+		).Return(helper.exampleMealPlanGroceryListItem, nil)
+
+		dbManager.MealPlanGroceryListItemDataManagerMock.On(
+			"UpdateMealPlanGroceryListItem",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.MealPlanGroceryListItem) bool { return true }),
+		).Return(nil)
+		helper.service.mealPlanGroceryListItemDataManager = dbManager
+
+		pantryItemDataManager := &mocktypes.PantryItemDataManagerMock{}
+		pantryItemDataManager.On(
+			"ArchivePantryItemForGroceryListItem",
+			testutils.ContextMatcher,
+			helper.exampleMealPlanGroceryListItem.ID,
+			helper.exampleHousehold.ID,
+		).Return("", errors.New("blah"))
+		helper.service.pantryItemDataManager = pantryItemDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil).Once()
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, dbManager, pantryItemDataManager, dataChangesPublisher)
+	})
 }
 
 func TestMealPlanGroceryListItemsService_ArchiveHandler(T *testing.T) {
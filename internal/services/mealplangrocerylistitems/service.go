@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -26,11 +27,13 @@
 	service struct {
 		logger                             logging.Logger
 		mealPlanGroceryListItemDataManager types.MealPlanGroceryListItemDataManager
+		pantryItemDataManager              types.PantryItemDataManager
 		mealPlanIDFetcher                  func(*http.Request) string
 		mealPlanEventIDFetcher             func(*http.Request) string
 		mealPlanGroceryListItemIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher          func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher               messagequeue.Publisher
+		transactionRunner                  database.TransactionRunner
 		encoderDecoder                     encoding.ServerEncoderDecoder
 		tracer                             tracing.Tracer
 	}
@@ -41,6 +44,8 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	mealPlanGroceryListItemDataManager types.MealPlanGroceryListItemDataManager,
+	pantryItemDataManager types.PantryItemDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -58,7 +63,9 @@ func ProvideService(
 		mealPlanGroceryListItemIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(MealPlanGroceryListItemIDURIParamKey),
 		sessionContextDataFetcher:          authservice.FetchContextFromRequest,
 		mealPlanGroceryListItemDataManager: mealPlanGroceryListItemDataManager,
+		pantryItemDataManager:              pantryItemDataManager,
 		dataChangesPublisher:               dataChangesPublisher,
+		transactionRunner:                  transactionRunner,
 		encoderDecoder:                     encoder,
 		tracer:                             tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
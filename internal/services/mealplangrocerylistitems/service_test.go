@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,8 +22,10 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:                  database.NewNoopTransactionRunner(),
 		logger:                             logging.NewNoopLogger(),
 		mealPlanGroceryListItemDataManager: &mocktypes.MealPlanGroceryListItemDataManagerMock{},
+		pantryItemDataManager:              &mocktypes.PantryItemDataManagerMock{},
 		mealPlanGroceryListItemIDFetcher:   func(req *http.Request) string { return "" },
 		encoderDecoder:                     encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
 		tracer:                             tracing.NewTracerForTest("test"),
@@ -60,6 +63,8 @@ func TestProvideMealPlansService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanGroceryListItemDataManagerMock{},
+			&mocktypes.PantryItemDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -86,6 +91,8 @@ func TestProvideMealPlansService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanGroceryListItemDataManagerMock{},
+			&mocktypes.PantryItemDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -1,6 +1,7 @@
 package mealplanoptions
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -81,8 +82,30 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	input.BelongsToMealPlanEvent = mealPlanEventID
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	mealPlanOption, err := s.mealPlanOptionDataManager.CreateMealPlanOption(ctx, input)
-	if err != nil {
+	var mealPlanOption *types.MealPlanOption
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if mealPlanOption, err = s.mealPlanOptionDataManager.CreateMealPlanOption(ctx, input); err != nil {
+			return err
+		}
+
+		// conflicts don't block creation, they're only surfaced as a warning.
+		conflicts, conflictsErr := s.conflictChecker.CheckMealForHousehold(ctx, sessionCtxData.ActiveHouseholdID, providedInput.MealID)
+		if conflictsErr != nil {
+			observability.AcknowledgeError(conflictsErr, logger, span, "checking meal plan option for dietary conflicts")
+		} else {
+			mealPlanOption.DietaryConflicts = conflicts
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.MealPlanOptionCreatedCustomerEventType,
+			MealPlanID:     mealPlanID,
+			MealPlanOption: mealPlanOption,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan option")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -90,18 +113,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.MealPlanOptionCreatedCustomerEventType,
-		MealPlanID:     mealPlanID,
-		MealPlanOption: mealPlanOption,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanOption]{
 		Details: responseDetails,
 		Data:    mealPlanOption,
@@ -322,7 +333,21 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	mealPlanOption.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.mealPlanOptionDataManager.UpdateMealPlanOption(ctx, mealPlanOption); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanOptionDataManager.UpdateMealPlanOption(ctx, mealPlanOption); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.MealPlanOptionUpdatedCustomerEventType,
+			MealPlanID:     mealPlanID,
+			MealPlanOption: mealPlanOption,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan option")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -330,18 +355,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.MealPlanOptionUpdatedCustomerEventType,
-		MealPlanID:     mealPlanID,
-		MealPlanOption: mealPlanOption,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanOption]{
 		Details: responseDetails,
 		Data:    mealPlanOption,
@@ -409,7 +422,21 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.mealPlanOptionDataManager.ArchiveMealPlanOption(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanOptionDataManager.ArchiveMealPlanOption(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:        types.MealPlanOptionArchivedCustomerEventType,
+			MealPlanID:       mealPlanID,
+			MealPlanOptionID: mealPlanOptionID,
+			HouseholdID:      sessionCtxData.ActiveHouseholdID,
+			UserID:           sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan option")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -417,22 +444,94 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:        types.MealPlanOptionArchivedCustomerEventType,
-		MealPlanID:       mealPlanID,
-		MealPlanOptionID: mealPlanOptionID,
-		HouseholdID:      sessionCtxData.ActiveHouseholdID,
-		UserID:           sessionCtxData.Requester.UserID,
+	responseValue := &types.APIResponse[*types.MealPlanOption]{
+		Details: responseDetails,
 	}
 
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
+	// let everybody go home.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// DietaryConflictsHandler returns a GET handler that reports how a meal plan option's meal conflicts with the household's allergies and dislikes.
+func (s *service) DietaryConflictsHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
 	}
 
-	responseValue := &types.APIResponse[*types.MealPlanOption]{
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine meal plan ID.
+	mealPlanID := s.mealPlanIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
+
+	// determine meal plan event ID.
+	mealPlanEventID := s.mealPlanEventIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
+
+	// determine meal plan option ID.
+	mealPlanOptionID := s.mealPlanOptionIDFetcher(req)
+	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
+	logger = logger.WithValue(keys.MealPlanOptionIDKey, mealPlanOptionID)
+
+	// fetch meal plan option from database.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	mealPlanOption, err := s.mealPlanOptionDataManager.GetMealPlanOption(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving meal plan option")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	conflictsTimer := timing.NewMetric("dietary_conflicts").WithDesc("check dietary conflicts").Start()
+	conflicts, err := s.conflictChecker.CheckMealForHousehold(ctx, sessionCtxData.ActiveHouseholdID, mealPlanOption.Meal.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "checking meal plan option for dietary conflicts")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	conflictsTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.MealPlanOptionDietaryConflictReport]{
 		Details: responseDetails,
+		Data: &types.MealPlanOptionDietaryConflictReport{
+			MealPlanOptionID: mealPlanOption.ID,
+			MealID:           mealPlanOption.Meal.ID,
+			Conflicts:        conflicts,
+		},
 	}
 
-	// let everybody go home.
 	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
 }
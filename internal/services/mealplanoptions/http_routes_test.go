@@ -9,6 +9,7 @@
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/dietaryconflicts"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -47,6 +48,16 @@ func TestMealPlanOptionsService_CreateHandler(T *testing.T) {
 		).Return(helper.exampleMealPlanOption, nil)
 		helper.service.mealPlanOptionDataManager = dbManager
 
+		exampleConflicts := []*types.DietaryConflict{fakes.BuildFakeDietaryConflict()}
+		conflictChecker := &dietaryconflicts.MockConflictChecker{}
+		conflictChecker.On(
+			"CheckMealForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleCreationInput.MealID,
+		).Return(exampleConflicts, nil)
+		helper.service.conflictChecker = conflictChecker
+
 		dataChangesPublisher := &mockpublishers.Publisher{}
 		dataChangesPublisher.On(
 			"Publish",
@@ -61,9 +72,61 @@ func TestMealPlanOptionsService_CreateHandler(T *testing.T) {
 		var actual *types.APIResponse[*types.MealPlanOption]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		assert.Equal(t, actual.Data, helper.exampleMealPlanOption)
+		assert.Equal(t, exampleConflicts, actual.Data.DietaryConflicts)
 		assert.NoError(t, actual.Error.AsError())
 
-		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
+		mock.AssertExpectationsForObjects(t, dbManager, conflictChecker, dataChangesPublisher)
+	})
+
+	T.Run("with error checking for dietary conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleCreationInput := fakes.BuildFakeMealPlanOptionCreationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleCreationInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.MealPlanOptionDataManagerMock.On(
+			"CreateMealPlanOption",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(*types.MealPlanOptionDatabaseCreationInput) bool { return true }),
+		).Return(helper.exampleMealPlanOption, nil)
+		helper.service.mealPlanOptionDataManager = dbManager
+
+		conflictChecker := &dietaryconflicts.MockConflictChecker{}
+		conflictChecker.On(
+			"CheckMealForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleCreationInput.MealID,
+		).Return([]*types.DietaryConflict(nil), errors.New("blah"))
+		helper.service.conflictChecker = conflictChecker
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.CreateHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOption]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, helper.exampleMealPlanOption)
+		assert.Empty(t, actual.Data.DietaryConflicts)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, dbManager, conflictChecker, dataChangesPublisher)
 	})
 
 	T.Run("without input attached", func(t *testing.T) {
@@ -189,6 +252,15 @@ func TestMealPlanOptionsService_CreateHandler(T *testing.T) {
 		).Return(helper.exampleMealPlanOption, nil)
 		helper.service.mealPlanOptionDataManager = dbManager
 
+		conflictChecker := &dietaryconflicts.MockConflictChecker{}
+		conflictChecker.On(
+			"CheckMealForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			exampleCreationInput.MealID,
+		).Return([]*types.DietaryConflict(nil), nil)
+		helper.service.conflictChecker = conflictChecker
+
 		dataChangesPublisher := &mockpublishers.Publisher{}
 		dataChangesPublisher.On(
 			"Publish",
@@ -199,13 +271,13 @@ func TestMealPlanOptionsService_CreateHandler(T *testing.T) {
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanOption]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMealPlanOption)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
-		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
+		mock.AssertExpectationsForObjects(t, dbManager, conflictChecker, dataChangesPublisher)
 	})
 }
 
@@ -666,11 +738,11 @@ func TestMealPlanOptionsService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanOption]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMealPlanOption)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -854,11 +926,192 @@ func TestMealPlanOptionsService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanOption]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
 }
+
+func TestMealPlanOptionsService_DietaryConflictsHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanOptionDataManager := &mocktypes.MealPlanOptionDataManagerMock{}
+		mealPlanOptionDataManager.On(
+			"GetMealPlanOption",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+			helper.exampleMealPlanOption.ID,
+		).Return(helper.exampleMealPlanOption, nil)
+		helper.service.mealPlanOptionDataManager = mealPlanOptionDataManager
+
+		exampleConflicts := []*types.DietaryConflict{fakes.BuildFakeDietaryConflict()}
+		conflictChecker := &dietaryconflicts.MockConflictChecker{}
+		conflictChecker.On(
+			"CheckMealForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlanOption.Meal.ID,
+		).Return(exampleConflicts, nil)
+		helper.service.conflictChecker = conflictChecker
+
+		helper.service.DietaryConflictsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, &types.MealPlanOptionDietaryConflictReport{
+			MealPlanOptionID: helper.exampleMealPlanOption.ID,
+			MealID:           helper.exampleMealPlanOption.Meal.ID,
+			Conflicts:        exampleConflicts,
+		}, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, mealPlanOptionDataManager, conflictChecker)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.DietaryConflictsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such meal plan option in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanOptionDataManager := &mocktypes.MealPlanOptionDataManagerMock{}
+		mealPlanOptionDataManager.On(
+			"GetMealPlanOption",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+			helper.exampleMealPlanOption.ID,
+		).Return((*types.MealPlanOption)(nil), sql.ErrNoRows)
+		helper.service.mealPlanOptionDataManager = mealPlanOptionDataManager
+
+		helper.service.DietaryConflictsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanOptionDataManager)
+	})
+
+	T.Run("with error fetching meal plan option from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanOptionDataManager := &mocktypes.MealPlanOptionDataManagerMock{}
+		mealPlanOptionDataManager.On(
+			"GetMealPlanOption",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+			helper.exampleMealPlanOption.ID,
+		).Return((*types.MealPlanOption)(nil), errors.New("blah"))
+		helper.service.mealPlanOptionDataManager = mealPlanOptionDataManager
+
+		helper.service.DietaryConflictsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanOptionDataManager)
+	})
+
+	T.Run("with no such meal", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanOptionDataManager := &mocktypes.MealPlanOptionDataManagerMock{}
+		mealPlanOptionDataManager.On(
+			"GetMealPlanOption",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+			helper.exampleMealPlanOption.ID,
+		).Return(helper.exampleMealPlanOption, nil)
+		helper.service.mealPlanOptionDataManager = mealPlanOptionDataManager
+
+		conflictChecker := &dietaryconflicts.MockConflictChecker{}
+		conflictChecker.On(
+			"CheckMealForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlanOption.Meal.ID,
+		).Return([]*types.DietaryConflict(nil), sql.ErrNoRows)
+		helper.service.conflictChecker = conflictChecker
+
+		helper.service.DietaryConflictsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanOptionDataManager, conflictChecker)
+	})
+
+	T.Run("with error checking for dietary conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		mealPlanOptionDataManager := &mocktypes.MealPlanOptionDataManagerMock{}
+		mealPlanOptionDataManager.On(
+			"GetMealPlanOption",
+			testutils.ContextMatcher,
+			helper.exampleMealPlan.ID,
+			helper.exampleMealPlanEvent.ID,
+			helper.exampleMealPlanOption.ID,
+		).Return(helper.exampleMealPlanOption, nil)
+		helper.service.mealPlanOptionDataManager = mealPlanOptionDataManager
+
+		conflictChecker := &dietaryconflicts.MockConflictChecker{}
+		conflictChecker.On(
+			"CheckMealForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleMealPlanOption.Meal.ID,
+		).Return([]*types.DietaryConflict(nil), errors.New("blah"))
+		helper.service.conflictChecker = conflictChecker
+
+		helper.service.DietaryConflictsHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, mealPlanOptionDataManager, conflictChecker)
+	})
+}
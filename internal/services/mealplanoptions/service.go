@@ -4,7 +4,9 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/dietaryconflicts"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -26,11 +28,13 @@
 	service struct {
 		logger                    logging.Logger
 		mealPlanOptionDataManager types.MealPlanOptionDataManager
+		conflictChecker           dietaryconflicts.ConflictChecker
 		mealPlanIDFetcher         func(*http.Request) string
 		mealPlanEventIDFetcher    func(*http.Request) string
 		mealPlanOptionIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 		encoderDecoder            encoding.ServerEncoderDecoder
 		tracer                    tracing.Tracer
 	}
@@ -41,10 +45,12 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	mealPlanOptionDataManager types.MealPlanOptionDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
 	tracerProvider tracing.TracerProvider,
+	conflictChecker dietaryconflicts.ConflictChecker,
 ) (types.MealPlanOptionDataService, error) {
 	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
 	if err != nil {
@@ -58,7 +64,9 @@ func ProvideService(
 		mealPlanOptionIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(MealPlanOptionIDURIParamKey),
 		sessionContextDataFetcher: authservice.FetchContextFromRequest,
 		mealPlanOptionDataManager: mealPlanOptionDataManager,
+		conflictChecker:           conflictChecker,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		encoderDecoder:            encoder,
 		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
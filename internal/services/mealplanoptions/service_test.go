@@ -5,8 +5,10 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	"github.com/dinnerdonebetter/backend/internal/features/dietaryconflicts"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -21,8 +23,10 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:         database.NewNoopTransactionRunner(),
 		logger:                    logging.NewNoopLogger(),
 		mealPlanOptionDataManager: &mocktypes.MealPlanOptionDataManagerMock{},
+		conflictChecker:           &dietaryconflicts.MockConflictChecker{},
 		mealPlanOptionIDFetcher:   func(req *http.Request) string { return "" },
 		encoderDecoder:            encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
 		tracer:                    tracing.NewTracerForTest("test"),
@@ -60,10 +64,12 @@ func TestProvideMealPlanOptionsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanOptionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
 			tracing.NewNoopTracerProvider(),
+			&dietaryconflicts.MockConflictChecker{},
 		)
 
 		assert.NotNil(t, s)
@@ -86,10 +92,12 @@ func TestProvideMealPlanOptionsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanOptionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
 			tracing.NewNoopTracerProvider(),
+			&dietaryconflicts.MockConflictChecker{},
 		)
 
 		assert.Nil(t, s)
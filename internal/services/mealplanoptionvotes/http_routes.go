@@ -1,6 +1,7 @@
 package mealplanoptionvotes
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -96,85 +97,93 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	input.ByUser = sessionCtxData.Requester.UserID
 
-	mealPlanOptionVotes, err := s.dataManager.CreateMealPlanOptionVote(ctx, input)
-	if err != nil {
-		observability.AcknowledgeError(err, logger, span, "creating meal plan option vote")
-		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-		return
-	}
+	// the votes, any finalization they trigger, and the messages about both are committed together.
+	var mealPlanOptionVotes []*types.MealPlanOptionVote
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if mealPlanOptionVotes, err = s.dataManager.CreateMealPlanOptionVote(ctx, input); err != nil {
+			return observability.PrepareError(err, span, "creating meal plan option vote")
+		}
 
-	for _, vote := range mealPlanOptionVotes {
-		dcm := &types.DataChangeMessage{
-			EventType:            types.MealPlanOptionVoteCreatedCustomerEventType,
-			MealPlanID:           mealPlanID,
-			MealPlanOptionID:     vote.BelongsToMealPlanOption,
-			MealPlanOptionVote:   vote,
-			MealPlanOptionVoteID: vote.ID,
-			HouseholdID:          sessionCtxData.ActiveHouseholdID,
-			UserID:               sessionCtxData.Requester.UserID,
+		for _, vote := range mealPlanOptionVotes {
+			dcm := &types.DataChangeMessage{
+				EventType:            types.MealPlanOptionVoteCreatedCustomerEventType,
+				MealPlanID:           mealPlanID,
+				MealPlanOptionID:     vote.BelongsToMealPlanOption,
+				MealPlanOptionVote:   vote,
+				MealPlanOptionVoteID: vote.ID,
+				HouseholdID:          sessionCtxData.ActiveHouseholdID,
+				UserID:               sessionCtxData.Requester.UserID,
+			}
+
+			if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
+				return observability.PrepareError(err, span, "publishing data change message about meal plan option vote")
+			}
 		}
 
-		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-			observability.AcknowledgeError(err, logger, span, "publishing data change message about meal plan option vote")
+		if len(mealPlanOptionVotes) == 0 {
+			return nil
 		}
-	}
 
-	if len(mealPlanOptionVotes) > 0 {
 		lastVote := mealPlanOptionVotes[len(mealPlanOptionVotes)-1]
 
 		// have all votes been received for an option? if so, finalize it
 		mealPlanOptionFinalized, optionFinalizationErr := s.dataManager.FinalizeMealPlanOption(ctx, mealPlanID, mealPlanEventID, lastVote.BelongsToMealPlanOption, sessionCtxData.ActiveHouseholdID)
 		if optionFinalizationErr != nil {
-			observability.AcknowledgeError(optionFinalizationErr, logger, span, "finalizing meal plan option vote")
-			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-			return
+			return observability.PrepareError(optionFinalizationErr, span, "finalizing meal plan option vote")
 		}
 
 		// have all options for the meal plan been selected? if so, finalize the meal plan and fire event
-		if mealPlanOptionFinalized {
-			logger.Debug("meal plan option finalized")
-			// fire event
-			dcm := &types.DataChangeMessage{
-				EventType:            types.MealPlanOptionFinalizedCreatedCustomerEventType,
-				MealPlanID:           mealPlanID,
-				MealPlanOptionID:     lastVote.BelongsToMealPlanOption,
-				MealPlanOptionVote:   lastVote,
-				MealPlanOptionVoteID: lastVote.ID,
-				HouseholdID:          sessionCtxData.ActiveHouseholdID,
-				UserID:               sessionCtxData.Requester.UserID,
-			}
+		if !mealPlanOptionFinalized {
+			return nil
+		}
 
-			if dataChangePublishErr := s.dataChangesPublisher.Publish(ctx, dcm); dataChangePublishErr != nil {
-				observability.AcknowledgeError(dataChangePublishErr, logger, span, "publishing data change message about meal plan option finalization")
-			}
+		logger.Debug("meal plan option finalized")
+		// fire event
+		dcm := &types.DataChangeMessage{
+			EventType:            types.MealPlanOptionFinalizedCreatedCustomerEventType,
+			MealPlanID:           mealPlanID,
+			MealPlanOptionID:     lastVote.BelongsToMealPlanOption,
+			MealPlanOptionVote:   lastVote,
+			MealPlanOptionVoteID: lastVote.ID,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
 
-			mealPlanFinalized, finalizationErr := s.dataManager.AttemptToFinalizeMealPlan(ctx, mealPlanID, sessionCtxData.ActiveHouseholdID)
-			if finalizationErr != nil {
-				observability.AcknowledgeError(err, logger, span, "finalizing meal plan option vote")
-				errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-				return
-			}
+		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
+			return observability.PrepareError(err, span, "publishing data change message about meal plan option finalization")
+		}
 
-			if mealPlanFinalized {
-				logger.Debug("meal plan finalized")
-				// fire event
-				dcm = &types.DataChangeMessage{
-					EventType:            types.MealPlanFinalizedCustomerEventType,
-					MealPlanID:           mealPlanID,
-					MealPlanOptionID:     lastVote.BelongsToMealPlanOption,
-					MealPlanOptionVote:   lastVote,
-					MealPlanOptionVoteID: lastVote.ID,
-					HouseholdID:          sessionCtxData.ActiveHouseholdID,
-					UserID:               sessionCtxData.Requester.UserID,
-				}
-				if dataChangePublishErr := s.dataChangesPublisher.Publish(ctx, dcm); dataChangePublishErr != nil {
-					observability.AcknowledgeError(dataChangePublishErr, logger, span, "publishing data change message about meal plan finalization")
-				}
-			}
+		mealPlanFinalized, finalizationErr := s.dataManager.AttemptToFinalizeMealPlan(ctx, mealPlanID, sessionCtxData.ActiveHouseholdID)
+		if finalizationErr != nil {
+			return observability.PrepareError(finalizationErr, span, "finalizing meal plan")
+		}
+
+		if !mealPlanFinalized {
+			return nil
 		}
+
+		logger.Debug("meal plan finalized")
+		// fire event
+		dcm = &types.DataChangeMessage{
+			EventType:            types.MealPlanFinalizedCustomerEventType,
+			MealPlanID:           mealPlanID,
+			MealPlanOptionID:     lastVote.BelongsToMealPlanOption,
+			MealPlanOptionVote:   lastVote,
+			MealPlanOptionVoteID: lastVote.ID,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
+			return observability.PrepareError(err, span, "publishing data change message about meal plan finalization")
+		}
+
+		return nil
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "creating meal plan option vote")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
 	}
 
 	responseValue := &types.APIResponse[[]*types.MealPlanOptionVote]{
@@ -403,27 +412,29 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	// update the meal plan option vote.
 	mealPlanOptionVote.Update(input)
 
-	if err = s.dataManager.UpdateMealPlanOptionVote(ctx, mealPlanOptionVote); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.dataManager.UpdateMealPlanOptionVote(ctx, mealPlanOptionVote); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.MealPlanOptionVoteUpdatedCustomerEventType,
+			MealPlanID:           mealPlanID,
+			MealPlanOptionID:     mealPlanOptionID,
+			MealPlanOptionVote:   mealPlanOptionVote,
+			MealPlanOptionVoteID: mealPlanOptionVote.ID,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating meal plan option vote")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:            types.MealPlanOptionVoteUpdatedCustomerEventType,
-		MealPlanID:           mealPlanID,
-		MealPlanOptionID:     mealPlanOptionID,
-		MealPlanOptionVote:   mealPlanOptionVote,
-		MealPlanOptionVoteID: mealPlanOptionVote.ID,
-		HouseholdID:          sessionCtxData.ActiveHouseholdID,
-		UserID:               sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanOptionVote]{
 		Details: responseDetails,
 		Data:    mealPlanOptionVote,
@@ -493,26 +504,28 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	if err = s.dataManager.ArchiveMealPlanOptionVote(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID, mealPlanOptionVoteID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.dataManager.ArchiveMealPlanOptionVote(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID, mealPlanOptionVoteID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.MealPlanOptionVoteArchivedCustomerEventType,
+			MealPlanID:           mealPlanID,
+			MealPlanOptionID:     mealPlanOptionID,
+			MealPlanOptionVoteID: mealPlanOptionVoteID,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal plan option vote")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:            types.MealPlanOptionVoteArchivedCustomerEventType,
-		MealPlanID:           mealPlanID,
-		MealPlanOptionID:     mealPlanOptionID,
-		MealPlanOptionVoteID: mealPlanOptionVoteID,
-		HouseholdID:          sessionCtxData.ActiveHouseholdID,
-		UserID:               sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanOptionVote]{
 		Details: responseDetails,
 	}
@@ -287,44 +287,16 @@ func TestMealPlanOptionVotesService_CreateHandler(T *testing.T) {
 			testutils.ContextMatcher,
 			testutils.DataChangeMessageMatcher,
 		).Return(errors.New("blah"))
-
-		dbManager.MealPlanOptionDataManagerMock.On(
-			"FinalizeMealPlanOption",
-			testutils.ContextMatcher,
-			helper.exampleMealPlan.ID,
-			helper.exampleMealPlanEvent.ID,
-			helper.exampleMealPlanOption.ID,
-			helper.exampleHousehold.ID,
-		).Return(true, nil)
-
-		dataChangesPublisher.On(
-			"Publish",
-			testutils.ContextMatcher,
-			testutils.DataChangeMessageMatcher,
-		).Return(nil)
-
-		dbManager.MealPlanDataManagerMock.On(
-			"AttemptToFinalizeMealPlan",
-			testutils.ContextMatcher,
-			helper.exampleMealPlan.ID,
-			helper.exampleHousehold.ID,
-		).Return(true, nil)
 		helper.service.dataManager = dbManager
-
-		dataChangesPublisher.On(
-			"Publish",
-			testutils.ContextMatcher,
-			testutils.DataChangeMessageMatcher,
-		).Return(nil)
 		helper.service.dataChangesPublisher = dataChangesPublisher
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[[]*types.MealPlanOptionVote]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NotEmpty(t, actual.Data)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -1078,11 +1050,11 @@ func TestMealPlanOptionVotesService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanOptionVote]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMealPlanOptionVote)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -1274,10 +1246,10 @@ func TestMealPlanOptionVotesService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.MealPlanOptionVote]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
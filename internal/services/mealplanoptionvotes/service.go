@@ -41,6 +41,7 @@
 		mealPlanOptionVoteIDFetcher func(*http.Request) string
 		sessionContextDataFetcher   func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher        messagequeue.Publisher
+		transactionRunner           database.TransactionRunner
 		encoderDecoder              encoding.ServerEncoderDecoder
 		tracer                      tracing.Tracer
 	}
@@ -70,6 +71,7 @@ func ProvideService(
 		sessionContextDataFetcher:   authservice.FetchContextFromRequest,
 		dataManager:                 dataManager,
 		dataChangesPublisher:        dataChangesPublisher,
+		transactionRunner:           dataManager,
 		encoderDecoder:              encoder,
 		tracer:                      tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
@@ -22,6 +22,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:           database.NewNoopTransactionRunner(),
 		logger:                      logging.NewNoopLogger(),
 		dataManager:                 database.NewMockDatabase(),
 		mealPlanOptionVoteIDFetcher: func(req *http.Request) string { return "" },
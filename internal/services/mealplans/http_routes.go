@@ -1,6 +1,7 @@
 package mealplans
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -58,8 +59,9 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	// only supported option right now
-	providedInput.ElectionMethod = types.MealPlanElectionMethodSchulze
+	if providedInput.ElectionMethod == "" {
+		providedInput.ElectionMethod = types.MealPlanElectionMethodSchulze
+	}
 
 	if err = providedInput.ValidateWithContext(ctx); err != nil {
 		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
@@ -87,8 +89,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	logger = logger.WithValue("input", input)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	mealPlan, err := s.mealPlanDataManager.CreateMealPlan(ctx, input)
-	if err != nil {
+	var mealPlan *types.MealPlan
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if mealPlan, err = s.mealPlanDataManager.CreateMealPlan(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.MealPlanCreatedCustomerEventType,
+			MealPlan:    mealPlan,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -96,17 +111,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.MealPlanCreatedCustomerEventType,
-		MealPlan:    mealPlan,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlan]{
 		Details: responseDetails,
 		Data:    mealPlan,
@@ -296,7 +300,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	mealPlan.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.mealPlanDataManager.UpdateMealPlan(ctx, mealPlan); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanDataManager.UpdateMealPlan(ctx, mealPlan); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.MealPlanUpdatedCustomerEventType,
+			MealPlan:    mealPlan,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -304,17 +321,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.MealPlanUpdatedCustomerEventType,
-		MealPlan:    mealPlan,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlan]{
 		Details: responseDetails,
 		Data:    mealPlan,
@@ -372,7 +378,20 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.mealPlanDataManager.ArchiveMealPlan(ctx, mealPlanID, sessionCtxData.ActiveHouseholdID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanDataManager.ArchiveMealPlan(ctx, mealPlanID, sessionCtxData.ActiveHouseholdID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.MealPlanArchivedCustomerEventType,
+			MealPlanID:  mealPlanID,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -380,17 +399,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.MealPlanArchivedCustomerEventType,
-		MealPlanID:  mealPlanID,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlan]{
 		Details: responseDetails,
 	}
@@ -452,8 +460,21 @@ func (s *service) FinalizeHandler(res http.ResponseWriter, req *http.Request) {
 	readTimer.Stop()
 
 	// update the meal plan.
-	worked, err := s.mealPlanDataManager.AttemptToFinalizeMealPlan(ctx, mealPlan.ID, householdID)
-	if err != nil {
+	var worked bool
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if worked, err = s.mealPlanDataManager.AttemptToFinalizeMealPlan(ctx, mealPlan.ID, householdID); err != nil || !worked {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.MealPlanFinalizedCustomerEventType,
+			MealPlan:    mealPlan,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		if errors.Is(err, database.ErrAlreadyFinalized) {
 			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, nil, http.StatusAlreadyReported)
 		} else {
@@ -469,17 +490,6 @@ func (s *service) FinalizeHandler(res http.ResponseWriter, req *http.Request) {
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
 		return
 	} else {
-		dcm := &types.DataChangeMessage{
-			EventType:   types.MealPlanFinalizedCustomerEventType,
-			MealPlan:    mealPlan,
-			HouseholdID: householdID,
-			UserID:      sessionCtxData.Requester.UserID,
-		}
-
-		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-			observability.AcknowledgeError(err, logger, span, "publishing data change message")
-		}
-
 		mealPlan.Status = string(types.MealPlanStatusFinalized)
 
 		responseValue := &types.APIResponse[*types.MealPlan]{
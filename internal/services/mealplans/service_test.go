@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,6 +20,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:   database.NewNoopTransactionRunner(),
 		logger:              logging.NewNoopLogger(),
 		mealPlanDataManager: &mocktypes.MealPlanDataManagerMock{},
 		mealPlanIDFetcher:   func(req *http.Request) string { return "" },
@@ -50,6 +52,7 @@ func TestProvideMealPlansService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -76,6 +79,7 @@ func TestProvideMealPlansService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.MealPlanDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
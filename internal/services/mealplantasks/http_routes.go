@@ -1,6 +1,7 @@
 package mealplantasks
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -76,8 +77,22 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	mealPlanTask, err := s.mealPlanTaskDataManager.CreateMealPlanTask(ctx, input)
-	if err != nil {
+	var mealPlanTask *types.MealPlanTask
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if mealPlanTask, err = s.mealPlanTaskDataManager.CreateMealPlanTask(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:    types.MealPlanCreatedCustomerEventType,
+			MealPlanID:   mealPlanID,
+			MealPlanTask: mealPlanTask,
+			HouseholdID:  sessionCtxData.ActiveHouseholdID,
+			UserID:       sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal plan")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -85,18 +100,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:    types.MealPlanCreatedCustomerEventType,
-		MealPlanID:   mealPlanID,
-		MealPlanTask: mealPlanTask,
-		HouseholdID:  sessionCtxData.ActiveHouseholdID,
-		UserID:       sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanTask]{
 		Details: responseDetails,
 		Data:    mealPlanTask,
@@ -297,25 +300,27 @@ func (s *service) StatusChangeHandler(res http.ResponseWriter, req *http.Request
 
 	mealPlanTask.Update(providedInput)
 
-	if err = s.mealPlanTaskDataManager.ChangeMealPlanTaskStatus(ctx, providedInput); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealPlanTaskDataManager.ChangeMealPlanTaskStatus(ctx, providedInput); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.MealPlanTaskStatusChangedCustomerEventType,
+			MealPlanTask:   mealPlanTask,
+			MealPlanTaskID: mealPlanTaskID,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal plan task")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.MealPlanTaskStatusChangedCustomerEventType,
-		MealPlanTask:   mealPlanTask,
-		MealPlanTaskID: mealPlanTaskID,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.MealPlanTask]{
 		Details: responseDetails,
 		Data:    mealPlanTask,
@@ -1,6 +1,7 @@
 package meals
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -74,8 +75,21 @@ func (s *service) CreateMealHandler(res http.ResponseWriter, req *http.Request)
 	tracing.AttachToSpan(span, keys.MealIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	meal, err := s.mealDataManager.CreateMeal(ctx, input)
-	if err != nil {
+	var meal *types.Meal
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if meal, err = s.mealDataManager.CreateMeal(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.MealCreatedCustomerEventType,
+			Meal:        meal,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating meal")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -83,17 +97,6 @@ func (s *service) CreateMealHandler(res http.ResponseWriter, req *http.Request)
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.MealCreatedCustomerEventType,
-		Meal:        meal,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.Meal]{
 		Details: responseDetails,
 		Data:    meal,
@@ -150,6 +153,13 @@ func (s *service) ReadMealHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	readTimer.Stop()
 
+	// nutrition is a nice-to-have, so failing to calculate it shouldn't fail the request.
+	nutritionTimer := timing.NewMetric("nutrition").WithDesc("calculate nutrition").Start()
+	if x.Nutrition, err = s.nutritionCalculator.CalculateMealNutrition(ctx, x); err != nil {
+		observability.AcknowledgeError(err, logger, span, "calculating meal nutrition")
+	}
+	nutritionTimer.Stop()
+
 	responseValue := &types.APIResponse[*types.Meal]{
 		Details: responseDetails,
 		Data:    x,
@@ -349,7 +359,20 @@ func (s *service) ArchiveMealHandler(res http.ResponseWriter, req *http.Request)
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.mealDataManager.ArchiveMeal(ctx, mealID, sessionCtxData.Requester.UserID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.mealDataManager.ArchiveMeal(ctx, mealID, sessionCtxData.Requester.UserID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.MealArchivedCustomerEventType,
+			MealID:      mealID,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving meal")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -357,17 +380,6 @@ func (s *service) ArchiveMealHandler(res http.ResponseWriter, req *http.Request)
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.MealArchivedCustomerEventType,
-		MealID:      mealID,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.Meal]{
 		Details: responseDetails,
 	}
@@ -11,6 +11,7 @@
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -203,11 +204,11 @@ func TestMealsService_CreateMealHandler(T *testing.T) {
 
 		helper.service.CreateMealHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Meal]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleMeal)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -232,15 +233,65 @@ func TestMealsService_ReadMealHandler(T *testing.T) {
 		).Return(helper.exampleMeal, nil)
 		helper.service.mealDataManager = mealDataManager
 
+		exampleNutrition := &types.NutritionSummary{
+			Total:    *fakes.BuildFakeNutrientAmounts(),
+			Warnings: []string{},
+			Complete: true,
+		}
+
+		nutritionCalculator := &nutrition.MockCalculator{}
+		nutritionCalculator.On(
+			"CalculateMealNutrition",
+			testutils.ContextMatcher,
+			helper.exampleMeal,
+		).Return(exampleNutrition, nil)
+		helper.service.nutritionCalculator = nutritionCalculator
+
 		helper.service.ReadMealHandler(helper.res, helper.req)
 
 		assert.Equal(t, http.StatusOK, helper.res.Code)
 		var actual *types.APIResponse[*types.Meal]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		assert.Equal(t, actual.Data, helper.exampleMeal)
+		assert.Equal(t, exampleNutrition, actual.Data.Nutrition)
 		assert.NoError(t, actual.Error.AsError())
 
-		mock.AssertExpectationsForObjects(t, mealDataManager)
+		mock.AssertExpectationsForObjects(t, mealDataManager, nutritionCalculator)
+	})
+
+	T.Run("with error calculating nutrition", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.mealIDFetcher = func(_ *http.Request) string {
+			return helper.exampleMeal.ID
+		}
+
+		mealDataManager := &mocktypes.MealDataManagerMock{}
+		mealDataManager.On(
+			"GetMeal",
+			testutils.ContextMatcher,
+			helper.exampleMeal.ID,
+		).Return(helper.exampleMeal, nil)
+		helper.service.mealDataManager = mealDataManager
+
+		nutritionCalculator := &nutrition.MockCalculator{}
+		nutritionCalculator.On(
+			"CalculateMealNutrition",
+			testutils.ContextMatcher,
+			helper.exampleMeal,
+		).Return((*types.NutritionSummary)(nil), errors.New("blah"))
+		helper.service.nutritionCalculator = nutritionCalculator
+
+		helper.service.ReadMealHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.Meal]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Nil(t, actual.Data.Nutrition)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, mealDataManager, nutritionCalculator)
 	})
 
 	T.Run("with error retrieving session context data", func(t *testing.T) {
@@ -718,10 +769,10 @@ func TestMealsService_ArchiveMealHandler(T *testing.T) {
 
 		helper.service.ArchiveMealHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Meal]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
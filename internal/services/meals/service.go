@@ -5,7 +5,9 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -30,9 +32,11 @@
 		mealIDFetcher             func(*http.Request) string
 		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 		encoderDecoder            encoding.ServerEncoderDecoder
 		tracer                    tracing.Tracer
 		searchIndex               search.IndexSearcher[types.MealSearchSubset]
+		nutritionCalculator       nutrition.Calculator
 	}
 )
 
@@ -43,10 +47,12 @@ func ProvideService(
 	cfg *Config,
 	searchConfig *searchcfg.Config,
 	mealDataManager types.MealDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
 	tracerProvider tracing.TracerProvider,
+	nutritionCalculator nutrition.Calculator,
 ) (types.MealDataService, error) {
 	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
 	if err != nil {
@@ -65,9 +71,11 @@ func ProvideService(
 		sessionContextDataFetcher: authservice.FetchContextFromRequest,
 		mealDataManager:           mealDataManager,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		encoderDecoder:            encoder,
 		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 		searchIndex:               searchIndex,
+		nutritionCalculator:       nutritionCalculator,
 	}
 
 	return svc, nil
@@ -6,8 +6,10 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -21,11 +23,12 @@
 
 func buildTestService() *service {
 	return &service{
-		logger:          logging.NewNoopLogger(),
-		mealDataManager: &mocktypes.MealDataManagerMock{},
-		mealIDFetcher:   func(req *http.Request) string { return "" },
-		encoderDecoder:  encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
-		tracer:          tracing.NewTracerForTest("test"),
+		transactionRunner: database.NewNoopTransactionRunner(),
+		logger:            logging.NewNoopLogger(),
+		mealDataManager:   &mocktypes.MealDataManagerMock{},
+		mealIDFetcher:     func(req *http.Request) string { return "" },
+		encoderDecoder:    encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
+		tracer:            tracing.NewTracerForTest("test"),
 		cfg: &Config{
 			UseSearchService: false,
 		},
@@ -57,10 +60,12 @@ func TestProvideMealsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.MealDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
 			tracing.NewNoopTracerProvider(),
+			&nutrition.MockCalculator{},
 		)
 
 		assert.NotNil(t, s)
@@ -85,10 +90,12 @@ func TestProvideMealsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.MealDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
 			tracing.NewNoopTracerProvider(),
+			&nutrition.MockCalculator{},
 		)
 
 		assert.Nil(t, s)
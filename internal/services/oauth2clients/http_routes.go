@@ -1,6 +1,7 @@
 package oauth2clients
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -94,8 +95,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 
 	// create the client.
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	client, err := s.oauth2ClientDataManager.CreateOAuth2Client(ctx, dbInput)
-	if err != nil {
+	var client *types.OAuth2Client
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if client, err = s.oauth2ClientDataManager.CreateOAuth2Client(ctx, dbInput); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.OAuth2ClientCreatedCustomerEventType,
+			OAuth2ClientID: client.ID,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating OAuth2 client")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -105,17 +119,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 
 	tracing.AttachToSpan(span, keys.OAuth2ClientClientIDKey, client.ID)
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.OAuth2ClientCreatedCustomerEventType,
-		OAuth2ClientID: client.ID,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	resObj := &types.OAuth2ClientCreationResponse{
 		ID:           client.ID,
 		ClientID:     client.ClientID,
@@ -280,7 +283,20 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 
 	// archive the OAuth2 client in the database.
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	err = s.oauth2ClientDataManager.ArchiveOAuth2Client(ctx, oauth2ClientID)
+	err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.oauth2ClientDataManager.ArchiveOAuth2Client(ctx, oauth2ClientID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.OAuth2ClientArchivedCustomerEventType,
+			OAuth2ClientID: oauth2ClientID,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	})
 	if errors.Is(err, sql.ErrNoRows) {
 		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
@@ -293,17 +309,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.OAuth2ClientArchivedCustomerEventType,
-		OAuth2ClientID: oauth2ClientID,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.OAuth2Client]{
 		Details: responseDetails,
 	}
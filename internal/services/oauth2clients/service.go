@@ -5,6 +5,7 @@
 	"net/http"
 
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -35,6 +36,7 @@
 		secretGenerator           random.Generator
 		tracer                    tracing.Tracer
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 	}
 )
 
@@ -44,6 +46,7 @@ func ProvideOAuth2ClientsService(
 	clientDataManager types.OAuth2ClientDataManager,
 	userDataManager types.UserDataManager,
 	authenticator authentication.Authenticator,
+	transactionRunner database.TransactionRunner,
 	encoderDecoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	cfg *Config,
@@ -67,6 +70,7 @@ func ProvideOAuth2ClientsService(
 		sessionContextDataFetcher: authservice.FetchContextFromRequest,
 		secretGenerator:           secretGenerator,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
 
@@ -25,6 +25,7 @@ func buildTestService(t *testing.T) *service {
 	t.Helper()
 
 	return &service{
+		transactionRunner:         database.NewNoopTransactionRunner(),
 		oauth2ClientDataManager:   database.NewMockDatabase(),
 		logger:                    logging.NewNoopLogger(),
 		encoderDecoder:            encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
@@ -62,6 +63,7 @@ func TestProvideOAuth2ClientsService(T *testing.T) {
 			mockOAuth2ClientDataManager,
 			&mocktypes.UserDataManagerMock{},
 			&mockauthn.Authenticator{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			cfg,
@@ -0,0 +1,4 @@
+/*
+Package pantryitems provides a series of HTTP handlers for managing household pantry items in a compatible database.
+*/
+package pantryitems
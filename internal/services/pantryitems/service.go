@@ -0,0 +1,68 @@
+package pantryitems
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/routing"
+	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	householdsservice "github.com/dinnerdonebetter/backend/internal/services/households"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	serviceName string = "pantry_items_service"
+)
+
+var _ types.PantryItemDataService = (*service)(nil)
+
+type (
+	// service handles pantry items.
+	service struct {
+		logger                    logging.Logger
+		pantryItemDataManager     types.PantryItemDataManager
+		householdIDFetcher        func(*http.Request) string
+		pantryItemIDFetcher       func(*http.Request) string
+		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
+		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
+		encoderDecoder            encoding.ServerEncoderDecoder
+		tracer                    tracing.Tracer
+	}
+)
+
+// ProvideService builds a new PantryItemsService.
+func ProvideService(
+	logger logging.Logger,
+	cfg *Config,
+	pantryItemDataManager types.PantryItemDataManager,
+	transactionRunner database.TransactionRunner,
+	encoder encoding.ServerEncoderDecoder,
+	routeParamManager routing.RouteParamManager,
+	publisherProvider messagequeue.PublisherProvider,
+	tracerProvider tracing.TracerProvider,
+) (types.PantryItemDataService, error) {
+	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
+	if err != nil {
+		return nil, fmt.Errorf("setting up pantry items service data changes publisher: %w", err)
+	}
+
+	svc := &service{
+		logger:                    logging.EnsureLogger(logger).WithName(serviceName),
+		householdIDFetcher:        routeParamManager.BuildRouteParamStringIDFetcher(householdsservice.HouseholdIDURIParamKey),
+		pantryItemIDFetcher:       routeParamManager.BuildRouteParamStringIDFetcher(PantryItemIDURIParamKey),
+		sessionContextDataFetcher: authservice.FetchContextFromRequest,
+		pantryItemDataManager:     pantryItemDataManager,
+		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
+		encoderDecoder:            encoder,
+		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
+	}
+
+	return svc, nil
+}
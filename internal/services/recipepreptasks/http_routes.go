@@ -1,6 +1,7 @@
 package recipepreptasks
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -80,39 +81,45 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.RecipePrepTaskIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	recipePrepTask, err := s.recipePrepTaskDataManager.CreateRecipePrepTask(ctx, input)
-	if err != nil {
-		observability.AcknowledgeError(err, logger, span, "creating recipe prep task")
-		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-		return
-	}
-	createTimer.Stop()
-
-	dcm := &types.DataChangeMessage{
-		EventType:      types.RecipePrepTaskCreatedCustomerEventType,
-		RecipePrepTask: recipePrepTask,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
+	var recipePrepTask *types.RecipePrepTask
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if recipePrepTask, err = s.recipePrepTaskDataManager.CreateRecipePrepTask(ctx, input); err != nil {
+			return err
+		}
 
-	for _, step := range recipePrepTask.TaskSteps {
-		dcm = &types.DataChangeMessage{
-			EventType:          types.RecipePrepTaskStepCreatedCustomerEventType,
-			RecipePrepTask:     recipePrepTask,
-			RecipePrepTaskStep: step,
-			HouseholdID:        sessionCtxData.ActiveHouseholdID,
-			UserID:             sessionCtxData.Requester.UserID,
+		dcm := &types.DataChangeMessage{
+			EventType:      types.RecipePrepTaskCreatedCustomerEventType,
+			RecipePrepTask: recipePrepTask,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
 		}
 
 		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-			observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
+			return err
+		}
+
+		for _, step := range recipePrepTask.TaskSteps {
+			dcm = &types.DataChangeMessage{
+				EventType:          types.RecipePrepTaskStepCreatedCustomerEventType,
+				RecipePrepTask:     recipePrepTask,
+				RecipePrepTaskStep: step,
+				HouseholdID:        sessionCtxData.ActiveHouseholdID,
+				UserID:             sessionCtxData.Requester.UserID,
+			}
+
+			if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
+				return err
+			}
 		}
+
+		return nil
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "creating recipe prep task")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
 	}
+	createTimer.Stop()
 
 	responseValue := &types.APIResponse[*types.RecipePrepTask]{
 		Details: responseDetails,
@@ -316,7 +323,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	recipePrepTask.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.recipePrepTaskDataManager.UpdateRecipePrepTask(ctx, recipePrepTask); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipePrepTaskDataManager.UpdateRecipePrepTask(ctx, recipePrepTask); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.RecipePrepTaskUpdatedCustomerEventType,
+			RecipePrepTask: recipePrepTask,
+			HouseholdID:    sessionCtxData.ActiveHouseholdID,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating recipe prep task")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -324,17 +344,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.RecipePrepTaskUpdatedCustomerEventType,
-		RecipePrepTask: recipePrepTask,
-		HouseholdID:    sessionCtxData.ActiveHouseholdID,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipePrepTask]{
 		Details: responseDetails,
 		Data:    recipePrepTask,
@@ -397,7 +406,19 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.recipePrepTaskDataManager.ArchiveRecipePrepTask(ctx, recipeID, recipePrepTaskID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipePrepTaskDataManager.ArchiveRecipePrepTask(ctx, recipeID, recipePrepTaskID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipePrepTaskArchivedCustomerEventType,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving recipe prep task")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -405,16 +426,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipePrepTaskArchivedCustomerEventType,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipePrepTask]{
 		Details: responseDetails,
 	}
@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	encoding "github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -20,6 +21,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:         database.NewNoopTransactionRunner(),
 		logger:                    logging.NewNoopLogger(),
 		recipePrepTaskDataManager: &mocktypes.RecipePrepTaskDataManagerMock{},
 		recipePrepTaskIDFetcher:   func(req *http.Request) string { return "" },
@@ -55,6 +57,7 @@ func TestProvideRecipePrepTasksService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.RecipePrepTaskDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -81,6 +84,7 @@ func TestProvideRecipePrepTasksService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.RecipePrepTaskDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
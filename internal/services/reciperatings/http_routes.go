@@ -1,6 +1,7 @@
 package reciperatings
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -71,8 +72,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.RecipeRatingIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	recipeRating, err := s.recipeRatingDataManager.CreateRecipeRating(ctx, input)
-	if err != nil {
+	var recipeRating *types.RecipeRating
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if recipeRating, err = s.recipeRatingDataManager.CreateRecipeRating(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:    types.RecipeRatingCreatedCustomerEventType,
+			RecipeRating: recipeRating,
+			UserID:       sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating recipe rating")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -80,16 +93,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:    types.RecipeRatingCreatedCustomerEventType,
-		RecipeRating: recipeRating,
-		UserID:       sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeRating]{
 		Details: responseDetails,
 		Data:    recipeRating,
@@ -278,7 +281,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	recipeRating.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.recipeRatingDataManager.UpdateRecipeRating(ctx, recipeRating); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeRatingDataManager.UpdateRecipeRating(ctx, recipeRating); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:    types.RecipeRatingUpdatedCustomerEventType,
+			RecipeRating: recipeRating,
+			UserID:       sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating recipe rating")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -286,16 +301,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:    types.RecipeRatingUpdatedCustomerEventType,
-		RecipeRating: recipeRating,
-		UserID:       sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeRating]{
 		Details: responseDetails,
 		Data:    recipeRating,
@@ -353,7 +358,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.recipeRatingDataManager.ArchiveRecipeRating(ctx, recipeRatingID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeRatingDataManager.ArchiveRecipeRating(ctx, recipeRatingID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.RecipeRatingArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving recipe rating")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -361,15 +377,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.RecipeRatingArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeRating]{
 		Details: responseDetails,
 	}
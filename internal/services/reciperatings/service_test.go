@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -20,6 +21,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:       database.NewNoopTransactionRunner(),
 		logger:                  logging.NewNoopLogger(),
 		recipeRatingDataManager: &mocktypes.RecipeRatingDataManagerMock{},
 		recipeRatingIDFetcher:   func(req *http.Request) string { return "" },
@@ -57,6 +59,7 @@ func TestProvideRecipeRatingsService(T *testing.T) {
 			logger,
 			cfg,
 			&mocktypes.RecipeRatingDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -85,6 +88,7 @@ func TestProvideRecipeRatingsService(T *testing.T) {
 			logger,
 			cfg,
 			&mocktypes.RecipeRatingDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
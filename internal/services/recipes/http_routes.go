@@ -1,14 +1,19 @@
 package recipes
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"image/png"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/features/recipeimport"
+	"github.com/dinnerdonebetter/backend/internal/features/recipescaling"
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
@@ -81,8 +86,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.RecipeIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	recipe, err := s.recipeDataManager.CreateRecipe(ctx, input)
-	if err != nil {
+	var recipe *types.Recipe
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if recipe, err = s.recipeDataManager.CreateRecipe(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeCreatedCustomerEventType,
+			Recipe:      recipe,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating recipe")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -90,17 +108,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeCreatedCustomerEventType,
-		Recipe:      recipe,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.Recipe]{
 		Details: responseDetails,
 		Data:    recipe,
@@ -159,6 +166,20 @@ func (s *service) ReadHandler(res http.ResponseWriter, req *http.Request) {
 
 	logger.Info("recipe retrieved")
 
+	// nutrition is a nice-to-have, so failing to calculate it shouldn't fail the request.
+	nutritionTimer := timing.NewMetric("nutrition").WithDesc("calculate nutrition").Start()
+	if x.Nutrition, err = s.nutritionCalculator.CalculateRecipeNutrition(ctx, x); err != nil {
+		observability.AcknowledgeError(err, logger, span, "calculating recipe nutrition")
+	}
+	nutritionTimer.Stop()
+
+	// likewise for substitutes, which are only offered for the viewer's own allergies.
+	substitutionsTimer := timing.NewMetric("substitutions").WithDesc("suggest substitutions").Start()
+	if x.IngredientSubstitutions, err = s.substitutionSuggester.SuggestSubstitutionsForUser(ctx, sessionCtxData.Requester.UserID, x); err != nil {
+		observability.AcknowledgeError(err, logger, span, "suggesting ingredient substitutions")
+	}
+	substitutionsTimer.Stop()
+
 	responseValue := &types.APIResponse[*types.Recipe]{
 		Details: responseDetails,
 		Data:    x,
@@ -168,6 +189,12 @@ func (s *service) ReadHandler(res http.ResponseWriter, req *http.Request) {
 	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
 }
 
+// canCookWithMyEquipment determines whether a list request should only include recipes the active household has the equipment for.
+// The filter applies to the requested page, so a filtered page may hold fewer than the requested number of recipes.
+func canCookWithMyEquipment(req *http.Request) bool {
+	return strings.TrimSpace(strings.ToLower(req.URL.Query().Get(types.CanCookWithMyEquipmentQueryKey))) == "true"
+}
+
 // ListHandler is our list route.
 func (s *service) ListHandler(res http.ResponseWriter, req *http.Request) {
 	ctx, span := s.tracer.StartSpan(req.Context())
@@ -201,7 +228,13 @@ func (s *service) ListHandler(res http.ResponseWriter, req *http.Request) {
 	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
 
 	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
-	recipes, err := s.recipeDataManager.GetRecipes(ctx, filter)
+	var recipes *types.QueryFilteredResult[types.Recipe]
+	if canCookWithMyEquipment(req) {
+		recipes, err = s.recipeDataManager.GetCookableRecipes(ctx, sessionCtxData.ActiveHouseholdID, filter)
+	} else {
+		recipes, err = s.recipeDataManager.GetRecipes(ctx, filter)
+	}
+
 	if errors.Is(err, sql.ErrNoRows) {
 		// in the event no rows exist, return an empty list.
 		recipes = &types.QueryFilteredResult[types.Recipe]{Data: []*types.Recipe{}}
@@ -267,7 +300,9 @@ func (s *service) SearchHandler(res http.ResponseWriter, req *http.Request) {
 	}
 
 	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
-	if useDB {
+	if useDB && canCookWithMyEquipment(req) {
+		recipes, err = s.recipeDataManager.SearchForCookableRecipes(ctx, query, sessionCtxData.ActiveHouseholdID, filter)
+	} else if useDB {
 		recipes, err = s.recipeDataManager.SearchForRecipes(ctx, query, filter)
 	} else {
 		var recipeSubsets []*types.RecipeSearchSubset
@@ -301,6 +336,19 @@ func (s *service) SearchHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	readTimer.Stop()
 
+	// search service results aren't paginated, so they can be filtered as they are.
+	if !useDB && canCookWithMyEquipment(req) {
+		equipmentTimer := timing.NewMetric("equipment").WithDesc("filter by equipment").Start()
+		recipes.Data, err = s.equipmentChecker.FilterCookableRecipes(ctx, sessionCtxData.ActiveHouseholdID, recipes.Data)
+		if err != nil {
+			observability.AcknowledgeError(err, logger, span, "filtering recipes by household equipment")
+			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+			return
+		}
+		equipmentTimer.Stop()
+	}
+
 	responseValue := &types.APIResponse[[]*types.Recipe]{
 		Details:    responseDetails,
 		Data:       recipes.Data,
@@ -385,7 +433,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	recipe.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.recipeDataManager.UpdateRecipe(ctx, recipe); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeDataManager.UpdateRecipe(ctx, recipe); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeUpdatedCustomerEventType,
+			Recipe:      recipe,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating recipe")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -393,17 +454,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeUpdatedCustomerEventType,
-		Recipe:      recipe,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.Recipe]{
 		Details: responseDetails,
 		Data:    recipe,
@@ -461,7 +511,20 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.recipeDataManager.ArchiveRecipe(ctx, recipeID, sessionCtxData.Requester.UserID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeDataManager.ArchiveRecipe(ctx, recipeID, sessionCtxData.Requester.UserID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeArchivedCustomerEventType,
+			RecipeID:    recipeID,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving recipe")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -469,17 +532,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeArchivedCustomerEventType,
-		RecipeID:    recipeID,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.Recipe]{
 		Details: responseDetails,
 	}
@@ -553,6 +605,142 @@ func (s *service) DAGHandler(res http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// ScaledHandler returns a GET handler that returns a recipe scaled to a given number of portions.
+func (s *service) ScaledHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine desired portions.
+	portions, err := strconv.ParseFloat(req.URL.Query().Get(types.RecipeScalingPortionsQueryKey), 32)
+	if err != nil || portions <= 0 || math.IsNaN(portions) || math.IsInf(portions, 0) {
+		errRes := types.NewAPIErrorResponse("invalid portions provided", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+	logger = logger.WithValue("portions", portions)
+
+	// determine recipe ID.
+	recipeID := s.recipeIDFetcher(req)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
+
+	// fetch recipe from database.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	x, err := s.recipeDataManager.GetRecipe(ctx, recipeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving recipe")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	scaled, err := s.recipeScaler.ScaleRecipe(ctx, x, float32(portions))
+	if errors.Is(err, recipescaling.ErrRecipeHasNoPortionEstimate) {
+		errRes := types.NewAPIErrorResponse("recipe cannot be scaled", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "scaling recipe")
+		errRes := types.NewAPIErrorResponse("scaling recipe", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("recipe scaled")
+
+	responseValue := &types.APIResponse[*types.Recipe]{
+		Details: responseDetails,
+		Data:    scaled,
+	}
+
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// EquipmentReportHandler returns a GET handler that reports the equipment the active household is missing for a recipe.
+func (s *service) EquipmentReportHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine recipe ID.
+	recipeID := s.recipeIDFetcher(req)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
+
+	checkTimer := timing.NewMetric("equipment").WithDesc("check equipment").Start()
+	report, err := s.equipmentChecker.CheckRecipeForHousehold(ctx, sessionCtxData.ActiveHouseholdID, recipeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "checking recipe equipment")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	checkTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.RecipeEquipmentReport]{
+		Details: responseDetails,
+		Data:    report,
+	}
+
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
 // EstimatedPrepStepsHandler is a handler that returns expected prep steps for a given recipe.
 func (s *service) EstimatedPrepStepsHandler(res http.ResponseWriter, req *http.Request) {
 	ctx, span := s.tracer.StartSpan(req.Context())
@@ -695,8 +883,21 @@ func (s *service) ImageUploadHandler(res http.ResponseWriter, req *http.Request)
 
 		var createdMedia *types.RecipeMedia
 		createTimer := timing.NewMetric("database").WithDesc(fmt.Sprintf("create #%d", i)).Start()
-		createdMedia, err = s.recipeMediaDataManager.CreateRecipeMedia(ctx, input)
-		if err != nil {
+		if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+			if createdMedia, err = s.recipeMediaDataManager.CreateRecipeMedia(ctx, input); err != nil {
+				return err
+			}
+
+			dcm := &types.DataChangeMessage{
+				EventType:     types.RecipeMediaCreatedCustomerEventType,
+				RecipeID:      recipeID,
+				RecipeMediaID: input.ID,
+				HouseholdID:   sessionCtxData.ActiveHouseholdID,
+				UserID:        sessionCtxData.Requester.UserID,
+			}
+
+			return s.dataChangesPublisher.Publish(ctx, dcm)
+		}); err != nil {
 			observability.AcknowledgeError(err, logger, span, "saving recipe media record")
 			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -705,18 +906,6 @@ func (s *service) ImageUploadHandler(res http.ResponseWriter, req *http.Request)
 		createTimer.Stop()
 		created = append(created, createdMedia)
 
-		dcm := &types.DataChangeMessage{
-			EventType:     types.RecipeMediaCreatedCustomerEventType,
-			RecipeID:      recipeID,
-			RecipeMediaID: input.ID,
-			HouseholdID:   sessionCtxData.ActiveHouseholdID,
-			UserID:        sessionCtxData.Requester.UserID,
-		}
-
-		if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-			observability.AcknowledgeError(err, logger, span, "publishing data change message")
-		}
-
 		logger.Info("image info saved in database")
 	}
 
@@ -858,7 +1047,7 @@ func (s *service) CloneHandler(res http.ResponseWriter, req *http.Request) {
 	// clone recipe.
 	cloneInput := converters.ConvertRecipeToRecipeDatabaseCreationInput(x)
 	cloneInput.CreatedByUser = sessionCtxData.Requester.UserID
-	// TODO: cloneInput.ClonedFromRecipeID = &x.ID
+	cloneInput.InspiredByRecipeID = &x.ID
 	cloneInput.ID = identifiers.New()
 	for i := range cloneInput.Steps {
 		newRecipeStepID := identifiers.New()
@@ -911,8 +1100,21 @@ func (s *service) CloneHandler(res http.ResponseWriter, req *http.Request) {
 	}
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	created, err := s.recipeDataManager.CreateRecipe(ctx, cloneInput)
-	if err != nil {
+	var created *types.Recipe
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if created, err = s.recipeDataManager.CreateRecipe(ctx, cloneInput); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeClonedCustomerEventType,
+			Recipe:      created,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "cloning recipe")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -920,17 +1122,6 @@ func (s *service) CloneHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeClonedCustomerEventType,
-		Recipe:      created,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.Recipe]{
 		Details: responseDetails,
 		Data:    created,
@@ -939,3 +1130,71 @@ func (s *service) CloneHandler(res http.ResponseWriter, req *http.Request) {
 	// encode our response and peace.
 	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
 }
+
+// ImportHandler returns a POST handler that builds a draft recipe from a schema.org Recipe document and/or free-text ingredient lines.
+// Nothing is saved; the draft is meant to be finished by the user and submitted to CreateHandler.
+func (s *service) ImportHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// read parsed input struct from request body.
+	providedInput := new(types.RecipeImportRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, providedInput); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = providedInput.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	importTimer := timing.NewMetric("import").WithDesc("import recipe").Start()
+	draft, err := s.recipeImporter.ImportRecipe(ctx, providedInput)
+	if errors.Is(err, recipeimport.ErrInvalidDocument) || errors.Is(err, recipeimport.ErrNoRecipeFound) {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided document was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "importing recipe")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	importTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.RecipeImportDraft]{
+		Details: responseDetails,
+		Data:    draft,
+	}
+
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
@@ -11,7 +11,12 @@
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/equipmentcheck"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/recipeimport"
+	"github.com/dinnerdonebetter/backend/internal/features/recipescaling"
+	"github.com/dinnerdonebetter/backend/internal/features/substitutions"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -204,11 +209,11 @@ func TestRecipesService_CreateHandler(T *testing.T) {
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Recipe]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleRecipe)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -233,15 +238,138 @@ func TestRecipesService_ReadHandler(T *testing.T) {
 		).Return(helper.exampleRecipe, nil)
 		helper.service.recipeDataManager = recipeDataManager
 
+		exampleNutrition := &types.NutritionSummary{
+			Total:    *fakes.BuildFakeNutrientAmounts(),
+			Warnings: []string{},
+			Complete: true,
+		}
+
+		nutritionCalculator := &nutrition.MockCalculator{}
+		nutritionCalculator.On(
+			"CalculateRecipeNutrition",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+		).Return(exampleNutrition, nil)
+		helper.service.nutritionCalculator = nutritionCalculator
+
+		exampleSuggestions := []*types.RecipeIngredientSubstitutionSuggestion{
+			{
+				RecipeStepID:           helper.exampleRecipe.Steps[0].ID,
+				RecipeStepIngredientID: helper.exampleRecipe.Steps[0].Ingredients[0].ID,
+				IngredientID:           helper.exampleRecipe.Steps[0].Ingredients[0].Ingredient.ID,
+				IngredientName:         helper.exampleRecipe.Steps[0].Ingredients[0].Ingredient.Name,
+				Substitutions:          []*types.ValidIngredientSubstitution{fakes.BuildFakeValidIngredientSubstitution()},
+			},
+		}
+
+		substitutionSuggester := &substitutions.MockSuggester{}
+		substitutionSuggester.On(
+			"SuggestSubstitutionsForUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			helper.exampleRecipe,
+		).Return(exampleSuggestions, nil)
+		helper.service.substitutionSuggester = substitutionSuggester
+
 		helper.service.ReadHandler(helper.res, helper.req)
 
 		assert.Equal(t, http.StatusOK, helper.res.Code)
 		var actual *types.APIResponse[*types.Recipe]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		assert.Equal(t, actual.Data, helper.exampleRecipe)
+		assert.Equal(t, exampleNutrition, actual.Data.Nutrition)
+		assert.Equal(t, exampleSuggestions, actual.Data.IngredientSubstitutions)
 		assert.NoError(t, actual.Error.AsError())
 
-		mock.AssertExpectationsForObjects(t, recipeDataManager)
+		mock.AssertExpectationsForObjects(t, recipeDataManager, nutritionCalculator, substitutionSuggester)
+	})
+
+	T.Run("with error calculating nutrition", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.recipeIDFetcher = func(_ *http.Request) string {
+			return helper.exampleRecipe.ID
+		}
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		nutritionCalculator := &nutrition.MockCalculator{}
+		nutritionCalculator.On(
+			"CalculateRecipeNutrition",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+		).Return((*types.NutritionSummary)(nil), errors.New("blah"))
+		helper.service.nutritionCalculator = nutritionCalculator
+
+		substitutionSuggester := &substitutions.MockSuggester{}
+		substitutionSuggester.On(
+			"SuggestSubstitutionsForUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			helper.exampleRecipe,
+		).Return([]*types.RecipeIngredientSubstitutionSuggestion{}, nil)
+		helper.service.substitutionSuggester = substitutionSuggester
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Nil(t, actual.Data.Nutrition)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, nutritionCalculator, substitutionSuggester)
+	})
+
+	T.Run("with error suggesting substitutions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.recipeIDFetcher = func(_ *http.Request) string {
+			return helper.exampleRecipe.ID
+		}
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		nutritionCalculator := &nutrition.MockCalculator{}
+		nutritionCalculator.On(
+			"CalculateRecipeNutrition",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+		).Return((*types.NutritionSummary)(nil), nil)
+		helper.service.nutritionCalculator = nutritionCalculator
+
+		substitutionSuggester := &substitutions.MockSuggester{}
+		substitutionSuggester.On(
+			"SuggestSubstitutionsForUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			helper.exampleRecipe,
+		).Return([]*types.RecipeIngredientSubstitutionSuggestion(nil), errors.New("blah"))
+		helper.service.substitutionSuggester = substitutionSuggester
+
+		helper.service.ReadHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data.IngredientSubstitutions)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, nutritionCalculator, substitutionSuggester)
 	})
 
 	T.Run("with error retrieving session context data", func(t *testing.T) {
@@ -343,6 +471,63 @@ func TestRecipesService_ListHandler(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, recipeDataManager)
 	})
 
+	T.Run("filtered by household equipment", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.CanCookWithMyEquipmentQueryKey: []string{"true"}}.Encode()
+
+		cookable := fakes.BuildFakeRecipeList()
+		cookable.Data = cookable.Data[:1]
+		cookable.FilteredCount = 1
+		cookable.TotalCount = 1
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetCookableRecipes",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			mock.IsType(&types.QueryFilter{}),
+		).Return(cookable, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.ListHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, cookable.Data, actual.Data)
+		assert.Equal(t, cookable.Pagination, *actual.Pagination)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with error filtering by household equipment", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.CanCookWithMyEquipmentQueryKey: []string{"true"}}.Encode()
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetCookableRecipes",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			mock.IsType(&types.QueryFilter{}),
+		).Return((*types.QueryFilteredResult[types.Recipe])(nil), errors.New("blah"))
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.ListHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[[]*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
 	T.Run("with error retrieving session context data", func(t *testing.T) {
 		t.Parallel()
 
@@ -486,6 +671,42 @@ func TestRecipesService_SearchHandler(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, recipeDataManager, searchIndex)
 	})
 
+	T.Run("filtered by household equipment", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{
+			types.SearchQueryKey:                 []string{exampleQuery},
+			types.CanCookWithMyEquipmentQueryKey: []string{"true"},
+		}.Encode()
+
+		cookable := &types.QueryFilteredResult[types.Recipe]{
+			Data:       exampleRecipeList.Data[:1],
+			Pagination: types.Pagination{Page: 1, Limit: 20, FilteredCount: 1, TotalCount: 1},
+		}
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"SearchForCookableRecipes",
+			testutils.ContextMatcher,
+			exampleQuery,
+			helper.exampleHousehold.ID,
+			mock.IsType(&types.QueryFilter{}),
+		).Return(cookable, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.SearchHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, cookable.Data, actual.Data)
+		assert.Equal(t, cookable.Pagination, *actual.Pagination)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
 	T.Run("with error fetching session context data", func(t *testing.T) {
 		t.Parallel()
 
@@ -805,11 +1026,11 @@ func TestRecipesService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Recipe]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleRecipe)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -980,10 +1201,10 @@ func TestRecipesService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Recipe]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -1109,6 +1330,336 @@ func TestRecipesService_DAGHandler(T *testing.T) {
 	})
 }
 
+func TestRecipesService_ScaledHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"4"}}.Encode()
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		scaledRecipe := fakes.BuildFakeRecipe()
+		recipeScaler := &recipescaling.MockRecipeScaler{}
+		recipeScaler.On(
+			"ScaleRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			float32(4),
+		).Return(scaledRecipe, nil)
+		helper.service.recipeScaler = recipeScaler
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, scaledRecipe)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, recipeScaler)
+	})
+
+	T.Run("with error fetching session context", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"4"}}.Encode()
+
+		helper.service.sessionContextDataFetcher = func(request *http.Request) (*types.SessionContextData, error) {
+			return nil, errors.New("blah")
+		}
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with missing portions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid portions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"-1"}}.Encode()
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with NaN portions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"NaN"}}.Encode()
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with Inf portions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"Inf"}}.Encode()
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such recipe in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"4"}}.Encode()
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return((*types.Recipe)(nil), sql.ErrNoRows)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with error fetching recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"4"}}.Encode()
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return((*types.Recipe)(nil), errors.New("blah"))
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with recipe that cannot be scaled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"4"}}.Encode()
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		recipeScaler := &recipescaling.MockRecipeScaler{}
+		recipeScaler.On(
+			"ScaleRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			float32(4),
+		).Return((*types.Recipe)(nil), recipescaling.ErrRecipeHasNoPortionEstimate)
+		helper.service.recipeScaler = recipeScaler
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, recipeScaler)
+	})
+
+	T.Run("with error scaling recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req.URL.RawQuery = url.Values{types.RecipeScalingPortionsQueryKey: []string{"4"}}.Encode()
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		recipeScaler := &recipescaling.MockRecipeScaler{}
+		recipeScaler.On(
+			"ScaleRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe,
+			float32(4),
+		).Return((*types.Recipe)(nil), errors.New("blah"))
+		helper.service.recipeScaler = recipeScaler
+
+		helper.service.ScaledHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.Recipe]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager, recipeScaler)
+	})
+}
+
+func TestRecipesService_EquipmentReportHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleReport := fakes.BuildFakeRecipeEquipmentReport()
+		equipmentChecker := &equipmentcheck.MockEquipmentChecker{}
+		equipmentChecker.On(
+			"CheckRecipeForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleRecipe.ID,
+		).Return(exampleReport, nil)
+		helper.service.equipmentChecker = equipmentChecker
+
+		helper.service.EquipmentReportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeEquipmentReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleReport, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, equipmentChecker)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.EquipmentReportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeEquipmentReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		equipmentChecker := &equipmentcheck.MockEquipmentChecker{}
+		equipmentChecker.On(
+			"CheckRecipeForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleRecipe.ID,
+		).Return((*types.RecipeEquipmentReport)(nil), sql.ErrNoRows)
+		helper.service.equipmentChecker = equipmentChecker
+
+		helper.service.EquipmentReportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeEquipmentReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, equipmentChecker)
+	})
+
+	T.Run("with error checking equipment", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		equipmentChecker := &equipmentcheck.MockEquipmentChecker{}
+		equipmentChecker.On(
+			"CheckRecipeForHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+			helper.exampleRecipe.ID,
+		).Return((*types.RecipeEquipmentReport)(nil), errors.New("blah"))
+		helper.service.equipmentChecker = equipmentChecker
+
+		helper.service.EquipmentReportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeEquipmentReport]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, equipmentChecker)
+	})
+}
+
 func TestRecipesService_EstimatedPrepStepsHandler(T *testing.T) {
 	T.Parallel()
 
@@ -1395,12 +1946,158 @@ func TestRecipesService_CloneHandler(T *testing.T) {
 
 		helper.service.CloneHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Recipe]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleRecipe)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, recipeDataManager, dataChangesPublisher)
 	})
 }
+
+func TestRecipesService_ImportHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		exampleDraft := &types.RecipeImportDraft{
+			Recipe:           fakes.BuildFakeRecipeCreationRequestInput(),
+			IngredientLines:  []*types.ParsedIngredientLine{},
+			UnresolvedTokens: []*types.UnresolvedRecipeImportToken{},
+		}
+
+		recipeImporter := &recipeimport.MockImporter{}
+		recipeImporter.On(
+			"ImportRecipe",
+			testutils.ContextMatcher,
+			exampleInput,
+		).Return(exampleDraft, nil)
+		helper.service.recipeImporter = recipeImporter
+
+		helper.service.ImportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeImportDraft]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleDraft, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, recipeImporter)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, &types.RecipeImportRequestInput{})
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		helper.service.ImportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeImportDraft]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ImportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeImportDraft]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with document that has no recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := &types.RecipeImportRequestInput{Document: `{"@type": "WebSite"}`}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeImporter := &recipeimport.MockImporter{}
+		recipeImporter.On(
+			"ImportRecipe",
+			testutils.ContextMatcher,
+			exampleInput,
+		).Return((*types.RecipeImportDraft)(nil), recipeimport.ErrNoRecipeFound)
+		helper.service.recipeImporter = recipeImporter
+
+		helper.service.ImportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeImportDraft]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeImporter)
+	})
+
+	T.Run("with error importing recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeImporter := &recipeimport.MockImporter{}
+		recipeImporter.On(
+			"ImportRecipe",
+			testutils.ContextMatcher,
+			exampleInput,
+		).Return((*types.RecipeImportDraft)(nil), errors.New("blah"))
+		helper.service.recipeImporter = recipeImporter
+
+		helper.service.ImportHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeImportDraft]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeImporter)
+	})
+}
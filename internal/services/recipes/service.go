@@ -7,8 +7,14 @@
 	"net/http"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/equipmentcheck"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/recipeimport"
+	"github.com/dinnerdonebetter/backend/internal/features/recipescaling"
+	"github.com/dinnerdonebetter/backend/internal/features/substitutions"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/objectstorage"
 	"github.com/dinnerdonebetter/backend/internal/observability"
@@ -37,15 +43,21 @@
 		recipeDataManager         types.RecipeDataManager
 		recipeMediaDataManager    types.RecipeMediaDataManager
 		recipeAnalyzer            recipeanalysis.RecipeAnalyzer
+		recipeScaler              recipescaling.RecipeScaler
 		imageUploadProcessor      images.MediaUploadProcessor
 		encoderDecoder            encoding.ServerEncoderDecoder
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 		searchIndex               search.IndexSearcher[types.RecipeSearchSubset]
 		uploadManager             uploads.UploadManager
 		timeFunc                  func() time.Time
 		sessionContextDataFetcher func(*http.Request) (*types.SessionContextData, error)
 		recipeIDFetcher           func(*http.Request) string
 		cfg                       *Config
+		equipmentChecker          equipmentcheck.EquipmentChecker
+		nutritionCalculator       nutrition.Calculator
+		substitutionSuggester     substitutions.Suggester
+		recipeImporter            recipeimport.Importer
 	}
 )
 
@@ -64,11 +76,17 @@ func ProvideService(
 	recipeDataManager types.RecipeDataManager,
 	recipeMediaDataManager types.RecipeMediaDataManager,
 	recipeGrapher recipeanalysis.RecipeAnalyzer,
+	recipeScaler recipescaling.RecipeScaler,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
 	imageUploadProcessor images.MediaUploadProcessor,
 	tracerProvider tracing.TracerProvider,
+	equipmentChecker equipmentcheck.EquipmentChecker,
+	nutritionCalculator nutrition.Calculator,
+	substitutionSuggester substitutions.Suggester,
+	recipeImporter recipeimport.Importer,
 ) (types.RecipeDataService, error) {
 	if cfg == nil {
 		return nil, errInvalidConfig
@@ -97,13 +115,19 @@ func ProvideService(
 		cfg:                       cfg,
 		recipeMediaDataManager:    recipeMediaDataManager,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		encoderDecoder:            encoder,
 		timeFunc:                  defaultTimeFunc,
 		recipeAnalyzer:            recipeGrapher,
+		recipeScaler:              recipeScaler,
 		uploadManager:             uploader,
 		imageUploadProcessor:      imageUploadProcessor,
 		tracer:                    tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 		searchIndex:               searchIndex,
+		equipmentChecker:          equipmentChecker,
+		nutritionCalculator:       nutritionCalculator,
+		substitutionSuggester:     substitutionSuggester,
+		recipeImporter:            recipeImporter,
 	}
 
 	return svc, nil
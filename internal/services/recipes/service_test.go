@@ -6,9 +6,15 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	"github.com/dinnerdonebetter/backend/internal/features/equipmentcheck"
+	"github.com/dinnerdonebetter/backend/internal/features/nutrition"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/recipeimport"
+	"github.com/dinnerdonebetter/backend/internal/features/recipescaling"
+	"github.com/dinnerdonebetter/backend/internal/features/substitutions"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/objectstorage"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -25,11 +31,13 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner: database.NewNoopTransactionRunner(),
 		logger:            logging.NewNoopLogger(),
 		recipeDataManager: &mocktypes.RecipeDataManagerMock{},
 		recipeIDFetcher:   func(req *http.Request) string { return "" },
 		encoderDecoder:    encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
 		tracer:            tracing.NewTracerForTest("test"),
+		equipmentChecker:  &equipmentcheck.MockEquipmentChecker{},
 		cfg: &Config{
 			UseSearchService: false,
 		},
@@ -73,11 +81,17 @@ func TestProvideRecipesService(T *testing.T) {
 			&mocktypes.RecipeDataManagerMock{},
 			&mocktypes.RecipeMediaDataManagerMock{},
 			&recipeanalysis.MockRecipeAnalyzer{},
+			&recipescaling.MockRecipeScaler{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
 			&images.MockImageUploadProcessor{},
 			tracing.NewNoopTracerProvider(),
+			&equipmentcheck.MockEquipmentChecker{},
+			&nutrition.MockCalculator{},
+			&substitutions.MockSuggester{},
+			&recipeimport.MockImporter{},
 		)
 
 		assert.NotNil(t, s)
@@ -110,11 +124,17 @@ func TestProvideRecipesService(T *testing.T) {
 			&mocktypes.RecipeDataManagerMock{},
 			&mocktypes.RecipeMediaDataManagerMock{},
 			&recipeanalysis.MockRecipeAnalyzer{},
+			&recipescaling.MockRecipeScaler{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
 			&images.MockImageUploadProcessor{},
 			tracing.NewNoopTracerProvider(),
+			&equipmentcheck.MockEquipmentChecker{},
+			&nutrition.MockCalculator{},
+			&substitutions.MockSuggester{},
+			&recipeimport.MockImporter{},
 		)
 
 		assert.Nil(t, s)
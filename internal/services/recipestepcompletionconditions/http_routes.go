@@ -1,6 +1,7 @@
 package recipestepingredients
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -81,8 +82,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.RecipeStepCompletionConditionIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	recipeStepCompletionCondition, err := s.recipeStepCompletionConditionDataManager.CreateRecipeStepCompletionCondition(ctx, input)
-	if err != nil {
+	var recipeStepCompletionCondition *types.RecipeStepCompletionCondition
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if recipeStepCompletionCondition, err = s.recipeStepCompletionConditionDataManager.CreateRecipeStepCompletionCondition(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                     types.RecipeStepCompletionConditionCreatedCustomerEventType,
+			RecipeStepCompletionCondition: recipeStepCompletionCondition,
+			HouseholdID:                   sessionCtxData.ActiveHouseholdID,
+			UserID:                        sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating recipe step completion conditions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -90,17 +104,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                     types.RecipeStepCompletionConditionCreatedCustomerEventType,
-		RecipeStepCompletionCondition: recipeStepCompletionCondition,
-		HouseholdID:                   sessionCtxData.ActiveHouseholdID,
-		UserID:                        sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepCompletionCondition]{
 		Details: responseDetails,
 		Data:    recipeStepCompletionCondition,
@@ -313,7 +316,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	recipeStepCompletionCondition.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.recipeStepCompletionConditionDataManager.UpdateRecipeStepCompletionCondition(ctx, recipeStepCompletionCondition); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepCompletionConditionDataManager.UpdateRecipeStepCompletionCondition(ctx, recipeStepCompletionCondition); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                     types.RecipeStepCompletionConditionUpdatedCustomerEventType,
+			RecipeStepCompletionCondition: recipeStepCompletionCondition,
+			HouseholdID:                   sessionCtxData.ActiveHouseholdID,
+			UserID:                        sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating recipe step completion conditions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -321,17 +337,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                     types.RecipeStepCompletionConditionUpdatedCustomerEventType,
-		RecipeStepCompletionCondition: recipeStepCompletionCondition,
-		HouseholdID:                   sessionCtxData.ActiveHouseholdID,
-		UserID:                        sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepCompletionCondition]{
 		Details: responseDetails,
 		Data:    recipeStepCompletionCondition,
@@ -399,7 +404,19 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.recipeStepCompletionConditionDataManager.ArchiveRecipeStepCompletionCondition(ctx, recipeStepID, recipeStepCompletionConditionID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepCompletionConditionDataManager.ArchiveRecipeStepCompletionCondition(ctx, recipeStepID, recipeStepCompletionConditionID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeStepCompletionConditionArchivedCustomerEventType,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving recipe step completion conditions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -407,16 +424,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeStepCompletionConditionArchivedCustomerEventType,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepCompletionCondition]{
 		Details: responseDetails,
 	}
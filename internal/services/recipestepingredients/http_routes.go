@@ -1,6 +1,7 @@
 package recipestepingredients
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -81,8 +82,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	recipeStepIngredient, err := s.recipeStepIngredientDataManager.CreateRecipeStepIngredient(ctx, input)
-	if err != nil {
+	var recipeStepIngredient *types.RecipeStepIngredient
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if recipeStepIngredient, err = s.recipeStepIngredientDataManager.CreateRecipeStepIngredient(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.RecipeStepIngredientCreatedCustomerEventType,
+			RecipeStepIngredient: recipeStepIngredient,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating recipe step ingredients")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -90,17 +104,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:            types.RecipeStepIngredientCreatedCustomerEventType,
-		RecipeStepIngredient: recipeStepIngredient,
-		HouseholdID:          sessionCtxData.ActiveHouseholdID,
-		UserID:               sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepIngredient]{
 		Details: responseDetails,
 		Data:    recipeStepIngredient,
@@ -319,7 +322,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	recipeStepIngredient.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.recipeStepIngredientDataManager.UpdateRecipeStepIngredient(ctx, recipeStepIngredient); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepIngredientDataManager.UpdateRecipeStepIngredient(ctx, recipeStepIngredient); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.RecipeStepIngredientUpdatedCustomerEventType,
+			RecipeStepIngredient: recipeStepIngredient,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating recipe step ingredients")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -327,17 +343,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:            types.RecipeStepIngredientUpdatedCustomerEventType,
-		RecipeStepIngredient: recipeStepIngredient,
-		HouseholdID:          sessionCtxData.ActiveHouseholdID,
-		UserID:               sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepIngredient]{
 		Details: responseDetails,
 		Data:    recipeStepIngredient,
@@ -405,7 +410,19 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.recipeStepIngredientDataManager.ArchiveRecipeStepIngredient(ctx, recipeStepID, recipeStepIngredientID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepIngredientDataManager.ArchiveRecipeStepIngredient(ctx, recipeStepID, recipeStepIngredientID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeStepIngredientArchivedCustomerEventType,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving recipe step ingredients")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -413,20 +430,167 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeStepIngredientArchivedCustomerEventType,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
+	responseValue := &types.APIResponse[*types.RecipeStepIngredient]{
+		Details: responseDetails,
+	}
+
+	// let everybody go home.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// SubstituteHandler returns a handler that swaps a cloned recipe's step ingredient for one of its valid substitutes.
+func (s *service) SubstituteHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	input := new(types.RecipeStepIngredientSubstitutionRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = input.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, input.ValidIngredientSubstitutionID)
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, input.ValidIngredientSubstitutionID)
+
+	// determine recipe ID.
+	recipeID := s.recipeIDFetcher(req)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
+
+	// determine recipe step ID.
+	recipeStepID := s.recipeStepIDFetcher(req)
+	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
+	logger = logger.WithValue(keys.RecipeStepIDKey, recipeStepID)
+
+	// determine recipe step ingredient ID.
+	recipeStepIngredientID := s.recipeStepIngredientIDFetcher(req)
+	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
+	logger = logger.WithValue(keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	recipe, err := s.recipeDataManager.GetRecipe(ctx, recipeID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving recipe")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	// substitutions rewrite the recipe, so they're only allowed on a requester's own clone, never on the original.
+	if recipe.CreatedByUser != sessionCtxData.Requester.UserID {
+		errRes := types.NewAPIErrorResponse("recipe belongs to another user", types.ErrUserIsNotAuthorized, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusForbidden)
+		return
+	}
+
+	if recipe.InspiredByRecipeID == nil {
+		errRes := types.NewAPIErrorResponse("ingredients can only be substituted in cloned recipes", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	recipeStep := recipe.FindStepByID(recipeStepID)
+	if recipeStep == nil {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	}
+
+	recipeStepIngredient, err := s.recipeStepIngredientDataManager.GetRecipeStepIngredient(ctx, recipeID, recipeStepID, recipeStepIngredientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving recipe step ingredient for substitution")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	substitution, err := s.substitutionDataManager.GetValidIngredientSubstitution(ctx, input.ValidIngredientSubstitutionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving valid ingredient substitution")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	if recipeStepIngredient.Ingredient == nil || recipeStepIngredient.Ingredient.ID != substitution.Original.ID || !substitution.AppliesToPreparation(recipeStep.Preparation.ID) {
+		errRes := types.NewAPIErrorResponse("substitution does not apply to this ingredient", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
 	}
 
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
+	recipeStepIngredient.Substitute(substitution)
+
+	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepIngredientDataManager.UpdateRecipeStepIngredient(ctx, recipeStepIngredient); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.RecipeStepIngredientUpdatedCustomerEventType,
+			RecipeStepIngredient: recipeStepIngredient,
+			HouseholdID:          sessionCtxData.ActiveHouseholdID,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "substituting recipe step ingredient")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
 	}
+	updateTimer.Stop()
 
 	responseValue := &types.APIResponse[*types.RecipeStepIngredient]{
 		Details: responseDetails,
+		Data:    recipeStepIngredient,
 	}
 
-	// let everybody go home.
+	// encode our response and peace.
 	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
 }
@@ -12,6 +12,7 @@
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
@@ -199,11 +200,11 @@ func TestRecipeStepIngredientsService_CreateHandler(T *testing.T) {
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.RecipeStepIngredient]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleRecipeStepIngredient)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -666,11 +667,11 @@ func TestRecipeStepIngredientsService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.RecipeStepIngredient]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleRecipeStepIngredient)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -851,11 +852,382 @@ func TestRecipeStepIngredientsService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
+	})
+}
+
+func prepareSubstitutionScenario(helper *recipeStepIngredientsServiceHTTPRoutesTestHelper) *types.ValidIngredientSubstitution {
+	helper.exampleRecipe.CreatedByUser = helper.exampleUser.ID
+	helper.exampleRecipe.InspiredByRecipeID = pointer.To(fakes.BuildFakeID())
+	helper.exampleRecipe.Steps = []*types.RecipeStep{helper.exampleRecipeStep}
+
+	substitution := fakes.BuildFakeValidIngredientSubstitution()
+	substitution.Original = *helper.exampleRecipeStepIngredient.Ingredient
+	substitution.Preparation = nil
+
+	return substitution
+}
+
+func TestRecipeStepIngredientsService_SubstituteHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.RecipeDataManagerMock.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+
+		dbManager.RecipeStepIngredientDataManagerMock.On(
+			"GetRecipeStepIngredient",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+			helper.exampleRecipeStep.ID,
+			helper.exampleRecipeStepIngredient.ID,
+		).Return(helper.exampleRecipeStepIngredient, nil)
+
+		dbManager.ValidIngredientSubstitutionDataManagerMock.On(
+			"GetValidIngredientSubstitution",
+			testutils.ContextMatcher,
+			exampleSubstitution.ID,
+		).Return(exampleSubstitution, nil)
+
+		dbManager.RecipeStepIngredientDataManagerMock.On(
+			"UpdateRecipeStepIngredient",
+			testutils.ContextMatcher,
+			helper.exampleRecipeStepIngredient,
+		).Return(nil)
+		helper.service.recipeDataManager = dbManager
+		helper.service.recipeStepIngredientDataManager = dbManager
+		helper.service.substitutionDataManager = dbManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
 		assert.Equal(t, http.StatusOK, helper.res.Code)
 		var actual *types.APIResponse[*types.RecipeStepIngredient]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
 		assert.NoError(t, actual.Error.AsError())
+		require.NotNil(t, actual.Data.Ingredient)
+		assert.Equal(t, exampleSubstitution.Substitute.ID, actual.Data.Ingredient.ID)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, &types.RecipeStepIngredientSubstitutionRequestInput{})
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such recipe", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return((*types.Recipe)(nil), sql.ErrNoRows)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with recipe belonging to another user", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+		helper.exampleRecipe.CreatedByUser = fakes.BuildFakeID()
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusForbidden, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with recipe that was not cloned", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+		helper.exampleRecipe.InspiredByRecipeID = nil
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		recipeDataManager := &mocktypes.RecipeDataManagerMock{}
+		recipeDataManager.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+		helper.service.recipeDataManager = recipeDataManager
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, recipeDataManager)
+	})
+
+	T.Run("with no such substitution", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.RecipeDataManagerMock.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+
+		dbManager.RecipeStepIngredientDataManagerMock.On(
+			"GetRecipeStepIngredient",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+			helper.exampleRecipeStep.ID,
+			helper.exampleRecipeStepIngredient.ID,
+		).Return(helper.exampleRecipeStepIngredient, nil)
+
+		dbManager.ValidIngredientSubstitutionDataManagerMock.On(
+			"GetValidIngredientSubstitution",
+			testutils.ContextMatcher,
+			exampleSubstitution.ID,
+		).Return((*types.ValidIngredientSubstitution)(nil), sql.ErrNoRows)
+		helper.service.recipeDataManager = dbManager
+		helper.service.recipeStepIngredientDataManager = dbManager
+		helper.service.substitutionDataManager = dbManager
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with substitution for another ingredient", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+		exampleSubstitution.Original = *fakes.BuildFakeValidIngredient()
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.RecipeDataManagerMock.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+
+		dbManager.RecipeStepIngredientDataManagerMock.On(
+			"GetRecipeStepIngredient",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+			helper.exampleRecipeStep.ID,
+			helper.exampleRecipeStepIngredient.ID,
+		).Return(helper.exampleRecipeStepIngredient, nil)
+
+		dbManager.ValidIngredientSubstitutionDataManagerMock.On(
+			"GetValidIngredientSubstitution",
+			testutils.ContextMatcher,
+			exampleSubstitution.ID,
+		).Return(exampleSubstitution, nil)
+		helper.service.recipeDataManager = dbManager
+		helper.service.recipeStepIngredientDataManager = dbManager
+		helper.service.substitutionDataManager = dbManager
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with error updating recipe step ingredient", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleSubstitution := prepareSubstitutionScenario(helper)
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: exampleSubstitution.ID}
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.RecipeDataManagerMock.On(
+			"GetRecipe",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+		).Return(helper.exampleRecipe, nil)
+
+		dbManager.RecipeStepIngredientDataManagerMock.On(
+			"GetRecipeStepIngredient",
+			testutils.ContextMatcher,
+			helper.exampleRecipe.ID,
+			helper.exampleRecipeStep.ID,
+			helper.exampleRecipeStepIngredient.ID,
+		).Return(helper.exampleRecipeStepIngredient, nil)
+
+		dbManager.ValidIngredientSubstitutionDataManagerMock.On(
+			"GetValidIngredientSubstitution",
+			testutils.ContextMatcher,
+			exampleSubstitution.ID,
+		).Return(exampleSubstitution, nil)
+
+		dbManager.RecipeStepIngredientDataManagerMock.On(
+			"UpdateRecipeStepIngredient",
+			testutils.ContextMatcher,
+			helper.exampleRecipeStepIngredient,
+		).Return(errors.New("blah"))
+		helper.service.recipeDataManager = dbManager
+		helper.service.recipeStepIngredientDataManager = dbManager
+		helper.service.substitutionDataManager = dbManager
+
+		helper.service.SubstituteHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.RecipeStepIngredient]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
 }
@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -26,11 +27,14 @@
 	service struct {
 		logger                          logging.Logger
 		recipeStepIngredientDataManager types.RecipeStepIngredientDataManager
+		recipeDataManager               types.RecipeDataManager
+		substitutionDataManager         types.ValidIngredientSubstitutionDataManager
 		recipeIDFetcher                 func(*http.Request) string
 		recipeStepIDFetcher             func(*http.Request) string
 		recipeStepIngredientIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher       func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher            messagequeue.Publisher
+		transactionRunner               database.TransactionRunner
 		encoderDecoder                  encoding.ServerEncoderDecoder
 		tracer                          tracing.Tracer
 	}
@@ -41,6 +45,9 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	recipeStepIngredientDataManager types.RecipeStepIngredientDataManager,
+	recipeDataManager types.RecipeDataManager,
+	substitutionDataManager types.ValidIngredientSubstitutionDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -58,7 +65,10 @@ func ProvideService(
 		recipeStepIngredientIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(RecipeStepIngredientIDURIParamKey),
 		sessionContextDataFetcher:       authservice.FetchContextFromRequest,
 		recipeStepIngredientDataManager: recipeStepIngredientDataManager,
+		recipeDataManager:               recipeDataManager,
+		substitutionDataManager:         substitutionDataManager,
 		dataChangesPublisher:            dataChangesPublisher,
+		transactionRunner:               transactionRunner,
 		encoderDecoder:                  encoder,
 		tracer:                          tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,8 +22,11 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:               database.NewNoopTransactionRunner(),
 		logger:                          logging.NewNoopLogger(),
 		recipeStepIngredientDataManager: &mocktypes.RecipeStepIngredientDataManagerMock{},
+		recipeDataManager:               &mocktypes.RecipeDataManagerMock{},
+		substitutionDataManager:         &mocktypes.ValidIngredientSubstitutionDataManagerMock{},
 		recipeStepIngredientIDFetcher:   func(req *http.Request) string { return "" },
 		encoderDecoder:                  encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
 		tracer:                          tracing.NewTracerForTest("test"),
@@ -60,6 +64,9 @@ func TestProvideRecipeStepIngredientsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.RecipeStepIngredientDataManagerMock{},
+			&mocktypes.RecipeDataManagerMock{},
+			&mocktypes.ValidIngredientSubstitutionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -86,6 +93,9 @@ func TestProvideRecipeStepIngredientsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.RecipeStepIngredientDataManagerMock{},
+			&mocktypes.RecipeDataManagerMock{},
+			&mocktypes.ValidIngredientSubstitutionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -31,6 +32,7 @@
 		recipeStepProductIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher    func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher         messagequeue.Publisher
+		transactionRunner            database.TransactionRunner
 		encoderDecoder               encoding.ServerEncoderDecoder
 		tracer                       tracing.Tracer
 	}
@@ -41,6 +43,7 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	recipeStepProductDataManager types.RecipeStepProductDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -59,6 +62,7 @@ func ProvideService(
 		sessionContextDataFetcher:    authservice.FetchContextFromRequest,
 		recipeStepProductDataManager: recipeStepProductDataManager,
 		dataChangesPublisher:         dataChangesPublisher,
+		transactionRunner:            transactionRunner,
 		encoderDecoder:               encoder,
 		tracer:                       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
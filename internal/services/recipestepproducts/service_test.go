@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,6 +22,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:            database.NewNoopTransactionRunner(),
 		logger:                       logging.NewNoopLogger(),
 		recipeStepProductDataManager: &mocktypes.RecipeStepProductDataManagerMock{},
 		recipeStepProductIDFetcher:   func(req *http.Request) string { return "" },
@@ -60,6 +62,7 @@ func TestProvideRecipeStepProductsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.RecipeStepProductDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -86,6 +89,7 @@ func TestProvideRecipeStepProductsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.RecipeStepProductDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -6,6 +6,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/objectstorage"
@@ -30,6 +31,7 @@
 	service struct {
 		logger                    logging.Logger
 		dataChangesPublisher      messagequeue.Publisher
+		transactionRunner         database.TransactionRunner
 		recipeStepDataManager     types.RecipeStepDataManager
 		recipeMediaDataManager    types.RecipeMediaDataManager
 		uploadManager             uploads.UploadManager
@@ -50,6 +52,7 @@ func ProvideService(
 	cfg *Config,
 	recipeStepDataManager types.RecipeStepDataManager,
 	recipeMediaDataManager types.RecipeMediaDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -78,6 +81,7 @@ func ProvideService(
 		recipeStepDataManager:     recipeStepDataManager,
 		recipeMediaDataManager:    recipeMediaDataManager,
 		dataChangesPublisher:      dataChangesPublisher,
+		transactionRunner:         transactionRunner,
 		encoderDecoder:            encoder,
 		cfg:                       *cfg,
 		imageUploadProcessor:      imageUploadProcessor,
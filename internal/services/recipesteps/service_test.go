@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -24,6 +25,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:     database.NewNoopTransactionRunner(),
 		logger:                logging.NewNoopLogger(),
 		recipeStepDataManager: &mocktypes.RecipeStepDataManagerMock{},
 		recipeStepIDFetcher:   func(req *http.Request) string { return "" },
@@ -73,6 +75,7 @@ func TestProvideRecipeStepsService(T *testing.T) {
 			cfg,
 			&mocktypes.RecipeStepDataManagerMock{},
 			&mocktypes.RecipeMediaDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -109,6 +112,7 @@ func TestProvideRecipeStepsService(T *testing.T) {
 			cfg,
 			&mocktypes.RecipeStepDataManagerMock{},
 			&mocktypes.RecipeMediaDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -1,6 +1,7 @@
 package recipestepvessels
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -76,8 +77,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.RecipeStepVesselIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	recipeStepVessel, err := s.recipeStepVesselDataManager.CreateRecipeStepVessel(ctx, input)
-	if err != nil {
+	var recipeStepVessel *types.RecipeStepVessel
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if recipeStepVessel, err = s.recipeStepVesselDataManager.CreateRecipeStepVessel(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:        types.RecipeStepVesselCreatedCustomerEventType,
+			RecipeStepVessel: recipeStepVessel,
+			HouseholdID:      sessionCtxData.ActiveHouseholdID,
+			UserID:           sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating recipe step ingredient")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -85,17 +99,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:        types.RecipeStepVesselCreatedCustomerEventType,
-		RecipeStepVessel: recipeStepVessel,
-		HouseholdID:      sessionCtxData.ActiveHouseholdID,
-		UserID:           sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepVessel]{
 		Details: responseDetails,
 		Data:    recipeStepVessel,
@@ -314,7 +317,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	recipeStepVessel.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.recipeStepVesselDataManager.UpdateRecipeStepVessel(ctx, recipeStepVessel); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepVesselDataManager.UpdateRecipeStepVessel(ctx, recipeStepVessel); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:        types.RecipeStepVesselUpdatedCustomerEventType,
+			RecipeStepVessel: recipeStepVessel,
+			HouseholdID:      sessionCtxData.ActiveHouseholdID,
+			UserID:           sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating recipe step ingredient")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -322,17 +338,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:        types.RecipeStepVesselUpdatedCustomerEventType,
-		RecipeStepVessel: recipeStepVessel,
-		HouseholdID:      sessionCtxData.ActiveHouseholdID,
-		UserID:           sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepVessel]{
 		Details: responseDetails,
 		Data:    recipeStepVessel,
@@ -400,7 +405,19 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.recipeStepVesselDataManager.ArchiveRecipeStepVessel(ctx, recipeStepID, recipeStepVesselID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.recipeStepVesselDataManager.ArchiveRecipeStepVessel(ctx, recipeStepID, recipeStepVesselID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.RecipeStepVesselArchivedCustomerEventType,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving recipe step ingredient")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -408,16 +425,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.RecipeStepVesselArchivedCustomerEventType,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.RecipeStepVessel]{
 		Details: responseDetails,
 	}
@@ -1,6 +1,7 @@
 package servicesettingconfigurations
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -74,8 +75,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.ServiceSettingConfigurationIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	serviceSettingConfiguration, err := s.serviceSettingConfigurationDataManager.CreateServiceSettingConfiguration(ctx, input)
-	if err != nil {
+	var serviceSettingConfiguration *types.ServiceSettingConfiguration
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if serviceSettingConfiguration, err = s.serviceSettingConfigurationDataManager.CreateServiceSettingConfiguration(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                   types.ServiceSettingConfigurationCreatedCustomerEventType,
+			ServiceSettingConfiguration: serviceSettingConfiguration,
+			UserID:                      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating service setting configurations")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -83,16 +96,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                   types.ServiceSettingConfigurationCreatedCustomerEventType,
-		ServiceSettingConfiguration: serviceSettingConfiguration,
-		UserID:                      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.ServiceSettingConfiguration]{
 		Details: responseDetails,
 		Data:    serviceSettingConfiguration,
@@ -331,7 +334,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	serviceSettingConfiguration.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.serviceSettingConfigurationDataManager.UpdateServiceSettingConfiguration(ctx, serviceSettingConfiguration); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.serviceSettingConfigurationDataManager.UpdateServiceSettingConfiguration(ctx, serviceSettingConfiguration); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                   types.ServiceSettingConfigurationUpdatedCustomerEventType,
+			ServiceSettingConfiguration: serviceSettingConfiguration,
+			UserID:                      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating service setting configurations")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -339,16 +354,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                   types.ServiceSettingConfigurationUpdatedCustomerEventType,
-		ServiceSettingConfiguration: serviceSettingConfiguration,
-		UserID:                      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ServiceSettingConfiguration]{
 		Details: responseDetails,
 		Data:    serviceSettingConfiguration,
@@ -406,7 +411,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.serviceSettingConfigurationDataManager.ArchiveServiceSettingConfiguration(ctx, serviceSettingConfigurationID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.serviceSettingConfigurationDataManager.ArchiveServiceSettingConfiguration(ctx, serviceSettingConfigurationID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.ServiceSettingConfigurationArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving service setting configurations")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -414,15 +430,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.ServiceSettingConfigurationArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ServiceSettingConfiguration]{
 		Details: responseDetails,
 	}
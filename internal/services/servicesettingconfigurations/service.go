@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -28,6 +29,7 @@
 		serviceSettingNameFetcher              func(*http.Request) string
 		sessionContextDataFetcher              func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher                   messagequeue.Publisher
+		transactionRunner                      database.TransactionRunner
 		encoderDecoder                         encoding.ServerEncoderDecoder
 		tracer                                 tracing.Tracer
 	}
@@ -38,6 +40,7 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	serviceSettingConfigurationDataManager types.ServiceSettingConfigurationDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -55,6 +58,7 @@ func ProvideService(
 		sessionContextDataFetcher:              authservice.FetchContextFromRequest,
 		serviceSettingConfigurationDataManager: serviceSettingConfigurationDataManager,
 		dataChangesPublisher:                   dataChangesPublisher,
+		transactionRunner:                      transactionRunner,
 		encoderDecoder:                         encoder,
 		tracer:                                 tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,6 +20,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:                      database.NewNoopTransactionRunner(),
 		logger:                                 logging.NewNoopLogger(),
 		serviceSettingConfigurationDataManager: &mocktypes.ServiceSettingConfigurationDataManagerMock{},
 		serviceSettingConfigurationIDFetcher:   func(req *http.Request) string { return "" },
@@ -55,6 +57,7 @@ func TestProvideServiceSettingConfigurationsService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.ServiceSettingConfigurationDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -82,6 +85,7 @@ func TestProvideServiceSettingConfigurationsService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.ServiceSettingConfigurationDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
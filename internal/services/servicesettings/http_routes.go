@@ -1,6 +1,7 @@
 package servicesettings
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -70,8 +71,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.ServiceSettingIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	serviceSetting, err := s.serviceSettingDataManager.CreateServiceSetting(ctx, input)
-	if err != nil {
+	var serviceSetting *types.ServiceSetting
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if serviceSetting, err = s.serviceSettingDataManager.CreateServiceSetting(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:      types.ServiceSettingCreatedCustomerEventType,
+			ServiceSetting: serviceSetting,
+			UserID:         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating service setting")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -79,16 +92,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:      types.ServiceSettingCreatedCustomerEventType,
-		ServiceSetting: serviceSetting,
-		UserID:         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.ServiceSetting]{
 		Details: responseDetails,
 		Data:    serviceSetting,
@@ -308,7 +311,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.serviceSettingDataManager.ArchiveServiceSetting(ctx, serviceSettingID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.serviceSettingDataManager.ArchiveServiceSetting(ctx, serviceSettingID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.ServiceSettingArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving service setting")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -316,15 +330,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.ServiceSettingArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ServiceSetting]{
 		Details: responseDetails,
 	}
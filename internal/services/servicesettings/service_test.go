@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,6 +20,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:         database.NewNoopTransactionRunner(),
 		logger:                    logging.NewNoopLogger(),
 		serviceSettingDataManager: &mocktypes.ServiceSettingDataManagerMock{},
 		serviceSettingIDFetcher:   func(req *http.Request) string { return "" },
@@ -51,6 +53,7 @@ func TestProvideServiceSettingsService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.ServiceSettingDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -78,6 +81,7 @@ func TestProvideServiceSettingsService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.ServiceSettingDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -1,6 +1,7 @@
 package useringredientpreferences
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -67,8 +68,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	input.BelongsToUser = sessionCtxData.Requester.UserID
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	userIngredientPreference, err := s.userIngredientPreferenceDataManager.CreateUserIngredientPreference(ctx, input)
-	if err != nil {
+	var userIngredientPreference []*types.UserIngredientPreference
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if userIngredientPreference, err = s.userIngredientPreferenceDataManager.CreateUserIngredientPreference(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                 types.UserIngredientPreferenceCreatedCustomerEventType,
+			UserIngredientPreferences: userIngredientPreference,
+			UserID:                    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating user ingredient preferences")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -76,16 +89,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                 types.UserIngredientPreferenceCreatedCustomerEventType,
-		UserIngredientPreferences: userIngredientPreference,
-		UserID:                    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[[]*types.UserIngredientPreference]{
 		Details: responseDetails,
 		Data:    userIngredientPreference,
@@ -213,7 +216,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	userIngredientPreference.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userIngredientPreferenceDataManager.UpdateUserIngredientPreference(ctx, userIngredientPreference); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userIngredientPreferenceDataManager.UpdateUserIngredientPreference(ctx, userIngredientPreference); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                 types.UserIngredientPreferenceUpdatedCustomerEventType,
+			UserIngredientPreferences: []*types.UserIngredientPreference{userIngredientPreference},
+			UserID:                    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating user ingredient preferences")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -221,16 +236,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                 types.UserIngredientPreferenceUpdatedCustomerEventType,
-		UserIngredientPreferences: []*types.UserIngredientPreference{userIngredientPreference},
-		UserID:                    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.UserIngredientPreference]{
 		Details: responseDetails,
 		Data:    userIngredientPreference,
@@ -288,7 +293,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.userIngredientPreferenceDataManager.ArchiveUserIngredientPreference(ctx, userIngredientPreferenceID, requester); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userIngredientPreferenceDataManager.ArchiveUserIngredientPreference(ctx, userIngredientPreferenceID, requester); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.UserIngredientPreferenceArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving user ingredient preferences")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -296,15 +312,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.UserIngredientPreferenceArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.UserIngredientPreference]{
 		Details: responseDetails,
 	}
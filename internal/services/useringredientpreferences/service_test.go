@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -20,6 +21,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:                   database.NewNoopTransactionRunner(),
 		logger:                              logging.NewNoopLogger(),
 		userIngredientPreferenceDataManager: &mocktypes.UserIngredientPreferenceDataManagerMock{},
 		userIngredientPreferenceIDFetcher:   func(req *http.Request) string { return "" },
@@ -55,6 +57,7 @@ func TestProvideUserIngredientPreferencesService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.UserIngredientPreferenceDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -85,6 +88,7 @@ func TestProvideUserIngredientPreferencesService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.UserIngredientPreferenceDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
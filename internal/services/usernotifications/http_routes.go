@@ -1,6 +1,7 @@
 package usernotifications
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -68,8 +69,21 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	input.ID = identifiers.New()
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	userNotification, err := s.userNotificationDataManager.CreateUserNotification(ctx, input)
-	if err != nil {
+	var userNotification *types.UserNotification
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if userNotification, err = s.userNotificationDataManager.CreateUserNotification(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:        types.UserNotificationCreatedCustomerEventType,
+			UserNotification: userNotification,
+			HouseholdID:      sessionCtxData.ActiveHouseholdID,
+			UserID:           sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating user notification")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -77,17 +91,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:        types.UserNotificationCreatedCustomerEventType,
-		UserNotification: userNotification,
-		HouseholdID:      sessionCtxData.ActiveHouseholdID,
-		UserID:           sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.UserNotification]{
 		Details: responseDetails,
 		Data:    userNotification,
@@ -276,7 +279,20 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	userNotification.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userNotificationDataManager.UpdateUserNotification(ctx, userNotification); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userNotificationDataManager.UpdateUserNotification(ctx, userNotification); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:        types.UserNotificationUpdatedCustomerEventType,
+			UserNotification: userNotification,
+			HouseholdID:      sessionCtxData.ActiveHouseholdID,
+			UserID:           sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating user notification")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -284,17 +300,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:        types.UserNotificationUpdatedCustomerEventType,
-		UserNotification: userNotification,
-		HouseholdID:      sessionCtxData.ActiveHouseholdID,
-		UserID:           sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.UserNotification]{
 		Details: responseDetails,
 		Data:    userNotification,
@@ -6,6 +6,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -26,6 +27,7 @@
 	service struct {
 		logger                      logging.Logger
 		dataChangesPublisher        messagequeue.Publisher
+		transactionRunner           database.TransactionRunner
 		tracer                      tracing.Tracer
 		encoderDecoder              encoding.ServerEncoderDecoder
 		userNotificationDataManager types.UserNotificationDataManager
@@ -40,6 +42,7 @@ func ProvideService(
 	_ context.Context,
 	logger logging.Logger,
 	cfg *Config,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -60,6 +63,7 @@ func ProvideService(
 		userNotificationIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(UserNotificationIDURIParamKey),
 		sessionContextDataFetcher:   authservice.FetchContextFromRequest,
 		dataChangesPublisher:        dataChangesPublisher,
+		transactionRunner:           transactionRunner,
 		encoderDecoder:              encoder,
 		cfg:                         *cfg,
 		userNotificationDataManager: userNotificationDataManager,
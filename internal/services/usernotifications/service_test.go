@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -20,6 +21,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:           database.NewNoopTransactionRunner(),
 		logger:                      logging.NewNoopLogger(),
 		userNotificationDataManager: &mocktypes.UserNotificationDataManagerMock{},
 		userNotificationIDFetcher:   func(req *http.Request) string { return "" },
@@ -51,6 +53,7 @@ func TestProvideService(T *testing.T) {
 			context.Background(),
 			logging.NewNoopLogger(),
 			cfg,
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -80,6 +83,7 @@ func TestProvideService(T *testing.T) {
 			context.Background(),
 			logging.NewNoopLogger(),
 			cfg,
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -13,6 +13,8 @@
 	"strings"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
 	"github.com/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
@@ -282,8 +284,36 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 
 	// create the user.
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	user, err := s.userDataManager.CreateUser(ctx, input)
-	if err != nil {
+	var (
+		user               *types.User
+		defaultHouseholdID string
+	)
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if user, err = s.userDataManager.CreateUser(ctx, input); err != nil {
+			return err
+		}
+
+		logger.Debug("user created")
+
+		if defaultHouseholdID, err = s.householdUserMembershipDataManager.GetDefaultHouseholdIDForUser(ctx, user.ID); err != nil {
+			return observability.PrepareError(err, span, "fetching default household ID for user")
+		}
+
+		emailVerificationToken, emailVerificationTokenErr := s.userDataManager.GetEmailAddressVerificationTokenForUser(ctx, user.ID)
+		if emailVerificationTokenErr != nil {
+			return observability.PrepareError(emailVerificationTokenErr, span, "fetching email verification token for user")
+		}
+
+		// notify the relevant parties.
+		dcm := &types.DataChangeMessage{
+			HouseholdID:            defaultHouseholdID,
+			EventType:              types.UserSignedUpCustomerEventType,
+			UserID:                 user.ID,
+			EmailVerificationToken: emailVerificationToken,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating user")
 		if errors.Is(err, database.ErrUserAlreadyExists) {
 			errRes := types.NewAPIErrorResponse("username taken", types.ErrValidatingRequestInput, responseDetails)
@@ -296,41 +326,8 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	logger.Debug("user created")
-
-	readTimer := timing.NewMetric("database").WithDesc("get default household").Start()
-	defaultHouseholdID, err := s.householdUserMembershipDataManager.GetDefaultHouseholdIDForUser(ctx, user.ID)
-	if err != nil {
-		observability.AcknowledgeError(err, logger, span, "fetching default household ID for user")
-		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-		return
-	}
-	readTimer.Stop()
-
-	readTimer = timing.NewMetric("database").WithDesc("get token").Start()
-	emailVerificationToken, emailVerificationTokenErr := s.userDataManager.GetEmailAddressVerificationTokenForUser(ctx, user.ID)
-	if emailVerificationTokenErr != nil {
-		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-		return
-	}
-	readTimer.Stop()
-
-	// notify the relevant parties.
 	tracing.AttachToSpan(span, keys.UserIDKey, user.ID)
 
-	dcm := &types.DataChangeMessage{
-		HouseholdID:            defaultHouseholdID,
-		EventType:              types.UserSignedUpCustomerEventType,
-		UserID:                 user.ID,
-		EmailVerificationToken: emailVerificationToken,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	if err = s.analyticsReporter.AddUser(ctx, user.ID, map[string]any{
 		"username":          user.Username,
 		"default_household": defaultHouseholdID,
@@ -508,7 +505,7 @@ func (s *service) PermissionsHandler(res http.ResponseWriter, req *http.Request)
 		p := authorization.Permission(perm)
 		hasHouseholdPerm := sessionCtxData.HouseholdPermissions[sessionCtxData.ActiveHouseholdID].HasPermission(p)
 		hasServicePerm := sessionCtxData.Requester.ServicePermissions.HasPermission(p)
-		body.Permissions[perm] = hasHouseholdPerm || hasServicePerm
+		body.Permissions[perm] = (hasHouseholdPerm || hasServicePerm) && sessionCtxData.TokenScopesAllow(p)
 	}
 
 	responseValue := &types.APIResponse[*types.UserPermissionsResponse]{
@@ -597,6 +594,12 @@ func (s *service) TOTPSecretVerificationHandler(res http.ResponseWriter, req *ht
 
 	logger = logger.WithValue(keys.UserIDKey, input.UserID)
 
+	ipAddress := loginattempts.ClientIPAddress(req)
+	if loginattempts.RespondIfLockedOut(ctx, logger, span, s.loginAttemptTracker, s.encoderDecoder, res, input.UserID, ipAddress, responseDetails) {
+		logger.Info("TOTP verification attempted while locked out")
+		return
+	}
+
 	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
 	user, err := s.userDataManager.GetUserWithUnverifiedTwoFactorSecret(ctx, input.UserID)
 	if err != nil {
@@ -621,26 +624,31 @@ func (s *service) TOTPSecretVerificationHandler(res http.ResponseWriter, req *ht
 	totpValid := totp.Validate(input.TOTPToken, user.TwoFactorSecret)
 	if !totpValid {
 		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		loginattempts.RecordFailure(ctx, logger, span, s.loginAttemptTracker, s.auditLogEntryDataManager, s.dataChangesPublisher, user.ID, ipAddress)
 		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrValidatingRequestInput, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
 		return
 	}
 
-	if err = s.userDataManager.MarkUserTwoFactorSecretAsVerified(ctx, user.ID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.MarkUserTwoFactorSecretAsVerified(ctx, user.ID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.TwoFactorSecretVerifiedCustomerEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "verifying user two factor secret")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.TwoFactorSecretVerifiedCustomerEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
+	loginattempts.RecordSuccess(ctx, logger, span, s.loginAttemptTracker, user.ID)
 
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
@@ -724,6 +732,33 @@ func (s *service) NewTOTPSecretHandler(res http.ResponseWriter, req *http.Reques
 			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
 			return
 		}
+
+		// users who've lost their TOTP device can prove who they are with a recovery code instead.
+		if input.TOTPToken == "" {
+			var redeemed bool
+			if redemptionErr := s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+				if redeemed, err = s.userRecoveryCodeDataManager.RedeemUserRecoveryCode(ctx, user.ID, authentication.HashRecoveryCode(input.RecoveryCode)); err != nil || !redeemed {
+					return err
+				}
+
+				dcm := &types.DataChangeMessage{
+					EventType: types.RecoveryCodeRedeemedCustomerEventType,
+					UserID:    user.ID,
+				}
+
+				return s.dataChangesPublisher.Publish(ctx, dcm)
+			}); redemptionErr != nil {
+				observability.AcknowledgeError(redemptionErr, logger, span, "redeeming recovery code")
+				errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+				return
+			} else if !redeemed {
+				logger.Debug("invalid recovery code provided")
+				errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrUserIsNotAuthorized, responseDetails)
+				s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+				return
+			}
+		}
 	} else {
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, []byte(""), http.StatusPreconditionFailed)
 		return
@@ -744,7 +779,18 @@ func (s *service) NewTOTPSecretHandler(res http.ResponseWriter, req *http.Reques
 	}
 
 	// update the user in the database.
-	if err = s.userDataManager.MarkUserTwoFactorSecretAsUnverified(ctx, user.ID, tfs); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.MarkUserTwoFactorSecretAsUnverified(ctx, user.ID, tfs); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.TwoFactorSecretChangedCustomerEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating 2FA secret")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -754,15 +800,6 @@ func (s *service) NewTOTPSecretHandler(res http.ResponseWriter, req *http.Reques
 	user.TwoFactorSecret = tfs
 	user.TwoFactorSecretVerifiedAt = nil
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.TwoFactorSecretChangedCustomerEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	// let the requester know we're all good.
 	result := &types.TOTPSecretRefreshResponse{
 		TwoFactorSecret: user.TwoFactorSecret,
@@ -777,6 +814,106 @@ func (s *service) NewTOTPSecretHandler(res http.ResponseWriter, req *http.Reques
 	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusAccepted)
 }
 
+// NewRecoveryCodesHandler replaces a user's recovery codes, which can be used in place of a TOTP token.
+func (s *service) NewRecoveryCodesHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// decode the request.
+	input := new(types.RecoveryCodesGenerationRequestInput)
+	if err := s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request body")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// recovery codes only make sense for users with two factor authentication enabled.
+	user, httpStatus := s.validateCredentialsForUpdateRequest(ctx, sessionCtxData.Requester.UserID, input.CurrentPassword, input.TOTPToken)
+	if httpStatus != http.StatusOK {
+		errRes := types.NewAPIErrorResponse("invalid credentials", types.ErrUserIsNotAuthorized, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, httpStatus)
+		return
+	}
+
+	if user.TwoFactorSecretVerifiedAt == nil {
+		errRes := types.NewAPIErrorResponse("two factor authentication is not enabled", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusPreconditionFailed)
+		return
+	}
+
+	logger = logger.WithValue(keys.UserIDKey, user.ID)
+
+	codes, err := authentication.GenerateRecoveryCodes(authentication.RecoveryCodeCount)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "generating recovery codes")
+		errRes := types.NewAPIErrorResponse("generating secret", types.ErrSecretGeneration, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	hashedCodes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashedCodes = append(hashedCodes, authentication.HashRecoveryCode(code))
+	}
+
+	createTimer := timing.NewMetric("database").WithDesc("create").Start()
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userRecoveryCodeDataManager.CreateUserRecoveryCodes(ctx, user.ID, hashedCodes); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.RecoveryCodesGeneratedCustomerEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
+		observability.AcknowledgeError(err, logger, span, "storing recovery codes")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	createTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.RecoveryCodesResponse]{
+		Details: responseDetails,
+		Data:    &types.RecoveryCodesResponse{Codes: codes},
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
 // UpdatePasswordHandler updates a user's password.
 func (s *service) UpdatePasswordHandler(res http.ResponseWriter, req *http.Request) {
 	ctx, span := s.tracer.StartSpan(req.Context())
@@ -856,7 +993,18 @@ func (s *service) UpdatePasswordHandler(res http.ResponseWriter, req *http.Reque
 
 	// update the user.
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userDataManager.UpdateUserPassword(ctx, user.ID, newPasswordHash); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.UpdateUserPassword(ctx, user.ID, newPasswordHash); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.PasswordChangedEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating user")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -864,15 +1012,6 @@ func (s *service) UpdatePasswordHandler(res http.ResponseWriter, req *http.Reque
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.PasswordChangedEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	// we're all good, log the user out
 	http.SetCookie(res, &http.Cookie{MaxAge: -1})
 
@@ -943,7 +1082,18 @@ func (s *service) UpdateUserEmailAddressHandler(res http.ResponseWriter, req *ht
 
 	// update the user.
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userDataManager.UpdateUserEmailAddress(ctx, user.ID, input.NewEmailAddress); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.UpdateUserEmailAddress(ctx, user.ID, input.NewEmailAddress); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.EmailAddressChangedEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating user")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -951,15 +1101,6 @@ func (s *service) UpdateUserEmailAddressHandler(res http.ResponseWriter, req *ht
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.EmailAddressChangedEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
 		Data:    user,
@@ -1028,7 +1169,18 @@ func (s *service) UpdateUserUsernameHandler(res http.ResponseWriter, req *http.R
 
 	// update the user.
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userDataManager.UpdateUserUsername(ctx, user.ID, input.NewUsername); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.UpdateUserUsername(ctx, user.ID, input.NewUsername); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.UsernameChangedEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating user")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -1036,15 +1188,6 @@ func (s *service) UpdateUserUsernameHandler(res http.ResponseWriter, req *http.R
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.UsernameChangedEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
 		Data:    user,
@@ -1114,7 +1257,18 @@ func (s *service) UpdateUserDetailsHandler(res http.ResponseWriter, req *http.Re
 
 	// update the user.
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userDataManager.UpdateUserDetails(ctx, user.ID, dbInput); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.UpdateUserDetails(ctx, user.ID, dbInput); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.UserDetailsChangedEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating user")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -1122,15 +1276,6 @@ func (s *service) UpdateUserDetailsHandler(res http.ResponseWriter, req *http.Re
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.UserDetailsChangedEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
 		Data:    user,
@@ -1236,7 +1381,19 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 
 	// do the deed.
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	err = s.userDataManager.ArchiveUser(ctx, userID)
+	err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.ArchiveUser(ctx, userID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			EventType:   types.UserArchivedCustomerEventType,
+			UserID:      userID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	})
 	if errors.Is(err, sql.ErrNoRows) {
 		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
@@ -1251,16 +1408,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 
 	logger.Info("user archived")
 
-	dcm := &types.DataChangeMessage{
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		EventType:   types.UserArchivedCustomerEventType,
-		UserID:      userID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
 	}
@@ -1389,8 +1536,20 @@ func (s *service) CreatePasswordResetTokenHandler(res http.ResponseWriter, req *
 	}
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	t, err := s.passwordResetTokenDataManager.CreatePasswordResetToken(ctx, dbInput)
-	if err != nil {
+	var t *types.PasswordResetToken
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if t, err = s.passwordResetTokenDataManager.CreatePasswordResetToken(ctx, dbInput); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:          types.PasswordResetTokenCreatedEventType,
+			UserID:             u.ID,
+			PasswordResetToken: t,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating password reset token")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -1398,16 +1557,6 @@ func (s *service) CreatePasswordResetTokenHandler(res http.ResponseWriter, req *
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:          types.PasswordResetTokenCreatedEventType,
-		UserID:             u.ID,
-		PasswordResetToken: t,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
 	}
@@ -1492,24 +1641,24 @@ func (s *service) PasswordResetTokenRedemptionHandler(res http.ResponseWriter, r
 		return
 	}
 
-	// update the user.
+	// update the user and redeem the token together, so the token can't be used twice.
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.userDataManager.UpdateUserPassword(ctx, u.ID, newPasswordHash); err != nil {
-		observability.AcknowledgeError(err, logger, span, "updating user")
-		if errors.Is(err, sql.ErrNoRows) {
-			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
-			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
-			return
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.UpdateUserPassword(ctx, u.ID, newPasswordHash); err != nil {
+			return err
 		}
 
-		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
-		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
-		return
-	}
-	updateTimer.Stop()
+		if err = s.passwordResetTokenDataManager.RedeemPasswordResetToken(ctx, t.ID); err != nil {
+			return err
+		}
 
-	redeemTimer := timing.NewMetric("database").WithDesc("redeem password reset token").Start()
-	if redemptionErr := s.passwordResetTokenDataManager.RedeemPasswordResetToken(ctx, t.ID); redemptionErr != nil {
+		dcm := &types.DataChangeMessage{
+			EventType: types.PasswordResetTokenRedeemedEventType,
+			UserID:    t.BelongsToUser,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "redeeming password reset token")
 		if errors.Is(err, sql.ErrNoRows) {
 			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
@@ -1521,16 +1670,7 @@ func (s *service) PasswordResetTokenRedemptionHandler(res http.ResponseWriter, r
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
 		return
 	}
-	redeemTimer.Stop()
-
-	dcm := &types.DataChangeMessage{
-		EventType: types.PasswordResetTokenRedeemedEventType,
-		UserID:    t.BelongsToUser,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
+	updateTimer.Stop()
 
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
@@ -1585,7 +1725,18 @@ func (s *service) VerifyUserEmailAddressHandler(res http.ResponseWriter, req *ht
 	}
 	readTimer.Stop()
 
-	if err = s.userDataManager.MarkUserEmailAddressAsVerified(ctx, user.ID, input.Token); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.userDataManager.MarkUserEmailAddressAsVerified(ctx, user.ID, input.Token); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.UserEmailAddressVerifiedEventType,
+			UserID:    user.ID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
 			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
@@ -1598,15 +1749,6 @@ func (s *service) VerifyUserEmailAddressHandler(res http.ResponseWriter, req *ht
 		return
 	}
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.UserEmailAddressVerifiedEventType,
-		UserID:    user.ID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.User]{
 		Details: responseDetails,
 	}
@@ -8,7 +8,11 @@
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	mockloginattempts "github.com/dinnerdonebetter/backend/internal/authentication/loginattempts/mock"
 	mockauthn "github.com/dinnerdonebetter/backend/internal/authentication/mock"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
@@ -19,6 +23,7 @@
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/converters"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
 	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -1003,11 +1008,11 @@ func TestService_CreateHandler(T *testing.T) {
 		helper.service.authSettings.EnableUserSignup = true
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.UserCreationResponse]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NotNil(t, actual.Data)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, auth, db, dataChangesPublisher)
 	})
@@ -1487,6 +1492,111 @@ func TestService_TOTPSecretVerificationHandler(T *testing.T) {
 		assert.Error(t, actual.Error)
 	})
 
+	T.Run("with invalid TOTP token triggering lockout", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeTOTPSecretVerificationInputForUser(helper.exampleUser)
+		exampleInput.TOTPToken = "000000"
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		helper.exampleUser.TwoFactorSecretVerifiedAt = nil
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUserWithUnverifiedTwoFactorSecret",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = mockDB
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			"127.0.0.1",
+		).Return(time.Time{}, nil)
+		tracker.On(
+			"RecordFailure",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			"127.0.0.1",
+		).Return([]*loginattempts.Lockout{
+			{
+				LockedUntil: time.Now().Add(time.Minute),
+				SubjectType: loginattempts.SubjectTypeUser,
+				Subject:     helper.exampleUser.ID,
+				Count:       1,
+			},
+		}, nil)
+		helper.service.loginAttemptTracker = tracker
+
+		auditLogEntryDataManager := &mocktypes.AuditLogEntryDataManagerMock{}
+		auditLogEntryDataManager.On(
+			"CreateAuditLogEntry",
+			testutils.ContextMatcher,
+			mock.IsType(&types.AuditLogEntryDatabaseCreationInput{}),
+		).Return(&types.AuditLogEntry{}, nil)
+		helper.service.auditLogEntryDataManager = auditLogEntryDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(dcm *types.DataChangeMessage) bool {
+				return dcm.EventType == types.LoginLockoutTriggeredEventType
+			}),
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.TOTPSecretVerificationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockDB, tracker, auditLogEntryDataManager, dataChangesPublisher)
+	})
+
+	T.Run("while locked out", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeTOTPSecretVerificationInputForUser(helper.exampleUser)
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+		helper.req.RemoteAddr = "127.0.0.1:12345"
+
+		tracker := &mockloginattempts.Tracker{}
+		tracker.On(
+			"LockedOutUntil",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			"127.0.0.1",
+		).Return(time.Now().Add(time.Minute), nil)
+		helper.service.loginAttemptTracker = tracker
+
+		helper.service.TOTPSecretVerificationHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusTooManyRequests, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get("Retry-After"))
+
+		mock.AssertExpectationsForObjects(t, tracker)
+	})
+
 	T.Run("with secret already validated", func(t *testing.T) {
 		t.Parallel()
 
@@ -1638,12 +1748,11 @@ func TestService_TOTPSecretVerificationHandler(T *testing.T) {
 
 		helper.service.TOTPSecretVerificationHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.User]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		helper.exampleUser.TwoFactorSecret = ""
-		assert.Equal(t, actual.Data, helper.exampleUser)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, mockDB, dataChangesPublisher)
 	})
@@ -1719,6 +1828,135 @@ func TestService_NewTOTPSecretHandler(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, mockDB, auth, sg, dataChangesPublisher)
 	})
 
+	T.Run("with recovery code", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeTOTPSecretRefreshInput()
+		exampleInput.TOTPToken = ""
+		exampleInput.RecoveryCode = "abcd-efgh-ijkl-mnop"
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+
+		fakeSecret := fakes.BuildFakeID()
+		sg := &randommock.Generator{}
+		sg.On(
+			"GenerateBase32EncodedString",
+			testutils.ContextMatcher,
+			totpSecretSize,
+		).Return(fakeSecret, nil)
+		helper.service.secretGenerator = sg
+
+		mockDB.UserDataManagerMock.On(
+			"MarkUserTwoFactorSecretAsUnverified",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			fakeSecret,
+		).Return(nil)
+		helper.service.userDataManager = mockDB
+
+		recoveryCodeDataManager := &mocktypes.UserRecoveryCodeDataManagerMock{}
+		recoveryCodeDataManager.On(
+			"RedeemUserRecoveryCode",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			authentication.HashRecoveryCode(exampleInput.RecoveryCode),
+		).Return(true, nil)
+		helper.service.userRecoveryCodeDataManager = recoveryCodeDataManager
+
+		auth := &mockauthn.Authenticator{}
+		auth.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			exampleInput.CurrentPassword,
+			helper.exampleUser.TwoFactorSecret,
+			exampleInput.TOTPToken,
+		).Return(true, nil)
+		helper.service.authenticator = auth
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.NewTOTPSecretHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		var actual *types.APIResponse[*types.TOTPSecretRefreshResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, mockDB, recoveryCodeDataManager, auth, sg, dataChangesPublisher)
+	})
+
+	T.Run("with invalid recovery code", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeTOTPSecretRefreshInput()
+		exampleInput.TOTPToken = ""
+		exampleInput.RecoveryCode = "abcd-efgh-ijkl-mnop"
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = mockDB
+
+		recoveryCodeDataManager := &mocktypes.UserRecoveryCodeDataManagerMock{}
+		recoveryCodeDataManager.On(
+			"RedeemUserRecoveryCode",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			authentication.HashRecoveryCode(exampleInput.RecoveryCode),
+		).Return(false, nil)
+		helper.service.userRecoveryCodeDataManager = recoveryCodeDataManager
+
+		auth := &mockauthn.Authenticator{}
+		auth.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			exampleInput.CurrentPassword,
+			helper.exampleUser.TwoFactorSecret,
+			exampleInput.TOTPToken,
+		).Return(true, nil)
+		helper.service.authenticator = auth
+
+		helper.service.NewTOTPSecretHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockDB, recoveryCodeDataManager, auth)
+	})
+
 	T.Run("with invalid credentials", func(t *testing.T) {
 		t.Parallel()
 
@@ -1967,15 +2205,228 @@ func TestService_NewTOTPSecretHandler(T *testing.T) {
 
 		helper.service.NewTOTPSecretHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.TOTPSecretRefreshResponse]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, mockDB, auth, sg, dataChangesPublisher)
 	})
 }
 
+func TestService_NewRecoveryCodesHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeRecoveryCodesGenerationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = mockDB
+
+		auth := &mockauthn.Authenticator{}
+		auth.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			exampleInput.CurrentPassword,
+			helper.exampleUser.TwoFactorSecret,
+			exampleInput.TOTPToken,
+		).Return(true, nil)
+		helper.service.authenticator = auth
+
+		recoveryCodeDataManager := &mocktypes.UserRecoveryCodeDataManagerMock{}
+		recoveryCodeDataManager.On(
+			"CreateUserRecoveryCodes",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			mock.MatchedBy(func(hashedCodes []string) bool { return len(hashedCodes) == authentication.RecoveryCodeCount }),
+		).Return(nil)
+		helper.service.userRecoveryCodeDataManager = recoveryCodeDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.NewRecoveryCodesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.RecoveryCodesResponse]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Len(t, actual.Data.Codes, authentication.RecoveryCodeCount)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, mockDB, auth, recoveryCodeDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, &types.RecoveryCodesGenerationRequestInput{})
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		helper.service.NewRecoveryCodesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+	})
+
+	T.Run("with invalid credentials", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeRecoveryCodesGenerationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = mockDB
+
+		auth := &mockauthn.Authenticator{}
+		auth.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			exampleInput.CurrentPassword,
+			helper.exampleUser.TwoFactorSecret,
+			exampleInput.TOTPToken,
+		).Return(false, nil)
+		helper.service.authenticator = auth
+
+		helper.service.NewRecoveryCodesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockDB, auth)
+	})
+
+	T.Run("without two factor authentication enabled", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+		helper.exampleUser.TwoFactorSecretVerifiedAt = nil
+
+		exampleInput := fakes.BuildFakeRecoveryCodesGenerationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = mockDB
+
+		auth := &mockauthn.Authenticator{}
+		auth.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			exampleInput.CurrentPassword,
+			"",
+			"",
+		).Return(true, nil)
+		helper.service.authenticator = auth
+
+		helper.service.NewRecoveryCodesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusPreconditionFailed, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockDB, auth)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.encoderDecoder = encoding.ProvideServerEncoderDecoder(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), encoding.ContentTypeJSON)
+
+		exampleInput := fakes.BuildFakeRecoveryCodesGenerationRequestInput()
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, exampleInput)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+
+		mockDB := database.NewMockDatabase()
+		mockDB.UserDataManagerMock.On(
+			"GetUser",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+		).Return(helper.exampleUser, nil)
+		helper.service.userDataManager = mockDB
+
+		auth := &mockauthn.Authenticator{}
+		auth.On(
+			"CredentialsAreValid",
+			testutils.ContextMatcher,
+			helper.exampleUser.HashedPassword,
+			exampleInput.CurrentPassword,
+			helper.exampleUser.TwoFactorSecret,
+			exampleInput.TOTPToken,
+		).Return(true, nil)
+		helper.service.authenticator = auth
+
+		recoveryCodeDataManager := &mocktypes.UserRecoveryCodeDataManagerMock{}
+		recoveryCodeDataManager.On(
+			"CreateUserRecoveryCodes",
+			testutils.ContextMatcher,
+			helper.exampleUser.ID,
+			mock.AnythingOfType("[]string"),
+		).Return(errors.New("blah"))
+		helper.service.userRecoveryCodeDataManager = recoveryCodeDataManager
+
+		helper.service.NewRecoveryCodesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, mockDB, auth, recoveryCodeDataManager)
+	})
+}
+
 func TestService_UpdatePasswordHandler(T *testing.T) {
 	T.Parallel()
 
@@ -4031,10 +4482,10 @@ func TestService_VerifyUserEmailAddressHandler(T *testing.T) {
 
 		helper.service.VerifyUserEmailAddressHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[any]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, mockDB, dataChangesPublisher)
 	})
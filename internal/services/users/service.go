@@ -8,6 +8,8 @@
 
 	"github.com/dinnerdonebetter/backend/internal/analytics"
 	"github.com/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/featureflags"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
@@ -40,11 +42,15 @@
 		householdUserMembershipDataManager types.HouseholdUserMembershipDataManager
 		householdInvitationDataManager     types.HouseholdInvitationDataManager
 		passwordResetTokenDataManager      types.PasswordResetTokenDataManager
+		userRecoveryCodeDataManager        types.UserRecoveryCodeDataManager
+		auditLogEntryDataManager           types.AuditLogEntryDataManager
+		loginAttemptTracker                loginattempts.Tracker
 		tracer                             tracing.Tracer
 		authenticator                      authentication.Authenticator
 		logger                             logging.Logger
 		encoderDecoder                     encoding.ServerEncoderDecoder
 		dataChangesPublisher               messagequeue.Publisher
+		transactionRunner                  database.TransactionRunner
 		analyticsReporter                  analytics.EventReporter
 		userDataManager                    types.UserDataManager
 		secretGenerator                    random.Generator
@@ -72,6 +78,7 @@ func ProvideUsersService(
 	householdInvitationDataManager types.HouseholdInvitationDataManager,
 	householdUserMembershipDataManager types.HouseholdUserMembershipDataManager,
 	authenticator authentication.Authenticator,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	imageUploadProcessor images.MediaUploadProcessor,
 	routeParamManager routing.RouteParamManager,
@@ -79,8 +86,11 @@ func ProvideUsersService(
 	publisherProvider messagequeue.PublisherProvider,
 	secretGenerator random.Generator,
 	passwordResetTokenDataManager types.PasswordResetTokenDataManager,
+	userRecoveryCodeDataManager types.UserRecoveryCodeDataManager,
 	featureFlagManager featureflags.FeatureFlagManager,
 	analyticsReporter analytics.EventReporter,
+	auditLogEntryDataManager types.AuditLogEntryDataManager,
+	loginAttemptTracker loginattempts.Tracker,
 ) (types.UserDataService, error) {
 	if cfg == nil {
 		return nil, ErrNilConfig
@@ -113,7 +123,11 @@ func ProvideUsersService(
 		imageUploadProcessor:               imageUploadProcessor,
 		uploadManager:                      uploadManager,
 		dataChangesPublisher:               dataChangesPublisher,
+		transactionRunner:                  transactionRunner,
 		passwordResetTokenDataManager:      passwordResetTokenDataManager,
+		userRecoveryCodeDataManager:        userRecoveryCodeDataManager,
+		auditLogEntryDataManager:           auditLogEntryDataManager,
+		loginAttemptTracker:                loginAttemptTracker,
 		featureFlagManager:                 featureFlagManager,
 		analyticsReporter:                  analyticsReporter,
 	}
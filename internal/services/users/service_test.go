@@ -6,7 +6,9 @@
 	"testing"
 
 	"github.com/dinnerdonebetter/backend/internal/analytics"
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
 	mockauthn "github.com/dinnerdonebetter/backend/internal/authentication/mock"
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	"github.com/dinnerdonebetter/backend/internal/featureflags"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -52,6 +54,7 @@ func buildTestService(t *testing.T) *service {
 		&mocktypes.HouseholdInvitationDataManagerMock{},
 		&mocktypes.HouseholdUserMembershipDataManagerMock{},
 		&mockauthn.Authenticator{},
+		database.NewNoopTransactionRunner(),
 		mockencoding.NewMockEncoderDecoder(),
 		&images.MockImageUploadProcessor{},
 		chi.NewRouteParamManager(),
@@ -59,8 +62,11 @@ func buildTestService(t *testing.T) *service {
 		pp,
 		random.NewGenerator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider()),
 		&mocktypes.PasswordResetTokenDataManagerMock{},
+		&mocktypes.UserRecoveryCodeDataManagerMock{},
 		&featureflags.NoopFeatureFlagManager{},
 		analytics.NewNoopEventReporter(),
+		&mocktypes.AuditLogEntryDataManagerMock{},
+		loginattempts.NewNoopTracker(),
 	)
 
 	require.NoError(t, err)
@@ -108,6 +114,7 @@ func TestProvideUsersService(T *testing.T) {
 			&mocktypes.HouseholdInvitationDataManagerMock{},
 			&mocktypes.HouseholdUserMembershipDataManagerMock{},
 			&mockauthn.Authenticator{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			&images.MockImageUploadProcessor{},
 			rpm,
@@ -115,8 +122,11 @@ func TestProvideUsersService(T *testing.T) {
 			pp,
 			random.NewGenerator(logging.NewNoopLogger(), tracing.NewNoopTracerProvider()),
 			&mocktypes.PasswordResetTokenDataManagerMock{},
+			&mocktypes.UserRecoveryCodeDataManagerMock{},
 			&featureflags.NoopFeatureFlagManager{},
 			analytics.NewNoopEventReporter(),
+			&mocktypes.AuditLogEntryDataManagerMock{},
+			loginattempts.NewNoopTracker(),
 		)
 
 		assert.NotNil(t, s)
@@ -1,6 +1,7 @@
 package validingredientgroups
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -74,8 +75,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.ValidIngredientGroupIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	validIngredientGroup, err := s.validIngredientGroupDataManager.CreateValidIngredientGroup(ctx, input)
-	if err != nil {
+	var validIngredientGroup *types.ValidIngredientGroup
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if validIngredientGroup, err = s.validIngredientGroupDataManager.CreateValidIngredientGroup(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.ValidIngredientGroupCreatedCustomerEventType,
+			ValidIngredientGroup: validIngredientGroup,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating valid ingredient")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -83,16 +96,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:            types.ValidIngredientGroupCreatedCustomerEventType,
-		ValidIngredientGroup: validIngredientGroup,
-		UserID:               sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidIngredientGroup]{
 		Details: responseDetails,
 		Data:    validIngredientGroup,
@@ -329,7 +332,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	validIngredientGroup.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.validIngredientGroupDataManager.UpdateValidIngredientGroup(ctx, validIngredientGroup); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.validIngredientGroupDataManager.UpdateValidIngredientGroup(ctx, validIngredientGroup); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:            types.ValidIngredientGroupUpdatedCustomerEventType,
+			ValidIngredientGroup: validIngredientGroup,
+			UserID:               sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating valid ingredient")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -337,16 +352,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:            types.ValidIngredientGroupUpdatedCustomerEventType,
-		ValidIngredientGroup: validIngredientGroup,
-		UserID:               sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidIngredientGroup]{
 		Details: responseDetails,
 		Data:    validIngredientGroup,
@@ -404,7 +409,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.validIngredientGroupDataManager.ArchiveValidIngredientGroup(ctx, validIngredientGroupID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.validIngredientGroupDataManager.ArchiveValidIngredientGroup(ctx, validIngredientGroupID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.ValidIngredientGroupArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating valid ingredient")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -412,15 +428,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.ValidIngredientGroupArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidIngredientGroup]{
 		Details: responseDetails,
 	}
@@ -4,6 +4,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
@@ -29,6 +30,7 @@
 		validPreparationIDFetcher          func(*http.Request) string
 		sessionContextDataFetcher          func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher               messagequeue.Publisher
+		transactionRunner                  database.TransactionRunner
 		encoderDecoder                     encoding.ServerEncoderDecoder
 		tracer                             tracing.Tracer
 	}
@@ -39,6 +41,7 @@ func ProvideService(
 	logger logging.Logger,
 	cfg *Config,
 	validIngredientGroupDataManager types.ValidIngredientGroupDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -57,6 +60,7 @@ func ProvideService(
 		sessionContextDataFetcher:          authservice.FetchContextFromRequest,
 		validIngredientGroupDataManager:    validIngredientGroupDataManager,
 		dataChangesPublisher:               dataChangesPublisher,
+		transactionRunner:                  transactionRunner,
 		encoderDecoder:                     encoder,
 		tracer:                             tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
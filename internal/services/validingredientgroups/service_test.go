@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,6 +20,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:                  database.NewNoopTransactionRunner(),
 		logger:                             logging.NewNoopLogger(),
 		validIngredientGroupDataManager:    &mocktypes.ValidIngredientGroupDataManagerMock{},
 		validIngredientGroupIDFetcher:      func(req *http.Request) string { return "" },
@@ -60,6 +62,7 @@ func TestProvideValidIngredientGroupsService(T *testing.T) {
 			logger,
 			cfg,
 			&mocktypes.ValidIngredientGroupDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -88,6 +91,7 @@ func TestProvideValidIngredientGroupsService(T *testing.T) {
 			logger,
 			cfg,
 			&mocktypes.ValidIngredientGroupDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -1,6 +1,7 @@
 package validingredientmeasurementunits
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -74,8 +75,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.ValidIngredientMeasurementUnitIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	validIngredientMeasurementUnit, err := s.validIngredientMeasurementUnitDataManager.CreateValidIngredientMeasurementUnit(ctx, input)
-	if err != nil {
+	var validIngredientMeasurementUnit *types.ValidIngredientMeasurementUnit
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if validIngredientMeasurementUnit, err = s.validIngredientMeasurementUnitDataManager.CreateValidIngredientMeasurementUnit(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                      types.ValidIngredientMeasurementUnitCreatedCustomerEventType,
+			ValidIngredientMeasurementUnit: validIngredientMeasurementUnit,
+			UserID:                         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating valid ingredient measurement unit")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -83,16 +96,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                      types.ValidIngredientMeasurementUnitCreatedCustomerEventType,
-		ValidIngredientMeasurementUnit: validIngredientMeasurementUnit,
-		UserID:                         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidIngredientMeasurementUnit]{
 		Details: responseDetails,
 		Data:    validIngredientMeasurementUnit,
@@ -276,7 +279,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	validIngredientMeasurementUnit.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.validIngredientMeasurementUnitDataManager.UpdateValidIngredientMeasurementUnit(ctx, validIngredientMeasurementUnit); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.validIngredientMeasurementUnitDataManager.UpdateValidIngredientMeasurementUnit(ctx, validIngredientMeasurementUnit); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                      types.ValidIngredientMeasurementUnitUpdatedCustomerEventType,
+			ValidIngredientMeasurementUnit: validIngredientMeasurementUnit,
+			UserID:                         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating valid ingredient measurement unit")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -284,16 +299,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                      types.ValidIngredientMeasurementUnitUpdatedCustomerEventType,
-		ValidIngredientMeasurementUnit: validIngredientMeasurementUnit,
-		UserID:                         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidIngredientMeasurementUnit]{
 		Details: responseDetails,
 		Data:    validIngredientMeasurementUnit,
@@ -351,7 +356,17 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.validIngredientMeasurementUnitDataManager.ArchiveValidIngredientMeasurementUnit(ctx, validIngredientMeasurementUnitID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.validIngredientMeasurementUnitDataManager.ArchiveValidIngredientMeasurementUnit(ctx, validIngredientMeasurementUnitID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.ValidIngredientMeasurementUnitArchivedCustomerEventType,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving valid ingredient measurement unit")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -359,14 +374,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.ValidIngredientMeasurementUnitArchivedCustomerEventType,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidIngredientMeasurementUnit]{
 		Details: responseDetails,
 	}
@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,7 +20,8 @@
 
 func buildTestService() *service {
 	return &service{
-		logger: logging.NewNoopLogger(),
+		transactionRunner: database.NewNoopTransactionRunner(),
+		logger:            logging.NewNoopLogger(),
 		validIngredientMeasurementUnitDataManager: &mocktypes.ValidIngredientMeasurementUnitDataManagerMock{},
 		validIngredientMeasurementUnitIDFetcher:   func(req *http.Request) string { return "" },
 		encoderDecoder:                            encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
@@ -58,6 +60,7 @@ func TestProvideValidIngredientMeasurementUnitsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.ValidIngredientMeasurementUnitDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -84,6 +87,7 @@ func TestProvideValidIngredientMeasurementUnitsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.ValidIngredientMeasurementUnitDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
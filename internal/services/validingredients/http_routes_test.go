@@ -203,7 +203,7 @@ func TestValidIngredientsService_CreateHandler(T *testing.T) {
 
 		helper.service.CreateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -1020,7 +1020,7 @@ func TestValidIngredientsService_UpdateHandler(T *testing.T) {
 
 		helper.service.UpdateHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -1185,7 +1185,7 @@ func TestValidIngredientsService_ArchiveHandler(T *testing.T) {
 
 		helper.service.ArchiveHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -1278,3 +1278,337 @@ func TestValidIngredientsService_RandomHandler(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, validIngredientDataManager)
 	})
 }
+
+func TestValidIngredientsService_ReadNutritionHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleNutrition := fakes.BuildFakeValidIngredientNutrition()
+		exampleNutrition.BelongsToValidIngredient = helper.exampleValidIngredient.ID
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"GetValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(exampleNutrition, nil)
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		helper.service.ReadNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, exampleNutrition)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ReadNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no nutrition for the valid ingredient", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"GetValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return((*types.ValidIngredientNutrition)(nil), sql.ErrNoRows)
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		helper.service.ReadNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager)
+	})
+
+	T.Run("with error fetching from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"GetValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return((*types.ValidIngredientNutrition)(nil), errors.New("blah"))
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		helper.service.ReadNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager)
+	})
+}
+
+func TestValidIngredientsService_UpdateNutritionHandler(T *testing.T) {
+	T.Parallel()
+
+	buildRequest := func(t *testing.T, helper *validIngredientsServiceHTTPRoutesTestHelper, input *types.ValidIngredientNutritionUpsertRequestInput) *http.Request {
+		t.Helper()
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, input)
+		req, err := http.NewRequestWithContext(helper.ctx, http.MethodPut, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, req)
+
+		return req
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		exampleNutrition := fakes.BuildFakeValidIngredientNutrition()
+		exampleNutrition.BelongsToValidIngredient = helper.exampleValidIngredient.ID
+		helper.req = buildRequest(t, helper, converters.ConvertValidIngredientNutritionToValidIngredientNutritionUpsertRequestInput(exampleNutrition))
+
+		validIngredientDataManager := &mocktypes.ValidIngredientDataManagerMock{}
+		validIngredientDataManager.On(
+			"ValidIngredientExists",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(true, nil)
+		helper.service.validIngredientDataManager = validIngredientDataManager
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"UpsertValidIngredientNutrition",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.ValidIngredientNutritionDatabaseCreationInput) bool {
+				return input.ValidIngredientID == helper.exampleValidIngredient.ID
+			}),
+		).Return(exampleNutrition, nil)
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.UpdateNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, exampleNutrition)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, validIngredientDataManager, nutritionDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.UpdateNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req = buildRequest(t, helper, &types.ValidIngredientNutritionUpsertRequestInput{})
+
+		helper.service.UpdateNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such valid ingredient", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req = buildRequest(t, helper, fakes.BuildFakeValidIngredientNutritionUpsertRequestInput())
+
+		validIngredientDataManager := &mocktypes.ValidIngredientDataManagerMock{}
+		validIngredientDataManager.On(
+			"ValidIngredientExists",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(false, nil)
+		helper.service.validIngredientDataManager = validIngredientDataManager
+
+		helper.service.UpdateNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, validIngredientDataManager)
+	})
+
+	T.Run("with error writing to database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.req = buildRequest(t, helper, fakes.BuildFakeValidIngredientNutritionUpsertRequestInput())
+
+		validIngredientDataManager := &mocktypes.ValidIngredientDataManagerMock{}
+		validIngredientDataManager.On(
+			"ValidIngredientExists",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(true, nil)
+		helper.service.validIngredientDataManager = validIngredientDataManager
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"UpsertValidIngredientNutrition",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(*types.ValidIngredientNutritionDatabaseCreationInput) bool { return true }),
+		).Return((*types.ValidIngredientNutrition)(nil), errors.New("blah"))
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		helper.service.UpdateNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.ValidIngredientNutrition]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, validIngredientDataManager, nutritionDataManager)
+	})
+}
+
+func TestValidIngredientsService_ArchiveNutritionHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"GetValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(fakes.BuildFakeValidIngredientNutrition(), nil)
+		nutritionDataManager.On(
+			"ArchiveValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(nil)
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		dataChangesPublisher := &mockpublishers.Publisher{}
+		dataChangesPublisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			testutils.DataChangeMessageMatcher,
+		).Return(nil)
+		helper.service.dataChangesPublisher = dataChangesPublisher
+
+		helper.service.ArchiveNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager, dataChangesPublisher)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ArchiveNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+	})
+
+	T.Run("with no nutrition for the valid ingredient", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"GetValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return((*types.ValidIngredientNutrition)(nil), sql.ErrNoRows)
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		helper.service.ArchiveNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager)
+	})
+
+	T.Run("with error archiving", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		nutritionDataManager := &mocktypes.ValidIngredientNutritionDataManagerMock{}
+		nutritionDataManager.On(
+			"GetValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(fakes.BuildFakeValidIngredientNutrition(), nil)
+		nutritionDataManager.On(
+			"ArchiveValidIngredientNutrition",
+			testutils.ContextMatcher,
+			helper.exampleValidIngredient.ID,
+		).Return(errors.New("blah"))
+		helper.service.validIngredientNutritionDataManager = nutritionDataManager
+
+		helper.service.ArchiveNutritionHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+
+		mock.AssertExpectationsForObjects(t, nutritionDataManager)
+	})
+}
@@ -5,6 +5,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
@@ -26,17 +27,19 @@
 type (
 	// service handles valid ingredients.
 	service struct {
-		cfg                           *Config
-		logger                        logging.Logger
-		validIngredientDataManager    types.ValidIngredientDataManager
-		validIngredientIDFetcher      func(*http.Request) string
-		validIngredientStateIDFetcher func(*http.Request) string
-		validPreparationIDFetcher     func(*http.Request) string
-		sessionContextDataFetcher     func(*http.Request) (*types.SessionContextData, error)
-		dataChangesPublisher          messagequeue.Publisher
-		encoderDecoder                encoding.ServerEncoderDecoder
-		tracer                        tracing.Tracer
-		searchIndex                   search.IndexSearcher[types.ValidIngredientSearchSubset]
+		cfg                                 *Config
+		logger                              logging.Logger
+		validIngredientDataManager          types.ValidIngredientDataManager
+		validIngredientNutritionDataManager types.ValidIngredientNutritionDataManager
+		validIngredientIDFetcher            func(*http.Request) string
+		validIngredientStateIDFetcher       func(*http.Request) string
+		validPreparationIDFetcher           func(*http.Request) string
+		sessionContextDataFetcher           func(*http.Request) (*types.SessionContextData, error)
+		dataChangesPublisher                messagequeue.Publisher
+		transactionRunner                   database.TransactionRunner
+		encoderDecoder                      encoding.ServerEncoderDecoder
+		tracer                              tracing.Tracer
+		searchIndex                         search.IndexSearcher[types.ValidIngredientSearchSubset]
 	}
 )
 
@@ -47,6 +50,8 @@ func ProvideService(
 	cfg *Config,
 	searchConfig *searchcfg.Config,
 	validIngredientDataManager types.ValidIngredientDataManager,
+	validIngredientNutritionDataManager types.ValidIngredientNutritionDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -63,17 +68,19 @@ func ProvideService(
 	}
 
 	svc := &service{
-		cfg:                           cfg,
-		logger:                        logging.EnsureLogger(logger).WithName(serviceName),
-		validIngredientIDFetcher:      routeParamManager.BuildRouteParamStringIDFetcher(ValidIngredientIDURIParamKey),
-		validIngredientStateIDFetcher: routeParamManager.BuildRouteParamStringIDFetcher(ValidIngredientStateIDURIParamKey),
-		validPreparationIDFetcher:     routeParamManager.BuildRouteParamStringIDFetcher(ValidPreparationIDURIParamKey),
-		sessionContextDataFetcher:     authservice.FetchContextFromRequest,
-		validIngredientDataManager:    validIngredientDataManager,
-		dataChangesPublisher:          dataChangesPublisher,
-		encoderDecoder:                encoder,
-		tracer:                        tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
-		searchIndex:                   searchIndex,
+		cfg:                                 cfg,
+		logger:                              logging.EnsureLogger(logger).WithName(serviceName),
+		validIngredientIDFetcher:            routeParamManager.BuildRouteParamStringIDFetcher(ValidIngredientIDURIParamKey),
+		validIngredientStateIDFetcher:       routeParamManager.BuildRouteParamStringIDFetcher(ValidIngredientStateIDURIParamKey),
+		validPreparationIDFetcher:           routeParamManager.BuildRouteParamStringIDFetcher(ValidPreparationIDURIParamKey),
+		sessionContextDataFetcher:           authservice.FetchContextFromRequest,
+		validIngredientDataManager:          validIngredientDataManager,
+		validIngredientNutritionDataManager: validIngredientNutritionDataManager,
+		dataChangesPublisher:                dataChangesPublisher,
+		transactionRunner:                   transactionRunner,
+		encoderDecoder:                      encoder,
+		tracer:                              tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
+		searchIndex:                         searchIndex,
 	}
 
 	return svc, nil
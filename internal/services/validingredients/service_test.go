@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,12 +22,14 @@
 
 func buildTestService() *service {
 	return &service{
-		logger:                        logging.NewNoopLogger(),
-		validIngredientDataManager:    &mocktypes.ValidIngredientDataManagerMock{},
-		validIngredientIDFetcher:      func(req *http.Request) string { return "" },
-		validIngredientStateIDFetcher: func(req *http.Request) string { return "" },
-		encoderDecoder:                encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
-		tracer:                        tracing.NewTracerForTest("test"),
+		transactionRunner:                   database.NewNoopTransactionRunner(),
+		logger:                              logging.NewNoopLogger(),
+		validIngredientDataManager:          &mocktypes.ValidIngredientDataManagerMock{},
+		validIngredientNutritionDataManager: &mocktypes.ValidIngredientNutritionDataManagerMock{},
+		validIngredientIDFetcher:            func(req *http.Request) string { return "" },
+		validIngredientStateIDFetcher:       func(req *http.Request) string { return "" },
+		encoderDecoder:                      encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
+		tracer:                              tracing.NewTracerForTest("test"),
 		cfg: &Config{
 			UseSearchService: false,
 		},
@@ -68,6 +71,8 @@ func TestProvideValidIngredientsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.ValidIngredientDataManagerMock{},
+			&mocktypes.ValidIngredientNutritionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -99,6 +104,8 @@ func TestProvideValidIngredientsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.ValidIngredientDataManagerMock{},
+			&mocktypes.ValidIngredientNutritionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -5,6 +5,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
@@ -32,6 +33,7 @@
 		validIngredientStateIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher       func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher            messagequeue.Publisher
+		transactionRunner               database.TransactionRunner
 		encoderDecoder                  encoding.ServerEncoderDecoder
 		tracer                          tracing.Tracer
 		searchIndex                     search.IndexSearcher[types.ValidIngredientStateSearchSubset]
@@ -45,6 +47,7 @@ func ProvideService(
 	cfg *Config,
 	searchConfig *searchcfg.Config,
 	validIngredientStateDataManager types.ValidIngredientStateDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -67,6 +70,7 @@ func ProvideService(
 		sessionContextDataFetcher:       authservice.FetchContextFromRequest,
 		validIngredientStateDataManager: validIngredientStateDataManager,
 		dataChangesPublisher:            dataChangesPublisher,
+		transactionRunner:               transactionRunner,
 		encoderDecoder:                  encoder,
 		tracer:                          tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 		searchIndex:                     searchIndex,
@@ -0,0 +1,4 @@
+/*
+Package validingredientsubstitutions provides a series of HTTP handlers for managing valid ingredient substitutions in a compatible database.
+*/
+package validingredientsubstitutions
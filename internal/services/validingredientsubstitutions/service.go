@@ -0,0 +1,67 @@
+package validingredientsubstitutions
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/routing"
+	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	serviceName string = "valid_ingredient_substitutions_service"
+)
+
+var _ types.ValidIngredientSubstitutionDataService = (*service)(nil)
+
+type (
+	// service handles valid ingredient substitutions.
+	service struct {
+		logger                                 logging.Logger
+		validIngredientSubstitutionDataManager types.ValidIngredientSubstitutionDataManager
+		validIngredientSubstitutionIDFetcher   func(*http.Request) string
+		validIngredientIDFetcher               func(*http.Request) string
+		sessionContextDataFetcher              func(*http.Request) (*types.SessionContextData, error)
+		dataChangesPublisher                   messagequeue.Publisher
+		transactionRunner                      database.TransactionRunner
+		encoderDecoder                         encoding.ServerEncoderDecoder
+		tracer                                 tracing.Tracer
+	}
+)
+
+// ProvideService builds a new ValidIngredientSubstitutionsService.
+func ProvideService(
+	logger logging.Logger,
+	cfg *Config,
+	validIngredientSubstitutionDataManager types.ValidIngredientSubstitutionDataManager,
+	transactionRunner database.TransactionRunner,
+	encoder encoding.ServerEncoderDecoder,
+	routeParamManager routing.RouteParamManager,
+	publisherProvider messagequeue.PublisherProvider,
+	tracerProvider tracing.TracerProvider,
+) (types.ValidIngredientSubstitutionDataService, error) {
+	dataChangesPublisher, err := publisherProvider.ProvidePublisher(cfg.DataChangesTopicName)
+	if err != nil {
+		return nil, fmt.Errorf("setting up valid ingredient substitutions service data changes publisher: %w", err)
+	}
+
+	svc := &service{
+		logger:                                 logging.EnsureLogger(logger).WithName(serviceName),
+		validIngredientSubstitutionIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(ValidIngredientSubstitutionIDURIParamKey),
+		validIngredientIDFetcher:               routeParamManager.BuildRouteParamStringIDFetcher(ValidIngredientIDURIParamKey),
+		sessionContextDataFetcher:              authservice.FetchContextFromRequest,
+		validIngredientSubstitutionDataManager: validIngredientSubstitutionDataManager,
+		dataChangesPublisher:                   dataChangesPublisher,
+		transactionRunner:                      transactionRunner,
+		encoderDecoder:                         encoder,
+		tracer:                                 tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
+	}
+
+	return svc, nil
+}
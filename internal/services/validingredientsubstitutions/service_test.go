@@ -0,0 +1,98 @@
+package validingredientsubstitutions
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	mockrouting "github.com/dinnerdonebetter/backend/internal/routing/mock"
+	mocktypes "github.com/dinnerdonebetter/backend/pkg/types/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func buildTestService() *service {
+	return &service{
+		transactionRunner:                      database.NewNoopTransactionRunner(),
+		logger:                                 logging.NewNoopLogger(),
+		validIngredientSubstitutionDataManager: &mocktypes.ValidIngredientSubstitutionDataManagerMock{},
+		validIngredientSubstitutionIDFetcher:   func(req *http.Request) string { return "" },
+		encoderDecoder:                         encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
+		tracer:                                 tracing.NewTracerForTest("test"),
+	}
+}
+
+func TestProvideValidIngredientSubstitutionsService(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		rpm := mockrouting.NewRouteParamManager()
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			ValidIngredientSubstitutionIDURIParamKey,
+		).Return(func(*http.Request) string { return "" })
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			ValidIngredientIDURIParamKey,
+		).Return(func(*http.Request) string { return "" })
+
+		cfg := &Config{
+			DataChangesTopicName: "data_changes",
+		}
+
+		pp := &mockpublishers.ProducerProvider{}
+		pp.On("ProvidePublisher", cfg.DataChangesTopicName).Return(&mockpublishers.Publisher{}, nil)
+
+		s, err := ProvideService(
+			logging.NewNoopLogger(),
+			cfg,
+			&mocktypes.ValidIngredientSubstitutionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
+			mockencoding.NewMockEncoderDecoder(),
+			rpm,
+			pp,
+			tracing.NewNoopTracerProvider(),
+		)
+
+		assert.NotNil(t, s)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, rpm, pp)
+	})
+
+	T.Run("with error providing data changes producer", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			DataChangesTopicName: "data_changes",
+		}
+
+		pp := &mockpublishers.ProducerProvider{}
+		pp.On("ProvidePublisher", cfg.DataChangesTopicName).Return((*mockpublishers.Publisher)(nil), errors.New("blah"))
+
+		s, err := ProvideService(
+			logging.NewNoopLogger(),
+			cfg,
+			&mocktypes.ValidIngredientSubstitutionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
+			mockencoding.NewMockEncoderDecoder(),
+			nil,
+			pp,
+			tracing.NewNoopTracerProvider(),
+		)
+
+		assert.Nil(t, s)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, pp)
+	})
+}
@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,6 +22,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:          database.NewNoopTransactionRunner(),
 		logger:                     logging.NewNoopLogger(),
 		validInstrumentDataManager: &mocktypes.ValidInstrumentDataManagerMock{},
 		validInstrumentIDFetcher:   func(req *http.Request) string { return "" },
@@ -60,6 +62,7 @@ func TestProvideValidInstrumentsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.ValidInstrumentDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -91,6 +94,7 @@ func TestProvideValidInstrumentsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.ValidInstrumentDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -1,6 +1,7 @@
 package validmeasurementunitconversions
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
@@ -72,8 +73,20 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	tracing.AttachToSpan(span, keys.ValidMeasurementUnitConversionIDKey, input.ID)
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	validMeasurementUnitConversion, err := s.validMeasurementUnitConversionDataManager.CreateValidMeasurementUnitConversion(ctx, input)
-	if err != nil {
+	var validMeasurementUnitConversion *types.ValidMeasurementUnitConversion
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if validMeasurementUnitConversion, err = s.validMeasurementUnitConversionDataManager.CreateValidMeasurementUnitConversion(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                      types.ValidMeasurementUnitConversionCreatedCustomerEventType,
+			ValidMeasurementUnitConversion: validMeasurementUnitConversion,
+			UserID:                         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating valid measurement conversions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -81,16 +94,6 @@ func (s *service) CreateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                      types.ValidMeasurementUnitConversionCreatedCustomerEventType,
-		ValidMeasurementUnitConversion: validMeasurementUnitConversion,
-		UserID:                         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing to data changes topic")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidMeasurementUnitConversion]{
 		Details: responseDetails,
 		Data:    validMeasurementUnitConversion,
@@ -220,7 +223,19 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	validMeasurementUnitConversion.Update(input)
 
 	updateTimer := timing.NewMetric("database").WithDesc("update").Start()
-	if err = s.validMeasurementUnitConversionDataManager.UpdateValidMeasurementUnitConversion(ctx, validMeasurementUnitConversion); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.validMeasurementUnitConversionDataManager.UpdateValidMeasurementUnitConversion(ctx, validMeasurementUnitConversion); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:                      types.ValidMeasurementUnitConversionUpdatedCustomerEventType,
+			ValidMeasurementUnitConversion: validMeasurementUnitConversion,
+			UserID:                         sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "updating valid measurement conversions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -228,16 +243,6 @@ func (s *service) UpdateHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	updateTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:                      types.ValidMeasurementUnitConversionUpdatedCustomerEventType,
-		ValidMeasurementUnitConversion: validMeasurementUnitConversion,
-		UserID:                         sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidMeasurementUnitConversion]{
 		Details: responseDetails,
 		Data:    validMeasurementUnitConversion,
@@ -295,7 +300,18 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.validMeasurementUnitConversionDataManager.ArchiveValidMeasurementUnitConversion(ctx, validMeasurementUnitConversionID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.validMeasurementUnitConversionDataManager.ArchiveValidMeasurementUnitConversion(ctx, validMeasurementUnitConversionID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType: types.ValidMeasurementUnitConversionArchivedCustomerEventType,
+			UserID:    sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving valid measurement conversions")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -303,15 +319,6 @@ func (s *service) ArchiveHandler(res http.ResponseWriter, req *http.Request) {
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType: types.ValidMeasurementUnitConversionArchivedCustomerEventType,
-		UserID:    sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.ValidMeasurementUnitConversion]{
 		Details: responseDetails,
 	}
@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -20,7 +21,8 @@
 
 func buildTestService() *service {
 	return &service{
-		logger: logging.NewNoopLogger(),
+		transactionRunner: database.NewNoopTransactionRunner(),
+		logger:            logging.NewNoopLogger(),
 		validMeasurementUnitConversionDataManager: &mocktypes.ValidMeasurementUnitConversionDataManagerMock{},
 		validMeasurementUnitConversionIDFetcher:   func(req *http.Request) string { return "" },
 		validMeasurementUnitIDFetcher:             func(req *http.Request) string { return "" },
@@ -60,6 +62,7 @@ func TestProvideValidMeasurementUnitConversionsService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.ValidMeasurementUnitConversionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -90,6 +93,7 @@ func TestProvideValidMeasurementUnitConversionsService(T *testing.T) {
 			logger,
 			&cfg,
 			&mocktypes.ValidMeasurementUnitConversionDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
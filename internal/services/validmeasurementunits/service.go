@@ -5,6 +5,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
@@ -33,6 +34,7 @@
 		validIngredientIDFetcher        func(*http.Request) string
 		sessionContextDataFetcher       func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher            messagequeue.Publisher
+		transactionRunner               database.TransactionRunner
 		encoderDecoder                  encoding.ServerEncoderDecoder
 		tracer                          tracing.Tracer
 		searchIndex                     search.IndexSearcher[types.ValidMeasurementUnitSearchSubset]
@@ -46,6 +48,7 @@ func ProvideService(
 	cfg *Config,
 	searchConfig *searchcfg.Config,
 	validMeasurementUnitDataManager types.ValidMeasurementUnitDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -69,6 +72,7 @@ func ProvideService(
 		sessionContextDataFetcher:       authservice.FetchContextFromRequest,
 		validMeasurementUnitDataManager: validMeasurementUnitDataManager,
 		dataChangesPublisher:            dataChangesPublisher,
+		transactionRunner:               transactionRunner,
 		encoderDecoder:                  encoder,
 		tracer:                          tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 		searchIndex:                     searchIndex,
@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,6 +22,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:               database.NewNoopTransactionRunner(),
 		logger:                          logging.NewNoopLogger(),
 		validMeasurementUnitDataManager: &mocktypes.ValidMeasurementUnitDataManagerMock{},
 		validMeasurementUnitIDFetcher:   func(req *http.Request) string { return "" },
@@ -64,6 +66,7 @@ func TestProvideValidMeasurementUnitsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.ValidMeasurementUnitDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -95,6 +98,7 @@ func TestProvideValidMeasurementUnitsService(T *testing.T) {
 			cfg,
 			&searchcfg.Config{},
 			&mocktypes.ValidMeasurementUnitDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
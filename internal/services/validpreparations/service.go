@@ -5,6 +5,7 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
@@ -32,6 +33,7 @@
 		validPreparationIDFetcher   func(*http.Request) string
 		sessionContextDataFetcher   func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher        messagequeue.Publisher
+		transactionRunner           database.TransactionRunner
 		encoderDecoder              encoding.ServerEncoderDecoder
 		tracer                      tracing.Tracer
 		searchIndex                 search.IndexSearcher[types.ValidPreparationSearchSubset]
@@ -45,6 +47,7 @@ func ProvideService(
 	cfg *Config,
 	searchConfig *searchcfg.Config,
 	validPreparationDataManager types.ValidPreparationDataManager,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -66,6 +69,7 @@ func ProvideService(
 		sessionContextDataFetcher:   authservice.FetchContextFromRequest,
 		validPreparationDataManager: validPreparationDataManager,
 		dataChangesPublisher:        dataChangesPublisher,
+		transactionRunner:           transactionRunner,
 		cfg:                         cfg,
 		encoderDecoder:              encoder,
 		tracer:                      tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
@@ -6,6 +6,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -21,7 +22,8 @@
 
 func buildTestService() *service {
 	return &service{
-		logger: logging.NewNoopLogger(),
+		transactionRunner: database.NewNoopTransactionRunner(),
+		logger:            logging.NewNoopLogger(),
 		cfg: &Config{
 			UseSearchService: false,
 		},
@@ -60,6 +62,7 @@ func TestProvideValidPreparationsService(T *testing.T) {
 			&cfg,
 			&searchcfg.Config{Provider: ""},
 			&mocktypes.ValidPreparationDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -91,6 +94,7 @@ func TestProvideValidPreparationsService(T *testing.T) {
 			&cfg,
 			&searchcfg.Config{Provider: ""},
 			&mocktypes.ValidPreparationDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
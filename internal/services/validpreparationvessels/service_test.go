@@ -5,6 +5,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
@@ -19,6 +20,7 @@
 
 func buildTestService() *service {
 	return &service{
+		transactionRunner:                 database.NewNoopTransactionRunner(),
 		logger:                            logging.NewNoopLogger(),
 		validPreparationVesselDataManager: &mocktypes.ValidPreparationVesselDataManagerMock{},
 		validPreparationVesselIDFetcher:   func(req *http.Request) string { return "" },
@@ -58,6 +60,7 @@ func TestProvideValidPreparationVesselsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.ValidPreparationVesselDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -84,6 +87,7 @@ func TestProvideValidPreparationVesselsService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.ValidPreparationVesselDataManagerMock{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
@@ -3,6 +3,8 @@
 import (
 	"context"
 
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -10,13 +12,16 @@
 type Config struct {
 	_ struct{} `json:"-"`
 
-	DataChangesTopicName string `json:"dataChangesTopicName,omitempty" toml:"data_changes_topic_name,omitempty"`
-	Debug                bool   `json:"debug"                          toml:"debug,omitempty"`
+	DataChangesTopicName string                 `json:"dataChangesTopicName,omitempty" toml:"data_changes_topic_name,omitempty"`
+	Delivery             webhookdelivery.Config `json:"delivery"                       toml:"delivery,omitempty"`
+	Debug                bool                   `json:"debug"                          toml:"debug,omitempty"`
 }
 
 var _ validation.ValidatableWithContext = (*Config)(nil)
 
 // ValidateWithContext validates a Config struct.
 func (cfg Config) ValidateWithContext(ctx context.Context) error {
-	return validation.ValidateStructWithContext(ctx, &cfg)
+	return validation.ValidateStructWithContext(ctx, &cfg,
+		validation.Field(&cfg.Delivery),
+	)
 }
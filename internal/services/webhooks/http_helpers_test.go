@@ -25,6 +25,7 @@ type webhooksServiceHTTPRoutesTestHelper struct {
 	exampleHousehold                 *types.Household
 	exampleWebhook                   *types.Webhook
 	exampleWebhookTriggerEvent       *types.WebhookTriggerEvent
+	exampleWebhookDelivery           *types.WebhookDelivery
 	exampleCreationInput             *types.WebhookCreationRequestInput
 	exampleTriggerEventCreationInput *types.WebhookTriggerEventCreationRequestInput
 }
@@ -43,6 +44,8 @@ func newTestHelper(t *testing.T) *webhooksServiceHTTPRoutesTestHelper {
 	helper.exampleWebhook.BelongsToHousehold = helper.exampleHousehold.ID
 	helper.exampleWebhookTriggerEvent = fakes.BuildFakeWebhookTriggerEvent()
 	helper.exampleWebhookTriggerEvent.BelongsToWebhook = helper.exampleWebhook.ID
+	helper.exampleWebhookDelivery = fakes.BuildFakeWebhookDelivery()
+	helper.exampleWebhookDelivery.BelongsToWebhook = helper.exampleWebhook.ID
 	helper.exampleCreationInput = converters.ConvertWebhookToWebhookCreationRequestInput(helper.exampleWebhook)
 	helper.exampleTriggerEventCreationInput = converters.ConvertWebhookTriggerEventToWebhookTriggerEventCreationRequestInput(fakes.BuildFakeWebhookTriggerEvent())
 
@@ -54,6 +57,10 @@ func newTestHelper(t *testing.T) *webhooksServiceHTTPRoutesTestHelper {
 		return helper.exampleWebhookTriggerEvent.ID
 	}
 
+	helper.service.webhookDeliveryIDFetcher = func(*http.Request) string {
+		return helper.exampleWebhookDelivery.ID
+	}
+
 	sessionCtxData := &types.SessionContextData{
 		Requester: types.RequesterInfo{
 			UserID:                   helper.exampleUser.ID,
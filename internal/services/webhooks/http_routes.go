@@ -1,16 +1,19 @@
 package webhooks
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
 	"github.com/dinnerdonebetter/backend/internal/identifiers"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 
 	servertiming "github.com/mitchellh/go-server-timing"
 )
@@ -20,6 +23,8 @@
 	WebhookIDURIParamKey = "webhookID"
 	// WebhookTriggerEventIDURIParamKey is a standard string that we'll use to refer to webhook trigger event IDs with.
 	WebhookTriggerEventIDURIParamKey = "webhookTriggerEventID"
+	// WebhookDeliveryIDURIParamKey is a standard string that we'll use to refer to webhook delivery IDs with.
+	WebhookDeliveryIDURIParamKey = "webhookDeliveryID"
 )
 
 var (
@@ -75,9 +80,21 @@ func (s *service) CreateWebhookHandler(res http.ResponseWriter, req *http.Reques
 	input.BelongsToHousehold = sessionCtxData.ActiveHouseholdID
 
 	createTimer := timing.NewMetric("database").WithDesc("create").Start()
-	webhook, err := s.webhookDataManager.CreateWebhook(ctx, input)
-	logger.Debug("database call executed")
-	if err != nil {
+	var webhook *types.Webhook
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if webhook, err = s.webhookDataManager.CreateWebhook(ctx, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.WebhookCreatedCustomerEventType,
+			Webhook:     webhook,
+			HouseholdID: sessionCtxData.ActiveHouseholdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "creating webhook in database")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -85,17 +102,6 @@ func (s *service) CreateWebhookHandler(res http.ResponseWriter, req *http.Reques
 	}
 	createTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.WebhookCreatedCustomerEventType,
-		Webhook:     webhook,
-		HouseholdID: sessionCtxData.ActiveHouseholdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.Webhook]{
 		Details: responseDetails,
 		Data:    webhook,
@@ -275,7 +281,19 @@ func (s *service) ArchiveWebhookHandler(res http.ResponseWriter, req *http.Reque
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.webhookDataManager.ArchiveWebhook(ctx, webhookID, householdID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.webhookDataManager.ArchiveWebhook(ctx, webhookID, householdID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.WebhookTriggerEventArchivedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving webhook in database")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -283,16 +301,6 @@ func (s *service) ArchiveWebhookHandler(res http.ResponseWriter, req *http.Reque
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.WebhookTriggerEventArchivedCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.Webhook]{
 		Details: responseDetails,
 	}
@@ -369,8 +377,20 @@ func (s *service) AddWebhookTriggerEventHandler(res http.ResponseWriter, req *ht
 	input := converters.ConvertWebhookTriggerEventCreationRequestInputToWebhookTriggerEventDatabaseCreationInput(providedInput)
 
 	archiveTimer := timing.NewMetric("database").WithDesc("add").Start()
-	event, err := s.webhookDataManager.AddWebhookTriggerEvent(ctx, householdID, input)
-	if err != nil {
+	var event *types.WebhookTriggerEvent
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if event, err = s.webhookDataManager.AddWebhookTriggerEvent(ctx, householdID, input); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.WebhookTriggerEventCreatedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving webhook trigger event in database")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -378,16 +398,6 @@ func (s *service) AddWebhookTriggerEventHandler(res http.ResponseWriter, req *ht
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.WebhookTriggerEventCreatedCustomerEventType,
-		HouseholdID: householdID,
-		UserID:      sessionCtxData.Requester.UserID,
-	}
-
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
-	}
-
 	responseValue := &types.APIResponse[*types.WebhookTriggerEvent]{
 		Data:    event,
 		Details: responseDetails,
@@ -452,7 +462,19 @@ func (s *service) ArchiveWebhookTriggerEventHandler(res http.ResponseWriter, req
 	existenceTimer.Stop()
 
 	archiveTimer := timing.NewMetric("database").WithDesc("archive").Start()
-	if err = s.webhookDataManager.ArchiveWebhookTriggerEvent(ctx, webhookID, webhookTriggerEventID); err != nil {
+	if err = s.transactionRunner.RunInTransaction(ctx, func(ctx context.Context) error {
+		if err = s.webhookDataManager.ArchiveWebhookTriggerEvent(ctx, webhookID, webhookTriggerEventID); err != nil {
+			return err
+		}
+
+		dcm := &types.DataChangeMessage{
+			EventType:   types.WebhookArchivedCustomerEventType,
+			HouseholdID: householdID,
+			UserID:      sessionCtxData.Requester.UserID,
+		}
+
+		return s.dataChangesPublisher.Publish(ctx, dcm)
+	}); err != nil {
 		observability.AcknowledgeError(err, logger, span, "archiving webhook trigger event in database")
 		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
 		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
@@ -460,20 +482,356 @@ func (s *service) ArchiveWebhookTriggerEventHandler(res http.ResponseWriter, req
 	}
 	archiveTimer.Stop()
 
-	dcm := &types.DataChangeMessage{
-		EventType:   types.WebhookArchivedCustomerEventType,
-		HouseholdID: householdID,
+	responseValue := &types.APIResponse[*types.Webhook]{
+		Details: responseDetails,
+	}
+
+	// let everybody go home.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ListWebhookDeliveriesHandler is our list route for a webhook's delivery log.
+func (s *service) ListWebhookDeliveriesHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	filter := types.ExtractQueryFilterFromRequest(req)
+	logger := filter.AttachToLogger(s.logger)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	tracing.AttachRequestToSpan(span, req)
+	tracing.AttachFilterDataToSpan(span, filter.Page, filter.Limit, filter.SortBy)
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine relevant webhook ID.
+	webhookID := s.webhookIDFetcher(req)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	// ensure the webhook belongs to the household.
+	existenceTimer := timing.NewMetric("database").WithDesc("existence check").Start()
+	exists, err := s.webhookDataManager.WebhookExists(ctx, webhookID, sessionCtxData.ActiveHouseholdID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		observability.AcknowledgeError(err, logger, span, "checking webhook existence")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	} else if !exists || errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	}
+	existenceTimer.Stop()
+
+	// find the deliveries.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	deliveries, err := s.webhookDeliveryDataManager.GetWebhookDeliveries(ctx, webhookID, filter)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			deliveries = &types.QueryFilteredResult[types.WebhookDelivery]{
+				Data: []*types.WebhookDelivery{},
+			}
+		} else {
+			observability.AcknowledgeError(err, logger, span, "fetching webhook deliveries")
+			errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+			return
+		}
+	}
+	readTimer.Stop()
+
+	responseValue := &types.APIResponse[[]*types.WebhookDelivery]{
+		Details:    responseDetails,
+		Pagination: &deliveries.Pagination,
+		Data:       deliveries.Data,
+	}
+
+	// encode the response.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// RedeliverWebhookDeliveryHandler resends a previously recorded delivery's payload to its webhook.
+func (s *service) RedeliverWebhookDeliveryHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine relevant webhook ID.
+	webhookID := s.webhookIDFetcher(req)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	// determine relevant webhook delivery ID.
+	webhookDeliveryID := s.webhookDeliveryIDFetcher(req)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, webhookDeliveryID)
+	logger = logger.WithValue(keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	webhook, err := s.webhookDataManager.GetWebhook(ctx, webhookID, sessionCtxData.ActiveHouseholdID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+			return
+		}
+		observability.AcknowledgeError(err, logger, span, "fetching webhook from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	delivery, err := s.webhookDeliveryDataManager.GetWebhookDelivery(ctx, webhook.ID, webhookDeliveryID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+			return
+		}
+		observability.AcknowledgeError(err, logger, span, "fetching webhook delivery from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	household, err := s.householdDataManager.GetHousehold(ctx, sessionCtxData.ActiveHouseholdID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching household from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	// a failed redelivery is still recorded, so we hand it back rather than erroring.
+	redeliveryTimer := timing.NewMetric("redelivery").WithDesc("redeliver webhook payload").Start()
+	redelivery, err := s.deliverer.Redeliver(ctx, household, webhook, delivery)
+	if err != nil && !errors.Is(err, webhookdelivery.ErrDeliveryFailed) {
+		observability.AcknowledgeError(err, logger, span, "redelivering webhook payload")
+		errRes := types.NewAPIErrorResponse("redelivery error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	redeliveryTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.WebhookDelivery]{
+		Details: responseDetails,
+		Data:    redelivery,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
+// PingWebhookHandler sends a synthetic event to a webhook, and returns the recorded delivery attempt.
+func (s *service) PingWebhookHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine relevant webhook ID.
+	webhookID := s.webhookIDFetcher(req)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	webhook, err := s.webhookDataManager.GetWebhook(ctx, webhookID, sessionCtxData.ActiveHouseholdID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+			return
+		}
+		observability.AcknowledgeError(err, logger, span, "fetching webhook from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	household, err := s.householdDataManager.GetHousehold(ctx, sessionCtxData.ActiveHouseholdID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching household from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	payload := &types.DataChangeMessage{
+		EventType:   types.WebhookPingCustomerEventType,
+		Webhook:     webhook,
+		HouseholdID: household.ID,
 		UserID:      sessionCtxData.Requester.UserID,
 	}
 
-	if err = s.dataChangesPublisher.Publish(ctx, dcm); err != nil {
-		observability.AcknowledgeError(err, logger, span, "publishing data change message")
+	// a failed ping is still recorded, and its response is what the caller wants to see, so we hand it back rather than erroring.
+	pingTimer := timing.NewMetric("ping").WithDesc("ping webhook").Start()
+	delivery, err := s.deliverer.Ping(ctx, household, webhook, payload)
+	if err != nil && !errors.Is(err, webhookdelivery.ErrDeliveryFailed) {
+		observability.AcknowledgeError(err, logger, span, "pinging webhook")
+		errRes := types.NewAPIErrorResponse("ping error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	pingTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.WebhookDelivery]{
+		Details: responseDetails,
+		Data:    delivery,
 	}
 
-	responseValue := &types.APIResponse[*types.Webhook]{
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusCreated)
+}
+
+// PreviewWebhookHandler renders the request a webhook would receive for a given trigger event, without sending it.
+func (s *service) PreviewWebhookHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// read parsed input struct from request body.
+	input := new(types.WebhookPreviewRequestInput)
+	if err = s.encoderDecoder.DecodeRequest(ctx, req, input); err != nil {
+		observability.AcknowledgeError(err, logger, span, "decoding request body")
+		errRes := types.NewAPIErrorResponse("invalid request content", types.ErrDecodingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	if err = input.ValidateWithContext(ctx); err != nil {
+		logger.WithValue(keys.ValidationErrorKey, err).Debug("provided input was invalid")
+		errRes := types.NewAPIErrorResponse(err.Error(), types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusBadRequest)
+		return
+	}
+
+	// determine relevant webhook ID.
+	webhookID := s.webhookIDFetcher(req)
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	webhook, err := s.webhookDataManager.GetWebhook(ctx, webhookID, sessionCtxData.ActiveHouseholdID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+			s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+			return
+		}
+		observability.AcknowledgeError(err, logger, span, "fetching webhook from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	household, err := s.householdDataManager.GetHousehold(ctx, sessionCtxData.ActiveHouseholdID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching household from database")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	// there's no real change to preview, so the payload carries a made-up version of what the trigger event's changes carry.
+	payload := fakes.BuildFakeDataChangeMessageForEvent(types.ServiceEventType(input.TriggerEvent))
+	payload.HouseholdID = household.ID
+	payload.UserID = sessionCtxData.Requester.UserID
+
+	preview, err := s.deliverer.Preview(ctx, household, webhook, payload)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "previewing webhook request")
+		errRes := types.NewAPIErrorResponse("preview error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	responseValue := &types.APIResponse[*types.WebhookPreview]{
 		Details: responseDetails,
+		Data:    preview,
 	}
 
-	// let everybody go home.
 	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
 }
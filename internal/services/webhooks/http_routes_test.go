@@ -9,6 +9,7 @@
 
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -192,11 +193,11 @@ func TestWebhooksService_CreateWebhookHandler(T *testing.T) {
 
 		helper.service.CreateWebhookHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Webhook]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.Equal(t, actual.Data, helper.exampleWebhook)
-		assert.NoError(t, actual.Error.AsError())
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dbManager, dataChangesPublisher)
 	})
@@ -562,10 +563,10 @@ func TestWebhooksService_ArchiveWebhookHandler(T *testing.T) {
 
 		helper.service.ArchiveWebhookHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Webhook]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dataManager, dataChangesPublisher)
 	})
@@ -821,10 +822,10 @@ func TestWebhooksService_AddWebhookTriggerEventHandler(T *testing.T) {
 
 		helper.service.AddWebhookTriggerEventHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.WebhookTriggerEvent]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dataManager, dataChangesPublisher)
 	})
@@ -1000,11 +1001,759 @@ func TestWebhooksService_ArchiveWebhookTriggerEventHandler(T *testing.T) {
 
 		helper.service.ArchiveWebhookTriggerEventHandler(helper.res, helper.req)
 
-		assert.Equal(t, http.StatusOK, helper.res.Code)
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
 		var actual *types.APIResponse[*types.Webhook]
 		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
-		assert.NoError(t, actual.Error.AsError())
+		assert.Error(t, actual.Error)
 
 		mock.AssertExpectationsForObjects(t, dataManager, dataChangesPublisher)
 	})
 }
+
+func TestWebhooksService_ListWebhookDeliveriesHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		exampleWebhookDeliveryList := fakes.BuildFakeWebhookDeliveryList()
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"WebhookExists",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(true, nil)
+		helper.service.webhookDataManager = wd
+
+		wdd := &mocktypes.WebhookDeliveryDataManagerMock{}
+		wdd.On(
+			"GetWebhookDeliveries",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			mock.IsType(&types.QueryFilter{}),
+		).Return(exampleWebhookDeliveryList, nil)
+		helper.service.webhookDeliveryDataManager = wdd
+
+		helper.service.ListWebhookDeliveriesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, exampleWebhookDeliveryList.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, wd, wdd)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ListWebhookDeliveriesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[[]*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with nonexistent webhook", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"WebhookExists",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(false, nil)
+		helper.service.webhookDataManager = wd
+
+		helper.service.ListWebhookDeliveriesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[[]*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd)
+	})
+
+	T.Run("with error checking webhook existence", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"WebhookExists",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(false, errors.New("blah"))
+		helper.service.webhookDataManager = wd
+
+		helper.service.ListWebhookDeliveriesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[[]*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd)
+	})
+
+	T.Run("with no rows returned", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"WebhookExists",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(true, nil)
+		helper.service.webhookDataManager = wd
+
+		wdd := &mocktypes.WebhookDeliveryDataManagerMock{}
+		wdd.On(
+			"GetWebhookDeliveries",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			mock.IsType(&types.QueryFilter{}),
+		).Return((*types.QueryFilteredResult[types.WebhookDelivery])(nil), sql.ErrNoRows)
+		helper.service.webhookDeliveryDataManager = wdd
+
+		helper.service.ListWebhookDeliveriesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, wd, wdd)
+	})
+
+	T.Run("with error fetching webhook deliveries from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"WebhookExists",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(true, nil)
+		helper.service.webhookDataManager = wd
+
+		wdd := &mocktypes.WebhookDeliveryDataManagerMock{}
+		wdd.On(
+			"GetWebhookDeliveries",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			mock.IsType(&types.QueryFilter{}),
+		).Return((*types.QueryFilteredResult[types.WebhookDelivery])(nil), errors.New("blah"))
+		helper.service.webhookDeliveryDataManager = wdd
+
+		helper.service.ListWebhookDeliveriesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[[]*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, wdd)
+	})
+}
+
+func TestWebhooksService_RedeliverWebhookDeliveryHandler(T *testing.T) {
+	T.Parallel()
+
+	setupMocks := func(helper *webhooksServiceHTTPRoutesTestHelper, redelivery *types.WebhookDelivery, redeliveryErr error) (*mocktypes.WebhookDataManagerMock, *mocktypes.WebhookDeliveryDataManagerMock, *mocktypes.HouseholdDataManagerMock, *webhookdelivery.MockDeliverer) {
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		wdd := &mocktypes.WebhookDeliveryDataManagerMock{}
+		wdd.On(
+			"GetWebhookDelivery",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleWebhookDelivery.ID,
+		).Return(helper.exampleWebhookDelivery, nil)
+		helper.service.webhookDeliveryDataManager = wdd
+
+		hdm := &mocktypes.HouseholdDataManagerMock{}
+		hdm.On(
+			"GetHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = hdm
+
+		md := &webhookdelivery.MockDeliverer{}
+		md.On(
+			"Redeliver",
+			testutils.ContextMatcher,
+			helper.exampleHousehold,
+			helper.exampleWebhook,
+			helper.exampleWebhookDelivery,
+		).Return(redelivery, redeliveryErr)
+		helper.service.deliverer = md
+
+		return wd, wdd, hdm, md
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		exampleRedelivery := fakes.BuildFakeWebhookDelivery()
+		exampleRedelivery.BelongsToWebhook = helper.exampleWebhook.ID
+		wd, wdd, hdm, md := setupMocks(helper, exampleRedelivery, nil)
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleRedelivery, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, wd, wdd, hdm, md)
+	})
+
+	T.Run("with failed redelivery", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		exampleRedelivery := fakes.BuildFakeWebhookDelivery()
+		exampleRedelivery.BelongsToWebhook = helper.exampleWebhook.ID
+		exampleRedelivery.Succeeded = false
+		wd, wdd, hdm, md := setupMocks(helper, exampleRedelivery, webhookdelivery.ErrDeliveryFailed)
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleRedelivery, actual.Data)
+		assert.False(t, actual.Data.Succeeded)
+
+		mock.AssertExpectationsForObjects(t, wd, wdd, hdm, md)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such webhook in database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.Webhook)(nil), sql.ErrNoRows)
+		helper.service.webhookDataManager = wd
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd)
+	})
+
+	T.Run("with no such webhook delivery in database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		wdd := &mocktypes.WebhookDeliveryDataManagerMock{}
+		wdd.On(
+			"GetWebhookDelivery",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleWebhookDelivery.ID,
+		).Return((*types.WebhookDelivery)(nil), sql.ErrNoRows)
+		helper.service.webhookDeliveryDataManager = wdd
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, wdd)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		wdd := &mocktypes.WebhookDeliveryDataManagerMock{}
+		wdd.On(
+			"GetWebhookDelivery",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleWebhookDelivery.ID,
+		).Return(helper.exampleWebhookDelivery, nil)
+		helper.service.webhookDeliveryDataManager = wdd
+
+		hdm := &mocktypes.HouseholdDataManagerMock{}
+		hdm.On(
+			"GetHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+		).Return((*types.Household)(nil), errors.New("blah"))
+		helper.service.householdDataManager = hdm
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, wdd, hdm)
+	})
+
+	T.Run("with error redelivering", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd, wdd, hdm, md := setupMocks(helper, (*types.WebhookDelivery)(nil), errors.New("blah"))
+
+		helper.service.RedeliverWebhookDeliveryHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, wdd, hdm, md)
+	})
+}
+
+func TestWebhooksService_PingWebhookHandler(T *testing.T) {
+	T.Parallel()
+
+	pingPayloadMatcher := func(helper *webhooksServiceHTTPRoutesTestHelper) any {
+		return mock.MatchedBy(func(message *types.DataChangeMessage) bool {
+			return message.EventType == types.WebhookPingCustomerEventType &&
+				message.HouseholdID == helper.exampleHousehold.ID &&
+				message.UserID == helper.exampleUser.ID
+		})
+	}
+
+	setupMocks := func(helper *webhooksServiceHTTPRoutesTestHelper, delivery *types.WebhookDelivery, pingErr error) (*mocktypes.WebhookDataManagerMock, *mocktypes.HouseholdDataManagerMock, *webhookdelivery.MockDeliverer) {
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		hdm := &mocktypes.HouseholdDataManagerMock{}
+		hdm.On(
+			"GetHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = hdm
+
+		md := &webhookdelivery.MockDeliverer{}
+		md.On(
+			"Ping",
+			testutils.ContextMatcher,
+			helper.exampleHousehold,
+			helper.exampleWebhook,
+			pingPayloadMatcher(helper),
+		).Return(delivery, pingErr)
+		helper.service.deliverer = md
+
+		return wd, hdm, md
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		exampleDelivery := fakes.BuildFakeWebhookDelivery()
+		exampleDelivery.BelongsToWebhook = helper.exampleWebhook.ID
+		exampleDelivery.TriggerEvent = string(types.WebhookPingCustomerEventType)
+		wd, hdm, md := setupMocks(helper, exampleDelivery, nil)
+
+		helper.service.PingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleDelivery, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, wd, hdm, md)
+	})
+
+	T.Run("with failed ping", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		exampleDelivery := fakes.BuildFakeWebhookDelivery()
+		exampleDelivery.BelongsToWebhook = helper.exampleWebhook.ID
+		exampleDelivery.Succeeded = false
+		wd, hdm, md := setupMocks(helper, exampleDelivery, webhookdelivery.ErrDeliveryFailed)
+
+		helper.service.PingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusCreated, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, exampleDelivery, actual.Data)
+		assert.False(t, actual.Data.Succeeded)
+
+		mock.AssertExpectationsForObjects(t, wd, hdm, md)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.PingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such webhook in database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.Webhook)(nil), sql.ErrNoRows)
+		helper.service.webhookDataManager = wd
+
+		helper.service.PingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		hdm := &mocktypes.HouseholdDataManagerMock{}
+		hdm.On(
+			"GetHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+		).Return((*types.Household)(nil), errors.New("blah"))
+		helper.service.householdDataManager = hdm
+
+		helper.service.PingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, hdm)
+	})
+
+	T.Run("with error pinging", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		wd, hdm, md := setupMocks(helper, (*types.WebhookDelivery)(nil), errors.New("blah"))
+
+		helper.service.PingWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookDelivery]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, hdm, md)
+	})
+}
+
+func TestWebhooksService_PreviewWebhookHandler(T *testing.T) {
+	T.Parallel()
+
+	setRequestBody := func(t *testing.T, helper *webhooksServiceHTTPRoutesTestHelper, input *types.WebhookPreviewRequestInput) {
+		t.Helper()
+
+		jsonBytes := helper.service.encoderDecoder.MustEncode(helper.ctx, input)
+
+		var err error
+		helper.req, err = http.NewRequestWithContext(helper.ctx, http.MethodPost, "https://whatever.whocares.gov", bytes.NewReader(jsonBytes))
+		require.NoError(t, err)
+		require.NotNil(t, helper.req)
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		exampleInput := fakes.BuildFakeWebhookPreviewRequestInput()
+		setRequestBody(t, helper, exampleInput)
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		hdm := &mocktypes.HouseholdDataManagerMock{}
+		hdm.On(
+			"GetHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = hdm
+
+		examplePreview := fakes.BuildFakeWebhookPreview()
+		md := &webhookdelivery.MockDeliverer{}
+		md.On(
+			"Preview",
+			testutils.ContextMatcher,
+			helper.exampleHousehold,
+			helper.exampleWebhook,
+			mock.MatchedBy(func(message *types.DataChangeMessage) bool {
+				return string(message.EventType) == exampleInput.TriggerEvent &&
+					message.Webhook != nil &&
+					message.HouseholdID == helper.exampleHousehold.ID
+			}),
+		).Return(examplePreview, nil)
+		helper.service.deliverer = md
+
+		helper.service.PreviewWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookPreview]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, examplePreview, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, wd, hdm, md)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+		setRequestBody(t, helper, fakes.BuildFakeWebhookPreviewRequestInput())
+
+		helper.service.PreviewWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookPreview]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("without input attached", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+
+		helper.service.PreviewWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookPreview]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with invalid input attached", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		setRequestBody(t, helper, &types.WebhookPreviewRequestInput{})
+
+		helper.service.PreviewWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusBadRequest, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookPreview]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such webhook in database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		setRequestBody(t, helper, fakes.BuildFakeWebhookPreviewRequestInput())
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return((*types.Webhook)(nil), sql.ErrNoRows)
+		helper.service.webhookDataManager = wd
+
+		helper.service.PreviewWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookPreview]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd)
+	})
+
+	T.Run("with error previewing", func(t *testing.T) {
+		t.Parallel()
+
+		helper := newTestHelper(t)
+		setRequestBody(t, helper, fakes.BuildFakeWebhookPreviewRequestInput())
+
+		wd := &mocktypes.WebhookDataManagerMock{}
+		wd.On(
+			"GetWebhook",
+			testutils.ContextMatcher,
+			helper.exampleWebhook.ID,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleWebhook, nil)
+		helper.service.webhookDataManager = wd
+
+		hdm := &mocktypes.HouseholdDataManagerMock{}
+		hdm.On(
+			"GetHousehold",
+			testutils.ContextMatcher,
+			helper.exampleHousehold.ID,
+		).Return(helper.exampleHousehold, nil)
+		helper.service.householdDataManager = hdm
+
+		md := &webhookdelivery.MockDeliverer{}
+		md.On(
+			"Preview",
+			testutils.ContextMatcher,
+			helper.exampleHousehold,
+			helper.exampleWebhook,
+			mock.AnythingOfType("*types.DataChangeMessage"),
+		).Return((*types.WebhookPreview)(nil), webhookdelivery.ErrUnsupportedContentType)
+		helper.service.deliverer = md
+
+		helper.service.PreviewWebhookHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.WebhookPreview]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, wd, hdm, md)
+	})
+}
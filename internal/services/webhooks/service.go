@@ -4,7 +4,9 @@
 	"fmt"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -22,11 +24,16 @@
 	service struct {
 		logger                       logging.Logger
 		webhookDataManager           types.WebhookDataManager
+		webhookDeliveryDataManager   types.WebhookDeliveryDataManager
+		householdDataManager         types.HouseholdDataManager
+		deliverer                    webhookdelivery.Deliverer
 		tracer                       tracing.Tracer
 		encoderDecoder               encoding.ServerEncoderDecoder
 		dataChangesPublisher         messagequeue.Publisher
+		transactionRunner            database.TransactionRunner
 		webhookIDFetcher             func(*http.Request) string
 		webhookTriggerEventIDFetcher func(*http.Request) string
+		webhookDeliveryIDFetcher     func(*http.Request) string
 		sessionContextDataFetcher    func(*http.Request) (*types.SessionContextData, error)
 	}
 )
@@ -36,6 +43,10 @@ func ProvideWebhooksService(
 	logger logging.Logger,
 	cfg *Config,
 	webhookDataManager types.WebhookDataManager,
+	webhookDeliveryDataManager types.WebhookDeliveryDataManager,
+	householdDataManager types.HouseholdDataManager,
+	deliverer webhookdelivery.Deliverer,
+	transactionRunner database.TransactionRunner,
 	encoder encoding.ServerEncoderDecoder,
 	routeParamManager routing.RouteParamManager,
 	publisherProvider messagequeue.PublisherProvider,
@@ -49,11 +60,16 @@ func ProvideWebhooksService(
 	s := &service{
 		logger:                       logging.EnsureLogger(logger).WithName(serviceName),
 		webhookDataManager:           webhookDataManager,
+		webhookDeliveryDataManager:   webhookDeliveryDataManager,
+		householdDataManager:         householdDataManager,
+		deliverer:                    deliverer,
 		encoderDecoder:               encoder,
 		dataChangesPublisher:         dataChangesPublisher,
+		transactionRunner:            transactionRunner,
 		sessionContextDataFetcher:    authservice.FetchContextFromRequest,
 		webhookIDFetcher:             routeParamManager.BuildRouteParamStringIDFetcher(WebhookIDURIParamKey),
 		webhookTriggerEventIDFetcher: routeParamManager.BuildRouteParamStringIDFetcher(WebhookTriggerEventIDURIParamKey),
+		webhookDeliveryIDFetcher:     routeParamManager.BuildRouteParamStringIDFetcher(WebhookDeliveryIDURIParamKey),
 		tracer:                       tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(serviceName)),
 	}
 
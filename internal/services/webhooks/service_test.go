@@ -5,8 +5,10 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/encoding/mock"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
@@ -19,11 +21,15 @@
 
 func buildTestService() *service {
 	return &service{
-		logger:             logging.NewNoopLogger(),
-		webhookDataManager: &mocktypes.WebhookDataManagerMock{},
-		webhookIDFetcher:   func(req *http.Request) string { return "" },
-		encoderDecoder:     encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
-		tracer:             tracing.NewTracerForTest("test"),
+		transactionRunner:          database.NewNoopTransactionRunner(),
+		logger:                     logging.NewNoopLogger(),
+		webhookDataManager:         &mocktypes.WebhookDataManagerMock{},
+		webhookDeliveryDataManager: &mocktypes.WebhookDeliveryDataManagerMock{},
+		householdDataManager:       &mocktypes.HouseholdDataManagerMock{},
+		deliverer:                  &webhookdelivery.MockDeliverer{},
+		webhookIDFetcher:           func(req *http.Request) string { return "" },
+		encoderDecoder:             encoding.ProvideServerEncoderDecoder(nil, nil, encoding.ContentTypeJSON),
+		tracer:                     tracing.NewTracerForTest("test"),
 	}
 }
 
@@ -42,6 +48,10 @@ func TestProvideWebhooksService(T *testing.T) {
 			"BuildRouteParamStringIDFetcher",
 			WebhookTriggerEventIDURIParamKey,
 		).Return(func(*http.Request) string { return "" })
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			WebhookDeliveryIDURIParamKey,
+		).Return(func(*http.Request) string { return "" })
 
 		cfg := &Config{
 			DataChangesTopicName: "data_changes",
@@ -54,6 +64,10 @@ func TestProvideWebhooksService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.WebhookDataManagerMock{},
+			&mocktypes.WebhookDeliveryDataManagerMock{},
+			&mocktypes.HouseholdDataManagerMock{},
+			&webhookdelivery.MockDeliverer{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			rpm,
 			pp,
@@ -80,6 +94,10 @@ func TestProvideWebhooksService(T *testing.T) {
 			logging.NewNoopLogger(),
 			cfg,
 			&mocktypes.WebhookDataManagerMock{},
+			&mocktypes.WebhookDeliveryDataManagerMock{},
+			&mocktypes.HouseholdDataManagerMock{},
+			&webhookdelivery.MockDeliverer{},
+			database.NewNoopTransactionRunner(),
 			mockencoding.NewMockEncoderDecoder(),
 			nil,
 			pp,
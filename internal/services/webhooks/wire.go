@@ -8,5 +8,6 @@
 	// Providers are our collection of what we provide to other services.
 	Providers = wire.NewSet(
 		ProvideWebhooksService,
+		wire.FieldsOf(new(*Config), "Delivery"),
 	)
 )
@@ -16,12 +16,13 @@
 )
 
 type validVesselsServiceHTTPRoutesTestHelper struct {
-	ctx              context.Context
-	req              *http.Request
-	res              *httptest.ResponseRecorder
-	service          *service
-	exampleUser      *types.User
-	exampleHousehold *types.Household
+	ctx                        context.Context
+	req                        *http.Request
+	res                        *httptest.ResponseRecorder
+	service                    *service
+	exampleUser                *types.User
+	exampleHousehold           *types.Household
+	exampleDeadLetteredMessage *types.DeadLetteredMessage
 }
 
 func buildTestHelper(t *testing.T) *validVesselsServiceHTTPRoutesTestHelper {
@@ -34,6 +35,7 @@ func buildTestHelper(t *testing.T) *validVesselsServiceHTTPRoutesTestHelper {
 	helper.exampleUser = fakes.BuildFakeUser()
 	helper.exampleHousehold = fakes.BuildFakeHousehold()
 	helper.exampleHousehold.BelongsToUser = helper.exampleUser.ID
+	helper.exampleDeadLetteredMessage = fakes.BuildFakeDeadLetteredMessage()
 
 	sessionCtxData := &types.SessionContextData{
 		Requester: types.RequesterInfo{
@@ -52,6 +54,9 @@ func buildTestHelper(t *testing.T) *validVesselsServiceHTTPRoutesTestHelper {
 	helper.service.sessionContextDataFetcher = func(*http.Request) (*types.SessionContextData, error) {
 		return sessionCtxData, nil
 	}
+	helper.service.deadLetteredMessageIDFetcher = func(*http.Request) string {
+		return helper.exampleDeadLetteredMessage.ID
+	}
 
 	req := testutils.BuildTestRequest(t)
 
@@ -1,10 +1,22 @@
 package workers
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	servertiming "github.com/mitchellh/go-server-timing"
+)
+
+const (
+	// DeadLetteredMessageIDURIParamKey is a standard string that we'll use to refer to dead-lettered message IDs with.
+	DeadLetteredMessageIDURIParamKey = "deadLetteredMessageID"
 )
 
 // MealPlanFinalizationHandler finalizes a meal plan.
@@ -111,3 +123,216 @@ func (s *service) MealPlanTaskCreationHandler(res http.ResponseWriter, req *http
 
 	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusAccepted)
 }
+
+// ListDeadLetteredMessagesHandler lists the dead-lettered messages that haven't been replayed yet.
+func (s *service) ListDeadLetteredMessagesHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	filter := types.ExtractQueryFilterFromRequest(req)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	logger = filter.AttachToLogger(logger)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	tracing.AttachRequestToSpan(span, req)
+	tracing.AttachFilterDataToSpan(span, filter.Page, filter.Limit, filter.SortBy)
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	deadLetteredMessages, err := s.dataManager.GetDeadLetteredMessages(ctx, filter)
+	if errors.Is(err, sql.ErrNoRows) {
+		// in the event no rows exist, return an empty list.
+		deadLetteredMessages = &types.QueryFilteredResult[types.DeadLetteredMessage]{Data: []*types.DeadLetteredMessage{}}
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving dead-lettered messages")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	responseValue := &types.APIResponse[[]*types.DeadLetteredMessage]{
+		Details:    responseDetails,
+		Data:       deadLetteredMessages.Data,
+		Pagination: &deadLetteredMessages.Pagination,
+	}
+
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ReadDeadLetteredMessageHandler returns a GET handler that returns a dead-lettered message.
+func (s *service) ReadDeadLetteredMessageHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine dead-lettered message ID.
+	deadLetteredMessageID := s.deadLetteredMessageIDFetcher(req)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	// fetch dead-lettered message from database.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	x, err := s.dataManager.GetDeadLetteredMessage(ctx, deadLetteredMessageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving dead-lettered message")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	responseValue := &types.APIResponse[*types.DeadLetteredMessage]{
+		Details: responseDetails,
+		Data:    x,
+	}
+
+	// encode our response and peace.
+	s.encoderDecoder.RespondWithData(ctx, res, responseValue)
+}
+
+// ReplayDeadLetteredMessageHandler republishes a dead-lettered message to the topic it was originally consumed from.
+func (s *service) ReplayDeadLetteredMessageHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	timing := servertiming.FromContext(ctx)
+	logger := s.logger.WithRequest(req).WithSpan(span)
+	tracing.AttachRequestToSpan(span, req)
+
+	responseDetails := types.ResponseDetails{
+		TraceID: span.SpanContext().TraceID().String(),
+	}
+
+	// determine user ID.
+	sessionContextTimer := timing.NewMetric("session").WithDesc("fetch session context").Start()
+	sessionCtxData, err := s.sessionContextDataFetcher(req)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving session context data")
+		errRes := types.NewAPIErrorResponse("unauthenticated", types.ErrFetchingSessionContextData, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusUnauthorized)
+		return
+	}
+	sessionContextTimer.Stop()
+
+	tracing.AttachSessionContextDataToSpan(span, sessionCtxData)
+	logger = sessionCtxData.AttachToLogger(logger)
+	responseDetails.CurrentHouseholdID = sessionCtxData.ActiveHouseholdID
+
+	// determine dead-lettered message ID.
+	deadLetteredMessageID := s.deadLetteredMessageIDFetcher(req)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	// fetch dead-lettered message from database.
+	readTimer := timing.NewMetric("database").WithDesc("fetch").Start()
+	x, err := s.dataManager.GetDeadLetteredMessage(ctx, deadLetteredMessageID)
+	if errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("not found", types.ErrDataNotFound, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusNotFound)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrieving dead-lettered message")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	readTimer.Stop()
+
+	if x.ReplayedAt != nil {
+		errRes := types.NewAPIErrorResponse("message has already been replayed", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusConflict)
+		return
+	}
+
+	logger = logger.WithValue(keys.QueueTopicKey, x.Topic)
+
+	publisher, err := s.publisherProvider.ProvidePublisher(x.Topic)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "providing publisher for dead-lettered message topic")
+		errRes := types.NewAPIErrorResponse("queue error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	// the message is claimed before it's published, so that concurrent replays can't both publish it.
+	updateTimer := timing.NewMetric("database").WithDesc("mark replayed").Start()
+	if err = s.dataManager.MarkDeadLetteredMessageAsReplayed(ctx, deadLetteredMessageID); errors.Is(err, sql.ErrNoRows) {
+		errRes := types.NewAPIErrorResponse("message has already been replayed", types.ErrValidatingRequestInput, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusConflict)
+		return
+	} else if err != nil {
+		observability.AcknowledgeError(err, logger, span, "marking dead-lettered message as replayed")
+		errRes := types.NewAPIErrorResponse("database error", types.ErrTalkingToDatabase, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+	updateTimer.Stop()
+
+	// the payload is already encoded, so it's published as-is.
+	if err = publisher.Publish(ctx, json.RawMessage(x.Payload)); err != nil {
+		observability.AcknowledgeError(err, logger, span, "replaying dead-lettered message")
+
+		// release the claim, so the message can be replayed again.
+		if unmarkErr := s.dataManager.UnmarkDeadLetteredMessageAsReplayed(ctx, deadLetteredMessageID); unmarkErr != nil {
+			observability.AcknowledgeError(unmarkErr, logger, span, "unmarking dead-lettered message as replayed")
+		}
+
+		errRes := types.NewAPIErrorResponse("queue error", types.ErrMisbehavingDependency, responseDetails)
+		s.encoderDecoder.EncodeResponseWithStatus(ctx, res, errRes, http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("dead-lettered message replayed")
+
+	responseValue := &types.APIResponse[any]{
+		Details: responseDetails,
+	}
+
+	s.encoderDecoder.EncodeResponseWithStatus(ctx, res, responseValue, http.StatusAccepted)
+}
@@ -2,12 +2,19 @@
 
 import (
 	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
 	"github.com/dinnerdonebetter/backend/internal/workers"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
@@ -88,3 +95,406 @@ func TestWorkerService_MealPlanFinalizationHandler(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, mpfw)
 	})
 }
+
+func TestWorkerService_ListDeadLetteredMessagesHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		exampleDeadLetteredMessageList := fakes.BuildFakeDeadLetteredMessageList()
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessages",
+			testutils.ContextMatcher,
+			mock.IsType(&types.QueryFilter{}),
+		).Return(exampleDeadLetteredMessageList, nil)
+		helper.service.dataManager = dbManager
+
+		helper.service.ListDeadLetteredMessagesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, exampleDeadLetteredMessageList.Data)
+		assert.Equal(t, *actual.Pagination, exampleDeadLetteredMessageList.Pagination)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ListDeadLetteredMessagesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[[]*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no rows returned", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessages",
+			testutils.ContextMatcher,
+			mock.IsType(&types.QueryFilter{}),
+		).Return((*types.QueryFilteredResult[types.DeadLetteredMessage])(nil), sql.ErrNoRows)
+		helper.service.dataManager = dbManager
+
+		helper.service.ListDeadLetteredMessagesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[[]*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with error retrieving dead-lettered messages from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessages",
+			testutils.ContextMatcher,
+			mock.IsType(&types.QueryFilter{}),
+		).Return((*types.QueryFilteredResult[types.DeadLetteredMessage])(nil), errors.New("blah"))
+		helper.service.dataManager = dbManager
+
+		helper.service.ListDeadLetteredMessagesHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[[]*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+}
+
+func TestWorkerService_ReadDeadLetteredMessageHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(helper.exampleDeadLetteredMessage, nil)
+		helper.service.dataManager = dbManager
+
+		helper.service.ReadDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+		var actual *types.APIResponse[*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Equal(t, actual.Data, helper.exampleDeadLetteredMessage)
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ReadDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such dead-lettered message in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return((*types.DeadLetteredMessage)(nil), sql.ErrNoRows)
+		helper.service.dataManager = dbManager
+
+		helper.service.ReadDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with error fetching from database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return((*types.DeadLetteredMessage)(nil), errors.New("blah"))
+		helper.service.dataManager = dbManager
+
+		helper.service.ReadDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[*types.DeadLetteredMessage]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Empty(t, actual.Data)
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+}
+
+func TestWorkerService_ReplayDeadLetteredMessageHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(helper.exampleDeadLetteredMessage, nil)
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"MarkDeadLetteredMessageAsReplayed",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(nil)
+		helper.service.dataManager = dbManager
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			json.RawMessage(helper.exampleDeadLetteredMessage.Payload),
+		).Return(nil)
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", helper.exampleDeadLetteredMessage.Topic).Return(publisher, nil)
+		helper.service.publisherProvider = publisherProvider
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusAccepted, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.NoError(t, actual.Error.AsError())
+
+		mock.AssertExpectationsForObjects(t, dbManager, publisher, publisherProvider)
+	})
+
+	T.Run("with error retrieving session context data", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.service.sessionContextDataFetcher = testutils.BrokenSessionContextDataFetcher
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+	})
+
+	T.Run("with no such dead-lettered message in the database", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return((*types.DeadLetteredMessage)(nil), sql.ErrNoRows)
+		helper.service.dataManager = dbManager
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusNotFound, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with already replayed message", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		helper.exampleDeadLetteredMessage.ReplayedAt = pointer.To(time.Now())
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(helper.exampleDeadLetteredMessage, nil)
+		helper.service.dataManager = dbManager
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusConflict, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager)
+	})
+
+	T.Run("with message claimed by a concurrent replay", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(helper.exampleDeadLetteredMessage, nil)
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"MarkDeadLetteredMessageAsReplayed",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(sql.ErrNoRows)
+		helper.service.dataManager = dbManager
+
+		publisher := &mockpublishers.Publisher{}
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", helper.exampleDeadLetteredMessage.Topic).Return(publisher, nil)
+		helper.service.publisherProvider = publisherProvider
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusConflict, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+		mock.AssertExpectationsForObjects(t, dbManager, publisher, publisherProvider)
+	})
+
+	T.Run("with error publishing message", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(helper.exampleDeadLetteredMessage, nil)
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"MarkDeadLetteredMessageAsReplayed",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(nil)
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"UnmarkDeadLetteredMessageAsReplayed",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(nil)
+		helper.service.dataManager = dbManager
+
+		publisher := &mockpublishers.Publisher{}
+		publisher.On(
+			"Publish",
+			testutils.ContextMatcher,
+			json.RawMessage(helper.exampleDeadLetteredMessage.Payload),
+		).Return(errors.New("blah"))
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", helper.exampleDeadLetteredMessage.Topic).Return(publisher, nil)
+		helper.service.publisherProvider = publisherProvider
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		mock.AssertExpectationsForObjects(t, dbManager, publisher, publisherProvider)
+	})
+
+	T.Run("with error marking message as replayed", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+
+		dbManager := database.NewMockDatabase()
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"GetDeadLetteredMessage",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(helper.exampleDeadLetteredMessage, nil)
+		dbManager.DeadLetteredMessageDataManagerMock.On(
+			"MarkDeadLetteredMessageAsReplayed",
+			testutils.ContextMatcher,
+			helper.exampleDeadLetteredMessage.ID,
+		).Return(errors.New("blah"))
+		helper.service.dataManager = dbManager
+
+		publisher := &mockpublishers.Publisher{}
+
+		publisherProvider := &mockpublishers.ProducerProvider{}
+		publisherProvider.On("ProvidePublisher", helper.exampleDeadLetteredMessage.Topic).Return(publisher, nil)
+		helper.service.publisherProvider = publisherProvider
+
+		helper.service.ReplayDeadLetteredMessageHandler(helper.res, helper.req)
+
+		assert.Equal(t, http.StatusInternalServerError, helper.res.Code)
+		var actual *types.APIResponse[any]
+		require.NoError(t, helper.service.encoderDecoder.DecodeBytes(helper.ctx, helper.res.Body.Bytes(), &actual))
+		assert.Error(t, actual.Error)
+
+		publisher.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+		mock.AssertExpectationsForObjects(t, dbManager, publisher, publisherProvider)
+	})
+}
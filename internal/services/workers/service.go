@@ -10,9 +10,11 @@
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/features/grocerylistpreparation"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/routing"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
 	"github.com/dinnerdonebetter/backend/internal/workers"
 	"github.com/dinnerdonebetter/backend/pkg/types"
@@ -32,6 +34,8 @@
 		dataManager                    database.DataManager
 		sessionContextDataFetcher      func(*http.Request) (*types.SessionContextData, error)
 		dataChangesPublisher           messagequeue.Publisher
+		publisherProvider              messagequeue.PublisherProvider
+		deadLetteredMessageIDFetcher   func(*http.Request) string
 		encoderDecoder                 encoding.ServerEncoderDecoder
 		tracer                         tracing.Tracer
 		mealPlanFinalizationWorker     workers.MealPlanFinalizationWorker
@@ -48,6 +52,7 @@ func ProvideService(
 	dataManager database.DataManager,
 	encoder encoding.ServerEncoderDecoder,
 	publisherProvider messagequeue.PublisherProvider,
+	routeParamManager routing.RouteParamManager,
 	tracerProvider tracing.TracerProvider,
 	analyticsEventReporter analytics.EventReporter,
 	grapher recipeanalysis.RecipeAnalyzer,
@@ -64,6 +69,8 @@ func ProvideService(
 		tracerProvider,
 	)
 
+	unitConversionGraphBuilder := unitconversion.NewGraphBuilder(logger, tracerProvider, dataManager)
+
 	mealPlanGroceryListInitializer := workers.ProvideMealPlanGroceryListInitializer(
 		logger,
 		dataManager,
@@ -71,7 +78,8 @@ func ProvideService(
 		dataChangesPublisher,
 		analyticsEventReporter,
 		tracerProvider,
-		grocerylistpreparation.NewGroceryListCreator(logger, tracerProvider),
+		grocerylistpreparation.NewGroceryListCreator(logger, tracerProvider, unitConversionGraphBuilder),
+		unitConversionGraphBuilder,
 	)
 
 	mealPlanTaskCreatorWorker := workers.ProvideMealPlanTaskCreationEnsurerWorker(
@@ -88,6 +96,8 @@ func ProvideService(
 		logger:                         logging.EnsureLogger(logger).WithName(serviceName),
 		sessionContextDataFetcher:      authservice.FetchContextFromRequest,
 		dataChangesPublisher:           dataChangesPublisher,
+		publisherProvider:              publisherProvider,
+		deadLetteredMessageIDFetcher:   routeParamManager.BuildRouteParamStringIDFetcher(DeadLetteredMessageIDURIParamKey),
 		encoderDecoder:                 encoder,
 		dataManager:                    dataManager,
 		mealPlanFinalizationWorker:     mealPlanFinalizationWorker,
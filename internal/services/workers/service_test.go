@@ -3,6 +3,7 @@
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/dinnerdonebetter/backend/internal/analytics"
@@ -13,6 +14,7 @@
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	mockrouting "github.com/dinnerdonebetter/backend/internal/routing/mock"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -44,6 +46,12 @@ func TestProvideValidVesselsService(T *testing.T) {
 		pp := &mockpublishers.ProducerProvider{}
 		pp.On("ProvidePublisher", cfg.DataChangesTopicName).Return(&mockpublishers.Publisher{}, nil)
 
+		rpm := mockrouting.NewRouteParamManager()
+		rpm.On(
+			"BuildRouteParamStringIDFetcher",
+			DeadLetteredMessageIDURIParamKey,
+		).Return(func(*http.Request) string { return "" })
+
 		s, err := ProvideService(
 			ctx,
 			logger,
@@ -51,6 +59,7 @@ func TestProvideValidVesselsService(T *testing.T) {
 			database.NewMockDatabase(),
 			mockencoding.NewMockEncoderDecoder(),
 			pp,
+			rpm,
 			tracing.NewNoopTracerProvider(),
 			analytics.NewNoopEventReporter(),
 			&recipeanalysis.MockRecipeAnalyzer{},
@@ -59,7 +68,7 @@ func TestProvideValidVesselsService(T *testing.T) {
 		assert.NotNil(t, s)
 		assert.NoError(t, err)
 
-		mock.AssertExpectationsForObjects(t, pp)
+		mock.AssertExpectationsForObjects(t, pp, rpm)
 	})
 
 	T.Run("with error providing data changes producer", func(t *testing.T) {
@@ -82,6 +91,7 @@ func TestProvideValidVesselsService(T *testing.T) {
 			database.NewMockDatabase(),
 			mockencoding.NewMockEncoderDecoder(),
 			pp,
+			mockrouting.NewRouteParamManager(),
 			tracing.NewNoopTracerProvider(),
 			analytics.NewNoopEventReporter(),
 			&recipeanalysis.MockRecipeAnalyzer{},
@@ -0,0 +1,483 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/dinnerdonebetter/backend/internal/analytics"
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/email"
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/search"
+	"github.com/dinnerdonebetter/backend/internal/search/indexing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+var (
+	errRequiredDataIsNil = errors.New("required data is nil")
+
+	nonWebhookEventTypes = []types.ServiceEventType{
+		types.UserSignedUpCustomerEventType,
+		types.UserArchivedCustomerEventType,
+		types.TwoFactorSecretVerifiedCustomerEventType,
+		types.TwoFactorDeactivatedCustomerEventType,
+		types.TwoFactorSecretChangedCustomerEventType,
+		types.PasswordResetTokenCreatedEventType,
+		types.PasswordResetTokenRedeemedEventType,
+		types.PasswordChangedEventType,
+		types.EmailAddressChangedEventType,
+		types.UsernameChangedEventType,
+		types.UserDetailsChangedEventType,
+		types.UsernameReminderRequestedEventType,
+		types.UserLoggedInCustomerEventType,
+		types.UserLoggedOutCustomerEventType,
+		types.UserChangedActiveHouseholdCustomerEventType,
+		types.UserEmailAddressVerifiedEventType,
+		types.UserEmailAddressVerificationEmailRequestedEventType,
+		types.HouseholdMemberRemovedCustomerEventType,
+		types.HouseholdMembershipPermissionsUpdatedCustomerEventType,
+		types.HouseholdOwnershipTransferredCustomerEventType,
+		types.OAuth2ClientCreatedCustomerEventType,
+		types.OAuth2ClientArchivedCustomerEventType,
+	}
+)
+
+type (
+	// DataChangesWorker fans data change messages out to analytics, webhooks, outbound emails, and search indexing.
+	DataChangesWorker interface {
+		HandleMessage(ctx context.Context, message []byte) error
+	}
+
+	// dataChangesWorker handles data change messages.
+	dataChangesWorker struct {
+		logger                           logging.Logger
+		tracer                           tracing.Tracer
+		dataManager                      database.DataManager
+		analyticsEventReporter           analytics.EventReporter
+		outboundEmailsPublisher          messagequeue.Publisher
+		searchDataIndexPublisher         messagequeue.Publisher
+		webhookExecutionRequestPublisher messagequeue.Publisher
+	}
+)
+
+// ProvideDataChangesWorker provides a DataChangesWorker.
+func ProvideDataChangesWorker(
+	logger logging.Logger,
+	dataManager database.DataManager,
+	analyticsEventReporter analytics.EventReporter,
+	outboundEmailsPublisher,
+	searchDataIndexPublisher,
+	webhookExecutionRequestPublisher messagequeue.Publisher,
+	tracerProvider tracing.TracerProvider,
+) DataChangesWorker {
+	n := "data_changes"
+
+	return &dataChangesWorker{
+		logger:                           logging.EnsureLogger(logger).WithName(n),
+		tracer:                           tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(n)),
+		dataManager:                      dataManager,
+		analyticsEventReporter:           analyticsEventReporter,
+		outboundEmailsPublisher:          outboundEmailsPublisher,
+		searchDataIndexPublisher:         searchDataIndexPublisher,
+		webhookExecutionRequestPublisher: webhookExecutionRequestPublisher,
+	}
+}
+
+// HandleMessage handles a data change message.
+func (w *dataChangesWorker) HandleMessage(ctx context.Context, message []byte) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.Clone()
+
+	var changeMessage types.DataChangeMessage
+	if err := json.Unmarshal(message, &changeMessage); err != nil {
+		logger = logger.WithValue("raw_data", message)
+		return observability.PrepareAndLogError(err, logger, span, "unmarshalling data change message")
+	}
+
+	logger = logger.WithValue("event_type", changeMessage.EventType)
+
+	if changeMessage.UserID != "" && changeMessage.EventType != "" {
+		if err := w.analyticsEventReporter.EventOccurred(ctx, changeMessage.EventType, changeMessage.UserID, changeMessage.Context); err != nil {
+			observability.AcknowledgeError(err, logger, span, "notifying customer data platform")
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		if err := w.handleWebhookExecutionRequests(ctx, &changeMessage); err != nil {
+			observability.AcknowledgeError(err, logger, span, "requesting webhook executions")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if err := w.handleOutboundNotifications(ctx, &changeMessage); err != nil {
+			observability.AcknowledgeError(err, logger, span, "notifying customer(s)")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		if err := w.handleSearchIndexUpdates(ctx, &changeMessage); err != nil {
+			observability.AcknowledgeError(err, logger, span, "updating search index")
+		}
+	}()
+
+	wg.Wait()
+
+	return nil
+}
+
+// handleWebhookExecutionRequests publishes a webhook execution request for every household webhook subscribed to a data change.
+func (w *dataChangesWorker) handleWebhookExecutionRequests(ctx context.Context, changeMessage *types.DataChangeMessage) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if changeMessage.HouseholdID == "" || slices.Contains(nonWebhookEventTypes, changeMessage.EventType) {
+		return nil
+	}
+
+	logger := w.logger.WithValue("event_type", changeMessage.EventType)
+
+	relevantWebhooks, err := w.dataManager.GetWebhooksForHouseholdAndEvent(ctx, changeMessage.HouseholdID, changeMessage.EventType)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "getting webhooks")
+	}
+
+	for _, webhook := range relevantWebhooks {
+		if err = w.webhookExecutionRequestPublisher.Publish(ctx, &types.WebhookExecutionRequest{
+			WebhookID:    webhook.ID,
+			HouseholdID:  changeMessage.HouseholdID,
+			TriggerEvent: string(changeMessage.EventType),
+			Payload:      changeMessage,
+		}); err != nil {
+			observability.AcknowledgeError(err, logger, span, "publishing webhook execution request")
+		}
+	}
+
+	return nil
+}
+
+// handleSearchIndexUpdates publishes a search index request for data changes that affect searchable records.
+func (w *dataChangesWorker) handleSearchIndexUpdates(ctx context.Context, changeMessage *types.DataChangeMessage) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.WithValue("event_type", changeMessage.EventType)
+
+	switch changeMessage.EventType {
+	case types.UserSignedUpCustomerEventType,
+		types.UserArchivedCustomerEventType,
+		types.EmailAddressChangedEventType,
+		types.UsernameChangedEventType,
+		types.UserDetailsChangedEventType,
+		types.UserEmailAddressVerifiedEventType:
+		if changeMessage.UserID == "" {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for User")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.UserID,
+			IndexType: search.IndexTypeUsers,
+			Delete:    changeMessage.EventType == types.UserArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.RecipeCreatedCustomerEventType,
+		types.RecipeUpdatedCustomerEventType,
+		types.RecipeArchivedCustomerEventType:
+		if changeMessage.Recipe == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for Recipe")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.Recipe.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.RecipeArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.MealCreatedCustomerEventType,
+		types.MealUpdatedCustomerEventType,
+		types.MealArchivedCustomerEventType:
+		if changeMessage.Meal == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for Meal")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.Meal.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.MealArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidIngredientCreatedCustomerEventType,
+		types.ValidIngredientUpdatedCustomerEventType,
+		types.ValidIngredientArchivedCustomerEventType:
+		if changeMessage.ValidIngredient == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidIngredient")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidIngredient.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidIngredientArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidInstrumentCreatedCustomerEventType,
+		types.ValidInstrumentUpdatedCustomerEventType,
+		types.ValidInstrumentArchivedCustomerEventType:
+		if changeMessage.ValidInstrument == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidInstrument")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidInstrument.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidInstrumentArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidMeasurementUnitCreatedCustomerEventType,
+		types.ValidMeasurementUnitUpdatedCustomerEventType,
+		types.ValidMeasurementUnitArchivedCustomerEventType:
+		if changeMessage.ValidMeasurementUnit == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidMeasurementUnit")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidMeasurementUnit.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidMeasurementUnitArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidPreparationCreatedCustomerEventType,
+		types.ValidPreparationUpdatedCustomerEventType,
+		types.ValidPreparationArchivedCustomerEventType:
+		if changeMessage.ValidPreparation == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidPreparation")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidPreparation.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidPreparationArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidIngredientStateCreatedCustomerEventType,
+		types.ValidIngredientStateUpdatedCustomerEventType,
+		types.ValidIngredientStateArchivedCustomerEventType:
+		if changeMessage.ValidIngredientState == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidIngredientState")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidIngredientState.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidIngredientStateArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidIngredientMeasurementUnitCreatedCustomerEventType,
+		types.ValidIngredientMeasurementUnitUpdatedCustomerEventType,
+		types.ValidIngredientMeasurementUnitArchivedCustomerEventType:
+		if changeMessage.ValidIngredientMeasurementUnit == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidIngredientMeasurementUnit")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidIngredientMeasurementUnit.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidIngredientMeasurementUnitArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidPreparationInstrumentCreatedCustomerEventType,
+		types.ValidPreparationInstrumentUpdatedCustomerEventType,
+		types.ValidPreparationInstrumentArchivedCustomerEventType:
+		if changeMessage.ValidPreparationInstrument == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidPreparationInstrument")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidPreparationInstrument.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidPreparationInstrumentArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	case types.ValidIngredientPreparationCreatedCustomerEventType,
+		types.ValidIngredientPreparationUpdatedCustomerEventType,
+		types.ValidIngredientPreparationArchivedCustomerEventType:
+		if changeMessage.ValidIngredientPreparation == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "updating search index for ValidIngredientPreparation")
+		}
+
+		if err := w.searchDataIndexPublisher.Publish(ctx, &indexing.IndexRequest{
+			RowID:     changeMessage.ValidIngredientPreparation.ID,
+			IndexType: search.IndexTypeRecipes,
+			Delete:    changeMessage.EventType == types.ValidIngredientPreparationArchivedCustomerEventType,
+		}); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "publishing search index update")
+		}
+
+		return nil
+	default:
+		logger.Debug("event type not handled for search indexing")
+		return nil
+	}
+}
+
+// handleOutboundNotifications publishes email delivery requests for data changes that warrant them.
+func (w *dataChangesWorker) handleOutboundNotifications(ctx context.Context, changeMessage *types.DataChangeMessage) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	var (
+		emailType string
+		edrs      []*email.DeliveryRequest
+	)
+
+	logger := w.logger.WithValue("event_type", changeMessage.EventType)
+
+	switch changeMessage.EventType {
+	case types.UserSignedUpCustomerEventType:
+		emailType = "user signup"
+		if err := w.analyticsEventReporter.AddUser(ctx, changeMessage.UserID, changeMessage.Context); err != nil {
+			observability.AcknowledgeError(err, logger, span, "notifying customer data platform")
+		}
+
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:                 changeMessage.UserID,
+			Template:               email.TemplateTypeVerifyEmailAddress,
+			EmailVerificationToken: changeMessage.EmailVerificationToken,
+		})
+	case types.UserEmailAddressVerificationEmailRequestedEventType:
+		emailType = "email address verification"
+
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:                 changeMessage.UserID,
+			Template:               email.TemplateTypeVerifyEmailAddress,
+			EmailVerificationToken: changeMessage.EmailVerificationToken,
+		})
+	case types.MealPlanCreatedCustomerEventType:
+		emailType = "meal plan created"
+		mealPlan := changeMessage.MealPlan
+		if mealPlan == nil {
+			return observability.PrepareError(fmt.Errorf("meal plan is nil"), span, "publishing meal plan created email")
+		}
+
+		household, err := w.dataManager.GetHousehold(ctx, mealPlan.BelongsToHousehold)
+		if err != nil {
+			return observability.PrepareError(err, span, "getting household")
+		}
+
+		for _, member := range household.Members {
+			if member.BelongsToUser.EmailAddressVerifiedAt != nil {
+				edrs = append(edrs, &email.DeliveryRequest{
+					UserID:   member.BelongsToUser.ID,
+					Template: email.TemplateTypeMealPlanCreated,
+					MealPlan: mealPlan,
+				})
+			}
+		}
+	case types.PasswordResetTokenCreatedEventType:
+		emailType = "password reset request"
+		if changeMessage.PasswordResetToken == nil {
+			return observability.PrepareError(fmt.Errorf("password reset token is nil"), span, "publishing password reset token email")
+		}
+
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:             changeMessage.UserID,
+			Template:           email.TemplateTypePasswordResetTokenCreated,
+			PasswordResetToken: changeMessage.PasswordResetToken,
+		})
+
+	case types.UsernameReminderRequestedEventType:
+		emailType = "username reminder"
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:   changeMessage.UserID,
+			Template: email.TemplateTypeUsernameReminder,
+		})
+
+	case types.PasswordResetTokenRedeemedEventType:
+		emailType = "password reset token redeemed"
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:   changeMessage.UserID,
+			Template: email.TemplateTypePasswordResetTokenRedeemed,
+		})
+
+	case types.PasswordChangedEventType:
+		emailType = "password reset token redeemed"
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:   changeMessage.UserID,
+			Template: email.TemplateTypePasswordReset,
+		})
+
+	case types.PasskeyRegisteredCustomerEventType:
+		emailType = "passkey registered"
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:   changeMessage.UserID,
+			Template: email.TemplateTypePasskeyRegistered,
+		})
+
+	case types.HouseholdInvitationCreatedCustomerEventType:
+		emailType = "household invitation created"
+		if changeMessage.HouseholdInvitation == nil {
+			return observability.PrepareError(fmt.Errorf("household invitation is nil"), span, "publishing password reset token redemption email")
+		}
+
+		edrs = append(edrs, &email.DeliveryRequest{
+			UserID:     changeMessage.UserID,
+			Template:   email.TemplateTypeInvite,
+			Invitation: changeMessage.HouseholdInvitation,
+		})
+	}
+
+	if len(edrs) > 0 {
+		logger.WithValue("email_type", emailType).WithValue("outbound_emails_to_send", len(edrs)).Info("publishing email requests")
+	}
+
+	for _, edr := range edrs {
+		if err := w.outboundEmailsPublisher.Publish(ctx, edr); err != nil {
+			observability.AcknowledgeError(err, logger, span, "publishing %s request email", emailType)
+		}
+	}
+
+	return nil
+}
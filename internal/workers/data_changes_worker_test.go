@@ -0,0 +1,252 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	analyticsmock "github.com/dinnerdonebetter/backend/internal/analytics/mock"
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/email"
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/search"
+	"github.com/dinnerdonebetter/backend/internal/search/indexing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type dataChangesWorkerTestDependencies struct {
+	dataManager                      *database.MockDatabase
+	analyticsEventReporter           *analyticsmock.EventReporter
+	outboundEmailsPublisher          *mockpublishers.Publisher
+	searchDataIndexPublisher         *mockpublishers.Publisher
+	webhookExecutionRequestPublisher *mockpublishers.Publisher
+}
+
+func (d *dataChangesWorkerTestDependencies) AssertExpectations(t *testing.T) {
+	t.Helper()
+
+	mock.AssertExpectationsForObjects(
+		t,
+		d.dataManager,
+		d.analyticsEventReporter,
+		d.outboundEmailsPublisher,
+		d.searchDataIndexPublisher,
+		d.webhookExecutionRequestPublisher,
+	)
+}
+
+func newTestDataChangesWorker(t *testing.T) (DataChangesWorker, *dataChangesWorkerTestDependencies) {
+	t.Helper()
+
+	deps := &dataChangesWorkerTestDependencies{
+		dataManager:                      database.NewMockDatabase(),
+		analyticsEventReporter:           &analyticsmock.EventReporter{},
+		outboundEmailsPublisher:          &mockpublishers.Publisher{},
+		searchDataIndexPublisher:         &mockpublishers.Publisher{},
+		webhookExecutionRequestPublisher: &mockpublishers.Publisher{},
+	}
+
+	worker := ProvideDataChangesWorker(
+		logging.NewNoopLogger(),
+		deps.dataManager,
+		deps.analyticsEventReporter,
+		deps.outboundEmailsPublisher,
+		deps.searchDataIndexPublisher,
+		deps.webhookExecutionRequestPublisher,
+		tracing.NewNoopTracerProvider(),
+	)
+	require.NotNil(t, worker)
+
+	return worker, deps
+}
+
+func buildDataChangeMessageBodyForTest(t *testing.T, input *types.DataChangeMessage) []byte {
+	t.Helper()
+
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	return body
+}
+
+func TestProvideDataChangesWorker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ProvideDataChangesWorker(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&analyticsmock.EventReporter{},
+			&mockpublishers.Publisher{},
+			&mockpublishers.Publisher{},
+			&mockpublishers.Publisher{},
+			tracing.NewNoopTracerProvider(),
+		)
+		assert.NotNil(t, actual)
+	})
+}
+
+func TestDataChangesWorker_HandleMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleRecipe := fakes.BuildFakeRecipe()
+		input := &types.DataChangeMessage{
+			EventType:   types.RecipeCreatedCustomerEventType,
+			UserID:      fakes.BuildFakeID(),
+			HouseholdID: fakes.BuildFakeID(),
+			Recipe:      exampleRecipe,
+			Context:     map[string]any{"things": "stuff"},
+		}
+
+		worker, deps := newTestDataChangesWorker(t)
+
+		deps.analyticsEventReporter.On("EventOccurred", testutils.ContextMatcher, input.EventType, input.UserID, input.Context).Return(nil)
+
+		exampleWebhooks := fakes.BuildFakeWebhookList().Data
+		deps.dataManager.WebhookDataManagerMock.On("GetWebhooksForHouseholdAndEvent", testutils.ContextMatcher, input.HouseholdID, input.EventType).Return(exampleWebhooks, nil)
+
+		for _, webhook := range exampleWebhooks {
+			deps.webhookExecutionRequestPublisher.On("Publish", testutils.ContextMatcher, mock.MatchedBy(func(req *types.WebhookExecutionRequest) bool {
+				return req.WebhookID == webhook.ID && req.HouseholdID == input.HouseholdID && req.TriggerEvent == string(input.EventType)
+			})).Return(nil)
+		}
+
+		deps.searchDataIndexPublisher.On("Publish", testutils.ContextMatcher, &indexing.IndexRequest{
+			RowID:     exampleRecipe.ID,
+			IndexType: search.IndexTypeRecipes,
+		}).Return(nil)
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDataChangeMessageBodyForTest(t, input)))
+
+		deps.AssertExpectations(t)
+	})
+
+	T.Run("with outbound email", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input := &types.DataChangeMessage{
+			EventType:   types.UsernameReminderRequestedEventType,
+			UserID:      fakes.BuildFakeID(),
+			HouseholdID: fakes.BuildFakeID(),
+			Context:     map[string]any{"things": "stuff"},
+		}
+
+		worker, deps := newTestDataChangesWorker(t)
+
+		deps.analyticsEventReporter.On("EventOccurred", testutils.ContextMatcher, input.EventType, input.UserID, input.Context).Return(nil)
+		deps.outboundEmailsPublisher.On("Publish", testutils.ContextMatcher, &email.DeliveryRequest{
+			UserID:   input.UserID,
+			Template: email.TemplateTypeUsernameReminder,
+		}).Return(nil)
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDataChangeMessageBodyForTest(t, input)))
+
+		deps.AssertExpectations(t)
+	})
+
+	T.Run("with passkey registered", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input := &types.DataChangeMessage{
+			EventType:   types.PasskeyRegisteredCustomerEventType,
+			UserID:      fakes.BuildFakeID(),
+			HouseholdID: fakes.BuildFakeID(),
+			Context:     map[string]any{"things": "stuff"},
+		}
+
+		worker, deps := newTestDataChangesWorker(t)
+
+		deps.analyticsEventReporter.On("EventOccurred", testutils.ContextMatcher, input.EventType, input.UserID, input.Context).Return(nil)
+		deps.dataManager.WebhookDataManagerMock.On("GetWebhooksForHouseholdAndEvent", testutils.ContextMatcher, input.HouseholdID, input.EventType).Return([]*types.Webhook{}, nil)
+		deps.outboundEmailsPublisher.On("Publish", testutils.ContextMatcher, &email.DeliveryRequest{
+			UserID:   input.UserID,
+			Template: email.TemplateTypePasskeyRegistered,
+		}).Return(nil)
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDataChangeMessageBodyForTest(t, input)))
+
+		deps.AssertExpectations(t)
+	})
+
+	T.Run("with invalid message", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		worker, deps := newTestDataChangesWorker(t)
+
+		assert.Error(t, worker.HandleMessage(ctx, []byte("} bad JSON lol")))
+
+		deps.AssertExpectations(t)
+	})
+
+	T.Run("with missing recipe", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input := &types.DataChangeMessage{
+			EventType: types.RecipeArchivedCustomerEventType,
+		}
+
+		worker, deps := newTestDataChangesWorker(t)
+
+		// the missing recipe is logged rather than returned, so the message isn't redelivered forever.
+		assert.NoError(t, worker.HandleMessage(ctx, buildDataChangeMessageBodyForTest(t, input)))
+
+		deps.AssertExpectations(t)
+	})
+
+	T.Run("with error fetching webhooks", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input := &types.DataChangeMessage{
+			EventType:   types.MealPlanUpdatedCustomerEventType,
+			HouseholdID: fakes.BuildFakeID(),
+		}
+
+		worker, deps := newTestDataChangesWorker(t)
+
+		deps.dataManager.WebhookDataManagerMock.On("GetWebhooksForHouseholdAndEvent", testutils.ContextMatcher, input.HouseholdID, input.EventType).Return([]*types.Webhook(nil), errors.New("blah"))
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDataChangeMessageBodyForTest(t, input)))
+
+		deps.AssertExpectations(t)
+	})
+
+	T.Run("skips webhooks for non-webhook events", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input := &types.DataChangeMessage{
+			EventType:   types.UserLoggedInCustomerEventType,
+			UserID:      fakes.BuildFakeID(),
+			HouseholdID: fakes.BuildFakeID(),
+			Context:     map[string]any{"things": "stuff"},
+		}
+
+		worker, deps := newTestDataChangesWorker(t)
+
+		deps.analyticsEventReporter.On("EventOccurred", testutils.ContextMatcher, input.EventType, input.UserID, input.Context).Return(nil)
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDataChangeMessageBodyForTest(t, input)))
+
+		deps.AssertExpectations(t)
+	})
+}
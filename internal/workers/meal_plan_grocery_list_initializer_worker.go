@@ -2,23 +2,31 @@
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/dinnerdonebetter/backend/internal/analytics"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/encoding"
 	"github.com/dinnerdonebetter/backend/internal/features/grocerylistpreparation"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
 	"github.com/dinnerdonebetter/backend/internal/messagequeue"
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/shopspring/decimal"
 )
 
 const (
 	mealPlanGroceryListInitializerName = "meal_plan_grocery_list_initializer"
+
+	pantryStockStatusExplanation = "covered by household pantry stock"
 )
 
 type (
@@ -37,7 +45,11 @@
 		postUpdatesPublisher   messagequeue.Publisher
 		analyticsEventReporter analytics.EventReporter
 		groceryListCreator     grocerylistpreparation.GroceryListCreator
+		graphBuilder           unitconversion.GraphBuilder
 	}
+
+	// pantryStock tracks the remaining on-hand quantity of each ingredient, by measurement unit, for a household.
+	pantryStock map[string]map[string]float32
 )
 
 // ProvideMealPlanGroceryListInitializer provides a mealPlanGroceryListInitializer.
@@ -49,6 +61,7 @@ func ProvideMealPlanGroceryListInitializer(
 	analyticsEventReporter analytics.EventReporter,
 	tracerProvider tracing.TracerProvider,
 	groceryListCreator grocerylistpreparation.GroceryListCreator,
+	graphBuilder unitconversion.GraphBuilder,
 ) MealPlanGroceryListInitializer {
 	return &mealPlanGroceryListInitializer{
 		logger:                 logging.EnsureLogger(logger).WithName(mealPlanGroceryListInitializerName),
@@ -59,6 +72,7 @@ func ProvideMealPlanGroceryListInitializer(
 		postUpdatesPublisher:   postUpdatesPublisher,
 		analyticsEventReporter: analyticsEventReporter,
 		groceryListCreator:     groceryListCreator,
+		graphBuilder:           graphBuilder,
 	}
 }
 
@@ -82,8 +96,11 @@ func (w *mealPlanGroceryListInitializer) InitializeGroceryListsForFinalizedMealP
 
 	var errorResult *multierror.Error
 
+	// stock is shared between meal plans for the same household, so the same pantry item isn't counted twice.
+	householdPantryStocks := map[string]pantryStock{}
+
 	for _, mealPlan := range mealPlans {
-		l := logger.WithValue(keys.MealPlanIDKey, mealPlan.ID)
+		l := logger.WithValue(keys.MealPlanIDKey, mealPlan.ID).WithValue(keys.HouseholdIDKey, mealPlan.BelongsToHousehold)
 
 		dbInputs, groceryListCreationErr := w.groceryListCreator.GenerateGroceryListInputs(ctx, mealPlan)
 		if groceryListCreationErr != nil {
@@ -92,6 +109,34 @@ func (w *mealPlanGroceryListInitializer) InitializeGroceryListsForFinalizedMealP
 			continue
 		}
 
+		stock, ok := householdPantryStocks[mealPlan.BelongsToHousehold]
+		if !ok {
+			pantryItems, pantryErr := w.dataManager.GetUnexpiredPantryItemsForHousehold(ctx, mealPlan.BelongsToHousehold)
+			if pantryErr != nil {
+				// a missing pantry shouldn't prevent the grocery list from being created.
+				l.Error(pantryErr, "fetching pantry items for household")
+			}
+
+			stock = buildPantryStock(pantryItems)
+			householdPantryStocks[mealPlan.BelongsToHousehold] = stock
+		}
+
+		// we only need to consult the conversion table if the pantry holds an ingredient in a different unit than the list calls for.
+		conversions := unitconversion.NewGraph()
+		if unitsToConvert := stock.unitsToConvert(dbInputs); len(unitsToConvert) > 0 {
+			graph, graphErr := w.graphBuilder.BuildGraph(ctx, unitsToConvert...)
+			if graphErr != nil {
+				// without conversions, pantry stock can still offset items in the same unit.
+				l.Error(graphErr, "building measurement unit conversion graph")
+			} else {
+				conversions = graph
+			}
+		}
+
+		for _, dbInput := range dbInputs {
+			stock.applyTo(l, conversions, dbInput)
+		}
+
 		l = l.WithValue("grocery_list_items_to_create", len(dbInputs))
 		l.Info("creating grocery list items for meal plan")
 
@@ -106,3 +151,99 @@ func (w *mealPlanGroceryListInitializer) InitializeGroceryListsForFinalizedMealP
 
 	return errorResult.ErrorOrNil()
 }
+
+// buildPantryStock totals the quantities of a household's pantry items.
+func buildPantryStock(pantryItems []*types.PantryItem) pantryStock {
+	stock := pantryStock{}
+	for _, item := range pantryItems {
+		if stock[item.Ingredient.ID] == nil {
+			stock[item.Ingredient.ID] = map[string]float32{}
+		}
+		stock[item.Ingredient.ID][item.MeasurementUnit.ID] += item.Quantity
+	}
+
+	return stock
+}
+
+// unitsFor returns the units an ingredient is stocked in, starting with the preferred unit so nothing is converted unnecessarily.
+func (s pantryStock) unitsFor(ingredientID, preferredUnitID string) []string {
+	units := []string{}
+	for unitID := range s[ingredientID] {
+		if unitID != preferredUnitID {
+			units = append(units, unitID)
+		}
+	}
+	slices.Sort(units)
+
+	if _, ok := s[ingredientID][preferredUnitID]; ok {
+		units = append([]string{preferredUnitID}, units...)
+	}
+
+	return units
+}
+
+// unitsToConvert returns the measurement units that grocery list items and the pantry stock of the same ingredient disagree on.
+func (s pantryStock) unitsToConvert(inputs []*types.MealPlanGroceryListItemDatabaseCreationInput) []string {
+	unitIDs := []string{}
+	for _, input := range inputs {
+		for _, unitID := range s.unitsFor(input.ValidIngredientID, input.ValidMeasurementUnitID) {
+			if unitID != input.ValidMeasurementUnitID {
+				unitIDs = append(unitIDs, input.ValidMeasurementUnitID, unitID)
+			}
+		}
+	}
+
+	return unitIDs
+}
+
+// applyTo reduces the quantities needed by a grocery list item by whatever is on hand, converting pantry stock into the
+// item's unit where possible, marking it as already owned if the pantry covers it entirely, and deducts what was used from the stock.
+func (s pantryStock) applyTo(logger logging.Logger, conversions *unitconversion.Graph, input *types.MealPlanGroceryListItemDatabaseCreationInput) {
+	var covered float32
+	for _, unitID := range s.unitsFor(input.ValidIngredientID, input.ValidMeasurementUnitID) {
+		remaining := input.MinimumQuantityNeeded - covered
+		if remaining <= 0 {
+			break
+		}
+
+		available := s[input.ValidIngredientID][unitID]
+		if available <= 0 {
+			continue
+		}
+
+		factor, err := conversions.ConversionFactor(unitID, input.ValidMeasurementUnitID, input.ValidIngredientID)
+		if err != nil {
+			logger.WithValue(keys.ValidIngredientIDKey, input.ValidIngredientID).Error(fmt.Errorf("converting %s to %s: %w", unitID, input.ValidMeasurementUnitID, err), "applying pantry stock")
+			continue
+		}
+
+		if !factor.IsPositive() {
+			continue
+		}
+
+		convertedAvailable := float32(decimal.NewFromFloat32(available).Mul(factor).InexactFloat64())
+		if convertedAvailable >= remaining {
+			s[input.ValidIngredientID][unitID] = available - float32(decimal.NewFromFloat32(remaining).Div(factor).InexactFloat64())
+			covered += remaining
+			continue
+		}
+
+		s[input.ValidIngredientID][unitID] = 0
+		covered += convertedAvailable
+	}
+
+	if covered <= 0 {
+		return
+	}
+
+	if covered >= input.MinimumQuantityNeeded {
+		input.Status = types.MealPlanGroceryListItemStatusAlreadyOwned
+		input.StatusExplanation = pantryStockStatusExplanation
+		return
+	}
+
+	input.MinimumQuantityNeeded -= covered
+	if input.MaximumQuantityNeeded != nil {
+		input.MaximumQuantityNeeded = pointer.To(max(*input.MaximumQuantityNeeded-covered, input.MinimumQuantityNeeded))
+	}
+}
@@ -2,17 +2,20 @@
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	analyticsmock "github.com/dinnerdonebetter/backend/internal/analytics/mock"
 	"github.com/dinnerdonebetter/backend/internal/database"
 	"github.com/dinnerdonebetter/backend/internal/features/grocerylistpreparation"
 	"github.com/dinnerdonebetter/backend/internal/features/recipeanalysis"
+	"github.com/dinnerdonebetter/backend/internal/features/unitconversion"
 	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
 	"github.com/dinnerdonebetter/backend/internal/observability/logging"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
 	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 	testutils "github.com/dinnerdonebetter/backend/tests/utils"
 
@@ -34,6 +37,7 @@ func TestProvideMealPlanGroceryListInitializer(T *testing.T) {
 			&analyticsmock.EventReporter{},
 			tracing.NewNoopTracerProvider(),
 			&grocerylistpreparation.MockGroceryListCreator{},
+			&unitconversion.MockGraphBuilder{},
 		)
 		assert.NotNil(t, actual)
 	})
@@ -53,6 +57,7 @@ func TestMealPlanGroceryListInitializer_HandleMessage(T *testing.T) {
 			&analyticsmock.EventReporter{},
 			tracing.NewNoopTracerProvider(),
 			&grocerylistpreparation.MockGroceryListCreator{},
+			&unitconversion.MockGraphBuilder{},
 		).(*mealPlanGroceryListInitializer)
 		assert.NotNil(t, w)
 
@@ -210,6 +215,7 @@ func TestMealPlanGroceryListInitializer_HandleMessage(T *testing.T) {
 		mdm := database.NewMockDatabase()
 
 		mdm.MealPlanDataManagerMock.On("GetFinalizedMealPlansWithUninitializedGroceryLists", testutils.ContextMatcher).Return(expectedMealPlans, nil)
+		mdm.PantryItemDataManagerMock.On("GetUnexpiredPantryItemsForHousehold", testutils.ContextMatcher, expectedMealPlans[0].BelongsToHousehold).Return([]*types.PantryItem{}, nil)
 
 		firstMealPlanExpectedGroceryListItemInputs := []*types.MealPlanGroceryListItemDatabaseCreationInput{
 			{
@@ -268,4 +274,251 @@ func TestMealPlanGroceryListInitializer_HandleMessage(T *testing.T) {
 		assert.NoError(t, w.InitializeGroceryListsForFinalizedMealPlans(ctx, []byte("{}")))
 		mock.AssertExpectationsForObjects(t, mdm)
 	})
+	T.Run("with pantry stock", func(t *testing.T) {
+		t.Parallel()
+
+		w := ProvideMealPlanGroceryListInitializer(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&recipeanalysis.MockRecipeAnalyzer{},
+			&mockpublishers.Publisher{},
+			&analyticsmock.EventReporter{},
+			tracing.NewNoopTracerProvider(),
+			&grocerylistpreparation.MockGroceryListCreator{},
+			&unitconversion.MockGraphBuilder{},
+		).(*mealPlanGroceryListInitializer)
+		assert.NotNil(t, w)
+
+		onion := fakes.BuildFakeValidIngredient()
+		carrot := fakes.BuildFakeValidIngredient()
+		celery := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		expectedMealPlans := []*types.MealPlan{exampleMealPlan}
+
+		ctx := context.Background()
+		mdm := database.NewMockDatabase()
+
+		mdm.MealPlanDataManagerMock.On("GetFinalizedMealPlansWithUninitializedGroceryLists", testutils.ContextMatcher).Return(expectedMealPlans, nil)
+		mdm.PantryItemDataManagerMock.On("GetUnexpiredPantryItemsForHousehold", testutils.ContextMatcher, exampleMealPlan.BelongsToHousehold).Return([]*types.PantryItem{
+			{Ingredient: *onion, MeasurementUnit: *grams, Quantity: 150},
+			{Ingredient: *onion, MeasurementUnit: *grams, Quantity: 100},
+			{Ingredient: *carrot, MeasurementUnit: *grams, Quantity: 40},
+			{Ingredient: *celery, MeasurementUnit: *cups, Quantity: 500},
+		}, nil)
+
+		generatedInputs := []*types.MealPlanGroceryListItemDatabaseCreationInput{
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      onion.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  200,
+				MaximumQuantityNeeded:  pointer.To(float32(200)),
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      carrot.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  100,
+				MaximumQuantityNeeded:  pointer.To(float32(120)),
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      celery.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  100,
+				MaximumQuantityNeeded:  pointer.To(float32(100)),
+			},
+		}
+
+		mglm := &grocerylistpreparation.MockGroceryListCreator{}
+		mglm.On(
+			"GenerateGroceryListInputs",
+			testutils.ContextMatcher,
+			exampleMealPlan,
+		).Return(generatedInputs, nil)
+		w.groceryListCreator = mglm
+
+		expectedInputs := []*types.MealPlanGroceryListItemDatabaseCreationInput{
+			{
+				Status:                 types.MealPlanGroceryListItemStatusAlreadyOwned,
+				StatusExplanation:      pantryStockStatusExplanation,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      onion.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  200,
+				MaximumQuantityNeeded:  pointer.To(float32(200)),
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      carrot.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  60,
+				MaximumQuantityNeeded:  pointer.To(float32(80)),
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: grams.ID,
+				ValidIngredientID:      celery.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  100,
+				MaximumQuantityNeeded:  pointer.To(float32(100)),
+			},
+		}
+
+		for _, input := range expectedInputs {
+			mdm.MealPlanGroceryListItemDataManagerMock.On("CreateMealPlanGroceryListItem", testutils.ContextMatcher, input).Return((*types.MealPlanGroceryListItem)(nil), nil)
+		}
+		w.dataManager = mdm
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{grams.ID, cups.ID}).Return(unitconversion.NewGraph(), nil)
+		w.graphBuilder = graphBuilder
+
+		assert.NoError(t, w.InitializeGroceryListsForFinalizedMealPlans(ctx, []byte("{}")))
+		mock.AssertExpectationsForObjects(t, mdm, mglm, graphBuilder)
+	})
+
+	T.Run("with pantry stock in another measurement unit", func(t *testing.T) {
+		t.Parallel()
+
+		w := ProvideMealPlanGroceryListInitializer(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&recipeanalysis.MockRecipeAnalyzer{},
+			&mockpublishers.Publisher{},
+			&analyticsmock.EventReporter{},
+			tracing.NewNoopTracerProvider(),
+			&grocerylistpreparation.MockGroceryListCreator{},
+			&unitconversion.MockGraphBuilder{},
+		).(*mealPlanGroceryListInitializer)
+		assert.NotNil(t, w)
+
+		flour := fakes.BuildFakeValidIngredient()
+		sugar := fakes.BuildFakeValidIngredient()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+		tablespoons := fakes.BuildFakeValidMeasurementUnit()
+
+		cupsToTablespoons := fakes.BuildFakeValidMeasurementUnitConversion()
+		cupsToTablespoons.From = *cups
+		cupsToTablespoons.To = *tablespoons
+		cupsToTablespoons.Modifier = 16
+		cupsToTablespoons.OnlyForIngredient = nil
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+
+		ctx := context.Background()
+		mdm := database.NewMockDatabase()
+
+		mdm.MealPlanDataManagerMock.On("GetFinalizedMealPlansWithUninitializedGroceryLists", testutils.ContextMatcher).Return([]*types.MealPlan{exampleMealPlan}, nil)
+		mdm.PantryItemDataManagerMock.On("GetUnexpiredPantryItemsForHousehold", testutils.ContextMatcher, exampleMealPlan.BelongsToHousehold).Return([]*types.PantryItem{
+			{Ingredient: *flour, MeasurementUnit: *cups, Quantity: 1},
+			{Ingredient: *sugar, MeasurementUnit: *cups, Quantity: 2},
+		}, nil)
+
+		generatedInputs := []*types.MealPlanGroceryListItemDatabaseCreationInput{
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: tablespoons.ID,
+				ValidIngredientID:      flour.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  20,
+				MaximumQuantityNeeded:  pointer.To(float32(24)),
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: tablespoons.ID,
+				ValidIngredientID:      sugar.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  8,
+				MaximumQuantityNeeded:  pointer.To(float32(8)),
+			},
+		}
+
+		mglm := &grocerylistpreparation.MockGroceryListCreator{}
+		mglm.On(
+			"GenerateGroceryListInputs",
+			testutils.ContextMatcher,
+			exampleMealPlan,
+		).Return(generatedInputs, nil)
+		w.groceryListCreator = mglm
+
+		graphBuilder := &unitconversion.MockGraphBuilder{}
+		graphBuilder.On("BuildGraph", testutils.ContextMatcher, []string{tablespoons.ID, cups.ID, tablespoons.ID, cups.ID}).Return(unitconversion.NewGraph(cupsToTablespoons), nil)
+		w.graphBuilder = graphBuilder
+
+		expectedInputs := []*types.MealPlanGroceryListItemDatabaseCreationInput{
+			{
+				Status:                 types.MealPlanGroceryListItemStatusUnknown,
+				ValidMeasurementUnitID: tablespoons.ID,
+				ValidIngredientID:      flour.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  4,
+				MaximumQuantityNeeded:  pointer.To(float32(8)),
+			},
+			{
+				Status:                 types.MealPlanGroceryListItemStatusAlreadyOwned,
+				StatusExplanation:      pantryStockStatusExplanation,
+				ValidMeasurementUnitID: tablespoons.ID,
+				ValidIngredientID:      sugar.ID,
+				BelongsToMealPlan:      exampleMealPlan.ID,
+				MinimumQuantityNeeded:  8,
+				MaximumQuantityNeeded:  pointer.To(float32(8)),
+			},
+		}
+
+		for _, input := range expectedInputs {
+			mdm.MealPlanGroceryListItemDataManagerMock.On("CreateMealPlanGroceryListItem", testutils.ContextMatcher, input).Return((*types.MealPlanGroceryListItem)(nil), nil)
+		}
+		w.dataManager = mdm
+
+		assert.NoError(t, w.InitializeGroceryListsForFinalizedMealPlans(ctx, []byte("{}")))
+		mock.AssertExpectationsForObjects(t, mdm, mglm, graphBuilder)
+	})
+
+	T.Run("with error fetching pantry items", func(t *testing.T) {
+		t.Parallel()
+
+		w := ProvideMealPlanGroceryListInitializer(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&recipeanalysis.MockRecipeAnalyzer{},
+			&mockpublishers.Publisher{},
+			&analyticsmock.EventReporter{},
+			tracing.NewNoopTracerProvider(),
+			&grocerylistpreparation.MockGroceryListCreator{},
+			&unitconversion.MockGraphBuilder{},
+		).(*mealPlanGroceryListInitializer)
+		assert.NotNil(t, w)
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+
+		ctx := context.Background()
+		mdm := database.NewMockDatabase()
+
+		mdm.MealPlanDataManagerMock.On("GetFinalizedMealPlansWithUninitializedGroceryLists", testutils.ContextMatcher).Return([]*types.MealPlan{exampleMealPlan}, nil)
+		mdm.PantryItemDataManagerMock.On("GetUnexpiredPantryItemsForHousehold", testutils.ContextMatcher, exampleMealPlan.BelongsToHousehold).Return([]*types.PantryItem(nil), errors.New("blah"))
+
+		expectedInput := converters.ConvertMealPlanGroceryListItemToMealPlanGroceryListItemDatabaseCreationInput(fakes.BuildFakeMealPlanGroceryListItem())
+
+		mglm := &grocerylistpreparation.MockGroceryListCreator{}
+		mglm.On(
+			"GenerateGroceryListInputs",
+			testutils.ContextMatcher,
+			exampleMealPlan,
+		).Return([]*types.MealPlanGroceryListItemDatabaseCreationInput{expectedInput}, nil)
+		w.groceryListCreator = mglm
+
+		mdm.MealPlanGroceryListItemDataManagerMock.On("CreateMealPlanGroceryListItem", testutils.ContextMatcher, expectedInput).Return((*types.MealPlanGroceryListItem)(nil), nil)
+		w.dataManager = mdm
+
+		assert.NoError(t, w.InitializeGroceryListsForFinalizedMealPlans(ctx, []byte("{}")))
+		mock.AssertExpectationsForObjects(t, mdm, mglm)
+	})
 }
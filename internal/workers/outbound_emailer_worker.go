@@ -0,0 +1,166 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/dinnerdonebetter/backend/internal/analytics"
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/email"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+)
+
+var (
+	errUnknownEmailTemplate = errors.New("unknown email template")
+)
+
+type (
+	// OutboundEmailerWorker sends the emails described by delivery request messages.
+	OutboundEmailerWorker interface {
+		HandleMessage(ctx context.Context, message []byte) error
+	}
+
+	// outboundEmailerWorker sends emails.
+	outboundEmailerWorker struct {
+		logger                 logging.Logger
+		tracer                 tracing.Tracer
+		dataManager            database.DataManager
+		emailer                email.Emailer
+		analyticsEventReporter analytics.EventReporter
+		envCfg                 *email.EnvironmentConfig
+	}
+)
+
+// ProvideOutboundEmailerWorker provides an OutboundEmailerWorker.
+func ProvideOutboundEmailerWorker(
+	logger logging.Logger,
+	dataManager database.DataManager,
+	emailer email.Emailer,
+	analyticsEventReporter analytics.EventReporter,
+	envCfg *email.EnvironmentConfig,
+	tracerProvider tracing.TracerProvider,
+) OutboundEmailerWorker {
+	n := "outbound_emailer"
+
+	return &outboundEmailerWorker{
+		logger:                 logging.EnsureLogger(logger).WithName(n),
+		tracer:                 tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(n)),
+		dataManager:            dataManager,
+		emailer:                emailer,
+		analyticsEventReporter: analyticsEventReporter,
+		envCfg:                 envCfg,
+	}
+}
+
+// HandleMessage handles an email delivery request message.
+func (w *outboundEmailerWorker) HandleMessage(ctx context.Context, message []byte) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.Clone()
+
+	if w.envCfg == nil {
+		return observability.PrepareAndLogError(email.ErrMissingEnvCfg, logger, span, "getting environment config")
+	}
+
+	var emailDeliveryRequest email.DeliveryRequest
+	if err := json.Unmarshal(message, &emailDeliveryRequest); err != nil {
+		logger = logger.WithValue("raw_data", message)
+		return observability.PrepareAndLogError(err, logger, span, "unmarshalling delivery request message")
+	}
+
+	logger = logger.WithValue("template", emailDeliveryRequest.Template)
+
+	user, err := w.dataManager.GetUser(ctx, emailDeliveryRequest.UserID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "getting user")
+	}
+
+	var (
+		mail                   *email.OutboundEmailMessage
+		shouldSkipIfUnverified = true
+		emailType              string
+	)
+
+	switch emailDeliveryRequest.Template {
+	case email.TemplateTypeInvite:
+		if emailDeliveryRequest.Invitation == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "missing household invitation")
+		}
+
+		mail, err = email.BuildInviteMemberEmail(emailDeliveryRequest.Invitation, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building email message")
+		}
+		emailType = "invite"
+	case email.TemplateTypeUsernameReminder:
+		mail, err = email.BuildUsernameReminderEmail(user, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building username reminder email")
+		}
+		emailType = "username reminder"
+	case email.TemplateTypePasswordResetTokenCreated:
+		if emailDeliveryRequest.PasswordResetToken == nil {
+			return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "missing password reset token")
+		}
+
+		mail, err = email.BuildGeneratedPasswordResetTokenEmail(user, emailDeliveryRequest.PasswordResetToken, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building password reset token created email")
+		}
+		emailType = "password reset token"
+	case email.TemplateTypePasswordReset:
+		mail, err = email.BuildPasswordChangedEmail(user, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building password reset token email")
+		}
+		emailType = "password reset token"
+	case email.TemplateTypePasswordResetTokenRedeemed:
+		mail, err = email.BuildPasswordResetTokenRedeemedEmail(user, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building password reset token redemption email")
+		}
+		emailType = "password reset token redemption"
+	case email.TemplateTypeMealPlanCreated:
+		mail, err = email.BuildMealPlanCreatedEmail(user, emailDeliveryRequest.MealPlan, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building meal plan created email")
+		}
+		emailType = "meal plan created"
+	case email.TemplateTypePasskeyRegistered:
+		mail, err = email.BuildPasskeyRegisteredEmail(user, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building passkey registered email")
+		}
+		emailType = "passkey registered"
+	case email.TemplateTypeVerifyEmailAddress:
+		shouldSkipIfUnverified = false
+		mail, err = email.BuildVerifyEmailAddressEmail(user, emailDeliveryRequest.EmailVerificationToken, w.envCfg)
+		if err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "building address verification email")
+		}
+		emailType = "email address verification"
+	default:
+		return observability.PrepareAndLogError(errUnknownEmailTemplate, logger, span, "building email")
+	}
+
+	if shouldSkipIfUnverified && user.EmailAddressVerifiedAt == nil {
+		logger.Info("user email address not verified, skipping email delivery")
+		return nil
+	}
+
+	logger.Info("sending email")
+
+	if err = w.emailer.SendEmail(ctx, mail); err != nil {
+		observability.AcknowledgeError(err, logger, span, "sending %s email", emailType)
+	}
+
+	if err = w.analyticsEventReporter.EventOccurred(ctx, email.SentEventType, emailDeliveryRequest.UserID, emailDeliveryRequest.TemplateParams); err != nil {
+		observability.AcknowledgeError(err, logger, span, "notifying customer data platform")
+	}
+
+	return nil
+}
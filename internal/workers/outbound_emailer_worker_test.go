@@ -0,0 +1,217 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	analyticsmock "github.com/dinnerdonebetter/backend/internal/analytics/mock"
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/email"
+	emailmock "github.com/dinnerdonebetter/backend/internal/email/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testEmailEnvironment = "dev"
+
+func buildDeliveryRequestMessageForTest(t *testing.T, input *email.DeliveryRequest) []byte {
+	t.Helper()
+
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	return body
+}
+
+func TestProvideOutboundEmailerWorker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ProvideOutboundEmailerWorker(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&emailmock.Emailer{},
+			&analyticsmock.EventReporter{},
+			email.GetConfigForEnvironment(testEmailEnvironment),
+			tracing.NewNoopTracerProvider(),
+		)
+		assert.NotNil(t, actual)
+	})
+}
+
+func TestOutboundEmailerWorker_HandleMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		exampleUser.EmailAddressVerifiedAt = pointer.To(time.Now())
+		input := &email.DeliveryRequest{
+			UserID:   exampleUser.ID,
+			Template: email.TemplateTypeUsernameReminder,
+		}
+
+		dbm := database.NewMockDatabase()
+		dbm.UserDataManagerMock.On("GetUser", testutils.ContextMatcher, exampleUser.ID).Return(exampleUser, nil)
+
+		emailer := &emailmock.Emailer{}
+		emailer.On("SendEmail", testutils.ContextMatcher, mock.AnythingOfType("*email.OutboundEmailMessage")).Return(nil)
+
+		reporter := &analyticsmock.EventReporter{}
+		reporter.On("EventOccurred", testutils.ContextMatcher, email.SentEventType, exampleUser.ID, input.TemplateParams).Return(nil)
+
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), dbm, emailer, reporter, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDeliveryRequestMessageForTest(t, input)))
+
+		mock.AssertExpectationsForObjects(t, dbm, emailer, reporter)
+	})
+
+	T.Run("with passkey registered", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		exampleUser.EmailAddressVerifiedAt = pointer.To(time.Now())
+		input := &email.DeliveryRequest{
+			UserID:   exampleUser.ID,
+			Template: email.TemplateTypePasskeyRegistered,
+		}
+
+		dbm := database.NewMockDatabase()
+		dbm.UserDataManagerMock.On("GetUser", testutils.ContextMatcher, exampleUser.ID).Return(exampleUser, nil)
+
+		emailer := &emailmock.Emailer{}
+		emailer.On("SendEmail", testutils.ContextMatcher, mock.MatchedBy(func(message *email.OutboundEmailMessage) bool {
+			return message.ToAddress == exampleUser.EmailAddress && strings.Contains(message.Subject, "passkey")
+		})).Return(nil)
+
+		reporter := &analyticsmock.EventReporter{}
+		reporter.On("EventOccurred", testutils.ContextMatcher, email.SentEventType, exampleUser.ID, input.TemplateParams).Return(nil)
+
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), dbm, emailer, reporter, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDeliveryRequestMessageForTest(t, input)))
+
+		mock.AssertExpectationsForObjects(t, dbm, emailer, reporter)
+	})
+
+	T.Run("skips unverified users", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		exampleUser.EmailAddressVerifiedAt = nil
+		input := &email.DeliveryRequest{
+			UserID:     exampleUser.ID,
+			Template:   email.TemplateTypeInvite,
+			Invitation: fakes.BuildFakeHouseholdInvitation(),
+		}
+
+		dbm := database.NewMockDatabase()
+		dbm.UserDataManagerMock.On("GetUser", testutils.ContextMatcher, exampleUser.ID).Return(exampleUser, nil)
+
+		emailer := &emailmock.Emailer{}
+		reporter := &analyticsmock.EventReporter{}
+
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), dbm, emailer, reporter, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, buildDeliveryRequestMessageForTest(t, input)))
+
+		mock.AssertExpectationsForObjects(t, dbm, emailer, reporter)
+	})
+
+	T.Run("without environment config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), database.NewMockDatabase(), &emailmock.Emailer{}, &analyticsmock.EventReporter{}, nil, tracing.NewNoopTracerProvider())
+
+		assert.ErrorIs(t, worker.HandleMessage(ctx, []byte("{}")), email.ErrMissingEnvCfg)
+	})
+
+	T.Run("with invalid message", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), database.NewMockDatabase(), &emailmock.Emailer{}, &analyticsmock.EventReporter{}, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.Error(t, worker.HandleMessage(ctx, []byte("} bad JSON lol")))
+	})
+
+	T.Run("with error fetching user", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		input := &email.DeliveryRequest{
+			UserID:   exampleUser.ID,
+			Template: email.TemplateTypeUsernameReminder,
+		}
+
+		dbm := database.NewMockDatabase()
+		dbm.UserDataManagerMock.On("GetUser", testutils.ContextMatcher, exampleUser.ID).Return((*types.User)(nil), errors.New("blah"))
+
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), dbm, &emailmock.Emailer{}, &analyticsmock.EventReporter{}, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.Error(t, worker.HandleMessage(ctx, buildDeliveryRequestMessageForTest(t, input)))
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("with missing invitation", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		input := &email.DeliveryRequest{
+			UserID:   exampleUser.ID,
+			Template: email.TemplateTypeInvite,
+		}
+
+		dbm := database.NewMockDatabase()
+		dbm.UserDataManagerMock.On("GetUser", testutils.ContextMatcher, exampleUser.ID).Return(exampleUser, nil)
+
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), dbm, &emailmock.Emailer{}, &analyticsmock.EventReporter{}, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.ErrorIs(t, worker.HandleMessage(ctx, buildDeliveryRequestMessageForTest(t, input)), errRequiredDataIsNil)
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("with unknown template", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		exampleUser := fakes.BuildFakeUser()
+		input := &email.DeliveryRequest{
+			UserID:   exampleUser.ID,
+			Template: "blah",
+		}
+
+		dbm := database.NewMockDatabase()
+		dbm.UserDataManagerMock.On("GetUser", testutils.ContextMatcher, exampleUser.ID).Return(exampleUser, nil)
+
+		worker := ProvideOutboundEmailerWorker(logging.NewNoopLogger(), dbm, &emailmock.Emailer{}, &analyticsmock.EventReporter{}, email.GetConfigForEnvironment(testEmailEnvironment), tracing.NewNoopTracerProvider())
+
+		assert.ErrorIs(t, worker.HandleMessage(ctx, buildDeliveryRequestMessageForTest(t, input)), errUnknownEmailTemplate)
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+}
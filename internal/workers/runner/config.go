@@ -0,0 +1,50 @@
+package runner
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	defaultConcurrency     = 16
+	defaultShutdownTimeout = 30 * time.Second
+)
+
+// Config configures a Runner. At most Concurrency messages are handled at once, across every topic. Consumers hand
+// over several of a topic's messages at once (a received SQS batch, or up to ten from Redis), so one busy topic can
+// use more than one slot.
+// On shutdown, in-flight messages get ShutdownTimeout to finish before their contexts are cancelled.
+type Config struct {
+	_ struct{} `json:"-"`
+
+	ShutdownTimeout time.Duration `json:"shutdownTimeout,omitempty" toml:"shutdown_timeout,omitempty"`
+	Concurrency     uint16        `json:"concurrency,omitempty"     toml:"concurrency,omitempty"`
+}
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg *Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.ShutdownTimeout, validation.Min(time.Duration(0))),
+	)
+}
+
+// withDefaults returns a copy of the config with zero values replaced by sensible defaults.
+func (cfg *Config) withDefaults() *Config {
+	x := &Config{}
+	if cfg != nil {
+		*x = *cfg
+	}
+
+	if x.Concurrency == 0 {
+		x.Concurrency = defaultConcurrency
+	}
+	if x.ShutdownTimeout == 0 {
+		x.ShutdownTimeout = defaultShutdownTimeout
+	}
+
+	return x
+}
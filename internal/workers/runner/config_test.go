@@ -0,0 +1,58 @@
+package runner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			Concurrency:     4,
+			ShutdownTimeout: time.Second,
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with negative shutdown timeout", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{
+			ShutdownTimeout: -time.Second,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(context.Background()))
+	})
+}
+
+func TestConfig_withDefaults(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil config", func(t *testing.T) {
+		t.Parallel()
+
+		var cfg *Config
+		actual := cfg.withDefaults()
+
+		assert.Equal(t, uint16(defaultConcurrency), actual.Concurrency)
+		assert.Equal(t, defaultShutdownTimeout, actual.ShutdownTimeout)
+	})
+
+	T.Run("preserves provided values", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{Concurrency: 2, ShutdownTimeout: time.Second}
+		actual := cfg.withDefaults()
+
+		assert.Equal(t, uint16(2), actual.Concurrency)
+		assert.Equal(t, time.Second, actual.ShutdownTimeout)
+	})
+}
@@ -0,0 +1,5 @@
+/*
+Package runner consumes message queue topics and hands their messages to worker handlers,
+bounding how many run at once and letting in-flight messages finish on shutdown.
+*/
+package runner
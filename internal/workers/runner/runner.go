@@ -0,0 +1,254 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+)
+
+var (
+	// ErrNoHandlersRegistered indicates Run was called before any handlers were registered.
+	ErrNoHandlersRegistered = errors.New("no handlers registered")
+	// ErrAlreadyRunning indicates Run was called on a Runner that is already running.
+	ErrAlreadyRunning = errors.New("runner is already running")
+	// ErrTopicAlreadyRegistered indicates a handler was registered for a topic that already has one.
+	ErrTopicAlreadyRegistered = errors.New("topic already has a handler registered")
+	// ErrNilHandler indicates a nil handler was registered.
+	ErrNilHandler = errors.New("nil handler provided")
+	// ErrShuttingDown is returned to a consumer for messages that arrive after shutdown begins, so they can be redelivered.
+	ErrShuttingDown = errors.New("runner is shutting down")
+	// ErrShutdownTimedOut indicates in-flight handlers were still running when the shutdown timeout elapsed.
+	ErrShutdownTimedOut = errors.New("timed out waiting for in-flight messages")
+)
+
+type (
+	// Handler handles a message consumed from a topic.
+	Handler func(ctx context.Context, message []byte) error
+
+	// Runner consumes topics and dispatches their messages to handlers.
+	Runner interface {
+		Register(topic string, handler Handler) error
+		Run(ctx context.Context) error
+	}
+
+	registration struct {
+		handler Handler
+		topic   string
+	}
+
+	runner struct {
+		logger           logging.Logger
+		tracer           tracing.Tracer
+		cfg              *Config
+		consumerProvider messagequeue.ConsumerProvider
+		registrations    []*registration
+		running          bool
+		hat              sync.Mutex
+	}
+)
+
+// NewRunner creates a Runner.
+func NewRunner(logger logging.Logger, tracerProvider tracing.TracerProvider, cfg *Config, consumerProvider messagequeue.ConsumerProvider) Runner {
+	n := "worker_runner"
+
+	return &runner{
+		logger:           logging.EnsureLogger(logger).WithName(n),
+		tracer:           tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(n)),
+		cfg:              cfg.withDefaults(),
+		consumerProvider: consumerProvider,
+	}
+}
+
+// Register sets the handler for a topic's messages.
+func (r *runner) Register(topic string, handler Handler) error {
+	if topic == "" {
+		return messagequeue.ErrEmptyTopicName
+	}
+
+	if handler == nil {
+		return ErrNilHandler
+	}
+
+	r.hat.Lock()
+	defer r.hat.Unlock()
+
+	if slices.ContainsFunc(r.registrations, func(x *registration) bool { return x.topic == topic }) {
+		return fmt.Errorf("%w: %s", ErrTopicAlreadyRegistered, topic)
+	}
+
+	r.registrations = append(r.registrations, &registration{topic: topic, handler: handler})
+
+	return nil
+}
+
+// dispatcher tracks the messages a Run is handling, so that shutdown can wait for them.
+type dispatcher struct {
+	ctx      context.Context
+	logger   logging.Logger
+	tracer   tracing.Tracer
+	slots    chan struct{}
+	stopping chan struct{}
+	inFlight sync.WaitGroup
+	stopped  bool
+	hat      sync.RWMutex
+}
+
+// handlerFor adapts a Handler for a consumer. The consumer is only told a message was handled once its handler
+// has finished, so a message whose handler fails, panics, or is cut off by shutdown can be redelivered. Consumers
+// block while every slot is taken, so a busy Runner stops pulling messages off of its queues.
+func (d *dispatcher) handlerFor(reg *registration) func(context.Context, []byte) error {
+	logger := d.logger.WithValue("topic", reg.topic)
+
+	return func(_ context.Context, message []byte) (err error) {
+		select {
+		case d.slots <- struct{}{}:
+		case <-d.stopping:
+			return ErrShuttingDown
+		}
+
+		d.hat.RLock()
+		if d.stopped {
+			d.hat.RUnlock()
+			<-d.slots
+			return ErrShuttingDown
+		}
+		d.inFlight.Add(1)
+		d.hat.RUnlock()
+
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = fmt.Errorf("handler panicked: %v", recovered)
+				logger.Error(err, "handling message")
+			}
+			<-d.slots
+			d.inFlight.Done()
+		}()
+
+		ctx, span := d.tracer.StartSpan(d.ctx)
+		defer span.End()
+
+		if err = reg.handler(ctx, message); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "handling message")
+		}
+
+		return nil
+	}
+}
+
+// stop keeps any more messages from being handled and waits up to timeout for in-flight ones to finish.
+func (d *dispatcher) stop(timeout time.Duration) error {
+	close(d.stopping)
+
+	d.hat.Lock()
+	d.stopped = true
+	d.hat.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		return ErrShutdownTimedOut
+	}
+}
+
+// Run consumes every registered topic until the context is cancelled, then shuts down gracefully.
+func (r *runner) Run(ctx context.Context) error {
+	r.hat.Lock()
+	if r.running {
+		r.hat.Unlock()
+		return ErrAlreadyRunning
+	}
+	registrations := slices.Clone(r.registrations)
+	r.running = true
+	r.hat.Unlock()
+
+	defer func() {
+		r.hat.Lock()
+		r.running = false
+		r.hat.Unlock()
+	}()
+
+	if len(registrations) == 0 {
+		return ErrNoHandlersRegistered
+	}
+
+	logger := r.logger.WithValue("concurrency", r.cfg.Concurrency)
+
+	// handlers get to finish what they're doing when shutdown begins, and are only cancelled once they outlast the shutdown timeout.
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelHandlers()
+
+	d := &dispatcher{
+		ctx:      handlerCtx,
+		logger:   logger,
+		tracer:   r.tracer,
+		slots:    make(chan struct{}, r.cfg.Concurrency),
+		stopping: make(chan struct{}),
+	}
+
+	finished := make(chan struct{})
+	defer close(finished)
+
+	consumerErrors := make(chan error, len(registrations))
+	go func() {
+		for {
+			select {
+			case err := <-consumerErrors:
+				logger.Error(err, "consuming message")
+			case <-finished:
+				return
+			}
+		}
+	}()
+
+	stopChans := make([]chan bool, 0, len(registrations))
+	stopConsumers := func() {
+		for _, stopChan := range stopChans {
+			stopChan <- true
+		}
+	}
+
+	for _, reg := range registrations {
+		consumer, err := r.consumerProvider.ProvideConsumer(ctx, reg.topic, d.handlerFor(reg))
+		if err != nil {
+			stopConsumers()
+			return observability.PrepareAndLogError(err, logger.WithValue("topic", reg.topic), nil, "providing consumer")
+		}
+
+		// buffered, so that stopping never blocks on a consumer that has already quit.
+		stopChan := make(chan bool, 1)
+		stopChans = append(stopChans, stopChan)
+
+		go consumer.Consume(stopChan, consumerErrors)
+	}
+
+	logger.WithValue("topic_count", len(registrations)).Info("consuming topics")
+
+	<-ctx.Done()
+
+	logger.Info("shutting down")
+	stopConsumers()
+
+	if err := d.stop(r.cfg.ShutdownTimeout); err != nil {
+		cancelHandlers()
+		return observability.PrepareAndLogError(err, logger, nil, "shutting down")
+	}
+
+	logger.Info("shut down")
+
+	return nil
+}
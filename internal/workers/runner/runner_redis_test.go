@@ -0,0 +1,171 @@
+package runner
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/internal/messagequeue/redis"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	rediscontainers "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+var (
+	runningContainerTests = strings.ToLower(os.Getenv("RUN_CONTAINER_TESTS")) == "true"
+)
+
+func buildContainerBackedRedisConfig(t *testing.T, ctx context.Context) (config *redis.Config, shutdownFunction func(context.Context) error) {
+	t.Helper()
+
+	redisContainer, err := rediscontainers.RunContainer(ctx,
+		testcontainers.WithImage("redis:7-bullseye"),
+		rediscontainers.WithLogLevel(rediscontainers.LogLevelNotice),
+	)
+	require.NoError(t, err)
+
+	redisAddress, err := redisContainer.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	cfg := &redis.Config{
+		QueueAddresses: []string{
+			strings.TrimPrefix(redisAddress, "redis://"),
+		},
+	}
+
+	return cfg, redisContainer.Terminate
+}
+
+func TestRunner_Run_WithRedis(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		if !runningContainerTests {
+			t.SkipNow()
+		}
+
+		ctx := context.Background()
+		cfg, containerShutdown := buildContainerBackedRedisConfig(t, ctx)
+		defer func() {
+			assert.NoError(t, containerShutdown(ctx))
+		}()
+
+		logger := logging.NewNoopLogger()
+		tracerProvider := tracing.NewNoopTracerProvider()
+
+		const (
+			dataChangesTopic    = "data_changes"
+			outboundEmailsTopic = "outbound_emails"
+			messagesPerTopic    = 10
+		)
+
+		var (
+			hat      sync.Mutex
+			received = map[string]int{}
+			allDone  = make(chan struct{})
+		)
+
+		handlerFor := func(topic string) Handler {
+			return func(context.Context, []byte) error {
+				hat.Lock()
+				defer hat.Unlock()
+
+				received[topic]++
+				if received[dataChangesTopic] == messagesPerTopic && received[outboundEmailsTopic] == messagesPerTopic {
+					close(allDone)
+				}
+
+				return nil
+			}
+		}
+
+		r := NewRunner(logger, tracerProvider, &Config{Concurrency: 2}, redis.ProvideRedisConsumerProvider(logger, tracerProvider, *cfg))
+		require.NoError(t, r.Register(dataChangesTopic, handlerFor(dataChangesTopic)))
+		require.NoError(t, r.Register(outboundEmailsTopic, handlerFor(outboundEmailsTopic)))
+
+		runCtx, cancel := context.WithCancel(ctx)
+		result := make(chan error, 1)
+		go func() {
+			result <- r.Run(runCtx)
+		}()
+
+		// redis pub/sub only delivers to subscribers that are already listening.
+		<-time.After(time.Second)
+
+		publisherProvider := redis.ProvideRedisPublisherProvider(logger, tracerProvider, *cfg)
+		for _, topic := range []string{dataChangesTopic, outboundEmailsTopic} {
+			publisher, err := publisherProvider.ProvidePublisher(topic)
+			require.NoError(t, err)
+
+			for range messagesPerTopic {
+				require.NoError(t, publisher.Publish(ctx, map[string]string{"topic": topic}))
+			}
+		}
+
+		select {
+		case <-allDone:
+		case <-time.After(30 * time.Second):
+			t.Fatal("timed out waiting for messages to be handled")
+		}
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+
+	T.Run("finishes in-flight messages on shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		if !runningContainerTests {
+			t.SkipNow()
+		}
+
+		ctx := context.Background()
+		cfg, containerShutdown := buildContainerBackedRedisConfig(t, ctx)
+		defer func() {
+			assert.NoError(t, containerShutdown(ctx))
+		}()
+
+		logger := logging.NewNoopLogger()
+		tracerProvider := tracing.NewNoopTracerProvider()
+
+		started, finished := make(chan struct{}), make(chan struct{})
+		r := NewRunner(logger, tracerProvider, &Config{ShutdownTimeout: 30 * time.Second}, redis.ProvideRedisConsumerProvider(logger, tracerProvider, *cfg))
+		require.NoError(t, r.Register(t.Name(), func(context.Context, []byte) error {
+			close(started)
+			<-time.After(500 * time.Millisecond)
+			close(finished)
+			return nil
+		}))
+
+		runCtx, cancel := context.WithCancel(ctx)
+		result := make(chan error, 1)
+		go func() {
+			result <- r.Run(runCtx)
+		}()
+
+		<-time.After(time.Second)
+
+		publisher, err := redis.ProvideRedisPublisherProvider(logger, tracerProvider, *cfg).ProvidePublisher(t.Name())
+		require.NoError(t, err)
+		require.NoError(t, publisher.Publish(ctx, []byte("blah")))
+
+		<-started
+		cancel()
+
+		assert.NoError(t, <-result)
+		select {
+		case <-finished:
+		default:
+			t.Fatal("runner returned before the in-flight message was handled")
+		}
+	})
+}
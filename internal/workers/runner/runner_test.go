@@ -0,0 +1,389 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	mockpublishers "github.com/dinnerdonebetter/backend/internal/messagequeue/mock"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// consumerHandlers collects the handler functions a Runner gives to its consumers.
+type consumerHandlers struct {
+	handlers map[string]func(context.Context, []byte) error
+	ready    chan string
+	hat      sync.Mutex
+}
+
+func (c *consumerHandlers) get(topic string) func(context.Context, []byte) error {
+	c.hat.Lock()
+	defer c.hat.Unlock()
+
+	return c.handlers[topic]
+}
+
+// buildTestRunner builds a Runner whose consumers wait to be stopped, and collects the handlers it gives them.
+func buildTestRunner(t *testing.T, cfg *Config, topics ...string) (*runner, *consumerHandlers) {
+	t.Helper()
+
+	captured := &consumerHandlers{
+		handlers: map[string]func(context.Context, []byte) error{},
+		ready:    make(chan string, len(topics)),
+	}
+
+	consumer := &mockpublishers.Consumer{}
+	consumer.On("Consume", mock.AnythingOfType("chan bool"), mock.AnythingOfType("chan error")).Run(func(args mock.Arguments) {
+		<-args.Get(0).(chan bool)
+	})
+
+	consumerProvider := &mockpublishers.ConsumerProvider{}
+	for _, topic := range topics {
+		consumerProvider.On("ProvideConsumer", testutils.ContextMatcher, topic, mock.Anything).Run(func(args mock.Arguments) {
+			captured.hat.Lock()
+			captured.handlers[topic] = args.Get(2).(func(context.Context, []byte) error)
+			captured.hat.Unlock()
+			captured.ready <- topic
+		}).Return(consumer, nil)
+	}
+
+	r := NewRunner(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), cfg, consumerProvider)
+	require.NotNil(t, r)
+
+	return r.(*runner), captured
+}
+
+// startRunner runs a Runner in the background and waits until every topic has a consumer.
+func startRunner(t *testing.T, r *runner, captured *consumerHandlers) (context.CancelFunc, chan error) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() {
+		result <- r.Run(ctx)
+	}()
+
+	for range r.registrations {
+		<-captured.ready
+	}
+
+	return cancel, result
+}
+
+func TestNewRunner(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := NewRunner(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), nil, &mockpublishers.ConsumerProvider{})
+		assert.NotNil(t, actual)
+	})
+}
+
+func TestRunner_Register(T *testing.T) {
+	T.Parallel()
+
+	hf := func(context.Context, []byte) error { return nil }
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := buildTestRunner(t, nil)
+
+		assert.NoError(t, r.Register(t.Name(), hf))
+	})
+
+	T.Run("with empty topic", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := buildTestRunner(t, nil)
+
+		assert.Error(t, r.Register("", hf))
+	})
+
+	T.Run("with nil handler", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := buildTestRunner(t, nil)
+
+		assert.ErrorIs(t, r.Register(t.Name(), nil), ErrNilHandler)
+	})
+
+	T.Run("with topic already registered", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := buildTestRunner(t, nil)
+
+		require.NoError(t, r.Register(t.Name(), hf))
+		assert.ErrorIs(t, r.Register(t.Name(), hf), ErrTopicAlreadyRegistered)
+	})
+}
+
+func TestRunner_Run(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			emailsTopic  = "emails"
+			indexerTopic = "indexer"
+		)
+
+		r, captured := buildTestRunner(t, nil, emailsTopic, indexerTopic)
+
+		handled := make(chan string, 2)
+		require.NoError(t, r.Register(emailsTopic, func(_ context.Context, message []byte) error {
+			handled <- emailsTopic + ":" + string(message)
+			return nil
+		}))
+		require.NoError(t, r.Register(indexerTopic, func(_ context.Context, message []byte) error {
+			handled <- indexerTopic + ":" + string(message)
+			return nil
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		ctx := context.Background()
+		assert.NoError(t, captured.get(emailsTopic)(ctx, []byte("one")))
+		assert.NoError(t, captured.get(indexerTopic)(ctx, []byte("two")))
+
+		assert.ElementsMatch(t, []string{"emails:one", "indexer:two"}, []string{<-handled, <-handled})
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+
+	T.Run("with no handlers registered", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := buildTestRunner(t, nil)
+
+		assert.ErrorIs(t, r.Run(context.Background()), ErrNoHandlersRegistered)
+	})
+
+	T.Run("with error providing consumer", func(t *testing.T) {
+		t.Parallel()
+
+		consumerProvider := &mockpublishers.ConsumerProvider{}
+		consumerProvider.On("ProvideConsumer", testutils.ContextMatcher, t.Name(), mock.Anything).Return((*mockpublishers.Consumer)(nil), errors.New("blah"))
+
+		r := NewRunner(logging.NewNoopLogger(), tracing.NewNoopTracerProvider(), nil, consumerProvider)
+		require.NoError(t, r.Register(t.Name(), func(context.Context, []byte) error { return nil }))
+
+		assert.Error(t, r.Run(context.Background()))
+
+		mock.AssertExpectationsForObjects(t, consumerProvider)
+	})
+
+	T.Run("with handler error", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, nil, t.Name())
+
+		expectedErr := errors.New("blah")
+		require.NoError(t, r.Register(t.Name(), func(context.Context, []byte) error {
+			return expectedErr
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		// the consumer hears about the failure, so the message isn't acknowledged and can be redelivered.
+		assert.ErrorIs(t, captured.get(t.Name())(context.Background(), []byte("blah")), expectedErr)
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+
+	T.Run("with panicking handler", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, nil, t.Name())
+
+		require.NoError(t, r.Register(t.Name(), func(_ context.Context, message []byte) error {
+			if string(message) == "panic" {
+				panic("blah")
+			}
+			return nil
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		assert.Error(t, captured.get(t.Name())(context.Background(), []byte("panic")))
+		assert.NoError(t, captured.get(t.Name())(context.Background(), []byte("fine")))
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+
+	T.Run("handles a topic's messages concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, &Config{Concurrency: 2}, t.Name())
+
+		started, release := make(chan string, 2), make(chan struct{})
+		require.NoError(t, r.Register(t.Name(), func(_ context.Context, message []byte) error {
+			started <- string(message)
+			<-release
+			return nil
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		handler := captured.get(t.Name())
+
+		handled := make(chan error, 2)
+		for _, message := range []string{"first", "second"} {
+			go func() {
+				handled <- handler(context.Background(), []byte(message))
+			}()
+		}
+
+		actual := []string{<-started, <-started}
+		assert.ElementsMatch(t, []string{"first", "second"}, actual)
+
+		close(release)
+		assert.NoError(t, <-handled)
+		assert.NoError(t, <-handled)
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+
+	T.Run("bounds concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, &Config{Concurrency: 1}, t.Name())
+
+		started, release := make(chan string, 2), make(chan struct{})
+		require.NoError(t, r.Register(t.Name(), func(_ context.Context, message []byte) error {
+			started <- string(message)
+			<-release
+			return nil
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		handler := captured.get(t.Name())
+
+		firstHandled := make(chan error, 1)
+		go func() {
+			firstHandled <- handler(context.Background(), []byte("first"))
+		}()
+		assert.Equal(t, "first", <-started)
+
+		secondHandled := make(chan error, 1)
+		go func() {
+			secondHandled <- handler(context.Background(), []byte("second"))
+		}()
+
+		select {
+		case <-started:
+			t.Fatal("second message was handled while the only slot was taken")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release <- struct{}{}
+		assert.NoError(t, <-firstHandled)
+		assert.Equal(t, "second", <-started)
+
+		release <- struct{}{}
+		assert.NoError(t, <-secondHandled)
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+
+	T.Run("waits for in-flight messages on shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, &Config{ShutdownTimeout: time.Minute}, t.Name())
+
+		started, release := make(chan struct{}), make(chan struct{})
+		var handlerCtxErr error
+		require.NoError(t, r.Register(t.Name(), func(ctx context.Context, _ []byte) error {
+			close(started)
+			<-release
+			handlerCtxErr = ctx.Err()
+			return nil
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		handled := make(chan error, 1)
+		go func() {
+			handled <- captured.get(t.Name())(context.Background(), []byte("blah"))
+		}()
+		<-started
+
+		cancel()
+
+		select {
+		case <-result:
+			t.Fatal("runner returned before its in-flight message was handled")
+		case <-handled:
+			t.Fatal("consumer was told the message was handled before its handler finished")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(release)
+		assert.NoError(t, <-handled)
+		assert.NoError(t, <-result)
+		assert.NoError(t, handlerCtxErr)
+
+		// anything the consumer hands over after shutdown is turned away, so it can be redelivered.
+		assert.ErrorIs(t, captured.get(t.Name())(context.Background(), []byte("late")), ErrShuttingDown)
+	})
+
+	T.Run("with in-flight messages outlasting the shutdown timeout", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, &Config{ShutdownTimeout: 10 * time.Millisecond}, t.Name())
+
+		started, cancelled := make(chan struct{}), make(chan struct{})
+		require.NoError(t, r.Register(t.Name(), func(ctx context.Context, _ []byte) error {
+			close(started)
+			<-ctx.Done()
+			close(cancelled)
+			return ctx.Err()
+		}))
+
+		cancel, result := startRunner(t, r, captured)
+
+		handled := make(chan error, 1)
+		go func() {
+			handled <- captured.get(t.Name())(context.Background(), []byte("blah"))
+		}()
+		<-started
+
+		cancel()
+		assert.ErrorIs(t, <-result, ErrShutdownTimedOut)
+		<-cancelled
+
+		// the cut off message is reported as unhandled, so the consumer leaves it to be redelivered.
+		assert.ErrorIs(t, <-handled, context.Canceled)
+	})
+
+	T.Run("when already running", func(t *testing.T) {
+		t.Parallel()
+
+		r, captured := buildTestRunner(t, nil, t.Name())
+		require.NoError(t, r.Register(t.Name(), func(context.Context, []byte) error { return nil }))
+
+		cancel, result := startRunner(t, r, captured)
+
+		assert.ErrorIs(t, r.Run(context.Background()), ErrAlreadyRunning)
+
+		cancel()
+		assert.NoError(t, <-result)
+	})
+}
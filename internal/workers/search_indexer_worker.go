@@ -0,0 +1,70 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	searchcfg "github.com/dinnerdonebetter/backend/internal/search/config"
+	"github.com/dinnerdonebetter/backend/internal/search/indexing"
+)
+
+type (
+	// SearchIndexerWorker indexes the records described by search index request messages.
+	SearchIndexerWorker interface {
+		HandleMessage(ctx context.Context, message []byte) error
+	}
+
+	// searchIndexerWorker indexes data for search.
+	searchIndexerWorker struct {
+		logger         logging.Logger
+		tracer         tracing.Tracer
+		tracerProvider tracing.TracerProvider
+		dataManager    database.DataManager
+		searchConfig   *searchcfg.Config
+		indexFunc      func(ctx context.Context, l logging.Logger, tracerProvider tracing.TracerProvider, searchConfig *searchcfg.Config, dataManager database.DataManager, indexReq *indexing.IndexRequest) error
+	}
+)
+
+// ProvideSearchIndexerWorker provides a SearchIndexerWorker.
+func ProvideSearchIndexerWorker(
+	logger logging.Logger,
+	dataManager database.DataManager,
+	searchConfig *searchcfg.Config,
+	tracerProvider tracing.TracerProvider,
+) SearchIndexerWorker {
+	n := "search_indexer"
+
+	return &searchIndexerWorker{
+		logger:         logging.EnsureLogger(logger).WithName(n),
+		tracer:         tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(n)),
+		tracerProvider: tracerProvider,
+		dataManager:    dataManager,
+		searchConfig:   searchConfig,
+		indexFunc:      indexing.HandleIndexRequest,
+	}
+}
+
+// HandleMessage handles a search index request message.
+func (w *searchIndexerWorker) HandleMessage(ctx context.Context, message []byte) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.Clone()
+
+	var searchIndexRequest *indexing.IndexRequest
+	if err := json.Unmarshal(message, &searchIndexRequest); err != nil {
+		logger = logger.WithValue("raw_data", message)
+		return observability.PrepareAndLogError(err, logger, span, "unmarshalling search index request message")
+	}
+
+	// we don't want to retry indexing perpetually in the event of a fundamental error, so we just log it and move on
+	if err := w.indexFunc(ctx, logger, w.tracerProvider, w.searchConfig, w.dataManager, searchIndexRequest); err != nil {
+		observability.AcknowledgeError(err, logger, span, "handling index request")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/internal/search"
+	searchcfg "github.com/dinnerdonebetter/backend/internal/search/config"
+	"github.com/dinnerdonebetter/backend/internal/search/indexing"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSearchIndexerWorker(t *testing.T, indexFunc func(context.Context, logging.Logger, tracing.TracerProvider, *searchcfg.Config, database.DataManager, *indexing.IndexRequest) error) *searchIndexerWorker {
+	t.Helper()
+
+	worker := ProvideSearchIndexerWorker(
+		logging.NewNoopLogger(),
+		database.NewMockDatabase(),
+		&searchcfg.Config{},
+		tracing.NewNoopTracerProvider(),
+	)
+	require.NotNil(t, worker)
+
+	w := worker.(*searchIndexerWorker)
+	w.indexFunc = indexFunc
+
+	return w
+}
+
+func TestProvideSearchIndexerWorker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ProvideSearchIndexerWorker(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&searchcfg.Config{},
+			tracing.NewNoopTracerProvider(),
+		)
+		assert.NotNil(t, actual)
+	})
+}
+
+func TestSearchIndexerWorker_HandleMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input := &indexing.IndexRequest{
+			RowID:     fakes.BuildFakeID(),
+			IndexType: search.IndexTypeRecipes,
+		}
+		body, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		var received *indexing.IndexRequest
+		worker := newTestSearchIndexerWorker(t, func(_ context.Context, _ logging.Logger, _ tracing.TracerProvider, _ *searchcfg.Config, _ database.DataManager, indexReq *indexing.IndexRequest) error {
+			received = indexReq
+			return nil
+		})
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+		assert.Equal(t, input, received)
+	})
+
+	T.Run("with invalid message", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		worker := newTestSearchIndexerWorker(t, func(context.Context, logging.Logger, tracing.TracerProvider, *searchcfg.Config, database.DataManager, *indexing.IndexRequest) error {
+			t.Fatal("index function should not have been called")
+			return nil
+		})
+
+		assert.Error(t, worker.HandleMessage(ctx, []byte("} bad JSON lol")))
+	})
+
+	T.Run("with error indexing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		body, err := json.Marshal(&indexing.IndexRequest{
+			RowID:     fakes.BuildFakeID(),
+			IndexType: search.IndexTypeRecipes,
+		})
+		require.NoError(t, err)
+
+		worker := newTestSearchIndexerWorker(t, func(context.Context, logging.Logger, tracing.TracerProvider, *searchcfg.Config, database.DataManager, *indexing.IndexRequest) error {
+			return errors.New("blah")
+		})
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+	})
+}
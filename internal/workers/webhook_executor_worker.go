@@ -0,0 +1,137 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+type (
+	// WebhookExecutorWorker delivers the payloads described by webhook execution request messages.
+	WebhookExecutorWorker interface {
+		HandleMessage(ctx context.Context, message []byte) error
+	}
+
+	// webhookExecutorWorker executes webhooks.
+	webhookExecutorWorker struct {
+		logger      logging.Logger
+		tracer      tracing.Tracer
+		dataManager database.DataManager
+		deliverer   webhookdelivery.Deliverer
+	}
+)
+
+// ProvideWebhookExecutorWorker provides a WebhookExecutorWorker.
+func ProvideWebhookExecutorWorker(
+	logger logging.Logger,
+	dataManager database.DataManager,
+	deliverer webhookdelivery.Deliverer,
+	tracerProvider tracing.TracerProvider,
+) WebhookExecutorWorker {
+	n := "webhook_executor"
+
+	return &webhookExecutorWorker{
+		logger:      logging.EnsureLogger(logger).WithName(n),
+		tracer:      tracing.NewTracer(tracing.EnsureTracerProvider(tracerProvider).Tracer(n)),
+		dataManager: dataManager,
+		deliverer:   deliverer,
+	}
+}
+
+// HandleMessage handles a webhook execution request message.
+func (w *webhookExecutorWorker) HandleMessage(ctx context.Context, message []byte) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.Clone()
+
+	var webhookExecutionRequest *types.WebhookExecutionRequest
+	if err := json.Unmarshal(message, &webhookExecutionRequest); err != nil {
+		logger = logger.WithValue("raw_data", message)
+		return observability.PrepareAndLogError(err, logger, span, "unmarshalling webhook execution request message")
+	}
+
+	if webhookExecutionRequest == nil {
+		return observability.PrepareAndLogError(errRequiredDataIsNil, logger, span, "reading webhook execution request message")
+	}
+
+	logger = logger.WithValue(keys.HouseholdIDKey, webhookExecutionRequest.HouseholdID).WithValue(keys.WebhookIDKey, webhookExecutionRequest.WebhookID)
+
+	household, err := w.dataManager.GetHousehold(ctx, webhookExecutionRequest.HouseholdID)
+	if err != nil {
+		if webhookExecutionRequest.RetryOfDeliveryID != "" && errors.Is(err, sql.ErrNoRows) {
+			observability.AcknowledgeError(err, logger, span, "getting household")
+			w.abandonRetry(ctx, logger, webhookExecutionRequest.RetryOfDeliveryID)
+			return nil
+		}
+		return observability.PrepareAndLogError(err, logger, span, "getting household")
+	}
+
+	webhook, err := w.dataManager.GetWebhook(ctx, webhookExecutionRequest.WebhookID, webhookExecutionRequest.HouseholdID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "getting webhook")
+		if webhookExecutionRequest.RetryOfDeliveryID != "" && errors.Is(err, sql.ErrNoRows) {
+			w.abandonRetry(ctx, logger, webhookExecutionRequest.RetryOfDeliveryID)
+		}
+		return nil
+	}
+
+	if webhookExecutionRequest.RetryOfDeliveryID != "" {
+		return w.retryDelivery(ctx, logger, household, webhook, webhookExecutionRequest.RetryOfDeliveryID)
+	}
+
+	// failed deliveries are persisted with their next attempt, so there's no sense in having the message redelivered.
+	if _, err = w.deliverer.Deliver(ctx, household, webhook, webhookExecutionRequest.TriggerEvent, webhookExecutionRequest.Payload); err != nil {
+		observability.AcknowledgeError(err, logger, span, "delivering webhook")
+	}
+
+	return nil
+}
+
+// retryDelivery makes the next attempt at a failed delivery the retry scheduler found due.
+func (w *webhookExecutorWorker) retryDelivery(ctx context.Context, logger logging.Logger, household *types.Household, webhook *types.Webhook, webhookDeliveryID string) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger = logger.WithValue(keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	delivery, err := w.dataManager.GetWebhookDelivery(ctx, webhook.ID, webhookDeliveryID)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "getting webhook delivery")
+		if errors.Is(err, sql.ErrNoRows) {
+			w.abandonRetry(ctx, logger, webhookDeliveryID)
+		}
+		return nil
+	}
+
+	if _, err = w.deliverer.Retry(ctx, household, webhook, delivery); err != nil {
+		observability.AcknowledgeError(err, logger, span, "retrying webhook delivery")
+	}
+
+	return nil
+}
+
+// abandonRetry clears the next attempt of a delivery whose household, webhook, or record is gone. Otherwise, the retry
+// scheduler would claim it again every time its claim lapsed. Other errors leave the claim to lapse, so it's retried.
+func (w *webhookExecutorWorker) abandonRetry(ctx context.Context, logger logging.Logger, webhookDeliveryID string) {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger = logger.WithValue(keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	if err := w.dataManager.MarkWebhookDeliveryAsRetried(ctx, webhookDeliveryID); err != nil {
+		observability.AcknowledgeError(err, logger, span, "abandoning webhook delivery retry")
+		return
+	}
+
+	logger.Info("abandoned webhook delivery retry")
+}
@@ -0,0 +1,276 @@
+package workers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/database"
+	"github.com/dinnerdonebetter/backend/internal/features/webhookdelivery"
+	"github.com/dinnerdonebetter/backend/internal/observability/logging"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+	testutils "github.com/dinnerdonebetter/backend/tests/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildWebhookExecutionRequestMessageForTest(t *testing.T) (*types.WebhookExecutionRequest, []byte) {
+	t.Helper()
+
+	input := &types.WebhookExecutionRequest{
+		WebhookID:    fakes.BuildFakeID(),
+		HouseholdID:  fakes.BuildFakeID(),
+		TriggerEvent: string(types.RecipeCreatedCustomerEventType),
+		Payload:      map[string]any{"things": "stuff"},
+	}
+
+	body, err := json.Marshal(input)
+	require.NoError(t, err)
+
+	return input, body
+}
+
+func TestProvideWebhookExecutorWorker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ProvideWebhookExecutorWorker(
+			logging.NewNoopLogger(),
+			&database.MockDatabase{},
+			&webhookdelivery.MockDeliverer{},
+			tracing.NewNoopTracerProvider(),
+		)
+		assert.NotNil(t, actual)
+	})
+}
+
+func TestWebhookExecutorWorker_HandleMessage(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, body := buildWebhookExecutionRequestMessageForTest(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(exampleHousehold, nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return(exampleWebhook, nil)
+
+		deliverer := &webhookdelivery.MockDeliverer{}
+		deliverer.On("Deliver", testutils.ContextMatcher, exampleHousehold, exampleWebhook, input.TriggerEvent, mock.Anything).Return(fakes.BuildFakeWebhookDelivery(), nil)
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, deliverer, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm, deliverer)
+	})
+
+	T.Run("with invalid message", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), database.NewMockDatabase(), &webhookdelivery.MockDeliverer{}, tracing.NewNoopTracerProvider())
+
+		assert.Error(t, worker.HandleMessage(ctx, []byte("} bad JSON lol")))
+	})
+
+	T.Run("with nil request", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), database.NewMockDatabase(), &webhookdelivery.MockDeliverer{}, tracing.NewNoopTracerProvider())
+
+		assert.ErrorIs(t, worker.HandleMessage(ctx, []byte("null")), errRequiredDataIsNil)
+	})
+
+	T.Run("with error fetching household", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, body := buildWebhookExecutionRequestMessageForTest(t)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return((*types.Household)(nil), errors.New("blah"))
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, &webhookdelivery.MockDeliverer{}, tracing.NewNoopTracerProvider())
+
+		assert.Error(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("with error fetching webhook", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, body := buildWebhookExecutionRequestMessageForTest(t)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(fakes.BuildFakeHousehold(), nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return((*types.Webhook)(nil), errors.New("blah"))
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, &webhookdelivery.MockDeliverer{}, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("with error delivering webhook", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, body := buildWebhookExecutionRequestMessageForTest(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(exampleHousehold, nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return(exampleWebhook, nil)
+
+		deliverer := &webhookdelivery.MockDeliverer{}
+		deliverer.On("Deliver", testutils.ContextMatcher, exampleHousehold, exampleWebhook, input.TriggerEvent, mock.Anything).Return((*types.WebhookDelivery)(nil), errors.New("blah"))
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, deliverer, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm, deliverer)
+	})
+
+	T.Run("with retry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, _ := buildWebhookExecutionRequestMessageForTest(t)
+		exampleHousehold := fakes.BuildFakeHousehold()
+		exampleWebhook := fakes.BuildFakeWebhook()
+		exampleWebhook.ID = input.WebhookID
+		exampleDelivery := fakes.BuildFakeWebhookDelivery()
+		input.RetryOfDeliveryID = exampleDelivery.ID
+
+		body, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(exampleHousehold, nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return(exampleWebhook, nil)
+		dbm.WebhookDeliveryDataManagerMock.On("GetWebhookDelivery", testutils.ContextMatcher, input.WebhookID, exampleDelivery.ID).Return(exampleDelivery, nil)
+
+		deliverer := &webhookdelivery.MockDeliverer{}
+		deliverer.On("Retry", testutils.ContextMatcher, exampleHousehold, exampleWebhook, exampleDelivery).Return(fakes.BuildFakeWebhookDelivery(), nil)
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, deliverer, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm, deliverer)
+	})
+
+	T.Run("with retry of unknown delivery", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, _ := buildWebhookExecutionRequestMessageForTest(t)
+		exampleWebhook := fakes.BuildFakeWebhook()
+		exampleWebhook.ID = input.WebhookID
+		input.RetryOfDeliveryID = fakes.BuildFakeID()
+
+		body, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(fakes.BuildFakeHousehold(), nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return(exampleWebhook, nil)
+		dbm.WebhookDeliveryDataManagerMock.On("GetWebhookDelivery", testutils.ContextMatcher, input.WebhookID, input.RetryOfDeliveryID).Return((*types.WebhookDelivery)(nil), sql.ErrNoRows)
+		dbm.WebhookDeliveryDataManagerMock.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, input.RetryOfDeliveryID).Return(nil)
+
+		deliverer := &webhookdelivery.MockDeliverer{}
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, deliverer, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm, deliverer)
+	})
+
+	T.Run("with retry of delivery for archived webhook", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, _ := buildWebhookExecutionRequestMessageForTest(t)
+		input.RetryOfDeliveryID = fakes.BuildFakeID()
+
+		body, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(fakes.BuildFakeHousehold(), nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return((*types.Webhook)(nil), sql.ErrNoRows)
+		dbm.WebhookDeliveryDataManagerMock.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, input.RetryOfDeliveryID).Return(nil)
+
+		deliverer := &webhookdelivery.MockDeliverer{}
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, deliverer, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm, deliverer)
+	})
+
+	T.Run("with retry of delivery for archived household", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, _ := buildWebhookExecutionRequestMessageForTest(t)
+		input.RetryOfDeliveryID = fakes.BuildFakeID()
+
+		body, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return((*types.Household)(nil), sql.ErrNoRows)
+		dbm.WebhookDeliveryDataManagerMock.On("MarkWebhookDeliveryAsRetried", testutils.ContextMatcher, input.RetryOfDeliveryID).Return(nil)
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, &webhookdelivery.MockDeliverer{}, tracing.NewNoopTracerProvider())
+
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("with retry and error fetching webhook", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		input, _ := buildWebhookExecutionRequestMessageForTest(t)
+		input.RetryOfDeliveryID = fakes.BuildFakeID()
+
+		body, err := json.Marshal(input)
+		require.NoError(t, err)
+
+		dbm := database.NewMockDatabase()
+		dbm.HouseholdDataManagerMock.On("GetHousehold", testutils.ContextMatcher, input.HouseholdID).Return(fakes.BuildFakeHousehold(), nil)
+		dbm.WebhookDataManagerMock.On("GetWebhook", testutils.ContextMatcher, input.WebhookID, input.HouseholdID).Return((*types.Webhook)(nil), errors.New("blah"))
+
+		worker := ProvideWebhookExecutorWorker(logging.NewNoopLogger(), dbm, &webhookdelivery.MockDeliverer{}, tracing.NewNoopTracerProvider())
+
+		// the claim is left to lapse, so that the delivery is retried once the database is reachable again.
+		assert.NoError(t, worker.HandleMessage(ctx, body))
+
+		mock.AssertExpectationsForObjects(t, dbm)
+		dbm.WebhookDeliveryDataManagerMock.AssertNotCalled(t, "MarkWebhookDeliveryAsRetried", mock.Anything, mock.Anything)
+	})
+}
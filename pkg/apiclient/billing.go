@@ -0,0 +1,64 @@
+package apiclient
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// CreateCheckoutSession starts a subscription checkout for the active household.
+func (c *Client) CreateCheckoutSession(ctx context.Context, input *types.CheckoutSessionCreationRequestInput) (*types.PaymentSession, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildCreateCheckoutSessionRequest(ctx, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building create checkout session request")
+	}
+
+	var apiResponse *types.APIResponse[*types.PaymentSession]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating checkout session")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// CreateCustomerPortalSession starts a session for managing the active household's subscription.
+func (c *Client) CreateCustomerPortalSession(ctx context.Context) (*types.PaymentSession, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	req, err := c.requestBuilder.BuildCreateCustomerPortalSessionRequest(ctx)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building create customer portal session request")
+	}
+
+	var apiResponse *types.APIResponse[*types.PaymentSession]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating customer portal session")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
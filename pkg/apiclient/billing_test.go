@@ -0,0 +1,131 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBilling(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(billingTestSuite))
+}
+
+type billingTestSuite struct {
+	suite.Suite
+	ctx                           context.Context
+	examplePaymentSession         *types.PaymentSession
+	examplePaymentSessionResponse *types.APIResponse[*types.PaymentSession]
+}
+
+var _ suite.SetupTestSuite = (*billingTestSuite)(nil)
+
+func (s *billingTestSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.examplePaymentSession = &types.PaymentSession{
+		ID:  fakes.BuildFakeID(),
+		URL: "https://checkout.stripe.com/c/pay/whatever",
+	}
+	s.examplePaymentSessionResponse = &types.APIResponse[*types.PaymentSession]{
+		Data: s.examplePaymentSession,
+	}
+}
+
+func (s *billingTestSuite) TestClient_CreateCheckoutSession() {
+	const expectedPath = "/api/v1/billing/checkout"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"}
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePaymentSessionResponse)
+
+		actual, err := c.CreateCheckoutSession(s.ctx, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, s.examplePaymentSession, actual)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.CreateCheckoutSession(s.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.CreateCheckoutSession(s.ctx, &types.CheckoutSessionCreationRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.CreateCheckoutSession(s.ctx, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.CreateCheckoutSession(s.ctx, &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *billingTestSuite) TestClient_CreateCustomerPortalSession() {
+	const expectedPath = "/api/v1/billing/portal"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePaymentSessionResponse)
+
+		actual, err := c.CreateCustomerPortalSession(s.ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, s.examplePaymentSession, actual)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.CreateCustomerPortalSession(s.ctx)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.CreateCustomerPortalSession(s.ctx)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,171 @@
+package apiclient
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// GetCookingSession gets a cooking session, along with where each of its steps stands.
+func (c *Client) GetCookingSession(ctx context.Context, cookingSessionID string) (*types.CookingSessionState, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	req, err := c.requestBuilder.BuildGetCookingSessionRequest(ctx, cookingSessionID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get cooking session request")
+	}
+
+	var apiResponse *types.APIResponse[*types.CookingSessionState]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving cooking session")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetCookingSessions retrieves a list of cooking sessions.
+func (c *Client) GetCookingSessions(ctx context.Context, filter *types.QueryFilter) (*types.QueryFilteredResult[types.CookingSession], error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := c.requestBuilder.BuildGetCookingSessionsRequest(ctx, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building cooking sessions list request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.CookingSession]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving cooking sessions")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryFilteredResult[types.CookingSession]{
+		Data:       apiResponse.Data,
+		Pagination: *apiResponse.Pagination,
+	}
+
+	return response, nil
+}
+
+// CreateCookingSession starts a cooking session.
+func (c *Client) CreateCookingSession(ctx context.Context, input *types.CookingSessionCreationRequestInput) (*types.CookingSessionState, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildCreateCookingSessionRequest(ctx, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building create cooking session request")
+	}
+
+	var apiResponse *types.APIResponse[*types.CookingSessionState]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating cooking session")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// RecordCookingSessionProgress records progress in a cooking session.
+func (c *Client) RecordCookingSessionProgress(ctx context.Context, cookingSessionID string, input *types.CookingSessionProgressCreationRequestInput) (*types.CookingSessionState, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildRecordCookingSessionProgressRequest(ctx, cookingSessionID, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building record cooking session progress request")
+	}
+
+	var apiResponse *types.APIResponse[*types.CookingSessionState]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "recording cooking session progress")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// ArchiveCookingSession archives a cooking session.
+func (c *Client) ArchiveCookingSession(ctx context.Context, cookingSessionID string) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if cookingSessionID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.CookingSessionIDKey, cookingSessionID)
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	req, err := c.requestBuilder.BuildArchiveCookingSessionRequest(ctx, cookingSessionID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building archive cooking session request")
+	}
+
+	var apiResponse *types.APIResponse[*types.CookingSession]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving cooking session %s", cookingSessionID)
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return err
+	}
+
+	return nil
+}
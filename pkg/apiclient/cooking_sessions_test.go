@@ -0,0 +1,309 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestCookingSessions(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(cookingSessionsTestSuite))
+}
+
+type cookingSessionsBaseSuite struct {
+	suite.Suite
+	ctx                                context.Context
+	exampleCookingSessionState         *types.CookingSessionState
+	exampleCookingSessionStateResponse *types.APIResponse[*types.CookingSessionState]
+	exampleCookingSessionListResponse  *types.APIResponse[[]*types.CookingSession]
+	exampleCookingSessionList          []*types.CookingSession
+}
+
+var _ suite.SetupTestSuite = (*cookingSessionsBaseSuite)(nil)
+
+func (s *cookingSessionsBaseSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.exampleCookingSessionState = fakes.BuildFakeCookingSessionState()
+	s.exampleCookingSessionStateResponse = &types.APIResponse[*types.CookingSessionState]{
+		Data: s.exampleCookingSessionState,
+	}
+	exampleCookingSessionList := fakes.BuildFakeCookingSessionList()
+	s.exampleCookingSessionList = exampleCookingSessionList.Data
+	s.exampleCookingSessionListResponse = &types.APIResponse[[]*types.CookingSession]{
+		Data:       s.exampleCookingSessionList,
+		Pagination: &exampleCookingSessionList.Pagination,
+	}
+}
+
+type cookingSessionsTestSuite struct {
+	suite.Suite
+	cookingSessionsBaseSuite
+}
+
+func (s *cookingSessionsTestSuite) TestClient_GetCookingSession() {
+	const expectedPathFormat = "/api/v1/cooking_sessions/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleCookingSessionState.Session.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleCookingSessionStateResponse)
+		actual, err := c.GetCookingSession(s.ctx, s.exampleCookingSessionState.Session.ID)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleCookingSessionState, actual)
+	})
+
+	s.Run("with invalid cooking session ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetCookingSession(s.ctx, "")
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetCookingSession(s.ctx, s.exampleCookingSessionState.Session.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleCookingSessionState.Session.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetCookingSession(s.ctx, s.exampleCookingSessionState.Session.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *cookingSessionsTestSuite) TestClient_GetCookingSessions() {
+	const expectedPath = "/api/v1/cooking_sessions"
+
+	filter := (*types.QueryFilter)(nil)
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleCookingSessionListResponse)
+		actual, err := c.GetCookingSessions(s.ctx, filter)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleCookingSessionList, actual.Data)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetCookingSessions(s.ctx, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetCookingSessions(s.ctx, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *cookingSessionsTestSuite) TestClient_CreateCookingSession() {
+	const expectedPath = "/api/v1/cooking_sessions"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleCookingSessionStateResponse)
+
+		actual, err := c.CreateCookingSession(s.ctx, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleCookingSessionState, actual)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.CreateCookingSession(s.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		exampleInput := &types.CookingSessionCreationRequestInput{}
+
+		actual, err := c.CreateCookingSession(s.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.CreateCookingSession(s.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.CreateCookingSession(s.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *cookingSessionsTestSuite) TestClient_RecordCookingSessionProgress() {
+	const expectedPathFormat = "/api/v1/cooking_sessions/%s/progress"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, s.exampleCookingSessionState.Session.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleCookingSessionStateResponse)
+
+		actual, err := c.RecordCookingSessionProgress(s.ctx, s.exampleCookingSessionState.Session.ID, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleCookingSessionState, actual)
+	})
+
+	s.Run("with invalid cooking session ID", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.RecordCookingSessionProgress(s.ctx, "", exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.RecordCookingSessionProgress(s.ctx, s.exampleCookingSessionState.Session.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		exampleInput := &types.CookingSessionProgressCreationRequestInput{}
+
+		actual, err := c.RecordCookingSessionProgress(s.ctx, s.exampleCookingSessionState.Session.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.RecordCookingSessionProgress(s.ctx, s.exampleCookingSessionState.Session.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.RecordCookingSessionProgress(s.ctx, s.exampleCookingSessionState.Session.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *cookingSessionsTestSuite) TestClient_ArchiveCookingSession() {
+	const expectedPathFormat = "/api/v1/cooking_sessions/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, s.exampleCookingSessionState.Session.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, &types.APIResponse[*types.CookingSession]{Data: s.exampleCookingSessionState.Session})
+
+		err := c.ArchiveCookingSession(s.ctx, s.exampleCookingSessionState.Session.ID)
+		assert.NoError(t, err)
+	})
+
+	s.Run("with invalid cooking session ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.ArchiveCookingSession(s.ctx, "")
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		err := c.ArchiveCookingSession(s.ctx, s.exampleCookingSessionState.Session.ID)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		err := c.ArchiveCookingSession(s.ctx, s.exampleCookingSessionState.Session.ID)
+		assert.Error(t, err)
+	})
+}
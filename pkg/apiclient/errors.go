@@ -56,6 +56,15 @@
 
 	// ErrArgumentIsNotPointer indicates we received a non-pointer interface argument.
 	ErrArgumentIsNotPointer = errors.New("value is not a pointer")
+
+	// ErrInvalidWebhookSignatureHeader indicates a webhook signature header couldn't be parsed.
+	ErrInvalidWebhookSignatureHeader = errors.New("invalid webhook signature header")
+
+	// ErrWebhookSignatureTimestampOutOfTolerance indicates a webhook was signed too long ago (or too far in the future) to be trusted.
+	ErrWebhookSignatureTimestampOutOfTolerance = errors.New("webhook signature timestamp outside of tolerance")
+
+	// ErrNoMatchingWebhookSignature indicates none of a webhook's signatures matched the provided key.
+	ErrNoMatchingWebhookSignature = errors.New("no matching webhook signature")
 )
 
 // buildInvalidIDError indicates a required ID was passed in as zero.
@@ -378,3 +378,141 @@ func (c *Client) TransferHouseholdOwnership(ctx context.Context, householdID str
 
 	return nil
 }
+
+// RotateHouseholdWebhookEncryptionKey replaces a household's webhook encryption key, and returns the new key.
+func (c *Client) RotateHouseholdWebhookEncryptionKey(ctx context.Context, householdID string) (*types.HouseholdWebhookEncryptionKeyRotationResponse, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	req, err := c.requestBuilder.BuildRotateHouseholdWebhookEncryptionKeyRequest(ctx, householdID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building rotate household webhook encryption key request")
+	}
+
+	var apiResponse *types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareError(err, span, "rotating household webhook encryption key")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetHouseholdRecommendations retrieves recipe recommendations for a household.
+func (c *Client) GetHouseholdRecommendations(ctx context.Context, householdID string, count int) ([]*types.RecipeRecommendation, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	req, err := c.requestBuilder.BuildGetHouseholdRecommendationsRequest(ctx, householdID, count)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building household recommendations request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.RecipeRecommendation]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareError(err, span, "retrieving household recommendations")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// SubscribeToHouseholdEvents streams a household's events over a websocket. A non-zero lastEventID resumes the
+// stream after that event, unless it is no longer retained, in which case the stream starts with a reset event. The returned channel is closed when ctx ends or the server ends the stream, at which
+// point callers may resubscribe from the last event they received.
+func (c *Client) SubscribeToHouseholdEvents(ctx context.Context, householdID string, lastEventID types.HouseholdEventCursor) (<-chan *types.HouseholdEvent, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	req, err := c.requestBuilder.BuildHouseholdEventStreamRequest(ctx, householdID, lastEventID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building household event stream request")
+	}
+
+	u := *req.URL
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	headers := req.Header.Clone()
+	if c.authHeaderBuilder != nil {
+		authHeaders, headerErr := c.authHeaderBuilder.BuildRequestHeaders(ctx)
+		if headerErr != nil {
+			return nil, observability.PrepareError(headerErr, span, "building household event stream auth headers")
+		}
+
+		for k, v := range authHeaders {
+			headers[k] = v
+		}
+	}
+
+	conn, res, err := c.websocketDialer.DialContext(ctx, u.String(), headers)
+	if res != nil && res.Body != nil {
+		c.closeResponseBody(ctx, res)
+	}
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "connecting to household event stream")
+	}
+
+	events := make(chan *types.HouseholdEvent)
+	done := make(chan struct{})
+
+	// closing the connection is what unblocks the reader once ctx ends.
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+
+		if closeErr := conn.Close(); closeErr != nil {
+			c.logger.Error(closeErr, "closing household event stream connection")
+		}
+	}()
+
+	go func() {
+		defer close(events)
+		defer close(done)
+
+		for {
+			var event *types.HouseholdEvent
+			if readErr := conn.ReadJSON(&event); readErr != nil {
+				return
+			}
+
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
@@ -2,13 +2,17 @@
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/converters"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -659,3 +663,197 @@ func (s *householdsTestSuite) TestClient_TransferHouseholdOwnership() {
 		assert.Error(t, c.TransferHouseholdOwnership(s.ctx, s.exampleHousehold.ID, exampleInput))
 	})
 }
+
+func (s *householdsTestSuite) TestClient_RotateHouseholdWebhookEncryptionKey() {
+	const expectedPathFormat = "/api/v1/households/%s/webhook_encryption_key/rotate"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		expected := &types.HouseholdWebhookEncryptionKeyRotationResponse{
+			PreviousKeyExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second).UTC(),
+			WebhookEncryptionKey: fakes.BuildFakeID(),
+		}
+		response := &types.APIResponse[*types.HouseholdWebhookEncryptionKeyRotationResponse]{
+			Data: expected,
+		}
+
+		spec := newRequestSpec(true, http.MethodPost, "", expectedPathFormat, s.exampleHousehold.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, response)
+
+		actual, err := c.RotateHouseholdWebhookEncryptionKey(s.ctx, s.exampleHousehold.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.RotateHouseholdWebhookEncryptionKey(s.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.RotateHouseholdWebhookEncryptionKey(s.ctx, s.exampleHousehold.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.RotateHouseholdWebhookEncryptionKey(s.ctx, s.exampleHousehold.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *householdsTestSuite) TestClient_GetHouseholdRecommendations() {
+	const expectedPathFormat = "/api/v1/households/%s/recommendations"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleRecommendations := fakes.BuildFakeRecipeRecommendations()
+		exampleResponse := &types.APIResponse[[]*types.RecipeRecommendation]{
+			Data: exampleRecommendations,
+		}
+
+		spec := newRequestSpec(true, http.MethodGet, "count=5", expectedPathFormat, s.exampleHousehold.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+		actual, err := c.GetHouseholdRecommendations(s.ctx, s.exampleHousehold.ID, 5)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleRecommendations, actual)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetHouseholdRecommendations(s.ctx, "", 5)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetHouseholdRecommendations(s.ctx, s.exampleHousehold.ID, 5)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "count=5", expectedPathFormat, s.exampleHousehold.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetHouseholdRecommendations(s.ctx, s.exampleHousehold.ID, 5)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *householdsTestSuite) TestClient_SubscribeToHouseholdEvents() {
+	const expectedPathFormat = "/api/v1/households/%s/events/stream"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleEvents := []*types.HouseholdEvent{
+			{
+				CreatedAt: fakes.BuildFakeTime(),
+				EventType: types.MealPlanTaskStatusChangedCustomerEventType,
+				ID:        types.HouseholdEventCursor{TransactionID: 123, Sequence: 5},
+				Data: types.DataChangeMessage{
+					EventType:   types.MealPlanTaskStatusChangedCustomerEventType,
+					HouseholdID: s.exampleHousehold.ID,
+				},
+			},
+			{
+				CreatedAt: fakes.BuildFakeTime(),
+				EventType: types.MealPlanGroceryListItemUpdatedCustomerEventType,
+				ID:        types.HouseholdEventCursor{TransactionID: 124, Sequence: 6},
+				Data: types.DataChangeMessage{
+					EventType:   types.MealPlanGroceryListItemUpdatedCustomerEventType,
+					HouseholdID: s.exampleHousehold.ID,
+				},
+			},
+		}
+
+		ts := httptest.NewTLSServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, fmt.Sprintf(expectedPathFormat, s.exampleHousehold.ID), req.URL.Path)
+			assert.Equal(t, "123-4", req.Header.Get(types.LastEventIDHeaderKey))
+
+			conn, err := (&websocket.Upgrader{}).Upgrade(res, req, nil)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, conn.Close())
+			}()
+
+			for _, event := range exampleEvents {
+				require.NoError(t, conn.WriteJSON(event))
+			}
+		}))
+		defer ts.Close()
+
+		c := buildTestClient(t, ts)
+		c.websocketDialer = &websocket.Dialer{TLSClientConfig: ts.Client().Transport.(*http.Transport).TLSClientConfig}
+
+		events, err := c.SubscribeToHouseholdEvents(s.ctx, s.exampleHousehold.ID, types.HouseholdEventCursor{TransactionID: 123, Sequence: 4})
+		require.NoError(t, err)
+
+		actual := []*types.HouseholdEvent{}
+		for event := range events {
+			actual = append(actual, event)
+		}
+
+		assert.Equal(t, exampleEvents, actual)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.SubscribeToHouseholdEvents(s.ctx, "", types.HouseholdEventCursor{})
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.SubscribeToHouseholdEvents(s.ctx, s.exampleHousehold.ID, types.HouseholdEventCursor{})
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error connecting", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleHousehold.ID)
+		c, _ := buildTestClientWithStatusCodeResponse(t, spec, http.StatusForbidden)
+		actual, err := c.SubscribeToHouseholdEvents(s.ctx, s.exampleHousehold.ID, types.HouseholdEventCursor{})
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
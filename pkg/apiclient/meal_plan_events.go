@@ -178,3 +178,67 @@ func (c *Client) ArchiveMealPlanEvent(ctx context.Context, mealPlanID, mealPlanE
 
 	return nil
 }
+
+// GetMealPlanEventResults retrieves the vote tally for a meal plan event.
+func (c *Client) GetMealPlanEventResults(ctx context.Context, mealPlanID, mealPlanEventID string) (*types.MealPlanEventResults, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	req, err := c.requestBuilder.BuildGetMealPlanEventResultsRequest(ctx, mealPlanID, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building get meal plan event results request")
+	}
+
+	var apiResponse *types.APIResponse[*types.MealPlanEventResults]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareError(err, span, "retrieving meal plan event results")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetMealPlanEventTimeline retrieves the cook timeline for a meal plan event.
+func (c *Client) GetMealPlanEventTimeline(ctx context.Context, mealPlanID, mealPlanEventID string) (*types.CookTimeline, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	req, err := c.requestBuilder.BuildGetMealPlanEventTimelineRequest(ctx, mealPlanID, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building get meal plan event timeline request")
+	}
+
+	var apiResponse *types.APIResponse[*types.CookTimeline]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareError(err, span, "retrieving meal plan event timeline")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
@@ -51,6 +51,48 @@ func (c *Client) GetMealPlanOption(ctx context.Context, mealPlanID, mealPlanEven
 	return apiResponse.Data, nil
 }
 
+// GetMealPlanOptionDietaryConflicts checks a meal plan option's meal against the active household's allergies and dislikes.
+func (c *Client) GetMealPlanOptionDietaryConflicts(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string) (*types.MealPlanOptionDietaryConflictReport, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if mealPlanID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	if mealPlanOptionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.MealPlanOptionIDKey, mealPlanOptionID)
+	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
+
+	req, err := c.requestBuilder.BuildGetMealPlanOptionDietaryConflictsRequest(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get meal plan option dietary conflicts request")
+	}
+
+	var apiResponse *types.APIResponse[*types.MealPlanOptionDietaryConflictReport]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving meal plan option dietary conflicts")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
 // GetMealPlanOptions retrieves a list of meal plan options.
 func (c *Client) GetMealPlanOptions(ctx context.Context, mealPlanID, mealPlanEventID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.MealPlanOption], error) {
 	ctx, span := c.tracer.StartSpan(ctx)
@@ -112,6 +112,78 @@ func (s *mealPlanOptionsTestSuite) TestClient_GetMealPlanOption() {
 	})
 }
 
+func (s *mealPlanOptionsTestSuite) TestClient_GetMealPlanOptionDietaryConflicts() {
+	const expectedPathFormat = "/api/v1/meal_plans/%s/events/%s/options/%s/dietary_conflicts"
+
+	exampleReport := fakes.BuildFakeMealPlanOptionDietaryConflictReport()
+	exampleResponse := &types.APIResponse[*types.MealPlanOptionDietaryConflictReport]{
+		Data: exampleReport,
+	}
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleMealPlanID, s.exampleMealPlanEventID, s.exampleMealPlanOption.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+		actual, err := c.GetMealPlanOptionDietaryConflicts(s.ctx, s.exampleMealPlanID, s.exampleMealPlanEventID, s.exampleMealPlanOption.ID)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleReport, actual)
+	})
+
+	s.Run("with invalid meal plan ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetMealPlanOptionDietaryConflicts(s.ctx, "", s.exampleMealPlanEventID, s.exampleMealPlanOption.ID)
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid meal plan event ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetMealPlanOptionDietaryConflicts(s.ctx, s.exampleMealPlanID, "", s.exampleMealPlanOption.ID)
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid meal plan option ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetMealPlanOptionDietaryConflicts(s.ctx, s.exampleMealPlanID, s.exampleMealPlanEventID, "")
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetMealPlanOptionDietaryConflicts(s.ctx, s.exampleMealPlanID, s.exampleMealPlanEventID, s.exampleMealPlanOption.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleMealPlanID, s.exampleMealPlanEventID, s.exampleMealPlanOption.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetMealPlanOptionDietaryConflicts(s.ctx, s.exampleMealPlanID, s.exampleMealPlanEventID, s.exampleMealPlanOption.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func (s *mealPlanOptionsTestSuite) TestClient_GetMealPlanOptions() {
 	const expectedPath = "/api/v1/meal_plans/%s/events/%s/options"
 
@@ -0,0 +1,193 @@
+package apiclient
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// GetPantryItem gets a pantry item.
+func (c *Client) GetPantryItem(ctx context.Context, householdID, pantryItemID string) (*types.PantryItem, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if pantryItemID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	req, err := c.requestBuilder.BuildGetPantryItemRequest(ctx, householdID, pantryItemID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get pantry item request")
+	}
+
+	var apiResponse *types.APIResponse[*types.PantryItem]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving pantry item")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetPantryItems retrieves a list of pantry items.
+func (c *Client) GetPantryItems(ctx context.Context, householdID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.PantryItem], error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	req, err := c.requestBuilder.BuildGetPantryItemsRequest(ctx, householdID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building pantry items list request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.PantryItem]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving pantry items")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryFilteredResult[types.PantryItem]{
+		Data:       apiResponse.Data,
+		Pagination: *apiResponse.Pagination,
+	}
+
+	return response, nil
+}
+
+// CreatePantryItem creates a pantry item.
+func (c *Client) CreatePantryItem(ctx context.Context, householdID string, input *types.PantryItemCreationRequestInput) (*types.PantryItem, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildCreatePantryItemRequest(ctx, householdID, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building create pantry item request")
+	}
+
+	var apiResponse *types.APIResponse[*types.PantryItem]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating pantry item")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// UpdatePantryItem updates a pantry item.
+func (c *Client) UpdatePantryItem(ctx context.Context, householdID string, pantryItem *types.PantryItem) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if householdID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if pantryItem == nil {
+		return ErrNilInputProvided
+	}
+	logger = logger.WithValue(keys.PantryItemIDKey, pantryItem.ID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItem.ID)
+
+	req, err := c.requestBuilder.BuildUpdatePantryItemRequest(ctx, householdID, pantryItem)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building update pantry item request")
+	}
+
+	var apiResponse *types.APIResponse[*types.PantryItem]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating pantry item %s", pantryItem.ID)
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ArchivePantryItem archives a pantry item.
+func (c *Client) ArchivePantryItem(ctx context.Context, householdID, pantryItemID string) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if householdID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.HouseholdIDKey, householdID)
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if pantryItemID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	req, err := c.requestBuilder.BuildArchivePantryItemRequest(ctx, householdID, pantryItemID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building archive pantry item request")
+	}
+
+	var apiResponse *types.APIResponse[*types.PantryItem]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving pantry item %s", pantryItemID)
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return err
+	}
+
+	return nil
+}
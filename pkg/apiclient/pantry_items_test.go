@@ -0,0 +1,331 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPantryItems(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(pantryItemsTestSuite))
+}
+
+type pantryItemsBaseSuite struct {
+	suite.Suite
+	ctx                           context.Context
+	examplePantryItem             *types.PantryItem
+	examplePantryItemResponse     *types.APIResponse[*types.PantryItem]
+	examplePantryItemListResponse *types.APIResponse[[]*types.PantryItem]
+	exampleHouseholdID            string
+	examplePantryItemList         []*types.PantryItem
+}
+
+var _ suite.SetupTestSuite = (*pantryItemsBaseSuite)(nil)
+
+func (s *pantryItemsBaseSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.exampleHouseholdID = fakes.BuildFakeID()
+	s.examplePantryItem = fakes.BuildFakePantryItem()
+	s.examplePantryItem.BelongsToHousehold = s.exampleHouseholdID
+	s.examplePantryItemResponse = &types.APIResponse[*types.PantryItem]{
+		Data: s.examplePantryItem,
+	}
+	examplePantryItemList := fakes.BuildFakePantryItemList()
+	s.examplePantryItemList = examplePantryItemList.Data
+	s.examplePantryItemListResponse = &types.APIResponse[[]*types.PantryItem]{
+		Data:       s.examplePantryItemList,
+		Pagination: &examplePantryItemList.Pagination,
+	}
+}
+
+type pantryItemsTestSuite struct {
+	suite.Suite
+	pantryItemsBaseSuite
+}
+
+func (s *pantryItemsTestSuite) TestClient_GetPantryItem() {
+	const expectedPathFormat = "/api/v1/households/%s/pantry/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleHouseholdID, s.examplePantryItem.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePantryItemResponse)
+		actual, err := c.GetPantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem.ID)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.examplePantryItem, actual)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetPantryItem(s.ctx, "", s.examplePantryItem.ID)
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid pantry item ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetPantryItem(s.ctx, s.exampleHouseholdID, "")
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetPantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleHouseholdID, s.examplePantryItem.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetPantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *pantryItemsTestSuite) TestClient_GetPantryItems() {
+	const expectedPathFormat = "/api/v1/households/%s/pantry"
+
+	filter := (*types.QueryFilter)(nil)
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat, s.exampleHouseholdID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePantryItemListResponse)
+		actual, err := c.GetPantryItems(s.ctx, s.exampleHouseholdID, filter)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.examplePantryItemList, actual.Data)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetPantryItems(s.ctx, "", filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetPantryItems(s.ctx, s.exampleHouseholdID, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat, s.exampleHouseholdID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetPantryItems(s.ctx, s.exampleHouseholdID, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *pantryItemsTestSuite) TestClient_CreatePantryItem() {
+	const expectedPathFormat = "/api/v1/households/%s/pantry"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakePantryItemCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, s.exampleHouseholdID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePantryItemResponse)
+
+		actual, err := c.CreatePantryItem(s.ctx, s.exampleHouseholdID, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, s.examplePantryItem, actual)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.CreatePantryItem(s.ctx, "", fakes.BuildFakePantryItemCreationRequestInput())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.CreatePantryItem(s.ctx, s.exampleHouseholdID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		exampleInput := &types.PantryItemCreationRequestInput{}
+
+		actual, err := c.CreatePantryItem(s.ctx, s.exampleHouseholdID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		exampleInput := converters.ConvertPantryItemToPantryItemCreationRequestInput(s.examplePantryItem)
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.CreatePantryItem(s.ctx, s.exampleHouseholdID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		exampleInput := converters.ConvertPantryItemToPantryItemCreationRequestInput(s.examplePantryItem)
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.CreatePantryItem(s.ctx, s.exampleHouseholdID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *pantryItemsTestSuite) TestClient_UpdatePantryItem() {
+	const expectedPathFormat = "/api/v1/households/%s/pantry/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(false, http.MethodPut, "", expectedPathFormat, s.exampleHouseholdID, s.examplePantryItem.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePantryItemResponse)
+
+		err := c.UpdatePantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem)
+		assert.NoError(t, err)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.UpdatePantryItem(s.ctx, "", s.examplePantryItem)
+		assert.Error(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.UpdatePantryItem(s.ctx, s.exampleHouseholdID, nil)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		err := c.UpdatePantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		err := c.UpdatePantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem)
+		assert.Error(t, err)
+	})
+}
+
+func (s *pantryItemsTestSuite) TestClient_ArchivePantryItem() {
+	const expectedPathFormat = "/api/v1/households/%s/pantry/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, s.exampleHouseholdID, s.examplePantryItem.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.examplePantryItemResponse)
+
+		err := c.ArchivePantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem.ID)
+		assert.NoError(t, err)
+	})
+
+	s.Run("with invalid household ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.ArchivePantryItem(s.ctx, "", s.examplePantryItem.ID)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid pantry item ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.ArchivePantryItem(s.ctx, s.exampleHouseholdID, "")
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		err := c.ArchivePantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem.ID)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		err := c.ArchivePantryItem(s.ctx, s.exampleHouseholdID, s.examplePantryItem.ID)
+		assert.Error(t, err)
+	})
+}
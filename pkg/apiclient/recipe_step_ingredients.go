@@ -173,6 +173,56 @@ func (c *Client) UpdateRecipeStepIngredient(ctx context.Context, recipeID string
 	return nil
 }
 
+// SubstituteRecipeStepIngredient swaps a recipe step ingredient for the substitute of a valid ingredient substitution.
+func (c *Client) SubstituteRecipeStepIngredient(ctx context.Context, recipeID, recipeStepID, recipeStepIngredientID string, input *types.RecipeStepIngredientSubstitutionRequestInput) (*types.RecipeStepIngredient, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if recipeID == "" {
+		return nil, buildInvalidIDError("recipe")
+	}
+	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	if recipeStepID == "" {
+		return nil, buildInvalidIDError("recipeStep")
+	}
+	logger = logger.WithValue(keys.RecipeStepIDKey, recipeStepID)
+	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
+
+	if recipeStepIngredientID == "" {
+		return nil, buildInvalidIDError("recipeStepIngredient")
+	}
+	logger = logger.WithValue(keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
+	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildSubstituteRecipeStepIngredientRequest(ctx, recipeID, recipeStepID, recipeStepIngredientID, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building substitute recipe step ingredient request")
+	}
+
+	var apiResponse *types.APIResponse[*types.RecipeStepIngredient]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "substituting recipe step ingredient %s", recipeStepIngredientID)
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
 // ArchiveRecipeStepIngredient archives a recipe step ingredient.
 func (c *Client) ArchiveRecipeStepIngredient(ctx context.Context, recipeID, recipeStepID, recipeStepIngredientID string) error {
 	ctx, span := c.tracer.StartSpan(ctx)
@@ -304,6 +304,64 @@ func (s *recipeStepIngredientsTestSuite) TestClient_UpdateRecipeStepIngredient()
 	})
 }
 
+func (s *recipeStepIngredientsTestSuite) TestClient_SubstituteRecipeStepIngredient() {
+	const expectedPathFormat = "/api/v1/recipes/%s/steps/%s/ingredients/%s/substitute"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()}
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, s.exampleRecipeID, s.exampleRecipeStepID, s.exampleRecipeStepIngredient.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleRecipeStepIngredientResponse)
+
+		actual, err := c.SubstituteRecipeStepIngredient(s.ctx, s.exampleRecipeID, s.exampleRecipeStepID, s.exampleRecipeStepIngredient.ID, exampleInput)
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleRecipeStepIngredient, actual)
+	})
+
+	s.Run("with invalid recipe step ingredient ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.SubstituteRecipeStepIngredient(s.ctx, s.exampleRecipeID, s.exampleRecipeStepID, "", &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.SubstituteRecipeStepIngredient(s.ctx, s.exampleRecipeID, s.exampleRecipeStepID, s.exampleRecipeStepIngredient.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.SubstituteRecipeStepIngredient(s.ctx, s.exampleRecipeID, s.exampleRecipeStepID, s.exampleRecipeStepIngredient.ID, &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.SubstituteRecipeStepIngredient(s.ctx, s.exampleRecipeID, s.exampleRecipeStepID, s.exampleRecipeStepIngredient.ID, &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func (s *recipeStepIngredientsTestSuite) TestClient_ArchiveRecipeStepIngredient() {
 	const expectedPathFormat = "/api/v1/recipes/%s/steps/%s/ingredients/%s"
 
@@ -136,6 +136,38 @@ func (c *Client) CreateRecipe(ctx context.Context, input *types.RecipeCreationRe
 	return apiResponse.Data, nil
 }
 
+// ImportRecipe builds a draft recipe from a schema.org Recipe document and/or free-text ingredient lines.
+func (c *Client) ImportRecipe(ctx context.Context, input *types.RecipeImportRequestInput) (*types.RecipeImportDraft, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildImportRecipeRequest(ctx, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building import recipe request")
+	}
+
+	var apiResponse *types.APIResponse[*types.RecipeImportDraft]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "importing recipe")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
 // UpdateRecipe updates a recipe.
 func (c *Client) UpdateRecipe(ctx context.Context, recipe *types.Recipe) error {
 	ctx, span := c.tracer.StartSpan(ctx)
@@ -291,6 +323,66 @@ func (c *Client) GetRecipeDAG(ctx context.Context, recipeID string) (image.Image
 	return img, nil
 }
 
+// GetScaledRecipe gets a recipe scaled to a given number of portions.
+func (c *Client) GetScaledRecipe(ctx context.Context, recipeID string, portions float32) (*types.Recipe, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if recipeID == "" {
+		return nil, buildInvalidIDError("recipe")
+	}
+	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	req, err := c.requestBuilder.BuildGetScaledRecipeRequest(ctx, recipeID, portions)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get scaled recipe request")
+	}
+
+	var apiResponse *types.APIResponse[*types.Recipe]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving scaled recipe")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetRecipeEquipmentReport reports which of a recipe's instruments and vessels the active household is missing.
+func (c *Client) GetRecipeEquipmentReport(ctx context.Context, recipeID string) (*types.RecipeEquipmentReport, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if recipeID == "" {
+		return nil, buildInvalidIDError("recipe")
+	}
+	logger = logger.WithValue(keys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	req, err := c.requestBuilder.BuildGetRecipeEquipmentReportRequest(ctx, recipeID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get recipe equipment report request")
+	}
+
+	var apiResponse *types.APIResponse[*types.RecipeEquipmentReport]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe equipment report")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
 // CloneRecipe gets a recipe.
 func (c *Client) CloneRecipe(ctx context.Context, recipeID string) (*types.Recipe, error) {
 	ctx, span := c.tracer.StartSpan(ctx)
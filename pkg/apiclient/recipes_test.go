@@ -254,6 +254,68 @@ func (s *recipesTestSuite) TestClient_CreateRecipe() {
 	})
 }
 
+func (s *recipesTestSuite) TestClient_ImportRecipe() {
+	const expectedPath = "/api/v1/recipes/import"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}}
+		exampleDraft := &types.RecipeImportDraft{
+			Recipe:           converters.ConvertRecipeToRecipeCreationRequestInput(s.exampleRecipe),
+			IngredientLines:  []*types.ParsedIngredientLine{},
+			UnresolvedTokens: []*types.UnresolvedRecipeImportToken{},
+		}
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, &types.APIResponse[*types.RecipeImportDraft]{Data: exampleDraft})
+
+		actual, err := c.ImportRecipe(s.ctx, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleDraft, actual)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.ImportRecipe(s.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.ImportRecipe(s.ctx, &types.RecipeImportRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.ImportRecipe(s.ctx, &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.ImportRecipe(s.ctx, &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func (s *recipesTestSuite) TestClient_UpdateRecipe() {
 	const expectedPathFormat = "/api/v1/recipes/%s"
 
@@ -386,6 +448,105 @@ func (s *recipesTestSuite) TestClient_GetRecipeDAG() {
 	})
 }
 
+func (s *recipesTestSuite) TestClient_GetScaledRecipe() {
+	const expectedPathFormat = "/api/v1/recipes/%s/scaled"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "portions=4", expectedPathFormat, s.exampleRecipe.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleRecipeResponse)
+		actual, err := c.GetScaledRecipe(s.ctx, s.exampleRecipe.ID, 4)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleRecipe, actual)
+	})
+
+	s.Run("with invalid recipe ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetScaledRecipe(s.ctx, "", 4)
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetScaledRecipe(s.ctx, s.exampleRecipe.ID, 4)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "portions=4", expectedPathFormat, s.exampleRecipe.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetScaledRecipe(s.ctx, s.exampleRecipe.ID, 4)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *recipesTestSuite) TestClient_GetRecipeEquipmentReport() {
+	const expectedPathFormat = "/api/v1/recipes/%s/equipment"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleReport := fakes.BuildFakeRecipeEquipmentReport()
+		exampleResponse := &types.APIResponse[*types.RecipeEquipmentReport]{
+			Data: exampleReport,
+		}
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleRecipe.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+		actual, err := c.GetRecipeEquipmentReport(s.ctx, s.exampleRecipe.ID)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleReport, actual)
+	})
+
+	s.Run("with invalid recipe ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetRecipeEquipmentReport(s.ctx, "")
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetRecipeEquipmentReport(s.ctx, s.exampleRecipe.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleRecipe.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetRecipeEquipmentReport(s.ctx, s.exampleRecipe.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func (s *recipesTestSuite) TestClient_CloneRecipe() {
 	const expectedPathFormat = "/api/v1/recipes/%s/clone"
 
@@ -0,0 +1,50 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	billingBasePath = "billing"
+)
+
+// BuildCreateCheckoutSessionRequest builds an HTTP request for starting a subscription checkout for the active household.
+func (b *Builder) BuildCreateCheckoutSessionRequest(ctx context.Context, input *types.CheckoutSessionCreationRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(ctx, nil, billingBasePath, "checkout")
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	return b.buildDataRequest(ctx, http.MethodPost, uri, input)
+}
+
+// BuildCreateCustomerPortalSessionRequest builds an HTTP request for managing the active household's subscription.
+func (b *Builder) BuildCreateCustomerPortalSessionRequest(ctx context.Context) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	uri := b.BuildURL(ctx, nil, billingBasePath, "portal")
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
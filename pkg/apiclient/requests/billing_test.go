@@ -0,0 +1,92 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_BuildCreateCheckoutSessionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPath = "/api/v1/billing/checkout"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleInput := &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"}
+
+		actual, err := helper.builder.BuildCreateCheckoutSessionRequest(helper.ctx, exampleInput)
+		assert.NoError(t, err)
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateCheckoutSessionRequest(helper.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateCheckoutSessionRequest(helper.ctx, &types.CheckoutSessionCreationRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+		exampleInput := &types.CheckoutSessionCreationRequestInput{SubscriptionPlanID: "price_premium"}
+
+		actual, err := helper.builder.BuildCreateCheckoutSessionRequest(helper.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildCreateCustomerPortalSessionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPath = "/api/v1/billing/portal"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateCustomerPortalSessionRequest(helper.ctx)
+		assert.NoError(t, err)
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildCreateCustomerPortalSessionRequest(helper.ctx)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
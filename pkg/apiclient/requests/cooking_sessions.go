@@ -0,0 +1,152 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+const (
+	cookingSessionsBasePath        = "cooking_sessions"
+	cookingSessionProgressBasePath = "progress"
+)
+
+// BuildGetCookingSessionRequest builds an HTTP request for fetching a cooking session.
+func (b *Builder) BuildGetCookingSessionRequest(ctx context.Context, cookingSessionID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		cookingSessionsBasePath,
+		cookingSessionID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetCookingSessionsRequest builds an HTTP request for fetching a list of cooking sessions.
+func (b *Builder) BuildGetCookingSessionsRequest(ctx context.Context, filter *types.QueryFilter) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	uri := b.BuildURL(
+		ctx,
+		filter.ToValues(),
+		cookingSessionsBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildCreateCookingSessionRequest builds an HTTP request for creating a cooking session.
+func (b *Builder) BuildCreateCookingSessionRequest(ctx context.Context, input *types.CookingSessionCreationRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		cookingSessionsBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPost, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildRecordCookingSessionProgressRequest builds an HTTP request for recording progress in a cooking session.
+func (b *Builder) BuildRecordCookingSessionProgressRequest(ctx context.Context, cookingSessionID string, input *types.CookingSessionProgressCreationRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		cookingSessionsBasePath,
+		cookingSessionID,
+		cookingSessionProgressBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPost, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildArchiveCookingSessionRequest builds an HTTP request for archiving a cooking session.
+func (b *Builder) BuildArchiveCookingSessionRequest(ctx context.Context, cookingSessionID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if cookingSessionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.CookingSessionIDKey, cookingSessionID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		cookingSessionsBasePath,
+		cookingSessionID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
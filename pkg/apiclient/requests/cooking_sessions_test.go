@@ -0,0 +1,258 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_BuildGetCookingSessionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/cooking_sessions/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleCookingSession.ID)
+
+		actual, err := helper.builder.BuildGetCookingSessionRequest(helper.ctx, exampleCookingSession.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetCookingSessionRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+
+		actual, err := helper.builder.BuildGetCookingSessionRequest(helper.ctx, exampleCookingSession.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetCookingSessionsRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/cooking_sessions"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		filter := (*types.QueryFilter)(nil)
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat)
+
+		actual, err := helper.builder.BuildGetCookingSessionsRequest(helper.ctx, filter)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		filter := (*types.QueryFilter)(nil)
+
+		actual, err := helper.builder.BuildGetCookingSessionsRequest(helper.ctx, filter)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildCreateCookingSessionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPath = "/api/v1/cooking_sessions"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+
+		actual, err := helper.builder.BuildCreateCookingSessionRequest(helper.ctx, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateCookingSessionRequest(helper.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateCookingSessionRequest(helper.ctx, &types.CookingSessionCreationRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleInput := fakes.BuildFakeCookingSessionCreationRequestInput()
+
+		actual, err := helper.builder.BuildCreateCookingSessionRequest(helper.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildRecordCookingSessionProgressRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/cooking_sessions/%s/progress"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleCookingSession.ID)
+
+		actual, err := helper.builder.BuildRecordCookingSessionProgressRequest(helper.ctx, exampleCookingSession.ID, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+
+		actual, err := helper.builder.BuildRecordCookingSessionProgressRequest(helper.ctx, "", exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+
+		actual, err := helper.builder.BuildRecordCookingSessionProgressRequest(helper.ctx, exampleCookingSession.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+
+		actual, err := helper.builder.BuildRecordCookingSessionProgressRequest(helper.ctx, exampleCookingSession.ID, &types.CookingSessionProgressCreationRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+		exampleInput := fakes.BuildFakeCookingSessionProgressCreationRequestInput()
+
+		actual, err := helper.builder.BuildRecordCookingSessionProgressRequest(helper.ctx, exampleCookingSession.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildArchiveCookingSessionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/cooking_sessions/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, exampleCookingSession.ID)
+
+		actual, err := helper.builder.BuildArchiveCookingSessionRequest(helper.ctx, exampleCookingSession.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid cooking session ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildArchiveCookingSessionRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleCookingSession := fakes.BuildFakeCookingSession()
+
+		actual, err := helper.builder.BuildArchiveCookingSessionRequest(helper.ctx, exampleCookingSession.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
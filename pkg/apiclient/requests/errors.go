@@ -38,4 +38,10 @@
 
 	// ErrInvalidSecretKeyLength indicates that a secret key of invalid length was provided as an argument.
 	ErrInvalidSecretKeyLength = errors.New("invalid secret key length")
+
+	// ErrInvalidPortionsProvided indicates a non-positive number of portions was provided.
+	ErrInvalidPortionsProvided = errors.New("portions must be greater than zero")
+
+	// ErrInvalidRecommendationCountProvided indicates a non-positive number of recommendations was requested.
+	ErrInvalidRecommendationCountProvided = errors.New("recommendation count must be greater than zero")
 )
@@ -4,6 +4,8 @@
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/dinnerdonebetter/backend/internal/observability"
 	"github.com/dinnerdonebetter/backend/internal/observability/keys"
@@ -281,3 +283,87 @@ func (b *Builder) BuildTransferHouseholdOwnershipRequest(ctx context.Context, ho
 
 	return b.buildDataRequest(ctx, http.MethodPost, uri, input)
 }
+
+// BuildRotateHouseholdWebhookEncryptionKeyRequest builds a request that rotates a household's webhook encryption key.
+func (b *Builder) BuildRotateHouseholdWebhookEncryptionKeyRequest(ctx context.Context, householdID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	uri := b.BuildURL(ctx, nil, householdsBasePath, householdID, "webhook_encryption_key", "rotate")
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetHouseholdRecommendationsRequest builds an HTTP request for fetching recipe recommendations for a household.
+func (b *Builder) BuildGetHouseholdRecommendationsRequest(ctx context.Context, householdID string, count int) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if count <= 0 {
+		return nil, ErrInvalidRecommendationCountProvided
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		url.Values{types.RecommendationCountQueryKey: []string{strconv.Itoa(count)}},
+		householdsBasePath,
+		householdID,
+		"recommendations",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildHouseholdEventStreamRequest builds an HTTP request for streaming a household's events. A non-zero
+// lastEventID resumes the stream after that event.
+func (b *Builder) BuildHouseholdEventStreamRequest(ctx context.Context, householdID string, lastEventID types.HouseholdEventCursor) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		householdsBasePath,
+		householdID,
+		"events",
+		"stream",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	if !lastEventID.IsZero() {
+		req.Header.Set(types.LastEventIDHeaderKey, lastEventID.String())
+	}
+
+	return req, nil
+}
@@ -598,3 +598,153 @@ func TestBuilder_BuildTransferHouseholdOwnershipRequest(T *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestBuilder_BuildRotateHouseholdWebhookEncryptionKeyRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/webhook_encryption_key/rotate"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleHouseholdID)
+
+		actual, err := helper.builder.BuildRotateHouseholdWebhookEncryptionKeyRequest(helper.ctx, exampleHouseholdID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildRotateHouseholdWebhookEncryptionKeyRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		actual, err := helper.builder.BuildRotateHouseholdWebhookEncryptionKeyRequest(helper.ctx, exampleHouseholdID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetHouseholdRecommendationsRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/recommendations"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodGet, "count=5", expectedPathFormat, exampleHouseholdID)
+
+		actual, err := helper.builder.BuildGetHouseholdRecommendationsRequest(helper.ctx, exampleHouseholdID, 5)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetHouseholdRecommendationsRequest(helper.ctx, "", 5)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid count", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetHouseholdRecommendationsRequest(helper.ctx, fakes.BuildFakeID(), 0)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildGetHouseholdRecommendationsRequest(helper.ctx, fakes.BuildFakeID(), 5)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildHouseholdEventStreamRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/events/stream"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleHouseholdID)
+
+		actual, err := helper.builder.BuildHouseholdEventStreamRequest(helper.ctx, exampleHouseholdID, types.HouseholdEventCursor{})
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+		assert.Empty(t, actual.Header.Get(types.LastEventIDHeaderKey))
+	})
+
+	T.Run("resuming from an event", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleHouseholdID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleHouseholdID)
+
+		actual, err := helper.builder.BuildHouseholdEventStreamRequest(helper.ctx, exampleHouseholdID, types.HouseholdEventCursor{TransactionID: 123, Sequence: 4})
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+		assert.Equal(t, "123-4", actual.Header.Get(types.LastEventIDHeaderKey))
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildHouseholdEventStreamRequest(helper.ctx, "", types.HouseholdEventCursor{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildHouseholdEventStreamRequest(helper.ctx, fakes.BuildFakeID(), types.HouseholdEventCursor{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -168,3 +168,71 @@ func (b *Builder) BuildArchiveMealPlanEventRequest(ctx context.Context, mealPlan
 
 	return req, nil
 }
+
+// BuildGetMealPlanEventResultsRequest builds an HTTP request for fetching the vote tally for a meal plan event.
+func (b *Builder) BuildGetMealPlanEventResultsRequest(ctx context.Context, mealPlanID, mealPlanEventID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		mealPlansBasePath,
+		mealPlanID,
+		mealPlanEventsBasePath,
+		mealPlanEventID,
+		"results",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetMealPlanEventTimelineRequest builds an HTTP request for fetching the cook timeline for a meal plan event.
+func (b *Builder) BuildGetMealPlanEventTimelineRequest(ctx context.Context, mealPlanID, mealPlanEventID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		mealPlansBasePath,
+		mealPlanID,
+		mealPlanEventsBasePath,
+		mealPlanEventID,
+		"timeline",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
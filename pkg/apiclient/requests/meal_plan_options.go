@@ -55,6 +55,47 @@ func (b *Builder) BuildGetMealPlanOptionRequest(ctx context.Context, mealPlanID,
 	return req, nil
 }
 
+// BuildGetMealPlanOptionDietaryConflictsRequest builds an HTTP request for checking a meal plan option for dietary conflicts.
+func (b *Builder) BuildGetMealPlanOptionDietaryConflictsRequest(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanEventIDKey, mealPlanEventID)
+
+	if mealPlanOptionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.MealPlanOptionIDKey, mealPlanOptionID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		mealPlansBasePath,
+		mealPlanID,
+		mealPlanEventsBasePath,
+		mealPlanEventID,
+		mealPlanOptionsBasePath,
+		mealPlanOptionID,
+		"dietary_conflicts",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
 // BuildGetMealPlanOptionsRequest builds an HTTP request for fetching a list of meal plan options.
 func (b *Builder) BuildGetMealPlanOptionsRequest(ctx context.Context, mealPlanID, mealPlanEventID string, filter *types.QueryFilter) (*http.Request, error) {
 	ctx, span := b.tracer.StartSpan(ctx)
@@ -74,6 +74,83 @@ func TestBuilder_BuildGetMealPlanOptionRequest(T *testing.T) {
 	})
 }
 
+func TestBuilder_BuildGetMealPlanOptionDietaryConflictsRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/meal_plans/%s/events/%s/options/%s/dietary_conflicts"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEventID := fakes.BuildFakeID()
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleMealPlanID, exampleMealPlanEventID, exampleMealPlanOption.ID)
+
+		actual, err := helper.builder.BuildGetMealPlanOptionDietaryConflictsRequest(helper.ctx, exampleMealPlanID, exampleMealPlanEventID, exampleMealPlanOption.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid meal plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleMealPlanEventID := fakes.BuildFakeID()
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
+
+		actual, err := helper.builder.BuildGetMealPlanOptionDietaryConflictsRequest(helper.ctx, "", exampleMealPlanEventID, exampleMealPlanOption.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid meal plan event ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
+
+		actual, err := helper.builder.BuildGetMealPlanOptionDietaryConflictsRequest(helper.ctx, exampleMealPlanID, "", exampleMealPlanOption.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid meal plan option ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEventID := fakes.BuildFakeID()
+
+		actual, err := helper.builder.BuildGetMealPlanOptionDietaryConflictsRequest(helper.ctx, exampleMealPlanID, exampleMealPlanEventID, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEventID := fakes.BuildFakeID()
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
+
+		actual, err := helper.builder.BuildGetMealPlanOptionDietaryConflictsRequest(helper.ctx, exampleMealPlanID, exampleMealPlanEventID, exampleMealPlanOption.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func TestBuilder_BuildGetMealPlanOptionsRequest(T *testing.T) {
 	T.Parallel()
 
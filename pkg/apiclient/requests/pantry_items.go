@@ -0,0 +1,180 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+)
+
+const (
+	pantryItemsBasePath = "pantry"
+)
+
+// BuildGetPantryItemRequest builds an HTTP request for fetching a pantry item.
+func (b *Builder) BuildGetPantryItemRequest(ctx context.Context, householdID, pantryItemID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if pantryItemID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		householdsBasePath,
+		householdID,
+		pantryItemsBasePath,
+		pantryItemID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetPantryItemsRequest builds an HTTP request for fetching a list of pantry items.
+func (b *Builder) BuildGetPantryItemsRequest(ctx context.Context, householdID string, filter *types.QueryFilter) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	uri := b.BuildURL(
+		ctx,
+		filter.ToValues(),
+		householdsBasePath,
+		householdID,
+		pantryItemsBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildCreatePantryItemRequest builds an HTTP request for creating a pantry item.
+func (b *Builder) BuildCreatePantryItemRequest(ctx context.Context, householdID string, input *types.PantryItemCreationRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		householdsBasePath,
+		householdID,
+		pantryItemsBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPost, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildUpdatePantryItemRequest builds an HTTP request for updating a pantry item.
+func (b *Builder) BuildUpdatePantryItemRequest(ctx context.Context, householdID string, pantryItem *types.PantryItem) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if pantryItem == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItem.ID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		householdsBasePath,
+		householdID,
+		pantryItemsBasePath,
+		pantryItem.ID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	input := converters.ConvertPantryItemToPantryItemUpdateRequestInput(pantryItem)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPut, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildArchivePantryItemRequest builds an HTTP request for archiving a pantry item.
+func (b *Builder) BuildArchivePantryItemRequest(ctx context.Context, householdID, pantryItemID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if householdID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.HouseholdIDKey, householdID)
+
+	if pantryItemID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.PantryItemIDKey, pantryItemID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		householdsBasePath,
+		householdID,
+		pantryItemsBasePath,
+		pantryItemID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
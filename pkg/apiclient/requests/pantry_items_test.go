@@ -0,0 +1,290 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_BuildGetPantryItemRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/pantry/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+
+		actual, err := helper.builder.BuildGetPantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		actual, err := helper.builder.BuildGetPantryItemRequest(helper.ctx, "", examplePantryItem.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		actual, err := helper.builder.BuildGetPantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		actual, err := helper.builder.BuildGetPantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetPantryItemsRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/pantry"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+		filter := (*types.QueryFilter)(nil)
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat, exampleHouseholdID)
+
+		actual, err := helper.builder.BuildGetPantryItemsRequest(helper.ctx, exampleHouseholdID, filter)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		filter := (*types.QueryFilter)(nil)
+
+		actual, err := helper.builder.BuildGetPantryItemsRequest(helper.ctx, "", filter)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		filter := (*types.QueryFilter)(nil)
+
+		actual, err := helper.builder.BuildGetPantryItemsRequest(helper.ctx, fakes.BuildFakeID(), filter)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildCreatePantryItemRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/pantry"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleHouseholdID := fakes.BuildFakeID()
+		exampleInput := fakes.BuildFakePantryItemCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleHouseholdID)
+
+		actual, err := helper.builder.BuildCreatePantryItemRequest(helper.ctx, exampleHouseholdID, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleInput := fakes.BuildFakePantryItemCreationRequestInput()
+
+		actual, err := helper.builder.BuildCreatePantryItemRequest(helper.ctx, "", exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreatePantryItemRequest(helper.ctx, fakes.BuildFakeID(), nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreatePantryItemRequest(helper.ctx, fakes.BuildFakeID(), &types.PantryItemCreationRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleInput := fakes.BuildFakePantryItemCreationRequestInput()
+
+		actual, err := helper.builder.BuildCreatePantryItemRequest(helper.ctx, fakes.BuildFakeID(), exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildUpdatePantryItemRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/pantry/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		spec := newRequestSpec(false, http.MethodPut, "", expectedPathFormat, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+
+		actual, err := helper.builder.BuildUpdatePantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, examplePantryItem)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildUpdatePantryItemRequest(helper.ctx, "", fakes.BuildFakePantryItem())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildUpdatePantryItemRequest(helper.ctx, fakes.BuildFakeID(), nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		actual, err := helper.builder.BuildUpdatePantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, examplePantryItem)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildArchivePantryItemRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/households/%s/pantry/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+
+		actual, err := helper.builder.BuildArchivePantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid household ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildArchivePantryItemRequest(helper.ctx, "", fakes.BuildFakeID())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildArchivePantryItemRequest(helper.ctx, fakes.BuildFakeID(), "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+
+		actual, err := helper.builder.BuildArchivePantryItemRequest(helper.ctx, examplePantryItem.BelongsToHousehold, examplePantryItem.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -169,6 +169,55 @@ func (b *Builder) BuildUpdateRecipeStepIngredientRequest(ctx context.Context, re
 	return req, nil
 }
 
+// BuildSubstituteRecipeStepIngredientRequest builds an HTTP request for substituting a recipe step ingredient.
+func (b *Builder) BuildSubstituteRecipeStepIngredientRequest(ctx context.Context, recipeID, recipeStepID, recipeStepIngredientID string, input *types.RecipeStepIngredientSubstitutionRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if recipeID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	if recipeStepID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.RecipeStepIDKey, recipeStepID)
+
+	if recipeStepIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.RecipeStepIngredientIDKey, recipeStepIngredientID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		recipesBasePath,
+		recipeID,
+		recipeStepsBasePath,
+		recipeStepID,
+		recipeStepIngredientsBasePath,
+		recipeStepIngredientID,
+		"substitute",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPost, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
 // BuildArchiveRecipeStepIngredientRequest builds an HTTP request for archiving a recipe step ingredient.
 func (b *Builder) BuildArchiveRecipeStepIngredientRequest(ctx context.Context, recipeID, recipeStepID, recipeStepIngredientID string) (*http.Request, error) {
 	ctx, span := b.tracer.StartSpan(ctx)
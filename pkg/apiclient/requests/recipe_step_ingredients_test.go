@@ -291,6 +291,64 @@ func TestBuilder_BuildUpdateRecipeStepIngredientRequest(T *testing.T) {
 	})
 }
 
+func TestBuilder_BuildSubstituteRecipeStepIngredientRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/recipes/%s/steps/%s/ingredients/%s/substitute"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleRecipeID := fakes.BuildFakeID()
+		exampleRecipeStepIngredient := fakes.BuildFakeRecipeStepIngredient()
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()}
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleRecipeID, exampleRecipeStepIngredient.BelongsToRecipeStep, exampleRecipeStepIngredient.ID)
+
+		actual, err := helper.builder.BuildSubstituteRecipeStepIngredientRequest(helper.ctx, exampleRecipeID, exampleRecipeStepIngredient.BelongsToRecipeStep, exampleRecipeStepIngredient.ID, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid recipe step ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()}
+
+		actual, err := helper.builder.BuildSubstituteRecipeStepIngredientRequest(helper.ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), "", exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildSubstituteRecipeStepIngredientRequest(helper.ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), fakes.BuildFakeID(), &types.RecipeStepIngredientSubstitutionRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleInput := &types.RecipeStepIngredientSubstitutionRequestInput{ValidIngredientSubstitutionID: fakes.BuildFakeID()}
+
+		actual, err := helper.builder.BuildSubstituteRecipeStepIngredientRequest(helper.ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), fakes.BuildFakeID(), exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func TestBuilder_BuildArchiveRecipeStepIngredientRequest(T *testing.T) {
 	T.Parallel()
 
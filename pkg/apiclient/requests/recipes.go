@@ -7,7 +7,9 @@
 	"io"
 	"mime/multipart"
 	"net/http"
+	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/dinnerdonebetter/backend/internal/observability"
@@ -123,6 +125,35 @@ func (b *Builder) BuildCreateRecipeRequest(ctx context.Context, input *types.Rec
 	return req, nil
 }
 
+// BuildImportRecipeRequest builds an HTTP request for importing a recipe.
+func (b *Builder) BuildImportRecipeRequest(ctx context.Context, input *types.RecipeImportRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		recipesBasePath,
+		"import",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPost, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
 // BuildUpdateRecipeRequest builds an HTTP request for updating a recipe.
 func (b *Builder) BuildUpdateRecipeRequest(ctx context.Context, recipe *types.Recipe) (*http.Request, error) {
 	ctx, span := b.tracer.StartSpan(ctx)
@@ -204,6 +235,64 @@ func (b *Builder) BuildGetRecipeDAGRequest(ctx context.Context, recipeID string)
 	return req, nil
 }
 
+// BuildGetScaledRecipeRequest builds an HTTP request for fetching a recipe scaled to a given number of portions.
+func (b *Builder) BuildGetScaledRecipeRequest(ctx context.Context, recipeID string, portions float32) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if recipeID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	if portions <= 0 {
+		return nil, ErrInvalidPortionsProvided
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		url.Values{types.RecipeScalingPortionsQueryKey: []string{strconv.FormatFloat(float64(portions), 'f', -1, 32)}},
+		recipesBasePath,
+		recipeID,
+		"scaled",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetRecipeEquipmentReportRequest builds an HTTP request for checking a recipe against the active household's equipment.
+func (b *Builder) BuildGetRecipeEquipmentReportRequest(ctx context.Context, recipeID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if recipeID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.RecipeIDKey, recipeID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		recipesBasePath,
+		recipeID,
+		"equipment",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
 // BuildGetRecipeMealPlanTasksRequest builds an HTTP request for fetching a recipe.
 func (b *Builder) BuildGetRecipeMealPlanTasksRequest(ctx context.Context, recipeID string) (*http.Request, error) {
 	ctx, span := b.tracer.StartSpan(ctx)
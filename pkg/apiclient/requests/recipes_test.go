@@ -174,6 +174,60 @@ func TestBuilder_BuildCreateRecipeRequest(T *testing.T) {
 	})
 }
 
+func TestBuilder_BuildImportRecipeRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPath = "/api/v1/recipes/import"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleInput := &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}}
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+
+		actual, err := helper.builder.BuildImportRecipeRequest(helper.ctx, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildImportRecipeRequest(helper.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildImportRecipeRequest(helper.ctx, &types.RecipeImportRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleInput := &types.RecipeImportRequestInput{IngredientLines: []string{"2 1/2 cups diced onion"}}
+
+		actual, err := helper.builder.BuildImportRecipeRequest(helper.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func TestBuilder_BuildUpdateRecipeRequest(T *testing.T) {
 	T.Parallel()
 
@@ -306,6 +360,106 @@ func TestBuilder_BuildGetRecipeDAGRequest(T *testing.T) {
 	})
 }
 
+func TestBuilder_BuildGetScaledRecipeRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/recipes/%s/scaled"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+
+		spec := newRequestSpec(true, http.MethodGet, "portions=2.5", expectedPathFormat, exampleRecipe.ID)
+
+		actual, err := helper.builder.BuildGetScaledRecipeRequest(helper.ctx, exampleRecipe.ID, 2.5)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid recipe ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetScaledRecipeRequest(helper.ctx, "", 2)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid portions", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+
+		actual, err := helper.builder.BuildGetScaledRecipeRequest(helper.ctx, exampleRecipe.ID, 0)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+
+		actual, err := helper.builder.BuildGetScaledRecipeRequest(helper.ctx, exampleRecipe.ID, 2)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetRecipeEquipmentReportRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/recipes/%s/equipment"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleRecipe.ID)
+
+		actual, err := helper.builder.BuildGetRecipeEquipmentReportRequest(helper.ctx, exampleRecipe.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid recipe ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetRecipeEquipmentReportRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+
+		actual, err := helper.builder.BuildGetRecipeEquipmentReportRequest(helper.ctx, exampleRecipe.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
 func TestBuilder_BuildGetRecipeMealPlanTasksRequest(T *testing.T) {
 	T.Parallel()
 
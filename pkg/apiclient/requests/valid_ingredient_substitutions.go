@@ -0,0 +1,173 @@
+package requests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	keys "github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+)
+
+const (
+	validIngredientSubstitutionsBasePath = "valid_ingredient_substitutions"
+)
+
+// BuildGetValidIngredientSubstitutionRequest builds an HTTP request for fetching a valid ingredient substitution.
+func (b *Builder) BuildGetValidIngredientSubstitutionRequest(ctx context.Context, validIngredientSubstitutionID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if validIngredientSubstitutionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientSubstitutionsBasePath,
+		validIngredientSubstitutionID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetValidIngredientSubstitutionsRequest builds an HTTP request for fetching a list of valid ingredient substitutions.
+func (b *Builder) BuildGetValidIngredientSubstitutionsRequest(ctx context.Context, filter *types.QueryFilter) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	uri := b.BuildURL(
+		ctx,
+		filter.ToValues(),
+		validIngredientSubstitutionsBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetValidIngredientSubstitutionsForIngredientRequest builds an HTTP request for fetching a list of valid ingredient substitutions.
+func (b *Builder) BuildGetValidIngredientSubstitutionsForIngredientRequest(ctx context.Context, ingredientID string, filter *types.QueryFilter) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if ingredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, ingredientID)
+
+	uri := b.BuildURL(
+		ctx,
+		filter.ToValues(),
+		validIngredientSubstitutionsBasePath,
+		"by_ingredient",
+		ingredientID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildCreateValidIngredientSubstitutionRequest builds an HTTP request for creating a valid ingredient substitution.
+func (b *Builder) BuildCreateValidIngredientSubstitutionRequest(ctx context.Context, input *types.ValidIngredientSubstitutionCreationRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientSubstitutionsBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPost, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildUpdateValidIngredientSubstitutionRequest builds an HTTP request for updating a valid ingredient substitution.
+func (b *Builder) BuildUpdateValidIngredientSubstitutionRequest(ctx context.Context, validIngredientSubstitution *types.ValidIngredientSubstitution) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if validIngredientSubstitution == nil {
+		return nil, ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitution.ID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientSubstitutionsBasePath,
+		validIngredientSubstitution.ID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	input := converters.ConvertValidIngredientSubstitutionToValidIngredientSubstitutionUpdateRequestInput(validIngredientSubstitution)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPut, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildArchiveValidIngredientSubstitutionRequest builds an HTTP request for archiving a valid ingredient substitution.
+func (b *Builder) BuildArchiveValidIngredientSubstitutionRequest(ctx context.Context, validIngredientSubstitutionID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if validIngredientSubstitutionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientSubstitutionsBasePath,
+		validIngredientSubstitutionID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
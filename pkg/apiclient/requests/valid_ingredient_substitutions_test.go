@@ -0,0 +1,276 @@
+package requests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_BuildGetValidIngredientSubstitutionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleValidIngredientSubstitution.ID)
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionRequest(helper.ctx, exampleValidIngredientSubstitution.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid valid ingredient substitution ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionRequest(helper.ctx, exampleValidIngredientSubstitution.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetValidIngredientSubstitutionsRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		filter := (*types.QueryFilter)(nil)
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat)
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionsRequest(helper.ctx, filter)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		filter := (*types.QueryFilter)(nil)
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionsRequest(helper.ctx, filter)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetValidIngredientSubstitutionsForIngredientRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/by_ingredient/%s"
+
+	exampleIngredient := fakes.BuildFakeValidIngredient()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		filter := (*types.QueryFilter)(nil)
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat, exampleIngredient.ID)
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionsForIngredientRequest(helper.ctx, exampleIngredient.ID, filter)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		filter := (*types.QueryFilter)(nil)
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionsForIngredientRequest(helper.ctx, "", filter)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		filter := (*types.QueryFilter)(nil)
+
+		actual, err := helper.builder.BuildGetValidIngredientSubstitutionsForIngredientRequest(helper.ctx, exampleIngredient.ID, filter)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildCreateValidIngredientSubstitutionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPath = "/api/v1/valid_ingredient_substitutions"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleInput := fakes.BuildFakeValidIngredientSubstitutionCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+
+		actual, err := helper.builder.BuildCreateValidIngredientSubstitutionRequest(helper.ctx, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateValidIngredientSubstitutionRequest(helper.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildCreateValidIngredientSubstitutionRequest(helper.ctx, &types.ValidIngredientSubstitutionCreationRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleInput := fakes.BuildFakeValidIngredientSubstitutionCreationRequestInput()
+
+		actual, err := helper.builder.BuildCreateValidIngredientSubstitutionRequest(helper.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildUpdateValidIngredientSubstitutionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+
+		spec := newRequestSpec(false, http.MethodPut, "", expectedPathFormat, exampleValidIngredientSubstitution.ID)
+
+		actual, err := helper.builder.BuildUpdateValidIngredientSubstitutionRequest(helper.ctx, exampleValidIngredientSubstitution)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildUpdateValidIngredientSubstitutionRequest(helper.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+
+		actual, err := helper.builder.BuildUpdateValidIngredientSubstitutionRequest(helper.ctx, exampleValidIngredientSubstitution)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildArchiveValidIngredientSubstitutionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, exampleValidIngredientSubstitution.ID)
+
+		actual, err := helper.builder.BuildArchiveValidIngredientSubstitutionRequest(helper.ctx, exampleValidIngredientSubstitution.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid valid ingredient substitution ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildArchiveValidIngredientSubstitutionRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		exampleValidIngredientSubstitution := fakes.BuildFakeValidIngredientSubstitution()
+
+		actual, err := helper.builder.BuildArchiveValidIngredientSubstitutionRequest(helper.ctx, exampleValidIngredientSubstitution.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
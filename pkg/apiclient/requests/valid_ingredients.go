@@ -191,3 +191,92 @@ func (b *Builder) BuildArchiveValidIngredientRequest(ctx context.Context, validI
 
 	return req, nil
 }
+
+// BuildGetValidIngredientNutritionRequest builds an HTTP request for fetching a valid ingredient's nutrition facts.
+func (b *Builder) BuildGetValidIngredientNutritionRequest(ctx context.Context, validIngredientID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientsBasePath,
+		validIngredientID,
+		"nutrition",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildUpdateValidIngredientNutritionRequest builds an HTTP request for setting a valid ingredient's nutrition facts.
+func (b *Builder) BuildUpdateValidIngredientNutritionRequest(ctx context.Context, validIngredientID string, input *types.ValidIngredientNutritionUpsertRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientsBasePath,
+		validIngredientID,
+		"nutrition",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := b.buildDataRequest(ctx, http.MethodPut, uri, input)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildArchiveValidIngredientNutritionRequest builds an HTTP request for archiving a valid ingredient's nutrition facts.
+func (b *Builder) BuildArchiveValidIngredientNutritionRequest(ctx context.Context, validIngredientID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		validIngredientsBasePath,
+		validIngredientID,
+		"nutrition",
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
@@ -293,3 +293,150 @@ func TestBuilder_BuildArchiveValidIngredientRequest(T *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestBuilder_BuildGetValidIngredientNutritionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredients/%s/nutrition"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleValidIngredient := fakes.BuildFakeValidIngredient()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleValidIngredient.ID)
+
+		actual, err := helper.builder.BuildGetValidIngredientNutritionRequest(helper.ctx, exampleValidIngredient.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetValidIngredientNutritionRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildGetValidIngredientNutritionRequest(helper.ctx, fakes.BuildFakeID())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildUpdateValidIngredientNutritionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredients/%s/nutrition"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleValidIngredient := fakes.BuildFakeValidIngredient()
+		exampleInput := fakes.BuildFakeValidIngredientNutritionUpsertRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPut, "", expectedPathFormat, exampleValidIngredient.ID)
+
+		actual, err := helper.builder.BuildUpdateValidIngredientNutritionRequest(helper.ctx, exampleValidIngredient.ID, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildUpdateValidIngredientNutritionRequest(helper.ctx, "", fakes.BuildFakeValidIngredientNutritionUpsertRequestInput())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildUpdateValidIngredientNutritionRequest(helper.ctx, fakes.BuildFakeID(), nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildUpdateValidIngredientNutritionRequest(helper.ctx, fakes.BuildFakeID(), &types.ValidIngredientNutritionUpsertRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildUpdateValidIngredientNutritionRequest(helper.ctx, fakes.BuildFakeID(), fakes.BuildFakeValidIngredientNutritionUpsertRequestInput())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildArchiveValidIngredientNutritionRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/valid_ingredients/%s/nutrition"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		exampleValidIngredient := fakes.BuildFakeValidIngredient()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, exampleValidIngredient.ID)
+
+		actual, err := helper.builder.BuildArchiveValidIngredientNutritionRequest(helper.ctx, exampleValidIngredient.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildArchiveValidIngredientNutritionRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildArchiveValidIngredientNutritionRequest(helper.ctx, fakes.BuildFakeID())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
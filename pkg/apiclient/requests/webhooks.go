@@ -130,3 +130,92 @@ func (b *Builder) BuildArchiveWebhookTriggerEventRequest(ctx context.Context, we
 
 	return req, nil
 }
+
+// BuildGetWebhookDeliveriesRequest builds an HTTP request for fetching a list of a webhook's deliveries.
+func (b *Builder) BuildGetWebhookDeliveriesRequest(ctx context.Context, webhookID string, filter *types.QueryFilter) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	uri := b.BuildURL(ctx, filter.ToValues(), webhooksBasePath, webhookID, "deliveries")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildRedeliverWebhookDeliveryRequest builds an HTTP request for redelivering a webhook delivery.
+func (b *Builder) BuildRedeliverWebhookDeliveryRequest(ctx context.Context, webhookID, webhookDeliveryID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" || webhookDeliveryID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	uri := b.BuildURL(ctx, nil, webhooksBasePath, webhookID, "deliveries", webhookDeliveryID, "redeliver")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildPingWebhookRequest builds an HTTP request for sending a synthetic event to a webhook.
+func (b *Builder) BuildPingWebhookRequest(ctx context.Context, webhookID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	uri := b.BuildURL(ctx, nil, webhooksBasePath, webhookID, "ping")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildPreviewWebhookRequest builds an HTTP request for previewing the request a webhook would receive.
+func (b *Builder) BuildPreviewWebhookRequest(ctx context.Context, webhookID string, input *types.WebhookPreviewRequestInput) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	tracing.AttachToSpan(span, keys.WebhookIDKey, webhookID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	uri := b.BuildURL(ctx, nil, webhooksBasePath, webhookID, "preview")
+
+	return b.buildDataRequest(ctx, http.MethodPost, uri, input)
+}
@@ -290,3 +290,210 @@ func TestBuilder_BuildArchiveWebhookTriggerEventRequest(T *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestBuilder_BuildGetWebhookDeliveriesRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/webhooks/%s/deliveries"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		spec := newRequestSpec(false, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat, exampleWebhook.ID)
+
+		actual, err := helper.builder.BuildGetWebhookDeliveriesRequest(helper.ctx, exampleWebhook.ID, nil)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetWebhookDeliveriesRequest(helper.ctx, "", nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		actual, err := helper.builder.BuildGetWebhookDeliveriesRequest(helper.ctx, exampleWebhook.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildRedeliverWebhookDeliveryRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/webhooks/%s/deliveries/%s/redeliver"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+		exampleWebhookDelivery := fakes.BuildFakeWebhookDelivery()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleWebhook.ID, exampleWebhookDelivery.ID)
+
+		actual, err := helper.builder.BuildRedeliverWebhookDeliveryRequest(helper.ctx, exampleWebhook.ID, exampleWebhookDelivery.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhookDelivery := fakes.BuildFakeWebhookDelivery()
+
+		actual, err := helper.builder.BuildRedeliverWebhookDeliveryRequest(helper.ctx, "", exampleWebhookDelivery.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid webhook delivery ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		actual, err := helper.builder.BuildRedeliverWebhookDeliveryRequest(helper.ctx, exampleWebhook.ID, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+		exampleWebhook := fakes.BuildFakeWebhook()
+		exampleWebhookDelivery := fakes.BuildFakeWebhookDelivery()
+
+		actual, err := helper.builder.BuildRedeliverWebhookDeliveryRequest(helper.ctx, exampleWebhook.ID, exampleWebhookDelivery.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildPingWebhookRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/webhooks/%s/ping"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleWebhook.ID)
+
+		actual, err := helper.builder.BuildPingWebhookRequest(helper.ctx, exampleWebhook.ID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildPingWebhookRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		actual, err := helper.builder.BuildPingWebhookRequest(helper.ctx, exampleWebhook.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildPreviewWebhookRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/webhooks/%s/preview"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+		exampleInput := fakes.BuildFakeWebhookPreviewRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, exampleWebhook.ID)
+
+		actual, err := helper.builder.BuildPreviewWebhookRequest(helper.ctx, exampleWebhook.ID, exampleInput)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleInput := fakes.BuildFakeWebhookPreviewRequestInput()
+
+		actual, err := helper.builder.BuildPreviewWebhookRequest(helper.ctx, "", exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		actual, err := helper.builder.BuildPreviewWebhookRequest(helper.ctx, exampleWebhook.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleWebhook := fakes.BuildFakeWebhook()
+
+		actual, err := helper.builder.BuildPreviewWebhookRequest(helper.ctx, exampleWebhook.ID, &types.WebhookPreviewRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+		exampleWebhook := fakes.BuildFakeWebhook()
+		exampleInput := fakes.BuildFakeWebhookPreviewRequestInput()
+
+		actual, err := helper.builder.BuildPreviewWebhookRequest(helper.ctx, exampleWebhook.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -4,11 +4,16 @@
 	"context"
 	"net/http"
 
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 )
 
 const (
-	workersBasePath = "workers"
+	workersBasePath      = "workers"
+	deadLettersBasePath  = "dead_letters"
+	replayDeadLetterPath = "replay"
 )
 
 // BuildRunFinalizeMealPlansWorkerRequest builds an HTTP request for running a worker.
@@ -44,3 +49,83 @@ func (b *Builder) BuildRunMealPlanTaskCreationWorkerRequest(ctx context.Context)
 
 	return b.buildDataRequest(ctx, http.MethodPost, uri, nil)
 }
+
+// BuildGetDeadLetteredMessagesRequest builds an HTTP request for fetching a list of dead-lettered messages.
+func (b *Builder) BuildGetDeadLetteredMessagesRequest(ctx context.Context, filter *types.QueryFilter) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	uri := b.BuildURL(
+		ctx,
+		filter.ToValues(),
+		adminBasePath,
+		workersBasePath,
+		deadLettersBasePath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildGetDeadLetteredMessageRequest builds an HTTP request for fetching a dead-lettered message.
+func (b *Builder) BuildGetDeadLetteredMessageRequest(ctx context.Context, deadLetteredMessageID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if deadLetteredMessageID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		adminBasePath,
+		workersBasePath,
+		deadLettersBasePath,
+		deadLetteredMessageID,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
+
+// BuildReplayDeadLetteredMessageRequest builds an HTTP request for replaying a dead-lettered message.
+func (b *Builder) BuildReplayDeadLetteredMessageRequest(ctx context.Context, deadLetteredMessageID string) (*http.Request, error) {
+	ctx, span := b.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if deadLetteredMessageID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	uri := b.BuildURL(
+		ctx,
+		nil,
+		adminBasePath,
+		workersBasePath,
+		deadLettersBasePath,
+		deadLetteredMessageID,
+		replayDeadLetterPath,
+	)
+	tracing.AttachToSpan(span, keys.RequestURIKey, uri)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, http.NoBody)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building request")
+	}
+
+	return req, nil
+}
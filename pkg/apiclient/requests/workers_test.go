@@ -4,6 +4,7 @@
 	"net/http"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/pkg/types"
 	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
 
 	"github.com/stretchr/testify/assert"
@@ -108,3 +109,116 @@ func TestBuilder_BuildRunMealPlanTaskCreationWorkerRequest(T *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestBuilder_BuildGetDeadLetteredMessagesRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPath = "/api/v1/admin/workers/dead_letters"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		filter := (*types.QueryFilter)(nil)
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+
+		actual, err := helper.builder.BuildGetDeadLetteredMessagesRequest(helper.ctx, filter)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildGetDeadLetteredMessagesRequest(helper.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildGetDeadLetteredMessageRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/admin/workers/dead_letters/%s"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleDeadLetteredMessageID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleDeadLetteredMessageID)
+
+		actual, err := helper.builder.BuildGetDeadLetteredMessageRequest(helper.ctx, exampleDeadLetteredMessageID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid dead-lettered message ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildGetDeadLetteredMessageRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildGetDeadLetteredMessageRequest(helper.ctx, fakes.BuildFakeID())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestBuilder_BuildReplayDeadLetteredMessageRequest(T *testing.T) {
+	T.Parallel()
+
+	const expectedPathFormat = "/api/v1/admin/workers/dead_letters/%s/replay"
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		exampleDeadLetteredMessageID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodPost, "", expectedPathFormat, exampleDeadLetteredMessageID)
+
+		actual, err := helper.builder.BuildReplayDeadLetteredMessageRequest(helper.ctx, exampleDeadLetteredMessageID)
+		assert.NoError(t, err)
+
+		assertRequestQuality(t, actual, spec)
+	})
+
+	T.Run("with invalid dead-lettered message ID", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+
+		actual, err := helper.builder.BuildReplayDeadLetteredMessageRequest(helper.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid request builder", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper()
+		helper.builder = buildTestRequestBuilderWithInvalidURL()
+
+		actual, err := helper.builder.BuildReplayDeadLetteredMessageRequest(helper.ctx, fakes.BuildFakeID())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,199 @@
+package apiclient
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// GetValidIngredientSubstitution gets a valid ingredient substitution.
+func (c *Client) GetValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) (*types.ValidIngredientSubstitution, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if validIngredientSubstitutionID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	req, err := c.requestBuilder.BuildGetValidIngredientSubstitutionRequest(ctx, validIngredientSubstitutionID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get valid ingredient substitution request")
+	}
+
+	var apiResponse *types.APIResponse[*types.ValidIngredientSubstitution]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient substitution")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// GetValidIngredientSubstitutions retrieves a list of valid ingredient substitutions.
+func (c *Client) GetValidIngredientSubstitutions(ctx context.Context, filter *types.QueryFilter) (*types.QueryFilteredResult[types.ValidIngredientSubstitution], error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := c.requestBuilder.BuildGetValidIngredientSubstitutionsRequest(ctx, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building valid ingredient substitutions list request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.ValidIngredientSubstitution]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient substitution")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryFilteredResult[types.ValidIngredientSubstitution]{
+		Data:       apiResponse.Data,
+		Pagination: *apiResponse.Pagination,
+	}
+
+	return response, nil
+}
+
+// GetValidIngredientSubstitutionsForIngredient retrieves a list of valid ingredient substitutions.
+func (c *Client) GetValidIngredientSubstitutionsForIngredient(ctx context.Context, validIngredientID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.ValidIngredientSubstitution], error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.loggerWithFilter(filter)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	req, err := c.requestBuilder.BuildGetValidIngredientSubstitutionsForIngredientRequest(ctx, validIngredientID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building valid ingredient substitutions list request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.ValidIngredientSubstitution]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient substitution")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryFilteredResult[types.ValidIngredientSubstitution]{
+		Data:       apiResponse.Data,
+		Pagination: *apiResponse.Pagination,
+	}
+
+	return response, nil
+}
+
+// CreateValidIngredientSubstitution creates a valid ingredient substitution.
+func (c *Client) CreateValidIngredientSubstitution(ctx context.Context, input *types.ValidIngredientSubstitutionCreationRequestInput) (*types.ValidIngredientSubstitution, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildCreateValidIngredientSubstitutionRequest(ctx, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building create valid ingredient substitution request")
+	}
+
+	var apiResponse *types.APIResponse[*types.ValidIngredientSubstitution]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient substitution")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// UpdateValidIngredientSubstitution updates a valid ingredient substitution.
+func (c *Client) UpdateValidIngredientSubstitution(ctx context.Context, validIngredientSubstitution *types.ValidIngredientSubstitution) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if validIngredientSubstitution == nil {
+		return ErrNilInputProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitution.ID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitution.ID)
+
+	req, err := c.requestBuilder.BuildUpdateValidIngredientSubstitutionRequest(ctx, validIngredientSubstitution)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building update valid ingredient substitution request")
+	}
+
+	var apiResponse *types.APIResponse[*types.ValidIngredientSubstitution]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient substitution")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ArchiveValidIngredientSubstitution archives a valid ingredient substitution.
+func (c *Client) ArchiveValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if validIngredientSubstitutionID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+	tracing.AttachToSpan(span, keys.ValidIngredientSubstitutionIDKey, validIngredientSubstitutionID)
+
+	req, err := c.requestBuilder.BuildArchiveValidIngredientSubstitutionRequest(ctx, validIngredientSubstitutionID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building archive valid ingredient substitution request")
+	}
+
+	var apiResponse *types.APIResponse[*types.ValidIngredientSubstitution]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient substitution")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return err
+	}
+
+	return nil
+}
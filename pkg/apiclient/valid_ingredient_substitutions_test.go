@@ -0,0 +1,340 @@
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+	"github.com/dinnerdonebetter/backend/pkg/types/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestValidIngredientSubstitutions(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(validIngredientSubstitutionsTestSuite))
+}
+
+type validIngredientSubstitutionsBaseSuite struct {
+	suite.Suite
+	ctx                                            context.Context
+	exampleValidIngredientSubstitution             *types.ValidIngredientSubstitution
+	exampleValidIngredientSubstitutionResponse     *types.APIResponse[*types.ValidIngredientSubstitution]
+	exampleValidIngredientSubstitutionListResponse *types.APIResponse[[]*types.ValidIngredientSubstitution]
+	exampleValidIngredientSubstitutionList         []*types.ValidIngredientSubstitution
+}
+
+var _ suite.SetupTestSuite = (*validIngredientSubstitutionsBaseSuite)(nil)
+
+func (s *validIngredientSubstitutionsBaseSuite) SetupTest() {
+	s.ctx = context.Background()
+	s.exampleValidIngredientSubstitution = fakes.BuildFakeValidIngredientSubstitution()
+	s.exampleValidIngredientSubstitutionResponse = &types.APIResponse[*types.ValidIngredientSubstitution]{
+		Data: s.exampleValidIngredientSubstitution,
+	}
+
+	exampleList := fakes.BuildFakeValidIngredientSubstitutionList()
+	s.exampleValidIngredientSubstitutionList = exampleList.Data
+	s.exampleValidIngredientSubstitutionListResponse = &types.APIResponse[[]*types.ValidIngredientSubstitution]{
+		Data:       s.exampleValidIngredientSubstitutionList,
+		Pagination: &exampleList.Pagination,
+	}
+}
+
+type validIngredientSubstitutionsTestSuite struct {
+	suite.Suite
+	validIngredientSubstitutionsBaseSuite
+}
+
+func (s *validIngredientSubstitutionsTestSuite) TestClient_GetValidIngredientSubstitution() {
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleValidIngredientSubstitution.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleValidIngredientSubstitutionResponse)
+		actual, err := c.GetValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution.ID)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleValidIngredientSubstitution, actual)
+	})
+
+	s.Run("with invalid valid ingredient substitution ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetValidIngredientSubstitution(s.ctx, "")
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleValidIngredientSubstitution.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientSubstitutionsTestSuite) TestClient_GetValidIngredientSubstitutions() {
+	const expectedPath = "/api/v1/valid_ingredient_substitutions"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		filter := (*types.QueryFilter)(nil)
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleValidIngredientSubstitutionListResponse)
+		actual, err := c.GetValidIngredientSubstitutions(s.ctx, filter)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleValidIngredientSubstitutionList, actual.Data)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		filter := (*types.QueryFilter)(nil)
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetValidIngredientSubstitutions(s.ctx, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		filter := (*types.QueryFilter)(nil)
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetValidIngredientSubstitutions(s.ctx, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientSubstitutionsTestSuite) TestClient_GetValidIngredientSubstitutionsForIngredient() {
+	const expectedPath = "/api/v1/valid_ingredient_substitutions/by_ingredient/%s"
+
+	exampleValidIngredient := fakes.BuildFakeValidIngredient()
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		filter := (*types.QueryFilter)(nil)
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath, exampleValidIngredient.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleValidIngredientSubstitutionListResponse)
+		actual, err := c.GetValidIngredientSubstitutionsForIngredient(s.ctx, exampleValidIngredient.ID, filter)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleValidIngredientSubstitutionList, actual.Data)
+	})
+
+	s.Run("with invalid ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetValidIngredientSubstitutionsForIngredient(s.ctx, "", nil)
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		filter := (*types.QueryFilter)(nil)
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetValidIngredientSubstitutionsForIngredient(s.ctx, exampleValidIngredient.ID, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		filter := (*types.QueryFilter)(nil)
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath, exampleValidIngredient.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetValidIngredientSubstitutionsForIngredient(s.ctx, exampleValidIngredient.ID, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientSubstitutionsTestSuite) TestClient_CreateValidIngredientSubstitution() {
+	const expectedPath = "/api/v1/valid_ingredient_substitutions"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleInput := fakes.BuildFakeValidIngredientSubstitutionCreationRequestInput()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleValidIngredientSubstitutionResponse)
+
+		actual, err := c.CreateValidIngredientSubstitution(s.ctx, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleValidIngredientSubstitution, actual)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.CreateValidIngredientSubstitution(s.ctx, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		exampleInput := &types.ValidIngredientSubstitutionCreationRequestInput{}
+
+		actual, err := c.CreateValidIngredientSubstitution(s.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		exampleInput := converters.ConvertValidIngredientSubstitutionToValidIngredientSubstitutionCreationRequestInput(s.exampleValidIngredientSubstitution)
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.CreateValidIngredientSubstitution(s.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		exampleInput := converters.ConvertValidIngredientSubstitutionToValidIngredientSubstitutionCreationRequestInput(s.exampleValidIngredientSubstitution)
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.CreateValidIngredientSubstitution(s.ctx, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientSubstitutionsTestSuite) TestClient_UpdateValidIngredientSubstitution() {
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(false, http.MethodPut, "", expectedPathFormat, s.exampleValidIngredientSubstitution.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleValidIngredientSubstitutionResponse)
+
+		err := c.UpdateValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution)
+		assert.NoError(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.UpdateValidIngredientSubstitution(s.ctx, nil)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		err := c.UpdateValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		err := c.UpdateValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientSubstitutionsTestSuite) TestClient_ArchiveValidIngredientSubstitution() {
+	const expectedPathFormat = "/api/v1/valid_ingredient_substitutions/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, s.exampleValidIngredientSubstitution.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleValidIngredientSubstitutionResponse)
+
+		err := c.ArchiveValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution.ID)
+		assert.NoError(t, err)
+	})
+
+	s.Run("with invalid valid ingredient substitution ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.ArchiveValidIngredientSubstitution(s.ctx, "")
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		err := c.ArchiveValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution.ID)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		err := c.ArchiveValidIngredientSubstitution(s.ctx, s.exampleValidIngredientSubstitution.ID)
+		assert.Error(t, err)
+	})
+}
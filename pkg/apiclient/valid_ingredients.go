@@ -195,3 +195,88 @@ func (c *Client) ArchiveValidIngredient(ctx context.Context, validIngredientID s
 
 	return nil
 }
+
+// GetValidIngredientNutrition gets a valid ingredient's nutrition facts.
+func (c *Client) GetValidIngredientNutrition(ctx context.Context, validIngredientID string) (*types.ValidIngredientNutrition, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	req, err := c.requestBuilder.BuildGetValidIngredientNutritionRequest(ctx, validIngredientID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get valid ingredient nutrition request")
+	}
+
+	var apiResponse *types.APIResponse[*types.ValidIngredientNutrition]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving valid ingredient nutrition")
+	}
+
+	return apiResponse.Data, nil
+}
+
+// UpdateValidIngredientNutrition sets a valid ingredient's nutrition facts.
+func (c *Client) UpdateValidIngredientNutrition(ctx context.Context, validIngredientID string, input *types.ValidIngredientNutritionUpsertRequestInput) (*types.ValidIngredientNutrition, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if validIngredientID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildUpdateValidIngredientNutritionRequest(ctx, validIngredientID, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building update valid ingredient nutrition request")
+	}
+
+	var apiResponse *types.APIResponse[*types.ValidIngredientNutrition]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "updating valid ingredient nutrition")
+	}
+
+	return apiResponse.Data, nil
+}
+
+// ArchiveValidIngredientNutrition archives a valid ingredient's nutrition facts.
+func (c *Client) ArchiveValidIngredientNutrition(ctx context.Context, validIngredientID string) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if validIngredientID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, keys.ValidIngredientIDKey, validIngredientID)
+
+	req, err := c.requestBuilder.BuildArchiveValidIngredientNutritionRequest(ctx, validIngredientID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building archive valid ingredient nutrition request")
+	}
+
+	if err = c.fetchAndUnmarshal(ctx, req, nil); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient nutrition %s", validIngredientID)
+	}
+
+	return nil
+}
@@ -371,3 +371,169 @@ func (s *validIngredientsTestSuite) TestClient_ArchiveValidIngredient() {
 		assert.Error(t, err)
 	})
 }
+
+func (s *validIngredientsTestSuite) TestClient_GetValidIngredientNutrition() {
+	const expectedPathFormat = "/api/v1/valid_ingredients/%s/nutrition"
+
+	exampleNutrition := fakes.BuildFakeValidIngredientNutrition()
+	exampleNutrition.BelongsToValidIngredient = s.exampleValidIngredient.ID
+	exampleResponse := &types.APIResponse[*types.ValidIngredientNutrition]{
+		Data: exampleNutrition,
+	}
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleValidIngredient.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+		actual, err := c.GetValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID)
+
+		require.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleNutrition, actual)
+	})
+
+	s.Run("with invalid valid ingredient ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetValidIngredientNutrition(s.ctx, "")
+
+		require.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, s.exampleValidIngredient.ID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientsTestSuite) TestClient_UpdateValidIngredientNutrition() {
+	const expectedPathFormat = "/api/v1/valid_ingredients/%s/nutrition"
+
+	exampleNutrition := fakes.BuildFakeValidIngredientNutrition()
+	exampleNutrition.BelongsToValidIngredient = s.exampleValidIngredient.ID
+	exampleInput := fakes.BuildFakeValidIngredientNutritionUpsertRequestInput()
+	exampleResponse := &types.APIResponse[*types.ValidIngredientNutrition]{
+		Data: exampleNutrition,
+	}
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(false, http.MethodPut, "", expectedPathFormat, s.exampleValidIngredient.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+
+		actual, err := c.UpdateValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleNutrition, actual)
+	})
+
+	s.Run("with invalid valid ingredient ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.UpdateValidIngredientNutrition(s.ctx, "", exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.UpdateValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.UpdateValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID, &types.ValidIngredientNutritionUpsertRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.UpdateValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.UpdateValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID, exampleInput)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *validIngredientsTestSuite) TestClient_ArchiveValidIngredientNutrition() {
+	const expectedPathFormat = "/api/v1/valid_ingredients/%s/nutrition"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodDelete, "", expectedPathFormat, s.exampleValidIngredient.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, &types.APIResponse[*types.ValidIngredientNutrition]{})
+
+		err := c.ArchiveValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID)
+		assert.NoError(t, err)
+	})
+
+	s.Run("with invalid valid ingredient ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		err := c.ArchiveValidIngredientNutrition(s.ctx, "")
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		err := c.ArchiveValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		err := c.ArchiveValidIngredientNutrition(s.ctx, s.exampleValidIngredient.ID)
+		assert.Error(t, err)
+	})
+}
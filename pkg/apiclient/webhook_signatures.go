@@ -0,0 +1,110 @@
+package apiclient
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// WebhookSignatureHeader is the header containing a webhook payload's timestamped signatures.
+	WebhookSignatureHeader = "X-Dinner-Done-Better-Signature"
+	// WebhookDeliveryIDHeader is the header containing the unique ID of a webhook delivery, which is repeated
+	// on its retries and redeliveries so that they can be deduplicated.
+	WebhookDeliveryIDHeader = "X-Dinner-Done-Better-Delivery-ID"
+	// DefaultWebhookSignatureTolerance is how far from the present a webhook signature's timestamp may be by default.
+	DefaultWebhookSignatureTolerance = 5 * time.Minute
+
+	webhookSignatureTimestampKey = "t"
+	webhookSignatureV1Key        = "v1"
+)
+
+// VerifyWebhookSignature verifies a webhook payload against the value of its signature header, which
+// looks like `t=1700000000,v1=5257a8...`. The signed content is the timestamp, a period, and the raw
+// payload, HMAC-SHA256'd with the household's hex-encoded webhook encryption key. Payloads signed more
+// than tolerance away from now are rejected, which keeps captured requests from being replayed; a
+// non-positive tolerance skips that check. While a key rotation is in progress there may be more than
+// one v1 signature, and any match is accepted.
+func VerifyWebhookSignature(payload []byte, signatureHeader, hexKey string, tolerance time.Duration) error {
+	return verifyWebhookSignature(payload, signatureHeader, hexKey, tolerance, time.Now())
+}
+
+// VerifyWebhookRequest reads a webhook request's body and verifies it against its signature header, returning the body.
+func VerifyWebhookRequest(req *http.Request, hexKey string, tolerance time.Duration) ([]byte, error) {
+	if req == nil || req.Body == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	payload, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading webhook request body: %w", err)
+	}
+
+	if err = VerifyWebhookSignature(payload, req.Header.Get(WebhookSignatureHeader), hexKey, tolerance); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func verifyWebhookSignature(payload []byte, signatureHeader, hexKey string, tolerance time.Duration, now time.Time) error {
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return fmt.Errorf("decoding webhook encryption key: %w", err)
+	}
+
+	var (
+		timestamp  string
+		signatures [][]byte
+	)
+
+	for _, part := range strings.Split(signatureHeader, ",") {
+		k, v, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return ErrInvalidWebhookSignatureHeader
+		}
+
+		switch k {
+		case webhookSignatureTimestampKey:
+			timestamp = v
+		case webhookSignatureV1Key:
+			signature, decodeErr := hex.DecodeString(v)
+			if decodeErr != nil {
+				return ErrInvalidWebhookSignatureHeader
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+
+	if timestamp == "" || len(signatures) == 0 {
+		return ErrInvalidWebhookSignatureHeader
+	}
+
+	unixSeconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidWebhookSignatureHeader
+	}
+
+	if age := now.Sub(time.Unix(unixSeconds, 0)).Abs(); tolerance > 0 && age > tolerance {
+		return ErrWebhookSignatureTimestampOutOfTolerance
+	}
+
+	digest := hmac.New(sha256.New, key)
+	digest.Write([]byte(timestamp + "."))
+	digest.Write(payload)
+	expected := digest.Sum(nil)
+
+	for _, signature := range signatures {
+		if hmac.Equal(expected, signature) {
+			return nil
+		}
+	}
+
+	return ErrNoMatchingWebhookSignature
+}
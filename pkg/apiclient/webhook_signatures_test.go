@@ -0,0 +1,171 @@
+package apiclient
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildTestWebhookSignature(t *testing.T, hexKey string, timestamp time.Time, payload []byte) string {
+	t.Helper()
+
+	key, err := hex.DecodeString(hexKey)
+	require.NoError(t, err)
+
+	digest := hmac.New(sha256.New, key)
+	digest.Write([]byte(fmt.Sprintf("%d.", timestamp.Unix())))
+	digest.Write(payload)
+
+	return hex.EncodeToString(digest.Sum(nil))
+}
+
+func TestVerifyWebhookSignature(T *testing.T) {
+	T.Parallel()
+
+	exampleKey := hex.EncodeToString([]byte("example webhook encryption key"))
+	otherKey := hex.EncodeToString([]byte("some other webhook encryption key"))
+	examplePayload := []byte(`{"things":"stuff"}`)
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		header := fmt.Sprintf("t=%d,v1=%s", now.Unix(), buildTestWebhookSignature(t, exampleKey, now, examplePayload))
+
+		assert.NoError(t, VerifyWebhookSignature(examplePayload, header, exampleKey, DefaultWebhookSignatureTolerance))
+	})
+
+	T.Run("with multiple signatures", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		header := fmt.Sprintf(
+			"t=%d,v1=%s,v1=%s",
+			now.Unix(),
+			buildTestWebhookSignature(t, otherKey, now, examplePayload),
+			buildTestWebhookSignature(t, exampleKey, now, examplePayload),
+		)
+
+		assert.NoError(t, VerifyWebhookSignature(examplePayload, header, exampleKey, DefaultWebhookSignatureTolerance))
+	})
+
+	T.Run("with tampered payload", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		header := fmt.Sprintf("t=%d,v1=%s", now.Unix(), buildTestWebhookSignature(t, exampleKey, now, examplePayload))
+
+		err := VerifyWebhookSignature([]byte(`{"things":"other stuff"}`), header, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.ErrorIs(t, err, ErrNoMatchingWebhookSignature)
+	})
+
+	T.Run("with wrong key", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		header := fmt.Sprintf("t=%d,v1=%s", now.Unix(), buildTestWebhookSignature(t, otherKey, now, examplePayload))
+
+		err := VerifyWebhookSignature(examplePayload, header, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.ErrorIs(t, err, ErrNoMatchingWebhookSignature)
+	})
+
+	T.Run("with replayed timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		then := time.Now().Add(-time.Hour)
+		header := fmt.Sprintf("t=%d,v1=%s", then.Unix(), buildTestWebhookSignature(t, exampleKey, then, examplePayload))
+
+		err := VerifyWebhookSignature(examplePayload, header, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.ErrorIs(t, err, ErrWebhookSignatureTimestampOutOfTolerance)
+	})
+
+	T.Run("with altered timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		then := time.Now().Add(-time.Hour)
+		header := fmt.Sprintf("t=%d,v1=%s", time.Now().Unix(), buildTestWebhookSignature(t, exampleKey, then, examplePayload))
+
+		err := VerifyWebhookSignature(examplePayload, header, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.ErrorIs(t, err, ErrNoMatchingWebhookSignature)
+	})
+
+	T.Run("with tolerance disabled", func(t *testing.T) {
+		t.Parallel()
+
+		then := time.Now().Add(-time.Hour)
+		header := fmt.Sprintf("t=%d,v1=%s", then.Unix(), buildTestWebhookSignature(t, exampleKey, then, examplePayload))
+
+		assert.NoError(t, VerifyWebhookSignature(examplePayload, header, exampleKey, 0))
+	})
+
+	T.Run("with malformed headers", func(t *testing.T) {
+		t.Parallel()
+
+		for _, header := range []string{
+			"",
+			"t=1700000000",
+			"v1=abcdef",
+			"t=yesterday,v1=abcdef",
+			"t=1700000000,v1=not hex",
+			"t=1700000000;v1=abcdef",
+		} {
+			err := VerifyWebhookSignature(examplePayload, header, exampleKey, DefaultWebhookSignatureTolerance)
+			assert.ErrorIs(t, err, ErrInvalidWebhookSignatureHeader, header)
+		}
+	})
+
+	T.Run("with invalid key", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		header := fmt.Sprintf("t=%d,v1=%s", now.Unix(), buildTestWebhookSignature(t, exampleKey, now, examplePayload))
+
+		assert.Error(t, VerifyWebhookSignature(examplePayload, header, "not hex", DefaultWebhookSignatureTolerance))
+	})
+}
+
+func TestVerifyWebhookRequest(T *testing.T) {
+	T.Parallel()
+
+	exampleKey := hex.EncodeToString([]byte("example webhook encryption key"))
+	examplePayload := []byte(`{"things":"stuff"}`)
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(examplePayload))
+		req.Header.Set(WebhookSignatureHeader, fmt.Sprintf("t=%d,v1=%s", now.Unix(), buildTestWebhookSignature(t, exampleKey, now, examplePayload)))
+
+		actual, err := VerifyWebhookRequest(req, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.NoError(t, err)
+		assert.Equal(t, examplePayload, actual)
+	})
+
+	T.Run("without signature header", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(examplePayload))
+
+		actual, err := VerifyWebhookRequest(req, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with nil request", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := VerifyWebhookRequest(nil, exampleKey, DefaultWebhookSignatureTolerance)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
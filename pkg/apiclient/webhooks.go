@@ -200,3 +200,139 @@ func (c *Client) ArchiveWebhookTriggerEvent(ctx context.Context, webhookID, webh
 
 	return nil
 }
+
+// GetWebhookDeliveries gets a list of a webhook's deliveries.
+func (c *Client) GetWebhookDeliveries(ctx context.Context, webhookID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.WebhookDelivery], error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	req, err := c.requestBuilder.BuildGetWebhookDeliveriesRequest(ctx, webhookID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building webhook deliveries list request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.WebhookDelivery]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving webhook deliveries")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	deliveries := &types.QueryFilteredResult[types.WebhookDelivery]{
+		Data:       apiResponse.Data,
+		Pagination: *apiResponse.Pagination,
+	}
+
+	return deliveries, nil
+}
+
+// RedeliverWebhookDelivery resends a webhook delivery's payload, returning the new delivery.
+func (c *Client) RedeliverWebhookDelivery(ctx context.Context, webhookID, webhookDeliveryID string) (*types.WebhookDelivery, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	if webhookDeliveryID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookDeliveryIDKey, webhookDeliveryID)
+
+	req, err := c.requestBuilder.BuildRedeliverWebhookDeliveryRequest(ctx, webhookID, webhookDeliveryID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building redeliver webhook delivery request")
+	}
+
+	var apiResponse *types.APIResponse[*types.WebhookDelivery]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "redelivering webhook delivery")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// PingWebhook sends a synthetic event to a webhook, returning the recorded delivery.
+func (c *Client) PingWebhook(ctx context.Context, webhookID string) (*types.WebhookDelivery, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	req, err := c.requestBuilder.BuildPingWebhookRequest(ctx, webhookID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building ping webhook request")
+	}
+
+	var apiResponse *types.APIResponse[*types.WebhookDelivery]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "pinging webhook")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// PreviewWebhook renders the request a webhook would receive for a given trigger event, without sending it.
+func (c *Client) PreviewWebhook(ctx context.Context, webhookID string, input *types.WebhookPreviewRequestInput) (*types.WebhookPreview, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if webhookID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.WebhookIDKey, webhookID)
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating input")
+	}
+
+	req, err := c.requestBuilder.BuildPreviewWebhookRequest(ctx, webhookID, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building preview webhook request")
+	}
+
+	var apiResponse *types.APIResponse[*types.WebhookPreview]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "previewing webhook")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
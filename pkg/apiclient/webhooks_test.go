@@ -29,6 +29,9 @@ type webhooksTestSuite struct {
 	exampleWebhookTriggerEvent         *types.WebhookTriggerEvent
 	exampleWebhookTriggerEventResponse *types.APIResponse[*types.WebhookTriggerEvent]
 	exampleWebhookTriggerEventList     *types.QueryFilteredResult[types.WebhookTriggerEvent]
+	exampleWebhookDelivery             *types.WebhookDelivery
+	exampleWebhookDeliveryResponse     *types.APIResponse[*types.WebhookDelivery]
+	exampleWebhookDeliveryList         *types.QueryFilteredResult[types.WebhookDelivery]
 }
 
 var _ suite.SetupTestSuite = (*webhooksTestSuite)(nil)
@@ -45,6 +48,12 @@ func (s *webhooksTestSuite) SetupTest() {
 		Data: s.exampleWebhookTriggerEvent,
 	}
 	s.exampleWebhookTriggerEventList = fakes.BuildFakeWebhookTriggerEventList()
+	s.exampleWebhookDelivery = fakes.BuildFakeWebhookDelivery()
+	s.exampleWebhookDelivery.BelongsToWebhook = s.exampleWebhook.ID
+	s.exampleWebhookDeliveryResponse = &types.APIResponse[*types.WebhookDelivery]{
+		Data: s.exampleWebhookDelivery,
+	}
+	s.exampleWebhookDeliveryList = fakes.BuildFakeWebhookDeliveryList()
 }
 
 func (s *webhooksTestSuite) TestClient_GetWebhook() {
@@ -340,3 +349,223 @@ func (s *webhooksTestSuite) TestClient_ArchiveWebhookTriggerEvent() {
 		assert.Error(t, err)
 	})
 }
+
+func (s *webhooksTestSuite) TestClient_GetWebhookDeliveries() {
+	const expectedPathFormat = "/api/v1/webhooks/%s/deliveries"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleWebhookDeliveryListAPIResponse := &types.APIResponse[[]*types.WebhookDelivery]{
+			Data:       s.exampleWebhookDeliveryList.Data,
+			Pagination: &s.exampleWebhookDeliveryList.Pagination,
+		}
+
+		spec := newRequestSpec(false, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPathFormat, s.exampleWebhook.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleWebhookDeliveryListAPIResponse)
+
+		actual, err := c.GetWebhookDeliveries(s.ctx, s.exampleWebhook.ID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleWebhookDeliveryList, actual)
+	})
+
+	s.Run("with invalid webhook ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.GetWebhookDeliveries(s.ctx, "", nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.GetWebhookDeliveries(s.ctx, s.exampleWebhook.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.GetWebhookDeliveries(s.ctx, s.exampleWebhook.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *webhooksTestSuite) TestClient_RedeliverWebhookDelivery() {
+	const expectedPathFormat = "/api/v1/webhooks/%s/deliveries/%s/redeliver"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, s.exampleWebhook.ID, s.exampleWebhookDelivery.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleWebhookDeliveryResponse)
+
+		actual, err := c.RedeliverWebhookDelivery(s.ctx, s.exampleWebhook.ID, s.exampleWebhookDelivery.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleWebhookDelivery, actual)
+	})
+
+	s.Run("with invalid webhook ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.RedeliverWebhookDelivery(s.ctx, "", s.exampleWebhookDelivery.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid webhook delivery ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.RedeliverWebhookDelivery(s.ctx, s.exampleWebhook.ID, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.RedeliverWebhookDelivery(s.ctx, s.exampleWebhook.ID, s.exampleWebhookDelivery.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.RedeliverWebhookDelivery(s.ctx, s.exampleWebhook.ID, s.exampleWebhookDelivery.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *webhooksTestSuite) TestClient_PingWebhook() {
+	const expectedPathFormat = "/api/v1/webhooks/%s/ping"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, s.exampleWebhook.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, s.exampleWebhookDeliveryResponse)
+
+		actual, err := c.PingWebhook(s.ctx, s.exampleWebhook.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, s.exampleWebhookDelivery, actual)
+	})
+
+	s.Run("with invalid webhook ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.PingWebhook(s.ctx, "")
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.PingWebhook(s.ctx, s.exampleWebhook.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.PingWebhook(s.ctx, s.exampleWebhook.ID)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *webhooksTestSuite) TestClient_PreviewWebhook() {
+	const expectedPathFormat = "/api/v1/webhooks/%s/preview"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		examplePreview := fakes.BuildFakeWebhookPreview()
+		exampleResponse := &types.APIResponse[*types.WebhookPreview]{
+			Data: examplePreview,
+		}
+
+		spec := newRequestSpec(false, http.MethodPost, "", expectedPathFormat, s.exampleWebhook.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+
+		actual, err := c.PreviewWebhook(s.ctx, s.exampleWebhook.ID, fakes.BuildFakeWebhookPreviewRequestInput())
+		assert.NoError(t, err)
+		assert.Equal(t, examplePreview, actual)
+	})
+
+	s.Run("with invalid webhook ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.PreviewWebhook(s.ctx, "", fakes.BuildFakeWebhookPreviewRequestInput())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with nil input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.PreviewWebhook(s.ctx, s.exampleWebhook.ID, nil)
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with invalid input", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		actual, err := c.PreviewWebhook(s.ctx, s.exampleWebhook.ID, &types.WebhookPreviewRequestInput{})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		actual, err := c.PreviewWebhook(s.ctx, s.exampleWebhook.ID, fakes.BuildFakeWebhookPreviewRequestInput())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		actual, err := c.PreviewWebhook(s.ctx, s.exampleWebhook.ID, fakes.BuildFakeWebhookPreviewRequestInput())
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -4,6 +4,8 @@
 	"context"
 
 	"github.com/dinnerdonebetter/backend/internal/observability"
+	"github.com/dinnerdonebetter/backend/internal/observability/keys"
+	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 )
 
@@ -82,3 +84,94 @@ func (c *Client) RunMealPlanTaskCreationWorker(ctx context.Context) error {
 
 	return nil
 }
+
+// GetDeadLetteredMessages retrieves a list of dead-lettered messages that haven't been replayed.
+func (c *Client) GetDeadLetteredMessages(ctx context.Context, filter *types.QueryFilter) (*types.QueryFilteredResult[types.DeadLetteredMessage], error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	req, err := c.requestBuilder.BuildGetDeadLetteredMessagesRequest(ctx, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building dead-lettered messages list request")
+	}
+
+	var apiResponse *types.APIResponse[[]*types.DeadLetteredMessage]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving dead-lettered messages")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	response := &types.QueryFilteredResult[types.DeadLetteredMessage]{
+		Data:       apiResponse.Data,
+		Pagination: *apiResponse.Pagination,
+	}
+
+	return response, nil
+}
+
+// GetDeadLetteredMessage retrieves a dead-lettered message.
+func (c *Client) GetDeadLetteredMessage(ctx context.Context, deadLetteredMessageID string) (*types.DeadLetteredMessage, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if deadLetteredMessageID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	req, err := c.requestBuilder.BuildGetDeadLetteredMessageRequest(ctx, deadLetteredMessageID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building get dead-lettered message request")
+	}
+
+	var apiResponse *types.APIResponse[*types.DeadLetteredMessage]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving dead-lettered message")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return nil, err
+	}
+
+	return apiResponse.Data, nil
+}
+
+// ReplayDeadLetteredMessage republishes a dead-lettered message to the topic it came from.
+func (c *Client) ReplayDeadLetteredMessage(ctx context.Context, deadLetteredMessageID string) error {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := c.logger.Clone()
+
+	if deadLetteredMessageID == "" {
+		return ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+	tracing.AttachToSpan(span, keys.DeadLetteredMessageIDKey, deadLetteredMessageID)
+
+	req, err := c.requestBuilder.BuildReplayDeadLetteredMessageRequest(ctx, deadLetteredMessageID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building replay dead-lettered message request")
+	}
+
+	var apiResponse *types.APIResponse[any]
+	if err = c.fetchAndUnmarshal(ctx, req, &apiResponse); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "replaying dead-lettered message")
+	}
+
+	if err = apiResponse.Error.AsError(); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -158,3 +158,140 @@ func (s *workersTestSuite) TestClient_RunMealPlanTaskCreationWorker() {
 		assert.Error(t, c.RunMealPlanGroceryListInitializationWorker(s.ctx))
 	})
 }
+
+func (s *workersTestSuite) TestClient_GetDeadLetteredMessages() {
+	const expectedPath = "/api/v1/admin/workers/dead_letters"
+
+	filter := (*types.QueryFilter)(nil)
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleDeadLetteredMessageList := fakes.BuildFakeDeadLetteredMessageList()
+		exampleResponse := &types.APIResponse[[]*types.DeadLetteredMessage]{
+			Data:       exampleDeadLetteredMessageList.Data,
+			Pagination: &exampleDeadLetteredMessageList.Pagination,
+		}
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+		actual, err := c.GetDeadLetteredMessages(s.ctx, filter)
+
+		assert.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleDeadLetteredMessageList.Data, actual.Data)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetDeadLetteredMessages(s.ctx, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		spec := newRequestSpec(true, http.MethodGet, "limit=50&page=1&sortBy=asc", expectedPath)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetDeadLetteredMessages(s.ctx, filter)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *workersTestSuite) TestClient_GetDeadLetteredMessage() {
+	const expectedPathFormat = "/api/v1/admin/workers/dead_letters/%s"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleDeadLetteredMessage := fakes.BuildFakeDeadLetteredMessage()
+		exampleResponse := &types.APIResponse[*types.DeadLetteredMessage]{
+			Data: exampleDeadLetteredMessage,
+		}
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleDeadLetteredMessage.ID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, exampleResponse)
+		actual, err := c.GetDeadLetteredMessage(s.ctx, exampleDeadLetteredMessage.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, exampleDeadLetteredMessage, actual)
+	})
+
+	s.Run("with invalid dead-lettered message ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+		actual, err := c.GetDeadLetteredMessage(s.ctx, "")
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+		actual, err := c.GetDeadLetteredMessage(s.ctx, fakes.BuildFakeID())
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		exampleDeadLetteredMessageID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodGet, "", expectedPathFormat, exampleDeadLetteredMessageID)
+		c := buildTestClientWithInvalidResponse(t, spec)
+		actual, err := c.GetDeadLetteredMessage(s.ctx, exampleDeadLetteredMessageID)
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func (s *workersTestSuite) TestClient_ReplayDeadLetteredMessage() {
+	const expectedPathFormat = "/api/v1/admin/workers/dead_letters/%s/replay"
+
+	s.Run("standard", func() {
+		t := s.T()
+
+		exampleDeadLetteredMessageID := fakes.BuildFakeID()
+
+		spec := newRequestSpec(true, http.MethodPost, "", expectedPathFormat, exampleDeadLetteredMessageID)
+		c, _ := buildTestClientWithJSONResponse(t, spec, &types.APIResponse[any]{})
+
+		assert.NoError(t, c.ReplayDeadLetteredMessage(s.ctx, exampleDeadLetteredMessageID))
+	})
+
+	s.Run("with invalid dead-lettered message ID", func() {
+		t := s.T()
+
+		c, _ := buildSimpleTestClient(t)
+
+		assert.Error(t, c.ReplayDeadLetteredMessage(s.ctx, ""))
+	})
+
+	s.Run("with error building request", func() {
+		t := s.T()
+
+		c := buildTestClientWithInvalidURL(t)
+
+		assert.Error(t, c.ReplayDeadLetteredMessage(s.ctx, fakes.BuildFakeID()))
+	})
+
+	s.Run("with error executing request", func() {
+		t := s.T()
+
+		c, _ := buildTestClientThatWaitsTooLong(t)
+
+		assert.Error(t, c.ReplayDeadLetteredMessage(s.ctx, fakes.BuildFakeID()))
+	})
+}
@@ -55,6 +55,7 @@
 		GetAuditLogEntriesForUserAndResourceType(ctx context.Context, userID string, resourceTypes []string, filter *QueryFilter) (*QueryFilteredResult[AuditLogEntry], error)
 		GetAuditLogEntriesForHousehold(ctx context.Context, householdID string, filter *QueryFilter) (*QueryFilteredResult[AuditLogEntry], error)
 		GetAuditLogEntriesForHouseholdAndResourceType(ctx context.Context, householdID string, resourceTypes []string, filter *QueryFilter) (*QueryFilteredResult[AuditLogEntry], error)
+		CreateAuditLogEntry(ctx context.Context, input *AuditLogEntryDatabaseCreationInput) (*AuditLogEntry, error)
 	}
 
 	// AuditLogEntryDataService describes a structure capable of serving traffic related to audit log entries.
@@ -76,8 +76,10 @@ func init() {
 		_ struct{} `json:"-"`
 
 		HouseholdPermissions map[string]authorization.HouseholdRolePermissionsChecker `json:"-"`
-		Requester            RequesterInfo                                            `json:"-"`
-		ActiveHouseholdID    string                                                   `json:"-"`
+		// TokenScopes is only set for requests made with OAuth2 tokens, and further restricts what the requester may do.
+		TokenScopes       authorization.OAuth2ScopePermissionChecker `json:"-"`
+		Requester         RequesterInfo                              `json:"-"`
+		ActiveHouseholdID string                                     `json:"-"`
 	}
 
 	// RequesterInfo contains data relevant to the user making a request.
@@ -130,16 +132,25 @@ func init() {
 		BuildLoginHandler(bool) func(http.ResponseWriter, *http.Request)
 		EndSessionHandler(http.ResponseWriter, *http.Request)
 		CycleCookieSecretHandler(http.ResponseWriter, *http.Request)
+		ClearLoginLockoutsHandler(http.ResponseWriter, *http.Request)
 		ChangeActiveHouseholdHandler(http.ResponseWriter, *http.Request)
 
 		SSOLoginHandler(http.ResponseWriter, *http.Request)
 		SSOLoginCallbackHandler(http.ResponseWriter, *http.Request)
 
+		BeginPasskeyRegistrationHandler(http.ResponseWriter, *http.Request)
+		FinishPasskeyRegistrationHandler(http.ResponseWriter, *http.Request)
+		BeginPasskeyLoginHandler(http.ResponseWriter, *http.Request)
+		ListPasskeysHandler(http.ResponseWriter, *http.Request)
+		ArchivePasskeyHandler(http.ResponseWriter, *http.Request)
+
 		PermissionFilterMiddleware(permissions ...authorization.Permission) func(next http.Handler) http.Handler
+		HouseholdPermissionFilterMiddleware(householdIDURIParamKey string, permissions ...authorization.Permission) func(next http.Handler) http.Handler
 		CookieRequirementMiddleware(next http.Handler) http.Handler
 		UserAttributionMiddleware(next http.Handler) http.Handler
 		AuthorizationMiddleware(next http.Handler) http.Handler
 		ServiceAdminMiddleware(next http.Handler) http.Handler
+		UnscopedRouteMiddleware(next http.Handler) http.Handler
 
 		OAuth2Service
 	}
@@ -164,6 +175,11 @@ func (x *SessionContextData) ServiceRolePermissionChecker() authorization.Servic
 	return x.Requester.ServicePermissions
 }
 
+// TokenScopesAllow returns whether the requester's OAuth2 token, if any, permits a given permission.
+func (x *SessionContextData) TokenScopesAllow(p authorization.Permission) bool {
+	return x.TokenScopes == nil || x.TokenScopes.HasPermission(p)
+}
+
 // AttachToLogger provides a consistent way to attach a SessionContextData object to a logger.
 func (x *SessionContextData) AttachToLogger(logger logging.Logger) logging.Logger {
 	if x != nil {
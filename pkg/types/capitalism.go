@@ -1,10 +1,60 @@
 package types
 
-import "net/http"
+import (
+	"context"
+	"encoding/gob"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func init() {
+	gob.Register(new(CheckoutSessionCreationRequestInput))
+}
 
 type (
+	// CheckoutSessionCreationRequestInput represents what a user could set as input for subscribing a household to a plan.
+	CheckoutSessionCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		SubscriptionPlanID string `json:"subscriptionPlanID"`
+	}
+
+	// PaymentSession is a hosted payment processor page that a user should be redirected to.
+	PaymentSession struct {
+		_ struct{} `json:"-"`
+
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+
 	// CapitalismService describes a structure capable of serving worker-oriented requests.
 	CapitalismService interface {
 		IncomingWebhookHandler(res http.ResponseWriter, req *http.Request)
+		CreateCheckoutSessionHandler(res http.ResponseWriter, req *http.Request)
+		CreateCustomerPortalSessionHandler(res http.ResponseWriter, req *http.Request)
+		ActiveSubscriptionRequirementMiddleware(next http.Handler) http.Handler
+		HouseholdSubscriptionRequirementMiddleware(householdIDURIParamKey string) func(next http.Handler) http.Handler
 	}
 )
+
+var _ validation.ValidatableWithContext = (*CheckoutSessionCreationRequestInput)(nil)
+
+// ValidateWithContext validates a CheckoutSessionCreationRequestInput.
+func (x *CheckoutSessionCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.SubscriptionPlanID, validation.Required),
+	)
+}
+
+// ValidateForSubscriptionPlans validates a CheckoutSessionCreationRequestInput, and that it requests one of the provided plans.
+func (x *CheckoutSessionCreationRequestInput) ValidateForSubscriptionPlans(ctx context.Context, subscriptionPlanIDs []string) error {
+	allowedPlanIDs := make([]any, len(subscriptionPlanIDs))
+	for i, id := range subscriptionPlanIDs {
+		allowedPlanIDs[i] = id
+	}
+
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.SubscriptionPlanID, validation.Required, validation.In(allowedPlanIDs...)),
+	)
+}
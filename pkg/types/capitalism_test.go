@@ -0,0 +1,69 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckoutSessionCreationRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CheckoutSessionCreationRequestInput{
+			SubscriptionPlanID: t.Name(),
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CheckoutSessionCreationRequestInput{}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+}
+
+func TestCheckoutSessionCreationRequestInput_ValidateForSubscriptionPlans(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CheckoutSessionCreationRequestInput{
+			SubscriptionPlanID: t.Name(),
+		}
+
+		actual := x.ValidateForSubscriptionPlans(context.Background(), []string{"other", t.Name()})
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with unknown plan", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CheckoutSessionCreationRequestInput{
+			SubscriptionPlanID: t.Name(),
+		}
+
+		actual := x.ValidateForSubscriptionPlans(context.Background(), []string{"other"})
+		assert.Error(t, actual)
+	})
+
+	T.Run("without any plans", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CheckoutSessionCreationRequestInput{
+			SubscriptionPlanID: t.Name(),
+		}
+
+		actual := x.ValidateForSubscriptionPlans(context.Background(), nil)
+		assert.Error(t, actual)
+	})
+}
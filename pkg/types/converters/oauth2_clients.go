@@ -7,30 +7,39 @@
 
 func ConvertOAuth2ClientCreationRequestInputToOAuth2ClientDatabaseCreationInput(x *types.OAuth2ClientCreationRequestInput) *types.OAuth2ClientDatabaseCreationInput {
 	return &types.OAuth2ClientDatabaseCreationInput{
-		ID:           identifiers.New(),
-		Name:         x.Name,
-		Description:  x.Description,
-		ClientID:     "",
-		ClientSecret: "",
+		ID:                 identifiers.New(),
+		Name:               x.Name,
+		Description:        x.Description,
+		ClientID:           "",
+		ClientSecret:       "",
+		Domain:             x.Domain,
+		BelongsToHousehold: x.BelongsToHousehold,
+		Scopes:             x.Scopes,
 	}
 }
 
 // ConvertOAuth2ClientToOAuth2ClientDatabaseCreationInput builds a faked OAuth2ClientDatabaseCreationInput.
 func ConvertOAuth2ClientToOAuth2ClientDatabaseCreationInput(client *types.OAuth2Client) *types.OAuth2ClientDatabaseCreationInput {
 	return &types.OAuth2ClientDatabaseCreationInput{
-		ID:           client.ID,
-		Name:         client.Name,
-		Description:  client.Description,
-		ClientID:     client.ClientID,
-		ClientSecret: client.ClientSecret,
+		ID:                 client.ID,
+		Name:               client.Name,
+		Description:        client.Description,
+		ClientID:           client.ClientID,
+		ClientSecret:       client.ClientSecret,
+		Domain:             client.Domain,
+		BelongsToHousehold: client.BelongsToHousehold,
+		Scopes:             client.Scopes,
 	}
 }
 
 // ConvertOAuth2ClientToOAuth2ClientCreationInput builds a faked OAuth2ClientCreationRequestInput.
 func ConvertOAuth2ClientToOAuth2ClientCreationInput(client *types.OAuth2Client) *types.OAuth2ClientCreationRequestInput {
 	return &types.OAuth2ClientCreationRequestInput{
-		Name:        client.Name,
-		Description: client.Description,
+		Name:               client.Name,
+		Description:        client.Description,
+		Domain:             client.Domain,
+		BelongsToHousehold: client.BelongsToHousehold,
+		Scopes:             client.Scopes,
 	}
 }
 
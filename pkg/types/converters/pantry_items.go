@@ -0,0 +1,61 @@
+package converters
+
+import (
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// ConvertPantryItemToPantryItemUpdateRequestInput creates a PantryItemUpdateRequestInput from a PantryItem.
+func ConvertPantryItemToPantryItemUpdateRequestInput(x *types.PantryItem) *types.PantryItemUpdateRequestInput {
+	out := &types.PantryItemUpdateRequestInput{
+		ExpiresAt:              x.ExpiresAt,
+		ValidIngredientID:      &x.Ingredient.ID,
+		ValidMeasurementUnitID: &x.MeasurementUnit.ID,
+		StorageLocation:        &x.StorageLocation,
+		Notes:                  &x.Notes,
+		Quantity:               &x.Quantity,
+	}
+
+	return out
+}
+
+// ConvertPantryItemCreationRequestInputToPantryItemDatabaseCreationInput creates a DatabaseCreationInput from a CreationInput.
+func ConvertPantryItemCreationRequestInputToPantryItemDatabaseCreationInput(x *types.PantryItemCreationRequestInput) *types.PantryItemDatabaseCreationInput {
+	out := &types.PantryItemDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		ExpiresAt:              x.ExpiresAt,
+		ValidIngredientID:      x.ValidIngredientID,
+		ValidMeasurementUnitID: x.ValidMeasurementUnitID,
+		StorageLocation:        x.StorageLocation,
+		Notes:                  x.Notes,
+		Quantity:               x.Quantity,
+	}
+
+	return out
+}
+
+// ConvertPantryItemToPantryItemCreationRequestInput builds a PantryItemCreationRequestInput from a PantryItem.
+func ConvertPantryItemToPantryItemCreationRequestInput(x *types.PantryItem) *types.PantryItemCreationRequestInput {
+	return &types.PantryItemCreationRequestInput{
+		ExpiresAt:              x.ExpiresAt,
+		ValidIngredientID:      x.Ingredient.ID,
+		ValidMeasurementUnitID: x.MeasurementUnit.ID,
+		StorageLocation:        x.StorageLocation,
+		Notes:                  x.Notes,
+		Quantity:               x.Quantity,
+	}
+}
+
+// ConvertPantryItemToPantryItemDatabaseCreationInput builds a PantryItemDatabaseCreationInput from a PantryItem.
+func ConvertPantryItemToPantryItemDatabaseCreationInput(x *types.PantryItem) *types.PantryItemDatabaseCreationInput {
+	return &types.PantryItemDatabaseCreationInput{
+		ID:                     x.ID,
+		ExpiresAt:              x.ExpiresAt,
+		ValidIngredientID:      x.Ingredient.ID,
+		ValidMeasurementUnitID: x.MeasurementUnit.ID,
+		StorageLocation:        x.StorageLocation,
+		Notes:                  x.Notes,
+		BelongsToHousehold:     x.BelongsToHousehold,
+		Quantity:               x.Quantity,
+	}
+}
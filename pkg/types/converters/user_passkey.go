@@ -0,0 +1,22 @@
+package converters
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// ConvertUserPasskeyToUserPasskeyDatabaseCreationInput builds a UserPasskeyDatabaseCreationInput from a UserPasskey.
+func ConvertUserPasskeyToUserPasskeyDatabaseCreationInput(x *types.UserPasskey) *types.UserPasskeyDatabaseCreationInput {
+	return &types.UserPasskeyDatabaseCreationInput{
+		ID:              x.ID,
+		Name:            x.Name,
+		CredentialID:    x.CredentialID,
+		AttestationType: x.AttestationType,
+		BelongsToUser:   x.BelongsToUser,
+		PublicKey:       x.PublicKey,
+		AAGUID:          x.AAGUID,
+		Transports:      x.Transports,
+		SignCount:       x.SignCount,
+		BackupEligible:  x.BackupEligible,
+		BackupState:     x.BackupState,
+	}
+}
@@ -0,0 +1,45 @@
+package converters
+
+import (
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// ConvertValidIngredientNutritionUpsertRequestInputToValidIngredientNutritionDatabaseCreationInput creates a DatabaseCreationInput from an UpsertRequestInput.
+func ConvertValidIngredientNutritionUpsertRequestInputToValidIngredientNutritionDatabaseCreationInput(validIngredientID string, x *types.ValidIngredientNutritionUpsertRequestInput) *types.ValidIngredientNutritionDatabaseCreationInput {
+	out := &types.ValidIngredientNutritionDatabaseCreationInput{
+		ID:                         identifiers.New(),
+		ValidIngredientID:          validIngredientID,
+		ReferenceMeasurementUnitID: x.ReferenceMeasurementUnitID,
+		Source:                     x.Source,
+		SourceID:                   x.SourceID,
+		Nutrients:                  x.Nutrients,
+		ReferenceQuantity:          x.ReferenceQuantity,
+	}
+
+	return out
+}
+
+// ConvertValidIngredientNutritionToValidIngredientNutritionUpsertRequestInput builds a ValidIngredientNutritionUpsertRequestInput from a ValidIngredientNutrition.
+func ConvertValidIngredientNutritionToValidIngredientNutritionUpsertRequestInput(x *types.ValidIngredientNutrition) *types.ValidIngredientNutritionUpsertRequestInput {
+	return &types.ValidIngredientNutritionUpsertRequestInput{
+		ReferenceMeasurementUnitID: x.ReferenceMeasurementUnit.ID,
+		Source:                     x.Source,
+		SourceID:                   x.SourceID,
+		Nutrients:                  x.Nutrients,
+		ReferenceQuantity:          x.ReferenceQuantity,
+	}
+}
+
+// ConvertValidIngredientNutritionToValidIngredientNutritionDatabaseCreationInput builds a ValidIngredientNutritionDatabaseCreationInput from a ValidIngredientNutrition.
+func ConvertValidIngredientNutritionToValidIngredientNutritionDatabaseCreationInput(x *types.ValidIngredientNutrition) *types.ValidIngredientNutritionDatabaseCreationInput {
+	return &types.ValidIngredientNutritionDatabaseCreationInput{
+		ID:                         x.ID,
+		ValidIngredientID:          x.BelongsToValidIngredient,
+		ReferenceMeasurementUnitID: x.ReferenceMeasurementUnit.ID,
+		Source:                     x.Source,
+		SourceID:                   x.SourceID,
+		Nutrients:                  x.Nutrients,
+		ReferenceQuantity:          x.ReferenceQuantity,
+	}
+}
@@ -0,0 +1,73 @@
+package converters
+
+import (
+	"github.com/dinnerdonebetter/backend/internal/identifiers"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// ConvertValidIngredientSubstitutionCreationRequestInputToValidIngredientSubstitutionDatabaseCreationInput creates a ValidIngredientSubstitutionDatabaseCreationInput from a ValidIngredientSubstitutionCreationRequestInput.
+func ConvertValidIngredientSubstitutionCreationRequestInputToValidIngredientSubstitutionDatabaseCreationInput(input *types.ValidIngredientSubstitutionCreationRequestInput) *types.ValidIngredientSubstitutionDatabaseCreationInput {
+	x := &types.ValidIngredientSubstitutionDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		ValidPreparationID:     input.ValidPreparationID,
+		OriginalIngredientID:   input.OriginalIngredientID,
+		SubstituteIngredientID: input.SubstituteIngredientID,
+		Notes:                  input.Notes,
+		Reasons:                input.Reasons,
+		Ratio:                  input.Ratio,
+	}
+
+	return x
+}
+
+// ConvertValidIngredientSubstitutionToValidIngredientSubstitutionUpdateRequestInput builds a ValidIngredientSubstitutionUpdateRequestInput from a ValidIngredientSubstitution.
+func ConvertValidIngredientSubstitutionToValidIngredientSubstitutionUpdateRequestInput(validIngredientSubstitution *types.ValidIngredientSubstitution) *types.ValidIngredientSubstitutionUpdateRequestInput {
+	x := &types.ValidIngredientSubstitutionUpdateRequestInput{
+		OriginalIngredientID:   &validIngredientSubstitution.Original.ID,
+		SubstituteIngredientID: &validIngredientSubstitution.Substitute.ID,
+		Notes:                  &validIngredientSubstitution.Notes,
+		Ratio:                  &validIngredientSubstitution.Ratio,
+		Reasons:                validIngredientSubstitution.Reasons,
+	}
+
+	if validIngredientSubstitution.Preparation != nil {
+		x.ValidPreparationID = &validIngredientSubstitution.Preparation.ID
+	}
+
+	return x
+}
+
+// ConvertValidIngredientSubstitutionToValidIngredientSubstitutionCreationRequestInput builds a ValidIngredientSubstitutionCreationRequestInput from a ValidIngredientSubstitution.
+func ConvertValidIngredientSubstitutionToValidIngredientSubstitutionCreationRequestInput(validIngredientSubstitution *types.ValidIngredientSubstitution) *types.ValidIngredientSubstitutionCreationRequestInput {
+	x := &types.ValidIngredientSubstitutionCreationRequestInput{
+		OriginalIngredientID:   validIngredientSubstitution.Original.ID,
+		SubstituteIngredientID: validIngredientSubstitution.Substitute.ID,
+		Notes:                  validIngredientSubstitution.Notes,
+		Reasons:                validIngredientSubstitution.Reasons,
+		Ratio:                  validIngredientSubstitution.Ratio,
+	}
+
+	if validIngredientSubstitution.Preparation != nil {
+		x.ValidPreparationID = &validIngredientSubstitution.Preparation.ID
+	}
+
+	return x
+}
+
+// ConvertValidIngredientSubstitutionToValidIngredientSubstitutionDatabaseCreationInput builds a ValidIngredientSubstitutionDatabaseCreationInput from a ValidIngredientSubstitution.
+func ConvertValidIngredientSubstitutionToValidIngredientSubstitutionDatabaseCreationInput(validIngredientSubstitution *types.ValidIngredientSubstitution) *types.ValidIngredientSubstitutionDatabaseCreationInput {
+	x := &types.ValidIngredientSubstitutionDatabaseCreationInput{
+		ID:                     validIngredientSubstitution.ID,
+		OriginalIngredientID:   validIngredientSubstitution.Original.ID,
+		SubstituteIngredientID: validIngredientSubstitution.Substitute.ID,
+		Notes:                  validIngredientSubstitution.Notes,
+		Reasons:                validIngredientSubstitution.Reasons,
+		Ratio:                  validIngredientSubstitution.Ratio,
+	}
+
+	if validIngredientSubstitution.Preparation != nil {
+		x.ValidPreparationID = &validIngredientSubstitution.Preparation.ID
+	}
+
+	return x
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+)
+
+const (
+	// CookTimelineFormatQueryKey is the query param key that selects how a cook timeline is rendered.
+	CookTimelineFormatQueryKey = "format"
+	// CookTimelineFormatJSON renders a cook timeline as a JSON API response.
+	CookTimelineFormatJSON = "json"
+	// CookTimelineFormatICalendar renders a cook timeline as an iCalendar document.
+	CookTimelineFormatICalendar = "ical"
+)
+
+type (
+	// CookTimelineEntry is a single scheduled recipe step in a cook timeline.
+	CookTimelineEntry struct {
+		_ struct{} `json:"-"`
+
+		StartsAt                time.Time `json:"startsAt"`
+		EndsAt                  time.Time `json:"endsAt"`
+		RecipeID                string    `json:"recipeID"`
+		RecipeName              string    `json:"recipeName"`
+		RecipeStepID            string    `json:"recipeStepID"`
+		PreparationName         string    `json:"preparationName"`
+		Instructions            string    `json:"instructions"`
+		Equipment               []string  `json:"equipment"`
+		DurationInSeconds       uint32    `json:"durationInSeconds"`
+		RecipeStepIndex         uint32    `json:"recipeStepIndex"`
+		OnCriticalPath          bool      `json:"onCriticalPath"`
+		StartTimerAutomatically bool      `json:"startTimerAutomatically"`
+	}
+
+	// CookTimeline is a merged schedule of every recipe step in a meal plan event's chosen meal.
+	CookTimeline struct {
+		_ struct{} `json:"-"`
+
+		StartsAt         time.Time            `json:"startsAt"`
+		FinishesAt       time.Time            `json:"finishesAt"`
+		MealPlanID       string               `json:"mealPlanID"`
+		MealPlanEventID  string               `json:"mealPlanEventID"`
+		MealPlanOptionID string               `json:"mealPlanOptionID"`
+		MealID           string               `json:"mealID"`
+		MealName         string               `json:"mealName"`
+		Entries          []*CookTimelineEntry `json:"entries"`
+	}
+)
@@ -0,0 +1,216 @@
+package types
+
+import (
+	"context"
+	"encoding/gob"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// CookingSessionCreatedCustomerEventType indicates a cooking session was created.
+	CookingSessionCreatedCustomerEventType ServiceEventType = "cooking_session_created"
+	// CookingSessionProgressRecordedCustomerEventType indicates progress was recorded for a cooking session.
+	CookingSessionProgressRecordedCustomerEventType ServiceEventType = "cooking_session_progress_recorded"
+	// CookingSessionArchivedCustomerEventType indicates a cooking session was archived.
+	CookingSessionArchivedCustomerEventType ServiceEventType = "cooking_session_archived"
+
+	// CookingSessionProgressTypeStepCompleted is the progress type for finishing a recipe step.
+	CookingSessionProgressTypeStepCompleted = "step_completed"
+	// CookingSessionProgressTypeStepSkipped is the progress type for skipping an optional recipe step.
+	CookingSessionProgressTypeStepSkipped = "step_skipped"
+	// CookingSessionProgressTypeConditionSatisfied is the progress type for satisfying a recipe step completion condition.
+	CookingSessionProgressTypeConditionSatisfied = "condition_satisfied"
+	// CookingSessionProgressTypeObservationRecorded is the progress type for recording an observation that condition expressions can refer to.
+	CookingSessionProgressTypeObservationRecorded = "observation_recorded"
+
+	// CookingSessionStepStatusLocked indicates a step is waiting on the steps before it.
+	CookingSessionStepStatusLocked = "locked"
+	// CookingSessionStepStatusAwaitingObservations indicates a step's condition expression refers to observations nobody has recorded yet.
+	CookingSessionStepStatusAwaitingObservations = "awaiting_observations"
+	// CookingSessionStepStatusUnlocked indicates a step can be worked on.
+	CookingSessionStepStatusUnlocked = "unlocked"
+	// CookingSessionStepStatusCompleted indicates a step has been finished.
+	CookingSessionStepStatusCompleted = "completed"
+	// CookingSessionStepStatusSkipped indicates a step was skipped, either by choice or because its condition expression was false.
+	CookingSessionStepStatusSkipped = "skipped"
+)
+
+func init() {
+	gob.Register(new(CookingSession))
+	gob.Register(new(CookingSessionCreationRequestInput))
+	gob.Register(new(CookingSessionProgressCreationRequestInput))
+}
+
+type (
+	// CookingSession represents one or more household members' progress through cooking a recipe.
+	CookingSession struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt          time.Time                      `json:"createdAt"`
+		ArchivedAt         *time.Time                     `json:"archivedAt"`
+		LastUpdatedAt      *time.Time                     `json:"lastUpdatedAt"`
+		CompletedAt        *time.Time                     `json:"completedAt"`
+		ID                 string                         `json:"id"`
+		RecipeID           string                         `json:"recipeID"`
+		BelongsToHousehold string                         `json:"belongsToHousehold"`
+		CreatedByUser      string                         `json:"createdByUser"`
+		Progress           []*CookingSessionProgressEntry `json:"progress"`
+	}
+
+	// CookingSessionProgressEntry represents a single thing that happened during a cooking session.
+	CookingSessionProgressEntry struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt               time.Time `json:"createdAt"`
+		ID                      string    `json:"id"`
+		BelongsToCookingSession string    `json:"belongsToCookingSession"`
+		ProgressType            string    `json:"progressType"`
+		Subject                 string    `json:"subject"`
+		Value                   string    `json:"value"`
+		RecordedByUser          string    `json:"recordedByUser"`
+	}
+
+	// CookingSessionStepState describes where a given recipe step stands within a cooking session.
+	CookingSessionStepState struct {
+		_ struct{} `json:"-"`
+
+		ConditionMet                    *bool    `json:"conditionMet"`
+		RecipeStepID                    string   `json:"recipeStepID"`
+		Status                          string   `json:"status"`
+		ConditionExpression             string   `json:"conditionExpression"`
+		MissingObservations             []string `json:"missingObservations"`
+		UnsatisfiedCompletionConditions []string `json:"unsatisfiedCompletionConditions"`
+		Index                           uint32   `json:"index"`
+		Optional                        bool     `json:"optional"`
+	}
+
+	// CookingSessionState is a cooking session along with everything that can be worked out from its progress.
+	CookingSessionState struct {
+		_ struct{} `json:"-"`
+
+		Session       *CookingSession            `json:"session"`
+		Observations  map[string]string          `json:"observations"`
+		Steps         []*CookingSessionStepState `json:"steps"`
+		UnlockedSteps []string                   `json:"unlockedSteps"`
+		Finished      bool                       `json:"finished"`
+	}
+
+	// CookingSessionCreationRequestInput represents what a user could set as input for creating cooking sessions.
+	CookingSessionCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		RecipeID string `json:"recipeID"`
+	}
+
+	// CookingSessionDatabaseCreationInput represents what a user could set as input for creating cooking sessions.
+	CookingSessionDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID                 string
+		RecipeID           string
+		BelongsToHousehold string
+		CreatedByUser      string
+	}
+
+	// CookingSessionProgressCreationRequestInput represents what a user could set as input for recording cooking session progress.
+	CookingSessionProgressCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ProgressType string `json:"progressType"`
+		Subject      string `json:"subject"`
+		Value        string `json:"value"`
+	}
+
+	// CookingSessionProgressDatabaseCreationInput represents what a user could set as input for recording cooking session progress.
+	CookingSessionProgressDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID                      string
+		BelongsToCookingSession string
+		ProgressType            string
+		Subject                 string
+		Value                   string
+		RecordedByUser          string
+	}
+
+	// CookingSessionDataManager describes a structure capable of storing cooking sessions permanently.
+	CookingSessionDataManager interface {
+		GetCookingSession(ctx context.Context, cookingSessionID, householdID string) (*CookingSession, error)
+		GetCookingSessionForUpdate(ctx context.Context, cookingSessionID, householdID string) (*CookingSession, error)
+		GetCookingSessions(ctx context.Context, householdID string, filter *QueryFilter) (*QueryFilteredResult[CookingSession], error)
+		CreateCookingSession(ctx context.Context, input *CookingSessionDatabaseCreationInput) (*CookingSession, error)
+		CreateCookingSessionProgressEntry(ctx context.Context, input *CookingSessionProgressDatabaseCreationInput) (*CookingSessionProgressEntry, error)
+		MarkCookingSessionAsCompleted(ctx context.Context, cookingSessionID, householdID string) error
+		ArchiveCookingSession(ctx context.Context, cookingSessionID, householdID string) error
+	}
+
+	// CookingSessionDataService describes a structure capable of serving traffic related to cooking sessions.
+	CookingSessionDataService interface {
+		ListHandler(http.ResponseWriter, *http.Request)
+		CreateHandler(http.ResponseWriter, *http.Request)
+		ReadHandler(http.ResponseWriter, *http.Request)
+		ProgressHandler(http.ResponseWriter, *http.Request)
+		ArchiveHandler(http.ResponseWriter, *http.Request)
+	}
+)
+
+var _ validation.ValidatableWithContext = (*CookingSessionCreationRequestInput)(nil)
+
+// ValidateWithContext validates a CookingSessionCreationRequestInput.
+func (x *CookingSessionCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.RecipeID, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*CookingSessionDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a CookingSessionDatabaseCreationInput.
+func (x *CookingSessionDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.RecipeID, validation.Required),
+		validation.Field(&x.BelongsToHousehold, validation.Required),
+		validation.Field(&x.CreatedByUser, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*CookingSessionProgressCreationRequestInput)(nil)
+
+// ValidateWithContext validates a CookingSessionProgressCreationRequestInput.
+func (x *CookingSessionProgressCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ProgressType, validation.Required, validation.In(
+			CookingSessionProgressTypeStepCompleted,
+			CookingSessionProgressTypeStepSkipped,
+			CookingSessionProgressTypeConditionSatisfied,
+			CookingSessionProgressTypeObservationRecorded,
+		)),
+		validation.Field(&x.Subject, validation.Required),
+		validation.Field(&x.Value, validation.When(x.ProgressType == CookingSessionProgressTypeObservationRecorded, validation.Required)),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*CookingSessionProgressDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a CookingSessionProgressDatabaseCreationInput.
+func (x *CookingSessionProgressDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.BelongsToCookingSession, validation.Required),
+		validation.Field(&x.ProgressType, validation.Required),
+		validation.Field(&x.Subject, validation.Required),
+		validation.Field(&x.RecordedByUser, validation.Required),
+	)
+}
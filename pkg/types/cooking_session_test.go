@@ -0,0 +1,109 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCookingSessionCreationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionCreationRequestInput{
+			RecipeID: t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionCreationRequestInput{}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestCookingSessionDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionDatabaseCreationInput{
+			ID:                 t.Name(),
+			RecipeID:           t.Name(),
+			BelongsToHousehold: t.Name(),
+			CreatedByUser:      t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestCookingSessionProgressCreationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionProgressCreationRequestInput{
+			ProgressType: CookingSessionProgressTypeStepCompleted,
+			Subject:      t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with unknown progress type", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionProgressCreationRequestInput{
+			ProgressType: t.Name(),
+			Subject:      t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with observation missing a value", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionProgressCreationRequestInput{
+			ProgressType: CookingSessionProgressTypeObservationRecorded,
+			Subject:      t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestCookingSessionProgressDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &CookingSessionProgressDatabaseCreationInput{
+			ID:                      t.Name(),
+			BelongsToCookingSession: t.Name(),
+			ProgressType:            CookingSessionProgressTypeStepCompleted,
+			Subject:                 t.Name(),
+			RecordedByUser:          t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+}
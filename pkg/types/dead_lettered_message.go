@@ -0,0 +1,43 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// DeadLetteredMessage represents a queue message that kept failing to be handled, and was set aside.
+	DeadLetteredMessage struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt       time.Time  `json:"createdAt"`
+		ReplayedAt      *time.Time `json:"replayedAt"`
+		ID              string     `json:"id"`
+		Topic           string     `json:"topic"`
+		DeadLetterTopic string     `json:"deadLetterTopic"`
+		Payload         string     `json:"payload"`
+		LastError       string     `json:"lastError"`
+		Attempts        uint16     `json:"attempts"`
+	}
+
+	// DeadLetteredMessageDatabaseCreationInput is used for recording a dead-lettered message.
+	DeadLetteredMessageDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID              string
+		Topic           string
+		DeadLetterTopic string
+		Payload         string
+		LastError       string
+		Attempts        uint16
+	}
+
+	// DeadLetteredMessageDataManager describes a structure capable of storing dead-lettered messages.
+	DeadLetteredMessageDataManager interface {
+		GetDeadLetteredMessage(ctx context.Context, deadLetteredMessageID string) (*DeadLetteredMessage, error)
+		GetDeadLetteredMessages(ctx context.Context, filter *QueryFilter) (*QueryFilteredResult[DeadLetteredMessage], error)
+		CreateDeadLetteredMessage(ctx context.Context, input *DeadLetteredMessageDatabaseCreationInput) (*DeadLetteredMessage, error)
+		MarkDeadLetteredMessageAsReplayed(ctx context.Context, deadLetteredMessageID string) error
+		UnmarkDeadLetteredMessageAsReplayed(ctx context.Context, deadLetteredMessageID string) error
+	}
+)
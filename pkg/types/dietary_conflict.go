@@ -0,0 +1,38 @@
+package types
+
+const (
+	// DietaryConflictTypeAllergy indicates a meal contains an ingredient a user is allergic to.
+	DietaryConflictTypeAllergy = "allergy"
+	// DietaryConflictTypeAllergen indicates a meal contains an ingredient that shares an allergen with one a user is allergic to.
+	DietaryConflictTypeAllergen = "allergen"
+	// DietaryConflictTypeDislike indicates a meal contains an ingredient a user has rated very poorly.
+	DietaryConflictTypeDislike = "dislike"
+)
+
+type (
+	// DietaryConflict represents a single ingredient in a meal that one household member shouldn't, or would rather not, eat.
+	DietaryConflict struct {
+		_ struct{} `json:"-"`
+
+		UserID                     string `json:"userID"`
+		UserIngredientPreferenceID string `json:"userIngredientPreferenceID"`
+		ConflictType               string `json:"conflictType"`
+		Allergen                   string `json:"allergen,omitempty"`
+		IngredientID               string `json:"ingredientID"`
+		IngredientName             string `json:"ingredientName"`
+		RecipeID                   string `json:"recipeID"`
+		RecipeName                 string `json:"recipeName"`
+		RecipeStepID               string `json:"recipeStepID"`
+		Rating                     int8   `json:"rating"`
+		Optional                   bool   `json:"optional"`
+	}
+
+	// MealPlanOptionDietaryConflictReport represents every dietary conflict a meal plan option has with its household.
+	MealPlanOptionDietaryConflictReport struct {
+		_ struct{} `json:"-"`
+
+		MealPlanOptionID string             `json:"mealPlanOptionID"`
+		MealID           string             `json:"mealID"`
+		Conflicts        []*DietaryConflict `json:"conflicts"`
+	}
+)
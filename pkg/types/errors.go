@@ -55,4 +55,8 @@
 	ErrUserIsBanned ErrorCode = "E109"
 	// ErrUserIsNotAuthorized is returned when a user is not authorized.
 	ErrUserIsNotAuthorized ErrorCode = "E110"
+	// ErrSubscriptionRequired is returned when a household needs an active subscription to do something.
+	ErrSubscriptionRequired ErrorCode = "E111"
+	// ErrTooManyLoginAttempts is returned when a user or IP address is locked out after too many failed login attempts.
+	ErrTooManyLoginAttempts ErrorCode = "E112"
 )
@@ -0,0 +1,87 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// BuildFakeCookingSession builds a faked cooking session.
+func BuildFakeCookingSession() *types.CookingSession {
+	sessionID := BuildFakeID()
+
+	return &types.CookingSession{
+		CreatedAt:          BuildFakeTime(),
+		ID:                 sessionID,
+		RecipeID:           BuildFakeID(),
+		BelongsToHousehold: BuildFakeID(),
+		CreatedByUser:      BuildFakeID(),
+		Progress: []*types.CookingSessionProgressEntry{
+			BuildFakeCookingSessionProgressEntry(sessionID),
+		},
+	}
+}
+
+// BuildFakeCookingSessionProgressEntry builds a faked cooking session progress entry.
+func BuildFakeCookingSessionProgressEntry(cookingSessionID string) *types.CookingSessionProgressEntry {
+	return &types.CookingSessionProgressEntry{
+		CreatedAt:               BuildFakeTime(),
+		ID:                      BuildFakeID(),
+		BelongsToCookingSession: cookingSessionID,
+		ProgressType:            types.CookingSessionProgressTypeObservationRecorded,
+		Subject:                 "internal_temperature",
+		Value:                   "74",
+		RecordedByUser:          BuildFakeID(),
+	}
+}
+
+// BuildFakeCookingSessionList builds a faked CookingSessionList.
+func BuildFakeCookingSessionList() *types.QueryFilteredResult[types.CookingSession] {
+	var examples []*types.CookingSession
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeCookingSession())
+	}
+
+	return &types.QueryFilteredResult[types.CookingSession]{
+		Pagination: types.Pagination{
+			Page:          1,
+			Limit:         50,
+			FilteredCount: exampleQuantity / 2,
+			TotalCount:    exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakeCookingSessionState builds a faked cooking session state.
+func BuildFakeCookingSessionState() *types.CookingSessionState {
+	session := BuildFakeCookingSession()
+	stepID := BuildFakeID()
+
+	return &types.CookingSessionState{
+		Session:      session,
+		Observations: map[string]string{"internal_temperature": "74"},
+		Steps: []*types.CookingSessionStepState{
+			{
+				RecipeStepID:                    stepID,
+				Status:                          types.CookingSessionStepStatusUnlocked,
+				MissingObservations:             []string{},
+				UnsatisfiedCompletionConditions: []string{},
+			},
+		},
+		UnlockedSteps: []string{stepID},
+	}
+}
+
+// BuildFakeCookingSessionCreationRequestInput builds a faked CookingSessionCreationRequestInput.
+func BuildFakeCookingSessionCreationRequestInput() *types.CookingSessionCreationRequestInput {
+	return &types.CookingSessionCreationRequestInput{
+		RecipeID: BuildFakeID(),
+	}
+}
+
+// BuildFakeCookingSessionProgressCreationRequestInput builds a faked CookingSessionProgressCreationRequestInput.
+func BuildFakeCookingSessionProgressCreationRequestInput() *types.CookingSessionProgressCreationRequestInput {
+	return &types.CookingSessionProgressCreationRequestInput{
+		ProgressType: types.CookingSessionProgressTypeStepCompleted,
+		Subject:      BuildFakeID(),
+	}
+}
@@ -0,0 +1,56 @@
+package fakes
+
+import (
+	"fmt"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeDeadLetteredMessage builds a faked DeadLetteredMessage.
+func BuildFakeDeadLetteredMessage() *types.DeadLetteredMessage {
+	topic := buildUniqueString()
+
+	return &types.DeadLetteredMessage{
+		ID:              BuildFakeID(),
+		Topic:           topic,
+		DeadLetterTopic: fmt.Sprintf("%s_dead_letters", topic),
+		Payload:         fmt.Sprintf(`{"id":%q}`, BuildFakeID()),
+		LastError:       fake.Sentence(5),
+		Attempts:        uint16(fake.Number(1, 10)),
+		CreatedAt:       BuildFakeTime(),
+	}
+}
+
+// BuildFakeDeadLetteredMessageList builds a faked DeadLetteredMessageList.
+func BuildFakeDeadLetteredMessageList() *types.QueryFilteredResult[types.DeadLetteredMessage] {
+	var examples []*types.DeadLetteredMessage
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeDeadLetteredMessage())
+	}
+
+	return &types.QueryFilteredResult[types.DeadLetteredMessage]{
+		Pagination: types.Pagination{
+			Page:          1,
+			Limit:         50,
+			FilteredCount: exampleQuantity / 2,
+			TotalCount:    exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakeDeadLetteredMessageDatabaseCreationInput builds a faked DeadLetteredMessageDatabaseCreationInput.
+func BuildFakeDeadLetteredMessageDatabaseCreationInput() *types.DeadLetteredMessageDatabaseCreationInput {
+	x := BuildFakeDeadLetteredMessage()
+
+	return &types.DeadLetteredMessageDatabaseCreationInput{
+		ID:              x.ID,
+		Topic:           x.Topic,
+		DeadLetterTopic: x.DeadLetterTopic,
+		Payload:         x.Payload,
+		LastError:       x.LastError,
+		Attempts:        x.Attempts,
+	}
+}
@@ -0,0 +1,33 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// BuildFakeDietaryConflict builds a faked DietaryConflict.
+func BuildFakeDietaryConflict() *types.DietaryConflict {
+	return &types.DietaryConflict{
+		UserID:                     BuildFakeID(),
+		UserIngredientPreferenceID: BuildFakeID(),
+		ConflictType:               types.DietaryConflictTypeAllergy,
+		IngredientID:               BuildFakeID(),
+		IngredientName:             buildUniqueString(),
+		RecipeID:                   BuildFakeID(),
+		RecipeName:                 buildUniqueString(),
+		RecipeStepID:               BuildFakeID(),
+	}
+}
+
+// BuildFakeMealPlanOptionDietaryConflictReport builds a faked MealPlanOptionDietaryConflictReport.
+func BuildFakeMealPlanOptionDietaryConflictReport() *types.MealPlanOptionDietaryConflictReport {
+	var examples []*types.DietaryConflict
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeDietaryConflict())
+	}
+
+	return &types.MealPlanOptionDietaryConflictReport{
+		MealPlanOptionID: BuildFakeID(),
+		MealID:           BuildFakeID(),
+		Conflicts:        examples,
+	}
+}
@@ -1,8 +1,11 @@
 package fakes
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/backend/pkg/types"
 
 	fake "github.com/brianvoe/gofakeit/v7"
@@ -15,6 +18,8 @@ func BuildFakeOAuth2Client() *types.OAuth2Client {
 		Name:         fake.Password(true, true, true, false, false, 32),
 		ClientID:     BuildFakeID(),
 		ClientSecret: buildFakePassword(),
+		Domain:       fmt.Sprintf("https://%s", fake.DomainName()),
+		Scopes:       []string{string(authorization.ReadRecipesOAuth2Scope), string(authorization.ReadMealPlansOAuth2Scope)},
 		CreatedAt:    BuildFakeTime(),
 	}
 }
@@ -26,7 +31,7 @@ func BuildFakeOAuth2ClientToken() *types.OAuth2ClientToken {
 		AccessCreatedAt:     BuildFakeTime(),
 		CodeCreatedAt:       BuildFakeTime(),
 		RedirectURI:         fake.URL(),
-		Scope:               "*",
+		Scope:               strings.Join([]string{string(authorization.ReadRecipesOAuth2Scope), string(authorization.ReadMealPlansOAuth2Scope)}, " "),
 		Code:                buildUniqueString(),
 		CodeChallenge:       buildUniqueString(),
 		CodeChallengeMethod: "S256",
@@ -75,5 +80,7 @@ func BuildFakeOAuth2ClientCreationInput() *types.OAuth2ClientCreationRequestInpu
 	return &types.OAuth2ClientCreationRequestInput{
 		Name:        client.Name,
 		Description: client.Description,
+		Domain:      client.Domain,
+		Scopes:      client.Scopes,
 	}
 }
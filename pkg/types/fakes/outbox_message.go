@@ -0,0 +1,36 @@
+package fakes
+
+import (
+	"fmt"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeOutboxMessage builds a faked OutboxMessage.
+func BuildFakeOutboxMessage() *types.OutboxMessage {
+	return &types.OutboxMessage{
+		ID:             BuildFakeID(),
+		Topic:          buildUniqueString(),
+		IdempotencyKey: BuildFakeID(),
+		Payload:        fmt.Sprintf(`{"id":%q}`, BuildFakeID()),
+		EventType:      string(types.MealPlanCreatedCustomerEventType),
+		Attempts:       uint16(fake.Number(1, 10)),
+		CreatedAt:      BuildFakeTime(),
+	}
+}
+
+// BuildFakeOutboxMessageDatabaseCreationInput builds a faked OutboxMessageDatabaseCreationInput.
+func BuildFakeOutboxMessageDatabaseCreationInput() *types.OutboxMessageDatabaseCreationInput {
+	x := BuildFakeOutboxMessage()
+
+	return &types.OutboxMessageDatabaseCreationInput{
+		BelongsToHousehold: x.BelongsToHousehold,
+		ID:                 x.ID,
+		Topic:              x.Topic,
+		IdempotencyKey:     x.IdempotencyKey,
+		Payload:            x.Payload,
+		EventType:          x.EventType,
+	}
+}
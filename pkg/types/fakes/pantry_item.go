@@ -0,0 +1,52 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+)
+
+// BuildFakePantryItem builds a faked pantry item.
+func BuildFakePantryItem() *types.PantryItem {
+	return &types.PantryItem{
+		CreatedAt:          BuildFakeTime(),
+		ExpiresAt:          pointer.To(BuildFakeTime()),
+		ID:                 BuildFakeID(),
+		StorageLocation:    types.PantryItemStorageLocationRefrigerator,
+		Notes:              buildUniqueString(),
+		BelongsToHousehold: BuildFakeID(),
+		MeasurementUnit:    *BuildFakeValidMeasurementUnit(),
+		Ingredient:         *BuildFakeValidIngredient(),
+		Quantity:           float32(buildFakeNumber()),
+	}
+}
+
+// BuildFakePantryItemList builds a faked PantryItemList.
+func BuildFakePantryItemList() *types.QueryFilteredResult[types.PantryItem] {
+	var examples []*types.PantryItem
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakePantryItem())
+	}
+
+	return &types.QueryFilteredResult[types.PantryItem]{
+		Pagination: types.Pagination{
+			Page:          1,
+			Limit:         50,
+			FilteredCount: exampleQuantity / 2,
+			TotalCount:    exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakePantryItemUpdateRequestInput builds a faked PantryItemUpdateRequestInput from a pantry item.
+func BuildFakePantryItemUpdateRequestInput() *types.PantryItemUpdateRequestInput {
+	pantryItem := BuildFakePantryItem()
+	return converters.ConvertPantryItemToPantryItemUpdateRequestInput(pantryItem)
+}
+
+// BuildFakePantryItemCreationRequestInput builds a faked PantryItemCreationRequestInput.
+func BuildFakePantryItemCreationRequestInput() *types.PantryItemCreationRequestInput {
+	pantryItem := BuildFakePantryItem()
+	return converters.ConvertPantryItemToPantryItemCreationRequestInput(pantryItem)
+}
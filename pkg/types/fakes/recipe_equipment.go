@@ -0,0 +1,32 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// BuildFakeMissingRecipeEquipment builds a faked MissingRecipeEquipment.
+func BuildFakeMissingRecipeEquipment() *types.MissingRecipeEquipment {
+	return &types.MissingRecipeEquipment{
+		EquipmentType:    types.MissingEquipmentTypeInstrument,
+		Instrument:       BuildFakeValidInstrument(),
+		RecipeStepIDs:    []string{BuildFakeID()},
+		Substitutes:      []*types.ValidInstrument{BuildFakeValidInstrument()},
+		RequiredQuantity: 1,
+	}
+}
+
+// BuildFakeRecipeEquipmentReport builds a faked RecipeEquipmentReport.
+func BuildFakeRecipeEquipmentReport() *types.RecipeEquipmentReport {
+	var examples []*types.MissingRecipeEquipment
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeMissingRecipeEquipment())
+	}
+
+	return &types.RecipeEquipmentReport{
+		RecipeID:         BuildFakeID(),
+		HouseholdID:      BuildFakeID(),
+		Missing:          examples,
+		UncheckedVessels: []*types.ValidVessel{BuildFakeValidVessel()},
+		CanCook:          true,
+	}
+}
@@ -0,0 +1,35 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeRecipeRecommendation builds a faked RecipeRecommendation.
+func BuildFakeRecipeRecommendation() *types.RecipeRecommendation {
+	return &types.RecipeRecommendation{
+		Recipe:  BuildFakeRecipe(),
+		Score:   fake.Float64Range(-10, 10),
+		Reasons: []string{buildUniqueString()},
+	}
+}
+
+// BuildFakeRecipeRecommendations builds a list of faked RecipeRecommendations.
+func BuildFakeRecipeRecommendations() []*types.RecipeRecommendation {
+	var examples []*types.RecipeRecommendation
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeRecipeRecommendation())
+	}
+
+	return examples
+}
+
+// BuildFakeMealRecommendation builds a faked MealRecommendation.
+func BuildFakeMealRecommendation() *types.MealRecommendation {
+	return &types.MealRecommendation{
+		Meal:    BuildFakeMeal(),
+		Score:   fake.Float64Range(-10, 10),
+		Reasons: []string{buildUniqueString()},
+	}
+}
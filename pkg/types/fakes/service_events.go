@@ -0,0 +1,199 @@
+package fakes
+
+import (
+	"strings"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+)
+
+// fakeDataChangeMessageResources populates the resource a data change message carries, keyed by the event
+// type prefix of the resource's events.
+var fakeDataChangeMessageResources = map[string]func(x *types.DataChangeMessage){
+	"cooking_session": func(x *types.DataChangeMessage) {
+		x.CookingSession = BuildFakeCookingSession()
+		x.CookingSessionID = x.CookingSession.ID
+	},
+	"household": func(x *types.DataChangeMessage) {
+		x.Household = BuildFakeHousehold()
+	},
+	"household_instrument_ownership": func(x *types.DataChangeMessage) {
+		x.HouseholdInstrumentOwnership = BuildFakeHouseholdInstrumentOwnership()
+		x.HouseholdInstrumentOwnershipID = x.HouseholdInstrumentOwnership.ID
+	},
+	"household_invitation": func(x *types.DataChangeMessage) {
+		x.HouseholdInvitation = BuildFakeHouseholdInvitation()
+		x.HouseholdInvitationID = x.HouseholdInvitation.ID
+	},
+	"household_member": func(x *types.DataChangeMessage) {
+		x.UserMembership = BuildFakeHouseholdUserMembership()
+	},
+	"household_membership": func(x *types.DataChangeMessage) {
+		x.UserMembership = BuildFakeHouseholdUserMembership()
+	},
+	"meal": func(x *types.DataChangeMessage) {
+		x.Meal = BuildFakeMeal()
+		x.MealID = x.Meal.ID
+	},
+	"meal_plan": func(x *types.DataChangeMessage) {
+		x.MealPlan = BuildFakeMealPlan()
+		x.MealPlanID = x.MealPlan.ID
+	},
+	"meal_plan_event": func(x *types.DataChangeMessage) {
+		x.MealPlanEvent = BuildFakeMealPlanEvent()
+		x.MealPlanEventID = x.MealPlanEvent.ID
+	},
+	"meal_plan_grocery_list_item": func(x *types.DataChangeMessage) {
+		x.MealPlanGroceryListItem = BuildFakeMealPlanGroceryListItem()
+		x.MealPlanGroceryListItemID = x.MealPlanGroceryListItem.ID
+	},
+	"meal_plan_option": func(x *types.DataChangeMessage) {
+		x.MealPlanOption = BuildFakeMealPlanOption()
+		x.MealPlanOptionID = x.MealPlanOption.ID
+	},
+	"meal_plan_option_vote": func(x *types.DataChangeMessage) {
+		x.MealPlanOptionVote = BuildFakeMealPlanOptionVote()
+		x.MealPlanOptionVoteID = x.MealPlanOptionVote.ID
+	},
+	"meal_plan_task": func(x *types.DataChangeMessage) {
+		x.MealPlanTask = BuildFakeMealPlanTask()
+		x.MealPlanTaskID = x.MealPlanTask.ID
+	},
+	"oauth2_client": func(x *types.DataChangeMessage) {
+		x.OAuth2ClientID = BuildFakeID()
+	},
+	"pantry_item": func(x *types.DataChangeMessage) {
+		x.PantryItem = BuildFakePantryItem()
+		x.PantryItemID = x.PantryItem.ID
+	},
+	"recipe": func(x *types.DataChangeMessage) {
+		x.Recipe = BuildFakeRecipe()
+		x.RecipeID = x.Recipe.ID
+	},
+	"recipe_media": func(x *types.DataChangeMessage) {
+		x.RecipeMediaID = BuildFakeID()
+	},
+	"recipe_prep_task": func(x *types.DataChangeMessage) {
+		x.RecipePrepTask = BuildFakeRecipePrepTask()
+		x.RecipePrepTaskID = x.RecipePrepTask.ID
+	},
+	"recipe_prep_task_step": func(x *types.DataChangeMessage) {
+		x.RecipePrepTaskStep = BuildFakeRecipePrepTaskStep()
+	},
+	"recipe_rating": func(x *types.DataChangeMessage) {
+		x.RecipeRating = BuildFakeRecipeRating()
+		x.RecipeRatingID = x.RecipeRating.ID
+	},
+	"recipe_step": func(x *types.DataChangeMessage) {
+		x.RecipeStep = BuildFakeRecipeStep()
+		x.RecipeStepID = x.RecipeStep.ID
+	},
+	"recipe_step_completion_condition": func(x *types.DataChangeMessage) {
+		x.RecipeStepCompletionCondition = BuildFakeRecipeStepCompletionCondition()
+	},
+	"recipe_step_ingredient": func(x *types.DataChangeMessage) {
+		x.RecipeStepIngredient = BuildFakeRecipeStepIngredient()
+	},
+	"recipe_step_instrument": func(x *types.DataChangeMessage) {
+		x.RecipeStepInstrument = BuildFakeRecipeStepInstrument()
+	},
+	"recipe_step_product": func(x *types.DataChangeMessage) {
+		x.RecipeStepProduct = BuildFakeRecipeStepProduct()
+	},
+	"recipe_step_vessel": func(x *types.DataChangeMessage) {
+		x.RecipeStepVessel = BuildFakeRecipeStepVessel()
+		x.RecipeStepVesselID = x.RecipeStepVessel.ID
+	},
+	"service_setting": func(x *types.DataChangeMessage) {
+		x.ServiceSetting = BuildFakeServiceSetting()
+	},
+	"service_setting_configuration": func(x *types.DataChangeMessage) {
+		x.ServiceSettingConfiguration = BuildFakeServiceSettingConfiguration()
+	},
+	"user_ingredient_preference": func(x *types.DataChangeMessage) {
+		x.UserIngredientPreferences = []*types.UserIngredientPreference{BuildFakeUserIngredientPreference()}
+		x.UserIngredientPreferenceID = x.UserIngredientPreferences[0].ID
+	},
+	"user_notification": func(x *types.DataChangeMessage) {
+		x.UserNotification = BuildFakeUserNotification()
+		x.UserNotificationID = x.UserNotification.ID
+	},
+	"valid_ingredient": func(x *types.DataChangeMessage) {
+		x.ValidIngredient = BuildFakeValidIngredient()
+	},
+	"valid_ingredient_group": func(x *types.DataChangeMessage) {
+		x.ValidIngredientGroup = BuildFakeValidIngredientGroup()
+		x.ValidIngredientGroupID = x.ValidIngredientGroup.ID
+	},
+	"valid_ingredient_measurement_unit": func(x *types.DataChangeMessage) {
+		x.ValidIngredientMeasurementUnit = BuildFakeValidIngredientMeasurementUnit()
+		x.ValidIngredientMeasurementUnitID = x.ValidIngredientMeasurementUnit.ID
+	},
+	"valid_ingredient_nutrition": func(x *types.DataChangeMessage) {
+		x.ValidIngredientNutrition = BuildFakeValidIngredientNutrition()
+	},
+	"valid_ingredient_preparation": func(x *types.DataChangeMessage) {
+		x.ValidIngredientPreparation = BuildFakeValidIngredientPreparation()
+	},
+	"valid_ingredient_state": func(x *types.DataChangeMessage) {
+		x.ValidIngredientState = BuildFakeValidIngredientState()
+		x.ValidIngredientStateID = x.ValidIngredientState.ID
+	},
+	"valid_ingredient_state_ingredient": func(x *types.DataChangeMessage) {
+		x.ValidIngredientStateIngredient = BuildFakeValidIngredientStateIngredient()
+		x.ValidIngredientStateIngredientID = x.ValidIngredientStateIngredient.ID
+	},
+	"valid_ingredient_substitution": func(x *types.DataChangeMessage) {
+		x.ValidIngredientSubstitution = BuildFakeValidIngredientSubstitution()
+	},
+	"valid_instrument": func(x *types.DataChangeMessage) {
+		x.ValidInstrument = BuildFakeValidInstrument()
+	},
+	"valid_measurement_unit": func(x *types.DataChangeMessage) {
+		x.ValidMeasurementUnit = BuildFakeValidMeasurementUnit()
+		x.ValidMeasurementUnitID = x.ValidMeasurementUnit.ID
+	},
+	"valid_measurement_unit_conversion": func(x *types.DataChangeMessage) {
+		x.ValidMeasurementUnitConversion = BuildFakeValidMeasurementUnitConversion()
+		x.ValidMeasurementUnitConversionID = x.ValidMeasurementUnitConversion.ID
+	},
+	"valid_preparation": func(x *types.DataChangeMessage) {
+		x.ValidPreparation = BuildFakeValidPreparation()
+	},
+	"valid_preparation_instrument": func(x *types.DataChangeMessage) {
+		x.ValidPreparationInstrument = BuildFakeValidPreparationInstrument()
+		x.ValidPreparationInstrumentID = x.ValidPreparationInstrument.ID
+	},
+	"valid_preparation_vessel": func(x *types.DataChangeMessage) {
+		x.ValidPreparationVessel = BuildFakeValidPreparationVessel()
+	},
+	"valid_vessel": func(x *types.DataChangeMessage) {
+		x.ValidVessel = BuildFakeValidVessel()
+	},
+	"webhook": func(x *types.DataChangeMessage) {
+		x.Webhook = BuildFakeWebhook()
+	},
+}
+
+// BuildFakeDataChangeMessageForEvent builds a faked DataChangeMessage for an event type, carrying a faked
+// version of the resource that event type's messages carry, if any.
+func BuildFakeDataChangeMessageForEvent(eventType types.ServiceEventType) *types.DataChangeMessage {
+	x := &types.DataChangeMessage{
+		EventType:   eventType,
+		HouseholdID: BuildFakeID(),
+		UserID:      BuildFakeID(),
+	}
+
+	// the longest matching prefix is the most specific resource, e.g. recipe_step over recipe.
+	longestPrefix := ""
+	for prefix := range fakeDataChangeMessageResources {
+		if strings.HasPrefix(string(eventType), prefix+"_") && len(prefix) > len(longestPrefix) {
+			longestPrefix = prefix
+		}
+	}
+
+	if populate, ok := fakeDataChangeMessageResources[longestPrefix]; ok {
+		populate(x)
+	}
+
+	return x
+}
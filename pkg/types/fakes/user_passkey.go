@@ -0,0 +1,43 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeUserPasskey builds a faked UserPasskey.
+func BuildFakeUserPasskey() *types.UserPasskey {
+	return &types.UserPasskey{
+		CreatedAt:       BuildFakeTime(),
+		ID:              BuildFakeID(),
+		Name:            buildUniqueString(),
+		CredentialID:    fake.UUID(),
+		AttestationType: "none",
+		BelongsToUser:   BuildFakeID(),
+		PublicKey:       []byte(fake.LetterN(64)),
+		AAGUID:          make([]byte, 16),
+		Transports:      []string{"internal", "hybrid"},
+		SignCount:       fake.Uint32(),
+	}
+}
+
+// BuildFakeUserPasskeyList builds a faked list of UserPasskeys.
+func BuildFakeUserPasskeyList() []*types.UserPasskey {
+	var examples []*types.UserPasskey
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeUserPasskey())
+	}
+
+	return examples
+}
+
+// BuildFakeRecoveryCodesGenerationRequestInput builds a faked RecoveryCodesGenerationRequestInput.
+func BuildFakeRecoveryCodesGenerationRequestInput() *types.RecoveryCodesGenerationRequestInput {
+	refreshInput := BuildFakeTOTPSecretRefreshInput()
+
+	return &types.RecoveryCodesGenerationRequestInput{
+		CurrentPassword: refreshInput.CurrentPassword,
+		TOTPToken:       refreshInput.TOTPToken,
+	}
+}
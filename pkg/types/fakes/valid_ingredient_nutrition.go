@@ -0,0 +1,40 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+)
+
+// BuildFakeNutrientAmounts builds faked nutrient amounts.
+func BuildFakeNutrientAmounts() *types.NutrientAmounts {
+	return &types.NutrientAmounts{
+		Calories:      float32(buildFakeNumber()),
+		Protein:       float32(buildFakeNumber()),
+		TotalFat:      float32(buildFakeNumber()),
+		SaturatedFat:  float32(buildFakeNumber()),
+		Carbohydrates: float32(buildFakeNumber()),
+		Sugar:         float32(buildFakeNumber()),
+		Fiber:         float32(buildFakeNumber()),
+		Sodium:        float32(buildFakeNumber()),
+	}
+}
+
+// BuildFakeValidIngredientNutrition builds faked valid ingredient nutrition.
+func BuildFakeValidIngredientNutrition() *types.ValidIngredientNutrition {
+	return &types.ValidIngredientNutrition{
+		CreatedAt:                BuildFakeTime(),
+		ID:                       BuildFakeID(),
+		BelongsToValidIngredient: BuildFakeID(),
+		Source:                   "usda_fdc",
+		SourceID:                 buildUniqueString(),
+		ReferenceMeasurementUnit: *BuildFakeValidMeasurementUnit(),
+		Nutrients:                *BuildFakeNutrientAmounts(),
+		ReferenceQuantity:        100,
+	}
+}
+
+// BuildFakeValidIngredientNutritionUpsertRequestInput builds a faked ValidIngredientNutritionUpsertRequestInput.
+func BuildFakeValidIngredientNutritionUpsertRequestInput() *types.ValidIngredientNutritionUpsertRequestInput {
+	validIngredientNutrition := BuildFakeValidIngredientNutrition()
+	return converters.ConvertValidIngredientNutritionToValidIngredientNutritionUpsertRequestInput(validIngredientNutrition)
+}
@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/backend/pkg/types"
+	"github.com/dinnerdonebetter/backend/pkg/types/converters"
+)
+
+// BuildFakeValidIngredientSubstitution builds a faked valid ingredient substitution.
+func BuildFakeValidIngredientSubstitution() *types.ValidIngredientSubstitution {
+	return &types.ValidIngredientSubstitution{
+		ID:          BuildFakeID(),
+		Notes:       buildUniqueString(),
+		Original:    *BuildFakeValidIngredient(),
+		Substitute:  *BuildFakeValidIngredient(),
+		Preparation: BuildFakeValidPreparation(),
+		Reasons:     []string{types.SubstitutionReasonAllergy},
+		Ratio:       1,
+		CreatedAt:   BuildFakeTime(),
+	}
+}
+
+// BuildFakeValidIngredientSubstitutionList builds a faked ValidIngredientSubstitutionList.
+func BuildFakeValidIngredientSubstitutionList() *types.QueryFilteredResult[types.ValidIngredientSubstitution] {
+	var examples []*types.ValidIngredientSubstitution
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeValidIngredientSubstitution())
+	}
+
+	return &types.QueryFilteredResult[types.ValidIngredientSubstitution]{
+		Pagination: types.Pagination{
+			Page:          1,
+			Limit:         50,
+			FilteredCount: exampleQuantity / 2,
+			TotalCount:    exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakeValidIngredientSubstitutionUpdateRequestInput builds a faked ValidIngredientSubstitutionUpdateRequestInput from a valid ingredient substitution.
+func BuildFakeValidIngredientSubstitutionUpdateRequestInput() *types.ValidIngredientSubstitutionUpdateRequestInput {
+	validIngredientSubstitution := BuildFakeValidIngredientSubstitution()
+	return converters.ConvertValidIngredientSubstitutionToValidIngredientSubstitutionUpdateRequestInput(validIngredientSubstitution)
+}
+
+// BuildFakeValidIngredientSubstitutionCreationRequestInput builds a faked ValidIngredientSubstitutionCreationRequestInput.
+func BuildFakeValidIngredientSubstitutionCreationRequestInput() *types.ValidIngredientSubstitutionCreationRequestInput {
+	validIngredientSubstitution := BuildFakeValidIngredientSubstitution()
+	return converters.ConvertValidIngredientSubstitutionToValidIngredientSubstitutionCreationRequestInput(validIngredientSubstitution)
+}
@@ -1,6 +1,7 @@
 package fakes
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/dinnerdonebetter/backend/pkg/types"
@@ -82,3 +83,59 @@ func BuildFakeWebhookCreationRequestInput() *types.WebhookCreationRequestInput {
 	webhook := BuildFakeWebhook()
 	return converters.ConvertWebhookToWebhookCreationRequestInput(webhook)
 }
+
+// BuildFakeWebhookDelivery builds a faked WebhookDelivery.
+func BuildFakeWebhookDelivery() *types.WebhookDelivery {
+	statusCode := uint16(http.StatusOK)
+
+	return &types.WebhookDelivery{
+		ID:                    BuildFakeID(),
+		DeliveryID:            BuildFakeID(),
+		TriggerEvent:          string(types.WebhookCreatedCustomerEventType),
+		Payload:               fmt.Sprintf(`{"id":%q}`, BuildFakeID()),
+		Attempt:               1,
+		ResponseStatusCode:    &statusCode,
+		ResponseBody:          "OK",
+		LatencyInMilliseconds: uint64(fake.Uint16()),
+		Succeeded:             true,
+		BelongsToWebhook:      BuildFakeID(),
+		CreatedAt:             BuildFakeTime(),
+	}
+}
+
+// BuildFakeWebhookDeliveryList builds a faked WebhookDeliveryList.
+func BuildFakeWebhookDeliveryList() *types.QueryFilteredResult[types.WebhookDelivery] {
+	var examples []*types.WebhookDelivery
+	for i := 0; i < exampleQuantity; i++ {
+		examples = append(examples, BuildFakeWebhookDelivery())
+	}
+
+	return &types.QueryFilteredResult[types.WebhookDelivery]{
+		Pagination: types.Pagination{
+			Page:          1,
+			Limit:         50,
+			FilteredCount: exampleQuantity / 2,
+			TotalCount:    exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakeWebhookPreviewRequestInput builds a faked WebhookPreviewRequestInput.
+func BuildFakeWebhookPreviewRequestInput() *types.WebhookPreviewRequestInput {
+	return &types.WebhookPreviewRequestInput{
+		TriggerEvent: string(types.WebhookCreatedCustomerEventType),
+	}
+}
+
+// BuildFakeWebhookPreview builds a faked WebhookPreview.
+func BuildFakeWebhookPreview() *types.WebhookPreview {
+	return &types.WebhookPreview{
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+		},
+		Method: http.MethodPost,
+		URL:    fake.URL(),
+		Body:   fmt.Sprintf(`{"id":%q}`, BuildFakeID()),
+	}
+}
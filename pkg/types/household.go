@@ -22,9 +22,17 @@
 	HouseholdMembershipPermissionsUpdatedCustomerEventType ServiceEventType = "household_membership_permissions_updated"
 	// HouseholdOwnershipTransferredCustomerEventType indicates a household was transferred to another owner.
 	HouseholdOwnershipTransferredCustomerEventType ServiceEventType = "household_ownership_transferred"
+	// HouseholdWebhookEncryptionKeyRotatedCustomerEventType indicates a household's webhook encryption key was rotated.
+	HouseholdWebhookEncryptionKeyRotatedCustomerEventType ServiceEventType = "household_webhook_encryption_key_rotated"
+	// HouseholdBillingStatusUpdatedCustomerEventType indicates a household's subscription changed.
+	HouseholdBillingStatusUpdatedCustomerEventType ServiceEventType = "household_billing_status_updated"
 
 	// UnpaidHouseholdBillingStatus indicates a household is not paid.
 	UnpaidHouseholdBillingStatus = "unpaid"
+	// PaidHouseholdBillingStatus indicates a household has an active subscription.
+	PaidHouseholdBillingStatus = "paid"
+	// PastDueHouseholdBillingStatus indicates a household's latest subscription payment failed, and is being retried.
+	PastDueHouseholdBillingStatus = "past_due"
 )
 
 type (
@@ -32,26 +40,28 @@
 	Household struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt                  time.Time                          `json:"createdAt"`
-		SubscriptionPlanID         *string                            `json:"subscriptionPlanID"`
-		LastUpdatedAt              *time.Time                         `json:"lastUpdatedAt"`
-		ArchivedAt                 *time.Time                         `json:"archivedAt"`
-		Longitude                  *float64                           `json:"longitude"`
-		Latitude                   *float64                           `json:"latitude"`
-		State                      string                             `json:"state"`
-		ContactPhone               string                             `json:"contactPhone"`
-		City                       string                             `json:"city"`
-		AddressLine1               string                             `json:"addressLine1"`
-		ZipCode                    string                             `json:"zipCode"`
-		Country                    string                             `json:"country"`
-		BillingStatus              string                             `json:"billingStatus"`
-		AddressLine2               string                             `json:"addressLine2"`
-		PaymentProcessorCustomerID string                             `json:"paymentProcessorCustomer"`
-		BelongsToUser              string                             `json:"belongsToUser"`
-		ID                         string                             `json:"id"`
-		Name                       string                             `json:"name"`
-		WebhookEncryptionKey       string                             `json:"-"`
-		Members                    []*HouseholdUserMembershipWithUser `json:"members"`
+		CreatedAt                             time.Time                          `json:"createdAt"`
+		SubscriptionPlanID                    *string                            `json:"subscriptionPlanID"`
+		PreviousWebhookEncryptionKeyExpiresAt *time.Time                         `json:"-"`
+		LastUpdatedAt                         *time.Time                         `json:"lastUpdatedAt"`
+		ArchivedAt                            *time.Time                         `json:"archivedAt"`
+		Longitude                             *float64                           `json:"longitude"`
+		Latitude                              *float64                           `json:"latitude"`
+		State                                 string                             `json:"state"`
+		ContactPhone                          string                             `json:"contactPhone"`
+		City                                  string                             `json:"city"`
+		AddressLine1                          string                             `json:"addressLine1"`
+		ZipCode                               string                             `json:"zipCode"`
+		Country                               string                             `json:"country"`
+		BillingStatus                         string                             `json:"billingStatus"`
+		AddressLine2                          string                             `json:"addressLine2"`
+		PaymentProcessorCustomerID            string                             `json:"paymentProcessorCustomer"`
+		BelongsToUser                         string                             `json:"belongsToUser"`
+		ID                                    string                             `json:"id"`
+		Name                                  string                             `json:"name"`
+		WebhookEncryptionKey                  string                             `json:"-"`
+		PreviousWebhookEncryptionKey          string                             `json:"-"`
+		Members                               []*HouseholdUserMembershipWithUser `json:"members"`
 	}
 
 	// HouseholdCreationRequestInput represents what a User could set as input for creating households.
@@ -106,6 +116,27 @@
 		BelongsToUser string   `json:"-"`
 	}
 
+	// HouseholdWebhookEncryptionKeyRotationResponse is what we return when a household's webhook encryption key is rotated.
+	HouseholdWebhookEncryptionKeyRotationResponse struct {
+		_ struct{} `json:"-"`
+
+		PreviousKeyExpiresAt time.Time `json:"previousKeyExpiresAt"`
+		WebhookEncryptionKey string    `json:"webhookEncryptionKey"`
+	}
+
+	// HouseholdBillingStatusUpdateInput represents a change to a household's subscription, as reported by a payment processor.
+	// OccurredAt is when the payment processor recorded the change, so that changes reported out of order are not applied
+	// over newer ones.
+	HouseholdBillingStatusUpdateInput struct {
+		_ struct{} `json:"-"`
+
+		OccurredAt                 time.Time `json:"occurredAt"`
+		SubscriptionPlanID         *string   `json:"subscriptionPlanID"`
+		HouseholdID                string    `json:"householdID"`
+		BillingStatus              string    `json:"billingStatus"`
+		PaymentProcessorCustomerID string    `json:"paymentProcessorCustomerID"`
+	}
+
 	// HouseholdDataManager describes a structure capable of storing households permanently.
 	HouseholdDataManager interface {
 		GetHousehold(ctx context.Context, householdID string) (*Household, error)
@@ -113,6 +144,8 @@
 		CreateHousehold(ctx context.Context, input *HouseholdDatabaseCreationInput) (*Household, error)
 		UpdateHousehold(ctx context.Context, updated *Household) error
 		ArchiveHousehold(ctx context.Context, householdID string, userID string) error
+		RotateHouseholdWebhookEncryptionKey(ctx context.Context, householdID, userID, newKey string, previousKeyExpiresAt time.Time) error
+		UpdateHouseholdBillingStatus(ctx context.Context, input *HouseholdBillingStatusUpdateInput) error
 	}
 
 	// HouseholdDataService describes a structure capable of serving traffic related to households.
@@ -127,6 +160,9 @@
 		MarkAsDefaultHouseholdHandler(http.ResponseWriter, *http.Request)
 		ModifyMemberPermissionsHandler(http.ResponseWriter, *http.Request)
 		TransferHouseholdOwnershipHandler(http.ResponseWriter, *http.Request)
+		RotateWebhookEncryptionKeyHandler(http.ResponseWriter, *http.Request)
+		RecommendationsHandler(http.ResponseWriter, *http.Request)
+		StreamEventsHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -173,6 +209,12 @@ func (x *Household) Update(input *HouseholdUpdateRequestInput) {
 	}
 }
 
+// HasActiveSubscription returns whether a household is entitled to premium features. Past due households
+// keep their access while the payment processor retries the failed payment.
+func (x *Household) HasActiveSubscription() bool {
+	return x.BillingStatus == PaidHouseholdBillingStatus || x.BillingStatus == PastDueHouseholdBillingStatus
+}
+
 var _ validation.ValidatableWithContext = (*HouseholdCreationRequestInput)(nil)
 
 // ValidateWithContext validates a HouseholdCreationRequestInput.
@@ -195,6 +237,21 @@ func (x *HouseholdUpdateRequestInput) ValidateWithContext(ctx context.Context) e
 	)
 }
 
+var _ validation.ValidatableWithContext = (*HouseholdBillingStatusUpdateInput)(nil)
+
+// ValidateWithContext validates a HouseholdBillingStatusUpdateInput.
+func (x *HouseholdBillingStatusUpdateInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.OccurredAt, validation.Required),
+		validation.Field(&x.HouseholdID, validation.Required),
+		validation.Field(&x.BillingStatus, validation.Required, validation.In(
+			UnpaidHouseholdBillingStatus,
+			PaidHouseholdBillingStatus,
+			PastDueHouseholdBillingStatus,
+		)),
+	)
+}
+
 // HouseholdCreationInputForNewUser creates a new HouseholdInputCreation struct for a given user.
 func HouseholdCreationInputForNewUser(u *User) *HouseholdDatabaseCreationInput {
 	return &HouseholdDatabaseCreationInput{
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// LastEventIDHeaderKey is the header clients use to resume a household event stream.
+	LastEventIDHeaderKey = "Last-Event-ID"
+	// LastEventIDQueryKey is the query parameter clients that can't set headers use to resume a household event stream.
+	LastEventIDQueryKey = "lastEventID"
+
+	// HouseholdEventStreamResetEventType indicates a stream could not resume from the event the client provided,
+	// because it is no longer retained. Clients should refetch whatever state they track and resume from this event.
+	HouseholdEventStreamResetEventType ServiceEventType = "household_event_stream_reset"
+)
+
+var (
+	// ErrInvalidHouseholdEventCursor is returned when a household event ID can't be parsed.
+	ErrInvalidHouseholdEventCursor = errors.New("invalid household event ID")
+)
+
+type (
+	// HouseholdEventCursor identifies a position in a household's event stream. Events are ordered by the
+	// transaction that recorded them, and then by the order they were recorded in within that transaction.
+	HouseholdEventCursor struct {
+		TransactionID uint64
+		Sequence      uint64
+	}
+
+	// HouseholdEvent represents a data change that happened within a household, as streamed to its members.
+	HouseholdEvent struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt time.Time            `json:"createdAt"`
+		Data      DataChangeMessage    `json:"data"`
+		EventType ServiceEventType     `json:"eventType"`
+		ID        HouseholdEventCursor `json:"id"`
+	}
+
+	// HouseholdEventDataManager describes a structure capable of retrieving household events.
+	HouseholdEventDataManager interface {
+		GetHouseholdEvents(ctx context.Context, householdID string, after HouseholdEventCursor, limit uint16) ([]*HouseholdEvent, error)
+		GetHouseholdEventHorizon(ctx context.Context) (HouseholdEventCursor, error)
+		HouseholdEventCursorIsRetained(ctx context.Context, householdID string, cursor HouseholdEventCursor) (bool, error)
+	}
+)
+
+// ParseHouseholdEventCursor parses a household event ID.
+func ParseHouseholdEventCursor(raw string) (HouseholdEventCursor, error) {
+	transactionID, sequence, found := strings.Cut(raw, "-")
+	if !found {
+		return HouseholdEventCursor{}, ErrInvalidHouseholdEventCursor
+	}
+
+	var (
+		x   HouseholdEventCursor
+		err error
+	)
+
+	if x.TransactionID, err = strconv.ParseUint(transactionID, 10, 64); err != nil {
+		return HouseholdEventCursor{}, ErrInvalidHouseholdEventCursor
+	}
+
+	if x.Sequence, err = strconv.ParseUint(sequence, 10, 64); err != nil {
+		return HouseholdEventCursor{}, ErrInvalidHouseholdEventCursor
+	}
+
+	return x, nil
+}
+
+// IsZero reports whether the cursor is unset.
+func (x HouseholdEventCursor) IsZero() bool {
+	return x.TransactionID == 0 && x.Sequence == 0
+}
+
+// String implements fmt.Stringer.
+func (x HouseholdEventCursor) String() string {
+	return fmt.Sprintf("%d-%d", x.TransactionID, x.Sequence)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (x HouseholdEventCursor) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (x *HouseholdEventCursor) UnmarshalText(text []byte) error {
+	parsed, err := ParseHouseholdEventCursor(string(text))
+	if err != nil {
+		return err
+	}
+
+	*x = parsed
+
+	return nil
+}
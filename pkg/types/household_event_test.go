@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHouseholdEventCursor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseHouseholdEventCursor("123-4")
+		assert.NoError(t, err)
+		assert.Equal(t, HouseholdEventCursor{TransactionID: 123, Sequence: 4}, actual)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		for _, raw := range []string{"", "123", "-4", "123-", "a-4", "123-b", "-1-4"} {
+			_, err := ParseHouseholdEventCursor(raw)
+			assert.ErrorIs(t, err, ErrInvalidHouseholdEventCursor, raw)
+		}
+	})
+}
+
+func TestHouseholdEventCursor_JSON(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		expected := &HouseholdEvent{ID: HouseholdEventCursor{TransactionID: 123, Sequence: 4}}
+
+		encoded, err := json.Marshal(expected)
+		require.NoError(t, err)
+		assert.Contains(t, string(encoded), `"id":"123-4"`)
+
+		var actual *HouseholdEvent
+		require.NoError(t, json.Unmarshal(encoded, &actual))
+		assert.Equal(t, expected.ID, actual.ID)
+	})
+}
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"testing"
+	"time"
 
 	fake "github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,18 @@ func TestHousehold_Update(T *testing.T) {
 	})
 }
 
+func TestHousehold_HasActiveSubscription(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, (&Household{BillingStatus: PaidHouseholdBillingStatus}).HasActiveSubscription())
+		assert.True(t, (&Household{BillingStatus: PastDueHouseholdBillingStatus}).HasActiveSubscription())
+		assert.False(t, (&Household{BillingStatus: UnpaidHouseholdBillingStatus}).HasActiveSubscription())
+	})
+}
+
 func TestHouseholdCreationInput_ValidateWithContext(T *testing.T) {
 	T.Parallel()
 
@@ -55,6 +68,48 @@ func TestHouseholdUpdateInput_ValidateWithContext(T *testing.T) {
 	})
 }
 
+func TestHouseholdBillingStatusUpdateInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &HouseholdBillingStatusUpdateInput{
+			OccurredAt:    time.Now(),
+			HouseholdID:   t.Name(),
+			BillingStatus: PaidHouseholdBillingStatus,
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with unknown billing status", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &HouseholdBillingStatusUpdateInput{
+			OccurredAt:    time.Now(),
+			HouseholdID:   t.Name(),
+			BillingStatus: "trialing",
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("without occurrence time", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &HouseholdBillingStatusUpdateInput{
+			HouseholdID:   t.Name(),
+			BillingStatus: PaidHouseholdBillingStatus,
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
 func TestHouseholdCreationInputForNewUser(T *testing.T) {
 	T.Parallel()
 
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// LoginLockoutTriggeredEventType indicates a user or IP address was locked out after too many failed login attempts.
+	LoginLockoutTriggeredEventType ServiceEventType = "login_lockout_triggered"
+	// LoginLockoutClearedEventType indicates an admin cleared a login lockout.
+	LoginLockoutClearedEventType ServiceEventType = "login_lockout_cleared"
+)
+
+type (
+	// LoginAttemptRecord tracks recent failed login attempts for a user or IP address.
+	LoginAttemptRecord struct {
+		_ struct{} `json:"-"`
+
+		LockedUntil time.Time   `json:"lockedUntil"`
+		Failures    []time.Time `json:"failures"`
+		Lockouts    uint        `json:"lockouts"`
+	}
+
+	// LoginLockoutClearanceInput represents what an admin provides to clear a login lockout.
+	LoginLockoutClearanceInput struct {
+		_ struct{} `json:"-"`
+
+		TargetUserID string `json:"targetUserID"`
+		IPAddress    string `json:"ipAddress"`
+	}
+)
+
+var _ validation.ValidatableWithContext = (*LoginLockoutClearanceInput)(nil)
+
+// ValidateWithContext validates a LoginLockoutClearanceInput.
+func (x *LoginLockoutClearanceInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.TargetUserID, validation.When(x.IPAddress == "", validation.Required)),
+		validation.Field(&x.IPAddress, validation.When(x.TargetUserID == "", validation.Required)),
+	)
+}
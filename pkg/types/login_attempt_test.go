@@ -0,0 +1,43 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoginLockoutClearanceInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &LoginLockoutClearanceInput{
+			TargetUserID: t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with IP address", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &LoginLockoutClearanceInput{
+			IPAddress: "127.0.0.1",
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with neither", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &LoginLockoutClearanceInput{}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
@@ -49,17 +49,18 @@ func init() {
 	Meal struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt                time.Time        `json:"createdAt"`
-		ArchivedAt               *time.Time       `json:"archivedAt"`
-		LastUpdatedAt            *time.Time       `json:"lastUpdatedAt"`
-		MaximumEstimatedPortions *float32         `json:"maximumEstimatedPortions"`
-		ID                       string           `json:"id"`
-		Description              string           `json:"description"`
-		CreatedByUser            string           `json:"createdByUser"`
-		Name                     string           `json:"name"`
-		Components               []*MealComponent `json:"components"`
-		MinimumEstimatedPortions float32          `json:"minimumEstimatedPortions"`
-		EligibleForMealPlans     bool             `json:"elibigleForMealPlans"`
+		CreatedAt                time.Time         `json:"createdAt"`
+		ArchivedAt               *time.Time        `json:"archivedAt"`
+		LastUpdatedAt            *time.Time        `json:"lastUpdatedAt"`
+		MaximumEstimatedPortions *float32          `json:"maximumEstimatedPortions"`
+		Nutrition                *NutritionSummary `json:"nutrition,omitempty"`
+		ID                       string            `json:"id"`
+		Description              string            `json:"description"`
+		CreatedByUser            string            `json:"createdByUser"`
+		Name                     string            `json:"name"`
+		Components               []*MealComponent  `json:"components"`
+		MinimumEstimatedPortions float32           `json:"minimumEstimatedPortions"`
+		EligibleForMealPlans     bool              `json:"elibigleForMealPlans"`
 	}
 
 	// MealComponent is a recipe with some extra data attached to it.
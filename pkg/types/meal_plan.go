@@ -14,6 +14,10 @@
 	MealPlanElectionMethodSchulze = "schulze"
 	// MealPlanElectionMethodInstantRunoff is used to denote the Instant Runoff election method.
 	MealPlanElectionMethodInstantRunoff = "instant-runoff"
+	// MealPlanElectionMethodPlurality is used to denote the plurality election method.
+	MealPlanElectionMethodPlurality = "plurality"
+	// MealPlanElectionMethodBorda is used to denote the Borda count election method.
+	MealPlanElectionMethodBorda = "borda"
 
 	// MealPlanCreatedCustomerEventType indicates a meal plan was created.
 	MealPlanCreatedCustomerEventType ServiceEventType = "meal_plan_created"
@@ -145,7 +149,12 @@ func (x *MealPlanCreationRequestInput) ValidateWithContext(ctx context.Context)
 		x,
 		validation.Field(&x.VotingDeadline, validation.Required),
 		validation.Field(&x.Events, validation.Required),
-		validation.Field(&x.ElectionMethod, validation.In(MealPlanElectionMethodSchulze, MealPlanElectionMethodInstantRunoff)),
+		validation.Field(&x.ElectionMethod, validation.In(
+			MealPlanElectionMethodSchulze,
+			MealPlanElectionMethodInstantRunoff,
+			MealPlanElectionMethodPlurality,
+			MealPlanElectionMethodBorda,
+		)),
 	)
 }
 
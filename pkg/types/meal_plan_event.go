@@ -108,6 +108,8 @@ func init() {
 		ReadHandler(http.ResponseWriter, *http.Request)
 		UpdateHandler(http.ResponseWriter, *http.Request)
 		ArchiveHandler(http.ResponseWriter, *http.Request)
+		ResultsHandler(http.ResponseWriter, *http.Request)
+		TimelineHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
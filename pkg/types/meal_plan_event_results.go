@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/gob"
+)
+
+func init() {
+	gob.Register(new(MealPlanEventResults))
+}
+
+type (
+	// MealPlanEventResults represents the full tally of the votes cast for a meal plan event's options.
+	MealPlanEventResults struct {
+		_ struct{} `json:"-"`
+
+		Schulze         *SchulzeElectionResults       `json:"schulze,omitempty"`
+		InstantRunoff   *InstantRunoffElectionResults `json:"instantRunoff,omitempty"`
+		Plurality       *PluralityElectionResults     `json:"plurality,omitempty"`
+		Borda           *BordaElectionResults         `json:"borda,omitempty"`
+		MealPlanEventID string                        `json:"mealPlanEventID"`
+		ElectionMethod  string                        `json:"electionMethod"`
+		ChosenOption    string                        `json:"chosenOption"`
+		Candidates      []string                      `json:"candidates"`
+		Winners         []string                      `json:"winners"`
+		BallotCount     int                           `json:"ballotCount"`
+		Tie             bool                          `json:"tie"`
+		TieBroken       bool                          `json:"tieBroken"`
+	}
+
+	// SchulzeElectionResults represents the intermediate results of a Schulze election.
+	SchulzeElectionResults struct {
+		_ struct{} `json:"-"`
+
+		// PairwisePreferences maps each option to the number of ballots that preferred it over every other option.
+		PairwisePreferences map[string]map[string]int `json:"pairwisePreferences"`
+		// StrongestPaths maps each option to the strength of the strongest path from it to every other option.
+		StrongestPaths map[string]map[string]int `json:"strongestPaths"`
+	}
+
+	// InstantRunoffElectionResults represents the intermediate results of an instant-runoff election.
+	InstantRunoffElectionResults struct {
+		_ struct{} `json:"-"`
+
+		Rounds []*InstantRunoffRound `json:"rounds"`
+	}
+
+	// InstantRunoffRound represents a single round of counting in an instant-runoff election.
+	InstantRunoffRound struct {
+		_ struct{} `json:"-"`
+
+		Tallies          map[string]int `json:"tallies"`
+		Eliminated       []string       `json:"eliminated"`
+		Round            int            `json:"round"`
+		ExhaustedBallots int            `json:"exhaustedBallots"`
+	}
+
+	// PluralityElectionResults represents the intermediate results of a plurality election.
+	PluralityElectionResults struct {
+		_ struct{} `json:"-"`
+
+		// FirstPreferences maps each option to the number of ballots that ranked it first.
+		FirstPreferences map[string]int `json:"firstPreferences"`
+	}
+
+	// BordaElectionResults represents the intermediate results of a Borda count election.
+	BordaElectionResults struct {
+		_ struct{} `json:"-"`
+
+		// Points maps each option to the number of points it was awarded across all ballots.
+		Points map[string]int `json:"points"`
+	}
+)
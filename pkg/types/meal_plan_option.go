@@ -40,6 +40,7 @@ func init() {
 		BelongsToMealPlanEvent string                `json:"belongsToMealPlanEvent"`
 		ID                     string                `json:"id"`
 		Votes                  []*MealPlanOptionVote `json:"votes"`
+		DietaryConflicts       []*DietaryConflict    `json:"dietaryConflicts,omitempty"`
 		Meal                   Meal                  `json:"meal"`
 		MealScale              float32               `json:"mealScale"`
 		Chosen                 bool                  `json:"chosen"`
@@ -100,6 +101,7 @@ func init() {
 		ReadHandler(http.ResponseWriter, *http.Request)
 		UpdateHandler(http.ResponseWriter, *http.Request)
 		ArchiveHandler(http.ResponseWriter, *http.Request)
+		DietaryConflictsHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
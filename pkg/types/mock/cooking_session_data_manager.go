@@ -0,0 +1,56 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.CookingSessionDataManager = (*CookingSessionDataManagerMock)(nil)
+
+// CookingSessionDataManagerMock is a mocked types.CookingSessionDataManager for testing.
+type CookingSessionDataManagerMock struct {
+	mock.Mock
+}
+
+// GetCookingSession is a mock function.
+func (m *CookingSessionDataManagerMock) GetCookingSession(ctx context.Context, cookingSessionID, householdID string) (*types.CookingSession, error) {
+	args := m.Called(ctx, cookingSessionID, householdID)
+	return args.Get(0).(*types.CookingSession), args.Error(1)
+}
+
+// GetCookingSessionForUpdate is a mock function.
+func (m *CookingSessionDataManagerMock) GetCookingSessionForUpdate(ctx context.Context, cookingSessionID, householdID string) (*types.CookingSession, error) {
+	args := m.Called(ctx, cookingSessionID, householdID)
+	return args.Get(0).(*types.CookingSession), args.Error(1)
+}
+
+// GetCookingSessions is a mock function.
+func (m *CookingSessionDataManagerMock) GetCookingSessions(ctx context.Context, householdID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.CookingSession], error) {
+	args := m.Called(ctx, householdID, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.CookingSession]), args.Error(1)
+}
+
+// CreateCookingSession is a mock function.
+func (m *CookingSessionDataManagerMock) CreateCookingSession(ctx context.Context, input *types.CookingSessionDatabaseCreationInput) (*types.CookingSession, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.CookingSession), args.Error(1)
+}
+
+// CreateCookingSessionProgressEntry is a mock function.
+func (m *CookingSessionDataManagerMock) CreateCookingSessionProgressEntry(ctx context.Context, input *types.CookingSessionProgressDatabaseCreationInput) (*types.CookingSessionProgressEntry, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.CookingSessionProgressEntry), args.Error(1)
+}
+
+// MarkCookingSessionAsCompleted is a mock function.
+func (m *CookingSessionDataManagerMock) MarkCookingSessionAsCompleted(ctx context.Context, cookingSessionID, householdID string) error {
+	return m.Called(ctx, cookingSessionID, householdID).Error(0)
+}
+
+// ArchiveCookingSession is a mock function.
+func (m *CookingSessionDataManagerMock) ArchiveCookingSession(ctx context.Context, cookingSessionID, householdID string) error {
+	return m.Called(ctx, cookingSessionID, householdID).Error(0)
+}
@@ -0,0 +1,44 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.DeadLetteredMessageDataManager = (*DeadLetteredMessageDataManagerMock)(nil)
+
+// DeadLetteredMessageDataManagerMock is a mocked types.DeadLetteredMessageDataManager for testing.
+type DeadLetteredMessageDataManagerMock struct {
+	mock.Mock
+}
+
+// GetDeadLetteredMessage satisfies our DeadLetteredMessageDataManagerMock interface.
+func (m *DeadLetteredMessageDataManagerMock) GetDeadLetteredMessage(ctx context.Context, deadLetteredMessageID string) (*types.DeadLetteredMessage, error) {
+	args := m.Called(ctx, deadLetteredMessageID)
+	return args.Get(0).(*types.DeadLetteredMessage), args.Error(1)
+}
+
+// GetDeadLetteredMessages satisfies our DeadLetteredMessageDataManagerMock interface.
+func (m *DeadLetteredMessageDataManagerMock) GetDeadLetteredMessages(ctx context.Context, filter *types.QueryFilter) (*types.QueryFilteredResult[types.DeadLetteredMessage], error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.DeadLetteredMessage]), args.Error(1)
+}
+
+// CreateDeadLetteredMessage satisfies our DeadLetteredMessageDataManagerMock interface.
+func (m *DeadLetteredMessageDataManagerMock) CreateDeadLetteredMessage(ctx context.Context, input *types.DeadLetteredMessageDatabaseCreationInput) (*types.DeadLetteredMessage, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.DeadLetteredMessage), args.Error(1)
+}
+
+// MarkDeadLetteredMessageAsReplayed satisfies our DeadLetteredMessageDataManagerMock interface.
+func (m *DeadLetteredMessageDataManagerMock) MarkDeadLetteredMessageAsReplayed(ctx context.Context, deadLetteredMessageID string) error {
+	return m.Called(ctx, deadLetteredMessageID).Error(0)
+}
+
+// UnmarkDeadLetteredMessageAsReplayed satisfies our DeadLetteredMessageDataManagerMock interface.
+func (m *DeadLetteredMessageDataManagerMock) UnmarkDeadLetteredMessageAsReplayed(ctx context.Context, deadLetteredMessageID string) error {
+	return m.Called(ctx, deadLetteredMessageID).Error(0)
+}
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/dinnerdonebetter/backend/pkg/types"
 
@@ -53,3 +54,13 @@ func (m *HouseholdDataManagerMock) UpdateHousehold(ctx context.Context, updated
 func (m *HouseholdDataManagerMock) ArchiveHousehold(ctx context.Context, householdID, userID string) error {
 	return m.Called(ctx, householdID, userID).Error(0)
 }
+
+// RotateHouseholdWebhookEncryptionKey is a mock function.
+func (m *HouseholdDataManagerMock) RotateHouseholdWebhookEncryptionKey(ctx context.Context, householdID, userID, newKey string, previousKeyExpiresAt time.Time) error {
+	return m.Called(ctx, householdID, userID, newKey, previousKeyExpiresAt).Error(0)
+}
+
+// UpdateHouseholdBillingStatus is a mock function.
+func (m *HouseholdDataManagerMock) UpdateHouseholdBillingStatus(ctx context.Context, input *types.HouseholdBillingStatusUpdateInput) error {
+	return m.Called(ctx, input).Error(0)
+}
@@ -0,0 +1,34 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.HouseholdEventDataManager = (*HouseholdEventDataManagerMock)(nil)
+
+// HouseholdEventDataManagerMock is a mocked types.HouseholdEventDataManager for testing.
+type HouseholdEventDataManagerMock struct {
+	mock.Mock
+}
+
+// GetHouseholdEvents satisfies our HouseholdEventDataManagerMock interface.
+func (m *HouseholdEventDataManagerMock) GetHouseholdEvents(ctx context.Context, householdID string, after types.HouseholdEventCursor, limit uint16) ([]*types.HouseholdEvent, error) {
+	args := m.Called(ctx, householdID, after, limit)
+	return args.Get(0).([]*types.HouseholdEvent), args.Error(1)
+}
+
+// GetHouseholdEventHorizon satisfies our HouseholdEventDataManagerMock interface.
+func (m *HouseholdEventDataManagerMock) GetHouseholdEventHorizon(ctx context.Context) (types.HouseholdEventCursor, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(types.HouseholdEventCursor), args.Error(1)
+}
+
+// HouseholdEventCursorIsRetained satisfies our HouseholdEventDataManagerMock interface.
+func (m *HouseholdEventDataManagerMock) HouseholdEventCursorIsRetained(ctx context.Context, householdID string, cursor types.HouseholdEventCursor) (bool, error) {
+	args := m.Called(ctx, householdID, cursor)
+	return args.Bool(0), args.Error(1)
+}
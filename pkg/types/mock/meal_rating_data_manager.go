@@ -33,6 +33,12 @@ func (m *RecipeRatingDataManagerMock) GetRecipeRatings(ctx context.Context, filt
 	return args.Get(0).(*types.QueryFilteredResult[types.RecipeRating]), args.Error(1)
 }
 
+// GetRecipeRatingsForHousehold is a mock function.
+func (m *RecipeRatingDataManagerMock) GetRecipeRatingsForHousehold(ctx context.Context, householdID string) ([]*types.RecipeRating, error) {
+	args := m.Called(ctx, householdID)
+	return args.Get(0).([]*types.RecipeRating), args.Error(1)
+}
+
 // CreateRecipeRating is a mock function.
 func (m *RecipeRatingDataManagerMock) CreateRecipeRating(ctx context.Context, input *types.RecipeRatingDatabaseCreationInput) (*types.RecipeRating, error) {
 	args := m.Called(ctx, input)
@@ -0,0 +1,51 @@
+package mocktypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.OutboxMessageDataManager = (*OutboxMessageDataManagerMock)(nil)
+
+// OutboxMessageDataManagerMock is a mocked types.OutboxMessageDataManager for testing.
+type OutboxMessageDataManagerMock struct {
+	mock.Mock
+}
+
+// CreateOutboxMessage satisfies our OutboxMessageDataManagerMock interface.
+func (m *OutboxMessageDataManagerMock) CreateOutboxMessage(ctx context.Context, input *types.OutboxMessageDatabaseCreationInput) (*types.OutboxMessage, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.OutboxMessage), args.Error(1)
+}
+
+// ClaimPendingOutboxMessages satisfies our OutboxMessageDataManagerMock interface.
+func (m *OutboxMessageDataManagerMock) ClaimPendingOutboxMessages(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*types.OutboxMessage, error) {
+	args := m.Called(ctx, batchSize, claimDuration)
+	return args.Get(0).([]*types.OutboxMessage), args.Error(1)
+}
+
+// MarkOutboxMessageAsPublished satisfies our OutboxMessageDataManagerMock interface.
+func (m *OutboxMessageDataManagerMock) MarkOutboxMessageAsPublished(ctx context.Context, outboxMessageID string) error {
+	return m.Called(ctx, outboxMessageID).Error(0)
+}
+
+// MarkOutboxMessageAsFailed satisfies our OutboxMessageDataManagerMock interface.
+func (m *OutboxMessageDataManagerMock) MarkOutboxMessageAsFailed(ctx context.Context, outboxMessageID, reason string) error {
+	return m.Called(ctx, outboxMessageID, reason).Error(0)
+}
+
+// GetOutboxLag satisfies our OutboxMessageDataManagerMock interface.
+func (m *OutboxMessageDataManagerMock) GetOutboxLag(ctx context.Context) (*types.OutboxLag, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*types.OutboxLag), args.Error(1)
+}
+
+// PrunePublishedOutboxMessages satisfies our OutboxMessageDataManagerMock interface.
+func (m *OutboxMessageDataManagerMock) PrunePublishedOutboxMessages(ctx context.Context, publishedBefore time.Time) (int64, error) {
+	args := m.Called(ctx, publishedBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
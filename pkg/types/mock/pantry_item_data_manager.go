@@ -0,0 +1,68 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.PantryItemDataManager = (*PantryItemDataManagerMock)(nil)
+
+// PantryItemDataManagerMock is a mocked types.PantryItemDataManager for testing.
+type PantryItemDataManagerMock struct {
+	mock.Mock
+}
+
+// PantryItemExists is a mock function.
+func (m *PantryItemDataManagerMock) PantryItemExists(ctx context.Context, pantryItemID, householdID string) (bool, error) {
+	args := m.Called(ctx, pantryItemID, householdID)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetPantryItem is a mock function.
+func (m *PantryItemDataManagerMock) GetPantryItem(ctx context.Context, pantryItemID, householdID string) (*types.PantryItem, error) {
+	args := m.Called(ctx, pantryItemID, householdID)
+	return args.Get(0).(*types.PantryItem), args.Error(1)
+}
+
+// GetPantryItems is a mock function.
+func (m *PantryItemDataManagerMock) GetPantryItems(ctx context.Context, householdID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.PantryItem], error) {
+	args := m.Called(ctx, householdID, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.PantryItem]), args.Error(1)
+}
+
+// GetUnexpiredPantryItemsForHousehold is a mock function.
+func (m *PantryItemDataManagerMock) GetUnexpiredPantryItemsForHousehold(ctx context.Context, householdID string) ([]*types.PantryItem, error) {
+	args := m.Called(ctx, householdID)
+	return args.Get(0).([]*types.PantryItem), args.Error(1)
+}
+
+// CreatePantryItem is a mock function.
+func (m *PantryItemDataManagerMock) CreatePantryItem(ctx context.Context, input *types.PantryItemDatabaseCreationInput) (*types.PantryItem, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.PantryItem), args.Error(1)
+}
+
+// CreatePantryItemForGroceryListItem is a mock function.
+func (m *PantryItemDataManagerMock) CreatePantryItemForGroceryListItem(ctx context.Context, input *types.PantryItemDatabaseCreationInput) (*types.PantryItem, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.PantryItem), args.Error(1)
+}
+
+// UpdatePantryItem is a mock function.
+func (m *PantryItemDataManagerMock) UpdatePantryItem(ctx context.Context, updated *types.PantryItem) error {
+	return m.Called(ctx, updated).Error(0)
+}
+
+// ArchivePantryItem is a mock function.
+func (m *PantryItemDataManagerMock) ArchivePantryItem(ctx context.Context, pantryItemID, householdID string) error {
+	return m.Called(ctx, pantryItemID, householdID).Error(0)
+}
+
+// ArchivePantryItemForGroceryListItem is a mock function.
+func (m *PantryItemDataManagerMock) ArchivePantryItemForGroceryListItem(ctx context.Context, groceryListItemID, householdID string) (string, error) {
+	args := m.Called(ctx, groceryListItemID, householdID)
+	return args.String(0), args.Error(1)
+}
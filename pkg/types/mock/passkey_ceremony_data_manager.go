@@ -0,0 +1,28 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.PasskeyCeremonyDataManager = (*PasskeyCeremonyDataManagerMock)(nil)
+
+// PasskeyCeremonyDataManagerMock is a mocked types.PasskeyCeremonyDataManager for testing.
+type PasskeyCeremonyDataManagerMock struct {
+	mock.Mock
+}
+
+// CreatePasskeyCeremony implements our interface requirements.
+func (m *PasskeyCeremonyDataManagerMock) CreatePasskeyCeremony(ctx context.Context, input *types.PasskeyCeremonyDatabaseCreationInput) error {
+	return m.Called(ctx, input).Error(0)
+}
+
+// ConsumePasskeyCeremony implements our interface requirements.
+func (m *PasskeyCeremonyDataManagerMock) ConsumePasskeyCeremony(ctx context.Context, ceremonyID string) ([]byte, error) {
+	args := m.Called(ctx, ceremonyID)
+
+	return args.Get(0).([]byte), args.Error(1)
+}
@@ -39,6 +39,18 @@ func (m *RecipeDataManagerMock) GetRecipes(ctx context.Context, filter *types.Qu
 	return args.Get(0).(*types.QueryFilteredResult[types.Recipe]), args.Error(1)
 }
 
+// GetCookableRecipes is a mock function.
+func (m *RecipeDataManagerMock) GetCookableRecipes(ctx context.Context, householdID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.Recipe], error) {
+	args := m.Called(ctx, householdID, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.Recipe]), args.Error(1)
+}
+
+// SearchForCookableRecipes is a mock function.
+func (m *RecipeDataManagerMock) SearchForCookableRecipes(ctx context.Context, query, householdID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.Recipe], error) {
+	args := m.Called(ctx, query, householdID, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.Recipe]), args.Error(1)
+}
+
 // CreateRecipe is a mock function.
 func (m *RecipeDataManagerMock) CreateRecipe(ctx context.Context, input *types.RecipeDatabaseCreationInput) (*types.Recipe, error) {
 	args := m.Called(ctx, input)
@@ -0,0 +1,40 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.UserPasskeyDataManager = (*UserPasskeyDataManagerMock)(nil)
+
+// UserPasskeyDataManagerMock is a mocked types.UserPasskeyDataManager for testing.
+type UserPasskeyDataManagerMock struct {
+	mock.Mock
+}
+
+// GetUserPasskeysForUser implements our interface requirements.
+func (m *UserPasskeyDataManagerMock) GetUserPasskeysForUser(ctx context.Context, userID string) ([]*types.UserPasskey, error) {
+	args := m.Called(ctx, userID)
+
+	return args.Get(0).([]*types.UserPasskey), args.Error(1)
+}
+
+// CreateUserPasskey implements our interface requirements.
+func (m *UserPasskeyDataManagerMock) CreateUserPasskey(ctx context.Context, input *types.UserPasskeyDatabaseCreationInput) (*types.UserPasskey, error) {
+	args := m.Called(ctx, input)
+
+	return args.Get(0).(*types.UserPasskey), args.Error(1)
+}
+
+// MarkUserPasskeyAsUsed implements our interface requirements.
+func (m *UserPasskeyDataManagerMock) MarkUserPasskeyAsUsed(ctx context.Context, passkeyID string, signCount uint32, backupState bool) error {
+	return m.Called(ctx, passkeyID, signCount, backupState).Error(0)
+}
+
+// ArchiveUserPasskey implements our interface requirements.
+func (m *UserPasskeyDataManagerMock) ArchiveUserPasskey(ctx context.Context, userID, passkeyID string) error {
+	return m.Called(ctx, userID, passkeyID).Error(0)
+}
@@ -0,0 +1,28 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.UserRecoveryCodeDataManager = (*UserRecoveryCodeDataManagerMock)(nil)
+
+// UserRecoveryCodeDataManagerMock is a mocked types.UserRecoveryCodeDataManager for testing.
+type UserRecoveryCodeDataManagerMock struct {
+	mock.Mock
+}
+
+// CreateUserRecoveryCodes implements our interface requirements.
+func (m *UserRecoveryCodeDataManagerMock) CreateUserRecoveryCodes(ctx context.Context, userID string, hashedCodes []string) error {
+	return m.Called(ctx, userID, hashedCodes).Error(0)
+}
+
+// RedeemUserRecoveryCode implements our interface requirements.
+func (m *UserRecoveryCodeDataManagerMock) RedeemUserRecoveryCode(ctx context.Context, userID, hashedCode string) (bool, error) {
+	args := m.Called(ctx, userID, hashedCode)
+
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,39 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.ValidIngredientNutritionDataManager = (*ValidIngredientNutritionDataManagerMock)(nil)
+
+// ValidIngredientNutritionDataManagerMock is a mocked types.ValidIngredientNutritionDataManager for testing.
+type ValidIngredientNutritionDataManagerMock struct {
+	mock.Mock
+}
+
+// GetValidIngredientNutrition is a mock function.
+func (m *ValidIngredientNutritionDataManagerMock) GetValidIngredientNutrition(ctx context.Context, validIngredientID string) (*types.ValidIngredientNutrition, error) {
+	args := m.Called(ctx, validIngredientID)
+	return args.Get(0).(*types.ValidIngredientNutrition), args.Error(1)
+}
+
+// GetValidIngredientNutritionForIngredients is a mock function.
+func (m *ValidIngredientNutritionDataManagerMock) GetValidIngredientNutritionForIngredients(ctx context.Context, validIngredientIDs []string) ([]*types.ValidIngredientNutrition, error) {
+	args := m.Called(ctx, validIngredientIDs)
+	return args.Get(0).([]*types.ValidIngredientNutrition), args.Error(1)
+}
+
+// UpsertValidIngredientNutrition is a mock function.
+func (m *ValidIngredientNutritionDataManagerMock) UpsertValidIngredientNutrition(ctx context.Context, input *types.ValidIngredientNutritionDatabaseCreationInput) (*types.ValidIngredientNutrition, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.ValidIngredientNutrition), args.Error(1)
+}
+
+// ArchiveValidIngredientNutrition is a mock function.
+func (m *ValidIngredientNutritionDataManagerMock) ArchiveValidIngredientNutrition(ctx context.Context, validIngredientID string) error {
+	return m.Called(ctx, validIngredientID).Error(0)
+}
@@ -0,0 +1,62 @@
+package mocktypes
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.ValidIngredientSubstitutionDataManager = (*ValidIngredientSubstitutionDataManagerMock)(nil)
+
+// ValidIngredientSubstitutionDataManagerMock is a mocked types.ValidIngredientSubstitutionDataManager for testing.
+type ValidIngredientSubstitutionDataManagerMock struct {
+	mock.Mock
+}
+
+// ValidIngredientSubstitutionExists is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) ValidIngredientSubstitutionExists(ctx context.Context, validIngredientSubstitutionID string) (bool, error) {
+	args := m.Called(ctx, validIngredientSubstitutionID)
+	return args.Bool(0), args.Error(1)
+}
+
+// GetValidIngredientSubstitution is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) GetValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) (*types.ValidIngredientSubstitution, error) {
+	args := m.Called(ctx, validIngredientSubstitutionID)
+	return args.Get(0).(*types.ValidIngredientSubstitution), args.Error(1)
+}
+
+// GetValidIngredientSubstitutions is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) GetValidIngredientSubstitutions(ctx context.Context, filter *types.QueryFilter) (*types.QueryFilteredResult[types.ValidIngredientSubstitution], error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.ValidIngredientSubstitution]), args.Error(1)
+}
+
+// GetValidIngredientSubstitutionsForIngredient is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) GetValidIngredientSubstitutionsForIngredient(ctx context.Context, ingredientID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.ValidIngredientSubstitution], error) {
+	args := m.Called(ctx, ingredientID, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.ValidIngredientSubstitution]), args.Error(1)
+}
+
+// GetValidIngredientSubstitutionsForIngredients is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) GetValidIngredientSubstitutionsForIngredients(ctx context.Context, ingredientIDs []string) ([]*types.ValidIngredientSubstitution, error) {
+	args := m.Called(ctx, ingredientIDs)
+	return args.Get(0).([]*types.ValidIngredientSubstitution), args.Error(1)
+}
+
+// CreateValidIngredientSubstitution is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) CreateValidIngredientSubstitution(ctx context.Context, input *types.ValidIngredientSubstitutionDatabaseCreationInput) (*types.ValidIngredientSubstitution, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.ValidIngredientSubstitution), args.Error(1)
+}
+
+// UpdateValidIngredientSubstitution is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) UpdateValidIngredientSubstitution(ctx context.Context, updated *types.ValidIngredientSubstitution) error {
+	return m.Called(ctx, updated).Error(0)
+}
+
+// ArchiveValidIngredientSubstitution is a mock function.
+func (m *ValidIngredientSubstitutionDataManagerMock) ArchiveValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) error {
+	return m.Called(ctx, validIngredientSubstitutionID).Error(0)
+}
@@ -0,0 +1,62 @@
+package mocktypes
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/backend/pkg/types"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ types.WebhookDeliveryDataManager = (*WebhookDeliveryDataManagerMock)(nil)
+
+// WebhookDeliveryDataManagerMock is a mocked types.WebhookDeliveryDataManager for testing.
+type WebhookDeliveryDataManagerMock struct {
+	mock.Mock
+}
+
+// GetWebhookDelivery satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) GetWebhookDelivery(ctx context.Context, webhookID, webhookDeliveryID string) (*types.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, webhookDeliveryID)
+	return args.Get(0).(*types.WebhookDelivery), args.Error(1)
+}
+
+// GetWebhookDeliveries satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) GetWebhookDeliveries(ctx context.Context, webhookID string, filter *types.QueryFilter) (*types.QueryFilteredResult[types.WebhookDelivery], error) {
+	args := m.Called(ctx, webhookID, filter)
+	return args.Get(0).(*types.QueryFilteredResult[types.WebhookDelivery]), args.Error(1)
+}
+
+// CreateWebhookDelivery satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) CreateWebhookDelivery(ctx context.Context, input *types.WebhookDeliveryDatabaseCreationInput) (*types.WebhookDelivery, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*types.WebhookDelivery), args.Error(1)
+}
+
+// RecordWebhookDeliverySuccess satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) RecordWebhookDeliverySuccess(ctx context.Context, webhookID string) error {
+	return m.Called(ctx, webhookID).Error(0)
+}
+
+// RecordWebhookDeliveryFailure satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) RecordWebhookDeliveryFailure(ctx context.Context, webhookID string) (uint32, error) {
+	args := m.Called(ctx, webhookID)
+	return args.Get(0).(uint32), args.Error(1)
+}
+
+// DisableWebhook satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) DisableWebhook(ctx context.Context, webhookID string) error {
+	return m.Called(ctx, webhookID).Error(0)
+}
+
+// ClaimDueWebhookDeliveryRetries satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) ClaimDueWebhookDeliveryRetries(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*types.WebhookDeliveryRetry, error) {
+	args := m.Called(ctx, batchSize, claimDuration)
+	return args.Get(0).([]*types.WebhookDeliveryRetry), args.Error(1)
+}
+
+// MarkWebhookDeliveryAsRetried satisfies our WebhookDeliveryDataManagerMock interface.
+func (m *WebhookDeliveryDataManagerMock) MarkWebhookDeliveryAsRetried(ctx context.Context, webhookDeliveryID string) error {
+	return m.Called(ctx, webhookDeliveryID).Error(0)
+}
@@ -2,10 +2,14 @@
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
 const (
@@ -20,32 +24,41 @@
 	OAuth2Client struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt    time.Time  `json:"createdAt"`
-		ArchivedAt   *time.Time `json:"archivedAt"`
-		Name         string     `json:"name"`
-		Description  string     `json:"description"`
-		ClientID     string     `json:"clientID"`
-		ID           string     `json:"id"`
-		ClientSecret string     `json:"clientSecret"`
+		CreatedAt          time.Time  `json:"createdAt"`
+		ArchivedAt         *time.Time `json:"archivedAt"`
+		BelongsToHousehold *string    `json:"belongsToHousehold"`
+		Name               string     `json:"name"`
+		Description        string     `json:"description"`
+		ClientID           string     `json:"clientID"`
+		ID                 string     `json:"id"`
+		ClientSecret       string     `json:"clientSecret"`
+		Domain             string     `json:"domain"`
+		Scopes             []string   `json:"scopes"`
 	}
 
 	// OAuth2ClientCreationRequestInput is a struct for use when creating OAuth2 clients.
 	OAuth2ClientCreationRequestInput struct {
 		_ struct{} `json:"-"`
 
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		BelongsToHousehold *string  `json:"belongsToHousehold"`
+		Name               string   `json:"name"`
+		Description        string   `json:"description"`
+		Domain             string   `json:"domain"`
+		Scopes             []string `json:"scopes"`
 	}
 
 	// OAuth2ClientDatabaseCreationInput is a struct for use when creating OAuth2 clients.
 	OAuth2ClientDatabaseCreationInput struct {
 		_ struct{} `json:"-"`
 
-		ID           string
-		Name         string
-		Description  string
-		ClientID     string
-		ClientSecret string
+		BelongsToHousehold *string
+		ID                 string
+		Name               string
+		Description        string
+		ClientID           string
+		ClientSecret       string
+		Domain             string
+		Scopes             []string
 	}
 
 	// OAuth2ClientCreationResponse is a struct for informing users of what their OAuth2 client's secret key is.
@@ -59,6 +72,40 @@
 		ID           string `json:"id"`
 	}
 
+	// OAuth2ConsentScope describes a scope an OAuth2 client is requesting.
+	OAuth2ConsentScope struct {
+		_ struct{} `json:"-"`
+
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Permissions []string `json:"permissions"`
+	}
+
+	// OAuth2ConsentScreen is what we show a user before they authorize an OAuth2 client.
+	OAuth2ConsentScreen struct {
+		_ struct{} `json:"-"`
+
+		BelongsToHousehold *string               `json:"belongsToHousehold"`
+		ClientID           string                `json:"clientID"`
+		ClientName         string                `json:"clientName"`
+		ClientDescription  string                `json:"clientDescription"`
+		ClientDomain       string                `json:"clientDomain"`
+		Scopes             []*OAuth2ConsentScope `json:"scopes"`
+	}
+
+	// OAuth2TokenIntrospectionResponse describes an OAuth2 token, per RFC 7662.
+	OAuth2TokenIntrospectionResponse struct {
+		_ struct{} `json:"-"`
+
+		Scope     string `json:"scope,omitempty"`
+		ClientID  string `json:"client_id,omitempty"`
+		Subject   string `json:"sub,omitempty"`
+		TokenType string `json:"token_type,omitempty"`
+		ExpiresAt int64  `json:"exp,omitempty"`
+		IssuedAt  int64  `json:"iat,omitempty"`
+		Active    bool   `json:"active"`
+	}
+
 	// OAuth2ClientDataManager handles OAuth2 clients.
 	OAuth2ClientDataManager interface {
 		GetOAuth2ClientByClientID(ctx context.Context, clientID string) (*OAuth2Client, error)
@@ -79,6 +126,9 @@
 	OAuth2Service interface {
 		AuthorizeHandler(res http.ResponseWriter, req *http.Request)
 		TokenHandler(res http.ResponseWriter, req *http.Request)
+		RevokeHandler(res http.ResponseWriter, req *http.Request)
+		IntrospectHandler(res http.ResponseWriter, req *http.Request)
+		ConsentHandler(res http.ResponseWriter, req *http.Request)
 	}
 )
 
@@ -86,5 +136,17 @@
 func (x *OAuth2ClientCreationRequestInput) ValidateWithContext(ctx context.Context) error {
 	return validation.ValidateStructWithContext(ctx, x,
 		validation.Field(&x.Name, validation.Required),
+		validation.Field(&x.Domain, is.URL),
+		validation.Field(&x.Scopes, validation.Required, validation.Each(validation.By(validateOAuth2Scope))),
 	)
 }
+
+var errInvalidOAuth2Scope = errors.New("invalid oauth2 scope")
+
+func validateOAuth2Scope(value any) error {
+	if scope, ok := value.(string); !ok || !authorization.IsValidOAuth2Scope(scope) {
+		return errInvalidOAuth2Scope
+	}
+
+	return nil
+}
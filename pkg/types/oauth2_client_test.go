@@ -4,6 +4,8 @@
 	"context"
 	"testing"
 
+	"github.com/dinnerdonebetter/backend/internal/authorization"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,9 +17,46 @@ func TestOAuth2ClientCreationRequestInput_ValidateWithContext(T *testing.T) {
 
 		ctx := context.Background()
 		x := &OAuth2ClientCreationRequestInput{
-			Name: t.Name(),
+			Name:   t.Name(),
+			Scopes: []string{string(authorization.ReadRecipesOAuth2Scope)},
 		}
 
 		assert.NoError(t, x.ValidateWithContext(ctx))
 	})
+
+	T.Run("with invalid domain", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:   t.Name(),
+			Domain: "not a domain",
+			Scopes: []string{string(authorization.ReadRecipesOAuth2Scope)},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("without scopes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &OAuth2ClientCreationRequestInput{
+			Name: t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid scope", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:   t.Name(),
+			Scopes: []string{"not_a_scope"},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
 }
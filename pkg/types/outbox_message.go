@@ -0,0 +1,55 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// OutboxMessage represents a queue message that was written alongside a domain change, and is waiting to be relayed.
+	OutboxMessage struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt          time.Time  `json:"createdAt"`
+		ClaimedUntil       *time.Time `json:"claimedUntil"`
+		PublishedAt        *time.Time `json:"publishedAt"`
+		BelongsToHousehold *string    `json:"belongsToHousehold"`
+		ID                 string     `json:"id"`
+		Topic              string     `json:"topic"`
+		IdempotencyKey     string     `json:"idempotencyKey"`
+		Payload            string     `json:"payload"`
+		EventType          string     `json:"eventType"`
+		LastError          string     `json:"lastError"`
+		Attempts           uint16     `json:"attempts"`
+	}
+
+	// OutboxMessageDatabaseCreationInput is used for recording an outbox message.
+	OutboxMessageDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		BelongsToHousehold *string
+		ID                 string
+		Topic              string
+		IdempotencyKey     string
+		Payload            string
+		EventType          string
+	}
+
+	// OutboxLag describes how far behind the outbox relay is.
+	OutboxLag struct {
+		_ struct{} `json:"-"`
+
+		PendingMessages  uint64        `json:"pendingMessages"`
+		OldestPendingAge time.Duration `json:"oldestPendingAge"`
+	}
+
+	// OutboxMessageDataManager describes a structure capable of storing outbox messages.
+	OutboxMessageDataManager interface {
+		CreateOutboxMessage(ctx context.Context, input *OutboxMessageDatabaseCreationInput) (*OutboxMessage, error)
+		ClaimPendingOutboxMessages(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*OutboxMessage, error)
+		MarkOutboxMessageAsPublished(ctx context.Context, outboxMessageID string) error
+		MarkOutboxMessageAsFailed(ctx context.Context, outboxMessageID, reason string) error
+		GetOutboxLag(ctx context.Context) (*OutboxLag, error)
+		PrunePublishedOutboxMessages(ctx context.Context, publishedBefore time.Time) (int64, error)
+	}
+)
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"context"
+	"encoding/gob"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// PantryItemStorageLocationPantry represents the database-side enum member for pantry storage locations.
+	PantryItemStorageLocationPantry = "pantry"
+	// PantryItemStorageLocationRefrigerator represents the database-side enum member for pantry storage locations.
+	PantryItemStorageLocationRefrigerator = "refrigerator"
+	// PantryItemStorageLocationFreezer represents the database-side enum member for pantry storage locations.
+	PantryItemStorageLocationFreezer = "freezer"
+	// PantryItemStorageLocationOther represents the database-side enum member for pantry storage locations.
+	PantryItemStorageLocationOther = "other"
+
+	// PantryItemCreatedCustomerEventType indicates a pantry item was created.
+	PantryItemCreatedCustomerEventType ServiceEventType = "pantry_item_created"
+	// PantryItemUpdatedCustomerEventType indicates a pantry item was updated.
+	PantryItemUpdatedCustomerEventType ServiceEventType = "pantry_item_updated"
+	// PantryItemArchivedCustomerEventType indicates a pantry item was archived.
+	PantryItemArchivedCustomerEventType ServiceEventType = "pantry_item_archived"
+)
+
+func init() {
+	gob.Register(new(PantryItem))
+	gob.Register(new(PantryItemCreationRequestInput))
+	gob.Register(new(PantryItemUpdateRequestInput))
+}
+
+type (
+	// PantryItem represents something a household has on hand.
+	PantryItem struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt          time.Time            `json:"createdAt"`
+		ExpiresAt          *time.Time           `json:"expiresAt"`
+		ArchivedAt         *time.Time           `json:"archivedAt"`
+		LastUpdatedAt      *time.Time           `json:"lastUpdatedAt"`
+		ID                 string               `json:"id"`
+		StorageLocation    string               `json:"storageLocation"`
+		Notes              string               `json:"notes"`
+		BelongsToHousehold string               `json:"belongsToHousehold"`
+		MeasurementUnit    ValidMeasurementUnit `json:"measurementUnit"`
+		Ingredient         ValidIngredient      `json:"ingredient"`
+		Quantity           float32              `json:"quantity"`
+	}
+
+	// PantryItemCreationRequestInput represents what a user could set as input for creating pantry items.
+	PantryItemCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt              *time.Time `json:"expiresAt"`
+		ValidIngredientID      string     `json:"validIngredientID"`
+		ValidMeasurementUnitID string     `json:"validMeasurementUnitID"`
+		StorageLocation        string     `json:"storageLocation"`
+		Notes                  string     `json:"notes"`
+		Quantity               float32    `json:"quantity"`
+	}
+
+	// PantryItemDatabaseCreationInput represents what a user could set as input for creating pantry items.
+	PantryItemDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt               *time.Time
+		ID                      string
+		ValidIngredientID       string
+		ValidMeasurementUnitID  string
+		StorageLocation         string
+		Notes                   string
+		BelongsToHousehold      string
+		SourceGroceryListItemID string
+		Quantity                float32
+	}
+
+	// PantryItemUpdateRequestInput represents what a user could set as input for updating pantry items.
+	PantryItemUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt              *time.Time `json:"expiresAt,omitempty"`
+		ValidIngredientID      *string    `json:"validIngredientID,omitempty"`
+		ValidMeasurementUnitID *string    `json:"validMeasurementUnitID,omitempty"`
+		StorageLocation        *string    `json:"storageLocation,omitempty"`
+		Notes                  *string    `json:"notes,omitempty"`
+		Quantity               *float32   `json:"quantity,omitempty"`
+	}
+
+	// PantryItemDataManager describes a structure capable of storing pantry items permanently.
+	PantryItemDataManager interface {
+		PantryItemExists(ctx context.Context, pantryItemID, householdID string) (bool, error)
+		GetPantryItem(ctx context.Context, pantryItemID, householdID string) (*PantryItem, error)
+		GetPantryItems(ctx context.Context, householdID string, filter *QueryFilter) (*QueryFilteredResult[PantryItem], error)
+		GetUnexpiredPantryItemsForHousehold(ctx context.Context, householdID string) ([]*PantryItem, error)
+		CreatePantryItem(ctx context.Context, input *PantryItemDatabaseCreationInput) (*PantryItem, error)
+		CreatePantryItemForGroceryListItem(ctx context.Context, input *PantryItemDatabaseCreationInput) (*PantryItem, error)
+		UpdatePantryItem(ctx context.Context, updated *PantryItem) error
+		ArchivePantryItem(ctx context.Context, pantryItemID, householdID string) error
+		ArchivePantryItemForGroceryListItem(ctx context.Context, groceryListItemID, householdID string) (string, error)
+	}
+
+	// PantryItemDataService describes a structure capable of serving traffic related to pantry items.
+	PantryItemDataService interface {
+		ListHandler(http.ResponseWriter, *http.Request)
+		CreateHandler(http.ResponseWriter, *http.Request)
+		ReadHandler(http.ResponseWriter, *http.Request)
+		UpdateHandler(http.ResponseWriter, *http.Request)
+		ArchiveHandler(http.ResponseWriter, *http.Request)
+	}
+)
+
+// Update merges a PantryItemUpdateRequestInput with a pantry item.
+func (x *PantryItem) Update(input *PantryItemUpdateRequestInput) {
+	if input.ExpiresAt != nil && (x.ExpiresAt == nil || !input.ExpiresAt.Equal(*x.ExpiresAt)) {
+		x.ExpiresAt = input.ExpiresAt
+	}
+
+	if input.ValidIngredientID != nil && *input.ValidIngredientID != x.Ingredient.ID {
+		x.Ingredient = ValidIngredient{ID: *input.ValidIngredientID}
+	}
+
+	if input.ValidMeasurementUnitID != nil && *input.ValidMeasurementUnitID != x.MeasurementUnit.ID {
+		x.MeasurementUnit = ValidMeasurementUnit{ID: *input.ValidMeasurementUnitID}
+	}
+
+	if input.StorageLocation != nil && *input.StorageLocation != x.StorageLocation {
+		x.StorageLocation = *input.StorageLocation
+	}
+
+	if input.Notes != nil && *input.Notes != x.Notes {
+		x.Notes = *input.Notes
+	}
+
+	if input.Quantity != nil && *input.Quantity != x.Quantity {
+		x.Quantity = *input.Quantity
+	}
+}
+
+var validPantryItemStorageLocations = []any{
+	PantryItemStorageLocationPantry,
+	PantryItemStorageLocationRefrigerator,
+	PantryItemStorageLocationFreezer,
+	PantryItemStorageLocationOther,
+}
+
+var _ validation.ValidatableWithContext = (*PantryItemCreationRequestInput)(nil)
+
+// ValidateWithContext validates a PantryItemCreationRequestInput.
+func (x *PantryItemCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.ValidMeasurementUnitID, validation.Required),
+		validation.Field(&x.Quantity, validation.Required, validation.Min(float32(0))),
+		validation.Field(&x.StorageLocation, validation.Required, validation.In(validPantryItemStorageLocations...)),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PantryItemDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a PantryItemDatabaseCreationInput.
+func (x *PantryItemDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.ValidMeasurementUnitID, validation.Required),
+		validation.Field(&x.BelongsToHousehold, validation.Required),
+		validation.Field(&x.Quantity, validation.Required, validation.Min(float32(0))),
+		validation.Field(&x.StorageLocation, validation.Required, validation.In(validPantryItemStorageLocations...)),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PantryItemUpdateRequestInput)(nil)
+
+// ValidateWithContext validates a PantryItemUpdateRequestInput.
+func (x *PantryItemUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Quantity, validation.Min(float32(0))),
+		validation.Field(&x.StorageLocation, validation.In(validPantryItemStorageLocations...)),
+	)
+}
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPantryItem_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &PantryItem{}
+		input := &PantryItemUpdateRequestInput{}
+
+		assert.NoError(t, fake.Struct(&input))
+
+		x.Update(input)
+	})
+}
+
+func TestPantryItemCreationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PantryItemCreationRequestInput{
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+			StorageLocation:        PantryItemStorageLocationFreezer,
+			Quantity:               1,
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid storage location", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PantryItemCreationRequestInput{
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+			StorageLocation:        "garage",
+			Quantity:               1,
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestPantryItemDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PantryItemDatabaseCreationInput{
+			ID:                     t.Name(),
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+			BelongsToHousehold:     t.Name(),
+			StorageLocation:        PantryItemStorageLocationPantry,
+			Quantity:               1,
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestPantryItemUpdateRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PantryItemUpdateRequestInput{
+			Quantity:        pointer.To[float32](1),
+			StorageLocation: pointer.To(PantryItemStorageLocationOther),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with negative quantity", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PantryItemUpdateRequestInput{
+			Quantity: pointer.To[float32](-1),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
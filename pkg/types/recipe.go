@@ -20,6 +20,9 @@
 	RecipeArchivedCustomerEventType ServiceEventType = "recipe_archived"
 	// RecipeClonedCustomerEventType indicates a recipe was cloned.
 	RecipeClonedCustomerEventType ServiceEventType = "recipe_cloned"
+
+	// RecipeScalingPortionsQueryKey is the query param key to specify how many portions a recipe should be scaled to.
+	RecipeScalingPortionsQueryKey = "portions"
 )
 
 func init() {
@@ -33,27 +36,29 @@ func init() {
 	Recipe struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt                time.Time         `json:"createdAt"`
-		InspiredByRecipeID       *string           `json:"inspiredByRecipeID"`
-		LastUpdatedAt            *time.Time        `json:"lastUpdatedAt"`
-		ArchivedAt               *time.Time        `json:"archivedAt"`
-		MaximumEstimatedPortions *float32          `json:"maximumEstimatedPortions"`
-		PluralPortionName        string            `json:"pluralPortionName"`
-		Description              string            `json:"description"`
-		Name                     string            `json:"name"`
-		PortionName              string            `json:"portionName"`
-		ID                       string            `json:"id"`
-		CreatedByUser            string            `json:"createdByUser"`
-		Source                   string            `json:"source"`
-		Slug                     string            `json:"slug"`
-		YieldsComponentType      string            `json:"yieldsComponentType"`
-		PrepTasks                []*RecipePrepTask `json:"prepTasks"`
-		Steps                    []*RecipeStep     `json:"steps"`
-		Media                    []*RecipeMedia    `json:"media"`
-		SupportingRecipes        []*Recipe         `json:"supportingRecipes"`
-		MinimumEstimatedPortions float32           `json:"minimumEstimatedPortions"`
-		SealOfApproval           bool              `json:"sealOfApproval"`
-		EligibleForMeals         bool              `json:"eligibleForMeals"`
+		CreatedAt                time.Time                                 `json:"createdAt"`
+		InspiredByRecipeID       *string                                   `json:"inspiredByRecipeID"`
+		LastUpdatedAt            *time.Time                                `json:"lastUpdatedAt"`
+		ArchivedAt               *time.Time                                `json:"archivedAt"`
+		MaximumEstimatedPortions *float32                                  `json:"maximumEstimatedPortions"`
+		Nutrition                *NutritionSummary                         `json:"nutrition,omitempty"`
+		PluralPortionName        string                                    `json:"pluralPortionName"`
+		Description              string                                    `json:"description"`
+		Name                     string                                    `json:"name"`
+		PortionName              string                                    `json:"portionName"`
+		ID                       string                                    `json:"id"`
+		CreatedByUser            string                                    `json:"createdByUser"`
+		Source                   string                                    `json:"source"`
+		Slug                     string                                    `json:"slug"`
+		YieldsComponentType      string                                    `json:"yieldsComponentType"`
+		PrepTasks                []*RecipePrepTask                         `json:"prepTasks"`
+		Steps                    []*RecipeStep                             `json:"steps"`
+		Media                    []*RecipeMedia                            `json:"media"`
+		SupportingRecipes        []*Recipe                                 `json:"supportingRecipes"`
+		IngredientSubstitutions  []*RecipeIngredientSubstitutionSuggestion `json:"ingredientSubstitutions,omitempty"`
+		MinimumEstimatedPortions float32                                   `json:"minimumEstimatedPortions"`
+		SealOfApproval           bool                                      `json:"sealOfApproval"`
+		EligibleForMeals         bool                                      `json:"eligibleForMeals"`
 	}
 
 	// RecipeCreationRequestInput represents what a user could set as input for creating recipes.
@@ -134,6 +139,8 @@ func init() {
 		GetRecipe(ctx context.Context, recipeID string) (*Recipe, error)
 		GetRecipes(ctx context.Context, filter *QueryFilter) (*QueryFilteredResult[Recipe], error)
 		SearchForRecipes(ctx context.Context, query string, filter *QueryFilter) (*QueryFilteredResult[Recipe], error)
+		GetCookableRecipes(ctx context.Context, householdID string, filter *QueryFilter) (*QueryFilteredResult[Recipe], error)
+		SearchForCookableRecipes(ctx context.Context, query, householdID string, filter *QueryFilter) (*QueryFilteredResult[Recipe], error)
 		CreateRecipe(ctx context.Context, input *RecipeDatabaseCreationInput) (*Recipe, error)
 		UpdateRecipe(ctx context.Context, updated *Recipe) error
 		MarkRecipeAsIndexed(ctx context.Context, recipeID string) error
@@ -155,6 +162,9 @@ func init() {
 		DAGHandler(http.ResponseWriter, *http.Request)
 		MermaidHandler(http.ResponseWriter, *http.Request)
 		CloneHandler(http.ResponseWriter, *http.Request)
+		ScaledHandler(http.ResponseWriter, *http.Request)
+		EquipmentReportHandler(http.ResponseWriter, *http.Request)
+		ImportHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
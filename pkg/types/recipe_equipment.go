@@ -0,0 +1,36 @@
+package types
+
+const (
+	// CanCookWithMyEquipmentQueryKey is the query param key that limits recipe lists to recipes the active household has the equipment for.
+	CanCookWithMyEquipmentQueryKey = "canCookWithMyEquipment"
+
+	// MissingEquipmentTypeInstrument indicates a recipe needs an instrument the household doesn't own enough of.
+	MissingEquipmentTypeInstrument = "instrument"
+)
+
+type (
+	// MissingRecipeEquipment represents a piece of equipment a recipe calls for that a household lacks.
+	MissingRecipeEquipment struct {
+		_ struct{} `json:"-"`
+
+		Instrument       *ValidInstrument   `json:"instrument,omitempty"`
+		EquipmentType    string             `json:"equipmentType"`
+		RecipeStepIDs    []string           `json:"recipeStepIDs"`
+		Substitutes      []*ValidInstrument `json:"substitutes"`
+		RequiredQuantity uint32             `json:"requiredQuantity"`
+		OwnedQuantity    uint32             `json:"ownedQuantity"`
+	}
+
+	// RecipeEquipmentReport represents what equipment, if any, a household is missing to cook a recipe.
+	// Households don't record the vessels they own, so the vessels a recipe calls for are listed in
+	// UncheckedVessels rather than checked, and don't affect CanCook.
+	RecipeEquipmentReport struct {
+		_ struct{} `json:"-"`
+
+		RecipeID         string                    `json:"recipeID"`
+		HouseholdID      string                    `json:"householdID"`
+		Missing          []*MissingRecipeEquipment `json:"missing"`
+		UncheckedVessels []*ValidVessel            `json:"uncheckedVessels"`
+		CanCook          bool                      `json:"canCook"`
+	}
+)
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"context"
+	"encoding/gob"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// UnresolvedRecipeImportTokenKindIngredient indicates an ingredient name that matched no valid ingredient.
+	UnresolvedRecipeImportTokenKindIngredient = "ingredient"
+	// UnresolvedRecipeImportTokenKindMeasurementUnit indicates a measurement unit that matched no valid measurement unit.
+	UnresolvedRecipeImportTokenKindMeasurementUnit = "measurement_unit"
+	// UnresolvedRecipeImportTokenKindPreparation indicates a preparation that matched no valid preparation.
+	UnresolvedRecipeImportTokenKindPreparation = "preparation"
+)
+
+func init() {
+	gob.Register(new(RecipeImportRequestInput))
+}
+
+type (
+	// RecipeImportRequestInput represents what a user could set as input for importing a recipe.
+	RecipeImportRequestInput struct {
+		_ struct{} `json:"-"`
+
+		// Document is a schema.org Recipe, encoded as JSON-LD.
+		Document        string   `json:"document"`
+		IngredientLines []string `json:"ingredientLines"`
+	}
+
+	// ParsedIngredientLine represents a free-text ingredient line, broken into its parts and resolved where possible.
+	ParsedIngredientLine struct {
+		_ struct{} `json:"-"`
+
+		MaximumQuantity   *float32 `json:"maximumQuantity"`
+		IngredientID      *string  `json:"ingredientID"`
+		Line              string   `json:"line"`
+		Name              string   `json:"name"`
+		MeasurementUnit   string   `json:"measurementUnit"`
+		MeasurementUnitID string   `json:"measurementUnitID"`
+		Notes             string   `json:"notes"`
+		Preparations      []string `json:"preparations"`
+		PreparationIDs    []string `json:"preparationIDs"`
+		MinimumQuantity   float32  `json:"minimumQuantity"`
+		Optional          bool     `json:"optional"`
+		ToTaste           bool     `json:"toTaste"`
+	}
+
+	// UnresolvedRecipeImportToken represents a piece of an imported recipe that couldn't be matched to valid data.
+	UnresolvedRecipeImportToken struct {
+		_ struct{} `json:"-"`
+
+		Kind      string `json:"kind"`
+		Value     string `json:"value"`
+		Source    string `json:"source"`
+		StepIndex uint32 `json:"stepIndex"`
+	}
+
+	// RecipeImportDraft represents the recipe an import produced, for a user to finish before creating it.
+	RecipeImportDraft struct {
+		_ struct{} `json:"-"`
+
+		Recipe           *RecipeCreationRequestInput    `json:"recipe"`
+		IngredientLines  []*ParsedIngredientLine        `json:"ingredientLines"`
+		UnresolvedTokens []*UnresolvedRecipeImportToken `json:"unresolvedTokens"`
+	}
+)
+
+var _ validation.ValidatableWithContext = (*RecipeImportRequestInput)(nil)
+
+// ValidateWithContext validates a RecipeImportRequestInput.
+func (x *RecipeImportRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Document, validation.When(len(x.IngredientLines) == 0, validation.Required)),
+	)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecipeImportRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with document", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeImportRequestInput{
+			Document: t.Name(),
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with ingredient lines", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeImportRequestInput{
+			IngredientLines: []string{t.Name()},
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeImportRequestInput{}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+}
@@ -92,6 +92,7 @@ func init() {
 		RecipeRatingExists(ctx context.Context, recipeRatingID string) (bool, error)
 		GetRecipeRating(ctx context.Context, recipeRatingID string) (*RecipeRating, error)
 		GetRecipeRatings(ctx context.Context, filter *QueryFilter) (*QueryFilteredResult[RecipeRating], error)
+		GetRecipeRatingsForHousehold(ctx context.Context, householdID string) ([]*RecipeRating, error)
 		CreateRecipeRating(ctx context.Context, input *RecipeRatingDatabaseCreationInput) (*RecipeRating, error)
 		UpdateRecipeRating(ctx context.Context, updated *RecipeRating) error
 		ArchiveRecipeRating(ctx context.Context, recipeRatingID string) error
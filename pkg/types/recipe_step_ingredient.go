@@ -6,6 +6,8 @@
 	"net/http"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -22,6 +24,7 @@ func init() {
 	gob.Register(new(RecipeStepIngredient))
 	gob.Register(new(RecipeStepIngredientCreationRequestInput))
 	gob.Register(new(RecipeStepIngredientUpdateRequestInput))
+	gob.Register(new(RecipeStepIngredientSubstitutionRequestInput))
 }
 
 type (
@@ -115,6 +118,13 @@ func init() {
 		RecipeStepProductRecipeID *string  `json:"productOfRecipeID"`
 	}
 
+	// RecipeStepIngredientSubstitutionRequestInput represents what a user could set as input for substituting a recipe step ingredient.
+	RecipeStepIngredientSubstitutionRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ValidIngredientSubstitutionID string `json:"validIngredientSubstitutionID"`
+	}
+
 	// RecipeStepIngredientDataManager describes a structure capable of storing recipe step ingredients permanently.
 	RecipeStepIngredientDataManager interface {
 		RecipeStepIngredientExists(ctx context.Context, recipeID, recipeStepID, recipeStepIngredientID string) (bool, error)
@@ -132,6 +142,7 @@ func init() {
 		ReadHandler(http.ResponseWriter, *http.Request)
 		UpdateHandler(http.ResponseWriter, *http.Request)
 		ArchiveHandler(http.ResponseWriter, *http.Request)
+		SubstituteHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -194,6 +205,18 @@ func (x *RecipeStepIngredient) Update(input *RecipeStepIngredientUpdateRequestIn
 	}
 }
 
+// Substitute swaps a recipe step ingredient's ingredient for a substitute, scaling its quantities by the substitution's ratio.
+func (x *RecipeStepIngredient) Substitute(substitution *ValidIngredientSubstitution) {
+	substitute := substitution.Substitute
+	x.Ingredient = &substitute
+	x.Name = substitute.Name
+	x.MinimumQuantity *= substitution.Ratio
+
+	if x.MaximumQuantity != nil {
+		x.MaximumQuantity = pointer.To(*x.MaximumQuantity * substitution.Ratio)
+	}
+}
+
 var _ validation.ValidatableWithContext = (*RecipeStepIngredientCreationRequestInput)(nil)
 
 // ValidateWithContext validates a RecipeStepIngredientCreationRequestInput.
@@ -230,3 +253,14 @@ func (x *RecipeStepIngredientUpdateRequestInput) ValidateWithContext(ctx context
 		validation.Field(&x.MinimumQuantity, validation.Required),
 	)
 }
+
+var _ validation.ValidatableWithContext = (*RecipeStepIngredientSubstitutionRequestInput)(nil)
+
+// ValidateWithContext validates a RecipeStepIngredientSubstitutionRequestInput.
+func (x *RecipeStepIngredientSubstitutionRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ValidIngredientSubstitutionID, validation.Required),
+	)
+}
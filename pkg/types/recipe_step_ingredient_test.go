@@ -30,6 +30,32 @@ func TestRecipeStepIngredient_Update(T *testing.T) {
 	})
 }
 
+func TestRecipeStepIngredient_Substitute(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeStepIngredient{
+			Ingredient:      &ValidIngredient{ID: "butter", Name: "butter"},
+			Name:            "butter",
+			MinimumQuantity: 100,
+			MaximumQuantity: pointer.To(float32(200)),
+		}
+
+		x.Substitute(&ValidIngredientSubstitution{
+			Original:   ValidIngredient{ID: "butter", Name: "butter"},
+			Substitute: ValidIngredient{ID: "olive_oil", Name: "olive oil"},
+			Ratio:      0.75,
+		})
+
+		assert.Equal(t, "olive_oil", x.Ingredient.ID)
+		assert.Equal(t, "olive oil", x.Name)
+		assert.Equal(t, float32(75), x.MinimumQuantity)
+		assert.Equal(t, float32(150), *x.MaximumQuantity)
+	})
+}
+
 func TestRecipeStepIngredientCreationRequestInput_Validate(T *testing.T) {
 	T.Parallel()
 
@@ -113,3 +139,27 @@ func TestRecipeStepIngredientUpdateRequestInput_Validate(T *testing.T) {
 		assert.Error(t, actual)
 	})
 }
+
+func TestRecipeStepIngredientSubstitutionRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeStepIngredientSubstitutionRequestInput{
+			ValidIngredientSubstitutionID: t.Name(),
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeStepIngredientSubstitutionRequestInput{}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+}
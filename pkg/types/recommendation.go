@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/gob"
+)
+
+const (
+	// RecommendationCountQueryKey is the query param key to specify how many recommendations should be returned.
+	RecommendationCountQueryKey = "count"
+	// DefaultRecommendationCount is how many recommendations are returned when no count is specified.
+	DefaultRecommendationCount = 10
+	// MaxRecommendationCount is the most recommendations that can be requested at once.
+	MaxRecommendationCount = 50
+)
+
+func init() {
+	gob.Register(new(RecipeRecommendation))
+	gob.Register(new(MealRecommendation))
+}
+
+type (
+	// RecipeRecommendation is a recipe ranked for a household.
+	RecipeRecommendation struct {
+		_ struct{} `json:"-"`
+
+		Recipe  *Recipe  `json:"recipe"`
+		Reasons []string `json:"reasons"`
+		Score   float64  `json:"score"`
+	}
+
+	// MealRecommendation is a meal ranked for a household.
+	MealRecommendation struct {
+		_ struct{} `json:"-"`
+
+		Meal    *Meal    `json:"meal"`
+		Reasons []string `json:"reasons"`
+		Score   float64  `json:"score"`
+	}
+)
@@ -55,7 +55,13 @@
 		ValidVessel                      *ValidVessel                    `json:"validVessel,omitempty"`
 		ValidPreparationVessel           *ValidPreparationVessel         `json:"validPreparationVessel,omitempty"`
 		UserNotification                 *UserNotification               `json:"userNotification,omitempty"`
+		CookingSession                   *CookingSession                 `json:"cookingSession,omitempty"`
+		CookingSessionProgressEntry      *CookingSessionProgressEntry    `json:"cookingSessionProgressEntry,omitempty"`
+		PantryItem                       *PantryItem                     `json:"pantryItem,omitempty"`
+		ValidIngredientNutrition         *ValidIngredientNutrition       `json:"validIngredientNutrition,omitempty"`
+		ValidIngredientSubstitution      *ValidIngredientSubstitution    `json:"validIngredientSubstitution,omitempty"`
 		UserNotificationID               string                          `json:"userNotificationID"`
+		CookingSessionID                 string                          `json:"cookingSessionID,omitempty"`
 		RecipeStepVesselID               string                          `json:"recipeStepVesselID,omitempty"`
 		HouseholdInvitationID            string                          `json:"householdInvitationID,omitempty"`
 		UserID                           string                          `json:"userID"`
@@ -82,8 +88,10 @@
 		EmailVerificationToken           string                          `json:"emailVerificationToken,omitempty"`
 		UserIngredientPreferenceID       string                          `json:"userIngredientPreferenceID,omitempty"`
 		HouseholdInstrumentOwnershipID   string                          `json:"householdInstrumentOwnershipID,omitempty"`
+		PantryItemID                     string                          `json:"pantryItemID,omitempty"`
 		RecipeRatingID                   string                          `json:"recipeRatingID,omitempty"`
 		OAuth2ClientID                   string                          `json:"oauth2ClientID,omitempty"`
+		IdempotencyKey                   string                          `json:"idempotencyKey,omitempty"`
 		UserIngredientPreferences        []*UserIngredientPreference     `json:"userIngredientPreference,omitempty"`
 	}
 
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"net/http"
 	"time"
@@ -132,9 +133,12 @@
 	UserLoginInput struct {
 		_ struct{} `json:"-"`
 
-		Username  string `json:"username"`
-		Password  string `json:"password"`
-		TOTPToken string `json:"totpToken"`
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+		TOTPToken    string `json:"totpToken"`
+		RecoveryCode string `json:"recoveryCode,omitempty"`
+		// PasskeyAssertion is a WebAuthn assertion, which is used in place of a username and password when provided.
+		PasskeyAssertion json.RawMessage `json:"passkeyAssertion,omitempty"`
 	}
 
 	// PasswordUpdateInput represents input a User would provide when updating their passwords.
@@ -197,6 +201,7 @@
 
 		CurrentPassword string `json:"currentPassword"`
 		TOTPToken       string `json:"totpToken"`
+		RecoveryCode    string `json:"recoveryCode,omitempty"`
 	}
 
 	// TOTPSecretVerificationInput represents input a User would provide when validating their 2FA secret.
@@ -263,6 +268,7 @@
 		PermissionsHandler(http.ResponseWriter, *http.Request)
 		UsernameSearchHandler(http.ResponseWriter, *http.Request)
 		NewTOTPSecretHandler(http.ResponseWriter, *http.Request)
+		NewRecoveryCodesHandler(http.ResponseWriter, *http.Request)
 		TOTPSecretVerificationHandler(http.ResponseWriter, *http.Request)
 		UpdatePasswordHandler(http.ResponseWriter, *http.Request)
 		UpdateUserEmailAddressHandler(http.ResponseWriter, *http.Request)
@@ -331,6 +337,10 @@ func (i *TOTPSecretVerificationInput) ValidateWithContext(ctx context.Context) e
 
 // ValidateWithContext ensures our provided UserLoginInput meets expectations.
 func (i *UserLoginInput) ValidateWithContext(ctx context.Context, minUsernameLength, minPasswordLength uint8) error {
+	if len(i.PasskeyAssertion) > 0 {
+		return nil
+	}
+
 	return validation.ValidateStructWithContext(ctx, i,
 		validation.Field(&i.Username, validation.Required, validation.Length(int(minUsernameLength), math.MaxInt8)),
 		validation.Field(&i.Password, validation.Required, validation.Length(int(minPasswordLength), math.MaxInt8)),
@@ -355,7 +365,7 @@ func (i *PasswordUpdateInput) ValidateWithContext(ctx context.Context, minPasswo
 func (i *TOTPSecretRefreshInput) ValidateWithContext(ctx context.Context) error {
 	return validation.ValidateStructWithContext(ctx, i,
 		validation.Field(&i.CurrentPassword, validation.Required),
-		validation.Field(&i.TOTPToken, validation.Required, totpTokenLengthRule),
+		validation.Field(&i.TOTPToken, validation.When(i.RecoveryCode == "", validation.Required), totpTokenLengthRule),
 	)
 }
 
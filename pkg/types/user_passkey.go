@@ -0,0 +1,172 @@
+package types
+
+import (
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// PasskeyRegisteredCustomerEventType indicates a user registered a passkey.
+	PasskeyRegisteredCustomerEventType ServiceEventType = "passkey_registered"
+	// PasskeyArchivedCustomerEventType indicates a user archived a passkey.
+	PasskeyArchivedCustomerEventType ServiceEventType = "passkey_archived"
+)
+
+func init() {
+	gob.Register(new(UserPasskey))
+	gob.Register(new(PasskeyRegistrationBeginRequestInput))
+	gob.Register(new(PasskeyRegistrationRequestInput))
+	gob.Register(new(PasskeyArchiveRequestInput))
+}
+
+type (
+	// UserPasskey represents a WebAuthn credential a user can log in with.
+	UserPasskey struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt       time.Time  `json:"createdAt"`
+		LastUsedAt      *time.Time `json:"lastUsedAt"`
+		ArchivedAt      *time.Time `json:"archivedAt"`
+		ID              string     `json:"id"`
+		Name            string     `json:"name"`
+		CredentialID    string     `json:"credentialID"`
+		AttestationType string     `json:"attestationType"`
+		BelongsToUser   string     `json:"belongsToUser"`
+		PublicKey       []byte     `json:"-"`
+		AAGUID          []byte     `json:"-"`
+		Transports      []string   `json:"transports"`
+		SignCount       uint32     `json:"-"`
+		BackupEligible  bool       `json:"backupEligible"`
+		BackupState     bool       `json:"backupState"`
+	}
+
+	// UserPasskeyDatabaseCreationInput represents what a user could set as input for creating passkeys.
+	UserPasskeyDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID              string
+		Name            string
+		CredentialID    string
+		AttestationType string
+		BelongsToUser   string
+		PublicKey       []byte
+		AAGUID          []byte
+		Transports      []string
+		SignCount       uint32
+		BackupEligible  bool
+		BackupState     bool
+	}
+
+	// PasskeyRegistrationBeginRequestInput represents what a user provides to start registering a passkey.
+	PasskeyRegistrationBeginRequestInput struct {
+		_ struct{} `json:"-"`
+
+		CurrentPassword string `json:"currentPassword"`
+		TOTPToken       string `json:"totpToken"`
+	}
+
+	// PasskeyRegistrationRequestInput represents what a user provides to finish registering a passkey.
+	PasskeyRegistrationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		Name            string          `json:"name"`
+		CurrentPassword string          `json:"currentPassword"`
+		TOTPToken       string          `json:"totpToken"`
+		Credential      json.RawMessage `json:"credential"`
+	}
+
+	// PasskeyArchiveRequestInput represents what a user provides to archive a passkey.
+	PasskeyArchiveRequestInput struct {
+		_ struct{} `json:"-"`
+
+		CurrentPassword string `json:"currentPassword"`
+		TOTPToken       string `json:"totpToken"`
+	}
+
+	// PasskeyCeremonyOptions is what we provide to a browser to start a WebAuthn ceremony.
+	PasskeyCeremonyOptions struct {
+		_ struct{} `json:"-"`
+
+		PublicKey json.RawMessage `json:"publicKey"`
+	}
+
+	// PasskeyCeremonyDatabaseCreationInput represents the state of an in-progress WebAuthn ceremony.
+	PasskeyCeremonyDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt   time.Time
+		ID          string
+		SessionData []byte
+	}
+
+	// PasskeyCeremonyDataManager describes a structure capable of storing in-progress WebAuthn ceremonies until they're finished.
+	PasskeyCeremonyDataManager interface {
+		CreatePasskeyCeremony(ctx context.Context, input *PasskeyCeremonyDatabaseCreationInput) error
+		ConsumePasskeyCeremony(ctx context.Context, ceremonyID string) ([]byte, error)
+	}
+
+	// UserPasskeyDataManager describes a structure capable of storing passkeys permanently.
+	UserPasskeyDataManager interface {
+		GetUserPasskeysForUser(ctx context.Context, userID string) ([]*UserPasskey, error)
+		CreateUserPasskey(ctx context.Context, input *UserPasskeyDatabaseCreationInput) (*UserPasskey, error)
+		MarkUserPasskeyAsUsed(ctx context.Context, passkeyID string, signCount uint32, backupState bool) error
+		ArchiveUserPasskey(ctx context.Context, userID, passkeyID string) error
+	}
+)
+
+var _ validation.ValidatableWithContext = (*PasskeyRegistrationBeginRequestInput)(nil)
+
+// ValidateWithContext validates a PasskeyRegistrationBeginRequestInput.
+func (x *PasskeyRegistrationBeginRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.CurrentPassword, validation.Required),
+		validation.Field(&x.TOTPToken, validation.When(x.TOTPToken != "", totpTokenLengthRule)),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PasskeyRegistrationRequestInput)(nil)
+
+// ValidateWithContext validates a PasskeyRegistrationRequestInput.
+func (x *PasskeyRegistrationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Name, validation.Required),
+		validation.Field(&x.CurrentPassword, validation.Required),
+		validation.Field(&x.TOTPToken, validation.When(x.TOTPToken != "", totpTokenLengthRule)),
+		validation.Field(&x.Credential, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PasskeyArchiveRequestInput)(nil)
+
+// ValidateWithContext validates a PasskeyArchiveRequestInput.
+func (x *PasskeyArchiveRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.CurrentPassword, validation.Required),
+		validation.Field(&x.TOTPToken, validation.When(x.TOTPToken != "", totpTokenLengthRule)),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*UserPasskeyDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a UserPasskeyDatabaseCreationInput.
+func (x *UserPasskeyDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.Name, validation.Required),
+		validation.Field(&x.CredentialID, validation.Required),
+		validation.Field(&x.PublicKey, validation.Required),
+		validation.Field(&x.BelongsToUser, validation.Required),
+	)
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasskeyRegistrationBeginRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyRegistrationBeginRequestInput{
+			CurrentPassword: t.Name(),
+			TOTPToken:       "123456",
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("without current password", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyRegistrationBeginRequestInput{
+			TOTPToken: "123456",
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestPasskeyRegistrationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyRegistrationRequestInput{
+			Name:            t.Name(),
+			CurrentPassword: t.Name(),
+			TOTPToken:       "123456",
+			Credential:      json.RawMessage(`{}`),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("without credential", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyRegistrationRequestInput{
+			Name:            t.Name(),
+			CurrentPassword: t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("without current password", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyRegistrationRequestInput{
+			Name:       t.Name(),
+			Credential: json.RawMessage(`{}`),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestPasskeyArchiveRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyArchiveRequestInput{
+			CurrentPassword: t.Name(),
+			TOTPToken:       "123456",
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid TOTP token", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &PasskeyArchiveRequestInput{
+			CurrentPassword: t.Name(),
+			TOTPToken:       "1",
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestUserPasskeyDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &UserPasskeyDatabaseCreationInput{
+			ID:            t.Name(),
+			Name:          t.Name(),
+			CredentialID:  t.Name(),
+			PublicKey:     []byte(t.Name()),
+			BelongsToUser: t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &UserPasskeyDatabaseCreationInput{}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// RecoveryCodesGeneratedCustomerEventType indicates a user generated a new set of recovery codes.
+	RecoveryCodesGeneratedCustomerEventType ServiceEventType = "recovery_codes_generated"
+	// RecoveryCodeRedeemedCustomerEventType indicates a user used a recovery code in place of a TOTP token.
+	RecoveryCodeRedeemedCustomerEventType ServiceEventType = "recovery_code_redeemed"
+)
+
+type (
+	// RecoveryCodesGenerationRequestInput represents what a user provides to generate new recovery codes.
+	RecoveryCodesGenerationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		CurrentPassword string `json:"currentPassword"`
+		TOTPToken       string `json:"totpToken"`
+	}
+
+	// RecoveryCodesResponse represents the response we provide to a user when generating recovery codes.
+	RecoveryCodesResponse struct {
+		_ struct{} `json:"-"`
+
+		Codes []string `json:"codes"`
+	}
+
+	// UserRecoveryCodeDataManager describes a structure capable of storing recovery codes permanently.
+	UserRecoveryCodeDataManager interface {
+		CreateUserRecoveryCodes(ctx context.Context, userID string, hashedCodes []string) error
+		RedeemUserRecoveryCode(ctx context.Context, userID, hashedCode string) (bool, error)
+	}
+)
+
+var _ validation.ValidatableWithContext = (*RecoveryCodesGenerationRequestInput)(nil)
+
+// ValidateWithContext validates a RecoveryCodesGenerationRequestInput.
+func (x *RecoveryCodesGenerationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.CurrentPassword, validation.Required),
+		validation.Field(&x.TOTPToken, validation.Required, totpTokenLengthRule),
+	)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryCodesGenerationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &RecoveryCodesGenerationRequestInput{
+			CurrentPassword: t.Name(),
+			TOTPToken:       "123456",
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("without TOTP token", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &RecoveryCodesGenerationRequestInput{
+			CurrentPassword: t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
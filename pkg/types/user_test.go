@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -110,6 +111,17 @@ func TestUserLoginInput_ValidateWithContext(T *testing.T) {
 
 		assert.NoError(t, x.ValidateWithContext(ctx, 1, 1))
 	})
+
+	T.Run("with passkey assertion", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &UserLoginInput{
+			PasskeyAssertion: json.RawMessage(`{}`),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx, 1, 1))
+	})
 }
 
 func TestPasswordUpdateInput_ValidateWithContext(T *testing.T) {
@@ -156,6 +168,18 @@ func TestTOTPSecretRefreshInput_ValidateWithContext(T *testing.T) {
 
 		assert.NoError(t, x.ValidateWithContext(ctx))
 	})
+
+	T.Run("with recovery code", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &TOTPSecretRefreshInput{
+			CurrentPassword: t.Name(),
+			RecoveryCode:    "ABCD-EFGH-IJKL-MNOP",
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
 }
 
 func TestEmailAddressVerificationRequestInput_ValidateWithContext(T *testing.T) {
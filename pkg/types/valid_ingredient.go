@@ -272,6 +272,9 @@ func init() {
 		UpdateHandler(http.ResponseWriter, *http.Request)
 		ArchiveHandler(http.ResponseWriter, *http.Request)
 		SearchByPreparationAndIngredientNameHandler(http.ResponseWriter, *http.Request)
+		ReadNutritionHandler(http.ResponseWriter, *http.Request)
+		UpdateNutritionHandler(http.ResponseWriter, *http.Request)
+		ArchiveNutritionHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// ValidIngredientNutritionUpdatedCustomerEventType indicates a valid ingredient's nutrition facts were set.
+	ValidIngredientNutritionUpdatedCustomerEventType ServiceEventType = "valid_ingredient_nutrition_updated"
+	// ValidIngredientNutritionArchivedCustomerEventType indicates a valid ingredient's nutrition facts were archived.
+	ValidIngredientNutritionArchivedCustomerEventType ServiceEventType = "valid_ingredient_nutrition_archived"
+)
+
+func init() {
+	gob.Register(new(ValidIngredientNutrition))
+	gob.Register(new(ValidIngredientNutritionUpsertRequestInput))
+}
+
+type (
+	// NutrientAmounts are the quantities of the nutrients we track.
+	// Calories are kilocalories, sodium is in milligrams, and everything else is in grams.
+	NutrientAmounts struct {
+		_ struct{} `json:"-"`
+
+		Calories      float32 `json:"calories"`
+		Protein       float32 `json:"protein"`
+		TotalFat      float32 `json:"totalFat"`
+		SaturatedFat  float32 `json:"saturatedFat"`
+		Carbohydrates float32 `json:"carbohydrates"`
+		Sugar         float32 `json:"sugar"`
+		Fiber         float32 `json:"fiber"`
+		Sodium        float32 `json:"sodium"`
+	}
+
+	// ValidIngredientNutrition represents the nutritional content of a reference quantity (i.e. 100 grams) of a valid ingredient.
+	ValidIngredientNutrition struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt                time.Time            `json:"createdAt"`
+		LastUpdatedAt            *time.Time           `json:"lastUpdatedAt"`
+		ArchivedAt               *time.Time           `json:"archivedAt"`
+		ID                       string               `json:"id"`
+		BelongsToValidIngredient string               `json:"belongsToValidIngredient"`
+		Source                   string               `json:"source"`
+		SourceID                 string               `json:"sourceID"`
+		ReferenceMeasurementUnit ValidMeasurementUnit `json:"referenceMeasurementUnit"`
+		Nutrients                NutrientAmounts      `json:"nutrients"`
+		ReferenceQuantity        float32              `json:"referenceQuantity"`
+	}
+
+	// ValidIngredientNutritionUpsertRequestInput represents what a user could set as input for setting a valid ingredient's nutrition.
+	ValidIngredientNutritionUpsertRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ReferenceMeasurementUnitID string          `json:"referenceMeasurementUnitID"`
+		Source                     string          `json:"source"`
+		SourceID                   string          `json:"sourceID"`
+		Nutrients                  NutrientAmounts `json:"nutrients"`
+		ReferenceQuantity          float32         `json:"referenceQuantity"`
+	}
+
+	// ValidIngredientNutritionDatabaseCreationInput represents what a user could set as input for setting a valid ingredient's nutrition.
+	ValidIngredientNutritionDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID                         string
+		ValidIngredientID          string
+		ReferenceMeasurementUnitID string
+		Source                     string
+		SourceID                   string
+		Nutrients                  NutrientAmounts
+		ReferenceQuantity          float32
+	}
+
+	// NutritionSummary describes the nutritional content of a recipe or meal.
+	NutritionSummary struct {
+		_ struct{} `json:"-"`
+
+		PerPortion *NutrientAmounts `json:"perPortion"`
+		Warnings   []string         `json:"warnings"`
+		Total      NutrientAmounts  `json:"total"`
+		Complete   bool             `json:"complete"`
+	}
+
+	// ValidIngredientNutritionDataManager describes a structure capable of storing valid ingredient nutrition permanently.
+	ValidIngredientNutritionDataManager interface {
+		GetValidIngredientNutrition(ctx context.Context, validIngredientID string) (*ValidIngredientNutrition, error)
+		GetValidIngredientNutritionForIngredients(ctx context.Context, validIngredientIDs []string) ([]*ValidIngredientNutrition, error)
+		UpsertValidIngredientNutrition(ctx context.Context, input *ValidIngredientNutritionDatabaseCreationInput) (*ValidIngredientNutrition, error)
+		ArchiveValidIngredientNutrition(ctx context.Context, validIngredientID string) error
+	}
+)
+
+// Add adds another set of nutrient amounts to this one.
+func (x *NutrientAmounts) Add(other *NutrientAmounts) {
+	x.Calories += other.Calories
+	x.Protein += other.Protein
+	x.TotalFat += other.TotalFat
+	x.SaturatedFat += other.SaturatedFat
+	x.Carbohydrates += other.Carbohydrates
+	x.Sugar += other.Sugar
+	x.Fiber += other.Fiber
+	x.Sodium += other.Sodium
+}
+
+// Scale returns a copy of these nutrient amounts multiplied by a factor.
+func (x *NutrientAmounts) Scale(factor float32) *NutrientAmounts {
+	return &NutrientAmounts{
+		Calories:      x.Calories * factor,
+		Protein:       x.Protein * factor,
+		TotalFat:      x.TotalFat * factor,
+		SaturatedFat:  x.SaturatedFat * factor,
+		Carbohydrates: x.Carbohydrates * factor,
+		Sugar:         x.Sugar * factor,
+		Fiber:         x.Fiber * factor,
+		Sodium:        x.Sodium * factor,
+	}
+}
+
+var _ validation.ValidatableWithContext = NutrientAmounts{}
+
+// ValidateWithContext validates a NutrientAmounts. It has a value receiver so that it's
+// picked up when validating the structs that embed nutrient amounts by value.
+func (x NutrientAmounts) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		&x,
+		validation.Field(&x.Calories, validation.Min(float32(0))),
+		validation.Field(&x.Protein, validation.Min(float32(0))),
+		validation.Field(&x.TotalFat, validation.Min(float32(0))),
+		validation.Field(&x.SaturatedFat, validation.Min(float32(0))),
+		validation.Field(&x.Carbohydrates, validation.Min(float32(0))),
+		validation.Field(&x.Sugar, validation.Min(float32(0))),
+		validation.Field(&x.Fiber, validation.Min(float32(0))),
+		validation.Field(&x.Sodium, validation.Min(float32(0))),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientNutritionUpsertRequestInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientNutritionUpsertRequestInput.
+func (x *ValidIngredientNutritionUpsertRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ReferenceMeasurementUnitID, validation.Required),
+		validation.Field(&x.ReferenceQuantity, validation.Required, validation.Min(float32(0))),
+		validation.Field(&x.Nutrients),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientNutritionDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientNutritionDatabaseCreationInput.
+func (x *ValidIngredientNutritionDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.ReferenceMeasurementUnitID, validation.Required),
+		validation.Field(&x.ReferenceQuantity, validation.Required, validation.Min(float32(0))),
+		validation.Field(&x.Nutrients),
+	)
+}
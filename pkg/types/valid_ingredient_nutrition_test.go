@@ -0,0 +1,102 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNutrientAmounts_Add(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &NutrientAmounts{Calories: 100, Protein: 2, Sodium: 10}
+		x.Add(&NutrientAmounts{Calories: 50, Fiber: 3, Sodium: 5})
+
+		assert.Equal(t, &NutrientAmounts{Calories: 150, Protein: 2, Fiber: 3, Sodium: 15}, x)
+	})
+}
+
+func TestNutrientAmounts_Scale(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &NutrientAmounts{Calories: 100, Carbohydrates: 20, Sugar: 4}
+
+		assert.Equal(t, &NutrientAmounts{Calories: 50, Carbohydrates: 10, Sugar: 2}, x.Scale(0.5))
+		assert.Equal(t, float32(100), x.Calories)
+	})
+}
+
+func TestValidIngredientNutritionUpsertRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &ValidIngredientNutritionUpsertRequestInput{
+			ReferenceMeasurementUnitID: t.Name(),
+			ReferenceQuantity:          100,
+			Nutrients:                  NutrientAmounts{Calories: 40},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with negative nutrients", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &ValidIngredientNutritionUpsertRequestInput{
+			ReferenceMeasurementUnitID: t.Name(),
+			ReferenceQuantity:          100,
+			Nutrients:                  NutrientAmounts{Calories: -1},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with missing reference quantity", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &ValidIngredientNutritionUpsertRequestInput{
+			ReferenceMeasurementUnitID: t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestValidIngredientNutritionDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &ValidIngredientNutritionDatabaseCreationInput{
+			ID:                         t.Name(),
+			ValidIngredientID:          t.Name(),
+			ReferenceMeasurementUnitID: t.Name(),
+			ReferenceQuantity:          100,
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		x := &ValidIngredientNutritionDatabaseCreationInput{}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
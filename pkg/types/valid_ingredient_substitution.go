@@ -0,0 +1,215 @@
+package types
+
+import (
+	"context"
+	"encoding/gob"
+	"net/http"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// ValidIngredientSubstitutionCreatedCustomerEventType indicates a valid ingredient substitution was created.
+	ValidIngredientSubstitutionCreatedCustomerEventType ServiceEventType = "valid_ingredient_substitution_created"
+	// ValidIngredientSubstitutionUpdatedCustomerEventType indicates a valid ingredient substitution was updated.
+	ValidIngredientSubstitutionUpdatedCustomerEventType ServiceEventType = "valid_ingredient_substitution_updated"
+	// ValidIngredientSubstitutionArchivedCustomerEventType indicates a valid ingredient substitution was archived.
+	ValidIngredientSubstitutionArchivedCustomerEventType ServiceEventType = "valid_ingredient_substitution_archived"
+
+	// SubstitutionReasonAllergy indicates a substitute is suitable for someone allergic to the original ingredient.
+	SubstitutionReasonAllergy = "allergy"
+	// SubstitutionReasonVegan indicates a substitute is a vegan replacement for the original ingredient.
+	SubstitutionReasonVegan = "vegan"
+	// SubstitutionReasonAvailability indicates a substitute can stand in when the original ingredient can't be found.
+	SubstitutionReasonAvailability = "availability"
+)
+
+func init() {
+	gob.Register(new(ValidIngredientSubstitution))
+	gob.Register(new(ValidIngredientSubstitutionCreationRequestInput))
+	gob.Register(new(ValidIngredientSubstitutionUpdateRequestInput))
+}
+
+type (
+	// ValidIngredientSubstitution represents one valid ingredient standing in for another.
+	ValidIngredientSubstitution struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt     time.Time         `json:"createdAt"`
+		LastUpdatedAt *time.Time        `json:"lastUpdatedAt"`
+		ArchivedAt    *time.Time        `json:"archivedAt"`
+		Preparation   *ValidPreparation `json:"preparation"`
+		ID            string            `json:"id"`
+		Notes         string            `json:"notes"`
+		Reasons       []string          `json:"reasons"`
+		Original      ValidIngredient   `json:"original"`
+		Substitute    ValidIngredient   `json:"substitute"`
+		Ratio         float32           `json:"ratio"`
+	}
+
+	// ValidIngredientSubstitutionCreationRequestInput represents what a user could set as input for creating valid ingredient substitutions.
+	ValidIngredientSubstitutionCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ValidPreparationID     *string  `json:"validPreparationID"`
+		OriginalIngredientID   string   `json:"originalIngredientID"`
+		SubstituteIngredientID string   `json:"substituteIngredientID"`
+		Notes                  string   `json:"notes"`
+		Reasons                []string `json:"reasons"`
+		Ratio                  float32  `json:"ratio"`
+	}
+
+	// ValidIngredientSubstitutionDatabaseCreationInput represents what a user could set as input for creating valid ingredient substitutions.
+	ValidIngredientSubstitutionDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ValidPreparationID     *string
+		ID                     string
+		OriginalIngredientID   string
+		SubstituteIngredientID string
+		Notes                  string
+		Reasons                []string
+		Ratio                  float32
+	}
+
+	// ValidIngredientSubstitutionUpdateRequestInput represents what a user could set as input for updating valid ingredient substitutions.
+	ValidIngredientSubstitutionUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ValidPreparationID     *string  `json:"validPreparationID,omitempty"`
+		OriginalIngredientID   *string  `json:"originalIngredientID,omitempty"`
+		SubstituteIngredientID *string  `json:"substituteIngredientID,omitempty"`
+		Notes                  *string  `json:"notes,omitempty"`
+		Ratio                  *float32 `json:"ratio,omitempty"`
+		Reasons                []string `json:"reasons,omitempty"`
+	}
+
+	// RecipeIngredientSubstitutionSuggestion represents the substitutes available for a recipe step ingredient that a user is allergic to.
+	RecipeIngredientSubstitutionSuggestion struct {
+		_ struct{} `json:"-"`
+
+		RecipeStepID           string                         `json:"recipeStepID"`
+		RecipeStepIngredientID string                         `json:"recipeStepIngredientID"`
+		IngredientID           string                         `json:"ingredientID"`
+		IngredientName         string                         `json:"ingredientName"`
+		Substitutions          []*ValidIngredientSubstitution `json:"substitutions"`
+	}
+
+	// ValidIngredientSubstitutionDataManager describes a structure capable of storing valid ingredient substitutions permanently.
+	ValidIngredientSubstitutionDataManager interface {
+		ValidIngredientSubstitutionExists(ctx context.Context, validIngredientSubstitutionID string) (bool, error)
+		GetValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) (*ValidIngredientSubstitution, error)
+		GetValidIngredientSubstitutions(ctx context.Context, filter *QueryFilter) (*QueryFilteredResult[ValidIngredientSubstitution], error)
+		GetValidIngredientSubstitutionsForIngredient(ctx context.Context, ingredientID string, filter *QueryFilter) (*QueryFilteredResult[ValidIngredientSubstitution], error)
+		GetValidIngredientSubstitutionsForIngredients(ctx context.Context, ingredientIDs []string) ([]*ValidIngredientSubstitution, error)
+		CreateValidIngredientSubstitution(ctx context.Context, input *ValidIngredientSubstitutionDatabaseCreationInput) (*ValidIngredientSubstitution, error)
+		UpdateValidIngredientSubstitution(ctx context.Context, updated *ValidIngredientSubstitution) error
+		ArchiveValidIngredientSubstitution(ctx context.Context, validIngredientSubstitutionID string) error
+	}
+
+	// ValidIngredientSubstitutionDataService describes a structure capable of serving traffic related to valid ingredient substitutions.
+	ValidIngredientSubstitutionDataService interface {
+		ListHandler(http.ResponseWriter, *http.Request)
+		CreateHandler(http.ResponseWriter, *http.Request)
+		ReadHandler(http.ResponseWriter, *http.Request)
+		UpdateHandler(http.ResponseWriter, *http.Request)
+		ArchiveHandler(http.ResponseWriter, *http.Request)
+		SearchByIngredientHandler(http.ResponseWriter, *http.Request)
+	}
+)
+
+// HasReason returns whether a substitution is tagged with a given reason.
+func (x *ValidIngredientSubstitution) HasReason(reason string) bool {
+	for _, r := range x.Reasons {
+		if r == reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppliesToPreparation returns whether a substitution can be used in a step with the given preparation.
+// Substitutions without a preparation apply everywhere.
+func (x *ValidIngredientSubstitution) AppliesToPreparation(preparationID string) bool {
+	return x.Preparation == nil || x.Preparation.ID == preparationID
+}
+
+// Update merges an ValidIngredientSubstitutionUpdateRequestInput with a valid ingredient substitution.
+func (x *ValidIngredientSubstitution) Update(input *ValidIngredientSubstitutionUpdateRequestInput) {
+	if input.ValidPreparationID != nil {
+		if *input.ValidPreparationID == "" {
+			x.Preparation = nil
+		} else if x.Preparation == nil || *input.ValidPreparationID != x.Preparation.ID {
+			x.Preparation = &ValidPreparation{ID: *input.ValidPreparationID}
+		}
+	}
+
+	if input.OriginalIngredientID != nil && *input.OriginalIngredientID != x.Original.ID {
+		x.Original = ValidIngredient{ID: *input.OriginalIngredientID}
+	}
+
+	if input.SubstituteIngredientID != nil && *input.SubstituteIngredientID != x.Substitute.ID {
+		x.Substitute = ValidIngredient{ID: *input.SubstituteIngredientID}
+	}
+
+	if input.Notes != nil && *input.Notes != x.Notes {
+		x.Notes = *input.Notes
+	}
+
+	if input.Ratio != nil && *input.Ratio != x.Ratio {
+		x.Ratio = *input.Ratio
+	}
+
+	if input.Reasons != nil {
+		x.Reasons = input.Reasons
+	}
+}
+
+var validSubstitutionReasons = []any{
+	SubstitutionReasonAllergy,
+	SubstitutionReasonVegan,
+	SubstitutionReasonAvailability,
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientSubstitutionCreationRequestInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientSubstitutionCreationRequestInput.
+func (x *ValidIngredientSubstitutionCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.OriginalIngredientID, validation.Required),
+		validation.Field(&x.SubstituteIngredientID, validation.Required, validation.NotIn(x.OriginalIngredientID)),
+		validation.Field(&x.Ratio, validation.Required, validation.Min(float32(0))),
+		validation.Field(&x.Reasons, validation.Each(validation.In(validSubstitutionReasons...))),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientSubstitutionDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientSubstitutionDatabaseCreationInput.
+func (x *ValidIngredientSubstitutionDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.OriginalIngredientID, validation.Required),
+		validation.Field(&x.SubstituteIngredientID, validation.Required, validation.NotIn(x.OriginalIngredientID)),
+		validation.Field(&x.Ratio, validation.Required, validation.Min(float32(0))),
+		validation.Field(&x.Reasons, validation.Each(validation.In(validSubstitutionReasons...))),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientSubstitutionUpdateRequestInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientSubstitutionUpdateRequestInput.
+func (x *ValidIngredientSubstitutionUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Ratio, validation.NilOrNotEmpty, validation.Min(float32(0))),
+		validation.Field(&x.Reasons, validation.Each(validation.In(validSubstitutionReasons...))),
+	)
+}
@@ -0,0 +1,181 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dinnerdonebetter/backend/internal/pkg/pointer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidIngredientSubstitution_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitution{
+			Original:    ValidIngredient{ID: "original"},
+			Substitute:  ValidIngredient{ID: "substitute"},
+			Preparation: &ValidPreparation{ID: "preparation"},
+			Ratio:       1,
+		}
+		input := &ValidIngredientSubstitutionUpdateRequestInput{
+			SubstituteIngredientID: pointer.To("other"),
+			ValidPreparationID:     pointer.To(""),
+			Ratio:                  pointer.To(float32(0.5)),
+			Reasons:                []string{SubstitutionReasonVegan},
+		}
+
+		x.Update(input)
+
+		assert.Equal(t, "original", x.Original.ID)
+		assert.Equal(t, "other", x.Substitute.ID)
+		assert.Nil(t, x.Preparation)
+		assert.Equal(t, float32(0.5), x.Ratio)
+		assert.Equal(t, []string{SubstitutionReasonVegan}, x.Reasons)
+	})
+}
+
+func TestValidIngredientSubstitution_HasReason(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitution{Reasons: []string{SubstitutionReasonAllergy}}
+
+		assert.True(t, x.HasReason(SubstitutionReasonAllergy))
+		assert.False(t, x.HasReason(SubstitutionReasonVegan))
+	})
+}
+
+func TestValidIngredientSubstitution_AppliesToPreparation(T *testing.T) {
+	T.Parallel()
+
+	T.Run("without preparation", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitution{}
+
+		assert.True(t, x.AppliesToPreparation(t.Name()))
+	})
+
+	T.Run("with preparation", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitution{Preparation: &ValidPreparation{ID: t.Name()}}
+
+		assert.True(t, x.AppliesToPreparation(t.Name()))
+		assert.False(t, x.AppliesToPreparation("other"))
+	})
+}
+
+func TestValidIngredientSubstitutionCreationRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionCreationRequestInput{
+			OriginalIngredientID:   "original",
+			SubstituteIngredientID: "substitute",
+			Ratio:                  1,
+			Reasons:                []string{SubstitutionReasonAllergy, SubstitutionReasonAvailability},
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionCreationRequestInput{}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+
+	T.Run("with ingredient substituting for itself", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionCreationRequestInput{
+			OriginalIngredientID:   t.Name(),
+			SubstituteIngredientID: t.Name(),
+			Ratio:                  1,
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+
+	T.Run("with unknown reason", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionCreationRequestInput{
+			OriginalIngredientID:   "original",
+			SubstituteIngredientID: "substitute",
+			Ratio:                  1,
+			Reasons:                []string{t.Name()},
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+}
+
+func TestValidIngredientSubstitutionDatabaseCreationInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionDatabaseCreationInput{
+			ID:                     t.Name(),
+			OriginalIngredientID:   "original",
+			SubstituteIngredientID: "substitute",
+			Ratio:                  1,
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionDatabaseCreationInput{}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+}
+
+func TestValidIngredientSubstitutionUpdateRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionUpdateRequestInput{
+			Ratio:   pointer.To(float32(2)),
+			Reasons: []string{SubstitutionReasonVegan},
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid reason", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientSubstitutionUpdateRequestInput{
+			Reasons: []string{t.Name()},
+		}
+
+		actual := x.ValidateWithContext(context.Background())
+		assert.Error(t, actual)
+	})
+}
@@ -2,7 +2,11 @@
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -18,6 +22,8 @@
 	WebhookTriggerEventCreatedCustomerEventType ServiceEventType = "webhook_trigger_event_created"
 	// WebhookTriggerEventArchivedCustomerEventType indicates a webhook was archived.
 	WebhookTriggerEventArchivedCustomerEventType ServiceEventType = "webhook_trigger_event_archived"
+	// WebhookPingCustomerEventType indicates a synthetic event sent to test a webhook.
+	WebhookPingCustomerEventType ServiceEventType = "webhook_ping"
 )
 
 type (
@@ -28,6 +34,7 @@
 		CreatedAt          time.Time              `json:"createdAt"`
 		ArchivedAt         *time.Time             `json:"archivedAt"`
 		LastUpdatedAt      *time.Time             `json:"lastUpdatedAt"`
+		DisabledAt         *time.Time             `json:"disabledAt"`
 		Name               string                 `json:"name"`
 		URL                string                 `json:"url"`
 		Method             string                 `json:"method"`
@@ -93,12 +100,30 @@
 	WebhookExecutionRequest struct {
 		_ struct{} `json:"-"`
 
-		Payload      any    `json:"payload"`
-		WebhookID    string `json:"webhookID"`
-		HouseholdID  string `json:"householdID"`
+		Payload           any    `json:"payload"`
+		WebhookID         string `json:"webhookID"`
+		HouseholdID       string `json:"householdID"`
+		TriggerEvent      string `json:"triggerEvent"`
+		RetryOfDeliveryID string `json:"retryOfDeliveryID,omitempty"`
+	}
+
+	// WebhookPreviewRequestInput represents what a User could set as input for previewing a webhook request.
+	WebhookPreviewRequestInput struct {
+		_ struct{} `json:"-"`
+
 		TriggerEvent string `json:"triggerEvent"`
 	}
 
+	// WebhookPreview represents the request that would be sent to a webhook, without it having been sent.
+	WebhookPreview struct {
+		_ struct{} `json:"-"`
+
+		Headers map[string]string `json:"headers"`
+		Method  string            `json:"method"`
+		URL     string            `json:"url"`
+		Body    string            `json:"body"`
+	}
+
 	// WebhookDataManager describes a structure capable of storing webhooks.
 	WebhookDataManager interface {
 		WebhookExists(ctx context.Context, webhookID, householdID string) (bool, error)
@@ -119,6 +144,10 @@
 		ArchiveWebhookHandler(http.ResponseWriter, *http.Request)
 		AddWebhookTriggerEventHandler(http.ResponseWriter, *http.Request)
 		ArchiveWebhookTriggerEventHandler(http.ResponseWriter, *http.Request)
+		ListWebhookDeliveriesHandler(http.ResponseWriter, *http.Request)
+		RedeliverWebhookDeliveryHandler(http.ResponseWriter, *http.Request)
+		PingWebhookHandler(http.ResponseWriter, *http.Request)
+		PreviewWebhookHandler(http.ResponseWriter, *http.Request)
 	}
 )
 
@@ -128,7 +157,7 @@
 func (w *WebhookCreationRequestInput) ValidateWithContext(ctx context.Context) error {
 	return validation.ValidateStructWithContext(ctx, w,
 		validation.Field(&w.Name, validation.Required),
-		validation.Field(&w.URL, validation.Required, is.URL),
+		validation.Field(&w.URL, validation.Required, is.URL, validation.By(validateWebhookURL)),
 		validation.Field(&w.Method, validation.Required, validation.In(http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete)),
 		validation.Field(&w.ContentType, validation.Required, validation.In("application/json", "application/xml")),
 		validation.Field(&w.Events, validation.Required),
@@ -152,10 +181,81 @@ func (w *WebhookDatabaseCreationInput) ValidateWithContext(ctx context.Context)
 	return validation.ValidateStructWithContext(ctx, w,
 		validation.Field(&w.ID, validation.Required),
 		validation.Field(&w.Name, validation.Required),
-		validation.Field(&w.URL, validation.Required, is.URL),
+		validation.Field(&w.URL, validation.Required, is.URL, validation.By(validateWebhookURL)),
 		validation.Field(&w.Method, validation.Required, validation.In(http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete)),
 		validation.Field(&w.ContentType, validation.Required, validation.In("application/json", "application/xml")),
 		validation.Field(&w.Events, validation.Required),
 		validation.Field(&w.BelongsToHousehold, validation.Required),
 	)
 }
+
+var _ validation.ValidatableWithContext = (*WebhookPreviewRequestInput)(nil)
+
+// ValidateWithContext validates a WebhookPreviewRequestInput.
+func (w *WebhookPreviewRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, w,
+		validation.Field(&w.TriggerEvent, validation.Required),
+	)
+}
+
+var (
+	errInvalidWebhookURLScheme  = errors.New("webhook URL must use http or https")
+	errDisallowedWebhookURLHost = errors.New("webhook URL must not point to a private, loopback, or link-local address")
+
+	// nonPublicPrefixes are ranges that aren't caught by netip.Addr's classification methods, but aren't
+	// publicly routable either.
+	nonPublicPrefixes = []netip.Prefix{
+		netip.MustParsePrefix("0.0.0.0/8"),
+		netip.MustParsePrefix("100.64.0.0/10"),
+		netip.MustParsePrefix("192.0.0.0/24"),
+		netip.MustParsePrefix("198.18.0.0/15"),
+	}
+)
+
+// IsAllowedWebhookDestination reports whether webhook deliveries may be sent to an address, which they may
+// not if it's private, loopback, link-local, or otherwise not publicly routable.
+func IsAllowedWebhookDestination(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateWebhookURL rejects webhook URLs whose host is a non-public address. Hostnames can't be fully
+// checked here, since what they resolve to can change, so deliveries check resolved addresses again when dialing.
+func validateWebhookURL(value any) error {
+	rawURL, ok := value.(string)
+	if !ok || rawURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		// is.URL reports malformed URLs.
+		return nil
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errInvalidWebhookURLScheme
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errDisallowedWebhookURLHost
+	}
+
+	if addr, parseErr := netip.ParseAddr(host); parseErr == nil && !IsAllowedWebhookDestination(addr) {
+		return errDisallowedWebhookURLHost
+	}
+
+	return nil
+}
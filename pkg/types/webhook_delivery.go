@@ -0,0 +1,67 @@
+package types
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// WebhookDelivery represents a single attempt to deliver a payload to a webhook.
+	WebhookDelivery struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt             time.Time  `json:"createdAt"`
+		NextAttemptAt         *time.Time `json:"nextAttemptAt"`
+		ResponseStatusCode    *uint16    `json:"responseStatusCode"`
+		ID                    string     `json:"id"`
+		DeliveryID            string     `json:"deliveryID"`
+		TriggerEvent          string     `json:"triggerEvent"`
+		Payload               string     `json:"payload"`
+		ResponseBody          string     `json:"responseBody"`
+		Error                 string     `json:"error"`
+		BelongsToWebhook      string     `json:"belongsToWebhook"`
+		LatencyInMilliseconds uint64     `json:"latencyInMilliseconds"`
+		Attempt               uint16     `json:"attempt"`
+		Succeeded             bool       `json:"succeeded"`
+	}
+
+	// WebhookDeliveryDatabaseCreationInput is used for recording a webhook delivery.
+	WebhookDeliveryDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		NextAttemptAt         *time.Time
+		ResponseStatusCode    *uint16
+		ID                    string
+		DeliveryID            string
+		TriggerEvent          string
+		Payload               string
+		ResponseBody          string
+		Error                 string
+		BelongsToWebhook      string
+		LatencyInMilliseconds uint64
+		Attempt               uint16
+		Succeeded             bool
+	}
+
+	// WebhookDeliveryRetry describes a failed webhook delivery whose next attempt is due.
+	WebhookDeliveryRetry struct {
+		_ struct{} `json:"-"`
+
+		WebhookDeliveryID string
+		TriggerEvent      string
+		WebhookID         string
+		HouseholdID       string
+	}
+
+	// WebhookDeliveryDataManager describes a structure capable of storing webhook deliveries.
+	WebhookDeliveryDataManager interface {
+		GetWebhookDelivery(ctx context.Context, webhookID, webhookDeliveryID string) (*WebhookDelivery, error)
+		GetWebhookDeliveries(ctx context.Context, webhookID string, filter *QueryFilter) (*QueryFilteredResult[WebhookDelivery], error)
+		CreateWebhookDelivery(ctx context.Context, input *WebhookDeliveryDatabaseCreationInput) (*WebhookDelivery, error)
+		RecordWebhookDeliverySuccess(ctx context.Context, webhookID string) error
+		RecordWebhookDeliveryFailure(ctx context.Context, webhookID string) (uint32, error)
+		DisableWebhook(ctx context.Context, webhookID string) error
+		ClaimDueWebhookDeliveryRetries(ctx context.Context, batchSize uint16, claimDuration time.Duration) ([]*WebhookDeliveryRetry, error)
+		MarkWebhookDeliveryAsRetried(ctx context.Context, webhookDeliveryID string) error
+	}
+)
@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -44,6 +45,35 @@ func TestWebhookCreationInput_Validate(T *testing.T) {
 		assert.Error(t, exampleInput.ValidateWithContext(context.Background()))
 	})
 
+	T.Run("non-http url", func(t *testing.T) {
+		t.Parallel()
+		exampleInput := buildValidWebhookCreationInput()
+		exampleInput.URL = "ftp://verygoodsoftwarenotvirus.ru"
+
+		assert.Error(t, exampleInput.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("non-public url", func(t *testing.T) {
+		t.Parallel()
+
+		for _, u := range []string{
+			"http://localhost:8080/webhook",
+			"http://127.0.0.1/webhook",
+			"http://10.0.0.1/webhook",
+			"http://192.168.1.1/webhook",
+			"http://169.254.169.254/latest/meta-data",
+			"http://[::1]/webhook",
+			"http://[fd00:ec2::254]/webhook",
+			"http://[::ffff:127.0.0.1]/webhook",
+			"http://0.0.0.0/webhook",
+		} {
+			exampleInput := buildValidWebhookCreationInput()
+			exampleInput.URL = u
+
+			assert.Error(t, exampleInput.ValidateWithContext(context.Background()), u)
+		}
+	})
+
 	T.Run("bad method", func(t *testing.T) {
 		t.Parallel()
 		exampleInput := buildValidWebhookCreationInput()
@@ -69,6 +99,39 @@ func TestWebhookCreationInput_Validate(T *testing.T) {
 	})
 }
 
+func TestIsAllowedWebhookDestination(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, IsAllowedWebhookDestination(netip.MustParseAddr("93.184.216.34")))
+		assert.True(t, IsAllowedWebhookDestination(netip.MustParseAddr("2606:2800:220:1:248:1893:25c8:1946")))
+	})
+
+	T.Run("with non-public addresses", func(t *testing.T) {
+		t.Parallel()
+
+		for _, addr := range []string{
+			"127.0.0.1",
+			"10.1.2.3",
+			"172.16.0.1",
+			"192.168.0.1",
+			"169.254.169.254",
+			"100.100.100.200",
+			"0.0.0.0",
+			"224.0.0.1",
+			"::",
+			"::1",
+			"fe80::1",
+			"fd00::1",
+			"::ffff:10.0.0.1",
+		} {
+			assert.False(t, IsAllowedWebhookDestination(netip.MustParseAddr(addr)), addr)
+		}
+	})
+}
+
 func TestWebhookCreationRequestInput_ValidateWithContext(T *testing.T) {
 	T.Parallel()
 
@@ -112,3 +175,25 @@ func TestWebhookDatabaseCreationInput_ValidateWithContext(T *testing.T) {
 		assert.NoError(t, x.ValidateWithContext(ctx))
 	})
 }
+
+func TestWebhookPreviewRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &WebhookPreviewRequestInput{
+			TriggerEvent: string(WebhookCreatedCustomerEventType),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(context.Background()))
+	})
+
+	T.Run("with missing trigger event", func(t *testing.T) {
+		t.Parallel()
+
+		x := &WebhookPreviewRequestInput{}
+
+		assert.Error(t, x.ValidateWithContext(context.Background()))
+	})
+}
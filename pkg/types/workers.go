@@ -16,5 +16,8 @@
 		MealPlanFinalizationHandler(http.ResponseWriter, *http.Request)
 		MealPlanGroceryListInitializationHandler(res http.ResponseWriter, req *http.Request)
 		MealPlanTaskCreationHandler(res http.ResponseWriter, req *http.Request)
+		ListDeadLetteredMessagesHandler(res http.ResponseWriter, req *http.Request)
+		ReadDeadLetteredMessageHandler(res http.ResponseWriter, req *http.Request)
+		ReplayDeadLetteredMessageHandler(res http.ResponseWriter, req *http.Request)
 	}
 )
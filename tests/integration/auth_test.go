@@ -8,6 +8,7 @@
 	"os"
 	"time"
 
+	"github.com/dinnerdonebetter/backend/internal/authentication/loginattempts"
 	"github.com/dinnerdonebetter/backend/internal/observability/tracing"
 	authservice "github.com/dinnerdonebetter/backend/internal/services/authentication"
 	"github.com/dinnerdonebetter/backend/pkg/types"
@@ -96,6 +97,35 @@ func (s *TestSuite) TestLogin_ShouldNotBeAbleToLoginWithInvalidPassword() {
 	})
 }
 
+func (s *TestSuite) TestLogin_LockoutsAreRecordedInTheAuditLog() {
+	s.Run("lockouts triggered by failed logins should be recorded in the audit log", func() {
+		t := s.T()
+
+		ctx, span := tracing.StartCustomSpan(context.Background(), t.Name())
+		defer span.End()
+
+		testUser, _, testClient, _ := createUserAndClientForTest(ctx, t, nil)
+
+		r := &types.UserLoginInput{
+			Username:  testUser.Username,
+			Password:  testUser.HashedPassword + "nope",
+			TOTPToken: generateTOTPTokenForUser(t, testUser),
+		}
+
+		// the integration tests config uses the default per-user threshold of five failures.
+		for range 5 {
+			cookie, err := testClient.BeginSession(ctx, r)
+			assert.Nil(t, cookie)
+			assert.Error(t, err)
+		}
+
+		entries, err := testClient.GetAuditLogEntriesForUser(ctx, loginattempts.ResourceTypeLoginLockouts)
+		requireNotNilAndNoProblems(t, entries, err)
+		require.Len(t, entries.Data, 1)
+		assert.Equal(t, testUser.ID, entries.Data[0].RelevantID)
+	})
+}
+
 func (s *TestSuite) TestLogin_ShouldNotBeAbleToLoginAsANonexistentUser() {
 	s.Run("should not be able to login as someone that does not exist", func() {
 		t := s.T()
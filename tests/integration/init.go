@@ -114,6 +114,7 @@ func init() {
 		Description:  "integration test client",
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
+		Scopes:       []string{string(authorization.ServiceAdminOAuth2Scope)},
 	})
 	if err != nil {
 		panic(err)